@@ -13,11 +13,23 @@ import (
 
 	"github.com/ochamu/morning-call-api/internal/config"
 	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
 	"github.com/ochamu/morning-call-api/internal/handler"
 	"github.com/ochamu/morning-call-api/internal/handler/middleware"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/audit"
 	"github.com/ochamu/morning-call-api/internal/infrastructure/auth"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/cache"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/circuitbreaker"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/events"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/external"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/mail"
 	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory/instrumented"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/scheduler"
 	"github.com/ochamu/morning-call-api/internal/infrastructure/server"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/translation"
+	adminUC "github.com/ochamu/morning-call-api/internal/usecase/admin"
 	authUC "github.com/ochamu/morning-call-api/internal/usecase/auth"
 	morningCallUC "github.com/ochamu/morning-call-api/internal/usecase/morning_call"
 	relationshipUC "github.com/ochamu/morning-call-api/internal/usecase/relationship"
@@ -35,10 +47,51 @@ func main() {
 	log.Printf("Morning Call API サーバーを起動します (ポート: %s)", cfg.Server.Port)
 
 	// リポジトリの初期化（インメモリ実装）
-	userRepo := memory.NewUserRepository()
-	morningCallRepo := memory.NewMorningCallRepository()
-	relationshipRepo := memory.NewRelationshipRepository()
-	transactionManager := memory.NewTransactionManager()
+	memUserRepo := memory.NewUserRepository()
+	memMorningCallRepo := memory.NewMorningCallRepository()
+	memRelationshipRepo := memory.NewRelationshipRepository()
+	memTransactionManager := memory.NewTransactionManager(memUserRepo, memMorningCallRepo, memRelationshipRepo)
+
+	var (
+		userRepo         repository.UserRepository                 = memUserRepo
+		morningCallRepo  repository.MorningCallRepository          = memMorningCallRepo
+		relationshipRepo repository.RelationshipRepository         = memRelationshipRepo
+		accessLogRepo    repository.MorningCallAccessLogRepository = memory.NewMorningCallAccessLogRepository(cfg.MorningCall.AccessLogSize)
+		friendInviteRepo repository.FriendInviteRepository         = memory.NewFriendInviteRepository()
+		deviceTokenRepo  repository.DeviceTokenRepository          = memory.NewDeviceTokenRepository()
+		commentRepo      repository.CommentRepository              = memory.NewCommentRepository()
+	)
+
+	// リポジトリ操作の計測（有効な場合は各リポジトリを計測用のデコレータでラップする）
+	// トランザクション内の操作はトランザクションマネージャーが直接扱うため計測の対象外
+	var repositoryMetrics repository.OperationMetricsSource
+	if cfg.Metrics.RepositoryEnabled {
+		recorder := instrumented.NewRecorder()
+		userRepo = instrumented.NewUserRepository(userRepo, recorder)
+		morningCallRepo = instrumented.NewMorningCallRepository(morningCallRepo, recorder)
+		relationshipRepo = instrumented.NewRelationshipRepository(relationshipRepo, recorder)
+		accessLogRepo = instrumented.NewMorningCallAccessLogRepository(accessLogRepo, recorder)
+		friendInviteRepo = instrumented.NewFriendInviteRepository(friendInviteRepo, recorder)
+		deviceTokenRepo = instrumented.NewDeviceTokenRepository(deviceTokenRepo, recorder)
+		commentRepo = instrumented.NewCommentRepository(commentRepo, recorder)
+		repositoryMetrics = recorder
+		log.Printf("リポジトリ操作の計測を有効にしました")
+	}
+
+	// IDによるユーザーの検索結果のキャッシュ（計測の外側に置き、キャッシュにない場合の呼び出しのみを計測する）
+	// トランザクション内ではユーザーを更新しないため、トランザクションマネージャーを経由した変更による無効化は不要
+	var cachedUserRepo *cache.CachedUserRepository
+	if cfg.Cache.UserEnabled {
+		cachedUserRepo = cache.NewCachedUserRepository(userRepo, cfg.Cache.UserCapacity, cfg.Cache.UserTTL)
+		userRepo = cachedUserRepo
+		log.Printf("ユーザーのキャッシュを有効にしました (有効期限: %v, 件数: %d)", cfg.Cache.UserTTL, cfg.Cache.UserCapacity)
+	}
+
+	// モーニングコールの変更通知（作成・ステータスの変更・削除をイベントバスの購読者へ非同期に通知する）
+	// トランザクション内の変更はコミットに成功した時点でまとめて通知する
+	eventBus := events.NewEventBus(events.DefaultSubscriberBufferSize)
+	morningCallRepo = events.NewMorningCallRepository(morningCallRepo, eventBus)
+	transactionManager := events.NewTransactionManager(memTransactionManager, eventBus)
 
 	// リポジトリファクトリーの作成
 	factory := &repositoryFactory{
@@ -52,38 +105,218 @@ func main() {
 	passwordService := auth.NewPasswordService()
 
 	// セッションマネージャーの初期化
-	sessionManager := auth.NewSessionManager(24 * time.Hour) // 24時間のセッションタイムアウト
+	sessionManager := auth.NewSessionManagerWithCleanupInterval(24*time.Hour, cfg.Auth.SessionCleanupInterval) // 24時間のセッションタイムアウト
+
+	// 監査ログの初期化
+	auditLogger := audit.NewLogAuditLogger(nil)
+
+	// メール送信の初期化（配信基盤が用意されるまではログに出力する）
+	var emailSender service.EmailSender = mail.NewLogEmailSender(nil)
+
+	// メッセージ翻訳の初期化（翻訳サービスが用意されるまでは辞書で翻訳する）
+	var translationService service.Translator = translation.NewDictionaryTranslator(nil)
+
+	// 天気予報の初期化（天気予報サービスが用意されるまでは設定した天気を返す）
+	var weatherProvider service.WeatherProvider = external.NewStubWeatherProvider(valueobject.WeatherCondition(cfg.Weather.StubCondition))
+
+	// 外部連携のサーキットブレーカー（有効な場合はエラー率が閾値を超えた連携先の呼び出しを一定時間スキップする）
+	if cfg.CircuitBreaker.Enabled {
+		breakerConfig := circuitbreaker.Config{
+			FailureRateThreshold: cfg.CircuitBreaker.FailureRateThreshold,
+			MinRequests:          cfg.CircuitBreaker.MinRequests,
+			Window:               cfg.CircuitBreaker.Window,
+			OpenDuration:         cfg.CircuitBreaker.OpenDuration,
+			HalfOpenMaxRequests:  cfg.CircuitBreaker.HalfOpenMaxRequests,
+			OnStateChange: func(name string, from, to circuitbreaker.State) {
+				log.Printf("サーキットブレーカー %s の状態が %s から %s に遷移しました", name, from, to)
+			},
+		}
+		emailSender = circuitbreaker.NewEmailSender(emailSender, circuitbreaker.New("mail", breakerConfig))
+
+		// 未対応の言語は連携先の不調ではないため失敗として数えない
+		translationBreakerConfig := breakerConfig
+		translationBreakerConfig.IsFailure = func(err error) bool {
+			return !errors.Is(err, translation.ErrUnsupportedLanguage) && !errors.Is(err, context.Canceled)
+		}
+		translationService = circuitbreaker.NewTranslator(translationService, circuitbreaker.New("translation", translationBreakerConfig))
+		weatherProvider = circuitbreaker.NewWeatherProvider(weatherProvider, circuitbreaker.New("weather", breakerConfig))
+		log.Printf("外部連携のサーキットブレーカーを有効にしました")
+	}
+
+	// 翻訳結果はキャッシュし、キャッシュにある翻訳はブレーカーがオープンの間も返せるようにする
+	translator := translation.NewCachingTranslator(translationService, cfg.MorningCall.TranslationCacheSize)
+
+	// 入力文字数制限の設定
+	inputLimits := valueobject.InputLimits{
+		UsernameMinLength: cfg.InputLimits.UsernameMinLength,
+		UsernameMaxLength: cfg.InputLimits.UsernameMaxLength,
+		EmailMaxLength:    cfg.InputLimits.EmailMaxLength,
+		MessageMaxLength:  cfg.InputLimits.MessageMaxLength,
+	}
 
 	// ユースケースの初期化
 	authUseCase := authUC.NewAuthUseCase(userRepo, passwordService)
-	userUseCase := userUC.NewUserUseCase(userRepo, passwordService)
+	authUseCase.SetLoginNotifier(mail.NewLoginNotifier(emailSender))
+	userUseCase := userUC.NewUserUseCase(userRepo, passwordService, inputLimits)
+	searchUsersUC := userUC.NewSearchUsersUseCase(userRepo, relationshipRepo)
+	requestEmailChangeUC := userUC.NewRequestEmailChangeUseCase(userRepo, passwordService, emailSender, inputLimits)
+	updateCallApprovalUC := userUC.NewUpdateCallApprovalUseCase(userRepo)
+	updateProxyConfirmerUC := userUC.NewUpdateProxyConfirmerUseCase(userRepo, relationshipRepo)
+	updateCallForwardingUC := userUC.NewUpdateCallForwardingUseCase(userRepo, relationshipRepo)
+	updateTimeZoneUC := userUC.NewUpdateTimeZoneUseCase(userRepo)
+	updatePreferencesUC := userUC.NewUpdatePreferencesUseCase(userRepo)
+	updateCallWindowUC := userUC.NewUpdateCallWindowUseCase(userRepo)
+	checkAvailabilityUC := userUC.NewCheckAvailabilityUseCase(userRepo, inputLimits, cfg.Auth.AvailabilityCheckLimit, cfg.Auth.AvailabilityCheckWindow)
+	leaderboardUC := userUC.NewLeaderboardUseCase(userRepo)
+	changeUsernameUC := userUC.NewChangeUsernameUseCase(userRepo, inputLimits, cfg.Auth.UsernameChangeCooldown)
+	changePasswordUC := userUC.NewChangePasswordUseCase(userRepo, passwordService, cfg.Auth.PasswordHistorySize)
+	registerDeviceUC := userUC.NewRegisterDeviceTokenUseCase(userRepo, deviceTokenRepo)
+	listDevicesUC := userUC.NewListDeviceTokensUseCase(deviceTokenRepo)
+	deleteDeviceUC := userUC.NewDeleteDeviceTokenUseCase(deviceTokenRepo)
+	requestAccountDeletionUC := userUC.NewRequestAccountDeletionUseCase(userRepo, passwordService, cfg.Auth.AccountDeletionGracePeriod)
+	confirmEmailChangeUC := userUC.NewConfirmEmailChangeUseCase(userRepo, inputLimits)
+	adminListUsersUC := userUC.NewAdminListUsersUseCase(userRepo, relationshipRepo, morningCallRepo)
+	adminChangePlanUC := userUC.NewAdminChangePlanUseCase(userRepo)
+	detectAnomaliesUC := adminUC.NewDetectAnomalousUsersUseCase(userRepo, relationshipRepo, morningCallRepo, adminUC.AnomalyDetectionConfig{
+		Window:                 cfg.Anomaly.Window,
+		FriendRequestThreshold: cfg.Anomaly.FriendRequestThreshold,
+		MorningCallThreshold:   cfg.Anomaly.MorningCallThreshold,
+		ExcludedUserIDs:        cfg.Anomaly.ExcludedUserIDs,
+	})
+	systemStatsUC := adminUC.NewSystemStatsUseCase(userRepo, relationshipRepo, morningCallRepo, repositoryMetrics, sessionManager)
+
+	// プラン別クォータの設定
+	planQuotas := valueobject.PlanQuotas{
+		valueobject.PlanFree: {
+			MaxActiveCalls: cfg.Plan.FreeMaxActiveCalls,
+			MaxDailyCalls:  cfg.Plan.FreeMaxDailyCalls,
+			MaxFriends:     cfg.Plan.FreeMaxFriends,
+		},
+		valueobject.PlanPremium: {
+			MaxActiveCalls: cfg.Plan.PremiumMaxActiveCalls,
+			MaxDailyCalls:  cfg.Plan.PremiumMaxDailyCalls,
+			MaxFriends:     cfg.Plan.PremiumMaxFriends,
+		},
+	}
+
+	// 天気に応じたアラーム時刻の調整（無効な場合は調整しない）
+	var scheduleAdjuster morningCallUC.ScheduleAdjuster
+	if cfg.Weather.AdjustmentEnabled {
+		scheduleAdjuster = morningCallUC.NewWeatherScheduleAdjuster(weatherProvider, valueobject.WeatherAdjustmentRules{
+			valueobject.WeatherRain: cfg.Weather.RainOffset,
+			valueobject.WeatherSnow: cfg.Weather.SnowOffset,
+		})
+		log.Printf("天気に応じたアラーム時刻の調整を有効にしました")
+	}
 
 	// モーニングコールユースケースの初期化
-	createMorningCallUC := morningCallUC.NewCreateUseCase(morningCallRepo, userRepo, relationshipRepo)
-	updateMorningCallUC := morningCallUC.NewUpdateUseCase(morningCallRepo, userRepo)
-	deleteMorningCallUC := morningCallUC.NewDeleteUseCase(morningCallRepo) // DeleteUseCaseは引数が1つのみ
-	listMorningCallUC := morningCallUC.NewListUseCase(morningCallRepo, userRepo)
-	confirmWakeUC := morningCallUC.NewConfirmWakeUseCase(morningCallRepo, userRepo)
+	createMorningCallUC := morningCallUC.NewCreateUseCase(morningCallRepo, userRepo, relationshipRepo, planQuotas, inputLimits, scheduleAdjuster)
+	updateMorningCallUC := morningCallUC.NewUpdateUseCase(morningCallRepo, userRepo, inputLimits)
+	deleteMorningCallUC := morningCallUC.NewDeleteUseCase(morningCallRepo, commentRepo)
+	listMorningCallUC := morningCallUC.NewListUseCase(morningCallRepo, userRepo, relationshipRepo)
+	confirmWakeUC := morningCallUC.NewConfirmWakeUseCase(morningCallRepo, userRepo, emailSender, auditLogger, cfg.MorningCall.ConfirmPoints)
+	notifyExpirationUC := morningCallUC.NewNotifyExpirationUseCase(userRepo, emailSender)
+	findConflictsUC := morningCallUC.NewFindScheduleConflictsUseCase(morningCallRepo, userRepo)
+	validateMessageUC := morningCallUC.NewValidateMessageUseCase(cfg.MorningCall.BannedWords, inputLimits)
+	sendStampUC := morningCallUC.NewSendStampUseCase(morningCallRepo, userRepo)
+	frequentReceiversUC := morningCallUC.NewFrequentReceiversUseCase(morningCallRepo, userRepo)
+	skipMorningCallUC := morningCallUC.NewSkipUseCase(morningCallRepo, userRepo)
+	adminBulkUpdateUC := morningCallUC.NewAdminBulkUpdateStatusUseCase(morningCallRepo, userRepo, auditLogger)
+	adminDeleteUC := morningCallUC.NewAdminDeleteUseCase(morningCallRepo, commentRepo, userRepo, auditLogger)
+	unconfirmedCountUC := morningCallUC.NewUnconfirmedCountUseCase(morningCallRepo)
+	setReceiverOffsetUC := morningCallUC.NewSetReceiverOffsetUseCase(morningCallRepo, userRepo)
+	patchMorningCallUC := morningCallUC.NewPatchUseCase(morningCallRepo, inputLimits)
+	approveCallUC := morningCallUC.NewApproveCallUseCase(morningCallRepo, userRepo)
+	rejectCallUC := morningCallUC.NewRejectCallUseCase(morningCallRepo, userRepo, emailSender)
+	setSilentDeliveryUC := morningCallUC.NewSetSilentDeliveryUseCase(morningCallRepo, userRepo)
+	createSeriesUC := morningCallUC.NewCreateSeriesUseCase(morningCallRepo, userRepo, relationshipRepo, planQuotas, inputLimits)
+	listSeriesUC := morningCallUC.NewListSeriesUseCase(morningCallRepo)
+	cancelSeriesUC := morningCallUC.NewCancelSeriesUseCase(morningCallRepo)
+	updateSeriesUC := morningCallUC.NewUpdateSeriesUseCase(morningCallRepo, inputLimits)
+	callLeaderboardUC := morningCallUC.NewLeaderboardUseCase(morningCallRepo, userRepo, relationshipRepo)
+	getMorningCallUC := morningCallUC.NewGetUseCase(morningCallRepo, accessLogRepo, translator)
+	listAccessLogUC := morningCallUC.NewListAccessLogUseCase(morningCallRepo, accessLogRepo, userRepo)
+	archiveMorningCallUC := morningCallUC.NewArchiveUseCase(morningCallRepo)
+	cancelMorningCallUC := morningCallUC.NewCancelUseCase(morningCallRepo, cfg.MorningCall.CancelGracePeriod)
+	requestRescheduleUC := morningCallUC.NewRequestRescheduleUseCase(morningCallRepo, userRepo)
+	respondRescheduleUC := morningCallUC.NewRespondRescheduleUseCase(morningCallRepo)
+	respondNextCallUC := morningCallUC.NewRespondNextCallUseCase(morningCallRepo, createMorningCallUC)
+	wakeHeatmapUC := morningCallUC.NewWakeHeatmapUseCase(morningCallRepo, userRepo)
+	listSystemMessagesUC := morningCallUC.NewListSystemMessagesUseCase(valueobject.DefaultSystemMessageCatalog())
+	rateMorningCallUC := morningCallUC.NewRateMorningCallUseCase(morningCallRepo, userRepo)
+	ratingStatsUC := morningCallUC.NewRatingStatsUseCase(morningCallRepo, userRepo)
+	confirmTimeStatsUC := morningCallUC.NewConfirmTimeStatsUseCase(morningCallRepo, userRepo)
+	messageVariantStatsUC := morningCallUC.NewMessageVariantStatsUseCase(morningCallRepo, userRepo)
+	setDeliveryHintsUC := morningCallUC.NewSetDeliveryHintsUseCase(morningCallRepo, userRepo)
+	generateReportUC := morningCallUC.NewGenerateReportUseCase(morningCallRepo, userRepo)
+	addCommentUC := morningCallUC.NewAddCommentUseCase(morningCallRepo, commentRepo)
+	listCommentsUC := morningCallUC.NewListCommentsUseCase(morningCallRepo, commentRepo)
 
 	// 関係性ユースケースの初期化
-	sendFriendRequestUC := relationshipUC.NewSendFriendRequestUseCase(relationshipRepo, userRepo)
-	acceptFriendRequestUC := relationshipUC.NewAcceptFriendRequestUseCase(relationshipRepo, userRepo)
+	sendFriendRequestUC := relationshipUC.NewSendFriendRequestUseCase(relationshipRepo, userRepo, planQuotas, valueobject.FriendRequestResendPolicy{
+		Cooldown:   cfg.FriendRequest.ResendCooldown,
+		MaxResends: cfg.FriendRequest.MaxResends,
+		Lockout:    cfg.FriendRequest.ResendLockout,
+	})
+	acceptFriendRequestUC := relationshipUC.NewAcceptFriendRequestUseCase(relationshipRepo, userRepo, planQuotas, transactionManager)
 	rejectFriendRequestUC := relationshipUC.NewRejectFriendRequestUseCase(relationshipRepo, userRepo)
-	blockUserUC := relationshipUC.NewBlockUserUseCase(relationshipRepo, userRepo)
-	blockRelationshipUC := relationshipUC.NewBlockRelationshipUseCase(relationshipRepo, userRepo)
-	removeRelationshipUC := relationshipUC.NewRemoveRelationshipUseCase(relationshipRepo, userRepo)
-	listFriendsUC := relationshipUC.NewListFriendsUseCase(relationshipRepo, userRepo)
+	blockUserUC := relationshipUC.NewBlockUserUseCase(relationshipRepo, userRepo, morningCallRepo, transactionManager)
+	blockRelationshipUC := relationshipUC.NewBlockRelationshipUseCase(relationshipRepo, userRepo, morningCallRepo, transactionManager)
+	unblockUserUC := relationshipUC.NewUnblockUserUseCase(relationshipRepo, userRepo)
+	removeRelationshipUC := relationshipUC.NewRemoveRelationshipUseCase(relationshipRepo, userRepo, morningCallRepo, transactionManager)
+	listFriendsUC := relationshipUC.NewListFriendsUseCase(relationshipRepo, userRepo, morningCallRepo)
 	listFriendRequestsUC := relationshipUC.NewListFriendRequestsUseCase(relationshipRepo, userRepo)
+	markRequestSeenUC := relationshipUC.NewMarkRequestSeenUseCase(relationshipRepo, userRepo)
+	unseenRequestCountUC := relationshipUC.NewUnseenRequestCountUseCase(relationshipRepo)
+	friendCallWindowUC := relationshipUC.NewUpdateFriendCallWindowUseCase(relationshipRepo, userRepo)
+	updateAutoConfirmUC := relationshipUC.NewUpdateAutoConfirmUseCase(relationshipRepo, userRepo)
+	favoriteSenderUC := relationshipUC.NewUpdateFavoriteSenderUseCase(relationshipRepo, userRepo)
+	generateFriendInviteUC := relationshipUC.NewGenerateFriendInviteTokenUseCase(friendInviteRepo, userRepo)
+	acceptFriendInviteUC := relationshipUC.NewAcceptFriendInviteUseCase(friendInviteRepo, sendFriendRequestUC)
+	friendGraphUC := relationshipUC.NewExportFriendGraphUseCase(relationshipRepo, userRepo)
 
 	// ハンドラーの初期化
 	authHandler := handler.NewAuthHandler(authUseCase, sessionManager)
-	userHandler := handler.NewUserHandler(userUseCase, sessionManager)
+	userHandler := handler.NewUserHandler(userUseCase, searchUsersUC, requestEmailChangeUC, confirmEmailChangeUC, updateCallApprovalUC, updateProxyConfirmerUC, updateCallForwardingUC, updateTimeZoneUC, updatePreferencesUC, changePasswordUC, updateCallWindowUC, checkAvailabilityUC, leaderboardUC, changeUsernameUC, registerDeviceUC, listDevicesUC, deleteDeviceUC, requestAccountDeletionUC, sessionManager)
 	morningCallHandler := handler.NewMorningCallHandler(
 		createMorningCallUC,
 		updateMorningCallUC,
 		deleteMorningCallUC,
 		listMorningCallUC,
 		confirmWakeUC,
+		findConflictsUC,
+		validateMessageUC,
+		sendStampUC,
+		frequentReceiversUC,
+		skipMorningCallUC,
+		unconfirmedCountUC,
+		setReceiverOffsetUC,
+		patchMorningCallUC,
+		approveCallUC,
+		rejectCallUC,
+		setSilentDeliveryUC,
+		createSeriesUC,
+		listSeriesUC,
+		cancelSeriesUC,
+		updateSeriesUC,
+		callLeaderboardUC,
+		getMorningCallUC,
+		listAccessLogUC,
+		archiveMorningCallUC,
+		cancelMorningCallUC,
+		requestRescheduleUC,
+		respondRescheduleUC,
+		respondNextCallUC,
+		wakeHeatmapUC,
+		listSystemMessagesUC,
+		rateMorningCallUC,
+		ratingStatsUC,
+		confirmTimeStatsUC,
+		messageVariantStatsUC,
+		setDeliveryHintsUC,
+		generateReportUC,
+		addCommentUC,
+		listCommentsUC,
 		sessionManager,
 	)
 	relationshipHandler := handler.NewRelationshipHandler(
@@ -95,12 +328,28 @@ func main() {
 		removeRelationshipUC,
 		listFriendsUC,
 		listFriendRequestsUC,
+		markRequestSeenUC,
+		unseenRequestCountUC,
+		friendCallWindowUC,
+		updateAutoConfirmUC,
+		favoriteSenderUC,
+		generateFriendInviteUC,
+		acceptFriendInviteUC,
+		friendGraphUC,
 		userUseCase,
 		sessionManager,
 	)
+	adminHandler := handler.NewAdminHandler(adminListUsersUC, adminChangePlanUC, adminBulkUpdateUC, detectAnomaliesUC, systemStatsUC, adminDeleteUC)
 
 	// 認証ミドルウェアの初期化
-	authMiddleware := middleware.NewAuthMiddleware(sessionManager, userRepo)
+	authMiddleware := middleware.NewAuthMiddlewareWithCache(sessionManager, userRepo, cfg.Auth.SessionCacheTTL)
+	// クライアントの二重送信による状態変更系リクエストの重複を抑制する
+	authMiddleware.SuppressDuplicateRequests(cfg.Server.DuplicateRequestWindow)
+	// 管理者エンドポイントへのアクセス元IPアドレスを制限する
+	adminIPFilter, err := middleware.NewIPFilterMiddleware(cfg.AdminAccess.AllowedCIDRs, cfg.AdminAccess.DeniedCIDRs, cfg.AdminAccess.TrustedProxyCIDRs)
+	if err != nil {
+		log.Fatalf("IPフィルターの初期化に失敗しました: %v", err)
+	}
 
 	// 依存性コンテナの作成
 	deps := &server.Dependencies{
@@ -108,35 +357,163 @@ func main() {
 		RepositoryFactory: factory,
 		PasswordService:   passwordService,
 		SessionManager:    sessionManager,
+		AuditLogger:       auditLogger,
 		Handlers: server.Handlers{
 			Auth:         authHandler,
 			User:         userHandler,
 			MorningCall:  morningCallHandler,
 			Relationship: relationshipHandler,
+			Admin:        adminHandler,
 		},
 		AuthMiddleware: authMiddleware,
+		AdminIPFilter:  adminIPFilter,
 		UseCases: server.UseCases{
 			Auth:                authUseCase,
 			User:                userUseCase,
+			SearchUsers:         searchUsersUC,
+			RequestEmailChange:  requestEmailChangeUC,
+			ConfirmEmailChange:  confirmEmailChangeUC,
+			UpdateCallApproval:  updateCallApprovalUC,
+			ProxyConfirmer:      updateProxyConfirmerUC,
+			CallForwarding:      updateCallForwardingUC,
+			UpdateTimeZone:      updateTimeZoneUC,
+			UpdatePreferences:   updatePreferencesUC,
+			ChangeUsername:      changeUsernameUC,
+			RegisterDevice:      registerDeviceUC,
+			ListDevices:         listDevicesUC,
+			DeleteDevice:        deleteDeviceUC,
+			DeleteAccount:       requestAccountDeletionUC,
+			ChangePassword:      changePasswordUC,
+			UpdateCallWindow:    updateCallWindowUC,
+			CheckAvailability:   checkAvailabilityUC,
+			Leaderboard:         leaderboardUC,
 			CreateMorningCall:   createMorningCallUC,
 			UpdateMorningCall:   updateMorningCallUC,
 			DeleteMorningCall:   deleteMorningCallUC,
 			ListMorningCalls:    listMorningCallUC,
 			ConfirmWake:         confirmWakeUC,
+			FindConflicts:       findConflictsUC,
+			ValidateMessage:     validateMessageUC,
+			SendStamp:           sendStampUC,
+			FrequentReceivers:   frequentReceiversUC,
+			SkipMorningCall:     skipMorningCallUC,
+			UnconfirmedCount:    unconfirmedCountUC,
+			SetReceiverOffset:   setReceiverOffsetUC,
+			PatchMorningCall:    patchMorningCallUC,
+			ApproveCall:         approveCallUC,
+			RejectCall:          rejectCallUC,
+			SetSilentDelivery:   setSilentDeliveryUC,
+			CreateSeries:        createSeriesUC,
+			ListSeries:          listSeriesUC,
+			CancelSeries:        cancelSeriesUC,
+			UpdateSeries:        updateSeriesUC,
+			CallLeaderboard:     callLeaderboardUC,
+			GetMorningCall:      getMorningCallUC,
+			ListAccessLog:       listAccessLogUC,
+			ArchiveMorningCall:  archiveMorningCallUC,
+			CancelMorningCall:   cancelMorningCallUC,
+			RequestReschedule:   requestRescheduleUC,
+			RespondReschedule:   respondRescheduleUC,
+			RespondNextCall:     respondNextCallUC,
+			WakeHeatmap:         wakeHeatmapUC,
+			ListSystemMessages:  listSystemMessagesUC,
+			RateMorningCall:     rateMorningCallUC,
+			RatingStats:         ratingStatsUC,
+			ConfirmTimeStats:    confirmTimeStatsUC,
+			MessageVariantStats: messageVariantStatsUC,
+			SetDeliveryHints:    setDeliveryHintsUC,
+			GenerateReport:      generateReportUC,
+			AddComment:          addCommentUC,
+			ListComments:        listCommentsUC,
 			SendFriendRequest:   sendFriendRequestUC,
 			AcceptFriendRequest: acceptFriendRequestUC,
 			RejectFriendRequest: rejectFriendRequestUC,
 			BlockUser:           blockUserUC,
 			BlockRelationship:   blockRelationshipUC,
+			UnblockUser:         unblockUserUC,
 			RemoveRelationship:  removeRelationshipUC,
 			ListFriends:         listFriendsUC,
 			ListFriendRequests:  listFriendRequestsUC,
+			MarkRequestSeen:     markRequestSeenUC,
+			UnseenRequestCount:  unseenRequestCountUC,
+			FriendCallWindow:    friendCallWindowUC,
+			UpdateAutoConfirm:   updateAutoConfirmUC,
+			FavoriteSender:      favoriteSenderUC,
+			GenerateInvite:      generateFriendInviteUC,
+			AcceptInvite:        acceptFriendInviteUC,
+			FriendGraph:         friendGraphUC,
+			AdminListUsers:      adminListUsersUC,
+			AdminChangePlan:     adminChangePlanUC,
+			AdminBulkUpdate:     adminBulkUpdateUC,
+			AdminDelete:         adminDeleteUC,
+			DetectAnomalies:     detectAnomaliesUC,
+			SystemStats:         systemStatsUC,
 		},
 	}
 
 	// HTTPサーバーの作成
 	srv := server.NewHTTPServer(cfg, deps)
 
+	// バックグラウンドワーカーの起動
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	friendRequestExpiryWorker := scheduler.NewFriendRequestExpiryWorker(relationshipRepo, auditLogger, scheduler.FriendRequestExpiryConfig{
+		Expiry:   cfg.Scheduler.FriendRequestExpiry,
+		Interval: cfg.Scheduler.FriendRequestExpiryInterval,
+		Action:   scheduler.ExpiryAction(cfg.Scheduler.FriendRequestExpiryAction),
+	})
+	friendRequestExpiryWorker.Start(workerCtx)
+	morningCallDeliveryWorker := scheduler.NewMorningCallDeliveryWorker(morningCallRepo, relationshipRepo, auditLogger, scheduler.MorningCallDeliveryConfig{
+		Interval:  cfg.Scheduler.MorningCallDeliveryInterval,
+		MaxPerRun: cfg.Scheduler.MorningCallDeliveryMaxPerRun,
+	})
+	morningCallDeliveryWorker.Start(workerCtx)
+	morningCallArchiveWorker := scheduler.NewMorningCallArchiveWorker(morningCallRepo, scheduler.MorningCallArchiveConfig{
+		After:    cfg.Scheduler.MorningCallArchiveAfter,
+		Interval: cfg.Scheduler.MorningCallArchiveInterval,
+	})
+	// 期間に0以下を指定した場合は自動アーカイブを行わない
+	if cfg.Scheduler.MorningCallArchiveAfter > 0 {
+		morningCallArchiveWorker.Start(workerCtx)
+	}
+	morningCallExpirationWorker := scheduler.NewMorningCallExpirationWorker(morningCallRepo, scheduler.MorningCallExpirationConfig{
+		Interval: cfg.Scheduler.MorningCallExpirationInterval,
+	})
+	morningCallExpirationWorker.Start(workerCtx)
+	deviceTokenCleanupWorker := scheduler.NewDeviceTokenCleanupWorker(deviceTokenRepo, scheduler.DeviceTokenCleanupConfig{
+		Interval: cfg.Scheduler.DeviceTokenCleanupInterval,
+	})
+	deviceTokenCleanupWorker.Start(workerCtx)
+	accountDeletionWorker := scheduler.NewAccountDeletionWorker(userRepo, morningCallRepo, commentRepo, relationshipRepo, deviceTokenRepo, auditLogger, scheduler.AccountDeletionConfig{
+		Interval: cfg.Scheduler.AccountDeletionInterval,
+	})
+	accountDeletionWorker.Start(workerCtx)
+
+	// 定期レポートの配信（無効の場合は届けない）
+	var reportNotifier service.ReportNotifier
+	if cfg.Report.DeliveryEnabled {
+		reportNotifier = mail.NewReportNotifier(emailSender)
+	}
+	deliverReportsUC := morningCallUC.NewDeliverReportsUseCase(userRepo, generateReportUC, reportNotifier, valueobject.ReportPeriod(cfg.Report.Period), valueobject.ReportFormat(cfg.Report.Format))
+	morningCallReportWorker := scheduler.NewMorningCallReportWorker(deliverReportsUC, scheduler.MorningCallReportConfig{
+		Interval: cfg.Scheduler.MorningCallReportInterval,
+	})
+	morningCallReportWorker.Start(workerCtx)
+
+	// 起床確認・承認されないまま期限切れになったコールを送信者へ通知する
+	// 期限切れのコールは再度処理されず通知を取り直せないため、まとめて期限切れになってもイベントを破棄しない購読にする
+	eventBus.SubscribeUnbounded(func(event events.Event) {
+		if !event.IsTransitionTo(valueobject.MorningCallStatusExpired) {
+			return
+		}
+		if _, err := notifyExpirationUC.Execute(workerCtx, morningCallUC.NotifyExpirationInput{
+			MorningCall:    event.MorningCall,
+			PreviousStatus: event.PreviousStatus,
+		}); err != nil {
+			log.Printf("期限切れ通知に失敗しました: %v", err)
+		}
+	})
+
 	// シグナルハンドリングの設定
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
@@ -162,6 +539,21 @@ func main() {
 		log.Printf("サーバーのシャットダウンに失敗しました: %v", err)
 	}
 
+	// バックグラウンドワーカーの停止
+	friendRequestExpiryWorker.Stop()
+	morningCallDeliveryWorker.Stop()
+	morningCallArchiveWorker.Stop()
+	morningCallExpirationWorker.Stop()
+	deviceTokenCleanupWorker.Stop()
+	accountDeletionWorker.Stop()
+	morningCallReportWorker.Stop()
+	eventBus.Close()
+
+	if cachedUserRepo != nil {
+		stats := cachedUserRepo.CacheStats()
+		log.Printf("ユーザーのキャッシュ: ヒット%d件, ミス%d件 (ヒット率: %.1f%%)", stats.Hits, stats.Misses, stats.HitRate()*100)
+	}
+
 	log.Println("サーバーを正常に停止しました")
 }
 
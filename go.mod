@@ -2,4 +2,4 @@ module github.com/ochamu/morning-call-api
 
 go 1.25.0
 
-require golang.org/x/crypto v0.41.0 // indirect
+require golang.org/x/crypto v0.41.0
@@ -0,0 +1,159 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MinShutdownTimeout はグレースフルシャットダウンのタイムアウトとして短すぎると判断する境界
+const MinShutdownTimeout = time.Second
+
+// combinationRule は複数フィールドの組み合わせを検査するルール
+type combinationRule struct {
+	fatal bool                   // trueの場合は起動を中止する（falseは警告のみ）
+	check func(c *Config) string // 不整合がある場合にその内容を返す
+}
+
+// combinationRules は個別には妥当でも組み合わせると運用上問題となる設定の検査ルール（先頭から順に検査する）
+var combinationRules = []combinationRule{
+	{
+		// ブラウザは資格情報付きリクエストへの "*" を拒否するうえ、任意のオリジンに資格情報を許す設定は危険
+		fatal: true,
+		check: func(c *Config) string {
+			if c.CORS.AllowCredentials && c.CORS.allowsAnyOrigin() {
+				return "CORSですべてのオリジンを許可したまま資格情報付きリクエストを許可することはできません"
+			}
+			return ""
+		},
+	},
+	{
+		check: func(c *Config) string {
+			if c.Server.ReadTimeout > 0 && c.Server.WriteTimeout > 0 && c.Server.ReadTimeout > c.Server.WriteTimeout {
+				return fmt.Sprintf("ReadTimeout(%v)がWriteTimeout(%v)より長いため、読み込み後にレスポンスを書き込む時間が不足する可能性があります", c.Server.ReadTimeout, c.Server.WriteTimeout)
+			}
+			return ""
+		},
+	},
+	{
+		check: func(c *Config) string {
+			if c.Server.ShutdownTimeout < MinShutdownTimeout {
+				return fmt.Sprintf("ShutdownTimeout(%v)が短すぎるため、処理中のリクエストが強制終了されます", c.Server.ShutdownTimeout)
+			}
+			if c.Server.WriteTimeout > 0 && c.Server.ShutdownTimeout < c.Server.WriteTimeout {
+				return fmt.Sprintf("ShutdownTimeout(%v)がWriteTimeout(%v)より短いため、シャットダウン時に処理中のレスポンスが中断される可能性があります", c.Server.ShutdownTimeout, c.Server.WriteTimeout)
+			}
+			return ""
+		},
+	},
+	{
+		check: func(c *Config) string {
+			if c.Auth.SessionCleanupInterval > 0 && c.Auth.SessionTimeout < c.Auth.SessionCleanupInterval {
+				return fmt.Sprintf("SessionTimeout(%v)がSessionCleanupInterval(%v)より短いため、期限切れのセッションが長く残ります", c.Auth.SessionTimeout, c.Auth.SessionCleanupInterval)
+			}
+			return ""
+		},
+	},
+	{
+		check: func(c *Config) string {
+			if c.Auth.SessionCacheTTL > 0 && c.Auth.SessionCacheTTL >= c.Auth.SessionTimeout {
+				return fmt.Sprintf("SessionCacheTTL(%v)がSessionTimeout(%v)以上のため、ログアウトや凍結がキャッシュの期間中反映されません", c.Auth.SessionCacheTTL, c.Auth.SessionTimeout)
+			}
+			return ""
+		},
+	},
+	{
+		check: func(c *Config) string {
+			if c.Scheduler.FriendRequestExpiryInterval > c.Scheduler.FriendRequestExpiry {
+				return fmt.Sprintf("友達リクエスト失効チェックの間隔(%v)が失効までの期間(%v)より長いため、失効が大きく遅れます", c.Scheduler.FriendRequestExpiryInterval, c.Scheduler.FriendRequestExpiry)
+			}
+			return ""
+		},
+	},
+	{
+		check: func(c *Config) string {
+			if c.Scheduler.MorningCallArchiveAfter > 0 && c.Scheduler.MorningCallArchiveInterval > c.Scheduler.MorningCallArchiveAfter {
+				return fmt.Sprintf("自動アーカイブの実行間隔(%v)がアーカイブまでの期間(%v)より長いため、アーカイブが大きく遅れます", c.Scheduler.MorningCallArchiveInterval, c.Scheduler.MorningCallArchiveAfter)
+			}
+			return ""
+		},
+	},
+	{
+		check: func(c *Config) string {
+			p := c.Plan
+			var names []string
+			for _, limit := range []struct {
+				name          string
+				free, premium int
+			}{
+				{"アクティブなモーニングコール数", p.FreeMaxActiveCalls, p.PremiumMaxActiveCalls},
+				{"1日あたりのモーニングコール作成数", p.FreeMaxDailyCalls, p.PremiumMaxDailyCalls},
+				{"友達数", p.FreeMaxFriends, p.PremiumMaxFriends},
+			} {
+				if looserLimit(limit.free, limit.premium) {
+					names = append(names, limit.name)
+				}
+			}
+			if len(names) > 0 {
+				return fmt.Sprintf("フリープランの上限がプレミアムプランより緩くなっています: %s", strings.Join(names, ", "))
+			}
+			return ""
+		},
+	},
+}
+
+// validateCombinations は複数フィールド間の関係を検査する
+// 起動を中止すべき不整合は最初の1件をエラーとして返し、それ以外はすべて警告として返す
+func (c *Config) validateCombinations() ([]string, error) {
+	var warnings []string
+	var err error
+	for _, rule := range combinationRules {
+		message := rule.check(c)
+		if message == "" {
+			continue
+		}
+		if rule.fatal {
+			if err == nil {
+				err = fmt.Errorf("設定の組み合わせが不正です: %s", message)
+			}
+			continue
+		}
+		warnings = append(warnings, message)
+	}
+	return warnings, err
+}
+
+// AllowedOrigin はリクエストのOriginに対して返すAccess-Control-Allow-Originの値を返す
+// すべてのオリジンを許可する場合は"*"、許可されていないオリジンの場合は空文字を返す
+func (c CORSConfig) AllowedOrigin(origin string) string {
+	if c.allowsAnyOrigin() {
+		return "*"
+	}
+	for _, allowed := range c.AllowedOrigins {
+		if origin != "" && strings.EqualFold(allowed, origin) {
+			return origin
+		}
+	}
+	return ""
+}
+
+// allowsAnyOrigin はすべてのオリジンを許可する設定かを判定する
+func (c CORSConfig) allowsAnyOrigin() bool {
+	if len(c.AllowedOrigins) == 0 {
+		return true
+	}
+	for _, origin := range c.AllowedOrigins {
+		if origin == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// looserLimit はフリープランの上限がプレミアムプランより緩いかを判定する（0以下は無制限）
+func looserLimit(free, premium int) bool {
+	if premium <= 0 {
+		return false
+	}
+	return free <= 0 || free > premium
+}
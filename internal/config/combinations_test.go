@@ -0,0 +1,215 @@
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestConfig は環境変数に依存しないデフォルト値の設定を返す
+func newTestConfig(t *testing.T) *Config {
+	t.Helper()
+	for _, key := range []string{
+		"CORS_ALLOWED_ORIGINS", "CORS_ALLOW_CREDENTIALS",
+		"SERVER_READ_TIMEOUT", "SERVER_WRITE_TIMEOUT", "SERVER_SHUTDOWN_TIMEOUT",
+		"AUTH_SESSION_TIMEOUT", "AUTH_SESSION_CLEANUP_INTERVAL", "AUTH_SESSION_CACHE_TTL",
+		"SCHEDULER_FRIEND_REQUEST_EXPIRY", "SCHEDULER_FRIEND_REQUEST_EXPIRY_INTERVAL",
+		"SCHEDULER_MORNING_CALL_ARCHIVE_AFTER", "SCHEDULER_MORNING_CALL_ARCHIVE_INTERVAL",
+		"PLAN_FREE_MAX_ACTIVE_CALLS", "PLAN_FREE_MAX_DAILY_CALLS", "PLAN_FREE_MAX_FRIENDS",
+		"PLAN_PREMIUM_MAX_ACTIVE_CALLS", "PLAN_PREMIUM_MAX_DAILY_CALLS", "PLAN_PREMIUM_MAX_FRIENDS",
+	} {
+		t.Setenv(key, "")
+	}
+	return Load()
+}
+
+func TestConfig_validateCombinations(t *testing.T) {
+	tests := []struct {
+		name        string
+		modify      func(c *Config)
+		wantWarning string // 空の場合は警告なし
+		wantErr     string // 空の場合はエラーなし
+	}{
+		{
+			name:   "デフォルト値は不整合なし",
+			modify: func(c *Config) {},
+		},
+		{
+			name: "CORSワイルドカードと資格情報の併用はエラー",
+			modify: func(c *Config) {
+				c.CORS.AllowCredentials = true
+			},
+			wantErr: "すべてのオリジンを許可したまま資格情報付きリクエストを許可することはできません",
+		},
+		{
+			name: "オリジン未指定と資格情報の併用もエラー",
+			modify: func(c *Config) {
+				c.CORS.AllowedOrigins = nil
+				c.CORS.AllowCredentials = true
+			},
+			wantErr: "資格情報付きリクエスト",
+		},
+		{
+			name: "オリジンを限定すれば資格情報を許可できる",
+			modify: func(c *Config) {
+				c.CORS.AllowedOrigins = []string{"https://app.example.com"}
+				c.CORS.AllowCredentials = true
+			},
+		},
+		{
+			name: "ReadTimeoutがWriteTimeoutより長い",
+			modify: func(c *Config) {
+				c.Server.ReadTimeout = 30 * time.Second
+			},
+			wantWarning: "ReadTimeout(30s)がWriteTimeout(15s)より長い",
+		},
+		{
+			name: "ShutdownTimeoutが極端に短い",
+			modify: func(c *Config) {
+				c.Server.ShutdownTimeout = 100 * time.Millisecond
+			},
+			wantWarning: "ShutdownTimeout(100ms)が短すぎる",
+		},
+		{
+			name: "ShutdownTimeoutがWriteTimeoutより短い",
+			modify: func(c *Config) {
+				c.Server.ShutdownTimeout = 5 * time.Second
+			},
+			wantWarning: "ShutdownTimeout(5s)がWriteTimeout(15s)より短い",
+		},
+		{
+			name: "セッションTTLがクリーンアップ間隔より短い",
+			modify: func(c *Config) {
+				c.Auth.SessionTimeout = time.Minute
+			},
+			wantWarning: "SessionTimeout(1m0s)がSessionCleanupInterval(5m0s)より短い",
+		},
+		{
+			name: "セッションキャッシュがセッションTTL以上",
+			modify: func(c *Config) {
+				c.Auth.SessionTimeout = 10 * time.Minute
+				c.Auth.SessionCacheTTL = 10 * time.Minute
+			},
+			wantWarning: "SessionCacheTTL(10m0s)がSessionTimeout(10m0s)以上",
+		},
+		{
+			name: "友達リクエスト失効チェックの間隔が失効期間より長い",
+			modify: func(c *Config) {
+				c.Scheduler.FriendRequestExpiry = 30 * time.Minute
+			},
+			wantWarning: "友達リクエスト失効チェックの間隔(1h0m0s)が失効までの期間(30m0s)より長い",
+		},
+		{
+			name: "自動アーカイブの実行間隔がアーカイブまでの期間より長い",
+			modify: func(c *Config) {
+				c.Scheduler.MorningCallArchiveAfter = 30 * time.Minute
+			},
+			wantWarning: "自動アーカイブの実行間隔(1h0m0s)がアーカイブまでの期間(30m0s)より長い",
+		},
+		{
+			name: "自動アーカイブが無効なら実行間隔は問わない",
+			modify: func(c *Config) {
+				c.Scheduler.MorningCallArchiveAfter = 0
+			},
+		},
+		{
+			name: "フリープランの上限がプレミアムより緩い",
+			modify: func(c *Config) {
+				c.Plan.FreeMaxFriends = 1000
+				c.Plan.FreeMaxDailyCalls = 0 // 無制限
+			},
+			wantWarning: "フリープランの上限がプレミアムプランより緩くなっています: 1日あたりのモーニングコール作成数, 友達数",
+		},
+		{
+			name: "プレミアムが無制限ならフリーの上限は問わない",
+			modify: func(c *Config) {
+				c.Plan.PremiumMaxFriends = 0
+				c.Plan.FreeMaxFriends = 0
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestConfig(t)
+			tt.modify(c)
+
+			warnings, err := c.validateCombinations()
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if tt.wantWarning == "" {
+				if len(warnings) != 0 {
+					t.Errorf("unexpected warnings: %v", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 || !strings.Contains(warnings[0], tt.wantWarning) {
+				t.Errorf("warnings = %v, want one containing %q", warnings, tt.wantWarning)
+			}
+		})
+	}
+
+	t.Run("エラーがあっても警告はすべて返す", func(t *testing.T) {
+		c := newTestConfig(t)
+		c.CORS.AllowCredentials = true
+		c.Server.ReadTimeout = 30 * time.Second
+		c.Auth.SessionTimeout = time.Minute
+
+		warnings, err := c.validateCombinations()
+		if err == nil {
+			t.Error("expected error, got nil")
+		}
+		if len(warnings) != 2 {
+			t.Errorf("got %d warnings, want 2: %v", len(warnings), warnings)
+		}
+	})
+}
+
+func TestConfig_Validate_Combinations(t *testing.T) {
+	c := newTestConfig(t)
+	if err := c.Validate(); err != nil {
+		t.Fatalf("Validate() unexpected error = %v", err)
+	}
+
+	// 警告のみの不整合では起動を中止しない
+	c.Server.ReadTimeout = time.Minute
+	if err := c.Validate(); err != nil {
+		t.Errorf("Validate() with warning-only combination error = %v, want nil", err)
+	}
+
+	c.CORS.AllowCredentials = true
+	if err := c.Validate(); err == nil || !strings.Contains(err.Error(), "設定の組み合わせが不正です") {
+		t.Errorf("Validate() error = %v, want combination error", err)
+	}
+}
+
+func TestCORSConfig_AllowedOrigin(t *testing.T) {
+	tests := []struct {
+		name    string
+		origins []string
+		origin  string
+		want    string
+	}{
+		{name: "ワイルドカード", origins: []string{"*"}, origin: "https://evil.example.com", want: "*"},
+		{name: "未指定はすべて許可", origins: nil, origin: "https://app.example.com", want: "*"},
+		{name: "許可されたオリジン", origins: []string{"https://app.example.com"}, origin: "https://app.example.com", want: "https://app.example.com"},
+		{name: "大文字小文字を区別しない", origins: []string{"https://App.example.com"}, origin: "https://app.example.com", want: "https://app.example.com"},
+		{name: "許可されていないオリジン", origins: []string{"https://app.example.com"}, origin: "https://evil.example.com", want: ""},
+		{name: "Originヘッダーなし", origins: []string{"https://app.example.com"}, origin: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := CORSConfig{AllowedOrigins: tt.origins}
+			if got := c.AllowedOrigin(tt.origin); got != tt.want {
+				t.Errorf("AllowedOrigin(%q) = %q, want %q", tt.origin, got, tt.want)
+			}
+		})
+	}
+}
@@ -3,16 +3,33 @@ package config
 import (
 	"fmt"
 	"log"
+	"net/netip"
 	"os"
 	"strconv"
+	"strings"
 	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
 )
 
 // Config はアプリケーション全体の設定を保持します
 type Config struct {
-	Server ServerConfig
-	Auth   AuthConfig
-	Log    LogConfig
+	Server         ServerConfig
+	CORS           CORSConfig
+	Auth           AuthConfig
+	Log            LogConfig
+	Scheduler      SchedulerConfig
+	MorningCall    MorningCallConfig
+	Plan           PlanConfig
+	Anomaly        AnomalyConfig
+	InputLimits    InputLimitsConfig
+	Metrics        MetricsConfig
+	Cache          CacheConfig
+	CircuitBreaker CircuitBreakerConfig
+	FriendRequest  FriendRequestConfig
+	Weather        WeatherConfig
+	Report         ReportConfig
+	AdminAccess    AdminAccessConfig
 }
 
 // ServerConfig はHTTPサーバーの設定を保持します
@@ -23,19 +40,142 @@ type ServerConfig struct {
 	IdleTimeout     time.Duration // アイドル接続のタイムアウト
 	ShutdownTimeout time.Duration // グレースフルシャットダウンのタイムアウト
 	MaxHeaderBytes  int           // 最大ヘッダーサイズ
+
+	DuplicateRequestWindow time.Duration // 同一ユーザーの同一リクエストを二重送信として抑制する期間（0以下で無効）
+}
+
+// CORSConfig はCORS（クロスオリジンリクエスト）の設定を保持します
+type CORSConfig struct {
+	AllowedOrigins   []string // 許可するオリジン（"*"はすべて許可。未指定の場合もすべて許可する）
+	AllowCredentials bool     // Cookie等の資格情報付きリクエストを許可するか
 }
 
 // AuthConfig は認証の設定を保持します
 type AuthConfig struct {
-	SessionTimeout   time.Duration // セッションタイムアウト
-	MaxLoginAttempts int           // 最大ログイン試行回数
-	LockoutDuration  time.Duration // アカウントロックアウト期間
+	SessionTimeout         time.Duration // セッションタイムアウト
+	SessionCleanupInterval time.Duration // 期限切れセッションのクリーンアップ間隔
+	SessionCacheTTL        time.Duration // セッション検証結果のキャッシュ期間（0以下で無効）
+	MaxLoginAttempts       int           // 最大ログイン試行回数
+	LockoutDuration        time.Duration // アカウントロックアウト期間
+	PasswordHistorySize    int           // 再利用を禁止する過去のパスワードの件数（現在のパスワードは常に禁止）
+
+	AvailabilityCheckLimit  int           // ユーザー名・メールアドレスの利用可能チェックの期間内の上限回数（0以下で無制限）
+	AvailabilityCheckWindow time.Duration // 利用可能チェックの上限回数を数える期間
+
+	UsernameChangeCooldown time.Duration // ユーザー名を変更してから再び変更できるようになるまでの期間
+
+	AccountDeletionGracePeriod time.Duration // アカウントの削除を申請してから完全に削除されるまでの猶予期間（この間のログインで取り消される）
+}
+
+// SchedulerConfig はバックグラウンドワーカーの設定を保持します
+type SchedulerConfig struct {
+	FriendRequestExpiry           time.Duration // 友達リクエストが自動失効するまでの期間
+	FriendRequestExpiryInterval   time.Duration // 友達リクエスト失効チェックの実行間隔
+	FriendRequestExpiryAction     string        // 失効時の処理 (reject, delete)
+	MorningCallDeliveryInterval   time.Duration // モーニングコールの配信チェックの実行間隔
+	MorningCallDeliveryMaxPerRun  int           // 1回の配信チェックで処理するコールの上限件数
+	MorningCallArchiveAfter       time.Duration // 確認済み・期限切れのコールをアラーム時刻から自動アーカイブするまでの期間（0で無効）
+	MorningCallArchiveInterval    time.Duration // 自動アーカイブの実行間隔
+	MorningCallExpirationInterval time.Duration // 起床確認の期限を過ぎたコールの期限切れチェックの実行間隔
+	DeviceTokenCleanupInterval    time.Duration // 無効化されたデバイストークンの削除の実行間隔
+	AccountDeletionInterval       time.Duration // 猶予期間を過ぎた削除予定アカウントの完全削除の実行間隔
+	MorningCallReportInterval     time.Duration // 定期レポートの配信チェックの実行間隔
+}
+
+// MorningCallConfig はモーニングコールの設定を保持します
+type MorningCallConfig struct {
+	BannedWords   []string // メッセージに使用できない語句
+	ConfirmPoints int      // 起床確認されたときに送信者へ付与する感謝ポイント（0以下は付与しない）
+	AccessLogSize int      // コールごとに保持するアクセスログ（閲覧記録）の上限件数
+
+	CancelGracePeriod time.Duration // 配信後も送信者がキャンセルできる猶予期間（0で無効）
+
+	TranslationCacheSize int // メッセージの翻訳結果をキャッシュする件数の上限
+}
+
+// PlanConfig はプラン別の利用上限を保持します（0以下は無制限）
+type PlanConfig struct {
+	FreeMaxActiveCalls    int // フリープランのアクティブなモーニングコール数上限
+	FreeMaxDailyCalls     int // フリープランの1日あたりのモーニングコール作成数上限
+	FreeMaxFriends        int // フリープランの友達数上限
+	PremiumMaxActiveCalls int // プレミアムプランのアクティブなモーニングコール数上限
+	PremiumMaxDailyCalls  int // プレミアムプランの1日あたりのモーニングコール作成数上限
+	PremiumMaxFriends     int // プレミアムプランの友達数上限
+}
+
+// AnomalyConfig は管理者向けの異常ユーザー検知の設定を保持します
+type AnomalyConfig struct {
+	Window                 time.Duration // 集計対象とする直近の期間
+	FriendRequestThreshold int           // 期間内の友達リクエスト送信数の閾値
+	MorningCallThreshold   int           // 期間内のモーニングコール作成数の閾値
+	ExcludedUserIDs        []string      // 検知対象から除外するユーザーID
+}
+
+// InputLimitsConfig は入力フィールドごとの文字数制限を保持します
+type InputLimitsConfig struct {
+	UsernameMinLength int // ユーザー名の最小文字数
+	UsernameMaxLength int // ユーザー名の最大文字数
+	EmailMaxLength    int // メールアドレスの最大文字数
+	MessageMaxLength  int // モーニングコールのメッセージの最大文字数
+}
+
+// MetricsConfig は計測の設定を保持します
+type MetricsConfig struct {
+	RepositoryEnabled bool // リポジトリの操作ごとの呼び出し回数・レイテンシ・エラー率を計測するか
+}
+
+// CacheConfig はリポジトリの読み取り結果のキャッシュの設定を保持します
+type CacheConfig struct {
+	UserEnabled  bool          // IDによるユーザーの検索結果をキャッシュするか
+	UserTTL      time.Duration // ユーザーのキャッシュの有効期限
+	UserCapacity int           // キャッシュするユーザーの件数の上限
+}
+
+// CircuitBreakerConfig は外部連携（メール送信・翻訳・天気予報）を保護するサーキットブレーカーの設定を保持します
+type CircuitBreakerConfig struct {
+	Enabled              bool          // サーキットブレーカーを有効にするか
+	FailureRateThreshold float64       // オープン状態にするエラー率（0より大きく1以下）
+	MinRequests          int           // エラー率を判定するのに必要な集計期間内の最小呼び出し回数
+	Window               time.Duration // エラー率を集計する期間
+	OpenDuration         time.Duration // オープン状態で呼び出しをスキップする期間
+	HalfOpenMaxRequests  int           // ハーフオープン状態で試行する呼び出し回数
+}
+
+// FriendRequestConfig は友達リクエストの設定を保持します
+type FriendRequestConfig struct {
+	ResendCooldown time.Duration // 拒否されてから同じ相手へ再送信できるようになるまでの期間（0で待機なし）
+	MaxResends     int           // 同じ相手へ続けて再送信できる回数（0で無制限）
+	ResendLockout  time.Duration // 再送信の回数が上限に達した後、最後の送信から再び送信できるようになるまでの期間
+}
+
+// WeatherConfig は天気に応じたアラーム時刻の調整の設定を保持します
+type WeatherConfig struct {
+	AdjustmentEnabled bool          // 天気に応じてモーニングコールのアラーム時刻を調整するか
+	RainOffset        time.Duration // 雨の日にアラーム時刻をずらす幅（負の値で早める）
+	SnowOffset        time.Duration // 雪の日にアラーム時刻をずらす幅（負の値で早める）
+	StubCondition     string        // 開発用の天気予報が返す天気 (clear, cloudy, rain, snow)（空の場合は予報を取得できない）
+}
+
+// ReportConfig はモーニングコールの定期レポートの設定を保持します
+type ReportConfig struct {
+	DeliveryEnabled bool   // 直前の期間のレポートを定期的にメールで届けるか
+	Period          string // 定期レポートの集計単位 (weekly, monthly)
+	Format          string // 定期レポートに添付するファイルの形式 (json, csv)
+}
+
+// AdminAccessConfig は管理者エンドポイントへのアクセス元IPアドレスの制限を保持します（CIDR表記）
+type AdminAccessConfig struct {
+	AllowedCIDRs      []string // アクセスを許可する範囲（空の場合は拒否リスト以外すべて許可）
+	DeniedCIDRs       []string // アクセスを拒否する範囲（許可リストより優先）
+	TrustedProxyCIDRs []string // X-Forwarded-Forを信頼するプロキシの範囲（空の場合はヘッダーを使用しない）
 }
 
 // LogConfig はログの設定を保持します
 type LogConfig struct {
-	Level  string // ログレベル (debug, info, warn, error)
-	Format string // ログフォーマット (json, text)
+	Level                string        // ログレベル (debug, info, warn, error)
+	Format               string        // ログフォーマット (json, text)
+	SensitiveKeys        []string      // ログ出力時にマスクするキー（未指定時はデフォルト）
+	SlowRequestThreshold time.Duration // この時間を超えたリクエストをスローログに出力する（0以下で無効）
 }
 
 // Load は環境変数から設定を読み込みます
@@ -48,15 +188,112 @@ func Load() *Config {
 			IdleTimeout:     getDurationEnv("SERVER_IDLE_TIMEOUT", 60*time.Second),
 			ShutdownTimeout: getDurationEnv("SERVER_SHUTDOWN_TIMEOUT", 30*time.Second),
 			MaxHeaderBytes:  getIntEnv("SERVER_MAX_HEADER_BYTES", 1<<20), // 1MB
+
+			DuplicateRequestWindow: getDurationEnv("SERVER_DUPLICATE_REQUEST_WINDOW", 2*time.Second),
+		},
+		CORS: CORSConfig{
+			AllowedOrigins:   getStringSliceEnv("CORS_ALLOWED_ORIGINS", []string{"*"}),
+			AllowCredentials: getBoolEnv("CORS_ALLOW_CREDENTIALS", false),
 		},
 		Auth: AuthConfig{
-			SessionTimeout:   getDurationEnv("AUTH_SESSION_TIMEOUT", 24*time.Hour),
-			MaxLoginAttempts: getIntEnv("AUTH_MAX_LOGIN_ATTEMPTS", 5),
-			LockoutDuration:  getDurationEnv("AUTH_LOCKOUT_DURATION", 30*time.Minute),
+			SessionTimeout:         getDurationEnv("AUTH_SESSION_TIMEOUT", 24*time.Hour),
+			SessionCleanupInterval: getDurationEnv("AUTH_SESSION_CLEANUP_INTERVAL", 5*time.Minute),
+			SessionCacheTTL:        getDurationEnv("AUTH_SESSION_CACHE_TTL", 5*time.Second),
+			MaxLoginAttempts:       getIntEnv("AUTH_MAX_LOGIN_ATTEMPTS", 5),
+			LockoutDuration:        getDurationEnv("AUTH_LOCKOUT_DURATION", 30*time.Minute),
+			PasswordHistorySize:    getIntEnv("AUTH_PASSWORD_HISTORY_SIZE", 5),
+
+			AvailabilityCheckLimit:  getIntEnv("AUTH_AVAILABILITY_CHECK_LIMIT", 20),
+			AvailabilityCheckWindow: getDurationEnv("AUTH_AVAILABILITY_CHECK_WINDOW", time.Minute),
+
+			UsernameChangeCooldown: getDurationEnv("AUTH_USERNAME_CHANGE_COOLDOWN", 30*24*time.Hour),
+
+			AccountDeletionGracePeriod: getDurationEnv("AUTH_ACCOUNT_DELETION_GRACE_PERIOD", 30*24*time.Hour),
 		},
 		Log: LogConfig{
-			Level:  getEnv("LOG_LEVEL", "info"),
-			Format: getEnv("LOG_FORMAT", "json"),
+			Level:                getEnv("LOG_LEVEL", "info"),
+			Format:               getEnv("LOG_FORMAT", "json"),
+			SensitiveKeys:        getStringSliceEnv("LOG_SENSITIVE_KEYS", nil),
+			SlowRequestThreshold: getDurationEnv("LOG_SLOW_REQUEST_THRESHOLD", 500*time.Millisecond),
+		},
+		Scheduler: SchedulerConfig{
+			FriendRequestExpiry:           getDurationEnv("SCHEDULER_FRIEND_REQUEST_EXPIRY", 30*24*time.Hour),
+			FriendRequestExpiryInterval:   getDurationEnv("SCHEDULER_FRIEND_REQUEST_EXPIRY_INTERVAL", time.Hour),
+			FriendRequestExpiryAction:     getEnv("SCHEDULER_FRIEND_REQUEST_EXPIRY_ACTION", "reject"),
+			MorningCallDeliveryInterval:   getDurationEnv("SCHEDULER_MORNING_CALL_DELIVERY_INTERVAL", time.Minute),
+			MorningCallDeliveryMaxPerRun:  getIntEnv("SCHEDULER_MORNING_CALL_DELIVERY_MAX_PER_RUN", 1000),
+			MorningCallArchiveAfter:       getDurationEnv("SCHEDULER_MORNING_CALL_ARCHIVE_AFTER", 30*24*time.Hour),
+			MorningCallArchiveInterval:    getDurationEnv("SCHEDULER_MORNING_CALL_ARCHIVE_INTERVAL", time.Hour),
+			MorningCallExpirationInterval: getDurationEnv("SCHEDULER_MORNING_CALL_EXPIRATION_INTERVAL", time.Minute),
+			DeviceTokenCleanupInterval:    getDurationEnv("SCHEDULER_DEVICE_TOKEN_CLEANUP_INTERVAL", time.Hour),
+			AccountDeletionInterval:       getDurationEnv("SCHEDULER_ACCOUNT_DELETION_INTERVAL", time.Hour),
+			MorningCallReportInterval:     getDurationEnv("SCHEDULER_MORNING_CALL_REPORT_INTERVAL", time.Hour),
+		},
+		MorningCall: MorningCallConfig{
+			BannedWords:   getStringSliceEnv("MORNING_CALL_BANNED_WORDS", nil),
+			ConfirmPoints: getIntEnv("MORNING_CALL_CONFIRM_POINTS", 10),
+			AccessLogSize: getIntEnv("MORNING_CALL_ACCESS_LOG_SIZE", 100),
+
+			CancelGracePeriod: getDurationEnv("MORNING_CALL_CANCEL_GRACE_PERIOD", 60*time.Second),
+
+			TranslationCacheSize: getIntEnv("MORNING_CALL_TRANSLATION_CACHE_SIZE", 1000),
+		},
+		Plan: PlanConfig{
+			FreeMaxActiveCalls:    getIntEnv("PLAN_FREE_MAX_ACTIVE_CALLS", 5),
+			FreeMaxDailyCalls:     getIntEnv("PLAN_FREE_MAX_DAILY_CALLS", 10),
+			FreeMaxFriends:        getIntEnv("PLAN_FREE_MAX_FRIENDS", 50),
+			PremiumMaxActiveCalls: getIntEnv("PLAN_PREMIUM_MAX_ACTIVE_CALLS", 50),
+			PremiumMaxDailyCalls:  getIntEnv("PLAN_PREMIUM_MAX_DAILY_CALLS", 100),
+			PremiumMaxFriends:     getIntEnv("PLAN_PREMIUM_MAX_FRIENDS", 500),
+		},
+		Anomaly: AnomalyConfig{
+			Window:                 getDurationEnv("ANOMALY_WINDOW", time.Hour),
+			FriendRequestThreshold: getIntEnv("ANOMALY_FRIEND_REQUEST_THRESHOLD", 20),
+			MorningCallThreshold:   getIntEnv("ANOMALY_MORNING_CALL_THRESHOLD", 30),
+			ExcludedUserIDs:        getStringSliceEnv("ANOMALY_EXCLUDED_USER_IDS", nil),
+		},
+		InputLimits: InputLimitsConfig{
+			UsernameMinLength: getIntEnv("INPUT_USERNAME_MIN_LENGTH", 3),
+			UsernameMaxLength: getIntEnv("INPUT_USERNAME_MAX_LENGTH", 30),
+			EmailMaxLength:    getIntEnv("INPUT_EMAIL_MAX_LENGTH", 255),
+			MessageMaxLength:  getIntEnv("INPUT_MESSAGE_MAX_LENGTH", 500),
+		},
+		Metrics: MetricsConfig{
+			RepositoryEnabled: getBoolEnv("METRICS_REPOSITORY_ENABLED", false),
+		},
+		Cache: CacheConfig{
+			UserEnabled:  getBoolEnv("CACHE_USER_ENABLED", false),
+			UserTTL:      getDurationEnv("CACHE_USER_TTL", 5*time.Second),
+			UserCapacity: getIntEnv("CACHE_USER_CAPACITY", 1000),
+		},
+		CircuitBreaker: CircuitBreakerConfig{
+			Enabled:              getBoolEnv("CIRCUIT_BREAKER_ENABLED", true),
+			FailureRateThreshold: getFloatEnv("CIRCUIT_BREAKER_FAILURE_RATE_THRESHOLD", 0.5),
+			MinRequests:          getIntEnv("CIRCUIT_BREAKER_MIN_REQUESTS", 10),
+			Window:               getDurationEnv("CIRCUIT_BREAKER_WINDOW", time.Minute),
+			OpenDuration:         getDurationEnv("CIRCUIT_BREAKER_OPEN_DURATION", 30*time.Second),
+			HalfOpenMaxRequests:  getIntEnv("CIRCUIT_BREAKER_HALF_OPEN_MAX_REQUESTS", 1),
+		},
+		FriendRequest: FriendRequestConfig{
+			ResendCooldown: getDurationEnv("FRIEND_REQUEST_RESEND_COOLDOWN", 24*time.Hour),
+			MaxResends:     getIntEnv("FRIEND_REQUEST_MAX_RESENDS", 3),
+			ResendLockout:  getDurationEnv("FRIEND_REQUEST_RESEND_LOCKOUT", 30*24*time.Hour),
+		},
+		Weather: WeatherConfig{
+			AdjustmentEnabled: getBoolEnv("WEATHER_ADJUSTMENT_ENABLED", false),
+			RainOffset:        getDurationEnv("WEATHER_RAIN_OFFSET", -15*time.Minute),
+			SnowOffset:        getDurationEnv("WEATHER_SNOW_OFFSET", -30*time.Minute),
+			StubCondition:     getEnv("WEATHER_STUB_CONDITION", string(valueobject.WeatherClear)),
+		},
+		Report: ReportConfig{
+			DeliveryEnabled: getBoolEnv("REPORT_DELIVERY_ENABLED", false),
+			Period:          getEnv("REPORT_PERIOD", string(valueobject.ReportPeriodWeekly)),
+			Format:          getEnv("REPORT_FORMAT", string(valueobject.ReportFormatCSV)),
+		},
+		AdminAccess: AdminAccessConfig{
+			AllowedCIDRs:      getStringSliceEnv("ADMIN_ALLOWED_CIDRS", nil),
+			DeniedCIDRs:       getStringSliceEnv("ADMIN_DENIED_CIDRS", nil),
+			TrustedProxyCIDRs: getStringSliceEnv("TRUSTED_PROXY_CIDRS", nil),
 		},
 	}
 }
@@ -69,6 +306,22 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+// getStringSliceEnv は環境変数をカンマ区切りのリストとして取得し、存在しない場合はデフォルト値を返します
+func getStringSliceEnv(key string, defaultValue []string) []string {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+
+	values := []string{}
+	for _, v := range strings.Split(valueStr, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
 // getIntEnv は環境変数を整数として取得し、存在しない場合はデフォルト値を返します
 func getIntEnv(key string, defaultValue int) int {
 	valueStr := os.Getenv(key)
@@ -85,6 +338,38 @@ func getIntEnv(key string, defaultValue int) int {
 	return value
 }
 
+// getFloatEnv は環境変数を小数として取得し、存在しない場合はデフォルト値を返します
+func getFloatEnv(key string, defaultValue float64) float64 {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+
+	value, err := strconv.ParseFloat(valueStr, 64)
+	if err != nil {
+		log.Printf("警告: 環境変数 %s の値が不正です: %v. デフォルト値 %v を使用します", key, err, defaultValue)
+		return defaultValue
+	}
+
+	return value
+}
+
+// getBoolEnv は環境変数を真偽値として取得し、存在しない場合はデフォルト値を返します
+func getBoolEnv(key string, defaultValue bool) bool {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+
+	value, err := strconv.ParseBool(valueStr)
+	if err != nil {
+		log.Printf("警告: 環境変数 %s の値が不正です: %v. デフォルト値 %v を使用します", key, err, defaultValue)
+		return defaultValue
+	}
+
+	return value
+}
+
 // getDurationEnv は環境変数を時間として取得し、存在しない場合はデフォルト値を返します
 func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
 	valueStr := os.Getenv(key)
@@ -117,6 +402,10 @@ func (c *Config) Validate() error {
 		log.Printf("警告: WriteTimeoutが0以下です")
 	}
 
+	if c.Auth.SessionCleanupInterval <= 0 {
+		log.Printf("警告: SessionCleanupIntervalが0以下です")
+	}
+
 	// ログレベルの検証
 	validLogLevels := map[string]bool{
 		"debug": true,
@@ -127,6 +416,119 @@ func (c *Config) Validate() error {
 	if !validLogLevels[c.Log.Level] {
 		log.Printf("警告: 無効なログレベル: %s", c.Log.Level)
 	}
+	if c.Log.SlowRequestThreshold <= 0 {
+		log.Printf("警告: SlowRequestThresholdが0以下のため、スローログは出力されません")
+	}
+
+	if c.Auth.PasswordHistorySize < 0 {
+		return fmt.Errorf("無効なパスワード履歴の件数: %d", c.Auth.PasswordHistorySize)
+	}
+	if c.Auth.AvailabilityCheckLimit > 0 && c.Auth.AvailabilityCheckWindow <= 0 {
+		return fmt.Errorf("無効な利用可能チェックの期間: %v", c.Auth.AvailabilityCheckWindow)
+	}
+	if c.Auth.AvailabilityCheckLimit <= 0 {
+		log.Printf("警告: AvailabilityCheckLimitが0以下のため、利用可能チェックの回数は制限されません")
+	}
+	if c.Auth.AccountDeletionGracePeriod <= 0 {
+		return fmt.Errorf("無効なアカウント削除の猶予期間: %v", c.Auth.AccountDeletionGracePeriod)
+	}
+
+	// 入力文字数制限の検証
+	limits := c.InputLimits
+	if limits.UsernameMinLength < 1 || limits.UsernameMaxLength < limits.UsernameMinLength {
+		return fmt.Errorf("無効なユーザー名の文字数制限: 最小%d, 最大%d", limits.UsernameMinLength, limits.UsernameMaxLength)
+	}
+	if limits.EmailMaxLength < 1 {
+		return fmt.Errorf("無効なメールアドレスの文字数制限: %d", limits.EmailMaxLength)
+	}
+	if limits.MessageMaxLength < 1 {
+		return fmt.Errorf("無効なメッセージの文字数制限: %d", limits.MessageMaxLength)
+	}
+
+	if c.MorningCall.AccessLogSize < 1 {
+		return fmt.Errorf("無効なアクセスログの保持件数: %d", c.MorningCall.AccessLogSize)
+	}
+	if c.MorningCall.CancelGracePeriod < 0 {
+		return fmt.Errorf("無効な送信取り消しの猶予期間: %v", c.MorningCall.CancelGracePeriod)
+	}
+	if c.MorningCall.TranslationCacheSize < 1 {
+		return fmt.Errorf("無効な翻訳キャッシュの件数: %d", c.MorningCall.TranslationCacheSize)
+	}
+
+	// キャッシュの検証
+	if c.Cache.UserEnabled && (c.Cache.UserTTL <= 0 || c.Cache.UserCapacity < 1) {
+		return fmt.Errorf("無効なユーザーのキャッシュの設定: 有効期限%v, 件数%d", c.Cache.UserTTL, c.Cache.UserCapacity)
+	}
+
+	// サーキットブレーカーの検証
+	if c.CircuitBreaker.Enabled {
+		breaker := c.CircuitBreaker
+		if breaker.FailureRateThreshold <= 0 || breaker.FailureRateThreshold > 1 {
+			return fmt.Errorf("無効なサーキットブレーカーのエラー率: %v", breaker.FailureRateThreshold)
+		}
+		if breaker.MinRequests < 1 || breaker.HalfOpenMaxRequests < 1 {
+			return fmt.Errorf("無効なサーキットブレーカーの呼び出し回数: 最小%d, ハーフオープン%d", breaker.MinRequests, breaker.HalfOpenMaxRequests)
+		}
+		if breaker.Window <= 0 || breaker.OpenDuration <= 0 {
+			return fmt.Errorf("無効なサーキットブレーカーの期間: 集計%v, オープン%v", breaker.Window, breaker.OpenDuration)
+		}
+	}
+
+	// 友達リクエストの再送信の制限の検証
+	if c.FriendRequest.ResendCooldown < 0 || c.FriendRequest.MaxResends < 0 {
+		return fmt.Errorf("無効な友達リクエストの再送信の制限: 待機期間%v, 回数%d", c.FriendRequest.ResendCooldown, c.FriendRequest.MaxResends)
+	}
+	if c.FriendRequest.MaxResends > 0 && c.FriendRequest.ResendLockout <= 0 {
+		return fmt.Errorf("無効な友達リクエストの再送信を制限する期間: %v", c.FriendRequest.ResendLockout)
+	}
+
+	// 天気に応じたアラーム時刻の調整の検証
+	for _, offset := range []time.Duration{c.Weather.RainOffset, c.Weather.SnowOffset} {
+		if offset > valueobject.MaxWeatherAdjustment || offset < -valueobject.MaxWeatherAdjustment {
+			return fmt.Errorf("無効な天気によるアラーム時刻のずらし幅: %v（前後%v以内で指定してください）", offset, valueobject.MaxWeatherAdjustment)
+		}
+	}
+	if c.Weather.StubCondition != "" && !valueobject.WeatherCondition(c.Weather.StubCondition).IsValid() {
+		return fmt.Errorf("無効な開発用の天気: %s", c.Weather.StubCondition)
+	}
+
+	// 定期レポートの検証
+	if !valueobject.ReportPeriod(c.Report.Period).IsValid() {
+		return fmt.Errorf("無効な定期レポートの集計単位: %s", c.Report.Period)
+	}
+	if !valueobject.ReportFormat(c.Report.Format).IsValid() {
+		return fmt.Errorf("無効な定期レポートの形式: %s", c.Report.Format)
+	}
+
+	// 管理者エンドポイントのアクセス元制限の検証
+	for _, list := range []struct {
+		name  string
+		cidrs []string
+	}{
+		{"管理者エンドポイントの許可リスト", c.AdminAccess.AllowedCIDRs},
+		{"管理者エンドポイントの拒否リスト", c.AdminAccess.DeniedCIDRs},
+		{"信頼するプロキシ", c.AdminAccess.TrustedProxyCIDRs},
+	} {
+		for _, cidr := range list.cidrs {
+			if _, err := netip.ParsePrefix(cidr); err != nil {
+				return fmt.Errorf("無効な%sのCIDR: %s", list.name, cidr)
+			}
+		}
+	}
+
+	// 友達リクエスト失効時の処理方法の検証
+	if c.Scheduler.FriendRequestExpiryAction != "reject" && c.Scheduler.FriendRequestExpiryAction != "delete" {
+		log.Printf("警告: 無効な友達リクエスト失効処理: %s", c.Scheduler.FriendRequestExpiryAction)
+	}
+
+	// 複数フィールドの組み合わせの検証
+	warnings, err := c.validateCombinations()
+	for _, w := range warnings {
+		log.Printf("警告: %s", w)
+	}
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// MaxCallCommentLength はコメントの最大文字数
+const MaxCallCommentLength = 500
+
+// CallComment はモーニングコールに紐づく送信者・受信者間のコメント
+// 起床確認の前後を問わず、コールの送信者と受信者がやり取りに使う
+type CallComment struct {
+	ID        string
+	CallID    string // コメントしたモーニングコールのID
+	AuthorID  string // コメントしたユーザーのID（コールの送信者または受信者）
+	Body      string // サニタイズ済みの本文
+	CreatedAt time.Time
+}
+
+// NewCallComment は新しいコメントを作成する
+// 本文はSanitizeCommentBodyで整えたうえで、空でないことと文字数を検証する
+func NewCallComment(id, callID, authorID, body string, now time.Time) (*CallComment, valueobject.NGReason) {
+	if id == "" {
+		return nil, valueobject.NGWithCode(valueobject.ReasonCodeRequired, "id", "コメントIDは必須です")
+	}
+	if callID == "" {
+		return nil, valueobject.NGWithCode(valueobject.ReasonCodeRequired, "call_id", "モーニングコールIDは必須です")
+	}
+	if authorID == "" {
+		return nil, valueobject.NGWithCode(valueobject.ReasonCodeRequired, "author_id", "コメントするユーザーのIDは必須です")
+	}
+
+	body = SanitizeCommentBody(body)
+	if body == "" {
+		return nil, valueobject.NGWithCode(valueobject.ReasonCodeRequired, "body", "コメントを入力してください")
+	}
+	if CountMessageLength(body) > MaxCallCommentLength {
+		return nil, valueobject.NGWithCode(valueobject.ReasonCodeTooLong, "body",
+			fmt.Sprintf("コメントは%d文字以内で入力してください", MaxCallCommentLength))
+	}
+
+	return &CallComment{
+		ID:        id,
+		CallID:    callID,
+		AuthorID:  authorID,
+		Body:      body,
+		CreatedAt: now,
+	}, valueobject.OK()
+}
+
+// SanitizeCommentBody はコメントの本文を保存用に整える
+// 改行コードを\nに揃え、改行・タブ以外の制御文字と不可視の書式文字（ゼロ幅文字・双方向制御文字など）を除去し、前後の空白を取り除く
+// HTMLのエスケープは表示時に行うため、ここでは行わない
+func SanitizeCommentBody(body string) string {
+	body = strings.ReplaceAll(body, "\r\n", "\n")
+	body = strings.ReplaceAll(body, "\r", "\n")
+	body = strings.Map(func(r rune) rune {
+		if r == '\n' || r == '\t' {
+			return r
+		}
+		if unicode.IsControl(r) || unicode.Is(unicode.Cf, r) {
+			return -1
+		}
+		return r
+	}, body)
+	return strings.TrimSpace(body)
+}
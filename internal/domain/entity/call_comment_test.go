@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+func TestNewCallComment(t *testing.T) {
+	now := time.Date(2026, 3, 15, 6, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		body     string
+		wantBody string
+		wantCode valueobject.ReasonCode
+	}{
+		{name: "コメントを作成", body: "起きた？", wantBody: "起きた？"},
+		{name: "前後の空白を除去", body: "  おはよう\n", wantBody: "おはよう"},
+		{name: "改行コードを揃える", body: "おはよう\r\n今日も\rがんばろう", wantBody: "おはよう\n今日も\nがんばろう"},
+		{name: "制御文字と不可視の書式文字を除去", body: "お\x00は​よ‮う\x1b[31m", wantBody: "おはよう[31m"},
+		{name: "HTMLはそのまま保存する", body: "<b>おはよう</b>", wantBody: "<b>おはよう</b>"},
+		{name: "上限ちょうどの文字数", body: strings.Repeat("あ", MaxCallCommentLength), wantBody: strings.Repeat("あ", MaxCallCommentLength)},
+		{name: "空", body: "", wantCode: valueobject.ReasonCodeRequired},
+		{name: "空白と制御文字のみ", body: " ​\t\n ", wantCode: valueobject.ReasonCodeRequired},
+		{name: "長すぎる", body: strings.Repeat("あ", MaxCallCommentLength+1), wantCode: valueobject.ReasonCodeTooLong},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comment, reason := NewCallComment("c1", "mc1", "user1", tt.body, now)
+			if tt.wantCode != "" {
+				if reason.Code() != tt.wantCode || reason.Field() != "body" {
+					t.Errorf("reason = %s (%s), want %s on body", reason, reason.Code(), tt.wantCode)
+				}
+				return
+			}
+			if reason.IsNG() {
+				t.Fatalf("予期しないNG: %s", reason)
+			}
+			if comment.Body != tt.wantBody || comment.CallID != "mc1" || comment.AuthorID != "user1" || !comment.CreatedAt.Equal(now) {
+				t.Errorf("comment = %+v, want body %q", comment, tt.wantBody)
+			}
+		})
+	}
+}
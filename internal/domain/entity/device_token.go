@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// MaxDeviceTokenLength はデバイストークンの最大文字数
+// APNs・FCMのトークンに加え、Web Pushの購読情報（エンドポイントURLを含む）を格納できる長さにする
+const MaxDeviceTokenLength = 4096
+
+// MaxDeviceTokensPerUser は1人のユーザーが登録できる端末の最大数
+const MaxDeviceTokensPerUser = 10
+
+// DeviceToken はプッシュ通知の配信先となるユーザーの端末
+// 1人のユーザーは複数の端末（プラットフォームが異なる端末を含む）を登録できる
+type DeviceToken struct {
+	ID        string
+	UserID    string
+	Token     string                     // 配信サービス（APNs・FCM・Web Push）が発行したトークン
+	Platform  valueobject.DevicePlatform // 端末のプラットフォーム
+	CreatedAt time.Time
+
+	InvalidatedAt *time.Time // 配信サービスからトークンが無効と通知された日時（nilは有効）
+}
+
+// NewDeviceToken は新しいデバイストークンを作成する
+func NewDeviceToken(id, userID, token string, platform valueobject.DevicePlatform, now time.Time) (*DeviceToken, valueobject.NGReason) {
+	if id == "" {
+		return nil, valueobject.NGWithCode(valueobject.ReasonCodeRequired, "id", "デバイストークンIDは必須です")
+	}
+	if userID == "" {
+		return nil, valueobject.NGWithCode(valueobject.ReasonCodeRequired, "user_id", "ユーザーIDは必須です")
+	}
+	if token == "" {
+		return nil, valueobject.NGWithCode(valueobject.ReasonCodeRequired, "token", "デバイストークンは必須です")
+	}
+	if len(token) > MaxDeviceTokenLength {
+		return nil, valueobject.NGWithCode(valueobject.ReasonCodeTooLong, "token",
+			fmt.Sprintf("デバイストークンは%d文字以内で指定してください", MaxDeviceTokenLength))
+	}
+	if !platform.IsValid() {
+		return nil, valueobject.NGWithCode(valueobject.ReasonCodeInvalid, "platform", "プラットフォームはios、android、webのいずれかを指定してください")
+	}
+
+	return &DeviceToken{
+		ID:        id,
+		UserID:    userID,
+		Token:     token,
+		Platform:  platform,
+		CreatedAt: now,
+	}, valueobject.OK()
+}
+
+// IsInvalidated はトークンが無効化されているかを判定する
+func (d *DeviceToken) IsInvalidated() bool {
+	return d.InvalidatedAt != nil
+}
+
+// Invalidate はトークンを無効化する（配信サービスから無効と通知された場合に呼び出す）
+// 無効化済みの場合は最初に無効化した日時を保持する
+func (d *DeviceToken) Invalidate(now time.Time) {
+	if d.InvalidatedAt == nil {
+		d.InvalidatedAt = &now
+	}
+}
@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+func TestNewDeviceToken(t *testing.T) {
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		token    string
+		platform valueobject.DevicePlatform
+		wantCode valueobject.ReasonCode
+	}{
+		{name: "iOSの端末を登録", token: "apns-token", platform: valueobject.DevicePlatformIOS},
+		{name: "Webの端末を登録", token: "https://push.example.com/abc", platform: valueobject.DevicePlatformWeb},
+		{name: "トークンが空", token: "", platform: valueobject.DevicePlatformAndroid, wantCode: valueobject.ReasonCodeRequired},
+		{name: "トークンが長すぎる", token: strings.Repeat("a", MaxDeviceTokenLength+1), platform: valueobject.DevicePlatformAndroid, wantCode: valueobject.ReasonCodeTooLong},
+		{name: "不明なプラットフォーム", token: "token", platform: "windows", wantCode: valueobject.ReasonCodeInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			device, reason := NewDeviceToken("d1", "user1", tt.token, tt.platform, now)
+			if tt.wantCode != "" {
+				if reason.Code() != tt.wantCode {
+					t.Errorf("Code() = %s, want %s", reason.Code(), tt.wantCode)
+				}
+				return
+			}
+			if reason.IsNG() {
+				t.Fatalf("予期しないNG: %s", reason)
+			}
+			if device.Token != tt.token || device.Platform != tt.platform || !device.CreatedAt.Equal(now) || device.IsInvalidated() {
+				t.Errorf("device = %+v", device)
+			}
+		})
+	}
+}
+
+func TestDeviceToken_Invalidate(t *testing.T) {
+	first := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	device := &DeviceToken{ID: "d1", UserID: "user1", Token: "token", Platform: valueobject.DevicePlatformIOS}
+
+	device.Invalidate(first)
+	device.Invalidate(first.Add(time.Hour))
+	if !device.IsInvalidated() || !device.InvalidatedAt.Equal(first) {
+		t.Errorf("InvalidatedAt = %v, want %v", device.InvalidatedAt, first)
+	}
+}
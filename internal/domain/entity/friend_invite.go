@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// FriendInvite は対面での友達追加に使う招待トークン
+// トークンを読み取ったユーザーは発行者へ友達リクエストを送信できる
+type FriendInvite struct {
+	Token     string
+	InviterID string    // トークンを発行したユーザー（友達リクエストの受信者になる）
+	MaxUses   int       // 使用できる回数の上限
+	UseCount  int       // 使用された回数
+	ExpiresAt time.Time // 有効期限（この時刻以降は使用できない）
+	CreatedAt time.Time
+
+	Version int // 楽観ロック用のバージョン（リポジトリが更新のたびに加算する）
+}
+
+// IsExpired は招待トークンが有効期限切れかを判定する
+func (i *FriendInvite) IsExpired(now time.Time) bool {
+	return !now.Before(i.ExpiresAt)
+}
+
+// RemainingUses は残りの使用可能回数を返す
+func (i *FriendInvite) RemainingUses() int {
+	return max(i.MaxUses-i.UseCount, 0)
+}
+
+// Use は招待トークンを1回使用する
+func (i *FriendInvite) Use(now time.Time) valueobject.NGReason {
+	if i.IsExpired(now) {
+		return valueobject.NGWithCode(valueobject.ReasonCodeExpired, "token", "招待トークンの有効期限が切れています")
+	}
+	if i.RemainingUses() == 0 {
+		return valueobject.NGWithCode(valueobject.ReasonCodeLimitExceeded, "token", "招待トークンは使用回数の上限に達しています")
+	}
+	i.UseCount++
+	return valueobject.OK()
+}
+
+// ReleaseUse は使用を取り消し、使用回数を1回分戻す
+// 友達リクエストの送信に失敗した場合に呼び出す
+func (i *FriendInvite) ReleaseUse() {
+	if i.UseCount > 0 {
+		i.UseCount--
+	}
+}
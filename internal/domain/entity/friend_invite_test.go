@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+func TestFriendInvite_Use(t *testing.T) {
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		invite    FriendInvite
+		at        time.Time
+		wantCode  valueobject.ReasonCode // 空の場合は成功
+		wantCount int
+	}{
+		{
+			name:      "有効期限内で残り回数がある",
+			invite:    FriendInvite{MaxUses: 2, UseCount: 1, ExpiresAt: now.Add(time.Hour)},
+			at:        now,
+			wantCount: 2,
+		},
+		{
+			name:      "有効期限ちょうどは使用できない",
+			invite:    FriendInvite{MaxUses: 1, ExpiresAt: now},
+			at:        now,
+			wantCode:  valueobject.ReasonCodeExpired,
+			wantCount: 0,
+		},
+		{
+			name:      "使用回数の上限に達している",
+			invite:    FriendInvite{MaxUses: 1, UseCount: 1, ExpiresAt: now.Add(time.Hour)},
+			at:        now,
+			wantCode:  valueobject.ReasonCodeLimitExceeded,
+			wantCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invite := tt.invite
+			reason := invite.Use(tt.at)
+			if tt.wantCode == "" {
+				if reason.IsNG() {
+					t.Errorf("Use() unexpected NG: %v", reason)
+				}
+			} else if !reason.IsNG() || reason.Code() != tt.wantCode {
+				t.Errorf("Use() = %v (%s), want code %s", reason, reason.Code(), tt.wantCode)
+			}
+			if invite.UseCount != tt.wantCount {
+				t.Errorf("UseCount = %d, want %d", invite.UseCount, tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestFriendInvite_ReleaseUse(t *testing.T) {
+	invite := FriendInvite{MaxUses: 1, UseCount: 1}
+	invite.ReleaseUse()
+	if invite.UseCount != 0 || invite.RemainingUses() != 1 {
+		t.Errorf("UseCount = %d, RemainingUses = %d, want 0, 1", invite.UseCount, invite.RemainingUses())
+	}
+
+	// 0回より少なくはならない
+	invite.ReleaseUse()
+	if invite.UseCount != 0 {
+		t.Errorf("UseCount = %d, want 0", invite.UseCount)
+	}
+}
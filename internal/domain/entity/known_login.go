@@ -0,0 +1,75 @@
+package entity
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxKnownLogins はユーザーごとに記録するログイン元（デバイスとIPアドレスの組み合わせ）の最大数
+// 上限を超えた場合は最後にログインした日時が最も古いものから破棄する
+const MaxKnownLogins = 20
+
+// MaxLoginDeviceLength はログイン元のデバイス（User-Agent）として記録する最大文字数
+const MaxLoginDeviceLength = 256
+
+// KnownLogin はユーザーが過去にログインしたデバイスとIPアドレスの組み合わせ
+type KnownLogin struct {
+	Device      string    // ログインに使ったデバイス（User-Agent）
+	IPAddress   string    // ログイン元のIPアドレス
+	FirstSeenAt time.Time // この組み合わせで初めてログインした日時
+	LastSeenAt  time.Time // この組み合わせで最後にログインした日時
+}
+
+// NormalizeLoginDevice はログイン元のデバイスを記録用に整える（前後の空白を除き、上限の文字数で切り詰める）
+func NormalizeLoginDevice(device string) string {
+	device = strings.TrimSpace(device)
+	if utf8.RuneCountInString(device) <= MaxLoginDeviceLength {
+		return device
+	}
+	return string([]rune(device)[:MaxLoginDeviceLength])
+}
+
+// RecordLogin はデバイスとIPアドレスの組み合わせからのログインを記録し、初めての組み合わせかを返す
+// ログイン元を記録する前（初回のログイン）は比較対象がないため、記録のみ行い初めての組み合わせとはみなさない
+// デバイスとIPアドレスがどちらも空の場合は判定できないため記録しない
+func (u *User) RecordLogin(device, ipAddress string, now time.Time) bool {
+	device = NormalizeLoginDevice(device)
+	ipAddress = strings.TrimSpace(ipAddress)
+	if device == "" && ipAddress == "" {
+		return false
+	}
+
+	for i := range u.KnownLogins {
+		known := &u.KnownLogins[i]
+		if known.Device == device && known.IPAddress == ipAddress {
+			known.LastSeenAt = now
+			return false
+		}
+	}
+
+	isNew := len(u.KnownLogins) > 0
+	if len(u.KnownLogins) >= MaxKnownLogins {
+		oldest := 0
+		for i, known := range u.KnownLogins {
+			if known.LastSeenAt.Before(u.KnownLogins[oldest].LastSeenAt) {
+				oldest = i
+			}
+		}
+		u.KnownLogins = append(u.KnownLogins[:oldest], u.KnownLogins[oldest+1:]...)
+	}
+	u.KnownLogins = append(u.KnownLogins, KnownLogin{
+		Device:      device,
+		IPAddress:   ipAddress,
+		FirstSeenAt: now,
+		LastSeenAt:  now,
+	})
+	return isNew
+}
+
+// SetNotifyOnNewLogin は初めてのデバイス・IPアドレスからログインしたときに通知を受け取るかを変更する
+// ログイン元は設定に関わらず記録される
+func (u *User) SetNotifyOnNewLogin(notify bool) {
+	u.NotifyOnNewLogin = notify
+	u.UpdatedAt = time.Now()
+}
@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUser_RecordLogin(t *testing.T) {
+	base := time.Date(2026, 3, 15, 7, 0, 0, 0, time.UTC)
+	const iphone = "Mozilla/5.0 (iPhone)"
+
+	user := &User{ID: "user1"}
+
+	// 初回のログインは比較対象がないため記録のみ行う
+	if user.RecordLogin(iphone, "192.0.2.1", base) {
+		t.Error("first login should not be reported as new")
+	}
+
+	tests := []struct {
+		name      string
+		device    string
+		ipAddress string
+		want      bool
+	}{
+		{name: "既知の組み合わせ", device: iphone, ipAddress: "192.0.2.1", want: false},
+		{name: "前後の空白は無視する", device: " " + iphone + " ", ipAddress: "192.0.2.1 ", want: false},
+		{name: "既知のデバイスで未知のIPアドレス", device: iphone, ipAddress: "198.51.100.7", want: true},
+		{name: "未知のデバイスで既知のIPアドレス", device: "Mozilla/5.0 (Windows NT 10.0)", ipAddress: "192.0.2.1", want: true},
+		{name: "一度ログインした組み合わせは既知になる", device: iphone, ipAddress: "198.51.100.7", want: false},
+		{name: "IPv6アドレス", device: iphone, ipAddress: "2001:db8::1", want: true},
+		{name: "ログイン元が不明な場合は判定しない", device: "", ipAddress: "", want: false},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := user.RecordLogin(tt.device, tt.ipAddress, base.Add(time.Duration(i+1)*time.Hour)); got != tt.want {
+				t.Errorf("RecordLogin(%q, %q) = %v, want %v", tt.device, tt.ipAddress, got, tt.want)
+			}
+		})
+	}
+
+	if len(user.KnownLogins) != 4 {
+		t.Fatalf("len(KnownLogins) = %d, want 4", len(user.KnownLogins))
+	}
+	first := user.KnownLogins[0]
+	if !first.FirstSeenAt.Equal(base) || !first.LastSeenAt.Equal(base.Add(2*time.Hour)) {
+		t.Errorf("KnownLogins[0] = %+v, want first seen at base and last seen 2 hours later", first)
+	}
+
+	t.Run("上限を超えると最後のログインが最も古い組み合わせから破棄する", func(t *testing.T) {
+		user := &User{ID: "user2"}
+		for i := 0; i < MaxKnownLogins; i++ {
+			user.RecordLogin("device", fmt.Sprintf("192.0.2.%d", i), base.Add(time.Duration(i)*time.Minute))
+		}
+		// 最初の組み合わせは最近使ったため残し、2番目を破棄する
+		user.RecordLogin("device", "192.0.2.0", base.Add(time.Hour))
+		if !user.RecordLogin("device", "198.51.100.1", base.Add(2*time.Hour)) {
+			t.Error("expected new login")
+		}
+		if len(user.KnownLogins) != MaxKnownLogins {
+			t.Fatalf("len(KnownLogins) = %d, want %d", len(user.KnownLogins), MaxKnownLogins)
+		}
+		if user.RecordLogin("device", "192.0.2.0", base.Add(3*time.Hour)) {
+			t.Error("recently used login should be kept")
+		}
+		if !user.RecordLogin("device", "192.0.2.1", base.Add(4*time.Hour)) {
+			t.Error("least recently used login should be discarded")
+		}
+	})
+
+	t.Run("長いデバイスは切り詰めて記録する", func(t *testing.T) {
+		user := &User{ID: "user3"}
+		long := strings.Repeat("a", MaxLoginDeviceLength+10)
+		user.RecordLogin(long, "192.0.2.1", base)
+		if got := len(user.KnownLogins[0].Device); got != MaxLoginDeviceLength {
+			t.Errorf("len(Device) = %d, want %d", got, MaxLoginDeviceLength)
+		}
+		if user.RecordLogin(long+"b", "192.0.2.1", base) {
+			t.Error("devices that differ only after the limit are treated as the same")
+		}
+	})
+}
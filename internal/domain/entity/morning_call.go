@@ -1,11 +1,34 @@
 package entity
 
 import (
+	"fmt"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
 )
 
+// MaxReceiverOffsetMinutes は受信者がアラーム時刻をずらせる最大の分数（前後それぞれ）
+const MaxReceiverOffsetMinutes = 30
+
+// MaxDeletionReasonLength は管理者が記録する削除理由の最大文字数
+const MaxDeletionReasonLength = 500
+
+const (
+	// MinHelpfulnessRating は受信者が付けられる役立ち度の評価の最小値
+	MinHelpfulnessRating = 1
+	// MaxHelpfulnessRating は受信者が付けられる役立ち度の評価の最大値
+	MaxHelpfulnessRating = 5
+)
+
+// CountMessageLength はメッセージの文字数を数える
+// UTF-8のコードポイント単位で数えるため、サロゲートペアで表現される文字や絵文字も1文字として扱う
+// （ただし結合文字やZWJで連結された絵文字は構成するコードポイントごとに数える）
+func CountMessageLength(message string) int {
+	return utf8.RuneCountInString(message)
+}
+
 // MorningCall は一人のユーザーが別のユーザーに設定するアラームを表すエンティティ
 type MorningCall struct {
 	ID            string
@@ -16,10 +39,60 @@ type MorningCall struct {
 	Status        valueobject.MorningCallStatus
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
+
+	DeliveredAt *time.Time // 配信された日時（未配信はnil）
+
+	ConfirmedBy           string                    // 起床確認をしたユーザーのID（受信者本人または代理人、未確認は空）
+	ConfirmLocation       *valueobject.GeoPoint     // 起床確認時の位置情報（任意）
+	ConfirmLocationShared bool                      // 位置情報を送信者に公開するか（受信者が選択）
+	ConfirmMethod         valueobject.ConfirmMethod // クライアントで起床確認した操作（未確認・自動確認は空）
+	TimeToConfirm         *time.Duration            // 配信（未配信の場合はアラーム時刻）から起床確認までの所要時間（未確認・自動確認はnil）
+
+	Stamp   valueobject.Stamp // 受信者から送信者へのお礼スタンプ（未送信は空）
+	StampAt *time.Time        // スタンプを送った日時
+
+	HelpfulnessRating *int       // 受信者によるメッセージの役立ち度の評価（1〜5、未評価はnil）
+	RatedAt           *time.Time // 役立ち度を評価した日時
+
+	ConfirmDeadline *time.Time // 起床確認の期限（nilは無期限）
+
+	WakeChallenge *valueobject.Challenge // 起床確認の前に受信者が答える起床クイズ（nilはクイズなし）
+
+	ReceiverOffsetMinutes int // 受信者が設定したアラーム時刻のずらし幅（分、負の値は早める）
+
+	SilentDelivery *bool // 受信者がこのコールに設定した無音配信の有無（nilは受信者のデフォルト設定に従う）
+
+	Volume  *int  // 受信者がこのコールに設定した配信時の音量（nilは受信者のデフォルト設定に従う）
+	Vibrate *bool // 受信者がこのコールに設定した配信時のバイブレーションの有無（nilは受信者のデフォルト設定に従う）
+
+	AutoConfirmed bool // 受信者の自動確認の設定により、配信時に自動で確認済みにされたか
+
+	SeriesID string // まとめて作成したシリーズのID（単独で作成したコールは空）
+
+	SelfCall bool // 送信者が自分自身に設定したセルフモーニングコールか（送信者と受信者が同じ）
+
+	ForwardedFrom string // 受信者の不在時転送により転送先に届けた場合の元の受信者ID（転送していないコールは空）
+
+	MessageVariants     []string // A/Bテスト用のメッセージの候補（バリアントなしは空、選ばれた候補がMessageになる）
+	MessageVariantIndex *int     // 配信するメッセージとして選ばれたバリアントの番号（0始まり、バリアントなしはnil）
+
+	RescheduleRequest *RescheduleRequest // 受信者からの最新のアラーム時刻の変更リクエスト（未リクエストはnil）
+
+	NextCallRequest *NextCallRequest // 受信者が起床確認と同時に依頼した翌日同時刻のコールのリクエスト（未リクエストはnil）
+
+	Archived   bool       // 受信者の受信箱からアーカイブされているか
+	ArchivedAt *time.Time // アーカイブされた日時（未アーカイブはnil）
+
+	DeletedAt      *time.Time // 管理者により削除された日時（nilは未削除）
+	DeletedBy      string     // 削除した管理者のID
+	DeletionReason string     // 管理者が記録した削除理由
+
+	Version int // 楽観ロック用のバージョン（リポジトリが更新のたびに加算する）
 }
 
 // NewMorningCall は新しいモーニングコールエンティティを作成する
-func NewMorningCall(id, senderID, receiverID string, scheduledTime time.Time, message string) (*MorningCall, valueobject.NGReason) {
+// メッセージの文字数はlimitsで検証する
+func NewMorningCall(id, senderID, receiverID string, scheduledTime time.Time, message string, limits valueobject.InputLimits) (*MorningCall, valueobject.NGReason) {
 	mc := &MorningCall{
 		ID:            id,
 		SenderID:      senderID,
@@ -32,7 +105,7 @@ func NewMorningCall(id, senderID, receiverID string, scheduledTime time.Time, me
 	}
 
 	// 検証
-	if reason := mc.Validate(); reason.IsNG() {
+	if reason := mc.Validate(limits); reason.IsNG() {
 		return nil, reason
 	}
 
@@ -40,10 +113,10 @@ func NewMorningCall(id, senderID, receiverID string, scheduledTime time.Time, me
 }
 
 // Validate はモーニングコールエンティティの妥当性を検証する
-func (mc *MorningCall) Validate() valueobject.NGReason {
+func (mc *MorningCall) Validate(limits valueobject.InputLimits) valueobject.NGReason {
 	// ID検証
 	if mc.ID == "" {
-		return valueobject.NG("モーニングコールIDは必須です")
+		return valueobject.NGWithCode(valueobject.ReasonCodeRequired, "id", "モーニングコールIDは必須です")
 	}
 
 	// 送信者・受信者検証
@@ -57,13 +130,23 @@ func (mc *MorningCall) Validate() valueobject.NGReason {
 	}
 
 	// メッセージ検証
-	if reason := mc.ValidateMessage(); reason.IsNG() {
+	if reason := mc.ValidateMessage(limits); reason.IsNG() {
+		return reason
+	}
+
+	// メッセージバリアントの検証
+	if reason := mc.ValidateMessageVariants(limits); reason.IsNG() {
+		return reason
+	}
+
+	// 起床確認期限の検証
+	if reason := mc.ValidateConfirmDeadline(); reason.IsNG() {
 		return reason
 	}
 
 	// ステータス検証
 	if !mc.Status.IsValid() {
-		return valueobject.NG("無効なステータスです")
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidFormat, "status", "無効なステータスです")
 	}
 
 	return valueobject.OK()
@@ -72,15 +155,22 @@ func (mc *MorningCall) Validate() valueobject.NGReason {
 // ValidateSenderReceiver は送信者と受信者の妥当性を検証する
 func (mc *MorningCall) ValidateSenderReceiver() valueobject.NGReason {
 	if mc.SenderID == "" {
-		return valueobject.NG("送信者IDは必須です")
+		return valueobject.NGWithCode(valueobject.ReasonCodeRequired, "sender_id", "送信者IDは必須です")
 	}
 
 	if mc.ReceiverID == "" {
-		return valueobject.NG("受信者IDは必須です")
+		return valueobject.NGWithCode(valueobject.ReasonCodeRequired, "receiver_id", "受信者IDは必須です")
 	}
 
+	// 自分自身へのコールはセルフモーニングコールとして明示された場合のみ許可する
+	if mc.SelfCall {
+		if mc.SenderID != mc.ReceiverID {
+			return valueobject.NGWithCode(valueobject.ReasonCodeInvalid, "receiver_id", "セルフモーニングコールの受信者は送信者自身である必要があります")
+		}
+		return valueobject.OK()
+	}
 	if mc.SenderID == mc.ReceiverID {
-		return valueobject.NG("自分自身にモーニングコールを設定することはできません")
+		return valueobject.NGWithCode(valueobject.ReasonCodeSelfReference, "receiver_id", "自分自身にモーニングコールを設定することはできません")
 	}
 
 	return valueobject.OK()
@@ -91,31 +181,101 @@ func (mc *MorningCall) ValidateScheduledTime() valueobject.NGReason {
 	now := time.Now()
 
 	// 過去の時刻は許可しない（作成時のみ。既存のものは過去になる可能性がある）
-	if mc.Status == valueobject.MorningCallStatusScheduled && mc.ScheduledTime.Before(now) {
-		return valueobject.NG("アラーム時刻は現在時刻より後である必要があります")
+	if mc.IsAwaitingDelivery() && mc.ScheduledTime.Before(now) {
+		return valueobject.NGWithCode(valueobject.ReasonCodeOutOfRange, "scheduled_time", "アラーム時刻は現在時刻より後である必要があります")
 	}
 
 	// 30日以内の制限
 	maxTime := now.Add(30 * 24 * time.Hour)
 	if mc.ScheduledTime.After(maxTime) {
-		return valueobject.NG("アラーム時刻は30日以内で設定してください")
+		return valueobject.NGWithCode(valueobject.ReasonCodeOutOfRange, "scheduled_time", "アラーム時刻は30日以内で設定してください")
 	}
 
 	return valueobject.OK()
 }
 
 // ValidateMessage はメッセージの妥当性を検証する
-func (mc *MorningCall) ValidateMessage() valueobject.NGReason {
+func (mc *MorningCall) ValidateMessage(limits valueobject.InputLimits) valueobject.NGReason {
 	// メッセージは任意（空でもOK）
 	// rune（文字）単位でカウント
-	messageLength := len([]rune(mc.Message))
-	if messageLength > 500 {
-		return valueobject.NG("メッセージは500文字以内で入力してください")
+	if CountMessageLength(mc.Message) > limits.MessageMaxLength {
+		return valueobject.NGWithCode(valueobject.ReasonCodeTooLong, "message", fmt.Sprintf("メッセージは%d文字以内で入力してください", limits.MessageMaxLength))
+	}
+
+	return valueobject.OK()
+}
+
+// ValidateMessageVariants はA/Bテスト用のメッセージバリアントの妥当性を検証する（バリアントなしは検証しない）
+// バリアントごとにメッセージと同じ文字数の上限を適用する
+func (mc *MorningCall) ValidateMessageVariants(limits valueobject.InputLimits) valueobject.NGReason {
+	if len(mc.MessageVariants) == 0 {
+		return valueobject.OK()
+	}
+
+	if len(mc.MessageVariants) < valueobject.MinMessageVariants || len(mc.MessageVariants) > valueobject.MaxMessageVariants {
+		return valueobject.NGWithCode(valueobject.ReasonCodeOutOfRange, "message_variants",
+			fmt.Sprintf("メッセージバリアントは%d件から%d件の範囲で指定してください", valueobject.MinMessageVariants, valueobject.MaxMessageVariants))
+	}
+	for i, variant := range mc.MessageVariants {
+		if CountMessageLength(variant) > limits.MessageMaxLength {
+			return valueobject.NGWithCode(valueobject.ReasonCodeTooLong, "message_variants",
+				fmt.Sprintf("メッセージバリアントの%d件目は%d文字以内で入力してください", i+1, limits.MessageMaxLength))
+		}
+	}
+	if mc.MessageVariantIndex == nil || *mc.MessageVariantIndex < 0 || *mc.MessageVariantIndex >= len(mc.MessageVariants) {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalid, "message_variants", "配信するメッセージバリアントが選ばれていません")
+	}
+
+	return valueobject.OK()
+}
+
+// SetMessageVariants はA/Bテスト用のメッセージバリアントを設定し、index番目のバリアントを配信するメッセージにする
+func (mc *MorningCall) SetMessageVariants(variants []string, index int, limits valueobject.InputLimits) valueobject.NGReason {
+	oldMessage, oldVariants, oldIndex := mc.Message, mc.MessageVariants, mc.MessageVariantIndex
+
+	mc.MessageVariants = append([]string(nil), variants...)
+	mc.MessageVariantIndex = &index
+	if reason := mc.ValidateMessageVariants(limits); reason.IsNG() {
+		mc.Message, mc.MessageVariants, mc.MessageVariantIndex = oldMessage, oldVariants, oldIndex // ロールバック
+		return reason
+	}
+
+	mc.Message = mc.MessageVariants[index]
+	return valueobject.OK()
+}
+
+// ClearMessageVariants はメッセージバリアントを取り消す（メッセージを直接変更した場合など）
+func (mc *MorningCall) ClearMessageVariants() {
+	mc.MessageVariants = nil
+	mc.MessageVariantIndex = nil
+}
+
+// ValidateConfirmDeadline は起床確認期限の妥当性を検証する
+func (mc *MorningCall) ValidateConfirmDeadline() valueobject.NGReason {
+	// 期限は任意（未指定は無期限）
+	if mc.ConfirmDeadline == nil {
+		return valueobject.OK()
+	}
+
+	if !mc.ConfirmDeadline.After(mc.ScheduledTime) {
+		return valueobject.NGWithCode(valueobject.ReasonCodeOutOfRange, "confirm_deadline", "起床確認の期限はアラーム時刻より後である必要があります")
 	}
 
 	return valueobject.OK()
 }
 
+// IsConfirmDeadlinePassed は指定時刻に起床確認の期限を過ぎているかを判定する
+// 期限ちょうどの時刻はまだ確認可能とみなす
+func (mc *MorningCall) IsConfirmDeadlinePassed(now time.Time) bool {
+	return mc.ConfirmDeadline != nil && now.After(*mc.ConfirmDeadline)
+}
+
+// IsApprovalDeadlinePassed は指定時刻に承認の期限（アラーム時刻）を過ぎているかを判定する
+// アラーム時刻を過ぎてから承認しても配信できないため、アラーム時刻ちょうどの時刻も期限切れとみなす
+func (mc *MorningCall) IsApprovalDeadlinePassed(now time.Time) bool {
+	return !mc.ScheduledTime.After(now)
+}
+
 // CanTransitionTo は指定されたステータスへの遷移が可能かを検証する
 func (mc *MorningCall) CanTransitionTo(newStatus valueobject.MorningCallStatus) bool {
 	return mc.Status.CanTransitionTo(newStatus)
@@ -123,8 +283,11 @@ func (mc *MorningCall) CanTransitionTo(newStatus valueobject.MorningCallStatus)
 
 // UpdateStatus はステータスを更新する
 func (mc *MorningCall) UpdateStatus(newStatus valueobject.MorningCallStatus) valueobject.NGReason {
+	if mc.IsDeleted() {
+		return deletedMorningCallReason()
+	}
 	if !mc.CanTransitionTo(newStatus) {
-		return valueobject.NG("このステータスへの遷移はできません")
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "status", "このステータスへの遷移はできません")
 	}
 
 	mc.Status = newStatus
@@ -137,14 +300,265 @@ func (mc *MorningCall) Cancel() valueobject.NGReason {
 	return mc.UpdateStatus(valueobject.MorningCallStatusCancelled)
 }
 
+// Approve は受信者の承認によりモーニングコールをスケジュール済みにする
+func (mc *MorningCall) Approve() valueobject.NGReason {
+	if mc.Status != valueobject.MorningCallStatusPendingApproval {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "承認待ちのモーニングコールのみ承認できます")
+	}
+	return mc.UpdateStatus(valueobject.MorningCallStatusScheduled)
+}
+
+// Reject は受信者の拒否によりモーニングコールを拒否済みにする
+func (mc *MorningCall) Reject() valueobject.NGReason {
+	if mc.Status != valueobject.MorningCallStatusPendingApproval {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "承認待ちのモーニングコールのみ拒否できます")
+	}
+	return mc.UpdateStatus(valueobject.MorningCallStatusRejected)
+}
+
+// Skip は受信者の都合でモーニングコールをスキップする
+func (mc *MorningCall) Skip() valueobject.NGReason {
+	return mc.UpdateStatus(valueobject.MorningCallStatusSkipped)
+}
+
 // MarkAsDelivered はモーニングコールを配信済みにする
 func (mc *MorningCall) MarkAsDelivered() valueobject.NGReason {
-	return mc.UpdateStatus(valueobject.MorningCallStatusDelivered)
+	if reason := mc.UpdateStatus(valueobject.MorningCallStatusDelivered); reason.IsNG() {
+		return reason
+	}
+	deliveredAt := mc.UpdatedAt
+	mc.DeliveredAt = &deliveredAt
+	return valueobject.OK()
 }
 
-// ConfirmWakeUp は起床確認を記録する
+// CancelBySender は送信者によるキャンセルを行う
+// 配信済みのコールも、配信からgracePeriod以内（境界の時刻を含む）であれば取り消せる
+// 受信者が起床確認したコールは取り消せない。gracePeriodが0以下の場合は猶予を設けない
+func (mc *MorningCall) CancelBySender(now time.Time, gracePeriod time.Duration) valueobject.NGReason {
+	if mc.IsDeleted() {
+		return deletedMorningCallReason()
+	}
+	if mc.Status != valueobject.MorningCallStatusDelivered {
+		return mc.Cancel()
+	}
+	if gracePeriod <= 0 {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "配信済みのモーニングコールはキャンセルできません")
+	}
+	if !mc.IsWithinCancelGrace(now, gracePeriod) {
+		return valueobject.NGWithCode(valueobject.ReasonCodeExpired, "", fmt.Sprintf("配信から%d秒を過ぎたモーニングコールはキャンセルできません", int(gracePeriod/time.Second)))
+	}
+
+	mc.Status = valueobject.MorningCallStatusCancelled
+	mc.UpdatedAt = now
+	return valueobject.OK()
+}
+
+// IsWithinCancelGrace は配信済みのコールが送信取り消しの猶予期間内かを判定する
+func (mc *MorningCall) IsWithinCancelGrace(now time.Time, gracePeriod time.Duration) bool {
+	if mc.Status != valueobject.MorningCallStatusDelivered || mc.DeliveredAt == nil {
+		return false
+	}
+	return !now.After(mc.DeliveredAt.Add(gracePeriod))
+}
+
+// ConfirmWakeUp は受信者本人による起床確認を記録する
 func (mc *MorningCall) ConfirmWakeUp() valueobject.NGReason {
-	return mc.UpdateStatus(valueobject.MorningCallStatusConfirmed)
+	if reason := mc.UpdateStatus(valueobject.MorningCallStatusConfirmed); reason.IsNG() {
+		return reason
+	}
+	mc.ConfirmedBy = mc.ReceiverID
+	return valueobject.OK()
+}
+
+// CheckWakeChallenge は起床クイズの回答を検証する（クイズなしのコールは常にOK）
+// 不正解の場合は起床確認を記録しないこと
+func (mc *MorningCall) CheckWakeChallenge(answer string) valueobject.NGReason {
+	if mc.WakeChallenge == nil {
+		return valueobject.OK()
+	}
+	return mc.WakeChallenge.Check(answer)
+}
+
+// ConfirmWakeUpByProxy は代理人による起床確認を記録する
+// 代理人として許可されているかは呼び出し側で確認すること
+func (mc *MorningCall) ConfirmWakeUpByProxy(proxyID string) valueobject.NGReason {
+	if proxyID == "" {
+		return valueobject.NGWithCode(valueobject.ReasonCodeRequired, "proxy_confirmer_id", "代理人のユーザーIDは必須です")
+	}
+	if proxyID == mc.ReceiverID {
+		return valueobject.NGWithCode(valueobject.ReasonCodeNotApplicable, "", "受信者本人は代理確認できません")
+	}
+	if reason := mc.UpdateStatus(valueobject.MorningCallStatusConfirmed); reason.IsNG() {
+		return reason
+	}
+	mc.ConfirmedBy = proxyID
+	return valueobject.OK()
+}
+
+// AutoConfirm は受信者の自動確認の設定により、配信時に配信済みを経ずに確認済みにする
+// 受信者が送信者を自動確認の対象にしているかは呼び出し側で確認すること
+func (mc *MorningCall) AutoConfirm() valueobject.NGReason {
+	if mc.Status != valueobject.MorningCallStatusScheduled {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "配信前のスケジュール済みのモーニングコールのみ自動確認できます")
+	}
+	if mc.WakeChallenge != nil {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "起床クイズ付きのモーニングコールは自動確認できません")
+	}
+	if reason := mc.UpdateStatus(valueobject.MorningCallStatusConfirmed); reason.IsNG() {
+		return reason
+	}
+	mc.ConfirmedBy = mc.ReceiverID
+	mc.AutoConfirmed = true
+	return valueobject.OK()
+}
+
+// DeleteByAdmin は規約違反などの理由で管理者がモーニングコールを削除済みにする
+// 履歴として残すためステータスは変更せず、削除後はステータスや内容を変更できなくなる
+// 管理者であるかは呼び出し側で確認すること
+func (mc *MorningCall) DeleteByAdmin(adminID, reason string, now time.Time) valueobject.NGReason {
+	if mc.IsDeleted() {
+		return valueobject.NGWithCode(valueobject.ReasonCodeDuplicate, "", "既に削除されたモーニングコールです")
+	}
+	if adminID == "" {
+		return valueobject.NGWithCode(valueobject.ReasonCodeRequired, "admin_id", "削除する管理者のIDは必須です")
+	}
+	if strings.TrimSpace(reason) == "" {
+		return valueobject.NGWithCode(valueobject.ReasonCodeRequired, "reason", "削除理由は必須です")
+	}
+	if CountMessageLength(reason) > MaxDeletionReasonLength {
+		return valueobject.NGWithCode(valueobject.ReasonCodeTooLong, "reason", fmt.Sprintf("削除理由は%d文字以内で入力してください", MaxDeletionReasonLength))
+	}
+
+	deletedAt := now
+	mc.DeletedAt = &deletedAt
+	mc.DeletedBy = adminID
+	mc.DeletionReason = reason
+	mc.UpdatedAt = now
+	return valueobject.OK()
+}
+
+// IsDeleted は管理者により削除されたかを判定する
+func (mc *MorningCall) IsDeleted() bool {
+	return mc.DeletedAt != nil
+}
+
+// deletedMorningCallReason は削除済みのコールを変更しようとした場合の検証エラーを返す
+func deletedMorningCallReason() valueobject.NGReason {
+	return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "削除されたモーニングコールは変更できません")
+}
+
+// IsProxyConfirmed は代理人によって起床確認されたかを判定する
+func (mc *MorningCall) IsProxyConfirmed() bool {
+	return mc.ConfirmedBy != "" && mc.ConfirmedBy != mc.ReceiverID
+}
+
+// ConfirmWakeUpWithLocation は位置情報付きで起床確認を記録する
+// locationがnilの場合は位置情報なしで確認のみ記録する
+func (mc *MorningCall) ConfirmWakeUpWithLocation(location *valueobject.GeoPoint, shareWithSender bool) valueobject.NGReason {
+	if location != nil {
+		if reason := location.Validate(); reason.IsNG() {
+			return reason
+		}
+	}
+
+	if reason := mc.ConfirmWakeUp(); reason.IsNG() {
+		return reason
+	}
+
+	if location != nil {
+		loc := *location
+		mc.ConfirmLocation = &loc
+		mc.ConfirmLocationShared = shareWithSender
+	}
+
+	return valueobject.OK()
+}
+
+// ConfirmLocationFor は指定したユーザーが閲覧できる起床確認時の位置情報を返す
+// 受信者本人は常に閲覧でき、送信者は受信者が公開を選択した場合のみ閲覧できる
+func (mc *MorningCall) ConfirmLocationFor(userID string) *valueobject.GeoPoint {
+	if mc.ConfirmLocation == nil {
+		return nil
+	}
+	if userID == mc.ReceiverID || (userID == mc.SenderID && mc.ConfirmLocationShared) {
+		loc := *mc.ConfirmLocation
+		return &loc
+	}
+	return nil
+}
+
+// SetConfirmMethod は起床確認した操作の種類を記録する（起床確認済みの場合のみ）
+// 未指定（空）の場合はボタンでの確認として記録する
+func (mc *MorningCall) SetConfirmMethod(method valueobject.ConfirmMethod) valueobject.NGReason {
+	method = method.OrDefault()
+	if !method.IsValid() {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidFormat, "confirm_method", "無効な確認方法です")
+	}
+	if mc.Status != valueobject.MorningCallStatusConfirmed || mc.AutoConfirmed {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "起床確認済みのモーニングコールにのみ確認方法を記録できます")
+	}
+	mc.ConfirmMethod = method
+	return valueobject.OK()
+}
+
+// RecordTimeToConfirm は配信から起床確認までの所要時間を記録する（起床確認済みの場合のみ）
+// 配信前に確認した場合はアラーム時刻（受信者のずらし幅を反映した時刻）を起点とし、起点より前の確認は0とする
+func (mc *MorningCall) RecordTimeToConfirm(confirmedAt time.Time) valueobject.NGReason {
+	if mc.Status != valueobject.MorningCallStatusConfirmed || mc.AutoConfirmed {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "起床確認済みのモーニングコールにのみ確認までの所要時間を記録できます")
+	}
+
+	start := mc.EffectiveScheduledTime()
+	if mc.DeliveredAt != nil {
+		start = *mc.DeliveredAt
+	}
+	elapsed := confirmedAt.Sub(start)
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	mc.TimeToConfirm = &elapsed
+	return valueobject.OK()
+}
+
+// SetStamp は受信者から送信者へのお礼スタンプを設定する（起床確認済みの場合のみ）
+// すでにスタンプがある場合は上書きする
+func (mc *MorningCall) SetStamp(stamp valueobject.Stamp) valueobject.NGReason {
+	if mc.IsDeleted() {
+		return deletedMorningCallReason()
+	}
+	if !stamp.IsValid() {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidFormat, "stamp", "無効なスタンプです")
+	}
+	if mc.Status != valueobject.MorningCallStatusConfirmed {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "起床確認済みのモーニングコールにのみスタンプを送れます")
+	}
+
+	now := time.Now()
+	mc.Stamp = stamp
+	mc.StampAt = &now
+	mc.UpdatedAt = now
+	return valueobject.OK()
+}
+
+// SetHelpfulnessRating は受信者によるメッセージの役立ち度の評価を設定する（起床確認済みの場合のみ）
+// すでに評価がある場合は上書きする
+func (mc *MorningCall) SetHelpfulnessRating(rating int) valueobject.NGReason {
+	if mc.IsDeleted() {
+		return deletedMorningCallReason()
+	}
+	if rating < MinHelpfulnessRating || rating > MaxHelpfulnessRating {
+		return valueobject.NGWithCode(valueobject.ReasonCodeOutOfRange, "rating",
+			fmt.Sprintf("評価は%dから%dの範囲で指定してください", MinHelpfulnessRating, MaxHelpfulnessRating))
+	}
+	if mc.Status != valueobject.MorningCallStatusConfirmed {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "起床確認済みのモーニングコールにのみ評価を付けられます")
+	}
+
+	now := time.Now()
+	mc.HelpfulnessRating = &rating
+	mc.RatedAt = &now
+	mc.UpdatedAt = now
+	return valueobject.OK()
 }
 
 // MarkAsExpired はモーニングコールを期限切れにする
@@ -153,27 +567,35 @@ func (mc *MorningCall) MarkAsExpired() valueobject.NGReason {
 }
 
 // UpdateMessage はメッセージを更新する（スケジュール済みの場合のみ）
-func (mc *MorningCall) UpdateMessage(newMessage string) valueobject.NGReason {
+func (mc *MorningCall) UpdateMessage(newMessage string, limits valueobject.InputLimits) valueobject.NGReason {
+	if mc.IsDeleted() {
+		return deletedMorningCallReason()
+	}
 	if mc.Status != valueobject.MorningCallStatusScheduled {
-		return valueobject.NG("スケジュール済みのモーニングコールのみ更新できます")
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "スケジュール済みのモーニングコールのみ更新できます")
 	}
 
 	oldMessage := mc.Message
 	mc.Message = newMessage
 
-	if reason := mc.ValidateMessage(); reason.IsNG() {
+	if reason := mc.ValidateMessage(limits); reason.IsNG() {
 		mc.Message = oldMessage // ロールバック
 		return reason
 	}
 
+	// 送信者がメッセージを書き換えた場合はA/Bテストの対象から外す
+	mc.ClearMessageVariants()
 	mc.UpdatedAt = time.Now()
 	return valueobject.OK()
 }
 
 // UpdateScheduledTime はアラーム時刻を更新する（スケジュール済みの場合のみ）
 func (mc *MorningCall) UpdateScheduledTime(newTime time.Time) valueobject.NGReason {
+	if mc.IsDeleted() {
+		return deletedMorningCallReason()
+	}
 	if mc.Status != valueobject.MorningCallStatusScheduled {
-		return valueobject.NG("スケジュール済みのモーニングコールのみ更新できます")
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "スケジュール済みのモーニングコールのみ更新できます")
 	}
 
 	oldTime := mc.ScheduledTime
@@ -183,25 +605,328 @@ func (mc *MorningCall) UpdateScheduledTime(newTime time.Time) valueobject.NGReas
 		mc.ScheduledTime = oldTime // ロールバック
 		return reason
 	}
+	if reason := mc.ValidateConfirmDeadline(); reason.IsNG() {
+		mc.ScheduledTime = oldTime // ロールバック
+		return reason
+	}
+
+	mc.UpdatedAt = time.Now()
+	return valueobject.OK()
+}
+
+// RequestReschedule は受信者から送信者へアラーム時刻の変更をリクエストする（スケジュール済みの場合のみ）
+// 送信者が応答するまでは新しいリクエストを出せない
+func (mc *MorningCall) RequestReschedule(requestedTime, now time.Time) valueobject.NGReason {
+	if mc.IsDeleted() {
+		return deletedMorningCallReason()
+	}
+	if mc.Status != valueobject.MorningCallStatusScheduled {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "スケジュール済みのモーニングコールのみ時刻の変更をリクエストできます")
+	}
+	if mc.HasPendingRescheduleRequest() {
+		return valueobject.NGWithCode(valueobject.ReasonCodeDuplicate, "", "既に時刻の変更をリクエスト中です")
+	}
+	if requestedTime.Equal(mc.ScheduledTime) {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalid, "requested_time", "希望時刻が現在のアラーム時刻と同じです")
+	}
+	if !requestedTime.After(now) {
+		return valueobject.NGWithCode(valueobject.ReasonCodeOutOfRange, "requested_time", "希望時刻は現在時刻より後である必要があります")
+	}
+	if requestedTime.After(now.Add(30 * 24 * time.Hour)) {
+		return valueobject.NGWithCode(valueobject.ReasonCodeOutOfRange, "requested_time", "希望時刻は30日以内で指定してください")
+	}
+
+	mc.RescheduleRequest = &RescheduleRequest{
+		RequestedTime: requestedTime,
+		Status:        RescheduleRequestStatusPending,
+		RequestedAt:   now,
+	}
+	mc.UpdatedAt = now
+	return valueobject.OK()
+}
+
+// HasPendingRescheduleRequest は送信者の応答待ちの変更リクエストがあるかを判定する
+func (mc *MorningCall) HasPendingRescheduleRequest() bool {
+	return mc.RescheduleRequest != nil && mc.RescheduleRequest.Status == RescheduleRequestStatusPending
+}
+
+// ApproveReschedule は送信者が変更リクエストを承認し、アラーム時刻を希望時刻に更新する
+// 希望時刻が既に過ぎているなど時刻を更新できない場合は承認しない
+func (mc *MorningCall) ApproveReschedule(now time.Time) valueobject.NGReason {
+	if !mc.HasPendingRescheduleRequest() {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "応答待ちの時刻の変更リクエストがありません")
+	}
+	if reason := mc.UpdateScheduledTime(mc.RescheduleRequest.RequestedTime); reason.IsNG() {
+		return reason
+	}
+
+	mc.RescheduleRequest.respond(RescheduleRequestStatusApproved, now)
+	mc.UpdatedAt = now
+	return valueobject.OK()
+}
+
+// RejectReschedule は送信者が変更リクエストを拒否する（アラーム時刻は変更しない）
+func (mc *MorningCall) RejectReschedule(now time.Time) valueobject.NGReason {
+	if mc.IsDeleted() {
+		return deletedMorningCallReason()
+	}
+	if !mc.HasPendingRescheduleRequest() {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "応答待ちの時刻の変更リクエストがありません")
+	}
+
+	mc.RescheduleRequest.respond(RescheduleRequestStatusRejected, now)
+	mc.UpdatedAt = now
+	return valueobject.OK()
+}
+
+// RequestNextCall は受信者から送信者へ翌日同時刻の次回のコールをリクエストする（起床確認済みの場合のみ）
+// 次回の時刻は送信者が設定したアラーム時刻を基準にし、受信者のずらし幅は引き継がない
+func (mc *MorningCall) RequestNextCall(now time.Time) valueobject.NGReason {
+	if mc.IsDeleted() {
+		return deletedMorningCallReason()
+	}
+	if mc.SelfCall {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalid, "request_next_call", "セルフモーニングコールでは次回のコールをリクエストできません")
+	}
+	if mc.Status != valueobject.MorningCallStatusConfirmed {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "起床確認済みのモーニングコールのみ次回のコールをリクエストできます")
+	}
+	if mc.NextCallRequest != nil {
+		return valueobject.NGWithCode(valueobject.ReasonCodeDuplicate, "", "既に次回のコールをリクエスト済みです")
+	}
+	requestedTime := mc.ScheduledTime.AddDate(0, 0, 1)
+	if !requestedTime.After(now) {
+		return valueobject.NGWithCode(valueobject.ReasonCodeOutOfRange, "request_next_call", "翌日同時刻を過ぎているため次回のコールをリクエストできません")
+	}
+
+	mc.NextCallRequest = &NextCallRequest{
+		RequestedTime: requestedTime,
+		Status:        NextCallRequestStatusPending,
+		RequestedAt:   now,
+	}
+	mc.UpdatedAt = now
+	return valueobject.OK()
+}
+
+// HasPendingNextCallRequest は送信者の応答待ちの次回のコールのリクエストがあるかを判定する
+func (mc *MorningCall) HasPendingNextCallRequest() bool {
+	return mc.NextCallRequest != nil && mc.NextCallRequest.Status == NextCallRequestStatusPending
+}
+
+// ApproveNextCall は送信者が次回のコールのリクエストを承認し、作成した次回のコールのIDを記録する
+func (mc *MorningCall) ApproveNextCall(createdCallID string, now time.Time) valueobject.NGReason {
+	if !mc.HasPendingNextCallRequest() {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "応答待ちの次回のコールのリクエストがありません")
+	}
+	if createdCallID == "" {
+		return valueobject.NGWithCode(valueobject.ReasonCodeRequired, "created_call_id", "作成したモーニングコールのIDは必須です")
+	}
+
+	mc.NextCallRequest.respond(NextCallRequestStatusApproved, now)
+	mc.NextCallRequest.CreatedCallID = createdCallID
+	mc.UpdatedAt = now
+	return valueobject.OK()
+}
+
+// RejectNextCall は送信者が次回のコールのリクエストを拒否する
+func (mc *MorningCall) RejectNextCall(now time.Time) valueobject.NGReason {
+	if mc.IsDeleted() {
+		return deletedMorningCallReason()
+	}
+	if !mc.HasPendingNextCallRequest() {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "応答待ちの次回のコールのリクエストがありません")
+	}
+
+	mc.NextCallRequest.respond(NextCallRequestStatusRejected, now)
+	mc.UpdatedAt = now
+	return valueobject.OK()
+}
+
+// SetReceiverOffset は受信者によるアラーム時刻のずらし幅を設定する（スケジュール済みの場合のみ）
+// 0を指定すると送信者が設定した時刻に戻る
+func (mc *MorningCall) SetReceiverOffset(minutes int) valueobject.NGReason {
+	if mc.IsDeleted() {
+		return deletedMorningCallReason()
+	}
+	if mc.Status != valueobject.MorningCallStatusScheduled {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "スケジュール済みのモーニングコールのみアラーム時刻をずらせます")
+	}
+	if minutes < -MaxReceiverOffsetMinutes || minutes > MaxReceiverOffsetMinutes {
+		return valueobject.NGWithCode(valueobject.ReasonCodeOutOfRange, "offset_minutes", "アラーム時刻のずらし幅は前後30分以内である必要があります")
+	}
+
+	mc.ReceiverOffsetMinutes = minutes
+	mc.UpdatedAt = time.Now()
+	return valueobject.OK()
+}
+
+// EffectiveScheduledTime は受信者のずらし幅を反映した実際の配信時刻を返す
+func (mc *MorningCall) EffectiveScheduledTime() time.Time {
+	return mc.ScheduledTime.Add(time.Duration(mc.ReceiverOffsetMinutes) * time.Minute)
+}
+
+// SetSilentDelivery は受信者がこのコールの無音配信の有無を設定する（nilで設定を解除しデフォルトに戻す）
+func (mc *MorningCall) SetSilentDelivery(silent *bool) valueobject.NGReason {
+	if mc.IsDeleted() {
+		return deletedMorningCallReason()
+	}
+	if !mc.IsAwaitingDelivery() {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "配信前のモーニングコールのみ無音配信を設定できます")
+	}
+
+	if silent == nil {
+		mc.SilentDelivery = nil
+	} else {
+		value := *silent
+		mc.SilentDelivery = &value
+	}
+	mc.UpdatedAt = time.Now()
+	return valueobject.OK()
+}
+
+// ResolveSilentDelivery は配信時に無音で通知するかを判定する
+// 優先順位は「コールごとの設定」「受信者のデフォルト設定」「音を鳴らす」の順
+// 配信時の通知ペイロードにはこの値を含め、クライアントが音を鳴らすかを判断する
+func (mc *MorningCall) ResolveSilentDelivery(receiver *User) bool {
+	return mc.ResolveSilentDeliveryFrom(receiver, nil)
+}
+
+// ResolveSilentDeliveryFrom は送信者が受信者のお気に入り送信元である場合の設定を考慮して無音で通知するかを判定する
+// お気に入り送信元で常に音を鳴らす設定の場合は、受信者のデフォルト設定より優先して音を鳴らす
+func (mc *MorningCall) ResolveSilentDeliveryFrom(receiver *User, favorite *valueobject.FavoriteSender) bool {
+	if mc.SilentDelivery != nil {
+		return *mc.SilentDelivery
+	}
+	if favorite != nil && favorite.AlwaysRing {
+		return false
+	}
+	if receiver != nil && receiver.ID == mc.ReceiverID {
+		return receiver.SilentDelivery
+	}
+	return false
+}
+
+// SetDeliveryHints は受信者がこのコールの配信時の音量とバイブレーションの有無を設定する
+// nilを指定した項目はコールごとの設定を解除し、受信者のデフォルト設定に戻す
+func (mc *MorningCall) SetDeliveryHints(volume *int, vibrate *bool) valueobject.NGReason {
+	if mc.IsDeleted() {
+		return deletedMorningCallReason()
+	}
+	if !mc.IsAwaitingDelivery() {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "配信前のモーニングコールのみ音量とバイブレーションを設定できます")
+	}
+	if volume != nil {
+		if reason := valueobject.ValidateVolume(*volume); reason.IsNG() {
+			return reason
+		}
+	}
 
+	if volume == nil {
+		mc.Volume = nil
+	} else {
+		value := *volume
+		mc.Volume = &value
+	}
+	if vibrate == nil {
+		mc.Vibrate = nil
+	} else {
+		value := *vibrate
+		mc.Vibrate = &value
+	}
 	mc.UpdatedAt = time.Now()
 	return valueobject.OK()
 }
 
-// IsActive はモーニングコールが有効（配信待ちまたは配信済み）かを判定する
+// ResolveDeliveryHints は配信時にクライアントへ渡す音量とバイブレーションの有無を決める
+// 音量・バイブレーションとも優先順位は「コールごとの設定」「受信者のデフォルト設定」「新規ユーザーのデフォルト値」の順
+// 無音で配信する場合（ResolveSilentDeliveryの判定）は、設定にかかわらず音量を0にする
+// バイブレーションは無音配信でも設定どおりに行う
+func (mc *MorningCall) ResolveDeliveryHints(receiver *User) valueobject.DeliveryHints {
+	return mc.ResolveDeliveryHintsFrom(receiver, nil)
+}
+
+// ResolveDeliveryHintsFrom は送信者が受信者のお気に入り送信元である場合の無音配信の設定を考慮して配信時のヒントを決める
+func (mc *MorningCall) ResolveDeliveryHintsFrom(receiver *User, favorite *valueobject.FavoriteSender) valueobject.DeliveryHints {
+	hints := valueobject.DeliveryHints{Volume: valueobject.DefaultVolume, Vibrate: true}
+	if receiver != nil && receiver.ID == mc.ReceiverID {
+		hints = valueobject.DeliveryHints{Volume: receiver.Volume, Vibrate: receiver.Vibrate}
+	}
+	if mc.Volume != nil {
+		hints.Volume = *mc.Volume
+	}
+	if mc.Vibrate != nil {
+		hints.Vibrate = *mc.Vibrate
+	}
+	if mc.ResolveSilentDeliveryFrom(receiver, favorite) {
+		hints.Volume = valueobject.MinVolume
+	}
+	return hints
+}
+
+// Archive は受信者の受信箱からモーニングコールをアーカイブする
+// 確認済み・期限切れなど終了したコールのみアーカイブでき、ステータスは変更しない
+func (mc *MorningCall) Archive(now time.Time) valueobject.NGReason {
+	if mc.IsDeleted() {
+		return deletedMorningCallReason()
+	}
+	if mc.Archived {
+		return valueobject.NGWithCode(valueobject.ReasonCodeDuplicate, "", "既にアーカイブされたモーニングコールです")
+	}
+	if mc.IsActive() {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "終了したモーニングコールのみアーカイブできます")
+	}
+
+	archivedAt := now
+	mc.Archived = true
+	mc.ArchivedAt = &archivedAt
+	mc.UpdatedAt = now
+	return valueobject.OK()
+}
+
+// Unarchive はアーカイブを解除して受信箱に戻す
+func (mc *MorningCall) Unarchive(now time.Time) valueobject.NGReason {
+	if mc.IsDeleted() {
+		return deletedMorningCallReason()
+	}
+	if !mc.Archived {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "アーカイブされていないモーニングコールです")
+	}
+
+	mc.Archived = false
+	mc.ArchivedAt = nil
+	mc.UpdatedAt = now
+	return valueobject.OK()
+}
+
+// IsActive はモーニングコールが有効（承認待ち・配信待ち・配信済み）かを判定する
+// 承認待ちのものも時刻の重複判定やプランの上限では有効なコールとして扱う
 func (mc *MorningCall) IsActive() bool {
-	return mc.Status == valueobject.MorningCallStatusScheduled ||
+	if mc.IsDeleted() {
+		return false
+	}
+	return mc.Status == valueobject.MorningCallStatusPendingApproval ||
+		mc.Status == valueobject.MorningCallStatusScheduled ||
 		mc.Status == valueobject.MorningCallStatusDelivered
 }
 
+// IsAwaitingDelivery はまだ配信されていない（承認待ちまたはスケジュール済み）かを判定する
+func (mc *MorningCall) IsAwaitingDelivery() bool {
+	if mc.IsDeleted() {
+		return false
+	}
+	return mc.Status == valueobject.MorningCallStatusPendingApproval ||
+		mc.Status == valueobject.MorningCallStatusScheduled
+}
+
 // IsPast はアラーム時刻が過去かを判定する
 func (mc *MorningCall) IsPast() bool {
 	return mc.ScheduledTime.Before(time.Now())
 }
 
 // ShouldDeliver は配信すべきかを判定する
+// 受信者がアラーム時刻をずらしている場合はずらした後の時刻で判定する
 func (mc *MorningCall) ShouldDeliver() bool {
-	return mc.Status == valueobject.MorningCallStatusScheduled && mc.IsPast()
+	return !mc.IsDeleted() && mc.Status == valueobject.MorningCallStatusScheduled && mc.EffectiveScheduledTime().Before(time.Now())
 }
 
 // Equals は他のモーニングコールと同一かを判定する
@@ -211,3 +936,75 @@ func (mc *MorningCall) Equals(other *MorningCall) bool {
 	}
 	return mc.ID == other.ID
 }
+
+// Clone はモーニングコールのディープコピーを返す
+// ポインタで保持するフィールドも複製するため、コピーへの変更は元のエンティティに影響しない
+func (mc *MorningCall) Clone() *MorningCall {
+	mcCopy := *mc
+	if mc.ConfirmLocation != nil {
+		loc := *mc.ConfirmLocation
+		mcCopy.ConfirmLocation = &loc
+	}
+	if mc.DeliveredAt != nil {
+		deliveredAt := *mc.DeliveredAt
+		mcCopy.DeliveredAt = &deliveredAt
+	}
+	if mc.StampAt != nil {
+		stampAt := *mc.StampAt
+		mcCopy.StampAt = &stampAt
+	}
+	if mc.HelpfulnessRating != nil {
+		rating := *mc.HelpfulnessRating
+		mcCopy.HelpfulnessRating = &rating
+	}
+	if mc.RatedAt != nil {
+		ratedAt := *mc.RatedAt
+		mcCopy.RatedAt = &ratedAt
+	}
+	if mc.ConfirmDeadline != nil {
+		deadline := *mc.ConfirmDeadline
+		mcCopy.ConfirmDeadline = &deadline
+	}
+	if mc.WakeChallenge != nil {
+		challenge := *mc.WakeChallenge
+		mcCopy.WakeChallenge = &challenge
+	}
+	if mc.TimeToConfirm != nil {
+		elapsed := *mc.TimeToConfirm
+		mcCopy.TimeToConfirm = &elapsed
+	}
+	if mc.MessageVariants != nil {
+		mcCopy.MessageVariants = append([]string(nil), mc.MessageVariants...)
+	}
+	if mc.MessageVariantIndex != nil {
+		index := *mc.MessageVariantIndex
+		mcCopy.MessageVariantIndex = &index
+	}
+	if mc.SilentDelivery != nil {
+		silent := *mc.SilentDelivery
+		mcCopy.SilentDelivery = &silent
+	}
+	if mc.Volume != nil {
+		volume := *mc.Volume
+		mcCopy.Volume = &volume
+	}
+	if mc.Vibrate != nil {
+		vibrate := *mc.Vibrate
+		mcCopy.Vibrate = &vibrate
+	}
+	if mc.ArchivedAt != nil {
+		archivedAt := *mc.ArchivedAt
+		mcCopy.ArchivedAt = &archivedAt
+	}
+	if mc.DeletedAt != nil {
+		deletedAt := *mc.DeletedAt
+		mcCopy.DeletedAt = &deletedAt
+	}
+	if mc.RescheduleRequest != nil {
+		mcCopy.RescheduleRequest = mc.RescheduleRequest.clone()
+	}
+	if mc.NextCallRequest != nil {
+		mcCopy.NextCallRequest = mc.NextCallRequest.clone()
+	}
+	return &mcCopy
+}
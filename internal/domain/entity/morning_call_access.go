@@ -0,0 +1,11 @@
+package entity
+
+import "time"
+
+// MorningCallAccess はモーニングコールの詳細が閲覧（単一取得）された記録
+type MorningCallAccess struct {
+	MorningCallID string
+	ViewerID      string    // 閲覧したユーザーのID
+	Allowed       bool      // falseは権限がなく拒否された閲覧試行
+	AccessedAt    time.Time // 閲覧された日時
+}
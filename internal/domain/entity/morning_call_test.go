@@ -115,7 +115,7 @@ func TestNewMorningCall(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mc, reason := NewMorningCall(tt.id, tt.senderID, tt.receiverID, tt.scheduledTime, tt.message)
+			mc, reason := NewMorningCall(tt.id, tt.senderID, tt.receiverID, tt.scheduledTime, tt.message, valueobject.DefaultInputLimits())
 
 			if tt.expectError {
 				if reason.IsOK() {
@@ -155,6 +155,55 @@ func TestNewMorningCall(t *testing.T) {
 	}
 }
 
+func TestNewMorningCall_InputLimits(t *testing.T) {
+	limits := valueobject.DefaultInputLimits()
+	limits.MessageMaxLength = 5
+	scheduled := time.Now().Add(time.Hour)
+
+	if _, reason := NewMorningCall("mc1", "sender", "receiver", scheduled, "おはようございます", limits); reason.Error() != "メッセージは5文字以内で入力してください" {
+		t.Errorf("NewMorningCall() reason = %q, want message length error", reason)
+	}
+	if _, reason := NewMorningCall("mc1", "sender", "receiver", scheduled, "おはよう！", limits); reason.IsNG() {
+		t.Errorf("NewMorningCall() reason = %q, want OK", reason)
+	}
+
+	// 制限を緩めるとデフォルトの上限を超えるメッセージも許可される
+	limits.MessageMaxLength = 1000
+	if _, reason := NewMorningCall("mc1", "sender", "receiver", scheduled, strings.Repeat("あ", 600), limits); reason.IsNG() {
+		t.Errorf("NewMorningCall() reason = %q, want OK", reason)
+	}
+}
+
+func TestMorningCall_ValidateSenderReceiver_SelfCall(t *testing.T) {
+	tests := []struct {
+		name       string
+		receiverID string
+		selfCall   bool
+		wantErr    string
+	}{
+		{name: "セルフコールは自分自身に設定できる", receiverID: "user-001", selfCall: true},
+		{name: "セルフコールの受信者が他人", receiverID: "user-002", selfCall: true, wantErr: "セルフモーニングコールの受信者は送信者自身である必要があります"},
+		{name: "フラグなしの自己送信は禁止", receiverID: "user-001", wantErr: "自分自身にモーニングコールを設定することはできません"},
+		{name: "通常のコール", receiverID: "user-002"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &MorningCall{SenderID: "user-001", ReceiverID: tt.receiverID, SelfCall: tt.selfCall}
+			reason := mc.ValidateSenderReceiver()
+			if tt.wantErr == "" {
+				if reason.IsNG() {
+					t.Errorf("ValidateSenderReceiver() = %q, want OK", reason)
+				}
+				return
+			}
+			if !reason.IsNG() || !strings.Contains(reason.Error(), tt.wantErr) {
+				t.Errorf("ValidateSenderReceiver() = %q, want %q", reason, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestMorningCall_ValidateScheduledTime(t *testing.T) {
 	now := time.Now()
 
@@ -251,6 +300,40 @@ func TestMorningCall_ValidateMessage(t *testing.T) {
 			expectError: true,
 			errorMsg:    "メッセージは500文字以内で入力してください",
 		},
+		{
+			name:        "サロゲートペア文字500文字ちょうど",
+			message:     strings.Repeat("𠮷", 500),
+			expectError: false,
+		},
+		{
+			name:        "サロゲートペア文字501文字",
+			message:     strings.Repeat("𠮷", 501),
+			expectError: true,
+			errorMsg:    "メッセージは500文字以内で入力してください",
+		},
+		{
+			name:        "絵文字500文字ちょうど",
+			message:     strings.Repeat("😀", 500),
+			expectError: false,
+		},
+		{
+			name:        "絵文字と文字の混在で501文字",
+			message:     strings.Repeat("😀", 250) + strings.Repeat("あ", 251),
+			expectError: true,
+			errorMsg:    "メッセージは500文字以内で入力してください",
+		},
+		{
+			// 表示用の整形でタグが除去されても、文字数は保存する元のテキストで判定する
+			name:        "除去されるタグを含めて501文字",
+			message:     "<b>" + strings.Repeat("あ", 494) + "</b>",
+			expectError: true,
+			errorMsg:    "メッセージは500文字以内で入力してください",
+		},
+		{
+			name:        "強調記法を含めて500文字ちょうど",
+			message:     "*" + strings.Repeat("あ", 498) + "*",
+			expectError: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -258,7 +341,7 @@ func TestMorningCall_ValidateMessage(t *testing.T) {
 			mc := &MorningCall{
 				Message: tt.message,
 			}
-			reason := mc.ValidateMessage()
+			reason := mc.ValidateMessage(valueobject.DefaultInputLimits())
 
 			if tt.expectError {
 				if reason.IsOK() {
@@ -276,6 +359,29 @@ func TestMorningCall_ValidateMessage(t *testing.T) {
 	}
 }
 
+func TestCountMessageLength(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    int
+	}{
+		{name: "空文字", message: "", want: 0},
+		{name: "ASCII", message: "hello", want: 5},
+		{name: "ひらがな", message: "おはよう", want: 4},
+		{name: "サロゲートペア文字", message: "𠮷野家", want: 3},
+		{name: "絵文字", message: "起きて😀⏰", want: 5},
+		{name: "ZWJ連結絵文字はコードポイント単位", message: "👨‍👩‍👧", want: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CountMessageLength(tt.message); got != tt.want {
+				t.Errorf("CountMessageLength(%q) = %d, want %d", tt.message, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestMorningCall_UpdateStatus(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -382,6 +488,9 @@ func TestMorningCall_StatusTransitionMethods(t *testing.T) {
 		if mc.Status != valueobject.MorningCallStatusDelivered {
 			t.Errorf("ステータスがDeliveredになるべき")
 		}
+		if mc.DeliveredAt == nil || !mc.DeliveredAt.Equal(mc.UpdatedAt) {
+			t.Errorf("配信日時が記録されるべき: %v", mc.DeliveredAt)
+		}
 	})
 
 	t.Run("ConfirmWakeUp", func(t *testing.T) {
@@ -409,6 +518,306 @@ func TestMorningCall_StatusTransitionMethods(t *testing.T) {
 			t.Errorf("ステータスがExpiredになるべき")
 		}
 	})
+
+	t.Run("Skip", func(t *testing.T) {
+		mc := &MorningCall{
+			Status: valueobject.MorningCallStatusDelivered,
+		}
+		reason := mc.Skip()
+		if reason.IsNG() {
+			t.Errorf("スキップに失敗: %s", reason.Error())
+		}
+		if mc.Status != valueobject.MorningCallStatusSkipped {
+			t.Errorf("ステータスがSkippedになるべき")
+		}
+		if reason := mc.ConfirmWakeUp(); reason.IsOK() {
+			t.Errorf("スキップ後は起床確認できないべき")
+		}
+	})
+}
+
+func TestMorningCall_CancelBySender(t *testing.T) {
+	deliveredAt := time.Date(2026, 3, 15, 7, 0, 0, 0, time.UTC)
+	grace := time.Minute
+
+	tests := []struct {
+		name     string
+		status   valueobject.MorningCallStatus
+		elapsed  time.Duration
+		grace    time.Duration
+		wantCode valueobject.ReasonCode // 空の場合は成功
+	}{
+		{name: "スケジュール済みは猶予に関係なくキャンセルできる", status: valueobject.MorningCallStatusScheduled, elapsed: time.Hour, grace: grace},
+		{name: "配信直後はキャンセルできる", status: valueobject.MorningCallStatusDelivered, elapsed: 0, grace: grace},
+		{name: "猶予期間ちょうどはキャンセルできる", status: valueobject.MorningCallStatusDelivered, elapsed: grace, grace: grace},
+		{name: "猶予期間を過ぎるとキャンセルできない", status: valueobject.MorningCallStatusDelivered, elapsed: grace + time.Nanosecond, grace: grace, wantCode: valueobject.ReasonCodeExpired},
+		{name: "猶予期間が無効", status: valueobject.MorningCallStatusDelivered, elapsed: 0, grace: 0, wantCode: valueobject.ReasonCodeInvalidState},
+		{name: "起床確認済みはキャンセルできない", status: valueobject.MorningCallStatusConfirmed, elapsed: time.Second, grace: grace, wantCode: valueobject.ReasonCodeInvalidState},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at := deliveredAt
+			mc := &MorningCall{Status: tt.status, DeliveredAt: &at}
+
+			reason := mc.CancelBySender(deliveredAt.Add(tt.elapsed), tt.grace)
+			if tt.wantCode == "" {
+				if reason.IsNG() {
+					t.Fatalf("キャンセルに失敗: %s", reason.Error())
+				}
+				if mc.Status != valueobject.MorningCallStatusCancelled {
+					t.Errorf("ステータスがCancelledになるべき: %s", mc.Status)
+				}
+				return
+			}
+			if !reason.IsNG() || reason.Code() != tt.wantCode {
+				t.Errorf("CancelBySender() = %v (%s), want code %s", reason, reason.Code(), tt.wantCode)
+			}
+			if mc.Status != tt.status {
+				t.Errorf("失敗時はステータスを変更しないべき: %s", mc.Status)
+			}
+		})
+	}
+}
+
+func TestMorningCall_ConfirmWakeUpWithLocation(t *testing.T) {
+	tokyo := &valueobject.GeoPoint{Latitude: 35.681236, Longitude: 139.767125}
+
+	tests := []struct {
+		name         string
+		location     *valueobject.GeoPoint
+		share        bool
+		wantNG       bool
+		errMsg       string
+		wantLocation bool
+	}{
+		{
+			name:         "位置情報なし",
+			location:     nil,
+			share:        true,
+			wantLocation: false,
+		},
+		{
+			name:         "位置情報あり（非公開）",
+			location:     tokyo,
+			share:        false,
+			wantLocation: true,
+		},
+		{
+			name:         "位置情報あり（公開）",
+			location:     tokyo,
+			share:        true,
+			wantLocation: true,
+		},
+		{
+			name:     "緯度が範囲外",
+			location: &valueobject.GeoPoint{Latitude: 100, Longitude: 0},
+			wantNG:   true,
+			errMsg:   "緯度は-90から90の範囲で指定してください",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &MorningCall{
+				SenderID:   "sender",
+				ReceiverID: "receiver",
+				Status:     valueobject.MorningCallStatusDelivered,
+			}
+
+			reason := mc.ConfirmWakeUpWithLocation(tt.location, tt.share)
+
+			if tt.wantNG {
+				if reason.IsOK() {
+					t.Fatal("NGが期待されたがOKが返された")
+				}
+				if reason.Error() != tt.errMsg {
+					t.Errorf("エラーメッセージ = %q, want %q", reason.Error(), tt.errMsg)
+				}
+				if mc.Status != valueobject.MorningCallStatusDelivered {
+					t.Error("検証失敗時はステータスを変更すべきでない")
+				}
+				return
+			}
+
+			if reason.IsNG() {
+				t.Fatalf("予期しないNG: %s", reason)
+			}
+			if mc.Status != valueobject.MorningCallStatusConfirmed {
+				t.Errorf("ステータスがConfirmedになるべき")
+			}
+			if (mc.ConfirmLocation != nil) != tt.wantLocation {
+				t.Fatalf("ConfirmLocation = %v, wantLocation %v", mc.ConfirmLocation, tt.wantLocation)
+			}
+			if tt.wantLocation {
+				if mc.ConfirmLocation == tt.location {
+					t.Error("位置情報はコピーして保持すべき")
+				}
+				if mc.ConfirmLocationShared != tt.share {
+					t.Errorf("ConfirmLocationShared = %v, want %v", mc.ConfirmLocationShared, tt.share)
+				}
+			}
+		})
+	}
+}
+
+func TestMorningCall_ConfirmLocationFor(t *testing.T) {
+	location := &valueobject.GeoPoint{Latitude: 35.0, Longitude: 135.0}
+
+	tests := []struct {
+		name    string
+		shared  bool
+		viewer  string
+		visible bool
+	}{
+		{name: "受信者は非公開でも閲覧できる", shared: false, viewer: "receiver", visible: true},
+		{name: "送信者は非公開なら閲覧できない", shared: false, viewer: "sender", visible: false},
+		{name: "送信者は公開なら閲覧できる", shared: true, viewer: "sender", visible: true},
+		{name: "第三者は公開でも閲覧できない", shared: true, viewer: "other", visible: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc := *location
+			mc := &MorningCall{
+				SenderID:              "sender",
+				ReceiverID:            "receiver",
+				ConfirmLocation:       &loc,
+				ConfirmLocationShared: tt.shared,
+			}
+
+			got := mc.ConfirmLocationFor(tt.viewer)
+			if (got != nil) != tt.visible {
+				t.Errorf("ConfirmLocationFor(%s) = %v, visible %v", tt.viewer, got, tt.visible)
+			}
+		})
+	}
+
+	t.Run("位置情報がない場合はnil", func(t *testing.T) {
+		mc := &MorningCall{SenderID: "sender", ReceiverID: "receiver"}
+		if got := mc.ConfirmLocationFor("receiver"); got != nil {
+			t.Errorf("ConfirmLocationFor = %v, want nil", got)
+		}
+	})
+}
+
+func TestMorningCall_SetStamp(t *testing.T) {
+	tests := []struct {
+		name   string
+		status valueobject.MorningCallStatus
+		stamp  valueobject.Stamp
+		wantNG bool
+		errMsg string
+	}{
+		{name: "確認済みにスタンプを設定", status: valueobject.MorningCallStatusConfirmed, stamp: valueobject.StampHeart},
+		{name: "無効なスタンプ", status: valueobject.MorningCallStatusConfirmed, stamp: valueobject.Stamp("x"), wantNG: true, errMsg: "無効なスタンプです"},
+		{name: "配信済みには設定できない", status: valueobject.MorningCallStatusDelivered, stamp: valueobject.StampHeart, wantNG: true, errMsg: "起床確認済みのモーニングコールにのみスタンプを送れます"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &MorningCall{Status: tt.status}
+			reason := mc.SetStamp(tt.stamp)
+
+			if tt.wantNG {
+				if reason.Error() != tt.errMsg {
+					t.Errorf("エラーメッセージ = %q, want %q", reason.Error(), tt.errMsg)
+				}
+				if mc.Stamp != "" || mc.StampAt != nil {
+					t.Error("NGの場合はスタンプを設定すべきでない")
+				}
+				return
+			}
+
+			if reason.IsNG() {
+				t.Fatalf("予期しないNG: %s", reason)
+			}
+			if mc.Stamp != tt.stamp || mc.StampAt == nil {
+				t.Errorf("Stamp = %v, StampAt = %v", mc.Stamp, mc.StampAt)
+			}
+		})
+	}
+}
+
+func TestMorningCall_SetConfirmMethod(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        valueobject.MorningCallStatus
+		autoConfirmed bool
+		method        valueobject.ConfirmMethod
+		want          valueobject.ConfirmMethod
+		errMsg        string
+	}{
+		{name: "スワイプを記録", status: valueobject.MorningCallStatusConfirmed, method: valueobject.ConfirmMethodSwipe, want: valueobject.ConfirmMethodSwipe},
+		{name: "未指定はボタン扱い", status: valueobject.MorningCallStatusConfirmed, want: valueobject.ConfirmMethodButton},
+		{name: "無効な確認方法", status: valueobject.MorningCallStatusConfirmed, method: valueobject.ConfirmMethod("voice"), errMsg: "無効な確認方法です"},
+		{name: "配信済みには記録できない", status: valueobject.MorningCallStatusDelivered, method: valueobject.ConfirmMethodShake, errMsg: "起床確認済みのモーニングコールにのみ確認方法を記録できます"},
+		{name: "自動確認には記録できない", status: valueobject.MorningCallStatusConfirmed, autoConfirmed: true, method: valueobject.ConfirmMethodButton, errMsg: "起床確認済みのモーニングコールにのみ確認方法を記録できます"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &MorningCall{Status: tt.status, AutoConfirmed: tt.autoConfirmed}
+			reason := mc.SetConfirmMethod(tt.method)
+
+			if tt.errMsg != "" {
+				if reason.Error() != tt.errMsg {
+					t.Errorf("エラーメッセージ = %q, want %q", reason.Error(), tt.errMsg)
+				}
+				if mc.ConfirmMethod != "" {
+					t.Error("NGの場合は確認方法を記録すべきでない")
+				}
+				return
+			}
+
+			if reason.IsNG() {
+				t.Fatalf("予期しないNG: %s", reason)
+			}
+			if mc.ConfirmMethod != tt.want {
+				t.Errorf("ConfirmMethod = %q, want %q", mc.ConfirmMethod, tt.want)
+			}
+		})
+	}
+}
+
+func TestMorningCall_SetHelpfulnessRating(t *testing.T) {
+	tests := []struct {
+		name   string
+		status valueobject.MorningCallStatus
+		rating int
+		wantNG bool
+		errMsg string
+	}{
+		{name: "確認済みに最小の評価を設定", status: valueobject.MorningCallStatusConfirmed, rating: MinHelpfulnessRating},
+		{name: "確認済みに最大の評価を設定", status: valueobject.MorningCallStatusConfirmed, rating: MaxHelpfulnessRating},
+		{name: "評価が範囲より小さい", status: valueobject.MorningCallStatusConfirmed, rating: 0, wantNG: true, errMsg: "評価は1から5の範囲で指定してください"},
+		{name: "評価が範囲より大きい", status: valueobject.MorningCallStatusConfirmed, rating: 6, wantNG: true, errMsg: "評価は1から5の範囲で指定してください"},
+		{name: "配信済みには設定できない", status: valueobject.MorningCallStatusDelivered, rating: 3, wantNG: true, errMsg: "起床確認済みのモーニングコールにのみ評価を付けられます"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &MorningCall{Status: tt.status}
+			reason := mc.SetHelpfulnessRating(tt.rating)
+
+			if tt.wantNG {
+				if reason.Error() != tt.errMsg {
+					t.Errorf("エラーメッセージ = %q, want %q", reason.Error(), tt.errMsg)
+				}
+				if mc.HelpfulnessRating != nil || mc.RatedAt != nil {
+					t.Error("NGの場合は評価を設定すべきでない")
+				}
+				return
+			}
+
+			if reason.IsNG() {
+				t.Fatalf("予期しないNG: %s", reason)
+			}
+			if mc.HelpfulnessRating == nil || *mc.HelpfulnessRating != tt.rating || mc.RatedAt == nil {
+				t.Errorf("HelpfulnessRating = %v, RatedAt = %v", mc.HelpfulnessRating, mc.RatedAt)
+			}
+		})
+	}
 }
 
 func TestMorningCall_UpdateMessage(t *testing.T) {
@@ -458,7 +867,7 @@ func TestMorningCall_UpdateMessage(t *testing.T) {
 			}
 
 			oldUpdatedAt := mc.UpdatedAt
-			reason := mc.UpdateMessage(tt.newMessage)
+			reason := mc.UpdateMessage(tt.newMessage, valueobject.DefaultInputLimits())
 
 			if tt.expectError {
 				if reason.IsOK() {
@@ -590,6 +999,16 @@ func TestMorningCall_IsActive(t *testing.T) {
 			status:   valueobject.MorningCallStatusDelivered,
 			expected: true,
 		},
+		{
+			name:     "承認待ちはアクティブ",
+			status:   valueobject.MorningCallStatusPendingApproval,
+			expected: true,
+		},
+		{
+			name:     "拒否済みは非アクティブ",
+			status:   valueobject.MorningCallStatusRejected,
+			expected: false,
+		},
 		{
 			name:     "確認済みは非アクティブ",
 			status:   valueobject.MorningCallStatusConfirmed,
@@ -619,6 +1038,61 @@ func TestMorningCall_IsActive(t *testing.T) {
 	}
 }
 
+func TestMorningCall_ApproveAndReject(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     valueobject.MorningCallStatus
+		approve    bool
+		wantStatus valueobject.MorningCallStatus
+		wantNG     bool
+	}{
+		{
+			name:       "承認待ちを承認",
+			status:     valueobject.MorningCallStatusPendingApproval,
+			approve:    true,
+			wantStatus: valueobject.MorningCallStatusScheduled,
+		},
+		{
+			name:       "承認待ちを拒否",
+			status:     valueobject.MorningCallStatusPendingApproval,
+			wantStatus: valueobject.MorningCallStatusRejected,
+		},
+		{
+			name:       "スケジュール済みは承認できない",
+			status:     valueobject.MorningCallStatusScheduled,
+			approve:    true,
+			wantStatus: valueobject.MorningCallStatusScheduled,
+			wantNG:     true,
+		},
+		{
+			name:       "スケジュール済みは拒否できない",
+			status:     valueobject.MorningCallStatusScheduled,
+			wantStatus: valueobject.MorningCallStatusScheduled,
+			wantNG:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &MorningCall{Status: tt.status}
+
+			var reason valueobject.NGReason
+			if tt.approve {
+				reason = mc.Approve()
+			} else {
+				reason = mc.Reject()
+			}
+
+			if reason.IsNG() != tt.wantNG {
+				t.Errorf("NG = %v, want %v (reason: %s)", reason.IsNG(), tt.wantNG, reason)
+			}
+			if mc.Status != tt.wantStatus {
+				t.Errorf("Status = %v, want %v", mc.Status, tt.wantStatus)
+			}
+		})
+	}
+}
+
 func TestMorningCall_IsPast(t *testing.T) {
 	now := time.Now()
 
@@ -651,65 +1125,287 @@ func TestMorningCall_IsPast(t *testing.T) {
 	}
 }
 
-func TestMorningCall_ShouldDeliver(t *testing.T) {
-	now := time.Now()
+func TestMorningCall_ValidateConfirmDeadline(t *testing.T) {
+	scheduledTime := time.Now().Add(1 * time.Hour)
+	after := scheduledTime.Add(30 * time.Minute)
+	before := scheduledTime.Add(-30 * time.Minute)
 
 	tests := []struct {
-		name          string
-		status        valueobject.MorningCallStatus
-		scheduledTime time.Time
-		expected      bool
+		name     string
+		deadline *time.Time
+		expected valueobject.NGReason
 	}{
 		{
-			name:          "スケジュール済み＆過去",
-			status:        valueobject.MorningCallStatusScheduled,
-			scheduledTime: now.Add(-1 * time.Hour),
-			expected:      true,
+			name:     "期限未指定",
+			deadline: nil,
+			expected: valueobject.OK(),
 		},
 		{
-			name:          "スケジュール済み＆未来",
-			status:        valueobject.MorningCallStatusScheduled,
-			scheduledTime: now.Add(1 * time.Hour),
-			expected:      false,
+			name:     "アラーム時刻より後の期限",
+			deadline: &after,
+			expected: valueobject.OK(),
 		},
 		{
-			name:          "配信済み＆過去",
-			status:        valueobject.MorningCallStatusDelivered,
-			scheduledTime: now.Add(-1 * time.Hour),
-			expected:      false,
+			name:     "アラーム時刻と同じ期限",
+			deadline: &scheduledTime,
+			expected: valueobject.NGWithCode(valueobject.ReasonCodeOutOfRange, "confirm_deadline", "起床確認の期限はアラーム時刻より後である必要があります"),
 		},
 		{
-			name:          "キャンセル済み＆過去",
-			status:        valueobject.MorningCallStatusCancelled,
-			scheduledTime: now.Add(-1 * time.Hour),
-			expected:      false,
+			name:     "アラーム時刻より前の期限",
+			deadline: &before,
+			expected: valueobject.NGWithCode(valueobject.ReasonCodeOutOfRange, "confirm_deadline", "起床確認の期限はアラーム時刻より後である必要があります"),
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mc := &MorningCall{
-				Status:        tt.status,
-				ScheduledTime: tt.scheduledTime,
+				ScheduledTime:   scheduledTime,
+				ConfirmDeadline: tt.deadline,
 			}
-			if got := mc.ShouldDeliver(); got != tt.expected {
-				t.Errorf("ShouldDeliver() = %v, expected %v", got, tt.expected)
+			if got := mc.ValidateConfirmDeadline(); got != tt.expected {
+				t.Errorf("ValidateConfirmDeadline() = %v, expected %v", got, tt.expected)
 			}
 		})
 	}
 }
 
-func TestMorningCall_Equals(t *testing.T) {
-	mc1 := &MorningCall{
-		ID:         "mc-001",
-		SenderID:   "user-001",
-		ReceiverID: "user-002",
-	}
+func TestMorningCall_IsConfirmDeadlinePassed(t *testing.T) {
+	deadline := time.Date(2025, 1, 1, 8, 0, 0, 0, time.UTC)
 
-	mc2 := &MorningCall{
-		ID:         "mc-001",
-		SenderID:   "user-003",
-		ReceiverID: "user-004",
+	tests := []struct {
+		name     string
+		deadline *time.Time
+		now      time.Time
+		expected bool
+	}{
+		{
+			name:     "期限未指定",
+			deadline: nil,
+			now:      deadline.Add(24 * time.Hour),
+			expected: false,
+		},
+		{
+			name:     "期限前",
+			deadline: &deadline,
+			now:      deadline.Add(-1 * time.Second),
+			expected: false,
+		},
+		{
+			name:     "期限ちょうど",
+			deadline: &deadline,
+			now:      deadline,
+			expected: false,
+		},
+		{
+			name:     "期限後",
+			deadline: &deadline,
+			now:      deadline.Add(1 * time.Second),
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &MorningCall{
+				ConfirmDeadline: tt.deadline,
+			}
+			if got := mc.IsConfirmDeadlinePassed(tt.now); got != tt.expected {
+				t.Errorf("IsConfirmDeadlinePassed() = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMorningCall_ShouldDeliver(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name          string
+		status        valueobject.MorningCallStatus
+		scheduledTime time.Time
+		offsetMinutes int
+		expected      bool
+	}{
+		{
+			name:          "スケジュール済み＆過去",
+			status:        valueobject.MorningCallStatusScheduled,
+			scheduledTime: now.Add(-1 * time.Hour),
+			expected:      true,
+		},
+		{
+			name:          "スケジュール済み＆未来",
+			status:        valueobject.MorningCallStatusScheduled,
+			scheduledTime: now.Add(1 * time.Hour),
+			expected:      false,
+		},
+		{
+			name:          "配信済み＆過去",
+			status:        valueobject.MorningCallStatusDelivered,
+			scheduledTime: now.Add(-1 * time.Hour),
+			expected:      false,
+		},
+		{
+			name:          "キャンセル済み＆過去",
+			status:        valueobject.MorningCallStatusCancelled,
+			scheduledTime: now.Add(-1 * time.Hour),
+			expected:      false,
+		},
+		{
+			name:          "予定時刻は過去だが受信者が遅らせている",
+			status:        valueobject.MorningCallStatusScheduled,
+			scheduledTime: now.Add(-10 * time.Minute),
+			offsetMinutes: 20,
+			expected:      false,
+		},
+		{
+			name:          "予定時刻は未来だが受信者が早めている",
+			status:        valueobject.MorningCallStatusScheduled,
+			scheduledTime: now.Add(10 * time.Minute),
+			offsetMinutes: -20,
+			expected:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &MorningCall{
+				Status:                tt.status,
+				ScheduledTime:         tt.scheduledTime,
+				ReceiverOffsetMinutes: tt.offsetMinutes,
+			}
+			if got := mc.ShouldDeliver(); got != tt.expected {
+				t.Errorf("ShouldDeliver() = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMorningCall_SetReceiverOffset(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      valueobject.MorningCallStatus
+		minutes     int
+		expectError bool
+	}{
+		{
+			name:        "遅らせる",
+			status:      valueobject.MorningCallStatusScheduled,
+			minutes:     15,
+			expectError: false,
+		},
+		{
+			name:        "早める",
+			status:      valueobject.MorningCallStatusScheduled,
+			minutes:     -15,
+			expectError: false,
+		},
+		{
+			name:        "上限ちょうど",
+			status:      valueobject.MorningCallStatusScheduled,
+			minutes:     MaxReceiverOffsetMinutes,
+			expectError: false,
+		},
+		{
+			name:        "下限ちょうど",
+			status:      valueobject.MorningCallStatusScheduled,
+			minutes:     -MaxReceiverOffsetMinutes,
+			expectError: false,
+		},
+		{
+			name:        "0で元に戻す",
+			status:      valueobject.MorningCallStatusScheduled,
+			minutes:     0,
+			expectError: false,
+		},
+		{
+			name:        "上限を超える",
+			status:      valueobject.MorningCallStatusScheduled,
+			minutes:     MaxReceiverOffsetMinutes + 1,
+			expectError: true,
+		},
+		{
+			name:        "下限を超える",
+			status:      valueobject.MorningCallStatusScheduled,
+			minutes:     -MaxReceiverOffsetMinutes - 1,
+			expectError: true,
+		},
+		{
+			name:        "配信済み",
+			status:      valueobject.MorningCallStatusDelivered,
+			minutes:     10,
+			expectError: true,
+		},
+		{
+			name:        "キャンセル済み",
+			status:      valueobject.MorningCallStatusCancelled,
+			minutes:     10,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &MorningCall{
+				Status:                tt.status,
+				ScheduledTime:         time.Now().Add(1 * time.Hour),
+				ReceiverOffsetMinutes: 5,
+			}
+
+			reason := mc.SetReceiverOffset(tt.minutes)
+			if tt.expectError {
+				if reason.IsOK() {
+					t.Error("エラーが期待されましたが、成功しました")
+				}
+				if mc.ReceiverOffsetMinutes != 5 {
+					t.Errorf("失敗時にずらし幅が変更されています: %d", mc.ReceiverOffsetMinutes)
+				}
+				return
+			}
+			if reason.IsNG() {
+				t.Errorf("成功が期待されましたが、エラーが発生しました: %s", reason)
+			}
+			if mc.ReceiverOffsetMinutes != tt.minutes {
+				t.Errorf("ReceiverOffsetMinutes = %d, expected %d", mc.ReceiverOffsetMinutes, tt.minutes)
+			}
+		})
+	}
+}
+
+func TestMorningCall_EffectiveScheduledTime(t *testing.T) {
+	scheduled := time.Date(2025, 1, 1, 7, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		offset   int
+		expected time.Time
+	}{
+		{name: "ずらしなし", offset: 0, expected: scheduled},
+		{name: "遅らせる", offset: 15, expected: scheduled.Add(15 * time.Minute)},
+		{name: "早める", offset: -30, expected: scheduled.Add(-30 * time.Minute)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &MorningCall{ScheduledTime: scheduled, ReceiverOffsetMinutes: tt.offset}
+			if got := mc.EffectiveScheduledTime(); !got.Equal(tt.expected) {
+				t.Errorf("EffectiveScheduledTime() = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMorningCall_Equals(t *testing.T) {
+	mc1 := &MorningCall{
+		ID:         "mc-001",
+		SenderID:   "user-001",
+		ReceiverID: "user-002",
+	}
+
+	mc2 := &MorningCall{
+		ID:         "mc-001",
+		SenderID:   "user-003",
+		ReceiverID: "user-004",
 	}
 
 	mc3 := &MorningCall{
@@ -759,3 +1455,604 @@ func TestMorningCall_Equals(t *testing.T) {
 		})
 	}
 }
+
+func TestMorningCall_ResolveSilentDelivery(t *testing.T) {
+	silent := true
+	audible := false
+
+	tests := []struct {
+		name            string
+		override        *bool
+		receiverDefault bool
+		want            bool
+	}{
+		{name: "どちらも未設定なら音を鳴らす", want: false},
+		{name: "受信者のデフォルトが無音", receiverDefault: true, want: true},
+		{name: "コールごとの無音がデフォルトより優先される", override: &silent, want: true},
+		{name: "コールごとの音ありがデフォルトの無音より優先される", override: &audible, receiverDefault: true, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &MorningCall{ID: "mc1", SenderID: "sender", ReceiverID: "receiver", SilentDelivery: tt.override}
+			receiver := &User{ID: "receiver", SilentDelivery: tt.receiverDefault}
+
+			if got := mc.ResolveSilentDelivery(receiver); got != tt.want {
+				t.Errorf("ResolveSilentDelivery() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("受信者以外のデフォルトは参照しない", func(t *testing.T) {
+		mc := &MorningCall{ID: "mc1", SenderID: "sender", ReceiverID: "receiver"}
+		if mc.ResolveSilentDelivery(&User{ID: "sender", SilentDelivery: true}) {
+			t.Error("ResolveSilentDelivery() = true, want false")
+		}
+	})
+
+	t.Run("常に音を鳴らすお気に入り送信元は受信者のデフォルトより優先される", func(t *testing.T) {
+		receiver := &User{ID: "receiver", SilentDelivery: true}
+		favorite := &valueobject.FavoriteSender{AlwaysRing: true}
+
+		mc := &MorningCall{ID: "mc1", SenderID: "sender", ReceiverID: "receiver"}
+		if mc.ResolveSilentDeliveryFrom(receiver, favorite) {
+			t.Error("ResolveSilentDeliveryFrom() = true, want false")
+		}
+		if !mc.ResolveSilentDeliveryFrom(receiver, &valueobject.FavoriteSender{}) {
+			t.Error("ResolveSilentDeliveryFrom() without AlwaysRing = false, want true")
+		}
+		// コールごとの設定はお気に入りの設定より優先される
+		mc.SilentDelivery = &silent
+		if !mc.ResolveSilentDeliveryFrom(receiver, favorite) {
+			t.Error("ResolveSilentDeliveryFrom() with per-call override = false, want true")
+		}
+	})
+}
+
+func TestMorningCall_SetSilentDelivery(t *testing.T) {
+	silent := true
+	mc := &MorningCall{ID: "mc1", Status: valueobject.MorningCallStatusScheduled}
+
+	if reason := mc.SetSilentDelivery(&silent); reason.IsNG() {
+		t.Fatalf("SetSilentDelivery() = %v, want OK", reason)
+	}
+	// 呼び出し側の変数を変更してもコールの設定は変わらない
+	silent = false
+	if mc.SilentDelivery == nil || !*mc.SilentDelivery {
+		t.Errorf("SilentDelivery = %v, want true", mc.SilentDelivery)
+	}
+
+	if reason := mc.SetSilentDelivery(nil); reason.IsNG() || mc.SilentDelivery != nil {
+		t.Errorf("SetSilentDelivery(nil) = %v, SilentDelivery = %v, want cleared", reason, mc.SilentDelivery)
+	}
+
+	mc.Status = valueobject.MorningCallStatusConfirmed
+	if reason := mc.SetSilentDelivery(&silent); reason.IsOK() {
+		t.Error("SetSilentDelivery() on a confirmed call should fail")
+	}
+}
+
+func TestMorningCall_ResolveDeliveryHints(t *testing.T) {
+	loud := 100
+	quiet := 20
+	vibrate := true
+	still := false
+
+	tests := []struct {
+		name            string
+		volume          *int
+		vibrate         *bool
+		silentOverride  *bool
+		receiverVolume  int
+		receiverVibrate bool
+		receiverSilent  bool
+		want            valueobject.DeliveryHints
+	}{
+		{name: "受信者のデフォルトに従う", receiverVolume: 60, receiverVibrate: true, want: valueobject.DeliveryHints{Volume: 60, Vibrate: true}},
+		{name: "コールごとの音量がデフォルトより優先される", volume: &loud, receiverVolume: 60, want: valueobject.DeliveryHints{Volume: 100}},
+		{name: "コールごとのバイブレーションがデフォルトより優先される", vibrate: &still, receiverVolume: 60, receiverVibrate: true, want: valueobject.DeliveryHints{Volume: 60}},
+		{name: "無音配信では音量を0にしバイブレーションは設定どおり", volume: &quiet, vibrate: &vibrate, receiverVolume: 60, receiverSilent: true, want: valueobject.DeliveryHints{Volume: 0, Vibrate: true}},
+		{name: "コールごとに音を鳴らす設定なら無音のデフォルトより音量が優先される", volume: &quiet, silentOverride: &still, receiverVolume: 60, receiverSilent: true, want: valueobject.DeliveryHints{Volume: 20}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &MorningCall{ID: "mc1", SenderID: "sender", ReceiverID: "receiver", Volume: tt.volume, Vibrate: tt.vibrate, SilentDelivery: tt.silentOverride}
+			receiver := &User{ID: "receiver", Volume: tt.receiverVolume, Vibrate: tt.receiverVibrate, SilentDelivery: tt.receiverSilent}
+
+			if got := mc.ResolveDeliveryHints(receiver); got != tt.want {
+				t.Errorf("ResolveDeliveryHints() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("受信者以外の設定は参照せずシステムのデフォルトに従う", func(t *testing.T) {
+		mc := &MorningCall{ID: "mc1", SenderID: "sender", ReceiverID: "receiver"}
+		want := valueobject.DeliveryHints{Volume: valueobject.DefaultVolume, Vibrate: true}
+		if got := mc.ResolveDeliveryHints(&User{ID: "sender", Volume: 10}); got != want {
+			t.Errorf("ResolveDeliveryHints() = %+v, want %+v", got, want)
+		}
+	})
+
+	t.Run("常に音を鳴らすお気に入り送信元は無音のデフォルトより優先される", func(t *testing.T) {
+		mc := &MorningCall{ID: "mc1", SenderID: "sender", ReceiverID: "receiver"}
+		receiver := &User{ID: "receiver", Volume: 70, SilentDelivery: true}
+		if got := mc.ResolveDeliveryHintsFrom(receiver, &valueobject.FavoriteSender{AlwaysRing: true}); got.Volume != 70 {
+			t.Errorf("ResolveDeliveryHintsFrom().Volume = %d, want 70", got.Volume)
+		}
+	})
+}
+
+func TestMorningCall_SetDeliveryHints(t *testing.T) {
+	volume := 30
+	vibrate := false
+	mc := &MorningCall{ID: "mc1", Status: valueobject.MorningCallStatusScheduled}
+
+	if reason := mc.SetDeliveryHints(&volume, &vibrate); reason.IsNG() {
+		t.Fatalf("SetDeliveryHints() = %v, want OK", reason)
+	}
+	// 呼び出し側の変数を変更してもコールの設定は変わらない
+	volume = 90
+	if mc.Volume == nil || *mc.Volume != 30 || mc.Vibrate == nil || *mc.Vibrate {
+		t.Errorf("(Volume, Vibrate) = (%v, %v), want (30, false)", mc.Volume, mc.Vibrate)
+	}
+
+	if reason := mc.SetDeliveryHints(nil, nil); reason.IsNG() || mc.Volume != nil || mc.Vibrate != nil {
+		t.Errorf("SetDeliveryHints(nil, nil) = %v, want cleared", reason)
+	}
+
+	outOfRange := valueobject.MaxVolume + 1
+	if reason := mc.SetDeliveryHints(&outOfRange, nil); reason.Code() != valueobject.ReasonCodeOutOfRange {
+		t.Errorf("SetDeliveryHints() code = %v, want %v", reason.Code(), valueobject.ReasonCodeOutOfRange)
+	}
+
+	mc.Status = valueobject.MorningCallStatusConfirmed
+	if reason := mc.SetDeliveryHints(&volume, nil); reason.IsOK() {
+		t.Error("SetDeliveryHints() on a confirmed call should fail")
+	}
+}
+
+func TestMorningCall_AutoConfirm(t *testing.T) {
+	mc := &MorningCall{ID: "mc1", SenderID: "sender", ReceiverID: "receiver", Status: valueobject.MorningCallStatusScheduled}
+
+	if reason := mc.AutoConfirm(); reason.IsNG() {
+		t.Fatalf("AutoConfirm() = %v, want OK", reason)
+	}
+	if mc.Status != valueobject.MorningCallStatusConfirmed {
+		t.Errorf("Status = %s, want confirmed", mc.Status)
+	}
+	if !mc.AutoConfirmed {
+		t.Error("AutoConfirmed = false, want true")
+	}
+	if mc.ConfirmedBy != "receiver" {
+		t.Errorf("ConfirmedBy = %s, want receiver", mc.ConfirmedBy)
+	}
+
+	// 配信済みのコールは受信者が確認するため自動確認できない
+	delivered := &MorningCall{ID: "mc2", Status: valueobject.MorningCallStatusDelivered}
+	if reason := delivered.AutoConfirm(); reason.IsOK() {
+		t.Error("AutoConfirm() on a delivered call should fail")
+	}
+	if delivered.AutoConfirmed {
+		t.Error("AutoConfirmed should remain false on failure")
+	}
+
+	// 起床クイズ付きのコールは受信者の回答が必要なため自動確認できない
+	challenged := &MorningCall{
+		ID:            "mc3",
+		Status:        valueobject.MorningCallStatusScheduled,
+		WakeChallenge: &valueobject.Challenge{Difficulty: valueobject.ChallengeDifficultyEasy, Question: "1 + 1", Answer: 2},
+	}
+	if reason := challenged.AutoConfirm(); reason.IsOK() {
+		t.Error("AutoConfirm() on a call with a wake challenge should fail")
+	}
+}
+
+func TestMorningCall_DeleteByAdmin(t *testing.T) {
+	now := time.Date(2025, 1, 10, 7, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		adminID  string
+		reason   string
+		errorMsg string
+	}{
+		{name: "削除理由を記録して削除済みにする", adminID: "admin", reason: "規約違反"},
+		{name: "管理者IDは必須", adminID: "", reason: "規約違反", errorMsg: "削除する管理者のIDは必須です"},
+		{name: "削除理由は必須", adminID: "admin", reason: " ", errorMsg: "削除理由は必須です"},
+		{name: "削除理由の文字数上限", adminID: "admin", reason: strings.Repeat("あ", MaxDeletionReasonLength+1), errorMsg: "削除理由は500文字以内で入力してください"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &MorningCall{ID: "mc1", Status: valueobject.MorningCallStatusDelivered}
+			reason := mc.DeleteByAdmin(tt.adminID, tt.reason, now)
+
+			if tt.errorMsg != "" {
+				if reason.Error() != tt.errorMsg {
+					t.Errorf("期待されたエラーメッセージ: %s, 実際: %s", tt.errorMsg, reason.Error())
+				}
+				if mc.IsDeleted() {
+					t.Error("failed deletion should not mark the call as deleted")
+				}
+				return
+			}
+			if reason.IsNG() {
+				t.Fatalf("予期しないエラー: %v", reason)
+			}
+			if !mc.IsDeleted() || mc.DeletedBy != tt.adminID || mc.DeletionReason != tt.reason {
+				t.Errorf("mc = %+v, want deleted by %s", mc, tt.adminID)
+			}
+			// ステータスは履歴として保持される
+			if mc.Status != valueobject.MorningCallStatusDelivered {
+				t.Errorf("Status = %s, want delivered", mc.Status)
+			}
+			if reason := mc.DeleteByAdmin(tt.adminID, tt.reason, now); reason.IsOK() {
+				t.Error("deleting an already deleted call should fail")
+			}
+			if reason := mc.ConfirmWakeUp(); reason.IsOK() {
+				t.Error("ConfirmWakeUp() on a deleted call should fail")
+			}
+		})
+	}
+}
+
+func TestMorningCall_Archive(t *testing.T) {
+	now := time.Date(2025, 1, 10, 7, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		status   valueobject.MorningCallStatus
+		archived bool
+		deleted  bool
+		errorMsg string
+	}{
+		{name: "確認済みのコールをアーカイブ", status: valueobject.MorningCallStatusConfirmed},
+		{name: "期限切れのコールをアーカイブ", status: valueobject.MorningCallStatusExpired},
+		{name: "キャンセル済みのコールをアーカイブ", status: valueobject.MorningCallStatusCancelled},
+		{name: "配信待ちのコールはアーカイブできない", status: valueobject.MorningCallStatusScheduled, errorMsg: "終了したモーニングコールのみアーカイブできます"},
+		{name: "配信済みのコールはアーカイブできない", status: valueobject.MorningCallStatusDelivered, errorMsg: "終了したモーニングコールのみアーカイブできます"},
+		{name: "アーカイブ済み", status: valueobject.MorningCallStatusConfirmed, archived: true, errorMsg: "既にアーカイブされたモーニングコールです"},
+		{name: "削除済み", status: valueobject.MorningCallStatusConfirmed, deleted: true, errorMsg: "削除されたモーニングコールは変更できません"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &MorningCall{ID: "mc1", Status: tt.status, Archived: tt.archived}
+			if tt.deleted {
+				mc.DeleteByAdmin("admin", "規約違反", now)
+			}
+			reason := mc.Archive(now)
+
+			if tt.errorMsg != "" {
+				if reason.Error() != tt.errorMsg {
+					t.Errorf("期待されたエラーメッセージ: %s, 実際: %s", tt.errorMsg, reason.Error())
+				}
+				return
+			}
+			if reason.IsNG() {
+				t.Fatalf("予期しないエラー: %v", reason)
+			}
+			if !mc.Archived || mc.ArchivedAt == nil || !mc.ArchivedAt.Equal(now) {
+				t.Errorf("Archived = %v, ArchivedAt = %v, want archived at %v", mc.Archived, mc.ArchivedAt, now)
+			}
+			// ステータスは変更しない
+			if mc.Status != tt.status {
+				t.Errorf("Status = %s, want %s", mc.Status, tt.status)
+			}
+		})
+	}
+}
+
+func TestMorningCall_Unarchive(t *testing.T) {
+	now := time.Date(2025, 1, 10, 7, 0, 0, 0, time.UTC)
+	mc := &MorningCall{ID: "mc1", Status: valueobject.MorningCallStatusConfirmed}
+
+	if reason := mc.Unarchive(now); reason.Error() != "アーカイブされていないモーニングコールです" {
+		t.Errorf("Unarchive() on a non-archived call = %v", reason)
+	}
+	if reason := mc.Archive(now); reason.IsNG() {
+		t.Fatalf("Archive() unexpected error: %v", reason)
+	}
+	if reason := mc.Unarchive(now.Add(time.Hour)); reason.IsNG() {
+		t.Fatalf("Unarchive() unexpected error: %v", reason)
+	}
+	if mc.Archived || mc.ArchivedAt != nil {
+		t.Errorf("Archived = %v, ArchivedAt = %v, want unarchived", mc.Archived, mc.ArchivedAt)
+	}
+}
+
+func TestMorningCall_RescheduleRequest(t *testing.T) {
+	now := time.Now()
+	scheduledTime := now.Add(time.Hour)
+	requestedTime := now.Add(2 * time.Hour)
+
+	newScheduled := func() *MorningCall {
+		return &MorningCall{Status: valueobject.MorningCallStatusScheduled, ScheduledTime: scheduledTime}
+	}
+
+	t.Run("リクエストすると応答待ちになり時刻は変わらない", func(t *testing.T) {
+		mc := newScheduled()
+		if reason := mc.RequestReschedule(requestedTime, now); reason.IsNG() {
+			t.Fatalf("リクエストに失敗: %s", reason.Error())
+		}
+		if !mc.HasPendingRescheduleRequest() || !mc.RescheduleRequest.RequestedTime.Equal(requestedTime) {
+			t.Errorf("応答待ちのリクエストが保持されるべき: %+v", mc.RescheduleRequest)
+		}
+		if !mc.ScheduledTime.Equal(scheduledTime) {
+			t.Errorf("リクエスト時点では時刻を変更しないべき: %v", mc.ScheduledTime)
+		}
+	})
+
+	t.Run("承認すると希望時刻に変更される", func(t *testing.T) {
+		mc := newScheduled()
+		mc.RequestReschedule(requestedTime, now)
+		if reason := mc.ApproveReschedule(now); reason.IsNG() {
+			t.Fatalf("承認に失敗: %s", reason.Error())
+		}
+		if mc.RescheduleRequest.Status != RescheduleRequestStatusApproved || mc.RescheduleRequest.RespondedAt == nil {
+			t.Errorf("承認済みになるべき: %+v", mc.RescheduleRequest)
+		}
+		if !mc.ScheduledTime.Equal(requestedTime) {
+			t.Errorf("ScheduledTime = %v, want %v", mc.ScheduledTime, requestedTime)
+		}
+	})
+
+	t.Run("拒否すると時刻は変わらない", func(t *testing.T) {
+		mc := newScheduled()
+		mc.RequestReschedule(requestedTime, now)
+		if reason := mc.RejectReschedule(now); reason.IsNG() {
+			t.Fatalf("拒否に失敗: %s", reason.Error())
+		}
+		if mc.RescheduleRequest.Status != RescheduleRequestStatusRejected || mc.RescheduleRequest.RespondedAt == nil {
+			t.Errorf("拒否済みになるべき: %+v", mc.RescheduleRequest)
+		}
+		if !mc.ScheduledTime.Equal(scheduledTime) {
+			t.Errorf("拒否時は時刻を変更しないべき: %v", mc.ScheduledTime)
+		}
+		// 応答後は再度リクエストできる
+		if reason := mc.RequestReschedule(requestedTime, now); reason.IsNG() {
+			t.Errorf("応答後の再リクエストに失敗: %s", reason.Error())
+		}
+	})
+
+	t.Run("応答待ちのまま再度リクエストできない", func(t *testing.T) {
+		mc := newScheduled()
+		mc.RequestReschedule(requestedTime, now)
+		if reason := mc.RequestReschedule(requestedTime.Add(time.Minute), now); reason.Code() != valueobject.ReasonCodeDuplicate {
+			t.Errorf("RequestReschedule() code = %s, want %s", reason.Code(), valueobject.ReasonCodeDuplicate)
+		}
+	})
+
+	t.Run("応答待ちのリクエストがなければ承認・拒否できない", func(t *testing.T) {
+		mc := newScheduled()
+		if reason := mc.ApproveReschedule(now); reason.Code() != valueobject.ReasonCodeInvalidState {
+			t.Errorf("ApproveReschedule() code = %s, want %s", reason.Code(), valueobject.ReasonCodeInvalidState)
+		}
+		if reason := mc.RejectReschedule(now); reason.Code() != valueobject.ReasonCodeInvalidState {
+			t.Errorf("RejectReschedule() code = %s, want %s", reason.Code(), valueobject.ReasonCodeInvalidState)
+		}
+	})
+
+	t.Run("リクエストできない条件", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			status    valueobject.MorningCallStatus
+			requested time.Time
+			wantCode  valueobject.ReasonCode
+		}{
+			{"配信済み", valueobject.MorningCallStatusDelivered, requestedTime, valueobject.ReasonCodeInvalidState},
+			{"現在の時刻と同じ", valueobject.MorningCallStatusScheduled, scheduledTime, valueobject.ReasonCodeInvalid},
+			{"過去の時刻", valueobject.MorningCallStatusScheduled, now.Add(-time.Minute), valueobject.ReasonCodeOutOfRange},
+			{"30日より先", valueobject.MorningCallStatusScheduled, now.Add(31 * 24 * time.Hour), valueobject.ReasonCodeOutOfRange},
+		}
+		for _, tt := range tests {
+			mc := &MorningCall{Status: tt.status, ScheduledTime: scheduledTime}
+			if reason := mc.RequestReschedule(tt.requested, now); reason.Code() != tt.wantCode {
+				t.Errorf("%s: code = %s, want %s", tt.name, reason.Code(), tt.wantCode)
+			}
+			if mc.RescheduleRequest != nil {
+				t.Errorf("%s: 失敗時はリクエストを保持しないべき", tt.name)
+			}
+		}
+	})
+
+	t.Run("Cloneはリクエストを複製する", func(t *testing.T) {
+		mc := newScheduled()
+		mc.RequestReschedule(requestedTime, now)
+		clone := mc.Clone()
+		mc.RejectReschedule(now)
+		if clone.RescheduleRequest.Status != RescheduleRequestStatusPending || clone.RescheduleRequest.RespondedAt != nil {
+			t.Errorf("元のリクエストの変更が複製に影響すべきでない: %+v", clone.RescheduleRequest)
+		}
+	})
+}
+
+func TestMorningCall_NextCallRequest(t *testing.T) {
+	now := time.Now()
+	scheduledTime := now.Add(-time.Hour)
+
+	newConfirmed := func() *MorningCall {
+		return &MorningCall{Status: valueobject.MorningCallStatusConfirmed, ScheduledTime: scheduledTime, SenderID: "sender", ReceiverID: "receiver"}
+	}
+
+	t.Run("翌日同時刻のコールを応答待ちでリクエストする", func(t *testing.T) {
+		mc := newConfirmed()
+		mc.ReceiverOffsetMinutes = 10
+		if reason := mc.RequestNextCall(now); reason.IsNG() {
+			t.Fatalf("リクエストに失敗: %s", reason.Error())
+		}
+		// 受信者のずらし幅は引き継がず、送信者が設定した時刻の翌日にする
+		if !mc.HasPendingNextCallRequest() || !mc.NextCallRequest.RequestedTime.Equal(scheduledTime.AddDate(0, 0, 1)) {
+			t.Errorf("応答待ちのリクエストが保持されるべき: %+v", mc.NextCallRequest)
+		}
+	})
+
+	t.Run("承認すると作成したコールのIDを記録する", func(t *testing.T) {
+		mc := newConfirmed()
+		mc.RequestNextCall(now)
+		if reason := mc.ApproveNextCall("next-call", now); reason.IsNG() {
+			t.Fatalf("承認に失敗: %s", reason.Error())
+		}
+		if mc.NextCallRequest.Status != NextCallRequestStatusApproved || mc.NextCallRequest.CreatedCallID != "next-call" || mc.NextCallRequest.RespondedAt == nil {
+			t.Errorf("承認済みになるべき: %+v", mc.NextCallRequest)
+		}
+		// 応答後も同じコールから再度リクエストはできない
+		if reason := mc.RequestNextCall(now); reason.Code() != valueobject.ReasonCodeDuplicate {
+			t.Errorf("RequestNextCall() code = %s, want %s", reason.Code(), valueobject.ReasonCodeDuplicate)
+		}
+	})
+
+	t.Run("拒否すると応答待ちでなくなる", func(t *testing.T) {
+		mc := newConfirmed()
+		mc.RequestNextCall(now)
+		if reason := mc.RejectNextCall(now); reason.IsNG() {
+			t.Fatalf("拒否に失敗: %s", reason.Error())
+		}
+		if mc.HasPendingNextCallRequest() || mc.NextCallRequest.Status != NextCallRequestStatusRejected {
+			t.Errorf("拒否済みになるべき: %+v", mc.NextCallRequest)
+		}
+		if reason := mc.ApproveNextCall("next-call", now); reason.Code() != valueobject.ReasonCodeInvalidState {
+			t.Errorf("ApproveNextCall() code = %s, want %s", reason.Code(), valueobject.ReasonCodeInvalidState)
+		}
+	})
+
+	t.Run("リクエストできない条件", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			mc       *MorningCall
+			wantCode valueobject.ReasonCode
+		}{
+			{"未確認", &MorningCall{Status: valueobject.MorningCallStatusDelivered, ScheduledTime: scheduledTime}, valueobject.ReasonCodeInvalidState},
+			{"セルフモーニングコール", &MorningCall{Status: valueobject.MorningCallStatusConfirmed, ScheduledTime: scheduledTime, SelfCall: true}, valueobject.ReasonCodeInvalid},
+			{"翌日同時刻を過ぎている", &MorningCall{Status: valueobject.MorningCallStatusConfirmed, ScheduledTime: now.Add(-25 * time.Hour)}, valueobject.ReasonCodeOutOfRange},
+		}
+		for _, tt := range tests {
+			if reason := tt.mc.RequestNextCall(now); reason.Code() != tt.wantCode {
+				t.Errorf("%s: code = %s, want %s", tt.name, reason.Code(), tt.wantCode)
+			}
+			if tt.mc.NextCallRequest != nil {
+				t.Errorf("%s: 失敗時はリクエストを保持しないべき", tt.name)
+			}
+		}
+	})
+
+	t.Run("Cloneはリクエストを複製する", func(t *testing.T) {
+		mc := newConfirmed()
+		mc.RequestNextCall(now)
+		clone := mc.Clone()
+		mc.RejectNextCall(now)
+		if clone.NextCallRequest.Status != NextCallRequestStatusPending || clone.NextCallRequest.RespondedAt != nil {
+			t.Errorf("元のリクエストの変更が複製に影響すべきでない: %+v", clone.NextCallRequest)
+		}
+	})
+}
+
+func TestMorningCall_RecordTimeToConfirm(t *testing.T) {
+	scheduled := time.Date(2025, 1, 10, 7, 0, 0, 0, time.UTC)
+	delivered := scheduled.Add(2 * time.Minute)
+
+	tests := []struct {
+		name          string
+		status        valueobject.MorningCallStatus
+		autoConfirmed bool
+		deliveredAt   *time.Time
+		offsetMinutes int
+		confirmedAt   time.Time
+		want          time.Duration
+		errorMsg      string
+	}{
+		{name: "配信直後に確認", status: valueobject.MorningCallStatusConfirmed, deliveredAt: &delivered, confirmedAt: delivered, want: 0},
+		{name: "配信から遅れて確認", status: valueobject.MorningCallStatusConfirmed, deliveredAt: &delivered, confirmedAt: delivered.Add(25 * time.Minute), want: 25 * time.Minute},
+		{name: "未配信の場合はアラーム時刻から", status: valueobject.MorningCallStatusConfirmed, offsetMinutes: 10, confirmedAt: scheduled.Add(15 * time.Minute), want: 5 * time.Minute},
+		{name: "アラーム時刻より前の確認は0", status: valueobject.MorningCallStatusConfirmed, confirmedAt: scheduled.Add(-30 * time.Minute), want: 0},
+		{name: "未確認のコール", status: valueobject.MorningCallStatusDelivered, deliveredAt: &delivered, confirmedAt: delivered, errorMsg: "起床確認済みのモーニングコールにのみ確認までの所要時間を記録できます"},
+		{name: "自動確認されたコール", status: valueobject.MorningCallStatusConfirmed, autoConfirmed: true, deliveredAt: &delivered, confirmedAt: delivered, errorMsg: "起床確認済みのモーニングコールにのみ確認までの所要時間を記録できます"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &MorningCall{
+				ID:                    "mc1",
+				ScheduledTime:         scheduled,
+				Status:                tt.status,
+				AutoConfirmed:         tt.autoConfirmed,
+				DeliveredAt:           tt.deliveredAt,
+				ReceiverOffsetMinutes: tt.offsetMinutes,
+			}
+			reason := mc.RecordTimeToConfirm(tt.confirmedAt)
+
+			if tt.errorMsg != "" {
+				if reason.Error() != tt.errorMsg {
+					t.Errorf("期待されたエラーメッセージ: %s, 実際: %s", tt.errorMsg, reason.Error())
+				}
+				if mc.TimeToConfirm != nil {
+					t.Errorf("TimeToConfirm = %v, want nil", *mc.TimeToConfirm)
+				}
+				return
+			}
+			if reason.IsNG() {
+				t.Fatalf("予期しないエラー: %v", reason)
+			}
+			if mc.TimeToConfirm == nil || *mc.TimeToConfirm != tt.want {
+				t.Errorf("TimeToConfirm = %v, want %v", mc.TimeToConfirm, tt.want)
+			}
+		})
+	}
+}
+
+func TestMorningCall_SetMessageVariants(t *testing.T) {
+	limits := valueobject.DefaultInputLimits()
+
+	tests := []struct {
+		name     string
+		variants []string
+		index    int
+		errorMsg string
+	}{
+		{name: "2件のバリアント", variants: []string{"A", "B"}, index: 1},
+		{name: "5件のバリアント", variants: []string{"A", "B", "C", "D", "E"}, index: 0},
+		{name: "500文字ちょうど", variants: []string{"A", strings.Repeat("あ", 500)}, index: 1},
+		{name: "1件のみ", variants: []string{"A"}, errorMsg: "メッセージバリアントは2件から5件の範囲で指定してください"},
+		{name: "6件", variants: []string{"A", "B", "C", "D", "E", "F"}, errorMsg: "メッセージバリアントは2件から5件の範囲で指定してください"},
+		{name: "501文字", variants: []string{strings.Repeat("あ", 501), "B"}, errorMsg: "メッセージバリアントの1件目は500文字以内で入力してください"},
+		{name: "範囲外の番号", variants: []string{"A", "B"}, index: 2, errorMsg: "配信するメッセージバリアントが選ばれていません"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := &MorningCall{ID: "mc1", Message: "元のメッセージ"}
+			reason := mc.SetMessageVariants(tt.variants, tt.index, limits)
+
+			if tt.errorMsg != "" {
+				if reason.Error() != tt.errorMsg {
+					t.Errorf("期待されたエラーメッセージ: %s, 実際: %s", tt.errorMsg, reason.Error())
+				}
+				// 失敗した場合は変更しない
+				if mc.Message != "元のメッセージ" || mc.MessageVariants != nil || mc.MessageVariantIndex != nil {
+					t.Errorf("Message = %q, MessageVariants = %v, want unchanged", mc.Message, mc.MessageVariants)
+				}
+				return
+			}
+			if reason.IsNG() {
+				t.Fatalf("予期しないエラー: %v", reason)
+			}
+			if mc.Message != tt.variants[tt.index] || mc.MessageVariantIndex == nil || *mc.MessageVariantIndex != tt.index {
+				t.Errorf("Message = %q, MessageVariantIndex = %v, want %q (%d)", mc.Message, mc.MessageVariantIndex, tt.variants[tt.index], tt.index)
+			}
+		})
+	}
+
+	t.Run("メッセージを書き換えるとバリアントを取り消す", func(t *testing.T) {
+		mc := &MorningCall{ID: "mc1", Status: valueobject.MorningCallStatusScheduled}
+		if reason := mc.SetMessageVariants([]string{"A", "B"}, 0, limits); reason.IsNG() {
+			t.Fatalf("予期しないエラー: %v", reason)
+		}
+		if reason := mc.UpdateMessage("C", limits); reason.IsNG() {
+			t.Fatalf("予期しないエラー: %v", reason)
+		}
+		if mc.MessageVariants != nil || mc.MessageVariantIndex != nil {
+			t.Errorf("MessageVariants = %v, MessageVariantIndex = %v, want cleared", mc.MessageVariants, mc.MessageVariantIndex)
+		}
+	})
+}
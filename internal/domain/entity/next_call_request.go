@@ -0,0 +1,38 @@
+package entity
+
+import "time"
+
+// NextCallRequestStatus は受信者からの次回のコールのリクエストの状態を表す
+type NextCallRequestStatus string
+
+const (
+	NextCallRequestStatusPending  NextCallRequestStatus = "pending"  // 送信者の応答待ち
+	NextCallRequestStatusApproved NextCallRequestStatus = "approved" // 承認され、次回のコールが作成された
+	NextCallRequestStatusRejected NextCallRequestStatus = "rejected" // 拒否され、次回のコールは作成されていない
+)
+
+// NextCallRequest は受信者が起床確認と同時に送信者へ依頼した、翌日同時刻の次回のコールのリクエスト
+type NextCallRequest struct {
+	RequestedTime time.Time // 次回のコールのアラーム時刻（確認したコールの翌日同時刻）
+	Status        NextCallRequestStatus
+	RequestedAt   time.Time  // リクエストした日時
+	RespondedAt   *time.Time // 送信者が応答した日時（応答待ちはnil）
+	CreatedCallID string     // 承認により作成された次回のコールのID（承認前・拒否は空）
+}
+
+// respond は送信者の応答を記録する
+func (r *NextCallRequest) respond(status NextCallRequestStatus, now time.Time) {
+	respondedAt := now
+	r.Status = status
+	r.RespondedAt = &respondedAt
+}
+
+// clone はリクエストのディープコピーを返す
+func (r *NextCallRequest) clone() *NextCallRequest {
+	rCopy := *r
+	if r.RespondedAt != nil {
+		respondedAt := *r.RespondedAt
+		rCopy.RespondedAt = &respondedAt
+	}
+	return &rCopy
+}
@@ -0,0 +1,138 @@
+package entity
+
+import (
+	"slices"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// ReadOnlyMorningCall はモーニングコールの読み取り専用ビュー
+// リポジトリが保持するエンティティをコピーせずに参照するため、値はメソッド経由でのみ読み取れる
+// ポインタで保持する値は複製して返すので、ビューを通じて元のエンティティを変更することはできない
+// 変更や呼び出し元の外へ渡す必要がある場合は Clone でコピーを取得すること
+type ReadOnlyMorningCall struct {
+	mc *MorningCall
+}
+
+// NewReadOnlyMorningCall はモーニングコールの読み取り専用ビューを作成する
+// 作成後に元のエンティティを変更するとビューにも反映されるため、
+// 以後変更されないエンティティ（リポジトリが保持するものなど）にのみ使うこと
+func NewReadOnlyMorningCall(mc *MorningCall) ReadOnlyMorningCall {
+	return ReadOnlyMorningCall{mc: mc}
+}
+
+// Clone は変更可能なディープコピーを返す
+func (v ReadOnlyMorningCall) Clone() *MorningCall {
+	return v.mc.Clone()
+}
+
+// ID はモーニングコールのIDを返す
+func (v ReadOnlyMorningCall) ID() string { return v.mc.ID }
+
+// SenderID は送信者のIDを返す
+func (v ReadOnlyMorningCall) SenderID() string { return v.mc.SenderID }
+
+// ReceiverID は受信者のIDを返す
+func (v ReadOnlyMorningCall) ReceiverID() string { return v.mc.ReceiverID }
+
+// ScheduledTime はアラーム時刻を返す
+func (v ReadOnlyMorningCall) ScheduledTime() time.Time { return v.mc.ScheduledTime }
+
+// Message はメッセージを返す
+func (v ReadOnlyMorningCall) Message() string { return v.mc.Message }
+
+// Status はステータスを返す
+func (v ReadOnlyMorningCall) Status() valueobject.MorningCallStatus { return v.mc.Status }
+
+// CreatedAt は作成日時を返す
+func (v ReadOnlyMorningCall) CreatedAt() time.Time { return v.mc.CreatedAt }
+
+// UpdatedAt は更新日時を返す
+func (v ReadOnlyMorningCall) UpdatedAt() time.Time { return v.mc.UpdatedAt }
+
+// ConfirmedBy は起床確認をしたユーザーのIDを返す（未確認は空）
+func (v ReadOnlyMorningCall) ConfirmedBy() string { return v.mc.ConfirmedBy }
+
+// Stamp は受信者から送信者へのお礼スタンプを返す（未送信は空）
+func (v ReadOnlyMorningCall) Stamp() valueobject.Stamp { return v.mc.Stamp }
+
+// HelpfulnessRating は受信者による役立ち度の評価を返す（未評価の場合はfalse）
+func (v ReadOnlyMorningCall) HelpfulnessRating() (int, bool) {
+	if v.mc.HelpfulnessRating == nil {
+		return 0, false
+	}
+	return *v.mc.HelpfulnessRating, true
+}
+
+// TimeToConfirm は配信から起床確認までの所要時間を返す（未確認・自動確認の場合はfalse）
+func (v ReadOnlyMorningCall) TimeToConfirm() (time.Duration, bool) {
+	if v.mc.TimeToConfirm == nil {
+		return 0, false
+	}
+	return *v.mc.TimeToConfirm, true
+}
+
+// MessageVariant は配信するメッセージとして選ばれたバリアントを返す（バリアントなしの場合はfalse）
+func (v ReadOnlyMorningCall) MessageVariant() (string, bool) {
+	if v.mc.MessageVariantIndex == nil {
+		return "", false
+	}
+	return v.mc.MessageVariants[*v.mc.MessageVariantIndex], true
+}
+
+// MessageVariants はA/Bテスト用のメッセージの候補のコピーを返す（バリアントなしの場合は空）
+func (v ReadOnlyMorningCall) MessageVariants() []string {
+	return slices.Clone(v.mc.MessageVariants)
+}
+
+// MessageVariantIndex は配信するメッセージとして選ばれたバリアントの番号を返す（バリアントなしの場合はfalse）
+func (v ReadOnlyMorningCall) MessageVariantIndex() (int, bool) {
+	if v.mc.MessageVariantIndex == nil {
+		return 0, false
+	}
+	return *v.mc.MessageVariantIndex, true
+}
+
+// HasMessageVariants はメッセージバリアントが指定した候補と同じ（順序を含む）かを判定する
+func (v ReadOnlyMorningCall) HasMessageVariants(variants []string) bool {
+	return len(v.mc.MessageVariants) > 0 && slices.Equal(v.mc.MessageVariants, variants)
+}
+
+// ConfirmDeadline は起床確認の期限を返す（無期限の場合はfalse）
+func (v ReadOnlyMorningCall) ConfirmDeadline() (time.Time, bool) {
+	if v.mc.ConfirmDeadline == nil {
+		return time.Time{}, false
+	}
+	return *v.mc.ConfirmDeadline, true
+}
+
+// ReceiverOffsetMinutes は受信者が設定したアラーム時刻のずらし幅（分）を返す
+func (v ReadOnlyMorningCall) ReceiverOffsetMinutes() int { return v.mc.ReceiverOffsetMinutes }
+
+// AutoConfirmed は配信時に自動で確認済みにされたかを返す
+func (v ReadOnlyMorningCall) AutoConfirmed() bool { return v.mc.AutoConfirmed }
+
+// Archived は受信者の受信箱からアーカイブされているかを返す
+func (v ReadOnlyMorningCall) Archived() bool { return v.mc.Archived }
+
+// SelfCall は送信者が自分自身に設定したセルフモーニングコールかを返す
+func (v ReadOnlyMorningCall) SelfCall() bool { return v.mc.SelfCall }
+
+// SeriesID はシリーズのIDを返す（単独で作成したコールは空）
+func (v ReadOnlyMorningCall) SeriesID() string { return v.mc.SeriesID }
+
+// Version は楽観ロック用のバージョンを返す
+func (v ReadOnlyMorningCall) Version() int { return v.mc.Version }
+
+// EffectiveScheduledTime は受信者のずらし幅を反映したアラーム時刻を返す
+func (v ReadOnlyMorningCall) EffectiveScheduledTime() time.Time { return v.mc.EffectiveScheduledTime() }
+
+// IsActive はモーニングコールが有効（承認待ち・配信待ち・配信済み）かを判定する
+func (v ReadOnlyMorningCall) IsActive() bool { return v.mc.IsActive() }
+
+// IsAwaitingDelivery はまだ配信されていない（承認待ちまたはスケジュール済み）かを判定する
+func (v ReadOnlyMorningCall) IsAwaitingDelivery() bool { return v.mc.IsAwaitingDelivery() }
+
+// IsDeleted は管理者により削除されたかを判定する
+func (v ReadOnlyMorningCall) IsDeleted() bool { return v.mc.IsDeleted() }
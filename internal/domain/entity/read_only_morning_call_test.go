@@ -0,0 +1,86 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+func TestMorningCall_Clone(t *testing.T) {
+	deadline := time.Now().Add(time.Hour)
+	silent := true
+	archivedAt := time.Now()
+	mc := &MorningCall{
+		ID:              "mc1",
+		Message:         "おはよう",
+		Status:          valueobject.MorningCallStatusScheduled,
+		ConfirmLocation: &valueobject.GeoPoint{Latitude: 35.0, Longitude: 139.0},
+		ConfirmDeadline: &deadline,
+		SilentDelivery:  &silent,
+		Archived:        true,
+		ArchivedAt:      &archivedAt,
+	}
+
+	clone := mc.Clone()
+	clone.Message = "changed"
+	clone.ConfirmLocation.Latitude = 0
+	*clone.ConfirmDeadline = deadline.Add(time.Hour)
+	*clone.SilentDelivery = false
+	*clone.ArchivedAt = archivedAt.Add(time.Hour)
+
+	if mc.Message != "おはよう" {
+		t.Errorf("Message = %q, want unchanged", mc.Message)
+	}
+	if mc.ConfirmLocation.Latitude != 35.0 {
+		t.Errorf("ConfirmLocation.Latitude = %v, want unchanged", mc.ConfirmLocation.Latitude)
+	}
+	if !mc.ConfirmDeadline.Equal(deadline) {
+		t.Errorf("ConfirmDeadline = %v, want unchanged", mc.ConfirmDeadline)
+	}
+	if !*mc.SilentDelivery {
+		t.Error("SilentDelivery should be unchanged")
+	}
+	if !mc.ArchivedAt.Equal(archivedAt) {
+		t.Errorf("ArchivedAt = %v, want unchanged", mc.ArchivedAt)
+	}
+}
+
+func TestReadOnlyMorningCall(t *testing.T) {
+	deadline := time.Now().Add(time.Hour)
+	mc := &MorningCall{
+		ID:                    "mc1",
+		SenderID:              "user1",
+		ReceiverID:            "user2",
+		ScheduledTime:         deadline.Add(-30 * time.Minute),
+		Status:                valueobject.MorningCallStatusScheduled,
+		ConfirmDeadline:       &deadline,
+		ReceiverOffsetMinutes: 10,
+	}
+	view := NewReadOnlyMorningCall(mc)
+
+	if view.ID() != "mc1" || view.SenderID() != "user1" || view.ReceiverID() != "user2" {
+		t.Errorf("view = (%s, %s, %s), want (mc1, user1, user2)", view.ID(), view.SenderID(), view.ReceiverID())
+	}
+	if !view.EffectiveScheduledTime().Equal(mc.EffectiveScheduledTime()) {
+		t.Errorf("EffectiveScheduledTime() = %v, want %v", view.EffectiveScheduledTime(), mc.EffectiveScheduledTime())
+	}
+	if !view.IsActive() || !view.IsAwaitingDelivery() || view.IsDeleted() {
+		t.Error("scheduled call should be active and awaiting delivery")
+	}
+
+	got, ok := view.ConfirmDeadline()
+	if !ok || !got.Equal(deadline) {
+		t.Errorf("ConfirmDeadline() = (%v, %v), want (%v, true)", got, ok, deadline)
+	}
+	if _, ok := NewReadOnlyMorningCall(&MorningCall{}).ConfirmDeadline(); ok {
+		t.Error("ConfirmDeadline() should report false when there is no deadline")
+	}
+
+	// Cloneしたコピーの変更は元のエンティティに影響しない
+	clone := view.Clone()
+	clone.Status = valueobject.MorningCallStatusCancelled
+	if view.Status() != valueobject.MorningCallStatusScheduled || mc.Status != valueobject.MorningCallStatusScheduled {
+		t.Errorf("Status() = %s, want unchanged", view.Status())
+	}
+}
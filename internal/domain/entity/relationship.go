@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
@@ -12,19 +13,38 @@ type Relationship struct {
 	RequesterID string // 友達リクエストを送信したユーザー
 	ReceiverID  string // 友達リクエストを受信したユーザー
 	Status      valueobject.RelationshipStatus
+	ExpiredAt   *time.Time // 放置により自動失効した日時（失効していない場合はnil）
+	BlockerID   string     // 最初にブロックしたユーザー（ブロック状態でない場合は空）
+	MutualBlock bool       // 双方がブロックしている場合にtrue
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+
+	SeenByReceiver bool // 受信者がリクエストを閲覧したか
+
+	RequesterCallWindow *valueobject.CallWindow // リクエスト送信者が相手からのモーニングコールを受け付ける曜日と時間帯（nilの場合はユーザー全体の設定に従う）
+	ReceiverCallWindow  *valueobject.CallWindow // リクエスト受信者が相手からのモーニングコールを受け付ける曜日と時間帯（nilの場合はユーザー全体の設定に従う）
+
+	RequesterAutoConfirm bool // リクエスト送信者が相手からのモーニングコールを配信時に自動で確認済みにするか
+	ReceiverAutoConfirm  bool // リクエスト受信者が相手からのモーニングコールを配信時に自動で確認済みにするか
+
+	RequesterFavoriteSender *valueobject.FavoriteSender // リクエスト送信者が相手をお気に入り送信元に登録した設定（未登録はnil）
+	ReceiverFavoriteSender  *valueobject.FavoriteSender // リクエスト受信者が相手をお気に入り送信元に登録した設定（未登録はnil）
+
+	ResendCount int       // 拒否された後に同じ相手へ続けて再送信した回数（再送信できない期間が明けると数え直す）
+	LastSentAt  time.Time // 最後に友達リクエストを送信した日時（ゼロ値の場合は作成日時）
 }
 
 // NewRelationship は新しい友達関係エンティティを作成する
 func NewRelationship(id, requesterID, receiverID string) (*Relationship, valueobject.NGReason) {
+	now := time.Now()
 	r := &Relationship{
 		ID:          id,
 		RequesterID: requesterID,
 		ReceiverID:  receiverID,
 		Status:      valueobject.RelationshipStatusPending,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		LastSentAt:  now,
 	}
 
 	// 検証
@@ -39,7 +59,7 @@ func NewRelationship(id, requesterID, receiverID string) (*Relationship, valueob
 func (r *Relationship) Validate() valueobject.NGReason {
 	// ID検証
 	if r.ID == "" {
-		return valueobject.NG("関係IDは必須です")
+		return valueobject.NGWithCode(valueobject.ReasonCodeRequired, "id", "関係IDは必須です")
 	}
 
 	// ユーザーID検証
@@ -49,7 +69,7 @@ func (r *Relationship) Validate() valueobject.NGReason {
 
 	// ステータス検証
 	if !r.Status.IsValid() {
-		return valueobject.NG("無効なステータスです")
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidFormat, "status", "無効なステータスです")
 	}
 
 	return valueobject.OK()
@@ -58,15 +78,15 @@ func (r *Relationship) Validate() valueobject.NGReason {
 // ValidateUsers はリクエスター・レシーバーの妥当性を検証する
 func (r *Relationship) ValidateUsers() valueobject.NGReason {
 	if r.RequesterID == "" {
-		return valueobject.NG("リクエスト送信者IDは必須です")
+		return valueobject.NGWithCode(valueobject.ReasonCodeRequired, "requester_id", "リクエスト送信者IDは必須です")
 	}
 
 	if r.ReceiverID == "" {
-		return valueobject.NG("リクエスト受信者IDは必須です")
+		return valueobject.NGWithCode(valueobject.ReasonCodeRequired, "receiver_id", "リクエスト受信者IDは必須です")
 	}
 
 	if r.RequesterID == r.ReceiverID {
-		return valueobject.NG("自分自身に友達リクエストを送ることはできません")
+		return valueobject.NGWithCode(valueobject.ReasonCodeSelfReference, "receiver_id", "自分自身に友達リクエストを送ることはできません")
 	}
 
 	return valueobject.OK()
@@ -80,7 +100,7 @@ func (r *Relationship) CanTransitionTo(newStatus valueobject.RelationshipStatus)
 // UpdateStatus はステータスを更新する
 func (r *Relationship) UpdateStatus(newStatus valueobject.RelationshipStatus) valueobject.NGReason {
 	if !r.CanTransitionTo(newStatus) {
-		return valueobject.NG("このステータスへの遷移はできません")
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "status", "このステータスへの遷移はできません")
 	}
 
 	r.Status = newStatus
@@ -89,36 +109,318 @@ func (r *Relationship) UpdateStatus(newStatus valueobject.RelationshipStatus) va
 }
 
 // Accept は友達リクエストを承認する
+// 承認したリクエストは受信者が閲覧済みとして扱う
 func (r *Relationship) Accept() valueobject.NGReason {
 	if r.Status != valueobject.RelationshipStatusPending {
-		return valueobject.NG("承認待ち状態のリクエストのみ承認できます")
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "承認待ち状態のリクエストのみ承認できます")
+	}
+	if reason := r.UpdateStatus(valueobject.RelationshipStatusAccepted); reason.IsNG() {
+		return reason
 	}
-	return r.UpdateStatus(valueobject.RelationshipStatusAccepted)
+	r.SeenByReceiver = true
+	return valueobject.OK()
 }
 
 // Reject は友達リクエストを拒否する
+// 拒否したリクエストは受信者が閲覧済みとして扱う
 func (r *Relationship) Reject() valueobject.NGReason {
 	if r.Status != valueobject.RelationshipStatusPending {
-		return valueobject.NG("承認待ち状態のリクエストのみ拒否できます")
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "承認待ち状態のリクエストのみ拒否できます")
+	}
+	if reason := r.UpdateStatus(valueobject.RelationshipStatusRejected); reason.IsNG() {
+		return reason
+	}
+	r.SeenByReceiver = true
+	return valueobject.OK()
+}
+
+// MarkSeenBy は指定されたユーザーとして友達リクエストを既読にする
+// 既読化はリクエスト内容の変更ではないためUpdatedAtは更新しない
+func (r *Relationship) MarkSeenBy(userID string) valueobject.NGReason {
+	if !r.IsReceiver(userID) {
+		return valueobject.NGWithCode(valueobject.ReasonCodeNotPermitted, "", "受信者のみが友達リクエストを既読にできます")
+	}
+	if !r.IsPending() {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "承認待ち状態のリクエストのみ既読にできます")
+	}
+
+	r.SeenByReceiver = true
+	return valueobject.OK()
+}
+
+// IsUnseen は受信者が未読の承認待ちリクエストかを判定する
+func (r *Relationship) IsUnseen() bool {
+	return r.IsPending() && !r.SeenByReceiver
+}
+
+// CallWindowFor は指定されたユーザーが相手からのモーニングコールを受け付ける曜日と時間帯を返す
+// 友達ごとの設定がない場合や関係の当事者でない場合はnilを返す
+func (r *Relationship) CallWindowFor(userID string) *valueobject.CallWindow {
+	switch {
+	case r.IsRequester(userID):
+		return r.RequesterCallWindow
+	case r.IsReceiver(userID):
+		return r.ReceiverCallWindow
+	default:
+		return nil
+	}
+}
+
+// SetCallWindow は指定されたユーザーが相手からのモーニングコールを受け付ける曜日と時間帯を設定する
+// nilを指定すると友達ごとの設定を解除し、ユーザー全体の設定に従うようにする
+func (r *Relationship) SetCallWindow(userID string, window *valueobject.CallWindow) valueobject.NGReason {
+	if !r.InvolvesUser(userID) {
+		return valueobject.NGWithCode(valueobject.ReasonCodeNotPermitted, "", "関係の当事者のみが受信時間帯を設定できます")
+	}
+	if !r.IsFriend() {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "友達関係にある相手のみ受信時間帯を設定できます")
+	}
+	if window != nil {
+		if reason := window.Validate(); reason.IsNG() {
+			return reason
+		}
+	}
+
+	if r.IsRequester(userID) {
+		r.RequesterCallWindow = window
+	} else {
+		r.ReceiverCallWindow = window
+	}
+	r.UpdatedAt = time.Now()
+	return valueobject.OK()
+}
+
+// AutoConfirmFor は指定されたユーザーが相手からのモーニングコールを自動で確認済みにするかを返す
+// 友達関係でない場合や関係の当事者でない場合はfalseを返す
+func (r *Relationship) AutoConfirmFor(userID string) bool {
+	if !r.IsFriend() {
+		return false
+	}
+	switch {
+	case r.IsRequester(userID):
+		return r.RequesterAutoConfirm
+	case r.IsReceiver(userID):
+		return r.ReceiverAutoConfirm
+	default:
+		return false
+	}
+}
+
+// SetAutoConfirm は指定されたユーザーが相手からのモーニングコールを自動で確認済みにするかを設定する
+func (r *Relationship) SetAutoConfirm(userID string, enabled bool) valueobject.NGReason {
+	if !r.InvolvesUser(userID) {
+		return valueobject.NGWithCode(valueobject.ReasonCodeNotPermitted, "", "関係の当事者のみが自動確認を設定できます")
+	}
+	if !r.IsFriend() {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "友達関係にある相手のみ自動確認を設定できます")
+	}
+
+	if r.IsRequester(userID) {
+		r.RequesterAutoConfirm = enabled
+	} else {
+		r.ReceiverAutoConfirm = enabled
+	}
+	r.UpdatedAt = time.Now()
+	return valueobject.OK()
+}
+
+// FavoriteSenderFor は指定されたユーザーが相手をお気に入り送信元に登録した設定を返す
+// 登録していない場合や友達関係でない場合、関係の当事者でない場合はnilを返す
+func (r *Relationship) FavoriteSenderFor(userID string) *valueobject.FavoriteSender {
+	if !r.IsFriend() {
+		return nil
+	}
+	switch {
+	case r.IsRequester(userID):
+		return r.RequesterFavoriteSender
+	case r.IsReceiver(userID):
+		return r.ReceiverFavoriteSender
+	default:
+		return nil
+	}
+}
+
+// SetFavoriteSender は指定されたユーザーが相手をお気に入り送信元に登録する
+// nilを指定するとお気に入り送信元の登録を解除する
+func (r *Relationship) SetFavoriteSender(userID string, favorite *valueobject.FavoriteSender) valueobject.NGReason {
+	if !r.InvolvesUser(userID) {
+		return valueobject.NGWithCode(valueobject.ReasonCodeNotPermitted, "", "関係の当事者のみがお気に入り送信元を設定できます")
+	}
+	if !r.IsFriend() {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "友達関係にある相手のみお気に入り送信元に登録できます")
+	}
+
+	var setting *valueobject.FavoriteSender
+	if favorite != nil {
+		value := *favorite
+		setting = &value
+	}
+	if r.IsRequester(userID) {
+		r.RequesterFavoriteSender = setting
+	} else {
+		r.ReceiverFavoriteSender = setting
+	}
+	r.UpdatedAt = time.Now()
+	return valueobject.OK()
+}
+
+// Expire は長期間放置された友達リクエストを失効させる
+// 失効したリクエストは拒否済みとして扱うが、送信者は待機期間なしで再送信できる
+func (r *Relationship) Expire() valueobject.NGReason {
+	if r.Status != valueobject.RelationshipStatusPending {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "承認待ち状態のリクエストのみ失効できます")
+	}
+	if reason := r.UpdateStatus(valueobject.RelationshipStatusRejected); reason.IsNG() {
+		return reason
 	}
-	return r.UpdateStatus(valueobject.RelationshipStatusRejected)
+	expiredAt := r.UpdatedAt
+	r.ExpiredAt = &expiredAt
+	return valueobject.OK()
+}
+
+// IsExpired は自動失効したリクエストかを判定する
+func (r *Relationship) IsExpired() bool {
+	return r.IsRejected() && r.ExpiredAt != nil
 }
 
 // Block はユーザーをブロックする
 func (r *Relationship) Block() valueobject.NGReason {
 	// ブロックは承認待ち、承認済み、拒否済みから可能
 	if r.Status == valueobject.RelationshipStatusBlocked {
-		return valueobject.NG("既にブロック済みです")
+		return valueobject.NGWithCode(valueobject.ReasonCodeDuplicate, "", "既にブロック済みです")
 	}
 	return r.UpdateStatus(valueobject.RelationshipStatusBlocked)
 }
 
+// BlockBy は指定されたユーザーとして相手をブロックする
+// 相手からのみブロックされている場合は相互ブロック状態になる
+func (r *Relationship) BlockBy(userID string) valueobject.NGReason {
+	if !r.InvolvesUser(userID) {
+		return valueobject.NGWithCode(valueobject.ReasonCodeNotApplicable, "", "関係に含まれないユーザーはブロックできません")
+	}
+	if r.IsBlockedBy(userID) {
+		return valueobject.NGWithCode(valueobject.ReasonCodeDuplicate, "", "既にブロック済みです")
+	}
+
+	// 相手からブロックされている場合は相互ブロックにする（最初のブロック実行者は維持）
+	if r.IsBlocked() {
+		r.BlockerID = r.primaryBlockerID()
+		r.MutualBlock = true
+		r.UpdatedAt = time.Now()
+		return valueobject.OK()
+	}
+
+	if reason := r.Block(); reason.IsNG() {
+		return reason
+	}
+	r.BlockerID = userID
+	r.MutualBlock = false
+	return valueobject.OK()
+}
+
+// UnblockBy は指定されたユーザーによるブロックを解除する
+// 相互ブロックの場合は相手のブロックを残し、releasedがfalseになる
+// releasedがtrueの場合はブロック状態が完全に解消されたため、呼び出し側で関係を削除する
+func (r *Relationship) UnblockBy(userID string) (released bool, reason valueobject.NGReason) {
+	if !r.IsBlockedBy(userID) {
+		return false, valueobject.NGWithCode(valueobject.ReasonCodeNotApplicable, "", "ブロックしていないユーザーのブロックは解除できません")
+	}
+
+	if r.MutualBlock {
+		r.BlockerID = r.GetOtherUserID(userID)
+		r.MutualBlock = false
+		r.UpdatedAt = time.Now()
+		return false, valueobject.OK()
+	}
+
+	return true, valueobject.OK()
+}
+
+// IsBlockedBy は指定されたユーザーが相手をブロックしているかを判定する
+func (r *Relationship) IsBlockedBy(userID string) bool {
+	if !r.IsBlocked() || !r.InvolvesUser(userID) {
+		return false
+	}
+	if r.MutualBlock {
+		return true
+	}
+	return r.primaryBlockerID() == userID
+}
+
+// IsMutualBlock は双方がブロックしているかを判定する
+func (r *Relationship) IsMutualBlock() bool {
+	return r.IsBlocked() && r.MutualBlock
+}
+
+// primaryBlockerID は最初にブロックしたユーザーのIDを返す
+// BlockerID導入前のデータはリクエスト送信者がブロックしたものとして扱う
+func (r *Relationship) primaryBlockerID() string {
+	if r.BlockerID != "" {
+		return r.BlockerID
+	}
+	return r.RequesterID
+}
+
 // Resend は拒否済みの友達リクエストを再送信する
 func (r *Relationship) Resend() valueobject.NGReason {
 	if r.Status != valueobject.RelationshipStatusRejected {
-		return valueobject.NG("拒否済みのリクエストのみ再送信できます")
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "拒否済みのリクエストのみ再送信できます")
+	}
+	if reason := r.UpdateStatus(valueobject.RelationshipStatusPending); reason.IsNG() {
+		return reason
+	}
+	r.ExpiredAt = nil
+	// 再送信されたリクエストは新しいリクエストとして未読に戻す
+	r.SeenByReceiver = false
+	return valueobject.OK()
+}
+
+// ResendWithPolicy は再送信の制限を確認してから拒否済みの友達リクエストを再送信する
+// 拒否されてから待機期間が経過していない場合と、続けて再送信した回数が上限に達してから
+// 再び送信できるようになるまでの期間が経過していない場合は再送信できない
+func (r *Relationship) ResendWithPolicy(policy valueobject.FriendRequestResendPolicy, now time.Time) valueobject.NGReason {
+	if r.Status != valueobject.RelationshipStatusRejected {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "拒否済みのリクエストのみ再送信できます")
+	}
+	// 自動失効したリクエストは拒否されたわけではないため待機期間の対象外
+	if !r.IsExpired() && policy.Cooldown > 0 && now.Before(r.UpdatedAt.Add(policy.Cooldown)) {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "",
+			fmt.Sprintf("友達リクエストが拒否されました。%s後に再送信できます", formatResendWait(policy.Cooldown)))
+	}
+	if policy.MaxResends > 0 && r.ResendCount >= policy.MaxResends {
+		next := r.lastSentAt().Add(policy.Lockout)
+		if now.Before(next) {
+			return valueobject.NGWithCode(valueobject.ReasonCodeLimitExceeded, "",
+				fmt.Sprintf("同じ相手への友達リクエストの再送信は%d回までです。%sまで送信できません", policy.MaxResends, next.Format(time.RFC3339)))
+		}
+		// 再送信できない期間が明けたら回数を数え直す
+		r.ResendCount = 0
+	}
+
+	if reason := r.Resend(); reason.IsNG() {
+		return reason
+	}
+	r.ResendCount++
+	r.LastSentAt = now
+	r.UpdatedAt = now
+	return valueobject.OK()
+}
+
+// lastSentAt は最後に友達リクエストを送信した日時を返す
+// LastSentAt導入前のデータは作成日時に送信したものとして扱う
+func (r *Relationship) lastSentAt() time.Time {
+	if r.LastSentAt.IsZero() {
+		return r.CreatedAt
+	}
+	return r.LastSentAt
+}
+
+// formatResendWait は再送信までの待機期間を「24時間」「30分」のような表記にする
+func formatResendWait(d time.Duration) string {
+	if d%time.Hour == 0 {
+		return fmt.Sprintf("%d時間", int(d/time.Hour))
 	}
-	return r.UpdateStatus(valueobject.RelationshipStatusPending)
+	return fmt.Sprintf("%d分", int((d+time.Minute-1)/time.Minute))
 }
 
 // IsFriend は友達関係かを判定する
@@ -181,8 +483,8 @@ func (r *Relationship) CanBeRejectedBy(userID string) bool {
 
 // CanBeBlockedBy は指定されたユーザーがブロック可能かを判定する
 func (r *Relationship) CanBeBlockedBy(userID string) bool {
-	// 両者がブロック可能（既にブロック済みでない限り）
-	return r.InvolvesUser(userID) && !r.IsBlocked()
+	// 両者がブロック可能（自分が既にブロック済みでない限り）
+	return r.InvolvesUser(userID) && !r.IsBlockedBy(userID)
 }
 
 // CanBeResendBy は指定されたユーザーが再送信可能かを判定する
@@ -305,6 +305,72 @@ func TestRelationship_Reject(t *testing.T) {
 	}
 }
 
+func TestRelationship_Expire(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      valueobject.RelationshipStatus
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:        "承認待ちから失効",
+			status:      valueobject.RelationshipStatusPending,
+			expectError: false,
+		},
+		{
+			name:        "承認済みから失効（不可）",
+			status:      valueobject.RelationshipStatusAccepted,
+			expectError: true,
+			errorMsg:    "承認待ち状態のリクエストのみ失効できます",
+		},
+		{
+			name:        "拒否済みから失効（不可）",
+			status:      valueobject.RelationshipStatusRejected,
+			expectError: true,
+			errorMsg:    "承認待ち状態のリクエストのみ失効できます",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rel := &Relationship{
+				Status: tt.status,
+			}
+			reason := rel.Expire()
+
+			if tt.expectError {
+				if reason.IsOK() {
+					t.Errorf("エラーが期待されたが、成功した")
+				}
+				if reason.Error() != tt.errorMsg {
+					t.Errorf("期待されたエラーメッセージ: %s, 実際: %s", tt.errorMsg, reason.Error())
+				}
+				if rel.ExpiredAt != nil {
+					t.Errorf("失敗時にExpiredAtが設定された")
+				}
+			} else {
+				if reason.IsNG() {
+					t.Errorf("成功が期待されたが、エラーが発生: %s", reason.Error())
+				}
+				if rel.Status != valueobject.RelationshipStatusRejected {
+					t.Errorf("ステータスがRejectedになるべき")
+				}
+				if !rel.IsExpired() {
+					t.Errorf("失効扱いになるべき")
+				}
+
+				// 再送信すると失効状態は解除される
+				if reason := rel.Resend(); reason.IsNG() {
+					t.Fatalf("再送信に失敗: %s", reason.Error())
+				}
+				if rel.ExpiredAt != nil || rel.IsExpired() {
+					t.Errorf("再送信後は失効状態が解除されるべき")
+				}
+			}
+		})
+	}
+}
+
 func TestRelationship_Block(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -361,6 +427,186 @@ func TestRelationship_Block(t *testing.T) {
 	}
 }
 
+func TestRelationship_BlockBy(t *testing.T) {
+	tests := []struct {
+		name            string
+		status          valueobject.RelationshipStatus
+		blockerID       string
+		mutualBlock     bool
+		userID          string
+		expectError     bool
+		errorMsg        string
+		wantBlockerID   string
+		wantMutualBlock bool
+	}{
+		{
+			name:          "承認済みから一方向のブロック",
+			status:        valueobject.RelationshipStatusAccepted,
+			userID:        "user-002",
+			wantBlockerID: "user-002",
+		},
+		{
+			name:            "相手からのブロックに対して逆方向からブロック",
+			status:          valueobject.RelationshipStatusBlocked,
+			blockerID:       "user-001",
+			userID:          "user-002",
+			wantBlockerID:   "user-001",
+			wantMutualBlock: true,
+		},
+		{
+			name:            "BlockerID未設定のデータはリクエスト送信者のブロックとして扱う",
+			status:          valueobject.RelationshipStatusBlocked,
+			userID:          "user-002",
+			wantBlockerID:   "user-001",
+			wantMutualBlock: true,
+		},
+		{
+			name:        "自分がブロック済みの場合は再ブロック不可",
+			status:      valueobject.RelationshipStatusBlocked,
+			blockerID:   "user-002",
+			userID:      "user-002",
+			expectError: true,
+			errorMsg:    "既にブロック済みです",
+		},
+		{
+			name:        "相互ブロック済みの場合は再ブロック不可",
+			status:      valueobject.RelationshipStatusBlocked,
+			blockerID:   "user-001",
+			mutualBlock: true,
+			userID:      "user-002",
+			expectError: true,
+			errorMsg:    "既にブロック済みです",
+		},
+		{
+			name:        "無関係なユーザーはブロック不可",
+			status:      valueobject.RelationshipStatusAccepted,
+			userID:      "user-003",
+			expectError: true,
+			errorMsg:    "関係に含まれないユーザーはブロックできません",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rel := &Relationship{
+				RequesterID: "user-001",
+				ReceiverID:  "user-002",
+				Status:      tt.status,
+				BlockerID:   tt.blockerID,
+				MutualBlock: tt.mutualBlock,
+			}
+			reason := rel.BlockBy(tt.userID)
+
+			if tt.expectError {
+				if reason.IsOK() {
+					t.Errorf("エラーが期待されたが、成功した")
+				}
+				if reason.Error() != tt.errorMsg {
+					t.Errorf("期待されたエラーメッセージ: %s, 実際: %s", tt.errorMsg, reason.Error())
+				}
+				return
+			}
+
+			if reason.IsNG() {
+				t.Fatalf("成功が期待されたが、エラーが発生: %s", reason.Error())
+			}
+			if rel.Status != valueobject.RelationshipStatusBlocked {
+				t.Errorf("ステータスがBlockedになるべき")
+			}
+			if rel.BlockerID != tt.wantBlockerID {
+				t.Errorf("BlockerID = %s, 期待値 %s", rel.BlockerID, tt.wantBlockerID)
+			}
+			if rel.IsMutualBlock() != tt.wantMutualBlock {
+				t.Errorf("IsMutualBlock() = %v, 期待値 %v", rel.IsMutualBlock(), tt.wantMutualBlock)
+			}
+			if !rel.IsBlockedBy(tt.userID) {
+				t.Errorf("ブロック実行者がブロックしている状態になるべき")
+			}
+		})
+	}
+}
+
+func TestRelationship_UnblockBy(t *testing.T) {
+	tests := []struct {
+		name          string
+		blockerID     string
+		mutualBlock   bool
+		userID        string
+		expectError   bool
+		wantReleased  bool
+		wantBlockerID string
+	}{
+		{
+			name:         "一方向のブロックを解除するとブロック状態が解消される",
+			blockerID:    "user-001",
+			userID:       "user-001",
+			wantReleased: true,
+		},
+		{
+			name:          "相互ブロックで最初のブロック実行者が解除すると相手のブロックが残る",
+			blockerID:     "user-001",
+			mutualBlock:   true,
+			userID:        "user-001",
+			wantBlockerID: "user-002",
+		},
+		{
+			name:          "相互ブロックで後からブロックした側が解除すると最初のブロックが残る",
+			blockerID:     "user-001",
+			mutualBlock:   true,
+			userID:        "user-002",
+			wantBlockerID: "user-001",
+		},
+		{
+			name:        "ブロックされた側は解除不可",
+			blockerID:   "user-001",
+			userID:      "user-002",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rel := &Relationship{
+				RequesterID: "user-001",
+				ReceiverID:  "user-002",
+				Status:      valueobject.RelationshipStatusBlocked,
+				BlockerID:   tt.blockerID,
+				MutualBlock: tt.mutualBlock,
+			}
+			released, reason := rel.UnblockBy(tt.userID)
+
+			if tt.expectError {
+				if reason.IsOK() {
+					t.Errorf("エラーが期待されたが、成功した")
+				}
+				return
+			}
+
+			if reason.IsNG() {
+				t.Fatalf("成功が期待されたが、エラーが発生: %s", reason.Error())
+			}
+			if released != tt.wantReleased {
+				t.Errorf("released = %v, 期待値 %v", released, tt.wantReleased)
+			}
+			if released {
+				return
+			}
+			if rel.IsMutualBlock() {
+				t.Errorf("相互ブロックが解除されるべき")
+			}
+			if rel.BlockerID != tt.wantBlockerID {
+				t.Errorf("BlockerID = %s, 期待値 %s", rel.BlockerID, tt.wantBlockerID)
+			}
+			if rel.IsBlockedBy(tt.userID) {
+				t.Errorf("解除したユーザーはブロックしていない状態になるべき")
+			}
+			if !rel.IsBlockedBy(tt.wantBlockerID) {
+				t.Errorf("相手のブロックは維持されるべき")
+			}
+		})
+	}
+}
+
 func TestRelationship_Resend(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -396,7 +642,8 @@ func TestRelationship_Resend(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			rel := &Relationship{
-				Status: tt.status,
+				Status:         tt.status,
+				SeenByReceiver: true,
 			}
 			reason := rel.Resend()
 
@@ -414,6 +661,259 @@ func TestRelationship_Resend(t *testing.T) {
 				if rel.Status != valueobject.RelationshipStatusPending {
 					t.Errorf("ステータスがPendingになるべき")
 				}
+				if rel.SeenByReceiver {
+					t.Errorf("再送信したリクエストは未読に戻るべき")
+				}
+			}
+		})
+	}
+}
+
+func TestRelationship_ResendWithPolicy(t *testing.T) {
+	policy := valueobject.FriendRequestResendPolicy{
+		Cooldown:   24 * time.Hour,
+		MaxResends: 2,
+		Lockout:    7 * 24 * time.Hour,
+	}
+	rejectedAt := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	expiredAt := rejectedAt
+
+	tests := []struct {
+		name      string
+		rel       Relationship
+		now       time.Time
+		wantCount int
+		wantCode  valueobject.ReasonCode
+		errorMsg  string
+	}{
+		{
+			name:      "待機期間ちょうどで再送信できる",
+			rel:       Relationship{Status: valueobject.RelationshipStatusRejected, UpdatedAt: rejectedAt},
+			now:       rejectedAt.Add(24 * time.Hour),
+			wantCount: 1,
+		},
+		{
+			name:     "待機期間の直前は再送信できない",
+			rel:      Relationship{Status: valueobject.RelationshipStatusRejected, UpdatedAt: rejectedAt},
+			now:      rejectedAt.Add(24*time.Hour - time.Nanosecond),
+			wantCode: valueobject.ReasonCodeInvalidState,
+			errorMsg: "友達リクエストが拒否されました。24時間後に再送信できます",
+		},
+		{
+			name:      "自動失効したリクエストは待機期間なしで再送信できる",
+			rel:       Relationship{Status: valueobject.RelationshipStatusRejected, UpdatedAt: rejectedAt, ExpiredAt: &expiredAt},
+			now:       rejectedAt,
+			wantCount: 1,
+		},
+		{
+			name: "上限に達した場合は最後の送信から制限期間が過ぎるまで再送信できない",
+			rel: Relationship{
+				Status: valueobject.RelationshipStatusRejected, UpdatedAt: rejectedAt,
+				ResendCount: 2, LastSentAt: rejectedAt.Add(-time.Hour),
+			},
+			now:      rejectedAt.Add(7*24*time.Hour - time.Hour - time.Nanosecond),
+			wantCode: valueobject.ReasonCodeLimitExceeded,
+			errorMsg: "同じ相手への友達リクエストの再送信は2回までです。2026-03-08T08:00:00Zまで送信できません",
+		},
+		{
+			name: "制限期間が過ぎると回数を数え直す",
+			rel: Relationship{
+				Status: valueobject.RelationshipStatusRejected, UpdatedAt: rejectedAt,
+				ResendCount: 2, LastSentAt: rejectedAt.Add(-time.Hour),
+			},
+			now:       rejectedAt.Add(7*24*time.Hour - time.Hour),
+			wantCount: 1,
+		},
+		{
+			name:     "ブロック済みは再送信できない",
+			rel:      Relationship{Status: valueobject.RelationshipStatusBlocked, UpdatedAt: rejectedAt},
+			now:      rejectedAt.Add(30 * 24 * time.Hour),
+			wantCode: valueobject.ReasonCodeInvalidState,
+			errorMsg: "拒否済みのリクエストのみ再送信できます",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rel := tt.rel
+			reason := rel.ResendWithPolicy(policy, tt.now)
+
+			if tt.errorMsg != "" {
+				if reason.IsOK() {
+					t.Fatalf("エラーが期待されたが、成功した")
+				}
+				if reason.Error() != tt.errorMsg || reason.Code() != tt.wantCode {
+					t.Errorf("期待されたエラー: %s (%s), 実際: %s (%s)", tt.errorMsg, tt.wantCode, reason.Error(), reason.Code())
+				}
+				if rel.Status != tt.rel.Status {
+					t.Errorf("エラー時にステータスが変更された: %s", rel.Status)
+				}
+				return
+			}
+			if reason.IsNG() {
+				t.Fatalf("成功が期待されたが、エラーが発生: %s", reason.Error())
+			}
+			if rel.Status != valueobject.RelationshipStatusPending {
+				t.Errorf("ステータスがPendingになるべき")
+			}
+			if rel.ResendCount != tt.wantCount {
+				t.Errorf("ResendCount = %d, want %d", rel.ResendCount, tt.wantCount)
+			}
+			if !rel.LastSentAt.Equal(tt.now) {
+				t.Errorf("LastSentAt = %v, want %v", rel.LastSentAt, tt.now)
+			}
+		})
+	}
+}
+
+func TestRelationship_MarkSeenBy(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      valueobject.RelationshipStatus
+		userID      string
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:        "受信者が承認待ちのリクエストを既読にする",
+			status:      valueobject.RelationshipStatusPending,
+			userID:      "receiver",
+			expectError: false,
+		},
+		{
+			name:        "送信者は既読にできない",
+			status:      valueobject.RelationshipStatusPending,
+			userID:      "requester",
+			expectError: true,
+			errorMsg:    "受信者のみが友達リクエストを既読にできます",
+		},
+		{
+			name:        "関係外のユーザーは既読にできない",
+			status:      valueobject.RelationshipStatusPending,
+			userID:      "other",
+			expectError: true,
+			errorMsg:    "受信者のみが友達リクエストを既読にできます",
+		},
+		{
+			name:        "承認済みのリクエストは既読にできない",
+			status:      valueobject.RelationshipStatusAccepted,
+			userID:      "receiver",
+			expectError: true,
+			errorMsg:    "承認待ち状態のリクエストのみ既読にできます",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rel := &Relationship{
+				RequesterID: "requester",
+				ReceiverID:  "receiver",
+				Status:      tt.status,
+			}
+			reason := rel.MarkSeenBy(tt.userID)
+
+			if tt.expectError {
+				if reason.IsOK() {
+					t.Errorf("エラーが期待されたが、成功した")
+				}
+				if reason.Error() != tt.errorMsg {
+					t.Errorf("期待されたエラーメッセージ: %s, 実際: %s", tt.errorMsg, reason.Error())
+				}
+				if rel.SeenByReceiver {
+					t.Errorf("失敗時に既読になるべきではない")
+				}
+			} else {
+				if reason.IsNG() {
+					t.Errorf("成功が期待されたが、エラーが発生: %s", reason.Error())
+				}
+				if !rel.SeenByReceiver || rel.IsUnseen() {
+					t.Errorf("既読になるべき")
+				}
+			}
+		})
+	}
+}
+
+func TestRelationship_SetCallWindow(t *testing.T) {
+	window := &valueobject.CallWindow{StartMinute: 7 * 60, EndMinute: 9 * 60}
+
+	tests := []struct {
+		name        string
+		status      valueobject.RelationshipStatus
+		userID      string
+		window      *valueobject.CallWindow
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:   "リクエスト送信者が友達ごとの時間帯を設定する",
+			status: valueobject.RelationshipStatusAccepted,
+			userID: "requester",
+			window: window,
+		},
+		{
+			name:   "リクエスト受信者が友達ごとの時間帯を設定する",
+			status: valueobject.RelationshipStatusAccepted,
+			userID: "receiver",
+			window: window,
+		},
+		{
+			name:        "関係外のユーザーは設定できない",
+			status:      valueobject.RelationshipStatusAccepted,
+			userID:      "other",
+			window:      window,
+			expectError: true,
+			errorMsg:    "関係の当事者のみが受信時間帯を設定できます",
+		},
+		{
+			name:        "承認待ちの関係には設定できない",
+			status:      valueobject.RelationshipStatusPending,
+			userID:      "receiver",
+			window:      window,
+			expectError: true,
+			errorMsg:    "友達関係にある相手のみ受信時間帯を設定できます",
+		},
+		{
+			name:        "不正な時間帯は設定できない",
+			status:      valueobject.RelationshipStatusAccepted,
+			userID:      "receiver",
+			window:      &valueobject.CallWindow{StartMinute: -1},
+			expectError: true,
+			errorMsg:    "開始時刻は00:00から23:59の範囲で指定してください",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rel := &Relationship{
+				RequesterID: "requester",
+				ReceiverID:  "receiver",
+				Status:      tt.status,
+			}
+			reason := rel.SetCallWindow(tt.userID, tt.window)
+
+			if tt.expectError {
+				if reason.Error() != tt.errorMsg {
+					t.Errorf("期待されたエラーメッセージ: %s, 実際: %s", tt.errorMsg, reason.Error())
+				}
+				if rel.RequesterCallWindow != nil || rel.ReceiverCallWindow != nil {
+					t.Errorf("失敗時に時間帯が設定されるべきではない")
+				}
+				return
+			}
+			if reason.IsNG() {
+				t.Fatalf("成功が期待されたが、エラーが発生: %s", reason.Error())
+			}
+			if rel.CallWindowFor(tt.userID) != tt.window {
+				t.Errorf("設定したユーザーの時間帯が返るべき")
+			}
+			if rel.CallWindowFor(rel.GetOtherUserID(tt.userID)) != nil {
+				t.Errorf("相手側の時間帯は変更されるべきではない")
+			}
+
+			// nilを指定すると解除できる
+			if reason := rel.SetCallWindow(tt.userID, nil); reason.IsNG() || rel.CallWindowFor(tt.userID) != nil {
+				t.Errorf("時間帯を解除できるべき: %v", reason)
 			}
 		})
 	}
@@ -484,6 +984,151 @@ func TestRelationship_StatusChecks(t *testing.T) {
 	}
 }
 
+func TestRelationship_SetAutoConfirm(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      valueobject.RelationshipStatus
+		userID      string
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:   "リクエスト送信者が自動確認を設定する",
+			status: valueobject.RelationshipStatusAccepted,
+			userID: "requester",
+		},
+		{
+			name:   "リクエスト受信者が自動確認を設定する",
+			status: valueobject.RelationshipStatusAccepted,
+			userID: "receiver",
+		},
+		{
+			name:        "関係外のユーザーは設定できない",
+			status:      valueobject.RelationshipStatusAccepted,
+			userID:      "other",
+			expectError: true,
+			errorMsg:    "関係の当事者のみが自動確認を設定できます",
+		},
+		{
+			name:        "承認待ちの関係には設定できない",
+			status:      valueobject.RelationshipStatusPending,
+			userID:      "receiver",
+			expectError: true,
+			errorMsg:    "友達関係にある相手のみ自動確認を設定できます",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rel := &Relationship{
+				RequesterID: "requester",
+				ReceiverID:  "receiver",
+				Status:      tt.status,
+			}
+			reason := rel.SetAutoConfirm(tt.userID, true)
+
+			if tt.expectError {
+				if reason.Error() != tt.errorMsg {
+					t.Errorf("期待されたエラーメッセージ: %s, 実際: %s", tt.errorMsg, reason.Error())
+				}
+				return
+			}
+			if reason.IsNG() {
+				t.Fatalf("予期しないエラー: %v", reason)
+			}
+			if !rel.AutoConfirmFor(tt.userID) {
+				t.Errorf("AutoConfirmFor(%s) = false, want true", tt.userID)
+			}
+			// 設定は本人側にのみ反映される
+			other := rel.GetOtherUserID(tt.userID)
+			if rel.AutoConfirmFor(other) {
+				t.Errorf("AutoConfirmFor(%s) = true, want false", other)
+			}
+
+			// 友達関係でなくなった後は自動確認しない
+			rel.Status = valueobject.RelationshipStatusBlocked
+			if rel.AutoConfirmFor(tt.userID) {
+				t.Error("AutoConfirmFor() should be false once the relationship is no longer accepted")
+			}
+		})
+	}
+}
+
+func TestRelationship_SetFavoriteSender(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      valueobject.RelationshipStatus
+		userID      string
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:   "リクエスト送信者が相手をお気に入り送信元に登録する",
+			status: valueobject.RelationshipStatusAccepted,
+			userID: "requester",
+		},
+		{
+			name:   "リクエスト受信者が相手をお気に入り送信元に登録する",
+			status: valueobject.RelationshipStatusAccepted,
+			userID: "receiver",
+		},
+		{
+			name:        "関係外のユーザーは登録できない",
+			status:      valueobject.RelationshipStatusAccepted,
+			userID:      "other",
+			expectError: true,
+			errorMsg:    "関係の当事者のみがお気に入り送信元を設定できます",
+		},
+		{
+			name:        "承認待ちの関係には登録できない",
+			status:      valueobject.RelationshipStatusPending,
+			userID:      "receiver",
+			expectError: true,
+			errorMsg:    "友達関係にある相手のみお気に入り送信元に登録できます",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rel := &Relationship{
+				RequesterID: "requester",
+				ReceiverID:  "receiver",
+				Status:      tt.status,
+			}
+			setting := &valueobject.FavoriteSender{AlwaysRing: true}
+			reason := rel.SetFavoriteSender(tt.userID, setting)
+
+			if tt.expectError {
+				if reason.Error() != tt.errorMsg {
+					t.Errorf("期待されたエラーメッセージ: %s, 実際: %s", tt.errorMsg, reason.Error())
+				}
+				return
+			}
+			if reason.IsNG() {
+				t.Fatalf("予期しないエラー: %v", reason)
+			}
+			// 呼び出し元の値を変更しても登録済みの設定には影響しない
+			setting.AlwaysRing = false
+			if got := rel.FavoriteSenderFor(tt.userID); got == nil || !got.AlwaysRing {
+				t.Errorf("FavoriteSenderFor(%s) = %+v, want AlwaysRing", tt.userID, got)
+			}
+			// 登録は本人側にのみ反映される
+			other := rel.GetOtherUserID(tt.userID)
+			if got := rel.FavoriteSenderFor(other); got != nil {
+				t.Errorf("FavoriteSenderFor(%s) = %+v, want nil", other, got)
+			}
+
+			// 解除するとnilになる
+			if reason := rel.SetFavoriteSender(tt.userID, nil); reason.IsNG() {
+				t.Fatalf("予期しないエラー: %v", reason)
+			}
+			if got := rel.FavoriteSenderFor(tt.userID); got != nil {
+				t.Errorf("FavoriteSenderFor(%s) = %+v after removal, want nil", tt.userID, got)
+			}
+		})
+	}
+}
+
 func TestRelationship_UserRelatedMethods(t *testing.T) {
 	rel := &Relationship{
 		RequesterID: "user-001",
@@ -699,6 +1344,14 @@ func TestRelationship_PermissionMethods(t *testing.T) {
 				userID:      "user-001",
 				expected:    false,
 			},
+			{
+				name:        "相手からブロックされている場合はブロック可能",
+				requesterID: "user-001",
+				receiverID:  "user-002",
+				status:      valueobject.RelationshipStatusBlocked,
+				userID:      "user-002",
+				expected:    true,
+			},
 			{
 				name:        "無関係なユーザーはブロック不可",
 				requesterID: "user-001",
@@ -0,0 +1,37 @@
+package entity
+
+import "time"
+
+// RescheduleRequestStatus は受信者からのアラーム時刻の変更リクエストの状態を表す
+type RescheduleRequestStatus string
+
+const (
+	RescheduleRequestStatusPending  RescheduleRequestStatus = "pending"  // 送信者の応答待ち
+	RescheduleRequestStatusApproved RescheduleRequestStatus = "approved" // 承認され、アラーム時刻が希望時刻に変更された
+	RescheduleRequestStatusRejected RescheduleRequestStatus = "rejected" // 拒否され、アラーム時刻は変更されていない
+)
+
+// RescheduleRequest は受信者が送信者にアラーム時刻の変更を依頼したリクエスト
+type RescheduleRequest struct {
+	RequestedTime time.Time // 受信者の希望するアラーム時刻
+	Status        RescheduleRequestStatus
+	RequestedAt   time.Time  // リクエストした日時
+	RespondedAt   *time.Time // 送信者が応答した日時（応答待ちはnil）
+}
+
+// respond は送信者の応答を記録する
+func (r *RescheduleRequest) respond(status RescheduleRequestStatus, now time.Time) {
+	respondedAt := now
+	r.Status = status
+	r.RespondedAt = &respondedAt
+}
+
+// clone はリクエストのディープコピーを返す
+func (r *RescheduleRequest) clone() *RescheduleRequest {
+	rCopy := *r
+	if r.RespondedAt != nil {
+		respondedAt := *r.RespondedAt
+		rCopy.RespondedAt = &respondedAt
+	}
+	return &rCopy
+}
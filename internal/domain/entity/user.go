@@ -1,6 +1,8 @@
 package entity
 
 import (
+	"crypto/subtle"
+	"fmt"
 	"regexp"
 	"strings"
 	"time"
@@ -13,27 +15,85 @@ type User struct {
 	ID           string
 	Username     string
 	Email        string
-	PasswordHash string // ハッシュ化されたパスワード
+	PasswordHash string           // ハッシュ化されたパスワード
+	IsAdmin      bool             // 管理者権限を持つか
+	IsFrozen     bool             // アカウントが凍結されているか
+	Plan         valueobject.Plan // 契約プラン（未設定はフリープランとして扱う）
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
+
+	RequireCallApproval bool     // 受け取るモーニングコールに事前の承認を必要とするか
+	ProxyConfirmerIDs   []string // 自分宛てのモーニングコールの起床確認を代理できるユーザーのID
+	TimeZone            string   // タイムゾーンのIANA名（例: Asia/Tokyo、未設定はサーバーのタイムゾーン）
+	SilentDelivery      bool     // 受け取るモーニングコールを無音で配信するか（コールごとの設定がある場合はそちらを優先）
+	Volume              int      // 受け取るモーニングコールの配信時の音量（0〜100、コールごとの設定がある場合はそちらを優先）
+	Vibrate             bool     // 受け取るモーニングコールの配信時にバイブレーションするか（コールごとの設定がある場合はそちらを優先）
+
+	NotifyOnConfirmation bool // 送ったモーニングコールを受信者が起床確認したときに通知を受け取るか
+	NotifyOnExpiration   bool // 送ったモーニングコールが起床確認されないまま期限切れになったときに通知を受け取るか
+	NotifyOnNewLogin     bool // 初めてのデバイス・IPアドレスからログインしたときに通知を受け取るか
+
+	KnownLogins []KnownLogin // 過去にログインしたデバイスとIPアドレスの組み合わせ（最大MaxKnownLogins件）
+
+	Points int // 送ったモーニングコールが起床確認されるたびに貯まる感謝ポイント（リポジトリのAddPointsでのみ加算する）
+
+	PasswordHistory []string // 過去に使用したパスワードのハッシュ値（新しい順、現在のパスワードは含まない）
+
+	UsernameChangedAt *time.Time // 最後にユーザー名を変更した日時（一度も変更していない場合はnil）
+
+	CallWindow *valueobject.CallWindow // モーニングコールを受け付ける曜日と時間帯（nilの場合は制限しない、友達ごとの設定がある場合はそちらを優先）
+
+	PendingEmail         string     // 変更申請中の新しいメールアドレス（申請がない場合は空）
+	EmailChangeTokenHash string     // メールアドレス変更の確認トークンのハッシュ値
+	EmailChangeExpiresAt *time.Time // 確認トークンの有効期限
+
+	DeletionScheduledAt *time.Time // 削除予定のアカウントが完全に削除される日時（削除予定でない場合はnil）
+
+	ForwardCallsTo    *string    // 不在時に自分宛てのモーニングコールを転送する友達のユーザーID（転送しない場合はnil）
+	ForwardCallsFrom  *time.Time // 転送の開始日時（この日時以降に予定されたコールを転送する）
+	ForwardCallsUntil *time.Time // 転送の終了日時（この日時より前に予定されたコールまで転送する）
 }
 
+// MaxProxyConfirmers は登録できる起床確認の代理人の最大人数
+const MaxProxyConfirmers = 5
+
+// EmailChangeTokenTTL はメールアドレス変更の確認トークンの有効期間
+const EmailChangeTokenTTL = 24 * time.Hour
+
+// DefaultAccountDeletionGracePeriod はアカウントの削除を申請してから完全に削除されるまでのデフォルトの猶予期間
+const DefaultAccountDeletionGracePeriod = 30 * 24 * time.Hour
+
+// DefaultUsernameChangeCooldown はユーザー名を変更してから再び変更できるようになるまでのデフォルト期間
+const DefaultUsernameChangeCooldown = 30 * 24 * time.Hour
+
+// MaxCallForwardingPeriod は不在時の転送を設定できる最長の期間
+const MaxCallForwardingPeriod = 30 * 24 * time.Hour
+
 // emailRegex はメールアドレスの簡易的な検証用正規表現
 var emailRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
 
 // NewUser は新しいユーザーエンティティを作成する
-func NewUser(id, username, email, passwordHash string) (*User, valueobject.NGReason) {
+// ユーザー名・メールアドレスの文字数はlimitsで検証する
+func NewUser(id, username, email, passwordHash string, limits valueobject.InputLimits) (*User, valueobject.NGReason) {
 	user := &User{
 		ID:           id,
 		Username:     username,
 		Email:        email,
 		PasswordHash: passwordHash,
+		Plan:         valueobject.PlanFree,
 		CreatedAt:    time.Now(),
 		UpdatedAt:    time.Now(),
+
+		NotifyOnConfirmation: true, // 起床確認の通知はデフォルトで受け取る
+		NotifyOnExpiration:   true, // 期限切れの通知もデフォルトで受け取る
+		NotifyOnNewLogin:     true, // 身に覚えのないログインに気づけるようデフォルトで受け取る
+
+		Volume:  valueobject.DefaultVolume,
+		Vibrate: true, // 音に気づかない場合に備えてデフォルトでバイブレーションする
 	}
 
 	// 検証
-	if reason := user.Validate(); reason.IsNG() {
+	if reason := user.Validate(limits); reason.IsNG() {
 		return nil, reason
 	}
 
@@ -41,19 +101,19 @@ func NewUser(id, username, email, passwordHash string) (*User, valueobject.NGRea
 }
 
 // Validate はユーザーエンティティの妥当性を検証する
-func (u *User) Validate() valueobject.NGReason {
+func (u *User) Validate(limits valueobject.InputLimits) valueobject.NGReason {
 	// ID検証
 	if u.ID == "" {
-		return valueobject.NG("ユーザーIDは必須です")
+		return valueobject.NGWithCode(valueobject.ReasonCodeRequired, "id", "ユーザーIDは必須です")
 	}
 
 	// ユーザー名検証
-	if reason := u.ValidateUsername(); reason.IsNG() {
+	if reason := u.ValidateUsername(limits); reason.IsNG() {
 		return reason
 	}
 
 	// メールアドレス検証
-	if reason := u.ValidateEmail(); reason.IsNG() {
+	if reason := u.ValidateEmail(limits); reason.IsNG() {
 		return reason
 	}
 
@@ -61,23 +121,23 @@ func (u *User) Validate() valueobject.NGReason {
 }
 
 // ValidateUsername はユーザー名の妥当性を検証する
-func (u *User) ValidateUsername() valueobject.NGReason {
+func (u *User) ValidateUsername(limits valueobject.InputLimits) valueobject.NGReason {
 	if u.Username == "" {
-		return valueobject.NG("ユーザー名は必須です")
+		return valueobject.NGWithCode(valueobject.ReasonCodeRequired, "username", "ユーザー名は必須です")
 	}
 
-	if len(u.Username) < 3 {
-		return valueobject.NG("ユーザー名は3文字以上である必要があります")
+	if len(u.Username) < limits.UsernameMinLength {
+		return valueobject.NGWithCode(valueobject.ReasonCodeTooShort, "username", fmt.Sprintf("ユーザー名は%d文字以上である必要があります", limits.UsernameMinLength))
 	}
 
-	if len(u.Username) > 30 {
-		return valueobject.NG("ユーザー名は30文字以内である必要があります")
+	if len(u.Username) > limits.UsernameMaxLength {
+		return valueobject.NGWithCode(valueobject.ReasonCodeTooLong, "username", fmt.Sprintf("ユーザー名は%d文字以内である必要があります", limits.UsernameMaxLength))
 	}
 
 	// ユーザー名に使用可能な文字のチェック（英数字、アンダースコア、ハイフン）
 	for _, r := range u.Username {
 		if !((r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' || r == '-') {
-			return valueobject.NG("ユーザー名には英数字、アンダースコア、ハイフンのみ使用できます")
+			return valueobject.NGWithCode(valueobject.ReasonCodeInvalidFormat, "username", "ユーザー名には英数字、アンダースコア、ハイフンのみ使用できます")
 		}
 	}
 
@@ -85,20 +145,20 @@ func (u *User) ValidateUsername() valueobject.NGReason {
 }
 
 // ValidateEmail はメールアドレスの妥当性を検証する
-func (u *User) ValidateEmail() valueobject.NGReason {
+func (u *User) ValidateEmail(limits valueobject.InputLimits) valueobject.NGReason {
 	if u.Email == "" {
-		return valueobject.NG("メールアドレスは必須です")
+		return valueobject.NGWithCode(valueobject.ReasonCodeRequired, "email", "メールアドレスは必須です")
 	}
 
 	// 小文字に正規化
 	u.Email = strings.ToLower(u.Email)
 
 	if !emailRegex.MatchString(u.Email) {
-		return valueobject.NG("メールアドレスの形式が正しくありません")
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidFormat, "email", "メールアドレスの形式が正しくありません")
 	}
 
-	if len(u.Email) > 255 {
-		return valueobject.NG("メールアドレスは255文字以内である必要があります")
+	if len(u.Email) > limits.EmailMaxLength {
+		return valueobject.NGWithCode(valueobject.ReasonCodeTooLong, "email", fmt.Sprintf("メールアドレスは%d文字以内である必要があります", limits.EmailMaxLength))
 	}
 
 	return valueobject.OK()
@@ -107,16 +167,16 @@ func (u *User) ValidateEmail() valueobject.NGReason {
 // ValidatePassword はパスワードの妥当性を検証する（平文パスワード用）
 func ValidatePassword(password string) valueobject.NGReason {
 	if password == "" {
-		return valueobject.NG("パスワードは必須です")
+		return valueobject.NGWithCode(valueobject.ReasonCodeRequired, "password", "パスワードは必須です")
 	}
 
 	if len(password) < 8 {
-		return valueobject.NG("パスワードは8文字以上である必要があります")
+		return valueobject.NGWithCode(valueobject.ReasonCodeTooShort, "password", "パスワードは8文字以上である必要があります")
 	}
 
 	// bcryptの制限（72バイト）を考慮
 	if len(password) > 72 {
-		return valueobject.NG("パスワードは72文字以内である必要があります")
+		return valueobject.NGWithCode(valueobject.ReasonCodeTooLong, "password", "パスワードは72文字以内である必要があります")
 	}
 
 	// パスワード強度の基本的なチェック
@@ -139,7 +199,7 @@ func ValidatePassword(password string) valueobject.NGReason {
 	}
 
 	if !hasUpper || !hasLower || !hasDigit || !hasSpecial {
-		return valueobject.NG("パスワードは大文字、小文字、数字、特殊文字をそれぞれ1文字以上含む必要があります")
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidFormat, "password", "パスワードは大文字、小文字、数字、特殊文字をそれぞれ1文字以上含む必要があります")
 	}
 
 	return valueobject.OK()
@@ -149,17 +209,17 @@ func ValidatePassword(password string) valueobject.NGReason {
 // 友達関係の確認は別レイヤーで行うため、ここでは自己送信のチェックのみ
 func (u *User) CanSendMorningCallTo(receiverID string) valueobject.NGReason {
 	if u.ID == receiverID {
-		return valueobject.NG("自分自身にモーニングコールを設定することはできません")
+		return valueobject.NGWithCode(valueobject.ReasonCodeSelfReference, "receiver_id", "自分自身にモーニングコールを設定することはできません")
 	}
 	return valueobject.OK()
 }
 
 // UpdateUsername はユーザー名を更新する
-func (u *User) UpdateUsername(newUsername string) valueobject.NGReason {
+func (u *User) UpdateUsername(newUsername string, limits valueobject.InputLimits) valueobject.NGReason {
 	oldUsername := u.Username
 	u.Username = newUsername
 
-	if reason := u.ValidateUsername(); reason.IsNG() {
+	if reason := u.ValidateUsername(limits); reason.IsNG() {
 		u.Username = oldUsername // ロールバック
 		return reason
 	}
@@ -168,12 +228,75 @@ func (u *User) UpdateUsername(newUsername string) valueobject.NGReason {
 	return valueobject.OK()
 }
 
+// NextUsernameChangeAt はユーザー名を次に変更できるようになる日時を返す（一度も変更していない場合はnil）
+func (u *User) NextUsernameChangeAt(cooldown time.Duration) *time.Time {
+	if u.UsernameChangedAt == nil {
+		return nil
+	}
+	next := u.UsernameChangedAt.Add(cooldown)
+	return &next
+}
+
+// ChangeUsername は利用者の操作でユーザー名を変更する
+// 前回の変更からcooldownが経過するまでは再変更できない（経過した時刻ちょうどからは変更できる）
+// 重複の確認はリポジトリで行う
+func (u *User) ChangeUsername(newUsername string, now time.Time, cooldown time.Duration, limits valueobject.InputLimits) valueobject.NGReason {
+	if next := u.NextUsernameChangeAt(cooldown); next != nil && now.Before(*next) {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "username",
+			fmt.Sprintf("ユーザー名は%sまで変更できません", next.Format(time.RFC3339)))
+	}
+	// 大小文字のみの変更も同じユーザー名として扱う
+	if strings.EqualFold(newUsername, u.Username) {
+		return valueobject.NGWithCode(valueobject.ReasonCodeDuplicate, "username", "新しいユーザー名が現在のユーザー名と同じです")
+	}
+
+	if reason := u.UpdateUsername(newUsername, limits); reason.IsNG() {
+		return reason
+	}
+	changedAt := now
+	u.UsernameChangedAt = &changedAt
+	return valueobject.OK()
+}
+
+// ScheduleDeletion はアカウントを削除予定にし、猶予期間の経過後に完全に削除されるようにする
+// 猶予期間中はCancelDeletionで取り消せる
+func (u *User) ScheduleDeletion(now time.Time, gracePeriod time.Duration) valueobject.NGReason {
+	if u.IsPendingDeletion() {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "",
+			fmt.Sprintf("アカウントは既に削除予定です（%sに削除されます）", u.DeletionScheduledAt.Format(time.RFC3339)))
+	}
+	scheduledAt := now.Add(gracePeriod)
+	u.DeletionScheduledAt = &scheduledAt
+	u.UpdatedAt = now
+	return valueobject.OK()
+}
+
+// CancelDeletion はアカウントの削除予定を取り消し、取り消したかを返す（削除予定でない場合は何もしない）
+func (u *User) CancelDeletion(now time.Time) bool {
+	if !u.IsPendingDeletion() {
+		return false
+	}
+	u.DeletionScheduledAt = nil
+	u.UpdatedAt = now
+	return true
+}
+
+// IsPendingDeletion はアカウントが削除予定かを判定する
+func (u *User) IsPendingDeletion() bool {
+	return u.DeletionScheduledAt != nil
+}
+
+// IsDeletionDue は削除予定のアカウントの猶予期間が過ぎ、完全に削除する時期かを判定する
+func (u *User) IsDeletionDue(now time.Time) bool {
+	return u.IsPendingDeletion() && !now.Before(*u.DeletionScheduledAt)
+}
+
 // UpdateEmail はメールアドレスを更新する
-func (u *User) UpdateEmail(newEmail string) valueobject.NGReason {
+func (u *User) UpdateEmail(newEmail string, limits valueobject.InputLimits) valueobject.NGReason {
 	oldEmail := u.Email
 	u.Email = newEmail
 
-	if reason := u.ValidateEmail(); reason.IsNG() {
+	if reason := u.ValidateEmail(limits); reason.IsNG() {
 		u.Email = oldEmail // ロールバック
 		return reason
 	}
@@ -189,3 +312,339 @@ func (u *User) Equals(other *User) bool {
 	}
 	return u.ID == other.ID
 }
+
+// Clone はユーザーのディープコピーを返す（呼び出し側で変更しても元のユーザーに影響しない）
+func (u *User) Clone() *User {
+	userCopy := *u
+	if u.ProxyConfirmerIDs != nil {
+		userCopy.ProxyConfirmerIDs = append([]string(nil), u.ProxyConfirmerIDs...)
+	}
+	if u.KnownLogins != nil {
+		userCopy.KnownLogins = append([]KnownLogin(nil), u.KnownLogins...)
+	}
+	if u.PasswordHistory != nil {
+		userCopy.PasswordHistory = append([]string(nil), u.PasswordHistory...)
+	}
+	if u.UsernameChangedAt != nil {
+		changedAt := *u.UsernameChangedAt
+		userCopy.UsernameChangedAt = &changedAt
+	}
+	if u.CallWindow != nil {
+		window := *u.CallWindow
+		if u.CallWindow.Weekdays != nil {
+			window.Weekdays = append([]time.Weekday(nil), u.CallWindow.Weekdays...)
+		}
+		userCopy.CallWindow = &window
+	}
+	if u.EmailChangeExpiresAt != nil {
+		expiresAt := *u.EmailChangeExpiresAt
+		userCopy.EmailChangeExpiresAt = &expiresAt
+	}
+	if u.DeletionScheduledAt != nil {
+		scheduledAt := *u.DeletionScheduledAt
+		userCopy.DeletionScheduledAt = &scheduledAt
+	}
+	if u.ForwardCallsTo != nil {
+		forwardTo := *u.ForwardCallsTo
+		userCopy.ForwardCallsTo = &forwardTo
+	}
+	if u.ForwardCallsFrom != nil {
+		forwardFrom := *u.ForwardCallsFrom
+		userCopy.ForwardCallsFrom = &forwardFrom
+	}
+	if u.ForwardCallsUntil != nil {
+		forwardUntil := *u.ForwardCallsUntil
+		userCopy.ForwardCallsUntil = &forwardUntil
+	}
+	return &userCopy
+}
+
+// EffectivePlan は適用されるプランを返す（未設定・無効な値はフリープラン）
+func (u *User) EffectivePlan() valueobject.Plan {
+	if u.Plan.IsValid() {
+		return u.Plan
+	}
+	return valueobject.PlanFree
+}
+
+// ChangePlan は契約プランを変更する
+func (u *User) ChangePlan(plan valueobject.Plan) valueobject.NGReason {
+	if !plan.IsValid() {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidFormat, "plan", "無効なプランです")
+	}
+
+	u.Plan = plan
+	u.UpdatedAt = time.Now()
+	return valueobject.OK()
+}
+
+// SetRequireCallApproval はモーニングコールの事前承認制の有効・無効を設定する
+// 設定は以降に作成されるコールにのみ適用され、作成済みのコールの状態は変わらない
+func (u *User) SetRequireCallApproval(enabled bool) {
+	u.RequireCallApproval = enabled
+	u.UpdatedAt = time.Now()
+}
+
+// SetSilentDelivery は受け取るモーニングコールの無音配信のデフォルト設定を変更する
+// 作成済みのコールにも、コールごとの設定がない限り配信時に適用される
+func (u *User) SetSilentDelivery(silent bool) {
+	u.SilentDelivery = silent
+	u.UpdatedAt = time.Now()
+}
+
+// SetVolume は受け取るモーニングコールの配信時の音量のデフォルト設定を変更する
+// 作成済みのコールにも、コールごとの設定がない限り配信時に適用される
+func (u *User) SetVolume(volume int) valueobject.NGReason {
+	if reason := valueobject.ValidateVolume(volume); reason.IsNG() {
+		return reason
+	}
+	u.Volume = volume
+	u.UpdatedAt = time.Now()
+	return valueobject.OK()
+}
+
+// SetVibrate は受け取るモーニングコールの配信時にバイブレーションするかのデフォルト設定を変更する
+// 作成済みのコールにも、コールごとの設定がない限り配信時に適用される
+func (u *User) SetVibrate(vibrate bool) {
+	u.Vibrate = vibrate
+	u.UpdatedAt = time.Now()
+}
+
+// ChangePassword はパスワードのハッシュ値を変更し、変更前のハッシュ値を履歴の先頭に追加する
+// 履歴はhistorySize件まで保持し、超えた分は古いものから押し出す（0以下の場合は履歴を保持しない）
+// 再利用の判定はハッシュの照合が必要なため、呼び出し側で事前に行うこと
+func (u *User) ChangePassword(newPasswordHash string, historySize int) valueobject.NGReason {
+	if newPasswordHash == "" {
+		return valueobject.NGWithCode(valueobject.ReasonCodeRequired, "new_password", "パスワードは必須です")
+	}
+
+	history := []string{}
+	if historySize > 0 {
+		if u.PasswordHash != "" {
+			history = append(history, u.PasswordHash)
+		}
+		history = append(history, u.PasswordHistory...)
+		if len(history) > historySize {
+			history = history[:historySize]
+		}
+	}
+
+	u.PasswordHistory = history
+	u.PasswordHash = newPasswordHash
+	u.UpdatedAt = time.Now()
+	return valueobject.OK()
+}
+
+// SetNotifyOnConfirmation は送ったモーニングコールの起床確認の通知を受け取るかを変更する
+// 起床確認自体は設定に関わらず記録される
+func (u *User) SetNotifyOnConfirmation(notify bool) {
+	u.NotifyOnConfirmation = notify
+	u.UpdatedAt = time.Now()
+}
+
+// SetNotifyOnExpiration は送ったモーニングコールが起床確認されないまま期限切れになったときの通知を受け取るかを変更する
+func (u *User) SetNotifyOnExpiration(notify bool) {
+	u.NotifyOnExpiration = notify
+	u.UpdatedAt = time.Now()
+}
+
+// SetCallWindow はモーニングコールを受け付ける曜日と時間帯を設定する（nilを指定すると制限を解除する）
+// 時間帯は受信者のタイムゾーンで判定する
+func (u *User) SetCallWindow(window *valueobject.CallWindow) valueobject.NGReason {
+	if window != nil {
+		if reason := window.Validate(); reason.IsNG() {
+			return reason
+		}
+	}
+	u.CallWindow = window
+	u.UpdatedAt = time.Now()
+	return valueobject.OK()
+}
+
+// SetTimeZone はタイムゾーンを設定する（空文字を指定するとサーバーのタイムゾーンに戻す）
+func (u *User) SetTimeZone(name string) valueobject.NGReason {
+	if name != "" {
+		if _, err := time.LoadLocation(name); err != nil {
+			return valueobject.NGWithCode(valueobject.ReasonCodeInvalidFormat, "time_zone", "タイムゾーンが不正です")
+		}
+	}
+
+	u.TimeZone = name
+	u.UpdatedAt = time.Now()
+	return valueobject.OK()
+}
+
+// Location はユーザーのタイムゾーンを返す
+// 未設定または読み込めない場合はサーバーのタイムゾーンを返す
+func (u *User) Location() *time.Location {
+	if u.TimeZone == "" {
+		return time.Local
+	}
+	loc, err := time.LoadLocation(u.TimeZone)
+	if err != nil {
+		return time.Local
+	}
+	return loc
+}
+
+// AddProxyConfirmer は起床確認を代理できるユーザーを追加する
+func (u *User) AddProxyConfirmer(userID string) valueobject.NGReason {
+	if userID == "" {
+		return valueobject.NGWithCode(valueobject.ReasonCodeRequired, "proxy_confirmer_id", "代理人のユーザーIDは必須です")
+	}
+	if userID == u.ID {
+		return valueobject.NGWithCode(valueobject.ReasonCodeSelfReference, "proxy_confirmer_id", "自分自身を代理人に設定することはできません")
+	}
+	if u.IsProxyConfirmer(userID) {
+		return valueobject.NGWithCode(valueobject.ReasonCodeDuplicate, "proxy_confirmer_id", "すでに代理人に設定されています")
+	}
+	if len(u.ProxyConfirmerIDs) >= MaxProxyConfirmers {
+		return valueobject.NGWithCode(valueobject.ReasonCodeLimitExceeded, "proxy_confirmer_id", "代理人は最大5人まで設定できます")
+	}
+
+	// 共有されている可能性のあるスライスを変更しないよう新しいスライスを作成する
+	ids := make([]string, 0, len(u.ProxyConfirmerIDs)+1)
+	ids = append(ids, u.ProxyConfirmerIDs...)
+	u.ProxyConfirmerIDs = append(ids, userID)
+	u.UpdatedAt = time.Now()
+	return valueobject.OK()
+}
+
+// RemoveProxyConfirmer は起床確認の代理人を解除する
+func (u *User) RemoveProxyConfirmer(userID string) valueobject.NGReason {
+	if !u.IsProxyConfirmer(userID) {
+		return valueobject.NGWithCode(valueobject.ReasonCodeNotApplicable, "proxy_confirmer_id", "代理人に設定されていません")
+	}
+
+	ids := make([]string, 0, len(u.ProxyConfirmerIDs)-1)
+	for _, id := range u.ProxyConfirmerIDs {
+		if id != userID {
+			ids = append(ids, id)
+		}
+	}
+	u.ProxyConfirmerIDs = ids
+	u.UpdatedAt = time.Now()
+	return valueobject.OK()
+}
+
+// IsProxyConfirmer は指定したユーザーが起床確認の代理人かを判定する
+func (u *User) IsProxyConfirmer(userID string) bool {
+	for _, id := range u.ProxyConfirmerIDs {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// SetCallForwarding は不在時に自分宛てのモーニングコールを転送する友達と期間を設定する
+// fromがゼロ値の場合はnowから転送する。既に設定がある場合は置き換える
+func (u *User) SetCallForwarding(targetID string, from, until, now time.Time) valueobject.NGReason {
+	if targetID == "" {
+		return valueobject.NGWithCode(valueobject.ReasonCodeRequired, "forward_to", "転送先のユーザーIDは必須です")
+	}
+	if targetID == u.ID {
+		return valueobject.NGWithCode(valueobject.ReasonCodeSelfReference, "forward_to", "自分自身を転送先に設定することはできません")
+	}
+	if from.IsZero() {
+		from = now
+	}
+	if !until.After(now) {
+		return valueobject.NGWithCode(valueobject.ReasonCodeOutOfRange, "until", "転送の終了日時は現在より後である必要があります")
+	}
+	if !until.After(from) {
+		return valueobject.NGWithCode(valueobject.ReasonCodeOutOfRange, "until", "転送の終了日時は開始日時より後である必要があります")
+	}
+	if until.Sub(from) > MaxCallForwardingPeriod {
+		return valueobject.NGWithCode(valueobject.ReasonCodeOutOfRange, "until", "転送の期間は30日以内である必要があります")
+	}
+
+	u.ForwardCallsTo = &targetID
+	u.ForwardCallsFrom = &from
+	u.ForwardCallsUntil = &until
+	u.UpdatedAt = now
+	return valueobject.OK()
+}
+
+// ClearCallForwarding は不在時の転送を解除し、解除したかを返す（設定がない場合は何もしない）
+func (u *User) ClearCallForwarding(now time.Time) bool {
+	if u.ForwardCallsTo == nil {
+		return false
+	}
+	u.ForwardCallsTo = nil
+	u.ForwardCallsFrom = nil
+	u.ForwardCallsUntil = nil
+	u.UpdatedAt = now
+	return true
+}
+
+// CallForwardingTarget は指定した時刻に予定されたコールの転送先を返す（転送期間外の場合はfalse）
+func (u *User) CallForwardingTarget(at time.Time) (string, bool) {
+	if u.ForwardCallsTo == nil || u.ForwardCallsFrom == nil || u.ForwardCallsUntil == nil {
+		return "", false
+	}
+	if at.Before(*u.ForwardCallsFrom) || !at.Before(*u.ForwardCallsUntil) {
+		return "", false
+	}
+	return *u.ForwardCallsTo, true
+}
+
+// IsForwardingCallsTo は指定したユーザーへの転送が設定されていて、まだ終了していないかを判定する
+func (u *User) IsForwardingCallsTo(userID string, now time.Time) bool {
+	return u.ForwardCallsTo != nil && *u.ForwardCallsTo == userID &&
+		u.ForwardCallsUntil != nil && now.Before(*u.ForwardCallsUntil)
+}
+
+// RequestEmailChange は新しいメールアドレスへの変更を申請する
+// 確認が完了するまでは現在のメールアドレスがそのまま使われる
+func (u *User) RequestEmailChange(newEmail, tokenHash string, now time.Time, limits valueobject.InputLimits) valueobject.NGReason {
+	// 現在のメールアドレスと同じ検証ルールを適用する（小文字に正規化される）
+	candidate := &User{Email: newEmail}
+	if reason := candidate.ValidateEmail(limits); reason.IsNG() {
+		return reason
+	}
+	if candidate.Email == strings.ToLower(u.Email) {
+		return valueobject.NGWithCode(valueobject.ReasonCodeDuplicate, "email", "現在と同じメールアドレスには変更できません")
+	}
+	if tokenHash == "" {
+		return valueobject.NGWithCode(valueobject.ReasonCodeRequired, "token", "確認トークンは必須です")
+	}
+
+	expiresAt := now.Add(EmailChangeTokenTTL)
+	u.PendingEmail = candidate.Email
+	u.EmailChangeTokenHash = tokenHash
+	u.EmailChangeExpiresAt = &expiresAt
+	u.UpdatedAt = now
+	return valueobject.OK()
+}
+
+// HasPendingEmailChange はメールアドレスの変更申請中かを判定する
+func (u *User) HasPendingEmailChange() bool {
+	return u.PendingEmail != ""
+}
+
+// ConfirmEmailChange は確認トークンを検証し、申請中のメールアドレスに変更する
+func (u *User) ConfirmEmailChange(tokenHash string, now time.Time, limits valueobject.InputLimits) valueobject.NGReason {
+	if !u.HasPendingEmailChange() {
+		return valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "メールアドレスの変更申請がありません")
+	}
+	if u.EmailChangeExpiresAt == nil || now.After(*u.EmailChangeExpiresAt) {
+		return valueobject.NGWithCode(valueobject.ReasonCodeExpired, "token", "確認トークンの有効期限が切れています")
+	}
+	if subtle.ConstantTimeCompare([]byte(tokenHash), []byte(u.EmailChangeTokenHash)) != 1 {
+		return valueobject.NGWithCode(valueobject.ReasonCodeMismatch, "token", "確認トークンが正しくありません")
+	}
+
+	if reason := u.UpdateEmail(u.PendingEmail, limits); reason.IsNG() {
+		return reason
+	}
+	u.CancelEmailChange()
+	return valueobject.OK()
+}
+
+// CancelEmailChange はメールアドレスの変更申請を取り消す
+func (u *User) CancelEmailChange() {
+	u.PendingEmail = ""
+	u.EmailChangeTokenHash = ""
+	u.EmailChangeExpiresAt = nil
+}
@@ -1,9 +1,12 @@
 package entity
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
 )
 
 func TestNewUser(t *testing.T) {
@@ -91,7 +94,7 @@ func TestNewUser(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			user, reason := NewUser(tt.id, tt.username, tt.email, tt.passwordHash)
+			user, reason := NewUser(tt.id, tt.username, tt.email, tt.passwordHash, valueobject.DefaultInputLimits())
 
 			if tt.expectError {
 				if reason.IsOK() {
@@ -124,12 +127,32 @@ func TestNewUser(t *testing.T) {
 					if user.PasswordHash != tt.passwordHash {
 						t.Errorf("PasswordHash: expected %s, got %s", tt.passwordHash, user.PasswordHash)
 					}
+					if !user.NotifyOnConfirmation {
+						t.Errorf("NotifyOnConfirmation: 新規ユーザーは起床確認の通知を受け取る設定が期待された")
+					}
+					if !user.NotifyOnExpiration {
+						t.Errorf("NotifyOnExpiration: 新規ユーザーは期限切れの通知を受け取る設定が期待された")
+					}
 				}
 			}
 		})
 	}
 }
 
+func TestNewUser_InputLimits(t *testing.T) {
+	limits := valueobject.DefaultInputLimits()
+	limits.UsernameMaxLength = 40
+	limits.EmailMaxLength = 20
+
+	// デフォルトの上限（30文字）を超えるユーザー名も許可される
+	if _, reason := NewUser("user1", strings.Repeat("a", 35), "a@example.com", "hash", limits); reason.IsNG() {
+		t.Errorf("NewUser() reason = %q, want OK", reason)
+	}
+	if _, reason := NewUser("user1", "alice", "alice.long.address@example.com", "hash", limits); reason.Error() != "メールアドレスは20文字以内である必要があります" {
+		t.Errorf("NewUser() reason = %q, want email length error", reason)
+	}
+}
+
 func TestUser_ValidateUsername(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -195,7 +218,7 @@ func TestUser_ValidateUsername(t *testing.T) {
 			user := &User{
 				Username: tt.username,
 			}
-			reason := user.ValidateUsername()
+			reason := user.ValidateUsername(valueobject.DefaultInputLimits())
 
 			if tt.expectError {
 				if reason.IsOK() {
@@ -288,7 +311,7 @@ func TestUser_ValidateEmail(t *testing.T) {
 			user := &User{
 				Email: tt.email,
 			}
-			reason := user.ValidateEmail()
+			reason := user.ValidateEmail(valueobject.DefaultInputLimits())
 
 			if tt.expectError {
 				if reason.IsOK() {
@@ -393,7 +416,7 @@ func TestUser_UpdateUsername(t *testing.T) {
 			}
 
 			oldUpdatedAt := user.UpdatedAt
-			reason := user.UpdateUsername(tt.newName)
+			reason := user.UpdateUsername(tt.newName, valueobject.DefaultInputLimits())
 
 			if tt.expectError {
 				if reason.IsOK() {
@@ -467,7 +490,7 @@ func TestUser_UpdateEmail(t *testing.T) {
 			}
 
 			oldUpdatedAt := user.UpdatedAt
-			reason := user.UpdateEmail(tt.newEmail)
+			reason := user.UpdateEmail(tt.newEmail, valueobject.DefaultInputLimits())
 
 			if tt.expectError {
 				if reason.IsOK() {
@@ -651,14 +674,315 @@ func TestValidatePassword(t *testing.T) {
 			if tt.expectError {
 				if result.IsOK() {
 					t.Errorf("エラーが期待されたが成功した")
-				} else if string(result) != tt.errorMsg {
-					t.Errorf("期待するエラーメッセージ = %q, 実際 = %q", tt.errorMsg, string(result))
+				} else if result.Error() != tt.errorMsg {
+					t.Errorf("期待するエラーメッセージ = %q, 実際 = %q", tt.errorMsg, result.Error())
 				}
 			} else {
 				if !result.IsOK() {
-					t.Errorf("成功が期待されたがエラーだった: %s", string(result))
+					t.Errorf("成功が期待されたがエラーだった: %s", result.Error())
+				}
+			}
+		})
+	}
+}
+
+func TestUser_ChangePlan(t *testing.T) {
+	user := &User{ID: "user1", Username: "alice", Email: "alice@example.com"}
+
+	if got := user.EffectivePlan(); got != valueobject.PlanFree {
+		t.Errorf("未設定のプランはフリープランのはずです: got %s", got)
+	}
+
+	if reason := user.ChangePlan(valueobject.PlanPremium); reason.IsNG() {
+		t.Fatalf("ChangePlan() returned NG: %s", reason)
+	}
+	if user.Plan != valueobject.PlanPremium || user.EffectivePlan() != valueobject.PlanPremium {
+		t.Errorf("プランが更新されていません: got %s", user.Plan)
+	}
+	if user.UpdatedAt.IsZero() {
+		t.Error("UpdatedAtが更新されていません")
+	}
+
+	if reason := user.ChangePlan(valueobject.Plan("gold")); reason.IsOK() {
+		t.Error("無効なプランはエラーになるはずです")
+	}
+	if user.Plan != valueobject.PlanPremium {
+		t.Errorf("無効なプランで変更されてはいけません: got %s", user.Plan)
+	}
+}
+
+func TestUser_EmailChange(t *testing.T) {
+	now := time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)
+
+	newUser := func() *User {
+		return &User{ID: "user1", Username: "alice", Email: "alice@example.com"}
+	}
+
+	t.Run("申請から確認まで", func(t *testing.T) {
+		user := newUser()
+		if reason := user.RequestEmailChange("Alice.New@Example.com", "hash", now, valueobject.DefaultInputLimits()); reason.IsNG() {
+			t.Fatalf("RequestEmailChange() returned NG: %s", reason)
+		}
+		if user.PendingEmail != "alice.new@example.com" {
+			t.Errorf("PendingEmailが正規化されていません: got %s", user.PendingEmail)
+		}
+		if user.Email != "alice@example.com" {
+			t.Errorf("確認前にメールアドレスが変更されています: got %s", user.Email)
+		}
+		if user.EmailChangeExpiresAt == nil || !user.EmailChangeExpiresAt.Equal(now.Add(EmailChangeTokenTTL)) {
+			t.Errorf("有効期限が正しくありません: got %v", user.EmailChangeExpiresAt)
+		}
+
+		if reason := user.ConfirmEmailChange("hash", now.Add(time.Hour), valueobject.DefaultInputLimits()); reason.IsNG() {
+			t.Fatalf("ConfirmEmailChange() returned NG: %s", reason)
+		}
+		if user.Email != "alice.new@example.com" {
+			t.Errorf("メールアドレスが変更されていません: got %s", user.Email)
+		}
+		if user.HasPendingEmailChange() || user.EmailChangeTokenHash != "" || user.EmailChangeExpiresAt != nil {
+			t.Error("確認後は申請情報がクリアされるはずです")
+		}
+	})
+
+	tests := []struct {
+		name     string
+		newEmail string
+		token    string
+		confirm  time.Time
+		errorMsg string
+	}{
+		{name: "形式が不正なメールアドレス", newEmail: "invalid", token: "hash", errorMsg: "メールアドレスの形式が正しくありません"},
+		{name: "現在と同じメールアドレス", newEmail: "ALICE@example.com", token: "hash", errorMsg: "現在と同じメールアドレスには変更できません"},
+		{name: "トークンが一致しない", newEmail: "new@example.com", token: "other", confirm: now, errorMsg: "確認トークンが正しくありません"},
+		{name: "有効期限切れ", newEmail: "new@example.com", token: "hash", confirm: now.Add(EmailChangeTokenTTL + time.Second), errorMsg: "確認トークンの有効期限が切れています"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := newUser()
+			reason := user.RequestEmailChange(tt.newEmail, "hash", now, valueobject.DefaultInputLimits())
+			if tt.confirm.IsZero() {
+				if reason.Error() != tt.errorMsg {
+					t.Errorf("期待するエラーメッセージ = %q, 実際 = %q", tt.errorMsg, reason.Error())
+				}
+				if user.HasPendingEmailChange() {
+					t.Error("エラー時は申請状態になってはいけません")
 				}
+				return
+			}
+
+			if reason.IsNG() {
+				t.Fatalf("RequestEmailChange() returned NG: %s", reason)
+			}
+			if reason := user.ConfirmEmailChange(tt.token, tt.confirm, valueobject.DefaultInputLimits()); reason.Error() != tt.errorMsg {
+				t.Errorf("期待するエラーメッセージ = %q, 実際 = %q", tt.errorMsg, reason.Error())
+			}
+			if user.Email != "alice@example.com" {
+				t.Errorf("エラー時にメールアドレスが変更されています: got %s", user.Email)
+			}
+		})
+	}
+
+	t.Run("申請がない状態での確認", func(t *testing.T) {
+		user := newUser()
+		if reason := user.ConfirmEmailChange("hash", now, valueobject.DefaultInputLimits()); reason.Error() != "メールアドレスの変更申請がありません" {
+			t.Errorf("予期しない結果: %q", reason.Error())
+		}
+	})
+}
+
+func TestUser_ProxyConfirmers(t *testing.T) {
+	user := &User{ID: "receiver"}
+
+	if reason := user.AddProxyConfirmer("proxy1"); reason.IsNG() {
+		t.Fatalf("AddProxyConfirmer() = %v, want OK", reason)
+	}
+	if !user.IsProxyConfirmer("proxy1") {
+		t.Error("IsProxyConfirmer(proxy1) = false, want true")
+	}
+	if reason := user.AddProxyConfirmer("proxy1"); reason.IsOK() {
+		t.Error("duplicate AddProxyConfirmer() should fail")
+	}
+	if reason := user.AddProxyConfirmer("receiver"); reason.IsOK() {
+		t.Error("AddProxyConfirmer(self) should fail")
+	}
+
+	for i := 2; i <= MaxProxyConfirmers; i++ {
+		if reason := user.AddProxyConfirmer(fmt.Sprintf("proxy%d", i)); reason.IsNG() {
+			t.Fatalf("AddProxyConfirmer(proxy%d) = %v, want OK", i, reason)
+		}
+	}
+	if reason := user.AddProxyConfirmer("one-more"); reason.IsOK() {
+		t.Error("AddProxyConfirmer() over the limit should fail")
+	}
+
+	if reason := user.RemoveProxyConfirmer("proxy1"); reason.IsNG() {
+		t.Fatalf("RemoveProxyConfirmer() = %v, want OK", reason)
+	}
+	if user.IsProxyConfirmer("proxy1") {
+		t.Error("IsProxyConfirmer(proxy1) = true after removal")
+	}
+	if len(user.ProxyConfirmerIDs) != MaxProxyConfirmers-1 {
+		t.Errorf("len(ProxyConfirmerIDs) = %d, want %d", len(user.ProxyConfirmerIDs), MaxProxyConfirmers-1)
+	}
+	if reason := user.RemoveProxyConfirmer("proxy1"); reason.IsOK() {
+		t.Error("RemoveProxyConfirmer() for unregistered user should fail")
+	}
+}
+
+func TestUser_TimeZone(t *testing.T) {
+	user := &User{ID: "user1"}
+
+	if user.Location() != time.Local {
+		t.Errorf("Location() = %v, want time.Local when unset", user.Location())
+	}
+
+	if reason := user.SetTimeZone("Asia/Tokyo"); reason.IsNG() {
+		t.Fatalf("SetTimeZone(Asia/Tokyo) = %v, want OK", reason)
+	}
+	if got := user.Location().String(); got != "Asia/Tokyo" {
+		t.Errorf("Location() = %s, want Asia/Tokyo", got)
+	}
+
+	if reason := user.SetTimeZone("Mars/Olympus"); reason.IsOK() {
+		t.Error("SetTimeZone(invalid) should fail")
+	}
+	if user.TimeZone != "Asia/Tokyo" {
+		t.Errorf("TimeZone = %s, want unchanged after invalid input", user.TimeZone)
+	}
+}
+
+func TestUser_ScheduleDeletion(t *testing.T) {
+	now := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	gracePeriod := 30 * 24 * time.Hour
+	user := &User{ID: "user1"}
+
+	if user.IsPendingDeletion() || user.IsDeletionDue(now) {
+		t.Fatal("new user should not be pending deletion")
+	}
+	if user.CancelDeletion(now) {
+		t.Error("CancelDeletion() = true, want false when not scheduled")
+	}
+
+	if reason := user.ScheduleDeletion(now, gracePeriod); reason.IsNG() {
+		t.Fatalf("ScheduleDeletion() = %v, want OK", reason)
+	}
+	if !user.IsPendingDeletion() {
+		t.Error("IsPendingDeletion() = false after ScheduleDeletion()")
+	}
+	if user.IsDeletionDue(now.Add(gracePeriod - time.Second)) {
+		t.Error("IsDeletionDue() = true during grace period")
+	}
+	if !user.IsDeletionDue(now.Add(gracePeriod)) {
+		t.Error("IsDeletionDue() = false after grace period")
+	}
+
+	// 削除予定の間は重ねて申請できない
+	if reason := user.ScheduleDeletion(now.Add(time.Hour), gracePeriod); reason.Code() != valueobject.ReasonCodeInvalidState {
+		t.Errorf("ScheduleDeletion() twice = %v, want invalid state", reason)
+	}
+	if !user.DeletionScheduledAt.Equal(now.Add(gracePeriod)) {
+		t.Errorf("DeletionScheduledAt = %v, want unchanged", user.DeletionScheduledAt)
+	}
+
+	if !user.CancelDeletion(now.Add(time.Hour)) {
+		t.Error("CancelDeletion() = false, want true")
+	}
+	if user.IsPendingDeletion() || user.IsDeletionDue(now.Add(gracePeriod)) {
+		t.Error("user should not be pending deletion after CancelDeletion()")
+	}
+}
+
+func TestUser_CallForwarding(t *testing.T) {
+	now := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	from := now.Add(24 * time.Hour)
+	until := now.Add(7 * 24 * time.Hour)
+	user := &User{ID: "user1"}
+
+	if _, ok := user.CallForwardingTarget(now); ok {
+		t.Fatal("new user should not forward calls")
+	}
+	if user.ClearCallForwarding(now) {
+		t.Error("ClearCallForwarding() = true, want false when not set")
+	}
+
+	tests := []struct {
+		name     string
+		targetID string
+		from     time.Time
+		until    time.Time
+		wantCode valueobject.ReasonCode
+	}{
+		{name: "転送先が空", targetID: "", until: until, wantCode: valueobject.ReasonCodeRequired},
+		{name: "自分自身", targetID: "user1", until: until, wantCode: valueobject.ReasonCodeSelfReference},
+		{name: "終了日時が過去", targetID: "user2", until: now.Add(-time.Minute), wantCode: valueobject.ReasonCodeOutOfRange},
+		{name: "終了日時が開始日時より前", targetID: "user2", from: until, until: from, wantCode: valueobject.ReasonCodeOutOfRange},
+		{name: "期間が長すぎる", targetID: "user2", until: now.Add(MaxCallForwardingPeriod + time.Minute), wantCode: valueobject.ReasonCodeOutOfRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if reason := user.SetCallForwarding(tt.targetID, tt.from, tt.until, now); reason.Code() != tt.wantCode {
+				t.Errorf("SetCallForwarding() = %v, want %s", reason, tt.wantCode)
+			}
+			if user.ForwardCallsTo != nil {
+				t.Error("forwarding should not be set after NG")
 			}
 		})
 	}
+
+	if reason := user.SetCallForwarding("user2", from, until, now); reason.IsNG() {
+		t.Fatalf("SetCallForwarding() = %v, want OK", reason)
+	}
+	// 開始日時以降・終了日時より前に予定されたコールのみ転送する
+	if _, ok := user.CallForwardingTarget(from.Add(-time.Second)); ok {
+		t.Error("CallForwardingTarget() before from should be false")
+	}
+	if target, ok := user.CallForwardingTarget(from); !ok || target != "user2" {
+		t.Errorf("CallForwardingTarget(from) = %q, %v, want user2", target, ok)
+	}
+	if _, ok := user.CallForwardingTarget(until); ok {
+		t.Error("CallForwardingTarget(until) should be false")
+	}
+	// 開始前でも終了していない転送は設定中として扱う
+	if !user.IsForwardingCallsTo("user2", now) || user.IsForwardingCallsTo("user3", now) || user.IsForwardingCallsTo("user2", until) {
+		t.Error("IsForwardingCallsTo() returned unexpected result")
+	}
+
+	if !user.ClearCallForwarding(now) {
+		t.Error("ClearCallForwarding() = false, want true")
+	}
+	if user.ForwardCallsTo != nil || user.ForwardCallsFrom != nil || user.ForwardCallsUntil != nil {
+		t.Error("forwarding fields should be cleared")
+	}
+}
+
+func TestUser_Clone(t *testing.T) {
+	changedAt := time.Now()
+	forwardTo := "friend"
+	user := &User{
+		ID:                "user1",
+		Username:          "user1",
+		ProxyConfirmerIDs: []string{"proxy"},
+		PasswordHistory:   []string{"old-hash"},
+		KnownLogins:       []KnownLogin{{Device: "device", IPAddress: "127.0.0.1"}},
+		UsernameChangedAt: &changedAt,
+		CallWindow:        &valueobject.CallWindow{Weekdays: []time.Weekday{time.Monday}, StartMinute: 360, EndMinute: 480},
+		ForwardCallsTo:    &forwardTo,
+	}
+
+	clone := user.Clone()
+	clone.Username = "changed"
+	clone.ProxyConfirmerIDs[0] = "changed"
+	clone.PasswordHistory[0] = "changed"
+	clone.KnownLogins[0].Device = "changed"
+	*clone.UsernameChangedAt = changedAt.Add(time.Hour)
+	clone.CallWindow.Weekdays[0] = time.Sunday
+	*clone.ForwardCallsTo = "changed"
+
+	// コピーを変更しても元のユーザーは変わらない
+	if user.Username != "user1" || user.ProxyConfirmerIDs[0] != "proxy" || user.PasswordHistory[0] != "old-hash" ||
+		user.KnownLogins[0].Device != "device" || !user.UsernameChangedAt.Equal(changedAt) ||
+		user.CallWindow.Weekdays[0] != time.Monday || *user.ForwardCallsTo != "friend" {
+		t.Errorf("original user was modified through clone: %+v", user)
+	}
 }
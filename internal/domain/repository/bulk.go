@@ -0,0 +1,41 @@
+package repository
+
+import "fmt"
+
+// BulkCreateMode はまとめて作成する際に、作成できないエンティティがあった場合の扱いを表す
+type BulkCreateMode int
+
+const (
+	// BulkCreateAllOrNothing は1件でも作成できなければ全体をロールバックし、何も作成しない
+	BulkCreateAllOrNothing BulkCreateMode = iota
+	// BulkCreatePartial は作成できるエンティティのみ作成し、作成できなかったものを結果で返す
+	BulkCreatePartial
+)
+
+// IsValid はモードが有効な値かを検証する
+func (m BulkCreateMode) IsValid() bool {
+	return m == BulkCreateAllOrNothing || m == BulkCreatePartial
+}
+
+// BulkCreateError はまとめて作成する際に作成できなかったエンティティを表す
+// errors.Is で ErrAlreadyExists・ErrInvalidArgument などの原因を判定できる
+type BulkCreateError struct {
+	Index int   // 渡したスライス内の位置
+	Err   error // 作成できなかった原因
+}
+
+// Error はエラーメッセージを返す
+func (e *BulkCreateError) Error() string {
+	return fmt.Sprintf("bulk create failed at index %d: %v", e.Index, e.Err)
+}
+
+// Unwrap は作成できなかった原因を返す
+func (e *BulkCreateError) Unwrap() error {
+	return e.Err
+}
+
+// BulkCreateResult はまとめて作成した結果
+type BulkCreateResult struct {
+	Created int                // 作成した件数
+	Failed  []*BulkCreateError // 作成できなかったエンティティ（BulkCreatePartialの場合のみ、位置の昇順）
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+)
+
+// CommentRepository はモーニングコールに紐づくコメントの永続化を担うリポジトリインターフェース
+type CommentRepository interface {
+	// Create は新しいコメントを保存する
+	// 同じIDのコメントがすでに存在する場合はErrAlreadyExistsを返す
+	Create(ctx context.Context, comment *entity.CallComment) error
+
+	// FindByCallID はコールのコメントを投稿日時の古い順に取得する
+	FindByCallID(ctx context.Context, callID string, offset, limit int) ([]*entity.CallComment, error)
+
+	// CountByCallID はコールのコメントの件数を取得する
+	CountByCallID(ctx context.Context, callID string) (int, error)
+
+	// DeleteByCallID はコールのコメントをすべて削除し、削除した件数を返す
+	// コメントがない場合は0を返す
+	DeleteByCallID(ctx context.Context, callID string) (int, error)
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+)
+
+// DeviceTokenRepository はプッシュ通知の配信先となる端末のデバイストークンの永続化を担うリポジトリインターフェース
+type DeviceTokenRepository interface {
+	// Create は新しいデバイストークンを保存する
+	// 同じIDまたは同じトークンがすでに登録されている場合はErrAlreadyExistsを返す
+	Create(ctx context.Context, device *entity.DeviceToken) error
+
+	// FindByID はIDでデバイストークンを検索する
+	FindByID(ctx context.Context, id string) (*entity.DeviceToken, error)
+
+	// FindByToken はトークンの値でデバイストークンを検索する
+	FindByToken(ctx context.Context, token string) (*entity.DeviceToken, error)
+
+	// FindByUserID はユーザーの端末を登録日時の昇順で返す（無効化されたトークンも含む）
+	FindByUserID(ctx context.Context, userID string) ([]*entity.DeviceToken, error)
+
+	// Update はデバイストークンを更新する
+	Update(ctx context.Context, device *entity.DeviceToken) error
+
+	// Delete はデバイストークンを削除する
+	Delete(ctx context.Context, id string) error
+
+	// DeleteInvalidated は無効化されたデバイストークンをすべて削除し、削除した件数を返す
+	DeleteInvalidated(ctx context.Context) (int, error)
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+)
+
+// FriendInviteRepository は友達追加用の招待トークンの永続化を担うリポジトリインターフェース
+type FriendInviteRepository interface {
+	// Create は新しい招待トークンを保存する
+	Create(ctx context.Context, invite *entity.FriendInvite) error
+
+	// BulkCreate は複数の招待トークンをまとめて作成する（テストやデモ用の初期データ投入向け）
+	// modeがBulkCreateAllOrNothingの場合、1件でも作成できなければ何も作成せず *BulkCreateError を返す
+	// BulkCreatePartialの場合は作成できたものを残し、作成できなかったものを結果のFailedで返す
+	BulkCreate(ctx context.Context, invites []*entity.FriendInvite, mode BulkCreateMode) (BulkCreateResult, error)
+
+	// FindByToken はトークンで招待を検索する
+	FindByToken(ctx context.Context, token string) (*entity.FriendInvite, error)
+
+	// Update は招待を更新する
+	// 取得後に他の更新が行われていた場合はErrUpdateConflictを返す
+	Update(ctx context.Context, invite *entity.FriendInvite) error
+}
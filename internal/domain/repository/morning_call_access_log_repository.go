@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+)
+
+// MorningCallAccessLogRepository はモーニングコールの閲覧記録（アクセスログ）を保持するリポジトリインターフェース
+// 閲覧記録はコールごとに保持件数の上限があり、上限を超えた分は古いものから破棄される
+type MorningCallAccessLogRepository interface {
+	// Record は閲覧記録を1件追加する
+	Record(ctx context.Context, access *entity.MorningCallAccess) error
+
+	// FindByMorningCallID はコールの閲覧記録を新しい順に取得する
+	FindByMorningCallID(ctx context.Context, morningCallID string, offset, limit int) ([]*entity.MorningCallAccess, error)
+
+	// CountByMorningCallID はコールの閲覧記録の件数を取得する
+	CountByMorningCallID(ctx context.Context, morningCallID string) (int, error)
+}
@@ -13,10 +13,16 @@ type MorningCallRepository interface {
 	// Create は新しいモーニングコールを作成する
 	Create(ctx context.Context, morningCall *entity.MorningCall) error
 
+	// BulkCreate は複数のモーニングコールをまとめて作成する（テストやデモ用の初期データ投入向け）
+	// modeがBulkCreateAllOrNothingの場合、1件でも作成できなければ何も作成せず *BulkCreateError を返す
+	// BulkCreatePartialの場合は作成できたものを残し、作成できなかったものを結果のFailedで返す
+	BulkCreate(ctx context.Context, morningCalls []*entity.MorningCall, mode BulkCreateMode) (BulkCreateResult, error)
+
 	// FindByID はIDでモーニングコールを検索する
 	FindByID(ctx context.Context, id string) (*entity.MorningCall, error)
 
 	// Update はモーニングコール情報を更新する
+	// 取得時からVersionが変わっている（他の更新が先に行われた）場合は ErrUpdateConflict を返す
 	Update(ctx context.Context, morningCall *entity.MorningCall) error
 
 	// Delete はモーニングコールを削除する
@@ -31,9 +37,24 @@ type MorningCallRepository interface {
 	// FindByReceiverID は受信者IDでモーニングコールを検索する
 	FindByReceiverID(ctx context.Context, receiverID string, offset, limit int) ([]*entity.MorningCall, error)
 
+	// FindByReceiverIDAndArchived は受信者IDとアーカイブ状態でモーニングコールを検索する
+	// 並び順とページネーションはFindByReceiverIDと同じ
+	FindByReceiverIDAndArchived(ctx context.Context, receiverID string, archived bool, offset, limit int) ([]*entity.MorningCall, error)
+
+	// FindReadOnlyBySenderID は送信者IDでモーニングコールを検索し、読み取り専用ビューで返す
+	// 並び順とページネーションはFindBySenderIDと同じ。コピーを作らないため、集計など読み取りのみの用途に使う
+	FindReadOnlyBySenderID(ctx context.Context, senderID string, offset, limit int) ([]entity.ReadOnlyMorningCall, error)
+
+	// FindReadOnlyByReceiverID は受信者IDでモーニングコールを検索し、読み取り専用ビューで返す
+	// 並び順とページネーションはFindByReceiverIDと同じ
+	FindReadOnlyByReceiverID(ctx context.Context, receiverID string, offset, limit int) ([]entity.ReadOnlyMorningCall, error)
+
 	// FindByStatus はステータスでモーニングコールを検索する
 	FindByStatus(ctx context.Context, status valueobject.MorningCallStatus, offset, limit int) ([]*entity.MorningCall, error)
 
+	// FindByStatusInRange はステータスと予定時刻の範囲（start以上end以下）でモーニングコールを検索する
+	FindByStatusInRange(ctx context.Context, status valueobject.MorningCallStatus, start, end time.Time, offset, limit int) ([]*entity.MorningCall, error)
+
 	// FindScheduledBefore は指定時刻より前にスケジュールされたモーニングコールを検索する
 	FindScheduledBefore(ctx context.Context, time time.Time, offset, limit int) ([]*entity.MorningCall, error)
 
@@ -43,6 +64,12 @@ type MorningCallRepository interface {
 	// FindActiveByUserPair は特定のユーザーペア間のアクティブなモーニングコールを検索する
 	FindActiveByUserPair(ctx context.Context, senderID, receiverID string) ([]*entity.MorningCall, error)
 
+	// FindBySeriesID はシリーズIDでモーニングコールを検索する（アラーム時刻の昇順）
+	FindBySeriesID(ctx context.Context, seriesID string) ([]*entity.MorningCall, error)
+
+	// FindActiveBetweenUsers は2人のユーザー間のアクティブなモーニングコールを送信方向を問わず検索する
+	FindActiveBetweenUsers(ctx context.Context, userID1, userID2 string) ([]*entity.MorningCall, error)
+
 	// CountBySenderID は送信者IDでモーニングコール数を取得する
 	CountBySenderID(ctx context.Context, senderID string) (int, error)
 
@@ -52,9 +79,30 @@ type MorningCallRepository interface {
 	// CountByStatus はステータスごとのモーニングコール数を取得する
 	CountByStatus(ctx context.Context, status valueobject.MorningCallStatus) (int, error)
 
+	// CountByReceiverIDAndStatus は受信者IDとステータスでモーニングコール数を取得する
+	CountByReceiverIDAndStatus(ctx context.Context, receiverID string, status valueobject.MorningCallStatus) (int, error)
+
+	// CountByReceiverIDAndArchived は受信者IDとアーカイブ状態でモーニングコール数を取得する
+	CountByReceiverIDAndArchived(ctx context.Context, receiverID string, archived bool) (int, error)
+
 	// FindAll はすべてのモーニングコールを取得する（ページネーション対応）
 	FindAll(ctx context.Context, offset, limit int) ([]*entity.MorningCall, error)
 
+	// FindAllExcludingStatuses は指定したステータスを除いたモーニングコールを取得する（ページネーション対応）
+	// excludeが空の場合はFindAllと同じ結果になる
+	FindAllExcludingStatuses(ctx context.Context, exclude []valueobject.MorningCallStatus, offset, limit int) ([]*entity.MorningCall, error)
+
+	// CountExcludingStatuses は指定したステータスを除いたモーニングコール数を取得する
+	CountExcludingStatuses(ctx context.Context, exclude []valueobject.MorningCallStatus) (int, error)
+
 	// Count は総モーニングコール数を取得する
 	Count(ctx context.Context) (int, error)
+
+	// CountConfirmationsByUser は予定時刻が期間内（start以上end未満）の起床確認済みコールをユーザーごとに数える
+	// 全件を走査しないよう集計用のカウンタを1時間単位で保持するため、start・endは1時間単位に切り捨てて扱う
+	// 自動確認されたコールと管理者により削除されたコールは数えない
+	CountConfirmationsByUser(ctx context.Context, start, end time.Time) (ConfirmationCounts, error)
+
+	// Stats は総モーニングコール数とステータス別の内訳をまとめて取得する
+	Stats(ctx context.Context) (RepositoryStats, error)
 }
@@ -12,6 +12,11 @@ type RelationshipRepository interface {
 	// Create は新しい友達関係を作成する
 	Create(ctx context.Context, relationship *entity.Relationship) error
 
+	// BulkCreate は複数の関係をまとめて作成する（テストやデモ用の初期データ投入向け）
+	// modeがBulkCreateAllOrNothingの場合、1件でも作成できなければ何も作成せず *BulkCreateError を返す
+	// BulkCreatePartialの場合は作成できたものを残し、作成できなかったものを結果のFailedで返す
+	BulkCreate(ctx context.Context, relationships []*entity.Relationship, mode BulkCreateMode) (BulkCreateResult, error)
+
 	// FindByID はIDで友達関係を検索する
 	FindByID(ctx context.Context, id string) (*entity.Relationship, error)
 
@@ -66,6 +71,9 @@ type RelationshipRepository interface {
 	// CountPendingRequestsByReceiverID は受信者IDで承認待ちリクエスト数を取得する
 	CountPendingRequestsByReceiverID(ctx context.Context, receiverID string) (int, error)
 
+	// CountUnseenRequestsByReceiverID は受信者IDで未読の承認待ちリクエスト数を取得する
+	CountUnseenRequestsByReceiverID(ctx context.Context, receiverID string) (int, error)
+
 	// CountByStatus はステータスごとの関係数を取得する
 	CountByStatus(ctx context.Context, status valueobject.RelationshipStatus) (int, error)
 
@@ -74,4 +82,7 @@ type RelationshipRepository interface {
 
 	// Count は総関係数を取得する
 	Count(ctx context.Context) (int, error)
+
+	// Stats は総関係数とステータス別の内訳をまとめて取得する
+	Stats(ctx context.Context) (RepositoryStats, error)
 }
@@ -0,0 +1,53 @@
+package repository
+
+import "time"
+
+// RepositoryStats はリポジトリが保持するエンティティ数の集計結果
+// 1回の読み取りでまとめて集計するため、TotalとBreakdownは同じ時点の状態を表す
+type RepositoryStats struct {
+	Total     int            // 総数
+	Breakdown map[string]int // 分類ごとの内訳（モーニングコール・友達関係はステータス別、ユーザーはプラン別）
+
+	// ConfirmMethods は起床確認されたモーニングコールの確認方法別の内訳（モーニングコールのみ、自動確認は含めない）
+	ConfirmMethods map[string]int
+}
+
+// ConfirmationCounts は起床確認済みのモーニングコールをユーザーごとに数えた結果
+type ConfirmationCounts struct {
+	Woken  map[string]int // 受信者ID -> 起床確認した回数
+	Wakers map[string]int // 送信者ID -> 起こした（送ったコールが起床確認された）回数
+}
+
+// StatsKeyAutoConfirmed はモーニングコールの内訳で、自動確認されたコールを表すキー
+// 自動確認されたコールはconfirmedには含めず、このキーで別に数える
+const StatsKeyAutoConfirmed = "auto_confirmed"
+
+// OperationMetrics はリポジトリのメソッドごとの呼び出しの計測値
+type OperationMetrics struct {
+	Operation    string        // リポジトリ名とメソッド名（例: UserRepository.FindByID）
+	Calls        int64         // 呼び出し回数
+	Errors       int64         // エラーになった回数（ErrNotFoundは正常な結果として数えない）
+	TotalLatency time.Duration // 呼び出しにかかった時間の合計
+}
+
+// AverageLatency は1回あたりの平均レイテンシを返す
+func (m OperationMetrics) AverageLatency() time.Duration {
+	if m.Calls == 0 {
+		return 0
+	}
+	return m.TotalLatency / time.Duration(m.Calls)
+}
+
+// ErrorRate は呼び出しのうちエラーになった割合（0〜1）を返す
+func (m OperationMetrics) ErrorRate() float64 {
+	if m.Calls == 0 {
+		return 0
+	}
+	return float64(m.Errors) / float64(m.Calls)
+}
+
+// OperationMetricsSource はリポジトリ操作の計測値を提供する
+type OperationMetricsSource interface {
+	// OperationMetrics はこれまでに計測した操作ごとの値を操作名の順に返す
+	OperationMetrics() []OperationMetrics
+}
@@ -7,14 +7,27 @@ type TransactionManager interface {
 	// ExecuteInTransaction はトランザクション内で処理を実行する
 	// 処理が成功した場合はコミット、エラーが発生した場合はロールバックする
 	ExecuteInTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+
+	// WithTx はトランザクションを開始し、トランザクション内で使用するリポジトリを返す
+	// 返されたリポジトリへの変更はCommitするまで他から見えず、Rollbackで破棄される
+	WithTx(ctx context.Context) (*TxRepositories, Transaction, error)
+}
+
+// TxRepositories はトランザクション内で使用するリポジトリの集合
+type TxRepositories struct {
+	User         UserRepository
+	MorningCall  MorningCallRepository
+	Relationship RelationshipRepository
 }
 
 // Transaction はトランザクションを表すインターフェース
 type Transaction interface {
 	// Commit はトランザクションをコミットする
+	// 他の更新と競合した場合はErrTransactionFailedを返し、変更は反映されない
 	Commit() error
 
 	// Rollback はトランザクションをロールバックする
+	// コミット済みのトランザクションに対しては何もしない
 	Rollback() error
 }
 
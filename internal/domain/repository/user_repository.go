@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/ochamu/morning-call-api/internal/domain/entity"
 )
@@ -11,9 +12,18 @@ type UserRepository interface {
 	// Create は新しいユーザーを作成する
 	Create(ctx context.Context, user *entity.User) error
 
+	// BulkCreate は複数のユーザーをまとめて作成する（テストやデモ用の初期データ投入向け）
+	// modeがBulkCreateAllOrNothingの場合、1件でも作成できなければ何も作成せず *BulkCreateError を返す
+	// BulkCreatePartialの場合は作成できたものを残し、作成できなかったものを結果のFailedで返す
+	BulkCreate(ctx context.Context, users []*entity.User, mode BulkCreateMode) (BulkCreateResult, error)
+
 	// FindByID はIDでユーザーを検索する
 	FindByID(ctx context.Context, id string) (*entity.User, error)
 
+	// FindByIDs は複数のIDでユーザーをまとめて検索する
+	// 存在しないIDは結果に含めず、エラーにもしない
+	FindByIDs(ctx context.Context, ids []string) ([]*entity.User, error)
+
 	// FindByUsername はユーザー名でユーザーを検索する
 	FindByUsername(ctx context.Context, username string) (*entity.User, error)
 
@@ -21,8 +31,20 @@ type UserRepository interface {
 	FindByEmail(ctx context.Context, email string) (*entity.User, error)
 
 	// Update はユーザー情報を更新する
+	// Pointsは同時に加算されても取りこぼさないようAddPointsでのみ変更し、Updateでは保存済みの値を維持する
 	Update(ctx context.Context, user *entity.User) error
 
+	// AddPoints はユーザーのポイントに加算し、加算後のポイントを返す
+	// 読み出しと書き込みを不可分に行う。加算するポイントが0以下の場合は ErrInvalidArgument を返す
+	AddPoints(ctx context.Context, id string, points int) (int, error)
+
+	// FindTopByPoints はポイントの多い順にユーザーを取得する（同点の場合はIDの昇順）
+	// ポイントが0のユーザーと凍結中のユーザーは含めない
+	FindTopByPoints(ctx context.Context, limit int) ([]*entity.User, error)
+
+	// FindDeletionDue は削除予定の猶予期間がnowまでに過ぎたユーザーを取得する（削除予定日時の古い順）
+	FindDeletionDue(ctx context.Context, now time.Time) ([]*entity.User, error)
+
 	// Delete はユーザーを削除する
 	Delete(ctx context.Context, id string) error
 
@@ -40,4 +62,7 @@ type UserRepository interface {
 
 	// Count は総ユーザー数を取得する
 	Count(ctx context.Context) (int, error)
+
+	// Stats は総ユーザー数とプラン別の内訳をまとめて取得する
+	Stats(ctx context.Context) (RepositoryStats, error)
 }
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// AuditActorSystem はシステム処理（スケジューラ等）による操作を表すアクターID
+const AuditActorSystem = "system"
+
+// AuditEntry は監査ログの1件分の記録
+type AuditEntry struct {
+	Action     string            // 実行された操作（例: friend_request.expired）
+	ActorID    string            // 操作を行ったユーザーID（システム処理の場合はAuditActorSystem）
+	TargetType string            // 操作対象の種類（例: relationship, morning_call, user）
+	TargetID   string            // 操作対象のID
+	Details    map[string]string // 補足情報
+	OccurredAt time.Time         // 操作が行われた日時
+}
+
+// AuditLogger は監査ログを記録するサービスのインターフェース
+type AuditLogger interface {
+	// Record は監査ログを1件記録する
+	Record(ctx context.Context, entry AuditEntry) error
+}
@@ -0,0 +1,16 @@
+package service
+
+import "context"
+
+// EmailMessage は送信するメール1通分の内容
+type EmailMessage struct {
+	To      string // 宛先メールアドレス
+	Subject string // 件名
+	Body    string // 本文（プレーンテキスト）
+}
+
+// EmailSender はメールを送信するサービスのインターフェース
+type EmailSender interface {
+	// Send はメールを1通送信する
+	Send(ctx context.Context, message EmailMessage) error
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// LoginNotification は初めてのデバイス・IPアドレスからのログイン1件分の通知内容
+type LoginNotification struct {
+	UserID     string    // ログインしたユーザーのID
+	Username   string    // ログインしたユーザーのユーザー名
+	Email      string    // ログインしたユーザーのメールアドレス
+	Device     string    // ログインに使ったデバイス（User-Agent）
+	IPAddress  string    // ログイン元のIPアドレス
+	LoggedInAt time.Time // ログインした日時
+}
+
+// LoginNotifier は身に覚えのないログインに気づけるよう、ユーザーへ新しいログインを知らせるサービスのインターフェース
+type LoginNotifier interface {
+	// NotifyNewLogin は初めてのデバイス・IPアドレスからのログインを1件知らせる
+	NotifyNewLogin(ctx context.Context, notification LoginNotification) error
+}
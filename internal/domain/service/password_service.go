@@ -6,5 +6,6 @@ type PasswordService interface {
 	HashPassword(password string) (string, error)
 
 	// VerifyPassword はパスワードとハッシュ値を比較検証する
+	// 実装は比較を定数時間で行い、一致・不一致で処理時間に差が出ないようにすること
 	VerifyPassword(password, passwordHash string) (bool, error)
 }
@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrInvalidDeviceToken は配信サービスがデバイストークンを無効（失効・登録解除済み）と判定したことを表す
+var ErrInvalidDeviceToken = errors.New("device token is invalid")
+
+// PushMessage は端末へ送るプッシュ通知1件分の内容
+type PushMessage struct {
+	Title string            // タイトル
+	Body  string            // 本文
+	Data  map[string]string // アプリが処理に使う付加情報（例: morning_call_id）
+}
+
+// PushSender は1つのプラットフォームの配信サービス（APNs・FCM・Web Push）へプッシュ通知を送るサービスのインターフェース
+type PushSender interface {
+	// Send はtokenの端末へ通知を1件送る
+	// トークンが失効・登録解除されている場合はErrInvalidDeviceTokenを返す
+	Send(ctx context.Context, token string, message PushMessage) error
+}
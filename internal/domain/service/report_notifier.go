@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// ReportDelivery は定期生成したモーニングコールのレポート1件分の配信内容
+type ReportDelivery struct {
+	UserID   string                   // レポートの対象ユーザーのID
+	Email    string                   // 対象ユーザーのメールアドレス
+	Period   valueobject.ReportPeriod // 集計単位
+	From     time.Time                // 集計期間の開始（この時刻を含む）
+	To       time.Time                // 集計期間の終了（この時刻を含まない）
+	Summary  string                   // 通知の本文に使う要約（プレーンテキスト）
+	Format   valueobject.ReportFormat // Contentの出力形式
+	Filename string                   // Contentを保存するときのファイル名
+	Content  []byte                   // 出力形式で書き出したレポート
+}
+
+// ReportNotifier は定期生成したレポートをユーザーへ届けるサービスのインターフェース
+// メール・プッシュ通知・ストレージへの保存など、届け方は実装ごとに選べる
+type ReportNotifier interface {
+	// NotifyReport はレポートを1件届ける
+	NotifyReport(ctx context.Context, delivery ReportDelivery) error
+}
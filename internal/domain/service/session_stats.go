@@ -0,0 +1,21 @@
+package service
+
+import "time"
+
+// SessionStats はセッション数と期限切れセッションのクリーンアップの統計
+type SessionStats struct {
+	ActiveSessions      int           // 現在の有効なセッション数
+	TotalSessions       int           // 保持しているセッション数（未削除の期限切れを含む）
+	CleanupRuns         int           // クリーンアップの実行回数
+	LastCleanupAt       time.Time     // 最後にクリーンアップを実行した日時
+	LastCleanupRemoved  int           // 最後のクリーンアップで削除した件数
+	TotalCleanupRemoved int           // クリーンアップで削除した累計件数
+	LastCleanupDuration time.Duration // 最後のクリーンアップの所要時間
+	CleanupInterval     time.Duration // クリーンアップの実行間隔
+}
+
+// SessionStatsSource はセッションの統計を提供するインターフェース
+type SessionStatsSource interface {
+	// Stats は現在のセッション数とこれまでのクリーンアップの統計を返す
+	Stats() SessionStats
+}
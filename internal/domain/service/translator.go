@@ -0,0 +1,10 @@
+package service
+
+import "context"
+
+// Translator はテキストを指定した言語に翻訳するサービスのインターフェース
+type Translator interface {
+	// Translate はtextをtargetLang（ISO 639-1の言語コード。例: ja, en）に翻訳する
+	// 翻訳できない場合はエラーを返す
+	Translate(ctx context.Context, text, targetLang string) (string, error)
+}
@@ -0,0 +1,16 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// WeatherProvider は天気予報を取得するサービスのインターフェース
+type WeatherProvider interface {
+	// Forecast は指定した時刻の天気を返す
+	// ユーザーの位置情報を持たないため、地域は受信者のタイムゾーン（IANA名、空文字はサーバーのタイムゾーン）で近似する
+	// 予報を取得できない場合はエラーを返す
+	Forecast(ctx context.Context, timeZone string, at time.Time) (valueobject.WeatherCondition, error)
+}
@@ -0,0 +1,148 @@
+package valueobject
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CallWindow はモーニングコールの受信を許可する曜日と時間帯
+// 時刻は受信者のタイムゾーンでの0時からの分で表し、開始が終了より後の場合は日付をまたぐ時間帯として扱う
+// 開始と終了が同じ場合は終日を表す
+type CallWindow struct {
+	Weekdays    []time.Weekday // 受信を許可する曜日（空の場合は毎日）
+	StartMinute int            // 受信を許可する時間帯の開始（この時刻を含む）
+	EndMinute   int            // 受信を許可する時間帯の終了（この時刻を含まない）
+}
+
+// minutesPerDay は1日の分数
+const minutesPerDay = 24 * 60
+
+// weekdayNames は曜日の文字列表現（APIでの指定に使う）
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// ParseCallWindow は曜日名（sun〜sat）と "HH:MM" 形式の開始・終了時刻から受信許可時間帯を作成する
+func ParseCallWindow(weekdays []string, start, end string) (*CallWindow, NGReason) {
+	days, reason := parseWeekdays(weekdays)
+	if reason.IsNG() {
+		return nil, reason
+	}
+
+	startMinute, ok := parseMinuteOfDay(start)
+	if !ok {
+		return nil, NGWithCode(ReasonCodeInvalidFormat, "start", "開始時刻は HH:MM の形式で指定してください")
+	}
+	endMinute, ok := parseMinuteOfDay(end)
+	if !ok {
+		return nil, NGWithCode(ReasonCodeInvalidFormat, "end", "終了時刻は HH:MM の形式で指定してください")
+	}
+
+	return &CallWindow{Weekdays: days, StartMinute: startMinute, EndMinute: endMinute}, OK()
+}
+
+// parseWeekdays は曜日名（sun〜sat）の一覧を曜日に変換する（重複は除く）
+func parseWeekdays(names []string) ([]time.Weekday, NGReason) {
+	days := make([]time.Weekday, 0, len(names))
+	seen := make(map[time.Weekday]bool, len(names))
+	for _, name := range names {
+		day, ok := weekdayNames[strings.ToLower(name)]
+		if !ok {
+			return nil, NGWithCode(ReasonCodeInvalidFormat, "weekdays", "曜日は sun, mon, tue, wed, thu, fri, sat のいずれかで指定してください")
+		}
+		if !seen[day] {
+			seen[day] = true
+			days = append(days, day)
+		}
+	}
+	return days, OK()
+}
+
+// parseMinuteOfDay は "HH:MM" 形式の時刻を0時からの分に変換する
+func parseMinuteOfDay(value string) (int, bool) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, false
+	}
+	return t.Hour()*60 + t.Minute(), true
+}
+
+// Validate は受信許可時間帯の妥当性を検証する
+func (w CallWindow) Validate() NGReason {
+	if w.StartMinute < 0 || w.StartMinute >= minutesPerDay {
+		return NGWithCode(ReasonCodeOutOfRange, "start", "開始時刻は00:00から23:59の範囲で指定してください")
+	}
+	if w.EndMinute < 0 || w.EndMinute >= minutesPerDay {
+		return NGWithCode(ReasonCodeOutOfRange, "end", "終了時刻は00:00から23:59の範囲で指定してください")
+	}
+	for _, day := range w.Weekdays {
+		if day < time.Sunday || day > time.Saturday {
+			return NGWithCode(ReasonCodeInvalidFormat, "weekdays", "曜日が不正です")
+		}
+	}
+	return OK()
+}
+
+// Allows は指定時刻に受信を許可するかを判定する
+// 曜日と時刻はlocでの値で判定する（locがnilの場合はUTC）
+// 日付をまたぐ時間帯の翌日側は、開始した日の曜日ではなくその時刻の曜日で判定する
+func (w CallWindow) Allows(t time.Time, loc *time.Location) bool {
+	if loc == nil {
+		loc = time.UTC
+	}
+	local := t.In(loc)
+
+	if len(w.Weekdays) > 0 {
+		allowedDay := false
+		for _, day := range w.Weekdays {
+			if local.Weekday() == day {
+				allowedDay = true
+				break
+			}
+		}
+		if !allowedDay {
+			return false
+		}
+	}
+
+	minute := local.Hour()*60 + local.Minute()
+	switch {
+	case w.StartMinute == w.EndMinute:
+		return true
+	case w.StartMinute < w.EndMinute:
+		return minute >= w.StartMinute && minute < w.EndMinute
+	default:
+		return minute >= w.StartMinute || minute < w.EndMinute
+	}
+}
+
+// WeekdayNames は許可する曜日の文字列表現（sun〜sat）を返す
+func (w CallWindow) WeekdayNames() []string {
+	names := make([]string, 0, len(w.Weekdays))
+	for _, day := range w.Weekdays {
+		names = append(names, strings.ToLower(day.String()[:3]))
+	}
+	return names
+}
+
+// Start は開始時刻を "HH:MM" 形式で返す
+func (w CallWindow) Start() string {
+	return formatMinuteOfDay(w.StartMinute)
+}
+
+// End は終了時刻を "HH:MM" 形式で返す
+func (w CallWindow) End() string {
+	return formatMinuteOfDay(w.EndMinute)
+}
+
+// formatMinuteOfDay は0時からの分を "HH:MM" 形式に変換する
+func formatMinuteOfDay(minute int) string {
+	return fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+}
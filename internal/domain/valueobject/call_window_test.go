@@ -0,0 +1,126 @@
+package valueobject
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseCallWindow(t *testing.T) {
+	tests := []struct {
+		name      string
+		weekdays  []string
+		start     string
+		end       string
+		want      *CallWindow
+		wantField string
+	}{
+		{
+			name:     "曜日と時間帯",
+			weekdays: []string{"mon", "FRI"},
+			start:    "07:00",
+			end:      "09:30",
+			want:     &CallWindow{Weekdays: []time.Weekday{time.Monday, time.Friday}, StartMinute: 7 * 60, EndMinute: 9*60 + 30},
+		},
+		{
+			name:     "重複した曜日はまとめる",
+			weekdays: []string{"sat", "sat"},
+			start:    "06:00",
+			end:      "06:00",
+			want:     &CallWindow{Weekdays: []time.Weekday{time.Saturday}, StartMinute: 6 * 60, EndMinute: 6 * 60},
+		},
+		{
+			name:  "曜日の指定なし",
+			start: "22:00",
+			end:   "02:00",
+			want:  &CallWindow{Weekdays: []time.Weekday{}, StartMinute: 22 * 60, EndMinute: 2 * 60},
+		},
+		{name: "不正な曜日", weekdays: []string{"monday"}, start: "07:00", end: "09:00", wantField: "weekdays"},
+		{name: "不正な開始時刻", start: "7時", end: "09:00", wantField: "start"},
+		{name: "範囲外の終了時刻", start: "07:00", end: "24:00", wantField: "end"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, reason := ParseCallWindow(tt.weekdays, tt.start, tt.end)
+			if tt.wantField != "" {
+				if reason.IsOK() || reason.Field() != tt.wantField {
+					t.Errorf("ParseCallWindow() reason = %v (field %q), want NG on %q", reason, reason.Field(), tt.wantField)
+				}
+				return
+			}
+			if reason.IsNG() {
+				t.Fatalf("ParseCallWindow() = %v", reason)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseCallWindow() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCallWindow_Validate(t *testing.T) {
+	if reason := (CallWindow{StartMinute: 0, EndMinute: minutesPerDay - 1}).Validate(); reason.IsNG() {
+		t.Errorf("Validate() = %v, want OK", reason)
+	}
+	if reason := (CallWindow{StartMinute: minutesPerDay}).Validate(); reason.Code() != ReasonCodeOutOfRange {
+		t.Errorf("Validate() code = %v, want OUT_OF_RANGE", reason.Code())
+	}
+	if reason := (CallWindow{Weekdays: []time.Weekday{7}}).Validate(); reason.IsOK() {
+		t.Error("Validate() should reject invalid weekday")
+	}
+}
+
+func TestCallWindow_Allows(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	// 2024-01-01は月曜日
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, 1, day, hour, minute, 0, 0, tokyo)
+	}
+
+	tests := []struct {
+		name   string
+		window CallWindow
+		time   time.Time
+		want   bool
+	}{
+		{name: "時間帯の開始", window: CallWindow{StartMinute: 7 * 60, EndMinute: 9 * 60}, time: at(1, 7, 0), want: true},
+		{name: "時間帯の終了は含まない", window: CallWindow{StartMinute: 7 * 60, EndMinute: 9 * 60}, time: at(1, 9, 0), want: false},
+		{name: "時間帯の前", window: CallWindow{StartMinute: 7 * 60, EndMinute: 9 * 60}, time: at(1, 6, 59), want: false},
+		{name: "日付をまたぐ時間帯の夜側", window: CallWindow{StartMinute: 22 * 60, EndMinute: 2 * 60}, time: at(1, 23, 0), want: true},
+		{name: "日付をまたぐ時間帯の朝側", window: CallWindow{StartMinute: 22 * 60, EndMinute: 2 * 60}, time: at(2, 1, 0), want: true},
+		{name: "日付をまたぐ時間帯の外", window: CallWindow{StartMinute: 22 * 60, EndMinute: 2 * 60}, time: at(1, 12, 0), want: false},
+		{name: "開始と終了が同じなら終日", window: CallWindow{StartMinute: 5 * 60, EndMinute: 5 * 60}, time: at(1, 3, 0), want: true},
+		{name: "許可した曜日", window: CallWindow{Weekdays: []time.Weekday{time.Monday}}, time: at(1, 7, 0), want: true},
+		{name: "許可していない曜日", window: CallWindow{Weekdays: []time.Weekday{time.Monday}}, time: at(2, 7, 0), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.window.Allows(tt.time, tokyo); got != tt.want {
+				t.Errorf("Allows(%v) = %v, want %v", tt.time, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("受信者のタイムゾーンで判定する", func(t *testing.T) {
+		window := CallWindow{StartMinute: 7 * 60, EndMinute: 9 * 60}
+		utc := time.Date(2023, 12, 31, 22, 30, 0, 0, time.UTC) // 東京では1月1日 07:30
+		if !window.Allows(utc, tokyo) {
+			t.Error("Allows() should evaluate in receiver's location")
+		}
+		if window.Allows(utc, nil) {
+			t.Error("Allows() with nil location should evaluate in UTC")
+		}
+	})
+}
+
+func TestCallWindow_Format(t *testing.T) {
+	window := CallWindow{Weekdays: []time.Weekday{time.Sunday, time.Wednesday}, StartMinute: 6*60 + 5, EndMinute: 23*60 + 59}
+	if got := window.WeekdayNames(); !reflect.DeepEqual(got, []string{"sun", "wed"}) {
+		t.Errorf("WeekdayNames() = %v", got)
+	}
+	if window.Start() != "06:05" || window.End() != "23:59" {
+		t.Errorf("Start()/End() = %s/%s, want 06:05/23:59", window.Start(), window.End())
+	}
+}
@@ -0,0 +1,41 @@
+package valueobject
+
+// ConfirmMethod は受信者がクライアントで起床確認した操作の種類を表す
+type ConfirmMethod string
+
+const (
+	// ConfirmMethodButton はボタンを押して確認した
+	ConfirmMethodButton ConfirmMethod = "button"
+	// ConfirmMethodSwipe はスワイプして確認した
+	ConfirmMethodSwipe ConfirmMethod = "swipe"
+	// ConfirmMethodShake は端末を振って確認した
+	ConfirmMethodShake ConfirmMethod = "shake"
+	// ConfirmMethodChallenge は起床クイズに答えて確認した
+	ConfirmMethodChallenge ConfirmMethod = "challenge"
+)
+
+// IsValid は確認方法が有効な値かを検証する
+func (m ConfirmMethod) IsValid() bool {
+	switch m {
+	case ConfirmMethodButton,
+		ConfirmMethodSwipe,
+		ConfirmMethodShake,
+		ConfirmMethodChallenge:
+		return true
+	default:
+		return false
+	}
+}
+
+// OrDefault は未指定（空）の場合にボタンでの確認として扱った値を返す
+func (m ConfirmMethod) OrDefault() ConfirmMethod {
+	if m == "" {
+		return ConfirmMethodButton
+	}
+	return m
+}
+
+// String は確認方法の文字列表現を返す
+func (m ConfirmMethod) String() string {
+	return string(m)
+}
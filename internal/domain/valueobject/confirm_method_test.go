@@ -0,0 +1,36 @@
+package valueobject
+
+import "testing"
+
+func TestConfirmMethod_IsValid(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   ConfirmMethod
+		expected bool
+	}{
+		{name: "ボタンは有効", method: ConfirmMethodButton, expected: true},
+		{name: "スワイプは有効", method: ConfirmMethodSwipe, expected: true},
+		{name: "シェイクは有効", method: ConfirmMethodShake, expected: true},
+		{name: "起床クイズは有効", method: ConfirmMethodChallenge, expected: true},
+		{name: "空文字は無効", method: ConfirmMethod(""), expected: false},
+		{name: "大文字は無効", method: ConfirmMethod("Button"), expected: false},
+		{name: "不明な方法は無効", method: ConfirmMethod("voice"), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.method.IsValid(); got != tt.expected {
+				t.Errorf("IsValid() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConfirmMethod_OrDefault(t *testing.T) {
+	if got := ConfirmMethod("").OrDefault(); got != ConfirmMethodButton {
+		t.Errorf("OrDefault() = %q, want %q", got, ConfirmMethodButton)
+	}
+	if got := ConfirmMethodShake.OrDefault(); got != ConfirmMethodShake {
+		t.Errorf("OrDefault() = %q, want %q", got, ConfirmMethodShake)
+	}
+}
@@ -0,0 +1,27 @@
+package valueobject
+
+import "fmt"
+
+const (
+	// MinVolume は配信時の音量の最小値（音を鳴らさない）
+	MinVolume = 0
+	// MaxVolume は配信時の音量の最大値
+	MaxVolume = 100
+	// DefaultVolume は新規ユーザーの配信時の音量
+	DefaultVolume = 80
+)
+
+// DeliveryHints はモーニングコールの配信時にクライアントがどう鳴らすかのヒント
+// サーバーは値を渡すだけで、実際の鳴らし方は端末の設定や状態に応じてクライアントが決める
+type DeliveryHints struct {
+	Volume  int  // 音量（0〜100、無音配信の場合は0）
+	Vibrate bool // バイブレーションするか
+}
+
+// ValidateVolume は配信時の音量が範囲内かを検証する
+func ValidateVolume(volume int) NGReason {
+	if volume < MinVolume || volume > MaxVolume {
+		return NGWithCode(ReasonCodeOutOfRange, "volume", fmt.Sprintf("音量は%dから%dの範囲で指定してください", MinVolume, MaxVolume))
+	}
+	return OK()
+}
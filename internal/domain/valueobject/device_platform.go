@@ -0,0 +1,30 @@
+package valueobject
+
+// DevicePlatform はプッシュ通知を受け取る端末のプラットフォームを表す
+type DevicePlatform string
+
+const (
+	// DevicePlatformIOS はiOS端末（APNs経由で配信する）
+	DevicePlatformIOS DevicePlatform = "ios"
+	// DevicePlatformAndroid はAndroid端末（FCM経由で配信する）
+	DevicePlatformAndroid DevicePlatform = "android"
+	// DevicePlatformWeb はWebブラウザ（Web Push経由で配信する）
+	DevicePlatformWeb DevicePlatform = "web"
+)
+
+// IsValid はプラットフォームが有効な値かを検証する
+func (p DevicePlatform) IsValid() bool {
+	switch p {
+	case DevicePlatformIOS,
+		DevicePlatformAndroid,
+		DevicePlatformWeb:
+		return true
+	default:
+		return false
+	}
+}
+
+// String はプラットフォームの文字列表現を返す
+func (p DevicePlatform) String() string {
+	return string(p)
+}
@@ -0,0 +1,26 @@
+package valueobject
+
+import "testing"
+
+func TestDevicePlatform_IsValid(t *testing.T) {
+	tests := []struct {
+		name     string
+		platform DevicePlatform
+		expected bool
+	}{
+		{name: "iOSは有効", platform: DevicePlatformIOS, expected: true},
+		{name: "Androidは有効", platform: DevicePlatformAndroid, expected: true},
+		{name: "Webは有効", platform: DevicePlatformWeb, expected: true},
+		{name: "空文字は無効", platform: DevicePlatform(""), expected: false},
+		{name: "大文字は無効", platform: DevicePlatform("IOS"), expected: false},
+		{name: "不明なプラットフォームは無効", platform: DevicePlatform("windows"), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.platform.IsValid(); got != tt.expected {
+				t.Errorf("IsValid() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
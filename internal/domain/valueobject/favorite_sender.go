@@ -0,0 +1,9 @@
+package valueobject
+
+// FavoriteSender は受信者が友達を「お気に入り送信元」に登録したときの設定
+// お気に入り送信元からのコールは受信一覧で優先して表示できる
+type FavoriteSender struct {
+	// AlwaysRing は受信者の無音配信のデフォルト設定にかかわらず、音を鳴らして通知するか
+	// （コールごとに無音配信を設定している場合はそちらを優先する）
+	AlwaysRing bool
+}
@@ -0,0 +1,20 @@
+package valueobject
+
+import "time"
+
+// FriendRequestResendPolicy は拒否された友達リクエストを同じ相手へ再送信する際の制限を表す
+// 拒否された相手に何度もリクエストを送りつける嫌がらせを防ぐために使う
+type FriendRequestResendPolicy struct {
+	Cooldown   time.Duration // 拒否されてから再送信できるようになるまでの期間（0以下で待機なし、自動失効したリクエストには適用しない）
+	MaxResends int           // 同じ相手へ続けて再送信できる回数（0以下で無制限）
+	Lockout    time.Duration // 再送信の回数が上限に達した後、最後の送信から再び送信できるようになるまでの期間
+}
+
+// DefaultFriendRequestResendPolicy はデフォルトの再送信の制限を返す
+func DefaultFriendRequestResendPolicy() FriendRequestResendPolicy {
+	return FriendRequestResendPolicy{
+		Cooldown:   24 * time.Hour,
+		MaxResends: 3,
+		Lockout:    30 * 24 * time.Hour,
+	}
+}
@@ -0,0 +1,34 @@
+package valueobject
+
+// GeoPoint は緯度経度で表される位置情報
+type GeoPoint struct {
+	Latitude  float64 // 緯度（-90〜90）
+	Longitude float64 // 経度（-180〜180）
+}
+
+// NewGeoPoint は緯度経度を検証して位置情報を作成する
+func NewGeoPoint(latitude, longitude float64) (*GeoPoint, NGReason) {
+	p := &GeoPoint{
+		Latitude:  latitude,
+		Longitude: longitude,
+	}
+
+	if reason := p.Validate(); reason.IsNG() {
+		return nil, reason
+	}
+
+	return p, OK()
+}
+
+// Validate は緯度経度が有効な範囲内かを検証する
+func (p GeoPoint) Validate() NGReason {
+	// NaNは範囲比較がすべてfalseになるため、否定形で判定する
+	if !(p.Latitude >= -90 && p.Latitude <= 90) {
+		return NGWithCode(ReasonCodeOutOfRange, "latitude", "緯度は-90から90の範囲で指定してください")
+	}
+	if !(p.Longitude >= -180 && p.Longitude <= 180) {
+		return NGWithCode(ReasonCodeOutOfRange, "longitude", "経度は-180から180の範囲で指定してください")
+	}
+
+	return OK()
+}
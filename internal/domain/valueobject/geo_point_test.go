@@ -0,0 +1,53 @@
+package valueobject
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNewGeoPoint(t *testing.T) {
+	tests := []struct {
+		name      string
+		latitude  float64
+		longitude float64
+		wantNG    bool
+		errMsg    string
+	}{
+		{name: "東京", latitude: 35.681236, longitude: 139.767125},
+		{name: "原点", latitude: 0, longitude: 0},
+		{name: "境界値（最小）", latitude: -90, longitude: -180},
+		{name: "境界値（最大）", latitude: 90, longitude: 180},
+		{name: "緯度が下限未満", latitude: -90.0001, longitude: 0, wantNG: true, errMsg: "緯度は-90から90の範囲で指定してください"},
+		{name: "緯度が上限超過", latitude: 91, longitude: 0, wantNG: true, errMsg: "緯度は-90から90の範囲で指定してください"},
+		{name: "経度が下限未満", latitude: 0, longitude: -180.5, wantNG: true, errMsg: "経度は-180から180の範囲で指定してください"},
+		{name: "経度が上限超過", latitude: 0, longitude: 181, wantNG: true, errMsg: "経度は-180から180の範囲で指定してください"},
+		{name: "緯度がNaN", latitude: math.NaN(), longitude: 0, wantNG: true, errMsg: "緯度は-90から90の範囲で指定してください"},
+		{name: "経度が無限大", latitude: 0, longitude: math.Inf(1), wantNG: true, errMsg: "経度は-180から180の範囲で指定してください"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, reason := NewGeoPoint(tt.latitude, tt.longitude)
+
+			if tt.wantNG {
+				if reason.IsOK() {
+					t.Fatal("NGが期待されたがOKが返された")
+				}
+				if reason.Error() != tt.errMsg {
+					t.Errorf("エラーメッセージ = %q, want %q", reason.Error(), tt.errMsg)
+				}
+				if p != nil {
+					t.Error("NGの場合はnilが返されるべき")
+				}
+				return
+			}
+
+			if reason.IsNG() {
+				t.Fatalf("予期しないNG: %s", reason)
+			}
+			if p.Latitude != tt.latitude || p.Longitude != tt.longitude {
+				t.Errorf("GeoPoint = %+v, want (%v, %v)", p, tt.latitude, tt.longitude)
+			}
+		})
+	}
+}
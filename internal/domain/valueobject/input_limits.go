@@ -0,0 +1,20 @@
+package valueobject
+
+// InputLimits は入力フィールドごとの文字数制限を表す
+// 文字数はユーザー名・メールアドレスはバイト数、メッセージはUTF-8のコードポイント数で数える
+type InputLimits struct {
+	UsernameMinLength int // ユーザー名の最小文字数
+	UsernameMaxLength int // ユーザー名の最大文字数
+	EmailMaxLength    int // メールアドレスの最大文字数
+	MessageMaxLength  int // モーニングコールのメッセージの最大文字数
+}
+
+// DefaultInputLimits はデフォルトの入力文字数制限を返す
+func DefaultInputLimits() InputLimits {
+	return InputLimits{
+		UsernameMinLength: 3,
+		UsernameMaxLength: 30,
+		EmailMaxLength:    255,
+		MessageMaxLength:  500,
+	}
+}
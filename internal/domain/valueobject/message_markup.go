@@ -0,0 +1,33 @@
+package valueobject
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	// unsafeBlockRegex は内容ごと除去する要素（閉じタグがない場合は末尾まで）
+	unsafeBlockRegex = regexp.MustCompile(`(?is)<(script|style|iframe|object)\b.*?(</(script|style|iframe|object)\s*>|$)`)
+	// htmlTagRegex はHTMLタグ・コメント
+	htmlTagRegex = regexp.MustCompile(`(?s)<[^>]*>`)
+	// emphasisRegex は *強調* の簡易記法（改行をまたがない）
+	emphasisRegex = regexp.MustCompile(`\*([^*\n]+)\*`)
+)
+
+// SanitizeMessageHTML はモーニングコールのメッセージを表示用の安全なHTMLに整形する
+// 許可する簡易記法は改行（<br>に変換）と *強調*（<strong>に変換）のみで、
+// HTMLタグは除去し（scriptなどは内容ごと）、残った文字はエスケープする
+// 保存するのは元のテキストで、文字数の制限も元のテキストで判定する
+func SanitizeMessageHTML(message string) string {
+	text := unsafeBlockRegex.ReplaceAllString(message, "")
+	text = htmlTagRegex.ReplaceAllString(text, "")
+	// 閉じられていない < などもエスケープで無害化する
+	text = html.EscapeString(text)
+
+	text = emphasisRegex.ReplaceAllString(text, "<strong>$1</strong>")
+
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = strings.ReplaceAll(text, "\r", "\n")
+	return strings.ReplaceAll(text, "\n", "<br>")
+}
@@ -0,0 +1,40 @@
+package valueobject
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSanitizeMessageHTML(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    string
+	}{
+		{name: "装飾のないメッセージ", message: "おはよう", want: "おはよう"},
+		{name: "改行をbrに変換する", message: "おはよう\n起きて\r\nね", want: "おはよう<br>起きて<br>ね"},
+		{name: "強調をstrongに変換する", message: "*絶対に*起きて", want: "<strong>絶対に</strong>起きて"},
+		{name: "閉じられていない強調はそのまま", message: "5*3=15", want: "5*3=15"},
+		{name: "改行をまたぐ強調は変換しない", message: "*起きて\nね*", want: "*起きて<br>ね*"},
+		{name: "scriptは内容ごと除去する", message: "おはよう<script>alert('xss')</script>", want: "おはよう"},
+		{name: "大文字や属性付きのscriptも除去する", message: `<SCRIPT type="text/javascript">alert(1)</SCRIPT >起きて`, want: "起きて"},
+		{name: "閉じタグのないscriptは末尾まで除去する", message: "起きて<script>alert(1)", want: "起きて"},
+		{name: "イベント属性を持つタグを除去する", message: `<img src=x onerror="alert(1)">起きて`, want: "起きて"},
+		{name: "装飾タグは除去して中身を残す", message: "<b>起きて</b>", want: "起きて"},
+		{name: "タグとして閉じていない記号はエスケープする", message: `a < b & "c"`, want: "a &lt; b &amp; &#34;c&#34;"},
+		{name: "強調の中のタグも無害化する", message: "*<script>alert(1)</script>起きて*", want: "<strong>起きて</strong>"},
+		{name: "エスケープ済みの文字列を二重に解釈しない", message: "&lt;script&gt;", want: "&amp;lt;script&amp;gt;"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SanitizeMessageHTML(tt.message)
+			if got != tt.want {
+				t.Errorf("SanitizeMessageHTML(%q) = %q, want %q", tt.message, got, tt.want)
+			}
+			if strings.Contains(strings.ToLower(got), "<script") || strings.Contains(got, "onerror") {
+				t.Errorf("SanitizeMessageHTML(%q) = %q, contains unsafe markup", tt.message, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package valueobject
+
+const (
+	// MinMessageVariants はA/Bテスト用のメッセージバリアントの最小数
+	MinMessageVariants = 2
+	// MaxMessageVariants はA/Bテスト用のメッセージバリアントの最大数
+	MaxMessageVariants = 5
+)
+
+// MessageVariantStrategy はA/Bテスト用のメッセージバリアントから配信する1つを選ぶ方法を表す
+type MessageVariantStrategy string
+
+const (
+	// MessageVariantStrategyRandom はランダムに選ぶ
+	MessageVariantStrategyRandom MessageVariantStrategy = "random"
+	// MessageVariantStrategyRoundRobin は同じバリアントで送ったコールの数に応じて順番に選ぶ
+	MessageVariantStrategyRoundRobin MessageVariantStrategy = "round_robin"
+)
+
+// IsValid は選択方法が有効な値かを検証する
+func (s MessageVariantStrategy) IsValid() bool {
+	switch s {
+	case MessageVariantStrategyRandom,
+		MessageVariantStrategyRoundRobin:
+		return true
+	default:
+		return false
+	}
+}
+
+// OrDefault は未指定（空）の場合にランダムとして扱った値を返す
+func (s MessageVariantStrategy) OrDefault() MessageVariantStrategy {
+	if s == "" {
+		return MessageVariantStrategyRandom
+	}
+	return s
+}
+
+// String は選択方法の文字列表現を返す
+func (s MessageVariantStrategy) String() string {
+	return string(s)
+}
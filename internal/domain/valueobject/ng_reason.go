@@ -1,30 +1,81 @@
 package valueobject
 
+// ReasonCode はドメイン検証エラーの種類を表すコード
+// 上位層はメッセージ文字列ではなくこのコードで分岐・翻訳する
+type ReasonCode string
+
+const (
+	ReasonCodeInvalid       ReasonCode = "INVALID"        // 種類を特定しない不正な値（NGで作成した場合）
+	ReasonCodeRequired      ReasonCode = "REQUIRED"       // 必須項目が未指定
+	ReasonCodeTooShort      ReasonCode = "TOO_SHORT"      // 文字数が下限未満
+	ReasonCodeTooLong       ReasonCode = "TOO_LONG"       // 文字数が上限超過
+	ReasonCodeInvalidFormat ReasonCode = "INVALID_FORMAT" // 形式が不正
+	ReasonCodeOutOfRange    ReasonCode = "OUT_OF_RANGE"   // 値が許容範囲外
+	ReasonCodeInvalidState  ReasonCode = "INVALID_STATE"  // 現在の状態では実行できない操作
+	ReasonCodeSelfReference ReasonCode = "SELF_REFERENCE" // 自分自身を対象にした操作
+	ReasonCodeDuplicate     ReasonCode = "DUPLICATE"      // 既に設定・実行済み
+	ReasonCodeLimitExceeded ReasonCode = "LIMIT_EXCEEDED" // 登録数の上限超過
+	ReasonCodeExpired       ReasonCode = "EXPIRED"        // 有効期限切れ
+	ReasonCodeMismatch      ReasonCode = "MISMATCH"       // 確認用の値が一致しない
+	ReasonCodeNotPermitted  ReasonCode = "NOT_PERMITTED"  // 操作する立場にない
+	ReasonCodeNotApplicable ReasonCode = "NOT_APPLICABLE" // 対象に該当しない
+)
+
+// String はコードの文字列表現を返す
+func (c ReasonCode) String() string {
+	return string(c)
+}
+
 // NGReason はドメイン検証結果を表す値オブジェクト
-// 空文字列は成功(OK)、非空文字列はエラーメッセージ(NG)を表す
-type NGReason string
+// ゼロ値は成功(OK)、メッセージを持つ値はエラー(NG)を表す
+// NGの場合はエラーの種類を表すコードと、対象の項目名（特定できる場合）を保持する
+type NGReason struct {
+	code    ReasonCode
+	field   string
+	message string
+}
 
 // IsOK は検証が成功したかを判定する
 func (r NGReason) IsOK() bool {
-	return r == ""
+	return r.message == ""
 }
 
 // IsNG は検証が失敗したかを判定する
 func (r NGReason) IsNG() bool {
-	return r != ""
+	return r.message != ""
 }
 
-// Error はエラーメッセージを返す
+// Error はエラーメッセージ（日本語）を返す
+// NGReasonはerrorとして%wでラップでき、上位層はerrors.Asでコードを取り出せる
 func (r NGReason) Error() string {
-	return string(r)
+	return r.message
+}
+
+// Code はエラーの種類を表すコードを返す（OKの場合は空）
+func (r NGReason) Code() ReasonCode {
+	return r.code
+}
+
+// Field はエラーの対象の項目名を返す（OKの場合や項目を特定しない場合は空）
+func (r NGReason) Field() string {
+	return r.field
 }
 
 // OK は成功を表すNGReasonを返す
 func OK() NGReason {
-	return NGReason("")
+	return NGReason{}
 }
 
-// NG はエラーメッセージを持つNGReasonを返す
+// NG はエラーメッセージを持つNGReasonを返す（コードはReasonCodeInvalid）
 func NG(message string) NGReason {
-	return NGReason(message)
+	return NGWithCode(ReasonCodeInvalid, "", message)
+}
+
+// NGWithCode はコードと対象の項目名を持つNGReasonを返す
+// fieldはAPIのフィールド名（例: username）で指定し、特定しない場合は空にする
+func NGWithCode(code ReasonCode, field, message string) NGReason {
+	if message == "" {
+		return OK()
+	}
+	return NGReason{code: code, field: field, message: message}
 }
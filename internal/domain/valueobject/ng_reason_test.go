@@ -1,6 +1,10 @@
 package valueobject
 
-import "testing"
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
 
 func TestNGReason_IsOK(t *testing.T) {
 	tests := []struct {
@@ -9,8 +13,8 @@ func TestNGReason_IsOK(t *testing.T) {
 		expected bool
 	}{
 		{
-			name:     "空文字列はOK",
-			reason:   NGReason(""),
+			name:     "ゼロ値はOK",
+			reason:   NGReason{},
 			expected: true,
 		},
 		{
@@ -19,9 +23,9 @@ func TestNGReason_IsOK(t *testing.T) {
 			expected: true,
 		},
 		{
-			name:     "エラーメッセージありはNG",
-			reason:   NGReason("エラー"),
-			expected: false,
+			name:     "空メッセージのNGWithCodeはOK",
+			reason:   NGWithCode(ReasonCodeRequired, "username", ""),
+			expected: true,
 		},
 		{
 			name:     "NG関数で生成した値はNG",
@@ -46,8 +50,8 @@ func TestNGReason_IsNG(t *testing.T) {
 		expected bool
 	}{
 		{
-			name:     "空文字列はNG",
-			reason:   NGReason(""),
+			name:     "ゼロ値はNGではない",
+			reason:   NGReason{},
 			expected: false,
 		},
 		{
@@ -56,8 +60,8 @@ func TestNGReason_IsNG(t *testing.T) {
 			expected: false,
 		},
 		{
-			name:     "エラーメッセージありはNG",
-			reason:   NGReason("エラー"),
+			name:     "NGWithCode関数で生成した値はNG",
+			reason:   NGWithCode(ReasonCodeRequired, "username", "エラー"),
 			expected: true,
 		},
 		{
@@ -83,13 +87,18 @@ func TestNGReason_Error(t *testing.T) {
 		expected string
 	}{
 		{
-			name:     "空文字列",
-			reason:   NGReason(""),
+			name:     "OK",
+			reason:   OK(),
 			expected: "",
 		},
 		{
 			name:     "エラーメッセージ",
-			reason:   NGReason("検証エラー"),
+			reason:   NG("検証エラー"),
+			expected: "検証エラー",
+		},
+		{
+			name:     "コード付きのエラーメッセージ",
+			reason:   NGWithCode(ReasonCodeTooLong, "message", "検証エラー"),
 			expected: "検証エラー",
 		},
 	}
@@ -102,3 +111,57 @@ func TestNGReason_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestNGReason_CodeAndField(t *testing.T) {
+	tests := []struct {
+		name      string
+		reason    NGReason
+		wantCode  ReasonCode
+		wantField string
+	}{
+		{
+			name:      "OKはコードも項目名も持たない",
+			reason:    OK(),
+			wantCode:  "",
+			wantField: "",
+		},
+		{
+			name:      "NGは種類を特定しないコードになる",
+			reason:    NG("エラー"),
+			wantCode:  ReasonCodeInvalid,
+			wantField: "",
+		},
+		{
+			name:      "NGWithCodeはコードと項目名を保持する",
+			reason:    NGWithCode(ReasonCodeTooLong, "message", "メッセージは500文字以内で入力してください"),
+			wantCode:  ReasonCodeTooLong,
+			wantField: "message",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.reason.Code(); got != tt.wantCode {
+				t.Errorf("Code() = %v, want %v", got, tt.wantCode)
+			}
+			if got := tt.reason.Field(); got != tt.wantField {
+				t.Errorf("Field() = %v, want %v", got, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestNGReason_ErrorsAs(t *testing.T) {
+	err := fmt.Errorf("モーニングコールの検証に失敗しました: %w", NGWithCode(ReasonCodeRequired, "receiver_id", "受信者IDは必須です"))
+
+	var reason NGReason
+	if !errors.As(err, &reason) {
+		t.Fatal("errors.As() = false, want true")
+	}
+	if reason.Code() != ReasonCodeRequired || reason.Field() != "receiver_id" {
+		t.Errorf("(Code, Field) = (%v, %v), want (REQUIRED, receiver_id)", reason.Code(), reason.Field())
+	}
+	if err.Error() != "モーニングコールの検証に失敗しました: 受信者IDは必須です" {
+		t.Errorf("Error() = %q", err.Error())
+	}
+}
@@ -0,0 +1,89 @@
+package valueobject
+
+import "fmt"
+
+// Plan はユーザーの契約プランを表す
+type Plan string
+
+const (
+	// PlanFree は無料プラン
+	PlanFree Plan = "free"
+	// PlanPremium は有料プラン
+	PlanPremium Plan = "premium"
+)
+
+// IsValid はプランが有効な値かを検証する
+func (p Plan) IsValid() bool {
+	switch p {
+	case PlanFree, PlanPremium:
+		return true
+	default:
+		return false
+	}
+}
+
+// String はプランの文字列表現を返す
+func (p Plan) String() string {
+	return string(p)
+}
+
+// DisplayName はプランの表示名を返す
+func (p Plan) DisplayName() string {
+	switch p {
+	case PlanPremium:
+		return "プレミアムプラン"
+	default:
+		return "フリープラン"
+	}
+}
+
+// PlanQuota はプランごとの利用上限を表す
+// 各値が0以下の場合は無制限として扱う
+type PlanQuota struct {
+	MaxActiveCalls int // 同時にアクティブ（予定中・配信済み）にできるモーニングコール数
+	MaxDailyCalls  int // 1日に作成できるモーニングコール数
+	MaxFriends     int // 友達数（承認待ちの送信済みリクエストを含む）
+}
+
+// PlanQuotas はプランとクォータの対応表
+type PlanQuotas map[Plan]PlanQuota
+
+// DefaultPlanQuotas はデフォルトのプラン別クォータを返す
+func DefaultPlanQuotas() PlanQuotas {
+	return PlanQuotas{
+		PlanFree: {
+			MaxActiveCalls: 5,
+			MaxDailyCalls:  10,
+			MaxFriends:     50,
+		},
+		PlanPremium: {
+			MaxActiveCalls: 50,
+			MaxDailyCalls:  100,
+			MaxFriends:     500,
+		},
+	}
+}
+
+// For は指定したプランのクォータを返す
+// 未設定のプランや無効なプランはフリープランのクォータを返し、それも未設定なら無制限とする
+func (q PlanQuotas) For(plan Plan) PlanQuota {
+	if quota, ok := q[plan]; ok && plan.IsValid() {
+		return quota
+	}
+	return q[PlanFree]
+}
+
+// IsLimitReached は現在の件数が上限に達しているかを判定する（上限が0以下の場合は無制限）
+func IsLimitReached(limit, current int) bool {
+	return limit > 0 && current >= limit
+}
+
+// QuotaExceededMessage は上限到達時のエラーメッセージを返す
+// フリープランの場合はアップグレードを促す文言を付与する
+func QuotaExceededMessage(plan Plan, target string, limit int) string {
+	msg := fmt.Sprintf("%sの%sの上限（%d件）に達しました", plan.DisplayName(), target, limit)
+	if plan != PlanPremium {
+		msg += "。プレミアムプランにアップグレードすると上限が引き上げられます"
+	}
+	return msg
+}
@@ -0,0 +1,93 @@
+package valueobject
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPlan_IsValid(t *testing.T) {
+	tests := []struct {
+		name     string
+		plan     Plan
+		expected bool
+	}{
+		{name: "フリープランは有効", plan: PlanFree, expected: true},
+		{name: "プレミアムプランは有効", plan: PlanPremium, expected: true},
+		{name: "空文字は無効", plan: Plan(""), expected: false},
+		{name: "不明なプランは無効", plan: Plan("enterprise"), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.plan.IsValid(); got != tt.expected {
+				t.Errorf("IsValid() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPlanQuotas_For(t *testing.T) {
+	quotas := PlanQuotas{
+		PlanFree:    {MaxActiveCalls: 1, MaxDailyCalls: 2, MaxFriends: 3},
+		PlanPremium: {MaxActiveCalls: 10, MaxDailyCalls: 20, MaxFriends: 30},
+	}
+
+	tests := []struct {
+		name     string
+		quotas   PlanQuotas
+		plan     Plan
+		expected PlanQuota
+	}{
+		{name: "フリープランのクォータ", quotas: quotas, plan: PlanFree, expected: quotas[PlanFree]},
+		{name: "プレミアムプランのクォータ", quotas: quotas, plan: PlanPremium, expected: quotas[PlanPremium]},
+		{name: "無効なプランはフリープラン扱い", quotas: quotas, plan: Plan("unknown"), expected: quotas[PlanFree]},
+		{name: "未設定のプランはフリープラン扱い", quotas: PlanQuotas{PlanFree: quotas[PlanFree]}, plan: PlanPremium, expected: quotas[PlanFree]},
+		{name: "nilの場合は無制限", quotas: nil, plan: PlanFree, expected: PlanQuota{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.quotas.For(tt.plan); got != tt.expected {
+				t.Errorf("For() = %+v, want %+v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsLimitReached(t *testing.T) {
+	tests := []struct {
+		name     string
+		limit    int
+		current  int
+		expected bool
+	}{
+		{name: "上限未満", limit: 5, current: 4, expected: false},
+		{name: "上限ちょうど", limit: 5, current: 5, expected: true},
+		{name: "上限超過", limit: 5, current: 6, expected: true},
+		{name: "上限0は無制限", limit: 0, current: 100, expected: false},
+		{name: "上限が負数は無制限", limit: -1, current: 100, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsLimitReached(tt.limit, tt.current); got != tt.expected {
+				t.Errorf("IsLimitReached(%d, %d) = %v, want %v", tt.limit, tt.current, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestQuotaExceededMessage(t *testing.T) {
+	free := QuotaExceededMessage(PlanFree, "友達数", 50)
+	if !strings.Contains(free, "フリープランの友達数の上限（50件）") {
+		t.Errorf("unexpected message: %s", free)
+	}
+	if !strings.Contains(free, "アップグレード") {
+		t.Errorf("フリープランではアップグレードを促す必要があります: %s", free)
+	}
+
+	premium := QuotaExceededMessage(PlanPremium, "友達数", 500)
+	if strings.Contains(premium, "アップグレード") {
+		t.Errorf("プレミアムプランではアップグレードを促さないはずです: %s", premium)
+	}
+}
@@ -0,0 +1,66 @@
+package valueobject
+
+import (
+	"fmt"
+	"time"
+)
+
+// MaxRelativeScheduleDuration は「○分後」形式で指定できる最大の時間
+const MaxRelativeScheduleDuration = 7 * 24 * time.Hour
+
+// RelativeSchedule は「30分後」「明日の7時」のような相対的なアラーム時刻の指定
+// 絶対時刻への変換は基準時刻とタイムゾーンを与えてResolveで行う
+type RelativeSchedule struct {
+	after    time.Duration // 基準時刻からの経過時間（「○分後」形式）
+	tomorrow bool          // 「明日の○時」形式か
+	hour     int           // 「明日の○時」形式の時
+	minute   int           // 「明日の○時」形式の分
+}
+
+// ParseRelativeAfter は "30m" や "1h30m" のような経過時間の指定を解析する
+func ParseRelativeAfter(value string) (*RelativeSchedule, NGReason) {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, NGWithCode(ReasonCodeInvalidFormat, "in", "相対時刻は 30m や 1h30m の形式で指定してください")
+	}
+	if d <= 0 {
+		return nil, NGWithCode(ReasonCodeOutOfRange, "in", "相対時刻は正の時間で指定してください")
+	}
+	if d > MaxRelativeScheduleDuration {
+		return nil, NGWithCode(ReasonCodeOutOfRange, "in", "相対時刻は7日以内で指定してください")
+	}
+
+	return &RelativeSchedule{after: d}, OK()
+}
+
+// ParseRelativeTomorrowAt は "07:00" のような翌日の時刻の指定を解析する
+func ParseRelativeTomorrowAt(value string) (*RelativeSchedule, NGReason) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return nil, NGWithCode(ReasonCodeInvalidFormat, "tomorrow_at", "翌日の時刻は HH:MM の形式で指定してください")
+	}
+
+	return &RelativeSchedule{tomorrow: true, hour: t.Hour(), minute: t.Minute()}, OK()
+}
+
+// Resolve は基準時刻とタイムゾーンから絶対時刻に変換する
+// 「明日」はlocにおける基準時刻の翌日として扱う（locがnilの場合はUTC）
+func (r RelativeSchedule) Resolve(now time.Time, loc *time.Location) time.Time {
+	if !r.tomorrow {
+		return now.Add(r.after)
+	}
+
+	if loc == nil {
+		loc = time.UTC
+	}
+	local := now.In(loc)
+	return time.Date(local.Year(), local.Month(), local.Day()+1, r.hour, r.minute, 0, 0, loc)
+}
+
+// String は相対指定の文字列表現を返す
+func (r RelativeSchedule) String() string {
+	if r.tomorrow {
+		return fmt.Sprintf("tomorrow_at %02d:%02d", r.hour, r.minute)
+	}
+	return fmt.Sprintf("in %s", r.after)
+}
@@ -0,0 +1,133 @@
+package valueobject
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRelativeAfter(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "分指定", value: "30m", want: 30 * time.Minute},
+		{name: "時間と分", value: "1h30m", want: 90 * time.Minute},
+		{name: "上限ちょうど", value: "168h", want: MaxRelativeScheduleDuration},
+		{name: "上限超過", value: "168h1m", wantErr: true},
+		{name: "ゼロ", value: "0m", wantErr: true},
+		{name: "負の値", value: "-30m", wantErr: true},
+		{name: "単位なし", value: "30", wantErr: true},
+		{name: "不正な形式", value: "thirty minutes", wantErr: true},
+	}
+
+	now := time.Date(2024, 1, 1, 6, 0, 0, 0, time.UTC)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, reason := ParseRelativeAfter(tt.value)
+			if tt.wantErr {
+				if reason.IsOK() {
+					t.Errorf("ParseRelativeAfter(%q) should fail", tt.value)
+				}
+				return
+			}
+			if reason.IsNG() {
+				t.Fatalf("ParseRelativeAfter(%q) = %v", tt.value, reason)
+			}
+			if got := schedule.Resolve(now, nil); !got.Equal(now.Add(tt.want)) {
+				t.Errorf("Resolve() = %v, want %v", got, now.Add(tt.want))
+			}
+		})
+	}
+}
+
+func TestParseRelativeTomorrowAt(t *testing.T) {
+	valid := []string{"07:00", "00:00", "23:59"}
+	for _, value := range valid {
+		if _, reason := ParseRelativeTomorrowAt(value); reason.IsNG() {
+			t.Errorf("ParseRelativeTomorrowAt(%q) = %v, want OK", value, reason)
+		}
+	}
+
+	invalid := []string{"", "24:00", "07:60", "7時", "07:00:00"}
+	for _, value := range invalid {
+		if _, reason := ParseRelativeTomorrowAt(value); reason.IsOK() {
+			t.Errorf("ParseRelativeTomorrowAt(%q) should fail", value)
+		}
+	}
+}
+
+func TestRelativeSchedule_Resolve_TomorrowAt(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skipf("time zone data is not available: %v", err)
+	}
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data is not available: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		value string
+		now   time.Time
+		loc   *time.Location
+		want  time.Time
+	}{
+		{
+			name:  "通常",
+			value: "07:00",
+			now:   time.Date(2024, 5, 10, 22, 0, 0, 0, tokyo),
+			loc:   tokyo,
+			want:  time.Date(2024, 5, 11, 7, 0, 0, 0, tokyo),
+		},
+		{
+			name:  "月末",
+			value: "07:00",
+			now:   time.Date(2024, 2, 29, 23, 59, 0, 0, tokyo),
+			loc:   tokyo,
+			want:  time.Date(2024, 3, 1, 7, 0, 0, 0, tokyo),
+		},
+		{
+			name:  "年末",
+			value: "06:30",
+			now:   time.Date(2024, 12, 31, 12, 0, 0, 0, tokyo),
+			loc:   tokyo,
+			want:  time.Date(2025, 1, 1, 6, 30, 0, 0, tokyo),
+		},
+		{
+			name:  "受信者のタイムゾーンでは既に日付が変わっている",
+			value: "07:00",
+			now:   time.Date(2024, 5, 10, 16, 0, 0, 0, time.UTC), // 東京では5/11 01:00
+			loc:   tokyo,
+			want:  time.Date(2024, 5, 12, 7, 0, 0, 0, tokyo),
+		},
+		{
+			name:  "受信者のタイムゾーンではまだ前日",
+			value: "07:00",
+			now:   time.Date(2024, 5, 11, 2, 0, 0, 0, time.UTC), // ニューヨークでは5/10 22:00
+			loc:   newYork,
+			want:  time.Date(2024, 5, 11, 7, 0, 0, 0, newYork),
+		},
+		{
+			name:  "タイムゾーン未指定はUTC",
+			value: "07:00",
+			now:   time.Date(2024, 5, 10, 23, 0, 0, 0, time.UTC),
+			loc:   nil,
+			want:  time.Date(2024, 5, 11, 7, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, reason := ParseRelativeTomorrowAt(tt.value)
+			if reason.IsNG() {
+				t.Fatalf("ParseRelativeTomorrowAt(%q) = %v", tt.value, reason)
+			}
+			if got := schedule.Resolve(tt.now, tt.loc); !got.Equal(tt.want) {
+				t.Errorf("Resolve() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package valueobject
+
+import "time"
+
+// ReportPeriod はモーニングコールのレポートの集計単位
+type ReportPeriod string
+
+const (
+	// ReportPeriodWeekly は月曜日から日曜日までの1週間
+	ReportPeriodWeekly ReportPeriod = "weekly"
+	// ReportPeriodMonthly は1日から月末までの1か月
+	ReportPeriodMonthly ReportPeriod = "monthly"
+)
+
+// IsValid は集計単位が有効な値かを検証する
+func (p ReportPeriod) IsValid() bool {
+	switch p {
+	case ReportPeriodWeekly, ReportPeriodMonthly:
+		return true
+	default:
+		return false
+	}
+}
+
+// String は集計単位の文字列表現を返す
+func (p ReportPeriod) String() string {
+	return string(p)
+}
+
+// PreviousRange はnowの直前に終わった集計期間 [start, end) を返す
+// 期間の区切りはlocの0時で、週次は先週の月曜日から、月次は先月の1日から始まる
+func (p ReportPeriod) PreviousRange(now time.Time, loc *time.Location) (time.Time, time.Time) {
+	now = now.In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+
+	if p == ReportPeriodMonthly {
+		end := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, loc)
+		return end.AddDate(0, -1, 0), end
+	}
+	// 月曜日を週の始まりとする（time.Sundayは0）
+	daysSinceMonday := (int(today.Weekday()) + 6) % 7
+	end := today.AddDate(0, 0, -daysSinceMonday)
+	return end.AddDate(0, 0, -7), end
+}
+
+// ReportFormat はモーニングコールのレポートの出力形式
+type ReportFormat string
+
+const (
+	// ReportFormatJSON はJSON形式
+	ReportFormatJSON ReportFormat = "json"
+	// ReportFormatCSV はCSV形式（表計算ソフトで開けるよう1行1項目で出力する）
+	ReportFormatCSV ReportFormat = "csv"
+)
+
+// IsValid は出力形式が有効な値かを検証する
+func (f ReportFormat) IsValid() bool {
+	switch f {
+	case ReportFormatJSON, ReportFormatCSV:
+		return true
+	default:
+		return false
+	}
+}
+
+// ContentType は出力形式のMIMEタイプを返す
+func (f ReportFormat) ContentType() string {
+	if f == ReportFormatCSV {
+		return "text/csv; charset=utf-8"
+	}
+	return "application/json; charset=utf-8"
+}
+
+// String は出力形式の文字列表現を返す
+func (f ReportFormat) String() string {
+	return string(f)
+}
@@ -0,0 +1,97 @@
+package valueobject
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReportPeriod_IsValid(t *testing.T) {
+	tests := []struct {
+		period   ReportPeriod
+		expected bool
+	}{
+		{ReportPeriodWeekly, true},
+		{ReportPeriodMonthly, true},
+		{ReportPeriod(""), false},
+		{ReportPeriod("daily"), false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.period.IsValid(); got != tt.expected {
+			t.Errorf("ReportPeriod(%q).IsValid() = %v, want %v", tt.period, got, tt.expected)
+		}
+	}
+}
+
+func TestReportPeriod_PreviousRange(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		period    ReportPeriod
+		now       time.Time
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			name:      "週の途中なら先週の月曜日から日曜日まで",
+			period:    ReportPeriodWeekly,
+			now:       time.Date(2026, 3, 11, 12, 0, 0, 0, tokyo), // 水曜日
+			wantStart: time.Date(2026, 3, 2, 0, 0, 0, 0, tokyo),
+			wantEnd:   time.Date(2026, 3, 9, 0, 0, 0, 0, tokyo),
+		},
+		{
+			name:      "月曜日の0時なら直前の1週間",
+			period:    ReportPeriodWeekly,
+			now:       time.Date(2026, 3, 9, 0, 0, 0, 0, tokyo),
+			wantStart: time.Date(2026, 3, 2, 0, 0, 0, 0, tokyo),
+			wantEnd:   time.Date(2026, 3, 9, 0, 0, 0, 0, tokyo),
+		},
+		{
+			name:      "日曜日は同じ週に含める",
+			period:    ReportPeriodWeekly,
+			now:       time.Date(2026, 3, 8, 23, 0, 0, 0, tokyo),
+			wantStart: time.Date(2026, 2, 23, 0, 0, 0, 0, tokyo),
+			wantEnd:   time.Date(2026, 3, 2, 0, 0, 0, 0, tokyo),
+		},
+		{
+			name:      "月次は先月の1日から月末まで",
+			period:    ReportPeriodMonthly,
+			now:       time.Date(2026, 3, 11, 12, 0, 0, 0, tokyo),
+			wantStart: time.Date(2026, 2, 1, 0, 0, 0, 0, tokyo),
+			wantEnd:   time.Date(2026, 3, 1, 0, 0, 0, 0, tokyo),
+		},
+		{
+			name:      "UTCではまだ前日でもユーザーのタイムゾーンで区切る",
+			period:    ReportPeriodMonthly,
+			now:       time.Date(2026, 2, 28, 16, 0, 0, 0, time.UTC), // 東京では3月1日1時
+			wantStart: time.Date(2026, 2, 1, 0, 0, 0, 0, tokyo),
+			wantEnd:   time.Date(2026, 3, 1, 0, 0, 0, 0, tokyo),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := tt.period.PreviousRange(tt.now, tokyo)
+			if !start.Equal(tt.wantStart) || !end.Equal(tt.wantEnd) {
+				t.Errorf("PreviousRange() = [%v, %v), want [%v, %v)", start, end, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestReportFormat_IsValid(t *testing.T) {
+	for _, f := range []ReportFormat{ReportFormatJSON, ReportFormatCSV} {
+		if !f.IsValid() {
+			t.Errorf("ReportFormat(%q).IsValid() = false, want true", f)
+		}
+	}
+	for _, f := range []ReportFormat{"", "xml", "CSV"} {
+		if f.IsValid() {
+			t.Errorf("ReportFormat(%q).IsValid() = true, want false", f)
+		}
+	}
+}
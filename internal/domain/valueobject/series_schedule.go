@@ -0,0 +1,99 @@
+package valueobject
+
+import (
+	"fmt"
+	"time"
+)
+
+// MaxSeriesDays はシリーズで指定できる期間の最大日数（開始日と終了日を含む）
+const MaxSeriesDays = 31
+
+// seriesDateLayout はシリーズの開始日・終了日の形式
+const seriesDateLayout = "2006-01-02"
+
+// SeriesSchedule は「来週月〜金の毎朝7時」のような繰り返しのアラーム時刻の指定
+// 日付と時刻は受信者のタイムゾーンでの値として扱い、絶対時刻への変換はOccurrencesで行う
+type SeriesSchedule struct {
+	StartDate time.Time      // 開始日（年月日のみを使用する）
+	EndDate   time.Time      // 終了日（年月日のみを使用し、この日を含む）
+	Weekdays  []time.Weekday // アラームを設定する曜日（空の場合は毎日）
+	Minute    int            // アラーム時刻（0時からの分）
+}
+
+// ParseSeriesSchedule は "YYYY-MM-DD" 形式の開始日・終了日、曜日名（sun〜sat）、"HH:MM" 形式の時刻からシリーズの指定を作成する
+func ParseSeriesSchedule(startDate, endDate string, weekdays []string, at string) (*SeriesSchedule, NGReason) {
+	start, err := time.Parse(seriesDateLayout, startDate)
+	if err != nil {
+		return nil, NGWithCode(ReasonCodeInvalidFormat, "start_date", "開始日は YYYY-MM-DD の形式で指定してください")
+	}
+	end, err := time.Parse(seriesDateLayout, endDate)
+	if err != nil {
+		return nil, NGWithCode(ReasonCodeInvalidFormat, "end_date", "終了日は YYYY-MM-DD の形式で指定してください")
+	}
+	days, reason := parseWeekdays(weekdays)
+	if reason.IsNG() {
+		return nil, reason
+	}
+	minute, ok := parseMinuteOfDay(at)
+	if !ok {
+		return nil, NGWithCode(ReasonCodeInvalidFormat, "time", "時刻は HH:MM の形式で指定してください")
+	}
+
+	schedule := &SeriesSchedule{StartDate: start, EndDate: end, Weekdays: days, Minute: minute}
+	if reason := schedule.Validate(); reason.IsNG() {
+		return nil, reason
+	}
+	return schedule, OK()
+}
+
+// Validate はシリーズの指定の妥当性を検証する
+func (s SeriesSchedule) Validate() NGReason {
+	if s.Minute < 0 || s.Minute >= minutesPerDay {
+		return NGWithCode(ReasonCodeOutOfRange, "time", "時刻は00:00から23:59の範囲で指定してください")
+	}
+	if s.EndDate.Before(s.StartDate) {
+		return NGWithCode(ReasonCodeOutOfRange, "end_date", "終了日は開始日以降の日付を指定してください")
+	}
+	if s.days() > MaxSeriesDays {
+		return NGWithCode(ReasonCodeOutOfRange, "end_date", fmt.Sprintf("シリーズの期間は%d日以内で指定してください", MaxSeriesDays))
+	}
+	return OK()
+}
+
+// Occurrences は期間内の指定曜日ごとのアラーム時刻を古い順に返す
+// 日付と時刻はlocでの値として解釈する（locがnilの場合はUTC）
+func (s SeriesSchedule) Occurrences(loc *time.Location) []time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	var result []time.Time
+	for i := 0; i < s.days(); i++ {
+		date := s.StartDate.AddDate(0, 0, i)
+		if !s.includes(date.Weekday()) {
+			continue
+		}
+		result = append(result, time.Date(date.Year(), date.Month(), date.Day(), s.Minute/60, s.Minute%60, 0, 0, loc))
+	}
+	return result
+}
+
+// days は開始日から終了日までの日数を返す（両端を含む）
+func (s SeriesSchedule) days() int {
+	start := time.Date(s.StartDate.Year(), s.StartDate.Month(), s.StartDate.Day(), 0, 0, 0, 0, time.UTC)
+	end := time.Date(s.EndDate.Year(), s.EndDate.Month(), s.EndDate.Day(), 0, 0, 0, 0, time.UTC)
+	return int(end.Sub(start).Hours()/24) + 1
+}
+
+// includes は指定された曜日がシリーズの対象かを判定する
+func (s SeriesSchedule) includes(day time.Weekday) bool {
+	if len(s.Weekdays) == 0 {
+		return true
+	}
+	for _, d := range s.Weekdays {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package valueobject
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSeriesSchedule(t *testing.T) {
+	tests := []struct {
+		name      string
+		start     string
+		end       string
+		weekdays  []string
+		at        string
+		wantField string
+		wantError string
+	}{
+		{name: "期間・曜日・時刻を指定する", start: "2025-01-06", end: "2025-01-10", weekdays: []string{"mon", "Fri"}, at: "07:00"},
+		{name: "曜日を省略すると毎日", start: "2025-01-06", end: "2025-01-06", at: "07:00"},
+		{name: "開始日の形式が不正", start: "2025/01/06", end: "2025-01-10", at: "07:00", wantField: "start_date", wantError: "開始日は YYYY-MM-DD の形式で指定してください"},
+		{name: "終了日の形式が不正", start: "2025-01-06", end: "", at: "07:00", wantField: "end_date", wantError: "終了日は YYYY-MM-DD の形式で指定してください"},
+		{name: "曜日名が不正", start: "2025-01-06", end: "2025-01-10", weekdays: []string{"monday"}, at: "07:00", wantField: "weekdays", wantError: "曜日は sun, mon, tue, wed, thu, fri, sat のいずれかで指定してください"},
+		{name: "時刻の形式が不正", start: "2025-01-06", end: "2025-01-10", at: "7時", wantField: "time", wantError: "時刻は HH:MM の形式で指定してください"},
+		{name: "終了日が開始日より前", start: "2025-01-10", end: "2025-01-06", at: "07:00", wantField: "end_date", wantError: "終了日は開始日以降の日付を指定してください"},
+		{name: "期間が上限を超える", start: "2025-01-01", end: "2025-02-01", at: "07:00", wantField: "end_date", wantError: "シリーズの期間は31日以内で指定してください"},
+		{name: "期間が上限ちょうど", start: "2025-01-01", end: "2025-01-31", at: "07:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, reason := ParseSeriesSchedule(tt.start, tt.end, tt.weekdays, tt.at)
+			if tt.wantError != "" {
+				if reason.IsOK() || reason.Error() != tt.wantError || reason.Field() != tt.wantField {
+					t.Errorf("ParseSeriesSchedule() = %v (field %s), want %s (field %s)", reason, reason.Field(), tt.wantError, tt.wantField)
+				}
+				return
+			}
+			if reason.IsNG() || schedule == nil {
+				t.Fatalf("ParseSeriesSchedule() unexpected error = %v", reason)
+			}
+		})
+	}
+}
+
+func TestSeriesSchedule_Occurrences(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skipf("タイムゾーンデータを読み込めません: %v", err)
+	}
+
+	// 2025-01-06は月曜日
+	schedule, reason := ParseSeriesSchedule("2025-01-04", "2025-01-12", []string{"mon", "tue", "wed", "thu", "fri"}, "07:30")
+	if reason.IsNG() {
+		t.Fatalf("ParseSeriesSchedule() = %v", reason)
+	}
+
+	got := schedule.Occurrences(tokyo)
+	if len(got) != 5 {
+		t.Fatalf("Occurrences() = %d, want 5", len(got))
+	}
+	for i, occurrence := range got {
+		want := time.Date(2025, 1, 6+i, 7, 30, 0, 0, tokyo)
+		if !occurrence.Equal(want) {
+			t.Errorf("Occurrences()[%d] = %v, want %v", i, occurrence, want)
+		}
+	}
+
+	// タイムゾーン未指定の場合はUTCで解釈する
+	if got := schedule.Occurrences(nil); !got[0].Equal(time.Date(2025, 1, 6, 7, 30, 0, 0, time.UTC)) {
+		t.Errorf("Occurrences(nil)[0] = %v, want UTC", got[0])
+	}
+}
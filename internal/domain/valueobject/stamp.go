@@ -0,0 +1,33 @@
+package valueobject
+
+// Stamp は起床確認時に受信者から送信者へ返す定型スタンプを表す
+type Stamp string
+
+const (
+	// StampThankYou はお礼のスタンプ
+	StampThankYou Stamp = "thank_you"
+	// StampHeart はハートのスタンプ
+	StampHeart Stamp = "heart"
+	// StampSleepy は眠そうなスタンプ
+	StampSleepy Stamp = "sleepy"
+	// StampThumbsUp はいいねのスタンプ
+	StampThumbsUp Stamp = "thumbs_up"
+)
+
+// IsValid はスタンプが有効な値かを検証する
+func (s Stamp) IsValid() bool {
+	switch s {
+	case StampThankYou,
+		StampHeart,
+		StampSleepy,
+		StampThumbsUp:
+		return true
+	default:
+		return false
+	}
+}
+
+// String はスタンプの文字列表現を返す
+func (s Stamp) String() string {
+	return string(s)
+}
@@ -0,0 +1,26 @@
+package valueobject
+
+import "testing"
+
+func TestStamp_IsValid(t *testing.T) {
+	tests := []struct {
+		name     string
+		stamp    Stamp
+		expected bool
+	}{
+		{name: "お礼は有効", stamp: StampThankYou, expected: true},
+		{name: "ハートは有効", stamp: StampHeart, expected: true},
+		{name: "眠そうは有効", stamp: StampSleepy, expected: true},
+		{name: "いいねは有効", stamp: StampThumbsUp, expected: true},
+		{name: "空文字は無効", stamp: Stamp(""), expected: false},
+		{name: "不明なスタンプは無効", stamp: Stamp("angry"), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.stamp.IsValid(); got != tt.expected {
+				t.Errorf("IsValid() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
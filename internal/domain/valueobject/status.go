@@ -4,6 +4,8 @@ package valueobject
 type MorningCallStatus string
 
 const (
+	// MorningCallStatusPendingApproval は受信者の承認待ち状態（受信者が事前承認制を有効にしている場合）
+	MorningCallStatusPendingApproval MorningCallStatus = "pending_approval"
 	// MorningCallStatusScheduled はスケジュール済み状態
 	MorningCallStatusScheduled MorningCallStatus = "scheduled"
 	// MorningCallStatusDelivered は配信済み状態
@@ -14,16 +16,23 @@ const (
 	MorningCallStatusCancelled MorningCallStatus = "cancelled"
 	// MorningCallStatusExpired は期限切れ状態
 	MorningCallStatusExpired MorningCallStatus = "expired"
+	// MorningCallStatusSkipped は受信者がスキップした状態（送信者によるキャンセルとは区別する）
+	MorningCallStatusSkipped MorningCallStatus = "skipped"
+	// MorningCallStatusRejected は受信者が承認を拒否した状態
+	MorningCallStatusRejected MorningCallStatus = "rejected"
 )
 
 // IsValid はステータスが有効な値かを検証する
 func (s MorningCallStatus) IsValid() bool {
 	switch s {
-	case MorningCallStatusScheduled,
+	case MorningCallStatusPendingApproval,
+		MorningCallStatusScheduled,
 		MorningCallStatusDelivered,
 		MorningCallStatusConfirmed,
 		MorningCallStatusCancelled,
-		MorningCallStatusExpired:
+		MorningCallStatusExpired,
+		MorningCallStatusSkipped,
+		MorningCallStatusRejected:
 		return true
 	default:
 		return false
@@ -38,14 +47,21 @@ func (s MorningCallStatus) String() string {
 // CanTransitionTo は指定されたステータスへの遷移が可能かを検証する
 func (s MorningCallStatus) CanTransitionTo(next MorningCallStatus) bool {
 	switch s {
+	case MorningCallStatusPendingApproval:
+		// 受信者の承認でスケジュール済みになる。承認前でも送信者によるキャンセルは可能
+		return next == MorningCallStatusScheduled || next == MorningCallStatusRejected ||
+			next == MorningCallStatusCancelled || next == MorningCallStatusExpired
 	case MorningCallStatusScheduled:
 		// 開発・テスト環境では、Scheduledから直接Confirmedへの遷移も許可
 		// 本番環境では、Delivered経由でのみConfirmedに遷移すべき
 		return next == MorningCallStatusDelivered || next == MorningCallStatusCancelled || 
-			next == MorningCallStatusExpired || next == MorningCallStatusConfirmed
+			next == MorningCallStatusExpired || next == MorningCallStatusConfirmed ||
+			next == MorningCallStatusSkipped
 	case MorningCallStatusDelivered:
-		return next == MorningCallStatusConfirmed || next == MorningCallStatusExpired
-	case MorningCallStatusConfirmed, MorningCallStatusCancelled, MorningCallStatusExpired:
+		return next == MorningCallStatusConfirmed || next == MorningCallStatusExpired ||
+			next == MorningCallStatusSkipped
+	case MorningCallStatusConfirmed, MorningCallStatusCancelled, MorningCallStatusExpired, MorningCallStatusSkipped,
+		MorningCallStatusRejected:
 		return false // 終了状態からの遷移は不可
 	default:
 		return false
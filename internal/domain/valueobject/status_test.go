@@ -33,6 +33,21 @@ func TestMorningCallStatus_IsValid(t *testing.T) {
 			status:   MorningCallStatusExpired,
 			expected: true,
 		},
+		{
+			name:     "スキップ済みは有効",
+			status:   MorningCallStatusSkipped,
+			expected: true,
+		},
+		{
+			name:     "承認待ちは有効",
+			status:   MorningCallStatusPendingApproval,
+			expected: true,
+		},
+		{
+			name:     "拒否済みは有効",
+			status:   MorningCallStatusRejected,
+			expected: true,
+		},
 		{
 			name:     "不明なステータスは無効",
 			status:   MorningCallStatus("unknown"),
@@ -61,6 +76,43 @@ func TestMorningCallStatus_CanTransitionTo(t *testing.T) {
 		to       MorningCallStatus
 		expected bool
 	}{
+		// PendingApproval からの遷移
+		{
+			name:     "承認待ち→スケジュール済み",
+			from:     MorningCallStatusPendingApproval,
+			to:       MorningCallStatusScheduled,
+			expected: true,
+		},
+		{
+			name:     "承認待ち→拒否済み",
+			from:     MorningCallStatusPendingApproval,
+			to:       MorningCallStatusRejected,
+			expected: true,
+		},
+		{
+			name:     "承認待ち→キャンセル",
+			from:     MorningCallStatusPendingApproval,
+			to:       MorningCallStatusCancelled,
+			expected: true,
+		},
+		{
+			name:     "承認待ち→配信済みは不可",
+			from:     MorningCallStatusPendingApproval,
+			to:       MorningCallStatusDelivered,
+			expected: false,
+		},
+		{
+			name:     "拒否済み→スケジュール済みは不可",
+			from:     MorningCallStatusRejected,
+			to:       MorningCallStatusScheduled,
+			expected: false,
+		},
+		{
+			name:     "スケジュール済み→拒否済みは不可",
+			from:     MorningCallStatusScheduled,
+			to:       MorningCallStatusRejected,
+			expected: false,
+		},
 		// Scheduled からの遷移
 		{
 			name:     "スケジュール済み→配信済み",
@@ -86,6 +138,12 @@ func TestMorningCallStatus_CanTransitionTo(t *testing.T) {
 			to:       MorningCallStatusConfirmed,
 			expected: true, // 開発・テスト環境用に変更
 		},
+		{
+			name:     "スケジュール済み→スキップ",
+			from:     MorningCallStatusScheduled,
+			to:       MorningCallStatusSkipped,
+			expected: true,
+		},
 		// Delivered からの遷移
 		{
 			name:     "配信済み→確認済み",
@@ -105,6 +163,12 @@ func TestMorningCallStatus_CanTransitionTo(t *testing.T) {
 			to:       MorningCallStatusCancelled,
 			expected: false,
 		},
+		{
+			name:     "配信済み→スキップ",
+			from:     MorningCallStatusDelivered,
+			to:       MorningCallStatusSkipped,
+			expected: true,
+		},
 		// 終了状態からの遷移
 		{
 			name:     "確認済み→他の状態（不可）",
@@ -124,6 +188,18 @@ func TestMorningCallStatus_CanTransitionTo(t *testing.T) {
 			to:       MorningCallStatusScheduled,
 			expected: false,
 		},
+		{
+			name:     "スキップ済み→確認済み（不可）",
+			from:     MorningCallStatusSkipped,
+			to:       MorningCallStatusConfirmed,
+			expected: false,
+		},
+		{
+			name:     "確認済み→スキップ（不可）",
+			from:     MorningCallStatusConfirmed,
+			to:       MorningCallStatusSkipped,
+			expected: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,130 @@
+package valueobject
+
+import "strings"
+
+// SystemMessageCategory はシステムが用意した定型メッセージのカテゴリを表す
+type SystemMessageCategory string
+
+const (
+	// SystemMessageCategoryCheerful は元気づける定型文
+	SystemMessageCategoryCheerful SystemMessageCategory = "cheerful"
+	// SystemMessageCategoryGentle は優しく起こす定型文
+	SystemMessageCategoryGentle SystemMessageCategory = "gentle"
+	// SystemMessageCategoryStrict は厳しめに起こす定型文
+	SystemMessageCategoryStrict SystemMessageCategory = "strict"
+)
+
+// DefaultSystemMessageLanguage は定型文の言語が未指定または未対応の場合に使う言語
+const DefaultSystemMessageLanguage = "ja"
+
+// IsValid はカテゴリが有効な値かを検証する
+func (c SystemMessageCategory) IsValid() bool {
+	switch c {
+	case SystemMessageCategoryCheerful,
+		SystemMessageCategoryGentle,
+		SystemMessageCategoryStrict:
+		return true
+	default:
+		return false
+	}
+}
+
+// String はカテゴリの文字列表現を返す
+func (c SystemMessageCategory) String() string {
+	return string(c)
+}
+
+// SystemMessage はシステムが用意した定型メッセージを表す
+type SystemMessage struct {
+	ID       string
+	Category SystemMessageCategory
+	Texts    map[string]string // 言語コード -> 本文（DefaultSystemMessageLanguageの本文は必須）
+}
+
+// Text は指定した言語の本文と、実際に使った言語コードを返す
+// 指定した言語の本文がない場合はDefaultSystemMessageLanguageの本文を返す
+func (m SystemMessage) Text(lang string) (string, string) {
+	lang = strings.ToLower(lang)
+	if text, ok := m.Texts[lang]; ok {
+		return text, lang
+	}
+	return m.Texts[DefaultSystemMessageLanguage], DefaultSystemMessageLanguage
+}
+
+// SystemMessageCatalog は定型メッセージの一覧（表示順）
+type SystemMessageCatalog []SystemMessage
+
+// Find はIDで定型メッセージを探す
+func (c SystemMessageCatalog) Find(id string) (SystemMessage, bool) {
+	for _, m := range c {
+		if m.ID == id {
+			return m, true
+		}
+	}
+	return SystemMessage{}, false
+}
+
+// SupportsLanguage は一覧のいずれかの定型メッセージが指定した言語の本文を持つかを判定する
+func (c SystemMessageCatalog) SupportsLanguage(lang string) bool {
+	lang = strings.ToLower(lang)
+	for _, m := range c {
+		if _, ok := m.Texts[lang]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultSystemMessageCatalog は組み込みの定型メッセージの一覧を返す
+func DefaultSystemMessageCatalog() SystemMessageCatalog {
+	return SystemMessageCatalog{
+		{
+			ID:       "cheerful-good-morning",
+			Category: SystemMessageCategoryCheerful,
+			Texts: map[string]string{
+				"ja": "おはよう！今日も一日がんばろう！",
+				"en": "Good morning! Let's make today a great day!",
+			},
+		},
+		{
+			ID:       "cheerful-new-day",
+			Category: SystemMessageCategoryCheerful,
+			Texts: map[string]string{
+				"ja": "新しい一日の始まりだよ。きっといいことがあるよ！",
+				"en": "A brand new day has started. Something good is waiting for you!",
+			},
+		},
+		{
+			ID:       "gentle-wake-up",
+			Category: SystemMessageCategoryGentle,
+			Texts: map[string]string{
+				"ja": "おはよう。ゆっくりでいいから起きてね",
+				"en": "Good morning. Take your time and wake up slowly.",
+			},
+		},
+		{
+			ID:       "gentle-slept-well",
+			Category: SystemMessageCategoryGentle,
+			Texts: map[string]string{
+				"ja": "よく眠れた？無理せず良い一日を",
+				"en": "Did you sleep well? Have a nice, easy day.",
+			},
+		},
+		{
+			ID:       "strict-get-up",
+			Category: SystemMessageCategoryStrict,
+			Texts: map[string]string{
+				"ja": "起きる時間です。今すぐ起きてください！",
+				"en": "It's time. Get up right now!",
+			},
+		},
+		{
+			ID:       "strict-no-snooze",
+			Category: SystemMessageCategoryStrict,
+			Texts: map[string]string{
+				"ja": "二度寝は禁止！遅刻しますよ",
+				"en": "No snoozing! You'll be late.",
+			},
+		},
+	}
+}
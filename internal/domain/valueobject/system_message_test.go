@@ -0,0 +1,54 @@
+package valueobject
+
+import "testing"
+
+func TestDefaultSystemMessageCatalog(t *testing.T) {
+	catalog := DefaultSystemMessageCatalog()
+	seen := make(map[string]bool)
+	for _, m := range catalog {
+		if seen[m.ID] {
+			t.Errorf("IDが重複しています: %s", m.ID)
+		}
+		seen[m.ID] = true
+		if !m.Category.IsValid() {
+			t.Errorf("%s: カテゴリが不正です: %s", m.ID, m.Category)
+		}
+		if m.Texts[DefaultSystemMessageLanguage] == "" {
+			t.Errorf("%s: デフォルト言語の本文がありません", m.ID)
+		}
+	}
+	for _, c := range []SystemMessageCategory{SystemMessageCategoryCheerful, SystemMessageCategoryGentle, SystemMessageCategoryStrict} {
+		found := false
+		for _, m := range catalog {
+			found = found || m.Category == c
+		}
+		if !found {
+			t.Errorf("カテゴリ %s の定型文がありません", c)
+		}
+	}
+}
+
+func TestSystemMessage_Text(t *testing.T) {
+	m := SystemMessage{ID: "hello", Category: SystemMessageCategoryGentle, Texts: map[string]string{"ja": "おはよう", "en": "Good morning"}}
+
+	tests := []struct {
+		name     string
+		lang     string
+		wantText string
+		wantLang string
+	}{
+		{name: "英語", lang: "en", wantText: "Good morning", wantLang: "en"},
+		{name: "大文字の言語コード", lang: "EN", wantText: "Good morning", wantLang: "en"},
+		{name: "未対応の言語はデフォルト言語", lang: "fr", wantText: "おはよう", wantLang: "ja"},
+		{name: "未指定はデフォルト言語", lang: "", wantText: "おはよう", wantLang: "ja"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, lang := m.Text(tt.lang)
+			if text != tt.wantText || lang != tt.wantLang {
+				t.Errorf("Text(%q) = (%q, %q), want (%q, %q)", tt.lang, text, lang, tt.wantText, tt.wantLang)
+			}
+		})
+	}
+}
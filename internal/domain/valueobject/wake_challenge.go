@@ -0,0 +1,93 @@
+package valueobject
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ChallengeDifficulty は起床クイズの難易度を表す
+type ChallengeDifficulty string
+
+const (
+	// ChallengeDifficultyEasy は1桁同士の足し算
+	ChallengeDifficultyEasy ChallengeDifficulty = "easy"
+	// ChallengeDifficultyNormal は2桁同士の足し算・引き算
+	ChallengeDifficultyNormal ChallengeDifficulty = "normal"
+	// ChallengeDifficultyHard は掛け算と足し算の組み合わせ
+	ChallengeDifficultyHard ChallengeDifficulty = "hard"
+)
+
+// IsValid は難易度が有効な値かを検証する
+func (d ChallengeDifficulty) IsValid() bool {
+	switch d {
+	case ChallengeDifficultyEasy,
+		ChallengeDifficultyNormal,
+		ChallengeDifficultyHard:
+		return true
+	default:
+		return false
+	}
+}
+
+// String は難易度の文字列表現を返す
+func (d ChallengeDifficulty) String() string {
+	return string(d)
+}
+
+// Challenge は起床確認の前に受信者が答える計算問題を表す
+// 答えは受信者に返さないこと
+type Challenge struct {
+	Difficulty ChallengeDifficulty
+	Question   string // 例: "12 + 34"
+	Answer     int
+}
+
+// NewChallenge は難易度に応じた計算問題を作成する
+// intnは0以上n未満の乱数を返す関数（テストでは固定値を返す関数を渡す）
+func NewChallenge(difficulty ChallengeDifficulty, intn func(n int) int) (*Challenge, NGReason) {
+	// between は min以上max以下の乱数を返す
+	between := func(min, max int) int {
+		return min + intn(max-min+1)
+	}
+
+	challenge := &Challenge{Difficulty: difficulty}
+	switch difficulty {
+	case ChallengeDifficultyEasy:
+		a, b := between(1, 9), between(1, 9)
+		challenge.Question, challenge.Answer = fmt.Sprintf("%d + %d", a, b), a+b
+	case ChallengeDifficultyNormal:
+		a, b := between(10, 99), between(10, 99)
+		// 答えが負にならないよう大きい方から引く
+		if intn(2) == 0 {
+			challenge.Question, challenge.Answer = fmt.Sprintf("%d + %d", a, b), a+b
+		} else {
+			if a < b {
+				a, b = b, a
+			}
+			challenge.Question, challenge.Answer = fmt.Sprintf("%d - %d", a, b), a-b
+		}
+	case ChallengeDifficultyHard:
+		a, b, c := between(3, 19), between(3, 9), between(10, 99)
+		challenge.Question, challenge.Answer = fmt.Sprintf("%d × %d + %d", a, b, c), a*b+c
+	default:
+		return nil, NGWithCode(ReasonCodeInvalid, "challenge_difficulty", "起床クイズの難易度が不正です")
+	}
+	return challenge, OK()
+}
+
+// Check は回答が正解かを検証する（前後の空白は無視する）
+func (c *Challenge) Check(answer string) NGReason {
+	answer = strings.TrimSpace(answer)
+	if answer == "" {
+		return NGWithCode(ReasonCodeRequired, "challenge_answer", "起床クイズの回答は必須です")
+	}
+	value, err := strconv.Atoi(answer)
+	if err != nil {
+		return NGWithCode(ReasonCodeInvalidFormat, "challenge_answer", "起床クイズの回答は整数で入力してください")
+	}
+	if value != c.Answer {
+		return NGWithCode(ReasonCodeMismatch, "challenge_answer", "起床クイズの回答が正しくありません")
+	}
+	return OK()
+}
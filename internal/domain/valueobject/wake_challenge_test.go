@@ -0,0 +1,116 @@
+package valueobject
+
+import (
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestChallengeDifficulty_IsValid(t *testing.T) {
+	for _, d := range []ChallengeDifficulty{ChallengeDifficultyEasy, ChallengeDifficultyNormal, ChallengeDifficultyHard} {
+		if !d.IsValid() {
+			t.Errorf("%s.IsValid() = false, want true", d)
+		}
+	}
+	if ChallengeDifficulty("extreme").IsValid() {
+		t.Error("extreme.IsValid() = true, want false")
+	}
+}
+
+func TestNewChallenge(t *testing.T) {
+	// 固定値の乱数で問題と答えが決まる
+	zero := func(n int) int { return 0 }
+	tests := []struct {
+		difficulty   ChallengeDifficulty
+		wantQuestion string
+		wantAnswer   int
+	}{
+		{ChallengeDifficultyEasy, "1 + 1", 2},
+		{ChallengeDifficultyNormal, "10 + 10", 20},
+		{ChallengeDifficultyHard, "3 × 3 + 10", 19},
+	}
+	for _, tt := range tests {
+		challenge, reason := NewChallenge(tt.difficulty, zero)
+		if reason.IsNG() {
+			t.Fatalf("NewChallenge(%s) error = %v", tt.difficulty, reason)
+		}
+		if challenge.Question != tt.wantQuestion || challenge.Answer != tt.wantAnswer || challenge.Difficulty != tt.difficulty {
+			t.Errorf("NewChallenge(%s) = %+v, want %q = %d", tt.difficulty, challenge, tt.wantQuestion, tt.wantAnswer)
+		}
+	}
+
+	if _, reason := NewChallenge("extreme", zero); reason.Code() != ReasonCodeInvalid {
+		t.Errorf("NewChallenge(extreme) reason = %v, want INVALID", reason)
+	}
+}
+
+func TestNewChallenge_AnswerMatchesQuestion(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	for _, difficulty := range []ChallengeDifficulty{ChallengeDifficultyEasy, ChallengeDifficultyNormal, ChallengeDifficultyHard} {
+		for range 100 {
+			challenge, _ := NewChallenge(difficulty, rng.IntN)
+			if got := evaluateQuestion(t, challenge.Question); got != challenge.Answer {
+				t.Fatalf("%s: %q evaluates to %d, answer = %d", difficulty, challenge.Question, got, challenge.Answer)
+			}
+			if challenge.Answer < 0 {
+				t.Fatalf("%s: %q has negative answer %d", difficulty, challenge.Question, challenge.Answer)
+			}
+		}
+	}
+}
+
+// evaluateQuestion は "a + b"・"a - b"・"a × b + c" 形式の問題を計算する
+func evaluateQuestion(t *testing.T, question string) int {
+	t.Helper()
+	tokens := strings.Fields(question)
+	atoi := func(s string) int {
+		v, err := strconv.Atoi(s)
+		if err != nil {
+			t.Fatalf("invalid question %q", question)
+		}
+		return v
+	}
+	result := atoi(tokens[0])
+	for i := 1; i+1 < len(tokens); i += 2 {
+		operand := atoi(tokens[i+1])
+		switch tokens[i] {
+		case "+":
+			result += operand
+		case "-":
+			result -= operand
+		case "×":
+			result *= operand
+		default:
+			t.Fatalf("invalid operator in %q", question)
+		}
+	}
+	return result
+}
+
+func TestChallenge_Check(t *testing.T) {
+	challenge := &Challenge{Difficulty: ChallengeDifficultyEasy, Question: "3 + 4", Answer: 7}
+
+	tests := []struct {
+		answer   string
+		wantCode ReasonCode // 空の場合は正解
+	}{
+		{answer: "7"},
+		{answer: " 7 "},
+		{answer: "8", wantCode: ReasonCodeMismatch},
+		{answer: "", wantCode: ReasonCodeRequired},
+		{answer: "seven", wantCode: ReasonCodeInvalidFormat},
+	}
+	for _, tt := range tests {
+		reason := challenge.Check(tt.answer)
+		if tt.wantCode == "" {
+			if reason.IsNG() {
+				t.Errorf("Check(%q) = %v, want OK", tt.answer, reason)
+			}
+			continue
+		}
+		if reason.Code() != tt.wantCode {
+			t.Errorf("Check(%q) code = %s, want %s", tt.answer, reason.Code(), tt.wantCode)
+		}
+	}
+}
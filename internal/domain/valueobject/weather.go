@@ -0,0 +1,54 @@
+package valueobject
+
+import "time"
+
+// WeatherCondition はアラーム時刻の調整に使う天気の種類
+type WeatherCondition string
+
+const (
+	// WeatherClear は晴れ
+	WeatherClear WeatherCondition = "clear"
+	// WeatherCloudy は曇り
+	WeatherCloudy WeatherCondition = "cloudy"
+	// WeatherRain は雨
+	WeatherRain WeatherCondition = "rain"
+	// WeatherSnow は雪
+	WeatherSnow WeatherCondition = "snow"
+)
+
+// IsValid は定義済みの天気かを判定する
+func (c WeatherCondition) IsValid() bool {
+	switch c {
+	case WeatherClear, WeatherCloudy, WeatherRain, WeatherSnow:
+		return true
+	default:
+		return false
+	}
+}
+
+// MaxWeatherAdjustment は天気に応じてアラーム時刻をずらせる幅の上限（前後とも）
+const MaxWeatherAdjustment = 2 * time.Hour
+
+// WeatherAdjustmentRules は天気ごとのアラーム時刻のずらし幅（負の値で早める）
+// ルールのない天気は調整しない
+type WeatherAdjustmentRules map[WeatherCondition]time.Duration
+
+// DefaultWeatherAdjustmentRules は雨の日は15分、雪の日は30分早く起こすデフォルトのルールを返す
+func DefaultWeatherAdjustmentRules() WeatherAdjustmentRules {
+	return WeatherAdjustmentRules{
+		WeatherRain: -15 * time.Minute,
+		WeatherSnow: -30 * time.Minute,
+	}
+}
+
+// OffsetFor は天気に応じたずらし幅を返す（上限を超える設定は上限に丸める）
+func (r WeatherAdjustmentRules) OffsetFor(condition WeatherCondition) time.Duration {
+	offset := r[condition]
+	if offset > MaxWeatherAdjustment {
+		return MaxWeatherAdjustment
+	}
+	if offset < -MaxWeatherAdjustment {
+		return -MaxWeatherAdjustment
+	}
+	return offset
+}
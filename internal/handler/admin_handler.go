@@ -0,0 +1,392 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/handler/dto/request"
+	"github.com/ochamu/morning-call-api/internal/handler/dto/response"
+	adminUC "github.com/ochamu/morning-call-api/internal/usecase/admin"
+	mcCreate "github.com/ochamu/morning-call-api/internal/usecase/morning_call"
+	"github.com/ochamu/morning-call-api/internal/usecase/user"
+)
+
+// AdminHandler は管理者向けのHTTPハンドラー
+type AdminHandler struct {
+	*BaseHandler
+	adminListUsersUC  *user.AdminListUsersUseCase
+	adminChangePlanUC *user.AdminChangePlanUseCase
+	bulkUpdateUC      *mcCreate.AdminBulkUpdateStatusUseCase
+	detectAnomaliesUC *adminUC.DetectAnomalousUsersUseCase
+	systemStatsUC     *adminUC.SystemStatsUseCase
+	adminDeleteUC     *mcCreate.AdminDeleteUseCase
+}
+
+// NewAdminHandler は新しいAdminHandlerを作成する
+func NewAdminHandler(
+	adminListUsersUC *user.AdminListUsersUseCase,
+	adminChangePlanUC *user.AdminChangePlanUseCase,
+	bulkUpdateUC *mcCreate.AdminBulkUpdateStatusUseCase,
+	detectAnomaliesUC *adminUC.DetectAnomalousUsersUseCase,
+	systemStatsUC *adminUC.SystemStatsUseCase,
+	adminDeleteUC *mcCreate.AdminDeleteUseCase,
+) *AdminHandler {
+	return &AdminHandler{
+		BaseHandler:       NewBaseHandler(),
+		adminListUsersUC:  adminListUsersUC,
+		adminChangePlanUC: adminChangePlanUC,
+		bulkUpdateUC:      bulkUpdateUC,
+		detectAnomaliesUC: detectAnomaliesUC,
+		systemStatsUC:     systemStatsUC,
+		adminDeleteUC:     adminDeleteUC,
+	}
+}
+
+// HandleListUsers は管理者向けにユーザー一覧を取得する
+// GET /api/v1/admin/users?query=xxx&frozen=true&sort=created_at_desc&include_counts=true&offset=0&limit=20
+func (h *AdminHandler) HandleListUsers(w http.ResponseWriter, r *http.Request) {
+	// GETメソッドのみ許可
+	if r.Method != http.MethodGet {
+		h.SendMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	// 認証が必要
+	currentUser, ok := h.RequireAuth(w, r)
+	if !ok {
+		return
+	}
+
+	// クエリパラメータのパース
+	q := newQueryParams(r)
+	input := user.AdminListUsersInput{
+		RequesterID: currentUser.ID,
+		Query:       q.get("query"),
+		Sort:        user.SortOrder(q.get("sort")),
+	}
+	if q.get("frozen") != "" {
+		frozen := queryBool(q, "frozen", false)
+		input.Frozen = &frozen
+	}
+	input.IncludeCounts = queryBool(q, "include_counts", false)
+	input.Offset = queryInt(q, "offset", 0, 0, queryNoMax)
+	input.Limit = queryInt(q, "limit", 0, 0, queryNoMax)
+	if h.sendQueryErrors(w, q) {
+		return
+	}
+
+	output, err := h.adminListUsersUC.Execute(r.Context(), input)
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// DTOに変換
+	users := make([]response.AdminUserDTO, 0, len(output.Users))
+	for _, s := range output.Users {
+		users = append(users, response.AdminUserDTO{
+			ID:          s.User.ID,
+			Username:    s.User.Username,
+			Email:       s.User.Email,
+			IsAdmin:     s.User.IsAdmin,
+			IsFrozen:    s.User.IsFrozen,
+			Plan:        s.User.EffectivePlan().String(),
+			Points:      s.User.Points,
+			FriendCount: s.FriendCount,
+			CallCount:   s.CallCount,
+			CreatedAt:   s.User.CreatedAt,
+			UpdatedAt:   s.User.UpdatedAt,
+		})
+	}
+
+	h.SendJSON(w, http.StatusOK, response.AdminUserListResponse{
+		Users:   users,
+		Total:   output.TotalCount,
+		Limit:   input.Limit,
+		Offset:  input.Offset,
+		HasNext: output.HasNext,
+	})
+}
+
+// HandleChangePlan は管理者がユーザーのプランを変更する
+// PUT /api/v1/admin/users/{id}/plan
+func (h *AdminHandler) HandleChangePlan(w http.ResponseWriter, r *http.Request) {
+	// PUTメソッドのみ許可
+	if r.Method != http.MethodPut {
+		h.SendMethodNotAllowed(w, http.MethodPut)
+		return
+	}
+
+	// 認証が必要
+	currentUser, ok := h.RequireAuth(w, r)
+	if !ok {
+		return
+	}
+
+	// パスからユーザーIDを取得
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/admin/users/"), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] != "plan" {
+		h.SendEndpointNotFound(w)
+		return
+	}
+
+	var req request.ChangePlanRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "PARSE_ERROR", err)
+		return
+	}
+
+	output, err := h.adminChangePlanUC.Execute(r.Context(), user.AdminChangePlanInput{
+		RequesterID: currentUser.ID,
+		UserID:      parts[0],
+		Plan:        valueobject.Plan(req.Plan),
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	h.SendJSON(w, http.StatusOK, response.AdminUserDTO{
+		ID:        output.User.ID,
+		Username:  output.User.Username,
+		Email:     output.User.Email,
+		IsAdmin:   output.User.IsAdmin,
+		IsFrozen:  output.User.IsFrozen,
+		Plan:      output.User.EffectivePlan().String(),
+		Points:    output.User.Points,
+		CreatedAt: output.User.CreatedAt,
+		UpdatedAt: output.User.UpdatedAt,
+	})
+}
+
+// HandleBulkUpdateMorningCallStatus は管理者がモーニングコールのステータスを一括更新する
+// POST /api/v1/admin/morning-calls/bulk-status
+func (h *AdminHandler) HandleBulkUpdateMorningCallStatus(w http.ResponseWriter, r *http.Request) {
+	// POSTメソッドのみ許可
+	if r.Method != http.MethodPost {
+		h.SendMethodNotAllowed(w, http.MethodPost)
+		return
+	}
+
+	// 認証が必要
+	currentUser, ok := h.RequireAuth(w, r)
+	if !ok {
+		return
+	}
+
+	var req request.BulkUpdateMorningCallStatusRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "PARSE_ERROR", err)
+		return
+	}
+
+	output, err := h.bulkUpdateUC.Execute(r.Context(), mcCreate.AdminBulkUpdateStatusInput{
+		RequesterID:   currentUser.ID,
+		FromStatus:    valueobject.MorningCallStatus(req.FromStatus),
+		ToStatus:      valueobject.MorningCallStatus(req.ToStatus),
+		ScheduledFrom: req.ScheduledFrom,
+		ScheduledTo:   req.ScheduledTo,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	failures := make([]response.BulkUpdateFailureDTO, 0, len(output.Failures))
+	for _, f := range output.Failures {
+		failures = append(failures, response.BulkUpdateFailureDTO{
+			MorningCallID: f.MorningCallID,
+			Reason:        f.Reason,
+		})
+	}
+
+	h.SendJSON(w, http.StatusOK, response.BulkUpdateMorningCallStatusResponse{
+		Matched:  output.Matched,
+		Updated:  output.Updated,
+		Failures: failures,
+	})
+}
+
+// HandleDeleteMorningCall は管理者がモーニングコールを強制削除する
+// DELETE /api/v1/admin/morning-calls/{id}
+func (h *AdminHandler) HandleDeleteMorningCall(w http.ResponseWriter, r *http.Request) {
+	// DELETEメソッドのみ許可
+	if r.Method != http.MethodDelete {
+		h.SendMethodNotAllowed(w, http.MethodDelete)
+		return
+	}
+
+	// 認証が必要
+	currentUser, ok := h.RequireAuth(w, r)
+	if !ok {
+		return
+	}
+
+	// パスからモーニングコールIDを取得
+	morningCallID := strings.TrimPrefix(r.URL.Path, "/api/v1/admin/morning-calls/")
+	if morningCallID == "" || strings.Contains(morningCallID, "/") {
+		h.SendEndpointNotFound(w)
+		return
+	}
+
+	var req request.AdminDeleteMorningCallRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "PARSE_ERROR", err)
+		return
+	}
+
+	output, err := h.adminDeleteUC.Execute(r.Context(), mcCreate.AdminDeleteInput{
+		RequesterID:   currentUser.ID,
+		MorningCallID: morningCallID,
+		Reason:        req.Reason,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	mc := output.DeletedMorningCall
+	h.SendJSON(w, http.StatusOK, response.AdminDeletedMorningCallResponse{
+		ID:             mc.ID,
+		SenderID:       mc.SenderID,
+		ReceiverID:     mc.ReceiverID,
+		Status:         mc.Status.String(),
+		DeletedAt:      *mc.DeletedAt,
+		DeletedBy:      mc.DeletedBy,
+		DeletionReason: mc.DeletionReason,
+	})
+}
+
+// HandleListAnomalies は短時間に大量の操作を行っている疑わしいユーザーの一覧を取得する
+// GET /api/v1/admin/anomalies
+func (h *AdminHandler) HandleListAnomalies(w http.ResponseWriter, r *http.Request) {
+	// GETメソッドのみ許可
+	if r.Method != http.MethodGet {
+		h.SendMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	// 認証が必要
+	currentUser, ok := h.RequireAuth(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.detectAnomaliesUC.Execute(r.Context(), adminUC.DetectAnomalousUsersInput{
+		RequesterID: currentUser.ID,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	users := make([]response.AnomalousUserDTO, 0, len(output.Users))
+	for _, a := range output.Users {
+		reasons := make([]string, 0, len(a.Reasons))
+		for _, reason := range a.Reasons {
+			reasons = append(reasons, string(reason))
+		}
+		users = append(users, response.AnomalousUserDTO{
+			ID:                 a.User.ID,
+			Username:           a.User.Username,
+			Email:              a.User.Email,
+			IsFrozen:           a.User.IsFrozen,
+			FriendRequestCount: a.FriendRequestCount,
+			MorningCallCount:   a.MorningCallCount,
+			Score:              a.Score,
+			Reasons:            reasons,
+		})
+	}
+
+	h.SendJSON(w, http.StatusOK, response.AnomalousUserListResponse{
+		Users:       users,
+		Total:       len(users),
+		WindowStart: output.WindowStart,
+		WindowEnd:   output.WindowEnd,
+	})
+}
+
+// HandleSystemStats はユーザー・友達関係・モーニングコールの件数と内訳を取得する
+// リポジトリの計測が有効な場合は操作ごとの呼び出し回数・平均レイテンシ・エラー率も含める
+// セッション数と期限切れセッションのクリーンアップの統計も含める
+// GET /api/v1/admin/stats
+func (h *AdminHandler) HandleSystemStats(w http.ResponseWriter, r *http.Request) {
+	// GETメソッドのみ許可
+	if r.Method != http.MethodGet {
+		h.SendMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	// 認証が必要
+	currentUser, ok := h.RequireAuth(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.systemStatsUC.Execute(r.Context(), adminUC.SystemStatsInput{
+		RequesterID: currentUser.ID,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	var operations []response.RepositoryOperationMetricsDTO
+	for _, m := range output.Operations {
+		operations = append(operations, response.RepositoryOperationMetricsDTO{
+			Operation:        m.Operation,
+			Calls:            m.Calls,
+			Errors:           m.Errors,
+			ErrorRate:        m.ErrorRate(),
+			AverageLatencyMs: float64(m.AverageLatency()) / float64(time.Millisecond),
+		})
+	}
+
+	h.SendJSON(w, http.StatusOK, response.SystemStatsResponse{
+		Users:                toRepositoryStatsDTO(output.Users),
+		Relationships:        toRepositoryStatsDTO(output.Relationships),
+		MorningCalls:         toRepositoryStatsDTO(output.MorningCalls),
+		GeneratedAt:          output.GeneratedAt,
+		ConfirmationRate:     output.ConfirmationRate,
+		RepositoryOperations: operations,
+		Sessions:             toSessionStatsDTO(output.Sessions),
+	})
+}
+
+// toSessionStatsDTO はセッションの統計をDTOに変換する（統計がない場合はnil）
+func toSessionStatsDTO(stats *service.SessionStats) *response.SessionStatsDTO {
+	if stats == nil {
+		return nil
+	}
+	dto := &response.SessionStatsDTO{
+		ActiveSessions:         stats.ActiveSessions,
+		TotalSessions:          stats.TotalSessions,
+		CleanupRuns:            stats.CleanupRuns,
+		LastCleanupRemoved:     stats.LastCleanupRemoved,
+		TotalCleanupRemoved:    stats.TotalCleanupRemoved,
+		LastCleanupDurationMs:  float64(stats.LastCleanupDuration) / float64(time.Millisecond),
+		CleanupIntervalSeconds: stats.CleanupInterval.Seconds(),
+	}
+	if !stats.LastCleanupAt.IsZero() {
+		lastCleanupAt := stats.LastCleanupAt
+		dto.LastCleanupAt = &lastCleanupAt
+	}
+	return dto
+}
+
+// toRepositoryStatsDTO はリポジトリの統計をDTOに変換する
+func toRepositoryStatsDTO(stats repository.RepositoryStats) response.RepositoryStatsDTO {
+	breakdown := stats.Breakdown
+	if breakdown == nil {
+		breakdown = map[string]int{}
+	}
+	return response.RepositoryStatsDTO{
+		Total:          stats.Total,
+		Breakdown:      breakdown,
+		ConfirmMethods: stats.ConfirmMethods,
+	}
+}
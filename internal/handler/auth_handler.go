@@ -2,6 +2,7 @@ package handler
 
 import (
 	"errors"
+	"net"
 	"net/http"
 
 	"github.com/ochamu/morning-call-api/internal/domain/entity"
@@ -33,14 +34,14 @@ func NewAuthHandler(authUseCase *authUC.AuthUseCase, sessionManager *auth.Sessio
 func (h *AuthHandler) HandleLogin(w http.ResponseWriter, r *http.Request) {
 	// POSTメソッドのみ許可
 	if r.Method != http.MethodPost {
-		h.SendError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "POSTメソッドのみ許可されています", nil)
+		h.SendMethodNotAllowed(w, http.MethodPost)
 		return
 	}
 
 	// リクエストボディをパース
 	var req request.LoginRequest
 	if err := h.ParseJSON(r, &req); err != nil {
-		h.SendError(w, http.StatusBadRequest, "INVALID_REQUEST", "リクエストの形式が不正です", nil)
+		h.SendJSONDecodeError(w, "INVALID_REQUEST", err)
 		return
 	}
 
@@ -58,9 +59,13 @@ func (h *AuthHandler) HandleLogin(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// ログイン処理を実行
+	// ログイン元は初めてのデバイス・IPアドレスからのログインの検出に使う
 	loginInput := authUC.LoginInput{
 		Username: req.Username,
 		Password: req.Password,
+
+		Device:    r.UserAgent(),
+		IPAddress: remoteIP(r),
 	}
 
 	loginOutput, err := h.authUseCase.Login(r.Context(), loginInput)
@@ -71,7 +76,7 @@ func (h *AuthHandler) HandleLogin(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		// その他のエラー
-		h.SendInternalServerError(w, err)
+		h.SendMappedError(w, err)
 		return
 	}
 
@@ -88,20 +93,87 @@ func (h *AuthHandler) HandleLogin(w http.ResponseWriter, r *http.Request) {
 
 	// レスポンスを返す
 	resp := response.LoginResponse{
-		SessionID: session.ID,
-		User:      h.convertToUserDTO(loginOutput.User),
-		ExpiresAt: session.ExpiresAt,
+		SessionID:        session.ID,
+		User:             h.convertToUserDTO(loginOutput.User),
+		ExpiresAt:        session.ExpiresAt,
+		DeletionCanceled: loginOutput.DeletionCanceled,
 	}
 
 	h.SendJSON(w, http.StatusOK, resp)
 }
 
+// HandleIssueToken はサードパーティ連携用のスコープ付きトークンを発行する
+// POST /api/v1/auth/tokens
+func (h *AuthHandler) HandleIssueToken(w http.ResponseWriter, r *http.Request) {
+	// POSTメソッドのみ許可
+	if r.Method != http.MethodPost {
+		h.SendMethodNotAllowed(w, http.MethodPost)
+		return
+	}
+
+	// 認証チェック（スコープ付きトークンでは書き込みとなる発行を認証ミドルウェアで拒否する）
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// リクエストボディをパース
+	var req request.IssueTokenRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "INVALID_REQUEST", err)
+		return
+	}
+
+	// バリデーション
+	if validationErrs := req.Validate(); len(validationErrs) > 0 {
+		var validationErrors []ValidationError
+		for field, message := range validationErrs {
+			validationErrors = append(validationErrors, ValidationError{
+				Field:   field,
+				Message: message,
+			})
+		}
+		h.SendValidationError(w, validationErrors)
+		return
+	}
+
+	scopes := make([]auth.Scope, 0, len(req.Scopes))
+	for _, s := range req.Scopes {
+		scope, err := auth.ParseScope(s)
+		if err != nil {
+			h.SendValidationError(w, []ValidationError{{Field: "scopes", Message: err.Error()}})
+			return
+		}
+		scopes = append(scopes, scope)
+	}
+
+	// トークン（スコープで制限したセッション）を作成
+	session, err := h.sessionManager.CreateScopedSession(user.ID, scopes)
+	if err != nil {
+		h.SendInternalServerError(w, err)
+		return
+	}
+
+	// レスポンスを返す
+	resp := response.IssueTokenResponse{
+		Token:     session.ID,
+		Scopes:    make([]string, len(session.Scopes)),
+		ExpiresAt: session.ExpiresAt,
+	}
+	for i, scope := range session.Scopes {
+		resp.Scopes[i] = string(scope)
+	}
+
+	h.SendJSON(w, http.StatusCreated, resp)
+}
+
 // HandleLogout はログアウトリクエストを処理する
 // POST /api/v1/auth/logout
 func (h *AuthHandler) HandleLogout(w http.ResponseWriter, r *http.Request) {
 	// POSTメソッドのみ許可
 	if r.Method != http.MethodPost {
-		h.SendError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "POSTメソッドのみ許可されています", nil)
+		h.SendMethodNotAllowed(w, http.MethodPost)
 		return
 	}
 
@@ -139,7 +211,7 @@ func (h *AuthHandler) HandleLogout(w http.ResponseWriter, r *http.Request) {
 func (h *AuthHandler) HandleGetCurrentUser(w http.ResponseWriter, r *http.Request) {
 	// GETメソッドのみ許可
 	if r.Method != http.MethodGet {
-		h.SendError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "GETメソッドのみ許可されています", nil)
+		h.SendMethodNotAllowed(w, http.MethodGet)
 		return
 	}
 
@@ -162,7 +234,7 @@ func (h *AuthHandler) HandleGetCurrentUser(w http.ResponseWriter, r *http.Reques
 func (h *AuthHandler) HandleValidateSession(w http.ResponseWriter, r *http.Request) {
 	// GETメソッドのみ許可
 	if r.Method != http.MethodGet {
-		h.SendError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "GETメソッドのみ許可されています", nil)
+		h.SendMethodNotAllowed(w, http.MethodGet)
 		return
 	}
 
@@ -189,7 +261,7 @@ func (h *AuthHandler) HandleValidateSession(w http.ResponseWriter, r *http.Reque
 func (h *AuthHandler) HandleRefreshSession(w http.ResponseWriter, r *http.Request) {
 	// POSTメソッドのみ許可
 	if r.Method != http.MethodPost {
-		h.SendError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "POSTメソッドのみ許可されています", nil)
+		h.SendMethodNotAllowed(w, http.MethodPost)
 		return
 	}
 
@@ -229,5 +301,23 @@ func (h *AuthHandler) convertToUserDTO(user *entity.User) response.UserDTO {
 		Email:     user.Email,
 		CreatedAt: user.CreatedAt,
 		UpdatedAt: user.UpdatedAt,
+
+		RequireCallApproval:  user.RequireCallApproval,
+		TimeZone:             user.TimeZone,
+		SilentDelivery:       user.SilentDelivery,
+		Volume:               user.Volume,
+		Vibrate:              user.Vibrate,
+		NotifyOnConfirmation: user.NotifyOnConfirmation,
+		NotifyOnExpiration:   user.NotifyOnExpiration,
+		NotifyOnNewLogin:     user.NotifyOnNewLogin,
+	}
+}
+
+// remoteIP はリクエストの直接の接続元のIPアドレスを返す（取得できない場合はRemoteAddrをそのまま返す）
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
 	}
+	return host
 }
@@ -1,13 +1,17 @@
 package handler
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
+	"strings"
 
 	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/pkg/utils"
 )
 
 // contextKey はコンテキストのキーの型
@@ -41,6 +45,7 @@ type ErrorDetail struct {
 type ValidationError struct {
 	Field   string `json:"field"`
 	Message string `json:"message"`
+	Reason  string `json:"reason,omitempty"` // ドメイン検証エラーの種類を表すコード（例: TOO_LONG）
 }
 
 // SuccessResponse は成功レスポンスの基本構造体
@@ -55,16 +60,64 @@ func NewBaseHandler() *BaseHandler {
 	return &BaseHandler{}
 }
 
+// internalServerErrorBody はレスポンスのエンコードに失敗した場合に返す本文
+const internalServerErrorBody = `{"error":{"code":"INTERNAL_SERVER_ERROR","message":"サーバーエラーが発生しました"}}` + "\n"
+
 // SendJSON はJSONレスポンスを送信する
+// ステータスを書き込む前にエンコードするため、エンコードに失敗した場合はログに記録して500を返す
+// 本文を持てないステータス（204・304）の場合は本文を書き込まない
 func (h *BaseHandler) SendJSON(w http.ResponseWriter, status int, data interface{}) {
+	if status == http.StatusNoContent || status == http.StatusNotModified {
+		w.WriteHeader(status)
+		return
+	}
+
+	body, ok := h.encodeJSON(w, data)
+	if !ok {
+		return
+	}
+	h.writeJSON(w, status, body)
+}
+
+// encodeJSON はレスポンスの本文をエンコードする
+// エンコードに失敗した場合はログに記録して500を返し、falseを返す
+func (h *BaseHandler) encodeJSON(w http.ResponseWriter, data interface{}) ([]byte, bool) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(data); err != nil {
+		log.Printf("%sJSONエンコードエラー: %v", requestIDLogPrefix(w), err)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = io.WriteString(w, internalServerErrorBody)
+		return nil, false
+	}
+	return buf.Bytes(), true
+}
+
+// writeJSON はエンコード済みの本文をステータスとともに書き込む
+func (h *BaseHandler) writeJSON(w http.ResponseWriter, status int, body []byte) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
+	if _, err := w.Write(body); err != nil {
+		log.Printf("%sレスポンスの書き込みエラー: %v", requestIDLogPrefix(w), err)
+	}
+}
 
-	if err := json.NewEncoder(w).Encode(data); err != nil {
-		log.Printf("JSONエンコードエラー: %v", err)
+// SendFile はダウンロード用のファイルを200レスポンスとして送信する
+// filenameはContent-Dispositionでブラウザに保存時のファイル名として伝える
+func (h *BaseHandler) SendFile(w http.ResponseWriter, contentType, filename string, body []byte) {
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(body); err != nil {
+		log.Printf("%sレスポンスの書き込みエラー: %v", requestIDLogPrefix(w), err)
 	}
 }
 
+// SendNoContent は本文のない204レスポンスを送信する
+func (h *BaseHandler) SendNoContent(w http.ResponseWriter) {
+	h.SendJSON(w, http.StatusNoContent, nil)
+}
+
 // SendSuccess は成功レスポンスを送信する
 func (h *BaseHandler) SendSuccess(w http.ResponseWriter, data interface{}, message string) {
 	response := SuccessResponse{
@@ -92,6 +145,24 @@ func (h *BaseHandler) SendValidationError(w http.ResponseWriter, errors []Valida
 	h.SendError(w, http.StatusBadRequest, "VALIDATION_ERROR", "入力値が不正です", errors)
 }
 
+// SendInvalidRequest はパスやクエリなどリクエストの形式が不正な場合の400レスポンスを送信する
+func (h *BaseHandler) SendInvalidRequest(w http.ResponseWriter, message string) {
+	h.SendError(w, http.StatusBadRequest, "INVALID_REQUEST", message, nil)
+}
+
+// SendMethodNotAllowed は許可されていないメソッドに対する405レスポンスを送信する
+// 許可するメソッドはAllowヘッダーにも設定する
+func (h *BaseHandler) SendMethodNotAllowed(w http.ResponseWriter, allowed ...string) {
+	w.Header().Set("Allow", strings.Join(allowed, ", "))
+	message := fmt.Sprintf("%sメソッドのみ許可されています", strings.Join(allowed, "または"))
+	h.SendError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", message, nil)
+}
+
+// SendEndpointNotFound は存在しないエンドポイントに対する404レスポンスを送信する
+func (h *BaseHandler) SendEndpointNotFound(w http.ResponseWriter) {
+	h.SendError(w, http.StatusNotFound, "NOT_FOUND", "エンドポイントが見つかりません", nil)
+}
+
 // SendAuthenticationError は認証エラーレスポンスを送信する
 func (h *BaseHandler) SendAuthenticationError(w http.ResponseWriter) {
 	h.SendError(w, http.StatusUnauthorized, "AUTHENTICATION_ERROR", "認証が必要です", nil)
@@ -110,21 +181,27 @@ func (h *BaseHandler) SendNotFoundError(w http.ResponseWriter, resource string)
 
 // SendInternalServerError は内部サーバーエラーレスポンスを送信する
 func (h *BaseHandler) SendInternalServerError(w http.ResponseWriter, err error) {
-	log.Printf("内部サーバーエラー: %v", err)
+	log.Printf("%s内部サーバーエラー: %v", requestIDLogPrefix(w), err)
 	h.SendError(w, http.StatusInternalServerError, "INTERNAL_SERVER_ERROR", "サーバーエラーが発生しました", nil)
 }
 
+// requestIDLogPrefix はミドルウェアがレスポンスヘッダーに設定したリクエストIDをログ用の表記で返す
+func requestIDLogPrefix(w http.ResponseWriter) string {
+	return utils.RequestIDLogPrefix(w.Header().Get(utils.RequestIDHeader))
+}
+
 // ParseJSON はリクエストボディからJSONをパースする
+// エラーは問題のあるフィールドや位置を含む *JSONDecodeError として返す
 func (h *BaseHandler) ParseJSON(r *http.Request, v interface{}) error {
 	if r.Body == nil {
-		return fmt.Errorf("リクエストボディが空です")
+		return DescribeJSONDecodeError(io.EOF)
 	}
 
 	decoder := json.NewDecoder(r.Body)
 	decoder.DisallowUnknownFields() // 未知のフィールドを許可しない
 
 	if err := decoder.Decode(v); err != nil {
-		return fmt.Errorf("JSONパースエラー: %w", err)
+		return DescribeJSONDecodeError(err)
 	}
 
 	return nil
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBaseHandler_SendJSON(t *testing.T) {
+	h := NewBaseHandler()
+
+	t.Run("ステータスとContent-Typeを設定して本文を書き込む", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		h.SendJSON(rec, http.StatusCreated, map[string]string{"id": "mc1"})
+
+		if rec.Code != http.StatusCreated {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusCreated)
+		}
+		if got := rec.Header().Get("Content-Type"); got != "application/json" {
+			t.Errorf("Content-Type = %q, want application/json", got)
+		}
+		var body map[string]string
+		if err := json.NewDecoder(rec.Body).Decode(&body); err != nil || body["id"] != "mc1" {
+			t.Errorf("body = %v (err=%v), want id=mc1", body, err)
+		}
+	})
+
+	t.Run("エンコードできない値は500を返す", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		h.SendJSON(rec, http.StatusOK, map[string]interface{}{"ch": make(chan int)})
+
+		if rec.Code != http.StatusInternalServerError {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusInternalServerError)
+		}
+		var body ErrorResponse
+		if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode body: %v", err)
+		}
+		if body.Error.Code != "INTERNAL_SERVER_ERROR" {
+			t.Errorf("code = %q, want INTERNAL_SERVER_ERROR", body.Error.Code)
+		}
+	})
+
+	t.Run("204は本文を書き込まない", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		h.SendNoContent(rec)
+
+		if rec.Code != http.StatusNoContent {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusNoContent)
+		}
+		if rec.Body.Len() != 0 {
+			t.Errorf("body = %q, want empty", rec.Body.String())
+		}
+		if got := rec.Header().Get("Content-Type"); got != "" {
+			t.Errorf("Content-Type = %q, want empty", got)
+		}
+	})
+}
+
+func TestBaseHandler_SendMethodNotAllowed(t *testing.T) {
+	h := NewBaseHandler()
+	rec := httptest.NewRecorder()
+	h.SendMethodNotAllowed(rec, http.MethodGet, http.MethodPut)
+
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusMethodNotAllowed)
+	}
+	if got := rec.Header().Get("Allow"); got != "GET, PUT" {
+		t.Errorf("Allow = %q, want %q", got, "GET, PUT")
+	}
+	var body ErrorResponse
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode body: %v", err)
+	}
+	if body.Error.Code != "METHOD_NOT_ALLOWED" || body.Error.Message != "GETまたはPUTメソッドのみ許可されています" {
+		t.Errorf("error = %+v", body.Error)
+	}
+}
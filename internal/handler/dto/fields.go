@@ -0,0 +1,186 @@
+package dto
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// FieldsQueryParam はレスポンスに含めるフィールドを指定するクエリパラメータ名
+const FieldsQueryParam = "fields"
+
+// UnknownFieldPolicy は存在しないフィールドが指定された場合の扱い
+type UnknownFieldPolicy int
+
+const (
+	// UnknownFieldReject は存在しないフィールドの指定をエラーにする
+	UnknownFieldReject UnknownFieldPolicy = iota
+	// UnknownFieldIgnore は存在しないフィールドの指定を無視する
+	UnknownFieldIgnore
+)
+
+// UnknownFieldError は存在しないフィールドが指定されたことを表すエラー
+type UnknownFieldError struct {
+	Fields []string // 存在しないフィールド名（指定順）
+}
+
+// Error はエラーメッセージを返す
+func (e *UnknownFieldError) Error() string {
+	return fmt.Sprintf("存在しないフィールドが指定されています: %s", strings.Join(e.Fields, ", "))
+}
+
+// FieldSet はレスポンスに含めるフィールドの集合
+// nilの場合はすべてのフィールドを含める
+type FieldSet map[string]struct{}
+
+// ParseFieldSet はカンマ区切りのフィールド指定（例: "id,message,status"）を解析する
+// 未指定または有効なフィールドが1つもない場合はnil（全フィールド）を返す
+func ParseFieldSet(raw string, allowed []string, policy UnknownFieldPolicy) (FieldSet, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+
+	allowedSet := make(map[string]struct{}, len(allowed))
+	for _, name := range allowed {
+		allowedSet[name] = struct{}{}
+	}
+
+	fields := FieldSet{}
+	var unknown []string
+	for _, name := range strings.Split(raw, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if _, ok := allowedSet[name]; !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+		fields[name] = struct{}{}
+	}
+
+	if len(unknown) > 0 && policy == UnknownFieldReject {
+		return nil, &UnknownFieldError{Fields: unknown}
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	return fields, nil
+}
+
+// JSONFieldNames は構造体のJSONタグからフィールド名の一覧を取得する
+// 構造体（またはそのポインタ）以外の場合は空のスライスを返す
+func JSONFieldNames(v interface{}) []string {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return []string{}
+	}
+
+	names := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := field.Name
+		if tag := field.Tag.Get("json"); tag != "" {
+			if tag == "-" {
+				continue
+			}
+			if tagName, _, _ := strings.Cut(tag, ","); tagName != "" {
+				name = tagName
+			}
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// Contains は指定したフィールドを含むかどうかを返す
+func (fs FieldSet) Contains(name string) bool {
+	if fs == nil {
+		return true
+	}
+	_, ok := fs[name]
+	return ok
+}
+
+// Filter はvをJSONオブジェクトとしてマップに変換し、指定フィールドのみを残す
+// FieldSetがnilの場合はvをそのまま返す
+func (fs FieldSet) Filter(v interface{}) (interface{}, error) {
+	if fs == nil {
+		return v, nil
+	}
+
+	object, err := toJSONObject(v)
+	if err != nil {
+		return nil, err
+	}
+	return fs.filterObject(object), nil
+}
+
+// FilterList はvの中のlistKeyで示される配列の各要素に対してフィールドを絞り込む
+// 件数などの一覧のメタ情報はそのまま残す
+func (fs FieldSet) FilterList(v interface{}, listKey string) (interface{}, error) {
+	if fs == nil {
+		return v, nil
+	}
+
+	object, err := toJSONObject(v)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, ok := object[listKey]
+	if !ok {
+		return nil, fmt.Errorf("failed to filter fields: key %q not found", listKey)
+	}
+
+	var items []map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, fmt.Errorf("failed to filter fields: %w", err)
+	}
+
+	filtered := make([]map[string]json.RawMessage, len(items))
+	for i, item := range items {
+		filtered[i] = fs.filterObject(item)
+	}
+
+	result := make(map[string]interface{}, len(object))
+	for key, value := range object {
+		result[key] = value
+	}
+	result[listKey] = filtered
+	return result, nil
+}
+
+// filterObject は指定フィールドのみを含む新しいマップを返す
+// 値は変換済みのJSONのまま保持するため、数値や時刻の表現は変わらない
+func (fs FieldSet) filterObject(object map[string]json.RawMessage) map[string]json.RawMessage {
+	result := make(map[string]json.RawMessage, len(fs))
+	for key, value := range object {
+		if fs.Contains(key) {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// toJSONObject はvをJSONオブジェクトのマップに変換する
+func toJSONObject(v interface{}) (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter fields: %w", err)
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, fmt.Errorf("failed to filter fields: %w", err)
+	}
+	return object, nil
+}
@@ -0,0 +1,155 @@
+package dto
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type fieldsTestItem struct {
+	ID        string     `json:"id"`
+	Message   string     `json:"message"`
+	Status    string     `json:"status"`
+	StampAt   *time.Time `json:"stamp_at,omitempty"`
+	Internal  string     `json:"-"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type fieldsTestList struct {
+	Items []fieldsTestItem `json:"items"`
+	Total int              `json:"total"`
+}
+
+func TestParseFieldSet(t *testing.T) {
+	allowed := JSONFieldNames(fieldsTestItem{})
+
+	tests := []struct {
+		name    string
+		raw     string
+		policy  UnknownFieldPolicy
+		want    FieldSet
+		wantErr []string
+	}{
+		{name: "未指定は全フィールド", raw: "", want: nil},
+		{name: "空白のみは全フィールド", raw: " , ", want: nil},
+		{
+			name: "指定フィールドのみ",
+			raw:  "id, message,status",
+			want: FieldSet{"id": {}, "message": {}, "status": {}},
+		},
+		{
+			name:    "存在しないフィールドはエラー",
+			raw:     "id,unknown,Internal",
+			policy:  UnknownFieldReject,
+			wantErr: []string{"unknown", "Internal"},
+		},
+		{
+			name:   "存在しないフィールドを無視",
+			raw:    "id,unknown",
+			policy: UnknownFieldIgnore,
+			want:   FieldSet{"id": {}},
+		},
+		{
+			name:   "すべて無視された場合は全フィールド",
+			raw:    "unknown",
+			policy: UnknownFieldIgnore,
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFieldSet(tt.raw, allowed, tt.policy)
+			if tt.wantErr != nil {
+				var fieldErr *UnknownFieldError
+				if !errors.As(err, &fieldErr) {
+					t.Fatalf("ParseFieldSet() error = %v, want UnknownFieldError", err)
+				}
+				if !reflect.DeepEqual(fieldErr.Fields, tt.wantErr) {
+					t.Errorf("UnknownFieldError.Fields = %v, want %v", fieldErr.Fields, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseFieldSet() unexpected error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseFieldSet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONFieldNames(t *testing.T) {
+	want := []string{"id", "message", "status", "stamp_at", "created_at"}
+	if got := JSONFieldNames(&fieldsTestItem{}); !reflect.DeepEqual(got, want) {
+		t.Errorf("JSONFieldNames() = %v, want %v", got, want)
+	}
+	if got := JSONFieldNames("not a struct"); len(got) != 0 {
+		t.Errorf("JSONFieldNames() = %v, want empty", got)
+	}
+}
+
+func TestFieldSet_Filter(t *testing.T) {
+	item := fieldsTestItem{ID: "mc1", Message: "おはよう", Status: "scheduled", CreatedAt: time.Date(2025, 1, 1, 7, 0, 0, 0, time.UTC)}
+
+	t.Run("nilの場合はそのまま返す", func(t *testing.T) {
+		var fields FieldSet
+		got, err := fields.Filter(item)
+		if err != nil {
+			t.Fatalf("Filter() unexpected error = %v", err)
+		}
+		if !reflect.DeepEqual(got, item) {
+			t.Errorf("Filter() = %v, want %v", got, item)
+		}
+	})
+
+	t.Run("指定フィールドのみ残す", func(t *testing.T) {
+		fields := FieldSet{"id": {}, "created_at": {}, "stamp_at": {}}
+		got, err := fields.Filter(item)
+		if err != nil {
+			t.Fatalf("Filter() unexpected error = %v", err)
+		}
+
+		data, err := json.Marshal(got)
+		if err != nil {
+			t.Fatalf("failed to marshal: %v", err)
+		}
+		// omitemptyで省略されたフィールドは含まれない
+		want := `{"created_at":"2025-01-01T07:00:00Z","id":"mc1"}`
+		if string(data) != want {
+			t.Errorf("Filter() = %s, want %s", data, want)
+		}
+	})
+}
+
+func TestFieldSet_FilterList(t *testing.T) {
+	list := fieldsTestList{
+		Items: []fieldsTestItem{
+			{ID: "mc1", Message: "a", Status: "scheduled"},
+			{ID: "mc2", Message: "b", Status: "delivered"},
+		},
+		Total: 2,
+	}
+
+	fields := FieldSet{"id": {}, "status": {}}
+	got, err := fields.FilterList(list, "items")
+	if err != nil {
+		t.Fatalf("FilterList() unexpected error = %v", err)
+	}
+
+	data, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	want := `{"items":[{"id":"mc1","status":"scheduled"},{"id":"mc2","status":"delivered"}],"total":2}`
+	if string(data) != want {
+		t.Errorf("FilterList() = %s, want %s", data, want)
+	}
+
+	if _, err := fields.FilterList(list, "missing"); err == nil {
+		t.Error("FilterList() expected error for missing key")
+	}
+}
@@ -0,0 +1,21 @@
+package request
+
+import "time"
+
+// ChangePlanRequest は管理者によるプラン変更リクエスト
+type ChangePlanRequest struct {
+	Plan string `json:"plan"` // free, premium
+}
+
+// BulkUpdateMorningCallStatusRequest は管理者によるモーニングコールの一括ステータス更新リクエスト
+type BulkUpdateMorningCallStatusRequest struct {
+	FromStatus    string     `json:"from_status"`              // 対象とするコールの現在のステータス
+	ToStatus      string     `json:"to_status"`                // 遷移先のステータス
+	ScheduledFrom *time.Time `json:"scheduled_from,omitempty"` // 対象とする予定時刻の開始（任意）
+	ScheduledTo   *time.Time `json:"scheduled_to,omitempty"`   // 対象とする予定時刻の終了（任意）
+}
+
+// AdminDeleteMorningCallRequest は管理者によるモーニングコールの強制削除リクエスト
+type AdminDeleteMorningCallRequest struct {
+	Reason string `json:"reason"` // 削除理由（規約違反の内容など）
+}
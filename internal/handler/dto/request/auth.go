@@ -27,6 +27,22 @@ func (r *LoginRequest) Validate() map[string]string {
 	return errors
 }
 
+// IssueTokenRequest はスコープ付きトークン発行リクエストのDTO
+type IssueTokenRequest struct {
+	Scopes []string `json:"scopes"` // read-only、morning-calls:read など
+}
+
+// Validate はスコープ付きトークン発行リクエストのバリデーションを行う
+func (r *IssueTokenRequest) Validate() map[string]string {
+	errors := make(map[string]string)
+
+	if len(r.Scopes) == 0 {
+		errors["scopes"] = "スコープは1つ以上指定してください"
+	}
+
+	return errors
+}
+
 // RegisterRequest はユーザー登録リクエストのDTO
 type RegisterRequest struct {
 	Username string `json:"username"`
@@ -1,12 +1,100 @@
 package request
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
 
 // CreateMorningCallRequest はモーニングコール作成リクエスト
+// アラーム時刻はscheduled_time（絶対時刻）、in、tomorrow_at（相対指定）のいずれか1つで指定する
 type CreateMorningCallRequest struct {
-	ReceiverID    string    `json:"receiver_id"`
-	ScheduledTime time.Time `json:"scheduled_time"`
-	Message       string    `json:"message"`
+	ReceiverID      string     `json:"receiver_id"`
+	ScheduledTime   time.Time  `json:"scheduled_time"`
+	In              string     `json:"in,omitempty"`          // 相対指定：現在からの経過時間（例: 30m, 1h30m）
+	TomorrowAt      string     `json:"tomorrow_at,omitempty"` // 相対指定：受信者のタイムゾーンでの翌日の時刻（例: 07:00）
+	Message         string     `json:"message"`
+	ConfirmDeadline *time.Time `json:"confirm_deadline,omitempty"` // 起床確認の期限（未指定は無期限）
+
+	// SystemMessageID はシステムが用意した定型文のID（messageとは排他、指定した場合は定型文の本文をメッセージにする）
+	SystemMessageID string `json:"system_message_id,omitempty"`
+	// SystemMessageLanguage は定型文の言語コード（未指定・未対応の場合は日本語）
+	SystemMessageLanguage string `json:"system_message_language,omitempty"`
+
+	// SelfCall は自分自身に設定するセルフモーニングコールか（trueの場合receiver_idは省略できる）
+	SelfCall bool `json:"self_call,omitempty"`
+
+	// ChallengeDifficulty は起床クイズの難易度（easy, normal, hard、未指定はクイズなし）
+	ChallengeDifficulty string `json:"challenge_difficulty,omitempty"`
+
+	// MessageVariants はA/Bテスト用のメッセージの候補（messageとは排他、2〜5件、それぞれ500文字以内）
+	MessageVariants []string `json:"message_variants,omitempty"`
+	// MessageVariantStrategy はメッセージの候補の選び方（random, round_robin、未指定はrandom）
+	MessageVariantStrategy string `json:"message_variant_strategy,omitempty"`
+}
+
+// ParseRelativeSchedule は相対指定のアラーム時刻を解析する
+// 相対指定がない場合はnilを返す。絶対時刻との同時指定や複数の相対指定はエラーとする
+func (r *CreateMorningCallRequest) ParseRelativeSchedule() (*valueobject.RelativeSchedule, map[string]string) {
+	errors := make(map[string]string)
+
+	specified := 0
+	if !r.ScheduledTime.IsZero() {
+		specified++
+	}
+	if r.In != "" {
+		specified++
+	}
+	if r.TomorrowAt != "" {
+		specified++
+	}
+	if specified > 1 {
+		errors["scheduled_time"] = "scheduled_time、in、tomorrow_atはいずれか1つのみ指定してください"
+		return nil, errors
+	}
+
+	var schedule *valueobject.RelativeSchedule
+	var reason valueobject.NGReason
+	switch {
+	case r.In != "":
+		if schedule, reason = valueobject.ParseRelativeAfter(r.In); reason.IsNG() {
+			errors["in"] = reason.Error()
+		}
+	case r.TomorrowAt != "":
+		if schedule, reason = valueobject.ParseRelativeTomorrowAt(r.TomorrowAt); reason.IsNG() {
+			errors["tomorrow_at"] = reason.Error()
+		}
+	}
+	if len(errors) > 0 {
+		return nil, errors
+	}
+
+	return schedule, nil
+}
+
+// CreateMorningCallSeriesRequest はモーニングコールのシリーズ作成リクエスト
+type CreateMorningCallSeriesRequest struct {
+	ReceiverID string   `json:"receiver_id"`
+	StartDate  string   `json:"start_date"` // 開始日（YYYY-MM-DD、受信者のタイムゾーン）
+	EndDate    string   `json:"end_date"`   // 終了日（YYYY-MM-DD、この日を含む）
+	Weekdays   []string `json:"weekdays"`   // アラームを設定する曜日（sun〜sat、空の場合は毎日）
+	Time       string   `json:"time"`       // アラーム時刻（HH:MM、受信者のタイムゾーン）
+	Message    string   `json:"message"`
+}
+
+// ParseSchedule は期間・曜日・時刻の指定を解析する
+func (r *CreateMorningCallSeriesRequest) ParseSchedule() (*valueobject.SeriesSchedule, map[string]string) {
+	schedule, reason := valueobject.ParseSeriesSchedule(r.StartDate, r.EndDate, r.Weekdays, r.Time)
+	if reason.IsNG() {
+		return nil, map[string]string{reason.Field(): reason.Error()}
+	}
+	return schedule, nil
+}
+
+// UpdateMorningCallSeriesRequest はシリーズのメッセージ一括更新リクエスト
+type UpdateMorningCallSeriesRequest struct {
+	Message string `json:"message"` // 空の場合はメッセージなしにする
 }
 
 // UpdateMorningCallRequest はモーニングコール更新リクエスト
@@ -15,6 +103,14 @@ type UpdateMorningCallRequest struct {
 	Message       string    `json:"message"`
 }
 
+// PatchMorningCallRequest はモーニングコール部分更新リクエスト
+// 含まれるフィールドのみを更新する（confirm_deadlineにnullを指定すると期限を解除する）
+type PatchMorningCallRequest struct {
+	ScheduledTime   Optional[time.Time] `json:"scheduled_time"`
+	Message         Optional[string]    `json:"message"`
+	ConfirmDeadline Optional[time.Time] `json:"confirm_deadline"`
+}
+
 // ListMorningCallsRequest はモーニングコール一覧取得リクエスト
 type ListMorningCallsRequest struct {
 	Status string `json:"status,omitempty"` // pending, sent, confirmed
@@ -22,3 +118,67 @@ type ListMorningCallsRequest struct {
 	Limit  int    `json:"limit,omitempty"`
 	Offset int    `json:"offset,omitempty"`
 }
+
+// GeoPointRequest は位置情報のリクエスト
+type GeoPointRequest struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// ConfirmWakeRequest は起床確認リクエスト（ボディは任意）
+type ConfirmWakeRequest struct {
+	Location      *GeoPointRequest `json:"location,omitempty"`
+	ShareLocation bool             `json:"share_location,omitempty"` // 位置情報を送信者に公開するか
+	Stamp         string           `json:"stamp,omitempty"`          // 送信者へのお礼スタンプ
+
+	// ChallengeAnswer は起床クイズの回答（クイズ付きのコールでは必須、数値・数値の文字列のどちらでも受け付ける）
+	ChallengeAnswer json.Number `json:"challenge_answer,omitempty"`
+
+	// ConfirmMethod はクライアントで確認した操作（button, swipe, shake, challenge、未指定はbutton）
+	ConfirmMethod string `json:"confirm_method,omitempty"`
+
+	// RequestNextCall は確認と同時に翌日同時刻の次回のコールを送信者へリクエストするか（送信者の承認待ちになる）
+	RequestNextCall bool `json:"request_next_call,omitempty"`
+}
+
+// SendStampRequest はお礼スタンプ送信リクエスト
+type SendStampRequest struct {
+	Stamp string `json:"stamp"` // thank_you, heart, sleepy, thumbs_up
+}
+
+// AddCallCommentRequest はモーニングコールへのコメント追加リクエスト
+type AddCallCommentRequest struct {
+	Body string `json:"body"` // 500文字以内
+}
+
+// RateMorningCallRequest は受信者による役立ち度の評価リクエスト
+type RateMorningCallRequest struct {
+	Rating int `json:"rating"` // 1〜5
+}
+
+// SetReceiverOffsetRequest は受信者によるアラーム時刻ずらし設定リクエスト
+type SetReceiverOffsetRequest struct {
+	OffsetMinutes int `json:"offset_minutes"` // 負の値は早める、0で元に戻す
+}
+
+// RequestRescheduleRequest は受信者によるアラーム時刻の変更リクエスト
+type RequestRescheduleRequest struct {
+	RequestedTime time.Time `json:"requested_time"` // 希望するアラーム時刻
+}
+
+// SetSilentDeliveryRequest は受信者によるコールごとの無音配信設定リクエスト
+type SetSilentDeliveryRequest struct {
+	Silent Optional[bool] `json:"silent"` // nullでコールごとの設定を解除し、デフォルト設定に従う
+}
+
+// SetDeliveryHintsRequest は受信者によるコールごとの音量・バイブレーション設定リクエスト
+// 未指定またはnullの項目はコールごとの設定を解除し、デフォルト設定に従う
+type SetDeliveryHintsRequest struct {
+	Volume  Optional[int]  `json:"volume"`
+	Vibrate Optional[bool] `json:"vibrate"`
+}
+
+// ValidateMessageRequest はメッセージ事前検証リクエスト
+type ValidateMessageRequest struct {
+	Message string `json:"message"`
+}
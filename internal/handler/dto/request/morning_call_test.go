@@ -0,0 +1,49 @@
+package request
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCreateMorningCallRequest_ParseRelativeSchedule(t *testing.T) {
+	scheduled := time.Date(2025, 1, 1, 7, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		req          CreateMorningCallRequest
+		wantSchedule bool
+		wantErrField string
+	}{
+		{name: "絶対時刻のみ", req: CreateMorningCallRequest{ScheduledTime: scheduled}},
+		{name: "経過時間指定", req: CreateMorningCallRequest{In: "30m"}, wantSchedule: true},
+		{name: "翌日の時刻指定", req: CreateMorningCallRequest{TomorrowAt: "07:00"}, wantSchedule: true},
+		{name: "絶対時刻と経過時間の同時指定", req: CreateMorningCallRequest{ScheduledTime: scheduled, In: "30m"}, wantErrField: "scheduled_time"},
+		{name: "絶対時刻と翌日の時刻の同時指定", req: CreateMorningCallRequest{ScheduledTime: scheduled, TomorrowAt: "07:00"}, wantErrField: "scheduled_time"},
+		{name: "相対指定の同時指定", req: CreateMorningCallRequest{In: "30m", TomorrowAt: "07:00"}, wantErrField: "scheduled_time"},
+		{name: "不正な経過時間", req: CreateMorningCallRequest{In: "soon"}, wantErrField: "in"},
+		{name: "不正な翌日の時刻", req: CreateMorningCallRequest{TomorrowAt: "25:00"}, wantErrField: "tomorrow_at"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, errs := tt.req.ParseRelativeSchedule()
+
+			if tt.wantErrField != "" {
+				if _, ok := errs[tt.wantErrField]; !ok {
+					t.Errorf("errors = %v, want error for %q", errs, tt.wantErrField)
+				}
+				if schedule != nil {
+					t.Errorf("schedule = %v, want nil", schedule)
+				}
+				return
+			}
+
+			if len(errs) > 0 {
+				t.Fatalf("unexpected errors: %v", errs)
+			}
+			if (schedule != nil) != tt.wantSchedule {
+				t.Errorf("schedule = %v, wantSchedule %v", schedule, tt.wantSchedule)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package request
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Optional は部分更新リクエストで「未指定」「null」「値あり」を区別するためのフィールド型
+// 未指定の場合はSetがfalse、nullが指定された場合はSetとNullがtrueになる
+type Optional[T any] struct {
+	Set   bool // JSONにキーが存在したか
+	Null  bool // nullが指定されたか
+	Value T    // 指定された値（Nullの場合はゼロ値）
+}
+
+// UnmarshalJSON はキーが存在する場合のみ呼ばれるため、呼ばれた時点で指定ありとして扱う
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	o.Set = true
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		o.Null = true
+		var zero T
+		o.Value = zero
+		return nil
+	}
+	o.Null = false
+	return json.Unmarshal(data, &o.Value)
+}
+
+// HasValue は値が指定されているか（未指定・nullでない）を返す
+func (o Optional[T]) HasValue() bool {
+	return o.Set && !o.Null
+}
+
+// Ptr は値が指定されている場合にそのポインタを返す（未指定・nullの場合はnil）
+func (o Optional[T]) Ptr() *T {
+	if !o.HasValue() {
+		return nil
+	}
+	v := o.Value
+	return &v
+}
@@ -0,0 +1,53 @@
+package request
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOptional_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		wantSet   bool
+		wantNull  bool
+		wantValue string
+	}{
+		{name: "未指定", body: `{}`, wantSet: false},
+		{name: "null", body: `{"message":null}`, wantSet: true, wantNull: true},
+		{name: "空文字", body: `{"message":""}`, wantSet: true, wantValue: ""},
+		{name: "値あり", body: `{"message":"おはよう"}`, wantSet: true, wantValue: "おはよう"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req struct {
+				Message Optional[string] `json:"message"`
+			}
+			if err := json.Unmarshal([]byte(tt.body), &req); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if req.Message.Set != tt.wantSet {
+				t.Errorf("Set = %v, want %v", req.Message.Set, tt.wantSet)
+			}
+			if req.Message.Null != tt.wantNull {
+				t.Errorf("Null = %v, want %v", req.Message.Null, tt.wantNull)
+			}
+			if req.Message.Value != tt.wantValue {
+				t.Errorf("Value = %q, want %q", req.Message.Value, tt.wantValue)
+			}
+			if got := req.Message.Ptr() != nil; got != (tt.wantSet && !tt.wantNull) {
+				t.Errorf("Ptr() != nil = %v", got)
+			}
+		})
+	}
+
+	t.Run("型が不正", func(t *testing.T) {
+		var req struct {
+			Message Optional[string] `json:"message"`
+		}
+		if err := json.Unmarshal([]byte(`{"message":1}`), &req); err == nil {
+			t.Error("expected error but got nil")
+		}
+	})
+}
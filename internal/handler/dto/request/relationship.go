@@ -24,3 +24,47 @@ type BlockUserRequest struct {
 type RemoveRelationshipRequest struct {
 	RelationshipID string `json:"relationship_id"`
 }
+
+// UpdateAutoConfirmRequest は友達からのモーニングコールの自動確認設定のリクエスト
+type UpdateAutoConfirmRequest struct {
+	AutoConfirm *bool `json:"auto_confirm"`
+}
+
+// Validate は自動確認設定のリクエストを検証する
+func (r *UpdateAutoConfirmRequest) Validate() map[string]string {
+	errors := make(map[string]string)
+
+	if r.AutoConfirm == nil {
+		errors["auto_confirm"] = "auto_confirmは必須です"
+	}
+
+	return errors
+}
+
+// UpdateFavoriteSenderRequest は友達をお気に入り送信元に登録・解除するリクエスト
+type UpdateFavoriteSenderRequest struct {
+	Favorite   *bool `json:"favorite"`
+	AlwaysRing bool  `json:"always_ring"` // 無音配信のデフォルト設定にかかわらず音を鳴らして通知するか
+}
+
+// Validate はお気に入り送信元設定のリクエストを検証する
+func (r *UpdateFavoriteSenderRequest) Validate() map[string]string {
+	errors := make(map[string]string)
+
+	if r.Favorite == nil {
+		errors["favorite"] = "favoriteは必須です"
+	}
+
+	return errors
+}
+
+// GenerateFriendInviteRequest は友達追加用の招待トークン発行のリクエスト（ボディは任意）
+type GenerateFriendInviteRequest struct {
+	MaxUses          int `json:"max_uses"`           // 使用回数の上限（省略時は1回）
+	ExpiresInMinutes int `json:"expires_in_minutes"` // 有効期間（分、省略時は10分）
+}
+
+// AcceptFriendInviteRequest は招待トークンを使用した友達リクエスト送信のリクエスト
+type AcceptFriendInviteRequest struct {
+	Token string `json:"token"`
+}
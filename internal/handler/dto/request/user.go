@@ -0,0 +1,175 @@
+package request
+
+import (
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// RequestEmailChangeRequest はメールアドレス変更申請リクエストのDTO
+type RequestEmailChangeRequest struct {
+	NewEmail string `json:"new_email"`
+	Password string `json:"password"` // 本人確認のための現在のパスワード
+}
+
+// Validate はメールアドレス変更申請リクエストのバリデーションを行う
+func (r *RequestEmailChangeRequest) Validate() map[string]string {
+	errors := make(map[string]string)
+
+	if r.NewEmail == "" {
+		errors["new_email"] = "新しいメールアドレスは必須です"
+	} else if !isValidEmail(r.NewEmail) {
+		errors["new_email"] = "有効なメールアドレスを入力してください"
+	}
+
+	if r.Password == "" {
+		errors["password"] = "パスワードは必須です"
+	}
+
+	return errors
+}
+
+// ChangePasswordRequest はパスワード変更リクエストのDTO
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password"` // 本人確認のための現在のパスワード
+	NewPassword     string `json:"new_password"`
+}
+
+// Validate はパスワード変更リクエストのバリデーションを行う
+// パスワードの強度はユースケースで検証する
+func (r *ChangePasswordRequest) Validate() map[string]string {
+	errors := make(map[string]string)
+
+	if r.CurrentPassword == "" {
+		errors["current_password"] = "現在のパスワードは必須です"
+	}
+	if r.NewPassword == "" {
+		errors["new_password"] = "新しいパスワードは必須です"
+	}
+
+	return errors
+}
+
+// RequestAccountDeletionRequest はアカウント削除申請リクエストのDTO
+type RequestAccountDeletionRequest struct {
+	Password string `json:"password"` // 本人確認のための現在のパスワード
+}
+
+// Validate はアカウント削除申請リクエストのバリデーションを行う
+func (r *RequestAccountDeletionRequest) Validate() map[string]string {
+	errors := make(map[string]string)
+
+	if r.Password == "" {
+		errors["password"] = "パスワードは必須です"
+	}
+
+	return errors
+}
+
+// UpdateCallApprovalRequest はモーニングコールの事前承認制設定リクエストのDTO
+type UpdateCallApprovalRequest struct {
+	RequireCallApproval bool `json:"require_call_approval"`
+}
+
+// UpdatePreferencesRequest は受信設定変更リクエストのDTO（未指定の項目は変更しない）
+type UpdatePreferencesRequest struct {
+	SilentDelivery       *bool `json:"silent_delivery,omitempty"`
+	Volume               *int  `json:"volume,omitempty"`  // 配信時の音量（0〜100）
+	Vibrate              *bool `json:"vibrate,omitempty"` // 配信時にバイブレーションするか
+	NotifyOnConfirmation *bool `json:"notify_on_confirmation,omitempty"`
+	NotifyOnExpiration   *bool `json:"notify_on_expiration,omitempty"`
+	NotifyOnNewLogin     *bool `json:"notify_on_new_login,omitempty"`
+}
+
+// ChangeUsernameRequest はユーザー名変更リクエストのDTO
+type ChangeUsernameRequest struct {
+	Username string `json:"username"`
+}
+
+// UpdateTimeZoneRequest はタイムゾーン設定リクエストのDTO
+type UpdateTimeZoneRequest struct {
+	TimeZone string `json:"time_zone"` // IANA名（例: Asia/Tokyo）、空文字でサーバーのタイムゾーンに戻す
+}
+
+// UpdateCallWindowRequest はモーニングコールを受け付ける曜日と時間帯の設定リクエストのDTO
+// call_windowにnullを指定すると設定を解除する
+type UpdateCallWindowRequest struct {
+	CallWindow *CallWindowRequest `json:"call_window"`
+}
+
+// CallWindowRequest はモーニングコールを受け付ける曜日と時間帯
+type CallWindowRequest struct {
+	Weekdays []string `json:"weekdays"` // 受け付ける曜日（sun〜sat、空の場合は毎日）
+	Start    string   `json:"start"`    // 受け付ける時間帯の開始（HH:MM、受信者のタイムゾーン）
+	End      string   `json:"end"`      // 受け付ける時間帯の終了（HH:MM、開始より前の場合は日付をまたぐ）
+}
+
+// ParseCallWindow は受信時間帯を解析する（解除の場合はnilを返す）
+func (r *UpdateCallWindowRequest) ParseCallWindow() (*valueobject.CallWindow, map[string]string) {
+	if r.CallWindow == nil {
+		return nil, nil
+	}
+
+	window, reason := valueobject.ParseCallWindow(r.CallWindow.Weekdays, r.CallWindow.Start, r.CallWindow.End)
+	if reason.IsNG() {
+		return nil, map[string]string{"call_window." + reason.Field(): reason.Error()}
+	}
+	return window, nil
+}
+
+// UpdateCallForwardingRequest は不在時のモーニングコールの転送設定リクエストのDTO
+type UpdateCallForwardingRequest struct {
+	ForwardTo string     `json:"forward_to"`     // 転送先の友達のユーザーID
+	From      *time.Time `json:"from,omitempty"` // 転送の開始日時（未指定は現在から）
+	Until     *time.Time `json:"until"`          // 転送の終了日時（開始から30日以内）
+}
+
+// Validate は転送設定リクエストのバリデーションを行う
+func (r *UpdateCallForwardingRequest) Validate() map[string]string {
+	errors := make(map[string]string)
+
+	if r.ForwardTo == "" {
+		errors["forward_to"] = "転送先のユーザーIDは必須です"
+	}
+	if r.Until == nil {
+		errors["until"] = "転送の終了日時は必須です"
+	}
+
+	return errors
+}
+
+// RegisterDeviceRequest はプッシュ通知を受け取る端末の登録リクエストのDTO
+type RegisterDeviceRequest struct {
+	Token    string `json:"token"`    // 端末のデバイストークン
+	Platform string `json:"platform"` // ios, android, web
+}
+
+// Validate は端末登録リクエストのバリデーションを行う
+func (r *RegisterDeviceRequest) Validate() map[string]string {
+	errors := make(map[string]string)
+
+	if r.Token == "" {
+		errors["token"] = "デバイストークンは必須です"
+	}
+	if r.Platform == "" {
+		errors["platform"] = "プラットフォームは必須です"
+	}
+
+	return errors
+}
+
+// ConfirmEmailChangeRequest はメールアドレス変更確認リクエストのDTO
+type ConfirmEmailChangeRequest struct {
+	Token string `json:"token"` // 新しいメールアドレスに送信された確認トークン
+}
+
+// Validate はメールアドレス変更確認リクエストのバリデーションを行う
+func (r *ConfirmEmailChangeRequest) Validate() map[string]string {
+	errors := make(map[string]string)
+
+	if r.Token == "" {
+		errors["token"] = "確認トークンは必須です"
+	}
+
+	return errors
+}
@@ -0,0 +1,118 @@
+package response
+
+import "time"
+
+// AdminUserDTO は管理者向けのユーザー情報のDTO（パスワードハッシュは含まない）
+type AdminUserDTO struct {
+	ID          string    `json:"id"`
+	Username    string    `json:"username"`
+	Email       string    `json:"email"`
+	IsAdmin     bool      `json:"is_admin"`
+	IsFrozen    bool      `json:"is_frozen"`
+	Plan        string    `json:"plan"`
+	Points      int       `json:"points"`
+	FriendCount *int      `json:"friend_count,omitempty"`
+	CallCount   *int      `json:"call_count,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// AdminUserListResponse は管理者向けユーザー一覧のレスポンス
+type AdminUserListResponse struct {
+	Users   []AdminUserDTO `json:"users"`
+	Total   int            `json:"total"`
+	Limit   int            `json:"limit"`
+	Offset  int            `json:"offset"`
+	HasNext bool           `json:"has_next"`
+}
+
+// BulkUpdateFailureDTO は一括ステータス更新で更新できなかったコールのDTO
+type BulkUpdateFailureDTO struct {
+	MorningCallID string `json:"morning_call_id"`
+	Reason        string `json:"reason"`
+}
+
+// BulkUpdateMorningCallStatusResponse は一括ステータス更新のレスポンス
+type BulkUpdateMorningCallStatusResponse struct {
+	Matched  int                    `json:"matched"`
+	Updated  int                    `json:"updated"`
+	Failures []BulkUpdateFailureDTO `json:"failures"`
+}
+
+// AnomalousUserDTO は異常検知で検出されたユーザーのDTO
+type AnomalousUserDTO struct {
+	ID                 string   `json:"id"`
+	Username           string   `json:"username"`
+	Email              string   `json:"email"`
+	IsFrozen           bool     `json:"is_frozen"`
+	FriendRequestCount int      `json:"friend_request_count"`
+	MorningCallCount   int      `json:"morning_call_count"`
+	Score              float64  `json:"score"`
+	Reasons            []string `json:"reasons"`
+}
+
+// RepositoryStatsDTO は1種類のエンティティの件数と内訳のDTO
+type RepositoryStatsDTO struct {
+	Total     int            `json:"total"`
+	Breakdown map[string]int `json:"breakdown"`
+
+	// ConfirmMethods は起床確認の確認方法別の内訳（モーニングコールのみ、自動確認は含めない）
+	ConfirmMethods map[string]int `json:"confirm_methods,omitempty"`
+}
+
+// SystemStatsResponse は管理者向けシステム統計のレスポンス
+type SystemStatsResponse struct {
+	Users         RepositoryStatsDTO `json:"users"`         // プラン別の内訳
+	Relationships RepositoryStatsDTO `json:"relationships"` // ステータス別の内訳
+	MorningCalls  RepositoryStatsDTO `json:"morning_calls"` // ステータス別の内訳
+	GeneratedAt   time.Time          `json:"generated_at"`
+
+	// ConfirmationRate は起床確認の結果が確定したコールのうち確認されたものの割合（期限切れは未確認として数える）
+	ConfirmationRate float64 `json:"confirmation_rate"`
+
+	// RepositoryOperations はリポジトリ操作ごとの計測値（計測が無効の場合は省略）
+	RepositoryOperations []RepositoryOperationMetricsDTO `json:"repository_operations,omitempty"`
+
+	// Sessions はセッション数と期限切れセッションのクリーンアップの統計（取得できない場合は省略）
+	Sessions *SessionStatsDTO `json:"sessions,omitempty"`
+}
+
+// SessionStatsDTO はセッション数とクリーンアップの統計のDTO
+type SessionStatsDTO struct {
+	ActiveSessions         int        `json:"active_sessions"`
+	TotalSessions          int        `json:"total_sessions"` // 未削除の期限切れを含む
+	CleanupRuns            int        `json:"cleanup_runs"`
+	LastCleanupAt          *time.Time `json:"last_cleanup_at,omitempty"` // 一度も実行していない場合は省略
+	LastCleanupRemoved     int        `json:"last_cleanup_removed"`
+	TotalCleanupRemoved    int        `json:"total_cleanup_removed"`
+	LastCleanupDurationMs  float64    `json:"last_cleanup_duration_ms"`
+	CleanupIntervalSeconds float64    `json:"cleanup_interval_seconds"`
+}
+
+// RepositoryOperationMetricsDTO はリポジトリ操作1種類の計測値のDTO
+type RepositoryOperationMetricsDTO struct {
+	Operation        string  `json:"operation"`
+	Calls            int64   `json:"calls"`
+	Errors           int64   `json:"errors"`
+	ErrorRate        float64 `json:"error_rate"`
+	AverageLatencyMs float64 `json:"average_latency_ms"`
+}
+
+// AnomalousUserListResponse は異常ユーザー一覧のレスポンス
+type AnomalousUserListResponse struct {
+	Users       []AnomalousUserDTO `json:"users"`
+	Total       int                `json:"total"`
+	WindowStart time.Time          `json:"window_start"`
+	WindowEnd   time.Time          `json:"window_end"`
+}
+
+// AdminDeletedMorningCallResponse は管理者によるモーニングコールの強制削除のレスポンス
+type AdminDeletedMorningCallResponse struct {
+	ID             string    `json:"id"`
+	SenderID       string    `json:"sender_id"`
+	ReceiverID     string    `json:"receiver_id"`
+	Status         string    `json:"status"`
+	DeletedAt      time.Time `json:"deleted_at"`
+	DeletedBy      string    `json:"deleted_by"`
+	DeletionReason string    `json:"deletion_reason"`
+}
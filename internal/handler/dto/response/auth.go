@@ -4,8 +4,16 @@ import "time"
 
 // LoginResponse はログインレスポンスのDTO
 type LoginResponse struct {
-	SessionID string    `json:"session_id"`
-	User      UserDTO   `json:"user"`
+	SessionID        string    `json:"session_id"`
+	User             UserDTO   `json:"user"`
+	ExpiresAt        time.Time `json:"expires_at"`
+	DeletionCanceled bool      `json:"deletion_canceled,omitempty"` // 削除予定だったアカウントの削除を取り消した場合にtrue
+}
+
+// IssueTokenResponse はスコープ付きトークン発行レスポンスのDTO
+type IssueTokenResponse struct {
+	Token     string    `json:"token"` // Authorizationヘッダー（Bearer）で指定する
+	Scopes    []string  `json:"scopes"`
 	ExpiresAt time.Time `json:"expires_at"`
 }
 
@@ -29,6 +37,86 @@ type UserDTO struct {
 	Email     string    `json:"email"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+
+	RequireCallApproval  bool   `json:"require_call_approval"`  // モーニングコールの受信に事前の承認が必要か
+	TimeZone             string `json:"time_zone,omitempty"`    // タイムゾーンのIANA名（未設定は省略）
+	SilentDelivery       bool   `json:"silent_delivery"`        // 受け取るモーニングコールを無音で配信するか
+	Volume               int    `json:"volume"`                 // 受け取るモーニングコールの配信時の音量（0〜100）
+	Vibrate              bool   `json:"vibrate"`                // 受け取るモーニングコールの配信時にバイブレーションするか
+	NotifyOnConfirmation bool   `json:"notify_on_confirmation"` // 送ったモーニングコールの起床確認の通知を受け取るか
+	NotifyOnExpiration   bool   `json:"notify_on_expiration"`   // 送ったモーニングコールが期限切れになったときの通知を受け取るか
+	NotifyOnNewLogin     bool   `json:"notify_on_new_login"`    // 初めてのデバイス・IPアドレスからのログインの通知を受け取るか
+	Points               int    `json:"points"`                 // 起床確認されて貯まった感謝ポイント
+
+	CallWindow *CallWindowResponse `json:"call_window"` // モーニングコールを受け付ける曜日と時間帯（未設定はnull）
+
+	CallForwarding *CallForwardingResponse `json:"call_forwarding"` // 不在時のモーニングコールの転送設定（未設定はnull）
+}
+
+// CallForwardingResponse は不在時のモーニングコールの転送設定のレスポンス
+type CallForwardingResponse struct {
+	ForwardTo string    `json:"forward_to"` // 転送先のユーザーID
+	From      time.Time `json:"from"`       // 転送の開始日時
+	Until     time.Time `json:"until"`      // 転送の終了日時
+}
+
+// NewCallForwardingResponse は転送設定からレスポンスを作成（未設定の場合はnil）
+func NewCallForwardingResponse(forwardTo *string, from, until *time.Time) *CallForwardingResponse {
+	if forwardTo == nil || from == nil || until == nil {
+		return nil
+	}
+	return &CallForwardingResponse{
+		ForwardTo: *forwardTo,
+		From:      *from,
+		Until:     *until,
+	}
+}
+
+// UserSearchResultDTO はユーザー検索結果のDTO
+type UserSearchResultDTO struct {
+	UserDTO
+	RelationshipStatus string `json:"relationship_status"`   // none, pending, accepted, blocked
+	CanSendMorningCall bool   `json:"can_send_morning_call"` // モーニングコールを送れるか
+}
+
+// LeaderboardEntryResponse は感謝ポイントランキングの1行
+type LeaderboardEntryResponse struct {
+	Rank     int    `json:"rank"`
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	Points   int    `json:"points"`
+}
+
+// LeaderboardResponse は感謝ポイントランキングのレスポンス
+type LeaderboardResponse struct {
+	Entries []LeaderboardEntryResponse `json:"entries"`
+}
+
+// AvailabilityResponse はユーザー名・メールアドレスの利用可能チェックのレスポンス
+// 確認しなかった項目は省略する
+type AvailabilityResponse struct {
+	UsernameAvailable *bool `json:"username_available,omitempty"`
+	EmailAvailable    *bool `json:"email_available,omitempty"`
+}
+
+// DeviceResponse はプッシュ通知を受け取る端末のレスポンス
+// デバイストークンは秘匿情報のため末尾のみ返す
+type DeviceResponse struct {
+	ID          string    `json:"id"`
+	Platform    string    `json:"platform"`
+	TokenSuffix string    `json:"token_suffix"` // デバイストークンの末尾（端末の見分け用）
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// DeviceListResponse は端末一覧のレスポンス
+type DeviceListResponse struct {
+	Devices []DeviceResponse `json:"devices"`
+}
+
+// AccountDeletionResponse はアカウント削除申請のレスポンス
+type AccountDeletionResponse struct {
+	DeletionScheduledAt time.Time `json:"deletion_scheduled_at"` // 完全に削除される日時（この日時までにログインすると取り消される）
+	Message             string    `json:"message"`
 }
 
 // SessionInfo はセッション情報のDTO
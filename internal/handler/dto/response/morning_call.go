@@ -1,18 +1,180 @@
 package response
 
-import "time"
+import (
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
 
 // MorningCallResponse はモーニングコールのレスポンス
 type MorningCallResponse struct {
-	ID            string     `json:"id"`
-	SenderID      string     `json:"sender_id"`
-	ReceiverID    string     `json:"receiver_id"`
-	ScheduledTime time.Time  `json:"scheduled_time"`
-	Message       string     `json:"message"`
-	Status        string     `json:"status"`
-	ConfirmedAt   *time.Time `json:"confirmed_at,omitempty"`
-	CreatedAt     time.Time  `json:"created_at"`
-	UpdatedAt     time.Time  `json:"updated_at"`
+	ID              string            `json:"id"`
+	SenderID        string            `json:"sender_id"`
+	ReceiverID      string            `json:"receiver_id"`
+	ScheduledTime   time.Time         `json:"scheduled_time"`
+	Message         string            `json:"message"`
+	MessageHTML     string            `json:"message_html"` // 改行と *強調* のみを反映した表示用の安全なHTML
+	Status          string            `json:"status"`
+	DeliveredAt     *time.Time        `json:"delivered_at,omitempty"`
+	ConfirmedAt     *time.Time        `json:"confirmed_at,omitempty"`
+	ConfirmedBy     string            `json:"confirmed_by,omitempty"`    // 起床確認をしたユーザーのID
+	ProxyConfirmed  bool              `json:"proxy_confirmed,omitempty"` // 代理人によって確認されたか
+	AutoConfirmed   bool              `json:"auto_confirmed,omitempty"`  // 受信者の設定により配信時に自動で確認されたか
+	ConfirmMethod   string            `json:"confirm_method,omitempty"`  // クライアントで確認した操作（自動確認は省略）
+	ConfirmLocation *GeoPointResponse `json:"confirm_location,omitempty"`
+	Stamp           string            `json:"stamp,omitempty"`
+	StampAt         *time.Time        `json:"stamp_at,omitempty"`
+	ConfirmDeadline *time.Time        `json:"confirm_deadline,omitempty"`
+	CreatedAt       time.Time         `json:"created_at"`
+	UpdatedAt       time.Time         `json:"updated_at"`
+
+	// WakeChallenge は起床クイズ（クイズなしは省略、答えは含めない）
+	WakeChallenge *WakeChallengeResponse `json:"wake_challenge,omitempty"`
+
+	// HelpfulnessRating・RatedAt は受信者によるメッセージの役立ち度の評価（未評価は省略）
+	HelpfulnessRating *int       `json:"helpfulness_rating,omitempty"`
+	RatedAt           *time.Time `json:"rated_at,omitempty"`
+
+	// TimeToConfirmSeconds は配信から起床確認までの所要時間（秒、未確認・自動確認は省略）
+	TimeToConfirmSeconds *int64 `json:"time_to_confirm_seconds,omitempty"`
+
+	ReceiverOffsetMinutes  int       `json:"receiver_offset_minutes"`
+	EffectiveScheduledTime time.Time `json:"effective_scheduled_time"`
+
+	// SilentDelivery は配信時に無音で通知するか（受信者本人が閲覧する場合のみ）
+	SilentDelivery *bool `json:"silent_delivery,omitempty"`
+
+	// DeliveryHints は配信時の音量とバイブレーションの有無（受信者本人が閲覧する場合のみ）
+	DeliveryHints *DeliveryHintsResponse `json:"delivery_hints,omitempty"`
+
+	// FromFavoriteSender は受信者がお気に入り送信元に登録した友達からのコールか（受信一覧のみ）
+	FromFavoriteSender bool `json:"from_favorite_sender,omitempty"`
+
+	// ExpiredUnconfirmed は受信者が起床確認しないまま期限切れになったか（送信者本人が閲覧する場合のみ）
+	ExpiredUnconfirmed bool `json:"expired_unconfirmed,omitempty"`
+
+	// SeriesID はまとめて作成したシリーズのID（単独で作成したコールは省略）
+	SeriesID string `json:"series_id,omitempty"`
+
+	// SelfCall は送信者が自分自身に設定したセルフモーニングコールか
+	SelfCall bool `json:"self_call"`
+
+	// ForwardedFrom は受信者の不在時転送により転送先に届けた場合の元の受信者ID（転送していないコールは省略）
+	ForwardedFrom string `json:"forwarded_from,omitempty"`
+
+	// MessageVariants・MessageVariantIndex はA/Bテスト用のメッセージの候補と配信に選ばれた候補の番号（送信者本人が閲覧する場合のみ）
+	MessageVariants     []string `json:"message_variants,omitempty"`
+	MessageVariantIndex *int     `json:"message_variant_index,omitempty"`
+
+	// RescheduleRequest は受信者からの最新のアラーム時刻の変更リクエスト（リクエストがない場合は省略）
+	RescheduleRequest *RescheduleRequestResponse `json:"reschedule_request,omitempty"`
+
+	// NextCallRequest は受信者が起床確認と同時に依頼した翌日同時刻のコールのリクエスト（リクエストがない場合は省略）
+	NextCallRequest *NextCallRequestResponse `json:"next_call_request,omitempty"`
+
+	// Archived・ArchivedAt は受信箱からアーカイブしたか（受信者本人が閲覧する場合のみ）
+	Archived   bool       `json:"archived,omitempty"`
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+
+	// DeletedAt は管理者により削除された日時（削除されたコールはメッセージを返さない）
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+
+	// Translation はメッセージの翻訳結果（詳細取得で翻訳先の言語を指定した場合のみ）
+	Translation *MessageTranslationResponse `json:"translation,omitempty"`
+}
+
+// RescheduleRequestResponse はアラーム時刻の変更リクエストのレスポンス
+type RescheduleRequestResponse struct {
+	RequestedTime time.Time  `json:"requested_time"`
+	Status        string     `json:"status"` // pending, approved, rejected
+	RequestedAt   time.Time  `json:"requested_at"`
+	RespondedAt   *time.Time `json:"responded_at,omitempty"`
+}
+
+// NextCallRequestResponse は次回のコールのリクエストのレスポンス
+type NextCallRequestResponse struct {
+	RequestedTime time.Time  `json:"requested_time"`
+	Status        string     `json:"status"` // pending, approved, rejected
+	RequestedAt   time.Time  `json:"requested_at"`
+	RespondedAt   *time.Time `json:"responded_at,omitempty"`
+	CreatedCallID string     `json:"created_call_id,omitempty"` // 承認により作成されたコールのID
+}
+
+// RespondNextCallResponse は次回のコールのリクエストへの応答のレスポンス
+type RespondNextCallResponse struct {
+	MorningCall MorningCallResponse  `json:"morning_call"`        // リクエストを受けたコール
+	NextCall    *MorningCallResponse `json:"next_call,omitempty"` // 承認により作成したコール（拒否した場合は省略）
+}
+
+// MessageTranslationResponse はメッセージの翻訳結果のレスポンス
+type MessageTranslationResponse struct {
+	Language string `json:"language"` // 翻訳先の言語コード
+	Message  string `json:"message"`  // 翻訳したメッセージ（翻訳に失敗した場合は元のメッセージ）
+	Failed   bool   `json:"failed"`   // 翻訳に失敗したか
+}
+
+// CreateMorningCallResponse はモーニングコール作成のレスポンス
+type CreateMorningCallResponse struct {
+	MorningCallResponse
+	// AwaitingApproval は受信者の承認待ちになったか（falseの場合は即時スケジュール済み）
+	AwaitingApproval bool                      `json:"awaiting_approval"`
+	Approval         *ApprovalFeedbackResponse `json:"approval,omitempty"`
+	// ScheduleAdjustmentMinutes は天気などに応じて指定された時刻からずらした分数（負の値は早めた、調整なしは省略）
+	ScheduleAdjustmentMinutes int `json:"schedule_adjustment_minutes,omitempty"`
+}
+
+// ApprovalFeedbackResponse は承認待ちになったコールの送信者向けの案内
+type ApprovalFeedbackResponse struct {
+	ApproveBy time.Time `json:"approve_by"` // この時刻までに承認されないと配信されない
+	Notice    string    `json:"notice"`
+}
+
+// SeriesFailureResponse はシリーズのうち作成できなかったコールのレスポンス
+type SeriesFailureResponse struct {
+	ScheduledTime time.Time `json:"scheduled_time"`
+	Reason        string    `json:"reason"`
+}
+
+// SeriesSkipResponse はシリーズの一括操作で対象外になったコールのレスポンス
+type SeriesSkipResponse struct {
+	MorningCallID string `json:"morning_call_id"`
+	Reason        string `json:"reason"`
+}
+
+// MorningCallSeriesResponse はモーニングコールのシリーズのレスポンス
+type MorningCallSeriesResponse struct {
+	SeriesID     string                  `json:"series_id"`
+	MorningCalls []MorningCallResponse   `json:"morning_calls"`
+	Failures     []SeriesFailureResponse `json:"failures,omitempty"`  // 作成時に作成できなかったコール
+	Cancelled    int                     `json:"cancelled,omitempty"` // キャンセル時にキャンセルした件数
+	Updated      int                     `json:"updated,omitempty"`   // 更新時に更新した件数
+	Skipped      []SeriesSkipResponse    `json:"skipped,omitempty"`   // 一括操作で対象外になったコールと理由
+}
+
+// WakeChallengeResponse は起床クイズのレスポンス
+type WakeChallengeResponse struct {
+	Difficulty string `json:"difficulty"`
+	Question   string `json:"question"`
+}
+
+// DeliveryHintsResponse は配信時の音量とバイブレーションの有無のレスポンス
+type DeliveryHintsResponse struct {
+	Volume  int  `json:"volume"`  // 音量（0〜100、無音配信の場合は0）
+	Vibrate bool `json:"vibrate"` // バイブレーションするか
+}
+
+// NewDeliveryHintsResponse は配信時のヒントをレスポンスに変換する
+func NewDeliveryHintsResponse(hints valueobject.DeliveryHints) *DeliveryHintsResponse {
+	return &DeliveryHintsResponse{
+		Volume:  hints.Volume,
+		Vibrate: hints.Vibrate,
+	}
+}
+
+// GeoPointResponse は位置情報のレスポンス
+type GeoPointResponse struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
 }
 
 // MorningCallListResponse はモーニングコール一覧のレスポンス
@@ -21,4 +183,158 @@ type MorningCallListResponse struct {
 	Total        int                   `json:"total"`
 	Limit        int                   `json:"limit"`
 	Offset       int                   `json:"offset"`
+	LastSyncTime time.Time             `json:"last_sync_time"` // 次回の差分取得でupdated_sinceに指定するサーバー時刻
+}
+
+// MorningCallAccessLogEntryResponse はモーニングコールの閲覧記録1件分のレスポンス
+type MorningCallAccessLogEntryResponse struct {
+	ViewerID       string    `json:"viewer_id"`
+	ViewerUsername string    `json:"viewer_username"`
+	ViewerRole     string    `json:"viewer_role"` // sender, receiver, other
+	IsSelf         bool      `json:"is_self"`     // リクエストしたユーザー自身の閲覧か
+	Allowed        bool      `json:"allowed"`     // falseは権限がなく拒否された閲覧試行
+	AccessedAt     time.Time `json:"accessed_at"`
+}
+
+// MorningCallAccessLogResponse はモーニングコールのアクセスログのレスポンス
+type MorningCallAccessLogResponse struct {
+	Entries []MorningCallAccessLogEntryResponse `json:"entries"`
+	Total   int                                 `json:"total"`
+	HasNext bool                                `json:"has_next"`
+}
+
+// CallCommentResponse はモーニングコールのコメント1件分のレスポンス
+type CallCommentResponse struct {
+	ID        string    `json:"id"`
+	CallID    string    `json:"call_id"`
+	AuthorID  string    `json:"author_id"`
+	Body      string    `json:"body"`
+	BodyHTML  string    `json:"body_html"` // 表示用に安全なHTMLへ整形した本文
+	IsMine    bool      `json:"is_mine"`   // リクエストしたユーザー自身のコメントか
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CallCommentsResponse はモーニングコールのコメント一覧のレスポンス
+type CallCommentsResponse struct {
+	Comments []CallCommentResponse `json:"comments"` // 投稿日時の古い順
+	Total    int                   `json:"total"`
+	HasNext  bool                  `json:"has_next"`
+}
+
+// ScheduleConflictGroupResponse は時刻が重複しているモーニングコールのグループのレスポンス
+type ScheduleConflictGroupResponse struct {
+	StartTime    time.Time             `json:"start_time"`
+	EndTime      time.Time             `json:"end_time"`
+	MorningCalls []MorningCallResponse `json:"morning_calls"`
+}
+
+// ScheduleConflictsResponse はスケジュール重複検出のレスポンス
+type ScheduleConflictsResponse struct {
+	Groups        []ScheduleConflictGroupResponse `json:"groups"`
+	WindowSeconds int                             `json:"window_seconds"`
+}
+
+// FrequentReceiverResponse はよく送る相手のレスポンス
+type FrequentReceiverResponse struct {
+	UserID     string    `json:"user_id"`
+	Username   string    `json:"username"`
+	SendCount  int       `json:"send_count"`
+	LastSentAt time.Time `json:"last_sent_at"`
+}
+
+// FrequentReceiversResponse はよく送る相手一覧のレスポンス
+type FrequentReceiversResponse struct {
+	Receivers []FrequentReceiverResponse `json:"receivers"`
+}
+
+// RatingStatsResponse は自分が送ったコールの役立ち度の評価の統計のレスポンス
+type RatingStatsResponse struct {
+	RatedCount    int                          `json:"rated_count"`
+	AverageRating float64                      `json:"average_rating"` // 評価がない場合は0
+	Distribution  []RatingDistributionResponse `json:"distribution"`   // 評価の昇順
+}
+
+// ConfirmTimeStatsResponse は自分が送ったコールが起床確認されるまでの所要時間の統計のレスポンス
+type ConfirmTimeStatsResponse struct {
+	ConfirmedCount int   `json:"confirmed_count"`
+	AverageSeconds int64 `json:"average_seconds"` // 確認されたコールがない場合は0
+	MedianSeconds  int64 `json:"median_seconds"`  // 確認されたコールがない場合は0
+}
+
+// MessageVariantStatResponse はメッセージバリアントごとの起床確認率のレスポンス
+// 同じ本文でも候補の組み合わせが異なる場合は別の行になる
+type MessageVariantStatResponse struct {
+	MessageVariants     []string `json:"message_variants"`
+	MessageVariantIndex int      `json:"message_variant_index"`
+	Message             string   `json:"message"`
+	Delivered           int      `json:"delivered"`
+	Confirmed           int      `json:"confirmed"`
+	Missed              int      `json:"missed"`
+	ConfirmationRate    float64  `json:"confirmation_rate"` // 結果の出たコールがない場合は0
+}
+
+// MessageVariantStatsResponse はメッセージバリアント別の起床確認率のレスポンス
+type MessageVariantStatsResponse struct {
+	Variants []MessageVariantStatResponse `json:"variants"` // 起床確認率の高い順
+}
+
+// RatingDistributionResponse は評価ごとのコール数のレスポンス
+type RatingDistributionResponse struct {
+	Rating int `json:"rating"`
+	Count  int `json:"count"`
+}
+
+// CallLeaderboardEntryResponse は起床確認・起こした回数ランキングの1行のレスポンス
+type CallLeaderboardEntryResponse struct {
+	Rank     int    `json:"rank"`
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	Count    int    `json:"count"`
+}
+
+// CallLeaderboardResponse は起床確認・起こした回数ランキングのレスポンス
+type CallLeaderboardResponse struct {
+	Type    string                         `json:"type"`
+	Scope   string                         `json:"scope"`
+	From    time.Time                      `json:"from"`
+	To      time.Time                      `json:"to"`
+	Entries []CallLeaderboardEntryResponse `json:"entries"`
+	Me      *CallLeaderboardEntryResponse  `json:"me"` // 期間内の回数が0の場合はnull
+}
+
+// WakeHeatmapResponse は日別の起床確認件数（起床ヒートマップ）のレスポンス
+type WakeHeatmapResponse struct {
+	From     time.Time      `json:"from"`
+	To       time.Time      `json:"to"`
+	TimeZone string         `json:"timezone"` // 日付キーの基準にしたタイムゾーン
+	Days     map[string]int `json:"days"`     // 日付（YYYY-MM-DD）-> 起床確認した件数
+	Total    int            `json:"total"`
+}
+
+// SystemMessageResponse はシステムが用意した定型メッセージのレスポンス
+type SystemMessageResponse struct {
+	ID       string `json:"id"`
+	Category string `json:"category"` // cheerful, gentle, strict
+	Text     string `json:"text"`
+}
+
+// SystemMessagesResponse は定型メッセージ一覧のレスポンス
+type SystemMessagesResponse struct {
+	Language string                  `json:"language"` // 本文に使った言語コード
+	Messages []SystemMessageResponse `json:"messages"`
+}
+
+// UnconfirmedCountResponse は未確認モーニングコール件数のレスポンス
+type UnconfirmedCountResponse struct {
+	Count int `json:"count"`
+}
+
+// ValidateMessageResponse はメッセージ事前検証のレスポンス
+type ValidateMessageResponse struct {
+	Valid       bool     `json:"valid"`
+	CharCount   int      `json:"char_count"`
+	MaxLength   int      `json:"max_length"`
+	WithinLimit bool     `json:"within_limit"`
+	BannedWords []string `json:"banned_words"`
+	Reasons     []string `json:"reasons"`
 }
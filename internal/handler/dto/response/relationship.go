@@ -15,6 +15,8 @@ type RelationshipResponse struct {
 	Status      valueobject.RelationshipStatus `json:"status"`
 	CreatedAt   time.Time                      `json:"created_at"`
 	UpdatedAt   time.Time                      `json:"updated_at"`
+
+	SeenByReceiver bool `json:"seen_by_receiver"`
 }
 
 // NewRelationshipResponse はentityからレスポンスを作成
@@ -26,9 +28,57 @@ func NewRelationshipResponse(r *entity.Relationship) *RelationshipResponse {
 		Status:      r.Status,
 		CreatedAt:   r.CreatedAt,
 		UpdatedAt:   r.UpdatedAt,
+
+		SeenByReceiver: r.SeenByReceiver,
 	}
 }
 
+// FriendInviteResponse は友達追加用の招待トークンのレスポンス
+type FriendInviteResponse struct {
+	Token     string    `json:"token"`
+	URI       string    `json:"uri"` // QRコードに埋め込む招待URI
+	MaxUses   int       `json:"max_uses"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// CallWindowResponse はモーニングコールを受け付ける曜日と時間帯のレスポンス
+type CallWindowResponse struct {
+	Weekdays []string `json:"weekdays"` // 受け付ける曜日（sun〜sat、空の場合は毎日）
+	Start    string   `json:"start"`    // 受け付ける時間帯の開始（HH:MM）
+	End      string   `json:"end"`      // 受け付ける時間帯の終了（HH:MM）
+}
+
+// NewCallWindowResponse は受信時間帯からレスポンスを作成（未設定の場合はnil）
+func NewCallWindowResponse(w *valueobject.CallWindow) *CallWindowResponse {
+	if w == nil {
+		return nil
+	}
+	return &CallWindowResponse{
+		Weekdays: w.WeekdayNames(),
+		Start:    w.Start(),
+		End:      w.End(),
+	}
+}
+
+// FriendCallWindowResponse は友達ごとの受信時間帯設定のレスポンス
+type FriendCallWindowResponse struct {
+	RelationshipID string              `json:"relationship_id"`
+	CallWindow     *CallWindowResponse `json:"call_window"` // 未設定（ユーザー全体の設定に従う）の場合はnull
+}
+
+// FriendAutoConfirmResponse は友達からのモーニングコールの自動確認設定のレスポンス
+type FriendAutoConfirmResponse struct {
+	RelationshipID string `json:"relationship_id"`
+	AutoConfirm    bool   `json:"auto_confirm"`
+}
+
+// FriendFavoriteSenderResponse は友達のお気に入り送信元設定のレスポンス
+type FriendFavoriteSenderResponse struct {
+	RelationshipID string `json:"relationship_id"`
+	Favorite       bool   `json:"favorite"`
+	AlwaysRing     bool   `json:"always_ring"`
+}
+
 // RelationshipListResponse は関係一覧のレスポンス
 type RelationshipListResponse struct {
 	Relationships []*RelationshipResponse `json:"relationships"`
@@ -65,12 +115,29 @@ func NewFriendRequestListResponse(relationships []*entity.Relationship) *FriendR
 	return res
 }
 
+// UnseenRequestCountResponse は未読の友達リクエスト件数のレスポンス
+type UnseenRequestCountResponse struct {
+	Count int `json:"count"`
+}
+
 // FriendResponse は友達情報のレスポンス
 type FriendResponse struct {
 	ID          string    `json:"id"`
 	Username    string    `json:"username"`
 	Email       string    `json:"email"`
 	FriendSince time.Time `json:"friend_since"`
+	// FavoriteSender は自分がこの友達をお気に入り送信元に登録しているか
+	FavoriteSender bool `json:"favorite_sender"`
+	// LatestCall は直近のモーニングコール（やり取りがない場合はnull）
+	LatestCall *FriendLatestCallResponse `json:"latest_call"`
+}
+
+// FriendLatestCallResponse は友達との直近のモーニングコールのレスポンス
+type FriendLatestCallResponse struct {
+	ID            string    `json:"id"`
+	ScheduledTime time.Time `json:"scheduled_time"`
+	Status        string    `json:"status"`
+	IsSender      bool      `json:"is_sender"` // 自分が送信者かどうか
 }
 
 // FriendListResponse は友達一覧のレスポンス
@@ -78,3 +145,23 @@ type FriendListResponse struct {
 	Friends []*FriendResponse `json:"friends"`
 	Total   int               `json:"total"`
 }
+
+// FriendGraphNodeResponse は友達関係グラフのノード（友達の友達も含むため連絡先は含めない）
+type FriendGraphNodeResponse struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+	Depth    int    `json:"depth"` // 自分からのホップ数（自分は0）
+}
+
+// FriendGraphEdgeResponse は友達関係グラフのエッジ
+type FriendGraphEdgeResponse struct {
+	Source string `json:"source"` // 友達リクエストを送ったユーザーのID
+	Target string `json:"target"` // 友達リクエストを受けたユーザーのID
+}
+
+// FriendGraphResponse は可視化用の友達関係グラフのレスポンス
+type FriendGraphResponse struct {
+	Nodes     []FriendGraphNodeResponse `json:"nodes"`
+	Edges     []FriendGraphEdgeResponse `json:"edges"`
+	Truncated bool                      `json:"truncated"` // ノード数の上限により一部のユーザーを省略したか
+}
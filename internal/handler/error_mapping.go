@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// sentinelErrorMapping はリポジトリのsentinel errorとレスポンスの対応
+type sentinelErrorMapping struct {
+	target  error
+	status  int
+	code    string
+	message string
+}
+
+// sentinelErrorMappings はerrors.Isで判定するsentinel errorの対応表（先頭から順に判定する）
+// 内部の状態を利用者に見せないよう、メッセージはエラー種別ごとの定型文を使う
+var sentinelErrorMappings = []sentinelErrorMapping{
+	{repository.ErrNotFound, http.StatusNotFound, "NOT_FOUND", "リソースが見つかりません"},
+	{repository.ErrAlreadyExists, http.StatusConflict, "ALREADY_EXISTS", "リソースが既に存在します"},
+	{repository.ErrInvalidArgument, http.StatusBadRequest, "VALIDATION_ERROR", "入力値が不正です"},
+	{repository.ErrPermissionDenied, http.StatusForbidden, "FORBIDDEN", "この操作を実行する権限がありません"},
+	{repository.ErrUpdateConflict, http.StatusConflict, "CONFLICT", "他の操作で更新されました。もう一度お試しください"},
+	{repository.ErrTransactionFailed, http.StatusConflict, "CONFLICT", "他の操作で更新されました。もう一度お試しください"},
+	{repository.ErrTimeout, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "一時的に処理できません。しばらくしてからお試しください"},
+	{repository.ErrConnectionFailed, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "一時的に処理できません。しばらくしてからお試しください"},
+}
+
+// MapErrorToResponse はユースケースのエラーをHTTPステータスとエラーレスポンスに変換する
+//
+// 判定は次の順に行う
+//  1. errors.Isでリポジトリのsentinel errorを判定し、種別ごとのステータスにする
+//  2. ドメイン検証エラー（NGReason）をラップしたエラーは業務エラーとして扱い、
+//     detailsに項目名とエラーコードを含める
+//  3. 他のエラーをラップした技術的なエラー（"failed to"で始まるものを含む）は500にする
+//  4. それ以外の業務エラーはメッセージをそのまま返し、
+//     「見つかりません」は404、「のみが」「権限」は403、それ以外は400にする
+func MapErrorToResponse(err error) (int, ErrorResponse) {
+	if err == nil {
+		return internalErrorResponse()
+	}
+
+	for _, m := range sentinelErrorMappings {
+		if errors.Is(err, m.target) {
+			return m.status, newErrorResponse(m.code, m.message)
+		}
+	}
+
+	message := err.Error()
+	var reason valueobject.NGReason
+	if errors.As(err, &reason) && reason.IsNG() {
+		status, resp := businessErrorResponse(message)
+		resp.Error.Details = []ValidationError{{
+			Field:   reason.Field(),
+			Message: reason.Error(),
+			Reason:  reason.Code().String(),
+		}}
+		return status, resp
+	}
+
+	if errors.Unwrap(err) != nil || strings.HasPrefix(message, "failed to") {
+		return internalErrorResponse()
+	}
+
+	return businessErrorResponse(message)
+}
+
+// businessErrorResponse は業務エラーのメッセージからステータスとエラーレスポンスを決める
+func businessErrorResponse(message string) (int, ErrorResponse) {
+	switch {
+	case strings.Contains(message, "見つかりません"):
+		return http.StatusNotFound, newErrorResponse("NOT_FOUND", message)
+	case strings.Contains(message, "のみが") || strings.Contains(message, "権限"):
+		return http.StatusForbidden, newErrorResponse("FORBIDDEN", message)
+	default:
+		return http.StatusBadRequest, newErrorResponse("VALIDATION_ERROR", message)
+	}
+}
+
+// SendMappedError はエラーをMapErrorToResponseで変換したレスポンスを送信する
+// 500番台の場合は元のエラーをログに出力する
+func (h *BaseHandler) SendMappedError(w http.ResponseWriter, err error) {
+	status, resp := MapErrorToResponse(err)
+	if status >= http.StatusInternalServerError {
+		log.Printf("%s内部サーバーエラー: %v", requestIDLogPrefix(w), err)
+	}
+	h.SendJSON(w, status, resp)
+}
+
+// newErrorResponse は詳細なしのエラーレスポンスを作成する
+func newErrorResponse(code, message string) ErrorResponse {
+	return ErrorResponse{Error: ErrorDetail{Code: code, Message: message}}
+}
+
+// internalErrorResponse は内部サーバーエラーのレスポンスを返す
+func internalErrorResponse() (int, ErrorResponse) {
+	return http.StatusInternalServerError, newErrorResponse("INTERNAL_SERVER_ERROR", "サーバーエラーが発生しました")
+}
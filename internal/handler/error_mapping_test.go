@@ -0,0 +1,208 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+func TestMapErrorToResponse(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantStatus  int
+		wantCode    string
+		wantMessage string
+	}{
+		{
+			name:        "NotFound",
+			err:         repository.ErrNotFound,
+			wantStatus:  http.StatusNotFound,
+			wantCode:    "NOT_FOUND",
+			wantMessage: "リソースが見つかりません",
+		},
+		{
+			name:        "ラップされたNotFound",
+			err:         fmt.Errorf("failed to find user: %w", repository.ErrNotFound),
+			wantStatus:  http.StatusNotFound,
+			wantCode:    "NOT_FOUND",
+			wantMessage: "リソースが見つかりません",
+		},
+		{
+			name:        "AlreadyExists",
+			err:         fmt.Errorf("%w: ユーザー名 'alice' は既に使用されています", repository.ErrAlreadyExists),
+			wantStatus:  http.StatusConflict,
+			wantCode:    "ALREADY_EXISTS",
+			wantMessage: "リソースが既に存在します",
+		},
+		{
+			name:        "InvalidArgument",
+			err:         repository.ErrInvalidArgument,
+			wantStatus:  http.StatusBadRequest,
+			wantCode:    "VALIDATION_ERROR",
+			wantMessage: "入力値が不正です",
+		},
+		{
+			name:        "PermissionDenied",
+			err:         repository.ErrPermissionDenied,
+			wantStatus:  http.StatusForbidden,
+			wantCode:    "FORBIDDEN",
+			wantMessage: "この操作を実行する権限がありません",
+		},
+		{
+			name:        "UpdateConflict",
+			err:         fmt.Errorf("起床確認の保存に失敗しました: %w", repository.ErrUpdateConflict),
+			wantStatus:  http.StatusConflict,
+			wantCode:    "CONFLICT",
+			wantMessage: "他の操作で更新されました。もう一度お試しください",
+		},
+		{
+			name:        "TransactionFailed",
+			err:         fmt.Errorf("ユーザーのブロックに失敗しました: %w", repository.ErrTransactionFailed),
+			wantStatus:  http.StatusConflict,
+			wantCode:    "CONFLICT",
+			wantMessage: "他の操作で更新されました。もう一度お試しください",
+		},
+		{
+			name:        "Timeout",
+			err:         repository.ErrTimeout,
+			wantStatus:  http.StatusServiceUnavailable,
+			wantCode:    "SERVICE_UNAVAILABLE",
+			wantMessage: "一時的に処理できません。しばらくしてからお試しください",
+		},
+		{
+			name:        "ラップされた技術的なエラーは内容を返さない",
+			err:         fmt.Errorf("モーニングコールの取得中にエラーが発生しました: %w", errors.New("disk full")),
+			wantStatus:  http.StatusInternalServerError,
+			wantCode:    "INTERNAL_SERVER_ERROR",
+			wantMessage: "サーバーエラーが発生しました",
+		},
+		{
+			name:        "failed toで始まるエラー",
+			err:         errors.New("failed to generate id"),
+			wantStatus:  http.StatusInternalServerError,
+			wantCode:    "INTERNAL_SERVER_ERROR",
+			wantMessage: "サーバーエラーが発生しました",
+		},
+		{
+			name:        "業務エラー（見つかりません）",
+			err:         errors.New("モーニングコールが見つかりません"),
+			wantStatus:  http.StatusNotFound,
+			wantCode:    "NOT_FOUND",
+			wantMessage: "モーニングコールが見つかりません",
+		},
+		{
+			name:        "業務エラー（のみが）",
+			err:         errors.New("送信者のみがモーニングコールを更新できます"),
+			wantStatus:  http.StatusForbidden,
+			wantCode:    "FORBIDDEN",
+			wantMessage: "送信者のみがモーニングコールを更新できます",
+		},
+		{
+			name:        "業務エラー（権限）",
+			err:         errors.New("このリクエストを承認する権限がありません"),
+			wantStatus:  http.StatusForbidden,
+			wantCode:    "FORBIDDEN",
+			wantMessage: "このリクエストを承認する権限がありません",
+		},
+		{
+			name:        "その他の業務エラー",
+			err:         errors.New("メッセージは500文字以内で入力してください"),
+			wantStatus:  http.StatusBadRequest,
+			wantCode:    "VALIDATION_ERROR",
+			wantMessage: "メッセージは500文字以内で入力してください",
+		},
+		{
+			name:        "nil",
+			err:         nil,
+			wantStatus:  http.StatusInternalServerError,
+			wantCode:    "INTERNAL_SERVER_ERROR",
+			wantMessage: "サーバーエラーが発生しました",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, resp := MapErrorToResponse(tt.err)
+			if status != tt.wantStatus {
+				t.Errorf("status = %d, want %d", status, tt.wantStatus)
+			}
+			if resp.Error.Code != tt.wantCode {
+				t.Errorf("code = %s, want %s", resp.Error.Code, tt.wantCode)
+			}
+			if resp.Error.Message != tt.wantMessage {
+				t.Errorf("message = %s, want %s", resp.Error.Message, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestMapErrorToResponse_NGReason(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantStatus  int
+		wantCode    string
+		wantMessage string
+		wantDetail  ValidationError
+	}{
+		{
+			name:        "ラップされたドメイン検証エラーは業務エラーとして返す",
+			err:         fmt.Errorf("メッセージの更新に失敗しました: %w", valueobject.NGWithCode(valueobject.ReasonCodeTooLong, "message", "メッセージは500文字以内で入力してください")),
+			wantStatus:  http.StatusBadRequest,
+			wantCode:    "VALIDATION_ERROR",
+			wantMessage: "メッセージの更新に失敗しました: メッセージは500文字以内で入力してください",
+			wantDetail:  ValidationError{Field: "message", Message: "メッセージは500文字以内で入力してください", Reason: "TOO_LONG"},
+		},
+		{
+			name:        "ステータスはメッセージから判定する",
+			err:         fmt.Errorf("友達リクエストを既読にできませんでした: %w", valueobject.NGWithCode(valueobject.ReasonCodeNotPermitted, "", "受信者のみが友達リクエストを既読にできます")),
+			wantStatus:  http.StatusForbidden,
+			wantCode:    "FORBIDDEN",
+			wantMessage: "友達リクエストを既読にできませんでした: 受信者のみが友達リクエストを既読にできます",
+			wantDetail:  ValidationError{Field: "", Message: "受信者のみが友達リクエストを既読にできます", Reason: "NOT_PERMITTED"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, resp := MapErrorToResponse(tt.err)
+			if status != tt.wantStatus {
+				t.Errorf("status = %d, want %d", status, tt.wantStatus)
+			}
+			if resp.Error.Code != tt.wantCode {
+				t.Errorf("code = %s, want %s", resp.Error.Code, tt.wantCode)
+			}
+			if resp.Error.Message != tt.wantMessage {
+				t.Errorf("message = %s, want %s", resp.Error.Message, tt.wantMessage)
+			}
+			if len(resp.Error.Details) != 1 || resp.Error.Details[0] != tt.wantDetail {
+				t.Errorf("details = %+v, want [%+v]", resp.Error.Details, tt.wantDetail)
+			}
+		})
+	}
+}
+
+func TestBaseHandler_SendMappedError(t *testing.T) {
+	h := NewBaseHandler()
+	w := httptest.NewRecorder()
+
+	h.SendMappedError(w, errors.New("ユーザーが見つかりません"))
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+	var resp ErrorResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Error.Code != "NOT_FOUND" || resp.Error.Message != "ユーザーが見つかりません" {
+		t.Errorf("response = %+v", resp)
+	}
+}
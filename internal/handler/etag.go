@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+)
+
+// etagHashLength はETagに使うレスポンスボディのハッシュの長さ（バイト）
+const etagHashLength = 16
+
+// SendJSONWithETag はレスポンスボディのハッシュからETagを生成して200のJSONレスポンスを送信する
+// リクエストのIf-None-MatchがETagと一致する場合は本文を返さずに304を返す
+// ETagは閲覧者ごとに異なる内容も含めたボディから生成するため、共有キャッシュには保存させない
+func (h *BaseHandler) SendJSONWithETag(w http.ResponseWriter, r *http.Request, data interface{}) {
+	body, ok := h.encodeJSON(w, data)
+	if !ok {
+		return
+	}
+
+	etag := generateETag(body)
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "private, no-cache")
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		h.SendJSON(w, http.StatusNotModified, nil)
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, body)
+}
+
+// generateETag はレスポンスボディのハッシュから強いETagを生成する
+func generateETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:etagHashLength]) + `"`
+}
+
+// etagMatches はIf-None-Matchの値がETagと一致するかを判定する
+// If-None-Matchは弱い比較で判定するため、W/付きのETagも一致として扱う
+func etagMatches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
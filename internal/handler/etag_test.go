@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBaseHandler_SendJSONWithETag(t *testing.T) {
+	h := NewBaseHandler()
+
+	send := func(data interface{}, ifNoneMatch string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/users/me", nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		rec := httptest.NewRecorder()
+		h.SendJSONWithETag(rec, req, data)
+		return rec
+	}
+
+	first := send(map[string]string{"id": "mc1", "status": "scheduled"}, "")
+	etag := first.Header().Get("ETag")
+	if first.Code != http.StatusOK || etag == "" || first.Body.Len() == 0 {
+		t.Fatalf("status = %d, ETag = %q, body = %q, want 200 with ETag and body", first.Code, etag, first.Body.String())
+	}
+
+	t.Run("一致すれば本文なしの304を返す", func(t *testing.T) {
+		rec := send(map[string]string{"id": "mc1", "status": "scheduled"}, etag)
+		if rec.Code != http.StatusNotModified {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusNotModified)
+		}
+		if rec.Body.Len() != 0 {
+			t.Errorf("body = %q, want empty", rec.Body.String())
+		}
+		if got := rec.Header().Get("ETag"); got != etag {
+			t.Errorf("ETag = %q, want %q", got, etag)
+		}
+	})
+
+	t.Run("弱いETagや複数指定でも一致を判定する", func(t *testing.T) {
+		for _, ifNoneMatch := range []string{"W/" + etag, `"other", ` + etag, "*"} {
+			if rec := send(map[string]string{"id": "mc1", "status": "scheduled"}, ifNoneMatch); rec.Code != http.StatusNotModified {
+				t.Errorf("If-None-Match %q: status = %d, want %d", ifNoneMatch, rec.Code, http.StatusNotModified)
+			}
+		}
+	})
+
+	t.Run("一致しなければ200で本文を返す", func(t *testing.T) {
+		rec := send(map[string]string{"id": "mc1", "status": "scheduled"}, `"stale"`)
+		if rec.Code != http.StatusOK || rec.Body.String() != first.Body.String() {
+			t.Errorf("status = %d, body = %q, want 200 with body", rec.Code, rec.Body.String())
+		}
+	})
+
+	t.Run("内容が変わるとETagも変わる", func(t *testing.T) {
+		rec := send(map[string]string{"id": "mc1", "status": "delivered"}, etag)
+		if rec.Code != http.StatusOK {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+		}
+		if got := rec.Header().Get("ETag"); got == etag {
+			t.Errorf("ETag = %q, want changed", got)
+		}
+	})
+}
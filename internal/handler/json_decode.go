@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// JSONDecodeError はリクエストボディのJSONデコードエラーの詳細
+type JSONDecodeError struct {
+	Field   string // 問題のあるフィールド名（特定できない場合は空）
+	Message string // 利用者向けのエラーメッセージ
+	Offset  int64  // エラーが発生したボディ中のバイト位置（不明な場合は-1）
+	Err     error  // 元のエラー
+}
+
+// Error はエラーメッセージを返す
+func (e *JSONDecodeError) Error() string {
+	return e.Message
+}
+
+// Unwrap は元のエラーを返す
+func (e *JSONDecodeError) Unwrap() error {
+	return e.Err
+}
+
+// DescribeJSONDecodeError はjson.Decoderのエラーを解析し、
+// 問題のあるフィールド名・期待される型・オフセットを含むエラーに変換する
+func DescribeJSONDecodeError(err error) *JSONDecodeError {
+	if err == nil {
+		return nil
+	}
+
+	var decodeErr *JSONDecodeError
+	if errors.As(err, &decodeErr) {
+		return decodeErr
+	}
+
+	result := &JSONDecodeError{Offset: -1, Err: err}
+
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	var timeErr *time.ParseError
+
+	switch {
+	case errors.Is(err, io.EOF):
+		result.Message = "リクエストボディが空です"
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		result.Message = "JSONが途中で終了しています"
+	case errors.As(err, &syntaxErr):
+		result.Offset = syntaxErr.Offset
+		result.Message = fmt.Sprintf("JSONの構文が不正です（位置: %d）", syntaxErr.Offset)
+	case errors.As(err, &typeErr):
+		result.Field = typeErr.Field
+		result.Offset = typeErr.Offset
+		expected := describeGoType(typeErr.Type)
+		if typeErr.Field == "" {
+			result.Message = fmt.Sprintf("リクエストの型が不正です: %sを指定してください（位置: %d）", expected, typeErr.Offset)
+		} else {
+			result.Message = fmt.Sprintf("%sの型が不正です: %sを指定してください（%sが指定されました、位置: %d）",
+				typeErr.Field, expected, describeJSONValue(typeErr.Value), typeErr.Offset)
+		}
+	case errors.As(err, &timeErr):
+		result.Message = "日時の形式が不正です: RFC3339形式（例: 2024-01-01T07:00:00+09:00）で指定してください"
+	case strings.HasPrefix(err.Error(), "json: unknown field "):
+		// DisallowUnknownFields のエラーは専用の型がないためメッセージから取り出す
+		field := strings.Trim(strings.TrimPrefix(err.Error(), "json: unknown field "), `"`)
+		result.Field = field
+		result.Message = fmt.Sprintf("不明なフィールド %s が含まれています", field)
+	default:
+		result.Message = "リクエストの形式が不正です"
+	}
+
+	return result
+}
+
+// describeGoType はGoの型を利用者向けの型名に変換する
+func describeGoType(t reflect.Type) string {
+	if t == nil {
+		return "正しい型"
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == reflect.TypeOf(time.Time{}) {
+		return "日時文字列"
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return "文字列"
+	case reflect.Bool:
+		return "真偽値"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "整数"
+	case reflect.Float32, reflect.Float64:
+		return "数値"
+	case reflect.Slice, reflect.Array:
+		return "配列"
+	case reflect.Struct, reflect.Map:
+		return "オブジェクト"
+	default:
+		return t.String()
+	}
+}
+
+// describeJSONValue はjson.UnmarshalTypeErrorのValueを利用者向けの型名に変換する
+func describeJSONValue(value string) string {
+	switch {
+	case value == "string":
+		return "文字列"
+	case value == "bool":
+		return "真偽値"
+	case value == "array":
+		return "配列"
+	case value == "object":
+		return "オブジェクト"
+	case value == "number" || strings.HasPrefix(value, "number "):
+		return "数値"
+	default:
+		return value
+	}
+}
+
+// SendJSONDecodeError はJSONデコードエラーを詳細付きのエラーレスポンスとして送信する
+func (h *BaseHandler) SendJSONDecodeError(w http.ResponseWriter, code string, err error) {
+	decodeErr := DescribeJSONDecodeError(err)
+
+	var details []ValidationError
+	if decodeErr.Field != "" {
+		details = []ValidationError{{Field: decodeErr.Field, Message: decodeErr.Message}}
+	}
+	h.SendError(w, http.StatusBadRequest, code, decodeErr.Message, details)
+}
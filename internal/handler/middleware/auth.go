@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
 	"github.com/ochamu/morning-call-api/internal/domain/repository"
 	"github.com/ochamu/morning-call-api/internal/handler"
 	"github.com/ochamu/morning-call-api/internal/infrastructure/auth"
@@ -24,6 +25,9 @@ type AuthMiddleware struct {
 	sessionManager *auth.SessionManager
 	userRepo       repository.UserRepository
 	baseHandler    *handler.BaseHandler
+	sessionCache   *SessionCache // nilの場合はキャッシュしない
+
+	duplicateSuppressor *DuplicateRequestSuppressor // nilの場合は重複リクエストを抑制しない
 }
 
 // NewAuthMiddleware は新しい認証ミドルウェアを作成する
@@ -35,8 +39,46 @@ func NewAuthMiddleware(sessionManager *auth.SessionManager, userRepo repository.
 	}
 }
 
+// NewAuthMiddlewareWithCache はセッション検証結果のキャッシュ付きで認証ミドルウェアを作成する
+// cacheTTLが0以下の場合はキャッシュを無効にする
+// ログアウトや失効などでセッションが削除された場合はキャッシュも即座に破棄する
+func NewAuthMiddlewareWithCache(sessionManager *auth.SessionManager, userRepo repository.UserRepository, cacheTTL time.Duration) *AuthMiddleware {
+	m := NewAuthMiddleware(sessionManager, userRepo)
+	if cacheTTL > 0 {
+		m.sessionCache = NewSessionCache(cacheTTL)
+		sessionManager.OnSessionInvalidated(m.sessionCache.Invalidate)
+	}
+	return m
+}
+
+// SuppressDuplicateRequests は認証が必要なエンドポイントで、同一ユーザーの短時間の重複リクエストを抑制する
+// windowが0以下の場合は抑制しない。ルートの登録（Authenticateの呼び出し）より前に設定すること
+func (m *AuthMiddleware) SuppressDuplicateRequests(window time.Duration) {
+	if window <= 0 {
+		m.duplicateSuppressor = nil
+		return
+	}
+	m.duplicateSuppressor = NewDuplicateRequestSuppressor(window)
+}
+
 // Authenticate は認証が必要なエンドポイントに適用するミドルウェア
+// スコープで制限したトークンはread-onlyスコープを持つ場合の読み取りのみ許可する
 func (m *AuthMiddleware) Authenticate(next http.HandlerFunc) http.HandlerFunc {
+	return m.authenticate(auth.ScopeReadOnly, next)
+}
+
+// RequireScope はスコープで制限したトークンに指定したスコープを要求するミドルウェア
+// 制限のないセッションは常に許可し、制限したトークンでは読み取り（GET・HEAD）以外の操作をスコープによらず拒否する
+func (m *AuthMiddleware) RequireScope(scope auth.Scope, next http.HandlerFunc) http.HandlerFunc {
+	return m.authenticate(scope, next)
+}
+
+// authenticate はセッションを検証し、スコープで制限したトークンの場合はrequiredを満たすかを確認する
+// requiredが空の場合は制限のないセッションのみ許可する
+func (m *AuthMiddleware) authenticate(required auth.Scope, next http.HandlerFunc) http.HandlerFunc {
+	if m.duplicateSuppressor != nil {
+		next = m.duplicateSuppressor.Wrap(next)
+	}
 	return func(w http.ResponseWriter, r *http.Request) {
 		// セッションIDを取得（Cookieまたはヘッダーから）
 		sessionID := m.getSessionID(r)
@@ -45,24 +87,16 @@ func (m *AuthMiddleware) Authenticate(next http.HandlerFunc) http.HandlerFunc {
 			return
 		}
 
-		// セッションの検証
-		valid, err := m.sessionManager.ValidateSession(sessionID)
-		if err != nil || !valid {
-			m.baseHandler.SendAuthenticationError(w)
-			return
-		}
-
-		// セッションからユーザーIDを取得
-		userID, err := m.sessionManager.GetUserIDFromSession(sessionID)
+		// セッションの検証とユーザー情報の取得
+		user, session, err := m.resolveUser(r, sessionID)
 		if err != nil {
 			m.baseHandler.SendAuthenticationError(w)
 			return
 		}
 
-		// ユーザー情報を取得
-		user, err := m.userRepo.FindByID(r.Context(), userID)
-		if err != nil {
-			m.baseHandler.SendAuthenticationError(w)
+		// スコープのチェック
+		if !scopeAllows(session, required, r.Method) {
+			m.baseHandler.SendError(w, http.StatusForbidden, "INSUFFICIENT_SCOPE", "トークンのスコープではこの操作を実行できません", nil)
 			return
 		}
 
@@ -82,21 +116,13 @@ func (m *AuthMiddleware) OptionalAuth(next http.HandlerFunc) http.HandlerFunc {
 		// セッションIDを取得（Cookieまたはヘッダーから）
 		sessionID := m.getSessionID(r)
 		if sessionID != "" {
-			// セッションの検証
-			valid, err := m.sessionManager.ValidateSession(sessionID)
-			if err == nil && valid {
-				// セッションからユーザーIDを取得
-				userID, err := m.sessionManager.GetUserIDFromSession(sessionID)
-				if err == nil {
-					// ユーザー情報を取得
-					user, err := m.userRepo.FindByID(r.Context(), userID)
-					if err == nil {
-						// コンテキストにユーザー情報とセッションIDを設定
-						ctx := context.WithValue(r.Context(), handler.UserContextKey, user)
-						ctx = context.WithValue(ctx, handler.SessionIDContextKey, sessionID)
-						r = r.WithContext(ctx)
-					}
-				}
+			// セッションの検証とユーザー情報の取得
+			// スコープで制限したトークンで許可されない操作は未認証として扱う
+			if user, session, err := m.resolveUser(r, sessionID); err == nil && scopeAllows(session, auth.ScopeReadOnly, r.Method) {
+				// コンテキストにユーザー情報とセッションIDを設定
+				ctx := context.WithValue(r.Context(), handler.UserContextKey, user)
+				ctx = context.WithValue(ctx, handler.SessionIDContextKey, sessionID)
+				r = r.WithContext(ctx)
 			}
 		}
 
@@ -106,8 +132,9 @@ func (m *AuthMiddleware) OptionalAuth(next http.HandlerFunc) http.HandlerFunc {
 }
 
 // RequireAdmin は管理者権限が必要なエンドポイントに適用するミドルウェア
+// スコープで制限したトークンでは管理者のエンドポイントを利用できない
 func (m *AuthMiddleware) RequireAdmin(next http.HandlerFunc) http.HandlerFunc {
-	return m.Authenticate(func(w http.ResponseWriter, r *http.Request) {
+	return m.authenticate("", func(w http.ResponseWriter, r *http.Request) {
 		// ユーザー情報を取得
 		user, err := m.baseHandler.GetUserFromContext(r.Context())
 		if err != nil {
@@ -115,18 +142,62 @@ func (m *AuthMiddleware) RequireAdmin(next http.HandlerFunc) http.HandlerFunc {
 			return
 		}
 
-		// 管理者権限のチェック（現在は実装されていないため、将来的に追加）
-		// if !user.IsAdmin {
-		//     m.baseHandler.SendForbiddenError(w)
-		//     return
-		// }
-		_ = user // 未使用変数の警告を回避
+		// 管理者権限のチェック
+		if !user.IsAdmin {
+			m.baseHandler.SendForbiddenError(w)
+			return
+		}
 
 		// 次のハンドラーを実行
 		next.ServeHTTP(w, r)
 	})
 }
 
+// resolveUser はセッションを検証し、セッションに紐づくユーザーとセッションを取得する
+// キャッシュが有効な場合は直近の検証結果を再利用し、ユーザー検索を省略する（キャッシュから取得した場合のセッションはnil）
+func (m *AuthMiddleware) resolveUser(r *http.Request, sessionID string) (*entity.User, *auth.Session, error) {
+	if m.sessionCache != nil {
+		if user, ok := m.sessionCache.Get(sessionID); ok {
+			return user, nil, nil
+		}
+	}
+
+	// セッションの検証（期限切れの場合はエラー）
+	session, err := m.sessionManager.GetSession(sessionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// ユーザー情報を取得
+	user, err := m.userRepo.FindByID(r.Context(), session.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// キャッシュにはスコープを保持しないため、スコープで制限したトークンはキャッシュしない
+	if m.sessionCache != nil && !session.IsScoped() {
+		m.sessionCache.Set(sessionID, user, session.ExpiresAt)
+		// 検証からキャッシュ登録までの間にログアウトされた場合に備えて再確認する
+		if _, err := m.sessionManager.GetSession(sessionID); err != nil {
+			m.sessionCache.Invalidate(sessionID)
+		}
+	}
+	return user, session, nil
+}
+
+// scopeAllows はセッションのスコープでリクエストを許可するかを判定する
+// 制限のないセッション（キャッシュから取得したnilを含む）は常に許可し、
+// 制限したトークンは読み取りのリクエストでrequiredのスコープを持つ場合のみ許可する
+func scopeAllows(session *auth.Session, required auth.Scope, method string) bool {
+	if session == nil || !session.IsScoped() {
+		return true
+	}
+	if required == "" || (method != http.MethodGet && method != http.MethodHead) {
+		return false
+	}
+	return session.HasScope(required)
+}
+
 // getSessionID はリクエストからセッションIDを取得する
 func (m *AuthMiddleware) getSessionID(r *http.Request) string {
 	// 1. Authorizationヘッダーから取得を試みる
@@ -0,0 +1,289 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/handler"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/auth"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+// countingUserRepository はFindByIDの呼び出し回数を数えるユーザーリポジトリ
+type countingUserRepository struct {
+	*memory.UserRepository
+	findCount int64
+}
+
+func (r *countingUserRepository) FindByID(ctx context.Context, id string) (*entity.User, error) {
+	atomic.AddInt64(&r.findCount, 1)
+	return r.UserRepository.FindByID(ctx, id)
+}
+
+func (r *countingUserRepository) count() int64 {
+	return atomic.LoadInt64(&r.findCount)
+}
+
+func setupAuthMiddlewareTest(t *testing.T, cacheTTL time.Duration) (*AuthMiddleware, *auth.SessionManager, *countingUserRepository, string) {
+	t.Helper()
+
+	userRepo := &countingUserRepository{UserRepository: memory.NewUserRepository()}
+	user := &entity.User{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"}
+	if err := userRepo.Create(context.Background(), user); err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+
+	sessionManager := auth.NewSessionManager(time.Hour)
+	t.Cleanup(sessionManager.Stop)
+	session, err := sessionManager.CreateSession(user.ID)
+	if err != nil {
+		t.Fatalf("failed to create session: %v", err)
+	}
+
+	return NewAuthMiddlewareWithCache(sessionManager, userRepo, cacheTTL), sessionManager, userRepo, session.ID
+}
+
+func doAuthenticatedRequest(m *AuthMiddleware, sessionID string) int {
+	h := m.Authenticate(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := r.Context().Value(handler.UserContextKey).(*entity.User); !ok {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("X-Session-ID", sessionID)
+	rec := httptest.NewRecorder()
+	h(rec, req)
+	return rec.Code
+}
+
+func TestAuthMiddleware_SessionCache(t *testing.T) {
+	t.Run("連続リクエストではユーザー検索を省略する", func(t *testing.T) {
+		m, _, userRepo, sessionID := setupAuthMiddlewareTest(t, time.Minute)
+
+		for i := 0; i < 5; i++ {
+			if code := doAuthenticatedRequest(m, sessionID); code != http.StatusOK {
+				t.Fatalf("status = %d, want %d", code, http.StatusOK)
+			}
+		}
+		if got := userRepo.count(); got != 1 {
+			t.Errorf("FindByID called %d times, want 1", got)
+		}
+	})
+
+	t.Run("キャッシュ無効時は毎回ユーザーを検索する", func(t *testing.T) {
+		m, _, userRepo, sessionID := setupAuthMiddlewareTest(t, 0)
+
+		for i := 0; i < 3; i++ {
+			if code := doAuthenticatedRequest(m, sessionID); code != http.StatusOK {
+				t.Fatalf("status = %d, want %d", code, http.StatusOK)
+			}
+		}
+		if got := userRepo.count(); got != 3 {
+			t.Errorf("FindByID called %d times, want 3", got)
+		}
+	})
+
+	t.Run("ログアウト後は即座に認証エラーになる", func(t *testing.T) {
+		m, sessionManager, _, sessionID := setupAuthMiddlewareTest(t, time.Minute)
+
+		if code := doAuthenticatedRequest(m, sessionID); code != http.StatusOK {
+			t.Fatalf("status = %d, want %d", code, http.StatusOK)
+		}
+		if err := sessionManager.DeleteSession(sessionID); err != nil {
+			t.Fatalf("failed to delete session: %v", err)
+		}
+		if code := doAuthenticatedRequest(m, sessionID); code != http.StatusUnauthorized {
+			t.Errorf("status = %d, want %d", code, http.StatusUnauthorized)
+		}
+	})
+
+	t.Run("ユーザーのセッション一括無効化後は即座に認証エラーになる", func(t *testing.T) {
+		m, sessionManager, _, sessionID := setupAuthMiddlewareTest(t, time.Minute)
+
+		if code := doAuthenticatedRequest(m, sessionID); code != http.StatusOK {
+			t.Fatalf("status = %d, want %d", code, http.StatusOK)
+		}
+		if err := sessionManager.InvalidateUserSessions("user1"); err != nil {
+			t.Fatalf("failed to invalidate sessions: %v", err)
+		}
+		if code := doAuthenticatedRequest(m, sessionID); code != http.StatusUnauthorized {
+			t.Errorf("status = %d, want %d", code, http.StatusUnauthorized)
+		}
+	})
+
+	t.Run("TTL経過後は再検証する", func(t *testing.T) {
+		m, _, userRepo, sessionID := setupAuthMiddlewareTest(t, time.Minute)
+		now := time.Now()
+		m.sessionCache.now = func() time.Time { return now }
+
+		doAuthenticatedRequest(m, sessionID)
+		now = now.Add(time.Minute)
+		doAuthenticatedRequest(m, sessionID)
+
+		if got := userRepo.count(); got != 2 {
+			t.Errorf("FindByID called %d times, want 2", got)
+		}
+	})
+
+	t.Run("並行リクエスト中のログアウト", func(t *testing.T) {
+		m, sessionManager, _, sessionID := setupAuthMiddlewareTest(t, time.Minute)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 50; j++ {
+					doAuthenticatedRequest(m, sessionID)
+				}
+			}()
+		}
+		if err := sessionManager.DeleteSession(sessionID); err != nil {
+			t.Fatalf("failed to delete session: %v", err)
+		}
+		wg.Wait()
+
+		// ログアウト完了後はキャッシュが残っていないこと
+		if code := doAuthenticatedRequest(m, sessionID); code != http.StatusUnauthorized {
+			t.Errorf("status = %d, want %d", code, http.StatusUnauthorized)
+		}
+	})
+}
+
+func TestSessionCache_ExpiresWithSession(t *testing.T) {
+	cache := NewSessionCache(time.Minute)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	user := &entity.User{ID: "user1"}
+	cache.Set("session1", user, now.Add(time.Second))
+
+	if _, ok := cache.Get("session1"); !ok {
+		t.Fatal("expected cache hit")
+	}
+
+	// セッションの有効期限を過ぎたらTTL内でもキャッシュを使わない
+	now = now.Add(time.Second)
+	if _, ok := cache.Get("session1"); ok {
+		t.Error("expected cache miss after session expiry")
+	}
+	if cache.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", cache.Len())
+	}
+}
+
+func TestSessionCache_CopiesUser(t *testing.T) {
+	cache := NewSessionCache(time.Minute)
+	windowFrom := time.Date(2026, 3, 1, 6, 0, 0, 0, time.UTC)
+	forwardFrom := windowFrom
+
+	user := &entity.User{
+		ID:                "user1",
+		ProxyConfirmerIDs: []string{"friend1"},
+		CallWindow:        &valueobject.CallWindow{Weekdays: []time.Weekday{time.Monday}},
+		ForwardCallsFrom:  &forwardFrom,
+	}
+	cache.Set("session1", user, time.Now().Add(time.Hour))
+
+	// 登録後に呼び出し側でスライスやポインタの先を変更してもキャッシュに影響しない
+	user.ProxyConfirmerIDs[0] = "changed"
+	user.CallWindow.Weekdays[0] = time.Sunday
+	*user.ForwardCallsFrom = windowFrom.Add(time.Hour)
+
+	cached, ok := cache.Get("session1")
+	if !ok {
+		t.Fatal("expected cache hit")
+	}
+	if cached.ProxyConfirmerIDs[0] != "friend1" || cached.CallWindow.Weekdays[0] != time.Monday || !cached.ForwardCallsFrom.Equal(windowFrom) {
+		t.Errorf("cached user was modified through the registered user: %+v", cached)
+	}
+
+	// 取得したユーザーを変更しても次の取得に影響しない
+	cached.ProxyConfirmerIDs[0] = "changed"
+	cached.CallWindow.Weekdays[0] = time.Sunday
+	again, _ := cache.Get("session1")
+	if again.ProxyConfirmerIDs[0] != "friend1" || again.CallWindow.Weekdays[0] != time.Monday {
+		t.Errorf("cached user was modified through a returned user: %+v", again)
+	}
+}
+
+func TestAuthMiddleware_Scopes(t *testing.T) {
+	const (
+		wrapAuthenticate = "Authenticate"
+		wrapMorningCalls = "RequireScope(morning-calls:read)"
+		wrapAdmin        = "RequireAdmin"
+	)
+
+	tests := []struct {
+		name   string
+		scopes []auth.Scope // nilはログインで作成したセッション
+		wrap   string
+		method string
+		want   int
+	}{
+		{name: "制限なしは書き込みも許可", scopes: nil, wrap: wrapAuthenticate, method: http.MethodPost, want: http.StatusOK},
+		{name: "制限なしはスコープ要求も許可", scopes: nil, wrap: wrapMorningCalls, method: http.MethodPut, want: http.StatusOK},
+		{name: "read-onlyは読み取りを許可", scopes: []auth.Scope{auth.ScopeReadOnly}, wrap: wrapAuthenticate, method: http.MethodGet, want: http.StatusOK},
+		{name: "read-onlyはモーニングコールの読み取りを許可", scopes: []auth.Scope{auth.ScopeReadOnly}, wrap: wrapMorningCalls, method: http.MethodGet, want: http.StatusOK},
+		{name: "read-onlyは書き込みを拒否", scopes: []auth.Scope{auth.ScopeReadOnly}, wrap: wrapAuthenticate, method: http.MethodPost, want: http.StatusForbidden},
+		{name: "read-onlyは削除を拒否", scopes: []auth.Scope{auth.ScopeReadOnly}, wrap: wrapMorningCalls, method: http.MethodDelete, want: http.StatusForbidden},
+		{name: "morning-calls:readはモーニングコールの読み取りを許可", scopes: []auth.Scope{auth.ScopeMorningCallsRead}, wrap: wrapMorningCalls, method: http.MethodGet, want: http.StatusOK},
+		{name: "morning-calls:readは他のエンドポイントを拒否", scopes: []auth.Scope{auth.ScopeMorningCallsRead}, wrap: wrapAuthenticate, method: http.MethodGet, want: http.StatusForbidden},
+		{name: "morning-calls:readはモーニングコールの書き込みを拒否", scopes: []auth.Scope{auth.ScopeMorningCallsRead}, wrap: wrapMorningCalls, method: http.MethodPut, want: http.StatusForbidden},
+		{name: "スコープ付きトークンは管理者のエンドポイントを拒否", scopes: []auth.Scope{auth.ScopeReadOnly}, wrap: wrapAdmin, method: http.MethodGet, want: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// キャッシュ有効時も制限が維持されることを確認するため、同じトークンで2回リクエストする
+			m, sessionManager, userRepo, _ := setupAuthMiddlewareTest(t, time.Minute)
+			admin, _ := userRepo.FindByID(context.Background(), "user1")
+			admin.IsAdmin = true
+			if err := userRepo.Update(context.Background(), admin); err != nil {
+				t.Fatalf("failed to update user: %v", err)
+			}
+
+			var session *auth.Session
+			var err error
+			if tt.scopes == nil {
+				session, err = sessionManager.CreateSession("user1")
+			} else {
+				session, err = sessionManager.CreateScopedSession("user1", tt.scopes)
+			}
+			if err != nil {
+				t.Fatalf("failed to create session: %v", err)
+			}
+
+			ok := func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) }
+			var h http.HandlerFunc
+			switch tt.wrap {
+			case wrapAuthenticate:
+				h = m.Authenticate(ok)
+			case wrapMorningCalls:
+				h = m.RequireScope(auth.ScopeMorningCallsRead, ok)
+			case wrapAdmin:
+				h = m.RequireAdmin(ok)
+			}
+
+			for i := 0; i < 2; i++ {
+				req := httptest.NewRequest(tt.method, "/", nil)
+				req.Header.Set("Authorization", "Bearer "+session.ID)
+				rec := httptest.NewRecorder()
+				h(rec, req)
+				if rec.Code != tt.want {
+					t.Fatalf("request %d: status = %d, want %d", i+1, rec.Code, tt.want)
+				}
+			}
+		})
+	}
+}
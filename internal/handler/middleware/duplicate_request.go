@@ -0,0 +1,209 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/handler"
+)
+
+// DefaultDuplicateRequestWindow は同一リクエストを重複とみなすデフォルトの期間
+const DefaultDuplicateRequestWindow = 2 * time.Second
+
+// maxDuplicateCheckBodySize は重複判定の対象にするリクエストボディの最大バイト数
+// これを超えるボディのリクエストは判定せずにそのまま処理する
+const maxDuplicateCheckBodySize = 1 << 20
+
+// DuplicateRequestHeader は重複として抑制し、最初のリクエストの結果を返したことを示すレスポンスヘッダー
+const DuplicateRequestHeader = "X-Duplicate-Request"
+
+// duplicateRequestEntry は最初のリクエストの処理状況と結果
+type duplicateRequestEntry struct {
+	receivedAt time.Time
+	done       chan struct{} // 最初のリクエストの処理が終わると閉じる
+	status     int
+	header     http.Header
+	body       []byte
+}
+
+// DuplicateRequestSuppressor はクライアントの二重送信による状態変更系リクエストの重複を抑制する
+// 同一ユーザー・同一メソッド・同一パス・同一ボディのリクエストが期間内に続いた場合、
+// 2回目以降はハンドラーを実行せず最初のリクエストの結果を返す（最初のリクエストが処理中の場合は完了を待つ）
+// べき等キーと異なりクライアントの対応は不要だが、期間は数秒程度に留めること
+type DuplicateRequestSuppressor struct {
+	window  time.Duration
+	entries map[string]*duplicateRequestEntry
+	now     func() time.Time
+	mu      sync.Mutex
+}
+
+// NewDuplicateRequestSuppressor は新しい重複リクエスト抑制を作成する
+func NewDuplicateRequestSuppressor(window time.Duration) *DuplicateRequestSuppressor {
+	return &DuplicateRequestSuppressor{
+		window:  window,
+		entries: make(map[string]*duplicateRequestEntry),
+		now:     time.Now,
+	}
+}
+
+// Wrap はハンドラーに重複リクエストの抑制を適用する
+// ユーザーは認証済みのコンテキストから取得するため、Authenticateの内側で適用すること
+// POST・PUT・PATCH・DELETE以外のメソッドと未認証のリクエストは抑制しない
+func (s *DuplicateRequestSuppressor) Wrap(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		user, ok := r.Context().Value(handler.UserContextKey).(*entity.User)
+		if !ok || !isStateChangingMethod(r.Method) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxDuplicateCheckBodySize+1))
+		if err != nil {
+			// 読み込みに失敗した場合の応答はハンドラーに任せる
+			r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+			next.ServeHTTP(w, r)
+			return
+		}
+		if len(body) > maxDuplicateCheckBodySize {
+			r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+			next.ServeHTTP(w, r)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		key := duplicateRequestKey(user.ID, r, body)
+		entry, first := s.acquire(key)
+		if !first {
+			select {
+			case <-entry.done:
+				writeDuplicateResponse(w, entry)
+			case <-r.Context().Done():
+				// クライアントが切断した場合は応答しない
+			}
+			return
+		}
+
+		rec := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+		defer func() {
+			if p := recover(); p != nil {
+				// 処理が中断された結果は再利用せず、待機中の重複リクエストにはエラーを返す
+				s.release(key, entry)
+				entry.status = http.StatusInternalServerError
+				close(entry.done)
+				panic(p)
+			}
+			entry.status = rec.status
+			entry.header = w.Header().Clone()
+			entry.body = rec.body.Bytes()
+			close(entry.done)
+		}()
+		next.ServeHTTP(rec, r)
+	}
+}
+
+// acquire はキーに対応する最初のリクエストの記録を返す
+// 期間内の記録がない場合は新しく登録し、呼び出し元が最初のリクエストであることを示すtrueを返す
+func (s *DuplicateRequestSuppressor) acquire(key string) (*duplicateRequestEntry, bool) {
+	now := s.now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if entry, exists := s.entries[key]; exists && !s.isExpired(entry, now) {
+		return entry, false
+	}
+
+	// 期間を過ぎた記録は登録のついでに破棄する
+	for k, entry := range s.entries {
+		if s.isExpired(entry, now) {
+			delete(s.entries, k)
+		}
+	}
+
+	entry := &duplicateRequestEntry{receivedAt: now, done: make(chan struct{})}
+	s.entries[key] = entry
+	return entry, true
+}
+
+// release はキーの記録が指定の記録のままであれば破棄する
+func (s *DuplicateRequestSuppressor) release(key string, entry *duplicateRequestEntry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.entries[key] == entry {
+		delete(s.entries, key)
+	}
+}
+
+// isExpired は記録が重複判定の期間を過ぎたかを判定する
+// 最初のリクエストが処理中の間は期間を過ぎても重複とみなす
+func (s *DuplicateRequestSuppressor) isExpired(entry *duplicateRequestEntry, now time.Time) bool {
+	select {
+	case <-entry.done:
+		return !now.Before(entry.receivedAt.Add(s.window))
+	default:
+		return false
+	}
+}
+
+// Len は保持している記録の数を返す（期間を過ぎて未削除のものを含む）
+func (s *DuplicateRequestSuppressor) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.entries)
+}
+
+// isStateChangingMethod は状態を変更するHTTPメソッドかを判定する
+func isStateChangingMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// duplicateRequestKey はユーザー・メソッド・パス（クエリを含む）・ボディのハッシュから重複判定のキーを作る
+func duplicateRequestKey(userID string, r *http.Request, body []byte) string {
+	bodyHash := sha256.Sum256(body)
+	return userID + "\x00" + r.Method + "\x00" + r.URL.RequestURI() + "\x00" + hex.EncodeToString(bodyHash[:])
+}
+
+// writeDuplicateResponse は最初のリクエストの結果を書き込む
+func writeDuplicateResponse(w http.ResponseWriter, entry *duplicateRequestEntry) {
+	for k, values := range entry.header {
+		w.Header()[k] = append([]string(nil), values...)
+	}
+	w.Header().Set(DuplicateRequestHeader, "true")
+	w.WriteHeader(entry.status)
+	_, _ = w.Write(entry.body)
+}
+
+// responseRecorder はレスポンスをクライアントへ書き込みつつ、ステータスとボディを記録する
+type responseRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+// WriteHeader はステータスを記録して書き込む
+func (r *responseRecorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status = status
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Write はボディを記録して書き込む
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
@@ -0,0 +1,244 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/handler"
+)
+
+// newDuplicateTestHandler は呼び出し回数をボディに含めて201を返すハンドラーを作成する
+func newDuplicateTestHandler(calls *int64) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt64(calls, 1)
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"call":%d,"body":%q}`, n, body)
+	}
+}
+
+// doDuplicateTestRequest はユーザーを認証済みとしてリクエストを送る
+func doDuplicateTestRequest(h http.HandlerFunc, userID, method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if userID != "" {
+		req = req.WithContext(context.WithValue(req.Context(), handler.UserContextKey, &entity.User{ID: userID}))
+	}
+	rec := httptest.NewRecorder()
+	h(rec, req)
+	return rec
+}
+
+func TestDuplicateRequestSuppressor_Wrap(t *testing.T) {
+	const body = `{"message":"おはよう"}`
+
+	t.Run("期間内の同一リクエストは最初の結果を返す", func(t *testing.T) {
+		var calls int64
+		h := NewDuplicateRequestSuppressor(DefaultDuplicateRequestWindow).Wrap(newDuplicateTestHandler(&calls))
+
+		first := doDuplicateTestRequest(h, "user1", http.MethodPost, "/api/v1/morning-calls", body)
+		second := doDuplicateTestRequest(h, "user1", http.MethodPost, "/api/v1/morning-calls", body)
+
+		if calls != 1 {
+			t.Errorf("handler called %d times, want 1", calls)
+		}
+		if second.Code != first.Code || second.Body.String() != first.Body.String() {
+			t.Errorf("second response = %d %s, want %d %s", second.Code, second.Body, first.Code, first.Body)
+		}
+		if second.Header().Get("Content-Type") != "application/json" || second.Header().Get(DuplicateRequestHeader) != "true" {
+			t.Errorf("second response header = %v", second.Header())
+		}
+		if first.Header().Get(DuplicateRequestHeader) != "" {
+			t.Errorf("first response has %s header", DuplicateRequestHeader)
+		}
+		// ハンドラーは元のボディを読める
+		if !strings.Contains(first.Body.String(), "おはよう") {
+			t.Errorf("handler did not receive the request body: %s", first.Body)
+		}
+	})
+
+	t.Run("異なるリクエストは抑制しない", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			userID string
+			method string
+			path   string
+			body   string
+		}{
+			{name: "ボディが異なる", userID: "user1", method: http.MethodPost, path: "/api/v1/morning-calls", body: `{"message":"起きて"}`},
+			{name: "ユーザーが異なる", userID: "user2", method: http.MethodPost, path: "/api/v1/morning-calls", body: body},
+			{name: "メソッドが異なる", userID: "user1", method: http.MethodPut, path: "/api/v1/morning-calls", body: body},
+			{name: "パスが異なる", userID: "user1", method: http.MethodPost, path: "/api/v1/morning-calls/series", body: body},
+			{name: "クエリが異なる", userID: "user1", method: http.MethodPost, path: "/api/v1/morning-calls?dry_run=true", body: body},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				var calls int64
+				h := NewDuplicateRequestSuppressor(DefaultDuplicateRequestWindow).Wrap(newDuplicateTestHandler(&calls))
+
+				doDuplicateTestRequest(h, "user1", http.MethodPost, "/api/v1/morning-calls", body)
+				rec := doDuplicateTestRequest(h, tt.userID, tt.method, tt.path, tt.body)
+
+				if calls != 2 {
+					t.Errorf("handler called %d times, want 2", calls)
+				}
+				if rec.Header().Get(DuplicateRequestHeader) != "" {
+					t.Errorf("response has %s header", DuplicateRequestHeader)
+				}
+			})
+		}
+	})
+
+	t.Run("状態を変更しないメソッドと未認証のリクエストは抑制しない", func(t *testing.T) {
+		for _, tt := range []struct {
+			userID string
+			method string
+		}{
+			{userID: "user1", method: http.MethodGet},
+			{userID: "", method: http.MethodPost},
+		} {
+			var calls int64
+			h := NewDuplicateRequestSuppressor(DefaultDuplicateRequestWindow).Wrap(newDuplicateTestHandler(&calls))
+
+			doDuplicateTestRequest(h, tt.userID, tt.method, "/api/v1/morning-calls", "")
+			doDuplicateTestRequest(h, tt.userID, tt.method, "/api/v1/morning-calls", "")
+			if calls != 2 {
+				t.Errorf("%s (user=%q): handler called %d times, want 2", tt.method, tt.userID, calls)
+			}
+		}
+	})
+
+	t.Run("抑制期間の境界", func(t *testing.T) {
+		window := 2 * time.Second
+		tests := []struct {
+			name       string
+			elapsed    time.Duration
+			wantCalled int64
+		}{
+			{name: "期間の1ナノ秒前は抑制する", elapsed: window - time.Nanosecond, wantCalled: 1},
+			{name: "期間ちょうどは抑制しない", elapsed: window, wantCalled: 2},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				var calls int64
+				now := time.Date(2026, 3, 15, 7, 0, 0, 0, time.UTC)
+				s := NewDuplicateRequestSuppressor(window)
+				s.now = func() time.Time { return now }
+				h := s.Wrap(newDuplicateTestHandler(&calls))
+
+				doDuplicateTestRequest(h, "user1", http.MethodDelete, "/api/v1/morning-calls/mc1", "")
+				now = now.Add(tt.elapsed)
+				doDuplicateTestRequest(h, "user1", http.MethodDelete, "/api/v1/morning-calls/mc1", "")
+
+				if calls != tt.wantCalled {
+					t.Errorf("handler called %d times, want %d", calls, tt.wantCalled)
+				}
+				// 期間を過ぎた記録は破棄される
+				if tt.wantCalled == 2 && s.Len() != 1 {
+					t.Errorf("Len() = %d, want 1", s.Len())
+				}
+			})
+		}
+	})
+
+	t.Run("処理中の最初のリクエストの完了を待って結果を返す", func(t *testing.T) {
+		var calls int64
+		started := make(chan struct{})
+		release := make(chan struct{})
+		h := NewDuplicateRequestSuppressor(DefaultDuplicateRequestWindow).Wrap(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt64(&calls, 1)
+			close(started)
+			<-release
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte("created"))
+		})
+
+		var wg sync.WaitGroup
+		responses := make([]*httptest.ResponseRecorder, 2)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			responses[0] = doDuplicateTestRequest(h, "user1", http.MethodPost, "/api/v1/relationships/request", body)
+		}()
+		<-started
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			responses[1] = doDuplicateTestRequest(h, "user1", http.MethodPost, "/api/v1/relationships/request", body)
+		}()
+		// 最初のリクエストが処理中の間、重複は完了を待つ
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		if calls != 1 {
+			t.Errorf("handler called %d times, want 1", calls)
+		}
+		for i, rec := range responses {
+			if rec.Code != http.StatusCreated || rec.Body.String() != "created" {
+				t.Errorf("responses[%d] = %d %s, want 201 created", i, rec.Code, rec.Body)
+			}
+		}
+	})
+
+	t.Run("処理中にパニックした結果は再利用しない", func(t *testing.T) {
+		var calls int64
+		s := NewDuplicateRequestSuppressor(DefaultDuplicateRequestWindow)
+		h := s.Wrap(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt64(&calls, 1) == 1 {
+				panic("boom")
+			}
+			w.WriteHeader(http.StatusCreated)
+		})
+
+		func() {
+			defer func() { _ = recover() }()
+			doDuplicateTestRequest(h, "user1", http.MethodPost, "/api/v1/morning-calls", body)
+		}()
+		rec := doDuplicateTestRequest(h, "user1", http.MethodPost, "/api/v1/morning-calls", body)
+
+		if calls != 2 || rec.Code != http.StatusCreated {
+			t.Errorf("calls = %d, status = %d, want 2 calls and 201", calls, rec.Code)
+		}
+	})
+}
+
+func TestAuthMiddleware_SuppressDuplicateRequests(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		window    time.Duration
+		wantCalls int64
+	}{
+		{name: "有効な場合は重複を抑制する", window: DefaultDuplicateRequestWindow, wantCalls: 1},
+		{name: "期間が0の場合は抑制しない", window: 0, wantCalls: 2},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			m, _, _, sessionID := setupAuthMiddlewareTest(t, 0)
+			m.SuppressDuplicateRequests(tt.window)
+
+			var calls int64
+			h := m.Authenticate(newDuplicateTestHandler(&calls))
+			for range 2 {
+				req := httptest.NewRequest(http.MethodPost, "/api/v1/morning-calls", strings.NewReader(`{}`))
+				req.Header.Set("X-Session-ID", sessionID)
+				rec := httptest.NewRecorder()
+				h(rec, req)
+				if rec.Code != http.StatusCreated {
+					t.Fatalf("status = %d, want 201", rec.Code)
+				}
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("handler called %d times, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
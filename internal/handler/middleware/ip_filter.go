@@ -0,0 +1,147 @@
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/ochamu/morning-call-api/internal/handler"
+	"github.com/ochamu/morning-call-api/pkg/utils"
+)
+
+// IPFilterMiddleware は接続元IPアドレスのCIDRによる許可リスト・拒否リストでリクエストを制限するミドルウェア
+// 拒否リストに一致するアドレスは常に拒否し、許可リストが空でない場合は許可リストに一致するアドレスのみ許可する
+// IPv4射影IPv6アドレス（::ffff:192.0.2.1）はIPv4アドレスとして照合する
+type IPFilterMiddleware struct {
+	allowed        []netip.Prefix
+	denied         []netip.Prefix
+	trustedProxies []netip.Prefix // X-Forwarded-Forを信頼するプロキシ（空の場合はヘッダーを使用しない）
+	baseHandler    *handler.BaseHandler
+}
+
+// NewIPFilterMiddleware は新しいIPフィルターミドルウェアを作成する
+// 各リストはCIDR表記（例: 10.0.0.0/8, 2001:db8::/32）で指定し、不正な値が含まれる場合はエラーを返す
+func NewIPFilterMiddleware(allowed, denied, trustedProxies []string) (*IPFilterMiddleware, error) {
+	allowedPrefixes, err := ParseCIDRs(allowed)
+	if err != nil {
+		return nil, fmt.Errorf("許可リストが不正です: %w", err)
+	}
+	deniedPrefixes, err := ParseCIDRs(denied)
+	if err != nil {
+		return nil, fmt.Errorf("拒否リストが不正です: %w", err)
+	}
+	proxyPrefixes, err := ParseCIDRs(trustedProxies)
+	if err != nil {
+		return nil, fmt.Errorf("信頼するプロキシのリストが不正です: %w", err)
+	}
+	return &IPFilterMiddleware{
+		allowed:        allowedPrefixes,
+		denied:         deniedPrefixes,
+		trustedProxies: proxyPrefixes,
+		baseHandler:    handler.NewBaseHandler(),
+	}, nil
+}
+
+// ParseCIDRs はCIDR表記のリストを解析する
+func ParseCIDRs(cidrs []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(strings.TrimSpace(cidr))
+		if err != nil {
+			return nil, fmt.Errorf("無効なCIDR: %s", cidr)
+		}
+		if prefix.Addr().Is4In6() {
+			// ::ffff:0:0/96 以下の範囲はIPv4の範囲として扱う
+			if prefix.Bits() < 96 {
+				return nil, fmt.Errorf("無効なCIDR: %s", cidr)
+			}
+			prefix = netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()-96)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes, nil
+}
+
+// Wrap はハンドラーにIPアドレスによる制限を適用する
+// 許可されないアドレスや、接続元のアドレスを判別できないリクエストには403を返す
+// レシーバーがnilの場合は制限しない
+func (m *IPFilterMiddleware) Wrap(next http.HandlerFunc) http.HandlerFunc {
+	if m == nil {
+		return next
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		ip, ok := m.ClientIP(r)
+		if !ok || !m.Allowed(ip) {
+			utils.Logf(r.Context(), "[WARN] [IP_FILTER] denied %s %s from %s (remote %s)", r.Method, r.URL.Path, ip, r.RemoteAddr)
+			m.baseHandler.SendForbiddenError(w)
+			return
+		}
+		next.ServeHTTP(w, r)
+	}
+}
+
+// Allowed はアドレスが許可リスト・拒否リストの条件で許可されるかを判定する
+func (m *IPFilterMiddleware) Allowed(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if containsAddr(m.denied, ip) {
+		return false
+	}
+	return len(m.allowed) == 0 || containsAddr(m.allowed, ip)
+}
+
+// ClientIP はリクエストの接続元IPアドレスを返す
+// 直接の接続元が信頼するプロキシの場合に限り、X-Forwarded-Forを右から辿って
+// 信頼するプロキシ以外で最初に現れるアドレスを接続元とする（それより左の値はクライアントが偽装できるため使用しない）
+func (m *IPFilterMiddleware) ClientIP(r *http.Request) (netip.Addr, bool) {
+	remote, ok := parseRemoteAddr(r.RemoteAddr)
+	if !ok {
+		return netip.Addr{}, false
+	}
+	if !containsAddr(m.trustedProxies, remote) {
+		return remote, true
+	}
+
+	forwarded := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	client := remote
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		value := strings.TrimSpace(forwarded[i])
+		if value == "" {
+			continue
+		}
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			// 不正な値を含む場合は接続元を判別できない
+			return netip.Addr{}, false
+		}
+		client = addr.Unmap().WithZone("")
+		if !containsAddr(m.trustedProxies, client) {
+			break
+		}
+	}
+	return client, true
+}
+
+// parseRemoteAddr はRemoteAddr（host:port または host）からIPアドレスを取り出す
+func parseRemoteAddr(remoteAddr string) (netip.Addr, bool) {
+	host := remoteAddr
+	if h, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		host = h
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.Unmap().WithZone(""), true
+}
+
+// containsAddr はアドレスがいずれかの範囲に含まれるかを判定する
+func containsAddr(prefixes []netip.Prefix, addr netip.Addr) bool {
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,125 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+)
+
+func TestIPFilterMiddleware_Allowed(t *testing.T) {
+	filter, err := NewIPFilterMiddleware(
+		[]string{"10.0.0.0/8", "192.0.2.10/32", "2001:db8::/32"},
+		[]string{"10.1.0.0/16", "2001:db8:bad::/48"},
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{"10.2.3.4", true},
+		{"192.0.2.10", true},
+		{"192.0.2.11", false},
+		{"10.1.2.3", false}, // 拒否リストが許可リストより優先
+		{"::ffff:10.2.3.4", true},
+		{"::ffff:10.1.2.3", false},
+		{"2001:db8:1::1", true},
+		{"2001:db8:bad::1", false},
+		{"2001:db9::1", false},
+		{"::1", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			if got := filter.Allowed(netip.MustParseAddr(tt.ip)); got != tt.want {
+				t.Errorf("Allowed(%s) = %v, want %v", tt.ip, got, tt.want)
+			}
+		})
+	}
+
+	// 許可リストが空の場合は拒否リスト以外を許可する
+	denyOnly, err := NewIPFilterMiddleware(nil, []string{"203.0.113.0/24", "::ffff:198.51.100.0/120"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for ip, want := range map[string]bool{"8.8.8.8": true, "2001:db8::1": true, "203.0.113.5": false, "198.51.100.7": false} {
+		if got := denyOnly.Allowed(netip.MustParseAddr(ip)); got != want {
+			t.Errorf("deny only: Allowed(%s) = %v, want %v", ip, got, want)
+		}
+	}
+}
+
+func TestNewIPFilterMiddleware_InvalidCIDR(t *testing.T) {
+	for _, lists := range [][3][]string{
+		{{"10.0.0.1"}, nil, nil},
+		{nil, {"2001:db8::/129"}, nil},
+		{nil, nil, {"proxy"}},
+	} {
+		if _, err := NewIPFilterMiddleware(lists[0], lists[1], lists[2]); err == nil {
+			t.Errorf("NewIPFilterMiddleware(%v) expected error", lists)
+		}
+	}
+}
+
+func TestIPFilterMiddleware_Wrap(t *testing.T) {
+	filter, err := NewIPFilterMiddleware(
+		[]string{"192.0.2.0/24", "2001:db8::/32"},
+		nil,
+		[]string{"10.0.0.0/8", "fd00::/8"},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	h := filter.Wrap(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor []string
+		wantStatus   int
+	}{
+		{name: "許可されたIPv4からの直接接続", remoteAddr: "192.0.2.5:51000", wantStatus: http.StatusOK},
+		{name: "許可されたIPv6からの直接接続", remoteAddr: "[2001:db8::5]:51000", wantStatus: http.StatusOK},
+		{name: "許可されていないIPv4からの直接接続", remoteAddr: "198.51.100.5:51000", wantStatus: http.StatusForbidden},
+		{name: "許可されていないIPv6からの直接接続", remoteAddr: "[2001:db9::5]:51000", wantStatus: http.StatusForbidden},
+		{name: "信頼しない接続元のX-Forwarded-Forは使用しない", remoteAddr: "198.51.100.5:51000", forwardedFor: []string{"192.0.2.5"}, wantStatus: http.StatusForbidden},
+		{name: "信頼するプロキシ経由のIPv4クライアント", remoteAddr: "10.0.0.2:51000", forwardedFor: []string{"192.0.2.5"}, wantStatus: http.StatusOK},
+		{name: "信頼するプロキシ経由のIPv6クライアント", remoteAddr: "[fd00::2]:51000", forwardedFor: []string{"2001:db8::5"}, wantStatus: http.StatusOK},
+		{name: "多段の信頼するプロキシを辿る", remoteAddr: "10.0.0.2:51000", forwardedFor: []string{"192.0.2.5, fd00::3", "10.0.0.9"}, wantStatus: http.StatusOK},
+		{name: "クライアントが付けた左側の値は使用しない", remoteAddr: "10.0.0.2:51000", forwardedFor: []string{"192.0.2.5, 198.51.100.5"}, wantStatus: http.StatusForbidden},
+		{name: "信頼するプロキシ自身は許可リストで判定する", remoteAddr: "10.0.0.2:51000", wantStatus: http.StatusForbidden},
+		{name: "不正なX-Forwarded-For", remoteAddr: "10.0.0.2:51000", forwardedFor: []string{"unknown"}, wantStatus: http.StatusForbidden},
+		{name: "不正な接続元", remoteAddr: "invalid", wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/stats", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for _, v := range tt.forwardedFor {
+				req.Header.Add("X-Forwarded-For", v)
+			}
+			rec := httptest.NewRecorder()
+			h(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+		})
+	}
+
+	// nilの場合は制限しない
+	var disabled *IPFilterMiddleware
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/stats", nil)
+	req.RemoteAddr = "198.51.100.5:51000"
+	disabled.Wrap(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) })(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Errorf("nil filter status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+)
+
+// DefaultSessionCacheTTL はセッション検証結果をキャッシュするデフォルトの期間
+const DefaultSessionCacheTTL = 5 * time.Second
+
+// sessionCacheEntry はキャッシュしたセッション検証結果
+type sessionCacheEntry struct {
+	user      *entity.User
+	expiresAt time.Time
+}
+
+// SessionCache はセッションIDごとの検証結果（認証済みユーザー）を短時間保持するキャッシュ
+// ユーザー情報の変更はTTLの間反映されないため、TTLは数秒程度に留めること
+type SessionCache struct {
+	ttl     time.Duration
+	entries map[string]sessionCacheEntry
+	now     func() time.Time
+	mu      sync.RWMutex
+}
+
+// NewSessionCache は新しいセッション検証結果キャッシュを作成する
+func NewSessionCache(ttl time.Duration) *SessionCache {
+	return &SessionCache{
+		ttl:     ttl,
+		entries: make(map[string]sessionCacheEntry),
+		now:     time.Now,
+	}
+}
+
+// Get はキャッシュ済みのユーザーを取得する（期限切れや未登録の場合はfalse）
+func (c *SessionCache) Get(sessionID string) (*entity.User, bool) {
+	c.mu.RLock()
+	entry, exists := c.entries[sessionID]
+	c.mu.RUnlock()
+
+	if !exists {
+		return nil, false
+	}
+	if !c.now().Before(entry.expiresAt) {
+		c.mu.Lock()
+		// 取得後に再登録された場合は削除しない
+		if current, ok := c.entries[sessionID]; ok && current.expiresAt.Equal(entry.expiresAt) {
+			delete(c.entries, sessionID)
+		}
+		c.mu.Unlock()
+		return nil, false
+	}
+
+	// 呼び出し側での変更がキャッシュに影響しないようディープコピーを返す
+	return entry.user.Clone(), true
+}
+
+// Set はセッションの検証結果をキャッシュする
+// キャッシュの期限はTTLとセッション自体の有効期限の早い方になる
+func (c *SessionCache) Set(sessionID string, user *entity.User, sessionExpiresAt time.Time) {
+	if user == nil {
+		return
+	}
+
+	expiresAt := c.now().Add(c.ttl)
+	if sessionExpiresAt.Before(expiresAt) {
+		expiresAt = sessionExpiresAt
+	}
+
+	// 呼び出し側で後から変更してもキャッシュに影響しないようディープコピーを保持する
+	userCopy := user.Clone()
+	c.mu.Lock()
+	c.entries[sessionID] = sessionCacheEntry{user: userCopy, expiresAt: expiresAt}
+	c.mu.Unlock()
+}
+
+// Invalidate はセッションのキャッシュを破棄する
+func (c *SessionCache) Invalidate(sessionID string) {
+	c.mu.Lock()
+	delete(c.entries, sessionID)
+	c.mu.Unlock()
+}
+
+// Len はキャッシュしているセッション数を返す（期限切れで未削除のものを含む）
+func (c *SessionCache) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.entries)
+}
@@ -1,27 +1,87 @@
 package handler
 
 import (
-	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/ochamu/morning-call-api/internal/domain/entity"
 	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/handler/dto"
 	"github.com/ochamu/morning-call-api/internal/handler/dto/request"
 	"github.com/ochamu/morning-call-api/internal/handler/dto/response"
 	"github.com/ochamu/morning-call-api/internal/infrastructure/auth"
 	mcCreate "github.com/ochamu/morning-call-api/internal/usecase/morning_call"
 )
 
+// morningCallUnknownFieldPolicy はfieldsクエリに存在しないフィールドが指定された場合の扱い
+const morningCallUnknownFieldPolicy = dto.UnknownFieldReject
+
+// モーニングコール一覧のページネーション（ListUseCaseのデフォルト値・上限と揃える）
+const (
+	defaultMorningCallListLimit = 20
+	maxMorningCallListLimit     = 100
+)
+
+// morningCallStatusQueryValues は一覧のstatusクエリで指定できるステータス
+var morningCallStatusQueryValues = []valueobject.MorningCallStatus{
+	valueobject.MorningCallStatusPendingApproval,
+	valueobject.MorningCallStatusScheduled,
+	valueobject.MorningCallStatusDelivered,
+	valueobject.MorningCallStatusConfirmed,
+	valueobject.MorningCallStatusCancelled,
+	valueobject.MorningCallStatusExpired,
+	valueobject.MorningCallStatusSkipped,
+	valueobject.MorningCallStatusRejected,
+}
+
+// morningCallFieldNames はfieldsクエリで指定できるモーニングコールのフィールド名
+var morningCallFieldNames = dto.JSONFieldNames(response.MorningCallResponse{})
+
 // MorningCallHandler はモーニングコール関連のHTTPハンドラー
 type MorningCallHandler struct {
 	*BaseHandler
-	createUseCase      *mcCreate.CreateUseCase
-	updateUseCase      *mcCreate.UpdateUseCase
-	deleteUseCase      *mcCreate.DeleteUseCase
-	listUseCase        *mcCreate.ListUseCase
-	confirmWakeUseCase *mcCreate.ConfirmWakeUseCase
-	sessionManager     *auth.SessionManager
+	createUseCase              *mcCreate.CreateUseCase
+	updateUseCase              *mcCreate.UpdateUseCase
+	deleteUseCase              *mcCreate.DeleteUseCase
+	listUseCase                *mcCreate.ListUseCase
+	confirmWakeUseCase         *mcCreate.ConfirmWakeUseCase
+	conflictsUseCase           *mcCreate.FindScheduleConflictsUseCase
+	validateMsgUseCase         *mcCreate.ValidateMessageUseCase
+	sendStampUseCase           *mcCreate.SendStampUseCase
+	frequentUseCase            *mcCreate.FrequentReceiversUseCase
+	skipUseCase                *mcCreate.SkipUseCase
+	unconfirmedUseCase         *mcCreate.UnconfirmedCountUseCase
+	setOffsetUseCase           *mcCreate.SetReceiverOffsetUseCase
+	patchUseCase               *mcCreate.PatchUseCase
+	approveUseCase             *mcCreate.ApproveCallUseCase
+	rejectUseCase              *mcCreate.RejectCallUseCase
+	setSilentUseCase           *mcCreate.SetSilentDeliveryUseCase
+	createSeriesUC             *mcCreate.CreateSeriesUseCase
+	listSeriesUC               *mcCreate.ListSeriesUseCase
+	cancelSeriesUC             *mcCreate.CancelSeriesUseCase
+	updateSeriesUC             *mcCreate.UpdateSeriesUseCase
+	leaderboardUC              *mcCreate.LeaderboardUseCase
+	getUseCase                 *mcCreate.GetUseCase
+	accessLogUseCase           *mcCreate.ListAccessLogUseCase
+	archiveUseCase             *mcCreate.ArchiveUseCase
+	cancelUseCase              *mcCreate.CancelUseCase
+	rescheduleUseCase          *mcCreate.RequestRescheduleUseCase
+	respondUseCase             *mcCreate.RespondRescheduleUseCase
+	respondNextCallUC          *mcCreate.RespondNextCallUseCase
+	heatmapUseCase             *mcCreate.WakeHeatmapUseCase
+	systemMsgUseCase           *mcCreate.ListSystemMessagesUseCase
+	rateUseCase                *mcCreate.RateMorningCallUseCase
+	ratingStatsUseCase         *mcCreate.RatingStatsUseCase
+	confirmTimeStatsUseCase    *mcCreate.ConfirmTimeStatsUseCase
+	messageVariantStatsUseCase *mcCreate.MessageVariantStatsUseCase
+	deliveryHintsUC            *mcCreate.SetDeliveryHintsUseCase
+	reportUseCase              *mcCreate.GenerateReportUseCase
+	addCommentUC               *mcCreate.AddCommentUseCase
+	listCommentsUC             *mcCreate.ListCommentsUseCase
+	sessionManager             *auth.SessionManager
 }
 
 // NewMorningCallHandler は新しいMorningCallHandlerを作成する
@@ -31,16 +91,82 @@ func NewMorningCallHandler(
 	deleteUC *mcCreate.DeleteUseCase,
 	listUC *mcCreate.ListUseCase,
 	confirmWakeUC *mcCreate.ConfirmWakeUseCase,
+	conflictsUC *mcCreate.FindScheduleConflictsUseCase,
+	validateMsgUC *mcCreate.ValidateMessageUseCase,
+	sendStampUC *mcCreate.SendStampUseCase,
+	frequentUC *mcCreate.FrequentReceiversUseCase,
+	skipUC *mcCreate.SkipUseCase,
+	unconfirmedUC *mcCreate.UnconfirmedCountUseCase,
+	setOffsetUC *mcCreate.SetReceiverOffsetUseCase,
+	patchUC *mcCreate.PatchUseCase,
+	approveUC *mcCreate.ApproveCallUseCase,
+	rejectUC *mcCreate.RejectCallUseCase,
+	setSilentUC *mcCreate.SetSilentDeliveryUseCase,
+	createSeriesUC *mcCreate.CreateSeriesUseCase,
+	listSeriesUC *mcCreate.ListSeriesUseCase,
+	cancelSeriesUC *mcCreate.CancelSeriesUseCase,
+	updateSeriesUC *mcCreate.UpdateSeriesUseCase,
+	leaderboardUC *mcCreate.LeaderboardUseCase,
+	getUC *mcCreate.GetUseCase,
+	accessLogUC *mcCreate.ListAccessLogUseCase,
+	archiveUC *mcCreate.ArchiveUseCase,
+	cancelUC *mcCreate.CancelUseCase,
+	rescheduleUC *mcCreate.RequestRescheduleUseCase,
+	respondUC *mcCreate.RespondRescheduleUseCase,
+	respondNextCallUC *mcCreate.RespondNextCallUseCase,
+	heatmapUC *mcCreate.WakeHeatmapUseCase,
+	systemMsgUC *mcCreate.ListSystemMessagesUseCase,
+	rateUC *mcCreate.RateMorningCallUseCase,
+	ratingStatsUC *mcCreate.RatingStatsUseCase,
+	confirmTimeStatsUC *mcCreate.ConfirmTimeStatsUseCase,
+	messageVariantStatsUC *mcCreate.MessageVariantStatsUseCase,
+	deliveryHintsUC *mcCreate.SetDeliveryHintsUseCase,
+	reportUC *mcCreate.GenerateReportUseCase,
+	addCommentUC *mcCreate.AddCommentUseCase,
+	listCommentsUC *mcCreate.ListCommentsUseCase,
 	sessionManager *auth.SessionManager,
 ) *MorningCallHandler {
 	return &MorningCallHandler{
-		BaseHandler:        &BaseHandler{},
-		createUseCase:      createUC,
-		updateUseCase:      updateUC,
-		deleteUseCase:      deleteUC,
-		listUseCase:        listUC,
-		confirmWakeUseCase: confirmWakeUC,
-		sessionManager:     sessionManager,
+		BaseHandler:                &BaseHandler{},
+		createUseCase:              createUC,
+		updateUseCase:              updateUC,
+		deleteUseCase:              deleteUC,
+		listUseCase:                listUC,
+		confirmWakeUseCase:         confirmWakeUC,
+		conflictsUseCase:           conflictsUC,
+		validateMsgUseCase:         validateMsgUC,
+		sendStampUseCase:           sendStampUC,
+		frequentUseCase:            frequentUC,
+		skipUseCase:                skipUC,
+		unconfirmedUseCase:         unconfirmedUC,
+		setOffsetUseCase:           setOffsetUC,
+		patchUseCase:               patchUC,
+		approveUseCase:             approveUC,
+		rejectUseCase:              rejectUC,
+		setSilentUseCase:           setSilentUC,
+		createSeriesUC:             createSeriesUC,
+		listSeriesUC:               listSeriesUC,
+		cancelSeriesUC:             cancelSeriesUC,
+		updateSeriesUC:             updateSeriesUC,
+		leaderboardUC:              leaderboardUC,
+		getUseCase:                 getUC,
+		accessLogUseCase:           accessLogUC,
+		archiveUseCase:             archiveUC,
+		cancelUseCase:              cancelUC,
+		rescheduleUseCase:          rescheduleUC,
+		respondUseCase:             respondUC,
+		respondNextCallUC:          respondNextCallUC,
+		heatmapUseCase:             heatmapUC,
+		systemMsgUseCase:           systemMsgUC,
+		rateUseCase:                rateUC,
+		ratingStatsUseCase:         ratingStatsUC,
+		confirmTimeStatsUseCase:    confirmTimeStatsUC,
+		messageVariantStatsUseCase: messageVariantStatsUC,
+		deliveryHintsUC:            deliveryHintsUC,
+		reportUseCase:              reportUC,
+		addCommentUC:               addCommentUC,
+		listCommentsUC:             listCommentsUC,
+		sessionManager:             sessionManager,
 	}
 }
 
@@ -55,30 +181,221 @@ func (h *MorningCallHandler) HandleCreate(w http.ResponseWriter, r *http.Request
 
 	// リクエストボディのパース
 	var req request.CreateMorningCallRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.SendError(w, http.StatusBadRequest, "PARSE_ERROR", "リクエストのパースに失敗しました", nil)
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "PARSE_ERROR", err)
+		return
+	}
+
+	// 相対指定のアラーム時刻を解析
+	relativeSchedule, validationErrs := req.ParseRelativeSchedule()
+	if len(validationErrs) > 0 {
+		var validationErrors []ValidationError
+		for field, message := range validationErrs {
+			validationErrors = append(validationErrors, ValidationError{Field: field, Message: message})
+		}
+		h.SendValidationError(w, validationErrors)
 		return
 	}
 
 	// UseCaseの実行
 	input := mcCreate.CreateInput{
-		SenderID:      user.ID,
-		ReceiverID:    req.ReceiverID,
-		ScheduledTime: req.ScheduledTime,
-		Message:       req.Message,
+		SenderID:         user.ID,
+		ReceiverID:       req.ReceiverID,
+		ScheduledTime:    req.ScheduledTime,
+		RelativeSchedule: relativeSchedule,
+		Message:          req.Message,
+		ConfirmDeadline:  req.ConfirmDeadline,
+
+		SystemMessageID:       req.SystemMessageID,
+		SystemMessageLanguage: req.SystemMessageLanguage,
+
+		SelfCall: req.SelfCall,
+
+		ChallengeDifficulty: valueobject.ChallengeDifficulty(req.ChallengeDifficulty),
+
+		MessageVariants:        req.MessageVariants,
+		MessageVariantStrategy: valueobject.MessageVariantStrategy(req.MessageVariantStrategy),
 	}
 
 	output, err := h.createUseCase.Execute(r.Context(), input)
 	if err != nil {
-		h.SendError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
+		h.SendMappedError(w, err)
 		return
 	}
 
-	// レスポンスの作成
-	resp := h.convertToMorningCallResponse(output.MorningCall)
+	// レスポンスの作成（承認待ちになった場合は送信者への案内を含める）
+	resp := response.CreateMorningCallResponse{
+		MorningCallResponse: h.convertToMorningCallResponse(output.MorningCall, user),
+		AwaitingApproval:    output.AwaitingApproval,
+
+		ScheduleAdjustmentMinutes: int(output.ScheduleAdjustment / time.Minute),
+	}
+	if output.AwaitingApproval {
+		resp.Approval = &response.ApprovalFeedbackResponse{
+			ApproveBy: *output.ApprovalDeadline,
+			Notice:    "受信者がモーニングコールの承認制を有効にしています。アラーム時刻までに承認されないと配信されないため、受信者に承認を依頼してください",
+		}
+	}
+	h.SendJSON(w, http.StatusCreated, resp)
+}
+
+// HandleCreateSeries はモーニングコールのシリーズ作成のハンドラー
+// POST /api/v1/morning-calls/series
+func (h *MorningCallHandler) HandleCreateSeries(w http.ResponseWriter, r *http.Request) {
+	// POSTメソッドのみ許可
+	if r.Method != http.MethodPost {
+		h.SendMethodNotAllowed(w, http.MethodPost)
+		return
+	}
+
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// リクエストボディのパース
+	var req request.CreateMorningCallSeriesRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "PARSE_ERROR", err)
+		return
+	}
+
+	schedule, validationErrs := req.ParseSchedule()
+	if len(validationErrs) > 0 {
+		var validationErrors []ValidationError
+		for field, message := range validationErrs {
+			validationErrors = append(validationErrors, ValidationError{Field: field, Message: message})
+		}
+		h.SendValidationError(w, validationErrors)
+		return
+	}
+
+	output, err := h.createSeriesUC.Execute(r.Context(), mcCreate.CreateSeriesInput{
+		SenderID:   user.ID,
+		ReceiverID: req.ReceiverID,
+		Schedule:   schedule,
+		Message:    req.Message,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// 一部のコールを作成できなかった場合も作成できた分はシリーズとして返す
+	resp := h.convertToSeriesResponse(output.SeriesID, output.MorningCalls, user)
+	resp.Failures = make([]response.SeriesFailureResponse, 0, len(output.Failures))
+	for _, f := range output.Failures {
+		resp.Failures = append(resp.Failures, response.SeriesFailureResponse{
+			ScheduledTime: f.ScheduledTime,
+			Reason:        f.Reason,
+		})
+	}
 	h.SendJSON(w, http.StatusCreated, resp)
 }
 
+// HandleSeries はシリーズ単位の操作のハンドラー
+// GET /api/v1/morning-calls/series/{seriesID} で一覧を取得し、
+// PUT /api/v1/morning-calls/series/{seriesID} でスケジュール済みのコールのメッセージをまとめて更新し、
+// PUT /api/v1/morning-calls/series/{seriesID}/cancel で配信前のコールをまとめてキャンセルする
+// 一括操作で対象外になったコールはskippedに理由とともに含める
+func (h *MorningCallHandler) HandleSeries(w http.ResponseWriter, r *http.Request) {
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/morning-calls/series/"), "/")
+	seriesID := parts[0]
+	if seriesID == "" || len(parts) > 2 || (len(parts) == 2 && parts[1] != "cancel") {
+		h.SendEndpointNotFound(w)
+		return
+	}
+
+	if len(parts) == 2 {
+		if r.Method != http.MethodPut {
+			h.SendMethodNotAllowed(w, http.MethodPut)
+			return
+		}
+		output, err := h.cancelSeriesUC.Execute(r.Context(), mcCreate.CancelSeriesInput{
+			SeriesID: seriesID,
+			SenderID: user.ID,
+		})
+		if err != nil {
+			h.SendMappedError(w, err)
+			return
+		}
+		resp := h.convertToSeriesResponse(seriesID, output.MorningCalls, user)
+		resp.Cancelled = output.Cancelled
+		resp.Skipped = convertToSeriesSkipResponses(output.Skipped)
+		h.SendJSON(w, http.StatusOK, resp)
+		return
+	}
+
+	if r.Method == http.MethodPut {
+		var req request.UpdateMorningCallSeriesRequest
+		if err := h.ParseJSON(r, &req); err != nil {
+			h.SendJSONDecodeError(w, "PARSE_ERROR", err)
+			return
+		}
+		output, err := h.updateSeriesUC.Execute(r.Context(), mcCreate.UpdateSeriesInput{
+			SeriesID: seriesID,
+			SenderID: user.ID,
+			Message:  req.Message,
+		})
+		if err != nil {
+			h.SendMappedError(w, err)
+			return
+		}
+		resp := h.convertToSeriesResponse(seriesID, output.MorningCalls, user)
+		resp.Updated = output.Updated
+		resp.Skipped = convertToSeriesSkipResponses(output.Skipped)
+		h.SendJSON(w, http.StatusOK, resp)
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		h.SendMethodNotAllowed(w, http.MethodGet, http.MethodPut)
+		return
+	}
+	output, err := h.listSeriesUC.Execute(r.Context(), mcCreate.ListSeriesInput{
+		SeriesID: seriesID,
+		UserID:   user.ID,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+	h.SendJSON(w, http.StatusOK, h.convertToSeriesResponse(seriesID, output.MorningCalls, user))
+}
+
+// convertToSeriesResponse はシリーズのコールをレスポンスDTOに変換する
+func (h *MorningCallHandler) convertToSeriesResponse(seriesID string, morningCalls []*entity.MorningCall, viewer *entity.User) response.MorningCallSeriesResponse {
+	calls := make([]response.MorningCallResponse, 0, len(morningCalls))
+	for _, mc := range morningCalls {
+		calls = append(calls, h.convertToMorningCallResponse(mc, viewer))
+	}
+	return response.MorningCallSeriesResponse{
+		SeriesID:     seriesID,
+		MorningCalls: calls,
+	}
+}
+
+// convertToSeriesSkipResponses は一括操作で対象外になったコールをレスポンスDTOに変換する
+func convertToSeriesSkipResponses(skipped []mcCreate.SeriesSkip) []response.SeriesSkipResponse {
+	result := make([]response.SeriesSkipResponse, 0, len(skipped))
+	for _, s := range skipped {
+		result = append(result, response.SeriesSkipResponse{
+			MorningCallID: s.MorningCallID,
+			Reason:        s.Reason,
+		})
+	}
+	return result
+}
+
 // HandleUpdate はモーニングコール更新のハンドラー
 func (h *MorningCallHandler) HandleUpdate(w http.ResponseWriter, r *http.Request) {
 	// 認証チェック
@@ -89,16 +406,15 @@ func (h *MorningCallHandler) HandleUpdate(w http.ResponseWriter, r *http.Request
 	}
 
 	// コンテキストからIDを取得
-	morningCallID, ok := r.Context().Value("morningCallID").(string)
-	if !ok || morningCallID == "" {
-		h.SendError(w, http.StatusBadRequest, "INVALID_REQUEST", "モーニングコールIDが指定されていません", nil)
+	morningCallID, ok := h.requireMorningCallID(w, r)
+	if !ok {
 		return
 	}
 
 	// リクエストボディのパース
 	var req request.UpdateMorningCallRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.SendError(w, http.StatusBadRequest, "PARSE_ERROR", "リクエストのパースに失敗しました", nil)
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "PARSE_ERROR", err)
 		return
 	}
 
@@ -112,18 +428,67 @@ func (h *MorningCallHandler) HandleUpdate(w http.ResponseWriter, r *http.Request
 
 	output, err := h.updateUseCase.Execute(r.Context(), input)
 	if err != nil {
-		if strings.Contains(err.Error(), "見つかりません") {
-			h.SendError(w, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
-		} else if strings.Contains(err.Error(), "送信者のみが") {
-			h.SendError(w, http.StatusForbidden, "FORBIDDEN", err.Error(), nil)
-		} else {
-			h.SendError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
-		}
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンスの作成
+	resp := h.convertToMorningCallResponse(output.MorningCall, user)
+	h.SendJSON(w, http.StatusOK, resp)
+}
+
+// HandlePatch はモーニングコール部分更新のハンドラー
+// PATCH /api/v1/morning-calls/{id}
+func (h *MorningCallHandler) HandlePatch(w http.ResponseWriter, r *http.Request) {
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// コンテキストからIDを取得
+	morningCallID, ok := h.requireMorningCallID(w, r)
+	if !ok {
+		return
+	}
+
+	// リクエストボディのパース
+	var req request.PatchMorningCallRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "PARSE_ERROR", err)
+		return
+	}
+
+	// アラーム時刻とメッセージは解除できないため、nullの指定はエラーとする
+	var validationErrors []ValidationError
+	if req.ScheduledTime.Null {
+		validationErrors = append(validationErrors, ValidationError{Field: "scheduled_time", Message: "アラーム時刻にnullは指定できません"})
+	}
+	if req.Message.Null {
+		validationErrors = append(validationErrors, ValidationError{Field: "message", Message: "メッセージにnullは指定できません"})
+	}
+	if len(validationErrors) > 0 {
+		h.SendValidationError(w, validationErrors)
+		return
+	}
+
+	// UseCaseの実行
+	output, err := h.patchUseCase.Execute(r.Context(), mcCreate.PatchInput{
+		ID:                   morningCallID,
+		SenderID:             user.ID,
+		ScheduledTime:        req.ScheduledTime.Ptr(),
+		Message:              req.Message.Ptr(),
+		ConfirmDeadline:      req.ConfirmDeadline.Ptr(),
+		ClearConfirmDeadline: req.ConfirmDeadline.Null,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
 		return
 	}
 
 	// レスポンスの作成
-	resp := h.convertToMorningCallResponse(output.MorningCall)
+	resp := h.convertToMorningCallResponse(output.MorningCall, user)
 	h.SendJSON(w, http.StatusOK, resp)
 }
 
@@ -137,9 +502,8 @@ func (h *MorningCallHandler) HandleDelete(w http.ResponseWriter, r *http.Request
 	}
 
 	// コンテキストからIDを取得
-	morningCallID, ok := r.Context().Value("morningCallID").(string)
-	if !ok || morningCallID == "" {
-		h.SendError(w, http.StatusBadRequest, "INVALID_REQUEST", "モーニングコールIDが指定されていません", nil)
+	morningCallID, ok := h.requireMorningCallID(w, r)
+	if !ok {
 		return
 	}
 
@@ -151,13 +515,7 @@ func (h *MorningCallHandler) HandleDelete(w http.ResponseWriter, r *http.Request
 
 	_, err = h.deleteUseCase.Execute(r.Context(), input)
 	if err != nil {
-		if strings.Contains(err.Error(), "見つかりません") {
-			h.SendError(w, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
-		} else if strings.Contains(err.Error(), "送信者のみが") {
-			h.SendError(w, http.StatusForbidden, "FORBIDDEN", err.Error(), nil)
-		} else {
-			h.SendError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
-		}
+		h.SendMappedError(w, err)
 		return
 	}
 
@@ -168,6 +526,7 @@ func (h *MorningCallHandler) HandleDelete(w http.ResponseWriter, r *http.Request
 }
 
 // HandleGet はモーニングコール詳細取得のハンドラー
+// GET /api/v1/morning-calls/{id}?translate=ja でメッセージの翻訳も返す
 func (h *MorningCallHandler) HandleGet(w http.ResponseWriter, r *http.Request) {
 	// 認証チェック
 	user, err := h.GetUserFromContext(r.Context())
@@ -177,56 +536,118 @@ func (h *MorningCallHandler) HandleGet(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// コンテキストからIDを取得
-	morningCallID, ok := r.Context().Value("morningCallID").(string)
-	if !ok || morningCallID == "" {
-		h.SendError(w, http.StatusBadRequest, "INVALID_REQUEST", "モーニングコールIDが指定されていません", nil)
+	morningCallID, ok := h.requireMorningCallID(w, r)
+	if !ok {
 		return
 	}
 
-	// UseCaseの実行（詳細取得は一覧から絞り込み）
-	// 送信と受信の両方を取得するため、2回実行
-	inputSent := mcCreate.ListInput{
-		UserID:   user.ID,
-		ListType: mcCreate.ListTypeSent,
+	fields, ok := h.parseMorningCallFields(w, r)
+	if !ok {
+		return
 	}
-	outputSent, err := h.listUseCase.Execute(r.Context(), inputSent)
+
+	// UseCaseの実行（閲覧は権限のない試行も含めてアクセスログに記録される）
+	output, err := h.getUseCase.Execute(r.Context(), mcCreate.GetInput{
+		MorningCallID: morningCallID,
+		ViewerID:      user.ID,
+		TranslateTo:   r.URL.Query().Get("translate"),
+	})
 	if err != nil {
-		h.SendInternalServerError(w, err)
+		h.SendMappedError(w, err)
 		return
 	}
 
-	inputReceived := mcCreate.ListInput{
-		UserID:   user.ID,
-		ListType: mcCreate.ListTypeReceived,
+	morningCall := h.convertToMorningCallResponse(output.MorningCall, user)
+	if t := output.Translation; t != nil {
+		morningCall.Translation = &response.MessageTranslationResponse{
+			Language: t.Language,
+			Message:  t.Message,
+			Failed:   t.Failed,
+		}
 	}
-	outputReceived, err := h.listUseCase.Execute(r.Context(), inputReceived)
+
+	resp, err := fields.Filter(morningCall)
 	if err != nil {
 		h.SendInternalServerError(w, err)
 		return
 	}
+	h.SendJSONWithETag(w, r, resp)
+}
 
-	// 結果をマージ
-	allMorningCalls := append(outputSent.MorningCalls, outputReceived.MorningCalls...)
+// HandleAccessLog はモーニングコールのアクセスログ取得のハンドラー
+// GET /api/v1/morning-calls/{id}/access-log?limit=20&offset=0
+func (h *MorningCallHandler) HandleAccessLog(w http.ResponseWriter, r *http.Request) {
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
 
-	// IDで絞り込み
-	for _, mc := range allMorningCalls {
-		if mc.ID == morningCallID {
-			// アクセス権限チェック（送信者または受信者）
-			if mc.SenderID != user.ID && mc.ReceiverID != user.ID {
-				h.SendForbiddenError(w)
-				return
-			}
-			resp := h.convertToMorningCallResponse(mc)
-			h.SendJSON(w, http.StatusOK, resp)
-			return
+	// コンテキストからIDを取得
+	morningCallID, ok := h.requireMorningCallID(w, r)
+	if !ok {
+		return
+	}
+
+	// ページネーションをパース
+	q := newQueryParams(r)
+	limit := queryInt(q, "limit", 0, 0, queryNoMax)
+	offset := queryInt(q, "offset", 0, 0, queryNoMax)
+	if h.sendQueryErrors(w, q) {
+		return
+	}
+
+	// UseCaseの実行
+	output, err := h.accessLogUseCase.Execute(r.Context(), mcCreate.ListAccessLogInput{
+		MorningCallID: morningCallID,
+		UserID:        user.ID,
+		Offset:        offset,
+		Limit:         limit,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンスの作成
+	entries := make([]response.MorningCallAccessLogEntryResponse, len(output.Entries))
+	for i, e := range output.Entries {
+		entries[i] = response.MorningCallAccessLogEntryResponse{
+			ViewerID:   e.Access.ViewerID,
+			ViewerRole: string(e.ViewerRole),
+			IsSelf:     e.IsSelf,
+			Allowed:    e.Access.Allowed,
+			AccessedAt: e.Access.AccessedAt,
+		}
+		if e.Viewer != nil {
+			entries[i].ViewerUsername = e.Viewer.Username
 		}
 	}
 
-	h.SendNotFoundError(w, "モーニングコール")
+	h.SendJSON(w, http.StatusOK, response.MorningCallAccessLogResponse{
+		Entries: entries,
+		Total:   output.TotalCount,
+		HasNext: output.HasNext,
+	})
 }
 
-// HandleListSent は送信済みモーニングコール一覧取得のハンドラー
-func (h *MorningCallHandler) HandleListSent(w http.ResponseWriter, r *http.Request) {
+// HandleComments はモーニングコールのコメントのハンドラー
+// GET /api/v1/morning-calls/{id}/comments?limit=50&offset=0 で一覧を取得し、
+// POST /api/v1/morning-calls/{id}/comments でコメントを追加する
+func (h *MorningCallHandler) HandleComments(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.handleListComments(w, r)
+	case http.MethodPost:
+		h.handleAddComment(w, r)
+	default:
+		h.SendMethodNotAllowed(w, http.MethodGet, http.MethodPost)
+	}
+}
+
+// handleAddComment はモーニングコールへのコメント追加を処理する
+func (h *MorningCallHandler) handleAddComment(w http.ResponseWriter, r *http.Request) {
 	// 認証チェック
 	user, err := h.GetUserFromContext(r.Context())
 	if err != nil {
@@ -234,36 +655,114 @@ func (h *MorningCallHandler) HandleListSent(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	// コンテキストからIDを取得
+	morningCallID, ok := h.requireMorningCallID(w, r)
+	if !ok {
+		return
+	}
+
+	// リクエストボディのパース
+	var req request.AddCallCommentRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "PARSE_ERROR", err)
+		return
+	}
+
 	// UseCaseの実行
-	input := mcCreate.ListInput{
-		UserID:   user.ID,
-		ListType: mcCreate.ListTypeSent,
+	output, err := h.addCommentUC.Execute(r.Context(), mcCreate.AddCommentInput{
+		MorningCallID: morningCallID,
+		AuthorID:      user.ID,
+		Body:          req.Body,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
 	}
 
-	output, err := h.listUseCase.Execute(r.Context(), input)
+	h.SendJSON(w, http.StatusCreated, convertToCallCommentResponse(output.Comment, user.ID))
+}
+
+// handleListComments はモーニングコールのコメント一覧の取得を処理する
+func (h *MorningCallHandler) handleListComments(w http.ResponseWriter, r *http.Request) {
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
 	if err != nil {
-		h.SendInternalServerError(w, err)
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// コンテキストからIDを取得
+	morningCallID, ok := h.requireMorningCallID(w, r)
+	if !ok {
+		return
+	}
+
+	// ページネーションをパース
+	q := newQueryParams(r)
+	limit := queryInt(q, "limit", 0, 0, queryNoMax)
+	offset := queryInt(q, "offset", 0, 0, queryNoMax)
+	if h.sendQueryErrors(w, q) {
+		return
+	}
+
+	// UseCaseの実行
+	output, err := h.listCommentsUC.Execute(r.Context(), mcCreate.ListCommentsInput{
+		MorningCallID: morningCallID,
+		UserID:        user.ID,
+		Offset:        offset,
+		Limit:         limit,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
 		return
 	}
 
 	// レスポンスの作成
-	morningCalls := make([]response.MorningCallResponse, len(output.MorningCalls))
-	for i, mc := range output.MorningCalls {
-		morningCalls[i] = h.convertToMorningCallResponse(mc)
+	comments := make([]response.CallCommentResponse, len(output.Comments))
+	for i, c := range output.Comments {
+		comments[i] = convertToCallCommentResponse(c, user.ID)
 	}
+	h.SendJSON(w, http.StatusOK, response.CallCommentsResponse{
+		Comments: comments,
+		Total:    output.TotalCount,
+		HasNext:  output.HasNext,
+	})
+}
 
-	resp := response.MorningCallListResponse{
-		MorningCalls: morningCalls,
-		Total:        len(morningCalls),
-		Limit:        0, // 現在はページネーション未実装
-		Offset:       0,
+// convertToCallCommentResponse はコメントをレスポンスに変換する
+func convertToCallCommentResponse(c *entity.CallComment, userID string) response.CallCommentResponse {
+	return response.CallCommentResponse{
+		ID:        c.ID,
+		CallID:    c.CallID,
+		AuthorID:  c.AuthorID,
+		Body:      c.Body,
+		BodyHTML:  valueobject.SanitizeMessageHTML(c.Body),
+		IsMine:    c.AuthorID == userID,
+		CreatedAt: c.CreatedAt,
 	}
+}
 
-	h.SendJSON(w, http.StatusOK, resp)
+// HandleListSent は送信済みモーニングコール一覧取得のハンドラー
+// GET /api/v1/morning-calls/sent?status=scheduled&receiver_id=xxx&from=2026-03-01T00:00:00Z&to=2026-03-31T23:59:59Z&sort=-scheduled_time&offset=0&limit=20
+// updated_sinceに前回のレスポンスのlast_sync_timeを指定すると、それ以降に変更されたコールのみを返す
+func (h *MorningCallHandler) HandleListSent(w http.ResponseWriter, r *http.Request) {
+	h.handleList(w, r, mcCreate.ListTypeSent)
 }
 
-// HandleListReceived は受信モーニングコール一覧取得のハンドラー
+// HandleListReceived は受信モーニングコール一覧取得のハンドラー（アーカイブしたものを除く）
+// GET /api/v1/morning-calls/received?status=scheduled&sender_id=xxx&from=2026-03-01T00:00:00Z&to=2026-03-31T23:59:59Z&sort=-scheduled_time&favorites_first=true&offset=0&limit=20
 func (h *MorningCallHandler) HandleListReceived(w http.ResponseWriter, r *http.Request) {
+	h.handleList(w, r, mcCreate.ListTypeReceived)
+}
+
+// HandleListArchived はアーカイブした受信モーニングコール一覧取得のハンドラー
+// GET /api/v1/morning-calls/archived（クエリパラメータは受信一覧と同じ）
+func (h *MorningCallHandler) HandleListArchived(w http.ResponseWriter, r *http.Request) {
+	h.handleList(w, r, mcCreate.ListTypeArchived)
+}
+
+// handleList は送信・受信・アーカイブ一覧の共通処理
+func (h *MorningCallHandler) handleList(w http.ResponseWriter, r *http.Request, listType mcCreate.ListType) {
 	// 認証チェック
 	user, err := h.GetUserFromContext(r.Context())
 	if err != nil {
@@ -271,34 +770,93 @@ func (h *MorningCallHandler) HandleListReceived(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	// UseCaseの実行
-	input := mcCreate.ListInput{
-		UserID:   user.ID,
-		ListType: mcCreate.ListTypeReceived,
+	fields, ok := h.parseMorningCallFields(w, r)
+	if !ok {
+		return
 	}
 
+	input, ok := h.parseListInput(w, r, user.ID, listType)
+	if !ok {
+		return
+	}
+
+	// UseCaseの実行
 	output, err := h.listUseCase.Execute(r.Context(), input)
 	if err != nil {
-		h.SendInternalServerError(w, err)
+		h.SendMappedError(w, err)
 		return
 	}
 
 	// レスポンスの作成
 	morningCalls := make([]response.MorningCallResponse, len(output.MorningCalls))
 	for i, mc := range output.MorningCalls {
-		morningCalls[i] = h.convertToMorningCallResponse(mc)
+		morningCalls[i] = h.convertToMorningCallResponse(mc, user)
+		// お気に入り送信元からのコールは印を付け、通知を強化する設定を無音配信の判定に反映する
+		if favorite, ok := output.FavoriteSenders[mc.SenderID]; ok && mc.ReceiverID == user.ID {
+			morningCalls[i].FromFavoriteSender = true
+			silent := mc.ResolveSilentDeliveryFrom(user, &favorite)
+			morningCalls[i].SilentDelivery = &silent
+			morningCalls[i].DeliveryHints = response.NewDeliveryHintsResponse(mc.ResolveDeliveryHintsFrom(user, &favorite))
+		}
 	}
 
-	resp := response.MorningCallListResponse{
+	resp, err := fields.FilterList(response.MorningCallListResponse{
 		MorningCalls: morningCalls,
-		Total:        len(morningCalls),
-		Limit:        0, // 現在はページネーション未実装
-		Offset:       0,
+		Total:        output.TotalCount,
+		Limit:        input.Limit,
+		Offset:       input.Offset,
+		LastSyncTime: output.LastSyncTime,
+	}, "morning_calls")
+	if err != nil {
+		h.SendInternalServerError(w, err)
+		return
 	}
 
 	h.SendJSON(w, http.StatusOK, resp)
 }
 
+// parseListInput は一覧取得のクエリパラメータをパースしてListInputを作成する
+// 不正なパラメータはまとめてバリデーションエラーとして返す
+func (h *MorningCallHandler) parseListInput(w http.ResponseWriter, r *http.Request, userID string, listType mcCreate.ListType) (mcCreate.ListInput, bool) {
+	input := mcCreate.ListInput{
+		UserID:   userID,
+		ListType: listType,
+	}
+
+	// 相手のユーザーは送信一覧ではreceiver_id、受信一覧ではsender_idで指定する
+	counterpartParam, otherParam := "sender_id", "receiver_id"
+	if listType == mcCreate.ListTypeSent {
+		counterpartParam, otherParam = "receiver_id", "sender_id"
+	}
+
+	q := newQueryParams(r)
+	if status := queryEnum(q, "status", "", morningCallStatusQueryValues...); status != "" {
+		input.Status = &status
+	}
+	input.CounterpartID = q.get(counterpartParam)
+	if q.get(otherParam) != "" {
+		q.addError(otherParam, otherParam+"はこの一覧では指定できません。"+counterpartParam+"を使用してください", valueobject.ReasonCodeNotApplicable)
+	}
+	input.StartTime = queryTime(q, "from")
+	input.EndTime = queryTime(q, "to")
+	input.UpdatedSince = queryTime(q, "updated_since")
+	if input.StartTime != nil && input.EndTime != nil && input.StartTime.After(*input.EndTime) {
+		q.addError("to", "toはfrom以降の日時を指定してください", valueobject.ReasonCodeOutOfRange)
+	}
+	input.Sort = queryEnum(q, "sort", mcCreate.ListSortOrderDefault,
+		mcCreate.ListSortOrderScheduledTime, mcCreate.ListSortOrderScheduledTimeDesc, mcCreate.ListSortOrderCreatedAt, mcCreate.ListSortOrderCreatedAtDesc)
+	input.Offset = queryInt(q, "offset", 0, 0, queryNoMax)
+	input.Limit = queryInt(q, "limit", defaultMorningCallListLimit, 1, maxMorningCallListLimit)
+	input.FavoritesFirst = queryBool(q, "favorites_first", false)
+	if input.FavoritesFirst && listType == mcCreate.ListTypeSent {
+		q.addError("favorites_first", "favorites_firstは受信一覧でのみ指定できます", valueobject.ReasonCodeNotApplicable)
+	}
+	if h.sendQueryErrors(w, q) {
+		return mcCreate.ListInput{}, false
+	}
+	return input, true
+}
+
 // HandleConfirmWake は起床確認のハンドラー
 func (h *MorningCallHandler) HandleConfirmWake(w http.ResponseWriter, r *http.Request) {
 	// 認証チェック
@@ -309,46 +867,1058 @@ func (h *MorningCallHandler) HandleConfirmWake(w http.ResponseWriter, r *http.Re
 	}
 
 	// コンテキストからIDを取得
-	morningCallID, ok := r.Context().Value("morningCallID").(string)
-	if !ok || morningCallID == "" {
-		h.SendError(w, http.StatusBadRequest, "INVALID_REQUEST", "モーニングコールIDが指定されていません", nil)
+	morningCallID, ok := h.requireMorningCallID(w, r)
+	if !ok {
+		return
+	}
+
+	// リクエストボディのパース（ボディは任意）
+	var req request.ConfirmWakeRequest
+	if err := h.ParseJSON(r, &req); err != nil && !errors.Is(err, io.EOF) {
+		h.SendJSONDecodeError(w, "PARSE_ERROR", err)
 		return
 	}
 
 	// UseCaseの実行
 	input := mcCreate.ConfirmWakeInput{
 		MorningCallID: morningCallID,
-		ReceiverID:    user.ID,
+		ConfirmerID:   user.ID,
+		ShareLocation: req.ShareLocation,
+		Stamp:         valueobject.Stamp(req.Stamp),
+
+		ChallengeAnswer: req.ChallengeAnswer.String(),
+		ConfirmMethod:   valueobject.ConfirmMethod(req.ConfirmMethod),
+		RequestNextCall: req.RequestNextCall,
+	}
+	if req.Location != nil {
+		input.Location = &valueobject.GeoPoint{
+			Latitude:  req.Location.Latitude,
+			Longitude: req.Location.Longitude,
+		}
 	}
 
 	output, err := h.confirmWakeUseCase.Execute(r.Context(), input)
 	if err != nil {
-		if strings.Contains(err.Error(), "見つかりません") {
-			h.SendError(w, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
-		} else if strings.Contains(err.Error(), "受信者のみが起床確認できます") {
-			h.SendError(w, http.StatusForbidden, "FORBIDDEN", err.Error(), nil)
-		} else {
-			h.SendError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
-		}
+		h.SendMappedError(w, err)
 		return
 	}
 
 	// レスポンスの作成
-	resp := h.convertToMorningCallResponse(output.MorningCall)
+	resp := h.convertToMorningCallResponse(output.MorningCall, user)
 	h.SendJSON(w, http.StatusOK, resp)
 }
 
-// convertToMorningCallResponse はエンティティをレスポンスDTOに変換する
-func (h *MorningCallHandler) convertToMorningCallResponse(mc *entity.MorningCall) response.MorningCallResponse {
-	resp := response.MorningCallResponse{
-		ID:            mc.ID,
-		SenderID:      mc.SenderID,
-		ReceiverID:    mc.ReceiverID,
-		ScheduledTime: mc.ScheduledTime,
-		Message:       mc.Message,
-		Status:        string(mc.Status),
-		CreatedAt:     mc.CreatedAt,
+// HandleSendStamp は起床確認後のお礼スタンプ送信のハンドラー
+// PUT /api/v1/morning-calls/{id}/stamp
+func (h *MorningCallHandler) HandleSendStamp(w http.ResponseWriter, r *http.Request) {
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// コンテキストからIDを取得
+	morningCallID, ok := h.requireMorningCallID(w, r)
+	if !ok {
+		return
+	}
+
+	// リクエストボディのパース
+	var req request.SendStampRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "PARSE_ERROR", err)
+		return
+	}
+
+	// UseCaseの実行
+	output, err := h.sendStampUseCase.Execute(r.Context(), mcCreate.SendStampInput{
+		MorningCallID: morningCallID,
+		ReceiverID:    user.ID,
+		Stamp:         valueobject.Stamp(req.Stamp),
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンスの作成
+	resp := h.convertToMorningCallResponse(output.MorningCall, user)
+	h.SendJSON(w, http.StatusOK, resp)
+}
+
+// HandleRate は起床確認後の受信者による役立ち度の評価のハンドラー
+// POST /api/v1/morning-calls/{id}/rating
+func (h *MorningCallHandler) HandleRate(w http.ResponseWriter, r *http.Request) {
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// コンテキストからIDを取得
+	morningCallID, ok := h.requireMorningCallID(w, r)
+	if !ok {
+		return
+	}
+
+	// リクエストボディのパース
+	var req request.RateMorningCallRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "PARSE_ERROR", err)
+		return
+	}
+
+	// UseCaseの実行
+	output, err := h.rateUseCase.Execute(r.Context(), mcCreate.RateMorningCallInput{
+		MorningCallID: morningCallID,
+		ReceiverID:    user.ID,
+		Rating:        req.Rating,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンスの作成
+	resp := h.convertToMorningCallResponse(output.MorningCall, user)
+	h.SendJSON(w, http.StatusOK, resp)
+}
+
+// HandleRatingStats は自分が送ったコールの役立ち度の評価の統計を取得するハンドラー
+// GET /api/v1/morning-calls/rating-stats
+func (h *MorningCallHandler) HandleRatingStats(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.SendMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// UseCaseの実行
+	output, err := h.ratingStatsUseCase.Execute(r.Context(), mcCreate.RatingStatsInput{
+		SenderID: user.ID,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンスの作成
+	distribution := make([]response.RatingDistributionResponse, len(output.Distribution))
+	for i, count := range output.Distribution {
+		distribution[i] = response.RatingDistributionResponse{
+			Rating: i + entity.MinHelpfulnessRating,
+			Count:  count,
+		}
+	}
+
+	h.SendJSON(w, http.StatusOK, response.RatingStatsResponse{
+		RatedCount:    output.RatedCount,
+		AverageRating: output.AverageRating,
+		Distribution:  distribution,
+	})
+}
+
+// HandleConfirmTimeStats は自分が送ったコールが起床確認されるまでの所要時間の統計を取得するハンドラー
+// GET /api/v1/morning-calls/confirm-time-stats
+func (h *MorningCallHandler) HandleConfirmTimeStats(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.SendMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// UseCaseの実行
+	output, err := h.confirmTimeStatsUseCase.Execute(r.Context(), mcCreate.ConfirmTimeStatsInput{
+		SenderID: user.ID,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	h.SendJSON(w, http.StatusOK, response.ConfirmTimeStatsResponse{
+		ConfirmedCount: output.ConfirmedCount,
+		AverageSeconds: int64(output.Average.Seconds()),
+		MedianSeconds:  int64(output.Median.Seconds()),
+	})
+}
+
+// HandleMessageVariantStats は自分が送ったコールのメッセージバリアント別の起床確認率を取得するハンドラー
+// GET /api/v1/morning-calls/variant-stats?series_id=xxx
+func (h *MorningCallHandler) HandleMessageVariantStats(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.SendMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// UseCaseの実行
+	output, err := h.messageVariantStatsUseCase.Execute(r.Context(), mcCreate.MessageVariantStatsInput{
+		SenderID: user.ID,
+		SeriesID: r.URL.Query().Get("series_id"),
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンスの作成
+	variants := make([]response.MessageVariantStatResponse, len(output.Variants))
+	for i, stat := range output.Variants {
+		variants[i] = response.MessageVariantStatResponse{
+			MessageVariants:     stat.MessageVariants,
+			MessageVariantIndex: stat.MessageVariantIndex,
+			Message:             stat.Message,
+			Delivered:           stat.Delivered,
+			Confirmed:           stat.Confirmed,
+			Missed:              stat.Missed,
+			ConfirmationRate:    stat.ConfirmationRate,
+		}
+	}
+
+	h.SendJSON(w, http.StatusOK, response.MessageVariantStatsResponse{Variants: variants})
+}
+
+// HandleSkip は受信者によるモーニングコールのスキップのハンドラー
+// PUT /api/v1/morning-calls/{id}/skip
+func (h *MorningCallHandler) HandleSkip(w http.ResponseWriter, r *http.Request) {
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// コンテキストからIDを取得
+	morningCallID, ok := h.requireMorningCallID(w, r)
+	if !ok {
+		return
+	}
+
+	// UseCaseの実行
+	output, err := h.skipUseCase.Execute(r.Context(), mcCreate.SkipInput{
+		MorningCallID: morningCallID,
+		ReceiverID:    user.ID,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンスの作成
+	resp := h.convertToMorningCallResponse(output.MorningCall, user)
+	h.SendJSON(w, http.StatusOK, resp)
+}
+
+// HandleCancel は送信者によるモーニングコールのキャンセルのハンドラー
+// 配信済みのコールも配信から猶予期間内で受信者が未確認であればキャンセルできる
+// PUT /api/v1/morning-calls/{id}/cancel
+func (h *MorningCallHandler) HandleCancel(w http.ResponseWriter, r *http.Request) {
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// コンテキストからIDを取得
+	morningCallID, ok := h.requireMorningCallID(w, r)
+	if !ok {
+		return
+	}
+
+	// UseCaseの実行
+	output, err := h.cancelUseCase.Execute(r.Context(), mcCreate.CancelInput{
+		MorningCallID: morningCallID,
+		SenderID:      user.ID,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンスの作成
+	resp := h.convertToMorningCallResponse(output.MorningCall, user)
+	h.SendJSON(w, http.StatusOK, resp)
+}
+
+// HandleSetReceiverOffset は受信者によるアラーム時刻ずらし設定のハンドラー
+// PUT /api/v1/morning-calls/{id}/offset
+func (h *MorningCallHandler) HandleSetReceiverOffset(w http.ResponseWriter, r *http.Request) {
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// コンテキストからIDを取得
+	morningCallID, ok := h.requireMorningCallID(w, r)
+	if !ok {
+		return
+	}
+
+	// リクエストボディのパース
+	var req request.SetReceiverOffsetRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "PARSE_ERROR", err)
+		return
+	}
+
+	// UseCaseの実行
+	output, err := h.setOffsetUseCase.Execute(r.Context(), mcCreate.SetReceiverOffsetInput{
+		MorningCallID: morningCallID,
+		ReceiverID:    user.ID,
+		OffsetMinutes: req.OffsetMinutes,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンスの作成
+	resp := h.convertToMorningCallResponse(output.MorningCall, user)
+	h.SendJSON(w, http.StatusOK, resp)
+}
+
+// HandleRequestReschedule は受信者によるアラーム時刻の変更リクエストのハンドラー
+// POST /api/v1/morning-calls/{id}/reschedule-request
+func (h *MorningCallHandler) HandleRequestReschedule(w http.ResponseWriter, r *http.Request) {
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// コンテキストからIDを取得
+	morningCallID, ok := h.requireMorningCallID(w, r)
+	if !ok {
+		return
+	}
+
+	// リクエストボディのパース
+	var req request.RequestRescheduleRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "PARSE_ERROR", err)
+		return
+	}
+	if req.RequestedTime.IsZero() {
+		h.SendValidationError(w, []ValidationError{
+			{Field: "requested_time", Message: "希望時刻は必須です"},
+		})
+		return
+	}
+
+	// UseCaseの実行
+	output, err := h.rescheduleUseCase.Execute(r.Context(), mcCreate.RequestRescheduleInput{
+		MorningCallID: morningCallID,
+		ReceiverID:    user.ID,
+		RequestedTime: req.RequestedTime,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンスの作成
+	resp := h.convertToMorningCallResponse(output.MorningCall, user)
+	h.SendJSON(w, http.StatusCreated, resp)
+}
+
+// HandleApproveReschedule は送信者による時刻変更リクエストの承認のハンドラー
+// POST /api/v1/morning-calls/{id}/reschedule-request/approve
+func (h *MorningCallHandler) HandleApproveReschedule(w http.ResponseWriter, r *http.Request) {
+	h.handleRespondReschedule(w, r, true)
+}
+
+// HandleRejectReschedule は送信者による時刻変更リクエストの拒否のハンドラー
+// POST /api/v1/morning-calls/{id}/reschedule-request/reject
+func (h *MorningCallHandler) HandleRejectReschedule(w http.ResponseWriter, r *http.Request) {
+	h.handleRespondReschedule(w, r, false)
+}
+
+// handleRespondReschedule は時刻変更リクエストへの応答の共通処理
+func (h *MorningCallHandler) handleRespondReschedule(w http.ResponseWriter, r *http.Request, approve bool) {
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// コンテキストからIDを取得
+	morningCallID, ok := h.requireMorningCallID(w, r)
+	if !ok {
+		return
+	}
+
+	// UseCaseの実行
+	output, err := h.respondUseCase.Execute(r.Context(), mcCreate.RespondRescheduleInput{
+		MorningCallID: morningCallID,
+		SenderID:      user.ID,
+		Approve:       approve,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンスの作成
+	resp := h.convertToMorningCallResponse(output.MorningCall, user)
+	h.SendJSON(w, http.StatusOK, resp)
+}
+
+// HandleApproveNextCall は送信者による次回のコールのリクエストの承認のハンドラー
+// 承認すると翌日同時刻のコールを作成する
+// POST /api/v1/morning-calls/{id}/next-call-request/approve
+func (h *MorningCallHandler) HandleApproveNextCall(w http.ResponseWriter, r *http.Request) {
+	h.handleRespondNextCall(w, r, true)
+}
+
+// HandleRejectNextCall は送信者による次回のコールのリクエストの拒否のハンドラー
+// POST /api/v1/morning-calls/{id}/next-call-request/reject
+func (h *MorningCallHandler) HandleRejectNextCall(w http.ResponseWriter, r *http.Request) {
+	h.handleRespondNextCall(w, r, false)
+}
+
+// handleRespondNextCall は次回のコールのリクエストへの応答の共通処理
+func (h *MorningCallHandler) handleRespondNextCall(w http.ResponseWriter, r *http.Request, approve bool) {
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// コンテキストからIDを取得
+	morningCallID, ok := h.requireMorningCallID(w, r)
+	if !ok {
+		return
+	}
+
+	// UseCaseの実行
+	output, err := h.respondNextCallUC.Execute(r.Context(), mcCreate.RespondNextCallInput{
+		MorningCallID: morningCallID,
+		SenderID:      user.ID,
+		Approve:       approve,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンスの作成（承認した場合は作成したコールも返す）
+	resp := response.RespondNextCallResponse{
+		MorningCall: h.convertToMorningCallResponse(output.MorningCall, user),
+	}
+	status := http.StatusOK
+	if output.NextCall != nil {
+		nextCall := h.convertToMorningCallResponse(output.NextCall, user)
+		resp.NextCall = &nextCall
+		status = http.StatusCreated
+	}
+	h.SendJSON(w, status, resp)
+}
+
+// HandleSetSilentDelivery は受信者によるコールごとの無音配信設定のハンドラー
+// PUT /api/v1/morning-calls/{id}/silent
+func (h *MorningCallHandler) HandleSetSilentDelivery(w http.ResponseWriter, r *http.Request) {
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// コンテキストからIDを取得
+	morningCallID, ok := h.requireMorningCallID(w, r)
+	if !ok {
+		return
+	}
+
+	// リクエストボディのパース
+	var req request.SetSilentDeliveryRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "PARSE_ERROR", err)
+		return
+	}
+	if !req.Silent.Set {
+		h.SendValidationError(w, []ValidationError{
+			{Field: "silent", Message: "silentを指定してください（nullでデフォルト設定に戻す）"},
+		})
+		return
+	}
+
+	// UseCaseの実行
+	output, err := h.setSilentUseCase.Execute(r.Context(), mcCreate.SetSilentDeliveryInput{
+		MorningCallID: morningCallID,
+		ReceiverID:    user.ID,
+		Silent:        req.Silent.Ptr(),
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンスの作成
+	resp := h.convertToMorningCallResponse(output.MorningCall, user)
+	h.SendJSON(w, http.StatusOK, resp)
+}
+
+// HandleSetDeliveryHints は受信者によるコールごとの音量・バイブレーション設定のハンドラー
+// PUT /api/v1/morning-calls/{id}/delivery-hints
+func (h *MorningCallHandler) HandleSetDeliveryHints(w http.ResponseWriter, r *http.Request) {
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// コンテキストからIDを取得
+	morningCallID, ok := h.requireMorningCallID(w, r)
+	if !ok {
+		return
+	}
+
+	// リクエストボディのパース
+	var req request.SetDeliveryHintsRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "PARSE_ERROR", err)
+		return
+	}
+
+	// UseCaseの実行
+	output, err := h.deliveryHintsUC.Execute(r.Context(), mcCreate.SetDeliveryHintsInput{
+		MorningCallID: morningCallID,
+		ReceiverID:    user.ID,
+		Volume:        req.Volume.Ptr(),
+		Vibrate:       req.Vibrate.Ptr(),
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンスの作成
+	resp := h.convertToMorningCallResponse(output.MorningCall, user)
+	h.SendJSON(w, http.StatusOK, resp)
+}
+
+// HandleArchive は受信者によるモーニングコールのアーカイブ・アーカイブ解除のハンドラー
+// PUT /api/v1/morning-calls/{id}/archive でアーカイブし、DELETE で解除する
+func (h *MorningCallHandler) HandleArchive(w http.ResponseWriter, r *http.Request) {
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// コンテキストからIDを取得
+	morningCallID, ok := h.requireMorningCallID(w, r)
+	if !ok {
+		return
+	}
+
+	// UseCaseの実行
+	output, err := h.archiveUseCase.Execute(r.Context(), mcCreate.ArchiveInput{
+		MorningCallID: morningCallID,
+		ReceiverID:    user.ID,
+		Archived:      r.Method != http.MethodDelete,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンスの作成
+	resp := h.convertToMorningCallResponse(output.MorningCall, user)
+	h.SendJSON(w, http.StatusOK, resp)
+}
+
+// HandleApprove は受信者による承認待ちモーニングコールの承認のハンドラー
+// PUT /api/v1/morning-calls/{id}/approve
+func (h *MorningCallHandler) HandleApprove(w http.ResponseWriter, r *http.Request) {
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// コンテキストからIDを取得
+	morningCallID, ok := h.requireMorningCallID(w, r)
+	if !ok {
+		return
+	}
+
+	// UseCaseの実行
+	output, err := h.approveUseCase.Execute(r.Context(), mcCreate.ApproveCallInput{
+		MorningCallID: morningCallID,
+		ReceiverID:    user.ID,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンスの作成
+	resp := h.convertToMorningCallResponse(output.MorningCall, user)
+	h.SendJSON(w, http.StatusOK, resp)
+}
+
+// HandleReject は受信者による承認待ちモーニングコールの拒否のハンドラー
+// PUT /api/v1/morning-calls/{id}/reject
+func (h *MorningCallHandler) HandleReject(w http.ResponseWriter, r *http.Request) {
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// コンテキストからIDを取得
+	morningCallID, ok := h.requireMorningCallID(w, r)
+	if !ok {
+		return
+	}
+
+	// UseCaseの実行
+	output, err := h.rejectUseCase.Execute(r.Context(), mcCreate.RejectCallInput{
+		MorningCallID: morningCallID,
+		ReceiverID:    user.ID,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンスの作成
+	resp := h.convertToMorningCallResponse(output.MorningCall, user)
+	h.SendJSON(w, http.StatusOK, resp)
+}
+
+// HandleListConflicts は送信予定コールのスケジュール重複一覧取得のハンドラー
+// GET /api/v1/morning-calls/conflicts?window=1m
+func (h *MorningCallHandler) HandleListConflicts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.SendMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// 重複判定の範囲をパース（例: 30s, 1m, 5m）
+	q := newQueryParams(r)
+	window := queryDuration(q, "window", 0)
+	if h.sendQueryErrors(w, q) {
+		return
+	}
+
+	// UseCaseの実行
+	output, err := h.conflictsUseCase.Execute(r.Context(), mcCreate.FindScheduleConflictsInput{
+		SenderID: user.ID,
+		Window:   window,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンスの作成
+	groups := make([]response.ScheduleConflictGroupResponse, len(output.Groups))
+	for i, g := range output.Groups {
+		calls := make([]response.MorningCallResponse, len(g.MorningCalls))
+		for j, mc := range g.MorningCalls {
+			calls[j] = h.convertToMorningCallResponse(mc, user)
+		}
+		groups[i] = response.ScheduleConflictGroupResponse{
+			StartTime:    g.StartTime,
+			EndTime:      g.EndTime,
+			MorningCalls: calls,
+		}
+	}
+
+	h.SendJSON(w, http.StatusOK, response.ScheduleConflictsResponse{
+		Groups:        groups,
+		WindowSeconds: int(output.Window / time.Second),
+	})
+}
+
+// HandleListFrequentReceivers はよく送る相手一覧取得のハンドラー
+// GET /api/v1/morning-calls/frequent-receivers?limit=5
+func (h *MorningCallHandler) HandleListFrequentReceivers(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.SendMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// 取得件数をパース
+	q := newQueryParams(r)
+	limit := queryInt(q, "limit", 0, 0, queryNoMax)
+	if h.sendQueryErrors(w, q) {
+		return
+	}
+
+	// UseCaseの実行
+	output, err := h.frequentUseCase.Execute(r.Context(), mcCreate.FrequentReceiversInput{
+		SenderID: user.ID,
+		Limit:    limit,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンスの作成
+	receivers := make([]response.FrequentReceiverResponse, len(output.Receivers))
+	for i, fr := range output.Receivers {
+		receivers[i] = response.FrequentReceiverResponse{
+			UserID:     fr.User.ID,
+			Username:   fr.User.Username,
+			SendCount:  fr.SendCount,
+			LastSentAt: fr.LastSentAt,
+		}
+	}
+
+	h.SendJSON(w, http.StatusOK, response.FrequentReceiversResponse{
+		Receivers: receivers,
+	})
+}
+
+// HandleLeaderboard は起床確認・起こした回数ランキング取得のハンドラー
+// GET /api/v1/morning-calls/leaderboard?type=woken&scope=friends&from=2026-03-01&to=2026-03-31&limit=10
+func (h *MorningCallHandler) HandleLeaderboard(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.SendMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// 取得件数をパース
+	q := newQueryParams(r)
+	limit := queryInt(q, "limit", 0, 0, queryNoMax)
+	if h.sendQueryErrors(w, q) {
+		return
+	}
+
+	// UseCaseの実行（期間の日付はユーザーのタイムゾーンで解釈される）
+	output, err := h.leaderboardUC.Execute(r.Context(), mcCreate.LeaderboardInput{
+		UserID: user.ID,
+		Kind:   mcCreate.LeaderboardKind(h.GetQueryParam(r, "type", string(mcCreate.LeaderboardKindWoken))),
+		Scope:  mcCreate.LeaderboardScope(h.GetQueryParam(r, "scope", "")),
+		From:   h.GetQueryParam(r, "from", ""),
+		To:     h.GetQueryParam(r, "to", ""),
+		Limit:  limit,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンスの作成
+	entries := make([]response.CallLeaderboardEntryResponse, len(output.Entries))
+	for i, e := range output.Entries {
+		entries[i] = convertToCallLeaderboardEntryResponse(e)
+	}
+	resp := response.CallLeaderboardResponse{
+		Type:    string(output.Kind),
+		Scope:   string(output.Scope),
+		From:    output.From,
+		To:      output.To,
+		Entries: entries,
+	}
+	if output.Me != nil {
+		me := convertToCallLeaderboardEntryResponse(*output.Me)
+		resp.Me = &me
+	}
+
+	h.SendJSON(w, http.StatusOK, resp)
+}
+
+// convertToCallLeaderboardEntryResponse はランキングの1行をレスポンスに変換する
+func convertToCallLeaderboardEntryResponse(e mcCreate.LeaderboardEntry) response.CallLeaderboardEntryResponse {
+	return response.CallLeaderboardEntryResponse{
+		Rank:     e.Rank,
+		UserID:   e.User.ID,
+		Username: e.User.Username,
+		Count:    e.Count,
+	}
+}
+
+// HandleWakeHeatmap は日別の起床確認件数（起床ヒートマップ）取得のハンドラー
+// GET /api/v1/morning-calls/heatmap?year=2024
+func (h *MorningCallHandler) HandleWakeHeatmap(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.SendMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// クエリパラメータのパース
+	q := newQueryParams(r)
+	input := mcCreate.WakeHeatmapInput{
+		UserID:       user.ID,
+		From:         q.get("from"),
+		To:           q.get("to"),
+		Year:         queryInt(q, "year", 0, 1, queryNoMax),
+		IncludeEmpty: queryBool(q, "include_empty", false),
+	}
+	if h.sendQueryErrors(w, q) {
+		return
+	}
+
+	// UseCaseの実行（日付はユーザーのタイムゾーンで解釈される）
+	output, err := h.heatmapUseCase.Execute(r.Context(), input)
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンスの作成
+	h.SendJSON(w, http.StatusOK, response.WakeHeatmapResponse{
+		From:     output.From,
+		To:       output.To,
+		TimeZone: output.Location.String(),
+		Days:     output.Days,
+		Total:    output.Total,
+	})
+}
+
+// HandleReport はモーニングコールの送受信をまとめたレポート取得のハンドラー
+// JSONまたはCSVのファイルとして返す
+// GET /api/v1/morning-calls/report?period=weekly&format=csv
+func (h *MorningCallHandler) HandleReport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.SendMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// UseCaseの実行（期間・形式の検証と、日付のユーザーのタイムゾーンでの解釈はUseCaseで行う）
+	output, err := h.reportUseCase.Execute(r.Context(), mcCreate.GenerateReportInput{
+		UserID: user.ID,
+		Period: valueobject.ReportPeriod(h.GetQueryParam(r, "period", "")),
+		From:   h.GetQueryParam(r, "from", ""),
+		To:     h.GetQueryParam(r, "to", ""),
+		Format: valueobject.ReportFormat(h.GetQueryParam(r, "format", "")),
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	h.SendFile(w, output.Format.ContentType(), output.Filename, output.Content)
+}
+
+// HandleListSystemMessages はシステムが用意した定型メッセージ一覧取得のハンドラー
+// GET /api/v1/morning-calls/system-messages?category=gentle&lang=en
+func (h *MorningCallHandler) HandleListSystemMessages(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.SendMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	// 認証チェック
+	if _, err := h.GetUserFromContext(r.Context()); err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// UseCaseの実行
+	output, err := h.systemMsgUseCase.Execute(r.Context(), mcCreate.ListSystemMessagesInput{
+		Category: valueobject.SystemMessageCategory(h.GetQueryParam(r, "category", "")),
+		Language: h.GetQueryParam(r, "lang", ""),
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンスの作成
+	messages := make([]response.SystemMessageResponse, len(output.Messages))
+	for i, m := range output.Messages {
+		messages[i] = response.SystemMessageResponse{
+			ID:       m.ID,
+			Category: string(m.Category),
+			Text:     m.Text,
+		}
+	}
+	h.SendJSON(w, http.StatusOK, response.SystemMessagesResponse{
+		Language: output.Language,
+		Messages: messages,
+	})
+}
+
+// HandleUnconfirmedCount は未確認モーニングコール件数取得のハンドラー
+// GET /api/v1/morning-calls/unconfirmed-count
+func (h *MorningCallHandler) HandleUnconfirmedCount(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.SendMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	// 認証チェック
+	user, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// UseCaseの実行
+	output, err := h.unconfirmedUseCase.Execute(r.Context(), mcCreate.UnconfirmedCountInput{
+		ReceiverID: user.ID,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	h.SendJSON(w, http.StatusOK, response.UnconfirmedCountResponse{
+		Count: output.Count,
+	})
+}
+
+// HandleValidateMessage はメッセージ事前検証のハンドラー
+// POST /api/v1/morning-calls/validate-message
+func (h *MorningCallHandler) HandleValidateMessage(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.SendMethodNotAllowed(w, http.MethodPost)
+		return
+	}
+
+	// 認証チェック
+	if _, err := h.GetUserFromContext(r.Context()); err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// リクエストボディのパース
+	var req request.ValidateMessageRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "PARSE_ERROR", err)
+		return
+	}
+
+	// UseCaseの実行
+	output, err := h.validateMsgUseCase.Execute(r.Context(), mcCreate.ValidateMessageInput{
+		Message: req.Message,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// 検証結果は成否にかかわらず200で返す
+	h.SendJSON(w, http.StatusOK, response.ValidateMessageResponse{
+		Valid:       output.Valid,
+		CharCount:   output.CharCount,
+		MaxLength:   output.MaxLength,
+		WithinLimit: output.WithinLimit,
+		BannedWords: output.BannedWords,
+		Reasons:     output.Reasons,
+	})
+}
+
+// requireMorningCallID はルーティング時にコンテキストへ設定されたモーニングコールIDを取得する
+// 取得できない場合は400を返し、falseを返す
+func (h *MorningCallHandler) requireMorningCallID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	morningCallID, ok := r.Context().Value("morningCallID").(string)
+	if !ok || morningCallID == "" {
+		h.SendInvalidRequest(w, "モーニングコールIDが指定されていません")
+		return "", false
+	}
+	return morningCallID, true
+}
+
+// parseMorningCallFields はfieldsクエリを解析する
+// 不正な指定の場合はバリデーションエラーを送信してfalseを返す
+func (h *MorningCallHandler) parseMorningCallFields(w http.ResponseWriter, r *http.Request) (dto.FieldSet, bool) {
+	fields, err := dto.ParseFieldSet(h.GetQueryParam(r, dto.FieldsQueryParam, ""), morningCallFieldNames, morningCallUnknownFieldPolicy)
+	if err != nil {
+		h.SendValidationError(w, []ValidationError{
+			{Field: dto.FieldsQueryParam, Message: err.Error()},
+		})
+		return nil, false
+	}
+	return fields, true
+}
+
+// convertToMorningCallResponse はエンティティをレスポンスDTOに変換する
+// 起床確認時の位置情報は閲覧者に公開されている場合のみ含め、
+// 無音配信の設定は閲覧者が受信者本人の場合のみ含める
+// 管理者に削除されたコールはメッセージを含めない
+func (h *MorningCallHandler) convertToMorningCallResponse(mc *entity.MorningCall, viewer *entity.User) response.MorningCallResponse {
+	viewerID := viewer.ID
+	resp := response.MorningCallResponse{
+		ID:            mc.ID,
+		SenderID:      mc.SenderID,
+		ReceiverID:    mc.ReceiverID,
+		ScheduledTime: mc.ScheduledTime,
+		Message:       mc.Message,
+		MessageHTML:   valueobject.SanitizeMessageHTML(mc.Message),
+		Status:        string(mc.Status),
+		CreatedAt:     mc.CreatedAt,
 		UpdatedAt:     mc.UpdatedAt,
+
+		ReceiverOffsetMinutes:  mc.ReceiverOffsetMinutes,
+		EffectiveScheduledTime: mc.EffectiveScheduledTime(),
+
+		SeriesID:      mc.SeriesID,
+		SelfCall:      mc.SelfCall,
+		ForwardedFrom: mc.ForwardedFrom,
 	}
 
 	// ConfirmedAtフィールドは現在のエンティティには存在しないため、
@@ -356,8 +1926,100 @@ func (h *MorningCallHandler) convertToMorningCallResponse(mc *entity.MorningCall
 	if mc.Status == valueobject.MorningCallStatusConfirmed {
 		confirmedAt := mc.UpdatedAt
 		resp.ConfirmedAt = &confirmedAt
+		resp.ConfirmedBy = mc.ConfirmedBy
+		resp.ProxyConfirmed = mc.IsProxyConfirmed()
+		resp.AutoConfirmed = mc.AutoConfirmed
+		resp.ConfirmMethod = mc.ConfirmMethod.String()
+		if mc.TimeToConfirm != nil {
+			seconds := int64(mc.TimeToConfirm.Seconds())
+			resp.TimeToConfirmSeconds = &seconds
+		}
+	}
+
+	if mc.DeliveredAt != nil {
+		deliveredAt := *mc.DeliveredAt
+		resp.DeliveredAt = &deliveredAt
+	}
+
+	if mc.HelpfulnessRating != nil {
+		rating := *mc.HelpfulnessRating
+		resp.HelpfulnessRating = &rating
+		resp.RatedAt = mc.RatedAt
+	}
+	if mc.Stamp != "" {
+		resp.Stamp = string(mc.Stamp)
+		resp.StampAt = mc.StampAt
+	}
+
+	if mc.ConfirmDeadline != nil {
+		deadline := *mc.ConfirmDeadline
+		resp.ConfirmDeadline = &deadline
+	}
+
+	// 起床クイズは問題のみを返し、答えは誰にも返さない
+	if challenge := mc.WakeChallenge; challenge != nil {
+		resp.WakeChallenge = &response.WakeChallengeResponse{
+			Difficulty: challenge.Difficulty.String(),
+			Question:   challenge.Question,
+		}
+	}
+
+	if rr := mc.RescheduleRequest; rr != nil {
+		resp.RescheduleRequest = &response.RescheduleRequestResponse{
+			RequestedTime: rr.RequestedTime,
+			Status:        string(rr.Status),
+			RequestedAt:   rr.RequestedAt,
+			RespondedAt:   rr.RespondedAt,
+		}
+	}
+
+	if nr := mc.NextCallRequest; nr != nil {
+		resp.NextCallRequest = &response.NextCallRequestResponse{
+			RequestedTime: nr.RequestedTime,
+			Status:        string(nr.Status),
+			RequestedAt:   nr.RequestedAt,
+			RespondedAt:   nr.RespondedAt,
+			CreatedCallID: nr.CreatedCallID,
+		}
+	}
+
+	if viewerID == mc.ReceiverID {
+		silent := mc.ResolveSilentDelivery(viewer)
+		resp.SilentDelivery = &silent
+		resp.DeliveryHints = response.NewDeliveryHintsResponse(mc.ResolveDeliveryHints(viewer))
+		resp.Archived = mc.Archived
+		if mc.ArchivedAt != nil {
+			archivedAt := *mc.ArchivedAt
+			resp.ArchivedAt = &archivedAt
+		}
+	}
+
+	// 送信者には起こせなかったコールを区別できるようにする
+	if viewerID == mc.SenderID && mc.Status == valueobject.MorningCallStatusExpired {
+		resp.ExpiredUnconfirmed = true
+	}
+
+	// A/Bテストの他の候補は受信者に見せない
+	if viewerID == mc.SenderID && mc.MessageVariantIndex != nil {
+		resp.MessageVariants = append([]string(nil), mc.MessageVariants...)
+		index := *mc.MessageVariantIndex
+		resp.MessageVariantIndex = &index
+	}
+
+	if loc := mc.ConfirmLocationFor(viewerID); loc != nil {
+		resp.ConfirmLocation = &response.GeoPointResponse{
+			Latitude:  loc.Latitude,
+			Longitude: loc.Longitude,
+		}
+	}
+
+	// 管理者に削除されたコールは削除された旨のみを示し、規約違反の可能性があるメッセージは返さない
+	if mc.IsDeleted() {
+		deletedAt := *mc.DeletedAt
+		resp.DeletedAt = &deletedAt
+		resp.Message = ""
+		resp.MessageHTML = ""
 	}
 
 	return resp
 }
-
@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// queryNoMax はqueryIntで上限を設けない場合に指定する
+const queryNoMax = math.MaxInt
+
+// queryParams はクエリパラメータを型付きで取得するためのヘルパー
+// パースに失敗した項目は取得した順にバリデーションエラーとして記録し、ハンドラーでまとめて返す
+type queryParams struct {
+	values url.Values
+	errors []ValidationError
+}
+
+// newQueryParams はリクエストのクエリパラメータからヘルパーを作成する
+func newQueryParams(r *http.Request) *queryParams {
+	return &queryParams{values: r.URL.Query()}
+}
+
+// get はクエリパラメータの値を返す（未指定は空文字）
+func (q *queryParams) get(name string) string {
+	return q.values.Get(name)
+}
+
+// addError はクエリパラメータのバリデーションエラーを記録する
+// 型の変換以外の検証（他の項目との組み合わせなど）もここに記録すると、取得した順にまとめて返せる
+func (q *queryParams) addError(field, message string, code valueobject.ReasonCode) {
+	q.errors = append(q.errors, ValidationError{Field: field, Message: message, Reason: string(code)})
+}
+
+// validationErrors は記録したバリデーションエラーを返す
+func (q *queryParams) validationErrors() []ValidationError {
+	return q.errors
+}
+
+// queryInt は整数のクエリパラメータを取得する
+// 未指定・パースに失敗した場合はdefaultValueを返し、minValue以上maxValue以下でない場合もエラーを記録する（上限なしはqueryNoMax）
+func queryInt(q *queryParams, name string, defaultValue, minValue, maxValue int) int {
+	v := q.get(name)
+	if v == "" {
+		return defaultValue
+	}
+
+	n, err := strconv.Atoi(v)
+	switch {
+	case err != nil:
+		q.addError(name, intRangeMessage(name, minValue, maxValue), valueobject.ReasonCodeInvalidFormat)
+	case n < minValue || n > maxValue:
+		q.addError(name, intRangeMessage(name, minValue, maxValue), valueobject.ReasonCodeOutOfRange)
+	default:
+		return n
+	}
+	return defaultValue
+}
+
+// intRangeMessage は整数のクエリパラメータのエラーメッセージを作成する
+func intRangeMessage(name string, minValue, maxValue int) string {
+	if maxValue == queryNoMax {
+		return fmt.Sprintf("%sは%d以上の整数を指定してください", name, minValue)
+	}
+	return fmt.Sprintf("%sは%d以上%d以下の整数を指定してください", name, minValue, maxValue)
+}
+
+// queryBool は真偽値のクエリパラメータを取得する（未指定・パースに失敗した場合はdefaultValue）
+func queryBool(q *queryParams, name string, defaultValue bool) bool {
+	v := q.get(name)
+	if v == "" {
+		return defaultValue
+	}
+
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		q.addError(name, name+"はtrueまたはfalseを指定してください", valueobject.ReasonCodeInvalidFormat)
+		return defaultValue
+	}
+	return b
+}
+
+// queryTime はRFC3339形式の日時のクエリパラメータを取得する（未指定・パースに失敗した場合はnil）
+func queryTime(q *queryParams, name string) *time.Time {
+	v := q.get(name)
+	if v == "" {
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		q.addError(name, name+"はRFC3339形式（例: 2024-01-01T07:00:00+09:00）で指定してください", valueobject.ReasonCodeInvalidFormat)
+		return nil
+	}
+	return &t
+}
+
+// queryDuration は期間のクエリパラメータを取得する（例: 30s, 1m、未指定・パースに失敗した場合はdefaultValue）
+func queryDuration(q *queryParams, name string, defaultValue time.Duration) time.Duration {
+	v := q.get(name)
+	if v == "" {
+		return defaultValue
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		q.addError(name, name+"は1m、30sのような期間形式で指定してください", valueobject.ReasonCodeInvalidFormat)
+		return defaultValue
+	}
+	return d
+}
+
+// queryEnum は決められた値のいずれかをとるクエリパラメータを取得する
+// 未指定・allowedに含まれない場合はdefaultValueを返す
+func queryEnum[T ~string](q *queryParams, name string, defaultValue T, allowed ...T) T {
+	v := q.get(name)
+	if v == "" {
+		return defaultValue
+	}
+
+	for _, a := range allowed {
+		if string(a) == v {
+			return a
+		}
+	}
+	names := make([]string, len(allowed))
+	for i, a := range allowed {
+		names[i] = string(a)
+	}
+	q.addError(name, fmt.Sprintf("%sは%sのいずれかを指定してください", name, strings.Join(names, "、")), valueobject.ReasonCodeInvalid)
+	return defaultValue
+}
+
+// sendQueryErrors はクエリパラメータのバリデーションエラーがあればまとめて送信し、送信したかを返す
+func (h *BaseHandler) sendQueryErrors(w http.ResponseWriter, q *queryParams) bool {
+	errs := q.validationErrors()
+	if len(errs) == 0 {
+		return false
+	}
+	h.SendValidationError(w, errs)
+	return true
+}
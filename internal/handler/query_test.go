@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newTestQueryParams(rawQuery string) *queryParams {
+	return newQueryParams(httptest.NewRequest(http.MethodGet, "/?"+rawQuery, nil))
+}
+
+func TestQueryInt(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		min, max   int
+		want       int
+		wantReason string
+		wantMsg    string
+	}{
+		{name: "未指定はデフォルト値", query: "", min: 0, max: queryNoMax, want: 20},
+		{name: "下限ちょうど", query: "limit=1", min: 1, max: 100, want: 1},
+		{name: "上限ちょうど", query: "limit=100", min: 1, max: 100, want: 100},
+		{name: "0も上限なしで指定できる", query: "limit=0", min: 0, max: queryNoMax, want: 0},
+		{name: "負数", query: "limit=-1", min: 0, max: queryNoMax, want: 20, wantReason: "OUT_OF_RANGE", wantMsg: "limitは0以上の整数を指定してください"},
+		{name: "下限未満", query: "limit=0", min: 1, max: 100, want: 20, wantReason: "OUT_OF_RANGE", wantMsg: "limitは1以上100以下の整数を指定してください"},
+		{name: "上限超過", query: "limit=101", min: 1, max: 100, want: 20, wantReason: "OUT_OF_RANGE", wantMsg: "limitは1以上100以下の整数を指定してください"},
+		{name: "整数でない", query: "limit=ten", min: 0, max: queryNoMax, want: 20, wantReason: "INVALID_FORMAT", wantMsg: "limitは0以上の整数を指定してください"},
+		{name: "小数", query: "limit=1.5", min: 0, max: queryNoMax, want: 20, wantReason: "INVALID_FORMAT", wantMsg: "limitは0以上の整数を指定してください"},
+		{name: "桁あふれ", query: "limit=99999999999999999999", min: 0, max: queryNoMax, want: 20, wantReason: "INVALID_FORMAT", wantMsg: "limitは0以上の整数を指定してください"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := newTestQueryParams(tt.query)
+			if got := queryInt(q, "limit", 20, tt.min, tt.max); got != tt.want {
+				t.Errorf("queryInt() = %d, want %d", got, tt.want)
+			}
+			assertQueryError(t, q, "limit", tt.wantReason, tt.wantMsg)
+		})
+	}
+}
+
+func TestQueryBool(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		want       bool
+		wantReason string
+	}{
+		{name: "未指定はデフォルト値", query: "", want: true},
+		{name: "true", query: "flag=true", want: true},
+		{name: "false", query: "flag=false", want: false},
+		{name: "1と0も指定できる", query: "flag=0", want: false},
+		{name: "不正な値", query: "flag=yes", want: true, wantReason: "INVALID_FORMAT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := newTestQueryParams(tt.query)
+			if got := queryBool(q, "flag", true); got != tt.want {
+				t.Errorf("queryBool() = %v, want %v", got, tt.want)
+			}
+			msg := ""
+			if tt.wantReason != "" {
+				msg = "flagはtrueまたはfalseを指定してください"
+			}
+			assertQueryError(t, q, "flag", tt.wantReason, msg)
+		})
+	}
+}
+
+func TestQueryTime(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		want       *time.Time
+		wantReason string
+	}{
+		{name: "未指定はnil", query: ""},
+		{name: "RFC3339", query: "from=2024-01-01T07:00:00%2B09:00", want: timePtr(time.Date(2024, 1, 1, 7, 0, 0, 0, time.FixedZone("", 9*60*60)))},
+		{name: "日付のみは不正", query: "from=2024-01-01", wantReason: "INVALID_FORMAT"},
+		{name: "存在しない日付", query: "from=2024-02-30T07:00:00Z", wantReason: "INVALID_FORMAT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := newTestQueryParams(tt.query)
+			got := queryTime(q, "from")
+			if (got == nil) != (tt.want == nil) || (got != nil && !got.Equal(*tt.want)) {
+				t.Errorf("queryTime() = %v, want %v", got, tt.want)
+			}
+			msg := ""
+			if tt.wantReason != "" {
+				msg = "fromはRFC3339形式（例: 2024-01-01T07:00:00+09:00）で指定してください"
+			}
+			assertQueryError(t, q, "from", tt.wantReason, msg)
+		})
+	}
+}
+
+func TestQueryDuration(t *testing.T) {
+	q := newTestQueryParams("window=90s")
+	if got := queryDuration(q, "window", time.Minute); got != 90*time.Second {
+		t.Errorf("queryDuration() = %v, want 90s", got)
+	}
+	assertQueryError(t, q, "window", "", "")
+
+	q = newTestQueryParams("")
+	if got := queryDuration(q, "window", time.Minute); got != time.Minute {
+		t.Errorf("queryDuration() = %v, want default", got)
+	}
+
+	q = newTestQueryParams("window=5")
+	if got := queryDuration(q, "window", time.Minute); got != time.Minute {
+		t.Errorf("queryDuration() = %v, want default on error", got)
+	}
+	assertQueryError(t, q, "window", "INVALID_FORMAT", "windowは1m、30sのような期間形式で指定してください")
+}
+
+func TestQueryEnum(t *testing.T) {
+	type direction string
+
+	tests := []struct {
+		name       string
+		query      string
+		want       direction
+		wantReason string
+	}{
+		{name: "未指定はデフォルト値", query: "", want: "received"},
+		{name: "許可された値", query: "direction=sent", want: "sent"},
+		{name: "大文字小文字は区別する", query: "direction=SENT", want: "received", wantReason: "INVALID"},
+		{name: "許可されていない値", query: "direction=both", want: "received", wantReason: "INVALID"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := newTestQueryParams(tt.query)
+			if got := queryEnum[direction](q, "direction", "received", "sent", "received"); got != tt.want {
+				t.Errorf("queryEnum() = %q, want %q", got, tt.want)
+			}
+			msg := ""
+			if tt.wantReason != "" {
+				msg = "directionはsent、receivedのいずれかを指定してください"
+			}
+			assertQueryError(t, q, "direction", tt.wantReason, msg)
+		})
+	}
+}
+
+func TestBaseHandler_SendQueryErrors(t *testing.T) {
+	h := NewBaseHandler()
+
+	rec := httptest.NewRecorder()
+	if h.sendQueryErrors(rec, newTestQueryParams("limit=10")) {
+		t.Error("sendQueryErrors() = true, want false without errors")
+	}
+
+	// 不正な項目は取得した順にまとめて返す
+	q := newTestQueryParams("offset=-1&limit=abc&from=today")
+	queryInt(q, "offset", 0, 0, queryNoMax)
+	queryInt(q, "limit", 20, 1, 100)
+	queryTime(q, "from")
+	if !h.sendQueryErrors(rec, q) {
+		t.Fatal("sendQueryErrors() = false, want true")
+	}
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	var body ErrorResponse
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode body: %v", err)
+	}
+	if body.Error.Code != "VALIDATION_ERROR" || len(body.Error.Details) != 3 {
+		t.Fatalf("error = %+v, want 3 validation errors", body.Error)
+	}
+	for i, field := range []string{"offset", "limit", "from"} {
+		if body.Error.Details[i].Field != field {
+			t.Errorf("details[%d].field = %q, want %q", i, body.Error.Details[i].Field, field)
+		}
+	}
+}
+
+// assertQueryError はクエリパラメータのエラーが期待どおり記録されたかを確認する（wantReasonが空の場合はエラーなし）
+func assertQueryError(t *testing.T, q *queryParams, field, wantReason, wantMsg string) {
+	t.Helper()
+	errs := q.validationErrors()
+	if wantReason == "" {
+		if len(errs) != 0 {
+			t.Errorf("errors = %+v, want none", errs)
+		}
+		return
+	}
+	if len(errs) != 1 {
+		t.Fatalf("errors = %+v, want 1 error", errs)
+	}
+	if errs[0].Field != field || errs[0].Reason != wantReason || errs[0].Message != wantMsg {
+		t.Errorf("error = %+v, want field=%s reason=%s message=%q", errs[0], field, wantReason, wantMsg)
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -1,9 +1,11 @@
 package handler
 
 import (
-	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/ochamu/morning-call-api/internal/domain/entity"
 	"github.com/ochamu/morning-call-api/internal/handler/dto/request"
@@ -24,6 +26,14 @@ type RelationshipHandler struct {
 	removeRelationshipUC  *relUseCase.RemoveRelationshipUseCase
 	listFriendsUC         *relUseCase.ListFriendsUseCase
 	listFriendRequestsUC  *relUseCase.ListFriendRequestsUseCase
+	markRequestSeenUC     *relUseCase.MarkRequestSeenUseCase
+	unseenRequestCountUC  *relUseCase.UnseenRequestCountUseCase
+	friendCallWindowUC    *relUseCase.UpdateFriendCallWindowUseCase
+	updateAutoConfirmUC   *relUseCase.UpdateAutoConfirmUseCase
+	favoriteSenderUC      *relUseCase.UpdateFavoriteSenderUseCase
+	generateInviteUC      *relUseCase.GenerateFriendInviteTokenUseCase
+	acceptInviteUC        *relUseCase.AcceptFriendInviteUseCase
+	friendGraphUC         *relUseCase.ExportFriendGraphUseCase
 	userUC                *user.UserUseCase
 	sessionManager        *auth.SessionManager
 }
@@ -38,6 +48,14 @@ func NewRelationshipHandler(
 	removeRelationshipUC *relUseCase.RemoveRelationshipUseCase,
 	listFriendsUC *relUseCase.ListFriendsUseCase,
 	listFriendRequestsUC *relUseCase.ListFriendRequestsUseCase,
+	markRequestSeenUC *relUseCase.MarkRequestSeenUseCase,
+	unseenRequestCountUC *relUseCase.UnseenRequestCountUseCase,
+	friendCallWindowUC *relUseCase.UpdateFriendCallWindowUseCase,
+	updateAutoConfirmUC *relUseCase.UpdateAutoConfirmUseCase,
+	favoriteSenderUC *relUseCase.UpdateFavoriteSenderUseCase,
+	generateInviteUC *relUseCase.GenerateFriendInviteTokenUseCase,
+	acceptInviteUC *relUseCase.AcceptFriendInviteUseCase,
+	friendGraphUC *relUseCase.ExportFriendGraphUseCase,
 	userUC *user.UserUseCase,
 	sessionManager *auth.SessionManager,
 ) *RelationshipHandler {
@@ -51,6 +69,14 @@ func NewRelationshipHandler(
 		removeRelationshipUC:  removeRelationshipUC,
 		listFriendsUC:         listFriendsUC,
 		listFriendRequestsUC:  listFriendRequestsUC,
+		markRequestSeenUC:     markRequestSeenUC,
+		unseenRequestCountUC:  unseenRequestCountUC,
+		friendCallWindowUC:    friendCallWindowUC,
+		updateAutoConfirmUC:   updateAutoConfirmUC,
+		favoriteSenderUC:      favoriteSenderUC,
+		generateInviteUC:      generateInviteUC,
+		acceptInviteUC:        acceptInviteUC,
+		friendGraphUC:         friendGraphUC,
 		userUC:                userUC,
 		sessionManager:        sessionManager,
 	}
@@ -67,8 +93,8 @@ func (h *RelationshipHandler) HandleSendFriendRequest(w http.ResponseWriter, r *
 
 	// リクエストボディの解析
 	var req request.SendFriendRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.SendError(w, http.StatusBadRequest, "PARSE_ERROR", "リクエストの形式が正しくありません", nil)
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "PARSE_ERROR", err)
 		return
 	}
 
@@ -84,20 +110,7 @@ func (h *RelationshipHandler) HandleSendFriendRequest(w http.ResponseWriter, r *
 		ReceiverID:  req.ReceiverID,
 	})
 	if err != nil {
-		// エラー内容に応じて適切なレスポンスを返す
-		if strings.Contains(err.Error(), "自分自身") {
-			h.SendError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
-			return
-		}
-		if strings.Contains(err.Error(), "既に") || strings.Contains(err.Error(), "ブロック") {
-			h.SendError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
-			return
-		}
-		if strings.Contains(err.Error(), "見つかりません") {
-			h.SendError(w, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
-			return
-		}
-		h.SendError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "友達リクエストの送信に失敗しました", nil)
+		h.SendMappedError(w, err)
 		return
 	}
 
@@ -108,6 +121,91 @@ func (h *RelationshipHandler) HandleSendFriendRequest(w http.ResponseWriter, r *
 	})
 }
 
+// HandleGenerateFriendInvite は自分への友達リクエスト用の招待トークン発行のハンドラー
+// POST /api/v1/relationships/invites
+func (h *RelationshipHandler) HandleGenerateFriendInvite(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.SendMethodNotAllowed(w, http.MethodPost)
+		return
+	}
+
+	// 認証チェック
+	currentUser, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// リクエストボディのパース（ボディは任意）
+	var req request.GenerateFriendInviteRequest
+	if err := h.ParseJSON(r, &req); err != nil && !errors.Is(err, io.EOF) {
+		h.SendJSONDecodeError(w, "PARSE_ERROR", err)
+		return
+	}
+
+	output, err := h.generateInviteUC.Execute(r.Context(), relUseCase.GenerateFriendInviteTokenInput{
+		InviterID: currentUser.ID,
+		MaxUses:   req.MaxUses,
+		ExpiresIn: time.Duration(req.ExpiresInMinutes) * time.Minute,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンス
+	h.SendJSON(w, http.StatusCreated, response.FriendInviteResponse{
+		Token:     output.Invite.Token,
+		URI:       output.URI,
+		MaxUses:   output.Invite.MaxUses,
+		ExpiresAt: output.Invite.ExpiresAt,
+	})
+}
+
+// HandleAcceptFriendInvite は招待トークンを使用して発行者へ友達リクエストを送信するハンドラー
+// POST /api/v1/relationships/accept-invite
+func (h *RelationshipHandler) HandleAcceptFriendInvite(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.SendMethodNotAllowed(w, http.MethodPost)
+		return
+	}
+
+	// 認証チェック
+	currentUser, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// リクエストボディの解析
+	var req request.AcceptFriendInviteRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "PARSE_ERROR", err)
+		return
+	}
+
+	// 入力検証
+	if req.Token == "" {
+		h.SendError(w, http.StatusBadRequest, "VALIDATION_ERROR", "招待トークンが必要です", nil)
+		return
+	}
+
+	output, err := h.acceptInviteUC.Execute(r.Context(), relUseCase.AcceptFriendInviteInput{
+		Token:  req.Token,
+		UserID: currentUser.ID,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンス
+	h.SendJSON(w, http.StatusCreated, map[string]interface{}{
+		"relationship": response.NewRelationshipResponse(output.Relationship),
+		"id":           output.Relationship.ID,
+	})
+}
+
 // HandleAcceptFriendRequest は友達リクエスト承認のハンドラー
 func (h *RelationshipHandler) HandleAcceptFriendRequest(w http.ResponseWriter, r *http.Request) {
 	// 認証チェック
@@ -120,7 +218,7 @@ func (h *RelationshipHandler) HandleAcceptFriendRequest(w http.ResponseWriter, r
 	// URLパラメータから関係IDを取得
 	parts := strings.Split(r.URL.Path, "/")
 	if len(parts) < 5 || parts[len(parts)-1] != "accept" {
-		h.SendError(w, http.StatusBadRequest, "INVALID_REQUEST", "無効なリクエストパスです", nil)
+		h.SendInvalidRequest(w, "無効なリクエストパスです")
 		return
 	}
 	relationshipID := parts[len(parts)-2]
@@ -131,15 +229,7 @@ func (h *RelationshipHandler) HandleAcceptFriendRequest(w http.ResponseWriter, r
 		ReceiverID:     currentUser.ID,
 	})
 	if err != nil {
-		if strings.Contains(err.Error(), "見つかりません") {
-			h.SendError(w, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
-			return
-		}
-		if strings.Contains(err.Error(), "権限") || strings.Contains(err.Error(), "承認できません") {
-			h.SendError(w, http.StatusForbidden, "FORBIDDEN", err.Error(), nil)
-			return
-		}
-		h.SendError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "友達リクエストの承認に失敗しました", nil)
+		h.SendMappedError(w, err)
 		return
 	}
 
@@ -161,7 +251,7 @@ func (h *RelationshipHandler) HandleRejectFriendRequest(w http.ResponseWriter, r
 	// URLパラメータから関係IDを取得
 	parts := strings.Split(r.URL.Path, "/")
 	if len(parts) < 5 || parts[len(parts)-1] != "reject" {
-		h.SendError(w, http.StatusBadRequest, "INVALID_REQUEST", "無効なリクエストパスです", nil)
+		h.SendInvalidRequest(w, "無効なリクエストパスです")
 		return
 	}
 	relationshipID := parts[len(parts)-2]
@@ -172,15 +262,7 @@ func (h *RelationshipHandler) HandleRejectFriendRequest(w http.ResponseWriter, r
 		ReceiverID:     currentUser.ID,
 	})
 	if err != nil {
-		if strings.Contains(err.Error(), "見つかりません") {
-			h.SendError(w, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
-			return
-		}
-		if strings.Contains(err.Error(), "権限") || strings.Contains(err.Error(), "拒否できません") {
-			h.SendError(w, http.StatusForbidden, "FORBIDDEN", err.Error(), nil)
-			return
-		}
-		h.SendError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "友達リクエストの拒否に失敗しました", nil)
+		h.SendMappedError(w, err)
 		return
 	}
 
@@ -188,8 +270,218 @@ func (h *RelationshipHandler) HandleRejectFriendRequest(w http.ResponseWriter, r
 	if output != nil {
 		h.SendJSON(w, http.StatusOK, response.NewRelationshipResponse(output.Relationship))
 	} else {
-		h.SendJSON(w, http.StatusNoContent, nil)
+		h.SendNoContent(w)
+	}
+}
+
+// HandleMarkRequestSeen は友達リクエスト既読化のハンドラー
+// PUT /api/v1/relationships/{id}/seen
+func (h *RelationshipHandler) HandleMarkRequestSeen(w http.ResponseWriter, r *http.Request) {
+	// 認証チェック
+	currentUser, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// URLパラメータから関係IDを取得
+	parts := strings.Split(r.URL.Path, "/")
+	if len(parts) < 5 || parts[len(parts)-1] != "seen" {
+		h.SendInvalidRequest(w, "無効なリクエストパスです")
+		return
+	}
+	relationshipID := parts[len(parts)-2]
+
+	// 友達リクエスト既読化
+	output, err := h.markRequestSeenUC.Execute(r.Context(), relUseCase.MarkRequestSeenInput{
+		RelationshipID: relationshipID,
+		ReceiverID:     currentUser.ID,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンス
+	h.SendJSON(w, http.StatusOK, response.NewRelationshipResponse(output.Relationship))
+}
+
+// HandleUpdateFriendCallWindow は友達ごとの受信時間帯設定のハンドラー
+// PUT /api/v1/relationships/{id}/call-window
+func (h *RelationshipHandler) HandleUpdateFriendCallWindow(w http.ResponseWriter, r *http.Request) {
+	// PUTメソッドのみ許可
+	if r.Method != http.MethodPut {
+		h.SendMethodNotAllowed(w, http.MethodPut)
+		return
+	}
+
+	// 認証チェック
+	currentUser, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// URLパラメータから関係IDを取得
+	parts := strings.Split(r.URL.Path, "/")
+	if len(parts) < 5 || parts[len(parts)-1] != "call-window" {
+		h.SendInvalidRequest(w, "無効なリクエストパスです")
+		return
+	}
+	relationshipID := parts[len(parts)-2]
+
+	// リクエストボディのパース
+	var req request.UpdateCallWindowRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "PARSE_ERROR", err)
+		return
+	}
+
+	window, validationErrs := req.ParseCallWindow()
+	if len(validationErrs) > 0 {
+		var validationErrors []ValidationError
+		for field, message := range validationErrs {
+			validationErrors = append(validationErrors, ValidationError{Field: field, Message: message})
+		}
+		h.SendValidationError(w, validationErrors)
+		return
+	}
+
+	// 友達ごとの受信時間帯を設定
+	output, err := h.friendCallWindowUC.Execute(r.Context(), relUseCase.UpdateFriendCallWindowInput{
+		RelationshipID: relationshipID,
+		UserID:         currentUser.ID,
+		CallWindow:     window,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンス
+	h.SendJSON(w, http.StatusOK, response.FriendCallWindowResponse{
+		RelationshipID: output.Relationship.ID,
+		CallWindow:     response.NewCallWindowResponse(output.Relationship.CallWindowFor(currentUser.ID)),
+	})
+}
+
+// HandleUpdateAutoConfirm は友達からのモーニングコールの自動確認設定のハンドラー
+// PUT /api/v1/relationships/{id}/auto-confirm
+func (h *RelationshipHandler) HandleUpdateAutoConfirm(w http.ResponseWriter, r *http.Request) {
+	// PUTメソッドのみ許可
+	if r.Method != http.MethodPut {
+		h.SendMethodNotAllowed(w, http.MethodPut)
+		return
+	}
+
+	// 認証チェック
+	currentUser, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// URLパラメータから関係IDを取得
+	parts := strings.Split(r.URL.Path, "/")
+	if len(parts) < 5 || parts[len(parts)-1] != "auto-confirm" {
+		h.SendInvalidRequest(w, "無効なリクエストパスです")
+		return
+	}
+	relationshipID := parts[len(parts)-2]
+
+	// リクエストボディのパース
+	var req request.UpdateAutoConfirmRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "PARSE_ERROR", err)
+		return
+	}
+	if validationErrs := req.Validate(); len(validationErrs) > 0 {
+		var validationErrors []ValidationError
+		for field, message := range validationErrs {
+			validationErrors = append(validationErrors, ValidationError{Field: field, Message: message})
+		}
+		h.SendValidationError(w, validationErrors)
+		return
+	}
+
+	// 自動確認を設定
+	output, err := h.updateAutoConfirmUC.Execute(r.Context(), relUseCase.UpdateAutoConfirmInput{
+		RelationshipID: relationshipID,
+		UserID:         currentUser.ID,
+		AutoConfirm:    *req.AutoConfirm,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンス
+	h.SendJSON(w, http.StatusOK, response.FriendAutoConfirmResponse{
+		RelationshipID: output.Relationship.ID,
+		AutoConfirm:    output.Relationship.AutoConfirmFor(currentUser.ID),
+	})
+}
+
+// HandleUpdateFavoriteSender は友達をお気に入り送信元に登録・解除するハンドラー
+// PUT /api/v1/relationships/{id}/favorite-sender
+func (h *RelationshipHandler) HandleUpdateFavoriteSender(w http.ResponseWriter, r *http.Request) {
+	// PUTメソッドのみ許可
+	if r.Method != http.MethodPut {
+		h.SendMethodNotAllowed(w, http.MethodPut)
+		return
+	}
+
+	// 認証チェック
+	currentUser, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// URLパラメータから関係IDを取得
+	parts := strings.Split(r.URL.Path, "/")
+	if len(parts) < 5 || parts[len(parts)-1] != "favorite-sender" {
+		h.SendInvalidRequest(w, "無効なリクエストパスです")
+		return
+	}
+	relationshipID := parts[len(parts)-2]
+
+	// リクエストボディのパース
+	var req request.UpdateFavoriteSenderRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "PARSE_ERROR", err)
+		return
+	}
+	if validationErrs := req.Validate(); len(validationErrs) > 0 {
+		var validationErrors []ValidationError
+		for field, message := range validationErrs {
+			validationErrors = append(validationErrors, ValidationError{Field: field, Message: message})
+		}
+		h.SendValidationError(w, validationErrors)
+		return
+	}
+
+	// お気に入り送信元を設定
+	output, err := h.favoriteSenderUC.Execute(r.Context(), relUseCase.UpdateFavoriteSenderInput{
+		RelationshipID: relationshipID,
+		UserID:         currentUser.ID,
+		Favorite:       *req.Favorite,
+		AlwaysRing:     req.AlwaysRing,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
 	}
+
+	// レスポンス
+	resp := response.FriendFavoriteSenderResponse{
+		RelationshipID: output.Relationship.ID,
+	}
+	if favorite := output.Relationship.FavoriteSenderFor(currentUser.ID); favorite != nil {
+		resp.Favorite = true
+		resp.AlwaysRing = favorite.AlwaysRing
+	}
+	h.SendJSON(w, http.StatusOK, resp)
 }
 
 // HandleBlockUser はユーザーブロックのハンドラー
@@ -204,7 +496,7 @@ func (h *RelationshipHandler) HandleBlockUser(w http.ResponseWriter, r *http.Req
 	// URLパラメータから関係IDを取得
 	parts := strings.Split(r.URL.Path, "/")
 	if len(parts) < 5 || parts[len(parts)-1] != "block" {
-		h.SendError(w, http.StatusBadRequest, "INVALID_REQUEST", "無効なリクエストパスです", nil)
+		h.SendInvalidRequest(w, "無効なリクエストパスです")
 		return
 	}
 	relationshipID := parts[len(parts)-2]
@@ -215,15 +507,7 @@ func (h *RelationshipHandler) HandleBlockUser(w http.ResponseWriter, r *http.Req
 		BlockerID:      currentUser.ID,
 	})
 	if err != nil {
-		if strings.Contains(err.Error(), "見つかりません") {
-			h.SendError(w, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
-			return
-		}
-		if strings.Contains(err.Error(), "自分自身") {
-			h.SendError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
-			return
-		}
-		h.SendError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "ユーザーのブロックに失敗しました", nil)
+		h.SendMappedError(w, err)
 		return
 	}
 
@@ -245,7 +529,7 @@ func (h *RelationshipHandler) HandleRemoveRelationship(w http.ResponseWriter, r
 	// URLパラメータから関係IDを取得
 	parts := strings.Split(r.URL.Path, "/")
 	if len(parts) < 4 {
-		h.SendError(w, http.StatusBadRequest, "INVALID_REQUEST", "無効なリクエストパスです", nil)
+		h.SendInvalidRequest(w, "無効なリクエストパスです")
 		return
 	}
 	relationshipID := parts[len(parts)-1]
@@ -256,15 +540,7 @@ func (h *RelationshipHandler) HandleRemoveRelationship(w http.ResponseWriter, r
 		UserID:         currentUser.ID,
 	})
 	if err != nil {
-		if strings.Contains(err.Error(), "見つかりません") {
-			h.SendError(w, http.StatusNotFound, "NOT_FOUND", err.Error(), nil)
-			return
-		}
-		if strings.Contains(err.Error(), "権限") {
-			h.SendError(w, http.StatusForbidden, "FORBIDDEN", err.Error(), nil)
-			return
-		}
-		h.SendError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "関係の削除に失敗しました", nil)
+		h.SendMappedError(w, err)
 		return
 	}
 
@@ -275,6 +551,7 @@ func (h *RelationshipHandler) HandleRemoveRelationship(w http.ResponseWriter, r
 }
 
 // HandleListFriends は友達一覧取得のハンドラー
+// GET /api/v1/relationships/friends?sort=activity
 func (h *RelationshipHandler) HandleListFriends(w http.ResponseWriter, r *http.Request) {
 	// 認証チェック
 	currentUser, err := h.GetUserFromContext(r.Context())
@@ -286,21 +563,33 @@ func (h *RelationshipHandler) HandleListFriends(w http.ResponseWriter, r *http.R
 	// 友達一覧取得
 	output, err := h.listFriendsUC.Execute(r.Context(), relUseCase.ListFriendsInput{
 		UserID: currentUser.ID,
+		Sort:   relUseCase.FriendSortOrder(h.GetQueryParam(r, "sort", "")),
 	})
 	if err != nil {
-		h.SendError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "友達一覧の取得に失敗しました", nil)
+		h.SendMappedError(w, err)
 		return
 	}
 
 	// 友達情報を取得して詳細なレスポンスを作成
 	friendResponses := make([]*response.FriendResponse, 0, len(output.Friends))
 	for _, friendInfo := range output.Friends {
-		friendResponses = append(friendResponses, &response.FriendResponse{
+		friendResponse := &response.FriendResponse{
 			ID:          friendInfo.User.ID,
 			Username:    friendInfo.User.Username,
 			Email:       friendInfo.User.Email,
 			FriendSince: friendInfo.Relationship.UpdatedAt, // 友達になった日時
-		})
+
+			FavoriteSender: friendInfo.Relationship.FavoriteSenderFor(currentUser.ID) != nil,
+		}
+		if mc := friendInfo.LatestCall; mc != nil {
+			friendResponse.LatestCall = &response.FriendLatestCallResponse{
+				ID:            mc.ID,
+				ScheduledTime: mc.ScheduledTime,
+				Status:        mc.Status.String(),
+				IsSender:      mc.SenderID == currentUser.ID,
+			}
+		}
+		friendResponses = append(friendResponses, friendResponse)
 	}
 
 	// レスポンス
@@ -319,10 +608,11 @@ func (h *RelationshipHandler) HandleListFriendRequests(w http.ResponseWriter, r
 		return
 	}
 
-	// クエリパラメータで方向を指定（sent/received）
-	direction := r.URL.Query().Get("direction")
-	if direction == "" {
-		direction = "received" // デフォルトは受信したリクエスト
+	// クエリパラメータで方向を指定（sent/received、デフォルトは受信したリクエスト）
+	q := newQueryParams(r)
+	direction := queryEnum(q, "direction", "received", "sent", "received")
+	if h.sendQueryErrors(w, q) {
+		return
 	}
 
 	// 友達リクエスト一覧取得
@@ -335,7 +625,7 @@ func (h *RelationshipHandler) HandleListFriendRequests(w http.ResponseWriter, r
 			Type:   "sent",
 		})
 		if err != nil {
-			h.SendError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "友達リクエスト一覧の取得に失敗しました", nil)
+			h.SendMappedError(w, err)
 			return
 		}
 		for _, reqInfo := range output.Requests {
@@ -348,17 +638,94 @@ func (h *RelationshipHandler) HandleListFriendRequests(w http.ResponseWriter, r
 			Type:   "received",
 		})
 		if err != nil {
-			h.SendError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "友達リクエスト一覧の取得に失敗しました", nil)
+			h.SendMappedError(w, err)
 			return
 		}
 		for _, reqInfo := range output.Requests {
 			relationships = append(relationships, reqInfo.Relationship)
 		}
-	default:
-		h.SendError(w, http.StatusBadRequest, "INVALID_REQUEST", "無効な方向指定です（sent/receivedのいずれかを指定してください）", nil)
-		return
 	}
 
 	// レスポンス
 	h.SendJSON(w, http.StatusOK, response.NewFriendRequestListResponse(relationships))
 }
+
+// HandleFriendGraph は自分を中心とした友達関係グラフ取得のハンドラー
+// GET /api/v1/relationships/graph?depth=2
+func (h *RelationshipHandler) HandleFriendGraph(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.SendMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	// 認証チェック
+	currentUser, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	// 深さをパース（未指定はユースケースのデフォルト）
+	q := newQueryParams(r)
+	depth := queryInt(q, "depth", 0, 1, relUseCase.MaxFriendGraphDepth)
+	if h.sendQueryErrors(w, q) {
+		return
+	}
+
+	output, err := h.friendGraphUC.Execute(r.Context(), relUseCase.ExportFriendGraphInput{
+		UserID: currentUser.ID,
+		Depth:  depth,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	resp := response.FriendGraphResponse{
+		Nodes:     make([]response.FriendGraphNodeResponse, 0, len(output.Nodes)),
+		Edges:     make([]response.FriendGraphEdgeResponse, 0, len(output.Edges)),
+		Truncated: output.Truncated,
+	}
+	for _, node := range output.Nodes {
+		resp.Nodes = append(resp.Nodes, response.FriendGraphNodeResponse{
+			ID:       node.User.ID,
+			Username: node.User.Username,
+			Depth:    node.Depth,
+		})
+	}
+	for _, edge := range output.Edges {
+		resp.Edges = append(resp.Edges, response.FriendGraphEdgeResponse{
+			Source: edge.SourceID,
+			Target: edge.TargetID,
+		})
+	}
+	h.SendJSON(w, http.StatusOK, resp)
+}
+
+// HandleUnseenRequestCount は未読の友達リクエスト件数取得のハンドラー
+// GET /api/v1/relationships/requests/unseen-count
+func (h *RelationshipHandler) HandleUnseenRequestCount(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.SendMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	// 認証チェック
+	currentUser, err := h.GetUserFromContext(r.Context())
+	if err != nil {
+		h.SendAuthenticationError(w)
+		return
+	}
+
+	output, err := h.unseenRequestCountUC.Execute(r.Context(), relUseCase.UnseenRequestCountInput{
+		ReceiverID: currentUser.ID,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	h.SendJSON(w, http.StatusOK, response.UnseenRequestCountResponse{
+		Count: output.Count,
+	})
+}
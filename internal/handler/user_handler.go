@@ -3,9 +3,11 @@ package handler
 import (
 	"errors"
 	"net/http"
+	"strings"
 
 	"github.com/ochamu/morning-call-api/internal/domain/entity"
 	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
 	"github.com/ochamu/morning-call-api/internal/handler/dto/request"
 	"github.com/ochamu/morning-call-api/internal/handler/dto/response"
 	"github.com/ochamu/morning-call-api/internal/infrastructure/auth"
@@ -15,16 +17,70 @@ import (
 // UserHandler はユーザー関連のハンドラー
 type UserHandler struct {
 	*BaseHandler
-	userUseCase    *user.UserUseCase
-	sessionManager *auth.SessionManager
+	userUseCase               *user.UserUseCase
+	searchUsersUseCase        *user.SearchUsersUseCase
+	requestEmailChangeUseCase *user.RequestEmailChangeUseCase
+	confirmEmailChangeUseCase *user.ConfirmEmailChangeUseCase
+	updateCallApprovalUseCase *user.UpdateCallApprovalUseCase
+	updateProxyConfirmerUC    *user.UpdateProxyConfirmerUseCase
+	updateCallForwardingUC    *user.UpdateCallForwardingUseCase
+	updateTimeZoneUseCase     *user.UpdateTimeZoneUseCase
+	updatePreferencesUC       *user.UpdatePreferencesUseCase
+	changePasswordUseCase     *user.ChangePasswordUseCase
+	updateCallWindowUseCase   *user.UpdateCallWindowUseCase
+	checkAvailabilityUC       *user.CheckAvailabilityUseCase
+	leaderboardUC             *user.LeaderboardUseCase
+	changeUsernameUC          *user.ChangeUsernameUseCase
+	registerDeviceUC          *user.RegisterDeviceTokenUseCase
+	listDevicesUC             *user.ListDeviceTokensUseCase
+	deleteDeviceUC            *user.DeleteDeviceTokenUseCase
+	requestAccountDeletionUC  *user.RequestAccountDeletionUseCase
+	sessionManager            *auth.SessionManager
 }
 
 // NewUserHandler は新しいユーザーハンドラーを作成する
-func NewUserHandler(userUseCase *user.UserUseCase, sessionManager *auth.SessionManager) *UserHandler {
+func NewUserHandler(
+	userUseCase *user.UserUseCase,
+	searchUsersUseCase *user.SearchUsersUseCase,
+	requestEmailChangeUseCase *user.RequestEmailChangeUseCase,
+	confirmEmailChangeUseCase *user.ConfirmEmailChangeUseCase,
+	updateCallApprovalUseCase *user.UpdateCallApprovalUseCase,
+	updateProxyConfirmerUC *user.UpdateProxyConfirmerUseCase,
+	updateCallForwardingUC *user.UpdateCallForwardingUseCase,
+	updateTimeZoneUseCase *user.UpdateTimeZoneUseCase,
+	updatePreferencesUC *user.UpdatePreferencesUseCase,
+	changePasswordUseCase *user.ChangePasswordUseCase,
+	updateCallWindowUseCase *user.UpdateCallWindowUseCase,
+	checkAvailabilityUC *user.CheckAvailabilityUseCase,
+	leaderboardUC *user.LeaderboardUseCase,
+	changeUsernameUC *user.ChangeUsernameUseCase,
+	registerDeviceUC *user.RegisterDeviceTokenUseCase,
+	listDevicesUC *user.ListDeviceTokensUseCase,
+	deleteDeviceUC *user.DeleteDeviceTokenUseCase,
+	requestAccountDeletionUC *user.RequestAccountDeletionUseCase,
+	sessionManager *auth.SessionManager,
+) *UserHandler {
 	return &UserHandler{
-		BaseHandler:    NewBaseHandler(),
-		userUseCase:    userUseCase,
-		sessionManager: sessionManager,
+		BaseHandler:               NewBaseHandler(),
+		userUseCase:               userUseCase,
+		searchUsersUseCase:        searchUsersUseCase,
+		requestEmailChangeUseCase: requestEmailChangeUseCase,
+		confirmEmailChangeUseCase: confirmEmailChangeUseCase,
+		updateCallApprovalUseCase: updateCallApprovalUseCase,
+		updateProxyConfirmerUC:    updateProxyConfirmerUC,
+		updateCallForwardingUC:    updateCallForwardingUC,
+		updateTimeZoneUseCase:     updateTimeZoneUseCase,
+		updatePreferencesUC:       updatePreferencesUC,
+		changePasswordUseCase:     changePasswordUseCase,
+		updateCallWindowUseCase:   updateCallWindowUseCase,
+		checkAvailabilityUC:       checkAvailabilityUC,
+		leaderboardUC:             leaderboardUC,
+		changeUsernameUC:          changeUsernameUC,
+		registerDeviceUC:          registerDeviceUC,
+		listDevicesUC:             listDevicesUC,
+		deleteDeviceUC:            deleteDeviceUC,
+		requestAccountDeletionUC:  requestAccountDeletionUC,
+		sessionManager:            sessionManager,
 	}
 }
 
@@ -33,14 +89,14 @@ func NewUserHandler(userUseCase *user.UserUseCase, sessionManager *auth.SessionM
 func (h *UserHandler) HandleRegister(w http.ResponseWriter, r *http.Request) {
 	// POSTメソッドのみ許可
 	if r.Method != http.MethodPost {
-		h.SendError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "POSTメソッドのみ許可されています", nil)
+		h.SendMethodNotAllowed(w, http.MethodPost)
 		return
 	}
 
 	// リクエストボディをパース
 	var req request.RegisterRequest
 	if err := h.ParseJSON(r, &req); err != nil {
-		h.SendError(w, http.StatusBadRequest, "INVALID_REQUEST", "リクエストの形式が不正です", nil)
+		h.SendJSONDecodeError(w, "INVALID_REQUEST", err)
 		return
 	}
 
@@ -71,13 +127,7 @@ func (h *UserHandler) HandleRegister(w http.ResponseWriter, r *http.Request) {
 			h.SendError(w, http.StatusConflict, "ALREADY_EXISTS", "ユーザー名またはメールアドレスが既に使用されています", nil)
 			return
 		}
-		// バリデーションエラーの場合
-		if err.Error() == "ユーザー名は必須です" || err.Error() == "メールアドレスは必須です" || err.Error() == "パスワードは必須です" {
-			h.SendError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error(), nil)
-			return
-		}
-		// その他のエラー
-		h.SendInternalServerError(w, err)
+		h.SendMappedError(w, err)
 		return
 	}
 
@@ -113,7 +163,7 @@ func (h *UserHandler) HandleRegister(w http.ResponseWriter, r *http.Request) {
 func (h *UserHandler) HandleGetProfile(w http.ResponseWriter, r *http.Request) {
 	// GETメソッドのみ許可
 	if r.Method != http.MethodGet {
-		h.SendError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "GETメソッドのみ許可されています", nil)
+		h.SendMethodNotAllowed(w, http.MethodGet)
 		return
 	}
 
@@ -123,8 +173,8 @@ func (h *UserHandler) HandleGetProfile(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// レスポンスを返す
-	h.SendJSON(w, http.StatusOK, map[string]interface{}{
+	// レスポンスを返す（変更がなければ304を返す）
+	h.SendJSONWithETag(w, r, map[string]interface{}{
 		"user": h.convertToUserDTO(currentUser),
 	})
 }
@@ -134,7 +184,7 @@ func (h *UserHandler) HandleGetProfile(w http.ResponseWriter, r *http.Request) {
 func (h *UserHandler) HandleSearchUsers(w http.ResponseWriter, r *http.Request) {
 	// GETメソッドのみ許可
 	if r.Method != http.MethodGet {
-		h.SendError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "GETメソッドのみ許可されています", nil)
+		h.SendMethodNotAllowed(w, http.MethodGet)
 		return
 	}
 
@@ -147,25 +197,29 @@ func (h *UserHandler) HandleSearchUsers(w http.ResponseWriter, r *http.Request)
 	// クエリパラメータを取得
 	query := h.GetQueryParam(r, "query", "")
 	if query == "" {
-		h.SendError(w, http.StatusBadRequest, "INVALID_REQUEST", "検索クエリが指定されていません", nil)
+		h.SendInvalidRequest(w, "検索クエリが指定されていません")
 		return
 	}
 
 	// ユーザー検索を実行
-	searchOutput, err := h.userUseCase.SearchUsers(r.Context(), user.SearchUsersInput{
-		Query:     query,
-		ExcludeID: currentUser.ID, // 自分自身を除外
-		Limit:     100,
+	searchOutput, err := h.searchUsersUseCase.Execute(r.Context(), user.SearchUsersInput{
+		SearcherID: currentUser.ID, // 自分自身は除外される
+		Query:      query,
+		Limit:      100,
 	})
 	if err != nil {
-		h.SendError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "ユーザー検索に失敗しました", nil)
+		h.SendMappedError(w, err)
 		return
 	}
 
 	// DTOに変換
-	var users []response.UserDTO
-	for _, u := range searchOutput.Users {
-		users = append(users, h.convertToUserDTO(u))
+	var users []response.UserSearchResultDTO
+	for _, result := range searchOutput.Results {
+		users = append(users, response.UserSearchResultDTO{
+			UserDTO:            h.convertToUserDTO(result.User),
+			RelationshipStatus: string(result.RelationshipState),
+			CanSendMorningCall: result.CanSendMorningCall,
+		})
 	}
 
 	// レスポンスを返す
@@ -175,12 +229,88 @@ func (h *UserHandler) HandleSearchUsers(w http.ResponseWriter, r *http.Request)
 	})
 }
 
+// HandleCheckAvailability はユーザー名・メールアドレスが利用可能かを確認する
+// GET /api/v1/users/check?username=xxx&email=xxx
+// アカウントの列挙を防ぐため、認証済みのユーザーのみが一定の回数まで利用できる
+func (h *UserHandler) HandleCheckAvailability(w http.ResponseWriter, r *http.Request) {
+	// GETメソッドのみ許可
+	if r.Method != http.MethodGet {
+		h.SendMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	// 認証が必要
+	currentUser, ok := h.RequireAuth(w, r)
+	if !ok {
+		return
+	}
+
+	output, err := h.checkAvailabilityUC.Execute(r.Context(), user.CheckAvailabilityInput{
+		RequesterID: currentUser.ID,
+		Username:    h.GetQueryParam(r, "username", ""),
+		Email:       h.GetQueryParam(r, "email", ""),
+	})
+	if err != nil {
+		if errors.Is(err, user.ErrAvailabilityCheckLimited) {
+			h.SendError(w, http.StatusTooManyRequests, "RATE_LIMITED", err.Error(), nil)
+			return
+		}
+		h.SendMappedError(w, err)
+		return
+	}
+
+	h.SendJSON(w, http.StatusOK, response.AvailabilityResponse{
+		UsernameAvailable: output.UsernameAvailable,
+		EmailAvailable:    output.EmailAvailable,
+	})
+}
+
+// HandleLeaderboard は感謝ポイントの上位ユーザーを取得する
+// GET /api/v1/leaderboard?limit=10
+func (h *UserHandler) HandleLeaderboard(w http.ResponseWriter, r *http.Request) {
+	// GETメソッドのみ許可
+	if r.Method != http.MethodGet {
+		h.SendMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	// 認証が必要
+	if _, ok := h.RequireAuth(w, r); !ok {
+		return
+	}
+
+	// 取得件数をパース
+	q := newQueryParams(r)
+	limit := queryInt(q, "limit", 0, 0, queryNoMax)
+	if h.sendQueryErrors(w, q) {
+		return
+	}
+
+	output, err := h.leaderboardUC.Execute(r.Context(), user.LeaderboardInput{Limit: limit})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// メールアドレスなどは含めず、表示に必要な項目のみ返す
+	entries := make([]response.LeaderboardEntryResponse, 0, len(output.Entries))
+	for _, e := range output.Entries {
+		entries = append(entries, response.LeaderboardEntryResponse{
+			Rank:     e.Rank,
+			UserID:   e.User.ID,
+			Username: e.User.Username,
+			Points:   e.User.Points,
+		})
+	}
+	h.SendJSON(w, http.StatusOK, response.LeaderboardResponse{Entries: entries})
+}
+
 // HandleGetUserByID は指定したIDのユーザー情報を取得する
 // GET /api/v1/users/{id}
 func (h *UserHandler) HandleGetUserByID(w http.ResponseWriter, r *http.Request) {
 	// GETメソッドのみ許可
 	if r.Method != http.MethodGet {
-		h.SendError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "GETメソッドのみ許可されています", nil)
+		h.SendMethodNotAllowed(w, http.MethodGet)
 		return
 	}
 
@@ -197,13 +327,13 @@ func (h *UserHandler) HandleGetUserByID(w http.ResponseWriter, r *http.Request)
 	path := r.URL.Path
 	prefix := "/api/v1/users/"
 	if len(path) <= len(prefix) {
-		h.SendError(w, http.StatusBadRequest, "INVALID_REQUEST", "ユーザーIDが指定されていません", nil)
+		h.SendInvalidRequest(w, "ユーザーIDが指定されていません")
 		return
 	}
 
 	userID := path[len(prefix):]
 	if userID == "" {
-		h.SendError(w, http.StatusBadRequest, "INVALID_REQUEST", "ユーザーIDが指定されていません", nil)
+		h.SendInvalidRequest(w, "ユーザーIDが指定されていません")
 		return
 	}
 
@@ -211,16 +341,623 @@ func (h *UserHandler) HandleGetUserByID(w http.ResponseWriter, r *http.Request)
 	// UserUseCaseのGetByIDメソッドを使用
 	foundUser, err := h.userUseCase.GetByID(r.Context(), userID)
 	if err != nil {
-		if errors.Is(err, repository.ErrNotFound) {
-			h.SendNotFoundError(w, "ユーザー")
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// レスポンスを返す（変更がなければ304を返す）
+	h.SendJSONWithETag(w, r, h.convertToUserDTO(foundUser))
+}
+
+// HandleRequestEmailChange はメールアドレス変更を申請する
+// POST /api/v1/users/me/email/request
+func (h *UserHandler) HandleRequestEmailChange(w http.ResponseWriter, r *http.Request) {
+	// POSTメソッドのみ許可
+	if r.Method != http.MethodPost {
+		h.SendMethodNotAllowed(w, http.MethodPost)
+		return
+	}
+
+	// 認証が必要
+	currentUser, ok := h.RequireAuth(w, r)
+	if !ok {
+		return
+	}
+
+	// リクエストボディをパース
+	var req request.RequestEmailChangeRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "INVALID_REQUEST", err)
+		return
+	}
+
+	// バリデーション
+	if validationErrs := req.Validate(); len(validationErrs) > 0 {
+		h.sendFieldValidationErrors(w, validationErrs)
+		return
+	}
+
+	output, err := h.requestEmailChangeUseCase.Execute(r.Context(), user.RequestEmailChangeInput{
+		UserID:   currentUser.ID,
+		NewEmail: req.NewEmail,
+		Password: req.Password,
+	})
+	if err != nil {
+		h.sendEmailChangeError(w, err)
+		return
+	}
+
+	h.SendJSON(w, http.StatusAccepted, map[string]interface{}{
+		"message":       "確認メールを送信しました。メールに記載された確認トークンで変更を完了してください",
+		"pending_email": output.PendingEmail,
+		"expires_at":    output.ExpiresAt,
+	})
+}
+
+// HandleChangePassword はパスワードを変更する
+// PUT /api/v1/users/me/password
+func (h *UserHandler) HandleChangePassword(w http.ResponseWriter, r *http.Request) {
+	// PUTメソッドのみ許可
+	if r.Method != http.MethodPut {
+		h.SendMethodNotAllowed(w, http.MethodPut)
+		return
+	}
+
+	// 認証が必要
+	currentUser, ok := h.RequireAuth(w, r)
+	if !ok {
+		return
+	}
+
+	// リクエストボディをパース
+	var req request.ChangePasswordRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "INVALID_REQUEST", err)
+		return
+	}
+
+	// バリデーション
+	if validationErrs := req.Validate(); len(validationErrs) > 0 {
+		h.sendFieldValidationErrors(w, validationErrs)
+		return
+	}
+
+	if _, err := h.changePasswordUseCase.Execute(r.Context(), user.ChangePasswordInput{
+		UserID:          currentUser.ID,
+		CurrentPassword: req.CurrentPassword,
+		NewPassword:     req.NewPassword,
+	}); err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	h.SendJSON(w, http.StatusOK, map[string]interface{}{
+		"message": "パスワードを変更しました",
+	})
+}
+
+// HandleConfirmEmailChange は確認トークンを検証してメールアドレスを変更する
+// POST /api/v1/users/me/email/confirm
+func (h *UserHandler) HandleConfirmEmailChange(w http.ResponseWriter, r *http.Request) {
+	// POSTメソッドのみ許可
+	if r.Method != http.MethodPost {
+		h.SendMethodNotAllowed(w, http.MethodPost)
+		return
+	}
+
+	// 認証が必要
+	currentUser, ok := h.RequireAuth(w, r)
+	if !ok {
+		return
+	}
+
+	// リクエストボディをパース
+	var req request.ConfirmEmailChangeRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "INVALID_REQUEST", err)
+		return
+	}
+
+	// バリデーション
+	if validationErrs := req.Validate(); len(validationErrs) > 0 {
+		h.sendFieldValidationErrors(w, validationErrs)
+		return
+	}
+
+	output, err := h.confirmEmailChangeUseCase.Execute(r.Context(), user.ConfirmEmailChangeInput{
+		UserID: currentUser.ID,
+		Token:  req.Token,
+	})
+	if err != nil {
+		h.sendEmailChangeError(w, err)
+		return
+	}
+
+	h.SendJSON(w, http.StatusOK, map[string]interface{}{
+		"message": "メールアドレスを変更しました",
+		"user":    h.convertToUserDTO(output.User),
+	})
+}
+
+// HandleUpdateCallApproval はモーニングコールの事前承認制の設定を変更する
+// PUT /api/v1/users/me/call-approval
+func (h *UserHandler) HandleUpdateCallApproval(w http.ResponseWriter, r *http.Request) {
+	// PUTメソッドのみ許可
+	if r.Method != http.MethodPut {
+		h.SendMethodNotAllowed(w, http.MethodPut)
+		return
+	}
+
+	// 認証が必要
+	currentUser, ok := h.RequireAuth(w, r)
+	if !ok {
+		return
+	}
+
+	// リクエストボディをパース
+	var req request.UpdateCallApprovalRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "INVALID_REQUEST", err)
+		return
+	}
+
+	output, err := h.updateCallApprovalUseCase.Execute(r.Context(), user.UpdateCallApprovalInput{
+		UserID:              currentUser.ID,
+		RequireCallApproval: req.RequireCallApproval,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	h.SendJSON(w, http.StatusOK, map[string]interface{}{
+		"user": h.convertToUserDTO(output.User),
+	})
+}
+
+// HandleUpdatePreferences はモーニングコールの受け取り方に関する設定を変更する
+// PUT /api/v1/users/me/preferences
+func (h *UserHandler) HandleUpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	// PUTメソッドのみ許可
+	if r.Method != http.MethodPut {
+		h.SendMethodNotAllowed(w, http.MethodPut)
+		return
+	}
+
+	// 認証が必要
+	currentUser, ok := h.RequireAuth(w, r)
+	if !ok {
+		return
+	}
+
+	// リクエストボディをパース
+	var req request.UpdatePreferencesRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "INVALID_REQUEST", err)
+		return
+	}
+
+	output, err := h.updatePreferencesUC.Execute(r.Context(), user.UpdatePreferencesInput{
+		UserID:               currentUser.ID,
+		SilentDelivery:       req.SilentDelivery,
+		Volume:               req.Volume,
+		Vibrate:              req.Vibrate,
+		NotifyOnConfirmation: req.NotifyOnConfirmation,
+		NotifyOnExpiration:   req.NotifyOnExpiration,
+		NotifyOnNewLogin:     req.NotifyOnNewLogin,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	h.SendJSON(w, http.StatusOK, map[string]interface{}{
+		"user": h.convertToUserDTO(output.User),
+	})
+}
+
+// HandleUpdateTimeZone はタイムゾーンの設定を変更する
+// PUT /api/v1/users/me/timezone
+func (h *UserHandler) HandleUpdateTimeZone(w http.ResponseWriter, r *http.Request) {
+	// PUTメソッドのみ許可
+	if r.Method != http.MethodPut {
+		h.SendMethodNotAllowed(w, http.MethodPut)
+		return
+	}
+
+	// 認証が必要
+	currentUser, ok := h.RequireAuth(w, r)
+	if !ok {
+		return
+	}
+
+	// リクエストボディをパース
+	var req request.UpdateTimeZoneRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "INVALID_REQUEST", err)
+		return
+	}
+
+	output, err := h.updateTimeZoneUseCase.Execute(r.Context(), user.UpdateTimeZoneInput{
+		UserID:   currentUser.ID,
+		TimeZone: req.TimeZone,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	h.SendJSON(w, http.StatusOK, map[string]interface{}{
+		"user": h.convertToUserDTO(output.User),
+	})
+}
+
+// HandleChangeUsername はユーザー名を変更する
+// 一度変更するとクールダウン期間が経過するまで再変更できない
+// PUT /api/v1/users/me/username
+func (h *UserHandler) HandleChangeUsername(w http.ResponseWriter, r *http.Request) {
+	// PUTメソッドのみ許可
+	if r.Method != http.MethodPut {
+		h.SendMethodNotAllowed(w, http.MethodPut)
+		return
+	}
+
+	// 認証が必要
+	currentUser, ok := h.RequireAuth(w, r)
+	if !ok {
+		return
+	}
+
+	// リクエストボディをパース
+	var req request.ChangeUsernameRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "INVALID_REQUEST", err)
+		return
+	}
+
+	output, err := h.changeUsernameUC.Execute(r.Context(), user.ChangeUsernameInput{
+		UserID:   currentUser.ID,
+		Username: req.Username,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	h.SendJSON(w, http.StatusOK, map[string]interface{}{
+		"user":                    h.convertToUserDTO(output.User),
+		"next_username_change_at": output.NextChangeAt,
+	})
+}
+
+// HandleUpdateCallWindow はモーニングコールを受け付ける曜日と時間帯を設定する
+// PUT /api/v1/users/me/call-window
+func (h *UserHandler) HandleUpdateCallWindow(w http.ResponseWriter, r *http.Request) {
+	// PUTメソッドのみ許可
+	if r.Method != http.MethodPut {
+		h.SendMethodNotAllowed(w, http.MethodPut)
+		return
+	}
+
+	// 認証が必要
+	currentUser, ok := h.RequireAuth(w, r)
+	if !ok {
+		return
+	}
+
+	// リクエストボディをパース
+	var req request.UpdateCallWindowRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "INVALID_REQUEST", err)
+		return
+	}
+
+	window, validationErrs := req.ParseCallWindow()
+	if len(validationErrs) > 0 {
+		h.sendFieldValidationErrors(w, validationErrs)
+		return
+	}
+
+	output, err := h.updateCallWindowUseCase.Execute(r.Context(), user.UpdateCallWindowInput{
+		UserID:     currentUser.ID,
+		CallWindow: window,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	h.SendJSON(w, http.StatusOK, map[string]interface{}{
+		"user": h.convertToUserDTO(output.User),
+	})
+}
+
+// HandleUpdateProxyConfirmer は起床確認の代理人を設定・解除する
+// PUT /api/v1/users/me/proxy-confirmers/{userID}
+// DELETE /api/v1/users/me/proxy-confirmers/{userID}
+func (h *UserHandler) HandleUpdateProxyConfirmer(w http.ResponseWriter, r *http.Request) {
+	// PUT（設定）とDELETE（解除）のみ許可
+	if r.Method != http.MethodPut && r.Method != http.MethodDelete {
+		h.SendMethodNotAllowed(w, http.MethodPut, http.MethodDelete)
+		return
+	}
+
+	// 認証が必要
+	currentUser, ok := h.RequireAuth(w, r)
+	if !ok {
+		return
+	}
+
+	// パスから代理人のユーザーIDを取得
+	proxyID := strings.TrimPrefix(r.URL.Path, "/api/v1/users/me/proxy-confirmers/")
+	if proxyID == "" || strings.Contains(proxyID, "/") {
+		h.SendEndpointNotFound(w)
+		return
+	}
+
+	output, err := h.updateProxyConfirmerUC.Execute(r.Context(), user.UpdateProxyConfirmerInput{
+		UserID:  currentUser.ID,
+		ProxyID: proxyID,
+		Enabled: r.Method == http.MethodPut,
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	proxyConfirmerIDs := output.User.ProxyConfirmerIDs
+	if proxyConfirmerIDs == nil {
+		proxyConfirmerIDs = []string{}
+	}
+	h.SendJSON(w, http.StatusOK, map[string]interface{}{
+		"user":                h.convertToUserDTO(output.User),
+		"proxy_confirmer_ids": proxyConfirmerIDs,
+	})
+}
+
+// HandleUpdateCallForwarding は不在時のモーニングコールの転送を設定・解除する
+// 転送期間中に予定されたコールは作成時に転送先の友達に届けられる
+// PUT /api/v1/users/me/call-forwarding
+// DELETE /api/v1/users/me/call-forwarding
+func (h *UserHandler) HandleUpdateCallForwarding(w http.ResponseWriter, r *http.Request) {
+	// PUT（設定）とDELETE（解除）のみ許可
+	if r.Method != http.MethodPut && r.Method != http.MethodDelete {
+		h.SendMethodNotAllowed(w, http.MethodPut, http.MethodDelete)
+		return
+	}
+
+	// 認証が必要
+	currentUser, ok := h.RequireAuth(w, r)
+	if !ok {
+		return
+	}
+
+	input := user.UpdateCallForwardingInput{
+		UserID:  currentUser.ID,
+		Enabled: r.Method == http.MethodPut,
+	}
+	if input.Enabled {
+		// リクエストボディをパース
+		var req request.UpdateCallForwardingRequest
+		if err := h.ParseJSON(r, &req); err != nil {
+			h.SendJSONDecodeError(w, "INVALID_REQUEST", err)
+			return
+		}
+		if validationErrs := req.Validate(); len(validationErrs) > 0 {
+			h.sendFieldValidationErrors(w, validationErrs)
 			return
 		}
+
+		input.TargetID = req.ForwardTo
+		input.Until = *req.Until
+		if req.From != nil {
+			input.From = *req.From
+		}
+	}
+
+	output, err := h.updateCallForwardingUC.Execute(r.Context(), input)
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	h.SendJSON(w, http.StatusOK, map[string]interface{}{
+		"user": h.convertToUserDTO(output.User),
+	})
+}
+
+// HandleDevices はプッシュ通知を受け取る端末を登録・一覧する
+// POST /api/v1/users/me/devices
+// GET /api/v1/users/me/devices?platform=ios
+func (h *UserHandler) HandleDevices(w http.ResponseWriter, r *http.Request) {
+	// POST（登録）とGET（一覧）のみ許可
+	if r.Method != http.MethodPost && r.Method != http.MethodGet {
+		h.SendMethodNotAllowed(w, http.MethodPost, http.MethodGet)
+		return
+	}
+
+	// 認証が必要
+	currentUser, ok := h.RequireAuth(w, r)
+	if !ok {
+		return
+	}
+
+	if r.Method == http.MethodGet {
+		output, err := h.listDevicesUC.Execute(r.Context(), user.ListDeviceTokensInput{
+			UserID:   currentUser.ID,
+			Platform: valueobject.DevicePlatform(r.URL.Query().Get("platform")),
+		})
+		if err != nil {
+			h.SendMappedError(w, err)
+			return
+		}
+
+		devices := make([]response.DeviceResponse, 0, len(output.Devices))
+		for _, d := range output.Devices {
+			devices = append(devices, h.convertToDeviceDTO(d))
+		}
+		h.SendJSON(w, http.StatusOK, response.DeviceListResponse{Devices: devices})
+		return
+	}
+
+	// リクエストボディをパース
+	var req request.RegisterDeviceRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "INVALID_REQUEST", err)
+		return
+	}
+	if validationErrs := req.Validate(); len(validationErrs) > 0 {
+		h.sendFieldValidationErrors(w, validationErrs)
+		return
+	}
+
+	output, err := h.registerDeviceUC.Execute(r.Context(), user.RegisterDeviceTokenInput{
+		UserID:   currentUser.ID,
+		Token:    req.Token,
+		Platform: valueobject.DevicePlatform(req.Platform),
+	})
+	if err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// 登録済みの端末の再登録は200、新規登録は201を返す
+	status := http.StatusOK
+	if output.Created {
+		status = http.StatusCreated
+	}
+	h.SendJSON(w, status, map[string]interface{}{
+		"device": h.convertToDeviceDTO(output.Device),
+	})
+}
+
+// HandleDeleteDevice は登録した端末を削除する
+// DELETE /api/v1/users/me/devices/{deviceID}
+func (h *UserHandler) HandleDeleteDevice(w http.ResponseWriter, r *http.Request) {
+	// DELETEメソッドのみ許可
+	if r.Method != http.MethodDelete {
+		h.SendMethodNotAllowed(w, http.MethodDelete)
+		return
+	}
+
+	// 認証が必要
+	currentUser, ok := h.RequireAuth(w, r)
+	if !ok {
+		return
+	}
+
+	// パスから端末IDを取得
+	deviceID := strings.TrimPrefix(r.URL.Path, "/api/v1/users/me/devices/")
+	if deviceID == "" || strings.Contains(deviceID, "/") {
+		h.SendEndpointNotFound(w)
+		return
+	}
+
+	if err := h.deleteDeviceUC.Execute(r.Context(), user.DeleteDeviceTokenInput{
+		UserID:   currentUser.ID,
+		DeviceID: deviceID,
+	}); err != nil {
+		h.SendMappedError(w, err)
+		return
+	}
+
+	h.SendNoContent(w)
+}
+
+// HandleRequestAccountDeletion はアカウントの削除を申請する
+// 猶予期間の経過後に完全に削除される。申請したアカウントのセッションはすべて無効化し、猶予期間中にログインすると取り消される
+// POST /api/v1/users/me/deletion
+func (h *UserHandler) HandleRequestAccountDeletion(w http.ResponseWriter, r *http.Request) {
+	// POSTメソッドのみ許可
+	if r.Method != http.MethodPost {
+		h.SendMethodNotAllowed(w, http.MethodPost)
+		return
+	}
+
+	// 認証が必要
+	currentUser, ok := h.RequireAuth(w, r)
+	if !ok {
+		return
+	}
+
+	// リクエストボディをパース
+	var req request.RequestAccountDeletionRequest
+	if err := h.ParseJSON(r, &req); err != nil {
+		h.SendJSONDecodeError(w, "INVALID_REQUEST", err)
+		return
+	}
+
+	// バリデーション
+	if validationErrs := req.Validate(); len(validationErrs) > 0 {
+		h.sendFieldValidationErrors(w, validationErrs)
+		return
+	}
+
+	output, err := h.requestAccountDeletionUC.Execute(r.Context(), user.RequestAccountDeletionInput{
+		UserID:   currentUser.ID,
+		Password: req.Password,
+	})
+	if err != nil {
+		if err.Error() == "パスワードが正しくありません" {
+			h.SendError(w, http.StatusUnauthorized, "INVALID_CREDENTIALS", err.Error(), nil)
+			return
+		}
+		h.SendMappedError(w, err)
+		return
+	}
+
+	// 削除予定の間は利用させないため、すべての端末からログアウトさせる
+	if err := h.sessionManager.InvalidateUserSessions(currentUser.ID); err != nil {
 		h.SendInternalServerError(w, err)
 		return
 	}
+	h.DeleteCookie(w, "session_id")
 
-	// レスポンスを返す
-	h.SendJSON(w, http.StatusOK, h.convertToUserDTO(foundUser))
+	h.SendJSON(w, http.StatusAccepted, response.AccountDeletionResponse{
+		DeletionScheduledAt: output.DeletionScheduledAt,
+		Message:             "アカウントの削除を受け付けました。削除予定日時までにログインすると取り消されます",
+	})
+}
+
+// sendEmailChangeError はメールアドレス変更のエラーをレスポンスに変換する
+func (h *UserHandler) sendEmailChangeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, repository.ErrAlreadyExists):
+		h.SendError(w, http.StatusConflict, "ALREADY_EXISTS", "メールアドレスが既に使用されています", nil)
+	case err.Error() == "パスワードが正しくありません":
+		h.SendError(w, http.StatusUnauthorized, "INVALID_CREDENTIALS", err.Error(), nil)
+	default:
+		h.SendMappedError(w, err)
+	}
+}
+
+// sendFieldValidationErrors はフィールドごとのバリデーションエラーを送信する
+func (h *UserHandler) sendFieldValidationErrors(w http.ResponseWriter, validationErrs map[string]string) {
+	var validationErrors []ValidationError
+	for field, message := range validationErrs {
+		validationErrors = append(validationErrors, ValidationError{
+			Field:   field,
+			Message: message,
+		})
+	}
+	h.SendValidationError(w, validationErrors)
+}
+
+// deviceTokenSuffixLength はレスポンスに含めるデバイストークンの末尾の文字数
+const deviceTokenSuffixLength = 6
+
+// convertToDeviceDTO は端末のエンティティをDTOに変換する
+func (h *UserHandler) convertToDeviceDTO(d *entity.DeviceToken) response.DeviceResponse {
+	suffix := d.Token
+	if len(suffix) > deviceTokenSuffixLength {
+		suffix = suffix[len(suffix)-deviceTokenSuffixLength:]
+	}
+	return response.DeviceResponse{
+		ID:          d.ID,
+		Platform:    d.Platform.String(),
+		TokenSuffix: suffix,
+		CreatedAt:   d.CreatedAt,
+	}
 }
 
 // convertToUserDTO はエンティティをDTOに変換する
@@ -231,5 +968,18 @@ func (h *UserHandler) convertToUserDTO(u *entity.User) response.UserDTO {
 		Email:     u.Email,
 		CreatedAt: u.CreatedAt,
 		UpdatedAt: u.UpdatedAt,
+
+		RequireCallApproval:  u.RequireCallApproval,
+		TimeZone:             u.TimeZone,
+		SilentDelivery:       u.SilentDelivery,
+		Volume:               u.Volume,
+		Vibrate:              u.Vibrate,
+		NotifyOnConfirmation: u.NotifyOnConfirmation,
+		NotifyOnExpiration:   u.NotifyOnExpiration,
+		NotifyOnNewLogin:     u.NotifyOnNewLogin,
+		Points:               u.Points,
+
+		CallWindow:     response.NewCallWindowResponse(u.CallWindow),
+		CallForwarding: response.NewCallForwardingResponse(u.ForwardCallsTo, u.ForwardCallsFrom, u.ForwardCallsUntil),
 	}
 }
@@ -0,0 +1,113 @@
+package audit
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/pkg/utils"
+)
+
+// LogAuditLogger は標準ログへ監査ログを出力する実装
+type LogAuditLogger struct {
+	logger *log.Logger
+}
+
+// NewLogAuditLogger は新しいLogAuditLoggerを作成する
+// loggerがnilの場合は標準ロガーを使用する
+func NewLogAuditLogger(logger *log.Logger) *LogAuditLogger {
+	if logger == nil {
+		logger = log.Default()
+	}
+	return &LogAuditLogger{logger: logger}
+}
+
+// Record は監査ログを1行で出力する
+func (l *LogAuditLogger) Record(ctx context.Context, entry service.AuditEntry) error {
+	if entry.OccurredAt.IsZero() {
+		entry.OccurredAt = time.Now()
+	}
+
+	// 出力順を安定させるため詳細はキーでソートする
+	keys := make([]string, 0, len(entry.Details))
+	for k := range entry.Details {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	details := make([]string, 0, len(keys))
+	for _, k := range keys {
+		details = append(details, k+"="+entry.Details[k])
+	}
+
+	l.logger.Printf("%s[AUDIT] action=%s actor=%s target=%s:%s at=%s %s",
+		utils.RequestIDLogPrefix(utils.RequestIDFromContext(ctx)),
+		entry.Action,
+		entry.ActorID,
+		entry.TargetType,
+		entry.TargetID,
+		entry.OccurredAt.Format(time.RFC3339),
+		strings.Join(details, " "),
+	)
+	return nil
+}
+
+// MemoryAuditLogger はメモリ上に監査ログを保持する実装
+// テストや開発環境での確認用に使用する
+type MemoryAuditLogger struct {
+	mu      sync.RWMutex
+	entries []service.AuditEntry
+}
+
+// NewMemoryAuditLogger は新しいMemoryAuditLoggerを作成する
+func NewMemoryAuditLogger() *MemoryAuditLogger {
+	return &MemoryAuditLogger{
+		entries: make([]service.AuditEntry, 0),
+	}
+}
+
+// Record は監査ログをメモリに追加する
+func (l *MemoryAuditLogger) Record(ctx context.Context, entry service.AuditEntry) error {
+	_ = ctx // 将来的な外部ストレージ実装のために保持
+	if entry.OccurredAt.IsZero() {
+		entry.OccurredAt = time.Now()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, copyEntry(entry))
+	return nil
+}
+
+// Entries は記録された監査ログのコピーを記録順に返す
+func (l *MemoryAuditLogger) Entries() []service.AuditEntry {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	result := make([]service.AuditEntry, len(l.entries))
+	for i, e := range l.entries {
+		result[i] = copyEntry(e)
+	}
+	return result
+}
+
+// copyEntry は監査ログのディープコピーを作成する
+func copyEntry(entry service.AuditEntry) service.AuditEntry {
+	if entry.Details != nil {
+		details := make(map[string]string, len(entry.Details))
+		for k, v := range entry.Details {
+			details[k] = v
+		}
+		entry.Details = details
+	}
+	return entry
+}
+
+// インターフェースの実装を保証
+var (
+	_ service.AuditLogger = (*LogAuditLogger)(nil)
+	_ service.AuditLogger = (*MemoryAuditLogger)(nil)
+)
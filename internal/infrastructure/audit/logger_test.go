@@ -0,0 +1,80 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/pkg/utils"
+)
+
+func TestLogAuditLogger_Record(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogAuditLogger(log.New(&buf, "", 0))
+
+	err := logger.Record(context.Background(), service.AuditEntry{
+		Action:     "friend_request.expired",
+		ActorID:    service.AuditActorSystem,
+		TargetType: "relationship",
+		TargetID:   "rel1",
+		Details:    map[string]string{"requester_id": "user1", "receiver_id": "user2"},
+		OccurredAt: time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+
+	want := "[AUDIT] action=friend_request.expired actor=system target=relationship:rel1 at=2024-01-01T07:00:00Z receiver_id=user2 requester_id=user1"
+	if got := strings.TrimSpace(buf.String()); got != want {
+		t.Errorf("出力 = %q, want %q", got, want)
+	}
+}
+
+func TestLogAuditLogger_Record_WithRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogAuditLogger(log.New(&buf, "", 0))
+
+	ctx := utils.WithRequestID(context.Background(), "req-123")
+	if err := logger.Record(ctx, service.AuditEntry{Action: "plan.changed", ActorID: "admin1"}); err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+
+	if got := buf.String(); !strings.HasPrefix(got, "[request_id=req-123] [AUDIT] action=plan.changed") {
+		t.Errorf("リクエストIDが付与されていない: %q", got)
+	}
+}
+
+func TestMemoryAuditLogger_Record(t *testing.T) {
+	logger := NewMemoryAuditLogger()
+	ctx := context.Background()
+
+	details := map[string]string{"key": "value"}
+	if err := logger.Record(ctx, service.AuditEntry{Action: "a1", Details: details}); err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+	if err := logger.Record(ctx, service.AuditEntry{Action: "a2"}); err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+
+	entries := logger.Entries()
+	if len(entries) != 2 {
+		t.Fatalf("件数 = %d, want 2", len(entries))
+	}
+	if entries[0].Action != "a1" || entries[1].Action != "a2" {
+		t.Errorf("記録順が保持されていない: %v", entries)
+	}
+	if entries[0].OccurredAt.IsZero() {
+		t.Error("OccurredAtが補完されていない")
+	}
+
+	// 記録後に元のマップや取得結果を変更しても内部に影響しない
+	details["key"] = "changed"
+	entries[0].Details["key"] = "changed"
+	if got := logger.Entries()[0].Details["key"]; got != "value" {
+		t.Errorf("内部データが変更された: %s", got)
+	}
+}
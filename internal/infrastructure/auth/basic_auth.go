@@ -69,7 +69,7 @@ func (s *PasswordService) VerifyPassword(password, passwordHash string) (bool, e
 		passwordBytes = []byte(hex.EncodeToString(hashBytes))
 	}
 
-	// bcryptで検証
+	// bcryptで検証（ハッシュの比較はsubtle.ConstantTimeCompareによる定数時間比較）
 	err := bcrypt.CompareHashAndPassword([]byte(passwordHash), passwordBytes)
 	if err != nil {
 		if err == bcrypt.ErrMismatchedHashAndPassword {
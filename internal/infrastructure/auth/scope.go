@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Scope はセッション（トークン）に許可する操作の範囲を表す
+type Scope string
+
+const (
+	// ScopeReadOnly はすべてのエンドポイントの読み取りを許可する
+	ScopeReadOnly Scope = "read-only"
+
+	// ScopeMorningCallsRead はモーニングコールのエンドポイントの読み取りのみを許可する
+	ScopeMorningCallsRead Scope = "morning-calls:read"
+)
+
+// scopeReadSuffix は読み取りのみを許可するスコープの接尾辞
+const scopeReadSuffix = ":read"
+
+// Scopes は発行できるスコープの一覧を返す
+func Scopes() []Scope {
+	return []Scope{ScopeReadOnly, ScopeMorningCallsRead}
+}
+
+// ParseScope は文字列からスコープを取得する
+func ParseScope(s string) (Scope, error) {
+	for _, scope := range Scopes() {
+		if string(scope) == s {
+			return scope, nil
+		}
+	}
+	names := make([]string, 0, len(Scopes()))
+	for _, scope := range Scopes() {
+		names = append(names, string(scope))
+	}
+	return "", fmt.Errorf("スコープは%sのいずれかを指定してください", strings.Join(names, "、"))
+}
+
+// IsScoped はスコープで権限を制限したセッションかを判定する（ログインで作成したセッションは制限なし）
+func (s *Session) IsScoped() bool {
+	return len(s.Scopes) > 0
+}
+
+// HasScope はセッションが指定したスコープの操作を許可されているかを判定する
+// 制限のないセッションはすべてのスコープを持ち、read-onlyは読み取りのスコープ（〜:read）をすべて含む
+func (s *Session) HasScope(required Scope) bool {
+	if !s.IsScoped() {
+		return true
+	}
+	for _, scope := range s.Scopes {
+		if scope == required {
+			return true
+		}
+		if scope == ScopeReadOnly && strings.HasSuffix(string(required), scopeReadSuffix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+package auth
+
+import "testing"
+
+func TestParseScope(t *testing.T) {
+	for _, scope := range Scopes() {
+		if got, err := ParseScope(string(scope)); err != nil || got != scope {
+			t.Errorf("ParseScope(%q) = %q, %v", scope, got, err)
+		}
+	}
+
+	_, err := ParseScope("morning-calls:write")
+	if err == nil || err.Error() != "スコープはread-only、morning-calls:readのいずれかを指定してください" {
+		t.Errorf("ParseScope() error = %v", err)
+	}
+}
+
+func TestSession_HasScope(t *testing.T) {
+	tests := []struct {
+		name     string
+		scopes   []Scope
+		required Scope
+		want     bool
+	}{
+		{name: "制限なしはすべて許可", scopes: nil, required: ScopeMorningCallsRead, want: true},
+		{name: "同じスコープ", scopes: []Scope{ScopeMorningCallsRead}, required: ScopeMorningCallsRead, want: true},
+		{name: "read-onlyは読み取りのスコープを含む", scopes: []Scope{ScopeReadOnly}, required: ScopeMorningCallsRead, want: true},
+		{name: "read-only", scopes: []Scope{ScopeReadOnly}, required: ScopeReadOnly, want: true},
+		{name: "morning-calls:readはread-onlyを含まない", scopes: []Scope{ScopeMorningCallsRead}, required: ScopeReadOnly, want: false},
+		{name: "read-onlyは読み取り以外のスコープを含まない", scopes: []Scope{ScopeReadOnly}, required: Scope("morning-calls:write"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := &Session{Scopes: tt.scopes}
+			if got := session.HasScope(tt.required); got != tt.want {
+				t.Errorf("HasScope(%q) = %v, want %v", tt.required, got, tt.want)
+			}
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"log"
 	"sync"
 	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/service"
 )
 
 // Session はユーザーセッション情報を表す
@@ -16,6 +18,7 @@ type Session struct {
 	CreatedAt time.Time
 	ExpiresAt time.Time
 	Data      map[string]interface{} // 追加のセッションデータ
+	Scopes    []Scope                // 許可する操作の範囲（空の場合は制限なし）
 }
 
 // IsExpired はセッションが有効期限切れかどうかを判定する
@@ -23,22 +26,54 @@ func (s *Session) IsExpired() bool {
 	return time.Now().After(s.ExpiresAt)
 }
 
+const (
+	// DefaultSessionCleanupInterval は期限切れセッションのクリーンアップのデフォルト実行間隔
+	DefaultSessionCleanupInterval = 5 * time.Minute
+
+	// sessionCleanupBatchSize は1回の書き込みロックで削除するセッション数
+	// 大量のセッションを削除する際に他の操作を長時間ブロックしないよう分割する
+	sessionCleanupBatchSize = 1000
+)
+
+// SessionStats はセッションマネージャーの統計情報を表す（管理者向けのシステム統計で返す）
+type SessionStats = service.SessionStats
+
+var _ service.SessionStatsSource = (*SessionManager)(nil)
+
 // SessionManager はセッション管理を行う
 type SessionManager struct {
 	sessions       map[string]*Session
 	mutex          sync.RWMutex
 	defaultTimeout time.Duration
 	// クリーンアップ用のチャネル
-	cleanupTicker *time.Ticker
-	stopCleanup   chan bool
+	cleanupInterval time.Duration
+	cleanupTicker   *time.Ticker
+	stopCleanup     chan bool
+	// クリーンアップの統計情報
+	statsMutex sync.Mutex
+	stats      SessionStats
+
+	listenersMutex sync.RWMutex
+	listeners      []func(sessionID string) // セッションの削除・失効時に呼び出すリスナー
 }
 
 // NewSessionManager は新しいセッションマネージャーを作成する
 func NewSessionManager(timeout time.Duration) *SessionManager {
+	return NewSessionManagerWithCleanupInterval(timeout, DefaultSessionCleanupInterval)
+}
+
+// NewSessionManagerWithCleanupInterval はクリーンアップ間隔を指定してセッションマネージャーを作成する
+// 間隔が0以下の場合はデフォルト値を使用する
+func NewSessionManagerWithCleanupInterval(timeout, cleanupInterval time.Duration) *SessionManager {
+	if cleanupInterval <= 0 {
+		cleanupInterval = DefaultSessionCleanupInterval
+	}
+
 	sm := &SessionManager{
-		sessions:       make(map[string]*Session),
-		defaultTimeout: timeout,
-		stopCleanup:    make(chan bool),
+		sessions:        make(map[string]*Session),
+		defaultTimeout:  timeout,
+		cleanupInterval: cleanupInterval,
+		stopCleanup:     make(chan bool),
 	}
 
 	// 期限切れセッションの自動クリーンアップを開始
@@ -49,6 +84,33 @@ func NewSessionManager(timeout time.Duration) *SessionManager {
 
 // CreateSession は新しいセッションを作成する
 func (sm *SessionManager) CreateSession(userID string) (*Session, error) {
+	return sm.createSession(userID, nil)
+}
+
+// CreateScopedSession はスコープで権限を制限したセッション（サードパーティ連携用のトークン）を作成する
+// 同じスコープを重複して指定した場合は1つにまとめる
+func (sm *SessionManager) CreateScopedSession(userID string, scopes []Scope) (*Session, error) {
+	if len(scopes) == 0 {
+		return nil, fmt.Errorf("スコープは1つ以上指定してください")
+	}
+
+	unique := make([]Scope, 0, len(scopes))
+	seen := make(map[Scope]bool, len(scopes))
+	for _, scope := range scopes {
+		if _, err := ParseScope(string(scope)); err != nil {
+			return nil, err
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			unique = append(unique, scope)
+		}
+	}
+
+	return sm.createSession(userID, unique)
+}
+
+// createSession はスコープを指定してセッションを作成・保存する（スコープが空の場合は制限なし）
+func (sm *SessionManager) createSession(userID string, scopes []Scope) (*Session, error) {
 	if userID == "" {
 		return nil, fmt.Errorf("ユーザーIDは必須です")
 	}
@@ -66,6 +128,7 @@ func (sm *SessionManager) CreateSession(userID string) (*Session, error) {
 		CreatedAt: now,
 		ExpiresAt: now.Add(sm.defaultTimeout),
 		Data:      make(map[string]interface{}),
+		Scopes:    scopes,
 	}
 
 	// セッションを保存
@@ -152,13 +215,14 @@ func (sm *SessionManager) DeleteSession(sessionID string) error {
 	}
 
 	sm.mutex.Lock()
-	defer sm.mutex.Unlock()
-
 	if _, exists := sm.sessions[sessionID]; !exists {
+		sm.mutex.Unlock()
 		return fmt.Errorf("セッションが見つかりません")
 	}
-
 	delete(sm.sessions, sessionID)
+	sm.mutex.Unlock()
+
+	sm.notifyInvalidated([]string{sessionID})
 	return nil
 }
 
@@ -195,17 +259,69 @@ func (sm *SessionManager) GetUserIDFromSession(sessionID string) (string, error)
 	return session.UserID, nil
 }
 
-// CleanupExpiredSessions は期限切れのセッションを削除する
-func (sm *SessionManager) CleanupExpiredSessions() {
-	sm.mutex.Lock()
-	defer sm.mutex.Unlock()
+// CleanupExpiredSessions は期限切れのセッションを削除し、削除した件数を返す
+// 対象の収集は読み取りロックで行い、削除は小分けに書き込みロックを取得して行う
+func (sm *SessionManager) CleanupExpiredSessions() int {
+	start := time.Now()
 
-	now := time.Now()
+	// 期限切れセッションのIDを収集
+	sm.mutex.RLock()
+	var expiredIDs []string
 	for id, session := range sm.sessions {
-		if now.After(session.ExpiresAt) {
-			delete(sm.sessions, id)
+		if start.After(session.ExpiresAt) {
+			expiredIDs = append(expiredIDs, id)
 		}
 	}
+	sm.mutex.RUnlock()
+
+	// バッチごとに削除（収集後に延長されたセッションは削除しない）
+	removed := 0
+	for i := 0; i < len(expiredIDs); i += sessionCleanupBatchSize {
+		end := i + sessionCleanupBatchSize
+		if end > len(expiredIDs) {
+			end = len(expiredIDs)
+		}
+
+		sm.mutex.Lock()
+		now := time.Now()
+		deletedIDs := make([]string, 0, end-i)
+		for _, id := range expiredIDs[i:end] {
+			if session, exists := sm.sessions[id]; exists && now.After(session.ExpiresAt) {
+				delete(sm.sessions, id)
+				deletedIDs = append(deletedIDs, id)
+			}
+		}
+		sm.mutex.Unlock()
+
+		removed += len(deletedIDs)
+		sm.notifyInvalidated(deletedIDs)
+	}
+
+	sm.statsMutex.Lock()
+	sm.stats.CleanupRuns++
+	sm.stats.LastCleanupAt = start
+	sm.stats.LastCleanupRemoved = removed
+	sm.stats.TotalCleanupRemoved += removed
+	sm.stats.LastCleanupDuration = time.Since(start)
+	sm.statsMutex.Unlock()
+
+	return removed
+}
+
+// Stats はセッション数とクリーンアップの統計情報を取得する
+func (sm *SessionManager) Stats() SessionStats {
+	sm.statsMutex.Lock()
+	stats := sm.stats
+	sm.statsMutex.Unlock()
+
+	sm.mutex.RLock()
+	stats.TotalSessions = len(sm.sessions)
+	sm.mutex.RUnlock()
+
+	stats.ActiveSessions = sm.GetActiveSessionCount()
+	stats.CleanupInterval = sm.cleanupInterval
+
+	return stats
 }
 
 // GetActiveSessionCount はアクティブなセッション数を取得する
@@ -247,17 +363,48 @@ func (sm *SessionManager) InvalidateUserSessions(userID string) error {
 	}
 
 	sm.mutex.Lock()
-	defer sm.mutex.Unlock()
-
+	var deletedIDs []string
 	for id, session := range sm.sessions {
 		if session.UserID == userID {
 			delete(sm.sessions, id)
+			deletedIDs = append(deletedIDs, id)
 		}
 	}
+	sm.mutex.Unlock()
 
+	sm.notifyInvalidated(deletedIDs)
 	return nil
 }
 
+// OnSessionInvalidated はセッションが削除・失効したときに呼び出すリスナーを登録する
+// リスナーはセッションのロックを解放した後に呼び出される
+func (sm *SessionManager) OnSessionInvalidated(listener func(sessionID string)) {
+	if listener == nil {
+		return
+	}
+
+	sm.listenersMutex.Lock()
+	sm.listeners = append(sm.listeners, listener)
+	sm.listenersMutex.Unlock()
+}
+
+// notifyInvalidated は削除・失効したセッションをリスナーに通知する
+func (sm *SessionManager) notifyInvalidated(sessionIDs []string) {
+	if len(sessionIDs) == 0 {
+		return
+	}
+
+	sm.listenersMutex.RLock()
+	listeners := sm.listeners
+	sm.listenersMutex.RUnlock()
+
+	for _, listener := range listeners {
+		for _, id := range sessionIDs {
+			listener(id)
+		}
+	}
+}
+
 // Stop はセッションマネージャーを停止する（クリーンアップルーチンを停止）
 func (sm *SessionManager) Stop() {
 	if sm.cleanupTicker != nil {
@@ -278,14 +425,16 @@ func (sm *SessionManager) generateSessionID() (string, error) {
 
 // startCleanupRoutine は定期的に期限切れセッションをクリーンアップするルーチンを開始する
 func (sm *SessionManager) startCleanupRoutine() {
-	// 5分ごとにクリーンアップを実行
-	sm.cleanupTicker = time.NewTicker(5 * time.Minute)
+	// 設定された間隔ごとにクリーンアップを実行
+	sm.cleanupTicker = time.NewTicker(sm.cleanupInterval)
 
 	go func() {
 		for {
 			select {
 			case <-sm.cleanupTicker.C:
-				sm.CleanupExpiredSessions()
+				if removed := sm.CleanupExpiredSessions(); removed > 0 {
+					log.Printf("期限切れセッションを%d件削除しました", removed)
+				}
 			case <-sm.stopCleanup:
 				return
 			}
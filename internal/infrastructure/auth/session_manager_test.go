@@ -0,0 +1,252 @@
+package auth
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewSessionManagerWithCleanupInterval(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval time.Duration
+		want     time.Duration
+	}{
+		{name: "指定した間隔を使用", interval: time.Minute, want: time.Minute},
+		{name: "0の場合はデフォルト値", interval: 0, want: DefaultSessionCleanupInterval},
+		{name: "負の場合はデフォルト値", interval: -time.Second, want: DefaultSessionCleanupInterval},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sm := NewSessionManagerWithCleanupInterval(time.Hour, tt.interval)
+			defer sm.Stop()
+
+			if got := sm.Stats().CleanupInterval; got != tt.want {
+				t.Errorf("CleanupInterval = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSessionManager_CleanupExpiredSessions_Stats(t *testing.T) {
+	sm := NewSessionManager(time.Hour)
+	defer sm.Stop()
+
+	// 有効なセッション2件と期限切れセッション3件を用意
+	for i := 0; i < 5; i++ {
+		if _, err := sm.CreateSession(fmt.Sprintf("user%d", i)); err != nil {
+			t.Fatalf("セッション作成エラー: %v", err)
+		}
+	}
+	expireSessions(sm, 3)
+
+	stats := sm.Stats()
+	if stats.TotalSessions != 5 || stats.ActiveSessions != 2 {
+		t.Errorf("クリーンアップ前: Total=%d Active=%d, want 5, 2", stats.TotalSessions, stats.ActiveSessions)
+	}
+	if stats.CleanupRuns != 0 {
+		t.Errorf("CleanupRuns = %d, want 0", stats.CleanupRuns)
+	}
+
+	if removed := sm.CleanupExpiredSessions(); removed != 3 {
+		t.Errorf("削除件数 = %d, want 3", removed)
+	}
+
+	stats = sm.Stats()
+	if stats.TotalSessions != 2 || stats.ActiveSessions != 2 {
+		t.Errorf("クリーンアップ後: Total=%d Active=%d, want 2, 2", stats.TotalSessions, stats.ActiveSessions)
+	}
+	if stats.CleanupRuns != 1 || stats.LastCleanupRemoved != 3 || stats.TotalCleanupRemoved != 3 {
+		t.Errorf("統計が不正: %+v", stats)
+	}
+	if stats.LastCleanupAt.IsZero() {
+		t.Error("LastCleanupAtが設定されていない")
+	}
+
+	// 削除対象がない場合も実行回数は記録され、累計は維持される
+	if removed := sm.CleanupExpiredSessions(); removed != 0 {
+		t.Errorf("削除件数 = %d, want 0", removed)
+	}
+	stats = sm.Stats()
+	if stats.CleanupRuns != 2 || stats.LastCleanupRemoved != 0 || stats.TotalCleanupRemoved != 3 {
+		t.Errorf("統計が不正: %+v", stats)
+	}
+}
+
+func TestSessionManager_CleanupExpiredSessions_Concurrent(t *testing.T) {
+	sm := NewSessionManager(time.Hour)
+	defer sm.Stop()
+
+	// 大量の期限切れセッションを用意
+	const expiredCount = 20000
+	for i := 0; i < expiredCount; i++ {
+		if _, err := sm.CreateSession(fmt.Sprintf("expired%d", i)); err != nil {
+			t.Fatalf("セッション作成エラー: %v", err)
+		}
+	}
+	expireSessions(sm, expiredCount)
+
+	// クリーンアップと並行してセッションの作成・取得を行う
+	const workers = 8
+	const perWorker = 200
+	var wg sync.WaitGroup
+	errCh := make(chan error, workers*perWorker)
+	created := make(chan string, workers*perWorker)
+
+	var removed int
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		removed = sm.CleanupExpiredSessions()
+	}()
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				session, err := sm.CreateSession(fmt.Sprintf("active%d-%d", w, i))
+				if err != nil {
+					errCh <- err
+					continue
+				}
+				if _, err := sm.GetSession(session.ID); err != nil {
+					errCh <- err
+					continue
+				}
+				created <- session.ID
+			}
+		}(w)
+	}
+
+	wg.Wait()
+	close(errCh)
+	close(created)
+
+	for err := range errCh {
+		t.Errorf("並行操作でエラー: %v", err)
+	}
+	if removed != expiredCount {
+		t.Errorf("削除件数 = %d, want %d", removed, expiredCount)
+	}
+
+	// クリーンアップ中に作成された有効なセッションは削除されていない
+	for id := range created {
+		if valid, _ := sm.ValidateSession(id); !valid {
+			t.Errorf("有効なセッション %s が削除された", id)
+		}
+	}
+
+	stats := sm.Stats()
+	if stats.ActiveSessions != workers*perWorker || stats.TotalSessions != workers*perWorker {
+		t.Errorf("Active=%d Total=%d, want %d", stats.ActiveSessions, stats.TotalSessions, workers*perWorker)
+	}
+}
+
+func TestSessionManager_CleanupExpiredSessions_SkipsExtendedSession(t *testing.T) {
+	sm := NewSessionManager(time.Hour)
+	defer sm.Stop()
+
+	session, err := sm.CreateSession("user1")
+	if err != nil {
+		t.Fatalf("セッション作成エラー: %v", err)
+	}
+	expireSessions(sm, 1)
+
+	// 期限切れ後に延長されたセッションは削除対象外
+	if err := sm.ExtendSession(session.ID, time.Hour); err != nil {
+		t.Fatalf("セッション延長エラー: %v", err)
+	}
+
+	if removed := sm.CleanupExpiredSessions(); removed != 0 {
+		t.Errorf("削除件数 = %d, want 0", removed)
+	}
+	if _, err := sm.GetSession(session.ID); err != nil {
+		t.Errorf("延長したセッションが取得できない: %v", err)
+	}
+}
+
+func TestSessionManager_OnSessionInvalidated(t *testing.T) {
+	sm := NewSessionManager(time.Hour)
+	defer sm.Stop()
+
+	var mu sync.Mutex
+	var notified []string
+	sm.OnSessionInvalidated(func(sessionID string) {
+		mu.Lock()
+		notified = append(notified, sessionID)
+		mu.Unlock()
+	})
+
+	deleted, _ := sm.CreateSession("user1")
+	expired, _ := sm.CreateSession("user2")
+	userSession, _ := sm.CreateSession("user3")
+
+	if err := sm.DeleteSession(deleted.ID); err != nil {
+		t.Fatalf("セッション削除エラー: %v", err)
+	}
+	sm.mutex.Lock()
+	sm.sessions[expired.ID].ExpiresAt = time.Now().Add(-time.Minute)
+	sm.mutex.Unlock()
+	sm.CleanupExpiredSessions()
+	if err := sm.InvalidateUserSessions("user3"); err != nil {
+		t.Fatalf("セッション無効化エラー: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	want := []string{deleted.ID, expired.ID, userSession.ID}
+	if fmt.Sprint(notified) != fmt.Sprint(want) {
+		t.Errorf("通知されたセッション = %v, want %v", notified, want)
+	}
+}
+
+// expireSessions は保持しているセッションのうちn件を期限切れにする
+func expireSessions(sm *SessionManager, n int) {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+
+	past := time.Now().Add(-time.Minute)
+	for _, session := range sm.sessions {
+		if n == 0 {
+			return
+		}
+		session.ExpiresAt = past
+		n--
+	}
+}
+
+func TestSessionManager_CreateScopedSession(t *testing.T) {
+	sm := NewSessionManager(time.Hour)
+	defer sm.Stop()
+
+	session, err := sm.CreateScopedSession("user1", []Scope{ScopeMorningCallsRead, ScopeReadOnly, ScopeMorningCallsRead})
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+	if !session.IsScoped() || len(session.Scopes) != 2 || session.Scopes[0] != ScopeMorningCallsRead || session.Scopes[1] != ScopeReadOnly {
+		t.Errorf("Scopes = %v, want [morning-calls:read read-only]", session.Scopes)
+	}
+	// 取得したセッションにもスコープが保持されている
+	if got, err := sm.GetSession(session.ID); err != nil || !got.IsScoped() {
+		t.Errorf("GetSession() = %+v, %v, want scoped session", got, err)
+	}
+
+	if _, err := sm.CreateScopedSession("user1", nil); err == nil {
+		t.Error("スコープなしでエラーになるべき")
+	}
+	if _, err := sm.CreateScopedSession("user1", []Scope{"admin"}); err == nil {
+		t.Error("未知のスコープでエラーになるべき")
+	}
+
+	// ログインで作成したセッションは制限なし
+	login, err := sm.CreateSession("user1")
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+	if login.IsScoped() {
+		t.Errorf("Scopes = %v, want none", login.Scopes)
+	}
+}
@@ -0,0 +1,176 @@
+// Package cache はリポジトリの読み取り結果をメモリ上に保持するキャッシュを提供する
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// DefaultCapacity は容量が指定されなかった場合のキャッシュの件数の上限
+const DefaultCapacity = 1000
+
+// Stats はキャッシュの計測値
+type Stats struct {
+	Hits      int64 // キャッシュにあった回数
+	Misses    int64 // キャッシュになかった（期限切れを含む）回数
+	Evictions int64 // 容量の上限を超えたため追い出した件数（期限切れ・無効化は含めない）
+	Size      int   // 現在保持している件数
+}
+
+// HitRate は参照のうちキャッシュにあった割合（0〜1、参照がない場合は0）を返す
+func (s Stats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// LRU は有効期限付きのLRUキャッシュ
+// 容量の上限を超えた場合は最も長く参照されていないものから削除し、有効期限を過ぎたものは参照時に削除する
+type LRU[K comparable, V any] struct {
+	capacity int
+	ttl      time.Duration
+	now      func() time.Time
+
+	mu      sync.Mutex
+	entries map[K]*list.Element
+	order   *list.List // 最近参照した順（先頭が最新）
+
+	// generation は無効化のたびに進める世代（無効化より前に読んだ値を後から書き込まないための判定用）
+	generation uint64
+
+	hits      int64
+	misses    int64
+	evictions int64
+}
+
+// lruEntry はキャッシュの1件
+type lruEntry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+}
+
+// NewLRU は新しいLRUキャッシュを作成する
+// capacityが0以下の場合はDefaultCapacityを使用し、ttlが0以下の場合は期限切れにしない
+func NewLRU[K comparable, V any](capacity int, ttl time.Duration) *LRU[K, V] {
+	if capacity <= 0 {
+		capacity = DefaultCapacity
+	}
+	return &LRU[K, V]{
+		capacity: capacity,
+		ttl:      ttl,
+		now:      time.Now,
+		entries:  make(map[K]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get はキャッシュにある値を返す（ない場合・期限切れの場合はfalse）
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*lruEntry[K, V])
+		if c.ttl <= 0 || c.now().Before(entry.expiresAt) {
+			c.order.MoveToFront(elem)
+			c.hits++
+			return entry.value, true
+		}
+		c.removeElement(elem)
+	}
+	c.misses++
+	var zero V
+	return zero, false
+}
+
+// Set は値をキャッシュする
+func (c *LRU[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.set(key, value)
+}
+
+// SetIfGeneration は世代がgenerationから進んでいない場合のみ値をキャッシュし、キャッシュしたかを返す
+// 読み取り前にGenerationで取得した世代を渡すと、読み取り中に無効化された古い値をキャッシュしない
+func (c *LRU[K, V]) SetIfGeneration(key K, value V, generation uint64) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.generation != generation {
+		return false
+	}
+	c.set(key, value)
+	return true
+}
+
+// Generation は現在の世代を返す
+func (c *LRU[K, V]) Generation() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generation
+}
+
+// Delete はキャッシュから値を削除する（キャッシュにない場合も世代は進める）
+func (c *LRU[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	if elem, ok := c.entries[key]; ok {
+		c.removeElement(elem)
+	}
+}
+
+// Purge はキャッシュをすべて削除する
+func (c *LRU[K, V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	c.entries = make(map[K]*list.Element)
+	c.order.Init()
+}
+
+// Len はキャッシュしている件数を返す（期限切れでまだ削除していないものを含む）
+func (c *LRU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// Stats はこれまでの計測値を返す
+func (c *LRU[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return Stats{
+		Hits:      c.hits,
+		Misses:    c.misses,
+		Evictions: c.evictions,
+		Size:      c.order.Len(),
+	}
+}
+
+// set は値をキャッシュし、容量の上限を超えた分を削除する（ロックを取得済みであること）
+func (c *LRU[K, V]) set(key K, value V) {
+	expiresAt := c.now().Add(c.ttl)
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*lruEntry[K, V])
+		entry.value = value
+		entry.expiresAt = expiresAt
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&lruEntry[K, V]{key: key, value: value, expiresAt: expiresAt})
+	for c.order.Len() > c.capacity {
+		c.removeElement(c.order.Back())
+		c.evictions++
+	}
+}
+
+// removeElement はキャッシュから1件を削除する（ロックを取得済みであること）
+func (c *LRU[K, V]) removeElement(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.entries, elem.Value.(*lruEntry[K, V]).key)
+}
@@ -0,0 +1,110 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLRU_Get(t *testing.T) {
+	t.Run("容量を超えると最も長く参照されていないものから削除する", func(t *testing.T) {
+		c := NewLRU[string, int](2, 0)
+		c.Set("a", 1)
+		c.Set("b", 2)
+		c.Get("a") // "a"を参照したため"b"が最も古くなる
+		c.Set("c", 3)
+
+		if _, ok := c.Get("b"); ok {
+			t.Error("Get(b) should be evicted")
+		}
+		for key, want := range map[string]int{"a": 1, "c": 3} {
+			if got, ok := c.Get(key); !ok || got != want {
+				t.Errorf("Get(%s) = %d, %v, want %d", key, got, ok, want)
+			}
+		}
+		if c.Len() != 2 || c.Stats().Evictions != 1 {
+			t.Errorf("Len() = %d, Evictions = %d, want 2 and 1", c.Len(), c.Stats().Evictions)
+		}
+	})
+
+	t.Run("有効期限を過ぎたものは返さない", func(t *testing.T) {
+		now := time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)
+		c := NewLRU[string, int](10, time.Minute)
+		c.now = func() time.Time { return now }
+		c.Set("a", 1)
+
+		now = now.Add(59 * time.Second)
+		if _, ok := c.Get("a"); !ok {
+			t.Error("Get(a) should hit before expiration")
+		}
+		now = now.Add(time.Second)
+		if _, ok := c.Get("a"); ok {
+			t.Error("Get(a) should miss after expiration")
+		}
+		if c.Len() != 0 {
+			t.Errorf("Len() = %d, want expired entry removed", c.Len())
+		}
+	})
+
+	t.Run("同じキーに設定すると値と有効期限を更新する", func(t *testing.T) {
+		now := time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)
+		c := NewLRU[string, int](10, time.Minute)
+		c.now = func() time.Time { return now }
+		c.Set("a", 1)
+		now = now.Add(30 * time.Second)
+		c.Set("a", 2)
+		now = now.Add(45 * time.Second)
+
+		if got, ok := c.Get("a"); !ok || got != 2 {
+			t.Errorf("Get(a) = %d, %v, want 2", got, ok)
+		}
+	})
+}
+
+func TestLRU_Stats(t *testing.T) {
+	c := NewLRU[string, int](10, 0)
+	if rate := c.Stats().HitRate(); rate != 0 {
+		t.Errorf("HitRate() = %v, want 0 without lookups", rate)
+	}
+
+	c.Set("a", 1)
+	c.Get("a")
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+
+	stats := c.Stats()
+	if stats.Hits != 3 || stats.Misses != 1 || stats.Size != 1 {
+		t.Errorf("Stats() = %+v, want 3 hits, 1 miss, size 1", stats)
+	}
+	if rate := stats.HitRate(); rate != 0.75 {
+		t.Errorf("HitRate() = %v, want 0.75", rate)
+	}
+}
+
+func TestLRU_SetIfGeneration(t *testing.T) {
+	c := NewLRU[string, int](10, 0)
+	c.Set("a", 1)
+
+	// 読み取りの開始後に無効化された場合、読み取った値はキャッシュしない
+	generation := c.Generation()
+	c.Delete("a")
+	if c.SetIfGeneration("a", 1, generation) {
+		t.Error("SetIfGeneration() = true, want false after invalidation")
+	}
+	if _, ok := c.Get("a"); ok {
+		t.Error("Get(a) should miss")
+	}
+
+	generation = c.Generation()
+	if !c.SetIfGeneration("a", 2, generation) {
+		t.Error("SetIfGeneration() = false, want true without invalidation")
+	}
+	if got, ok := c.Get("a"); !ok || got != 2 {
+		t.Errorf("Get(a) = %d, %v, want 2", got, ok)
+	}
+
+	c.Purge()
+	if c.Len() != 0 || c.SetIfGeneration("a", 3, generation) {
+		t.Errorf("Len() = %d, want empty cache that rejects stale generation", c.Len())
+	}
+}
@@ -0,0 +1,139 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// CachedUserRepository はFindByIDの結果をキャッシュするユーザーリポジトリ
+// 同じユーザーを短時間に繰り返し読む場合に内側のリポジトリを呼び出さない
+// このリポジトリを通した更新・削除では該当ユーザーのキャッシュを無効化する（内側のリポジトリを直接更新した場合は有効期限まで古い値を返す）
+// 見つからなかった結果とエラーはキャッシュしない
+type CachedUserRepository struct {
+	inner repository.UserRepository
+	cache *LRU[string, *entity.User]
+}
+
+// NewCachedUserRepository はユーザーリポジトリをラップしてFindByIDをキャッシュする
+// capacityが0以下の場合はDefaultCapacityを使用し、ttlが0以下の場合は期限切れにしない
+func NewCachedUserRepository(inner repository.UserRepository, capacity int, ttl time.Duration) *CachedUserRepository {
+	return &CachedUserRepository{
+		inner: inner,
+		cache: NewLRU[string, *entity.User](capacity, ttl),
+	}
+}
+
+var _ repository.UserRepository = (*CachedUserRepository)(nil)
+
+// CacheStats はFindByIDのキャッシュの計測値を返す
+func (r *CachedUserRepository) CacheStats() Stats {
+	return r.cache.Stats()
+}
+
+// Create は新しいユーザーを作成する（見つからなかった結果はキャッシュしないため無効化は不要）
+func (r *CachedUserRepository) Create(ctx context.Context, user *entity.User) error {
+	return r.inner.Create(ctx, user)
+}
+
+// BulkCreate は複数のユーザーをまとめて作成する（テストやデモ用の初期データ投入向け）
+func (r *CachedUserRepository) BulkCreate(ctx context.Context, users []*entity.User, mode repository.BulkCreateMode) (repository.BulkCreateResult, error) {
+	return r.inner.BulkCreate(ctx, users, mode)
+}
+
+// FindByID はIDでユーザーを検索する（キャッシュにある場合はキャッシュから返す）
+// 呼び出し側が変更してもキャッシュに影響しないよう、常にコピーを返す
+func (r *CachedUserRepository) FindByID(ctx context.Context, id string) (*entity.User, error) {
+	if user, ok := r.cache.Get(id); ok {
+		return user.Clone(), nil
+	}
+
+	// 読み取り中に更新・削除された場合は、読み取った値が古い可能性があるためキャッシュしない
+	generation := r.cache.Generation()
+	user, err := r.inner.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	r.cache.SetIfGeneration(id, user.Clone(), generation)
+	return user, nil
+}
+
+// FindByIDs は複数のIDでユーザーをまとめて検索する
+func (r *CachedUserRepository) FindByIDs(ctx context.Context, ids []string) ([]*entity.User, error) {
+	return r.inner.FindByIDs(ctx, ids)
+}
+
+// FindByUsername はユーザー名でユーザーを検索する
+func (r *CachedUserRepository) FindByUsername(ctx context.Context, username string) (*entity.User, error) {
+	return r.inner.FindByUsername(ctx, username)
+}
+
+// FindByEmail はメールアドレスでユーザーを検索する
+func (r *CachedUserRepository) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
+	return r.inner.FindByEmail(ctx, email)
+}
+
+// Update はユーザー情報を更新する
+func (r *CachedUserRepository) Update(ctx context.Context, user *entity.User) error {
+	err := r.inner.Update(ctx, user)
+	if user != nil {
+		r.cache.Delete(user.ID)
+	}
+	return err
+}
+
+// AddPoints はユーザーのポイントに加算し、加算後のポイントを返す
+func (r *CachedUserRepository) AddPoints(ctx context.Context, id string, points int) (int, error) {
+	total, err := r.inner.AddPoints(ctx, id, points)
+	r.cache.Delete(id)
+	return total, err
+}
+
+// FindTopByPoints はポイントの多い順にユーザーを取得する（同点の場合はIDの昇順）
+func (r *CachedUserRepository) FindTopByPoints(ctx context.Context, limit int) ([]*entity.User, error) {
+	return r.inner.FindTopByPoints(ctx, limit)
+}
+
+// FindDeletionDue は削除予定の猶予期間がnowまでに過ぎたユーザーを取得する
+func (r *CachedUserRepository) FindDeletionDue(ctx context.Context, now time.Time) ([]*entity.User, error) {
+	return r.inner.FindDeletionDue(ctx, now)
+}
+
+// Delete はユーザーを削除する
+func (r *CachedUserRepository) Delete(ctx context.Context, id string) error {
+	err := r.inner.Delete(ctx, id)
+	r.cache.Delete(id)
+	return err
+}
+
+// ExistsByID はIDでユーザーの存在を確認する
+func (r *CachedUserRepository) ExistsByID(ctx context.Context, id string) (bool, error) {
+	return r.inner.ExistsByID(ctx, id)
+}
+
+// ExistsByUsername はユーザー名でユーザーの存在を確認する
+func (r *CachedUserRepository) ExistsByUsername(ctx context.Context, username string) (bool, error) {
+	return r.inner.ExistsByUsername(ctx, username)
+}
+
+// ExistsByEmail はメールアドレスでユーザーの存在を確認する
+func (r *CachedUserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	return r.inner.ExistsByEmail(ctx, email)
+}
+
+// FindAll はすべてのユーザーを取得する（ページネーション対応）
+func (r *CachedUserRepository) FindAll(ctx context.Context, offset, limit int) ([]*entity.User, error) {
+	return r.inner.FindAll(ctx, offset, limit)
+}
+
+// Count は総ユーザー数を取得する
+func (r *CachedUserRepository) Count(ctx context.Context) (int, error) {
+	return r.inner.Count(ctx)
+}
+
+// Stats は総ユーザー数とプラン別の内訳をまとめて取得する
+func (r *CachedUserRepository) Stats(ctx context.Context) (repository.RepositoryStats, error) {
+	return r.inner.Stats(ctx)
+}
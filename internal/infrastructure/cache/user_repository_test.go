@@ -0,0 +1,160 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+// countingUserRepository はFindByIDの呼び出し回数を数えるユーザーリポジトリ
+type countingUserRepository struct {
+	repository.UserRepository
+	findCalls int
+	onFind    func() // 内側のリポジトリから読み取った直後に呼ばれる（nilの場合は何もしない）
+}
+
+func (r *countingUserRepository) FindByID(ctx context.Context, id string) (*entity.User, error) {
+	r.findCalls++
+	user, err := r.UserRepository.FindByID(ctx, id)
+	if r.onFind != nil {
+		r.onFind()
+	}
+	return user, err
+}
+
+// newCachedUserRepositoryFixture はuser1を登録したキャッシュ付きのリポジトリを作成する
+func newCachedUserRepositoryFixture(t *testing.T) (*CachedUserRepository, *countingUserRepository) {
+	t.Helper()
+	inner := &countingUserRepository{UserRepository: memory.NewUserRepository()}
+	if err := inner.Create(context.Background(), &entity.User{
+		ID:           "user1",
+		Username:     "user1",
+		Email:        "user1@example.com",
+		PasswordHash: "hashed",
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}); err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	return NewCachedUserRepository(inner, 10, time.Minute), inner
+}
+
+func TestCachedUserRepository_FindByID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("繰り返し読む場合は内側のリポジトリを呼び出さない", func(t *testing.T) {
+		repo, inner := newCachedUserRepositoryFixture(t)
+		for i := 0; i < 4; i++ {
+			user, err := repo.FindByID(ctx, "user1")
+			if err != nil || user.Username != "user1" {
+				t.Fatalf("FindByID() = %+v, %v", user, err)
+			}
+		}
+		if inner.findCalls != 1 {
+			t.Errorf("findCalls = %d, want 1", inner.findCalls)
+		}
+
+		stats := repo.CacheStats()
+		if stats.Hits != 3 || stats.Misses != 1 || stats.HitRate() != 0.75 {
+			t.Errorf("CacheStats() = %+v (hit rate %v), want 3 hits and 1 miss", stats, stats.HitRate())
+		}
+	})
+
+	t.Run("返したユーザーを変更してもキャッシュに影響しない", func(t *testing.T) {
+		repo, _ := newCachedUserRepositoryFixture(t)
+		first, _ := repo.FindByID(ctx, "user1")
+		first.Username = "changed"
+		second, _ := repo.FindByID(ctx, "user1")
+		second.Username = "changed"
+
+		if got, _ := repo.FindByID(ctx, "user1"); got.Username != "user1" {
+			t.Errorf("Username = %s, want user1", got.Username)
+		}
+	})
+
+	t.Run("見つからなかった結果はキャッシュしない", func(t *testing.T) {
+		repo, inner := newCachedUserRepositoryFixture(t)
+		for i := 0; i < 2; i++ {
+			if _, err := repo.FindByID(ctx, "unknown"); !errors.Is(err, repository.ErrNotFound) {
+				t.Fatalf("FindByID() error = %v, want ErrNotFound", err)
+			}
+		}
+		if inner.findCalls != 2 || repo.CacheStats().Size != 0 {
+			t.Errorf("findCalls = %d, size = %d, want 2 calls and no cache", inner.findCalls, repo.CacheStats().Size)
+		}
+
+		// 後から作成したユーザーはすぐに読める
+		if err := repo.Create(ctx, &entity.User{ID: "unknown", Username: "unknown", Email: "unknown@example.com", PasswordHash: "hashed"}); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+		if _, err := repo.FindByID(ctx, "unknown"); err != nil {
+			t.Errorf("FindByID() unexpected error: %v", err)
+		}
+	})
+}
+
+func TestCachedUserRepository_Invalidation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("更新直後は新しい値を返す", func(t *testing.T) {
+		repo, _ := newCachedUserRepositoryFixture(t)
+		user, _ := repo.FindByID(ctx, "user1")
+		user.Username = "renamed"
+		if err := repo.Update(ctx, user); err != nil {
+			t.Fatalf("Update() unexpected error: %v", err)
+		}
+
+		if got, _ := repo.FindByID(ctx, "user1"); got.Username != "renamed" {
+			t.Errorf("Username = %s, want renamed", got.Username)
+		}
+	})
+
+	t.Run("ポイントの加算直後は新しい値を返す", func(t *testing.T) {
+		repo, _ := newCachedUserRepositoryFixture(t)
+		repo.FindByID(ctx, "user1")
+		if _, err := repo.AddPoints(ctx, "user1", 3); err != nil {
+			t.Fatalf("AddPoints() unexpected error: %v", err)
+		}
+
+		if got, _ := repo.FindByID(ctx, "user1"); got.Points != 3 {
+			t.Errorf("Points = %d, want 3", got.Points)
+		}
+	})
+
+	t.Run("削除直後は見つからない", func(t *testing.T) {
+		repo, _ := newCachedUserRepositoryFixture(t)
+		repo.FindByID(ctx, "user1")
+		if err := repo.Delete(ctx, "user1"); err != nil {
+			t.Fatalf("Delete() unexpected error: %v", err)
+		}
+
+		if _, err := repo.FindByID(ctx, "user1"); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("FindByID() error = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("読み取り中に更新された場合は古い値をキャッシュしない", func(t *testing.T) {
+		repo, inner := newCachedUserRepositoryFixture(t)
+		inner.onFind = func() {
+			inner.onFind = nil
+			user, _ := inner.UserRepository.FindByID(ctx, "user1")
+			user.Username = "renamed"
+			if err := repo.Update(ctx, user); err != nil {
+				t.Fatalf("Update() unexpected error: %v", err)
+			}
+		}
+
+		// 更新前に読み取った値は返るが、キャッシュはされない
+		if got, _ := repo.FindByID(ctx, "user1"); got.Username != "user1" {
+			t.Fatalf("Username = %s, want user1", got.Username)
+		}
+		if got, _ := repo.FindByID(ctx, "user1"); got.Username != "renamed" {
+			t.Errorf("Username = %s, want renamed", got.Username)
+		}
+	})
+}
@@ -0,0 +1,255 @@
+// Package circuitbreaker はメール送信・翻訳・天気予報などの外部連携の呼び出しを保護する汎用サーキットブレーカーを提供する
+// 外部連携のエラー率が閾値を超えると一定時間呼び出しをスキップし、不調な連携先を待ってメイン処理が詰まることを防ぐ
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrOpen はサーキットブレーカーがオープン状態のため呼び出しをスキップしたことを表す
+var ErrOpen = errors.New("circuit breaker is open")
+
+// State はサーキットブレーカーの状態
+type State int
+
+const (
+	// StateClosed は通常どおり呼び出す状態
+	StateClosed State = iota
+	// StateOpen は呼び出しをスキップする状態
+	StateOpen
+	// StateHalfOpen は一部の呼び出しを試行して連携先の回復を確認する状態
+	StateHalfOpen
+)
+
+// String は状態の文字列表現を返す
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half_open"
+	default:
+		return "unknown"
+	}
+}
+
+const (
+	// DefaultFailureRateThreshold はオープン状態にするデフォルトのエラー率
+	DefaultFailureRateThreshold = 0.5
+	// DefaultMinRequests はエラー率を判定するのに必要なデフォルトの最小呼び出し回数
+	DefaultMinRequests = 10
+	// DefaultWindow はエラー率を集計するデフォルトの期間
+	DefaultWindow = 1 * time.Minute
+	// DefaultOpenDuration はオープン状態で呼び出しをスキップするデフォルトの期間
+	DefaultOpenDuration = 30 * time.Second
+	// DefaultHalfOpenMaxRequests はハーフオープン状態で試行するデフォルトの呼び出し回数
+	DefaultHalfOpenMaxRequests = 1
+)
+
+// Config はサーキットブレーカーの設定
+type Config struct {
+	FailureRateThreshold float64       // オープン状態にするエラー率（0より大きく1以下）
+	MinRequests          int           // エラー率を判定するのに必要な集計期間内の最小呼び出し回数
+	Window               time.Duration // エラー率を集計する期間（経過するたびに集計をリセットする）
+	OpenDuration         time.Duration // オープン状態で呼び出しをスキップする期間
+	HalfOpenMaxRequests  int           // ハーフオープン状態で試行する呼び出し回数（すべて成功するとクローズ状態に戻る）
+
+	// IsFailure はエラーを連携先の失敗として数えるかを判定する
+	// nilの場合は呼び出し元のキャンセル（context.Canceled）以外のすべてのエラーを失敗として数える
+	IsFailure func(err error) bool
+
+	// OnStateChange は状態が遷移したときに呼び出される（ロックの外で呼び出すため、この中でブレーカーを操作してもよい）
+	OnStateChange func(name string, from, to State)
+}
+
+// transition は1回の状態遷移
+type transition struct {
+	from, to State
+}
+
+// Breaker はエラー率に基づいて外部連携の呼び出しを止めるサーキットブレーカー
+// ロックは状態の判定と集計の間だけ保持し、連携先の呼び出し中は保持しない
+type Breaker struct {
+	name   string
+	config Config
+	now    func() time.Time
+
+	mu                sync.Mutex
+	state             State
+	generation        uint64 // 状態が遷移するたびに増やし、遷移前に開始した呼び出しの結果を集計から除く
+	windowStart       time.Time
+	requests          int
+	failures          int
+	openedAt          time.Time
+	halfOpenRequests  int
+	halfOpenSuccesses int
+	transitions       []transition // ロックの解放後に通知する状態遷移
+}
+
+// New は新しいサーキットブレーカーを作成する
+// nameは状態遷移の通知でどの連携先のブレーカーかを区別するために使用する
+// 設定値が未指定（ゼロ値）または範囲外の項目にはデフォルト値を使用する
+func New(name string, config Config) *Breaker {
+	if config.FailureRateThreshold <= 0 || config.FailureRateThreshold > 1 {
+		config.FailureRateThreshold = DefaultFailureRateThreshold
+	}
+	if config.MinRequests <= 0 {
+		config.MinRequests = DefaultMinRequests
+	}
+	if config.Window <= 0 {
+		config.Window = DefaultWindow
+	}
+	if config.OpenDuration <= 0 {
+		config.OpenDuration = DefaultOpenDuration
+	}
+	if config.HalfOpenMaxRequests <= 0 {
+		config.HalfOpenMaxRequests = DefaultHalfOpenMaxRequests
+	}
+	if config.IsFailure == nil {
+		config.IsFailure = func(err error) bool {
+			return !errors.Is(err, context.Canceled)
+		}
+	}
+
+	b := &Breaker{
+		name:   name,
+		config: config,
+		now:    time.Now,
+	}
+	b.windowStart = b.now()
+	return b
+}
+
+// State は現在の状態を返す
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	b.refresh(b.now())
+	state := b.state
+	b.unlockAndNotify()
+	return state
+}
+
+// Execute は状態に応じてfnを呼び出し、その結果を集計する
+// オープン状態、またはハーフオープン状態で試行回数に達している場合はfnを呼び出さずにErrOpenを返す
+func (b *Breaker) Execute(fn func() error) (err error) {
+	generation, err := b.beforeRequest()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		// fnがパニックした場合も失敗として数え、ハーフオープン状態の試行枠を残さない
+		if r := recover(); r != nil {
+			b.afterRequest(generation, false)
+			panic(r)
+		}
+	}()
+
+	err = fn()
+	b.afterRequest(generation, err == nil || !b.config.IsFailure(err))
+	return err
+}
+
+// beforeRequest は呼び出してよいかを判定し、呼び出し開始時点の世代を返す
+func (b *Breaker) beforeRequest() (uint64, error) {
+	b.mu.Lock()
+	defer b.unlockAndNotify()
+
+	b.refresh(b.now())
+	switch b.state {
+	case StateOpen:
+		return 0, ErrOpen
+	case StateHalfOpen:
+		if b.halfOpenRequests >= b.config.HalfOpenMaxRequests {
+			return 0, ErrOpen
+		}
+		b.halfOpenRequests++
+	}
+	return b.generation, nil
+}
+
+// afterRequest は呼び出しの結果を集計し、必要に応じて状態を遷移させる
+func (b *Breaker) afterRequest(generation uint64, success bool) {
+	b.mu.Lock()
+	defer b.unlockAndNotify()
+
+	now := b.now()
+	b.refresh(now)
+	if generation != b.generation {
+		return
+	}
+
+	switch b.state {
+	case StateClosed:
+		b.requests++
+		if !success {
+			b.failures++
+		}
+		if b.requests >= b.config.MinRequests &&
+			float64(b.failures)/float64(b.requests) >= b.config.FailureRateThreshold {
+			b.setState(StateOpen, now)
+		}
+	case StateHalfOpen:
+		if !success {
+			b.setState(StateOpen, now)
+			return
+		}
+		b.halfOpenSuccesses++
+		if b.halfOpenSuccesses >= b.config.HalfOpenMaxRequests {
+			b.setState(StateClosed, now)
+		}
+	}
+}
+
+// refresh は時間の経過による集計のリセットと、オープンからハーフオープンへの遷移を行う
+// 呼び出し元でロックを取得していること
+func (b *Breaker) refresh(now time.Time) {
+	switch b.state {
+	case StateClosed:
+		if now.Sub(b.windowStart) >= b.config.Window {
+			b.windowStart = now
+			b.requests, b.failures = 0, 0
+		}
+	case StateOpen:
+		if now.Sub(b.openedAt) >= b.config.OpenDuration {
+			b.setState(StateHalfOpen, now)
+		}
+	}
+}
+
+// setState は状態を遷移させ、集計をリセットする
+// 呼び出し元でロックを取得していること
+func (b *Breaker) setState(state State, now time.Time) {
+	if b.state == state {
+		return
+	}
+	b.transitions = append(b.transitions, transition{from: b.state, to: state})
+
+	b.state = state
+	b.generation++
+	b.windowStart = now
+	b.requests, b.failures = 0, 0
+	b.halfOpenRequests, b.halfOpenSuccesses = 0, 0
+	if state == StateOpen {
+		b.openedAt = now
+	}
+}
+
+// unlockAndNotify はロックを解放してから、ロック中に発生した状態遷移を通知する
+func (b *Breaker) unlockAndNotify() {
+	transitions := b.transitions
+	b.transitions = nil
+	b.mu.Unlock()
+
+	if b.config.OnStateChange == nil {
+		return
+	}
+	for _, t := range transitions {
+		b.config.OnStateChange(b.name, t.from, t.to)
+	}
+}
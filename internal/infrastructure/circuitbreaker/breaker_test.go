@@ -0,0 +1,259 @@
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/mail"
+)
+
+var errUnavailable = errors.New("unavailable")
+
+// newTestBreaker は時刻を操作できるブレーカーと、状態遷移の記録を返す
+func newTestBreaker(config Config) (*Breaker, *time.Time, *[]string) {
+	now := time.Date(2026, 1, 1, 7, 0, 0, 0, time.UTC)
+	var mu sync.Mutex
+	var transitions []string
+	config.OnStateChange = func(name string, from, to State) {
+		mu.Lock()
+		defer mu.Unlock()
+		transitions = append(transitions, from.String()+"->"+to.String())
+	}
+	b := New("test", config)
+	b.now = func() time.Time { return now }
+	b.windowStart = now
+	return b, &now, &transitions
+}
+
+func succeed() error { return nil }
+func fail() error    { return errUnavailable }
+
+func TestBreaker_StateTransitions(t *testing.T) {
+	b, now, transitions := newTestBreaker(Config{
+		FailureRateThreshold: 0.5,
+		MinRequests:          4,
+		OpenDuration:         30 * time.Second,
+		HalfOpenMaxRequests:  2,
+	})
+
+	// 最小呼び出し回数に達するまではエラー率が高くてもクローズのまま
+	for range 3 {
+		if err := b.Execute(fail); !errors.Is(err, errUnavailable) {
+			t.Fatalf("Execute() = %v, want errUnavailable", err)
+		}
+	}
+	if b.State() != StateClosed {
+		t.Fatalf("State() = %s, want closed before MinRequests", b.State())
+	}
+
+	// 4回中3回失敗（75%）で閾値を超えてオープンになる
+	_ = b.Execute(succeed)
+	if b.State() != StateOpen {
+		t.Fatalf("State() = %s, want open", b.State())
+	}
+
+	// オープン中は呼び出さずにErrOpenを返す
+	called := false
+	if err := b.Execute(func() error { called = true; return nil }); !errors.Is(err, ErrOpen) || called {
+		t.Fatalf("Execute() = %v, called = %v, want ErrOpen without calling", err, called)
+	}
+
+	// オープン期間が過ぎるとハーフオープンになり、試行回数までは呼び出す
+	*now = now.Add(30 * time.Second)
+	if b.State() != StateHalfOpen {
+		t.Fatalf("State() = %s, want half_open", b.State())
+	}
+	if err := b.Execute(succeed); err != nil {
+		t.Fatalf("Execute() = %v, want nil in half_open", err)
+	}
+	if b.State() != StateHalfOpen {
+		t.Fatalf("State() = %s, want half_open until all trials succeed", b.State())
+	}
+
+	// すべての試行が成功するとクローズに戻る
+	if err := b.Execute(succeed); err != nil {
+		t.Fatalf("Execute() = %v, want nil in half_open", err)
+	}
+	if b.State() != StateClosed {
+		t.Fatalf("State() = %s, want closed", b.State())
+	}
+
+	want := []string{"closed->open", "open->half_open", "half_open->closed"}
+	if len(*transitions) != len(want) {
+		t.Fatalf("transitions = %v, want %v", *transitions, want)
+	}
+	for i := range want {
+		if (*transitions)[i] != want[i] {
+			t.Errorf("transitions[%d] = %s, want %s", i, (*transitions)[i], want[i])
+		}
+	}
+}
+
+func TestBreaker_HalfOpenFailureReopens(t *testing.T) {
+	b, now, _ := newTestBreaker(Config{MinRequests: 1, OpenDuration: time.Minute})
+
+	_ = b.Execute(fail)
+	if b.State() != StateOpen {
+		t.Fatalf("State() = %s, want open", b.State())
+	}
+
+	*now = now.Add(time.Minute)
+	if err := b.Execute(fail); !errors.Is(err, errUnavailable) {
+		t.Fatalf("Execute() = %v, want errUnavailable", err)
+	}
+	if b.State() != StateOpen {
+		t.Fatalf("State() = %s, want open after failed trial", b.State())
+	}
+
+	// 再オープンした時刻からオープン期間を数え直す
+	*now = now.Add(time.Minute - time.Nanosecond)
+	if b.State() != StateOpen {
+		t.Errorf("State() = %s, want open before OpenDuration elapses", b.State())
+	}
+}
+
+func TestBreaker_HalfOpenLimitsConcurrentTrials(t *testing.T) {
+	b, now, _ := newTestBreaker(Config{MinRequests: 1, OpenDuration: time.Minute, HalfOpenMaxRequests: 1})
+
+	_ = b.Execute(fail)
+	*now = now.Add(time.Minute)
+
+	// 試行中の呼び出しが終わるまでは、それ以上の呼び出しをスキップする
+	started := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- b.Execute(func() error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+
+	if err := b.Execute(succeed); !errors.Is(err, ErrOpen) {
+		t.Errorf("Execute() during trial = %v, want ErrOpen", err)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("trial Execute() = %v", err)
+	}
+	if b.State() != StateClosed {
+		t.Errorf("State() = %s, want closed", b.State())
+	}
+}
+
+func TestBreaker_WindowResetsCounts(t *testing.T) {
+	b, now, _ := newTestBreaker(Config{FailureRateThreshold: 0.5, MinRequests: 2, Window: time.Minute})
+
+	_ = b.Execute(fail)
+	// 集計期間が過ぎると前の期間の失敗は数えない
+	*now = now.Add(time.Minute)
+	_ = b.Execute(succeed)
+	_ = b.Execute(succeed)
+	if b.State() != StateClosed {
+		t.Errorf("State() = %s, want closed", b.State())
+	}
+}
+
+func TestBreaker_IsFailure(t *testing.T) {
+	b, _, _ := newTestBreaker(Config{
+		MinRequests: 1,
+		IsFailure: func(err error) bool {
+			return !errors.Is(err, errUnavailable)
+		},
+	})
+
+	// 失敗として数えないエラーはそのまま返すがオープンにはしない
+	if err := b.Execute(fail); !errors.Is(err, errUnavailable) {
+		t.Fatalf("Execute() = %v, want errUnavailable", err)
+	}
+	if b.State() != StateClosed {
+		t.Errorf("State() = %s, want closed", b.State())
+	}
+
+	// デフォルトでは呼び出し元のキャンセルを失敗として数えない
+	d, _, _ := newTestBreaker(Config{MinRequests: 1})
+	_ = d.Execute(func() error { return context.Canceled })
+	if d.State() != StateClosed {
+		t.Errorf("State() = %s, want closed after cancellation", d.State())
+	}
+}
+
+func TestBreaker_StaleResultIgnored(t *testing.T) {
+	b, _, _ := newTestBreaker(Config{MinRequests: 1})
+
+	// クローズ中に開始した呼び出しの結果は、その間にオープンになっていれば集計しない
+	started := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- b.Execute(func() error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+	_ = b.Execute(fail)
+	close(release)
+	<-done
+
+	if b.State() != StateOpen {
+		t.Errorf("State() = %s, want open", b.State())
+	}
+}
+
+func TestNew_Defaults(t *testing.T) {
+	b := New("test", Config{FailureRateThreshold: 2})
+	c := b.config
+	if c.FailureRateThreshold != DefaultFailureRateThreshold ||
+		c.MinRequests != DefaultMinRequests ||
+		c.Window != DefaultWindow ||
+		c.OpenDuration != DefaultOpenDuration ||
+		c.HalfOpenMaxRequests != DefaultHalfOpenMaxRequests {
+		t.Errorf("config = %+v, want defaults", c)
+	}
+}
+
+// failingEmailSender は常に送信に失敗するEmailSender
+type failingEmailSender struct {
+	calls int
+}
+
+func (s *failingEmailSender) Send(ctx context.Context, message service.EmailMessage) error {
+	s.calls++
+	return errUnavailable
+}
+
+func TestEmailSender(t *testing.T) {
+	ctx := context.Background()
+	message := service.EmailMessage{To: "alice@example.com", Subject: "件名"}
+
+	next := mail.NewMemoryEmailSender()
+	sender := NewEmailSender(next, New("mail", Config{}))
+	if err := sender.Send(ctx, message); err != nil {
+		t.Fatalf("Send() = %v", err)
+	}
+	if len(next.Messages()) != 1 {
+		t.Errorf("sent %d messages, want 1", len(next.Messages()))
+	}
+
+	// 連携先が不調になるとオープンになり、以降は送信を試みない
+	failing := &failingEmailSender{}
+	sender = NewEmailSender(failing, New("mail", Config{MinRequests: 2}))
+	for range 2 {
+		_ = sender.Send(ctx, message)
+	}
+	if err := sender.Send(ctx, message); !errors.Is(err, ErrOpen) {
+		t.Errorf("Send() = %v, want ErrOpen", err)
+	}
+	if failing.calls != 2 {
+		t.Errorf("calls = %d, want 2", failing.calls)
+	}
+}
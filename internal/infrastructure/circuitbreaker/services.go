@@ -0,0 +1,81 @@
+package circuitbreaker
+
+import (
+	"context"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// EmailSender はメール送信をサーキットブレーカー経由で行うデコレータ
+// オープン状態の間は送信せずにErrOpenを返す
+type EmailSender struct {
+	next    service.EmailSender
+	breaker *Breaker
+}
+
+// NewEmailSender はnextをbreakerでラップしたEmailSenderを作成する
+func NewEmailSender(next service.EmailSender, breaker *Breaker) *EmailSender {
+	return &EmailSender{next: next, breaker: breaker}
+}
+
+// Send はブレーカーがメールの送信を許可している場合にのみ送信する
+func (s *EmailSender) Send(ctx context.Context, message service.EmailMessage) error {
+	return s.breaker.Execute(func() error {
+		return s.next.Send(ctx, message)
+	})
+}
+
+// Translator は翻訳をサーキットブレーカー経由で行うデコレータ
+// オープン状態の間は翻訳せずにErrOpenを返す
+type Translator struct {
+	next    service.Translator
+	breaker *Breaker
+}
+
+// NewTranslator はnextをbreakerでラップしたTranslatorを作成する
+func NewTranslator(next service.Translator, breaker *Breaker) *Translator {
+	return &Translator{next: next, breaker: breaker}
+}
+
+// Translate はブレーカーが翻訳を許可している場合にのみ翻訳する
+func (t *Translator) Translate(ctx context.Context, text, targetLang string) (string, error) {
+	var translated string
+	err := t.breaker.Execute(func() error {
+		var err error
+		translated, err = t.next.Translate(ctx, text, targetLang)
+		return err
+	})
+	return translated, err
+}
+
+// WeatherProvider は天気予報の取得をサーキットブレーカー経由で行うデコレータ
+// オープン状態の間は取得せずにErrOpenを返す
+type WeatherProvider struct {
+	next    service.WeatherProvider
+	breaker *Breaker
+}
+
+// NewWeatherProvider はnextをbreakerでラップしたWeatherProviderを作成する
+func NewWeatherProvider(next service.WeatherProvider, breaker *Breaker) *WeatherProvider {
+	return &WeatherProvider{next: next, breaker: breaker}
+}
+
+// Forecast はブレーカーが取得を許可している場合にのみ天気予報を取得する
+func (p *WeatherProvider) Forecast(ctx context.Context, timeZone string, at time.Time) (valueobject.WeatherCondition, error) {
+	var condition valueobject.WeatherCondition
+	err := p.breaker.Execute(func() error {
+		var err error
+		condition, err = p.next.Forecast(ctx, timeZone, at)
+		return err
+	})
+	return condition, err
+}
+
+// インターフェースの実装を保証
+var (
+	_ service.EmailSender     = (*EmailSender)(nil)
+	_ service.Translator      = (*Translator)(nil)
+	_ service.WeatherProvider = (*WeatherProvider)(nil)
+)
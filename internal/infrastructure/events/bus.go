@@ -0,0 +1,251 @@
+// Package events はリポジトリの変更を複数の購読者へ非同期に通知するイベントバスを提供する
+// スケジューラ・WebSocket・Webhookなどの購読者は、ユースケースを変更せずにモーニングコールの変更を知ることができる
+package events
+
+import (
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// EventType はイベントの種類
+type EventType string
+
+const (
+	EventMorningCallCreated       EventType = "morning_call.created"        // モーニングコールが作成された
+	EventMorningCallStatusChanged EventType = "morning_call.status_changed" // モーニングコールのステータスが変わった
+	EventMorningCallDeleted       EventType = "morning_call.deleted"        // モーニングコールが削除された
+)
+
+// Event はリポジトリの変更を表すイベント
+type Event struct {
+	Type EventType
+	// MorningCall は変更後（削除の場合は削除前）のモーニングコールのスナップショット
+	// 同じイベントを受け取る購読者間で共有されるため、購読者は変更しないこと
+	MorningCall *entity.MorningCall
+	// PreviousStatus はステータスが変わった場合の変更前のステータス（それ以外は空）
+	PreviousStatus valueobject.MorningCallStatus
+	OccurredAt     time.Time
+}
+
+// IsTransitionTo はモーニングコールのステータスが指定のステータスへ変わったイベントかを判定する
+func (e Event) IsTransitionTo(status valueobject.MorningCallStatus) bool {
+	return e.Type == EventMorningCallStatusChanged && e.MorningCall != nil &&
+		e.MorningCall.Status == status && e.PreviousStatus != status
+}
+
+// DefaultSubscriberBufferSize は購読者ごとに保持できる未処理イベント数のデフォルト値
+const DefaultSubscriberBufferSize = 64
+
+// EventBus はイベントを購読者へ非同期に配信する
+// 購読者ごとにバッファとゴルーチンを持つため、1つの購読者の処理が遅れても発行側や他の購読者は待たされない
+// バッファがいっぱいの購読者へのイベントは破棄し、破棄した件数を購読者ごとに数える
+// 取りこぼすと復旧できない購読者（通知の送信など）はSubscribeUnboundedで登録し、破棄させない
+type EventBus struct {
+	mu          sync.RWMutex
+	subscribers map[*Subscription]struct{}
+	bufferSize  int
+	closed      bool
+}
+
+// NewEventBus は新しいイベントバスを作成する
+// bufferSizeが0以下の場合はDefaultSubscriberBufferSizeを使用する
+func NewEventBus(bufferSize int) *EventBus {
+	if bufferSize <= 0 {
+		bufferSize = DefaultSubscriberBufferSize
+	}
+	return &EventBus{
+		subscribers: make(map[*Subscription]struct{}),
+		bufferSize:  bufferSize,
+	}
+}
+
+// Subscribe はhandlerを購読者として登録する
+// handlerは購読者ごとのゴルーチンから発行された順に1件ずつ呼ばれる
+// バスが閉じられている場合はイベントを受け取らない購読を返す
+func (b *EventBus) Subscribe(handler func(Event)) *Subscription {
+	return b.subscribe(handler, false)
+}
+
+// SubscribeUnbounded はイベントを破棄しない購読者としてhandlerを登録する
+// 処理が追いつかない間は未処理のイベントを件数の上限なくメモリに保持するため、発行側を待たせずに全件を配信する
+// handlerの呼ばれ方とバスが閉じられている場合の扱いはSubscribeと同じ
+func (b *EventBus) SubscribeUnbounded(handler func(Event)) *Subscription {
+	return b.subscribe(handler, true)
+}
+
+// subscribe はhandlerを購読者として登録する
+func (b *EventBus) subscribe(handler func(Event), unbounded bool) *Subscription {
+	sub := &Subscription{
+		bus:       b,
+		unbounded: unbounded,
+		ready:     make(chan struct{}, 1),
+		done:      make(chan struct{}),
+	}
+	if !unbounded {
+		sub.events = make(chan Event, b.bufferSize)
+	}
+
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		sub.closeEvents()
+		close(sub.done)
+		return sub
+	}
+	b.subscribers[sub] = struct{}{}
+	b.mu.Unlock()
+
+	go sub.run(handler)
+	return sub
+}
+
+// Publish はイベントをすべての購読者へ配信する
+// 購読者の処理を待たずに戻る。バスが閉じられている場合は何もしない
+func (b *EventBus) Publish(event Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.closed {
+		return
+	}
+
+	for sub := range b.subscribers {
+		sub.deliver(event)
+	}
+}
+
+// Close はすべての購読を解除し、購読者が受け取り済みのイベントを処理し終えるまで待つ
+// 閉じた後のPublishは何もしない
+func (b *EventBus) Close() {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return
+	}
+	b.closed = true
+	subs := make([]*Subscription, 0, len(b.subscribers))
+	for sub := range b.subscribers {
+		subs = append(subs, sub)
+		delete(b.subscribers, sub)
+		sub.closeEvents()
+	}
+	b.mu.Unlock()
+
+	for _, sub := range subs {
+		<-sub.done
+	}
+}
+
+// Subscription はイベントバスへの購読
+type Subscription struct {
+	bus       *EventBus
+	events    chan Event // バッファ付きの購読で受け取ったイベント（破棄しない購読ではnil）
+	unbounded bool       // イベントを破棄しない購読か
+
+	// 破棄しない購読で受け取ったイベントと、購読が閉じられたか
+	mu     sync.Mutex
+	queue  []Event
+	closed bool
+	ready  chan struct{} // queueへの追加と購読の終了を知らせる
+
+	done    chan struct{}
+	dropped atomic.Int64
+}
+
+// deliver はイベントを購読者へ渡す（バッファがいっぱいの場合は破棄して数える）
+func (s *Subscription) deliver(event Event) {
+	if s.unbounded {
+		s.mu.Lock()
+		s.queue = append(s.queue, event)
+		s.mu.Unlock()
+		s.notify()
+		return
+	}
+
+	select {
+	case s.events <- event:
+	default:
+		s.dropped.Add(1)
+	}
+}
+
+// closeEvents はイベントの受け取りを終える（受け取り済みのイベントは処理する）
+func (s *Subscription) closeEvents() {
+	if s.unbounded {
+		s.mu.Lock()
+		s.closed = true
+		s.mu.Unlock()
+		s.notify()
+		return
+	}
+	close(s.events)
+}
+
+// notify は待機中のrunへ知らせる（知らせ済みの場合は何もしない）
+func (s *Subscription) notify() {
+	select {
+	case s.ready <- struct{}{}:
+	default:
+	}
+}
+
+// run は受け取ったイベントを順にhandlerへ渡す
+func (s *Subscription) run(handler func(Event)) {
+	defer close(s.done)
+	if !s.unbounded {
+		for event := range s.events {
+			s.handle(handler, event)
+		}
+		return
+	}
+
+	for {
+		s.mu.Lock()
+		pending, closed := s.queue, s.closed
+		s.queue = nil
+		s.mu.Unlock()
+
+		for _, event := range pending {
+			s.handle(handler, event)
+		}
+		if len(pending) == 0 {
+			if closed {
+				return
+			}
+			<-s.ready
+		}
+	}
+}
+
+// handle は1件のイベントをhandlerへ渡す
+// handlerがパニックしても購読は続け、以降のイベントも配信する
+func (s *Subscription) handle(handler func(Event), event Event) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("イベントの処理中にパニックが発生しました (%s): %v", event.Type, r)
+		}
+	}()
+	handler(event)
+}
+
+// Unsubscribe は購読を解除し、解除前に受け取ったイベントを処理し終えるまで待つ
+// 複数回呼んでもよいが、自身のhandlerの中からは呼ばないこと（処理の終了を待つため戻らなくなる）
+func (s *Subscription) Unsubscribe() {
+	s.bus.mu.Lock()
+	if _, ok := s.bus.subscribers[s]; ok {
+		delete(s.bus.subscribers, s)
+		s.closeEvents()
+	}
+	s.bus.mu.Unlock()
+
+	<-s.done
+}
+
+// Dropped はバッファがいっぱいだったために破棄したイベント数を返す（破棄しない購読では常に0）
+func (s *Subscription) Dropped() int64 {
+	return s.dropped.Load()
+}
@@ -0,0 +1,231 @@
+package events
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// receive はチャネルからイベントを1件受け取る（一定時間内に届かない場合は失敗）
+func receive(t *testing.T, ch <-chan Event) Event {
+	t.Helper()
+	select {
+	case event := <-ch:
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("イベントが届きませんでした")
+		return Event{}
+	}
+}
+
+func TestEventBus_PublishSubscribe(t *testing.T) {
+	bus := NewEventBus(0)
+	defer bus.Close()
+
+	first := make(chan Event, 10)
+	second := make(chan Event, 10)
+	bus.Subscribe(func(e Event) { first <- e })
+	bus.Subscribe(func(e Event) { second <- e })
+
+	bus.Publish(Event{Type: EventMorningCallCreated})
+	bus.Publish(Event{Type: EventMorningCallDeleted})
+
+	// すべての購読者が発行順に受け取る
+	for _, ch := range []chan Event{first, second} {
+		if got := receive(t, ch).Type; got != EventMorningCallCreated {
+			t.Errorf("1件目 = %s, want %s", got, EventMorningCallCreated)
+		}
+		if got := receive(t, ch).Type; got != EventMorningCallDeleted {
+			t.Errorf("2件目 = %s, want %s", got, EventMorningCallDeleted)
+		}
+	}
+}
+
+func TestSubscription_Unsubscribe(t *testing.T) {
+	bus := NewEventBus(0)
+	defer bus.Close()
+
+	var mu sync.Mutex
+	var received []EventType
+	sub := bus.Subscribe(func(e Event) {
+		mu.Lock()
+		defer mu.Unlock()
+		received = append(received, e.Type)
+	})
+
+	bus.Publish(Event{Type: EventMorningCallCreated})
+	// 解除前に発行したイベントは処理し終えてから戻る
+	sub.Unsubscribe()
+	bus.Publish(Event{Type: EventMorningCallDeleted})
+	sub.Unsubscribe()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(received) != 1 || received[0] != EventMorningCallCreated {
+		t.Errorf("received = %v, want only %s", received, EventMorningCallCreated)
+	}
+}
+
+func TestEventBus_SlowSubscriberDoesNotBlockOthers(t *testing.T) {
+	bus := NewEventBus(1)
+
+	release := make(chan struct{})
+	slow := bus.Subscribe(func(Event) { <-release })
+	fast := make(chan Event, 10)
+	bus.Subscribe(func(e Event) { fast <- e })
+
+	// 遅い購読者のバッファが溢れても発行と他の購読者への配信は止まらない
+	for range 5 {
+		published := make(chan struct{})
+		go func() {
+			defer close(published)
+			bus.Publish(Event{Type: EventMorningCallStatusChanged})
+		}()
+		select {
+		case <-published:
+		case <-time.After(time.Second):
+			t.Fatal("遅い購読者によって発行がブロックされました")
+		}
+		receive(t, fast)
+	}
+
+	// 遅い購読者は処理中の1件とバッファの1件以外を破棄する
+	if got := slow.Dropped(); got < 3 {
+		t.Errorf("Dropped() = %d, want at least 3", got)
+	}
+
+	close(release)
+	bus.Close()
+}
+
+func TestEventBus_UnboundedSubscriberKeepsAllEvents(t *testing.T) {
+	bus := NewEventBus(1)
+
+	release := make(chan struct{})
+	var received []EventType
+	sub := bus.SubscribeUnbounded(func(e Event) {
+		<-release
+		received = append(received, e.Type)
+	})
+
+	// 処理が追いつかなくても発行はブロックされず、イベントも破棄しない
+	published := make(chan struct{})
+	go func() {
+		defer close(published)
+		for i := range 10 {
+			eventType := EventMorningCallCreated
+			if i%2 == 1 {
+				eventType = EventMorningCallDeleted
+			}
+			bus.Publish(Event{Type: eventType})
+		}
+	}()
+	select {
+	case <-published:
+	case <-time.After(time.Second):
+		t.Fatal("破棄しない購読者によって発行がブロックされました")
+	}
+
+	close(release)
+	bus.Close()
+
+	if len(received) != 10 || sub.Dropped() != 0 {
+		t.Fatalf("received %d events (dropped %d), want 10", len(received), sub.Dropped())
+	}
+	// 発行した順に受け取る
+	for i, eventType := range received {
+		want := EventMorningCallCreated
+		if i%2 == 1 {
+			want = EventMorningCallDeleted
+		}
+		if eventType != want {
+			t.Errorf("%d件目 = %s, want %s", i+1, eventType, want)
+		}
+	}
+
+	// 閉じた後の購読と解除は何もしない
+	closedSub := bus.SubscribeUnbounded(func(Event) { t.Error("閉じたバスの購読者が呼ばれました") })
+	bus.Publish(Event{Type: EventMorningCallCreated})
+	closedSub.Unsubscribe()
+}
+
+func TestEventBus_HandlerPanicKeepsSubscription(t *testing.T) {
+	bus := NewEventBus(0)
+	defer bus.Close()
+
+	received := make(chan Event, 10)
+	bus.Subscribe(func(e Event) {
+		if e.Type == EventMorningCallCreated {
+			panic("handler failure")
+		}
+		received <- e
+	})
+
+	bus.Publish(Event{Type: EventMorningCallCreated})
+	bus.Publish(Event{Type: EventMorningCallDeleted})
+
+	if got := receive(t, received).Type; got != EventMorningCallDeleted {
+		t.Errorf("got %s, want %s", got, EventMorningCallDeleted)
+	}
+}
+
+func TestEventBus_Close(t *testing.T) {
+	bus := NewEventBus(0)
+
+	var count int
+	bus.Subscribe(func(Event) { count++ })
+	bus.Publish(Event{Type: EventMorningCallCreated})
+
+	// Closeは受け取り済みのイベントを処理し終えるまで待つ
+	bus.Close()
+	if count != 1 {
+		t.Errorf("count = %d, want 1", count)
+	}
+
+	// 閉じた後の発行・購読・解除は何もしない
+	bus.Publish(Event{Type: EventMorningCallCreated})
+	sub := bus.Subscribe(func(Event) { count++ })
+	sub.Unsubscribe()
+	bus.Close()
+	if count != 1 {
+		t.Errorf("count after Close = %d, want 1", count)
+	}
+}
+
+func TestEvent_IsTransitionTo(t *testing.T) {
+	expired := &entity.MorningCall{ID: "mc1", Status: valueobject.MorningCallStatusExpired}
+
+	tests := []struct {
+		name  string
+		event Event
+		want  bool
+	}{
+		{
+			name:  "配信済みから期限切れへの遷移",
+			event: Event{Type: EventMorningCallStatusChanged, MorningCall: expired, PreviousStatus: valueobject.MorningCallStatusDelivered},
+			want:  true,
+		},
+		{
+			name:  "他のステータスへの遷移",
+			event: Event{Type: EventMorningCallStatusChanged, MorningCall: &entity.MorningCall{ID: "mc1", Status: valueobject.MorningCallStatusConfirmed}, PreviousStatus: valueobject.MorningCallStatusDelivered},
+		},
+		{
+			name:  "ステータスの変更以外のイベント",
+			event: Event{Type: EventMorningCallDeleted, MorningCall: expired},
+		},
+		{
+			name:  "モーニングコールのないイベント",
+			event: Event{Type: EventMorningCallStatusChanged},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.event.IsTransitionTo(valueobject.MorningCallStatusExpired); got != tt.want {
+				t.Errorf("IsTransitionTo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/mail"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/scheduler"
+	morningCallUC "github.com/ochamu/morning-call-api/internal/usecase/morning_call"
+)
+
+// blockingEmailSender はreleaseが閉じられるまで送信を待たせるメール送信
+type blockingEmailSender struct {
+	*mail.MemoryEmailSender
+	release chan struct{}
+}
+
+func (s *blockingEmailSender) Send(ctx context.Context, message service.EmailMessage) error {
+	<-s.release
+	return s.MemoryEmailSender.Send(ctx, message)
+}
+
+func TestExpirationNotification_NotifiesEverySenderBeyondBufferSize(t *testing.T) {
+	ctx := context.Background()
+	bus := NewEventBus(0)
+	userRepo := memory.NewUserRepository()
+	morningCallRepo := NewMorningCallRepository(memory.NewMorningCallRepository(), bus)
+
+	// バッファを超える件数のコールを同じ実行でまとめて期限切れにする
+	calls := DefaultSubscriberBufferSize * 2
+	passed := time.Now().Add(-time.Minute)
+	if err := userRepo.Create(ctx, &entity.User{ID: "receiver", Username: "receiver", Email: "receiver@example.com", PasswordHash: "hashed"}); err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	for i := 0; i < calls; i++ {
+		senderID := fmt.Sprintf("sender%03d", i)
+		if err := userRepo.Create(ctx, &entity.User{
+			ID:                 senderID,
+			Username:           senderID,
+			Email:              senderID + "@example.com",
+			PasswordHash:       "hashed",
+			NotifyOnExpiration: true,
+		}); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+		if err := morningCallRepo.Create(ctx, &entity.MorningCall{
+			ID:              fmt.Sprintf("mc%03d", i),
+			SenderID:        senderID,
+			ReceiverID:      "receiver",
+			ScheduledTime:   passed.Add(-time.Hour),
+			Status:          valueobject.MorningCallStatusDelivered,
+			ConfirmDeadline: &passed,
+		}); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+
+	// メールの送信が遅れている間に期限切れが続いても通知を取りこぼさない
+	emailSender := &blockingEmailSender{MemoryEmailSender: mail.NewMemoryEmailSender(), release: make(chan struct{})}
+	notifyUC := morningCallUC.NewNotifyExpirationUseCase(userRepo, emailSender)
+	bus.SubscribeUnbounded(func(event Event) {
+		if !event.IsTransitionTo(valueobject.MorningCallStatusExpired) {
+			return
+		}
+		if _, err := notifyUC.Execute(ctx, morningCallUC.NotifyExpirationInput{MorningCall: event.MorningCall, PreviousStatus: event.PreviousStatus}); err != nil {
+			t.Errorf("Execute() unexpected error: %v", err)
+		}
+	})
+
+	worker := scheduler.NewMorningCallExpirationWorker(morningCallRepo, scheduler.MorningCallExpirationConfig{})
+	if count, err := worker.RunOnce(ctx); err != nil || count != calls {
+		t.Fatalf("RunOnce() = %d, %v, want %d", count, err, calls)
+	}
+
+	close(emailSender.release)
+	bus.Close()
+
+	notified := make(map[string]bool)
+	for _, message := range emailSender.Messages() {
+		notified[message.To] = true
+	}
+	for i := 0; i < calls; i++ {
+		if to := fmt.Sprintf("sender%03d@example.com", i); !notified[to] {
+			t.Errorf("%s was not notified", to)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// MorningCallRepository は変更時にイベントを発行するモーニングコールリポジトリ
+// 作成・ステータスの変更・削除に成功した場合のみイベントを発行し、参照系の操作はそのまま委譲する
+type MorningCallRepository struct {
+	repository.MorningCallRepository
+	emit func(Event) // イベントの発行先（トランザクション内ではコミットまで保留する）
+	now  func() time.Time
+}
+
+// NewMorningCallRepository はモーニングコールリポジトリをラップして変更時にイベントを発行するようにする
+func NewMorningCallRepository(inner repository.MorningCallRepository, bus *EventBus) *MorningCallRepository {
+	return &MorningCallRepository{MorningCallRepository: inner, emit: bus.Publish, now: time.Now}
+}
+
+var _ repository.MorningCallRepository = (*MorningCallRepository)(nil)
+
+// Create は新しいモーニングコールを作成し、作成イベントを発行する
+func (r *MorningCallRepository) Create(ctx context.Context, morningCall *entity.MorningCall) error {
+	if err := r.MorningCallRepository.Create(ctx, morningCall); err != nil {
+		return err
+	}
+	r.publish(EventMorningCallCreated, morningCall, "")
+	return nil
+}
+
+// BulkCreate は複数のモーニングコールをまとめて作成し、作成できたものごとに作成イベントを発行する
+func (r *MorningCallRepository) BulkCreate(ctx context.Context, morningCalls []*entity.MorningCall, mode repository.BulkCreateMode) (repository.BulkCreateResult, error) {
+	result, err := r.MorningCallRepository.BulkCreate(ctx, morningCalls, mode)
+	if err != nil || result.Created == 0 {
+		return result, err
+	}
+
+	failed := make(map[int]bool, len(result.Failed))
+	for _, f := range result.Failed {
+		failed[f.Index] = true
+	}
+	for i, morningCall := range morningCalls {
+		if !failed[i] {
+			r.publish(EventMorningCallCreated, morningCall, "")
+		}
+	}
+	return result, nil
+}
+
+// Update はモーニングコール情報を更新し、ステータスが変わった場合はステータス変更イベントを発行する
+// 変更前のステータスは更新前に取得する。取得後に他の更新が行われた場合は楽観ロックにより更新自体が失敗する
+func (r *MorningCallRepository) Update(ctx context.Context, morningCall *entity.MorningCall) error {
+	previous, findErr := r.MorningCallRepository.FindByID(ctx, morningCall.ID)
+	if err := r.MorningCallRepository.Update(ctx, morningCall); err != nil {
+		return err
+	}
+	if findErr == nil && previous.Status != morningCall.Status {
+		r.publish(EventMorningCallStatusChanged, morningCall, previous.Status)
+	}
+	return nil
+}
+
+// Delete はモーニングコールを削除し、削除イベントを発行する
+func (r *MorningCallRepository) Delete(ctx context.Context, id string) error {
+	deleted, findErr := r.MorningCallRepository.FindByID(ctx, id)
+	if err := r.MorningCallRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	if findErr == nil {
+		r.publish(EventMorningCallDeleted, deleted, "")
+	}
+	return nil
+}
+
+// publish はモーニングコールのスナップショットを持つイベントを発行する
+// 呼び出し元がエンティティを変更し続けても購読者に影響しないよう複製を渡す
+func (r *MorningCallRepository) publish(eventType EventType, morningCall *entity.MorningCall, previous valueobject.MorningCallStatus) {
+	r.emit(Event{
+		Type:           eventType,
+		MorningCall:    morningCall.Clone(),
+		PreviousStatus: previous,
+		OccurredAt:     r.now(),
+	})
+}
@@ -0,0 +1,115 @@
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestMorningCallRepository_PublishesChanges(t *testing.T) {
+	ctx := context.Background()
+	bus := NewEventBus(0)
+	defer bus.Close()
+
+	received := make(chan Event, 10)
+	bus.Subscribe(func(e Event) { received <- e })
+
+	repo := NewMorningCallRepository(memory.NewMorningCallRepository(), bus)
+	mc := &entity.MorningCall{
+		ID:            "mc1",
+		SenderID:      "sender",
+		ReceiverID:    "receiver",
+		ScheduledTime: time.Now().Add(time.Hour),
+		Status:        valueobject.MorningCallStatusScheduled,
+	}
+
+	// 作成
+	if err := repo.Create(ctx, mc); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	created := receive(t, received)
+	if created.Type != EventMorningCallCreated || created.MorningCall.ID != "mc1" {
+		t.Errorf("created event = %+v", created)
+	}
+
+	// ステータスが変わらない更新ではイベントを発行しない
+	saved, _ := repo.FindByID(ctx, "mc1")
+	saved.Message = "おはよう"
+	if err := repo.Update(ctx, saved); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	// 状態遷移
+	saved, _ = repo.FindByID(ctx, "mc1")
+	if reason := saved.MarkAsDelivered(); reason.IsNG() {
+		t.Fatalf("MarkAsDelivered() = %v", reason)
+	}
+	if err := repo.Update(ctx, saved); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	changed := receive(t, received)
+	if changed.Type != EventMorningCallStatusChanged ||
+		changed.PreviousStatus != valueobject.MorningCallStatusScheduled ||
+		changed.MorningCall.Status != valueobject.MorningCallStatusDelivered {
+		t.Errorf("status changed event = %+v", changed)
+	}
+	// イベントはスナップショットのため、発行後に呼び出し元が変更しても影響しない
+	saved.Message = "変更後"
+	if changed.MorningCall.Message != "おはよう" {
+		t.Errorf("event snapshot message = %q, want おはよう", changed.MorningCall.Message)
+	}
+
+	// 楽観ロックで失敗した更新ではイベントを発行しない
+	stale := saved.Clone()
+	stale.Version--
+	stale.Status = valueobject.MorningCallStatusConfirmed
+	if err := repo.Update(ctx, stale); err == nil {
+		t.Fatal("Update() with stale version should fail")
+	}
+
+	// 削除
+	if err := repo.Delete(ctx, "mc1"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	deleted := receive(t, received)
+	if deleted.Type != EventMorningCallDeleted || deleted.MorningCall.ID != "mc1" {
+		t.Errorf("deleted event = %+v", deleted)
+	}
+
+	select {
+	case e := <-received:
+		t.Errorf("unexpected event: %+v", e)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestMorningCallRepository_BulkCreatePublishesCreatedOnly(t *testing.T) {
+	ctx := context.Background()
+	bus := NewEventBus(0)
+
+	var events []Event
+	bus.Subscribe(func(e Event) { events = append(events, e) })
+
+	repo := NewMorningCallRepository(memory.NewMorningCallRepository(), bus)
+	newCall := func(id string) *entity.MorningCall {
+		return &entity.MorningCall{ID: id, SenderID: "sender", ReceiverID: "receiver", ScheduledTime: time.Now().Add(time.Hour), Status: valueobject.MorningCallStatusScheduled}
+	}
+
+	result, err := repo.BulkCreate(ctx, []*entity.MorningCall{newCall("mc1"), newCall("mc1"), newCall("mc2")}, repository.BulkCreatePartial)
+	if err != nil {
+		t.Fatalf("BulkCreate() error = %v", err)
+	}
+	bus.Close()
+
+	if result.Created != 2 || len(events) != 2 {
+		t.Fatalf("Created = %d, events = %d, want 2 and 2", result.Created, len(events))
+	}
+	if events[0].MorningCall.ID != "mc1" || events[1].MorningCall.ID != "mc2" {
+		t.Errorf("events = %s, %s, want mc1, mc2", events[0].MorningCall.ID, events[1].MorningCall.ID)
+	}
+}
@@ -0,0 +1,89 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// TransactionManager はトランザクション内のモーニングコールの変更についてもイベントを発行するトランザクションマネージャー
+// トランザクション内の変更によるイベントはコミットに成功するまで保留し、ロールバックした場合は破棄する
+type TransactionManager struct {
+	repository.TransactionManager
+	bus *EventBus
+	now func() time.Time
+}
+
+// NewTransactionManager はトランザクションマネージャーをラップしてコミット時にイベントを発行するようにする
+func NewTransactionManager(inner repository.TransactionManager, bus *EventBus) *TransactionManager {
+	return &TransactionManager{TransactionManager: inner, bus: bus, now: time.Now}
+}
+
+var _ repository.TransactionManager = (*TransactionManager)(nil)
+
+// WithTx はトランザクションを開始し、変更時にイベントを保留するモーニングコールリポジトリを返す
+func (tm *TransactionManager) WithTx(ctx context.Context) (*repository.TxRepositories, repository.Transaction, error) {
+	repos, tx, err := tm.TransactionManager.WithTx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	eventTx := &transaction{Transaction: tx, bus: tm.bus}
+	txRepos := *repos
+	if repos.MorningCall != nil {
+		txRepos.MorningCall = &MorningCallRepository{
+			MorningCallRepository: repos.MorningCall,
+			emit:                  eventTx.hold,
+			now:                   tm.now,
+		}
+	}
+	return &txRepos, eventTx, nil
+}
+
+// transaction はコミットに成功した場合に保留したイベントを発行するトランザクション
+type transaction struct {
+	repository.Transaction
+	bus *EventBus
+
+	mu      sync.Mutex
+	pending []Event
+}
+
+// hold はコミットまでイベントを保留する
+func (tx *transaction) hold(event Event) {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+	tx.pending = append(tx.pending, event)
+}
+
+// Commit はトランザクションをコミットし、成功した場合は保留したイベントを発生順に発行する
+func (tx *transaction) Commit() error {
+	if err := tx.Transaction.Commit(); err != nil {
+		tx.discard()
+		return err
+	}
+
+	tx.mu.Lock()
+	pending := tx.pending
+	tx.pending = nil
+	tx.mu.Unlock()
+	for _, event := range pending {
+		tx.bus.Publish(event)
+	}
+	return nil
+}
+
+// Rollback はトランザクションをロールバックし、保留したイベントを破棄する
+func (tx *transaction) Rollback() error {
+	tx.discard()
+	return tx.Transaction.Rollback()
+}
+
+// discard は保留したイベントを破棄する
+func (tx *transaction) discard() {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+	tx.pending = nil
+}
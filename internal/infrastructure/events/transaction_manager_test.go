@@ -0,0 +1,133 @@
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+	relationshipUC "github.com/ochamu/morning-call-api/internal/usecase/relationship"
+)
+
+// newTransactionFixture は友達同士のsenderとreceiver、その間の予定済みのモーニングコールを持つリポジトリを作成する
+func newTransactionFixture(t *testing.T) (*memory.UserRepository, *memory.MorningCallRepository, *memory.RelationshipRepository) {
+	t.Helper()
+	ctx := context.Background()
+	userRepo := memory.NewUserRepository()
+	morningCallRepo := memory.NewMorningCallRepository()
+	relationshipRepo := memory.NewRelationshipRepository()
+
+	for _, id := range []string{"sender", "receiver"} {
+		if err := userRepo.Create(ctx, &entity.User{ID: id, Username: id, Email: id + "@example.com", PasswordHash: "hashed"}); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+	if err := relationshipRepo.Create(ctx, &entity.Relationship{
+		ID:          "rel1",
+		RequesterID: "sender",
+		ReceiverID:  "receiver",
+		Status:      valueobject.RelationshipStatusAccepted,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}); err != nil {
+		t.Fatalf("failed to create relationship: %v", err)
+	}
+	if err := morningCallRepo.Create(ctx, &entity.MorningCall{
+		ID:            "mc1",
+		SenderID:      "sender",
+		ReceiverID:    "receiver",
+		ScheduledTime: time.Now().Add(time.Hour),
+		Status:        valueobject.MorningCallStatusScheduled,
+	}); err != nil {
+		t.Fatalf("failed to create morning call: %v", err)
+	}
+	return userRepo, morningCallRepo, relationshipRepo
+}
+
+func TestTransactionManager_PublishesOnCommit(t *testing.T) {
+	ctx := context.Background()
+	bus := NewEventBus(0)
+	defer bus.Close()
+	received := make(chan Event, 10)
+	bus.Subscribe(func(e Event) { received <- e })
+
+	userRepo, morningCallRepo, relationshipRepo := newTransactionFixture(t)
+	tm := NewTransactionManager(memory.NewTransactionManager(userRepo, morningCallRepo, relationshipRepo), bus)
+
+	cancelAndRollback := func() {
+		t.Helper()
+		repos, tx, err := tm.WithTx(ctx)
+		if err != nil {
+			t.Fatalf("WithTx() error = %v", err)
+		}
+		mc, _ := repos.MorningCall.FindByID(ctx, "mc1")
+		if reason := mc.Cancel(); reason.IsNG() {
+			t.Fatalf("Cancel() = %v", reason)
+		}
+		if err := repos.MorningCall.Update(ctx, mc); err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+		if err := tx.Rollback(); err != nil {
+			t.Fatalf("Rollback() error = %v", err)
+		}
+	}
+
+	// ロールバックした変更は通知しない
+	cancelAndRollback()
+	select {
+	case e := <-received:
+		t.Fatalf("unexpected event after rollback: %+v", e)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// コミットした変更はコミット後に通知する
+	repos, tx, err := tm.WithTx(ctx)
+	if err != nil {
+		t.Fatalf("WithTx() error = %v", err)
+	}
+	mc, _ := repos.MorningCall.FindByID(ctx, "mc1")
+	mc.Cancel()
+	if err := repos.MorningCall.Update(ctx, mc); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	select {
+	case e := <-received:
+		t.Fatalf("event published before commit: %+v", e)
+	case <-time.After(50 * time.Millisecond):
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+	if e := receive(t, received); !e.IsTransitionTo(valueobject.MorningCallStatusCancelled) {
+		t.Errorf("event = %+v, want cancelled", e)
+	}
+}
+
+func TestTransactionManager_BlockUserPublishesCancellation(t *testing.T) {
+	ctx := context.Background()
+	bus := NewEventBus(0)
+	defer bus.Close()
+	received := make(chan Event, 10)
+	bus.Subscribe(func(e Event) { received <- e })
+
+	userRepo, memMorningCallRepo, relationshipRepo := newTransactionFixture(t)
+	tm := NewTransactionManager(memory.NewTransactionManager(userRepo, memMorningCallRepo, relationshipRepo), bus)
+	morningCallRepo := NewMorningCallRepository(memMorningCallRepo, bus)
+
+	uc := relationshipUC.NewBlockUserUseCase(relationshipRepo, userRepo, morningCallRepo, tm)
+	output, err := uc.Execute(ctx, relationshipUC.BlockUserInput{BlockerID: "receiver", BlockedID: "sender"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if output.CancelledCalls != 1 {
+		t.Fatalf("CancelledCalls = %d, want 1", output.CancelledCalls)
+	}
+
+	e := receive(t, received)
+	if !e.IsTransitionTo(valueobject.MorningCallStatusCancelled) || e.MorningCall.ID != "mc1" ||
+		e.PreviousStatus != valueobject.MorningCallStatusScheduled {
+		t.Errorf("event = %+v, want mc1 cancelled from scheduled", e)
+	}
+}
@@ -0,0 +1,40 @@
+// Package external は天気予報などの外部データ提供サービスとの連携を提供する
+package external
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// ErrWeatherUnavailable は天気予報を取得できないことを表す
+var ErrWeatherUnavailable = errors.New("weather forecast is unavailable")
+
+// StubWeatherProvider は常に同じ天気を返すWeatherProviderの実装
+// 外部の天気予報サービスが用意されるまでの開発用に使用する
+type StubWeatherProvider struct {
+	condition valueobject.WeatherCondition
+}
+
+// NewStubWeatherProvider は新しいStubWeatherProviderを作成する
+// conditionが定義済みの天気でない場合は、予報を取得できない連携先として振る舞う
+func NewStubWeatherProvider(condition valueobject.WeatherCondition) *StubWeatherProvider {
+	return &StubWeatherProvider{condition: condition}
+}
+
+// Forecast は地域・時刻にかかわらず設定された天気を返す
+func (p *StubWeatherProvider) Forecast(ctx context.Context, timeZone string, at time.Time) (valueobject.WeatherCondition, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	if !p.condition.IsValid() {
+		return "", ErrWeatherUnavailable
+	}
+	return p.condition, nil
+}
+
+// インターフェースの実装を保証
+var _ service.WeatherProvider = (*StubWeatherProvider)(nil)
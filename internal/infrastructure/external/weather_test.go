@@ -0,0 +1,31 @@
+package external
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+func TestStubWeatherProvider_Forecast(t *testing.T) {
+	ctx := context.Background()
+	at := time.Date(2026, 1, 1, 7, 0, 0, 0, time.UTC)
+
+	got, err := NewStubWeatherProvider(valueobject.WeatherRain).Forecast(ctx, "Asia/Tokyo", at)
+	if err != nil || got != valueobject.WeatherRain {
+		t.Errorf("Forecast() = (%q, %v), want (rain, nil)", got, err)
+	}
+
+	// 天気が設定されていない場合は取得できない連携先として振る舞う
+	if _, err := NewStubWeatherProvider("").Forecast(ctx, "Asia/Tokyo", at); !errors.Is(err, ErrWeatherUnavailable) {
+		t.Errorf("Forecast() error = %v, want ErrWeatherUnavailable", err)
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := NewStubWeatherProvider(valueobject.WeatherRain).Forecast(canceled, "", at); !errors.Is(err, context.Canceled) {
+		t.Errorf("Forecast() error = %v, want context.Canceled", err)
+	}
+}
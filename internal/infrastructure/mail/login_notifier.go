@@ -0,0 +1,45 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+)
+
+// LoginNotifier は初めてのデバイス・IPアドレスからのログインをメールで知らせる実装
+type LoginNotifier struct {
+	sender service.EmailSender
+}
+
+// NewLoginNotifier はsenderで通知を送るLoginNotifierを作成する
+func NewLoginNotifier(sender service.EmailSender) *LoginNotifier {
+	return &LoginNotifier{sender: sender}
+}
+
+// NotifyNewLogin はログインしたユーザーのメールアドレスへ通知を送る
+func (n *LoginNotifier) NotifyNewLogin(ctx context.Context, notification service.LoginNotification) error {
+	device := notification.Device
+	if device == "" {
+		device = "不明"
+	}
+	ipAddress := notification.IPAddress
+	if ipAddress == "" {
+		ipAddress = "不明"
+	}
+
+	return n.sender.Send(ctx, service.EmailMessage{
+		To:      notification.Email,
+		Subject: "新しいデバイスからのログイン",
+		Body: fmt.Sprintf(
+			"%sさん、これまでと異なるデバイスまたは場所からログインがありました。\n\n日時: %s\nデバイス: %s\nIPアドレス: %s\n\n心当たりがない場合は、すぐにパスワードを変更してください。",
+			notification.Username,
+			notification.LoggedInAt.Format("2006-01-02 15:04:05 MST"),
+			device,
+			ipAddress,
+		),
+	})
+}
+
+// インターフェースの実装を保証
+var _ service.LoginNotifier = (*LoginNotifier)(nil)
@@ -0,0 +1,37 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// ReportNotifier は定期レポートをメールで届ける実装
+// メールは添付ファイルに対応していないため、要約の後に書き出したレポートを本文として続ける
+type ReportNotifier struct {
+	sender service.EmailSender
+}
+
+// NewReportNotifier はsenderでレポートを送るReportNotifierを作成する
+func NewReportNotifier(sender service.EmailSender) *ReportNotifier {
+	return &ReportNotifier{sender: sender}
+}
+
+// NotifyReport はレポートをユーザーのメールアドレスへ送る
+func (n *ReportNotifier) NotifyReport(ctx context.Context, delivery service.ReportDelivery) error {
+	subject := "モーニングコールの週次レポート"
+	if delivery.Period == valueobject.ReportPeriodMonthly {
+		subject = fmt.Sprintf("モーニングコールの月次レポート（%d年%d月）", delivery.From.Year(), int(delivery.From.Month()))
+	}
+
+	return n.sender.Send(ctx, service.EmailMessage{
+		To:      delivery.Email,
+		Subject: subject,
+		Body:    fmt.Sprintf("%s\n\n--- %s ---\n%s", delivery.Summary, delivery.Filename, delivery.Content),
+	})
+}
+
+// インターフェースの実装を保証
+var _ service.ReportNotifier = (*ReportNotifier)(nil)
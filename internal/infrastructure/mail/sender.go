@@ -0,0 +1,75 @@
+package mail
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/pkg/utils"
+)
+
+// LogEmailSender は標準ログへメールの内容を出力する実装
+// 実際のメール配信基盤が用意されるまでの開発用に使用する
+type LogEmailSender struct {
+	logger *log.Logger
+}
+
+// NewLogEmailSender は新しいLogEmailSenderを作成する
+// loggerがnilの場合は標準ロガーを使用する
+func NewLogEmailSender(logger *log.Logger) *LogEmailSender {
+	if logger == nil {
+		logger = log.Default()
+	}
+	return &LogEmailSender{logger: logger}
+}
+
+// Send はメールの宛先・件名・本文をログに出力する
+func (s *LogEmailSender) Send(ctx context.Context, message service.EmailMessage) error {
+	s.logger.Printf("%s[MAIL] to=%s subject=%s\n%s",
+		utils.RequestIDLogPrefix(utils.RequestIDFromContext(ctx)),
+		message.To,
+		message.Subject,
+		message.Body,
+	)
+	return nil
+}
+
+// MemoryEmailSender は送信したメールをメモリ上に保持する実装
+// テストや開発環境での確認用に使用する
+type MemoryEmailSender struct {
+	mu       sync.RWMutex
+	messages []service.EmailMessage
+}
+
+// NewMemoryEmailSender は新しいMemoryEmailSenderを作成する
+func NewMemoryEmailSender() *MemoryEmailSender {
+	return &MemoryEmailSender{
+		messages: make([]service.EmailMessage, 0),
+	}
+}
+
+// Send はメールをメモリに追加する
+func (s *MemoryEmailSender) Send(ctx context.Context, message service.EmailMessage) error {
+	_ = ctx // 将来的な外部サービス実装のために保持
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages = append(s.messages, message)
+	return nil
+}
+
+// Messages は送信されたメールのコピーを送信順に返す
+func (s *MemoryEmailSender) Messages() []service.EmailMessage {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make([]service.EmailMessage, len(s.messages))
+	copy(result, s.messages)
+	return result
+}
+
+// インターフェースの実装を保証
+var (
+	_ service.EmailSender = (*LogEmailSender)(nil)
+	_ service.EmailSender = (*MemoryEmailSender)(nil)
+)
@@ -0,0 +1,89 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+func TestLogEmailSender_Send(t *testing.T) {
+	var buf bytes.Buffer
+	sender := NewLogEmailSender(log.New(&buf, "", 0))
+
+	err := sender.Send(context.Background(), service.EmailMessage{
+		To:      "alice@example.com",
+		Subject: "件名",
+		Body:    "本文",
+	})
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+
+	want := "[MAIL] to=alice@example.com subject=件名\n本文"
+	if got := strings.TrimSpace(buf.String()); got != want {
+		t.Errorf("出力 = %q, want %q", got, want)
+	}
+}
+
+func TestMemoryEmailSender_Send(t *testing.T) {
+	sender := NewMemoryEmailSender()
+	ctx := context.Background()
+
+	if err := sender.Send(ctx, service.EmailMessage{To: "a@example.com", Subject: "s1"}); err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+	if err := sender.Send(ctx, service.EmailMessage{To: "b@example.com", Subject: "s2"}); err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+
+	messages := sender.Messages()
+	if len(messages) != 2 {
+		t.Fatalf("件数 = %d, want 2", len(messages))
+	}
+	if messages[0].Subject != "s1" || messages[1].Subject != "s2" {
+		t.Errorf("送信順が保持されていない: %v", messages)
+	}
+
+	// 取得結果を変更しても内部に影響しない
+	messages[0].Subject = "changed"
+	if got := sender.Messages()[0].Subject; got != "s1" {
+		t.Errorf("内部データが変更された: %s", got)
+	}
+}
+
+func TestReportNotifier_NotifyReport(t *testing.T) {
+	sender := NewMemoryEmailSender()
+	notifier := NewReportNotifier(sender)
+
+	err := notifier.NotifyReport(context.Background(), service.ReportDelivery{
+		UserID:   "user1",
+		Email:    "alice@example.com",
+		Period:   valueobject.ReportPeriodMonthly,
+		From:     time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+		To:       time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		Summary:  "要約",
+		Format:   valueobject.ReportFormatCSV,
+		Filename: "report.csv",
+		Content:  []byte("metric,value\n"),
+	})
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+
+	messages := sender.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("送信数 = %d, want 1", len(messages))
+	}
+	if messages[0].To != "alice@example.com" || messages[0].Subject != "モーニングコールの月次レポート（2026年2月）" {
+		t.Errorf("宛先・件名 = %s, %s", messages[0].To, messages[0].Subject)
+	}
+	if !strings.HasPrefix(messages[0].Body, "要約\n") || !strings.Contains(messages[0].Body, "metric,value") {
+		t.Errorf("本文 = %q", messages[0].Body)
+	}
+}
@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"maps"
+
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// bulkCreate は各リポジトリのBulkCreateの共通処理
+// 呼び出し側でロックを取得した状態で使い、全件を検証してから受け付けたものをまとめて保存する
+// checkは保存済みのエンティティに加え、同じバッチ内で先に受け付けたエンティティとの重複も検証し、
+// 受け付けた場合はバッチ内の一意キーを記録する。nilのエンティティは ErrInvalidArgument とする
+func bulkCreate[T any](
+	items []*T,
+	mode repository.BulkCreateMode,
+	check func(item *T) error,
+	insert func(item *T),
+) (repository.BulkCreateResult, error) {
+	if !mode.IsValid() {
+		return repository.BulkCreateResult{}, repository.ErrInvalidArgument
+	}
+
+	accepted := make([]*T, 0, len(items))
+	var failed []*repository.BulkCreateError
+	for i, item := range items {
+		err := repository.ErrInvalidArgument
+		if item != nil {
+			err = check(item)
+		}
+		if err != nil {
+			bulkErr := &repository.BulkCreateError{Index: i, Err: err}
+			if mode == repository.BulkCreateAllOrNothing {
+				// 保存前に検証しているため、ロールバックする変更はない
+				return repository.BulkCreateResult{}, bulkErr
+			}
+			failed = append(failed, bulkErr)
+			continue
+		}
+		accepted = append(accepted, item)
+	}
+
+	for _, item := range accepted {
+		insert(item)
+	}
+	return repository.BulkCreateResult{Created: len(accepted), Failed: failed}, nil
+}
+
+// growMap は追加する件数分の容量を確保したマップを返す
+// 1件ずつ追加する際の段階的な拡張を避けるため、追加する件数が既存の件数より多い場合のみ作り直す
+func growMap[K comparable, V any](m map[K]V, extra int) map[K]V {
+	if extra <= len(m) {
+		return m
+	}
+	grown := make(map[K]V, len(m)+extra)
+	maps.Copy(grown, m)
+	return grown
+}
+
+// cloneMap は追加する件数分の容量を確保したマップの複製を返す（コピーオンライト用）
+func cloneMap[K comparable, V any](m map[K]V, extra int) map[K]V {
+	cloned := make(map[K]V, len(m)+extra)
+	maps.Copy(cloned, m)
+	return cloned
+}
@@ -0,0 +1,322 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+func TestUserRepository_BulkCreate(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		users       []*entity.User
+		mode        repository.BulkCreateMode
+		wantCreated int
+		wantFailed  []int // 作成できなかった位置
+		wantErr     error
+		wantIndex   int // wantErrがある場合の失敗した位置
+		wantCount   int // 作成後の総数（既存のuser0を含む）
+	}{
+		{
+			name:        "全件作成する",
+			users:       []*entity.User{createTestUser("user1", "alice", "alice@example.com"), createTestUser("user2", "bob", "bob@example.com")},
+			mode:        repository.BulkCreateAllOrNothing,
+			wantCreated: 2,
+			wantCount:   3,
+		},
+		{
+			name:      "既存ユーザーとの重複は全体をロールバックする",
+			users:     []*entity.User{createTestUser("user1", "alice", "alice@example.com"), createTestUser("user2", "existing", "bob@example.com")},
+			mode:      repository.BulkCreateAllOrNothing,
+			wantErr:   repository.ErrAlreadyExists,
+			wantIndex: 1,
+			wantCount: 1,
+		},
+		{
+			name:      "バッチ内の重複は全体をロールバックする",
+			users:     []*entity.User{createTestUser("user1", "alice", "alice@example.com"), createTestUser("user2", "bob", "ALICE@example.com")},
+			mode:      repository.BulkCreateAllOrNothing,
+			wantErr:   repository.ErrAlreadyExists,
+			wantIndex: 1,
+			wantCount: 1,
+		},
+		{
+			name:      "nilが混在すると全体をロールバックする",
+			users:     []*entity.User{createTestUser("user1", "alice", "alice@example.com"), nil},
+			mode:      repository.BulkCreateAllOrNothing,
+			wantErr:   repository.ErrInvalidArgument,
+			wantIndex: 1,
+			wantCount: 1,
+		},
+		{
+			name: "部分成功では重複とnilのみ作成しない",
+			users: []*entity.User{
+				createTestUser("user1", "alice", "alice@example.com"),
+				nil,
+				createTestUser("user0", "carol", "carol@example.com"),  // 既存IDと重複
+				createTestUser("user3", "Alice", "alice2@example.com"), // バッチ内のユーザー名と重複
+				createTestUser("user4", "dave", "dave@example.com"),
+			},
+			mode:        repository.BulkCreatePartial,
+			wantCreated: 2,
+			wantFailed:  []int{1, 2, 3},
+			wantCount:   3,
+		},
+		{
+			name:      "不正なモード",
+			users:     []*entity.User{createTestUser("user1", "alice", "alice@example.com")},
+			mode:      repository.BulkCreateMode(99),
+			wantErr:   repository.ErrInvalidArgument,
+			wantIndex: -1,
+			wantCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewUserRepository()
+			if err := repo.Create(ctx, createTestUser("user0", "existing", "existing@example.com")); err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+
+			result, err := repo.BulkCreate(ctx, tt.users, tt.mode)
+			checkBulkCreateResult(t, result, err, tt.wantCreated, tt.wantFailed, tt.wantErr, tt.wantIndex)
+
+			if count, _ := repo.Count(ctx); count != tt.wantCount {
+				t.Errorf("Count() = %d, want %d", count, tt.wantCount)
+			}
+		})
+	}
+
+	t.Run("作成したユーザーをインデックスで検索できる", func(t *testing.T) {
+		repo := NewUserRepository()
+		users := []*entity.User{createTestUser("user1", "Alice", "Alice@example.com"), createTestUser("user2", "bob", "bob@example.com")}
+		if _, err := repo.BulkCreate(ctx, users, repository.BulkCreateAllOrNothing); err != nil {
+			t.Fatalf("BulkCreate() error = %v", err)
+		}
+		// 渡したエンティティを変更しても保存済みのユーザーに影響しない
+		users[0].Username = "changed"
+
+		found, err := repo.FindByUsername(ctx, "alice")
+		if err != nil || found.ID != "user1" {
+			t.Errorf("FindByUsername() = %v, %v, want user1", found, err)
+		}
+		if found, err := repo.FindByEmail(ctx, "BOB@example.com"); err != nil || found.ID != "user2" {
+			t.Errorf("FindByEmail() = %v, %v, want user2", found, err)
+		}
+		// 一括作成後も1件ずつの作成で重複を検出できる
+		if err := repo.Create(ctx, createTestUser("user3", "ALICE", "carol@example.com")); !errors.Is(err, repository.ErrAlreadyExists) {
+			t.Errorf("Create() error = %v, want ErrAlreadyExists", err)
+		}
+	})
+}
+
+func TestMorningCallRepository_BulkCreate(t *testing.T) {
+	ctx := context.Background()
+	scheduled := time.Now().Add(time.Hour)
+
+	t.Run("作成したコールをインデックスで検索できる", func(t *testing.T) {
+		repo := NewMorningCallRepository()
+		calls := []*entity.MorningCall{
+			createTestMorningCall("mc1", "user1", "user2", scheduled, valueobject.MorningCallStatusScheduled),
+			createTestMorningCall("mc2", "user1", "user3", scheduled, valueobject.MorningCallStatusScheduled),
+			createTestMorningCall("mc3", "user2", "user1", scheduled, valueobject.MorningCallStatusConfirmed),
+		}
+		result, err := repo.BulkCreate(ctx, calls, repository.BulkCreateAllOrNothing)
+		checkBulkCreateResult(t, result, err, 3, nil, nil, 0)
+
+		if sent, _ := repo.FindBySenderID(ctx, "user1", 0, 10); len(sent) != 2 {
+			t.Errorf("FindBySenderID() got %d calls, want 2", len(sent))
+		}
+		if count, _ := repo.CountByStatus(ctx, valueobject.MorningCallStatusConfirmed); count != 1 {
+			t.Errorf("CountByStatus(confirmed) = %d, want 1", count)
+		}
+		counts, _ := repo.CountConfirmationsByUser(ctx, scheduled.Add(-time.Hour), scheduled.Add(time.Hour))
+		if counts.Woken["user1"] != 1 {
+			t.Errorf("CountConfirmationsByUser() woken = %v, want user1: 1", counts.Woken)
+		}
+	})
+
+	t.Run("重複があれば全体をロールバックする", func(t *testing.T) {
+		repo := NewMorningCallRepository()
+		calls := []*entity.MorningCall{
+			createTestMorningCall("mc1", "user1", "user2", scheduled, valueobject.MorningCallStatusScheduled),
+			createTestMorningCall("mc1", "user1", "user3", scheduled, valueobject.MorningCallStatusScheduled),
+		}
+		result, err := repo.BulkCreate(ctx, calls, repository.BulkCreateAllOrNothing)
+		checkBulkCreateResult(t, result, err, 0, nil, repository.ErrAlreadyExists, 1)
+		if count, _ := repo.Count(ctx); count != 0 {
+			t.Errorf("Count() = %d, want 0", count)
+		}
+		if sent, _ := repo.FindBySenderID(ctx, "user1", 0, 10); len(sent) != 0 {
+			t.Errorf("FindBySenderID() got %d calls, want 0 after rollback", len(sent))
+		}
+	})
+
+	t.Run("部分成功ではnilと重複のみ作成しない", func(t *testing.T) {
+		repo := NewMorningCallRepository()
+		calls := []*entity.MorningCall{
+			nil,
+			createTestMorningCall("mc1", "user1", "user2", scheduled, valueobject.MorningCallStatusScheduled),
+			createTestMorningCall("mc1", "user1", "user3", scheduled, valueobject.MorningCallStatusScheduled),
+		}
+		result, err := repo.BulkCreate(ctx, calls, repository.BulkCreatePartial)
+		checkBulkCreateResult(t, result, err, 1, []int{0, 2}, nil, 0)
+	})
+}
+
+func TestRelationshipRepository_BulkCreate(t *testing.T) {
+	ctx := context.Background()
+	newRel := func(id, requester, receiver string) *entity.Relationship {
+		return &entity.Relationship{ID: id, RequesterID: requester, ReceiverID: receiver, Status: valueobject.RelationshipStatusAccepted}
+	}
+
+	t.Run("逆向きの同じペアはバッチ内の重複とする", func(t *testing.T) {
+		repo := NewRelationshipRepository()
+		rels := []*entity.Relationship{newRel("rel1", "user1", "user2"), newRel("rel2", "user2", "user1"), newRel("rel3", "user1", "user3")}
+		result, err := repo.BulkCreate(ctx, rels, repository.BulkCreatePartial)
+		checkBulkCreateResult(t, result, err, 2, []int{1}, nil, 0)
+
+		if friends, _ := repo.AreFriends(ctx, "user1", "user3"); !friends {
+			t.Error("AreFriends(user1, user3) = false, want true")
+		}
+	})
+
+	t.Run("重複があれば全体をロールバックする", func(t *testing.T) {
+		repo := NewRelationshipRepository()
+		if err := repo.Create(ctx, newRel("rel0", "user1", "user2")); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		rels := []*entity.Relationship{newRel("rel1", "user1", "user3"), newRel("rel2", "user2", "user1")}
+		result, err := repo.BulkCreate(ctx, rels, repository.BulkCreateAllOrNothing)
+		checkBulkCreateResult(t, result, err, 0, nil, repository.ErrAlreadyExists, 1)
+		if friends, _ := repo.AreFriends(ctx, "user1", "user3"); friends {
+			t.Error("AreFriends(user1, user3) = true, want false after rollback")
+		}
+	})
+}
+
+func TestFriendInviteRepository_BulkCreate(t *testing.T) {
+	ctx := context.Background()
+	expiresAt := time.Now().Add(time.Hour)
+	newInvite := func(token, inviterID string) *entity.FriendInvite {
+		return &entity.FriendInvite{Token: token, InviterID: inviterID, MaxUses: 1, ExpiresAt: expiresAt}
+	}
+
+	repo := NewFriendInviteRepository()
+	invites := []*entity.FriendInvite{newInvite("t1", "user1"), newInvite("t1", "user2"), newInvite("t2", ""), nil, newInvite("t3", "user1")}
+	result, err := repo.BulkCreate(ctx, invites, repository.BulkCreatePartial)
+	checkBulkCreateResult(t, result, err, 2, []int{1, 2, 3}, nil, 0)
+
+	for _, token := range []string{"t1", "t3"} {
+		if _, err := repo.FindByToken(ctx, token); err != nil {
+			t.Errorf("FindByToken(%s) error = %v", token, err)
+		}
+	}
+	if !errors.Is(result.Failed[1].Err, repository.ErrInvalidArgument) {
+		t.Errorf("Failed[1].Err = %v, want ErrInvalidArgument", result.Failed[1].Err)
+	}
+}
+
+// checkBulkCreateResult はBulkCreateの結果を検証する
+// wantErrがある場合は、位置wantIndexの *BulkCreateError（wantIndexが負の場合はwantErrそのもの）を期待する
+func checkBulkCreateResult(t *testing.T, result repository.BulkCreateResult, err error, wantCreated int, wantFailed []int, wantErr error, wantIndex int) {
+	t.Helper()
+	if wantErr != nil {
+		if !errors.Is(err, wantErr) {
+			t.Fatalf("BulkCreate() error = %v, want %v", err, wantErr)
+		}
+		var bulkErr *repository.BulkCreateError
+		if wantIndex >= 0 && (!errors.As(err, &bulkErr) || bulkErr.Index != wantIndex) {
+			t.Errorf("BulkCreate() error = %v, want BulkCreateError at index %d", err, wantIndex)
+		}
+		if result.Created != 0 {
+			t.Errorf("Created = %d, want 0 on error", result.Created)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatalf("BulkCreate() unexpected error = %v", err)
+	}
+	if result.Created != wantCreated {
+		t.Errorf("Created = %d, want %d", result.Created, wantCreated)
+	}
+	if len(result.Failed) != len(wantFailed) {
+		t.Fatalf("Failed = %v, want indexes %v", result.Failed, wantFailed)
+	}
+	for i, f := range result.Failed {
+		if f.Index != wantFailed[i] {
+			t.Errorf("Failed[%d].Index = %d, want %d", i, f.Index, wantFailed[i])
+		}
+	}
+}
+
+// 1件ずつのCreateと一括作成を比較する
+//
+//	go test ./internal/infrastructure/memory -run '^$' -bench Insert -benchmem
+func BenchmarkMorningCallRepository_Insert(b *testing.B) {
+	const n = 1000
+	scheduled := time.Now().Add(time.Hour)
+	calls := make([]*entity.MorningCall, n)
+	for i := range calls {
+		calls[i] = createTestMorningCall(fmt.Sprintf("mc%d", i), "user1", fmt.Sprintf("user%d", i%10+2), scheduled, valueobject.MorningCallStatusScheduled)
+	}
+
+	b.Run("Create", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			repo := NewMorningCallRepository()
+			for _, mc := range calls {
+				if err := repo.Create(context.Background(), mc); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	b.Run("BulkCreate", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			repo := NewMorningCallRepository()
+			if _, err := repo.BulkCreate(context.Background(), calls, repository.BulkCreateAllOrNothing); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkUserRepository_Insert(b *testing.B) {
+	const n = 1000
+	users := make([]*entity.User, n)
+	for i := range users {
+		users[i] = createTestUser(generateTestUserID(i), fmt.Sprintf("user%d", i), fmt.Sprintf("user%d@example.com", i))
+	}
+
+	b.Run("Create", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			repo := NewUserRepository()
+			for _, u := range users {
+				if err := repo.Create(context.Background(), u); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	b.Run("BulkCreate", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			repo := NewUserRepository()
+			if _, err := repo.BulkCreate(context.Background(), users, repository.BulkCreateAllOrNothing); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
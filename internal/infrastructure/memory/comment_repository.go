@@ -0,0 +1,99 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// CommentRepository はメモリ内でモーニングコールのコメントを管理するリポジトリ
+type CommentRepository struct {
+	comments map[string][]*entity.CallComment // callID -> 投稿日時の古い順のコメント
+	ids      map[string]bool                  // 保存済みのコメントID
+	mu       sync.RWMutex
+}
+
+// NewCommentRepository は新しいインメモリコメントリポジトリを作成する
+func NewCommentRepository() *CommentRepository {
+	return &CommentRepository{
+		comments: make(map[string][]*entity.CallComment),
+		ids:      make(map[string]bool),
+	}
+}
+
+// Create は新しいコメントを保存する
+func (r *CommentRepository) Create(ctx context.Context, comment *entity.CallComment) error {
+	_ = ctx // 将来的なDB実装のために保持
+	if comment == nil || comment.ID == "" || comment.CallID == "" {
+		return repository.ErrInvalidArgument
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ids[comment.ID] {
+		return repository.ErrAlreadyExists
+	}
+
+	// 投稿日時の順を保って挿入する（同じ日時のコメントは後に保存したものを後ろにする）
+	comments := r.comments[comment.CallID]
+	i := sort.Search(len(comments), func(i int) bool {
+		return comments[i].CreatedAt.After(comment.CreatedAt)
+	})
+	commentCopy := *comment
+	comments = append(comments, nil)
+	copy(comments[i+1:], comments[i:])
+	comments[i] = &commentCopy
+	r.comments[comment.CallID] = comments
+	r.ids[comment.ID] = true
+	return nil
+}
+
+// FindByCallID はコールのコメントを投稿日時の古い順に取得する
+// 同じ日時のコメントは保存した順に並べる
+func (r *CommentRepository) FindByCallID(ctx context.Context, callID string, offset, limit int) ([]*entity.CallComment, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if offset < 0 || limit < 0 {
+		return nil, repository.ErrInvalidArgument
+	}
+
+	comments := r.comments[callID]
+	result := []*entity.CallComment{}
+	for i := offset; i < len(comments) && len(result) < limit; i++ {
+		commentCopy := *comments[i]
+		result = append(result, &commentCopy)
+	}
+	return result, nil
+}
+
+// CountByCallID はコールのコメントの件数を取得する
+func (r *CommentRepository) CountByCallID(ctx context.Context, callID string) (int, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.comments[callID]), nil
+}
+
+// DeleteByCallID はコールのコメントをすべて削除し、削除した件数を返す
+func (r *CommentRepository) DeleteByCallID(ctx context.Context, callID string) (int, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	comments := r.comments[callID]
+	for _, comment := range comments {
+		delete(r.ids, comment.ID)
+	}
+	delete(r.comments, callID)
+	return len(comments), nil
+}
+
+// インターフェースの実装を保証
+var _ repository.CommentRepository = (*CommentRepository)(nil)
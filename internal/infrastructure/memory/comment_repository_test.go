@@ -0,0 +1,79 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+func TestCommentRepository(t *testing.T) {
+	ctx := context.Background()
+	base := time.Date(2026, 3, 10, 6, 0, 0, 0, time.UTC)
+	repo := NewCommentRepository()
+
+	for _, c := range []*entity.CallComment{
+		{ID: "c1", CallID: "mc1", AuthorID: "sender", Body: "おはよう", CreatedAt: base},
+		{ID: "c3", CallID: "mc1", AuthorID: "sender", Body: "よかった", CreatedAt: base.Add(2 * time.Minute)},
+		// 保存が前後しても投稿日時の順に並ぶ
+		{ID: "c2", CallID: "mc1", AuthorID: "receiver", Body: "起きた", CreatedAt: base.Add(time.Minute)},
+		{ID: "c4", CallID: "mc2", AuthorID: "sender", Body: "別のコール", CreatedAt: base},
+	} {
+		if err := repo.Create(ctx, c); err != nil {
+			t.Fatalf("Create() unexpected error = %v", err)
+		}
+	}
+
+	comments, err := repo.FindByCallID(ctx, "mc1", 0, 10)
+	if err != nil {
+		t.Fatalf("FindByCallID() unexpected error = %v", err)
+	}
+	if len(comments) != 3 || comments[0].ID != "c1" || comments[1].ID != "c2" || comments[2].ID != "c3" {
+		t.Errorf("FindByCallID() = %+v, want c1, c2, c3", comments)
+	}
+	comments, _ = repo.FindByCallID(ctx, "mc1", 1, 1)
+	if len(comments) != 1 || comments[0].ID != "c2" {
+		t.Errorf("FindByCallID(offset=1, limit=1) = %+v, want [c2]", comments)
+	}
+	if n, _ := repo.CountByCallID(ctx, "mc1"); n != 3 {
+		t.Errorf("CountByCallID() = %d, want 3", n)
+	}
+
+	// 取得結果を変更しても保持しているコメントに影響しない
+	comments, _ = repo.FindByCallID(ctx, "mc2", 0, 1)
+	comments[0].Body = "modified"
+	comments, _ = repo.FindByCallID(ctx, "mc2", 0, 1)
+	if comments[0].Body != "別のコール" {
+		t.Errorf("stored comment was modified: %+v", comments[0])
+	}
+
+	if err := repo.Create(ctx, &entity.CallComment{ID: "c1", CallID: "mc2", CreatedAt: base}); !errors.Is(err, repository.ErrAlreadyExists) {
+		t.Errorf("Create(duplicate ID) error = %v, want ErrAlreadyExists", err)
+	}
+	if err := repo.Create(ctx, &entity.CallComment{ID: "c5"}); !errors.Is(err, repository.ErrInvalidArgument) {
+		t.Errorf("Create(without call ID) error = %v, want ErrInvalidArgument", err)
+	}
+	if _, err := repo.FindByCallID(ctx, "mc1", -1, 10); !errors.Is(err, repository.ErrInvalidArgument) {
+		t.Errorf("FindByCallID(offset=-1) error = %v, want ErrInvalidArgument", err)
+	}
+
+	// コールのコメントだけがすべて削除され、削除したIDは再利用できる
+	if n, err := repo.DeleteByCallID(ctx, "mc1"); err != nil || n != 3 {
+		t.Errorf("DeleteByCallID() = %d, %v, want 3, nil", n, err)
+	}
+	if n, _ := repo.CountByCallID(ctx, "mc1"); n != 0 {
+		t.Errorf("CountByCallID() after delete = %d, want 0", n)
+	}
+	if n, _ := repo.CountByCallID(ctx, "mc2"); n != 1 {
+		t.Errorf("CountByCallID(mc2) after delete = %d, want 1", n)
+	}
+	if n, err := repo.DeleteByCallID(ctx, "mc1"); err != nil || n != 0 {
+		t.Errorf("DeleteByCallID(no comments) = %d, %v, want 0, nil", n, err)
+	}
+	if err := repo.Create(ctx, &entity.CallComment{ID: "c1", CallID: "mc1", CreatedAt: base}); err != nil {
+		t.Errorf("Create(deleted ID) unexpected error = %v", err)
+	}
+}
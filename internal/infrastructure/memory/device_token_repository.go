@@ -0,0 +1,163 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// DeviceTokenRepository はメモリ内で端末のデバイストークンを管理するリポジトリ
+type DeviceTokenRepository struct {
+	devices    map[string]*entity.DeviceToken // ID -> デバイストークン
+	tokenIndex map[string]string              // トークン -> ID
+	mu         sync.RWMutex
+}
+
+// NewDeviceTokenRepository は新しいインメモリデバイストークンリポジトリを作成する
+func NewDeviceTokenRepository() *DeviceTokenRepository {
+	return &DeviceTokenRepository{
+		devices:    make(map[string]*entity.DeviceToken),
+		tokenIndex: make(map[string]string),
+	}
+}
+
+// Create は新しいデバイストークンを保存する
+func (r *DeviceTokenRepository) Create(ctx context.Context, device *entity.DeviceToken) error {
+	_ = ctx // 将来的なDB実装のために保持
+	if device == nil || device.ID == "" || device.UserID == "" || device.Token == "" {
+		return repository.ErrInvalidArgument
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.devices[device.ID]; exists {
+		return repository.ErrAlreadyExists
+	}
+	if _, exists := r.tokenIndex[device.Token]; exists {
+		return repository.ErrAlreadyExists
+	}
+
+	r.devices[device.ID] = cloneDeviceToken(device)
+	r.tokenIndex[device.Token] = device.ID
+	return nil
+}
+
+// FindByID はIDでデバイストークンを検索する
+func (r *DeviceTokenRepository) FindByID(ctx context.Context, id string) (*entity.DeviceToken, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	device, exists := r.devices[id]
+	if !exists {
+		return nil, repository.ErrNotFound
+	}
+	return cloneDeviceToken(device), nil
+}
+
+// FindByToken はトークンの値でデバイストークンを検索する
+func (r *DeviceTokenRepository) FindByToken(ctx context.Context, token string) (*entity.DeviceToken, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	id, exists := r.tokenIndex[token]
+	if !exists {
+		return nil, repository.ErrNotFound
+	}
+	return cloneDeviceToken(r.devices[id]), nil
+}
+
+// FindByUserID はユーザーの端末を登録日時の昇順で返す
+func (r *DeviceTokenRepository) FindByUserID(ctx context.Context, userID string) ([]*entity.DeviceToken, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*entity.DeviceToken
+	for _, device := range r.devices {
+		if device.UserID == userID {
+			result = append(result, cloneDeviceToken(device))
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].CreatedAt.Equal(result[j].CreatedAt) {
+			return result[i].CreatedAt.Before(result[j].CreatedAt)
+		}
+		return result[i].ID < result[j].ID
+	})
+	return result, nil
+}
+
+// Update はデバイストークンを更新する
+// トークンの値やユーザーは変更できない（別の端末として登録し直す）
+func (r *DeviceTokenRepository) Update(ctx context.Context, device *entity.DeviceToken) error {
+	_ = ctx // 将来的なDB実装のために保持
+	if device == nil {
+		return repository.ErrInvalidArgument
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, exists := r.devices[device.ID]
+	if !exists {
+		return repository.ErrNotFound
+	}
+	if existing.Token != device.Token || existing.UserID != device.UserID {
+		return repository.ErrInvalidArgument
+	}
+
+	r.devices[device.ID] = cloneDeviceToken(device)
+	return nil
+}
+
+// Delete はデバイストークンを削除する
+func (r *DeviceTokenRepository) Delete(ctx context.Context, id string) error {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	device, exists := r.devices[id]
+	if !exists {
+		return repository.ErrNotFound
+	}
+	delete(r.tokenIndex, device.Token)
+	delete(r.devices, id)
+	return nil
+}
+
+// DeleteInvalidated は無効化されたデバイストークンをすべて削除し、削除した件数を返す
+func (r *DeviceTokenRepository) DeleteInvalidated(ctx context.Context) (int, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deleted := 0
+	for id, device := range r.devices {
+		if device.IsInvalidated() {
+			delete(r.tokenIndex, device.Token)
+			delete(r.devices, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// cloneDeviceToken はデバイストークンのコピーを作成する
+func cloneDeviceToken(device *entity.DeviceToken) *entity.DeviceToken {
+	deviceCopy := *device
+	if device.InvalidatedAt != nil {
+		invalidatedAt := *device.InvalidatedAt
+		deviceCopy.InvalidatedAt = &invalidatedAt
+	}
+	return &deviceCopy
+}
+
+// インターフェースの実装を保証
+var _ repository.DeviceTokenRepository = (*DeviceTokenRepository)(nil)
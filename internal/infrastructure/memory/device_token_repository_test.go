@@ -0,0 +1,115 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+func TestDeviceTokenRepository(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	newDevice := func(id, userID, token string, createdAt time.Time) *entity.DeviceToken {
+		return &entity.DeviceToken{ID: id, UserID: userID, Token: token, Platform: valueobject.DevicePlatformIOS, CreatedAt: createdAt}
+	}
+
+	t.Run("保存した端末をID・トークン・ユーザーで取得できる", func(t *testing.T) {
+		repo := NewDeviceTokenRepository()
+		if err := repo.Create(ctx, newDevice("d1", "user1", "token1", now)); err != nil {
+			t.Fatalf("Create() unexpected error = %v", err)
+		}
+		if err := repo.Create(ctx, newDevice("d2", "user2", "token1", now)); !errors.Is(err, repository.ErrAlreadyExists) {
+			t.Errorf("Create() duplicate token error = %v, want ErrAlreadyExists", err)
+		}
+		if err := repo.Create(ctx, newDevice("d3", "user1", "", now)); !errors.Is(err, repository.ErrInvalidArgument) {
+			t.Errorf("Create() without token error = %v, want ErrInvalidArgument", err)
+		}
+
+		if found, err := repo.FindByID(ctx, "d1"); err != nil || found.Token != "token1" {
+			t.Errorf("FindByID() = %+v, %v", found, err)
+		}
+		if found, err := repo.FindByToken(ctx, "token1"); err != nil || found.ID != "d1" {
+			t.Errorf("FindByToken() = %+v, %v", found, err)
+		}
+		if _, err := repo.FindByToken(ctx, "unknown"); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("FindByToken() error = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("ユーザーの複数の端末を登録日時の昇順で返す", func(t *testing.T) {
+		repo := NewDeviceTokenRepository()
+		for _, d := range []*entity.DeviceToken{
+			newDevice("d2", "user1", "token2", now.Add(time.Minute)),
+			newDevice("d1", "user1", "token1", now),
+			newDevice("d3", "user2", "token3", now),
+		} {
+			if err := repo.Create(ctx, d); err != nil {
+				t.Fatalf("Create() unexpected error = %v", err)
+			}
+		}
+
+		devices, err := repo.FindByUserID(ctx, "user1")
+		if err != nil {
+			t.Fatalf("FindByUserID() unexpected error = %v", err)
+		}
+		if len(devices) != 2 || devices[0].ID != "d1" || devices[1].ID != "d2" {
+			t.Errorf("FindByUserID() = %+v, want [d1 d2]", devices)
+		}
+	})
+
+	t.Run("無効化した端末だけをまとめて削除する", func(t *testing.T) {
+		repo := NewDeviceTokenRepository()
+		for _, d := range []*entity.DeviceToken{
+			newDevice("d1", "user1", "token1", now),
+			newDevice("d2", "user1", "token2", now),
+		} {
+			if err := repo.Create(ctx, d); err != nil {
+				t.Fatalf("Create() unexpected error = %v", err)
+			}
+		}
+
+		device, _ := repo.FindByID(ctx, "d1")
+		device.Invalidate(now)
+		if err := repo.Update(ctx, device); err != nil {
+			t.Fatalf("Update() unexpected error = %v", err)
+		}
+
+		deleted, err := repo.DeleteInvalidated(ctx)
+		if err != nil || deleted != 1 {
+			t.Fatalf("DeleteInvalidated() = %d, %v, want 1", deleted, err)
+		}
+		if _, err := repo.FindByToken(ctx, "token1"); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("invalidated token still indexed: %v", err)
+		}
+		if _, err := repo.FindByID(ctx, "d2"); err != nil {
+			t.Errorf("valid device was deleted: %v", err)
+		}
+
+		// 削除したトークンは再登録できる
+		if err := repo.Create(ctx, newDevice("d3", "user1", "token1", now)); err != nil {
+			t.Errorf("Create() after cleanup error = %v", err)
+		}
+	})
+
+	t.Run("トークンの値は更新できない", func(t *testing.T) {
+		repo := NewDeviceTokenRepository()
+		if err := repo.Create(ctx, newDevice("d1", "user1", "token1", now)); err != nil {
+			t.Fatalf("Create() unexpected error = %v", err)
+		}
+		if err := repo.Update(ctx, newDevice("d1", "user1", "other", now)); !errors.Is(err, repository.ErrInvalidArgument) {
+			t.Errorf("Update() error = %v, want ErrInvalidArgument", err)
+		}
+		if err := repo.Delete(ctx, "d1"); err != nil {
+			t.Fatalf("Delete() unexpected error = %v", err)
+		}
+		if err := repo.Delete(ctx, "d1"); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("Delete() error = %v, want ErrNotFound", err)
+		}
+	})
+}
@@ -0,0 +1,133 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// FriendInviteRepository はメモリ内で友達追加用の招待トークンを管理するリポジトリ
+type FriendInviteRepository struct {
+	invites map[string]*entity.FriendInvite // token -> 招待
+	mu      sync.RWMutex
+	now     func() time.Time
+}
+
+// NewFriendInviteRepository は新しいインメモリ招待トークンリポジトリを作成する
+func NewFriendInviteRepository() *FriendInviteRepository {
+	return &FriendInviteRepository{
+		invites: make(map[string]*entity.FriendInvite),
+		now:     time.Now,
+	}
+}
+
+// Create は新しい招待トークンを保存する
+// 保存のたびに有効期限切れの招待を破棄し、使われなくなったトークンが溜まり続けないようにする
+func (r *FriendInviteRepository) Create(ctx context.Context, invite *entity.FriendInvite) error {
+	_ = ctx // 将来的なDB実装のために保持
+	if invite == nil || invite.Token == "" || invite.InviterID == "" {
+		return repository.ErrInvalidArgument
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.invites[invite.Token]; exists {
+		return repository.ErrAlreadyExists
+	}
+
+	now := r.now()
+	for token, existing := range r.invites {
+		if existing.IsExpired(now) {
+			delete(r.invites, token)
+		}
+	}
+
+	inviteCopy := *invite
+	r.invites[inviteCopy.Token] = &inviteCopy
+	return nil
+}
+
+// BulkCreate は複数の招待トークンをロックを1回だけ取得してまとめて保存する
+// トークンの重複は保存済みの招待に加え、同じバッチ内でも検証する
+func (r *FriendInviteRepository) BulkCreate(ctx context.Context, invites []*entity.FriendInvite, mode repository.BulkCreateMode) (repository.BulkCreateResult, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	for token, existing := range r.invites {
+		if existing.IsExpired(now) {
+			delete(r.invites, token)
+		}
+	}
+
+	tokens := make(map[string]struct{}, len(invites))
+	check := func(invite *entity.FriendInvite) error {
+		if invite.Token == "" || invite.InviterID == "" {
+			return repository.ErrInvalidArgument
+		}
+		_, dup := tokens[invite.Token]
+		_, exists := r.invites[invite.Token]
+		if dup || exists {
+			return repository.ErrAlreadyExists
+		}
+		tokens[invite.Token] = struct{}{}
+		return nil
+	}
+	insert := func(invite *entity.FriendInvite) {
+		inviteCopy := *invite
+		r.invites[inviteCopy.Token] = &inviteCopy
+	}
+
+	return bulkCreate(invites, mode, check, insert)
+}
+
+// FindByToken はトークンで招待を検索する
+func (r *FriendInviteRepository) FindByToken(ctx context.Context, token string) (*entity.FriendInvite, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	invite, exists := r.invites[token]
+	if !exists {
+		return nil, repository.ErrNotFound
+	}
+
+	inviteCopy := *invite
+	return &inviteCopy, nil
+}
+
+// Update は招待を更新する
+func (r *FriendInviteRepository) Update(ctx context.Context, invite *entity.FriendInvite) error {
+	_ = ctx // 将来的なDB実装のために保持
+	if invite == nil {
+		return repository.ErrInvalidArgument
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, exists := r.invites[invite.Token]
+	if !exists {
+		return repository.ErrNotFound
+	}
+
+	// 楽観ロック: 取得後に他の更新が行われていれば競合とする
+	if existing.Version != invite.Version {
+		return repository.ErrUpdateConflict
+	}
+
+	inviteCopy := *invite
+	inviteCopy.Version = existing.Version + 1
+	r.invites[inviteCopy.Token] = &inviteCopy
+	// 呼び出し側が続けて更新できるようにバージョンを反映する
+	invite.Version = inviteCopy.Version
+	return nil
+}
+
+// インターフェースの実装を保証
+var _ repository.FriendInviteRepository = (*FriendInviteRepository)(nil)
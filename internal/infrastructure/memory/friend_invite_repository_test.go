@@ -0,0 +1,99 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+func TestFriendInviteRepository(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	newRepo := func() *FriendInviteRepository {
+		repo := NewFriendInviteRepository()
+		repo.now = func() time.Time { return now }
+		return repo
+	}
+
+	t.Run("保存したトークンを取得できる", func(t *testing.T) {
+		repo := newRepo()
+		invite := &entity.FriendInvite{Token: "token1", InviterID: "user1", MaxUses: 1, ExpiresAt: now.Add(time.Hour)}
+		if err := repo.Create(ctx, invite); err != nil {
+			t.Fatalf("Create() unexpected error = %v", err)
+		}
+		if err := repo.Create(ctx, invite); !errors.Is(err, repository.ErrAlreadyExists) {
+			t.Errorf("Create() duplicate error = %v, want ErrAlreadyExists", err)
+		}
+		if err := repo.Create(ctx, &entity.FriendInvite{InviterID: "user1"}); !errors.Is(err, repository.ErrInvalidArgument) {
+			t.Errorf("Create() without token error = %v, want ErrInvalidArgument", err)
+		}
+
+		found, err := repo.FindByToken(ctx, "token1")
+		if err != nil {
+			t.Fatalf("FindByToken() unexpected error = %v", err)
+		}
+		if found.InviterID != "user1" {
+			t.Errorf("InviterID = %s, want user1", found.InviterID)
+		}
+
+		// 取得結果を変更しても保持している招待に影響しない
+		found.UseCount = 1
+		found, _ = repo.FindByToken(ctx, "token1")
+		if found.UseCount != 0 {
+			t.Errorf("stored invite was modified: %+v", found)
+		}
+
+		if _, err := repo.FindByToken(ctx, "unknown"); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("FindByToken() error = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("同じバージョンからの2回目の更新は競合になる", func(t *testing.T) {
+		repo := newRepo()
+		if err := repo.Create(ctx, &entity.FriendInvite{Token: "token1", InviterID: "user1", MaxUses: 1, ExpiresAt: now.Add(time.Hour)}); err != nil {
+			t.Fatalf("Create() unexpected error = %v", err)
+		}
+
+		first, _ := repo.FindByToken(ctx, "token1")
+		second, _ := repo.FindByToken(ctx, "token1")
+		first.UseCount++
+		second.UseCount++
+
+		if err := repo.Update(ctx, first); err != nil {
+			t.Fatalf("Update() unexpected error = %v", err)
+		}
+		if first.Version != 1 {
+			t.Errorf("Version = %d, want 1", first.Version)
+		}
+		if err := repo.Update(ctx, second); !errors.Is(err, repository.ErrUpdateConflict) {
+			t.Errorf("Update() error = %v, want ErrUpdateConflict", err)
+		}
+
+		stored, _ := repo.FindByToken(ctx, "token1")
+		if stored.UseCount != 1 {
+			t.Errorf("UseCount = %d, want 1", stored.UseCount)
+		}
+	})
+
+	t.Run("保存時に有効期限切れの招待を破棄する", func(t *testing.T) {
+		repo := newRepo()
+		if err := repo.Create(ctx, &entity.FriendInvite{Token: "expired", InviterID: "user1", MaxUses: 1, ExpiresAt: now}); err != nil {
+			t.Fatalf("Create() unexpected error = %v", err)
+		}
+		if err := repo.Create(ctx, &entity.FriendInvite{Token: "active", InviterID: "user1", MaxUses: 1, ExpiresAt: now.Add(time.Hour)}); err != nil {
+			t.Fatalf("Create() unexpected error = %v", err)
+		}
+
+		if _, err := repo.FindByToken(ctx, "expired"); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("FindByToken(expired) error = %v, want ErrNotFound", err)
+		}
+		if _, err := repo.FindByToken(ctx, "active"); err != nil {
+			t.Errorf("FindByToken(active) unexpected error = %v", err)
+		}
+	})
+}
@@ -0,0 +1,54 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// CommentRepository は計測付きのコメントリポジトリ
+type CommentRepository struct {
+	inner    repository.CommentRepository
+	recorder *Recorder
+}
+
+// NewCommentRepository はコメントリポジトリをラップして計測付きにする
+func NewCommentRepository(inner repository.CommentRepository, recorder *Recorder) *CommentRepository {
+	return &CommentRepository{inner: inner, recorder: recorder}
+}
+
+var _ repository.CommentRepository = (*CommentRepository)(nil)
+
+// Create は新しいコメントを保存する
+func (r *CommentRepository) Create(ctx context.Context, comment *entity.CallComment) error {
+	begin := time.Now()
+	err := r.inner.Create(ctx, comment)
+	r.recorder.observe("CommentRepository.Create", begin, err)
+	return err
+}
+
+// FindByCallID はコールのコメントを投稿日時の古い順に取得する
+func (r *CommentRepository) FindByCallID(ctx context.Context, callID string, offset, limit int) ([]*entity.CallComment, error) {
+	begin := time.Now()
+	results, err := r.inner.FindByCallID(ctx, callID, offset, limit)
+	r.recorder.observe("CommentRepository.FindByCallID", begin, err)
+	return results, err
+}
+
+// CountByCallID はコールのコメントの件数を取得する
+func (r *CommentRepository) CountByCallID(ctx context.Context, callID string) (int, error) {
+	begin := time.Now()
+	n, err := r.inner.CountByCallID(ctx, callID)
+	r.recorder.observe("CommentRepository.CountByCallID", begin, err)
+	return n, err
+}
+
+// DeleteByCallID はコールのコメントをすべて削除する
+func (r *CommentRepository) DeleteByCallID(ctx context.Context, callID string) (int, error) {
+	begin := time.Now()
+	deleted, err := r.inner.DeleteByCallID(ctx, callID)
+	r.recorder.observe("CommentRepository.DeleteByCallID", begin, err)
+	return deleted, err
+}
@@ -0,0 +1,78 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// DeviceTokenRepository は計測付きのデバイストークンリポジトリ
+type DeviceTokenRepository struct {
+	inner    repository.DeviceTokenRepository
+	recorder *Recorder
+}
+
+// NewDeviceTokenRepository はデバイストークンリポジトリをラップして計測付きにする
+func NewDeviceTokenRepository(inner repository.DeviceTokenRepository, recorder *Recorder) *DeviceTokenRepository {
+	return &DeviceTokenRepository{inner: inner, recorder: recorder}
+}
+
+var _ repository.DeviceTokenRepository = (*DeviceTokenRepository)(nil)
+
+// Create は新しいデバイストークンを保存する
+func (r *DeviceTokenRepository) Create(ctx context.Context, device *entity.DeviceToken) error {
+	begin := time.Now()
+	err := r.inner.Create(ctx, device)
+	r.recorder.observe("DeviceTokenRepository.Create", begin, err)
+	return err
+}
+
+// FindByID はIDでデバイストークンを検索する
+func (r *DeviceTokenRepository) FindByID(ctx context.Context, id string) (*entity.DeviceToken, error) {
+	begin := time.Now()
+	device, err := r.inner.FindByID(ctx, id)
+	r.recorder.observe("DeviceTokenRepository.FindByID", begin, err)
+	return device, err
+}
+
+// FindByToken はトークンの値でデバイストークンを検索する
+func (r *DeviceTokenRepository) FindByToken(ctx context.Context, token string) (*entity.DeviceToken, error) {
+	begin := time.Now()
+	device, err := r.inner.FindByToken(ctx, token)
+	r.recorder.observe("DeviceTokenRepository.FindByToken", begin, err)
+	return device, err
+}
+
+// FindByUserID はユーザーの端末を登録日時の昇順で返す
+func (r *DeviceTokenRepository) FindByUserID(ctx context.Context, userID string) ([]*entity.DeviceToken, error) {
+	begin := time.Now()
+	devices, err := r.inner.FindByUserID(ctx, userID)
+	r.recorder.observe("DeviceTokenRepository.FindByUserID", begin, err)
+	return devices, err
+}
+
+// Update はデバイストークンを更新する
+func (r *DeviceTokenRepository) Update(ctx context.Context, device *entity.DeviceToken) error {
+	begin := time.Now()
+	err := r.inner.Update(ctx, device)
+	r.recorder.observe("DeviceTokenRepository.Update", begin, err)
+	return err
+}
+
+// Delete はデバイストークンを削除する
+func (r *DeviceTokenRepository) Delete(ctx context.Context, id string) error {
+	begin := time.Now()
+	err := r.inner.Delete(ctx, id)
+	r.recorder.observe("DeviceTokenRepository.Delete", begin, err)
+	return err
+}
+
+// DeleteInvalidated は無効化されたデバイストークンをすべて削除する
+func (r *DeviceTokenRepository) DeleteInvalidated(ctx context.Context) (int, error) {
+	begin := time.Now()
+	deleted, err := r.inner.DeleteInvalidated(ctx)
+	r.recorder.observe("DeviceTokenRepository.DeleteInvalidated", begin, err)
+	return deleted, err
+}
@@ -0,0 +1,54 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// FriendInviteRepository は計測付きの友達招待トークンリポジトリ
+type FriendInviteRepository struct {
+	inner    repository.FriendInviteRepository
+	recorder *Recorder
+}
+
+// NewFriendInviteRepository は友達招待トークンリポジトリをラップして計測付きにする
+func NewFriendInviteRepository(inner repository.FriendInviteRepository, recorder *Recorder) *FriendInviteRepository {
+	return &FriendInviteRepository{inner: inner, recorder: recorder}
+}
+
+var _ repository.FriendInviteRepository = (*FriendInviteRepository)(nil)
+
+// Create は新しい招待トークンを保存する
+func (r *FriendInviteRepository) Create(ctx context.Context, invite *entity.FriendInvite) error {
+	begin := time.Now()
+	err := r.inner.Create(ctx, invite)
+	r.recorder.observe("FriendInviteRepository.Create", begin, err)
+	return err
+}
+
+// BulkCreate は複数の招待トークンをまとめて作成する（テストやデモ用の初期データ投入向け）
+func (r *FriendInviteRepository) BulkCreate(ctx context.Context, invites []*entity.FriendInvite, mode repository.BulkCreateMode) (repository.BulkCreateResult, error) {
+	begin := time.Now()
+	result, err := r.inner.BulkCreate(ctx, invites, mode)
+	r.recorder.observe("FriendInviteRepository.BulkCreate", begin, err)
+	return result, err
+}
+
+// FindByToken はトークンで招待を検索する
+func (r *FriendInviteRepository) FindByToken(ctx context.Context, token string) (*entity.FriendInvite, error) {
+	begin := time.Now()
+	invite, err := r.inner.FindByToken(ctx, token)
+	r.recorder.observe("FriendInviteRepository.FindByToken", begin, err)
+	return invite, err
+}
+
+// Update は招待を更新する
+func (r *FriendInviteRepository) Update(ctx context.Context, invite *entity.FriendInvite) error {
+	begin := time.Now()
+	err := r.inner.Update(ctx, invite)
+	r.recorder.observe("FriendInviteRepository.Update", begin, err)
+	return err
+}
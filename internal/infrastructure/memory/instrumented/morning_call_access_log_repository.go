@@ -0,0 +1,46 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// MorningCallAccessLogRepository は計測付きのアクセスログリポジトリ
+type MorningCallAccessLogRepository struct {
+	inner    repository.MorningCallAccessLogRepository
+	recorder *Recorder
+}
+
+// NewMorningCallAccessLogRepository はアクセスログリポジトリをラップして計測付きにする
+func NewMorningCallAccessLogRepository(inner repository.MorningCallAccessLogRepository, recorder *Recorder) *MorningCallAccessLogRepository {
+	return &MorningCallAccessLogRepository{inner: inner, recorder: recorder}
+}
+
+var _ repository.MorningCallAccessLogRepository = (*MorningCallAccessLogRepository)(nil)
+
+// Record は閲覧記録を1件追加する
+func (r *MorningCallAccessLogRepository) Record(ctx context.Context, access *entity.MorningCallAccess) error {
+	begin := time.Now()
+	err := r.inner.Record(ctx, access)
+	r.recorder.observe("MorningCallAccessLogRepository.Record", begin, err)
+	return err
+}
+
+// FindByMorningCallID はコールの閲覧記録を新しい順に取得する
+func (r *MorningCallAccessLogRepository) FindByMorningCallID(ctx context.Context, morningCallID string, offset, limit int) ([]*entity.MorningCallAccess, error) {
+	begin := time.Now()
+	results, err := r.inner.FindByMorningCallID(ctx, morningCallID, offset, limit)
+	r.recorder.observe("MorningCallAccessLogRepository.FindByMorningCallID", begin, err)
+	return results, err
+}
+
+// CountByMorningCallID はコールの閲覧記録の件数を取得する
+func (r *MorningCallAccessLogRepository) CountByMorningCallID(ctx context.Context, morningCallID string) (int, error) {
+	begin := time.Now()
+	n, err := r.inner.CountByMorningCallID(ctx, morningCallID)
+	r.recorder.observe("MorningCallAccessLogRepository.CountByMorningCallID", begin, err)
+	return n, err
+}
@@ -0,0 +1,255 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// MorningCallRepository は計測付きのモーニングコールリポジトリ
+type MorningCallRepository struct {
+	inner    repository.MorningCallRepository
+	recorder *Recorder
+}
+
+// NewMorningCallRepository はモーニングコールリポジトリをラップして計測付きにする
+func NewMorningCallRepository(inner repository.MorningCallRepository, recorder *Recorder) *MorningCallRepository {
+	return &MorningCallRepository{inner: inner, recorder: recorder}
+}
+
+var _ repository.MorningCallRepository = (*MorningCallRepository)(nil)
+
+// Create は新しいモーニングコールを作成する
+func (r *MorningCallRepository) Create(ctx context.Context, morningCall *entity.MorningCall) error {
+	begin := time.Now()
+	err := r.inner.Create(ctx, morningCall)
+	r.recorder.observe("MorningCallRepository.Create", begin, err)
+	return err
+}
+
+// BulkCreate は複数のモーニングコールをまとめて作成する（テストやデモ用の初期データ投入向け）
+func (r *MorningCallRepository) BulkCreate(ctx context.Context, morningCalls []*entity.MorningCall, mode repository.BulkCreateMode) (repository.BulkCreateResult, error) {
+	begin := time.Now()
+	result, err := r.inner.BulkCreate(ctx, morningCalls, mode)
+	r.recorder.observe("MorningCallRepository.BulkCreate", begin, err)
+	return result, err
+}
+
+// FindByID はIDでモーニングコールを検索する
+func (r *MorningCallRepository) FindByID(ctx context.Context, id string) (*entity.MorningCall, error) {
+	begin := time.Now()
+	morningCall, err := r.inner.FindByID(ctx, id)
+	r.recorder.observe("MorningCallRepository.FindByID", begin, err)
+	return morningCall, err
+}
+
+// Update はモーニングコール情報を更新する
+func (r *MorningCallRepository) Update(ctx context.Context, morningCall *entity.MorningCall) error {
+	begin := time.Now()
+	err := r.inner.Update(ctx, morningCall)
+	r.recorder.observe("MorningCallRepository.Update", begin, err)
+	return err
+}
+
+// Delete はモーニングコールを削除する
+func (r *MorningCallRepository) Delete(ctx context.Context, id string) error {
+	begin := time.Now()
+	err := r.inner.Delete(ctx, id)
+	r.recorder.observe("MorningCallRepository.Delete", begin, err)
+	return err
+}
+
+// ExistsByID はIDでモーニングコールの存在を確認する
+func (r *MorningCallRepository) ExistsByID(ctx context.Context, id string) (bool, error) {
+	begin := time.Now()
+	ok, err := r.inner.ExistsByID(ctx, id)
+	r.recorder.observe("MorningCallRepository.ExistsByID", begin, err)
+	return ok, err
+}
+
+// FindBySenderID は送信者IDでモーニングコールを検索する
+func (r *MorningCallRepository) FindBySenderID(ctx context.Context, senderID string, offset, limit int) ([]*entity.MorningCall, error) {
+	begin := time.Now()
+	results, err := r.inner.FindBySenderID(ctx, senderID, offset, limit)
+	r.recorder.observe("MorningCallRepository.FindBySenderID", begin, err)
+	return results, err
+}
+
+// FindByReceiverID は受信者IDでモーニングコールを検索する
+func (r *MorningCallRepository) FindByReceiverID(ctx context.Context, receiverID string, offset, limit int) ([]*entity.MorningCall, error) {
+	begin := time.Now()
+	results, err := r.inner.FindByReceiverID(ctx, receiverID, offset, limit)
+	r.recorder.observe("MorningCallRepository.FindByReceiverID", begin, err)
+	return results, err
+}
+
+// FindByReceiverIDAndArchived は受信者IDとアーカイブ状態でモーニングコールを検索する
+func (r *MorningCallRepository) FindByReceiverIDAndArchived(ctx context.Context, receiverID string, archived bool, offset, limit int) ([]*entity.MorningCall, error) {
+	begin := time.Now()
+	results, err := r.inner.FindByReceiverIDAndArchived(ctx, receiverID, archived, offset, limit)
+	r.recorder.observe("MorningCallRepository.FindByReceiverIDAndArchived", begin, err)
+	return results, err
+}
+
+// FindReadOnlyBySenderID は送信者IDでモーニングコールを検索し、読み取り専用ビューで返す
+func (r *MorningCallRepository) FindReadOnlyBySenderID(ctx context.Context, senderID string, offset, limit int) ([]entity.ReadOnlyMorningCall, error) {
+	begin := time.Now()
+	results, err := r.inner.FindReadOnlyBySenderID(ctx, senderID, offset, limit)
+	r.recorder.observe("MorningCallRepository.FindReadOnlyBySenderID", begin, err)
+	return results, err
+}
+
+// FindReadOnlyByReceiverID は受信者IDでモーニングコールを検索し、読み取り専用ビューで返す
+func (r *MorningCallRepository) FindReadOnlyByReceiverID(ctx context.Context, receiverID string, offset, limit int) ([]entity.ReadOnlyMorningCall, error) {
+	begin := time.Now()
+	results, err := r.inner.FindReadOnlyByReceiverID(ctx, receiverID, offset, limit)
+	r.recorder.observe("MorningCallRepository.FindReadOnlyByReceiverID", begin, err)
+	return results, err
+}
+
+// FindByStatus はステータスでモーニングコールを検索する
+func (r *MorningCallRepository) FindByStatus(ctx context.Context, status valueobject.MorningCallStatus, offset, limit int) ([]*entity.MorningCall, error) {
+	begin := time.Now()
+	results, err := r.inner.FindByStatus(ctx, status, offset, limit)
+	r.recorder.observe("MorningCallRepository.FindByStatus", begin, err)
+	return results, err
+}
+
+// FindByStatusInRange はステータスと予定時刻の範囲（start以上end以下）でモーニングコールを検索する
+func (r *MorningCallRepository) FindByStatusInRange(ctx context.Context, status valueobject.MorningCallStatus, start, end time.Time, offset, limit int) ([]*entity.MorningCall, error) {
+	begin := time.Now()
+	results, err := r.inner.FindByStatusInRange(ctx, status, start, end, offset, limit)
+	r.recorder.observe("MorningCallRepository.FindByStatusInRange", begin, err)
+	return results, err
+}
+
+// FindScheduledBefore は指定時刻より前にスケジュールされたモーニングコールを検索する
+func (r *MorningCallRepository) FindScheduledBefore(ctx context.Context, t time.Time, offset, limit int) ([]*entity.MorningCall, error) {
+	begin := time.Now()
+	results, err := r.inner.FindScheduledBefore(ctx, t, offset, limit)
+	r.recorder.observe("MorningCallRepository.FindScheduledBefore", begin, err)
+	return results, err
+}
+
+// FindScheduledBetween は指定期間内にスケジュールされたモーニングコールを検索する
+func (r *MorningCallRepository) FindScheduledBetween(ctx context.Context, start, end time.Time, offset, limit int) ([]*entity.MorningCall, error) {
+	begin := time.Now()
+	results, err := r.inner.FindScheduledBetween(ctx, start, end, offset, limit)
+	r.recorder.observe("MorningCallRepository.FindScheduledBetween", begin, err)
+	return results, err
+}
+
+// FindActiveByUserPair は特定のユーザーペア間のアクティブなモーニングコールを検索する
+func (r *MorningCallRepository) FindActiveByUserPair(ctx context.Context, senderID, receiverID string) ([]*entity.MorningCall, error) {
+	begin := time.Now()
+	results, err := r.inner.FindActiveByUserPair(ctx, senderID, receiverID)
+	r.recorder.observe("MorningCallRepository.FindActiveByUserPair", begin, err)
+	return results, err
+}
+
+// FindBySeriesID はシリーズIDでモーニングコールを検索する（アラーム時刻の昇順）
+func (r *MorningCallRepository) FindBySeriesID(ctx context.Context, seriesID string) ([]*entity.MorningCall, error) {
+	begin := time.Now()
+	results, err := r.inner.FindBySeriesID(ctx, seriesID)
+	r.recorder.observe("MorningCallRepository.FindBySeriesID", begin, err)
+	return results, err
+}
+
+// FindActiveBetweenUsers は2人のユーザー間のアクティブなモーニングコールを送信方向を問わず検索する
+func (r *MorningCallRepository) FindActiveBetweenUsers(ctx context.Context, userID1, userID2 string) ([]*entity.MorningCall, error) {
+	begin := time.Now()
+	results, err := r.inner.FindActiveBetweenUsers(ctx, userID1, userID2)
+	r.recorder.observe("MorningCallRepository.FindActiveBetweenUsers", begin, err)
+	return results, err
+}
+
+// CountBySenderID は送信者IDでモーニングコール数を取得する
+func (r *MorningCallRepository) CountBySenderID(ctx context.Context, senderID string) (int, error) {
+	begin := time.Now()
+	n, err := r.inner.CountBySenderID(ctx, senderID)
+	r.recorder.observe("MorningCallRepository.CountBySenderID", begin, err)
+	return n, err
+}
+
+// CountByReceiverID は受信者IDでモーニングコール数を取得する
+func (r *MorningCallRepository) CountByReceiverID(ctx context.Context, receiverID string) (int, error) {
+	begin := time.Now()
+	n, err := r.inner.CountByReceiverID(ctx, receiverID)
+	r.recorder.observe("MorningCallRepository.CountByReceiverID", begin, err)
+	return n, err
+}
+
+// CountByStatus はステータスごとのモーニングコール数を取得する
+func (r *MorningCallRepository) CountByStatus(ctx context.Context, status valueobject.MorningCallStatus) (int, error) {
+	begin := time.Now()
+	n, err := r.inner.CountByStatus(ctx, status)
+	r.recorder.observe("MorningCallRepository.CountByStatus", begin, err)
+	return n, err
+}
+
+// CountByReceiverIDAndStatus は受信者IDとステータスでモーニングコール数を取得する
+func (r *MorningCallRepository) CountByReceiverIDAndStatus(ctx context.Context, receiverID string, status valueobject.MorningCallStatus) (int, error) {
+	begin := time.Now()
+	n, err := r.inner.CountByReceiverIDAndStatus(ctx, receiverID, status)
+	r.recorder.observe("MorningCallRepository.CountByReceiverIDAndStatus", begin, err)
+	return n, err
+}
+
+// CountByReceiverIDAndArchived は受信者IDとアーカイブ状態でモーニングコール数を取得する
+func (r *MorningCallRepository) CountByReceiverIDAndArchived(ctx context.Context, receiverID string, archived bool) (int, error) {
+	begin := time.Now()
+	n, err := r.inner.CountByReceiverIDAndArchived(ctx, receiverID, archived)
+	r.recorder.observe("MorningCallRepository.CountByReceiverIDAndArchived", begin, err)
+	return n, err
+}
+
+// FindAll はすべてのモーニングコールを取得する（ページネーション対応）
+func (r *MorningCallRepository) FindAll(ctx context.Context, offset, limit int) ([]*entity.MorningCall, error) {
+	begin := time.Now()
+	results, err := r.inner.FindAll(ctx, offset, limit)
+	r.recorder.observe("MorningCallRepository.FindAll", begin, err)
+	return results, err
+}
+
+// FindAllExcludingStatuses は指定したステータスを除いたモーニングコールを取得する（ページネーション対応）
+func (r *MorningCallRepository) FindAllExcludingStatuses(ctx context.Context, exclude []valueobject.MorningCallStatus, offset, limit int) ([]*entity.MorningCall, error) {
+	begin := time.Now()
+	results, err := r.inner.FindAllExcludingStatuses(ctx, exclude, offset, limit)
+	r.recorder.observe("MorningCallRepository.FindAllExcludingStatuses", begin, err)
+	return results, err
+}
+
+// CountExcludingStatuses は指定したステータスを除いたモーニングコール数を取得する
+func (r *MorningCallRepository) CountExcludingStatuses(ctx context.Context, exclude []valueobject.MorningCallStatus) (int, error) {
+	begin := time.Now()
+	n, err := r.inner.CountExcludingStatuses(ctx, exclude)
+	r.recorder.observe("MorningCallRepository.CountExcludingStatuses", begin, err)
+	return n, err
+}
+
+// Count は総モーニングコール数を取得する
+func (r *MorningCallRepository) Count(ctx context.Context) (int, error) {
+	begin := time.Now()
+	n, err := r.inner.Count(ctx)
+	r.recorder.observe("MorningCallRepository.Count", begin, err)
+	return n, err
+}
+
+// CountConfirmationsByUser は予定時刻が期間内（start以上end未満）の起床確認済みコールをユーザーごとに数える
+func (r *MorningCallRepository) CountConfirmationsByUser(ctx context.Context, start, end time.Time) (repository.ConfirmationCounts, error) {
+	begin := time.Now()
+	counts, err := r.inner.CountConfirmationsByUser(ctx, start, end)
+	r.recorder.observe("MorningCallRepository.CountConfirmationsByUser", begin, err)
+	return counts, err
+}
+
+// Stats は総モーニングコール数とステータス別の内訳をまとめて取得する
+func (r *MorningCallRepository) Stats(ctx context.Context) (repository.RepositoryStats, error) {
+	begin := time.Now()
+	stats, err := r.inner.Stats(ctx)
+	r.recorder.observe("MorningCallRepository.Stats", begin, err)
+	return stats, err
+}
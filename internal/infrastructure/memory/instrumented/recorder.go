@@ -0,0 +1,78 @@
+// Package instrumented はリポジトリをラップして操作ごとの呼び出し回数・レイテンシ・エラーを計測するデコレータを提供する
+// ラップしたリポジトリは元のインターフェースをそのまま満たすため、ユースケースからは計測の有無を区別できない
+package instrumented
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// Recorder はリポジトリ操作の計測値を集計する
+// 計測はロックを取らず、操作ごとのカウンタへのアトミックな加算のみで行う
+type Recorder struct {
+	operations sync.Map // 操作名 -> *operationCounter
+}
+
+// operationCounter は1つの操作の計測値
+type operationCounter struct {
+	calls        atomic.Int64
+	errors       atomic.Int64
+	totalLatency atomic.Int64 // ナノ秒
+}
+
+// NewRecorder は新しいRecorderを作成する
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// observe は操作の呼び出しを1回記録する
+// 対象が見つからないこと（ErrNotFound）は正常な結果のため、エラーとしては数えない
+func (r *Recorder) observe(operation string, start time.Time, err error) {
+	elapsed := time.Since(start)
+	c := r.counter(operation)
+	c.calls.Add(1)
+	c.totalLatency.Add(int64(elapsed))
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		c.errors.Add(1)
+	}
+}
+
+// counter は操作の計測値を返す（未記録の場合は作成する）
+func (r *Recorder) counter(operation string) *operationCounter {
+	if c, ok := r.operations.Load(operation); ok {
+		return c.(*operationCounter)
+	}
+	c, _ := r.operations.LoadOrStore(operation, &operationCounter{})
+	return c.(*operationCounter)
+}
+
+// OperationMetrics はこれまでに計測した操作ごとの値を操作名の順に返す
+// 各操作の値は個別に読み取るため、計測中の呼び出しが一部だけ反映されることがある
+func (r *Recorder) OperationMetrics() []repository.OperationMetrics {
+	var metrics []repository.OperationMetrics
+	r.operations.Range(func(key, value any) bool {
+		c := value.(*operationCounter)
+		metrics = append(metrics, repository.OperationMetrics{
+			Operation:    key.(string),
+			Calls:        c.calls.Load(),
+			Errors:       c.errors.Load(),
+			TotalLatency: time.Duration(c.totalLatency.Load()),
+		})
+		return true
+	})
+
+	sort.Slice(metrics, func(i, j int) bool {
+		return metrics[i].Operation < metrics[j].Operation
+	})
+	return metrics
+}
+
+// Reset はすべての計測値を破棄する
+func (r *Recorder) Reset() {
+	r.operations.Clear()
+}
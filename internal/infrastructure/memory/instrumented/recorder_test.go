@@ -0,0 +1,122 @@
+package instrumented
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestUserRepository_RecordsOperations(t *testing.T) {
+	ctx := context.Background()
+	recorder := NewRecorder()
+	repo := NewUserRepository(memory.NewUserRepository(), recorder)
+
+	user := &entity.User{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"}
+	if err := repo.Create(ctx, user); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	// 重複した作成はエラーとして数える
+	if err := repo.Create(ctx, user); !errors.Is(err, repository.ErrAlreadyExists) {
+		t.Fatalf("Create() error = %v, want ErrAlreadyExists", err)
+	}
+	if _, err := repo.FindByID(ctx, "user1"); err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	// 見つからないことはエラーとして数えない
+	if _, err := repo.FindByID(ctx, "missing"); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("FindByID() error = %v, want ErrNotFound", err)
+	}
+
+	metrics := recorder.OperationMetrics()
+	if len(metrics) != 2 {
+		t.Fatalf("got %d operations, want 2: %+v", len(metrics), metrics)
+	}
+
+	tests := []struct {
+		operation string
+		calls     int64
+		errors    int64
+		errorRate float64
+	}{
+		{"UserRepository.Create", 2, 1, 0.5},
+		{"UserRepository.FindByID", 2, 0, 0},
+	}
+	for i, tt := range tests {
+		m := metrics[i]
+		if m.Operation != tt.operation {
+			t.Errorf("metrics[%d].Operation = %s, want %s", i, m.Operation, tt.operation)
+			continue
+		}
+		if m.Calls != tt.calls || m.Errors != tt.errors || m.ErrorRate() != tt.errorRate {
+			t.Errorf("%s: calls=%d errors=%d rate=%v, want %d %d %v", m.Operation, m.Calls, m.Errors, m.ErrorRate(), tt.calls, tt.errors, tt.errorRate)
+		}
+		if m.TotalLatency <= 0 || m.AverageLatency() > m.TotalLatency {
+			t.Errorf("%s: total=%v average=%v", m.Operation, m.TotalLatency, m.AverageLatency())
+		}
+	}
+
+	recorder.Reset()
+	if metrics := recorder.OperationMetrics(); len(metrics) != 0 {
+		t.Errorf("after Reset() got %d operations, want 0", len(metrics))
+	}
+}
+
+func TestRecorder_ConcurrentObserve(t *testing.T) {
+	ctx := context.Background()
+	recorder := NewRecorder()
+	repo := NewMorningCallRepository(memory.NewMorningCallRepository(), recorder)
+
+	const workers, callsPerWorker = 8, 100
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range callsPerWorker {
+				_, _ = repo.Count(ctx)
+			}
+		}()
+	}
+	wg.Wait()
+
+	metrics := recorder.OperationMetrics()
+	if len(metrics) != 1 || metrics[0].Calls != workers*callsPerWorker {
+		t.Errorf("metrics = %+v, want %d calls of MorningCallRepository.Count", metrics, workers*callsPerWorker)
+	}
+}
+
+func TestOperationMetrics_NoCalls(t *testing.T) {
+	var m repository.OperationMetrics
+	if m.AverageLatency() != 0 || m.ErrorRate() != 0 {
+		t.Errorf("AverageLatency() = %v, ErrorRate() = %v, want 0", m.AverageLatency(), m.ErrorRate())
+	}
+}
+
+// 計測のオーバーヘッドは BenchmarkUserRepository_FindByID の Raw と Instrumented の差で確認する
+// （go test -bench=FindByID ./internal/infrastructure/memory/instrumented/）
+func BenchmarkUserRepository_FindByID(b *testing.B) {
+	ctx := context.Background()
+	raw := memory.NewUserRepository()
+	if err := raw.Create(ctx, &entity.User{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"}); err != nil {
+		b.Fatalf("failed to create user: %v", err)
+	}
+
+	for _, bm := range []struct {
+		name string
+		repo repository.UserRepository
+	}{
+		{"Raw", raw},
+		{"Instrumented", NewUserRepository(raw, NewRecorder())},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			for b.Loop() {
+				_, _ = bm.repo.FindByID(ctx, "user1")
+			}
+		})
+	}
+}
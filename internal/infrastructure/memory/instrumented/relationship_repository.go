@@ -0,0 +1,223 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// RelationshipRepository は計測付きの友達関係リポジトリ
+type RelationshipRepository struct {
+	inner    repository.RelationshipRepository
+	recorder *Recorder
+}
+
+// NewRelationshipRepository は友達関係リポジトリをラップして計測付きにする
+func NewRelationshipRepository(inner repository.RelationshipRepository, recorder *Recorder) *RelationshipRepository {
+	return &RelationshipRepository{inner: inner, recorder: recorder}
+}
+
+var _ repository.RelationshipRepository = (*RelationshipRepository)(nil)
+
+// Create は新しい友達関係を作成する
+func (r *RelationshipRepository) Create(ctx context.Context, relationship *entity.Relationship) error {
+	begin := time.Now()
+	err := r.inner.Create(ctx, relationship)
+	r.recorder.observe("RelationshipRepository.Create", begin, err)
+	return err
+}
+
+// BulkCreate は複数の関係をまとめて作成する（テストやデモ用の初期データ投入向け）
+func (r *RelationshipRepository) BulkCreate(ctx context.Context, relationships []*entity.Relationship, mode repository.BulkCreateMode) (repository.BulkCreateResult, error) {
+	begin := time.Now()
+	result, err := r.inner.BulkCreate(ctx, relationships, mode)
+	r.recorder.observe("RelationshipRepository.BulkCreate", begin, err)
+	return result, err
+}
+
+// FindByID はIDで友達関係を検索する
+func (r *RelationshipRepository) FindByID(ctx context.Context, id string) (*entity.Relationship, error) {
+	begin := time.Now()
+	relationship, err := r.inner.FindByID(ctx, id)
+	r.recorder.observe("RelationshipRepository.FindByID", begin, err)
+	return relationship, err
+}
+
+// Update は友達関係情報を更新する
+func (r *RelationshipRepository) Update(ctx context.Context, relationship *entity.Relationship) error {
+	begin := time.Now()
+	err := r.inner.Update(ctx, relationship)
+	r.recorder.observe("RelationshipRepository.Update", begin, err)
+	return err
+}
+
+// Delete は友達関係を削除する
+func (r *RelationshipRepository) Delete(ctx context.Context, id string) error {
+	begin := time.Now()
+	err := r.inner.Delete(ctx, id)
+	r.recorder.observe("RelationshipRepository.Delete", begin, err)
+	return err
+}
+
+// ExistsByID はIDで友達関係の存在を確認する
+func (r *RelationshipRepository) ExistsByID(ctx context.Context, id string) (bool, error) {
+	begin := time.Now()
+	ok, err := r.inner.ExistsByID(ctx, id)
+	r.recorder.observe("RelationshipRepository.ExistsByID", begin, err)
+	return ok, err
+}
+
+// FindByUserPair は特定のユーザーペア間の関係を検索する
+func (r *RelationshipRepository) FindByUserPair(ctx context.Context, userID1, userID2 string) (*entity.Relationship, error) {
+	begin := time.Now()
+	relationship, err := r.inner.FindByUserPair(ctx, userID1, userID2)
+	r.recorder.observe("RelationshipRepository.FindByUserPair", begin, err)
+	return relationship, err
+}
+
+// FindByRequesterID はリクエスト送信者IDで友達関係を検索する
+func (r *RelationshipRepository) FindByRequesterID(ctx context.Context, requesterID string, offset, limit int) ([]*entity.Relationship, error) {
+	begin := time.Now()
+	results, err := r.inner.FindByRequesterID(ctx, requesterID, offset, limit)
+	r.recorder.observe("RelationshipRepository.FindByRequesterID", begin, err)
+	return results, err
+}
+
+// FindByReceiverID はリクエスト受信者IDで友達関係を検索する
+func (r *RelationshipRepository) FindByReceiverID(ctx context.Context, receiverID string, offset, limit int) ([]*entity.Relationship, error) {
+	begin := time.Now()
+	results, err := r.inner.FindByReceiverID(ctx, receiverID, offset, limit)
+	r.recorder.observe("RelationshipRepository.FindByReceiverID", begin, err)
+	return results, err
+}
+
+// FindByUserID はユーザーIDで友達関係を検索する（送信者・受信者両方）
+func (r *RelationshipRepository) FindByUserID(ctx context.Context, userID string, offset, limit int) ([]*entity.Relationship, error) {
+	begin := time.Now()
+	results, err := r.inner.FindByUserID(ctx, userID, offset, limit)
+	r.recorder.observe("RelationshipRepository.FindByUserID", begin, err)
+	return results, err
+}
+
+// FindByStatus はステータスで友達関係を検索する
+func (r *RelationshipRepository) FindByStatus(ctx context.Context, status valueobject.RelationshipStatus, offset, limit int) ([]*entity.Relationship, error) {
+	begin := time.Now()
+	results, err := r.inner.FindByStatus(ctx, status, offset, limit)
+	r.recorder.observe("RelationshipRepository.FindByStatus", begin, err)
+	return results, err
+}
+
+// FindFriendsByUserID はユーザーIDで友達（承認済み）関係を検索する
+func (r *RelationshipRepository) FindFriendsByUserID(ctx context.Context, userID string, offset, limit int) ([]*entity.Relationship, error) {
+	begin := time.Now()
+	results, err := r.inner.FindFriendsByUserID(ctx, userID, offset, limit)
+	r.recorder.observe("RelationshipRepository.FindFriendsByUserID", begin, err)
+	return results, err
+}
+
+// FindPendingRequestsByReceiverID は受信者IDで承認待ちリクエストを検索する
+func (r *RelationshipRepository) FindPendingRequestsByReceiverID(ctx context.Context, receiverID string, offset, limit int) ([]*entity.Relationship, error) {
+	begin := time.Now()
+	results, err := r.inner.FindPendingRequestsByReceiverID(ctx, receiverID, offset, limit)
+	r.recorder.observe("RelationshipRepository.FindPendingRequestsByReceiverID", begin, err)
+	return results, err
+}
+
+// FindPendingRequestsByRequesterID は送信者IDで承認待ちリクエストを検索する
+func (r *RelationshipRepository) FindPendingRequestsByRequesterID(ctx context.Context, requesterID string, offset, limit int) ([]*entity.Relationship, error) {
+	begin := time.Now()
+	results, err := r.inner.FindPendingRequestsByRequesterID(ctx, requesterID, offset, limit)
+	r.recorder.observe("RelationshipRepository.FindPendingRequestsByRequesterID", begin, err)
+	return results, err
+}
+
+// FindBlockedRelationshipsByUserID はユーザーIDでブロック関係を検索する
+func (r *RelationshipRepository) FindBlockedRelationshipsByUserID(ctx context.Context, userID string, offset, limit int) ([]*entity.Relationship, error) {
+	begin := time.Now()
+	results, err := r.inner.FindBlockedRelationshipsByUserID(ctx, userID, offset, limit)
+	r.recorder.observe("RelationshipRepository.FindBlockedRelationshipsByUserID", begin, err)
+	return results, err
+}
+
+// ExistsByUserPair は特定のユーザーペア間の関係の存在を確認する
+func (r *RelationshipRepository) ExistsByUserPair(ctx context.Context, userID1, userID2 string) (bool, error) {
+	begin := time.Now()
+	ok, err := r.inner.ExistsByUserPair(ctx, userID1, userID2)
+	r.recorder.observe("RelationshipRepository.ExistsByUserPair", begin, err)
+	return ok, err
+}
+
+// AreFriends は2人のユーザーが友達関係かを確認する
+func (r *RelationshipRepository) AreFriends(ctx context.Context, userID1, userID2 string) (bool, error) {
+	begin := time.Now()
+	ok, err := r.inner.AreFriends(ctx, userID1, userID2)
+	r.recorder.observe("RelationshipRepository.AreFriends", begin, err)
+	return ok, err
+}
+
+// IsBlocked は指定ユーザーがブロックされているかを確認する
+func (r *RelationshipRepository) IsBlocked(ctx context.Context, blockerID, blockedID string) (bool, error) {
+	begin := time.Now()
+	ok, err := r.inner.IsBlocked(ctx, blockerID, blockedID)
+	r.recorder.observe("RelationshipRepository.IsBlocked", begin, err)
+	return ok, err
+}
+
+// CountFriendsByUserID はユーザーIDで友達数を取得する
+func (r *RelationshipRepository) CountFriendsByUserID(ctx context.Context, userID string) (int, error) {
+	begin := time.Now()
+	n, err := r.inner.CountFriendsByUserID(ctx, userID)
+	r.recorder.observe("RelationshipRepository.CountFriendsByUserID", begin, err)
+	return n, err
+}
+
+// CountPendingRequestsByReceiverID は受信者IDで承認待ちリクエスト数を取得する
+func (r *RelationshipRepository) CountPendingRequestsByReceiverID(ctx context.Context, receiverID string) (int, error) {
+	begin := time.Now()
+	n, err := r.inner.CountPendingRequestsByReceiverID(ctx, receiverID)
+	r.recorder.observe("RelationshipRepository.CountPendingRequestsByReceiverID", begin, err)
+	return n, err
+}
+
+// CountUnseenRequestsByReceiverID は受信者IDで未読の承認待ちリクエスト数を取得する
+func (r *RelationshipRepository) CountUnseenRequestsByReceiverID(ctx context.Context, receiverID string) (int, error) {
+	begin := time.Now()
+	n, err := r.inner.CountUnseenRequestsByReceiverID(ctx, receiverID)
+	r.recorder.observe("RelationshipRepository.CountUnseenRequestsByReceiverID", begin, err)
+	return n, err
+}
+
+// CountByStatus はステータスごとの関係数を取得する
+func (r *RelationshipRepository) CountByStatus(ctx context.Context, status valueobject.RelationshipStatus) (int, error) {
+	begin := time.Now()
+	n, err := r.inner.CountByStatus(ctx, status)
+	r.recorder.observe("RelationshipRepository.CountByStatus", begin, err)
+	return n, err
+}
+
+// FindAll はすべての友達関係を取得する（ページネーション対応）
+func (r *RelationshipRepository) FindAll(ctx context.Context, offset, limit int) ([]*entity.Relationship, error) {
+	begin := time.Now()
+	results, err := r.inner.FindAll(ctx, offset, limit)
+	r.recorder.observe("RelationshipRepository.FindAll", begin, err)
+	return results, err
+}
+
+// Count は総関係数を取得する
+func (r *RelationshipRepository) Count(ctx context.Context) (int, error) {
+	begin := time.Now()
+	n, err := r.inner.Count(ctx)
+	r.recorder.observe("RelationshipRepository.Count", begin, err)
+	return n, err
+}
+
+// Stats は総関係数とステータス別の内訳をまとめて取得する
+func (r *RelationshipRepository) Stats(ctx context.Context) (repository.RepositoryStats, error) {
+	begin := time.Now()
+	stats, err := r.inner.Stats(ctx)
+	r.recorder.observe("RelationshipRepository.Stats", begin, err)
+	return stats, err
+}
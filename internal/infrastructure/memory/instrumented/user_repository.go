@@ -0,0 +1,158 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// UserRepository は計測付きのユーザーリポジトリ
+type UserRepository struct {
+	inner    repository.UserRepository
+	recorder *Recorder
+}
+
+// NewUserRepository はユーザーリポジトリをラップして計測付きにする
+func NewUserRepository(inner repository.UserRepository, recorder *Recorder) *UserRepository {
+	return &UserRepository{inner: inner, recorder: recorder}
+}
+
+var _ repository.UserRepository = (*UserRepository)(nil)
+
+// Create は新しいユーザーを作成する
+func (r *UserRepository) Create(ctx context.Context, user *entity.User) error {
+	begin := time.Now()
+	err := r.inner.Create(ctx, user)
+	r.recorder.observe("UserRepository.Create", begin, err)
+	return err
+}
+
+// BulkCreate は複数のユーザーをまとめて作成する（テストやデモ用の初期データ投入向け）
+func (r *UserRepository) BulkCreate(ctx context.Context, users []*entity.User, mode repository.BulkCreateMode) (repository.BulkCreateResult, error) {
+	begin := time.Now()
+	result, err := r.inner.BulkCreate(ctx, users, mode)
+	r.recorder.observe("UserRepository.BulkCreate", begin, err)
+	return result, err
+}
+
+// FindByID はIDでユーザーを検索する
+func (r *UserRepository) FindByID(ctx context.Context, id string) (*entity.User, error) {
+	begin := time.Now()
+	user, err := r.inner.FindByID(ctx, id)
+	r.recorder.observe("UserRepository.FindByID", begin, err)
+	return user, err
+}
+
+// FindByIDs は複数のIDでユーザーをまとめて検索する
+func (r *UserRepository) FindByIDs(ctx context.Context, ids []string) ([]*entity.User, error) {
+	begin := time.Now()
+	results, err := r.inner.FindByIDs(ctx, ids)
+	r.recorder.observe("UserRepository.FindByIDs", begin, err)
+	return results, err
+}
+
+// FindByUsername はユーザー名でユーザーを検索する
+func (r *UserRepository) FindByUsername(ctx context.Context, username string) (*entity.User, error) {
+	begin := time.Now()
+	user, err := r.inner.FindByUsername(ctx, username)
+	r.recorder.observe("UserRepository.FindByUsername", begin, err)
+	return user, err
+}
+
+// FindByEmail はメールアドレスでユーザーを検索する
+func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
+	begin := time.Now()
+	user, err := r.inner.FindByEmail(ctx, email)
+	r.recorder.observe("UserRepository.FindByEmail", begin, err)
+	return user, err
+}
+
+// Update はユーザー情報を更新する
+func (r *UserRepository) Update(ctx context.Context, user *entity.User) error {
+	begin := time.Now()
+	err := r.inner.Update(ctx, user)
+	r.recorder.observe("UserRepository.Update", begin, err)
+	return err
+}
+
+// AddPoints はユーザーのポイントに加算し、加算後のポイントを返す
+func (r *UserRepository) AddPoints(ctx context.Context, id string, points int) (int, error) {
+	begin := time.Now()
+	n, err := r.inner.AddPoints(ctx, id, points)
+	r.recorder.observe("UserRepository.AddPoints", begin, err)
+	return n, err
+}
+
+// FindTopByPoints はポイントの多い順にユーザーを取得する（同点の場合はIDの昇順）
+func (r *UserRepository) FindTopByPoints(ctx context.Context, limit int) ([]*entity.User, error) {
+	begin := time.Now()
+	results, err := r.inner.FindTopByPoints(ctx, limit)
+	r.recorder.observe("UserRepository.FindTopByPoints", begin, err)
+	return results, err
+}
+
+// FindDeletionDue は削除予定の猶予期間がnowまでに過ぎたユーザーを取得する
+func (r *UserRepository) FindDeletionDue(ctx context.Context, now time.Time) ([]*entity.User, error) {
+	begin := time.Now()
+	results, err := r.inner.FindDeletionDue(ctx, now)
+	r.recorder.observe("UserRepository.FindDeletionDue", begin, err)
+	return results, err
+}
+
+// Delete はユーザーを削除する
+func (r *UserRepository) Delete(ctx context.Context, id string) error {
+	begin := time.Now()
+	err := r.inner.Delete(ctx, id)
+	r.recorder.observe("UserRepository.Delete", begin, err)
+	return err
+}
+
+// ExistsByID はIDでユーザーの存在を確認する
+func (r *UserRepository) ExistsByID(ctx context.Context, id string) (bool, error) {
+	begin := time.Now()
+	ok, err := r.inner.ExistsByID(ctx, id)
+	r.recorder.observe("UserRepository.ExistsByID", begin, err)
+	return ok, err
+}
+
+// ExistsByUsername はユーザー名でユーザーの存在を確認する
+func (r *UserRepository) ExistsByUsername(ctx context.Context, username string) (bool, error) {
+	begin := time.Now()
+	ok, err := r.inner.ExistsByUsername(ctx, username)
+	r.recorder.observe("UserRepository.ExistsByUsername", begin, err)
+	return ok, err
+}
+
+// ExistsByEmail はメールアドレスでユーザーの存在を確認する
+func (r *UserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	begin := time.Now()
+	ok, err := r.inner.ExistsByEmail(ctx, email)
+	r.recorder.observe("UserRepository.ExistsByEmail", begin, err)
+	return ok, err
+}
+
+// FindAll はすべてのユーザーを取得する（ページネーション対応）
+func (r *UserRepository) FindAll(ctx context.Context, offset, limit int) ([]*entity.User, error) {
+	begin := time.Now()
+	results, err := r.inner.FindAll(ctx, offset, limit)
+	r.recorder.observe("UserRepository.FindAll", begin, err)
+	return results, err
+}
+
+// Count は総ユーザー数を取得する
+func (r *UserRepository) Count(ctx context.Context) (int, error) {
+	begin := time.Now()
+	n, err := r.inner.Count(ctx)
+	r.recorder.observe("UserRepository.Count", begin, err)
+	return n, err
+}
+
+// Stats は総ユーザー数とプラン別の内訳をまとめて取得する
+func (r *UserRepository) Stats(ctx context.Context) (repository.RepositoryStats, error) {
+	begin := time.Now()
+	stats, err := r.inner.Stats(ctx)
+	r.recorder.observe("UserRepository.Stats", begin, err)
+	return stats, err
+}
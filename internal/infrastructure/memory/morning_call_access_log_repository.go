@@ -0,0 +1,82 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// DefaultMaxAccessLogsPerCall はコールごとに保持する閲覧記録のデフォルトの上限件数
+const DefaultMaxAccessLogsPerCall = 100
+
+// MorningCallAccessLogRepository はメモリ内でモーニングコールの閲覧記録を管理するリポジトリ
+type MorningCallAccessLogRepository struct {
+	logs       map[string][]*entity.MorningCallAccess // morningCallID -> 古い順の閲覧記録
+	maxPerCall int
+	mu         sync.RWMutex
+}
+
+// NewMorningCallAccessLogRepository は新しいインメモリ閲覧記録リポジトリを作成する
+// maxPerCallが0以下の場合はDefaultMaxAccessLogsPerCallを上限とする
+func NewMorningCallAccessLogRepository(maxPerCall int) *MorningCallAccessLogRepository {
+	if maxPerCall <= 0 {
+		maxPerCall = DefaultMaxAccessLogsPerCall
+	}
+	return &MorningCallAccessLogRepository{
+		logs:       make(map[string][]*entity.MorningCallAccess),
+		maxPerCall: maxPerCall,
+	}
+}
+
+// Record は閲覧記録を1件追加し、上限を超えた古い記録を破棄する
+func (r *MorningCallAccessLogRepository) Record(ctx context.Context, access *entity.MorningCallAccess) error {
+	_ = ctx // 将来的なDB実装のために保持
+	if access == nil || access.MorningCallID == "" || access.ViewerID == "" {
+		return repository.ErrInvalidArgument
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	accessCopy := *access
+	logs := append(r.logs[access.MorningCallID], &accessCopy)
+	if len(logs) > r.maxPerCall {
+		// 破棄した記録を参照し続けないよう新しいスライスへ詰め直す
+		logs = append([]*entity.MorningCallAccess(nil), logs[len(logs)-r.maxPerCall:]...)
+	}
+	r.logs[access.MorningCallID] = logs
+	return nil
+}
+
+// FindByMorningCallID はコールの閲覧記録を新しい順に取得する
+func (r *MorningCallAccessLogRepository) FindByMorningCallID(ctx context.Context, morningCallID string, offset, limit int) ([]*entity.MorningCallAccess, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if offset < 0 || limit < 0 {
+		return nil, repository.ErrInvalidArgument
+	}
+
+	logs := r.logs[morningCallID]
+	result := []*entity.MorningCallAccess{}
+	for i := len(logs) - 1 - offset; i >= 0 && len(result) < limit; i-- {
+		accessCopy := *logs[i]
+		result = append(result, &accessCopy)
+	}
+	return result, nil
+}
+
+// CountByMorningCallID はコールの閲覧記録の件数を取得する
+func (r *MorningCallAccessLogRepository) CountByMorningCallID(ctx context.Context, morningCallID string) (int, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.logs[morningCallID]), nil
+}
+
+// インターフェースの実装を保証
+var _ repository.MorningCallAccessLogRepository = (*MorningCallAccessLogRepository)(nil)
@@ -0,0 +1,88 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+func TestMorningCallAccessLogRepository_Record(t *testing.T) {
+	ctx := context.Background()
+	base := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+
+	t.Run("新しい順に取得できる", func(t *testing.T) {
+		repo := NewMorningCallAccessLogRepository(0)
+		for i, viewerID := range []string{"user1", "user2", "user3"} {
+			if err := repo.Record(ctx, &entity.MorningCallAccess{MorningCallID: "mc1", ViewerID: viewerID, Allowed: true, AccessedAt: base.Add(time.Duration(i) * time.Minute)}); err != nil {
+				t.Fatalf("Record() unexpected error = %v", err)
+			}
+		}
+		if err := repo.Record(ctx, &entity.MorningCallAccess{MorningCallID: "mc2", ViewerID: "user1", AccessedAt: base}); err != nil {
+			t.Fatalf("Record() unexpected error = %v", err)
+		}
+
+		logs, err := repo.FindByMorningCallID(ctx, "mc1", 0, 10)
+		if err != nil {
+			t.Fatalf("FindByMorningCallID() unexpected error = %v", err)
+		}
+		if len(logs) != 3 || logs[0].ViewerID != "user3" || logs[2].ViewerID != "user1" {
+			t.Errorf("FindByMorningCallID() = %+v, want user3, user2, user1", logs)
+		}
+
+		logs, _ = repo.FindByMorningCallID(ctx, "mc1", 1, 1)
+		if len(logs) != 1 || logs[0].ViewerID != "user2" {
+			t.Errorf("FindByMorningCallID(offset=1, limit=1) = %+v, want [user2]", logs)
+		}
+		logs, _ = repo.FindByMorningCallID(ctx, "mc1", 5, 10)
+		if len(logs) != 0 {
+			t.Errorf("FindByMorningCallID(offset=5) = %+v, want empty", logs)
+		}
+
+		// 取得結果を変更しても保持している記録に影響しない
+		logs, _ = repo.FindByMorningCallID(ctx, "mc1", 0, 1)
+		logs[0].ViewerID = "modified"
+		logs, _ = repo.FindByMorningCallID(ctx, "mc1", 0, 1)
+		if logs[0].ViewerID != "user3" {
+			t.Errorf("stored access was modified: %+v", logs[0])
+		}
+	})
+
+	t.Run("上限を超えた古い記録は破棄する", func(t *testing.T) {
+		repo := NewMorningCallAccessLogRepository(3)
+		for i := 0; i < 5; i++ {
+			if err := repo.Record(ctx, &entity.MorningCallAccess{MorningCallID: "mc1", ViewerID: fmt.Sprintf("user%d", i), AccessedAt: base.Add(time.Duration(i) * time.Minute)}); err != nil {
+				t.Fatalf("Record() unexpected error = %v", err)
+			}
+		}
+
+		count, _ := repo.CountByMorningCallID(ctx, "mc1")
+		if count != 3 {
+			t.Errorf("CountByMorningCallID() = %d, want 3", count)
+		}
+		logs, _ := repo.FindByMorningCallID(ctx, "mc1", 0, 10)
+		if len(logs) != 3 || logs[0].ViewerID != "user4" || logs[2].ViewerID != "user2" {
+			t.Errorf("FindByMorningCallID() = %+v, want user4, user3, user2", logs)
+		}
+	})
+
+	t.Run("不正な引数", func(t *testing.T) {
+		repo := NewMorningCallAccessLogRepository(0)
+		for _, access := range []*entity.MorningCallAccess{
+			nil,
+			{ViewerID: "user1"},
+			{MorningCallID: "mc1"},
+		} {
+			if err := repo.Record(ctx, access); !errors.Is(err, repository.ErrInvalidArgument) {
+				t.Errorf("Record(%+v) error = %v, want ErrInvalidArgument", access, err)
+			}
+		}
+		if _, err := repo.FindByMorningCallID(ctx, "mc1", -1, 10); !errors.Is(err, repository.ErrInvalidArgument) {
+			t.Errorf("FindByMorningCallID(offset=-1) error = %v, want ErrInvalidArgument", err)
+		}
+	})
+}
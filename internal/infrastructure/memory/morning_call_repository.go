@@ -21,6 +21,13 @@ type MorningCallRepository struct {
 	receiverIndex map[string][]string                        // receiverID -> []morningCallID
 	statusIndex   map[valueobject.MorningCallStatus][]string // status -> []morningCallID
 	userPairIndex map[string][]string                        // "senderID:receiverID" -> []morningCallID
+	seriesIndex   map[string][]string                        // seriesID -> []morningCallID
+
+	// 起床確認のランキング用カウンタ（予定時刻を1時間単位に切り捨てたUnix時刻 -> ユーザー別件数）
+	confirmCounters map[int64]*confirmationBucket
+
+	// 書き込みごとに増加するバージョン（トランザクション内で変更したかの判定用）
+	version uint64
 
 	// 並行アクセス制御用
 	mu sync.RWMutex
@@ -34,9 +41,18 @@ func NewMorningCallRepository() *MorningCallRepository {
 		receiverIndex: make(map[string][]string),
 		statusIndex:   make(map[valueobject.MorningCallStatus][]string),
 		userPairIndex: make(map[string][]string),
+		seriesIndex:   make(map[string][]string),
+
+		confirmCounters: make(map[int64]*confirmationBucket),
 	}
 }
 
+// confirmationBucket は1時間分の起床確認済みコールのユーザー別件数
+type confirmationBucket struct {
+	woken  map[string]int // 受信者ID -> 件数
+	wakers map[string]int // 送信者ID -> 件数
+}
+
 // Create は新しいモーニングコールを作成する
 func (r *MorningCallRepository) Create(ctx context.Context, morningCall *entity.MorningCall) error {
 	_ = ctx // 将来的なDB実装のために保持
@@ -61,9 +77,41 @@ func (r *MorningCallRepository) Create(ctx context.Context, morningCall *entity.
 	// インデックスを更新
 	r.addToIndexes(mcCopy)
 
+	r.version++
 	return nil
 }
 
+// BulkCreate は複数のモーニングコールをロックを1回だけ取得してまとめて作成する
+// IDの重複は保存済みのコールに加え、同じバッチ内でも検証する
+func (r *MorningCallRepository) BulkCreate(ctx context.Context, morningCalls []*entity.MorningCall, mode repository.BulkCreateMode) (repository.BulkCreateResult, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ids := make(map[string]struct{}, len(morningCalls))
+	check := func(mc *entity.MorningCall) error {
+		_, dup := ids[mc.ID]
+		_, exists := r.morningCalls[mc.ID]
+		if dup || exists {
+			return repository.ErrAlreadyExists
+		}
+		ids[mc.ID] = struct{}{}
+		return nil
+	}
+	r.morningCalls = growMap(r.morningCalls, len(morningCalls))
+	insert := func(mc *entity.MorningCall) {
+		mcCopy := r.copyMorningCall(mc)
+		r.morningCalls[mcCopy.ID] = mcCopy
+		r.addToIndexes(mcCopy)
+	}
+
+	result, err := bulkCreate(morningCalls, mode, check, insert)
+	if result.Created > 0 {
+		r.version++
+	}
+	return result, err
+}
+
 // FindByID はIDでモーニングコールを検索する
 func (r *MorningCallRepository) FindByID(ctx context.Context, id string) (*entity.MorningCall, error) {
 	_ = ctx // 将来的なDB実装のために保持
@@ -93,16 +141,25 @@ func (r *MorningCallRepository) Update(ctx context.Context, morningCall *entity.
 		return repository.ErrNotFound
 	}
 
+	// 楽観ロック: 取得後に他の更新が行われていれば競合とする
+	if existing.Version != morningCall.Version {
+		return repository.ErrUpdateConflict
+	}
+
 	// 既存のインデックスから削除
 	r.removeFromIndexes(existing)
 
 	// モーニングコール情報を更新
 	mcCopy := r.copyMorningCall(morningCall)
+	mcCopy.Version = existing.Version + 1
 	r.morningCalls[mcCopy.ID] = mcCopy
+	// 呼び出し側が続けて更新できるようにバージョンを反映する
+	morningCall.Version = mcCopy.Version
 
 	// 新しいインデックスに追加
 	r.addToIndexes(mcCopy)
 
+	r.version++
 	return nil
 }
 
@@ -123,6 +180,7 @@ func (r *MorningCallRepository) Delete(ctx context.Context, id string) error {
 	// モーニングコールを削除
 	delete(r.morningCalls, id)
 
+	r.version++
 	return nil
 }
 
@@ -142,58 +200,82 @@ func (r *MorningCallRepository) FindBySenderID(ctx context.Context, senderID str
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	if offset < 0 || limit < 0 {
-		return nil, repository.ErrInvalidArgument
+	// ページネーション後の分だけコピーする
+	page, err := paginate(r.sentCalls(senderID), offset, limit)
+	if err != nil {
+		return nil, err
 	}
+	return r.copyAll(page), nil
+}
 
-	// limit が 0 の場合は空のスライスを返す
-	if limit == 0 {
-		return []*entity.MorningCall{}, nil
+// FindReadOnlyBySenderID は送信者IDでモーニングコールの読み取り専用ビューを検索する
+func (r *MorningCallRepository) FindReadOnlyBySenderID(ctx context.Context, senderID string, offset, limit int) ([]entity.ReadOnlyMorningCall, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	page, err := paginate(r.sentCalls(senderID), offset, limit)
+	if err != nil {
+		return nil, err
 	}
+	return r.readOnlyAll(page), nil
+}
 
-	// インデックスから該当するIDを取得
-	ids, exists := r.senderIndex[senderID]
-	if !exists || len(ids) == 0 {
-		return []*entity.MorningCall{}, nil
+// FindByReceiverID は受信者IDでモーニングコールを検索する
+func (r *MorningCallRepository) FindByReceiverID(ctx context.Context, receiverID string, offset, limit int) ([]*entity.MorningCall, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// ページネーション後の分だけコピーする
+	page, err := paginate(r.receivedCalls(receiverID), offset, limit)
+	if err != nil {
+		return nil, err
 	}
+	return r.copyAll(page), nil
+}
 
-	// モーニングコールを取得してスケジュール時刻でソート
-	morningCalls := make([]*entity.MorningCall, 0, len(ids))
-	for _, id := range ids {
-		if mc, exists := r.morningCalls[id]; exists {
-			morningCalls = append(morningCalls, r.copyMorningCall(mc))
+// FindByReceiverIDAndArchived は受信者IDとアーカイブ状態でモーニングコールを検索する
+func (r *MorningCallRepository) FindByReceiverIDAndArchived(ctx context.Context, receiverID string, archived bool, offset, limit int) ([]*entity.MorningCall, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	matched := make([]*entity.MorningCall, 0)
+	for _, mc := range r.receivedCalls(receiverID) {
+		if mc.Archived == archived {
+			matched = append(matched, mc)
 		}
 	}
 
-	// スケジュール時刻でソート（降順：新しいものが先）
-	sort.Slice(morningCalls, func(i, j int) bool {
-		return morningCalls[i].ScheduledTime.After(morningCalls[j].ScheduledTime)
-	})
-
-	// ページネーション処理
-	return r.paginate(morningCalls, offset, limit), nil
+	page, err := paginate(matched, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return r.copyAll(page), nil
 }
 
-// FindByReceiverID は受信者IDでモーニングコールを検索する
-func (r *MorningCallRepository) FindByReceiverID(ctx context.Context, receiverID string, offset, limit int) ([]*entity.MorningCall, error) {
+// FindReadOnlyByReceiverID は受信者IDでモーニングコールの読み取り専用ビューを検索する
+func (r *MorningCallRepository) FindReadOnlyByReceiverID(ctx context.Context, receiverID string, offset, limit int) ([]entity.ReadOnlyMorningCall, error) {
 	_ = ctx // 将来的なDB実装のために保持
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	if offset < 0 || limit < 0 {
-		return nil, repository.ErrInvalidArgument
+	page, err := paginate(r.receivedCalls(receiverID), offset, limit)
+	if err != nil {
+		return nil, err
 	}
+	return r.readOnlyAll(page), nil
+}
 
-	// limit が 0 の場合は空のスライスを返す
-	if limit == 0 {
-		return []*entity.MorningCall{}, nil
-	}
+// FindByStatus はステータスでモーニングコールを検索する
+func (r *MorningCallRepository) FindByStatus(ctx context.Context, status valueobject.MorningCallStatus, offset, limit int) ([]*entity.MorningCall, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 
 	// インデックスから該当するIDを取得
-	ids, exists := r.receiverIndex[receiverID]
-	if !exists || len(ids) == 0 {
-		return []*entity.MorningCall{}, nil
-	}
+	ids := r.statusIndex[status]
 
 	// モーニングコールを取得してスケジュール時刻でソート
 	morningCalls := make([]*entity.MorningCall, 0, len(ids))
@@ -209,36 +291,31 @@ func (r *MorningCallRepository) FindByReceiverID(ctx context.Context, receiverID
 	})
 
 	// ページネーション処理
-	return r.paginate(morningCalls, offset, limit), nil
+	return paginate(morningCalls, offset, limit)
 }
 
-// FindByStatus はステータスでモーニングコールを検索する
-func (r *MorningCallRepository) FindByStatus(ctx context.Context, status valueobject.MorningCallStatus, offset, limit int) ([]*entity.MorningCall, error) {
+// FindByStatusInRange はステータスと予定時刻の範囲（start以上end以下）でモーニングコールを検索する
+func (r *MorningCallRepository) FindByStatusInRange(ctx context.Context, status valueobject.MorningCallStatus, start, end time.Time, offset, limit int) ([]*entity.MorningCall, error) {
 	_ = ctx // 将来的なDB実装のために保持
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	if offset < 0 || limit < 0 {
+	// start と end の妥当性チェック
+	if start.After(end) {
 		return nil, repository.ErrInvalidArgument
 	}
 
-	// limit が 0 の場合は空のスライスを返す
-	if limit == 0 {
-		return []*entity.MorningCall{}, nil
-	}
-
-	// インデックスから該当するIDを取得
-	ids, exists := r.statusIndex[status]
-	if !exists || len(ids) == 0 {
-		return []*entity.MorningCall{}, nil
-	}
-
-	// モーニングコールを取得してスケジュール時刻でソート
-	morningCalls := make([]*entity.MorningCall, 0, len(ids))
-	for _, id := range ids {
-		if mc, exists := r.morningCalls[id]; exists {
-			morningCalls = append(morningCalls, r.copyMorningCall(mc))
+	// ステータスインデックスから候補を取得し、予定時刻で絞り込む
+	morningCalls := make([]*entity.MorningCall, 0)
+	for _, id := range r.statusIndex[status] {
+		mc, exists := r.morningCalls[id]
+		if !exists {
+			continue
+		}
+		if mc.ScheduledTime.Before(start) || mc.ScheduledTime.After(end) {
+			continue
 		}
+		morningCalls = append(morningCalls, r.copyMorningCall(mc))
 	}
 
 	// スケジュール時刻でソート（昇順：直近のものが先）
@@ -247,7 +324,7 @@ func (r *MorningCallRepository) FindByStatus(ctx context.Context, status valueob
 	})
 
 	// ページネーション処理
-	return r.paginate(morningCalls, offset, limit), nil
+	return paginate(morningCalls, offset, limit)
 }
 
 // FindScheduledBefore は指定時刻より前にスケジュールされたモーニングコールを検索する
@@ -256,15 +333,6 @@ func (r *MorningCallRepository) FindScheduledBefore(ctx context.Context, t time.
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	if offset < 0 || limit < 0 {
-		return nil, repository.ErrInvalidArgument
-	}
-
-	// limit が 0 の場合は空のスライスを返す
-	if limit == 0 {
-		return []*entity.MorningCall{}, nil
-	}
-
 	// 条件に該当するモーニングコールを収集
 	morningCalls := make([]*entity.MorningCall, 0)
 	for _, mc := range r.morningCalls {
@@ -279,7 +347,7 @@ func (r *MorningCallRepository) FindScheduledBefore(ctx context.Context, t time.
 	})
 
 	// ページネーション処理
-	return r.paginate(morningCalls, offset, limit), nil
+	return paginate(morningCalls, offset, limit)
 }
 
 // FindScheduledBetween は指定期間内にスケジュールされたモーニングコールを検索する
@@ -288,15 +356,6 @@ func (r *MorningCallRepository) FindScheduledBetween(ctx context.Context, start,
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	if offset < 0 || limit < 0 {
-		return nil, repository.ErrInvalidArgument
-	}
-
-	// limit が 0 の場合は空のスライスを返す
-	if limit == 0 {
-		return []*entity.MorningCall{}, nil
-	}
-
 	// start と end の妥当性チェック
 	if start.After(end) {
 		return nil, repository.ErrInvalidArgument
@@ -317,7 +376,7 @@ func (r *MorningCallRepository) FindScheduledBetween(ctx context.Context, start,
 	})
 
 	// ページネーション処理
-	return r.paginate(morningCalls, offset, limit), nil
+	return paginate(morningCalls, offset, limit)
 }
 
 // FindActiveByUserPair は特定の送信者から受信者へのアクティブなモーニングコールを検索する
@@ -340,9 +399,64 @@ func (r *MorningCallRepository) FindActiveByUserPair(ctx context.Context, sender
 	morningCalls := make([]*entity.MorningCall, 0)
 	for _, id := range ids {
 		if mc, exists := r.morningCalls[id]; exists {
-			// アクティブなステータスの判定（scheduled または delivered）
-			if mc.Status == valueobject.MorningCallStatusScheduled ||
-				mc.Status == valueobject.MorningCallStatusDelivered {
+			if mc.IsActive() {
+				morningCalls = append(morningCalls, r.copyMorningCall(mc))
+			}
+		}
+	}
+
+	// スケジュール時刻でソート（昇順：直近のものが先）
+	sort.Slice(morningCalls, func(i, j int) bool {
+		return morningCalls[i].ScheduledTime.Before(morningCalls[j].ScheduledTime)
+	})
+
+	return morningCalls, nil
+}
+
+// FindBySeriesID はシリーズIDでモーニングコールを検索する
+func (r *MorningCallRepository) FindBySeriesID(ctx context.Context, seriesID string) ([]*entity.MorningCall, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids, exists := r.seriesIndex[seriesID]
+	if seriesID == "" || !exists || len(ids) == 0 {
+		return []*entity.MorningCall{}, nil
+	}
+
+	morningCalls := make([]*entity.MorningCall, 0, len(ids))
+	for _, id := range ids {
+		if mc, exists := r.morningCalls[id]; exists {
+			morningCalls = append(morningCalls, r.copyMorningCall(mc))
+		}
+	}
+
+	// スケジュール時刻でソート（昇順：直近のものが先）
+	sort.Slice(morningCalls, func(i, j int) bool {
+		return morningCalls[i].ScheduledTime.Before(morningCalls[j].ScheduledTime)
+	})
+
+	return morningCalls, nil
+}
+
+// FindActiveBetweenUsers は2人のユーザー間のアクティブなモーニングコールを送信方向を問わず検索する
+// 結果は方向が混在した状態でスケジュール時刻の昇順に並ぶ
+func (r *MorningCallRepository) FindActiveBetweenUsers(ctx context.Context, userID1, userID2 string) ([]*entity.MorningCall, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// 両方向のインデックスキーを生成（同一ユーザーの場合は1つのみ）
+	pairKeys := []string{r.generateUserPairKey(userID1, userID2)}
+	if userID1 != userID2 {
+		pairKeys = append(pairKeys, r.generateUserPairKey(userID2, userID1))
+	}
+
+	// アクティブなモーニングコールのみを収集
+	morningCalls := make([]*entity.MorningCall, 0)
+	for _, pairKey := range pairKeys {
+		for _, id := range r.userPairIndex[pairKey] {
+			if mc, exists := r.morningCalls[id]; exists && mc.IsActive() {
 				morningCalls = append(morningCalls, r.copyMorningCall(mc))
 			}
 		}
@@ -398,21 +512,44 @@ func (r *MorningCallRepository) CountByStatus(ctx context.Context, status valueo
 	return len(ids), nil
 }
 
-// FindAll はすべてのモーニングコールを取得する（ページネーション対応）
-func (r *MorningCallRepository) FindAll(ctx context.Context, offset, limit int) ([]*entity.MorningCall, error) {
+// CountByReceiverIDAndStatus は受信者IDとステータスでモーニングコール数を取得する
+func (r *MorningCallRepository) CountByReceiverIDAndStatus(ctx context.Context, receiverID string, status valueobject.MorningCallStatus) (int, error) {
 	_ = ctx // 将来的なDB実装のために保持
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	if offset < 0 || limit < 0 {
-		return nil, repository.ErrInvalidArgument
+	count := 0
+	for _, id := range r.receiverIndex[receiverID] {
+		if mc, exists := r.morningCalls[id]; exists && mc.Status == status {
+			count++
+		}
 	}
 
-	// limit が 0 の場合は空のスライスを返す
-	if limit == 0 {
-		return []*entity.MorningCall{}, nil
+	return count, nil
+}
+
+// CountByReceiverIDAndArchived は受信者IDとアーカイブ状態でモーニングコール数を取得する
+func (r *MorningCallRepository) CountByReceiverIDAndArchived(ctx context.Context, receiverID string, archived bool) (int, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	count := 0
+	for _, id := range r.receiverIndex[receiverID] {
+		if mc, exists := r.morningCalls[id]; exists && mc.Archived == archived {
+			count++
+		}
 	}
 
+	return count, nil
+}
+
+// FindAll はすべてのモーニングコールを取得する（ページネーション対応）
+func (r *MorningCallRepository) FindAll(ctx context.Context, offset, limit int) ([]*entity.MorningCall, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	// すべてのモーニングコールをスライスに変換
 	morningCalls := make([]*entity.MorningCall, 0, len(r.morningCalls))
 	for _, mc := range r.morningCalls {
@@ -425,7 +562,49 @@ func (r *MorningCallRepository) FindAll(ctx context.Context, offset, limit int)
 	})
 
 	// ページネーション処理
-	return r.paginate(morningCalls, offset, limit), nil
+	return paginate(morningCalls, offset, limit)
+}
+
+// FindAllExcludingStatuses は指定したステータスを除いたモーニングコールを取得する（ページネーション対応）
+func (r *MorningCallRepository) FindAllExcludingStatuses(ctx context.Context, exclude []valueobject.MorningCallStatus, offset, limit int) ([]*entity.MorningCall, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	excluded := r.statusSet(exclude)
+	morningCalls := make([]*entity.MorningCall, 0, len(r.morningCalls))
+	for _, mc := range r.morningCalls {
+		if excluded[mc.Status] {
+			continue
+		}
+		morningCalls = append(morningCalls, r.copyMorningCall(mc))
+	}
+
+	// IDでソートして一貫した順序を保証（FindAllと同じ順序）
+	sort.Slice(morningCalls, func(i, j int) bool {
+		return morningCalls[i].ID < morningCalls[j].ID
+	})
+
+	// ページネーション処理
+	return paginate(morningCalls, offset, limit)
+}
+
+// CountExcludingStatuses は指定したステータスを除いたモーニングコール数を取得する
+func (r *MorningCallRepository) CountExcludingStatuses(ctx context.Context, exclude []valueobject.MorningCallStatus) (int, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// FindAllExcludingStatusesの結果と件数が一致するよう同じ条件で数える
+	excluded := r.statusSet(exclude)
+	count := 0
+	for _, mc := range r.morningCalls {
+		if !excluded[mc.Status] {
+			count++
+		}
+	}
+
+	return count, nil
 }
 
 // Count は総モーニングコール数を取得する
@@ -437,18 +616,155 @@ func (r *MorningCallRepository) Count(ctx context.Context) (int, error) {
 	return len(r.morningCalls), nil
 }
 
+// CountConfirmationsByUser は予定時刻が期間内の起床確認済みコールをユーザーごとに数える
+func (r *MorningCallRepository) CountConfirmationsByUser(ctx context.Context, start, end time.Time) (repository.ConfirmationCounts, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	counts := repository.ConfirmationCounts{
+		Woken:  make(map[string]int),
+		Wakers: make(map[string]int),
+	}
+	startKey, endKey := confirmationHour(start), confirmationHour(end)
+	if startKey >= endKey {
+		return counts, nil
+	}
+
+	add := func(bucket *confirmationBucket) {
+		for id, n := range bucket.woken {
+			counts.Woken[id] += n
+		}
+		for id, n := range bucket.wakers {
+			counts.Wakers[id] += n
+		}
+	}
+	// 期間の時間数とカウンタの数の少ない方を走査する
+	if (endKey-startKey)/confirmationHourSeconds > int64(len(r.confirmCounters)) {
+		for key, bucket := range r.confirmCounters {
+			if key >= startKey && key < endKey {
+				add(bucket)
+			}
+		}
+		return counts, nil
+	}
+	for key := startKey; key < endKey; key += confirmationHourSeconds {
+		if bucket, exists := r.confirmCounters[key]; exists {
+			add(bucket)
+		}
+	}
+	return counts, nil
+}
+
+// Stats は総モーニングコール数とステータス別の内訳をまとめて取得する
+func (r *MorningCallRepository) Stats(ctx context.Context) (repository.RepositoryStats, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	breakdown := make(map[string]int, len(r.statusIndex)+1)
+	for status, ids := range r.statusIndex {
+		if len(ids) > 0 {
+			breakdown[status.String()] = len(ids)
+		}
+	}
+
+	// 自動確認されたコールは通常の確認と区別し、confirmedから除いて数える
+	// 受信者が確認したコールは確認方法別にも数える（記録前のコールはボタン扱い）
+	autoConfirmed := 0
+	confirmMethods := make(map[string]int)
+	for _, id := range r.statusIndex[valueobject.MorningCallStatusConfirmed] {
+		mc, exists := r.morningCalls[id]
+		if !exists {
+			continue
+		}
+		if mc.AutoConfirmed {
+			autoConfirmed++
+			continue
+		}
+		confirmMethods[mc.ConfirmMethod.OrDefault().String()]++
+	}
+	if autoConfirmed > 0 {
+		breakdown[repository.StatsKeyAutoConfirmed] = autoConfirmed
+		breakdown[valueobject.MorningCallStatusConfirmed.String()] -= autoConfirmed
+		if breakdown[valueobject.MorningCallStatusConfirmed.String()] == 0 {
+			delete(breakdown, valueobject.MorningCallStatusConfirmed.String())
+		}
+	}
+
+	return repository.RepositoryStats{
+		Total:          len(r.morningCalls),
+		Breakdown:      breakdown,
+		ConfirmMethods: confirmMethods,
+	}, nil
+}
+
+// statusSet はステータスのリストを重複のない集合に変換する
+func (r *MorningCallRepository) statusSet(statuses []valueobject.MorningCallStatus) map[valueobject.MorningCallStatus]bool {
+	set := make(map[valueobject.MorningCallStatus]bool, len(statuses))
+	for _, status := range statuses {
+		set[status] = true
+	}
+	return set
+}
+
+// sentCalls は送信者のモーニングコールをスケジュール時刻の降順（新しいものが先、同時刻はIDの昇順）で返す
+// ページ単位で読み進めても漏れや重複がないよう、同時刻のコールも毎回同じ順序にする
+// 保持しているエンティティをそのまま返すため、呼び出し側でロックを取得し、返却前にコピーかビューにすること
+func (r *MorningCallRepository) sentCalls(senderID string) []*entity.MorningCall {
+	morningCalls := r.lookup(r.senderIndex[senderID])
+	sort.Slice(morningCalls, func(i, j int) bool {
+		if !morningCalls[i].ScheduledTime.Equal(morningCalls[j].ScheduledTime) {
+			return morningCalls[i].ScheduledTime.After(morningCalls[j].ScheduledTime)
+		}
+		return morningCalls[i].ID < morningCalls[j].ID
+	})
+	return morningCalls
+}
+
+// receivedCalls は受信者のモーニングコールをスケジュール時刻の昇順（直近のものが先）で返す
+// 保持しているエンティティをそのまま返すため、呼び出し側でロックを取得し、返却前にコピーかビューにすること
+func (r *MorningCallRepository) receivedCalls(receiverID string) []*entity.MorningCall {
+	morningCalls := r.lookup(r.receiverIndex[receiverID])
+	sort.Slice(morningCalls, func(i, j int) bool {
+		return morningCalls[i].ScheduledTime.Before(morningCalls[j].ScheduledTime)
+	})
+	return morningCalls
+}
+
+// lookup はIDに対応する保持中のモーニングコールを返す（コピーしない）
+func (r *MorningCallRepository) lookup(ids []string) []*entity.MorningCall {
+	morningCalls := make([]*entity.MorningCall, 0, len(ids))
+	for _, id := range ids {
+		if mc, exists := r.morningCalls[id]; exists {
+			morningCalls = append(morningCalls, mc)
+		}
+	}
+	return morningCalls
+}
+
+// copyAll はモーニングコールをそれぞれディープコピーしたスライスを返す
+func (r *MorningCallRepository) copyAll(morningCalls []*entity.MorningCall) []*entity.MorningCall {
+	result := make([]*entity.MorningCall, 0, len(morningCalls))
+	for _, mc := range morningCalls {
+		result = append(result, r.copyMorningCall(mc))
+	}
+	return result
+}
+
+// readOnlyAll はモーニングコールをコピーせずに読み取り専用ビューへ変換する
+// 保持しているエンティティは更新時に差し替えるだけで書き換えないため、ビューから参照しても安全
+func (r *MorningCallRepository) readOnlyAll(morningCalls []*entity.MorningCall) []entity.ReadOnlyMorningCall {
+	result := make([]entity.ReadOnlyMorningCall, 0, len(morningCalls))
+	for _, mc := range morningCalls {
+		result = append(result, entity.NewReadOnlyMorningCall(mc))
+	}
+	return result
+}
+
 // copyMorningCall はモーニングコールエンティティのディープコピーを作成する
 func (r *MorningCallRepository) copyMorningCall(mc *entity.MorningCall) *entity.MorningCall {
-	return &entity.MorningCall{
-		ID:            mc.ID,
-		SenderID:      mc.SenderID,
-		ReceiverID:    mc.ReceiverID,
-		ScheduledTime: mc.ScheduledTime,
-		Message:       mc.Message,
-		Status:        mc.Status,
-		CreatedAt:     mc.CreatedAt,
-		UpdatedAt:     mc.UpdatedAt,
-	}
+	return mc.Clone()
 }
 
 // addToIndexes はモーニングコールを各インデックスに追加する
@@ -465,6 +781,23 @@ func (r *MorningCallRepository) addToIndexes(mc *entity.MorningCall) {
 	// ユーザーペアインデックス
 	pairKey := r.generateUserPairKey(mc.SenderID, mc.ReceiverID)
 	r.userPairIndex[pairKey] = append(r.userPairIndex[pairKey], mc.ID)
+
+	// シリーズインデックス（シリーズに属するコールのみ）
+	if mc.SeriesID != "" {
+		r.seriesIndex[mc.SeriesID] = append(r.seriesIndex[mc.SeriesID], mc.ID)
+	}
+
+	// 起床確認のランキング用カウンタ
+	if countsAsConfirmation(mc) {
+		key := confirmationHour(mc.ScheduledTime)
+		bucket, exists := r.confirmCounters[key]
+		if !exists {
+			bucket = &confirmationBucket{woken: make(map[string]int), wakers: make(map[string]int)}
+			r.confirmCounters[key] = bucket
+		}
+		bucket.woken[mc.ReceiverID]++
+		bucket.wakers[mc.SenderID]++
+	}
 }
 
 // removeFromIndexes はモーニングコールを各インデックスから削除する
@@ -493,6 +826,48 @@ func (r *MorningCallRepository) removeFromIndexes(mc *entity.MorningCall) {
 	if len(r.userPairIndex[pairKey]) == 0 {
 		delete(r.userPairIndex, pairKey)
 	}
+
+	// シリーズインデックスから削除
+	if mc.SeriesID != "" {
+		r.seriesIndex[mc.SeriesID] = r.removeIDFromSlice(r.seriesIndex[mc.SeriesID], mc.ID)
+		if len(r.seriesIndex[mc.SeriesID]) == 0 {
+			delete(r.seriesIndex, mc.SeriesID)
+		}
+	}
+
+	// 起床確認のランキング用カウンタから減算
+	if countsAsConfirmation(mc) {
+		key := confirmationHour(mc.ScheduledTime)
+		if bucket, exists := r.confirmCounters[key]; exists {
+			decrementCount(bucket.woken, mc.ReceiverID)
+			decrementCount(bucket.wakers, mc.SenderID)
+			if len(bucket.woken) == 0 && len(bucket.wakers) == 0 {
+				delete(r.confirmCounters, key)
+			}
+		}
+	}
+}
+
+// confirmationHourSeconds はランキング用カウンタの1区間の秒数
+const confirmationHourSeconds = int64(time.Hour / time.Second)
+
+// countsAsConfirmation は起床確認のランキングで数えるコールかを判定する
+// 受信者が実際に確認していない自動確認と、管理者により削除されたコールは数えない
+func countsAsConfirmation(mc *entity.MorningCall) bool {
+	return mc.Status == valueobject.MorningCallStatusConfirmed && !mc.AutoConfirmed && !mc.IsDeleted()
+}
+
+// confirmationHour はランキング用カウンタのキー（1時間単位に切り捨てたUnix時刻）を返す
+func confirmationHour(t time.Time) int64 {
+	return t.Truncate(time.Hour).Unix()
+}
+
+// decrementCount は件数を1減らし、0になったユーザーは削除する
+func decrementCount(counts map[string]int, id string) {
+	counts[id]--
+	if counts[id] <= 0 {
+		delete(counts, id)
+	}
 }
 
 // removeIDFromSlice はスライスから指定されたIDを削除する
@@ -513,17 +888,58 @@ func (r *MorningCallRepository) generateUserPairKey(senderID, receiverID string)
 	return senderID + ":" + receiverID
 }
 
-// paginate はモーニングコールのスライスにページネーションを適用する
-func (r *MorningCallRepository) paginate(morningCalls []*entity.MorningCall, offset, limit int) []*entity.MorningCall {
-	start := offset
-	if start >= len(morningCalls) {
-		return []*entity.MorningCall{}
+// snapshot は現在の状態を複製した新しいリポジトリを返す（トランザクション用）
+// 保存したエンティティは変更せずに差し替えるため、エンティティは複製せずに共有する
+func (r *MorningCallRepository) snapshot() *MorningCallRepository {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return &MorningCallRepository{
+		morningCalls:  cloneMap(r.morningCalls, 0),
+		senderIndex:   copyIndex(r.senderIndex),
+		receiverIndex: copyIndex(r.receiverIndex),
+		statusIndex:   copyIndex(r.statusIndex),
+		userPairIndex: copyIndex(r.userPairIndex),
+		seriesIndex:   copyIndex(r.seriesIndex),
+		version:       r.version,
+
+		confirmCounters: copyConfirmCounters(r.confirmCounters),
 	}
+}
 
-	end := start + limit
-	if end > len(morningCalls) {
-		end = len(morningCalls)
+// copyConfirmCounters は起床確認のランキング用カウンタを複製する
+func copyConfirmCounters(src map[int64]*confirmationBucket) map[int64]*confirmationBucket {
+	dst := make(map[int64]*confirmationBucket, len(src))
+	for key, bucket := range src {
+		copied := &confirmationBucket{
+			woken:  make(map[string]int, len(bucket.woken)),
+			wakers: make(map[string]int, len(bucket.wakers)),
+		}
+		for id, n := range bucket.woken {
+			copied.woken[id] = n
+		}
+		for id, n := range bucket.wakers {
+			copied.wakers[id] = n
+		}
+		dst[key] = copied
 	}
+	return dst
+}
 
-	return morningCalls[start:end]
+// applyChanges はトランザクション用の複製で作成・更新・削除されたモーニングコールを反映する（呼び出し側でロックを取得すること）
+func (r *MorningCallRepository) applyChanges(src *MorningCallRepository, ids []string) {
+	// 先にすべて取り除いてから追加し、変更したコール同士でインデックスを消し合わないようにする
+	for _, id := range ids {
+		if existing, exists := r.morningCalls[id]; exists {
+			r.removeFromIndexes(existing)
+			delete(r.morningCalls, id)
+		}
+	}
+	for _, id := range ids {
+		if mc, exists := src.morningCalls[id]; exists {
+			r.morningCalls[id] = mc
+			r.addToIndexes(mc)
+		}
+	}
+	r.version++
 }
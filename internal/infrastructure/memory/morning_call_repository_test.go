@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"sort"
 	"testing"
 	"time"
@@ -28,6 +29,15 @@ func createTestMorningCall(id, senderID, receiverID string, scheduledTime time.T
 	}
 }
 
+// morningCallIDs はモーニングコールのIDを順に返す
+func morningCallIDs(mcs []*entity.MorningCall) []string {
+	ids := make([]string, len(mcs))
+	for i, mc := range mcs {
+		ids[i] = mc.ID
+	}
+	return ids
+}
+
 func TestNewMorningCallRepository(t *testing.T) {
 	repo := NewMorningCallRepository()
 
@@ -285,6 +295,44 @@ func TestMorningCallRepository_Update(t *testing.T) {
 	}
 }
 
+func TestMorningCallRepository_Update_OptimisticLock(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMorningCallRepository()
+
+	mc := createTestMorningCall("mc1", "user1", "user2", time.Now().Add(time.Hour), valueobject.MorningCallStatusScheduled)
+	if err := repo.Create(ctx, mc); err != nil {
+		t.Fatalf("Failed to setup test: %v", err)
+	}
+
+	// 同じバージョンを2つのクライアントが取得する
+	first, _ := repo.FindByID(ctx, "mc1")
+	second, _ := repo.FindByID(ctx, "mc1")
+
+	first.Status = valueobject.MorningCallStatusDelivered
+	if err := repo.Update(ctx, first); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if first.Version != 1 {
+		t.Errorf("更新後のVersion = %d, want 1", first.Version)
+	}
+
+	// 古いバージョンでの更新は競合になる
+	second.Status = valueobject.MorningCallStatusCancelled
+	if err := repo.Update(ctx, second); !errors.Is(err, repository.ErrUpdateConflict) {
+		t.Errorf("Update() error = %v, want %v", err, repository.ErrUpdateConflict)
+	}
+	stored, _ := repo.FindByID(ctx, "mc1")
+	if stored.Status != valueobject.MorningCallStatusDelivered {
+		t.Errorf("競合した更新が反映されている: Status = %v", stored.Status)
+	}
+
+	// 更新に成功したエンティティは続けて更新できる
+	first.Message = "updated"
+	if err := repo.Update(ctx, first); err != nil {
+		t.Errorf("続けての Update() error = %v", err)
+	}
+}
+
 func TestMorningCallRepository_Delete(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -799,6 +847,264 @@ func TestMorningCallRepository_FindScheduledBetween(t *testing.T) {
 	}
 }
 
+func TestMorningCallRepository_FindByStatusInRange(t *testing.T) {
+	baseTime := time.Now()
+
+	// 配信済み: mc1(1h), mc2(2h), mc3(3h), mc5(5h) / スケジュール済み: mc4(3h)
+	setup := func(r *MorningCallRepository) {
+		mcs := []*entity.MorningCall{
+			createTestMorningCall("mc3", "user1", "user2", baseTime.Add(3*time.Hour), valueobject.MorningCallStatusDelivered),
+			createTestMorningCall("mc1", "user1", "user2", baseTime.Add(1*time.Hour), valueobject.MorningCallStatusDelivered),
+			createTestMorningCall("mc2", "user1", "user3", baseTime.Add(2*time.Hour), valueobject.MorningCallStatusDelivered),
+			createTestMorningCall("mc4", "user2", "user1", baseTime.Add(3*time.Hour), valueobject.MorningCallStatusScheduled),
+			createTestMorningCall("mc5", "user3", "user2", baseTime.Add(5*time.Hour), valueobject.MorningCallStatusDelivered),
+		}
+		for _, mc := range mcs {
+			r.morningCalls[mc.ID] = mc
+			r.addToIndexes(mc)
+		}
+	}
+
+	tests := []struct {
+		name      string
+		status    valueobject.MorningCallStatus
+		start     time.Time
+		end       time.Time
+		offset    int
+		limit     int
+		setupFunc func(*MorningCallRepository)
+		wantIDs   []string
+		wantErr   error
+	}{
+		{
+			name:      "ステータスと期間の両方で絞り込み（境界を含む）",
+			status:    valueobject.MorningCallStatusDelivered,
+			start:     baseTime.Add(1 * time.Hour),
+			end:       baseTime.Add(3 * time.Hour),
+			offset:    0,
+			limit:     10,
+			setupFunc: setup,
+			wantIDs:   []string{"mc1", "mc2", "mc3"},
+		},
+		{
+			name:      "ページネーション",
+			status:    valueobject.MorningCallStatusDelivered,
+			start:     baseTime,
+			end:       baseTime.Add(6 * time.Hour),
+			offset:    1,
+			limit:     2,
+			setupFunc: setup,
+			wantIDs:   []string{"mc2", "mc3"},
+		},
+		{
+			name:      "オフセットが件数を超える",
+			status:    valueobject.MorningCallStatusDelivered,
+			start:     baseTime,
+			end:       baseTime.Add(6 * time.Hour),
+			offset:    10,
+			limit:     2,
+			setupFunc: setup,
+			wantIDs:   []string{},
+		},
+		{
+			name:      "該当なし",
+			status:    valueobject.MorningCallStatusConfirmed,
+			start:     baseTime,
+			end:       baseTime.Add(6 * time.Hour),
+			offset:    0,
+			limit:     10,
+			setupFunc: setup,
+			wantIDs:   []string{},
+		},
+		{
+			name:      "開始時刻が終了時刻より後",
+			status:    valueobject.MorningCallStatusDelivered,
+			start:     baseTime.Add(4 * time.Hour),
+			end:       baseTime.Add(2 * time.Hour),
+			offset:    0,
+			limit:     10,
+			setupFunc: setup,
+			wantErr:   repository.ErrInvalidArgument,
+		},
+		{
+			name:      "負のオフセット",
+			status:    valueobject.MorningCallStatusDelivered,
+			start:     baseTime,
+			end:       baseTime.Add(6 * time.Hour),
+			offset:    -1,
+			limit:     10,
+			setupFunc: setup,
+			wantErr:   repository.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewMorningCallRepository()
+			tt.setupFunc(repo)
+
+			got, err := repo.FindByStatusInRange(context.Background(), tt.status, tt.start, tt.end, tt.offset, tt.limit)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FindByStatusInRange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			gotIDs := make([]string, len(got))
+			for i, mc := range got {
+				gotIDs[i] = mc.ID
+			}
+			if fmt.Sprint(gotIDs) != fmt.Sprint(tt.wantIDs) {
+				t.Errorf("FindByStatusInRange() = %v, want %v", gotIDs, tt.wantIDs)
+			}
+		})
+	}
+}
+
+func TestMorningCallRepository_FindBySeriesID(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMorningCallRepository()
+	base := time.Now().Add(time.Hour)
+
+	mcs := []*entity.MorningCall{
+		createTestMorningCall("mc1", "user1", "user2", base.Add(48*time.Hour), valueobject.MorningCallStatusScheduled),
+		createTestMorningCall("mc2", "user1", "user2", base, valueobject.MorningCallStatusScheduled),
+		createTestMorningCall("mc3", "user1", "user2", base.Add(24*time.Hour), valueobject.MorningCallStatusScheduled),
+		createTestMorningCall("single", "user1", "user2", base, valueobject.MorningCallStatusScheduled),
+	}
+	for _, mc := range mcs[:3] {
+		mc.SeriesID = "series1"
+	}
+	for _, mc := range mcs {
+		if err := repo.Create(ctx, mc); err != nil {
+			t.Fatalf("Create() unexpected error = %v", err)
+		}
+	}
+
+	got, err := repo.FindBySeriesID(ctx, "series1")
+	if err != nil {
+		t.Fatalf("FindBySeriesID() unexpected error = %v", err)
+	}
+	// アラーム時刻の昇順で返す
+	wantIDs := []string{"mc2", "mc3", "mc1"}
+	if len(got) != len(wantIDs) {
+		t.Fatalf("FindBySeriesID() = %d calls, want %d", len(got), len(wantIDs))
+	}
+	for i, id := range wantIDs {
+		if got[i].ID != id {
+			t.Errorf("FindBySeriesID()[%d] = %s, want %s", i, got[i].ID, id)
+		}
+	}
+
+	// 削除したコールはシリーズから外れる
+	if err := repo.Delete(ctx, "mc3"); err != nil {
+		t.Fatalf("Delete() unexpected error = %v", err)
+	}
+	got, _ = repo.FindBySeriesID(ctx, "series1")
+	if len(got) != 2 {
+		t.Errorf("FindBySeriesID() after delete = %d calls, want 2", len(got))
+	}
+
+	// シリーズに属さないコールは空のシリーズIDで検索されない
+	if got, _ := repo.FindBySeriesID(ctx, ""); len(got) != 0 {
+		t.Errorf("FindBySeriesID(\"\") = %d calls, want 0", len(got))
+	}
+}
+
+func TestMorningCallRepository_FindReadOnly(t *testing.T) {
+	ctx := context.Background()
+	base := time.Now().Add(time.Hour)
+	setup := func(t *testing.T) *MorningCallRepository {
+		t.Helper()
+		repo := NewMorningCallRepository()
+		for i := 0; i < 3; i++ {
+			mc := createTestMorningCall(fmt.Sprintf("mc%d", i), "user1", "user2", base.Add(time.Duration(i)*time.Hour), valueobject.MorningCallStatusScheduled)
+			if err := repo.Create(ctx, mc); err != nil {
+				t.Fatalf("Create() unexpected error = %v", err)
+			}
+		}
+		return repo
+	}
+
+	t.Run("コピーを返す検索と同じ並び順とページネーションになる", func(t *testing.T) {
+		repo := setup(t)
+		for _, tc := range []struct {
+			name     string
+			copies   func() ([]*entity.MorningCall, error)
+			readOnly func() ([]entity.ReadOnlyMorningCall, error)
+		}{
+			{
+				name:     "送信者",
+				copies:   func() ([]*entity.MorningCall, error) { return repo.FindBySenderID(ctx, "user1", 1, 2) },
+				readOnly: func() ([]entity.ReadOnlyMorningCall, error) { return repo.FindReadOnlyBySenderID(ctx, "user1", 1, 2) },
+			},
+			{
+				name:     "受信者",
+				copies:   func() ([]*entity.MorningCall, error) { return repo.FindByReceiverID(ctx, "user2", 1, 2) },
+				readOnly: func() ([]entity.ReadOnlyMorningCall, error) { return repo.FindReadOnlyByReceiverID(ctx, "user2", 1, 2) },
+			},
+		} {
+			copies, err := tc.copies()
+			if err != nil {
+				t.Fatalf("%s: unexpected error = %v", tc.name, err)
+			}
+			views, err := tc.readOnly()
+			if err != nil {
+				t.Fatalf("%s: unexpected error = %v", tc.name, err)
+			}
+			if len(views) != len(copies) {
+				t.Fatalf("%s: got %d views, want %d", tc.name, len(views), len(copies))
+			}
+			for i := range copies {
+				if views[i].ID() != copies[i].ID {
+					t.Errorf("%s: views[%d].ID() = %s, want %s", tc.name, i, views[i].ID(), copies[i].ID)
+				}
+			}
+		}
+	})
+
+	t.Run("不正なページネーション", func(t *testing.T) {
+		repo := setup(t)
+		if _, err := repo.FindReadOnlyBySenderID(ctx, "user1", -1, 10); !errors.Is(err, repository.ErrInvalidArgument) {
+			t.Errorf("FindReadOnlyBySenderID() error = %v, want ErrInvalidArgument", err)
+		}
+		if got, _ := repo.FindReadOnlyByReceiverID(ctx, "user2", 0, 0); len(got) != 0 {
+			t.Errorf("FindReadOnlyByReceiverID() with limit 0 = %d views, want 0", len(got))
+		}
+	})
+
+	t.Run("Cloneしたコピーを変更してもリポジトリに影響しない", func(t *testing.T) {
+		repo := setup(t)
+		views, _ := repo.FindReadOnlyBySenderID(ctx, "user1", 0, 1)
+		mc := views[0].Clone()
+		mc.Message = "changed"
+
+		if views[0].Message() == "changed" {
+			t.Error("ReadOnlyMorningCall should not reflect changes to its clone")
+		}
+		stored, _ := repo.FindByID(ctx, mc.ID)
+		if stored.Message == "changed" {
+			t.Error("Clone() should return a copy")
+		}
+	})
+
+	t.Run("取得後の更新は取得済みのビューに影響しない", func(t *testing.T) {
+		repo := setup(t)
+		views, _ := repo.FindReadOnlyBySenderID(ctx, "user1", 0, 1)
+		mc := views[0].Clone()
+		mc.Message = "updated"
+		if err := repo.Update(ctx, mc); err != nil {
+			t.Fatalf("Update() unexpected error = %v", err)
+		}
+
+		if views[0].Message() != "Test message" {
+			t.Errorf("view Message() = %q, want the value at the time of retrieval", views[0].Message())
+		}
+	})
+}
+
 func TestMorningCallRepository_FindActiveByUserPair(t *testing.T) {
 	baseTime := time.Now()
 
@@ -882,6 +1188,95 @@ func TestMorningCallRepository_FindActiveByUserPair(t *testing.T) {
 	}
 }
 
+func TestMorningCallRepository_FindActiveBetweenUsers(t *testing.T) {
+	baseTime := time.Now()
+
+	setup := func(r *MorningCallRepository) {
+		mcs := []*entity.MorningCall{
+			createTestMorningCall("mc1", "user1", "user2", baseTime.Add(3*time.Hour), valueobject.MorningCallStatusScheduled),
+			createTestMorningCall("mc2", "user2", "user1", baseTime.Add(1*time.Hour), valueobject.MorningCallStatusScheduled),
+			createTestMorningCall("mc3", "user2", "user1", baseTime.Add(2*time.Hour), valueobject.MorningCallStatusDelivered),
+			createTestMorningCall("mc4", "user1", "user2", baseTime.Add(4*time.Hour), valueobject.MorningCallStatusConfirmed),
+			createTestMorningCall("mc5", "user2", "user1", baseTime.Add(5*time.Hour), valueobject.MorningCallStatusCancelled),
+			createTestMorningCall("mc6", "user1", "user3", baseTime.Add(6*time.Hour), valueobject.MorningCallStatusScheduled),
+			createTestMorningCall("mc7", "user3", "user2", baseTime.Add(7*time.Hour), valueobject.MorningCallStatusScheduled),
+		}
+		for _, mc := range mcs {
+			r.morningCalls[mc.ID] = mc
+			r.addToIndexes(mc)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		userID1 string
+		userID2 string
+		wantIDs []string
+	}{
+		{
+			name:    "双方向のアクティブなコールを時刻順に取得",
+			userID1: "user1",
+			userID2: "user2",
+			wantIDs: []string{"mc2", "mc3", "mc1"},
+		},
+		{
+			name:    "引数の順序を入れ替えても同じ結果",
+			userID1: "user2",
+			userID2: "user1",
+			wantIDs: []string{"mc2", "mc3", "mc1"},
+		},
+		{
+			name:    "一方向のみのペア",
+			userID1: "user2",
+			userID2: "user3",
+			wantIDs: []string{"mc7"},
+		},
+		{
+			name:    "コールがないペア",
+			userID1: "user1",
+			userID2: "user4",
+			wantIDs: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewMorningCallRepository()
+			setup(repo)
+
+			got, err := repo.FindActiveBetweenUsers(context.Background(), tt.userID1, tt.userID2)
+			if err != nil {
+				t.Fatalf("FindActiveBetweenUsers() unexpected error = %v", err)
+			}
+
+			if len(got) != len(tt.wantIDs) {
+				t.Fatalf("FindActiveBetweenUsers() returned %d items, want %d", len(got), len(tt.wantIDs))
+			}
+			for i, mc := range got {
+				if mc.ID != tt.wantIDs[i] {
+					t.Errorf("FindActiveBetweenUsers()[%d].ID = %v, want %v", i, mc.ID, tt.wantIDs[i])
+				}
+			}
+		})
+	}
+
+	t.Run("返却値を変更してもリポジトリに影響しない", func(t *testing.T) {
+		repo := NewMorningCallRepository()
+		setup(repo)
+
+		got, err := repo.FindActiveBetweenUsers(context.Background(), "user1", "user2")
+		if err != nil {
+			t.Fatalf("FindActiveBetweenUsers() unexpected error = %v", err)
+		}
+		got[0].Message = "changed"
+
+		stored, _ := repo.FindByID(context.Background(), got[0].ID)
+		if stored.Message == "changed" {
+			t.Error("FindActiveBetweenUsers() should return copies")
+		}
+	})
+}
+
 func TestMorningCallRepository_Count(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -926,6 +1321,234 @@ func TestMorningCallRepository_Count(t *testing.T) {
 	}
 }
 
+func TestMorningCallRepository_Stats(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMorningCallRepository()
+
+	// 空の場合
+	stats, err := repo.Stats(ctx)
+	if err != nil {
+		t.Fatalf("Stats() unexpected error = %v", err)
+	}
+	if stats.Total != 0 || len(stats.Breakdown) != 0 {
+		t.Errorf("Stats() = %+v, want empty", stats)
+	}
+
+	mcs := []*entity.MorningCall{
+		createTestMorningCall("mc1", "user1", "user2", time.Now().Add(time.Hour), valueobject.MorningCallStatusScheduled),
+		createTestMorningCall("mc2", "user1", "user3", time.Now().Add(2*time.Hour), valueobject.MorningCallStatusScheduled),
+		createTestMorningCall("mc3", "user2", "user1", time.Now().Add(3*time.Hour), valueobject.MorningCallStatusDelivered),
+		createTestMorningCall("mc4", "user3", "user1", time.Now().Add(4*time.Hour), valueobject.MorningCallStatusPendingApproval),
+	}
+	for _, mc := range mcs {
+		if err := repo.Create(ctx, mc); err != nil {
+			t.Fatalf("Create() unexpected error = %v", err)
+		}
+	}
+
+	// ステータス変更と削除後も個別のCountと一致する
+	updated, err := repo.FindByID(ctx, "mc2")
+	if err != nil {
+		t.Fatalf("FindByID() unexpected error = %v", err)
+	}
+	updated.Status = valueobject.MorningCallStatusCancelled
+	if err := repo.Update(ctx, updated); err != nil {
+		t.Fatalf("Update() unexpected error = %v", err)
+	}
+	if err := repo.Delete(ctx, "mc3"); err != nil {
+		t.Fatalf("Delete() unexpected error = %v", err)
+	}
+
+	stats, err = repo.Stats(ctx)
+	if err != nil {
+		t.Fatalf("Stats() unexpected error = %v", err)
+	}
+
+	total, _ := repo.Count(ctx)
+	if stats.Total != total || stats.Total != 3 {
+		t.Errorf("Stats().Total = %d, Count() = %d, want 3", stats.Total, total)
+	}
+
+	breakdownSum := 0
+	for _, status := range []valueobject.MorningCallStatus{
+		valueobject.MorningCallStatusPendingApproval,
+		valueobject.MorningCallStatusScheduled,
+		valueobject.MorningCallStatusDelivered,
+		valueobject.MorningCallStatusConfirmed,
+		valueobject.MorningCallStatusCancelled,
+		valueobject.MorningCallStatusExpired,
+		valueobject.MorningCallStatusSkipped,
+		valueobject.MorningCallStatusRejected,
+	} {
+		count, _ := repo.CountByStatus(ctx, status)
+		if stats.Breakdown[status.String()] != count {
+			t.Errorf("Stats().Breakdown[%s] = %d, CountByStatus() = %d", status, stats.Breakdown[status.String()], count)
+		}
+		breakdownSum += stats.Breakdown[status.String()]
+	}
+	if breakdownSum != stats.Total {
+		t.Errorf("sum of Breakdown = %d, want %d", breakdownSum, stats.Total)
+	}
+	if _, ok := stats.Breakdown[valueobject.MorningCallStatusDelivered.String()]; ok {
+		t.Error("Breakdown should not contain statuses with no entries")
+	}
+}
+
+func TestMorningCallRepository_Stats_ConfirmMethods(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMorningCallRepository()
+
+	newConfirmed := func(id string, method valueobject.ConfirmMethod, auto bool) *entity.MorningCall {
+		mc := createTestMorningCall(id, "user1", "user2", time.Now().Add(-time.Hour), valueobject.MorningCallStatusConfirmed)
+		mc.ConfirmMethod = method
+		mc.AutoConfirmed = auto
+		return mc
+	}
+	for _, mc := range []*entity.MorningCall{
+		newConfirmed("mc1", valueobject.ConfirmMethodSwipe, false),
+		newConfirmed("mc2", valueobject.ConfirmMethodSwipe, false),
+		newConfirmed("mc3", valueobject.ConfirmMethodShake, false),
+		newConfirmed("mc4", "", false), // 確認方法の記録前のコール
+		newConfirmed("mc5", "", true),
+		createTestMorningCall("mc6", "user1", "user2", time.Now().Add(time.Hour), valueobject.MorningCallStatusScheduled),
+	} {
+		if err := repo.Create(ctx, mc); err != nil {
+			t.Fatalf("Create() unexpected error = %v", err)
+		}
+	}
+
+	stats, err := repo.Stats(ctx)
+	if err != nil {
+		t.Fatalf("Stats() unexpected error = %v", err)
+	}
+
+	// 自動確認は含めず、記録前のコールはボタン扱いで数える
+	want := map[string]int{
+		valueobject.ConfirmMethodSwipe.String():  2,
+		valueobject.ConfirmMethodShake.String():  1,
+		valueobject.ConfirmMethodButton.String(): 1,
+	}
+	if !reflect.DeepEqual(stats.ConfirmMethods, want) {
+		t.Errorf("Stats().ConfirmMethods = %v, want %v", stats.ConfirmMethods, want)
+	}
+	if stats.Breakdown[valueobject.MorningCallStatusConfirmed.String()] != 4 {
+		t.Errorf("Breakdown[confirmed] = %d, want 4", stats.Breakdown[valueobject.MorningCallStatusConfirmed.String()])
+	}
+}
+
+func TestMorningCallRepository_CountConfirmationsByUser(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMorningCallRepository()
+	base := time.Date(2026, 3, 10, 6, 0, 0, 0, time.UTC)
+
+	confirmed := func(id, senderID, receiverID string, scheduled time.Time) *entity.MorningCall {
+		return createTestMorningCall(id, senderID, receiverID, scheduled, valueobject.MorningCallStatusConfirmed)
+	}
+	autoConfirmed := confirmed("mc_auto", "user1", "user2", base)
+	autoConfirmed.AutoConfirmed = true
+	deleted := confirmed("mc_deleted", "user1", "user2", base)
+	if reason := deleted.DeleteByAdmin("admin", "不適切なメッセージ", base); reason.IsNG() {
+		t.Fatalf("DeleteByAdmin() unexpected reason = %v", reason)
+	}
+
+	mcs := []*entity.MorningCall{
+		confirmed("mc1", "user1", "user2", base),
+		confirmed("mc2", "user1", "user2", base.Add(30*time.Minute)),
+		confirmed("mc3", "user3", "user2", base.Add(24*time.Hour)),
+		confirmed("mc4", "user2", "user1", base.Add(48*time.Hour)),
+		createTestMorningCall("mc5", "user3", "user1", base, valueobject.MorningCallStatusScheduled),
+		autoConfirmed,
+		deleted,
+	}
+	for _, mc := range mcs {
+		if err := repo.Create(ctx, mc); err != nil {
+			t.Fatalf("Create() unexpected error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name       string
+		start, end time.Time
+		wantWoken  map[string]int
+		wantWakers map[string]int
+	}{
+		{
+			name:       "全期間",
+			start:      base.Add(-time.Hour),
+			end:        base.Add(72 * time.Hour),
+			wantWoken:  map[string]int{"user2": 3, "user1": 1},
+			wantWakers: map[string]int{"user1": 2, "user3": 1, "user2": 1},
+		},
+		{
+			name:       "時間単位に切り捨てて集計する",
+			start:      base.Add(45 * time.Minute),
+			end:        base.Add(24*time.Hour + 30*time.Minute),
+			wantWoken:  map[string]int{"user2": 2},
+			wantWakers: map[string]int{"user1": 2},
+		},
+		{
+			name:       "終了時刻は含まない",
+			start:      base.Add(24 * time.Hour),
+			end:        base.Add(48 * time.Hour),
+			wantWoken:  map[string]int{"user2": 1},
+			wantWakers: map[string]int{"user3": 1},
+		},
+		{
+			name:       "開始と終了が逆",
+			start:      base.Add(72 * time.Hour),
+			end:        base,
+			wantWoken:  map[string]int{},
+			wantWakers: map[string]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counts, err := repo.CountConfirmationsByUser(ctx, tt.start, tt.end)
+			if err != nil {
+				t.Fatalf("CountConfirmationsByUser() unexpected error = %v", err)
+			}
+			if !reflect.DeepEqual(counts.Woken, tt.wantWoken) {
+				t.Errorf("Woken = %v, want %v", counts.Woken, tt.wantWoken)
+			}
+			if !reflect.DeepEqual(counts.Wakers, tt.wantWakers) {
+				t.Errorf("Wakers = %v, want %v", counts.Wakers, tt.wantWakers)
+			}
+		})
+	}
+
+	t.Run("更新・削除に追従する", func(t *testing.T) {
+		start, end := base.Add(-time.Hour), base.Add(72*time.Hour)
+
+		scheduled, _ := repo.FindByID(ctx, "mc5")
+		scheduled.Status = valueobject.MorningCallStatusConfirmed
+		if err := repo.Update(ctx, scheduled); err != nil {
+			t.Fatalf("Update() unexpected error = %v", err)
+		}
+		if err := repo.Delete(ctx, "mc1"); err != nil {
+			t.Fatalf("Delete() unexpected error = %v", err)
+		}
+
+		counts, _ := repo.CountConfirmationsByUser(ctx, start, end)
+		if counts.Woken["user1"] != 2 || counts.Woken["user2"] != 2 {
+			t.Errorf("Woken = %v, want user1=2 user2=2", counts.Woken)
+		}
+		if counts.Wakers["user3"] != 2 || counts.Wakers["user1"] != 1 {
+			t.Errorf("Wakers = %v, want user3=2 user1=1", counts.Wakers)
+		}
+
+		// トランザクション用の複製への変更は元に影響しない
+		snap := repo.snapshot()
+		if err := snap.Delete(ctx, "mc2"); err != nil {
+			t.Fatalf("Delete() on snapshot unexpected error = %v", err)
+		}
+		counts, _ = repo.CountConfirmationsByUser(ctx, start, end)
+		if counts.Woken["user2"] != 2 {
+			t.Errorf("Woken[user2] after snapshot change = %d, want 2", counts.Woken["user2"])
+		}
+	})
+}
+
 func TestMorningCallRepository_CountBySenderID(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -1058,6 +1681,113 @@ func TestMorningCallRepository_CountByStatus(t *testing.T) {
 	}
 }
 
+func TestMorningCallRepository_CountByReceiverIDAndStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		receiverID string
+		status     valueobject.MorningCallStatus
+		setupFunc  func(*MorningCallRepository)
+		want       int
+	}{
+		{
+			name:       "受信者とステータスが一致するモーニングコール数",
+			receiverID: "user2",
+			status:     valueobject.MorningCallStatusDelivered,
+			setupFunc: func(r *MorningCallRepository) {
+				mcs := []*entity.MorningCall{
+					createTestMorningCall("mc1", "user1", "user2", time.Now(), valueobject.MorningCallStatusDelivered),
+					createTestMorningCall("mc2", "user3", "user2", time.Now(), valueobject.MorningCallStatusDelivered),
+					createTestMorningCall("mc3", "user1", "user2", time.Now(), valueobject.MorningCallStatusConfirmed),
+					createTestMorningCall("mc4", "user2", "user1", time.Now(), valueobject.MorningCallStatusDelivered),
+				}
+				for _, mc := range mcs {
+					r.morningCalls[mc.ID] = mc
+					r.addToIndexes(mc)
+				}
+			},
+			want: 2,
+		},
+		{
+			name:       "存在しない受信者",
+			receiverID: "nonexistent",
+			status:     valueobject.MorningCallStatusDelivered,
+			setupFunc:  func(r *MorningCallRepository) {},
+			want:       0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewMorningCallRepository()
+			tt.setupFunc(repo)
+
+			got, err := repo.CountByReceiverIDAndStatus(context.Background(), tt.receiverID, tt.status)
+			if err != nil {
+				t.Fatalf("CountByReceiverIDAndStatus() unexpected error = %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("CountByReceiverIDAndStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMorningCallRepository_FindByReceiverIDAndArchived(t *testing.T) {
+	repo := NewMorningCallRepository()
+	baseTime := time.Now()
+	mcs := []*entity.MorningCall{
+		createTestMorningCall("mc1", "user1", "user2", baseTime.Add(3*time.Hour), valueobject.MorningCallStatusConfirmed),
+		createTestMorningCall("mc2", "user3", "user2", baseTime.Add(1*time.Hour), valueobject.MorningCallStatusConfirmed),
+		createTestMorningCall("mc3", "user1", "user2", baseTime.Add(2*time.Hour), valueobject.MorningCallStatusExpired),
+		createTestMorningCall("mc4", "user2", "user1", baseTime.Add(1*time.Hour), valueobject.MorningCallStatusConfirmed),
+	}
+	mcs[0].Archived = true
+	mcs[1].Archived = true
+	mcs[3].Archived = true
+	for _, mc := range mcs {
+		repo.morningCalls[mc.ID] = mc
+		repo.addToIndexes(mc)
+	}
+	ctx := context.Background()
+
+	archived, err := repo.FindByReceiverIDAndArchived(ctx, "user2", true, 0, 10)
+	if err != nil {
+		t.Fatalf("FindByReceiverIDAndArchived() unexpected error = %v", err)
+	}
+	// 受信者のアーカイブ済みのみをアラーム時刻の昇順で返す
+	if len(archived) != 2 || archived[0].ID != "mc2" || archived[1].ID != "mc1" {
+		t.Errorf("FindByReceiverIDAndArchived(true) = %v, want [mc2 mc1]", morningCallIDs(archived))
+	}
+
+	inbox, err := repo.FindByReceiverIDAndArchived(ctx, "user2", false, 0, 10)
+	if err != nil {
+		t.Fatalf("FindByReceiverIDAndArchived() unexpected error = %v", err)
+	}
+	if len(inbox) != 1 || inbox[0].ID != "mc3" {
+		t.Errorf("FindByReceiverIDAndArchived(false) = %v, want [mc3]", morningCallIDs(inbox))
+	}
+
+	paged, err := repo.FindByReceiverIDAndArchived(ctx, "user2", true, 1, 10)
+	if err != nil || len(paged) != 1 || paged[0].ID != "mc1" {
+		t.Errorf("FindByReceiverIDAndArchived(offset=1) = %v, %v, want [mc1]", morningCallIDs(paged), err)
+	}
+
+	if _, err := repo.FindByReceiverIDAndArchived(ctx, "user2", true, -1, 10); !errors.Is(err, repository.ErrInvalidArgument) {
+		t.Errorf("FindByReceiverIDAndArchived(offset=-1) error = %v, want ErrInvalidArgument", err)
+	}
+
+	for archivedFlag, want := range map[bool]int{true: 2, false: 1} {
+		got, err := repo.CountByReceiverIDAndArchived(ctx, "user2", archivedFlag)
+		if err != nil {
+			t.Fatalf("CountByReceiverIDAndArchived() unexpected error = %v", err)
+		}
+		if got != want {
+			t.Errorf("CountByReceiverIDAndArchived(%v) = %d, want %d", archivedFlag, got, want)
+		}
+	}
+}
+
 func TestMorningCallRepository_FindAll(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -1139,6 +1869,100 @@ func TestMorningCallRepository_FindAll(t *testing.T) {
 	}
 }
 
+func TestMorningCallRepository_FindAllExcludingStatuses(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMorningCallRepository()
+
+	statuses := []valueobject.MorningCallStatus{
+		valueobject.MorningCallStatusScheduled,
+		valueobject.MorningCallStatusCancelled,
+		valueobject.MorningCallStatusDelivered,
+		valueobject.MorningCallStatusExpired,
+		valueobject.MorningCallStatusScheduled,
+		valueobject.MorningCallStatusConfirmed,
+		valueobject.MorningCallStatusCancelled,
+	}
+	for i, status := range statuses {
+		mc := createTestMorningCall(fmt.Sprintf("mc%d", i), "user1", "user2", time.Now().Add(time.Duration(i)*time.Hour), status)
+		if err := repo.Create(ctx, mc); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	exclude := []valueobject.MorningCallStatus{
+		valueobject.MorningCallStatusCancelled,
+		valueobject.MorningCallStatusExpired,
+	}
+
+	t.Run("除外したステータスを含まずIDでソートされる", func(t *testing.T) {
+		got, err := repo.FindAllExcludingStatuses(ctx, exclude, 0, 10)
+		if err != nil {
+			t.Fatalf("FindAllExcludingStatuses() error = %v", err)
+		}
+
+		var ids []string
+		for _, mc := range got {
+			ids = append(ids, mc.ID)
+		}
+		want := []string{"mc0", "mc2", "mc4", "mc5"}
+		if !reflect.DeepEqual(ids, want) {
+			t.Errorf("FindAllExcludingStatuses() IDs = %v, want %v", ids, want)
+		}
+	})
+
+	t.Run("ページネーションを併用できる", func(t *testing.T) {
+		var ids []string
+		for offset := 0; ; offset += 3 {
+			page, err := repo.FindAllExcludingStatuses(ctx, exclude, offset, 3)
+			if err != nil {
+				t.Fatalf("FindAllExcludingStatuses() error = %v", err)
+			}
+			for _, mc := range page {
+				ids = append(ids, mc.ID)
+			}
+			if len(page) < 3 {
+				break
+			}
+		}
+		want := []string{"mc0", "mc2", "mc4", "mc5"}
+		if !reflect.DeepEqual(ids, want) {
+			t.Errorf("paginated IDs = %v, want %v", ids, want)
+		}
+	})
+
+	t.Run("総件数は除外後の件数になる", func(t *testing.T) {
+		count, err := repo.CountExcludingStatuses(ctx, exclude)
+		if err != nil {
+			t.Fatalf("CountExcludingStatuses() error = %v", err)
+		}
+		if count != 4 {
+			t.Errorf("CountExcludingStatuses() = %d, want 4", count)
+		}
+	})
+
+	t.Run("除外指定が空の場合は全件と同じ", func(t *testing.T) {
+		got, err := repo.FindAllExcludingStatuses(ctx, nil, 0, 100)
+		if err != nil {
+			t.Fatalf("FindAllExcludingStatuses() error = %v", err)
+		}
+		all, _ := repo.FindAll(ctx, 0, 100)
+		if len(got) != len(all) {
+			t.Errorf("FindAllExcludingStatuses() returned %d items, want %d", len(got), len(all))
+		}
+		count, _ := repo.CountExcludingStatuses(ctx, []valueobject.MorningCallStatus{})
+		total, _ := repo.Count(ctx)
+		if count != total {
+			t.Errorf("CountExcludingStatuses() = %d, want %d", count, total)
+		}
+	})
+
+	t.Run("不正なページネーション", func(t *testing.T) {
+		if _, err := repo.FindAllExcludingStatuses(ctx, exclude, -1, 10); !errors.Is(err, repository.ErrInvalidArgument) {
+			t.Errorf("FindAllExcludingStatuses() error = %v, want %v", err, repository.ErrInvalidArgument)
+		}
+	})
+}
+
 func TestMorningCallRepository_ConcurrentAccess(t *testing.T) {
 	repo := NewMorningCallRepository()
 	ctx := context.Background()
@@ -1256,3 +2080,44 @@ func containsString(slice []string, str string) bool {
 	}
 	return false
 }
+
+// benchmarkFindBySenderSetup は大量取得のベンチマーク用に1人の送信者のコールを登録する
+func benchmarkFindBySenderSetup(b *testing.B, n int) *MorningCallRepository {
+	b.Helper()
+	repo := NewMorningCallRepository()
+	base := time.Now().Add(time.Hour)
+	deadline := base.Add(time.Hour)
+	for i := 0; i < n; i++ {
+		mc := createTestMorningCall(fmt.Sprintf("mc%d", i), "user1", fmt.Sprintf("user%d", i%10+2), base.Add(time.Duration(i)*time.Minute), valueobject.MorningCallStatusScheduled)
+		mc.ConfirmDeadline = &deadline
+		if err := repo.Create(context.Background(), mc); err != nil {
+			b.Fatalf("Create() unexpected error = %v", err)
+		}
+	}
+	return repo
+}
+
+// 大量取得でのアロケーションを比較する
+//
+//	go test ./internal/infrastructure/memory -run '^$' -bench BySenderID -benchmem
+func BenchmarkMorningCallRepository_FindBySenderID(b *testing.B) {
+	repo := benchmarkFindBySenderSetup(b, 1000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := repo.FindBySenderID(context.Background(), "user1", 0, 1000); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMorningCallRepository_FindReadOnlyBySenderID(b *testing.B) {
+	repo := benchmarkFindBySenderSetup(b, 1000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := repo.FindReadOnlyBySenderID(context.Background(), "user1", 0, 1000); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package memory
+
+import "github.com/ochamu/morning-call-api/internal/domain/repository"
+
+// paginate はスライスにoffset/limitによるページネーションを適用する
+// offsetまたはlimitが負の場合はErrInvalidArgumentを返し、limitが0またはoffsetが範囲外の場合は空のスライスを返す
+// 戻り値は元のスライスと要素を共有するため、呼び出し側で必要に応じてコピーすること
+func paginate[T any](items []T, offset, limit int) ([]T, error) {
+	if offset < 0 || limit < 0 {
+		return nil, repository.ErrInvalidArgument
+	}
+	if limit == 0 || offset >= len(items) {
+		return []T{}, nil
+	}
+
+	// offset+limitの桁あふれを避けるため、残りの件数と比較する
+	end := len(items)
+	if limit < end-offset {
+		end = offset + limit
+	}
+	return items[offset:end], nil
+}
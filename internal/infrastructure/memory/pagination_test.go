@@ -0,0 +1,59 @@
+package memory
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+func TestPaginate(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+
+	tests := []struct {
+		name    string
+		offset  int
+		limit   int
+		want    []int
+		wantErr error
+	}{
+		{name: "先頭から", offset: 0, limit: 2, want: []int{1, 2}},
+		{name: "途中から", offset: 2, limit: 2, want: []int{3, 4}},
+		{name: "末尾を超えるlimit", offset: 3, limit: 10, want: []int{4, 5}},
+		{name: "全件", offset: 0, limit: 5, want: []int{1, 2, 3, 4, 5}},
+		{name: "limit が 0", offset: 0, limit: 0, want: []int{}},
+		{name: "範囲外のoffset", offset: 5, limit: 10, want: []int{}},
+		{name: "桁あふれするlimit", offset: 1, limit: math.MaxInt, want: []int{2, 3, 4, 5}},
+		{name: "不正なoffset", offset: -1, limit: 10, wantErr: repository.ErrInvalidArgument},
+		{name: "不正なlimit", offset: 0, limit: -1, wantErr: repository.ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := paginate(items, tt.offset, tt.limit)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("paginate() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if got != nil {
+					t.Errorf("paginate() = %v, want nil", got)
+				}
+				return
+			}
+			// 該当なしの場合もnilではなく空のスライスを返す
+			if got == nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("paginate() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+
+	// 空のスライスでも引数は検証する
+	if _, err := paginate([]string(nil), -1, 10); !errors.Is(err, repository.ErrInvalidArgument) {
+		t.Errorf("paginate(nil, -1) error = %v, want ErrInvalidArgument", err)
+	}
+	if got, err := paginate([]string(nil), 0, 10); err != nil || got == nil || len(got) != 0 {
+		t.Errorf("paginate(nil) = %#v, %v, want empty slice", got, err)
+	}
+}
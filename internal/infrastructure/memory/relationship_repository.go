@@ -22,6 +22,9 @@ type RelationshipRepository struct {
 	statusIndex     map[valueobject.RelationshipStatus][]string            // status -> []relationshipID
 	userStatusIndex map[string]map[valueobject.RelationshipStatus][]string // userID -> status -> []relationshipID
 
+	// 書き込みごとに増加するバージョン（トランザクション内で変更したかの判定用）
+	version uint64
+
 	// 並行アクセス制御用
 	mu sync.RWMutex
 }
@@ -66,9 +69,44 @@ func (r *RelationshipRepository) Create(ctx context.Context, relationship *entit
 	r.relationships[relationshipCopy.ID] = relationshipCopy
 	r.addToIndexes(relationshipCopy)
 
+	r.version++
 	return nil
 }
 
+// BulkCreate は複数の関係をロックを1回だけ取得してまとめて作成する
+// IDとユーザーペアの重複は保存済みの関係に加え、同じバッチ内でも検証する
+func (r *RelationshipRepository) BulkCreate(ctx context.Context, relationships []*entity.Relationship, mode repository.BulkCreateMode) (repository.BulkCreateResult, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ids := make(map[string]struct{}, len(relationships))
+	pairs := make(map[string]struct{}, len(relationships))
+	check := func(rel *entity.Relationship) error {
+		pairKey := r.createUserPairKey(rel.RequesterID, rel.ReceiverID)
+		_, dupID := ids[rel.ID]
+		_, dupPair := pairs[pairKey]
+		_, existsID := r.relationships[rel.ID]
+		_, existsPair := r.userPairIndex[pairKey]
+		if dupID || dupPair || existsID || existsPair {
+			return repository.ErrAlreadyExists
+		}
+		ids[rel.ID], pairs[pairKey] = struct{}{}, struct{}{}
+		return nil
+	}
+	insert := func(rel *entity.Relationship) {
+		relationshipCopy := r.copyRelationship(rel)
+		r.relationships[relationshipCopy.ID] = relationshipCopy
+		r.addToIndexes(relationshipCopy)
+	}
+
+	result, err := bulkCreate(relationships, mode, check, insert)
+	if result.Created > 0 {
+		r.version++
+	}
+	return result, err
+}
+
 // FindByID はIDで友達関係を検索する
 func (r *RelationshipRepository) FindByID(ctx context.Context, id string) (*entity.Relationship, error) {
 	_ = ctx // 将来的なDB実装のために保持
@@ -118,6 +156,7 @@ func (r *RelationshipRepository) Update(ctx context.Context, relationship *entit
 	// 新しい情報でインデックスを更新
 	r.addToIndexes(relationshipCopy)
 
+	r.version++
 	return nil
 }
 
@@ -138,6 +177,7 @@ func (r *RelationshipRepository) Delete(ctx context.Context, id string) error {
 	// メインストレージから削除
 	delete(r.relationships, id)
 
+	r.version++
 	return nil
 }
 
@@ -355,8 +395,8 @@ func (r *RelationshipRepository) AreFriends(ctx context.Context, userID1, userID
 }
 
 // IsBlocked は2人のユーザー間にブロック関係が存在するかを確認する
-// 注: 現在のエンティティ設計では誰がブロックしたかは記録されないため、
-// 単にブロック関係の存在のみを確認します
+// 注: どちらがブロックしていても相互のやり取りを禁止するため、方向は区別しません
+// （誰がブロックしたかは Relationship.IsBlockedBy で確認できます）
 func (r *RelationshipRepository) IsBlocked(ctx context.Context, blockerID, blockedID string) (bool, error) {
 	_ = ctx // 将来的なDB実装のために保持
 	r.mu.RLock()
@@ -370,8 +410,6 @@ func (r *RelationshipRepository) IsBlocked(ctx context.Context, blockerID, block
 
 	relationship := r.relationships[relationshipID]
 	// ブロック関係が存在するかを確認
-	// 注: 将来的にブロックした人を特定する必要がある場合は、
-	// エンティティにBlockerIDフィールドを追加する必要があります
 	return relationship.Status == valueobject.RelationshipStatusBlocked, nil
 }
 
@@ -412,6 +450,24 @@ func (r *RelationshipRepository) CountPendingRequestsByReceiverID(ctx context.Co
 	return count, nil
 }
 
+// CountUnseenRequestsByReceiverID は受信者IDで未読の承認待ちリクエスト数を取得する
+func (r *RelationshipRepository) CountUnseenRequestsByReceiverID(ctx context.Context, receiverID string) (int, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	count := 0
+	if ids, exists := r.receiverIndex[receiverID]; exists {
+		for _, id := range ids {
+			if rel := r.relationships[id]; rel != nil && rel.IsUnseen() {
+				count++
+			}
+		}
+	}
+
+	return count, nil
+}
+
 // CountByStatus はステータスごとの関係数を取得する
 func (r *RelationshipRepository) CountByStatus(ctx context.Context, status valueobject.RelationshipStatus) (int, error) {
 	_ = ctx // 将来的なDB実装のために保持
@@ -453,6 +509,25 @@ func (r *RelationshipRepository) Count(ctx context.Context) (int, error) {
 	return len(r.relationships), nil
 }
 
+// Stats は総関係数とステータス別の内訳をまとめて取得する
+func (r *RelationshipRepository) Stats(ctx context.Context) (repository.RepositoryStats, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	breakdown := make(map[string]int, len(r.statusIndex))
+	for status, relationshipIDs := range r.statusIndex {
+		if len(relationshipIDs) > 0 {
+			breakdown[status.String()] = len(relationshipIDs)
+		}
+	}
+
+	return repository.RepositoryStats{
+		Total:     len(r.relationships),
+		Breakdown: breakdown,
+	}, nil
+}
+
 // ===== ヘルパーメソッド =====
 
 // copyRelationship は友達関係のディープコピーを作成する
@@ -461,6 +536,24 @@ func (r *RelationshipRepository) copyRelationship(rel *entity.Relationship) *ent
 		return nil
 	}
 	relCopy := *rel
+	if rel.ExpiredAt != nil {
+		expiredAt := *rel.ExpiredAt
+		relCopy.ExpiredAt = &expiredAt
+	}
+	if rel.RequesterCallWindow != nil {
+		relCopy.RequesterCallWindow = copyCallWindow(rel.RequesterCallWindow)
+	}
+	if rel.ReceiverCallWindow != nil {
+		relCopy.ReceiverCallWindow = copyCallWindow(rel.ReceiverCallWindow)
+	}
+	if rel.RequesterFavoriteSender != nil {
+		favorite := *rel.RequesterFavoriteSender
+		relCopy.RequesterFavoriteSender = &favorite
+	}
+	if rel.ReceiverFavoriteSender != nil {
+		favorite := *rel.ReceiverFavoriteSender
+		relCopy.ReceiverFavoriteSender = &favorite
+	}
 	return &relCopy
 }
 
@@ -566,34 +659,79 @@ func (r *RelationshipRepository) removeFromSlice(slice []string, item string) []
 
 // getRelationshipsWithPagination はページネーション付きで関係を取得する
 func (r *RelationshipRepository) getRelationshipsWithPagination(ids []string, offset, limit int) ([]*entity.Relationship, error) {
-	// パラメータバリデーション（MorningCallRepositoryと同じ仕様）
-	if offset < 0 || limit < 0 {
-		return nil, repository.ErrInvalidArgument
+	page, err := paginate(ids, offset, limit)
+	if err != nil {
+		return nil, err
 	}
 
-	// limit が 0 の場合は空のスライスを返す
-	if limit == 0 {
-		return []*entity.Relationship{}, nil
+	// 指定範囲の関係を収集
+	result := make([]*entity.Relationship, 0, len(page))
+	for _, id := range page {
+		if rel, exists := r.relationships[id]; exists {
+			result = append(result, r.copyRelationship(rel))
+		}
 	}
 
-	// オフセットが範囲外の場合は空のスライスを返す
-	if offset >= len(ids) {
-		return []*entity.Relationship{}, nil
+	return result, nil
+}
+
+// snapshot は現在の状態を複製した新しいリポジトリを返す（トランザクション用）
+// 保存したエンティティは変更せずに差し替えるため、エンティティは複製せずに共有する
+func (r *RelationshipRepository) snapshot() *RelationshipRepository {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	relationships := cloneMap(r.relationships, 0)
+	userPairIndex := make(map[string]string, len(r.userPairIndex))
+	for k, v := range r.userPairIndex {
+		userPairIndex[k] = v
+	}
+	userStatusIndex := make(map[string]map[valueobject.RelationshipStatus][]string, len(r.userStatusIndex))
+	for userID, statusMap := range r.userStatusIndex {
+		userStatusIndex[userID] = copyIndex(statusMap)
 	}
 
-	// 終了位置の計算
-	end := offset + limit
-	if end > len(ids) {
-		end = len(ids)
+	return &RelationshipRepository{
+		relationships:   relationships,
+		requesterIndex:  copyIndex(r.requesterIndex),
+		receiverIndex:   copyIndex(r.receiverIndex),
+		userPairIndex:   userPairIndex,
+		statusIndex:     copyIndex(r.statusIndex),
+		userStatusIndex: userStatusIndex,
+		version:         r.version,
 	}
+}
 
-	// 指定範囲の関係を収集
-	result := make([]*entity.Relationship, 0, end-offset)
-	for i := offset; i < end; i++ {
-		if rel, exists := r.relationships[ids[i]]; exists {
-			result = append(result, r.copyRelationship(rel))
+// hasConflict はトランザクションで変更した関係を反映できないかを判定する（呼び出し側でロックを取得すること）
+// 変更した関係が開始後に他から変更された場合と、反映すると同じユーザーペアの関係が重複する場合に競合とする
+func (r *RelationshipRepository) hasConflict(base map[string]*entity.Relationship, src *RelationshipRepository, ids []string) bool {
+	if modifiedSince(base, r.relationships, ids) {
+		return true
+	}
+	changed := idSet(ids)
+	for _, id := range ids {
+		if rel, exists := src.relationships[id]; exists &&
+			takenByOther(r.userPairIndex, r.createUserPairKey(rel.RequesterID, rel.ReceiverID), id, changed) {
+			return true
 		}
 	}
+	return false
+}
 
-	return result, nil
+// applyChanges はトランザクション用の複製で作成・更新・削除された関係を反映する（呼び出し側でロックを取得すること）
+func (r *RelationshipRepository) applyChanges(src *RelationshipRepository, ids []string) {
+	// 先にすべて取り除いてから追加し、変更した関係同士でインデックスを消し合わないようにする
+	for _, id := range ids {
+		if existing, exists := r.relationships[id]; exists {
+			r.removeFromIndexes(existing)
+			delete(r.relationships, id)
+		}
+	}
+	for _, id := range ids {
+		if rel, exists := src.relationships[id]; exists {
+			r.relationships[id] = rel
+			r.addToIndexes(rel)
+		}
+	}
+	r.version++
 }
@@ -690,6 +690,65 @@ func TestRelationshipRepository_CountFriendsByUserID(t *testing.T) {
 }
 
 // TestRelationshipRepository_Pagination はページネーションのテスト
+func TestRelationshipRepository_Stats(t *testing.T) {
+	ctx := context.Background()
+	repo := NewRelationshipRepository()
+
+	statuses := []valueobject.RelationshipStatus{
+		valueobject.RelationshipStatusAccepted,
+		valueobject.RelationshipStatusAccepted,
+		valueobject.RelationshipStatusPending,
+		valueobject.RelationshipStatusBlocked,
+	}
+	for i, status := range statuses {
+		rel := &entity.Relationship{
+			ID:          generateTestRelationshipID(i),
+			RequesterID: generateTestUserID(0),
+			ReceiverID:  generateTestUserID(i + 1),
+			Status:      status,
+			CreatedAt:   time.Now(),
+			UpdatedAt:   time.Now(),
+		}
+		if err := repo.Create(ctx, rel); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	// 承認待ちを承認済みに変更
+	pending, err := repo.FindByID(ctx, generateTestRelationshipID(2))
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	pending.Status = valueobject.RelationshipStatusAccepted
+	if err := repo.Update(ctx, pending); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	stats, err := repo.Stats(ctx)
+	if err != nil {
+		t.Fatalf("Stats() error = %v", err)
+	}
+
+	total, _ := repo.Count(ctx)
+	if stats.Total != total || stats.Total != len(statuses) {
+		t.Errorf("Stats().Total = %d, Count() = %d, want %d", stats.Total, total, len(statuses))
+	}
+	for _, status := range []valueobject.RelationshipStatus{
+		valueobject.RelationshipStatusPending,
+		valueobject.RelationshipStatusAccepted,
+		valueobject.RelationshipStatusBlocked,
+		valueobject.RelationshipStatusRejected,
+	} {
+		count, _ := repo.CountByStatus(ctx, status)
+		if stats.Breakdown[status.String()] != count {
+			t.Errorf("Stats().Breakdown[%s] = %d, CountByStatus() = %d", status, stats.Breakdown[status.String()], count)
+		}
+	}
+	if stats.Breakdown[valueobject.RelationshipStatusAccepted.String()] != 3 {
+		t.Errorf("Stats().Breakdown[accepted] = %d, want 3", stats.Breakdown[valueobject.RelationshipStatusAccepted.String()])
+	}
+}
+
 func TestRelationshipRepository_Pagination(t *testing.T) {
 	ctx := context.Background()
 	repo := NewRelationshipRepository()
@@ -0,0 +1,140 @@
+package memory
+
+import "iter"
+
+// shardCount はシャード分割したマップのシャード数
+const shardCount = 64
+
+// shardedMap はキーのハッシュでシャードに分割したコピーオンライト用のマップ
+// clone は各シャードを共有したまま複製し、書き込みでは変更するシャードだけを複製する
+// そのため書き込みのコストは全体の件数ではなく1シャード分の件数に比例する
+//
+// 公開した（他から参照されうる）マップは変更せず、clone で得た複製に対して書き込むこと
+type shardedMap[V any] struct {
+	shards [shardCount]*mapShard[V]
+	owned  [shardCount]bool // この複製で作成したシャード（共有していないため変更してよい）
+	size   int
+}
+
+// mapShard はシャード1つ分のマップ
+// 複製した状態同士で共有するため、ポインタが同じなら内容も同じであることを変更の判定に使う
+type mapShard[V any] struct {
+	entries map[string]V
+}
+
+// shardIndex はキーが属するシャードの番号を返す（FNV-1a）
+func shardIndex(key string) int {
+	h := uint32(2166136261)
+	for i := 0; i < len(key); i++ {
+		h ^= uint32(key[i])
+		h *= 16777619
+	}
+	return int(h % shardCount)
+}
+
+// clone はシャードを共有した複製を返す（シャードは書き込むときに複製する）
+func (m *shardedMap[V]) clone() shardedMap[V] {
+	return shardedMap[V]{shards: m.shards, size: m.size}
+}
+
+// get はキーの値を返す
+func (m *shardedMap[V]) get(key string) (V, bool) {
+	shard := m.shards[shardIndex(key)]
+	if shard == nil {
+		var zero V
+		return zero, false
+	}
+	v, exists := shard.entries[key]
+	return v, exists
+}
+
+// len は件数を返す
+func (m *shardedMap[V]) len() int {
+	return m.size
+}
+
+// all はすべてのキーと値を順不同で返す
+func (m *shardedMap[V]) all() iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		for _, shard := range m.shards {
+			if shard == nil {
+				continue
+			}
+			for key, v := range shard.entries {
+				if !yield(key, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// set はキーに値を保存する
+func (m *shardedMap[V]) set(key string, v V) {
+	entries := m.own(shardIndex(key))
+	if _, exists := entries[key]; !exists {
+		m.size++
+	}
+	entries[key] = v
+}
+
+// delete はキーを削除する
+func (m *shardedMap[V]) delete(key string) {
+	if _, exists := m.get(key); !exists {
+		return
+	}
+	delete(m.own(shardIndex(key)), key)
+	m.size--
+}
+
+// own は変更するシャードを（共有している場合は複製してから）返す
+func (m *shardedMap[V]) own(i int) map[string]V {
+	if !m.owned[i] {
+		var entries map[string]V
+		if shard := m.shards[i]; shard != nil {
+			entries = shard.entries
+		}
+		m.shards[i] = &mapShard[V]{entries: cloneMap(entries, 1)}
+		m.owned[i] = true
+	}
+	return m.shards[i].entries
+}
+
+// changedKeys はbaseから作成・更新・削除されたキーを返す
+// baseと共有しているシャードは変更されていないため比較しない
+func changedKeys[E any](base, current *shardedMap[*E]) []string {
+	var keys []string
+	for i := range current.shards {
+		b, c := base.shards[i], current.shards[i]
+		if b == c {
+			continue
+		}
+		var baseEntries, currentEntries map[string]*E
+		if b != nil {
+			baseEntries = b.entries
+		}
+		if c != nil {
+			currentEntries = c.entries
+		}
+		keys = append(keys, changedIDs(baseEntries, currentEntries)...)
+	}
+	return keys
+}
+
+// modifiedKeysSince はkeysのエンティティのいずれかがbaseから作成・更新・削除されているかを判定する
+func modifiedKeysSince[E any](base, current *shardedMap[*E], keys []string) bool {
+	for _, key := range keys {
+		b, _ := base.get(key)
+		c, _ := current.get(key)
+		if b != c {
+			return true
+		}
+	}
+	return false
+}
+
+// keyTakenByOther は一意なキーがchanged以外の別のエンティティに使われているかを判定する
+func keyTakenByOther(index *shardedMap[string], key, id string, changed map[string]bool) bool {
+	owner, exists := index.get(key)
+	return exists && owner != id && !changed[owner]
+}
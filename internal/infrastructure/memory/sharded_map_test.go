@@ -0,0 +1,58 @@
+package memory
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestShardedMap_CloneIsolation(t *testing.T) {
+	var base shardedMap[*int]
+	values := make([]int, 200)
+	for i := range values {
+		values[i] = i
+		base.set("key"+strconv.Itoa(i), &values[i])
+	}
+	if base.len() != len(values) {
+		t.Fatalf("len() = %d, want %d", base.len(), len(values))
+	}
+
+	// 複製への書き込みは元のマップに影響しない
+	next := base.clone()
+	updated := -1
+	next.set("key1", &updated)
+	next.set("added", &updated)
+	next.delete("key2")
+	next.delete("missing")
+
+	if v, _ := base.get("key1"); *v != 1 {
+		t.Errorf("base key1 = %d, want 1", *v)
+	}
+	if _, exists := base.get("added"); exists {
+		t.Error("added key should not be visible in base")
+	}
+	if _, exists := base.get("key2"); !exists {
+		t.Error("deleted key should remain in base")
+	}
+	if base.len() != len(values) || next.len() != len(values) {
+		t.Errorf("len() = %d, %d, want %d, %d", base.len(), next.len(), len(values), len(values))
+	}
+
+	// 変更したキーだけが検出される
+	changed := changedKeys(&base, &next)
+	slices.Sort(changed)
+	if want := []string{"added", "key1", "key2"}; !slices.Equal(changed, want) {
+		t.Errorf("changedKeys() = %v, want %v", changed, want)
+	}
+	if !modifiedKeysSince(&base, &next, []string{"key3", "key1"}) || modifiedKeysSince(&base, &next, []string{"key3"}) {
+		t.Error("modifiedKeysSince() should detect only key1")
+	}
+
+	count := 0
+	for range next.all() {
+		count++
+	}
+	if count != next.len() {
+		t.Errorf("all() yielded %d entries, want %d", count, next.len())
+	}
+}
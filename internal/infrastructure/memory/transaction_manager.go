@@ -2,24 +2,204 @@ package memory
 
 import (
 	"context"
+	"sync"
 
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
 	"github.com/ochamu/morning-call-api/internal/domain/repository"
 )
 
 // TransactionManager はインメモリ実装のトランザクションマネージャー
-// インメモリ実装ではトランザクションは必要ないため、何もしない実装を提供
-type TransactionManager struct{}
+// WithTxでは各リポジトリのスナップショットを作成し、トランザクション内の変更はスナップショットに対して行う
+// コミット時にはトランザクション内で作成・更新・削除したエンティティだけを元のリポジトリにまとめて反映する
+// 反映するエンティティが開始後に他から変更されていれば競合とし、他のエンティティへの更新は競合にしない
+// 読み取っただけのエンティティは競合の判定に含めない（判定に含めたい場合は同じ値で更新する）
+type TransactionManager struct {
+	userRepo         *UserRepository
+	morningCallRepo  *MorningCallRepository
+	relationshipRepo *RelationshipRepository
+
+	// コミット処理の直列化用
+	mu sync.Mutex
+}
 
 // NewTransactionManager は新しいTransactionManagerを作成する
-func NewTransactionManager() *TransactionManager {
-	return &TransactionManager{}
+func NewTransactionManager(
+	userRepo *UserRepository,
+	morningCallRepo *MorningCallRepository,
+	relationshipRepo *RelationshipRepository,
+) *TransactionManager {
+	return &TransactionManager{
+		userRepo:         userRepo,
+		morningCallRepo:  morningCallRepo,
+		relationshipRepo: relationshipRepo,
+	}
 }
 
 // ExecuteInTransaction はトランザクション内で関数を実行する
-// インメモリ実装では単に関数を実行するだけ
+// インメモリ実装では単に関数を実行するだけ（リポジトリを差し替える場合はWithTxを使用する）
 func (tm *TransactionManager) ExecuteInTransaction(ctx context.Context, fn func(context.Context) error) error {
 	return fn(ctx)
 }
 
+// WithTx はスナップショットを作成してトランザクションを開始する
+func (tm *TransactionManager) WithTx(ctx context.Context) (*repository.TxRepositories, repository.Transaction, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	tx := &transaction{
+		tm:           tm,
+		user:         tm.userRepo.snapshot(),
+		morningCall:  tm.morningCallRepo.snapshot(),
+		relationship: tm.relationshipRepo.snapshot(),
+	}
+	tx.baseUserVersion = tx.user.version()
+	tx.baseMorningCallVersion = tx.morningCall.version
+	tx.baseRelationshipVersion = tx.relationship.version
+	tx.baseUsers = tx.user.state.Load()
+	tx.baseMorningCalls = cloneMap(tx.morningCall.morningCalls, 0)
+	tx.baseRelationships = cloneMap(tx.relationship.relationships, 0)
+
+	repos := &repository.TxRepositories{
+		User:         tx.user,
+		MorningCall:  tx.morningCall,
+		Relationship: tx.relationship,
+	}
+	return repos, tx, nil
+}
+
+// transaction はインメモリ実装のトランザクション
+type transaction struct {
+	tm *TransactionManager
+
+	// トランザクション内で使用するスナップショット
+	user         *UserRepository
+	morningCall  *MorningCallRepository
+	relationship *RelationshipRepository
+
+	// 開始時点のバージョン（変更したリポジトリの判定用）
+	baseUserVersion         uint64
+	baseMorningCallVersion  uint64
+	baseRelationshipVersion uint64
+
+	// 開始時点のエンティティ（変更したエンティティの特定と競合検出用）
+	baseUsers         *userState // スナップショットは変更されないため複製せずに保持する
+	baseMorningCalls  map[string]*entity.MorningCall
+	baseRelationships map[string]*entity.Relationship
+
+	mu   sync.Mutex
+	done bool
+}
+
+// Commit はスナップショットへの変更を元のリポジトリに反映する
+func (tx *transaction) Commit() error {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+	if tx.done {
+		return repository.ErrTransactionFailed
+	}
+	tx.done = true
+
+	tm := tx.tm
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	// デッドロックを避けるため常に同じ順序でロックを取得する
+	tm.userRepo.mu.Lock()
+	defer tm.userRepo.mu.Unlock()
+	tm.morningCallRepo.mu.Lock()
+	defer tm.morningCallRepo.mu.Unlock()
+	tm.relationshipRepo.mu.Lock()
+	defer tm.relationshipRepo.mu.Unlock()
+
+	// スナップショットのバージョンが進んでいるリポジトリについて、トランザクション内で変更したエンティティを特定する
+	var userIDs, morningCallIDs, relationshipIDs []string
+	if tx.user.version() != tx.baseUserVersion {
+		userIDs = changedKeys(&tx.baseUsers.users, &tx.user.state.Load().users)
+	}
+	if tx.morningCall.version != tx.baseMorningCallVersion {
+		morningCallIDs = changedIDs(tx.baseMorningCalls, tx.morningCall.morningCalls)
+	}
+	if tx.relationship.version != tx.baseRelationshipVersion {
+		relationshipIDs = changedIDs(tx.baseRelationships, tx.relationship.relationships)
+	}
+
+	// 変更したエンティティが開始後に他から変更されていれば競合とする
+	if tm.userRepo.hasConflict(tx.baseUsers, tx.user, userIDs) ||
+		modifiedSince(tx.baseMorningCalls, tm.morningCallRepo.morningCalls, morningCallIDs) ||
+		tm.relationshipRepo.hasConflict(tx.baseRelationships, tx.relationship, relationshipIDs) {
+		return repository.ErrTransactionFailed
+	}
+
+	if len(userIDs) > 0 {
+		tm.userRepo.applyChanges(tx.user, userIDs)
+	}
+	if len(morningCallIDs) > 0 {
+		tm.morningCallRepo.applyChanges(tx.morningCall, morningCallIDs)
+	}
+	if len(relationshipIDs) > 0 {
+		tm.relationshipRepo.applyChanges(tx.relationship, relationshipIDs)
+	}
+
+	return nil
+}
+
+// Rollback はスナップショットを破棄する
+func (tx *transaction) Rollback() error {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+	tx.done = true
+	return nil
+}
+
+// copyIndex はIDリストのインデックスをディープコピーする
+func copyIndex[K comparable](src map[K][]string) map[K][]string {
+	dst := make(map[K][]string, len(src))
+	for k, ids := range src {
+		dst[k] = append([]string(nil), ids...)
+	}
+	return dst
+}
+
+// changedIDs はトランザクション内で作成・更新・削除したエンティティのIDを返す
+// リポジトリは保存したエンティティを変更せずに差し替えるため、開始時点とポインタが異なるものを変更とみなす
+func changedIDs[E any](base, current map[string]*E) []string {
+	var ids []string
+	for id, e := range current {
+		if base[id] != e {
+			ids = append(ids, id)
+		}
+	}
+	for id := range base {
+		if _, exists := current[id]; !exists {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// modifiedSince はidsのエンティティのいずれかが開始時点から作成・更新・削除されているかを判定する
+func modifiedSince[E any](base, current map[string]*E, ids []string) bool {
+	for _, id := range ids {
+		if current[id] != base[id] {
+			return true
+		}
+	}
+	return false
+}
+
+// idSet はIDの集合を返す
+func idSet(ids []string) map[string]bool {
+	set := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	return set
+}
+
+// takenByOther は一意なキーがchanged以外の別のエンティティに使われているかを判定する
+func takenByOther(index map[string]string, key, id string, changed map[string]bool) bool {
+	owner, exists := index[key]
+	return exists && owner != id && !changed[owner]
+}
+
 // インターフェースの実装を保証
 var _ repository.TransactionManager = (*TransactionManager)(nil)
+var _ repository.Transaction = (*transaction)(nil)
@@ -0,0 +1,288 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// setupTransactionTest はトランザクションテスト用のリポジトリを作成する
+func setupTransactionTest(t *testing.T) (*TransactionManager, *UserRepository, *MorningCallRepository, *RelationshipRepository) {
+	t.Helper()
+	ctx := context.Background()
+
+	userRepo := NewUserRepository()
+	morningCallRepo := NewMorningCallRepository()
+	relationshipRepo := NewRelationshipRepository()
+
+	rel := &entity.Relationship{
+		ID:          generateTestRelationshipID(1),
+		RequesterID: generateTestUserID(1),
+		ReceiverID:  generateTestUserID(2),
+		Status:      valueobject.RelationshipStatusPending,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	if err := relationshipRepo.Create(ctx, rel); err != nil {
+		t.Fatalf("failed to create relationship: %v", err)
+	}
+
+	return NewTransactionManager(userRepo, morningCallRepo, relationshipRepo), userRepo, morningCallRepo, relationshipRepo
+}
+
+// acceptInTx はトランザクション内で関係を承認済みに更新する
+func acceptInTx(t *testing.T, repos *repository.TxRepositories) {
+	t.Helper()
+	ctx := context.Background()
+
+	rel, err := repos.Relationship.FindByID(ctx, generateTestRelationshipID(1))
+	if err != nil {
+		t.Fatalf("failed to find relationship: %v", err)
+	}
+	rel.Status = valueobject.RelationshipStatusAccepted
+	if err := repos.Relationship.Update(ctx, rel); err != nil {
+		t.Fatalf("failed to update relationship: %v", err)
+	}
+}
+
+// TestTransactionManager_Commit はコミットで変更が反映されることを確認する
+func TestTransactionManager_Commit(t *testing.T) {
+	ctx := context.Background()
+	tm, _, _, relationshipRepo := setupTransactionTest(t)
+
+	repos, tx, err := tm.WithTx(ctx)
+	if err != nil {
+		t.Fatalf("WithTx() error = %v", err)
+	}
+	acceptInTx(t, repos)
+
+	// コミット前は元のリポジトリに反映されない
+	rel, _ := relationshipRepo.FindByID(ctx, generateTestRelationshipID(1))
+	if rel.Status != valueobject.RelationshipStatusPending {
+		t.Errorf("コミット前に変更が見えています: %s", rel.Status)
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+
+	rel, _ = relationshipRepo.FindByID(ctx, generateTestRelationshipID(1))
+	if rel.Status != valueobject.RelationshipStatusAccepted {
+		t.Errorf("コミット後のステータス = %s, want accepted", rel.Status)
+	}
+	friends, _ := relationshipRepo.CountFriendsByUserID(ctx, generateTestUserID(1))
+	if friends != 1 {
+		t.Errorf("インデックスが更新されていません: friends = %d", friends)
+	}
+
+	// コミット後のロールバックは何もしない
+	if err := tx.Rollback(); err != nil {
+		t.Errorf("Rollback() after commit error = %v", err)
+	}
+	rel, _ = relationshipRepo.FindByID(ctx, generateTestRelationshipID(1))
+	if rel.Status != valueobject.RelationshipStatusAccepted {
+		t.Errorf("コミット後のロールバックで変更が失われました: %s", rel.Status)
+	}
+}
+
+// TestTransactionManager_Rollback はロールバックで変更が破棄されることを確認する
+func TestTransactionManager_Rollback(t *testing.T) {
+	ctx := context.Background()
+	tm, _, morningCallRepo, relationshipRepo := setupTransactionTest(t)
+
+	repos, tx, err := tm.WithTx(ctx)
+	if err != nil {
+		t.Fatalf("WithTx() error = %v", err)
+	}
+	acceptInTx(t, repos)
+	mc := &entity.MorningCall{
+		ID:            "mc1",
+		SenderID:      generateTestUserID(1),
+		ReceiverID:    generateTestUserID(2),
+		ScheduledTime: time.Now().Add(time.Hour),
+		Status:        valueobject.MorningCallStatusScheduled,
+	}
+	if err := repos.MorningCall.Create(ctx, mc); err != nil {
+		t.Fatalf("failed to create morning call: %v", err)
+	}
+
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("Rollback() error = %v", err)
+	}
+
+	rel, _ := relationshipRepo.FindByID(ctx, generateTestRelationshipID(1))
+	if rel.Status != valueobject.RelationshipStatusPending {
+		t.Errorf("ロールバック後のステータス = %s, want pending", rel.Status)
+	}
+	if count, _ := morningCallRepo.Count(ctx); count != 0 {
+		t.Errorf("ロールバック後にモーニングコールが残っています: %d", count)
+	}
+
+	// ロールバック後のコミットは失敗する
+	if err := tx.Commit(); !errors.Is(err, repository.ErrTransactionFailed) {
+		t.Errorf("Commit() after rollback error = %v, want ErrTransactionFailed", err)
+	}
+}
+
+// TestTransactionManager_Conflict は開始後に他の更新があった場合にコミットが失敗することを確認する
+func TestTransactionManager_Conflict(t *testing.T) {
+	ctx := context.Background()
+	tm, _, _, relationshipRepo := setupTransactionTest(t)
+
+	repos, tx, err := tm.WithTx(ctx)
+	if err != nil {
+		t.Fatalf("WithTx() error = %v", err)
+	}
+	acceptInTx(t, repos)
+
+	// トランザクション外で関係を更新
+	rel, _ := relationshipRepo.FindByID(ctx, generateTestRelationshipID(1))
+	rel.Status = valueobject.RelationshipStatusRejected
+	if err := relationshipRepo.Update(ctx, rel); err != nil {
+		t.Fatalf("failed to update relationship: %v", err)
+	}
+
+	if err := tx.Commit(); !errors.Is(err, repository.ErrTransactionFailed) {
+		t.Fatalf("Commit() error = %v, want ErrTransactionFailed", err)
+	}
+
+	rel, _ = relationshipRepo.FindByID(ctx, generateTestRelationshipID(1))
+	if rel.Status != valueobject.RelationshipStatusRejected {
+		t.Errorf("競合時に外部の更新が上書きされました: %s", rel.Status)
+	}
+}
+
+// TestTransactionManager_UnmodifiedRepositoryIsNotReplaced は変更していないリポジトリが上書きされないことを確認する
+func TestTransactionManager_UnmodifiedRepositoryIsNotReplaced(t *testing.T) {
+	ctx := context.Background()
+	tm, userRepo, _, relationshipRepo := setupTransactionTest(t)
+
+	repos, tx, err := tm.WithTx(ctx)
+	if err != nil {
+		t.Fatalf("WithTx() error = %v", err)
+	}
+	acceptInTx(t, repos)
+
+	// トランザクション外でユーザーを作成（トランザクションではユーザーを変更しない）
+	user := &entity.User{ID: generateTestUserID(3), Username: "charlie", Email: "charlie@example.com"}
+	if err := userRepo.Create(ctx, user); err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+
+	if exists, _ := userRepo.ExistsByID(ctx, user.ID); !exists {
+		t.Error("トランザクション外で作成したユーザーが失われました")
+	}
+	rel, _ := relationshipRepo.FindByID(ctx, generateTestRelationshipID(1))
+	if rel.Status != valueobject.RelationshipStatusAccepted {
+		t.Errorf("コミット後のステータス = %s, want accepted", rel.Status)
+	}
+}
+
+// TestTransactionManager_UnrelatedUpdateDoesNotConflict は開始後に別のエンティティが更新されても競合にならないことを確認する
+func TestTransactionManager_UnrelatedUpdateDoesNotConflict(t *testing.T) {
+	ctx := context.Background()
+	tm, _, morningCallRepo, relationshipRepo := setupTransactionTest(t)
+	other := &entity.MorningCall{
+		ID:            "other",
+		SenderID:      generateTestUserID(3),
+		ReceiverID:    generateTestUserID(4),
+		ScheduledTime: time.Now().Add(time.Hour),
+		Status:        valueobject.MorningCallStatusScheduled,
+	}
+	if err := morningCallRepo.Create(ctx, other); err != nil {
+		t.Fatalf("failed to create morning call: %v", err)
+	}
+
+	repos, tx, err := tm.WithTx(ctx)
+	if err != nil {
+		t.Fatalf("WithTx() error = %v", err)
+	}
+	acceptInTx(t, repos)
+	mine := &entity.MorningCall{
+		ID:            "mine",
+		SenderID:      generateTestUserID(1),
+		ReceiverID:    generateTestUserID(2),
+		ScheduledTime: time.Now().Add(time.Hour),
+		Status:        valueobject.MorningCallStatusScheduled,
+	}
+	if err := repos.MorningCall.Create(ctx, mine); err != nil {
+		t.Fatalf("failed to create morning call: %v", err)
+	}
+
+	// トランザクション外で別のユーザーのコールを配信済みにし、別の関係を作成する
+	if reason := other.MarkAsDelivered(); reason.IsNG() {
+		t.Fatalf("MarkAsDelivered() = %v", reason)
+	}
+	if err := morningCallRepo.Update(ctx, other); err != nil {
+		t.Fatalf("failed to update morning call: %v", err)
+	}
+	if err := relationshipRepo.Create(ctx, &entity.Relationship{
+		ID:          generateTestRelationshipID(2),
+		RequesterID: generateTestUserID(3),
+		ReceiverID:  generateTestUserID(4),
+		Status:      valueobject.RelationshipStatusPending,
+	}); err != nil {
+		t.Fatalf("failed to create relationship: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+
+	// トランザクション内外の変更がどちらも残る
+	rel, _ := relationshipRepo.FindByID(ctx, generateTestRelationshipID(1))
+	if rel.Status != valueobject.RelationshipStatusAccepted {
+		t.Errorf("コミット後のステータス = %s, want accepted", rel.Status)
+	}
+	if exists, _ := relationshipRepo.ExistsByID(ctx, generateTestRelationshipID(2)); !exists {
+		t.Error("トランザクション外で作成した関係が失われました")
+	}
+	saved, _ := morningCallRepo.FindByID(ctx, "other")
+	if saved.Status != valueobject.MorningCallStatusDelivered {
+		t.Errorf("トランザクション外の更新が失われました: %s", saved.Status)
+	}
+	if calls, _ := morningCallRepo.FindBySenderID(ctx, generateTestUserID(1), 0, 10); len(calls) != 1 {
+		t.Errorf("トランザクション内で作成したコールのインデックスが反映されていません: %d件", len(calls))
+	}
+}
+
+// TestTransactionManager_DuplicatePairConflict は開始後に同じユーザーペアの関係が作成された場合にコミットが失敗することを確認する
+func TestTransactionManager_DuplicatePairConflict(t *testing.T) {
+	ctx := context.Background()
+	tm, _, _, relationshipRepo := setupTransactionTest(t)
+	newRelationship := func(id string) *entity.Relationship {
+		return &entity.Relationship{
+			ID:          id,
+			RequesterID: generateTestUserID(3),
+			ReceiverID:  generateTestUserID(4),
+			Status:      valueobject.RelationshipStatusBlocked,
+		}
+	}
+
+	repos, tx, err := tm.WithTx(ctx)
+	if err != nil {
+		t.Fatalf("WithTx() error = %v", err)
+	}
+	if err := repos.Relationship.Create(ctx, newRelationship("in-tx")); err != nil {
+		t.Fatalf("failed to create relationship: %v", err)
+	}
+	if err := relationshipRepo.Create(ctx, newRelationship("outside")); err != nil {
+		t.Fatalf("failed to create relationship: %v", err)
+	}
+
+	if err := tx.Commit(); !errors.Is(err, repository.ErrTransactionFailed) {
+		t.Fatalf("Commit() error = %v, want ErrTransactionFailed", err)
+	}
+	if exists, _ := relationshipRepo.ExistsByID(ctx, "in-tx"); exists {
+		t.Error("競合したトランザクションの関係が反映されました")
+	}
+}
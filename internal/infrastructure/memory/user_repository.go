@@ -5,30 +5,67 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/ochamu/morning-call-api/internal/domain/entity"
 	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
 )
 
 // UserRepository はメモリ内でユーザーエンティティを管理するリポジトリ実装
+// 認証のたびに参照される読み取りの多いリポジトリのため、読み取りはロックを取得せずスナップショットを参照する
+//
+// 整合性:
+//   - 書き込みは書き込み用のロックで直列化し、複製した状態を変更してからスナップショットを差し替える（コピーオンライト）
+//   - 差し替えは書き込みメソッドが戻る前に行うため、書き込みの完了後に開始した読み取りは必ずその結果を返す（結果整合ではない）
+//   - 書き込みと並行する読み取りは差し替え前か後のどちらかの状態全体を参照し、書き込み途中の状態は見えない
+//   - 1回の読み取りメソッドの中では同じ時点の状態を参照するが、複数の呼び出しにまたがる一貫性は保証しない（必要な場合はトランザクションを使う）
+//
+// 状態のマップはシャードに分割し、書き込みでは変更するシャードだけを複製する
+// そのため書き込みのコストはユーザー数ではなく1シャード分のユーザー数に比例する
 type UserRepository struct {
+	// 読み取り専用のスナップショット（公開後は変更しない）
+	state atomic.Pointer[userState]
+
+	// 書き込みの直列化用（読み取りでは取得しない）
+	mu sync.Mutex
+}
+
+// userState はユーザーリポジトリのある時点の状態
+// スナップショットとして公開した後はマップもエンティティも変更しない
+type userState struct {
 	// メインストレージ（IDをキーとする）
-	users map[string]*entity.User
+	users shardedMap[*entity.User]
 
 	// インデックス（高速検索用）
-	usernameIndex map[string]string // username -> ID
-	emailIndex    map[string]string // email -> ID
+	usernameIndex shardedMap[string] // username -> ID
+	emailIndex    shardedMap[string] // email -> ID
 
-	// 並行アクセス制御用
-	mu sync.RWMutex
+	// 書き込みごとに増加するバージョン（トランザクション内で変更したかの判定用）
+	version uint64
 }
 
 // NewUserRepository は新しいメモリ内ユーザーリポジトリを作成する
 func NewUserRepository() *UserRepository {
-	return &UserRepository{
-		users:         make(map[string]*entity.User),
-		usernameIndex: make(map[string]string),
-		emailIndex:    make(map[string]string),
+	return newUserRepositoryFrom(&userState{})
+}
+
+// newUserRepositoryFrom は指定した状態をスナップショットとするリポジトリを作成する
+func newUserRepositoryFrom(state *userState) *UserRepository {
+	r := &UserRepository{}
+	r.state.Store(state)
+	return r
+}
+
+// next は書き込み用に状態を複製し、バージョンを1つ進める
+// シャードは共有したまま複製し、書き込んだシャードだけが新たに複製される
+func (s *userState) next() *userState {
+	return &userState{
+		users:         s.users.clone(),
+		usernameIndex: s.usernameIndex.clone(),
+		emailIndex:    s.emailIndex.clone(),
+		version:       s.version + 1,
 	}
 }
 
@@ -41,19 +78,20 @@ func (r *UserRepository) Create(ctx context.Context, user *entity.User) error {
 
 	r.mu.Lock()
 	defer r.mu.Unlock()
+	current := r.state.Load()
 
 	// 既存チェック
-	if _, exists := r.users[user.ID]; exists {
+	if _, exists := current.users.get(user.ID); exists {
 		return repository.ErrAlreadyExists
 	}
 
 	// ユーザー名の重複チェック（大小文字を区別しない）
-	if _, exists := r.usernameIndex[strings.ToLower(user.Username)]; exists {
+	if _, exists := current.usernameIndex.get(strings.ToLower(user.Username)); exists {
 		return repository.ErrAlreadyExists
 	}
 
 	// メールアドレスの重複チェック（大小文字を区別しない）
-	if _, exists := r.emailIndex[strings.ToLower(user.Email)]; exists {
+	if _, exists := current.emailIndex.get(strings.ToLower(user.Email)); exists {
 		return repository.ErrAlreadyExists
 	}
 
@@ -61,20 +99,65 @@ func (r *UserRepository) Create(ctx context.Context, user *entity.User) error {
 	userCopy := r.copyUser(user)
 
 	// 保存（インデックスは小文字で正規化）
-	r.users[userCopy.ID] = userCopy
-	r.usernameIndex[strings.ToLower(userCopy.Username)] = userCopy.ID
-	r.emailIndex[strings.ToLower(userCopy.Email)] = userCopy.ID
+	next := current.next()
+	next.users.set(userCopy.ID, userCopy)
+	next.usernameIndex.set(strings.ToLower(userCopy.Username), userCopy.ID)
+	next.emailIndex.set(strings.ToLower(userCopy.Email), userCopy.ID)
 
+	r.state.Store(next)
 	return nil
 }
 
+// BulkCreate は複数のユーザーをロックを1回だけ取得してまとめて作成する
+// ID・ユーザー名・メールアドレスの重複は保存済みのユーザーに加え、同じバッチ内でも検証する
+// 状態の複製とスナップショットの差し替えもバッチ全体で1回だけ行う
+func (r *UserRepository) BulkCreate(ctx context.Context, users []*entity.User, mode repository.BulkCreateMode) (repository.BulkCreateResult, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	current := r.state.Load()
+
+	ids := make(map[string]struct{}, len(users))
+	usernames := make(map[string]struct{}, len(users))
+	emails := make(map[string]struct{}, len(users))
+	check := func(user *entity.User) error {
+		username, email := strings.ToLower(user.Username), strings.ToLower(user.Email)
+		_, dupID := ids[user.ID]
+		_, dupUsername := usernames[username]
+		_, dupEmail := emails[email]
+		_, existsID := current.users.get(user.ID)
+		_, existsUsername := current.usernameIndex.get(username)
+		_, existsEmail := current.emailIndex.get(email)
+		if dupID || dupUsername || dupEmail || existsID || existsUsername || existsEmail {
+			return repository.ErrAlreadyExists
+		}
+		ids[user.ID], usernames[username], emails[email] = struct{}{}, struct{}{}, struct{}{}
+		return nil
+	}
+	var next *userState
+	insert := func(user *entity.User) {
+		if next == nil {
+			next = current.next()
+		}
+		userCopy := r.copyUser(user)
+		next.users.set(userCopy.ID, userCopy)
+		next.usernameIndex.set(strings.ToLower(userCopy.Username), userCopy.ID)
+		next.emailIndex.set(strings.ToLower(userCopy.Email), userCopy.ID)
+	}
+
+	result, err := bulkCreate(users, mode, check, insert)
+	if next != nil {
+		r.state.Store(next)
+	}
+	return result, err
+}
+
 // FindByID はIDでユーザーを検索する
 func (r *UserRepository) FindByID(ctx context.Context, id string) (*entity.User, error) {
 	_ = ctx // 将来的なDB実装のために保持
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+	s := r.state.Load()
 
-	user, exists := r.users[id]
+	user, exists := s.users.get(id)
 	if !exists {
 		return nil, repository.ErrNotFound
 	}
@@ -82,33 +165,52 @@ func (r *UserRepository) FindByID(ctx context.Context, id string) (*entity.User,
 	return r.copyUser(user), nil
 }
 
+// FindByIDs は複数のIDでユーザーをまとめて検索する（存在しないIDは結果に含めない）
+// 結果は指定したIDの順序に従い、重複したIDは1件にまとめる
+func (r *UserRepository) FindByIDs(ctx context.Context, ids []string) ([]*entity.User, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	s := r.state.Load()
+
+	users := make([]*entity.User, 0, len(ids))
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if user, exists := s.users.get(id); exists {
+			users = append(users, r.copyUser(user))
+		}
+	}
+
+	return users, nil
+}
+
 // FindByUsername はユーザー名でユーザーを検索する（大小文字を区別しない）
 func (r *UserRepository) FindByUsername(ctx context.Context, username string) (*entity.User, error) {
 	_ = ctx // 将来的なDB実装のために保持
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+	s := r.state.Load()
 
-	id, exists := r.usernameIndex[strings.ToLower(username)]
+	id, exists := s.usernameIndex.get(strings.ToLower(username))
 	if !exists {
 		return nil, repository.ErrNotFound
 	}
 
-	user := r.users[id]
+	user, _ := s.users.get(id)
 	return r.copyUser(user), nil
 }
 
 // FindByEmail はメールアドレスでユーザーを検索する（大小文字を区別しない）
 func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
 	_ = ctx // 将来的なDB実装のために保持
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+	s := r.state.Load()
 
-	id, exists := r.emailIndex[strings.ToLower(email)]
+	id, exists := s.emailIndex.get(strings.ToLower(email))
 	if !exists {
 		return nil, repository.ErrNotFound
 	}
 
-	user := r.users[id]
+	user, _ := s.users.get(id)
 	return r.copyUser(user), nil
 }
 
@@ -121,99 +223,122 @@ func (r *UserRepository) Update(ctx context.Context, user *entity.User) error {
 
 	r.mu.Lock()
 	defer r.mu.Unlock()
+	current := r.state.Load()
 
-	existing, exists := r.users[user.ID]
+	existing, exists := current.users.get(user.ID)
 	if !exists {
 		return repository.ErrNotFound
 	}
 
+	// 新しいユーザー名・メールアドレスが既に使用されていないか確認（大小文字を区別しない）
+	usernameChanged := existing.Username != user.Username
+	if _, exists := current.usernameIndex.get(strings.ToLower(user.Username)); usernameChanged && exists {
+		return repository.ErrAlreadyExists
+	}
+	emailChanged := existing.Email != user.Email
+	if _, exists := current.emailIndex.get(strings.ToLower(user.Email)); emailChanged && exists {
+		return repository.ErrAlreadyExists
+	}
+
+	next := current.next()
+
 	// ユーザー名が変更された場合のインデックス更新
-	if existing.Username != user.Username {
-		// 新しいユーザー名が既に使用されていないか確認（大小文字を区別しない）
-		if _, exists := r.usernameIndex[strings.ToLower(user.Username)]; exists {
-			return repository.ErrAlreadyExists
-		}
-		// 古いインデックスを削除
-		delete(r.usernameIndex, strings.ToLower(existing.Username))
-		// 新しいインデックスを追加
-		r.usernameIndex[strings.ToLower(user.Username)] = user.ID
+	if usernameChanged {
+		next.usernameIndex.delete(strings.ToLower(existing.Username))
+		next.usernameIndex.set(strings.ToLower(user.Username), user.ID)
 	}
 
 	// メールアドレスが変更された場合のインデックス更新
-	if existing.Email != user.Email {
-		// 新しいメールアドレスが既に使用されていないか確認（大小文字を区別しない）
-		if _, exists := r.emailIndex[strings.ToLower(user.Email)]; exists {
-			return repository.ErrAlreadyExists
-		}
-		// 古いインデックスを削除
-		delete(r.emailIndex, strings.ToLower(existing.Email))
-		// 新しいインデックスを追加
-		r.emailIndex[strings.ToLower(user.Email)] = user.ID
+	if emailChanged {
+		next.emailIndex.delete(strings.ToLower(existing.Email))
+		next.emailIndex.set(strings.ToLower(user.Email), user.ID)
 	}
 
-	// ユーザー情報を更新
+	// ユーザー情報を更新（ポイントはAddPointsでのみ変更するため保存済みの値を維持する）
 	userCopy := r.copyUser(user)
-	r.users[userCopy.ID] = userCopy
+	userCopy.Points = existing.Points
+	next.users.set(userCopy.ID, userCopy)
 
+	r.state.Store(next)
 	return nil
 }
 
+// AddPoints はユーザーのポイントに加算し、加算後のポイントを返す
+func (r *UserRepository) AddPoints(ctx context.Context, id string, points int) (int, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	if points <= 0 {
+		return 0, repository.ErrInvalidArgument
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	current := r.state.Load()
+
+	existing, exists := current.users.get(id)
+	if !exists {
+		return 0, repository.ErrNotFound
+	}
+
+	// スナップショットのユーザーは変更できないため、コピーを差し替える
+	userCopy := r.copyUser(existing)
+	userCopy.Points += points
+	next := current.next()
+	next.users.set(id, userCopy)
+
+	r.state.Store(next)
+	return userCopy.Points, nil
+}
+
 // Delete はユーザーを削除する
 func (r *UserRepository) Delete(ctx context.Context, id string) error {
 	_ = ctx // 将来的なDB実装のために保持
 	r.mu.Lock()
 	defer r.mu.Unlock()
+	current := r.state.Load()
 
-	user, exists := r.users[id]
+	user, exists := current.users.get(id)
 	if !exists {
 		return repository.ErrNotFound
 	}
 
+	next := current.next()
+
 	// インデックスから削除（大小文字を区別しない）
-	delete(r.usernameIndex, strings.ToLower(user.Username))
-	delete(r.emailIndex, strings.ToLower(user.Email))
+	next.usernameIndex.delete(strings.ToLower(user.Username))
+	next.emailIndex.delete(strings.ToLower(user.Email))
 
 	// ユーザーを削除
-	delete(r.users, id)
+	next.users.delete(id)
 
+	r.state.Store(next)
 	return nil
 }
 
 // ExistsByID はIDでユーザーの存在を確認する
 func (r *UserRepository) ExistsByID(ctx context.Context, id string) (bool, error) {
 	_ = ctx // 将来的なDB実装のために保持
-	r.mu.RLock()
-	defer r.mu.RUnlock()
-
-	_, exists := r.users[id]
+	_, exists := r.state.Load().users.get(id)
 	return exists, nil
 }
 
 // ExistsByUsername はユーザー名でユーザーの存在を確認する（大小文字を区別しない）
 func (r *UserRepository) ExistsByUsername(ctx context.Context, username string) (bool, error) {
 	_ = ctx // 将来的なDB実装のために保持
-	r.mu.RLock()
-	defer r.mu.RUnlock()
-
-	_, exists := r.usernameIndex[strings.ToLower(username)]
+	_, exists := r.state.Load().usernameIndex.get(strings.ToLower(username))
 	return exists, nil
 }
 
 // ExistsByEmail はメールアドレスでユーザーの存在を確認する（大小文字を区別しない）
 func (r *UserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
 	_ = ctx // 将来的なDB実装のために保持
-	r.mu.RLock()
-	defer r.mu.RUnlock()
-
-	_, exists := r.emailIndex[strings.ToLower(email)]
+	_, exists := r.state.Load().emailIndex.get(strings.ToLower(email))
 	return exists, nil
 }
 
 // FindAll はすべてのユーザーを取得する（ページネーション対応）
 func (r *UserRepository) FindAll(ctx context.Context, offset, limit int) ([]*entity.User, error) {
 	_ = ctx // 将来的なDB実装のために保持
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+	s := r.state.Load()
 
 	if offset < 0 || limit < 0 {
 		return nil, repository.ErrInvalidArgument
@@ -225,8 +350,8 @@ func (r *UserRepository) FindAll(ctx context.Context, offset, limit int) ([]*ent
 	}
 
 	// すべてのユーザーをスライスに変換（IDでソートして順序を保証）
-	allUsers := make([]*entity.User, 0, len(r.users))
-	for _, user := range r.users {
+	allUsers := make([]*entity.User, 0, s.users.len())
+	for _, user := range s.users.all() {
 		allUsers = append(allUsers, r.copyUser(user))
 	}
 
@@ -249,23 +374,206 @@ func (r *UserRepository) FindAll(ctx context.Context, offset, limit int) ([]*ent
 	return allUsers[start:end], nil
 }
 
+// FindTopByPoints はポイントの多い順にユーザーを取得する（同点の場合はIDの昇順）
+func (r *UserRepository) FindTopByPoints(ctx context.Context, limit int) ([]*entity.User, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	s := r.state.Load()
+
+	if limit < 0 {
+		return nil, repository.ErrInvalidArgument
+	}
+
+	ranked := make([]*entity.User, 0, s.users.len())
+	for _, user := range s.users.all() {
+		if user.Points > 0 && !user.IsFrozen {
+			ranked = append(ranked, user)
+		}
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].Points != ranked[j].Points {
+			return ranked[i].Points > ranked[j].Points
+		}
+		return ranked[i].ID < ranked[j].ID
+	})
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+
+	// 上位の分だけコピーする
+	result := make([]*entity.User, 0, len(ranked))
+	for _, user := range ranked {
+		result = append(result, r.copyUser(user))
+	}
+	return result, nil
+}
+
+// FindDeletionDue は削除予定の猶予期間がnowまでに過ぎたユーザーを取得する（削除予定日時の古い順、同時刻の場合はIDの昇順）
+func (r *UserRepository) FindDeletionDue(ctx context.Context, now time.Time) ([]*entity.User, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	s := r.state.Load()
+
+	due := make([]*entity.User, 0)
+	for _, user := range s.users.all() {
+		if user.IsDeletionDue(now) {
+			due = append(due, r.copyUser(user))
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		if !due[i].DeletionScheduledAt.Equal(*due[j].DeletionScheduledAt) {
+			return due[i].DeletionScheduledAt.Before(*due[j].DeletionScheduledAt)
+		}
+		return due[i].ID < due[j].ID
+	})
+	return due, nil
+}
+
 // Count は総ユーザー数を取得する
 func (r *UserRepository) Count(ctx context.Context) (int, error) {
 	_ = ctx // 将来的なDB実装のために保持
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+	return r.state.Load().users.len(), nil
+}
 
-	return len(r.users), nil
+// Stats は総ユーザー数とプラン別の内訳をまとめて取得する
+// プランは未設定のユーザーも含めて実際に適用されるプランで分類する
+func (r *UserRepository) Stats(ctx context.Context) (repository.RepositoryStats, error) {
+	_ = ctx // 将来的なDB実装のために保持
+	s := r.state.Load()
+
+	breakdown := make(map[string]int)
+	for _, user := range s.users.all() {
+		breakdown[user.EffectivePlan().String()]++
+	}
+
+	return repository.RepositoryStats{
+		Total:     s.users.len(),
+		Breakdown: breakdown,
+	}, nil
 }
 
 // copyUser はユーザーエンティティのディープコピーを作成する
 func (r *UserRepository) copyUser(user *entity.User) *entity.User {
-	return &entity.User{
-		ID:           user.ID,
-		Username:     user.Username,
-		Email:        user.Email,
-		PasswordHash: user.PasswordHash,
-		CreatedAt:    user.CreatedAt,
-		UpdatedAt:    user.UpdatedAt,
+	userCopy := &entity.User{
+		ID:                   user.ID,
+		Username:             user.Username,
+		Email:                user.Email,
+		PasswordHash:         user.PasswordHash,
+		IsAdmin:              user.IsAdmin,
+		IsFrozen:             user.IsFrozen,
+		Plan:                 user.Plan,
+		CreatedAt:            user.CreatedAt,
+		UpdatedAt:            user.UpdatedAt,
+		PendingEmail:         user.PendingEmail,
+		EmailChangeTokenHash: user.EmailChangeTokenHash,
+		RequireCallApproval:  user.RequireCallApproval,
+		TimeZone:             user.TimeZone,
+		SilentDelivery:       user.SilentDelivery,
+		Volume:               user.Volume,
+		Vibrate:              user.Vibrate,
+		NotifyOnConfirmation: user.NotifyOnConfirmation,
+		NotifyOnExpiration:   user.NotifyOnExpiration,
+		NotifyOnNewLogin:     user.NotifyOnNewLogin,
+		Points:               user.Points,
+	}
+	if user.PasswordHistory != nil {
+		userCopy.PasswordHistory = append([]string{}, user.PasswordHistory...)
+	}
+	if user.CallWindow != nil {
+		userCopy.CallWindow = copyCallWindow(user.CallWindow)
+	}
+	if user.ProxyConfirmerIDs != nil {
+		userCopy.ProxyConfirmerIDs = append([]string{}, user.ProxyConfirmerIDs...)
+	}
+	if user.KnownLogins != nil {
+		userCopy.KnownLogins = append([]entity.KnownLogin{}, user.KnownLogins...)
+	}
+	if user.EmailChangeExpiresAt != nil {
+		expiresAt := *user.EmailChangeExpiresAt
+		userCopy.EmailChangeExpiresAt = &expiresAt
+	}
+	if user.UsernameChangedAt != nil {
+		changedAt := *user.UsernameChangedAt
+		userCopy.UsernameChangedAt = &changedAt
+	}
+	if user.DeletionScheduledAt != nil {
+		scheduledAt := *user.DeletionScheduledAt
+		userCopy.DeletionScheduledAt = &scheduledAt
+	}
+	if user.ForwardCallsTo != nil {
+		forwardTo := *user.ForwardCallsTo
+		userCopy.ForwardCallsTo = &forwardTo
+	}
+	if user.ForwardCallsFrom != nil {
+		forwardFrom := *user.ForwardCallsFrom
+		userCopy.ForwardCallsFrom = &forwardFrom
+	}
+	if user.ForwardCallsUntil != nil {
+		forwardUntil := *user.ForwardCallsUntil
+		userCopy.ForwardCallsUntil = &forwardUntil
+	}
+	return userCopy
+}
+
+// copyCallWindow は受信許可時間帯のディープコピーを作成する
+func copyCallWindow(window *valueobject.CallWindow) *valueobject.CallWindow {
+	windowCopy := *window
+	if window.Weekdays != nil {
+		windowCopy.Weekdays = append([]time.Weekday{}, window.Weekdays...)
+	}
+	return &windowCopy
+}
+
+// version は現在のスナップショットのバージョンを返す（トランザクション内で変更したかの判定用）
+func (r *UserRepository) version() uint64 {
+	return r.state.Load().version
+}
+
+// snapshot は現在の状態を参照する新しいリポジトリを返す（トランザクション用）
+// スナップショットは変更されないため複製せずに共有し、トランザクション内の書き込みは共有した状態の複製に対して行う
+func (r *UserRepository) snapshot() *UserRepository {
+	return newUserRepositoryFrom(r.state.Load())
+}
+
+// hasConflict はトランザクションで変更したユーザーを反映できないかを判定する（呼び出し側でロックを取得すること）
+// 変更したユーザーが開始後に他から変更された場合と、反映するとユーザー名・メールアドレスが重複する場合に競合とする
+func (r *UserRepository) hasConflict(base *userState, src *UserRepository, ids []string) bool {
+	current := r.state.Load()
+	if modifiedKeysSince(&base.users, &current.users, ids) {
+		return true
+	}
+	changed := idSet(ids)
+	users := &src.state.Load().users
+	for _, id := range ids {
+		user, exists := users.get(id)
+		if !exists {
+			continue
+		}
+		if keyTakenByOther(&current.usernameIndex, strings.ToLower(user.Username), id, changed) ||
+			keyTakenByOther(&current.emailIndex, strings.ToLower(user.Email), id, changed) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyChanges はトランザクション用の複製で作成・更新・削除されたユーザーを反映する（呼び出し側でロックを取得すること）
+func (r *UserRepository) applyChanges(src *UserRepository, ids []string) {
+	next := r.state.Load().next()
+	users := &src.state.Load().users
+
+	// 先にすべて取り除いてから追加し、変更したユーザー同士でインデックスを消し合わないようにする
+	for _, id := range ids {
+		if existing, exists := next.users.get(id); exists {
+			next.usernameIndex.delete(strings.ToLower(existing.Username))
+			next.emailIndex.delete(strings.ToLower(existing.Email))
+			next.users.delete(id)
+		}
+	}
+	for _, id := range ids {
+		if user, exists := users.get(id); exists {
+			next.users.set(id, user)
+			next.usernameIndex.set(strings.ToLower(user.Username), id)
+			next.emailIndex.set(strings.ToLower(user.Email), id)
+		}
 	}
+	r.state.Store(next)
 }
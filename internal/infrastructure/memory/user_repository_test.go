@@ -5,11 +5,13 @@ import (
 	"errors"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/ochamu/morning-call-api/internal/domain/entity"
 	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
 )
 
 func TestUserRepository_Create(t *testing.T) {
@@ -124,6 +126,43 @@ func TestUserRepository_FindByID(t *testing.T) {
 	}
 }
 
+func TestUserRepository_FindByIDs(t *testing.T) {
+	ctx := context.Background()
+	repo := NewUserRepository()
+
+	for _, u := range []*entity.User{
+		createTestUser("user1", "user1", "user1@example.com"),
+		createTestUser("user2", "user2", "user2@example.com"),
+	} {
+		if err := repo.Create(ctx, u); err != nil {
+			t.Fatalf("Failed to create test user: %v", err)
+		}
+	}
+
+	got, err := repo.FindByIDs(ctx, []string{"user2", "nonexistent", "user1", "user2"})
+	if err != nil {
+		t.Fatalf("FindByIDs() error = %v", err)
+	}
+
+	// 存在しないIDは除外し、重複は1件にまとめ、指定順を保つ
+	want := []string{"user2", "user1"}
+	if len(got) != len(want) {
+		t.Fatalf("FindByIDs() returned %d users, want %d", len(got), len(want))
+	}
+	for i, id := range want {
+		if got[i].ID != id {
+			t.Errorf("FindByIDs()[%d] = %s, want %s", i, got[i].ID, id)
+		}
+	}
+
+	// 返されたユーザーの変更がリポジトリに影響しないこと
+	got[0].Username = "changed"
+	stored, _ := repo.FindByID(ctx, "user2")
+	if stored.Username != "user2" {
+		t.Errorf("stored Username = %s, want user2", stored.Username)
+	}
+}
+
 func TestUserRepository_FindByUsername(t *testing.T) {
 	ctx := context.Background()
 	repo := NewUserRepository()
@@ -303,6 +342,97 @@ func TestUserRepository_Update(t *testing.T) {
 	}
 }
 
+func TestUserRepository_AddPoints(t *testing.T) {
+	ctx := context.Background()
+	repo := NewUserRepository()
+	if err := repo.Create(ctx, &entity.User{ID: "user1", Username: "user1", Email: "user1@example.com", Points: 5}); err != nil {
+		t.Fatalf("Create() unexpected error = %v", err)
+	}
+
+	t.Run("同時に加算しても取りこぼさない", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if _, err := repo.AddPoints(ctx, "user1", 10); err != nil {
+					t.Errorf("AddPoints() unexpected error = %v", err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		user, _ := repo.FindByID(ctx, "user1")
+		if user.Points != 205 {
+			t.Errorf("Points = %d, want 205", user.Points)
+		}
+	})
+
+	t.Run("Updateでは保存済みのポイントを維持する", func(t *testing.T) {
+		stale, _ := repo.FindByID(ctx, "user1")
+		if _, err := repo.AddPoints(ctx, "user1", 10); err != nil {
+			t.Fatalf("AddPoints() unexpected error = %v", err)
+		}
+		stale.TimeZone = "Asia/Tokyo"
+		stale.Points = 0
+		if err := repo.Update(ctx, stale); err != nil {
+			t.Fatalf("Update() unexpected error = %v", err)
+		}
+
+		user, _ := repo.FindByID(ctx, "user1")
+		if user.Points != 215 || user.TimeZone != "Asia/Tokyo" {
+			t.Errorf("Points = %d, TimeZone = %q, want 215 and Asia/Tokyo", user.Points, user.TimeZone)
+		}
+	})
+
+	t.Run("不正な引数", func(t *testing.T) {
+		if _, err := repo.AddPoints(ctx, "user1", 0); !errors.Is(err, repository.ErrInvalidArgument) {
+			t.Errorf("AddPoints(0) error = %v, want ErrInvalidArgument", err)
+		}
+		if _, err := repo.AddPoints(ctx, "unknown", 10); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("AddPoints(unknown) error = %v, want ErrNotFound", err)
+		}
+	})
+}
+
+func TestUserRepository_FindTopByPoints(t *testing.T) {
+	ctx := context.Background()
+	repo := NewUserRepository()
+	for _, u := range []*entity.User{
+		{ID: "user1", Username: "user1", Email: "user1@example.com", Points: 10},
+		{ID: "user2", Username: "user2", Email: "user2@example.com", Points: 30},
+		{ID: "user3", Username: "user3", Email: "user3@example.com", Points: 10},
+		{ID: "user4", Username: "user4", Email: "user4@example.com"},
+		{ID: "user5", Username: "user5", Email: "user5@example.com", Points: 50, IsFrozen: true},
+	} {
+		if err := repo.Create(ctx, u); err != nil {
+			t.Fatalf("Create() unexpected error = %v", err)
+		}
+	}
+
+	got, err := repo.FindTopByPoints(ctx, 10)
+	if err != nil {
+		t.Fatalf("FindTopByPoints() unexpected error = %v", err)
+	}
+	// ポイントの降順、同点はIDの昇順。0ポイントと凍結中のユーザーは含めない
+	wantIDs := []string{"user2", "user1", "user3"}
+	if len(got) != len(wantIDs) {
+		t.Fatalf("FindTopByPoints() = %d users, want %d", len(got), len(wantIDs))
+	}
+	for i, id := range wantIDs {
+		if got[i].ID != id {
+			t.Errorf("FindTopByPoints()[%d] = %s, want %s", i, got[i].ID, id)
+		}
+	}
+
+	if got, _ := repo.FindTopByPoints(ctx, 1); len(got) != 1 || got[0].ID != "user2" {
+		t.Errorf("FindTopByPoints(1) = %v, want [user2]", got)
+	}
+	if _, err := repo.FindTopByPoints(ctx, -1); !errors.Is(err, repository.ErrInvalidArgument) {
+		t.Errorf("FindTopByPoints(-1) error = %v, want ErrInvalidArgument", err)
+	}
+}
+
 func TestUserRepository_Delete(t *testing.T) {
 	ctx := context.Background()
 
@@ -636,6 +766,45 @@ func TestUserRepository_Count(t *testing.T) {
 	}
 }
 
+func TestUserRepository_Stats(t *testing.T) {
+	ctx := context.Background()
+	repo := NewUserRepository()
+
+	for i := 1; i <= 4; i++ {
+		user := createTestUser(
+			"user"+strconv.Itoa(i),
+			"user"+strconv.Itoa(i),
+			"user"+strconv.Itoa(i)+"@example.com",
+		)
+		// 偶数番目は有料プラン、1番目はプラン未設定（フリープラン扱い）
+		switch {
+		case i%2 == 0:
+			user.Plan = valueobject.PlanPremium
+		case i == 1:
+			user.Plan = ""
+		}
+		if err := repo.Create(ctx, user); err != nil {
+			t.Fatalf("Failed to create test user user%d: %v", i, err)
+		}
+	}
+
+	stats, err := repo.Stats(ctx)
+	if err != nil {
+		t.Fatalf("Stats() error = %v", err)
+	}
+
+	count, _ := repo.Count(ctx)
+	if stats.Total != count {
+		t.Errorf("Stats().Total = %d, Count() = %d", stats.Total, count)
+	}
+	if stats.Breakdown[valueobject.PlanFree.String()] != 2 {
+		t.Errorf("Stats().Breakdown[free] = %d, want 2", stats.Breakdown[valueobject.PlanFree.String()])
+	}
+	if stats.Breakdown[valueobject.PlanPremium.String()] != 2 {
+		t.Errorf("Stats().Breakdown[premium] = %d, want 2", stats.Breakdown[valueobject.PlanPremium.String()])
+	}
+}
+
 func TestUserRepository_ConcurrentAccess(t *testing.T) {
 	ctx := context.Background()
 	repo := NewUserRepository()
@@ -717,9 +886,226 @@ func TestUserRepository_DataIsolation(t *testing.T) {
 	}
 }
 
+func TestUserRepository_SnapshotConsistency(t *testing.T) {
+	ctx := context.Background()
+	repo := NewUserRepository()
+	if err := repo.Create(ctx, createTestUser("user1", "before", "user1@example.com")); err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+	before := repo.state.Load()
+
+	// 書き込みの完了後に開始した読み取りは最新の状態を返す
+	updated := createTestUser("user1", "after", "user1@example.com")
+	if err := repo.Update(ctx, updated); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if user, err := repo.FindByUsername(ctx, "after"); err != nil || user.ID != "user1" {
+		t.Errorf("FindByUsername(after) = %v, %v, want user1", user, err)
+	}
+	if _, err := repo.FindByUsername(ctx, "before"); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("FindByUsername(before) error = %v, want ErrNotFound", err)
+	}
+
+	// 書き込み前のスナップショットは変更されない（コピーオンライト）
+	user, _ := before.users.get("user1")
+	owner, _ := before.usernameIndex.get("before")
+	if user.Username != "before" || owner != "user1" || before.usernameIndex.len() != 1 {
+		t.Errorf("snapshot was modified by Update: username = %s, owner = %s", user.Username, owner)
+	}
+	if repo.version() != before.version+1 {
+		t.Errorf("version() = %d, want %d", repo.version(), before.version+1)
+	}
+}
+
+func TestUserRepository_ConcurrentSnapshotReads(t *testing.T) {
+	ctx := context.Background()
+	repo := NewUserRepository()
+	if err := repo.Create(ctx, createTestUser("user1", "name0", "user1@example.com")); err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+
+	// ユーザー名の変更と並行して、読み取りがインデックスと本体の食い違った状態を見ないことを確認する
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				s := repo.state.Load()
+				user, _ := s.users.get("user1")
+				owner, _ := s.usernameIndex.get(user.Username)
+				if s.usernameIndex.len() != 1 || owner != "user1" {
+					t.Errorf("inconsistent snapshot: username = %s, owner = %s", user.Username, owner)
+					return
+				}
+			}
+		}()
+	}
+
+	for i := 1; i <= 200; i++ {
+		user := createTestUser("user1", "name"+strconv.Itoa(i), "user1@example.com")
+		if err := repo.Update(ctx, user); err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+	}
+	close(done)
+	wg.Wait()
+}
+
+// rwMutexUserStore はスナップショット導入前と同じく読み書きともにRWMutexで保護する比較用のストア
+type rwMutexUserStore struct {
+	mu    sync.RWMutex
+	users map[string]*entity.User
+	repo  *UserRepository // copyUserの利用のみ
+}
+
+func (s *rwMutexUserStore) FindByID(ctx context.Context, id string) (*entity.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	user, exists := s.users[id]
+	if !exists {
+		return nil, repository.ErrNotFound
+	}
+	return s.repo.copyUser(user), nil
+}
+
+func (s *rwMutexUserStore) Update(ctx context.Context, user *entity.User) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.users[user.ID] = s.repo.copyUser(user)
+	return nil
+}
+
+// fullCopyUserStore はシャード分割の導入前と同じく書き込みのたびにマップ全体を複製する比較用のストア
+type fullCopyUserStore struct {
+	mu    sync.Mutex
+	users atomic.Pointer[map[string]*entity.User]
+	repo  *UserRepository // copyUserの利用のみ
+}
+
+func (s *fullCopyUserStore) FindByID(ctx context.Context, id string) (*entity.User, error) {
+	user, exists := (*s.users.Load())[id]
+	if !exists {
+		return nil, repository.ErrNotFound
+	}
+	return s.repo.copyUser(user), nil
+}
+
+func (s *fullCopyUserStore) Update(ctx context.Context, user *entity.User) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var users map[string]*entity.User
+	if current := s.users.Load(); current != nil {
+		users = *current
+	}
+	next := cloneMap(users, 1)
+	next[user.ID] = s.repo.copyUser(user)
+	s.users.Store(&next)
+	return nil
+}
+
+// 読み取りが多い並行アクセスでの性能を、ロックなしのスナップショット参照・マップ全体の複製・RWMutexで比較する
+// 書き込みの割合（writeEveryごとに1回、0は読み取りのみ）を変えて、書き込みの複製コストが上回る境目を確認する
+//
+//	go test ./internal/infrastructure/memory -run '^$' -bench ReadHeavy -cpu 1,4,8
+func BenchmarkUserRepository_ReadHeavy(b *testing.B) {
+	const numUsers = 1000
+	ctx := context.Background()
+
+	snapshot := NewUserRepository()
+	fullCopy := &fullCopyUserStore{repo: snapshot}
+	locked := &rwMutexUserStore{users: make(map[string]*entity.User), repo: snapshot}
+	users := make([]*entity.User, numUsers)
+	for i := range users {
+		users[i] = createTestUser("user"+strconv.Itoa(i), "user"+strconv.Itoa(i), "user"+strconv.Itoa(i)+"@example.com")
+		if err := snapshot.Create(ctx, users[i]); err != nil {
+			b.Fatalf("Create() error = %v", err)
+		}
+		_ = fullCopy.Update(ctx, users[i])
+		_ = locked.Update(ctx, users[i])
+	}
+
+	for _, bm := range []struct {
+		name string
+		repo interface {
+			FindByID(ctx context.Context, id string) (*entity.User, error)
+			Update(ctx context.Context, user *entity.User) error
+		}
+	}{
+		{"Snapshot", snapshot},
+		{"FullCopy", fullCopy},
+		{"RWMutex", locked},
+	} {
+		for _, writeEvery := range []int{0, 10000, 100} {
+			b.Run(bm.name+"/writeEvery="+strconv.Itoa(writeEvery), func(b *testing.B) {
+				b.RunParallel(func(pb *testing.PB) {
+					i := 1
+					for pb.Next() {
+						user := users[i%numUsers]
+						if writeEvery > 0 && i%writeEvery == 0 {
+							_ = bm.repo.Update(ctx, user)
+						} else {
+							_, _ = bm.repo.FindByID(ctx, user.ID)
+						}
+						i++
+					}
+				})
+			})
+		}
+	}
+}
+
+// 書き込み1回のコストがユーザー数に比例しないことを、マップ全体を複製する方式と比較して確認する
+//
+//	go test ./internal/infrastructure/memory -run '^$' -bench 'UserRepository_Update$'
+func BenchmarkUserRepository_Update(b *testing.B) {
+	ctx := context.Background()
+
+	for _, numUsers := range []int{1000, 10000, 100000} {
+		snapshot := NewUserRepository()
+		fullCopy := &fullCopyUserStore{repo: snapshot}
+		users := make([]*entity.User, numUsers)
+		for i := range users {
+			users[i] = createTestUser("user"+strconv.Itoa(i), "user"+strconv.Itoa(i), "user"+strconv.Itoa(i)+"@example.com")
+		}
+		if _, err := snapshot.BulkCreate(ctx, users, repository.BulkCreateAllOrNothing); err != nil {
+			b.Fatalf("BulkCreate() error = %v", err)
+		}
+		stored := make(map[string]*entity.User, numUsers)
+		for _, user := range users {
+			stored[user.ID] = snapshot.copyUser(user)
+		}
+		fullCopy.users.Store(&stored)
+
+		for _, bm := range []struct {
+			name string
+			repo interface {
+				Update(ctx context.Context, user *entity.User) error
+			}
+		}{
+			{"Snapshot", snapshot},
+			{"FullCopy", fullCopy},
+		} {
+			b.Run(bm.name+"/users="+strconv.Itoa(numUsers), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					if err := bm.repo.Update(ctx, users[i%numUsers]); err != nil {
+						b.Fatalf("Update() error = %v", err)
+					}
+				}
+			})
+		}
+	}
+}
+
 // ヘルパー関数：テスト用ユーザーを作成
 func createTestUser(id, username, email string) *entity.User {
-	user, reason := entity.NewUser(id, username, email, "hashedpassword")
+	user, reason := entity.NewUser(id, username, email, "hashedpassword", valueobject.DefaultInputLimits())
 	if reason.IsNG() {
 		// テスト用なので、エラーの場合は直接構造体を作成
 		return &entity.User{
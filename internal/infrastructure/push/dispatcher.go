@@ -0,0 +1,78 @@
+// Package push はユーザーが登録した端末へのプッシュ通知の配信を提供する
+// 配信サービスとの通信はプラットフォームごとのservice.PushSenderに任せ、このパッケージは配信先の選択と無効なトークンの記録を担う
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/pkg/utils"
+)
+
+// Dispatcher はユーザーが登録したすべての有効な端末へプッシュ通知を配信する
+// 通知の送信元（起床確認・期限切れ通知など）はユーザーIDを指定するだけでよく、端末やプラットフォームを意識しない
+type Dispatcher struct {
+	deviceRepo repository.DeviceTokenRepository
+	senders    map[valueobject.DevicePlatform]service.PushSender
+	now        func() time.Time
+}
+
+// NewDispatcher は新しいDispatcherを作成する
+// sendersに配信サービスが登録されていないプラットフォームの端末には配信しない
+func NewDispatcher(deviceRepo repository.DeviceTokenRepository, senders map[valueobject.DevicePlatform]service.PushSender) *Dispatcher {
+	registered := make(map[valueobject.DevicePlatform]service.PushSender, len(senders))
+	for platform, sender := range senders {
+		registered[platform] = sender
+	}
+	return &Dispatcher{
+		deviceRepo: deviceRepo,
+		senders:    registered,
+		now:        time.Now,
+	}
+}
+
+// NotifyUser はユーザーのすべての有効な端末へ通知を送り、配信できた端末数を返す
+// 配信サービスが無効と判定したトークンは無効化して記録し、以降は配信しない（削除はクリーンアップで行う）
+// 一部の端末への配信に失敗しても残りの端末への配信は続け、失敗をまとめて返す
+func (d *Dispatcher) NotifyUser(ctx context.Context, userID string, message service.PushMessage) (int, error) {
+	devices, err := d.deviceRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find devices of user %s: %w", userID, err)
+	}
+
+	delivered := 0
+	var errs []error
+	for _, device := range devices {
+		if device.IsInvalidated() {
+			continue
+		}
+		sender, ok := d.senders[device.Platform]
+		if !ok {
+			continue
+		}
+
+		err := sender.Send(ctx, device.Token, message)
+		if err == nil {
+			delivered++
+			continue
+		}
+		if !errors.Is(err, service.ErrInvalidDeviceToken) {
+			errs = append(errs, fmt.Errorf("failed to push to device %s: %w", device.ID, err))
+			continue
+		}
+
+		device.Invalidate(d.now())
+		if err := d.deviceRepo.Update(ctx, device); err != nil && !errors.Is(err, repository.ErrNotFound) {
+			errs = append(errs, fmt.Errorf("failed to invalidate device %s: %w", device.ID, err))
+			continue
+		}
+		utils.Logf(ctx, "無効なデバイストークンを無効化しました: device=%s platform=%s", device.ID, device.Platform)
+	}
+
+	return delivered, errors.Join(errs...)
+}
@@ -0,0 +1,86 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+// fakeSender は送信先のトークンを記録し、指定したトークンへの送信を失敗させるPushSender
+type fakeSender struct {
+	sent   []string
+	errors map[string]error
+}
+
+func (s *fakeSender) Send(ctx context.Context, token string, message service.PushMessage) error {
+	if err := s.errors[token]; err != nil {
+		return err
+	}
+	s.sent = append(s.sent, token)
+	return nil
+}
+
+func TestDispatcher_NotifyUser(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 15, 7, 0, 0, 0, time.UTC)
+	deviceRepo := memory.NewDeviceTokenRepository()
+
+	invalidatedAt := now.Add(-time.Hour)
+	for _, d := range []*entity.DeviceToken{
+		{ID: "ios", UserID: "user1", Token: "ios-token", Platform: valueobject.DevicePlatformIOS, CreatedAt: now},
+		{ID: "android", UserID: "user1", Token: "android-token", Platform: valueobject.DevicePlatformAndroid, CreatedAt: now},
+		{ID: "expired", UserID: "user1", Token: "expired-token", Platform: valueobject.DevicePlatformAndroid, CreatedAt: now},
+		{ID: "broken", UserID: "user1", Token: "broken-token", Platform: valueobject.DevicePlatformAndroid, CreatedAt: now},
+		{ID: "web", UserID: "user1", Token: "web-token", Platform: valueobject.DevicePlatformWeb, CreatedAt: now},
+		{ID: "old", UserID: "user1", Token: "old-token", Platform: valueobject.DevicePlatformIOS, CreatedAt: now, InvalidatedAt: &invalidatedAt},
+		{ID: "other", UserID: "user2", Token: "other-token", Platform: valueobject.DevicePlatformIOS, CreatedAt: now},
+	} {
+		if err := deviceRepo.Create(ctx, d); err != nil {
+			t.Fatalf("failed to create device: %v", err)
+		}
+	}
+
+	errUnavailable := errors.New("unavailable")
+	ios := &fakeSender{}
+	android := &fakeSender{errors: map[string]error{
+		"expired-token": service.ErrInvalidDeviceToken,
+		"broken-token":  errUnavailable,
+	}}
+	// Webの配信サービスは未登録
+	dispatcher := NewDispatcher(deviceRepo, map[valueobject.DevicePlatform]service.PushSender{
+		valueobject.DevicePlatformIOS:     ios,
+		valueobject.DevicePlatformAndroid: android,
+	})
+	dispatcher.now = func() time.Time { return now }
+
+	delivered, err := dispatcher.NotifyUser(ctx, "user1", service.PushMessage{Title: "おはよう", Body: "起きる時間です"})
+	if !errors.Is(err, errUnavailable) {
+		t.Errorf("NotifyUser() error = %v, want errUnavailable", err)
+	}
+	if delivered != 2 {
+		t.Errorf("delivered = %d, want 2", delivered)
+	}
+	// 無効化済みの端末や他のユーザーの端末には送らない
+	if len(ios.sent) != 1 || ios.sent[0] != "ios-token" {
+		t.Errorf("ios sent = %v, want [ios-token]", ios.sent)
+	}
+	if len(android.sent) != 1 || android.sent[0] != "android-token" {
+		t.Errorf("android sent = %v, want [android-token]", android.sent)
+	}
+
+	// 配信サービスが無効と判定したトークンだけを無効化する
+	expired, _ := deviceRepo.FindByID(ctx, "expired")
+	if !expired.IsInvalidated() || !expired.InvalidatedAt.Equal(now) {
+		t.Errorf("expired InvalidatedAt = %v, want %v", expired.InvalidatedAt, now)
+	}
+	broken, _ := deviceRepo.FindByID(ctx, "broken")
+	if broken.IsInvalidated() {
+		t.Error("temporarily failed device should not be invalidated")
+	}
+}
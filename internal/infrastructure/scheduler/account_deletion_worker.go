@@ -0,0 +1,195 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+)
+
+const (
+	// DefaultAccountDeletionInterval は削除予定アカウントの完全削除のデフォルト実行間隔
+	DefaultAccountDeletionInterval = 1 * time.Hour
+
+	// accountDeletionBatchSize は関連データをリポジトリから一度に取得する件数
+	accountDeletionBatchSize = 100
+)
+
+// AccountDeletionConfig はアカウント完全削除ワーカーの設定
+type AccountDeletionConfig struct {
+	Interval time.Duration // 完全削除の実行間隔
+}
+
+// AccountDeletionWorker は猶予期間を過ぎた削除予定のアカウントを関連データとともに完全に削除するワーカー
+// 関連データ（モーニングコールとそのコメント・友達関係・デバイストークン）を先に削除し、最後にユーザーを削除する
+// 途中で失敗してもユーザーが残るため、次回の実行で続きから削除される
+type AccountDeletionWorker struct {
+	*periodicRunner[int]
+
+	userRepo         repository.UserRepository
+	morningCallRepo  repository.MorningCallRepository
+	commentRepo      repository.CommentRepository
+	relationshipRepo repository.RelationshipRepository
+	deviceRepo       repository.DeviceTokenRepository
+	auditLogger      service.AuditLogger
+	config           AccountDeletionConfig
+	now              func() time.Time
+}
+
+// NewAccountDeletionWorker は新しいアカウント完全削除ワーカーを作成する
+// 設定値が未指定（ゼロ値）の項目にはデフォルト値を使用する
+func NewAccountDeletionWorker(
+	userRepo repository.UserRepository,
+	morningCallRepo repository.MorningCallRepository,
+	commentRepo repository.CommentRepository,
+	relationshipRepo repository.RelationshipRepository,
+	deviceRepo repository.DeviceTokenRepository,
+	auditLogger service.AuditLogger,
+	config AccountDeletionConfig,
+) *AccountDeletionWorker {
+	if config.Interval <= 0 {
+		config.Interval = DefaultAccountDeletionInterval
+	}
+
+	w := &AccountDeletionWorker{
+		userRepo:         userRepo,
+		morningCallRepo:  morningCallRepo,
+		commentRepo:      commentRepo,
+		relationshipRepo: relationshipRepo,
+		deviceRepo:       deviceRepo,
+		auditLogger:      auditLogger,
+		config:           config,
+		now:              time.Now,
+	}
+	w.periodicRunner = newPeriodicRunner(config.Interval, w.RunOnce, logCount("削除予定アカウントの完全削除に失敗しました", "猶予期間を過ぎたアカウントを%d件削除しました"))
+	return w
+}
+
+// RunOnce は完全削除を1回実行し、削除したアカウントの件数を返す
+func (w *AccountDeletionWorker) RunOnce(ctx context.Context) (int, error) {
+	users, err := w.userRepo.FindDeletionDue(ctx, w.now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to find accounts due for deletion: %w", err)
+	}
+
+	purged := 0
+	for _, user := range users {
+		if err := w.purge(ctx, user); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+
+	return purged, nil
+}
+
+// purge は1件のアカウントを関連データとともに削除し、監査ログに記録する
+func (w *AccountDeletionWorker) purge(ctx context.Context, user *entity.User) error {
+	morningCalls, err := w.deleteMorningCalls(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	relationships, err := w.deleteRelationships(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	devices, err := w.deviceRepo.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("failed to find device tokens of user %s: %w", user.ID, err)
+	}
+	for _, device := range devices {
+		if err := w.deviceRepo.Delete(ctx, device.ID); err != nil && !errors.Is(err, repository.ErrNotFound) {
+			return fmt.Errorf("failed to delete device token %s: %w", device.ID, err)
+		}
+	}
+
+	if err := w.userRepo.Delete(ctx, user.ID); err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return fmt.Errorf("failed to delete user %s: %w", user.ID, err)
+	}
+
+	if w.auditLogger != nil {
+		// 削除後のアカウントを特定できる情報（ユーザー名・メールアドレス）は記録しない
+		entry := service.AuditEntry{
+			Action:     "user.purged",
+			ActorID:    service.AuditActorSystem,
+			TargetType: "user",
+			TargetID:   user.ID,
+			Details: map[string]string{
+				"deletion_scheduled_at": user.DeletionScheduledAt.Format(time.RFC3339),
+				"morning_calls":         strconv.Itoa(morningCalls),
+				"relationships":         strconv.Itoa(relationships),
+				"devices":               strconv.Itoa(len(devices)),
+			},
+			OccurredAt: w.now(),
+		}
+		if err := w.auditLogger.Record(ctx, entry); err != nil {
+			// 監査ログの失敗で削除自体は巻き戻さない
+			log.Printf("監査ログの記録に失敗しました: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// deleteMorningCalls はユーザーが送信者または受信者のモーニングコールをコメントとともにすべて削除し、削除した件数を返す
+func (w *AccountDeletionWorker) deleteMorningCalls(ctx context.Context, userID string) (int, error) {
+	// 削除するとページ位置がずれるため、先に対象を全件収集する
+	ids := make(map[string]struct{})
+	finders := []func(ctx context.Context, userID string, offset, limit int) ([]*entity.MorningCall, error){
+		w.morningCallRepo.FindBySenderID,
+		w.morningCallRepo.FindByReceiverID,
+	}
+	for _, find := range finders {
+		for offset := 0; ; offset += accountDeletionBatchSize {
+			batch, err := find(ctx, userID, offset, accountDeletionBatchSize)
+			if err != nil {
+				return 0, fmt.Errorf("failed to find morning calls of user %s: %w", userID, err)
+			}
+			for _, mc := range batch {
+				ids[mc.ID] = struct{}{}
+			}
+			if len(batch) < accountDeletionBatchSize {
+				break
+			}
+		}
+	}
+
+	for id := range ids {
+		// コールを先に削除すると失敗時に次回の実行でコメントを見つけられないため、コメントから削除する
+		if _, err := w.commentRepo.DeleteByCallID(ctx, id); err != nil {
+			return 0, fmt.Errorf("failed to delete comments of morning call %s: %w", id, err)
+		}
+		if err := w.morningCallRepo.Delete(ctx, id); err != nil && !errors.Is(err, repository.ErrNotFound) {
+			return 0, fmt.Errorf("failed to delete morning call %s: %w", id, err)
+		}
+	}
+	return len(ids), nil
+}
+
+// deleteRelationships はユーザーが含まれる友達関係・リクエスト・ブロックをすべて削除し、削除した件数を返す
+func (w *AccountDeletionWorker) deleteRelationships(ctx context.Context, userID string) (int, error) {
+	var relationships []*entity.Relationship
+	for offset := 0; ; offset += accountDeletionBatchSize {
+		batch, err := w.relationshipRepo.FindByUserID(ctx, userID, offset, accountDeletionBatchSize)
+		if err != nil {
+			return 0, fmt.Errorf("failed to find relationships of user %s: %w", userID, err)
+		}
+		relationships = append(relationships, batch...)
+		if len(batch) < accountDeletionBatchSize {
+			break
+		}
+	}
+
+	for _, rel := range relationships {
+		if err := w.relationshipRepo.Delete(ctx, rel.ID); err != nil && !errors.Is(err, repository.ErrNotFound) {
+			return 0, fmt.Errorf("failed to delete relationship %s: %w", rel.ID, err)
+		}
+	}
+	return len(relationships), nil
+}
@@ -0,0 +1,155 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/audit"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestNewAccountDeletionWorker_Defaults(t *testing.T) {
+	worker := NewAccountDeletionWorker(memory.NewUserRepository(), memory.NewMorningCallRepository(), memory.NewCommentRepository(),
+		memory.NewRelationshipRepository(), memory.NewDeviceTokenRepository(), nil, AccountDeletionConfig{})
+
+	if worker.config.Interval != DefaultAccountDeletionInterval {
+		t.Errorf("Interval = %v, want %v", worker.config.Interval, DefaultAccountDeletionInterval)
+	}
+}
+
+func TestAccountDeletionWorker_RunOnce(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 6, 1, 7, 0, 0, 0, time.UTC)
+	due := now.Add(-time.Minute)
+	notYet := now.Add(time.Hour)
+
+	userRepo := memory.NewUserRepository()
+	morningCallRepo := memory.NewMorningCallRepository()
+	commentRepo := memory.NewCommentRepository()
+	relationshipRepo := memory.NewRelationshipRepository()
+	deviceRepo := memory.NewDeviceTokenRepository()
+	auditLogger := audit.NewMemoryAuditLogger()
+
+	for _, u := range []*entity.User{
+		{ID: "leaving", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed", DeletionScheduledAt: &due},
+		{ID: "grace", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed", DeletionScheduledAt: &notYet},
+		{ID: "staying", Username: "carol", Email: "carol@example.com", PasswordHash: "hashed"},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+	for _, mc := range []*entity.MorningCall{
+		{ID: "sent", SenderID: "leaving", ReceiverID: "staying"},
+		{ID: "received", SenderID: "staying", ReceiverID: "leaving"},
+		{ID: "self", SenderID: "leaving", ReceiverID: "leaving"},
+		{ID: "unrelated", SenderID: "staying", ReceiverID: "grace"},
+	} {
+		mc.Status = valueobject.MorningCallStatusScheduled
+		mc.ScheduledTime = now.Add(time.Hour)
+		if err := morningCallRepo.Create(ctx, mc); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+	for _, c := range []*entity.CallComment{
+		{ID: "comment1", CallID: "sent", AuthorID: "staying", Body: "おはよう", CreatedAt: now},
+		{ID: "comment2", CallID: "received", AuthorID: "leaving", Body: "起きた", CreatedAt: now},
+		{ID: "comment3", CallID: "unrelated", AuthorID: "grace", Body: "起きた", CreatedAt: now},
+	} {
+		if err := commentRepo.Create(ctx, c); err != nil {
+			t.Fatalf("failed to create comment: %v", err)
+		}
+	}
+	for _, rel := range []*entity.Relationship{
+		{ID: "friend", RequesterID: "leaving", ReceiverID: "staying", Status: valueobject.RelationshipStatusAccepted},
+		{ID: "blocked", RequesterID: "grace", ReceiverID: "leaving", Status: valueobject.RelationshipStatusBlocked},
+		{ID: "other", RequesterID: "grace", ReceiverID: "staying", Status: valueobject.RelationshipStatusAccepted},
+	} {
+		if err := relationshipRepo.Create(ctx, rel); err != nil {
+			t.Fatalf("failed to create relationship: %v", err)
+		}
+	}
+	for _, d := range []*entity.DeviceToken{
+		{ID: "device1", UserID: "leaving", Token: "token1", Platform: valueobject.DevicePlatformIOS},
+		{ID: "device2", UserID: "staying", Token: "token2", Platform: valueobject.DevicePlatformIOS},
+	} {
+		if err := deviceRepo.Create(ctx, d); err != nil {
+			t.Fatalf("failed to create device token: %v", err)
+		}
+	}
+
+	worker := NewAccountDeletionWorker(userRepo, morningCallRepo, commentRepo, relationshipRepo, deviceRepo, auditLogger, AccountDeletionConfig{})
+	worker.now = func() time.Time { return now }
+
+	count, err := worker.RunOnce(ctx)
+	if err != nil {
+		t.Fatalf("RunOnce() unexpected error: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("count = %d, want 1", count)
+	}
+
+	// 猶予期間を過ぎたアカウントと関連データは削除される
+	if _, err := userRepo.FindByID(ctx, "leaving"); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("leaving user should be deleted: %v", err)
+	}
+	for _, id := range []string{"sent", "received", "self"} {
+		if _, err := morningCallRepo.FindByID(ctx, id); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("morning call %s should be deleted: %v", id, err)
+		}
+		if n, _ := commentRepo.CountByCallID(ctx, id); n != 0 {
+			t.Errorf("comments of morning call %s should be deleted: %d remain", id, n)
+		}
+	}
+	for _, id := range []string{"friend", "blocked"} {
+		if _, err := relationshipRepo.FindByID(ctx, id); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("relationship %s should be deleted: %v", id, err)
+		}
+	}
+	if _, err := deviceRepo.FindByID(ctx, "device1"); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("device1 should be deleted: %v", err)
+	}
+
+	// 猶予期間中・削除予定でないアカウントとそのデータは残る
+	for _, id := range []string{"grace", "staying"} {
+		if _, err := userRepo.FindByID(ctx, id); err != nil {
+			t.Errorf("user %s should remain: %v", id, err)
+		}
+	}
+	if _, err := morningCallRepo.FindByID(ctx, "unrelated"); err != nil {
+		t.Errorf("unrelated morning call should remain: %v", err)
+	}
+	if n, _ := commentRepo.CountByCallID(ctx, "unrelated"); n != 1 {
+		t.Errorf("comments of unrelated morning call = %d, want 1", n)
+	}
+	if _, err := relationshipRepo.FindByID(ctx, "other"); err != nil {
+		t.Errorf("unrelated relationship should remain: %v", err)
+	}
+	if _, err := deviceRepo.FindByID(ctx, "device2"); err != nil {
+		t.Errorf("device2 should remain: %v", err)
+	}
+
+	// 監査ログに削除件数を記録する
+	entries := auditLogger.Entries()
+	if len(entries) != 1 {
+		t.Fatalf("監査ログ件数 = %d, want 1", len(entries))
+	}
+	e := entries[0]
+	if e.Action != "user.purged" || e.TargetID != "leaving" || e.ActorID != "system" {
+		t.Errorf("予期しない監査ログ: %+v", e)
+	}
+	if e.Details["morning_calls"] != "3" || e.Details["relationships"] != "2" || e.Details["devices"] != "1" {
+		t.Errorf("監査ログの件数 = %v", e.Details)
+	}
+
+	// 2回目の実行では対象なし
+	count, err = worker.RunOnce(ctx)
+	if err != nil || count != 0 {
+		t.Errorf("second RunOnce() = %d, %v, want 0, nil", count, err)
+	}
+}
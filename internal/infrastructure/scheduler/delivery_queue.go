@@ -0,0 +1,83 @@
+package scheduler
+
+import (
+	"sort"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+)
+
+// senderQueue は1人の送信者の配信待ちコールを予定時刻順に保持する
+type senderQueue struct {
+	calls []*entity.MorningCall
+	next  int
+}
+
+// head は次に配信するコールを返す
+func (q *senderQueue) head() *entity.MorningCall {
+	return q.calls[q.next]
+}
+
+// fairDeliveryOrder は配信待ちのコールを配信する順に並べ、上限件数までを返す
+// 送信者ごとのキューから1件ずつ取り出すラウンドロビンで配分し、1人の送信者の大量のコールが
+// 他の送信者のコールを後回しにしないようにする。各ラウンドでは遅延の大きい（予定時刻の早い）コールから並べる
+// limitが0以下の場合は全件を返す
+func fairDeliveryOrder(calls []*entity.MorningCall, limit int) []*entity.MorningCall {
+	if limit <= 0 || limit > len(calls) {
+		limit = len(calls)
+	}
+
+	sorted := make([]*entity.MorningCall, len(calls))
+	copy(sorted, calls)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return deliveredBefore(sorted[i], sorted[j])
+	})
+
+	// 予定時刻順に振り分けるため、各キューも予定時刻順になる
+	queues := make([]*senderQueue, 0)
+	bySender := make(map[string]*senderQueue)
+	for _, mc := range sorted {
+		q, ok := bySender[mc.SenderID]
+		if !ok {
+			q = &senderQueue{}
+			bySender[mc.SenderID] = q
+			queues = append(queues, q)
+		}
+		q.calls = append(q.calls, mc)
+	}
+
+	result := make([]*entity.MorningCall, 0, limit)
+	for len(result) < limit {
+		sort.SliceStable(queues, func(i, j int) bool {
+			return deliveredBefore(queues[i].head(), queues[j].head())
+		})
+
+		remaining := queues[:0]
+		for _, q := range queues {
+			if len(result) < limit {
+				result = append(result, q.head())
+				q.next++
+			}
+			if q.next < len(q.calls) {
+				remaining = append(remaining, q)
+			}
+		}
+		queues = remaining
+	}
+	return result
+}
+
+// deliveredBefore はaをbより先に配信すべきかを判定する
+// 受信者がずらした後の予定時刻が早いほど遅延が大きいため優先し、同時刻の場合はIDで順序を決める
+func deliveredBefore(a, b *entity.MorningCall) bool {
+	at, bt := a.EffectiveScheduledTime(), b.EffectiveScheduledTime()
+	if !at.Equal(bt) {
+		return at.Before(bt)
+	}
+	return a.ID < b.ID
+}
+
+// deliveryDelay は配信時点での予定時刻からの遅延を返す
+func deliveryDelay(mc *entity.MorningCall, now time.Time) time.Duration {
+	return now.Sub(mc.EffectiveScheduledTime())
+}
@@ -0,0 +1,184 @@
+package scheduler
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+func newQueuedCall(id, sender string, scheduled time.Time) *entity.MorningCall {
+	return &entity.MorningCall{
+		ID:            id,
+		SenderID:      sender,
+		ReceiverID:    "receiver-" + id,
+		ScheduledTime: scheduled,
+		Status:        valueobject.MorningCallStatusScheduled,
+	}
+}
+
+func TestFairDeliveryOrder(t *testing.T) {
+	base := time.Date(2024, 6, 1, 7, 0, 0, 0, time.UTC)
+	calls := []*entity.MorningCall{
+		newQueuedCall("a3", "alice", base.Add(2*time.Minute)),
+		newQueuedCall("a1", "alice", base),
+		newQueuedCall("a2", "alice", base.Add(time.Minute)),
+		newQueuedCall("b1", "bob", base.Add(3*time.Minute)),
+		newQueuedCall("c1", "carol", base.Add(time.Minute)),
+		newQueuedCall("c2", "carol", base.Add(4*time.Minute)),
+	}
+
+	ids := func(calls []*entity.MorningCall) []string {
+		result := make([]string, len(calls))
+		for i, mc := range calls {
+			result[i] = mc.ID
+		}
+		return result
+	}
+
+	// 各ラウンドで送信者ごとに1件ずつ、予定時刻の早い順に並べる
+	got := ids(fairDeliveryOrder(calls, 0))
+	want := []string{"a1", "c1", "b1", "a2", "c2", "a3"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("fairDeliveryOrder() = %v, want %v", got, want)
+	}
+
+	// 上限件数までで打ち切る
+	got = ids(fairDeliveryOrder(calls, 4))
+	if fmt.Sprint(got) != fmt.Sprint(want[:4]) {
+		t.Errorf("fairDeliveryOrder(limit=4) = %v, want %v", got, want[:4])
+	}
+
+	// 受信者がずらした後の時刻で遅延を判定する
+	early := newQueuedCall("b0", "bob", base.Add(10*time.Minute))
+	early.ReceiverOffsetMinutes = -15
+	got = ids(fairDeliveryOrder([]*entity.MorningCall{calls[1], early}, 0))
+	if fmt.Sprint(got) != fmt.Sprint([]string{"b0", "a1"}) {
+		t.Errorf("fairDeliveryOrder() with offset = %v, want [b0 a1]", got)
+	}
+
+	if got := fairDeliveryOrder(nil, 10); len(got) != 0 {
+		t.Errorf("fairDeliveryOrder(nil) = %v, want empty", got)
+	}
+}
+
+func TestFairDeliveryOrder_ManyCalls(t *testing.T) {
+	base := time.Date(2024, 6, 1, 7, 0, 0, 0, time.UTC)
+	const (
+		heavyCalls   = 5000
+		lightSenders = 200
+		limit        = 300
+	)
+
+	// 1人の送信者が同時刻に大量のコールを予約し、他の送信者は少し遅い時刻に1件ずつ予約している
+	var calls []*entity.MorningCall
+	for i := 0; i < heavyCalls; i++ {
+		calls = append(calls, newQueuedCall(fmt.Sprintf("heavy-%05d", i), "heavy", base))
+	}
+	for i := 0; i < lightSenders; i++ {
+		calls = append(calls, newQueuedCall(fmt.Sprintf("light-%03d", i), fmt.Sprintf("sender-%03d", i), base.Add(time.Duration(i%10)*time.Second)))
+	}
+
+	batch := fairDeliveryOrder(calls, limit)
+	if len(batch) != limit {
+		t.Fatalf("len(batch) = %d, want %d", len(batch), limit)
+	}
+
+	perSender := make(map[string]int)
+	for _, mc := range batch {
+		perSender[mc.SenderID]++
+	}
+	// 大量のコールがあっても他の送信者のコールはすべて同じバッチで配信される
+	if len(perSender) != lightSenders+1 {
+		t.Errorf("送信者数 = %d, want %d", len(perSender), lightSenders+1)
+	}
+	if perSender["heavy"] != limit-lightSenders {
+		t.Errorf("heavy = %d, want %d", perSender["heavy"], limit-lightSenders)
+	}
+
+	// 同じ送信者のコールは予定時刻順（同時刻ならID順）に配信される
+	last := make(map[string]*entity.MorningCall)
+	for _, mc := range batch {
+		if prev, ok := last[mc.SenderID]; ok && deliveredBefore(mc, prev) {
+			t.Errorf("%s が %s より先に配信されていない", prev.ID, mc.ID)
+		}
+		last[mc.SenderID] = mc
+	}
+}
+
+// TestFairDeliveryOrder_DelayDistribution は上限件数ずつ配信を繰り返したときの遅延の分布を検証する
+func TestFairDeliveryOrder_DelayDistribution(t *testing.T) {
+	base := time.Date(2024, 6, 1, 7, 0, 0, 0, time.UTC)
+	const (
+		heavySenders = 3
+		heavyCalls   = 2000
+		lightSenders = 500
+		limit        = 500
+		interval     = time.Minute
+	)
+
+	var pending []*entity.MorningCall
+	for s := 0; s < heavySenders; s++ {
+		for i := 0; i < heavyCalls; i++ {
+			pending = append(pending, newQueuedCall(fmt.Sprintf("heavy%d-%05d", s, i), fmt.Sprintf("heavy-%d", s), base))
+		}
+	}
+	for i := 0; i < lightSenders; i++ {
+		pending = append(pending, newQueuedCall(fmt.Sprintf("light-%03d", i), fmt.Sprintf("sender-%03d", i), base))
+	}
+	total := len(pending)
+
+	// 1分ごとに上限件数まで配信するとして、コールごとの遅延を記録する
+	delays := make(map[string][]time.Duration)
+	now := base
+	for len(pending) > 0 {
+		batch := fairDeliveryOrder(pending, limit)
+		delivered := make(map[string]bool, len(batch))
+		for _, mc := range batch {
+			delivered[mc.ID] = true
+			delays[mc.SenderID] = append(delays[mc.SenderID], deliveryDelay(mc, now))
+		}
+		remaining := pending[:0]
+		for _, mc := range pending {
+			if !delivered[mc.ID] {
+				remaining = append(remaining, mc)
+			}
+		}
+		pending = remaining
+		now = now.Add(interval)
+	}
+
+	var all []time.Duration
+	for sender, ds := range delays {
+		all = append(all, ds...)
+		// 1件だけ予約した送信者は最初の2回のうちに配信される
+		if len(ds) == 1 && ds[0] > interval {
+			t.Errorf("%s の遅延 = %v, want <= %v", sender, ds[0], interval)
+		}
+	}
+	if len(all) != total {
+		t.Fatalf("配信件数 = %d, want %d", len(all), total)
+	}
+
+	// 全体の遅延は件数と上限から決まる回数を超えない
+	sort.Slice(all, func(i, j int) bool { return all[i] < all[j] })
+	maxRuns := (total + limit - 1) / limit
+	if max := all[len(all)-1]; max > time.Duration(maxRuns-1)*interval {
+		t.Errorf("最大遅延 = %v, want <= %v", max, time.Duration(maxRuns-1)*interval)
+	}
+	// 大量に予約した送信者同士も同じ割合で配信されるため、遅延の中央値の差は1回分以内に収まる
+	median := func(ds []time.Duration) time.Duration {
+		sorted := append([]time.Duration(nil), ds...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		return sorted[len(sorted)/2]
+	}
+	first := median(delays["heavy-0"])
+	for s := 1; s < heavySenders; s++ {
+		if got := median(delays[fmt.Sprintf("heavy-%d", s)]); got-first > interval || first-got > interval {
+			t.Errorf("heavy-%d の遅延の中央値 = %v, want %v ± %v", s, got, first, interval)
+		}
+	}
+}
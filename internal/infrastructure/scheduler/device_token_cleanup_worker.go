@@ -0,0 +1,53 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// DefaultDeviceTokenCleanupInterval は無効化されたデバイストークンのクリーンアップのデフォルト実行間隔
+const DefaultDeviceTokenCleanupInterval = 1 * time.Hour
+
+// DeviceTokenCleanupConfig はデバイストークンのクリーンアップワーカーの設定
+type DeviceTokenCleanupConfig struct {
+	Interval time.Duration // クリーンアップの実行間隔
+}
+
+// DeviceTokenCleanupWorker は配信サービスから無効と通知されたデバイストークンを定期的に削除するワーカー
+// 配信時には無効化の記録のみを行い、削除はこのワーカーにまとめて任せる
+type DeviceTokenCleanupWorker struct {
+	*periodicRunner[int]
+
+	deviceRepo repository.DeviceTokenRepository
+	config     DeviceTokenCleanupConfig
+}
+
+// NewDeviceTokenCleanupWorker は新しいデバイストークンのクリーンアップワーカーを作成する
+// 設定値が未指定（ゼロ値）の項目にはデフォルト値を使用する
+func NewDeviceTokenCleanupWorker(
+	deviceRepo repository.DeviceTokenRepository,
+	config DeviceTokenCleanupConfig,
+) *DeviceTokenCleanupWorker {
+	if config.Interval <= 0 {
+		config.Interval = DefaultDeviceTokenCleanupInterval
+	}
+
+	w := &DeviceTokenCleanupWorker{
+		deviceRepo: deviceRepo,
+		config:     config,
+	}
+	w.periodicRunner = newPeriodicRunner(config.Interval, w.RunOnce, logCount("デバイストークンのクリーンアップに失敗しました", "無効化されたデバイストークンを%d件削除しました"))
+	return w
+}
+
+// RunOnce はクリーンアップを1回実行し、削除した件数を返す
+func (w *DeviceTokenCleanupWorker) RunOnce(ctx context.Context) (int, error) {
+	deleted, err := w.deviceRepo.DeleteInvalidated(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete invalidated device tokens: %w", err)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,58 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestNewDeviceTokenCleanupWorker_Defaults(t *testing.T) {
+	worker := NewDeviceTokenCleanupWorker(memory.NewDeviceTokenRepository(), DeviceTokenCleanupConfig{})
+
+	if worker.config.Interval != DefaultDeviceTokenCleanupInterval {
+		t.Errorf("Interval = %v, want %v", worker.config.Interval, DefaultDeviceTokenCleanupInterval)
+	}
+}
+
+func TestDeviceTokenCleanupWorker_RunOnce(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 15, 7, 0, 0, 0, time.UTC)
+
+	repo := memory.NewDeviceTokenRepository()
+	for _, d := range []*entity.DeviceToken{
+		{ID: "valid", UserID: "user1", Token: "valid-token", Platform: valueobject.DevicePlatformIOS, CreatedAt: now},
+		{ID: "invalid", UserID: "user1", Token: "invalid-token", Platform: valueobject.DevicePlatformAndroid, CreatedAt: now, InvalidatedAt: &now},
+	} {
+		if err := repo.Create(ctx, d); err != nil {
+			t.Fatalf("failed to create device: %v", err)
+		}
+	}
+
+	worker := NewDeviceTokenCleanupWorker(repo, DeviceTokenCleanupConfig{})
+	count, err := worker.RunOnce(ctx)
+	if err != nil {
+		t.Fatalf("RunOnce() unexpected error: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("RunOnce() = %d, want 1", count)
+	}
+
+	devices, _ := repo.FindByUserID(ctx, "user1")
+	if len(devices) != 1 || devices[0].ID != "valid" {
+		t.Errorf("remaining devices = %+v, want [valid]", devices)
+	}
+}
+
+func TestDeviceTokenCleanupWorker_StartStop(t *testing.T) {
+	worker := NewDeviceTokenCleanupWorker(memory.NewDeviceTokenRepository(), DeviceTokenCleanupConfig{Interval: time.Millisecond})
+
+	worker.Start(context.Background())
+	worker.Start(context.Background()) // 二重起動しても問題ない
+	time.Sleep(5 * time.Millisecond)
+	worker.Stop()
+	worker.Stop() // 二重停止しても問題ない
+}
@@ -0,0 +1,155 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// ExpiryAction は失効したリクエストに対する処理方法
+type ExpiryAction string
+
+const (
+	// ExpiryActionReject は失効したリクエストを拒否済みにする（履歴を残す）
+	ExpiryActionReject ExpiryAction = "reject"
+	// ExpiryActionDelete は失効したリクエストを削除する
+	ExpiryActionDelete ExpiryAction = "delete"
+)
+
+const (
+	// DefaultFriendRequestExpiry は友達リクエストが失効するまでのデフォルト期間
+	DefaultFriendRequestExpiry = 30 * 24 * time.Hour
+	// DefaultFriendRequestExpiryInterval は失効チェックのデフォルト実行間隔
+	DefaultFriendRequestExpiryInterval = 1 * time.Hour
+
+	// friendRequestBatchSize はリポジトリから一度に取得する件数
+	friendRequestBatchSize = 100
+)
+
+// FriendRequestExpiryConfig は友達リクエスト失効ワーカーの設定
+type FriendRequestExpiryConfig struct {
+	Expiry   time.Duration // CreatedAtからこの期間を超えたPendingリクエストを失効させる
+	Interval time.Duration // 失効チェックの実行間隔
+	Action   ExpiryAction  // 失効時の処理方法
+}
+
+// FriendRequestExpiryWorker は長期間放置された友達リクエストを自動失効させるワーカー
+type FriendRequestExpiryWorker struct {
+	*periodicRunner[int]
+
+	relationshipRepo repository.RelationshipRepository
+	auditLogger      service.AuditLogger
+	config           FriendRequestExpiryConfig
+	now              func() time.Time
+}
+
+// NewFriendRequestExpiryWorker は新しい友達リクエスト失効ワーカーを作成する
+// 設定値が未指定（ゼロ値）の項目にはデフォルト値を使用する
+func NewFriendRequestExpiryWorker(
+	relationshipRepo repository.RelationshipRepository,
+	auditLogger service.AuditLogger,
+	config FriendRequestExpiryConfig,
+) *FriendRequestExpiryWorker {
+	if config.Expiry <= 0 {
+		config.Expiry = DefaultFriendRequestExpiry
+	}
+	if config.Interval <= 0 {
+		config.Interval = DefaultFriendRequestExpiryInterval
+	}
+	if config.Action != ExpiryActionDelete {
+		config.Action = ExpiryActionReject
+	}
+
+	w := &FriendRequestExpiryWorker{
+		relationshipRepo: relationshipRepo,
+		auditLogger:      auditLogger,
+		config:           config,
+		now:              time.Now,
+	}
+	w.periodicRunner = newPeriodicRunner(config.Interval, w.RunOnce, logCount("友達リクエストの失効処理に失敗しました", "友達リクエストを%d件失効させました"))
+	return w
+}
+
+// RunOnce は失効チェックを1回実行し、失効させたリクエストの件数を返す
+func (w *FriendRequestExpiryWorker) RunOnce(ctx context.Context) (int, error) {
+	// 処理中にステータスが変わるとページ位置がずれるため、先に対象を全件収集する
+	pending, err := w.collectPendingRequests(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	deadline := w.now().Add(-w.config.Expiry)
+	processed := 0
+	for _, rel := range pending {
+		if !rel.CreatedAt.Before(deadline) {
+			continue
+		}
+
+		if err := w.expire(ctx, rel); err != nil {
+			return processed, err
+		}
+		processed++
+	}
+
+	return processed, nil
+}
+
+// collectPendingRequests は承認待ちの友達リクエストを全件取得する
+func (w *FriendRequestExpiryWorker) collectPendingRequests(ctx context.Context) ([]*entity.Relationship, error) {
+	var result []*entity.Relationship
+	for offset := 0; ; offset += friendRequestBatchSize {
+		batch, err := w.relationshipRepo.FindByStatus(ctx, valueobject.RelationshipStatusPending, offset, friendRequestBatchSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find pending friend requests: %w", err)
+		}
+		result = append(result, batch...)
+		if len(batch) < friendRequestBatchSize {
+			return result, nil
+		}
+	}
+}
+
+// expire は1件のリクエストを設定に従って失効させ、監査ログに記録する
+func (w *FriendRequestExpiryWorker) expire(ctx context.Context, rel *entity.Relationship) error {
+	switch w.config.Action {
+	case ExpiryActionDelete:
+		if err := w.relationshipRepo.Delete(ctx, rel.ID); err != nil {
+			return fmt.Errorf("failed to delete expired friend request %s: %w", rel.ID, err)
+		}
+	default:
+		if reason := rel.Expire(); reason.IsNG() {
+			return fmt.Errorf("failed to expire friend request %s: %s", rel.ID, reason)
+		}
+		if err := w.relationshipRepo.Update(ctx, rel); err != nil {
+			return fmt.Errorf("failed to update expired friend request %s: %w", rel.ID, err)
+		}
+	}
+
+	if w.auditLogger != nil {
+		entry := service.AuditEntry{
+			Action:     "friend_request.expired",
+			ActorID:    service.AuditActorSystem,
+			TargetType: "relationship",
+			TargetID:   rel.ID,
+			Details: map[string]string{
+				"requester_id": rel.RequesterID,
+				"receiver_id":  rel.ReceiverID,
+				"action":       string(w.config.Action),
+				"created_at":   rel.CreatedAt.Format(time.RFC3339),
+			},
+			OccurredAt: w.now(),
+		}
+		if err := w.auditLogger.Record(ctx, entry); err != nil {
+			// 監査ログの失敗で失効処理自体は巻き戻さない
+			log.Printf("監査ログの記録に失敗しました: %v", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,200 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/audit"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestNewFriendRequestExpiryWorker_Defaults(t *testing.T) {
+	worker := NewFriendRequestExpiryWorker(memory.NewRelationshipRepository(), nil, FriendRequestExpiryConfig{})
+
+	if worker.config.Expiry != DefaultFriendRequestExpiry {
+		t.Errorf("Expiry = %v, want %v", worker.config.Expiry, DefaultFriendRequestExpiry)
+	}
+	if worker.config.Interval != DefaultFriendRequestExpiryInterval {
+		t.Errorf("Interval = %v, want %v", worker.config.Interval, DefaultFriendRequestExpiryInterval)
+	}
+	if worker.config.Action != ExpiryActionReject {
+		t.Errorf("Action = %v, want %v", worker.config.Action, ExpiryActionReject)
+	}
+}
+
+func TestFriendRequestExpiryWorker_RunOnce(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 6, 1, 7, 0, 0, 0, time.UTC)
+	expiry := 30 * 24 * time.Hour
+
+	newRel := func(id, requester, receiver string, status valueobject.RelationshipStatus, createdAt time.Time) *entity.Relationship {
+		return &entity.Relationship{
+			ID:          id,
+			RequesterID: requester,
+			ReceiverID:  receiver,
+			Status:      status,
+			CreatedAt:   createdAt,
+			UpdatedAt:   createdAt,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		action      ExpiryAction
+		wantCount   int
+		wantExpired []string
+	}{
+		{
+			name:        "期限切れリクエストを拒否済みにする",
+			action:      ExpiryActionReject,
+			wantCount:   2,
+			wantExpired: []string{"old1", "old2"},
+		},
+		{
+			name:        "期限切れリクエストを削除する",
+			action:      ExpiryActionDelete,
+			wantCount:   2,
+			wantExpired: []string{"old1", "old2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := memory.NewRelationshipRepository()
+			auditLogger := audit.NewMemoryAuditLogger()
+
+			rels := []*entity.Relationship{
+				newRel("old1", "user1", "user2", valueobject.RelationshipStatusPending, now.Add(-expiry-time.Hour)),
+				newRel("old2", "user3", "user4", valueobject.RelationshipStatusPending, now.Add(-60*24*time.Hour)),
+				newRel("boundary", "user5", "user6", valueobject.RelationshipStatusPending, now.Add(-expiry)),
+				newRel("recent", "user7", "user8", valueobject.RelationshipStatusPending, now.Add(-24*time.Hour)),
+				newRel("accepted", "user9", "user10", valueobject.RelationshipStatusAccepted, now.Add(-90*24*time.Hour)),
+			}
+			for _, rel := range rels {
+				if err := repo.Create(ctx, rel); err != nil {
+					t.Fatalf("failed to create relationship: %v", err)
+				}
+			}
+
+			worker := NewFriendRequestExpiryWorker(repo, auditLogger, FriendRequestExpiryConfig{
+				Expiry: expiry,
+				Action: tt.action,
+			})
+			worker.now = func() time.Time { return now }
+
+			count, err := worker.RunOnce(ctx)
+			if err != nil {
+				t.Fatalf("予期しないエラー: %v", err)
+			}
+			if count != tt.wantCount {
+				t.Errorf("処理件数 = %d, want %d", count, tt.wantCount)
+			}
+
+			for _, id := range tt.wantExpired {
+				rel, err := repo.FindByID(ctx, id)
+				if tt.action == ExpiryActionDelete {
+					if !errors.Is(err, repository.ErrNotFound) {
+						t.Errorf("%s が削除されていない: %v", id, err)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("failed to find %s: %v", id, err)
+				}
+				if rel.Status != valueobject.RelationshipStatusRejected {
+					t.Errorf("%s のステータス = %s, want rejected", id, rel.Status)
+				}
+				if !rel.IsExpired() {
+					t.Errorf("%s が失効扱いになっていない", id)
+				}
+			}
+
+			// 期限内・境界ちょうど・承認済みは変更されない
+			for _, id := range []string{"boundary", "recent"} {
+				rel, err := repo.FindByID(ctx, id)
+				if err != nil {
+					t.Fatalf("failed to find %s: %v", id, err)
+				}
+				if rel.Status != valueobject.RelationshipStatusPending {
+					t.Errorf("%s のステータスが変更された: %s", id, rel.Status)
+				}
+			}
+			rel, err := repo.FindByID(ctx, "accepted")
+			if err != nil || rel.Status != valueobject.RelationshipStatusAccepted {
+				t.Errorf("承認済みの関係が変更された: %v", err)
+			}
+
+			// 監査ログに記録される
+			entries := auditLogger.Entries()
+			if len(entries) != tt.wantCount {
+				t.Fatalf("監査ログ件数 = %d, want %d", len(entries), tt.wantCount)
+			}
+			for _, e := range entries {
+				if e.Action != "friend_request.expired" || e.TargetType != "relationship" {
+					t.Errorf("予期しない監査ログ: %+v", e)
+				}
+				if e.Details["action"] != string(tt.action) {
+					t.Errorf("監査ログの処理方法 = %s, want %s", e.Details["action"], tt.action)
+				}
+			}
+
+			// 2回目の実行では対象なし
+			count, err = worker.RunOnce(ctx)
+			if err != nil {
+				t.Fatalf("予期しないエラー: %v", err)
+			}
+			if count != 0 {
+				t.Errorf("2回目の処理件数 = %d, want 0", count)
+			}
+		})
+	}
+}
+
+func TestFriendRequestExpiryWorker_RunOnce_ManyRequests(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewRelationshipRepository()
+	now := time.Now()
+
+	// バッチサイズを超える件数でも全件処理されることを確認
+	total := friendRequestBatchSize*2 + 5
+	for i := 0; i < total; i++ {
+		rel := &entity.Relationship{
+			ID:          fmt.Sprintf("rel-%04d", i),
+			RequesterID: fmt.Sprintf("requester-%04d", i),
+			ReceiverID:  fmt.Sprintf("receiver-%04d", i),
+			Status:      valueobject.RelationshipStatusPending,
+			CreatedAt:   now.Add(-40 * 24 * time.Hour),
+			UpdatedAt:   now.Add(-40 * 24 * time.Hour),
+		}
+		if err := repo.Create(ctx, rel); err != nil {
+			t.Fatalf("failed to create relationship: %v", err)
+		}
+	}
+
+	worker := NewFriendRequestExpiryWorker(repo, nil, FriendRequestExpiryConfig{})
+	count, err := worker.RunOnce(ctx)
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+	if count != total {
+		t.Errorf("処理件数 = %d, want %d", count, total)
+	}
+}
+
+func TestFriendRequestExpiryWorker_StartStop(t *testing.T) {
+	worker := NewFriendRequestExpiryWorker(memory.NewRelationshipRepository(), nil, FriendRequestExpiryConfig{
+		Interval: 10 * time.Millisecond,
+	})
+
+	worker.Start(context.Background())
+	worker.Start(context.Background()) // 二重起動は無視される
+	time.Sleep(30 * time.Millisecond)
+	worker.Stop()
+	worker.Stop() // 二重停止は無視される
+}
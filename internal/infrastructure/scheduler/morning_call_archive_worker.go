@@ -0,0 +1,113 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+const (
+	// DefaultMorningCallArchiveAfter はアラーム時刻から自動アーカイブするまでのデフォルト期間
+	DefaultMorningCallArchiveAfter = 30 * 24 * time.Hour
+	// DefaultMorningCallArchiveInterval は自動アーカイブのデフォルト実行間隔
+	DefaultMorningCallArchiveInterval = 1 * time.Hour
+)
+
+// archivableStatuses は自動アーカイブの対象にするステータス
+var archivableStatuses = []valueobject.MorningCallStatus{
+	valueobject.MorningCallStatusConfirmed,
+	valueobject.MorningCallStatusExpired,
+}
+
+// MorningCallArchiveConfig はモーニングコール自動アーカイブワーカーの設定
+type MorningCallArchiveConfig struct {
+	After    time.Duration // アラーム時刻からこの期間を超えた確認済み・期限切れのコールをアーカイブする
+	Interval time.Duration // 自動アーカイブの実行間隔
+}
+
+// MorningCallArchiveWorker は古い確認済み・期限切れのモーニングコールを受信箱から自動でアーカイブするワーカー
+type MorningCallArchiveWorker struct {
+	*periodicRunner[int]
+
+	morningCallRepo repository.MorningCallRepository
+	config          MorningCallArchiveConfig
+	now             func() time.Time
+}
+
+// NewMorningCallArchiveWorker は新しいモーニングコール自動アーカイブワーカーを作成する
+// 設定値が未指定（ゼロ値）の項目にはデフォルト値を使用する
+func NewMorningCallArchiveWorker(
+	morningCallRepo repository.MorningCallRepository,
+	config MorningCallArchiveConfig,
+) *MorningCallArchiveWorker {
+	if config.After <= 0 {
+		config.After = DefaultMorningCallArchiveAfter
+	}
+	if config.Interval <= 0 {
+		config.Interval = DefaultMorningCallArchiveInterval
+	}
+
+	w := &MorningCallArchiveWorker{
+		morningCallRepo: morningCallRepo,
+		config:          config,
+		now:             time.Now,
+	}
+	w.periodicRunner = newPeriodicRunner(config.Interval, w.RunOnce, logCount("モーニングコールの自動アーカイブに失敗しました", "モーニングコールを%d件アーカイブしました"))
+	return w
+}
+
+// RunOnce は自動アーカイブを1回実行し、アーカイブした件数を返す
+func (w *MorningCallArchiveWorker) RunOnce(ctx context.Context) (int, error) {
+	now := w.now()
+	targets, err := w.collectArchivableCalls(ctx, now.Add(-w.config.After))
+	if err != nil {
+		return 0, err
+	}
+
+	archived := 0
+	for _, mc := range targets {
+		if reason := mc.Archive(now); reason.IsNG() {
+			return archived, fmt.Errorf("failed to archive morning call %s: %s", mc.ID, reason)
+		}
+		if err := w.morningCallRepo.Update(ctx, mc); err != nil {
+			// 受信者の操作と競合した場合は次回の実行に任せる
+			if errors.Is(err, repository.ErrUpdateConflict) {
+				continue
+			}
+			return archived, fmt.Errorf("failed to update archived morning call %s: %w", mc.ID, err)
+		}
+		archived++
+	}
+
+	return archived, nil
+}
+
+// collectArchivableCalls はアラーム時刻がdeadline以前の未アーカイブの確認済み・期限切れコールを全件取得する
+// 処理中にアーカイブ状態が変わってもページ位置がずれないよう、先に対象を全件収集する
+func (w *MorningCallArchiveWorker) collectArchivableCalls(ctx context.Context, deadline time.Time) ([]*entity.MorningCall, error) {
+	var result []*entity.MorningCall
+	for _, status := range archivableStatuses {
+		for offset := 0; ; offset += morningCallBatchSize {
+			batch, err := w.morningCallRepo.FindByStatusInRange(ctx, status, time.Time{}, deadline, offset, morningCallBatchSize)
+			if err != nil {
+				return nil, fmt.Errorf("failed to find %s morning calls: %w", status, err)
+			}
+			for _, mc := range batch {
+				// 管理者に削除されたコールは変更できないため対象外
+				if mc.Archived || mc.IsDeleted() {
+					continue
+				}
+				result = append(result, mc)
+			}
+			if len(batch) < morningCallBatchSize {
+				break
+			}
+		}
+	}
+	return result, nil
+}
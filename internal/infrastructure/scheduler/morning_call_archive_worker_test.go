@@ -0,0 +1,93 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestNewMorningCallArchiveWorker_Defaults(t *testing.T) {
+	worker := NewMorningCallArchiveWorker(memory.NewMorningCallRepository(), MorningCallArchiveConfig{})
+
+	if worker.config.After != DefaultMorningCallArchiveAfter {
+		t.Errorf("After = %v, want %v", worker.config.After, DefaultMorningCallArchiveAfter)
+	}
+	if worker.config.Interval != DefaultMorningCallArchiveInterval {
+		t.Errorf("Interval = %v, want %v", worker.config.Interval, DefaultMorningCallArchiveInterval)
+	}
+}
+
+func TestMorningCallArchiveWorker_RunOnce(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 6, 1, 7, 0, 0, 0, time.UTC)
+	after := 7 * 24 * time.Hour
+	old := now.Add(-after - time.Hour)
+	recent := now.Add(-after + time.Hour)
+
+	repo := memory.NewMorningCallRepository()
+	calls := []*entity.MorningCall{
+		{ID: "old_confirmed", Status: valueobject.MorningCallStatusConfirmed, ScheduledTime: old},
+		{ID: "old_expired", Status: valueobject.MorningCallStatusExpired, ScheduledTime: old},
+		{ID: "old_cancelled", Status: valueobject.MorningCallStatusCancelled, ScheduledTime: old},
+		{ID: "old_delivered", Status: valueobject.MorningCallStatusDelivered, ScheduledTime: old},
+		{ID: "recent_confirmed", Status: valueobject.MorningCallStatusConfirmed, ScheduledTime: recent},
+		{ID: "old_deleted", Status: valueobject.MorningCallStatusConfirmed, ScheduledTime: old},
+	}
+	for _, mc := range calls {
+		mc.SenderID = "sender"
+		mc.ReceiverID = "receiver"
+		mc.CreatedAt = old.Add(-24 * time.Hour)
+		mc.UpdatedAt = mc.ScheduledTime
+		if mc.ID == "old_deleted" {
+			mc.DeleteByAdmin("admin", "規約違反", old)
+		}
+		if err := repo.Create(ctx, mc); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+
+	worker := NewMorningCallArchiveWorker(repo, MorningCallArchiveConfig{After: after})
+	worker.now = func() time.Time { return now }
+
+	count, err := worker.RunOnce(ctx)
+	if err != nil {
+		t.Fatalf("RunOnce() unexpected error: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("RunOnce() = %d, want 2", count)
+	}
+
+	wantArchived := map[string]bool{"old_confirmed": true, "old_expired": true}
+	for _, mc := range calls {
+		stored, err := repo.FindByID(ctx, mc.ID)
+		if err != nil {
+			t.Fatalf("failed to find morning call %s: %v", mc.ID, err)
+		}
+		if stored.Archived != wantArchived[mc.ID] {
+			t.Errorf("%s Archived = %v, want %v", mc.ID, stored.Archived, wantArchived[mc.ID])
+		}
+	}
+
+	// アーカイブ済みのコールは再度処理しない
+	count, err = worker.RunOnce(ctx)
+	if err != nil {
+		t.Fatalf("RunOnce() unexpected error: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("second RunOnce() = %d, want 0", count)
+	}
+}
+
+func TestMorningCallArchiveWorker_StartStop(t *testing.T) {
+	worker := NewMorningCallArchiveWorker(memory.NewMorningCallRepository(), MorningCallArchiveConfig{Interval: time.Millisecond})
+
+	worker.Start(context.Background())
+	worker.Start(context.Background()) // 二重起動しても問題ない
+	time.Sleep(5 * time.Millisecond)
+	worker.Stop()
+	worker.Stop() // 二重停止しても問題ない
+}
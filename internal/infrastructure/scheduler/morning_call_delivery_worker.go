@@ -0,0 +1,216 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+const (
+	// DefaultMorningCallDeliveryInterval は配信チェックのデフォルト実行間隔
+	DefaultMorningCallDeliveryInterval = 1 * time.Minute
+
+	// DefaultMorningCallDeliveryMaxPerRun は1回の配信チェックで処理するコールのデフォルト上限件数
+	DefaultMorningCallDeliveryMaxPerRun = 1000
+
+	// morningCallBatchSize はリポジトリから一度に取得する件数
+	morningCallBatchSize = 100
+)
+
+// MorningCallDeliveryConfig はモーニングコール配信ワーカーの設定
+type MorningCallDeliveryConfig struct {
+	Interval  time.Duration // 配信チェックの実行間隔
+	MaxPerRun int           // 1回の配信チェックで処理するコールの上限件数（超えた分は次回に持ち越す）
+}
+
+// DeliveryResult は1回の配信チェックの結果
+type DeliveryResult struct {
+	Delivered     int           // 配信済みにした件数
+	AutoConfirmed int           // 受信者の自動確認の設定により確認済みにした件数
+	Deferred      int           // 上限件数を超えたため次回に持ち越した件数
+	MaxDelay      time.Duration // 処理したコールのうち予定時刻からの遅延の最大値
+}
+
+// MorningCallDeliveryWorker は配信時刻を迎えたモーニングコールを配信するワーカー
+// 受信者が送信者を自動確認の対象にしている場合は、配信済みを経ずに確認済みにする
+type MorningCallDeliveryWorker struct {
+	*periodicRunner[DeliveryResult]
+
+	morningCallRepo  repository.MorningCallRepository
+	relationshipRepo repository.RelationshipRepository
+	auditLogger      service.AuditLogger
+	config           MorningCallDeliveryConfig
+	now              func() time.Time
+}
+
+// NewMorningCallDeliveryWorker は新しいモーニングコール配信ワーカーを作成する
+// 設定値が未指定（ゼロ値）の項目にはデフォルト値を使用する
+func NewMorningCallDeliveryWorker(
+	morningCallRepo repository.MorningCallRepository,
+	relationshipRepo repository.RelationshipRepository,
+	auditLogger service.AuditLogger,
+	config MorningCallDeliveryConfig,
+) *MorningCallDeliveryWorker {
+	if config.Interval <= 0 {
+		config.Interval = DefaultMorningCallDeliveryInterval
+	}
+	if config.MaxPerRun <= 0 {
+		config.MaxPerRun = DefaultMorningCallDeliveryMaxPerRun
+	}
+
+	w := &MorningCallDeliveryWorker{
+		morningCallRepo:  morningCallRepo,
+		relationshipRepo: relationshipRepo,
+		auditLogger:      auditLogger,
+		config:           config,
+		now:              time.Now,
+	}
+	w.periodicRunner = newPeriodicRunner(config.Interval, w.RunOnce, logDeliveryResult)
+	return w
+}
+
+// RunOnce は配信チェックを1回実行し、処理した件数を返す
+// 受信者がアラーム時刻をずらしている場合はずらした後の時刻で判定する
+// 対象が上限件数を超える場合は送信者間で公平になるよう選び、残りは次回に持ち越す
+func (w *MorningCallDeliveryWorker) RunOnce(ctx context.Context) (DeliveryResult, error) {
+	var result DeliveryResult
+	now := w.now()
+
+	// 処理中にステータスが変わるとページ位置がずれるため、先に対象を全件収集する
+	due, err := w.collectDueCalls(ctx, now)
+	if err != nil {
+		return result, err
+	}
+
+	batch := fairDeliveryOrder(due, w.config.MaxPerRun)
+	result.Deferred = len(due) - len(batch)
+
+	for _, mc := range batch {
+		if delay := deliveryDelay(mc, now); delay > result.MaxDelay {
+			result.MaxDelay = delay
+		}
+		autoConfirmed, err := w.deliver(ctx, mc)
+		if err != nil {
+			return result, err
+		}
+		if autoConfirmed {
+			result.AutoConfirmed++
+		} else {
+			result.Delivered++
+		}
+	}
+
+	return result, nil
+}
+
+// collectDueCalls は配信時刻を迎えたスケジュール済みのモーニングコールを全件取得する
+func (w *MorningCallDeliveryWorker) collectDueCalls(ctx context.Context, now time.Time) ([]*entity.MorningCall, error) {
+	// 受信者が時刻を早めている可能性があるため、ずらせる最大幅だけ先まで取得してから絞り込む
+	end := now.Add(entity.MaxReceiverOffsetMinutes * time.Minute)
+
+	var result []*entity.MorningCall
+	for offset := 0; ; offset += morningCallBatchSize {
+		batch, err := w.morningCallRepo.FindByStatusInRange(ctx, valueobject.MorningCallStatusScheduled, time.Time{}, end, offset, morningCallBatchSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find scheduled morning calls: %w", err)
+		}
+		for _, mc := range batch {
+			// 管理者に削除されたコールは配信しない
+			if mc.IsDeleted() {
+				continue
+			}
+			if !mc.EffectiveScheduledTime().After(now) {
+				result = append(result, mc)
+			}
+		}
+		if len(batch) < morningCallBatchSize {
+			return result, nil
+		}
+	}
+}
+
+// deliver は1件のモーニングコールを配信済みまたは自動確認済みにする
+func (w *MorningCallDeliveryWorker) deliver(ctx context.Context, mc *entity.MorningCall) (bool, error) {
+	autoConfirm, err := w.shouldAutoConfirm(ctx, mc)
+	if err != nil {
+		return false, err
+	}
+
+	if autoConfirm {
+		if reason := mc.AutoConfirm(); reason.IsNG() {
+			return false, fmt.Errorf("failed to auto-confirm morning call %s: %s", mc.ID, reason)
+		}
+	} else if reason := mc.MarkAsDelivered(); reason.IsNG() {
+		return false, fmt.Errorf("failed to deliver morning call %s: %s", mc.ID, reason)
+	}
+
+	if err := w.morningCallRepo.Update(ctx, mc); err != nil {
+		return false, fmt.Errorf("failed to update delivered morning call %s: %w", mc.ID, err)
+	}
+
+	if autoConfirm {
+		w.recordAutoConfirm(ctx, mc)
+	}
+	return autoConfirm, nil
+}
+
+// shouldAutoConfirm は受信者が送信者からのコールを自動確認の対象にしているかを判定する
+// 起床クイズ付きのコールは受信者が答える必要があるため自動確認しない
+func (w *MorningCallDeliveryWorker) shouldAutoConfirm(ctx context.Context, mc *entity.MorningCall) (bool, error) {
+	if mc.WakeChallenge != nil {
+		return false, nil
+	}
+	relationship, err := w.relationshipRepo.FindByUserPair(ctx, mc.ReceiverID, mc.SenderID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to find relationship for morning call %s: %w", mc.ID, err)
+	}
+	return relationship.AutoConfirmFor(mc.ReceiverID), nil
+}
+
+// recordAutoConfirm は自動確認したことを監査ログに記録する
+func (w *MorningCallDeliveryWorker) recordAutoConfirm(ctx context.Context, mc *entity.MorningCall) {
+	if w.auditLogger == nil {
+		return
+	}
+
+	entry := service.AuditEntry{
+		Action:     "morning_call.auto_confirmed",
+		ActorID:    service.AuditActorSystem,
+		TargetType: "morning_call",
+		TargetID:   mc.ID,
+		Details: map[string]string{
+			"sender_id":      mc.SenderID,
+			"receiver_id":    mc.ReceiverID,
+			"scheduled_time": mc.EffectiveScheduledTime().Format(time.RFC3339),
+		},
+		OccurredAt: w.now(),
+	}
+	if err := w.auditLogger.Record(ctx, entry); err != nil {
+		// 監査ログの失敗で自動確認自体は巻き戻さない
+		log.Printf("監査ログの記録に失敗しました: %v", err)
+	}
+}
+
+// logDeliveryResult は配信処理の結果をログに出力する
+func logDeliveryResult(result DeliveryResult, err error) {
+	if err != nil {
+		log.Printf("モーニングコールの配信処理に失敗しました: %v", err)
+		return
+	}
+	if result.Delivered > 0 || result.AutoConfirmed > 0 {
+		log.Printf("モーニングコールを%d件配信し、%d件を自動確認しました（最大遅延: %v）", result.Delivered, result.AutoConfirmed, result.MaxDelay)
+	}
+	if result.Deferred > 0 {
+		log.Printf("処理上限により%d件のモーニングコールの配信を次回に持ち越しました", result.Deferred)
+	}
+}
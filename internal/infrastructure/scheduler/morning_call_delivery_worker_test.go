@@ -0,0 +1,196 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/audit"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestNewMorningCallDeliveryWorker_Defaults(t *testing.T) {
+	worker := NewMorningCallDeliveryWorker(memory.NewMorningCallRepository(), memory.NewRelationshipRepository(), nil, MorningCallDeliveryConfig{})
+
+	if worker.config.Interval != DefaultMorningCallDeliveryInterval {
+		t.Errorf("Interval = %v, want %v", worker.config.Interval, DefaultMorningCallDeliveryInterval)
+	}
+	if worker.config.MaxPerRun != DefaultMorningCallDeliveryMaxPerRun {
+		t.Errorf("MaxPerRun = %d, want %d", worker.config.MaxPerRun, DefaultMorningCallDeliveryMaxPerRun)
+	}
+}
+
+func TestMorningCallDeliveryWorker_RunOnce(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 6, 1, 7, 0, 0, 0, time.UTC)
+
+	morningCallRepo := memory.NewMorningCallRepository()
+	relationshipRepo := memory.NewRelationshipRepository()
+	auditLogger := audit.NewMemoryAuditLogger()
+
+	// user2はuser1からのコールを自動確認し、user3は自動確認しない
+	rels := []*entity.Relationship{
+		{ID: "rel1", RequesterID: "user1", ReceiverID: "user2", Status: valueobject.RelationshipStatusAccepted, ReceiverAutoConfirm: true},
+		{ID: "rel2", RequesterID: "user1", ReceiverID: "user3", Status: valueobject.RelationshipStatusAccepted, RequesterAutoConfirm: true},
+	}
+	for _, rel := range rels {
+		if err := relationshipRepo.Create(ctx, rel); err != nil {
+			t.Fatalf("failed to create relationship: %v", err)
+		}
+	}
+
+	newCall := func(id, receiver string, scheduled time.Time) *entity.MorningCall {
+		return &entity.MorningCall{
+			ID:            id,
+			SenderID:      "user1",
+			ReceiverID:    receiver,
+			ScheduledTime: scheduled,
+			Message:       "おはよう",
+			Status:        valueobject.MorningCallStatusScheduled,
+			CreatedAt:     now.Add(-time.Hour),
+			UpdatedAt:     now.Add(-time.Hour),
+		}
+	}
+	calls := []*entity.MorningCall{
+		newCall("trusted", "user2", now.Add(-time.Minute)),
+		newCall("normal", "user3", now),
+		newCall("future", "user2", now.Add(time.Minute)),
+	}
+	for _, mc := range calls {
+		if err := morningCallRepo.Create(ctx, mc); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+
+	worker := NewMorningCallDeliveryWorker(morningCallRepo, relationshipRepo, auditLogger, MorningCallDeliveryConfig{})
+	worker.now = func() time.Time { return now }
+
+	result, err := worker.RunOnce(ctx)
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+	if result.AutoConfirmed != 1 || result.Delivered != 1 {
+		t.Errorf("RunOnce() = %+v, want 1 auto-confirmed and 1 delivered", result)
+	}
+
+	// 自動確認の対象は配信済みを経ずに確認済みになる
+	trusted, _ := morningCallRepo.FindByID(ctx, "trusted")
+	if trusted.Status != valueobject.MorningCallStatusConfirmed || !trusted.AutoConfirmed {
+		t.Errorf("trusted = %s (auto=%v), want auto-confirmed", trusted.Status, trusted.AutoConfirmed)
+	}
+
+	// 相手側の設定は受信者の自動確認に影響しない
+	normal, _ := morningCallRepo.FindByID(ctx, "normal")
+	if normal.Status != valueobject.MorningCallStatusDelivered || normal.AutoConfirmed {
+		t.Errorf("normal = %s (auto=%v), want delivered", normal.Status, normal.AutoConfirmed)
+	}
+
+	// 配信時刻前のコールは変更されない
+	future, _ := morningCallRepo.FindByID(ctx, "future")
+	if future.Status != valueobject.MorningCallStatusScheduled {
+		t.Errorf("future = %s, want scheduled", future.Status)
+	}
+
+	// 自動確認は監査ログに記録される
+	entries := auditLogger.Entries()
+	if len(entries) != 1 || entries[0].Action != "morning_call.auto_confirmed" || entries[0].TargetID != "trusted" {
+		t.Errorf("予期しない監査ログ: %+v", entries)
+	}
+
+	// 統計では自動確認を通常の確認と区別する
+	stats, err := morningCallRepo.Stats(ctx)
+	if err != nil {
+		t.Fatalf("Stats() unexpected error = %v", err)
+	}
+	if stats.Breakdown[repository.StatsKeyAutoConfirmed] != 1 {
+		t.Errorf("Breakdown[auto_confirmed] = %d, want 1", stats.Breakdown[repository.StatsKeyAutoConfirmed])
+	}
+	if _, ok := stats.Breakdown[valueobject.MorningCallStatusConfirmed.String()]; ok {
+		t.Error("auto-confirmed calls should not be counted as confirmed")
+	}
+
+	// 2回目の実行では対象なし
+	result, err = worker.RunOnce(ctx)
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+	if result.AutoConfirmed != 0 || result.Delivered != 0 {
+		t.Errorf("2回目の結果 = %+v, want none", result)
+	}
+}
+
+func TestMorningCallDeliveryWorker_RunOnce_MaxPerRun(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 6, 1, 7, 0, 0, 0, time.UTC)
+	morningCallRepo := memory.NewMorningCallRepository()
+
+	// 1人の送信者の大量のコールと、他の送信者の1件のコールが同時刻に集中している
+	total := morningCallBatchSize*2 + 1
+	for i := 0; i < total; i++ {
+		sender := "heavy"
+		if i == total-1 {
+			sender = "light"
+		}
+		mc := &entity.MorningCall{
+			ID:            fmt.Sprintf("mc-%04d", i),
+			SenderID:      sender,
+			ReceiverID:    fmt.Sprintf("receiver-%04d", i),
+			ScheduledTime: now.Add(-time.Minute),
+			Message:       "おはよう",
+			Status:        valueobject.MorningCallStatusScheduled,
+			CreatedAt:     now.Add(-time.Hour),
+			UpdatedAt:     now.Add(-time.Hour),
+		}
+		if err := morningCallRepo.Create(ctx, mc); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+
+	worker := NewMorningCallDeliveryWorker(morningCallRepo, memory.NewRelationshipRepository(), nil, MorningCallDeliveryConfig{MaxPerRun: morningCallBatchSize})
+	worker.now = func() time.Time { return now }
+
+	result, err := worker.RunOnce(ctx)
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+	if result.Delivered != morningCallBatchSize || result.Deferred != total-morningCallBatchSize {
+		t.Errorf("RunOnce() = %+v, want %d delivered and %d deferred", result, morningCallBatchSize, total-morningCallBatchSize)
+	}
+	if result.MaxDelay != time.Minute {
+		t.Errorf("MaxDelay = %v, want %v", result.MaxDelay, time.Minute)
+	}
+
+	// 他の送信者のコールは大量のコールの後回しにならない
+	light, _ := morningCallRepo.FindByID(ctx, fmt.Sprintf("mc-%04d", total-1))
+	if light.Status != valueobject.MorningCallStatusDelivered {
+		t.Errorf("light = %s, want delivered", light.Status)
+	}
+
+	// 持ち越したコールは次回以降に配信される
+	delivered := result.Delivered
+	for i := 0; i < 3 && result.Deferred > 0; i++ {
+		if result, err = worker.RunOnce(ctx); err != nil {
+			t.Fatalf("予期しないエラー: %v", err)
+		}
+		delivered += result.Delivered
+	}
+	if delivered != total || result.Deferred != 0 {
+		t.Errorf("配信件数 = %d (deferred %d), want %d", delivered, result.Deferred, total)
+	}
+}
+
+func TestMorningCallDeliveryWorker_StartStop(t *testing.T) {
+	worker := NewMorningCallDeliveryWorker(memory.NewMorningCallRepository(), memory.NewRelationshipRepository(), nil, MorningCallDeliveryConfig{
+		Interval: 10 * time.Millisecond,
+	})
+
+	worker.Start(context.Background())
+	worker.Start(context.Background()) // 二重起動は無視される
+	time.Sleep(30 * time.Millisecond)
+	worker.Stop()
+	worker.Stop() // 二重停止は無視される
+}
@@ -0,0 +1,123 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// DefaultMorningCallExpirationInterval は起床確認期限切れチェックのデフォルト実行間隔
+const DefaultMorningCallExpirationInterval = 1 * time.Minute
+
+// MorningCallExpirationConfig はモーニングコール期限切れワーカーの設定
+type MorningCallExpirationConfig struct {
+	Interval time.Duration // 期限切れチェックの実行間隔
+}
+
+// MorningCallExpirationWorker は起床確認の期限を過ぎても確認されない配信済みのモーニングコールと、
+// 承認されないままアラーム時刻を過ぎた承認待ちのモーニングコールを期限切れにするワーカー
+// 期限切れへの遷移はリポジトリの変更として通知されるため、送信者への通知などはイベントの購読者が行う
+type MorningCallExpirationWorker struct {
+	*periodicRunner[int]
+
+	morningCallRepo repository.MorningCallRepository
+	config          MorningCallExpirationConfig
+	now             func() time.Time
+}
+
+// NewMorningCallExpirationWorker は新しいモーニングコール期限切れワーカーを作成する
+// 設定値が未指定（ゼロ値）の項目にはデフォルト値を使用する
+func NewMorningCallExpirationWorker(
+	morningCallRepo repository.MorningCallRepository,
+	config MorningCallExpirationConfig,
+) *MorningCallExpirationWorker {
+	if config.Interval <= 0 {
+		config.Interval = DefaultMorningCallExpirationInterval
+	}
+
+	w := &MorningCallExpirationWorker{
+		morningCallRepo: morningCallRepo,
+		config:          config,
+		now:             time.Now,
+	}
+	w.periodicRunner = newPeriodicRunner(config.Interval, w.RunOnce, logCount("モーニングコールの期限切れ処理に失敗しました", "起床確認または承認の期限を過ぎたモーニングコールを%d件期限切れにしました"))
+	return w
+}
+
+// RunOnce は期限切れチェックを1回実行し、期限切れにした件数を返す
+func (w *MorningCallExpirationWorker) RunOnce(ctx context.Context) (int, error) {
+	now := w.now()
+	targets, err := w.collectExpiredCalls(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, mc := range targets {
+		if reason := mc.MarkAsExpired(); reason.IsNG() {
+			return expired, fmt.Errorf("failed to expire morning call %s: %s", mc.ID, reason)
+		}
+		if err := w.morningCallRepo.Update(ctx, mc); err != nil {
+			// 受信者の起床確認と競合した場合は確認を優先し、まだ期限切れなら次回の実行に任せる
+			if errors.Is(err, repository.ErrUpdateConflict) {
+				continue
+			}
+			return expired, fmt.Errorf("failed to update expired morning call %s: %w", mc.ID, err)
+		}
+		expired++
+	}
+
+	return expired, nil
+}
+
+// collectExpiredCalls は期限切れにするコールを全件取得する
+// 起床確認の期限を過ぎた配信済みのコールと、アラーム時刻を過ぎて承認できなくなった承認待ちのコールが対象
+// 承認待ちのコールを残すと、アクティブなコールとして送信者の上限や同じ時刻の重複の判定に数えられ続けるため期限切れにする
+func (w *MorningCallExpirationWorker) collectExpiredCalls(ctx context.Context, now time.Time) ([]*entity.MorningCall, error) {
+	delivered, err := w.collectByStatus(ctx, valueobject.MorningCallStatusDelivered, func(mc *entity.MorningCall) bool {
+		return mc.IsConfirmDeadlinePassed(now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	pending, err := w.collectByStatus(ctx, valueobject.MorningCallStatusPendingApproval, func(mc *entity.MorningCall) bool {
+		return mc.IsApprovalDeadlinePassed(now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append(delivered, pending...), nil
+}
+
+// collectByStatus は指定したステータスのコールのうちexpiredに該当するものを全件取得する
+// 処理中にステータスが変わるとページ位置がずれるため、先に対象を全件収集する
+func (w *MorningCallExpirationWorker) collectByStatus(
+	ctx context.Context,
+	status valueobject.MorningCallStatus,
+	expired func(*entity.MorningCall) bool,
+) ([]*entity.MorningCall, error) {
+	var result []*entity.MorningCall
+	for offset := 0; ; offset += morningCallBatchSize {
+		batch, err := w.morningCallRepo.FindByStatus(ctx, status, offset, morningCallBatchSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find %s morning calls: %w", status, err)
+		}
+		for _, mc := range batch {
+			// 管理者に削除されたコールは変更できないため対象外
+			if mc.IsDeleted() {
+				continue
+			}
+			if expired(mc) {
+				result = append(result, mc)
+			}
+		}
+		if len(batch) < morningCallBatchSize {
+			return result, nil
+		}
+	}
+}
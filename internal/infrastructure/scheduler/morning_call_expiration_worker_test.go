@@ -0,0 +1,169 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+	morningCallUC "github.com/ochamu/morning-call-api/internal/usecase/morning_call"
+)
+
+func TestNewMorningCallExpirationWorker_Defaults(t *testing.T) {
+	worker := NewMorningCallExpirationWorker(memory.NewMorningCallRepository(), MorningCallExpirationConfig{})
+
+	if worker.config.Interval != DefaultMorningCallExpirationInterval {
+		t.Errorf("Interval = %v, want %v", worker.config.Interval, DefaultMorningCallExpirationInterval)
+	}
+}
+
+func TestMorningCallExpirationWorker_RunOnce(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 6, 1, 7, 30, 0, 0, time.UTC)
+	scheduled := now.Add(-time.Hour)
+	passed := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+
+	repo := memory.NewMorningCallRepository()
+	calls := []*entity.MorningCall{
+		{ID: "delivered_passed", Status: valueobject.MorningCallStatusDelivered, ConfirmDeadline: &passed},
+		{ID: "delivered_future", Status: valueobject.MorningCallStatusDelivered, ConfirmDeadline: &future},
+		{ID: "delivered_no_deadline", Status: valueobject.MorningCallStatusDelivered},
+		{ID: "confirmed_passed", Status: valueobject.MorningCallStatusConfirmed, ConfirmDeadline: &passed},
+		{ID: "deleted_passed", Status: valueobject.MorningCallStatusDelivered, ConfirmDeadline: &passed},
+		{ID: "pending_passed", Status: valueobject.MorningCallStatusPendingApproval},
+		{ID: "pending_future", Status: valueobject.MorningCallStatusPendingApproval},
+	}
+	for _, mc := range calls {
+		mc.SenderID = "sender"
+		mc.ReceiverID = "receiver"
+		mc.ScheduledTime = scheduled
+		mc.CreatedAt = scheduled.Add(-24 * time.Hour)
+		mc.UpdatedAt = scheduled
+		if mc.ID == "pending_future" {
+			mc.ScheduledTime = future
+		}
+		if mc.ID == "deleted_passed" {
+			mc.DeleteByAdmin("admin", "規約違反", scheduled)
+		}
+		if err := repo.Create(ctx, mc); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+
+	worker := NewMorningCallExpirationWorker(repo, MorningCallExpirationConfig{})
+	worker.now = func() time.Time { return now }
+
+	count, err := worker.RunOnce(ctx)
+	if err != nil {
+		t.Fatalf("RunOnce() unexpected error: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("RunOnce() = %d, want 2", count)
+	}
+
+	wantStatus := map[string]valueobject.MorningCallStatus{
+		"delivered_passed":      valueobject.MorningCallStatusExpired,
+		"delivered_future":      valueobject.MorningCallStatusDelivered,
+		"delivered_no_deadline": valueobject.MorningCallStatusDelivered,
+		"confirmed_passed":      valueobject.MorningCallStatusConfirmed,
+		"deleted_passed":        valueobject.MorningCallStatusDelivered,
+		"pending_passed":        valueobject.MorningCallStatusExpired,
+		"pending_future":        valueobject.MorningCallStatusPendingApproval,
+	}
+	for id, want := range wantStatus {
+		stored, err := repo.FindByID(ctx, id)
+		if err != nil {
+			t.Fatalf("failed to find morning call %s: %v", id, err)
+		}
+		if stored.Status != want {
+			t.Errorf("%s Status = %s, want %s", id, stored.Status, want)
+		}
+	}
+
+	// 期限切れにしたコールは再度処理しない
+	count, err = worker.RunOnce(ctx)
+	if err != nil {
+		t.Fatalf("RunOnce() unexpected error: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("second RunOnce() = %d, want 0", count)
+	}
+}
+
+func TestMorningCallExpirationWorker_StalePendingCallReleasesQuota(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	morningCallRepo := memory.NewMorningCallRepository()
+	userRepo := memory.NewUserRepository()
+	relationshipRepo := memory.NewRelationshipRepository()
+	for _, u := range []*entity.User{
+		{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+		{ID: "user2", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+	if err := relationshipRepo.Create(ctx, &entity.Relationship{
+		ID:          "rel1",
+		RequesterID: "user1",
+		ReceiverID:  "user2",
+		Status:      valueobject.RelationshipStatusAccepted,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}); err != nil {
+		t.Fatalf("failed to create friendship: %v", err)
+	}
+
+	// 承認されないままアラーム時刻を過ぎたコール
+	if err := morningCallRepo.Create(ctx, &entity.MorningCall{
+		ID:            "stale_pending",
+		SenderID:      "user1",
+		ReceiverID:    "user2",
+		ScheduledTime: now.Add(-time.Hour),
+		Status:        valueobject.MorningCallStatusPendingApproval,
+		CreatedAt:     now.Add(-48 * time.Hour),
+		UpdatedAt:     now.Add(-48 * time.Hour),
+	}); err != nil {
+		t.Fatalf("failed to create morning call: %v", err)
+	}
+
+	quotas := valueobject.PlanQuotas{
+		valueobject.PlanFree: {MaxActiveCalls: 1, MaxDailyCalls: 10},
+	}
+	createUC := morningCallUC.NewCreateUseCase(morningCallRepo, userRepo, relationshipRepo, quotas, valueobject.DefaultInputLimits(), nil)
+	input := morningCallUC.CreateInput{
+		SenderID:      "user1",
+		ReceiverID:    "user2",
+		ScheduledTime: now.Add(24 * time.Hour),
+		Message:       "おはよう",
+	}
+
+	if _, err := createUC.Execute(ctx, input); err == nil {
+		t.Fatal("expected quota error while the stale pending call is active")
+	}
+
+	worker := NewMorningCallExpirationWorker(morningCallRepo, MorningCallExpirationConfig{})
+	if count, err := worker.RunOnce(ctx); err != nil || count != 1 {
+		t.Fatalf("RunOnce() = %d, %v, want 1", count, err)
+	}
+
+	// 期限切れにしたコールはアクティブ数に含めない
+	if _, err := createUC.Execute(ctx, input); err != nil {
+		t.Errorf("Execute() unexpected error after expiration: %v", err)
+	}
+}
+
+func TestMorningCallExpirationWorker_StartStop(t *testing.T) {
+	worker := NewMorningCallExpirationWorker(memory.NewMorningCallRepository(), MorningCallExpirationConfig{Interval: time.Millisecond})
+
+	worker.Start(context.Background())
+	worker.Start(context.Background()) // 二重起動しても問題ない
+	time.Sleep(5 * time.Millisecond)
+	worker.Stop()
+	worker.Stop() // 二重停止しても問題ない
+}
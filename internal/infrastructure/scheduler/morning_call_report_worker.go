@@ -0,0 +1,64 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// DefaultMorningCallReportInterval は定期レポートの配信チェックのデフォルト実行間隔
+// 期間の区切りはユーザーのタイムゾーンごとに異なるため、期間より十分短い間隔で確認する
+const DefaultMorningCallReportInterval = 1 * time.Hour
+
+// ReportDeliverer はまだ届けていない定期レポートを生成して届け、届けた件数を返す処理
+// レポートの集計と配信済みの管理は実装（ユースケース）側で行う
+type ReportDeliverer interface {
+	Execute(ctx context.Context) (int, error)
+}
+
+// MorningCallReportConfig は定期レポート配信ワーカーの設定
+type MorningCallReportConfig struct {
+	Interval time.Duration // 配信チェックの実行間隔
+}
+
+// MorningCallReportWorker は週次・月次のモーニングコールのレポートを定期的に届けるワーカー
+type MorningCallReportWorker struct {
+	*periodicRunner[int]
+
+	deliverer ReportDeliverer
+	config    MorningCallReportConfig
+}
+
+// NewMorningCallReportWorker は新しい定期レポート配信ワーカーを作成する
+// 設定値が未指定（ゼロ値）の項目にはデフォルト値を使用する
+func NewMorningCallReportWorker(
+	deliverer ReportDeliverer,
+	config MorningCallReportConfig,
+) *MorningCallReportWorker {
+	if config.Interval <= 0 {
+		config.Interval = DefaultMorningCallReportInterval
+	}
+
+	w := &MorningCallReportWorker{
+		deliverer: deliverer,
+		config:    config,
+	}
+	w.periodicRunner = newPeriodicRunner(config.Interval, w.RunOnce, logReportDelivery)
+	return w
+}
+
+// RunOnce は配信チェックを1回実行し、届けたレポートの件数を返す
+func (w *MorningCallReportWorker) RunOnce(ctx context.Context) (int, error) {
+	return w.deliverer.Execute(ctx)
+}
+
+// logReportDelivery は配信チェックの結果をログに出力する
+// 一部のユーザーへの配信に失敗しても、届けられた分は記録する
+func logReportDelivery(count int, err error) {
+	if err != nil {
+		log.Printf("定期レポートの配信に失敗しました: %v", err)
+	}
+	if count > 0 {
+		log.Printf("定期レポートを%d件配信しました", count)
+	}
+}
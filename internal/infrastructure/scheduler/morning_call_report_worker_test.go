@@ -0,0 +1,41 @@
+package scheduler
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingReportDeliverer は呼び出し回数を数えるReportDeliverer
+type countingReportDeliverer struct {
+	calls atomic.Int32
+}
+
+func (d *countingReportDeliverer) Execute(ctx context.Context) (int, error) {
+	d.calls.Add(1)
+	return 1, nil
+}
+
+func TestNewMorningCallReportWorker_Defaults(t *testing.T) {
+	worker := NewMorningCallReportWorker(&countingReportDeliverer{}, MorningCallReportConfig{})
+
+	if worker.config.Interval != DefaultMorningCallReportInterval {
+		t.Errorf("Interval = %v, want %v", worker.config.Interval, DefaultMorningCallReportInterval)
+	}
+}
+
+func TestMorningCallReportWorker_StartStop(t *testing.T) {
+	deliverer := &countingReportDeliverer{}
+	worker := NewMorningCallReportWorker(deliverer, MorningCallReportConfig{Interval: time.Millisecond})
+
+	worker.Start(context.Background())
+	worker.Start(context.Background()) // 二重起動しても問題ない
+	time.Sleep(10 * time.Millisecond)
+	worker.Stop()
+	worker.Stop() // 二重停止しても問題ない
+
+	if deliverer.calls.Load() == 0 {
+		t.Error("deliverer was not called")
+	}
+}
@@ -0,0 +1,93 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// periodicRunner はワーカーの処理を一定間隔でバックグラウンド実行する
+// 各ワーカーは埋め込んでStart/Stopを公開し、1回分の処理と結果のログ出力だけを渡す
+type periodicRunner[T any] struct {
+	interval time.Duration
+	runOnce  func(ctx context.Context) (T, error)
+	report   func(result T, err error) // 1回分の処理の結果を受け取る（ログ出力用）
+
+	mu      sync.Mutex
+	stopCh  chan struct{}
+	doneCh  chan struct{}
+	running bool
+}
+
+// newPeriodicRunner は新しい定期実行を作成する
+func newPeriodicRunner[T any](interval time.Duration, runOnce func(ctx context.Context) (T, error), report func(result T, err error)) *periodicRunner[T] {
+	return &periodicRunner[T]{
+		interval: interval,
+		runOnce:  runOnce,
+		report:   report,
+	}
+}
+
+// logCount は処理した件数を返すワーカー向けのログ出力を作成する
+// 失敗した場合はfailureMessageとエラーを、1件以上処理した場合はsuccessFormatに件数を埋め込んで出力する
+func logCount(failureMessage, successFormat string) func(count int, err error) {
+	return func(count int, err error) {
+		if err != nil {
+			log.Printf("%s: %v", failureMessage, err)
+			return
+		}
+		if count > 0 {
+			log.Printf(successFormat, count)
+		}
+	}
+}
+
+// Start はワーカーをバックグラウンドで定期実行する（起動済みの場合は何もしない）
+func (r *periodicRunner[T]) Start(ctx context.Context) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.running {
+		return
+	}
+	r.running = true
+	r.stopCh = make(chan struct{})
+	r.doneCh = make(chan struct{})
+
+	go r.loop(ctx, r.stopCh, r.doneCh)
+}
+
+// Stop はワーカーを停止し、実行中の処理が終わるまで待機する（停止済みの場合は何もしない）
+func (r *periodicRunner[T]) Stop() {
+	r.mu.Lock()
+	if !r.running {
+		r.mu.Unlock()
+		return
+	}
+	r.running = false
+	close(r.stopCh)
+	doneCh := r.doneCh
+	r.mu.Unlock()
+
+	<-doneCh
+}
+
+// loop は一定間隔で処理を実行する
+func (r *periodicRunner[T]) loop(ctx context.Context, stopCh <-chan struct{}, doneCh chan<- struct{}) {
+	defer close(doneCh)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			result, err := r.runOnce(ctx)
+			r.report(result, err)
+		case <-stopCh:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
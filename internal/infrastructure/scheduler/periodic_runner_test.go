@@ -0,0 +1,88 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestPeriodicRunner_StartStop(t *testing.T) {
+	var mu sync.Mutex
+	var reported []int
+	var reportedErrs []error
+	calls := 0
+
+	runner := newPeriodicRunner(time.Millisecond,
+		func(ctx context.Context) (int, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			calls++
+			if calls == 1 {
+				return 0, errors.New("failed")
+			}
+			return calls, nil
+		},
+		func(result int, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			reported = append(reported, result)
+			reportedErrs = append(reportedErrs, err)
+		},
+	)
+
+	runner.Start(context.Background())
+	runner.Start(context.Background()) // 二重起動は無視される
+	deadline := time.Now().Add(time.Second)
+	for {
+		mu.Lock()
+		n := len(reported)
+		mu.Unlock()
+		if n >= 2 || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	runner.Stop()
+	runner.Stop() // 二重停止は無視される
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(reported) < 2 {
+		t.Fatalf("reported %d results, want at least 2", len(reported))
+	}
+	// 失敗しても次回も実行し、結果はそのまま渡す
+	if reportedErrs[0] == nil || reportedErrs[1] != nil || reported[1] != 2 {
+		t.Errorf("reported = %v, errs = %v, want failure then 2", reported, reportedErrs)
+	}
+
+	// 停止後は実行しない
+	stoppedAt := calls
+	time.Sleep(5 * time.Millisecond)
+	if calls != stoppedAt {
+		t.Errorf("calls = %d after Stop, want %d", calls, stoppedAt)
+	}
+}
+
+func TestPeriodicRunner_StopsWhenContextIsCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	runner := newPeriodicRunner(time.Hour,
+		func(ctx context.Context) (int, error) { return 0, nil },
+		func(int, error) {},
+	)
+
+	runner.Start(ctx)
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		runner.Stop()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Stop() did not return after the context was cancelled")
+	}
+}
@@ -3,9 +3,11 @@ package server
 import (
 	"github.com/ochamu/morning-call-api/internal/config"
 	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/service"
 	"github.com/ochamu/morning-call-api/internal/handler"
 	"github.com/ochamu/morning-call-api/internal/handler/middleware"
 	"github.com/ochamu/morning-call-api/internal/infrastructure/auth"
+	adminUC "github.com/ochamu/morning-call-api/internal/usecase/admin"
 	authUC "github.com/ochamu/morning-call-api/internal/usecase/auth"
 	morningCallUC "github.com/ochamu/morning-call-api/internal/usecase/morning_call"
 	relationshipUC "github.com/ochamu/morning-call-api/internal/usecase/relationship"
@@ -18,8 +20,10 @@ type Dependencies struct {
 	RepositoryFactory repository.RepositoryFactory
 	PasswordService   *auth.PasswordService
 	SessionManager    *auth.SessionManager
+	AuditLogger       service.AuditLogger
 	Handlers          Handlers
 	AuthMiddleware    *middleware.AuthMiddleware
+	AdminIPFilter     *middleware.IPFilterMiddleware // nilの場合は管理者エンドポイントのアクセス元を制限しない
 	UseCases          UseCases
 }
 
@@ -29,23 +33,89 @@ type Handlers struct {
 	User         *handler.UserHandler
 	Relationship *handler.RelationshipHandler
 	MorningCall  *handler.MorningCallHandler
+	Admin        *handler.AdminHandler
 }
 
 // UseCases はユースケースをまとめた構造体
 type UseCases struct {
 	Auth                *authUC.AuthUseCase
 	User                *userUC.UserUseCase
+	SearchUsers         *userUC.SearchUsersUseCase
+	RequestEmailChange  *userUC.RequestEmailChangeUseCase
+	ConfirmEmailChange  *userUC.ConfirmEmailChangeUseCase
+	UpdateCallApproval  *userUC.UpdateCallApprovalUseCase
+	ProxyConfirmer      *userUC.UpdateProxyConfirmerUseCase
+	CallForwarding      *userUC.UpdateCallForwardingUseCase
+	UpdateTimeZone      *userUC.UpdateTimeZoneUseCase
+	UpdatePreferences   *userUC.UpdatePreferencesUseCase
+	ChangeUsername      *userUC.ChangeUsernameUseCase
+	RegisterDevice      *userUC.RegisterDeviceTokenUseCase
+	ListDevices         *userUC.ListDeviceTokensUseCase
+	DeleteDevice        *userUC.DeleteDeviceTokenUseCase
+	DeleteAccount       *userUC.RequestAccountDeletionUseCase
+	ChangePassword      *userUC.ChangePasswordUseCase
+	UpdateCallWindow    *userUC.UpdateCallWindowUseCase
+	CheckAvailability   *userUC.CheckAvailabilityUseCase
+	Leaderboard         *userUC.LeaderboardUseCase
 	CreateMorningCall   *morningCallUC.CreateUseCase
 	UpdateMorningCall   *morningCallUC.UpdateUseCase
 	DeleteMorningCall   *morningCallUC.DeleteUseCase
 	ListMorningCalls    *morningCallUC.ListUseCase
 	ConfirmWake         *morningCallUC.ConfirmWakeUseCase
+	FindConflicts       *morningCallUC.FindScheduleConflictsUseCase
+	ValidateMessage     *morningCallUC.ValidateMessageUseCase
+	SendStamp           *morningCallUC.SendStampUseCase
+	FrequentReceivers   *morningCallUC.FrequentReceiversUseCase
+	SkipMorningCall     *morningCallUC.SkipUseCase
+	UnconfirmedCount    *morningCallUC.UnconfirmedCountUseCase
+	SetReceiverOffset   *morningCallUC.SetReceiverOffsetUseCase
+	PatchMorningCall    *morningCallUC.PatchUseCase
+	ApproveCall         *morningCallUC.ApproveCallUseCase
+	RejectCall          *morningCallUC.RejectCallUseCase
+	SetSilentDelivery   *morningCallUC.SetSilentDeliveryUseCase
+	CreateSeries        *morningCallUC.CreateSeriesUseCase
+	ListSeries          *morningCallUC.ListSeriesUseCase
+	CancelSeries        *morningCallUC.CancelSeriesUseCase
+	UpdateSeries        *morningCallUC.UpdateSeriesUseCase
+	CallLeaderboard     *morningCallUC.LeaderboardUseCase
+	GetMorningCall      *morningCallUC.GetUseCase
+	ListAccessLog       *morningCallUC.ListAccessLogUseCase
+	ArchiveMorningCall  *morningCallUC.ArchiveUseCase
+	CancelMorningCall   *morningCallUC.CancelUseCase
+	RequestReschedule   *morningCallUC.RequestRescheduleUseCase
+	RespondReschedule   *morningCallUC.RespondRescheduleUseCase
+	RespondNextCall     *morningCallUC.RespondNextCallUseCase
+	WakeHeatmap         *morningCallUC.WakeHeatmapUseCase
+	ListSystemMessages  *morningCallUC.ListSystemMessagesUseCase
+	RateMorningCall     *morningCallUC.RateMorningCallUseCase
+	RatingStats         *morningCallUC.RatingStatsUseCase
+	ConfirmTimeStats    *morningCallUC.ConfirmTimeStatsUseCase
+	MessageVariantStats *morningCallUC.MessageVariantStatsUseCase
+	SetDeliveryHints    *morningCallUC.SetDeliveryHintsUseCase
+	GenerateReport      *morningCallUC.GenerateReportUseCase
+	AddComment          *morningCallUC.AddCommentUseCase
+	ListComments        *morningCallUC.ListCommentsUseCase
 	SendFriendRequest   *relationshipUC.SendFriendRequestUseCase
 	AcceptFriendRequest *relationshipUC.AcceptFriendRequestUseCase
 	RejectFriendRequest *relationshipUC.RejectFriendRequestUseCase
 	BlockUser           *relationshipUC.BlockUserUseCase
 	BlockRelationship   *relationshipUC.BlockRelationshipUseCase
+	UnblockUser         *relationshipUC.UnblockUserUseCase
 	RemoveRelationship  *relationshipUC.RemoveRelationshipUseCase
 	ListFriends         *relationshipUC.ListFriendsUseCase
 	ListFriendRequests  *relationshipUC.ListFriendRequestsUseCase
+	MarkRequestSeen     *relationshipUC.MarkRequestSeenUseCase
+	UnseenRequestCount  *relationshipUC.UnseenRequestCountUseCase
+	FriendCallWindow    *relationshipUC.UpdateFriendCallWindowUseCase
+	UpdateAutoConfirm   *relationshipUC.UpdateAutoConfirmUseCase
+	FavoriteSender      *relationshipUC.UpdateFavoriteSenderUseCase
+	GenerateInvite      *relationshipUC.GenerateFriendInviteTokenUseCase
+	AcceptInvite        *relationshipUC.AcceptFriendInviteUseCase
+	FriendGraph         *relationshipUC.ExportFriendGraphUseCase
+	AdminListUsers      *userUC.AdminListUsersUseCase
+	AdminChangePlan     *userUC.AdminChangePlanUseCase
+	AdminBulkUpdate     *morningCallUC.AdminBulkUpdateStatusUseCase
+	AdminDelete         *morningCallUC.AdminDeleteUseCase
+	DetectAnomalies     *adminUC.DetectAnomalousUsersUseCase
+	SystemStats         *adminUC.SystemStatsUseCase
 }
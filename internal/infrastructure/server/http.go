@@ -1,9 +1,11 @@
 package server
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"runtime/debug"
@@ -13,6 +15,8 @@ import (
 	"github.com/ochamu/morning-call-api/internal/config"
 	"github.com/ochamu/morning-call-api/internal/handler"
 	"github.com/ochamu/morning-call-api/internal/handler/middleware"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/auth"
+	"github.com/ochamu/morning-call-api/pkg/utils"
 )
 
 // HTTPServer はHTTPサーバーの構造体です
@@ -29,6 +33,7 @@ func NewHTTPServer(cfg *config.Config, deps *Dependencies) *HTTPServer {
 	
 	// ミドルウェアを作成
 	authMiddleware := deps.AuthMiddleware
+	adminIPFilter := deps.AdminIPFilter
 	
 	// ヘルスチェック
 	router.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
@@ -47,11 +52,35 @@ func NewHTTPServer(cfg *config.Config, deps *Dependencies) *HTTPServer {
 	// 認証エンドポイント
 	router.HandleFunc("/api/v1/auth/login", deps.Handlers.Auth.HandleLogin)
 	router.HandleFunc("/api/v1/auth/logout", authMiddleware.Authenticate(deps.Handlers.Auth.HandleLogout))
+	router.HandleFunc("/api/v1/auth/tokens", authMiddleware.Authenticate(deps.Handlers.Auth.HandleIssueToken))
 	
 	// ユーザーエンドポイント
 	router.HandleFunc("/api/v1/users/register", deps.Handlers.User.HandleRegister)
 	router.HandleFunc("/api/v1/users/me", authMiddleware.Authenticate(deps.Handlers.User.HandleGetProfile))
 	router.HandleFunc("/api/v1/users/search", authMiddleware.Authenticate(deps.Handlers.User.HandleSearchUsers))
+	router.HandleFunc("/api/v1/users/check", authMiddleware.Authenticate(deps.Handlers.User.HandleCheckAvailability))
+	router.HandleFunc("/api/v1/users/me/email/request", authMiddleware.Authenticate(deps.Handlers.User.HandleRequestEmailChange))
+	router.HandleFunc("/api/v1/users/me/email/confirm", authMiddleware.Authenticate(deps.Handlers.User.HandleConfirmEmailChange))
+	router.HandleFunc("/api/v1/users/me/call-approval", authMiddleware.Authenticate(deps.Handlers.User.HandleUpdateCallApproval))
+	router.HandleFunc("/api/v1/users/me/timezone", authMiddleware.Authenticate(deps.Handlers.User.HandleUpdateTimeZone))
+	router.HandleFunc("/api/v1/users/me/username", authMiddleware.Authenticate(deps.Handlers.User.HandleChangeUsername))
+	router.HandleFunc("/api/v1/users/me/preferences", authMiddleware.Authenticate(deps.Handlers.User.HandleUpdatePreferences))
+	router.HandleFunc("/api/v1/users/me/password", authMiddleware.Authenticate(deps.Handlers.User.HandleChangePassword))
+	router.HandleFunc("/api/v1/users/me/call-window", authMiddleware.Authenticate(deps.Handlers.User.HandleUpdateCallWindow))
+	router.HandleFunc("/api/v1/users/me/proxy-confirmers/", authMiddleware.Authenticate(deps.Handlers.User.HandleUpdateProxyConfirmer))
+	router.HandleFunc("/api/v1/users/me/call-forwarding", authMiddleware.Authenticate(deps.Handlers.User.HandleUpdateCallForwarding))
+	router.HandleFunc("/api/v1/users/me/devices", authMiddleware.Authenticate(deps.Handlers.User.HandleDevices))
+	router.HandleFunc("/api/v1/users/me/devices/", authMiddleware.Authenticate(deps.Handlers.User.HandleDeleteDevice))
+	router.HandleFunc("/api/v1/users/me/deletion", authMiddleware.Authenticate(deps.Handlers.User.HandleRequestAccountDeletion))
+	router.HandleFunc("/api/v1/leaderboard", authMiddleware.Authenticate(deps.Handlers.User.HandleLeaderboard))
+	
+	// 管理者エンドポイント（アクセス元IPアドレスの制限は認証より先に適用する）
+	router.HandleFunc("/api/v1/admin/users", adminIPFilter.Wrap(authMiddleware.RequireAdmin(deps.Handlers.Admin.HandleListUsers)))
+	router.HandleFunc("/api/v1/admin/users/", adminIPFilter.Wrap(authMiddleware.RequireAdmin(deps.Handlers.Admin.HandleChangePlan)))
+	router.HandleFunc("/api/v1/admin/morning-calls/bulk-status", adminIPFilter.Wrap(authMiddleware.RequireAdmin(deps.Handlers.Admin.HandleBulkUpdateMorningCallStatus)))
+	router.HandleFunc("/api/v1/admin/morning-calls/", adminIPFilter.Wrap(authMiddleware.RequireAdmin(deps.Handlers.Admin.HandleDeleteMorningCall)))
+	router.HandleFunc("/api/v1/admin/anomalies", adminIPFilter.Wrap(authMiddleware.RequireAdmin(deps.Handlers.Admin.HandleListAnomalies)))
+	router.HandleFunc("/api/v1/admin/stats", adminIPFilter.Wrap(authMiddleware.RequireAdmin(deps.Handlers.Admin.HandleSystemStats)))
 	
 	// リレーションシップエンドポイント
 	router.HandleFunc("/api/v1/relationships/request", authMiddleware.Authenticate(deps.Handlers.Relationship.HandleSendFriendRequest))
@@ -91,6 +120,34 @@ func NewHTTPServer(cfg *config.Config, deps *Dependencies) *HTTPServer {
 			} else {
 				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			}
+		case "seen":
+			if r.Method == http.MethodPut {
+				ctx := context.WithValue(r.Context(), "relationshipID", relationshipID)
+				deps.Handlers.Relationship.HandleMarkRequestSeen(w, r.WithContext(ctx))
+			} else {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
+		case "call-window":
+			if r.Method == http.MethodPut {
+				ctx := context.WithValue(r.Context(), "relationshipID", relationshipID)
+				deps.Handlers.Relationship.HandleUpdateFriendCallWindow(w, r.WithContext(ctx))
+			} else {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
+		case "auto-confirm":
+			if r.Method == http.MethodPut {
+				ctx := context.WithValue(r.Context(), "relationshipID", relationshipID)
+				deps.Handlers.Relationship.HandleUpdateAutoConfirm(w, r.WithContext(ctx))
+			} else {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
+		case "favorite-sender":
+			if r.Method == http.MethodPut {
+				ctx := context.WithValue(r.Context(), "relationshipID", relationshipID)
+				deps.Handlers.Relationship.HandleUpdateFavoriteSender(w, r.WithContext(ctx))
+			} else {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
 		default:
 			// DELETE /api/v1/relationships/{id}
 			if r.Method == http.MethodDelete && action == "" {
@@ -103,9 +160,13 @@ func NewHTTPServer(cfg *config.Config, deps *Dependencies) *HTTPServer {
 	}))
 	router.HandleFunc("/api/v1/relationships/friends", authMiddleware.Authenticate(deps.Handlers.Relationship.HandleListFriends))
 	router.HandleFunc("/api/v1/relationships/requests", authMiddleware.Authenticate(deps.Handlers.Relationship.HandleListFriendRequests))
+	router.HandleFunc("/api/v1/relationships/requests/unseen-count", authMiddleware.Authenticate(deps.Handlers.Relationship.HandleUnseenRequestCount))
+	router.HandleFunc("/api/v1/relationships/invites", authMiddleware.Authenticate(deps.Handlers.Relationship.HandleGenerateFriendInvite))
+	router.HandleFunc("/api/v1/relationships/accept-invite", authMiddleware.Authenticate(deps.Handlers.Relationship.HandleAcceptFriendInvite))
+	router.HandleFunc("/api/v1/relationships/graph", authMiddleware.Authenticate(deps.Handlers.Relationship.HandleFriendGraph))
 	
 	// モーニングコールエンドポイント
-	router.HandleFunc("/api/v1/morning-calls", authMiddleware.Authenticate(func(w http.ResponseWriter, r *http.Request) {
+	router.HandleFunc("/api/v1/morning-calls", authMiddleware.RequireScope(auth.ScopeMorningCallsRead, func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodPost:
 			deps.Handlers.MorningCall.HandleCreate(w, r)
@@ -123,12 +184,26 @@ func NewHTTPServer(cfg *config.Config, deps *Dependencies) *HTTPServer {
 		}
 	}))
 	
-	router.HandleFunc("/api/v1/morning-calls/sent", authMiddleware.Authenticate(deps.Handlers.MorningCall.HandleListSent))
-	router.HandleFunc("/api/v1/morning-calls/received", authMiddleware.Authenticate(deps.Handlers.MorningCall.HandleListReceived))
+	router.HandleFunc("/api/v1/morning-calls/sent", authMiddleware.RequireScope(auth.ScopeMorningCallsRead, deps.Handlers.MorningCall.HandleListSent))
+	router.HandleFunc("/api/v1/morning-calls/received", authMiddleware.RequireScope(auth.ScopeMorningCallsRead, deps.Handlers.MorningCall.HandleListReceived))
+	router.HandleFunc("/api/v1/morning-calls/archived", authMiddleware.RequireScope(auth.ScopeMorningCallsRead, deps.Handlers.MorningCall.HandleListArchived))
+	router.HandleFunc("/api/v1/morning-calls/conflicts", authMiddleware.RequireScope(auth.ScopeMorningCallsRead, deps.Handlers.MorningCall.HandleListConflicts))
+	router.HandleFunc("/api/v1/morning-calls/frequent-receivers", authMiddleware.RequireScope(auth.ScopeMorningCallsRead, deps.Handlers.MorningCall.HandleListFrequentReceivers))
+	router.HandleFunc("/api/v1/morning-calls/unconfirmed-count", authMiddleware.RequireScope(auth.ScopeMorningCallsRead, deps.Handlers.MorningCall.HandleUnconfirmedCount))
+	router.HandleFunc("/api/v1/morning-calls/leaderboard", authMiddleware.RequireScope(auth.ScopeMorningCallsRead, deps.Handlers.MorningCall.HandleLeaderboard))
+	router.HandleFunc("/api/v1/morning-calls/heatmap", authMiddleware.RequireScope(auth.ScopeMorningCallsRead, deps.Handlers.MorningCall.HandleWakeHeatmap))
+	router.HandleFunc("/api/v1/morning-calls/report", authMiddleware.RequireScope(auth.ScopeMorningCallsRead, deps.Handlers.MorningCall.HandleReport))
+	router.HandleFunc("/api/v1/morning-calls/system-messages", authMiddleware.RequireScope(auth.ScopeMorningCallsRead, deps.Handlers.MorningCall.HandleListSystemMessages))
+	router.HandleFunc("/api/v1/morning-calls/rating-stats", authMiddleware.RequireScope(auth.ScopeMorningCallsRead, deps.Handlers.MorningCall.HandleRatingStats))
+	router.HandleFunc("/api/v1/morning-calls/confirm-time-stats", authMiddleware.RequireScope(auth.ScopeMorningCallsRead, deps.Handlers.MorningCall.HandleConfirmTimeStats))
+	router.HandleFunc("/api/v1/morning-calls/variant-stats", authMiddleware.RequireScope(auth.ScopeMorningCallsRead, deps.Handlers.MorningCall.HandleMessageVariantStats))
+	router.HandleFunc("/api/v1/morning-calls/validate-message", authMiddleware.RequireScope(auth.ScopeMorningCallsRead, deps.Handlers.MorningCall.HandleValidateMessage))
+	router.HandleFunc("/api/v1/morning-calls/series", authMiddleware.RequireScope(auth.ScopeMorningCallsRead, deps.Handlers.MorningCall.HandleCreateSeries))
+	router.HandleFunc("/api/v1/morning-calls/series/", authMiddleware.RequireScope(auth.ScopeMorningCallsRead, deps.Handlers.MorningCall.HandleSeries))
 	
 	// パスが/api/v1/morning-calls/で始まる全てのリクエストを処理
 	// Go標準のServeMuxは末尾スラッシュがある場合、そのプレフィックスで始まる全パスをマッチする
-	router.HandleFunc("/api/v1/morning-calls/", authMiddleware.Authenticate(func(w http.ResponseWriter, r *http.Request) {
+	router.HandleFunc("/api/v1/morning-calls/", authMiddleware.RequireScope(auth.ScopeMorningCallsRead, func(w http.ResponseWriter, r *http.Request) {
 		// /api/v1/morning-calls/{id}/* のパターンを処理
 		path := strings.TrimPrefix(r.URL.Path, "/api/v1/morning-calls/")
 		
@@ -158,6 +233,176 @@ func NewHTTPServer(cfg *config.Config, deps *Dependencies) *HTTPServer {
 			return
 		}
 		
+		// /api/v1/morning-calls/{id}/stamp
+		if len(parts) > 1 && parts[1] == "stamp" {
+			if r.Method == http.MethodPut {
+				ctx := context.WithValue(r.Context(), "morningCallID", morningCallID)
+				deps.Handlers.MorningCall.HandleSendStamp(w, r.WithContext(ctx))
+			} else {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
+			return
+		}
+		
+		// /api/v1/morning-calls/{id}/rating
+		if len(parts) > 1 && parts[1] == "rating" {
+			if r.Method == http.MethodPost {
+				ctx := context.WithValue(r.Context(), "morningCallID", morningCallID)
+				deps.Handlers.MorningCall.HandleRate(w, r.WithContext(ctx))
+			} else {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
+			return
+		}
+		
+		// /api/v1/morning-calls/{id}/skip
+		if len(parts) > 1 && parts[1] == "skip" {
+			if r.Method == http.MethodPut {
+				ctx := context.WithValue(r.Context(), "morningCallID", morningCallID)
+				deps.Handlers.MorningCall.HandleSkip(w, r.WithContext(ctx))
+			} else {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
+			return
+		}
+		
+		// /api/v1/morning-calls/{id}/cancel
+		if len(parts) > 1 && parts[1] == "cancel" {
+			if r.Method == http.MethodPut {
+				ctx := context.WithValue(r.Context(), "morningCallID", morningCallID)
+				deps.Handlers.MorningCall.HandleCancel(w, r.WithContext(ctx))
+			} else {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
+			return
+		}
+		
+		// /api/v1/morning-calls/{id}/reschedule-request[/approve|/reject]
+		if len(parts) > 1 && parts[1] == "reschedule-request" {
+			if r.Method != http.MethodPost {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			ctx := context.WithValue(r.Context(), "morningCallID", morningCallID)
+			switch {
+			case len(parts) == 2:
+				deps.Handlers.MorningCall.HandleRequestReschedule(w, r.WithContext(ctx))
+			case len(parts) == 3 && parts[2] == "approve":
+				deps.Handlers.MorningCall.HandleApproveReschedule(w, r.WithContext(ctx))
+			case len(parts) == 3 && parts[2] == "reject":
+				deps.Handlers.MorningCall.HandleRejectReschedule(w, r.WithContext(ctx))
+			default:
+				http.Error(w, "Not found", http.StatusNotFound)
+			}
+			return
+		}
+
+		// /api/v1/morning-calls/{id}/next-call-request/approve|reject
+		if len(parts) == 3 && parts[1] == "next-call-request" {
+			if r.Method != http.MethodPost {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			ctx := context.WithValue(r.Context(), "morningCallID", morningCallID)
+			switch parts[2] {
+			case "approve":
+				deps.Handlers.MorningCall.HandleApproveNextCall(w, r.WithContext(ctx))
+			case "reject":
+				deps.Handlers.MorningCall.HandleRejectNextCall(w, r.WithContext(ctx))
+			default:
+				http.Error(w, "Not found", http.StatusNotFound)
+			}
+			return
+		}
+		
+		// /api/v1/morning-calls/{id}/offset
+		if len(parts) > 1 && parts[1] == "offset" {
+			if r.Method == http.MethodPut {
+				ctx := context.WithValue(r.Context(), "morningCallID", morningCallID)
+				deps.Handlers.MorningCall.HandleSetReceiverOffset(w, r.WithContext(ctx))
+			} else {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
+			return
+		}
+		
+		// /api/v1/morning-calls/{id}/silent
+		if len(parts) > 1 && parts[1] == "silent" {
+			if r.Method == http.MethodPut {
+				ctx := context.WithValue(r.Context(), "morningCallID", morningCallID)
+				deps.Handlers.MorningCall.HandleSetSilentDelivery(w, r.WithContext(ctx))
+			} else {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
+			return
+		}
+		
+		// /api/v1/morning-calls/{id}/delivery-hints
+		if len(parts) > 1 && parts[1] == "delivery-hints" {
+			if r.Method == http.MethodPut {
+				ctx := context.WithValue(r.Context(), "morningCallID", morningCallID)
+				deps.Handlers.MorningCall.HandleSetDeliveryHints(w, r.WithContext(ctx))
+			} else {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
+			return
+		}
+		
+		// /api/v1/morning-calls/{id}/approve
+		if len(parts) > 1 && parts[1] == "approve" {
+			if r.Method == http.MethodPut {
+				ctx := context.WithValue(r.Context(), "morningCallID", morningCallID)
+				deps.Handlers.MorningCall.HandleApprove(w, r.WithContext(ctx))
+			} else {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
+			return
+		}
+		
+		// /api/v1/morning-calls/{id}/archive
+		if len(parts) > 1 && parts[1] == "archive" {
+			if r.Method == http.MethodPut || r.Method == http.MethodDelete {
+				ctx := context.WithValue(r.Context(), "morningCallID", morningCallID)
+				deps.Handlers.MorningCall.HandleArchive(w, r.WithContext(ctx))
+			} else {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
+			return
+		}
+		
+		// /api/v1/morning-calls/{id}/access-log
+		if len(parts) > 1 && parts[1] == "access-log" {
+			if r.Method == http.MethodGet {
+				ctx := context.WithValue(r.Context(), "morningCallID", morningCallID)
+				deps.Handlers.MorningCall.HandleAccessLog(w, r.WithContext(ctx))
+			} else {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
+			return
+		}
+		
+		// /api/v1/morning-calls/{id}/comments
+		if len(parts) > 1 && parts[1] == "comments" {
+			if r.Method == http.MethodGet || r.Method == http.MethodPost {
+				ctx := context.WithValue(r.Context(), "morningCallID", morningCallID)
+				deps.Handlers.MorningCall.HandleComments(w, r.WithContext(ctx))
+			} else {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
+			return
+		}
+		
+		// /api/v1/morning-calls/{id}/reject
+		if len(parts) > 1 && parts[1] == "reject" {
+			if r.Method == http.MethodPut {
+				ctx := context.WithValue(r.Context(), "morningCallID", morningCallID)
+				deps.Handlers.MorningCall.HandleReject(w, r.WithContext(ctx))
+			} else {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			}
+			return
+		}
+		
 		// /api/v1/morning-calls/{id}
 		switch r.Method {
 		case http.MethodGet:
@@ -166,6 +411,9 @@ func NewHTTPServer(cfg *config.Config, deps *Dependencies) *HTTPServer {
 		case http.MethodPut:
 			ctx := context.WithValue(r.Context(), "morningCallID", morningCallID)
 			deps.Handlers.MorningCall.HandleUpdate(w, r.WithContext(ctx))
+		case http.MethodPatch:
+			ctx := context.WithValue(r.Context(), "morningCallID", morningCallID)
+			deps.Handlers.MorningCall.HandlePatch(w, r.WithContext(ctx))
 		case http.MethodDelete:
 			ctx := context.WithValue(r.Context(), "morningCallID", morningCallID)
 			deps.Handlers.MorningCall.HandleDelete(w, r.WithContext(ctx))
@@ -242,6 +490,8 @@ func (s *HTTPServer) setupRoutes() {
 		s.router.HandleFunc("/api/v1/relationships/request", authMiddleware.Authenticate(relationshipHandler.HandleSendFriendRequest))
 		s.router.HandleFunc("/api/v1/relationships/friends", authMiddleware.Authenticate(relationshipHandler.HandleListFriends))
 		s.router.HandleFunc("/api/v1/relationships/requests", authMiddleware.Authenticate(relationshipHandler.HandleListFriendRequests))
+		s.router.HandleFunc("/api/v1/relationships/invites", authMiddleware.Authenticate(relationshipHandler.HandleGenerateFriendInvite))
+		s.router.HandleFunc("/api/v1/relationships/accept-invite", authMiddleware.Authenticate(relationshipHandler.HandleAcceptFriendInvite))
 		// IDを含むエンドポイント
 		s.router.HandleFunc("/api/v1/relationships/", authMiddleware.Authenticate(func(w http.ResponseWriter, r *http.Request) {
 			path := r.URL.Path
@@ -297,11 +547,12 @@ func (s *HTTPServer) setupRoutes() {
 	// Morning Callsエンドポイント
 	if morningCallHandler != nil && authMiddleware != nil {
 		// 一覧系
-		s.router.HandleFunc("/api/v1/morning-calls/sent", authMiddleware.Authenticate(morningCallHandler.HandleListSent))
-		s.router.HandleFunc("/api/v1/morning-calls/received", authMiddleware.Authenticate(morningCallHandler.HandleListReceived))
+		s.router.HandleFunc("/api/v1/morning-calls/sent", authMiddleware.RequireScope(auth.ScopeMorningCallsRead, morningCallHandler.HandleListSent))
+		s.router.HandleFunc("/api/v1/morning-calls/received", authMiddleware.RequireScope(auth.ScopeMorningCallsRead, morningCallHandler.HandleListReceived))
+		s.router.HandleFunc("/api/v1/morning-calls/archived", authMiddleware.RequireScope(auth.ScopeMorningCallsRead, morningCallHandler.HandleListArchived))
 
 		// CRUD操作
-		s.router.HandleFunc("/api/v1/morning-calls", authMiddleware.Authenticate(func(w http.ResponseWriter, r *http.Request) {
+		s.router.HandleFunc("/api/v1/morning-calls", authMiddleware.RequireScope(auth.ScopeMorningCallsRead, func(w http.ResponseWriter, r *http.Request) {
 			switch r.Method {
 			case http.MethodPost:
 				morningCallHandler.HandleCreate(w, r)
@@ -311,7 +562,7 @@ func (s *HTTPServer) setupRoutes() {
 		}))
 
 		// IDを含むエンドポイント
-		s.router.HandleFunc("/api/v1/morning-calls/", authMiddleware.Authenticate(func(w http.ResponseWriter, r *http.Request) {
+		s.router.HandleFunc("/api/v1/morning-calls/", authMiddleware.RequireScope(auth.ScopeMorningCallsRead, func(w http.ResponseWriter, r *http.Request) {
 			path := r.URL.Path
 			// パスからIDを抽出
 			prefix := "/api/v1/morning-calls/"
@@ -368,11 +619,45 @@ func (s *HTTPServer) applyMiddleware(handler http.Handler) http.Handler {
 	return handler
 }
 
+// debugBodyLogLimit はデバッグログに出力するリクエストボディの最大バイト数です
+const debugBodyLogLimit = 64 * 1024
+
 // loggingMiddleware はリクエストログを記録するミドルウェアです
+// URL・ヘッダー・ボディのセンシティブな値はマスクして出力します
+// X-Request-IDヘッダーがあればそのIDを引き継ぎ（なければ生成し）、
+// レスポンスヘッダーとコンテキストに設定して後続のログに付与します
+// 処理時間が閾値を超えたリクエストはスローログとして別途出力します
 func (s *HTTPServer) loggingMiddleware(next http.Handler) http.Handler {
+	sanitizer := NewLogSanitizer(s.config.Log.SensitiveKeys)
+	debugEnabled := s.config.Log.Level == "debug"
+	slowThreshold := s.config.Log.SlowRequestThreshold
+
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
 
+		// リクエストIDの決定（不正な値はサニタイズし、空なら新規生成）
+		requestID := utils.ResolveRequestID(r.Header.Get(utils.RequestIDHeader))
+		w.Header().Set(utils.RequestIDHeader, requestID)
+		r = r.WithContext(utils.WithRequestID(r.Context(), requestID))
+		ctx := r.Context()
+
+		// デバッグ時はヘッダーとボディをマスクして出力
+		if debugEnabled {
+			utils.Logf(ctx, "[DEBUG] %s %s headers: %s", r.Method, sanitizer.SanitizeURL(r.RequestURI), sanitizer.SanitizeHeaders(r.Header))
+			if r.Body != nil {
+				body, err := io.ReadAll(io.LimitReader(r.Body, debugBodyLogLimit+1))
+				if err == nil && len(body) > 0 {
+					if len(body) > debugBodyLogLimit {
+						utils.Logf(ctx, "[DEBUG] %s %s body: [ボディが大きすぎるため省略]", r.Method, sanitizer.SanitizeURL(r.RequestURI))
+					} else {
+						utils.Logf(ctx, "[DEBUG] %s %s body: %s", r.Method, sanitizer.SanitizeURL(r.RequestURI), sanitizer.SanitizeJSON(body))
+					}
+				}
+				// 読み取った分を戻して後続のハンドラーで読めるようにする
+				r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+			}
+		}
+
 		// レスポンスライターのラッパー
 		lrw := &loggingResponseWriter{
 			ResponseWriter: w,
@@ -384,23 +669,46 @@ func (s *HTTPServer) loggingMiddleware(next http.Handler) http.Handler {
 
 		// アクセスログ出力
 		duration := time.Since(start)
-		log.Printf(
+		utils.Logf(
+			ctx,
 			"[%s] %s %s %d %v",
 			r.Method,
-			r.RequestURI,
+			sanitizer.SanitizeURL(r.RequestURI),
 			r.RemoteAddr,
 			lrw.statusCode,
 			duration,
 		)
+		logSlowRequest(ctx, r, sanitizer, lrw.statusCode, duration, slowThreshold)
 	})
 }
 
+// slowRequestLogPrefix はスローログを通常のアクセスログと区別するための接頭辞です
+const slowRequestLogPrefix = "[WARN] [SLOW_REQUEST]"
+
+// logSlowRequest は処理時間が閾値を超えたリクエストをWARNレベルで出力します
+// 閾値が0以下の場合は出力しません。クエリパラメータのセンシティブな値はマスクします
+func logSlowRequest(ctx context.Context, r *http.Request, sanitizer *LogSanitizer, statusCode int, duration, threshold time.Duration) {
+	if threshold <= 0 || duration <= threshold {
+		return
+	}
+	utils.Logf(
+		ctx,
+		"%s %s %s %d duration=%v threshold=%v",
+		slowRequestLogPrefix,
+		r.Method,
+		sanitizer.SanitizeURL(r.RequestURI),
+		statusCode,
+		duration,
+		threshold,
+	)
+}
+
 // recoveryMiddleware はパニックから回復するミドルウェアです
 func (s *HTTPServer) recoveryMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		defer func() {
 			if err := recover(); err != nil {
-				log.Printf("パニックが発生しました: %v\n%s", err, debug.Stack())
+				utils.Logf(r.Context(), "パニックが発生しました: %v\n%s", err, debug.Stack())
 
 				// エラーレスポンスを返す
 				w.Header().Set("Content-Type", "application/json")
@@ -411,7 +719,7 @@ func (s *HTTPServer) recoveryMiddleware(next http.Handler) http.Handler {
 						"message": "内部エラーが発生しました",
 					},
 				}); err != nil {
-					log.Printf("パニックリカバリー時のエラーレスポンス送信に失敗しました: %v", err)
+					utils.Logf(r.Context(), "パニックリカバリー時のエラーレスポンス送信に失敗しました: %v", err)
 				}
 			}
 		}()
@@ -423,10 +731,19 @@ func (s *HTTPServer) recoveryMiddleware(next http.Handler) http.Handler {
 // corsMiddleware はCORSヘッダーを設定するミドルウェアです
 func (s *HTTPServer) corsMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// CORS ヘッダーの設定
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		// CORS ヘッダーの設定（許可されていないオリジンにはAccess-Control-Allow-Originを返さない）
+		if allowed := s.config.CORS.AllowedOrigin(r.Header.Get("Origin")); allowed != "" {
+			w.Header().Set("Access-Control-Allow-Origin", allowed)
+			if allowed != "*" {
+				w.Header().Add("Vary", "Origin")
+			}
+			if s.config.CORS.AllowCredentials {
+				w.Header().Set("Access-Control-Allow-Credentials", "true")
+			}
+		}
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, If-None-Match, "+utils.RequestIDHeader)
+		w.Header().Set("Access-Control-Expose-Headers", "ETag, Content-Disposition, "+utils.RequestIDHeader)
 
 		// プリフライトリクエストの処理
 		if r.Method == http.MethodOptions {
@@ -0,0 +1,145 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// MaskedValue はマスクされた値の表示
+const MaskedValue = "***"
+
+// DefaultSensitiveKeys はデフォルトでマスク対象とするキー
+// キー名にこれらの語を含む場合（大文字小文字を区別しない）にマスクする
+var DefaultSensitiveKeys = []string{
+	"password",
+	"token",
+	"authorization",
+	"cookie",
+	"secret",
+	"session",
+	"api_key",
+}
+
+// LogSanitizer はログ出力前にセンシティブな値をマスクする
+type LogSanitizer struct {
+	keys []string // 小文字に正規化したマスク対象キー
+}
+
+// NewLogSanitizer は新しいLogSanitizerを作成する
+// keysが空の場合はDefaultSensitiveKeysを使用する
+func NewLogSanitizer(keys []string) *LogSanitizer {
+	if len(keys) == 0 {
+		keys = DefaultSensitiveKeys
+	}
+
+	normalized := make([]string, 0, len(keys))
+	for _, k := range keys {
+		k = strings.ToLower(strings.TrimSpace(k))
+		if k != "" {
+			normalized = append(normalized, k)
+		}
+	}
+
+	return &LogSanitizer{keys: normalized}
+}
+
+// IsSensitiveKey はキーがマスク対象かを判定する
+// new_password や X-Auth-Token のように対象語を含むキーもマスク対象とする
+func (s *LogSanitizer) IsSensitiveKey(key string) bool {
+	lower := strings.ToLower(key)
+	for _, k := range s.keys {
+		if strings.Contains(lower, k) {
+			return true
+		}
+	}
+	return false
+}
+
+// SanitizeHeaders はヘッダーをマスクしてログ用の文字列に変換する
+func (s *LogSanitizer) SanitizeHeaders(header http.Header) string {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		value := strings.Join(header[name], ",")
+		if s.IsSensitiveKey(name) {
+			value = MaskedValue
+		}
+		parts = append(parts, fmt.Sprintf("%s=%s", name, value))
+	}
+	return strings.Join(parts, " ")
+}
+
+// SanitizeURL はクエリパラメータをマスクしたURLを返す
+func (s *LogSanitizer) SanitizeURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		// 解析できないURLはクエリごと伏せる
+		if i := strings.Index(rawURL, "?"); i >= 0 {
+			return rawURL[:i] + "?" + MaskedValue
+		}
+		return rawURL
+	}
+	if u.RawQuery == "" {
+		return rawURL
+	}
+
+	query := u.Query()
+	for key := range query {
+		if s.IsSensitiveKey(key) {
+			query[key] = []string{MaskedValue}
+		}
+	}
+	// url.Values.Encodeはキー順にソートしてエンコードする
+	u.RawQuery = strings.ReplaceAll(query.Encode(), url.QueryEscape(MaskedValue), MaskedValue)
+	return u.String()
+}
+
+// SanitizeJSON はJSONボディのセンシティブな値をネストも含めて再帰的にマスクする
+// JSONとして解析できないボディは内容を出力せず、サイズのみを返す
+func (s *LogSanitizer) SanitizeJSON(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return fmt.Sprintf("[非JSONボディ %d bytes]", len(body))
+	}
+
+	masked, err := json.Marshal(s.sanitizeValue(v))
+	if err != nil {
+		return fmt.Sprintf("[ボディ %d bytes]", len(body))
+	}
+	return string(masked)
+}
+
+// sanitizeValue はJSONの値を再帰的にたどり、マスク対象キーの値を置き換える
+func (s *LogSanitizer) sanitizeValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for key, child := range val {
+			if s.IsSensitiveKey(key) {
+				val[key] = MaskedValue
+				continue
+			}
+			val[key] = s.sanitizeValue(child)
+		}
+		return val
+	case []interface{}:
+		for i, child := range val {
+			val[i] = s.sanitizeValue(child)
+		}
+		return val
+	default:
+		return val
+	}
+}
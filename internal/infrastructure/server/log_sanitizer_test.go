@@ -0,0 +1,197 @@
+package server
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ochamu/morning-call-api/internal/config"
+)
+
+func TestLogSanitizer_IsSensitiveKey(t *testing.T) {
+	s := NewLogSanitizer(nil)
+
+	tests := []struct {
+		key  string
+		want bool
+	}{
+		{key: "password", want: true},
+		{key: "Password", want: true},
+		{key: "new_password", want: true},
+		{key: "currentPassword", want: true},
+		{key: "access_token", want: true},
+		{key: "Authorization", want: true},
+		{key: "X-Auth-Token", want: true},
+		{key: "Cookie", want: true},
+		{key: "session_id", want: true},
+		{key: "username", want: false},
+		{key: "email", want: false},
+		{key: "Content-Type", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if got := s.IsSensitiveKey(tt.key); got != tt.want {
+				t.Errorf("IsSensitiveKey(%q) = %v, want %v", tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLogSanitizer_CustomKeys(t *testing.T) {
+	s := NewLogSanitizer([]string{" PIN ", ""})
+
+	if !s.IsSensitiveKey("pin_code") {
+		t.Error("設定したキーがマスク対象になっていない")
+	}
+	if s.IsSensitiveKey("password") {
+		t.Error("キーを指定した場合はデフォルトのキーを使用しない")
+	}
+}
+
+func TestLogSanitizer_SanitizeJSON(t *testing.T) {
+	s := NewLogSanitizer(nil)
+
+	tests := []struct {
+		name    string
+		body    string
+		secrets []string // 出力に含まれてはいけない値
+		keep    []string // 出力に残るべき値
+	}{
+		{
+			name:    "トップレベルのパスワード",
+			body:    `{"username":"alice","password":"Secret123!"}`,
+			secrets: []string{"Secret123!"},
+			keep:    []string{`"username":"alice"`, `"password":"***"`},
+		},
+		{
+			name:    "ネストしたオブジェクト",
+			body:    `{"user":{"name":"bob","credentials":{"token":"abc.def.ghi","refresh_token":"zzz"}}}`,
+			secrets: []string{"abc.def.ghi", "zzz"},
+			keep:    []string{`"name":"bob"`},
+		},
+		{
+			name:    "配列内のオブジェクト",
+			body:    `[{"password":"p1"},{"items":[{"api_key":"k1","value":1}]}]`,
+			secrets: []string{"p1", "k1"},
+			keep:    []string{`"value":1`},
+		},
+		{
+			name:    "オブジェクトごとマスク",
+			body:    `{"secret":{"nested":"hidden-value"}}`,
+			secrets: []string{"hidden-value"},
+			keep:    []string{`"secret":"***"`},
+		},
+		{
+			name:    "JSONでないボディは内容を出力しない",
+			body:    `password=Secret123!&username=alice`,
+			secrets: []string{"Secret123!", "alice"},
+			keep:    []string{"非JSONボディ"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := s.SanitizeJSON([]byte(tt.body))
+
+			for _, secret := range tt.secrets {
+				if strings.Contains(got, secret) {
+					t.Errorf("センシティブな値 %q が漏洩している: %s", secret, got)
+				}
+			}
+			for _, k := range tt.keep {
+				if !strings.Contains(got, k) {
+					t.Errorf("出力に %q が含まれていない: %s", k, got)
+				}
+			}
+		})
+	}
+
+	if got := s.SanitizeJSON(nil); got != "" {
+		t.Errorf("空のボディ = %q, want empty", got)
+	}
+}
+
+func TestLogSanitizer_SanitizeHeaders(t *testing.T) {
+	s := NewLogSanitizer(nil)
+
+	header := http.Header{}
+	header.Set("Authorization", "Bearer secret-token")
+	header.Set("Cookie", "session_id=abcdef")
+	header.Set("Content-Type", "application/json")
+
+	got := s.SanitizeHeaders(header)
+
+	for _, secret := range []string{"secret-token", "abcdef"} {
+		if strings.Contains(got, secret) {
+			t.Errorf("センシティブな値 %q が漏洩している: %s", secret, got)
+		}
+	}
+	want := "Authorization=*** Content-Type=application/json Cookie=***"
+	if got != want {
+		t.Errorf("SanitizeHeaders() = %q, want %q", got, want)
+	}
+}
+
+func TestLogSanitizer_SanitizeURL(t *testing.T) {
+	s := NewLogSanitizer(nil)
+
+	tests := []struct {
+		name string
+		url  string
+		want string
+	}{
+		{name: "クエリなし", url: "/api/v1/users/me", want: "/api/v1/users/me"},
+		{name: "マスク対象外のクエリ", url: "/api/v1/users/search?query=alice", want: "/api/v1/users/search?query=alice"},
+		{name: "トークンを含むクエリ", url: "/api/v1/invite?token=abc123&ref=x", want: "/api/v1/invite?ref=x&token=***"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.SanitizeURL(tt.url); got != tt.want {
+				t.Errorf("SanitizeURL(%q) = %q, want %q", tt.url, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoggingMiddleware_MasksSensitiveValues(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	cfg := &config.Config{Log: config.LogConfig{Level: "debug"}}
+	s := &HTTPServer{config: cfg}
+
+	var receivedBody string
+	handler := s.loggingMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		receivedBody = string(body)
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	body := `{"username":"alice","password":"Secret123!","profile":{"token":"tok-xyz"}}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login?token=query-secret", strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer header-secret")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	// ハンドラーはボディを元のまま読める
+	if receivedBody != body {
+		t.Errorf("ハンドラーが受け取ったボディ = %q, want %q", receivedBody, body)
+	}
+
+	output := buf.String()
+	for _, secret := range []string{"Secret123!", "tok-xyz", "query-secret", "header-secret"} {
+		if strings.Contains(output, secret) {
+			t.Errorf("ログにセンシティブな値 %q が出力されている:\n%s", secret, output)
+		}
+	}
+	if !strings.Contains(output, "alice") {
+		t.Errorf("マスク対象外の値がログに出力されていない:\n%s", output)
+	}
+}
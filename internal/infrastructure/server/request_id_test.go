@@ -0,0 +1,118 @@
+package server
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ochamu/morning-call-api/internal/config"
+	"github.com/ochamu/morning-call-api/pkg/utils"
+)
+
+func TestLoggingMiddleware_RequestID(t *testing.T) {
+	longID := strings.Repeat("a", utils.MaxRequestIDLength+50)
+
+	tests := []struct {
+		name     string
+		header   string
+		want     string
+		generate bool // trueの場合は新規生成されることを確認する
+	}{
+		{name: "受信したIDを引き継ぐ", header: "gateway-req-001", want: "gateway-req-001"},
+		{name: "ヘッダーがなければ生成する", header: "", generate: true},
+		{name: "長すぎるIDは切り詰める", header: longID, want: longID[:utils.MaxRequestIDLength]},
+		{name: "改行などの不正な文字は除去する", header: "abc\r\n[INFO] fake\tlog", want: "abcINFOfakelog"},
+		{name: "使用できる文字がなければ生成する", header: "\r\n<>\"", generate: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			log.SetOutput(&buf)
+			defer log.SetOutput(os.Stderr)
+
+			s := &HTTPServer{config: &config.Config{Log: config.LogConfig{Level: "info"}}}
+
+			var ctxRequestID string
+			handler := s.loggingMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				ctxRequestID = utils.RequestIDFromContext(r.Context())
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/users/me", nil)
+			if tt.header != "" {
+				req.Header.Set(utils.RequestIDHeader, tt.header)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			got := rec.Header().Get(utils.RequestIDHeader)
+			if tt.generate {
+				if got == "" {
+					t.Fatal("リクエストIDが生成されていない")
+				}
+			} else if got != tt.want {
+				t.Errorf("レスポンスヘッダーのID = %q, want %q", got, tt.want)
+			}
+			if len(got) > utils.MaxRequestIDLength {
+				t.Errorf("IDの長さ = %d, 上限 %d を超えている", len(got), utils.MaxRequestIDLength)
+			}
+
+			// ハンドラーにはレスポンスと同じIDがコンテキスト経由で渡る
+			if ctxRequestID != got {
+				t.Errorf("コンテキストのID = %q, want %q", ctxRequestID, got)
+			}
+
+			// アクセスログにIDが付与される
+			if !strings.Contains(buf.String(), "[request_id="+got+"]") {
+				t.Errorf("アクセスログにリクエストIDが含まれていない:\n%s", buf.String())
+			}
+		})
+	}
+}
+
+func TestLoggingMiddleware_GeneratesUniqueRequestIDs(t *testing.T) {
+	log.SetOutput(&bytes.Buffer{})
+	defer log.SetOutput(os.Stderr)
+
+	s := &HTTPServer{config: &config.Config{Log: config.LogConfig{Level: "info"}}}
+	handler := s.loggingMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	seen := make(map[string]bool)
+	for i := 0; i < 10; i++ {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+		id := rec.Header().Get(utils.RequestIDHeader)
+		if seen[id] {
+			t.Fatalf("リクエストIDが重複している: %s", id)
+		}
+		seen[id] = true
+	}
+}
+
+func TestRecoveryMiddleware_LogsRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	s := &HTTPServer{config: &config.Config{Log: config.LogConfig{Level: "info"}}}
+	handler := s.loggingMiddleware(s.recoveryMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	})))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/users/me", nil)
+	req.Header.Set(utils.RequestIDHeader, "trace-xyz")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("ステータス = %d, want %d", rec.Code, http.StatusInternalServerError)
+	}
+	if !strings.Contains(buf.String(), "[request_id=trace-xyz] パニックが発生しました") {
+		t.Errorf("パニックログにリクエストIDが含まれていない:\n%s", buf.String())
+	}
+}
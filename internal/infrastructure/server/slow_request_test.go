@@ -0,0 +1,104 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/config"
+)
+
+func TestLogSlowRequest(t *testing.T) {
+	threshold := 500 * time.Millisecond
+
+	tests := []struct {
+		name      string
+		duration  time.Duration
+		threshold time.Duration
+		wantLog   bool
+	}{
+		{name: "閾値未満は出力しない", duration: 499 * time.Millisecond, threshold: threshold, wantLog: false},
+		{name: "閾値ちょうどは出力しない", duration: threshold, threshold: threshold, wantLog: false},
+		{name: "閾値を超えたら出力する", duration: 501 * time.Millisecond, threshold: threshold, wantLog: true},
+		{name: "閾値が0なら無効", duration: time.Hour, threshold: 0, wantLog: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			log.SetOutput(&buf)
+			defer log.SetOutput(os.Stderr)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/users/search?q=alice&token=secret123", nil)
+			logSlowRequest(context.Background(), req, NewLogSanitizer(nil), http.StatusOK, tt.duration, tt.threshold)
+
+			output := buf.String()
+			if got := strings.Contains(output, slowRequestLogPrefix); got != tt.wantLog {
+				t.Fatalf("スローログの出力 = %v, want %v:\n%s", got, tt.wantLog, output)
+			}
+			if !tt.wantLog {
+				return
+			}
+
+			// 経路・パラメータ・所要時間を記録し、機密値はマスクする
+			for _, want := range []string{"GET", "/api/v1/users/search", "q=alice", "duration=501ms", "threshold=500ms"} {
+				if !strings.Contains(output, want) {
+					t.Errorf("スローログに %q が含まれていない:\n%s", want, output)
+				}
+			}
+			if strings.Contains(output, "secret123") {
+				t.Errorf("スローログに機密値が含まれている:\n%s", output)
+			}
+		})
+	}
+}
+
+func TestLoggingMiddleware_SlowRequest(t *testing.T) {
+	tests := []struct {
+		name      string
+		threshold time.Duration
+		wantSlow  bool
+	}{
+		{name: "閾値を超えたリクエストは通常ログとは別にスローログを出力する", threshold: time.Nanosecond, wantSlow: true},
+		{name: "閾値内のリクエストは通常ログのみ", threshold: time.Hour, wantSlow: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			log.SetOutput(&buf)
+			defer log.SetOutput(os.Stderr)
+
+			s := &HTTPServer{config: &config.Config{Log: config.LogConfig{Level: "info", SlowRequestThreshold: tt.threshold}}}
+			handler := s.loggingMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				time.Sleep(time.Millisecond)
+				w.WriteHeader(http.StatusOK)
+			}))
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/users/me", nil))
+
+			lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+			slowLines := 0
+			for _, line := range lines {
+				if strings.Contains(line, slowRequestLogPrefix) {
+					slowLines++
+				}
+			}
+			wantSlowLines := 0
+			if tt.wantSlow {
+				wantSlowLines = 1
+			}
+			if slowLines != wantSlowLines {
+				t.Errorf("スローログの行数 = %d, want %d:\n%s", slowLines, wantSlowLines, buf.String())
+			}
+			if len(lines)-slowLines != 1 {
+				t.Errorf("通常のアクセスログの行数 = %d, want 1:\n%s", len(lines)-slowLines, buf.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package translation
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+)
+
+var (
+	// ErrUnsupportedLanguage は翻訳先の言語に対応していないことを表す
+	ErrUnsupportedLanguage = errors.New("unsupported target language")
+	// ErrNoTranslation は翻訳できる語句が含まれていないことを表す
+	ErrNoTranslation = errors.New("no translation available")
+)
+
+// DefaultDictionary は辞書ベースの翻訳で使う初期の辞書（翻訳先の言語コードごとの語句の対応）
+var DefaultDictionary = map[string]map[string]string{
+	"ja": {
+		"good morning":    "おはよう",
+		"wake up":         "起きて",
+		"have a nice day": "良い一日を",
+		"thank you":       "ありがとう",
+	},
+	"en": {
+		"おはようございます": "Good morning",
+		"おはよう":      "Good morning",
+		"起きて":       "Wake up",
+		"良い一日を":     "Have a nice day",
+		"ありがとう":     "Thank you",
+	},
+}
+
+// DictionaryTranslator は語句の辞書で置き換えて翻訳する実装
+// 外部の翻訳サービスが用意されるまでの開発用に使用する
+type DictionaryTranslator struct {
+	phrases map[string][]phrase // 翻訳先の言語コードごとの語句（長いものから順に置き換える）
+}
+
+// phrase は辞書の1語句
+type phrase struct {
+	source string
+	target string
+}
+
+// NewDictionaryTranslator は新しいDictionaryTranslatorを作成する
+// dictionaryがnilの場合はDefaultDictionaryを使用する
+func NewDictionaryTranslator(dictionary map[string]map[string]string) *DictionaryTranslator {
+	if dictionary == nil {
+		dictionary = DefaultDictionary
+	}
+
+	phrases := make(map[string][]phrase, len(dictionary))
+	for lang, entries := range dictionary {
+		list := make([]phrase, 0, len(entries))
+		for source, target := range entries {
+			list = append(list, phrase{source: strings.ToLower(source), target: target})
+		}
+		// 短い語句が長い語句の一部を先に置き換えないよう、長い順に並べる
+		sort.Slice(list, func(i, j int) bool {
+			if len(list[i].source) != len(list[j].source) {
+				return len(list[i].source) > len(list[j].source)
+			}
+			return list[i].source < list[j].source
+		})
+		phrases[strings.ToLower(lang)] = list
+	}
+	return &DictionaryTranslator{phrases: phrases}
+}
+
+// Translate は辞書にある語句を翻訳先の言語に置き換える（大文字小文字は区別しない）
+// 辞書にある語句が1つも含まれない場合は ErrNoTranslation を返す
+func (t *DictionaryTranslator) Translate(ctx context.Context, text, targetLang string) (string, error) {
+	_ = ctx // 将来的な外部サービス実装のために保持
+	list, ok := t.phrases[strings.ToLower(targetLang)]
+	if !ok {
+		return "", ErrUnsupportedLanguage
+	}
+
+	var b strings.Builder
+	translated := false
+	for i := 0; i < len(text); {
+		matched := false
+		for _, p := range list {
+			end := i + len(p.source)
+			if end <= len(text) && strings.EqualFold(text[i:end], p.source) {
+				b.WriteString(p.target)
+				i = end
+				matched, translated = true, true
+				break
+			}
+		}
+		if !matched {
+			// 辞書にない文字はそのまま残す（マルチバイト文字を分割しないよう1文字ずつ進める）
+			_, size := utf8.DecodeRuneInString(text[i:])
+			b.WriteString(text[i : i+size])
+			i += size
+		}
+	}
+
+	if !translated {
+		return "", ErrNoTranslation
+	}
+	return b.String(), nil
+}
+
+// DefaultCacheSize は翻訳結果のキャッシュで保持するデフォルトの件数
+const DefaultCacheSize = 1000
+
+// CachingTranslator は他のTranslatorの翻訳結果をメモリ上にキャッシュする実装
+// 同じメッセージを同じ言語に翻訳する場合は外部サービスを呼び出さない
+// 翻訳に失敗した結果はキャッシュしない
+type CachingTranslator struct {
+	next    service.Translator
+	maxSize int
+
+	mu      sync.Mutex
+	entries map[cacheKey]string
+	order   []cacheKey // 追加順（上限を超えた場合は古いものから削除する）
+}
+
+// cacheKey はキャッシュのキー
+type cacheKey struct {
+	text       string
+	targetLang string
+}
+
+// NewCachingTranslator は新しいCachingTranslatorを作成する
+// maxSizeが0以下の場合はDefaultCacheSizeを使用する
+func NewCachingTranslator(next service.Translator, maxSize int) *CachingTranslator {
+	if maxSize <= 0 {
+		maxSize = DefaultCacheSize
+	}
+	return &CachingTranslator{
+		next:    next,
+		maxSize: maxSize,
+		entries: make(map[cacheKey]string),
+	}
+}
+
+// Translate はキャッシュにある翻訳結果を返し、ない場合は翻訳してキャッシュする
+func (t *CachingTranslator) Translate(ctx context.Context, text, targetLang string) (string, error) {
+	key := cacheKey{text: text, targetLang: strings.ToLower(targetLang)}
+
+	t.mu.Lock()
+	if translated, ok := t.entries[key]; ok {
+		t.mu.Unlock()
+		return translated, nil
+	}
+	t.mu.Unlock()
+
+	// 翻訳中はロックを保持しない（同じ語句を同時に翻訳することは許容する）
+	translated, err := t.next.Translate(ctx, text, targetLang)
+	if err != nil {
+		return "", err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.entries[key]; !ok {
+		t.entries[key] = translated
+		t.order = append(t.order, key)
+		for len(t.order) > t.maxSize {
+			delete(t.entries, t.order[0])
+			t.order = t.order[1:]
+		}
+	}
+	return translated, nil
+}
+
+// Len はキャッシュしている翻訳結果の件数を返す
+func (t *CachingTranslator) Len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.entries)
+}
+
+// インターフェースの実装を保証
+var (
+	_ service.Translator = (*DictionaryTranslator)(nil)
+	_ service.Translator = (*CachingTranslator)(nil)
+)
@@ -0,0 +1,131 @@
+package translation
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestDictionaryTranslator_Translate(t *testing.T) {
+	translator := NewDictionaryTranslator(nil)
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		text       string
+		targetLang string
+		want       string
+		wantErr    error
+	}{
+		{name: "英語から日本語", text: "Good morning! Wake up", targetLang: "ja", want: "おはよう! 起きて"},
+		{name: "大文字小文字を区別しない", text: "GOOD MORNING", targetLang: "JA", want: "おはよう"},
+		{name: "長い語句を優先する", text: "おはようございます、起きて", targetLang: "en", want: "Good morning、Wake up"},
+		{name: "辞書にない語句はそのまま残す", text: "もう7時だよ、起きて", targetLang: "en", want: "もう7時だよ、Wake up"},
+		{name: "翻訳できる語句がない", text: "see you", targetLang: "ja", wantErr: ErrNoTranslation},
+		{name: "対応していない言語", text: "Good morning", targetLang: "fr", wantErr: ErrUnsupportedLanguage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := translator.Translate(ctx, tt.text, tt.targetLang)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Translate() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Translate() unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Translate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// countingTranslator は呼び出し回数を数えるテスト用のTranslator
+type countingTranslator struct {
+	calls int
+	err   error
+}
+
+func (c *countingTranslator) Translate(_ context.Context, text, targetLang string) (string, error) {
+	c.calls++
+	if c.err != nil {
+		return "", c.err
+	}
+	return targetLang + ":" + text, nil
+}
+
+func TestCachingTranslator_Translate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("同じメッセージと言語は再翻訳しない", func(t *testing.T) {
+		next := &countingTranslator{}
+		translator := NewCachingTranslator(next, 10)
+
+		for i := 0; i < 3; i++ {
+			got, err := translator.Translate(ctx, "hello", "ja")
+			if err != nil || got != "ja:hello" {
+				t.Fatalf("Translate() = %q, %v", got, err)
+			}
+		}
+		if _, err := translator.Translate(ctx, "hello", "JA"); err != nil {
+			t.Fatalf("Translate() unexpected error: %v", err)
+		}
+		if next.calls != 1 {
+			t.Errorf("calls = %d, want 1", next.calls)
+		}
+
+		// 言語が異なれば別に翻訳する
+		if got, _ := translator.Translate(ctx, "hello", "en"); got != "en:hello" {
+			t.Errorf("Translate() = %q, want en:hello", got)
+		}
+		if next.calls != 2 {
+			t.Errorf("calls = %d, want 2", next.calls)
+		}
+	})
+
+	t.Run("翻訳に失敗した結果はキャッシュしない", func(t *testing.T) {
+		next := &countingTranslator{err: errors.New("unavailable")}
+		translator := NewCachingTranslator(next, 10)
+
+		for i := 0; i < 2; i++ {
+			if _, err := translator.Translate(ctx, "hello", "ja"); err == nil {
+				t.Fatal("Translate() expected error")
+			}
+		}
+		if next.calls != 2 || translator.Len() != 0 {
+			t.Errorf("calls = %d, cached = %d, want 2 calls and no cache", next.calls, translator.Len())
+		}
+	})
+
+	t.Run("上限を超えると古いものから削除する", func(t *testing.T) {
+		next := &countingTranslator{}
+		translator := NewCachingTranslator(next, 2)
+
+		for _, text := range []string{"a", "b", "c"} {
+			if _, err := translator.Translate(ctx, text, "ja"); err != nil {
+				t.Fatalf("Translate() unexpected error: %v", err)
+			}
+		}
+		if translator.Len() != 2 {
+			t.Errorf("Len() = %d, want 2", translator.Len())
+		}
+
+		// "a"は削除されているため再翻訳し、"c"はキャッシュから返す
+		translator.Translate(ctx, "c", "ja")
+		translator.Translate(ctx, "a", "ja")
+		if next.calls != 4 {
+			t.Errorf("calls = %d, want 4", next.calls)
+		}
+	})
+
+	t.Run("上限未指定はデフォルト値", func(t *testing.T) {
+		translator := NewCachingTranslator(&countingTranslator{}, 0)
+		if translator.maxSize != DefaultCacheSize {
+			t.Errorf("maxSize = %d, want %d", translator.maxSize, DefaultCacheSize)
+		}
+	})
+}
@@ -0,0 +1,245 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+const (
+	// DefaultAnomalyWindow は集計対象とするデフォルトの直近期間
+	DefaultAnomalyWindow = 1 * time.Hour
+	// DefaultFriendRequestThreshold は期間内の友達リクエスト送信数のデフォルト閾値
+	DefaultFriendRequestThreshold = 20
+	// DefaultMorningCallThreshold は期間内のモーニングコール作成数のデフォルト閾値
+	DefaultMorningCallThreshold = 30
+
+	// anomalyBatchSize はリポジトリから一度に取得する件数
+	anomalyBatchSize = 100
+)
+
+// AnomalyReason は異常と判定された理由
+type AnomalyReason string
+
+const (
+	// AnomalyReasonFriendRequests は短時間に大量の友達リクエストを送信している
+	AnomalyReasonFriendRequests AnomalyReason = "friend_requests"
+	// AnomalyReasonMorningCalls は短時間に大量のモーニングコールを作成している
+	AnomalyReasonMorningCalls AnomalyReason = "morning_calls"
+)
+
+// AnomalyDetectionConfig は異常検知の設定
+type AnomalyDetectionConfig struct {
+	Window                 time.Duration // 現在時刻からこの期間内の操作を集計する
+	FriendRequestThreshold int           // 期間内の友達リクエスト送信数がこの値以上で異常とみなす
+	MorningCallThreshold   int           // 期間内のモーニングコール作成数がこの値以上で異常とみなす
+	ExcludedUserIDs        []string      // 検知対象から除外するユーザーID（誤検知の既知アカウントなど）
+}
+
+// DetectAnomalousUsersUseCase は短時間に大量の操作を行っているユーザーを検出するユースケース
+type DetectAnomalousUsersUseCase struct {
+	userRepo         repository.UserRepository
+	relationshipRepo repository.RelationshipRepository
+	morningCallRepo  repository.MorningCallRepository
+	config           AnomalyDetectionConfig
+	excluded         map[string]struct{}
+	now              func() time.Time
+}
+
+// NewDetectAnomalousUsersUseCase は新しい異常ユーザー検出ユースケースを作成する
+// 設定値が未指定（ゼロ値）の項目にはデフォルト値を使用する
+func NewDetectAnomalousUsersUseCase(
+	userRepo repository.UserRepository,
+	relationshipRepo repository.RelationshipRepository,
+	morningCallRepo repository.MorningCallRepository,
+	config AnomalyDetectionConfig,
+) *DetectAnomalousUsersUseCase {
+	if config.Window <= 0 {
+		config.Window = DefaultAnomalyWindow
+	}
+	if config.FriendRequestThreshold <= 0 {
+		config.FriendRequestThreshold = DefaultFriendRequestThreshold
+	}
+	if config.MorningCallThreshold <= 0 {
+		config.MorningCallThreshold = DefaultMorningCallThreshold
+	}
+
+	excluded := make(map[string]struct{}, len(config.ExcludedUserIDs))
+	for _, id := range config.ExcludedUserIDs {
+		excluded[id] = struct{}{}
+	}
+
+	return &DetectAnomalousUsersUseCase{
+		userRepo:         userRepo,
+		relationshipRepo: relationshipRepo,
+		morningCallRepo:  morningCallRepo,
+		config:           config,
+		excluded:         excluded,
+		now:              time.Now,
+	}
+}
+
+// DetectAnomalousUsersInput は異常ユーザー検出の入力データ
+type DetectAnomalousUsersInput struct {
+	RequesterID string // 必須：リクエストした管理者のID
+}
+
+// AnomalousUser は異常と判定されたユーザーの情報
+type AnomalousUser struct {
+	User               *entity.User
+	FriendRequestCount int             // 期間内に送信した友達リクエスト数
+	MorningCallCount   int             // 期間内に作成したモーニングコール数
+	Score              float64         // 疑わしさスコア（各指標の閾値に対する比率の合計）
+	Reasons            []AnomalyReason // 閾値を超えた指標
+}
+
+// DetectAnomalousUsersOutput は異常ユーザー検出の出力データ
+type DetectAnomalousUsersOutput struct {
+	Users       []AnomalousUser // スコアの高い順
+	WindowStart time.Time       // 集計期間の開始
+	WindowEnd   time.Time       // 集計期間の終了
+}
+
+// Execute は集計期間内の操作数が閾値を超えたユーザーをスコアの高い順に返す
+func (uc *DetectAnomalousUsersUseCase) Execute(ctx context.Context, input DetectAnomalousUsersInput) (*DetectAnomalousUsersOutput, error) {
+	// 入力値の基本検証
+	if input.RequesterID == "" {
+		return nil, fmt.Errorf("リクエストユーザーIDは必須です")
+	}
+
+	// 管理者権限の確認
+	requester, err := uc.userRepo.FindByID(ctx, input.RequesterID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("ユーザーの確認中にエラーが発生しました: %w", err)
+	}
+	if !requester.IsAdmin {
+		return nil, fmt.Errorf("管理者のみが異常なユーザーを確認できます")
+	}
+
+	// 全ユーザーを取得
+	total, err := uc.userRepo.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ユーザー数の取得中にエラーが発生しました: %w", err)
+	}
+	users, err := uc.userRepo.FindAll(ctx, 0, total)
+	if err != nil {
+		return nil, fmt.Errorf("ユーザー一覧の取得中にエラーが発生しました: %w", err)
+	}
+
+	end := uc.now()
+	start := end.Add(-uc.config.Window)
+
+	anomalies := []AnomalousUser{}
+	for _, u := range users {
+		if _, ok := uc.excluded[u.ID]; ok {
+			continue
+		}
+
+		anomaly, err := uc.evaluate(ctx, u, start)
+		if err != nil {
+			return nil, err
+		}
+		if len(anomaly.Reasons) > 0 {
+			anomalies = append(anomalies, anomaly)
+		}
+	}
+
+	// スコアの高い順（同点の場合はIDで順序を保証）
+	sort.SliceStable(anomalies, func(i, j int) bool {
+		if anomalies[i].Score == anomalies[j].Score {
+			return anomalies[i].User.ID < anomalies[j].User.ID
+		}
+		return anomalies[i].Score > anomalies[j].Score
+	})
+
+	return &DetectAnomalousUsersOutput{
+		Users:       anomalies,
+		WindowStart: start,
+		WindowEnd:   end,
+	}, nil
+}
+
+// evaluate はユーザーの期間内の操作数を集計し、スコアと判定理由を設定する
+func (uc *DetectAnomalousUsersUseCase) evaluate(ctx context.Context, u *entity.User, since time.Time) (AnomalousUser, error) {
+	result := AnomalousUser{User: u, Reasons: []AnomalyReason{}}
+
+	friendRequests, err := uc.countRecentFriendRequests(ctx, u.ID, since)
+	if err != nil {
+		return result, err
+	}
+	morningCalls, err := uc.countRecentMorningCalls(ctx, u.ID, since)
+	if err != nil {
+		return result, err
+	}
+
+	result.FriendRequestCount = friendRequests
+	result.MorningCallCount = morningCalls
+	if friendRequests >= uc.config.FriendRequestThreshold {
+		result.Reasons = append(result.Reasons, AnomalyReasonFriendRequests)
+	}
+	if morningCalls >= uc.config.MorningCallThreshold {
+		result.Reasons = append(result.Reasons, AnomalyReasonMorningCalls)
+	}
+
+	score := float64(friendRequests)/float64(uc.config.FriendRequestThreshold) +
+		float64(morningCalls)/float64(uc.config.MorningCallThreshold)
+	result.Score = math.Round(score*100) / 100
+	return result, nil
+}
+
+// countRecentFriendRequests は指定時刻以降に送信した友達リクエスト数を返す
+func (uc *DetectAnomalousUsersUseCase) countRecentFriendRequests(ctx context.Context, userID string, since time.Time) (int, error) {
+	count := 0
+	for offset := 0; ; offset += anomalyBatchSize {
+		batch, err := uc.relationshipRepo.FindByRequesterID(ctx, userID, offset, anomalyBatchSize)
+		if err != nil {
+			return 0, fmt.Errorf("友達リクエストの取得中にエラーが発生しました: %w", err)
+		}
+		for _, rel := range batch {
+			if !rel.CreatedAt.Before(since) {
+				count++
+			}
+		}
+		if len(batch) < anomalyBatchSize {
+			return count, nil
+		}
+	}
+}
+
+// countRecentMorningCalls は指定時刻以降に作成したモーニングコール数を返す
+func (uc *DetectAnomalousUsersUseCase) countRecentMorningCalls(ctx context.Context, userID string, since time.Time) (int, error) {
+	// 作成したコールがないユーザーが大半のため、総数を先に確認して一覧の取得を省略する
+	total, err := uc.morningCallRepo.CountBySenderID(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("モーニングコール数の取得中にエラーが発生しました: %w", err)
+	}
+	if total == 0 {
+		return 0, nil
+	}
+
+	count := 0
+	for offset := 0; offset < total; offset += anomalyBatchSize {
+		batch, err := uc.morningCallRepo.FindReadOnlyBySenderID(ctx, userID, offset, anomalyBatchSize)
+		if err != nil {
+			return 0, fmt.Errorf("モーニングコールの取得中にエラーが発生しました: %w", err)
+		}
+		for _, mc := range batch {
+			if !mc.CreatedAt().Before(since) {
+				count++
+			}
+		}
+		if len(batch) < anomalyBatchSize {
+			break
+		}
+	}
+	return count, nil
+}
@@ -0,0 +1,222 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+// anomalyFixture は異常検知テスト用のリポジトリ一式
+type anomalyFixture struct {
+	userRepo         *memory.UserRepository
+	relationshipRepo *memory.RelationshipRepository
+	morningCallRepo  *memory.MorningCallRepository
+	now              time.Time
+}
+
+func newAnomalyFixture(t *testing.T) *anomalyFixture {
+	t.Helper()
+	f := &anomalyFixture{
+		userRepo:         memory.NewUserRepository(),
+		relationshipRepo: memory.NewRelationshipRepository(),
+		morningCallRepo:  memory.NewMorningCallRepository(),
+		now:              time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	users := []*entity.User{
+		{ID: "admin", Username: "admin", Email: "admin@example.com", PasswordHash: "hash", IsAdmin: true},
+		{ID: "member", Username: "member", Email: "member@example.com", PasswordHash: "hash"},
+	}
+	for i := 0; i < 10; i++ {
+		users = append(users, &entity.User{
+			ID:           fmt.Sprintf("target%d", i),
+			Username:     fmt.Sprintf("target%d", i),
+			Email:        fmt.Sprintf("target%d@example.com", i),
+			PasswordHash: "hash",
+		})
+	}
+	for _, u := range users {
+		if err := f.userRepo.Create(context.Background(), u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+	return f
+}
+
+// addFriendRequests はrequesterIDからcount件の友達リクエストを指定日時で作成する
+func (f *anomalyFixture) addFriendRequests(t *testing.T, requesterID string, count int, createdAt time.Time) {
+	t.Helper()
+	for i := 0; i < count; i++ {
+		rel := &entity.Relationship{
+			ID:          fmt.Sprintf("%s-rel-%d-%d", requesterID, createdAt.Unix(), i),
+			RequesterID: requesterID,
+			ReceiverID:  fmt.Sprintf("%s-peer-%d-%d", requesterID, createdAt.Unix(), i),
+			Status:      valueobject.RelationshipStatusPending,
+			CreatedAt:   createdAt,
+			UpdatedAt:   createdAt,
+		}
+		if err := f.relationshipRepo.Create(context.Background(), rel); err != nil {
+			t.Fatalf("failed to create relationship: %v", err)
+		}
+	}
+}
+
+// addMorningCalls はsenderIDからcount件のモーニングコールを指定日時で作成する
+func (f *anomalyFixture) addMorningCalls(t *testing.T, senderID string, count int, createdAt time.Time) {
+	t.Helper()
+	for i := 0; i < count; i++ {
+		mc := &entity.MorningCall{
+			ID:            fmt.Sprintf("%s-mc-%d-%d", senderID, createdAt.Unix(), i),
+			SenderID:      senderID,
+			ReceiverID:    "target0",
+			ScheduledTime: f.now.Add(time.Duration(i+1) * time.Hour),
+			Message:       "おはよう",
+			Status:        valueobject.MorningCallStatusScheduled,
+			CreatedAt:     createdAt,
+			UpdatedAt:     createdAt,
+		}
+		if err := f.morningCallRepo.Create(context.Background(), mc); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+}
+
+func (f *anomalyFixture) newUseCase(config AnomalyDetectionConfig) *DetectAnomalousUsersUseCase {
+	uc := NewDetectAnomalousUsersUseCase(f.userRepo, f.relationshipRepo, f.morningCallRepo, config)
+	uc.now = func() time.Time { return f.now }
+	return uc
+}
+
+func TestNewDetectAnomalousUsersUseCase_Defaults(t *testing.T) {
+	uc := NewDetectAnomalousUsersUseCase(nil, nil, nil, AnomalyDetectionConfig{})
+
+	if uc.config.Window != DefaultAnomalyWindow {
+		t.Errorf("Window = %v, want %v", uc.config.Window, DefaultAnomalyWindow)
+	}
+	if uc.config.FriendRequestThreshold != DefaultFriendRequestThreshold {
+		t.Errorf("FriendRequestThreshold = %d, want %d", uc.config.FriendRequestThreshold, DefaultFriendRequestThreshold)
+	}
+	if uc.config.MorningCallThreshold != DefaultMorningCallThreshold {
+		t.Errorf("MorningCallThreshold = %d, want %d", uc.config.MorningCallThreshold, DefaultMorningCallThreshold)
+	}
+}
+
+func TestDetectAnomalousUsersUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	config := AnomalyDetectionConfig{
+		Window:                 time.Hour,
+		FriendRequestThreshold: 5,
+		MorningCallThreshold:   4,
+	}
+
+	t.Run("閾値を超えたユーザーをスコア順に検出する", func(t *testing.T) {
+		f := newAnomalyFixture(t)
+		// target1: 友達リクエスト10件（比率2.0）+ コール2件（比率0.5）
+		f.addFriendRequests(t, "target1", 10, f.now.Add(-10*time.Minute))
+		f.addMorningCalls(t, "target1", 2, f.now.Add(-10*time.Minute))
+		// target2: コール4件（比率1.0）
+		f.addMorningCalls(t, "target2", 4, f.now.Add(-30*time.Minute))
+		// target3: 閾値未満
+		f.addFriendRequests(t, "target3", 4, f.now.Add(-5*time.Minute))
+		// target4: 件数は多いが集計期間外
+		f.addFriendRequests(t, "target4", 10, f.now.Add(-2*time.Hour))
+
+		output, err := f.newUseCase(config).Execute(ctx, DetectAnomalousUsersInput{RequesterID: "admin"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(output.Users) != 2 {
+			t.Fatalf("検出数 = %d, want 2", len(output.Users))
+		}
+
+		first := output.Users[0]
+		if first.User.ID != "target1" {
+			t.Errorf("1件目 = %s, want target1", first.User.ID)
+		}
+		if first.FriendRequestCount != 10 || first.MorningCallCount != 2 {
+			t.Errorf("target1 counts = (%d, %d), want (10, 2)", first.FriendRequestCount, first.MorningCallCount)
+		}
+		if first.Score != 2.5 {
+			t.Errorf("target1 score = %v, want 2.5", first.Score)
+		}
+		if len(first.Reasons) != 1 || first.Reasons[0] != AnomalyReasonFriendRequests {
+			t.Errorf("target1 reasons = %v, want [friend_requests]", first.Reasons)
+		}
+
+		second := output.Users[1]
+		if second.User.ID != "target2" {
+			t.Errorf("2件目 = %s, want target2", second.User.ID)
+		}
+		if second.Score != 1.0 {
+			t.Errorf("target2 score = %v, want 1", second.Score)
+		}
+		if len(second.Reasons) != 1 || second.Reasons[0] != AnomalyReasonMorningCalls {
+			t.Errorf("target2 reasons = %v, want [morning_calls]", second.Reasons)
+		}
+
+		if !output.WindowEnd.Equal(f.now) || !output.WindowStart.Equal(f.now.Add(-time.Hour)) {
+			t.Errorf("window = %v - %v", output.WindowStart, output.WindowEnd)
+		}
+	})
+
+	t.Run("除外リストのユーザーは検出しない", func(t *testing.T) {
+		f := newAnomalyFixture(t)
+		f.addFriendRequests(t, "target1", 10, f.now.Add(-10*time.Minute))
+		f.addFriendRequests(t, "target2", 10, f.now.Add(-10*time.Minute))
+
+		excludedConfig := config
+		excludedConfig.ExcludedUserIDs = []string{"target1"}
+
+		output, err := f.newUseCase(excludedConfig).Execute(ctx, DetectAnomalousUsersInput{RequesterID: "admin"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(output.Users) != 1 || output.Users[0].User.ID != "target2" {
+			t.Errorf("検出結果が不正: %+v", output.Users)
+		}
+	})
+
+	t.Run("該当者がいない場合は空のスライスを返す", func(t *testing.T) {
+		f := newAnomalyFixture(t)
+
+		output, err := f.newUseCase(config).Execute(ctx, DetectAnomalousUsersInput{RequesterID: "admin"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.Users == nil || len(output.Users) != 0 {
+			t.Errorf("Users = %v, want empty slice", output.Users)
+		}
+	})
+
+	t.Run("権限と入力の検証", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			requesterID string
+			errMsg      string
+		}{
+			{name: "リクエストユーザーIDが空", requesterID: "", errMsg: "リクエストユーザーIDは必須です"},
+			{name: "存在しないユーザー", requesterID: "unknown", errMsg: "ユーザーが見つかりません"},
+			{name: "管理者以外", requesterID: "member", errMsg: "管理者のみが異常なユーザーを確認できます"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				f := newAnomalyFixture(t)
+				_, err := f.newUseCase(config).Execute(ctx, DetectAnomalousUsersInput{RequesterID: tt.requesterID})
+				if err == nil {
+					t.Fatal("expected error but got nil")
+				}
+				if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("error = %v, want containing %v", err, tt.errMsg)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,128 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// SystemStatsUseCase は管理ダッシュボード向けにシステム全体の統計を取得するユースケース
+type SystemStatsUseCase struct {
+	userRepo         repository.UserRepository
+	relationshipRepo repository.RelationshipRepository
+	morningCallRepo  repository.MorningCallRepository
+	metrics          repository.OperationMetricsSource
+	sessions         service.SessionStatsSource
+	now              func() time.Time
+}
+
+// NewSystemStatsUseCase は新しいシステム統計取得ユースケースを作成する
+// metricsがnilの場合はリポジトリ操作の計測値を、sessionsがnilの場合はセッションの統計を返さない
+func NewSystemStatsUseCase(
+	userRepo repository.UserRepository,
+	relationshipRepo repository.RelationshipRepository,
+	morningCallRepo repository.MorningCallRepository,
+	metrics repository.OperationMetricsSource,
+	sessions service.SessionStatsSource,
+) *SystemStatsUseCase {
+	return &SystemStatsUseCase{
+		userRepo:         userRepo,
+		relationshipRepo: relationshipRepo,
+		morningCallRepo:  morningCallRepo,
+		metrics:          metrics,
+		sessions:         sessions,
+		now:              time.Now,
+	}
+}
+
+// SystemStatsInput はシステム統計取得の入力データ
+type SystemStatsInput struct {
+	RequesterID string // 必須：リクエストした管理者のID
+}
+
+// SystemStatsOutput はシステム統計取得の出力データ
+// 各リポジトリの統計はそれぞれ一貫したスナップショットだが、リポジトリ間で同時点とは限らない
+type SystemStatsOutput struct {
+	Users         repository.RepositoryStats    // プラン別の内訳
+	Relationships repository.RepositoryStats    // ステータス別の内訳
+	MorningCalls  repository.RepositoryStats    // ステータス別の内訳
+	Operations    []repository.OperationMetrics // リポジトリ操作ごとの計測値（計測が無効の場合はnil）
+	Sessions      *service.SessionStats         // セッション数とクリーンアップの統計（取得元がない場合はnil）
+	GeneratedAt   time.Time
+
+	// ConfirmationRate は起床確認の結果が確定したコールのうち確認されたものの割合（0〜1、確定したコールがない場合は0）
+	// 起床確認されないまま期限切れになったコールは未確認として数える
+	ConfirmationRate float64
+}
+
+// Execute はユーザー・友達関係・モーニングコールの統計をまとめて取得する
+func (uc *SystemStatsUseCase) Execute(ctx context.Context, input SystemStatsInput) (*SystemStatsOutput, error) {
+	// 入力値の基本検証
+	if input.RequesterID == "" {
+		return nil, fmt.Errorf("リクエストユーザーIDは必須です")
+	}
+
+	// 管理者権限の確認
+	requester, err := uc.userRepo.FindByID(ctx, input.RequesterID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("ユーザーの確認中にエラーが発生しました: %w", err)
+	}
+	if !requester.IsAdmin {
+		return nil, fmt.Errorf("管理者のみがシステム統計を確認できます")
+	}
+
+	// 個別のCountを呼ぶとリポジトリごとに何度もロックを取るため、集約メソッドで1回にまとめる
+	userStats, err := uc.userRepo.Stats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ユーザーの統計の取得中にエラーが発生しました: %w", err)
+	}
+	relationshipStats, err := uc.relationshipRepo.Stats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("友達関係の統計の取得中にエラーが発生しました: %w", err)
+	}
+	morningCallStats, err := uc.morningCallRepo.Stats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("モーニングコールの統計の取得中にエラーが発生しました: %w", err)
+	}
+
+	var operations []repository.OperationMetrics
+	if uc.metrics != nil {
+		operations = uc.metrics.OperationMetrics()
+	}
+
+	var sessions *service.SessionStats
+	if uc.sessions != nil {
+		stats := uc.sessions.Stats()
+		sessions = &stats
+	}
+
+	return &SystemStatsOutput{
+		Users:         userStats,
+		Relationships: relationshipStats,
+		MorningCalls:  morningCallStats,
+		Operations:    operations,
+		Sessions:      sessions,
+		GeneratedAt:   uc.now(),
+
+		ConfirmationRate: confirmationRate(morningCallStats),
+	}, nil
+}
+
+// confirmationRate はステータス別の内訳から起床確認率を求める
+// キャンセル・スキップなど起床確認の対象にならなかったコールは含めない
+func confirmationRate(stats repository.RepositoryStats) float64 {
+	confirmed := stats.Breakdown[valueobject.MorningCallStatusConfirmed.String()]
+	expired := stats.Breakdown[valueobject.MorningCallStatusExpired.String()]
+	if confirmed+expired == 0 {
+		return 0
+	}
+	return float64(confirmed) / float64(confirmed+expired)
+}
@@ -0,0 +1,210 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+func TestSystemStatsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("各リポジトリの件数と内訳を返す", func(t *testing.T) {
+		f := newAnomalyFixture(t)
+		f.addFriendRequests(t, "target0", 3, f.now)
+		f.addMorningCalls(t, "target1", 2, f.now)
+
+		uc := NewSystemStatsUseCase(f.userRepo, f.relationshipRepo, f.morningCallRepo, nil, nil)
+		uc.now = func() time.Time { return f.now }
+
+		output, err := uc.Execute(ctx, SystemStatsInput{RequesterID: "admin"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		userCount, _ := f.userRepo.Count(ctx)
+		if output.Users.Total != userCount {
+			t.Errorf("Users.Total = %d, want %d", output.Users.Total, userCount)
+		}
+		if output.Users.Breakdown[valueobject.PlanFree.String()] != userCount {
+			t.Errorf("Users.Breakdown[free] = %d, want %d", output.Users.Breakdown[valueobject.PlanFree.String()], userCount)
+		}
+		if output.Relationships.Total != 3 || output.Relationships.Breakdown[valueobject.RelationshipStatusPending.String()] != 3 {
+			t.Errorf("Relationships = %+v, want 3 pending", output.Relationships)
+		}
+		if output.MorningCalls.Total != 2 {
+			t.Errorf("MorningCalls.Total = %d, want 2", output.MorningCalls.Total)
+		}
+		if !output.GeneratedAt.Equal(f.now) {
+			t.Errorf("GeneratedAt = %v, want %v", output.GeneratedAt, f.now)
+		}
+		if output.Operations != nil {
+			t.Errorf("Operations = %+v, want nil when metrics are disabled", output.Operations)
+		}
+	})
+
+	t.Run("計測が有効な場合はリポジトリ操作の計測値を含める", func(t *testing.T) {
+		f := newAnomalyFixture(t)
+		metrics := stubMetricsSource{{Operation: "UserRepository.FindByID", Calls: 3}}
+
+		uc := NewSystemStatsUseCase(f.userRepo, f.relationshipRepo, f.morningCallRepo, metrics, nil)
+
+		output, err := uc.Execute(ctx, SystemStatsInput{RequesterID: "admin"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(output.Operations) != 1 || output.Operations[0].Calls != 3 {
+			t.Errorf("Operations = %+v, want stub metrics", output.Operations)
+		}
+	})
+
+	t.Run("セッションの統計を含める", func(t *testing.T) {
+		f := newAnomalyFixture(t)
+		sessions := stubSessionStatsSource{ActiveSessions: 2, TotalSessions: 5, CleanupRuns: 1, TotalCleanupRemoved: 3}
+
+		uc := NewSystemStatsUseCase(f.userRepo, f.relationshipRepo, f.morningCallRepo, nil, sessions)
+
+		output, err := uc.Execute(ctx, SystemStatsInput{RequesterID: "admin"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.Sessions == nil || *output.Sessions != service.SessionStats(sessions) {
+			t.Errorf("Sessions = %+v, want %+v", output.Sessions, sessions)
+		}
+	})
+
+	t.Run("セッションの統計の取得元がない場合は含めない", func(t *testing.T) {
+		f := newAnomalyFixture(t)
+		uc := NewSystemStatsUseCase(f.userRepo, f.relationshipRepo, f.morningCallRepo, nil, nil)
+
+		output, err := uc.Execute(ctx, SystemStatsInput{RequesterID: "admin"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.Sessions != nil {
+			t.Errorf("Sessions = %+v, want nil", output.Sessions)
+		}
+	})
+
+	t.Run("期限切れのコールを未確認として起床確認率を求める", func(t *testing.T) {
+		f := newAnomalyFixture(t)
+		statuses := []valueobject.MorningCallStatus{
+			valueobject.MorningCallStatusConfirmed,
+			valueobject.MorningCallStatusConfirmed,
+			valueobject.MorningCallStatusConfirmed,
+			valueobject.MorningCallStatusExpired,
+			valueobject.MorningCallStatusCancelled, // 起床確認の対象にならなかったコールは含めない
+			valueobject.MorningCallStatusScheduled,
+		}
+		for i, status := range statuses {
+			if err := f.morningCallRepo.Create(ctx, &entity.MorningCall{
+				ID:            fmt.Sprintf("mc%d", i),
+				SenderID:      "target1",
+				ReceiverID:    "target0",
+				ScheduledTime: f.now,
+				Status:        status,
+				CreatedAt:     f.now,
+				UpdatedAt:     f.now,
+			}); err != nil {
+				t.Fatalf("failed to create morning call: %v", err)
+			}
+		}
+
+		uc := NewSystemStatsUseCase(f.userRepo, f.relationshipRepo, f.morningCallRepo, nil, nil)
+		output, err := uc.Execute(ctx, SystemStatsInput{RequesterID: "admin"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.ConfirmationRate != 0.75 {
+			t.Errorf("ConfirmationRate = %v, want 0.75", output.ConfirmationRate)
+		}
+	})
+
+	t.Run("起床確認の確認方法別の内訳を返す", func(t *testing.T) {
+		f := newAnomalyFixture(t)
+		methods := []valueobject.ConfirmMethod{
+			valueobject.ConfirmMethodShake,
+			valueobject.ConfirmMethodShake,
+			valueobject.ConfirmMethodChallenge,
+		}
+		for i, method := range methods {
+			if err := f.morningCallRepo.Create(ctx, &entity.MorningCall{
+				ID:            fmt.Sprintf("mc%d", i),
+				SenderID:      "target1",
+				ReceiverID:    "target0",
+				ScheduledTime: f.now,
+				Status:        valueobject.MorningCallStatusConfirmed,
+				ConfirmMethod: method,
+				CreatedAt:     f.now,
+				UpdatedAt:     f.now,
+			}); err != nil {
+				t.Fatalf("failed to create morning call: %v", err)
+			}
+		}
+
+		uc := NewSystemStatsUseCase(f.userRepo, f.relationshipRepo, f.morningCallRepo, nil, nil)
+		output, err := uc.Execute(ctx, SystemStatsInput{RequesterID: "admin"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := output.MorningCalls.ConfirmMethods
+		if len(got) != 2 || got[valueobject.ConfirmMethodShake.String()] != 2 || got[valueobject.ConfirmMethodChallenge.String()] != 1 {
+			t.Errorf("MorningCalls.ConfirmMethods = %v, want shake=2 challenge=1", got)
+		}
+	})
+
+	t.Run("結果が確定したコールがない場合の起床確認率は0", func(t *testing.T) {
+		f := newAnomalyFixture(t)
+		f.addMorningCalls(t, "target1", 2, f.now)
+
+		uc := NewSystemStatsUseCase(f.userRepo, f.relationshipRepo, f.morningCallRepo, nil, nil)
+		output, err := uc.Execute(ctx, SystemStatsInput{RequesterID: "admin"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.ConfirmationRate != 0 {
+			t.Errorf("ConfirmationRate = %v, want 0", output.ConfirmationRate)
+		}
+	})
+
+	t.Run("管理者以外は確認できない", func(t *testing.T) {
+		f := newAnomalyFixture(t)
+		uc := NewSystemStatsUseCase(f.userRepo, f.relationshipRepo, f.morningCallRepo, nil, nil)
+
+		_, err := uc.Execute(ctx, SystemStatsInput{RequesterID: "member"})
+		if err == nil || !strings.Contains(err.Error(), "管理者のみが") {
+			t.Errorf("error = %v, want admin only error", err)
+		}
+	})
+
+	t.Run("存在しないユーザー", func(t *testing.T) {
+		f := newAnomalyFixture(t)
+		uc := NewSystemStatsUseCase(f.userRepo, f.relationshipRepo, f.morningCallRepo, nil, nil)
+
+		_, err := uc.Execute(ctx, SystemStatsInput{RequesterID: "unknown"})
+		if err == nil || !strings.Contains(err.Error(), "ユーザーが見つかりません") {
+			t.Errorf("error = %v, want not found error", err)
+		}
+	})
+}
+
+// stubMetricsSource は固定の計測値を返すOperationMetricsSource
+type stubMetricsSource []repository.OperationMetrics
+
+func (s stubMetricsSource) OperationMetrics() []repository.OperationMetrics {
+	return s
+}
+
+// stubSessionStatsSource は固定の統計を返すSessionStatsSource
+type stubSessionStatsSource service.SessionStats
+
+func (s stubSessionStatsSource) Stats() service.SessionStats {
+	return service.SessionStats(s)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/ochamu/morning-call-api/internal/domain/entity"
 	"github.com/ochamu/morning-call-api/internal/domain/repository"
 	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/pkg/utils"
 )
 
 // Session はユーザーセッション情報を表す
@@ -22,6 +23,9 @@ type Session struct {
 	ExpiresAt time.Time
 }
 
+// dummyPassword はダミーのパスワードハッシュを生成するための固定文字列
+const dummyPassword = "morning-call-dummy-password"
+
 // AuthUseCase は認証に関するユースケースを実装する
 type AuthUseCase struct {
 	userRepo        repository.UserRepository
@@ -29,28 +33,48 @@ type AuthUseCase struct {
 	sessions        map[string]*Session
 	sessionMutex    sync.RWMutex
 	sessionTimeout  time.Duration
+	// dummyPasswordHash は存在しないユーザーのログイン時に検証するハッシュ
+	// 実ユーザーと同じコストで検証させ、処理時間からユーザーの存在有無を推測されないようにする
+	dummyPasswordHash string
+
+	loginNotifier service.LoginNotifier // nilの場合は新しいログインを通知しない（ログには記録する）
 }
 
 // NewAuthUseCase は新しい認証ユースケースを作成する
 func NewAuthUseCase(userRepo repository.UserRepository, passwordService service.PasswordService) *AuthUseCase {
+	// 初回ログイン時に生成すると、その1回だけ処理時間が変わるため作成時に生成しておく
+	// 生成に失敗した場合は空のままとし、ログイン自体は継続できるようにする
+	dummyHash, _ := passwordService.HashPassword(dummyPassword)
+
 	return &AuthUseCase{
-		userRepo:        userRepo,
-		passwordService: passwordService,
-		sessions:        make(map[string]*Session),
-		sessionTimeout:  24 * time.Hour, // デフォルトで24時間のセッション有効期限
+		userRepo:          userRepo,
+		passwordService:   passwordService,
+		sessions:          make(map[string]*Session),
+		sessionTimeout:    24 * time.Hour, // デフォルトで24時間のセッション有効期限
+		dummyPasswordHash: dummyHash,
 	}
 }
 
+// SetLoginNotifier は初めてのデバイス・IPアドレスからのログインを知らせる通知先を設定する
+func (u *AuthUseCase) SetLoginNotifier(notifier service.LoginNotifier) {
+	u.loginNotifier = notifier
+}
+
 // LoginInput はログイン時の入力データ
 type LoginInput struct {
 	Username string
 	Password string
+
+	Device    string // ログインに使ったデバイス（User-Agent、任意）
+	IPAddress string // ログイン元のIPアドレス（任意）
 }
 
 // LoginOutput はログイン時の出力データ
 type LoginOutput struct {
-	SessionID string
-	User      *entity.User
+	SessionID        string
+	User             *entity.User
+	DeletionCanceled bool // 削除予定だったアカウントの削除をこのログインで取り消したか
+	NewLogin         bool // 初めてのデバイス・IPアドレスの組み合わせからのログインか
 }
 
 // Login はユーザー名とパスワードで認証を行う
@@ -67,6 +91,8 @@ func (u *AuthUseCase) Login(ctx context.Context, input LoginInput) (*LoginOutput
 	user, err := u.userRepo.FindByUsername(ctx, input.Username)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
+			// 存在するユーザーと処理時間を揃えるため、結果を使わずにダミーのハッシュを検証する
+			u.verifyDummyPassword(input.Password)
 			return nil, fmt.Errorf("ユーザー名またはパスワードが間違っています")
 		}
 		return nil, fmt.Errorf("ログイン処理中にエラーが発生しました: %w", err)
@@ -81,6 +107,28 @@ func (u *AuthUseCase) Login(ctx context.Context, input LoginInput) (*LoginOutput
 		return nil, fmt.Errorf("ユーザー名またはパスワードが間違っています")
 	}
 
+	// 猶予期間中のログインは削除の取り消しとして扱う
+	// 猶予期間を過ぎたアカウントは完全削除を待つだけのため、存在しないユーザーと同様にログインさせない
+	now := time.Now()
+	if user.IsDeletionDue(now) {
+		return nil, fmt.Errorf("ユーザー名またはパスワードが間違っています")
+	}
+	deletionCanceled := user.CancelDeletion(now)
+	newLogin := user.RecordLogin(input.Device, input.IPAddress, now)
+	if deletionCanceled {
+		if err := u.userRepo.Update(ctx, user); err != nil {
+			return nil, fmt.Errorf("アカウント削除の取り消しに失敗しました: %w", err)
+		}
+	} else if input.Device != "" || input.IPAddress != "" {
+		// ログイン元の記録に失敗してもログインは継続する（次回のログインで改めて記録する）
+		if err := u.userRepo.Update(ctx, user); err != nil {
+			utils.Logf(ctx, "ログイン元の記録に失敗しました: user=%s: %v", user.ID, err)
+		}
+	}
+	if newLogin {
+		u.notifyNewLogin(ctx, user, now)
+	}
+
 	// セッションを作成
 	sessionID, err := u.createSession(user.ID)
 	if err != nil {
@@ -88,11 +136,36 @@ func (u *AuthUseCase) Login(ctx context.Context, input LoginInput) (*LoginOutput
 	}
 
 	return &LoginOutput{
-		SessionID: sessionID,
-		User:      user,
+		SessionID:        sessionID,
+		User:             user,
+		DeletionCanceled: deletionCanceled,
+		NewLogin:         newLogin,
 	}, nil
 }
 
+// notifyNewLogin は初めてのデバイス・IPアドレスからのログインをログに記録し、ユーザーが希望する場合は通知する
+// 通知に失敗してもログインは継続する
+func (u *AuthUseCase) notifyNewLogin(ctx context.Context, user *entity.User, now time.Time) {
+	login := user.KnownLogins[len(user.KnownLogins)-1]
+	notify := user.NotifyOnNewLogin && u.loginNotifier != nil
+	utils.Logf(ctx, "新しいデバイス・IPアドレスからのログインを検出しました: user=%s ip=%s device=%q notify=%v",
+		user.ID, login.IPAddress, login.Device, notify)
+	if !notify {
+		return
+	}
+
+	if err := u.loginNotifier.NotifyNewLogin(ctx, service.LoginNotification{
+		UserID:     user.ID,
+		Username:   user.Username,
+		Email:      user.Email,
+		Device:     login.Device,
+		IPAddress:  login.IPAddress,
+		LoggedInAt: now,
+	}); err != nil {
+		utils.Logf(ctx, "新しいログインの通知に失敗しました: user=%s: %v", user.ID, err)
+	}
+}
+
 // Logout はセッションを削除してログアウトする
 func (u *AuthUseCase) Logout(_ context.Context, sessionID string) error {
 	if sessionID == "" {
@@ -170,6 +243,14 @@ func (u *AuthUseCase) ValidateSession(_ context.Context, sessionID string) (bool
 	return true, nil
 }
 
+// verifyDummyPassword はダミーのハッシュに対してパスワード検証を行い、結果を破棄する
+func (u *AuthUseCase) verifyDummyPassword(password string) {
+	if u.dummyPasswordHash == "" {
+		return
+	}
+	_, _ = u.passwordService.VerifyPassword(password, u.dummyPasswordHash)
+}
+
 // createSession は新しいセッションを作成する
 func (u *AuthUseCase) createSession(userID string) (string, error) {
 	// セッションIDを生成
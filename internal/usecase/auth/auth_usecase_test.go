@@ -2,11 +2,13 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/service"
 	"github.com/ochamu/morning-call-api/internal/infrastructure/auth"
 	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
 )
@@ -508,3 +510,253 @@ func TestAuthUseCase_GetCurrentUser_DeletedUser(t *testing.T) {
 		t.Error("expected session to be deleted but it still exists")
 	}
 }
+
+// countingPasswordService はVerifyPasswordの呼び出し回数を記録するPasswordService
+type countingPasswordService struct {
+	*auth.PasswordService
+	verifyCalls int
+}
+
+func (s *countingPasswordService) VerifyPassword(password, passwordHash string) (bool, error) {
+	s.verifyCalls++
+	return s.PasswordService.VerifyPassword(password, passwordHash)
+}
+
+func TestAuthUseCase_Login_UnknownUserVerifiesDummyHash(t *testing.T) {
+	ctx := context.Background()
+	userRepo := memory.NewUserRepository()
+	passwordService := &countingPasswordService{PasswordService: auth.NewPasswordService()}
+	uc := NewAuthUseCase(userRepo, passwordService)
+
+	_, err := uc.Login(ctx, LoginInput{Username: "nonexistent", Password: "password123"})
+	if err == nil {
+		t.Fatal("expected error but got nil")
+	}
+	if err.Error() != "ユーザー名またはパスワードが間違っています" {
+		t.Errorf("error message = %v, want same message as wrong password", err.Error())
+	}
+	if passwordService.verifyCalls != 1 {
+		t.Errorf("VerifyPassword calls = %d, want 1", passwordService.verifyCalls)
+	}
+}
+
+func TestAuthUseCase_Login_ResponseTimeDoesNotRevealUser(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping timing test in short mode")
+	}
+
+	ctx := context.Background()
+	userRepo := memory.NewUserRepository()
+	passwordService := auth.NewPasswordService()
+	uc := NewAuthUseCase(userRepo, passwordService)
+
+	hashedPassword, err := passwordService.HashPassword("password123")
+	if err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+	if err := userRepo.Create(ctx, &entity.User{
+		ID:           "user1",
+		Username:     "testuser",
+		Email:        "test@example.com",
+		PasswordHash: hashedPassword,
+	}); err != nil {
+		t.Fatalf("failed to create test user: %v", err)
+	}
+
+	measure := func(username string) time.Duration {
+		const attempts = 5
+		var total time.Duration
+		for i := 0; i < attempts; i++ {
+			start := time.Now()
+			if _, err := uc.Login(ctx, LoginInput{Username: username, Password: "wrongpassword"}); err == nil {
+				t.Fatal("expected error but got nil")
+			}
+			total += time.Since(start)
+		}
+		return total / attempts
+	}
+
+	existing := measure("testuser")
+	unknown := measure("nonexistent")
+
+	// ダミー検証がない場合は桁違いの差になるため、2倍以内であれば有意差なしとみなす
+	if unknown*2 < existing || existing*2 < unknown {
+		t.Errorf("login time differs: existing=%v, unknown=%v", existing, unknown)
+	}
+}
+
+func TestAuthUseCase_Login_PendingDeletion(t *testing.T) {
+	ctx := context.Background()
+	passwordService := auth.NewPasswordService()
+	hashedPassword, err := passwordService.HashPassword("password123")
+	if err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+
+	newUseCase := func(t *testing.T, scheduledAt time.Time) (*AuthUseCase, *memory.UserRepository) {
+		t.Helper()
+		userRepo := memory.NewUserRepository()
+		if err := userRepo.Create(ctx, &entity.User{
+			ID:                  "user1",
+			Username:            "testuser",
+			Email:               "test@example.com",
+			PasswordHash:        hashedPassword,
+			DeletionScheduledAt: &scheduledAt,
+		}); err != nil {
+			t.Fatalf("failed to create test user: %v", err)
+		}
+		return NewAuthUseCase(userRepo, passwordService), userRepo
+	}
+
+	t.Run("猶予期間中のログインで削除予定を取り消す", func(t *testing.T) {
+		uc, userRepo := newUseCase(t, time.Now().Add(24*time.Hour))
+
+		output, err := uc.Login(ctx, LoginInput{Username: "testuser", Password: "password123"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !output.DeletionCanceled {
+			t.Error("DeletionCanceled = false, want true")
+		}
+		persisted, _ := userRepo.FindByID(ctx, "user1")
+		if persisted.IsPendingDeletion() {
+			t.Error("deletion should be canceled")
+		}
+	})
+
+	t.Run("パスワードを間違えた場合は取り消さない", func(t *testing.T) {
+		uc, userRepo := newUseCase(t, time.Now().Add(24*time.Hour))
+
+		if _, err := uc.Login(ctx, LoginInput{Username: "testuser", Password: "wrong"}); err == nil {
+			t.Fatal("expected error but got nil")
+		}
+		persisted, _ := userRepo.FindByID(ctx, "user1")
+		if !persisted.IsPendingDeletion() {
+			t.Error("deletion should remain scheduled")
+		}
+	})
+
+	t.Run("猶予期間を過ぎたアカウントにはログインできない", func(t *testing.T) {
+		uc, userRepo := newUseCase(t, time.Now().Add(-time.Minute))
+
+		_, err := uc.Login(ctx, LoginInput{Username: "testuser", Password: "password123"})
+		if err == nil || err.Error() != "ユーザー名またはパスワードが間違っています" {
+			t.Errorf("error = %v, want invalid credentials", err)
+		}
+		persisted, _ := userRepo.FindByID(ctx, "user1")
+		if !persisted.IsPendingDeletion() {
+			t.Error("deletion should remain scheduled")
+		}
+	})
+}
+
+// recordingLoginNotifier は届いたログイン通知を記録するテスト用のLoginNotifier
+type recordingLoginNotifier struct {
+	notifications []service.LoginNotification
+	err           error
+}
+
+func (n *recordingLoginNotifier) NotifyNewLogin(_ context.Context, notification service.LoginNotification) error {
+	n.notifications = append(n.notifications, notification)
+	return n.err
+}
+
+func TestAuthUseCase_Login_NewLoginNotification(t *testing.T) {
+	ctx := context.Background()
+	passwordService := auth.NewPasswordService()
+	hashedPassword, err := passwordService.HashPassword("password123")
+	if err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+
+	const iphone = "Mozilla/5.0 (iPhone)"
+	setup := func(t *testing.T, notifyOnNewLogin bool) (*AuthUseCase, *recordingLoginNotifier, *memory.UserRepository) {
+		t.Helper()
+		userRepo := memory.NewUserRepository()
+		if err := userRepo.Create(ctx, &entity.User{
+			ID:               "user1",
+			Username:         "testuser",
+			Email:            "test@example.com",
+			PasswordHash:     hashedPassword,
+			NotifyOnNewLogin: notifyOnNewLogin,
+			CreatedAt:        time.Now(),
+			UpdatedAt:        time.Now(),
+		}); err != nil {
+			t.Fatalf("failed to create test user: %v", err)
+		}
+		notifier := &recordingLoginNotifier{}
+		uc := NewAuthUseCase(userRepo, passwordService)
+		uc.SetLoginNotifier(notifier)
+		return uc, notifier, userRepo
+	}
+	login := func(t *testing.T, uc *AuthUseCase, device, ipAddress string) *LoginOutput {
+		t.Helper()
+		output, err := uc.Login(ctx, LoginInput{Username: "testuser", Password: "password123", Device: device, IPAddress: ipAddress})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return output
+	}
+
+	t.Run("初めての組み合わせのみ通知する", func(t *testing.T) {
+		uc, notifier, userRepo := setup(t, true)
+
+		// 初回のログインは記録のみ
+		if output := login(t, uc, iphone, "192.0.2.1"); output.NewLogin {
+			t.Error("first login should not be reported as new")
+		}
+		// 既知のログイン元
+		if output := login(t, uc, iphone, "192.0.2.1"); output.NewLogin {
+			t.Error("known login should not be reported as new")
+		}
+		if len(notifier.notifications) != 0 {
+			t.Fatalf("notifications = %+v, want none", notifier.notifications)
+		}
+
+		// 未知のIPアドレス
+		if output := login(t, uc, iphone, "198.51.100.7"); !output.NewLogin {
+			t.Error("login from unknown IP address should be reported as new")
+		}
+		// 同じ組み合わせからの2回目は通知しない
+		login(t, uc, iphone, "198.51.100.7")
+
+		if len(notifier.notifications) != 1 {
+			t.Fatalf("len(notifications) = %d, want 1", len(notifier.notifications))
+		}
+		got := notifier.notifications[0]
+		if got.UserID != "user1" || got.Email != "test@example.com" || got.Device != iphone || got.IPAddress != "198.51.100.7" {
+			t.Errorf("notification = %+v", got)
+		}
+
+		// ログイン元はリポジトリに保存される
+		persisted, _ := userRepo.FindByID(ctx, "user1")
+		if len(persisted.KnownLogins) != 2 {
+			t.Errorf("len(KnownLogins) = %d, want 2", len(persisted.KnownLogins))
+		}
+	})
+
+	t.Run("通知を無効にしている場合は検出のみ行う", func(t *testing.T) {
+		uc, notifier, _ := setup(t, false)
+
+		login(t, uc, iphone, "192.0.2.1")
+		if output := login(t, uc, "Mozilla/5.0 (Windows NT 10.0)", "192.0.2.1"); !output.NewLogin {
+			t.Error("login from unknown device should be reported as new")
+		}
+		if len(notifier.notifications) != 0 {
+			t.Errorf("notifications = %+v, want none", notifier.notifications)
+		}
+	})
+
+	t.Run("通知に失敗してもログインできる", func(t *testing.T) {
+		uc, notifier, _ := setup(t, true)
+		notifier.err = errors.New("mail server unavailable")
+
+		login(t, uc, iphone, "192.0.2.1")
+		if output := login(t, uc, iphone, "2001:db8::1"); output.SessionID == "" || !output.NewLogin {
+			t.Errorf("output = %+v, want a session for the new login", output)
+		}
+		if len(notifier.notifications) != 1 {
+			t.Errorf("len(notifications) = %d, want 1", len(notifier.notifications))
+		}
+	})
+}
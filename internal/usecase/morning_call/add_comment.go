@@ -0,0 +1,91 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/pkg/utils"
+)
+
+// AddCommentUseCase はモーニングコールにコメントを追加するユースケース
+// 起床確認の前後を問わず、コールの送信者と受信者がやり取りに使う
+type AddCommentUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	commentRepo     repository.CommentRepository
+	now             func() time.Time
+}
+
+// NewAddCommentUseCase は新しいコメント追加ユースケースを作成する
+func NewAddCommentUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	commentRepo repository.CommentRepository,
+) *AddCommentUseCase {
+	return &AddCommentUseCase{
+		morningCallRepo: morningCallRepo,
+		commentRepo:     commentRepo,
+		now:             time.Now,
+	}
+}
+
+// AddCommentInput はコメント追加の入力データ
+type AddCommentInput struct {
+	MorningCallID string // 必須：コメントするモーニングコールのID
+	AuthorID      string // 必須：コメントするユーザーのID
+	Body          string // 必須：本文（500文字以内）
+}
+
+// AddCommentOutput はコメント追加の出力データ
+type AddCommentOutput struct {
+	Comment *entity.CallComment
+}
+
+// Execute はモーニングコールにコメントを追加する
+// コメントできるのはコールの送信者と受信者のみ
+func (uc *AddCommentUseCase) Execute(ctx context.Context, input AddCommentInput) (*AddCommentOutput, error) {
+	// 入力値の基本検証
+	if input.MorningCallID == "" {
+		return nil, fmt.Errorf("モーニングコールIDは必須です")
+	}
+	if input.AuthorID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+
+	morningCall, err := findCommentTarget(ctx, uc.morningCallRepo, input.MorningCallID, input.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := utils.GenerateUUID()
+	if err != nil {
+		return nil, fmt.Errorf("ID生成に失敗しました: %w", err)
+	}
+	comment, reason := entity.NewCallComment(id, morningCall.ID, input.AuthorID, input.Body, uc.now())
+	if reason.IsNG() {
+		return nil, fmt.Errorf("%w", reason)
+	}
+
+	if err := uc.commentRepo.Create(ctx, comment); err != nil {
+		return nil, fmt.Errorf("failed to create comment: %w", err)
+	}
+
+	return &AddCommentOutput{Comment: comment}, nil
+}
+
+// findCommentTarget はコメントの対象のコールを取得し、ユーザーが送信者または受信者であることを確認する
+func findCommentTarget(ctx context.Context, morningCallRepo repository.MorningCallRepository, morningCallID, userID string) (*entity.MorningCall, error) {
+	morningCall, err := morningCallRepo.FindByID(ctx, morningCallID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("モーニングコールが見つかりません")
+		}
+		return nil, fmt.Errorf("モーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+	if morningCall.SenderID != userID && morningCall.ReceiverID != userID {
+		return nil, fmt.Errorf("送信者または受信者のみがコメントを利用できます")
+	}
+	return morningCall, nil
+}
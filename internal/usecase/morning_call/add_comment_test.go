@@ -0,0 +1,90 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+// newCommentTestRepos は送信者senderから受信者receiverへのコールmc1を登録したリポジトリを作成する
+func newCommentTestRepos(t *testing.T) (*memory.MorningCallRepository, *memory.CommentRepository) {
+	t.Helper()
+	base := time.Date(2026, 3, 10, 6, 0, 0, 0, time.UTC)
+
+	morningCallRepo := memory.NewMorningCallRepository()
+	if err := morningCallRepo.Create(context.Background(), &entity.MorningCall{
+		ID:            "mc1",
+		SenderID:      "sender",
+		ReceiverID:    "receiver",
+		ScheduledTime: base,
+		Message:       "おはよう",
+		Status:        valueobject.MorningCallStatusConfirmed,
+		CreatedAt:     base.Add(-24 * time.Hour),
+		UpdatedAt:     base,
+	}); err != nil {
+		t.Fatalf("failed to create morning call: %v", err)
+	}
+	return morningCallRepo, memory.NewCommentRepository()
+}
+
+func TestAddCommentUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		morningCallID string
+		authorID      string
+		body          string
+		wantBody      string
+		wantErr       string
+		wantField     string // NGReasonとして返る場合のフィールド
+	}{
+		{name: "送信者がコメントする", morningCallID: "mc1", authorID: "sender", body: "起きた？", wantBody: "起きた？"},
+		{name: "受信者がコメントする", morningCallID: "mc1", authorID: "receiver", body: " 起きたよ\r\nありがとう ", wantBody: "起きたよ\nありがとう"},
+		{name: "第三者はコメントできない", morningCallID: "mc1", authorID: "stranger", body: "おはよう", wantErr: "送信者または受信者のみがコメントを利用できます"},
+		{name: "存在しないコール", morningCallID: "unknown", authorID: "sender", body: "おはよう", wantErr: "モーニングコールが見つかりません"},
+		{name: "空のコメント", morningCallID: "mc1", authorID: "sender", body: " \x00 ", wantField: "body"},
+		{name: "長すぎるコメント", morningCallID: "mc1", authorID: "sender", body: strings.Repeat("あ", entity.MaxCallCommentLength+1), wantField: "body"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			morningCallRepo, commentRepo := newCommentTestRepos(t)
+			uc := NewAddCommentUseCase(morningCallRepo, commentRepo)
+
+			output, err := uc.Execute(ctx, AddCommentInput{MorningCallID: tt.morningCallID, AuthorID: tt.authorID, Body: tt.body})
+			count, _ := commentRepo.CountByCallID(ctx, "mc1")
+
+			if tt.wantErr != "" || tt.wantField != "" {
+				var reason valueobject.NGReason
+				switch {
+				case err == nil:
+					t.Fatal("expected error but got nil")
+				case tt.wantErr != "" && err.Error() != tt.wantErr:
+					t.Errorf("error = %v, want %s", err, tt.wantErr)
+				case tt.wantField != "" && (!errors.As(err, &reason) || reason.Field() != tt.wantField):
+					t.Errorf("error = %v, want NGReason on %s", err, tt.wantField)
+				}
+				if count != 0 {
+					t.Errorf("CountByCallID() = %d, want 0", count)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output.Comment.Body != tt.wantBody || output.Comment.AuthorID != tt.authorID || output.Comment.CallID != "mc1" {
+				t.Errorf("Comment = %+v, want body %q", output.Comment, tt.wantBody)
+			}
+			if count != 1 {
+				t.Errorf("CountByCallID() = %d, want 1", count)
+			}
+		})
+	}
+}
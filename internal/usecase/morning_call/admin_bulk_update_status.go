@@ -0,0 +1,173 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/pkg/utils"
+)
+
+const (
+	// bulkUpdateBatchSize はリポジトリから一度に取得する件数
+	bulkUpdateBatchSize = 100
+)
+
+// bulkUpdateRangeMax は時刻範囲の終了が未指定の場合に用いる上限
+var bulkUpdateRangeMax = time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)
+
+// AdminBulkUpdateStatusUseCase は管理者によるモーニングコールの一括ステータス更新ユースケース
+type AdminBulkUpdateStatusUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	userRepo        repository.UserRepository
+	auditLogger     service.AuditLogger
+	now             func() time.Time
+}
+
+// NewAdminBulkUpdateStatusUseCase は新しい一括ステータス更新ユースケースを作成する
+// auditLoggerがnilの場合は監査ログを記録しない
+func NewAdminBulkUpdateStatusUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	userRepo repository.UserRepository,
+	auditLogger service.AuditLogger,
+) *AdminBulkUpdateStatusUseCase {
+	return &AdminBulkUpdateStatusUseCase{
+		morningCallRepo: morningCallRepo,
+		userRepo:        userRepo,
+		auditLogger:     auditLogger,
+		now:             time.Now,
+	}
+}
+
+// AdminBulkUpdateStatusInput は一括ステータス更新の入力データ
+type AdminBulkUpdateStatusInput struct {
+	RequesterID   string                        // 必須：リクエストした管理者のID
+	FromStatus    valueobject.MorningCallStatus // 必須：対象とするコールの現在のステータス
+	ToStatus      valueobject.MorningCallStatus // 必須：遷移先のステータス
+	ScheduledFrom *time.Time                    // オプション：対象とする予定時刻の開始（この時刻を含む）
+	ScheduledTo   *time.Time                    // オプション：対象とする予定時刻の終了（この時刻を含む）
+}
+
+// BulkUpdateFailure は一括更新で更新できなかったコールの情報
+type BulkUpdateFailure struct {
+	MorningCallID string
+	Reason        string
+}
+
+// AdminBulkUpdateStatusOutput は一括ステータス更新の出力データ
+type AdminBulkUpdateStatusOutput struct {
+	Matched  int                 // フィルタ条件に一致した件数
+	Updated  int                 // ステータスを更新した件数
+	Failures []BulkUpdateFailure // 遷移できない・保存に失敗したコール
+}
+
+// Execute はフィルタ条件に一致するモーニングコールを指定ステータスへ一括で遷移させる
+// 遷移できないコールはスキップし、失敗として返す
+func (uc *AdminBulkUpdateStatusUseCase) Execute(ctx context.Context, input AdminBulkUpdateStatusInput) (*AdminBulkUpdateStatusOutput, error) {
+	// 入力値の基本検証
+	if input.RequesterID == "" {
+		return nil, fmt.Errorf("リクエストユーザーIDは必須です")
+	}
+	if !input.FromStatus.IsValid() {
+		return nil, fmt.Errorf("対象のステータスが不正です")
+	}
+	if !input.ToStatus.IsValid() {
+		return nil, fmt.Errorf("遷移先のステータスが不正です")
+	}
+	if input.ScheduledFrom != nil && input.ScheduledTo != nil && input.ScheduledFrom.After(*input.ScheduledTo) {
+		return nil, fmt.Errorf("開始時刻は終了時刻より前である必要があります")
+	}
+
+	// 管理者権限の確認
+	requester, err := uc.userRepo.FindByID(ctx, input.RequesterID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("ユーザーの確認中にエラーが発生しました: %w", err)
+	}
+	if !requester.IsAdmin {
+		return nil, fmt.Errorf("管理者のみがモーニングコールを一括更新できます")
+	}
+
+	// 処理中にステータスが変わるとページ位置がずれるため、先に対象を全件収集する
+	targets, err := uc.collectTargets(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &AdminBulkUpdateStatusOutput{
+		Matched:  len(targets),
+		Failures: []BulkUpdateFailure{},
+	}
+	for _, mc := range targets {
+		if reason := mc.UpdateStatus(input.ToStatus); reason.IsNG() {
+			output.Failures = append(output.Failures, BulkUpdateFailure{MorningCallID: mc.ID, Reason: reason.Error()})
+			continue
+		}
+		if err := uc.morningCallRepo.Update(ctx, mc); err != nil {
+			reason := "保存に失敗しました"
+			if errors.Is(err, repository.ErrUpdateConflict) {
+				reason = "他の操作で更新されたためスキップしました"
+			}
+			output.Failures = append(output.Failures, BulkUpdateFailure{MorningCallID: mc.ID, Reason: reason})
+			continue
+		}
+		output.Updated++
+		uc.recordAudit(ctx, requester.ID, mc.ID, input)
+	}
+
+	return output, nil
+}
+
+// collectTargets はフィルタ条件に一致するモーニングコールを全件取得する
+func (uc *AdminBulkUpdateStatusUseCase) collectTargets(ctx context.Context, input AdminBulkUpdateStatusInput) ([]*entity.MorningCall, error) {
+	var start time.Time
+	if input.ScheduledFrom != nil {
+		start = *input.ScheduledFrom
+	}
+	end := bulkUpdateRangeMax
+	if input.ScheduledTo != nil {
+		end = *input.ScheduledTo
+	}
+
+	var result []*entity.MorningCall
+	for offset := 0; ; offset += bulkUpdateBatchSize {
+		batch, err := uc.morningCallRepo.FindByStatusInRange(ctx, input.FromStatus, start, end, offset, bulkUpdateBatchSize)
+		if err != nil {
+			return nil, fmt.Errorf("対象のモーニングコールの取得中にエラーが発生しました: %w", err)
+		}
+		result = append(result, batch...)
+		if len(batch) < bulkUpdateBatchSize {
+			return result, nil
+		}
+	}
+}
+
+// recordAudit は一括更新したコール1件分の監査ログを記録する
+func (uc *AdminBulkUpdateStatusUseCase) recordAudit(ctx context.Context, actorID, morningCallID string, input AdminBulkUpdateStatusInput) {
+	if uc.auditLogger == nil {
+		return
+	}
+
+	entry := service.AuditEntry{
+		Action:     "morning_call.bulk_status_updated",
+		ActorID:    actorID,
+		TargetType: "morning_call",
+		TargetID:   morningCallID,
+		Details: map[string]string{
+			"from_status": input.FromStatus.String(),
+			"to_status":   input.ToStatus.String(),
+		},
+		OccurredAt: uc.now(),
+	}
+	if err := uc.auditLogger.Record(ctx, entry); err != nil {
+		// 監査ログの失敗で更新自体は巻き戻さない
+		utils.Logf(ctx, "監査ログの記録に失敗しました: %v", err)
+	}
+}
@@ -0,0 +1,184 @@
+package morning_call
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/audit"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestAdminBulkUpdateStatusUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	base := time.Date(2025, 1, 10, 7, 0, 0, 0, time.UTC)
+
+	setup := func(t *testing.T) (*memory.MorningCallRepository, *memory.UserRepository) {
+		t.Helper()
+		morningCallRepo := memory.NewMorningCallRepository()
+		userRepo := memory.NewUserRepository()
+
+		for _, u := range []*entity.User{
+			{ID: "admin", Username: "admin", Email: "admin@example.com", PasswordHash: "hashed_password", IsAdmin: true},
+			{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+		} {
+			if err := userRepo.Create(ctx, u); err != nil {
+				t.Fatalf("failed to create user: %v", err)
+			}
+		}
+
+		calls := []struct {
+			id     string
+			offset time.Duration
+			status valueobject.MorningCallStatus
+		}{
+			{"old1", -72 * time.Hour, valueobject.MorningCallStatusScheduled},
+			{"old2", -48 * time.Hour, valueobject.MorningCallStatusScheduled},
+			{"recent", -1 * time.Hour, valueobject.MorningCallStatusScheduled},
+			{"delivered", -48 * time.Hour, valueobject.MorningCallStatusDelivered},
+		}
+		for _, c := range calls {
+			mc := &entity.MorningCall{
+				ID:            c.id,
+				SenderID:      "user1",
+				ReceiverID:    "user2",
+				ScheduledTime: base.Add(c.offset),
+				Status:        c.status,
+				CreatedAt:     base.Add(c.offset - time.Hour),
+				UpdatedAt:     base.Add(c.offset - time.Hour),
+			}
+			if err := morningCallRepo.Create(ctx, mc); err != nil {
+				t.Fatalf("failed to create morning call: %v", err)
+			}
+		}
+		return morningCallRepo, userRepo
+	}
+
+	t.Run("条件に一致するコールを一括で期限切れにする", func(t *testing.T) {
+		morningCallRepo, userRepo := setup(t)
+		auditLogger := audit.NewMemoryAuditLogger()
+		uc := NewAdminBulkUpdateStatusUseCase(morningCallRepo, userRepo, auditLogger)
+
+		scheduledTo := base.Add(-24 * time.Hour)
+		output, err := uc.Execute(ctx, AdminBulkUpdateStatusInput{
+			RequesterID: "admin",
+			FromStatus:  valueobject.MorningCallStatusScheduled,
+			ToStatus:    valueobject.MorningCallStatusExpired,
+			ScheduledTo: &scheduledTo,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.Matched != 2 || output.Updated != 2 || len(output.Failures) != 0 {
+			t.Errorf("output = %+v, want Matched=2 Updated=2 Failures=0", output)
+		}
+
+		want := map[string]valueobject.MorningCallStatus{
+			"old1":      valueobject.MorningCallStatusExpired,
+			"old2":      valueobject.MorningCallStatusExpired,
+			"recent":    valueobject.MorningCallStatusScheduled,
+			"delivered": valueobject.MorningCallStatusDelivered,
+		}
+		for id, status := range want {
+			mc, err := morningCallRepo.FindByID(ctx, id)
+			if err != nil {
+				t.Fatalf("failed to find morning call %s: %v", id, err)
+			}
+			if mc.Status != status {
+				t.Errorf("%s Status = %v, want %v", id, mc.Status, status)
+			}
+		}
+
+		entries := auditLogger.Entries()
+		if len(entries) != 2 {
+			t.Fatalf("audit entries = %d, want 2", len(entries))
+		}
+		for _, e := range entries {
+			if e.Action != "morning_call.bulk_status_updated" || e.ActorID != "admin" || e.TargetType != "morning_call" {
+				t.Errorf("unexpected audit entry: %+v", e)
+			}
+			if e.Details["from_status"] != "scheduled" || e.Details["to_status"] != "expired" {
+				t.Errorf("unexpected audit details: %v", e.Details)
+			}
+		}
+	})
+
+	t.Run("無効な遷移はスキップして失敗として返す", func(t *testing.T) {
+		morningCallRepo, userRepo := setup(t)
+		uc := NewAdminBulkUpdateStatusUseCase(morningCallRepo, userRepo, nil)
+
+		output, err := uc.Execute(ctx, AdminBulkUpdateStatusInput{
+			RequesterID: "admin",
+			FromStatus:  valueobject.MorningCallStatusDelivered,
+			ToStatus:    valueobject.MorningCallStatusCancelled,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.Matched != 1 || output.Updated != 0 || len(output.Failures) != 1 {
+			t.Fatalf("output = %+v, want Matched=1 Updated=0 Failures=1", output)
+		}
+		if output.Failures[0].MorningCallID != "delivered" || output.Failures[0].Reason != "このステータスへの遷移はできません" {
+			t.Errorf("failure = %+v", output.Failures[0])
+		}
+
+		mc, err := morningCallRepo.FindByID(ctx, "delivered")
+		if err != nil {
+			t.Fatalf("failed to find morning call: %v", err)
+		}
+		if mc.Status != valueobject.MorningCallStatusDelivered {
+			t.Errorf("Status = %v, want %v", mc.Status, valueobject.MorningCallStatusDelivered)
+		}
+	})
+
+	t.Run("入力エラー", func(t *testing.T) {
+		morningCallRepo, userRepo := setup(t)
+		uc := NewAdminBulkUpdateStatusUseCase(morningCallRepo, userRepo, nil)
+		from := base
+		to := base.Add(-time.Hour)
+
+		tests := []struct {
+			name    string
+			input   AdminBulkUpdateStatusInput
+			wantErr string
+		}{
+			{
+				name:    "管理者以外は実行できない",
+				input:   AdminBulkUpdateStatusInput{RequesterID: "user1", FromStatus: valueobject.MorningCallStatusScheduled, ToStatus: valueobject.MorningCallStatusExpired},
+				wantErr: "管理者のみがモーニングコールを一括更新できます",
+			},
+			{
+				name:    "存在しないユーザー",
+				input:   AdminBulkUpdateStatusInput{RequesterID: "unknown", FromStatus: valueobject.MorningCallStatusScheduled, ToStatus: valueobject.MorningCallStatusExpired},
+				wantErr: "ユーザーが見つかりません",
+			},
+			{
+				name:    "対象ステータスが不正",
+				input:   AdminBulkUpdateStatusInput{RequesterID: "admin", FromStatus: "unknown", ToStatus: valueobject.MorningCallStatusExpired},
+				wantErr: "対象のステータスが不正です",
+			},
+			{
+				name:    "遷移先ステータスが不正",
+				input:   AdminBulkUpdateStatusInput{RequesterID: "admin", FromStatus: valueobject.MorningCallStatusScheduled},
+				wantErr: "遷移先のステータスが不正です",
+			},
+			{
+				name:    "開始時刻が終了時刻より後",
+				input:   AdminBulkUpdateStatusInput{RequesterID: "admin", FromStatus: valueobject.MorningCallStatusScheduled, ToStatus: valueobject.MorningCallStatusExpired, ScheduledFrom: &from, ScheduledTo: &to},
+				wantErr: "開始時刻は終了時刻より前である必要があります",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := uc.Execute(ctx, tt.input)
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+			})
+		}
+	})
+}
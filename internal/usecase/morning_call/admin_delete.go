@@ -0,0 +1,131 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/pkg/utils"
+)
+
+// AdminDeleteUseCase は管理者によるモーニングコールの強制削除ユースケース
+type AdminDeleteUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	commentRepo     repository.CommentRepository
+	userRepo        repository.UserRepository
+	auditLogger     service.AuditLogger
+	now             func() time.Time
+}
+
+// NewAdminDeleteUseCase は新しい管理者削除ユースケースを作成する
+// auditLoggerがnilの場合は監査ログを記録しない
+func NewAdminDeleteUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	commentRepo repository.CommentRepository,
+	userRepo repository.UserRepository,
+	auditLogger service.AuditLogger,
+) *AdminDeleteUseCase {
+	return &AdminDeleteUseCase{
+		morningCallRepo: morningCallRepo,
+		commentRepo:     commentRepo,
+		userRepo:        userRepo,
+		auditLogger:     auditLogger,
+		now:             time.Now,
+	}
+}
+
+// AdminDeleteInput は管理者削除の入力データ
+type AdminDeleteInput struct {
+	RequesterID   string // 必須：リクエストした管理者のID
+	MorningCallID string // 必須：削除するモーニングコールのID
+	Reason        string // 必須：削除理由（規約違反の内容など）
+}
+
+// AdminDeleteOutput は管理者削除の出力データ
+type AdminDeleteOutput struct {
+	DeletedMorningCall *entity.MorningCall // 削除済みにしたモーニングコール
+}
+
+// Execute は管理者の権限でモーニングコールを削除済みにする
+// 送信者による削除と異なりステータスを問わず削除でき、送信者・受信者が削除されたことを確認できるよう履歴として残す
+// コールに付いたコメントは削除する
+func (uc *AdminDeleteUseCase) Execute(ctx context.Context, input AdminDeleteInput) (*AdminDeleteOutput, error) {
+	// 入力値の基本検証
+	if input.RequesterID == "" {
+		return nil, fmt.Errorf("リクエストユーザーIDは必須です")
+	}
+	if input.MorningCallID == "" {
+		return nil, fmt.Errorf("モーニングコールIDは必須です")
+	}
+
+	// 管理者権限の確認
+	requester, err := uc.userRepo.FindByID(ctx, input.RequesterID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("ユーザーの確認中にエラーが発生しました: %w", err)
+	}
+	if !requester.IsAdmin {
+		return nil, fmt.Errorf("管理者のみがモーニングコールを強制削除できます")
+	}
+
+	// モーニングコールの存在確認
+	morningCall, err := uc.morningCallRepo.FindByID(ctx, input.MorningCallID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("モーニングコールが見つかりません")
+		}
+		return nil, fmt.Errorf("モーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+
+	previousStatus := morningCall.Status
+	if reason := morningCall.DeleteByAdmin(requester.ID, input.Reason, uc.now()); reason.IsNG() {
+		return nil, fmt.Errorf("モーニングコールを削除できませんでした: %w", reason)
+	}
+
+	// 物理削除せず削除済みとして更新する
+	if err := uc.morningCallRepo.Update(ctx, morningCall); err != nil {
+		return nil, fmt.Errorf("モーニングコールの削除に失敗しました: %w", err)
+	}
+
+	// コールは履歴として残すが、規約違反の内容を含みうるコメントは削除する
+	if _, err := uc.commentRepo.DeleteByCallID(ctx, morningCall.ID); err != nil {
+		utils.Logf(ctx, "モーニングコールのコメントの削除に失敗しました: %v", err)
+	}
+
+	uc.recordAudit(ctx, morningCall, previousStatus.String())
+
+	return &AdminDeleteOutput{
+		DeletedMorningCall: morningCall,
+	}, nil
+}
+
+// recordAudit は強制削除の監査ログを記録する
+func (uc *AdminDeleteUseCase) recordAudit(ctx context.Context, mc *entity.MorningCall, status string) {
+	if uc.auditLogger == nil {
+		return
+	}
+
+	entry := service.AuditEntry{
+		Action:     "morning_call.admin_deleted",
+		ActorID:    mc.DeletedBy,
+		TargetType: "morning_call",
+		TargetID:   mc.ID,
+		Details: map[string]string{
+			"sender_id":   mc.SenderID,
+			"receiver_id": mc.ReceiverID,
+			"status":      status,
+			"reason":      mc.DeletionReason,
+		},
+		OccurredAt: uc.now(),
+	}
+	if err := uc.auditLogger.Record(ctx, entry); err != nil {
+		// 監査ログの失敗で削除自体は巻き戻さない
+		utils.Logf(ctx, "監査ログの記録に失敗しました: %v", err)
+	}
+}
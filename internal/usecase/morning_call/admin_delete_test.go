@@ -0,0 +1,158 @@
+package morning_call
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/audit"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestAdminDeleteUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	base := time.Date(2025, 1, 10, 7, 0, 0, 0, time.UTC)
+
+	setup := func(t *testing.T, status valueobject.MorningCallStatus) (*memory.MorningCallRepository, *memory.CommentRepository, *audit.MemoryAuditLogger, *AdminDeleteUseCase) {
+		t.Helper()
+		morningCallRepo := memory.NewMorningCallRepository()
+		commentRepo := memory.NewCommentRepository()
+		userRepo := memory.NewUserRepository()
+		auditLogger := audit.NewMemoryAuditLogger()
+
+		for _, u := range []*entity.User{
+			{ID: "admin", Username: "admin", Email: "admin@example.com", PasswordHash: "hashed_password", IsAdmin: true},
+			{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+		} {
+			if err := userRepo.Create(ctx, u); err != nil {
+				t.Fatalf("failed to create user: %v", err)
+			}
+		}
+		if err := morningCallRepo.Create(ctx, &entity.MorningCall{
+			ID:            "mc1",
+			SenderID:      "user1",
+			ReceiverID:    "user2",
+			ScheduledTime: base.Add(-time.Hour),
+			Message:       "不適切なメッセージ",
+			Status:        status,
+			CreatedAt:     base.Add(-2 * time.Hour),
+			UpdatedAt:     base.Add(-2 * time.Hour),
+		}); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+		if err := commentRepo.Create(ctx, &entity.CallComment{ID: "c1", CallID: "mc1", AuthorID: "user1", Body: "不適切なコメント", CreatedAt: base.Add(-time.Hour)}); err != nil {
+			t.Fatalf("failed to create comment: %v", err)
+		}
+
+		uc := NewAdminDeleteUseCase(morningCallRepo, commentRepo, userRepo, auditLogger)
+		uc.now = func() time.Time { return base }
+		return morningCallRepo, commentRepo, auditLogger, uc
+	}
+
+	t.Run("送信者による削除ができないステータスでも削除済みにする", func(t *testing.T) {
+		morningCallRepo, commentRepo, auditLogger, uc := setup(t, valueobject.MorningCallStatusConfirmed)
+
+		output, err := uc.Execute(ctx, AdminDeleteInput{RequesterID: "admin", MorningCallID: "mc1", Reason: "規約違反"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.DeletedMorningCall.DeletedBy != "admin" {
+			t.Errorf("DeletedBy = %s, want admin", output.DeletedMorningCall.DeletedBy)
+		}
+
+		// ソフトデリートのためステータスを保ったまま残る
+		saved, err := morningCallRepo.FindByID(ctx, "mc1")
+		if err != nil {
+			t.Fatalf("failed to find morning call: %v", err)
+		}
+		if !saved.IsDeleted() || !saved.DeletedAt.Equal(base) || saved.DeletionReason != "規約違反" {
+			t.Errorf("saved = %+v, want soft-deleted with reason", saved)
+		}
+		if saved.Status != valueobject.MorningCallStatusConfirmed {
+			t.Errorf("Status = %s, want confirmed", saved.Status)
+		}
+
+		// コールに付いたコメントは削除される
+		if n, _ := commentRepo.CountByCallID(ctx, "mc1"); n != 0 {
+			t.Errorf("comments = %d, want 0", n)
+		}
+
+		entries := auditLogger.Entries()
+		if len(entries) != 1 {
+			t.Fatalf("監査ログ件数 = %d, want 1", len(entries))
+		}
+		e := entries[0]
+		if e.Action != "morning_call.admin_deleted" || e.ActorID != "admin" || e.TargetID != "mc1" {
+			t.Errorf("予期しない監査ログ: %+v", e)
+		}
+		if e.Details["reason"] != "規約違反" || e.Details["status"] != "confirmed" {
+			t.Errorf("監査ログの詳細 = %v", e.Details)
+		}
+	})
+
+	t.Run("削除済みのコールは配信されず変更もできない", func(t *testing.T) {
+		morningCallRepo, _, _, uc := setup(t, valueobject.MorningCallStatusScheduled)
+
+		if _, err := uc.Execute(ctx, AdminDeleteInput{RequesterID: "admin", MorningCallID: "mc1", Reason: "規約違反"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		saved, _ := morningCallRepo.FindByID(ctx, "mc1")
+		if saved.IsActive() || saved.ShouldDeliver() {
+			t.Error("deleted call should not be active or delivered")
+		}
+		if reason := saved.Cancel(); reason.IsOK() {
+			t.Error("Cancel() on a deleted call should fail")
+		}
+
+		// 送信者による削除で履歴が消えない
+		_, err := NewDeleteUseCase(morningCallRepo, memory.NewCommentRepository()).Execute(ctx, DeleteInput{ID: "mc1", SenderID: "user1"})
+		if err == nil || !strings.Contains(err.Error(), "削除済み") {
+			t.Errorf("error = %v, want already deleted", err)
+		}
+	})
+
+	t.Run("管理者以外は削除できない", func(t *testing.T) {
+		_, _, auditLogger, uc := setup(t, valueobject.MorningCallStatusScheduled)
+
+		_, err := uc.Execute(ctx, AdminDeleteInput{RequesterID: "user1", MorningCallID: "mc1", Reason: "規約違反"})
+		if err == nil || !strings.Contains(err.Error(), "管理者のみ") {
+			t.Errorf("error = %v, want forbidden", err)
+		}
+		if len(auditLogger.Entries()) != 0 {
+			t.Error("監査ログが記録された")
+		}
+	})
+
+	t.Run("削除理由は必須", func(t *testing.T) {
+		_, _, _, uc := setup(t, valueobject.MorningCallStatusScheduled)
+
+		_, err := uc.Execute(ctx, AdminDeleteInput{RequesterID: "admin", MorningCallID: "mc1", Reason: "  "})
+		if err == nil || !strings.Contains(err.Error(), "削除理由は必須です") {
+			t.Errorf("error = %v, want reason required", err)
+		}
+	})
+
+	t.Run("削除済みのコールは再度削除できない", func(t *testing.T) {
+		_, _, _, uc := setup(t, valueobject.MorningCallStatusScheduled)
+
+		if _, err := uc.Execute(ctx, AdminDeleteInput{RequesterID: "admin", MorningCallID: "mc1", Reason: "規約違反"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		_, err := uc.Execute(ctx, AdminDeleteInput{RequesterID: "admin", MorningCallID: "mc1", Reason: "規約違反"})
+		if err == nil || !strings.Contains(err.Error(), "既に削除された") {
+			t.Errorf("error = %v, want already deleted", err)
+		}
+	})
+
+	t.Run("存在しないコール", func(t *testing.T) {
+		_, _, _, uc := setup(t, valueobject.MorningCallStatusScheduled)
+
+		_, err := uc.Execute(ctx, AdminDeleteInput{RequesterID: "admin", MorningCallID: "unknown", Reason: "規約違反"})
+		if err == nil || err.Error() != "モーニングコールが見つかりません" {
+			t.Errorf("error = %v, want not found", err)
+		}
+	})
+}
@@ -0,0 +1,117 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// ApproveCallUseCase は受信者が承認待ちのモーニングコールを承認するユースケース
+// 承認されたコールはスケジュール済みとなり、通常どおり配信される
+type ApproveCallUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	userRepo        repository.UserRepository
+	now             func() time.Time
+}
+
+// NewApproveCallUseCase は新しいモーニングコール承認ユースケースを作成する
+func NewApproveCallUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	userRepo repository.UserRepository,
+) *ApproveCallUseCase {
+	return &ApproveCallUseCase{
+		morningCallRepo: morningCallRepo,
+		userRepo:        userRepo,
+		now:             time.Now,
+	}
+}
+
+// ApproveCallInput はモーニングコール承認の入力データ
+type ApproveCallInput struct {
+	MorningCallID string
+	ReceiverID    string // 承認する受信者のID
+}
+
+// ApproveCallOutput はモーニングコール承認の出力データ
+type ApproveCallOutput struct {
+	MorningCall *entity.MorningCall
+}
+
+// Execute は受信者宛の承認待ちのモーニングコールを承認する
+func (uc *ApproveCallUseCase) Execute(ctx context.Context, input ApproveCallInput) (*ApproveCallOutput, error) {
+	morningCall, err := findPendingApprovalCall(ctx, uc.morningCallRepo, uc.userRepo, input.MorningCallID, input.ReceiverID, "承認")
+	if err != nil {
+		return nil, err
+	}
+
+	// アラーム時刻を過ぎてから承認しても配信できないため拒否する
+	if morningCall.IsApprovalDeadlinePassed(uc.now()) {
+		return nil, fmt.Errorf("アラーム時刻を過ぎたモーニングコールは承認できません")
+	}
+
+	if reason := morningCall.Approve(); reason.IsNG() {
+		return nil, fmt.Errorf("承認に失敗しました: %w", reason)
+	}
+
+	if err := uc.morningCallRepo.Update(ctx, morningCall); err != nil {
+		if errors.Is(err, repository.ErrUpdateConflict) {
+			return nil, fmt.Errorf("他の操作でモーニングコールが更新されました。再度お試しください")
+		}
+		return nil, fmt.Errorf("承認の保存に失敗しました: %w", err)
+	}
+
+	return &ApproveCallOutput{
+		MorningCall: morningCall,
+	}, nil
+}
+
+// findPendingApprovalCall は受信者本人宛の承認待ちのモーニングコールを取得する
+// actionは「承認」「拒否」などエラーメッセージに使う操作名
+func findPendingApprovalCall(
+	ctx context.Context,
+	morningCallRepo repository.MorningCallRepository,
+	userRepo repository.UserRepository,
+	morningCallID, receiverID, action string,
+) (*entity.MorningCall, error) {
+	// 入力値の基本検証
+	if morningCallID == "" {
+		return nil, fmt.Errorf("モーニングコールIDは必須です")
+	}
+	if receiverID == "" {
+		return nil, fmt.Errorf("受信者IDは必須です")
+	}
+
+	// 受信者の存在確認
+	receiver, err := userRepo.FindByID(ctx, receiverID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("受信者が見つかりません")
+		}
+		return nil, fmt.Errorf("受信者の確認中にエラーが発生しました: %w", err)
+	}
+
+	// モーニングコールの取得
+	morningCall, err := morningCallRepo.FindByID(ctx, morningCallID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("モーニングコールが見つかりません")
+		}
+		return nil, fmt.Errorf("モーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+
+	// 受信者本人のみ承認・拒否できる
+	if morningCall.ReceiverID != receiver.ID {
+		return nil, fmt.Errorf("受信者のみがモーニングコールを%sできます", action)
+	}
+
+	if morningCall.Status != valueobject.MorningCallStatusPendingApproval {
+		return nil, fmt.Errorf("承認待ちのモーニングコールのみ%sできます", action)
+	}
+
+	return morningCall, nil
+}
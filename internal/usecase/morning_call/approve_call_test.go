@@ -0,0 +1,145 @@
+package morning_call
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+// setupApprovalTest は承認・拒否のテスト用に送信者・受信者とコールを用意する
+func setupApprovalTest(t *testing.T, status valueobject.MorningCallStatus, scheduledTime time.Time) (*memory.MorningCallRepository, *memory.UserRepository) {
+	t.Helper()
+	ctx := context.Background()
+
+	morningCallRepo := memory.NewMorningCallRepository()
+	userRepo := memory.NewUserRepository()
+
+	for _, u := range []*entity.User{
+		{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+		{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password", RequireCallApproval: true},
+		{ID: "other", Username: "carol", Email: "carol@example.com", PasswordHash: "hashed_password"},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+
+	if err := morningCallRepo.Create(ctx, &entity.MorningCall{
+		ID:            "mc1",
+		SenderID:      "sender",
+		ReceiverID:    "receiver",
+		ScheduledTime: scheduledTime,
+		Status:        status,
+		CreatedAt:     time.Now().Add(-time.Hour),
+		UpdatedAt:     time.Now().Add(-time.Hour),
+	}); err != nil {
+		t.Fatalf("failed to create morning call: %v", err)
+	}
+
+	return morningCallRepo, userRepo
+}
+
+func TestApproveCallUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		status        valueobject.MorningCallStatus
+		scheduledTime time.Time
+		requester     string
+		wantErr       bool
+		errMsg        string
+	}{
+		{
+			name:          "受信者が承認",
+			status:        valueobject.MorningCallStatusPendingApproval,
+			scheduledTime: time.Now().Add(time.Hour),
+			requester:     "receiver",
+		},
+		{
+			name:          "送信者は承認できない",
+			status:        valueobject.MorningCallStatusPendingApproval,
+			scheduledTime: time.Now().Add(time.Hour),
+			requester:     "sender",
+			wantErr:       true,
+			errMsg:        "受信者のみがモーニングコールを承認できます",
+		},
+		{
+			name:          "第三者は承認できない",
+			status:        valueobject.MorningCallStatusPendingApproval,
+			scheduledTime: time.Now().Add(time.Hour),
+			requester:     "other",
+			wantErr:       true,
+			errMsg:        "受信者のみがモーニングコールを承認できます",
+		},
+		{
+			name:          "スケジュール済みは承認できない",
+			status:        valueobject.MorningCallStatusScheduled,
+			scheduledTime: time.Now().Add(time.Hour),
+			requester:     "receiver",
+			wantErr:       true,
+			errMsg:        "承認待ちのモーニングコールのみ承認できます",
+		},
+		{
+			name:          "アラーム時刻を過ぎたものは承認できない",
+			status:        valueobject.MorningCallStatusPendingApproval,
+			scheduledTime: time.Now().Add(-time.Minute),
+			requester:     "receiver",
+			wantErr:       true,
+			errMsg:        "アラーム時刻を過ぎた",
+		},
+		{
+			name:          "存在しない受信者",
+			status:        valueobject.MorningCallStatusPendingApproval,
+			scheduledTime: time.Now().Add(time.Hour),
+			requester:     "unknown",
+			wantErr:       true,
+			errMsg:        "受信者が見つかりません",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			morningCallRepo, userRepo := setupApprovalTest(t, tt.status, tt.scheduledTime)
+
+			uc := NewApproveCallUseCase(morningCallRepo, userRepo)
+			output, err := uc.Execute(ctx, ApproveCallInput{
+				MorningCallID: "mc1",
+				ReceiverID:    tt.requester,
+			})
+
+			persisted, findErr := morningCallRepo.FindByID(ctx, "mc1")
+			if findErr != nil {
+				t.Fatalf("failed to get persisted morning call: %v", findErr)
+			}
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error but got nil")
+				}
+				if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("error = %v, want containing %v", err, tt.errMsg)
+				}
+				if persisted.Status != tt.status {
+					t.Errorf("Status changed on error: got %v, want %v", persisted.Status, tt.status)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output.MorningCall.Status != valueobject.MorningCallStatusScheduled {
+				t.Errorf("Status = %v, want %v", output.MorningCall.Status, valueobject.MorningCallStatusScheduled)
+			}
+			if persisted.Status != valueobject.MorningCallStatusScheduled {
+				t.Errorf("persisted Status = %v, want %v", persisted.Status, valueobject.MorningCallStatusScheduled)
+			}
+		})
+	}
+}
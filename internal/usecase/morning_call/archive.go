@@ -0,0 +1,88 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// ArchiveUseCase は受信者が終了したモーニングコールを受信箱からアーカイブ（または解除）するユースケース
+// アーカイブしたコールは受信一覧に表示されず、アーカイブ一覧から取得する
+type ArchiveUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	now             func() time.Time
+}
+
+// NewArchiveUseCase は新しいアーカイブユースケースを作成する
+func NewArchiveUseCase(morningCallRepo repository.MorningCallRepository) *ArchiveUseCase {
+	return &ArchiveUseCase{
+		morningCallRepo: morningCallRepo,
+		now:             time.Now,
+	}
+}
+
+// ArchiveInput はアーカイブの入力データ
+type ArchiveInput struct {
+	MorningCallID string
+	ReceiverID    string // 操作する受信者のID
+	Archived      bool   // trueでアーカイブ、falseでアーカイブを解除する
+}
+
+// ArchiveOutput はアーカイブの出力データ
+type ArchiveOutput struct {
+	MorningCall *entity.MorningCall
+}
+
+// Execute は受信者宛のモーニングコールをアーカイブ、またはアーカイブを解除する
+func (uc *ArchiveUseCase) Execute(ctx context.Context, input ArchiveInput) (*ArchiveOutput, error) {
+	// 入力値の基本検証
+	if input.MorningCallID == "" {
+		return nil, fmt.Errorf("モーニングコールIDは必須です")
+	}
+	if input.ReceiverID == "" {
+		return nil, fmt.Errorf("受信者IDは必須です")
+	}
+
+	// モーニングコールの取得
+	morningCall, err := uc.morningCallRepo.FindByID(ctx, input.MorningCallID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("モーニングコールが見つかりません")
+		}
+		return nil, fmt.Errorf("モーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+
+	// 受信箱は受信者のものなので受信者本人のみ操作できる
+	if morningCall.ReceiverID != input.ReceiverID {
+		return nil, fmt.Errorf("受信者のみがモーニングコールをアーカイブできます")
+	}
+
+	var reason valueobject.NGReason
+	if input.Archived {
+		reason = morningCall.Archive(uc.now())
+	} else {
+		reason = morningCall.Unarchive(uc.now())
+	}
+	if reason.IsNG() {
+		if input.Archived {
+			return nil, fmt.Errorf("モーニングコールをアーカイブできませんでした: %w", reason)
+		}
+		return nil, fmt.Errorf("アーカイブを解除できませんでした: %w", reason)
+	}
+
+	if err := uc.morningCallRepo.Update(ctx, morningCall); err != nil {
+		if errors.Is(err, repository.ErrUpdateConflict) {
+			return nil, fmt.Errorf("他の操作でモーニングコールが更新されました。再度お試しください")
+		}
+		return nil, fmt.Errorf("アーカイブ状態の保存に失敗しました: %w", err)
+	}
+
+	return &ArchiveOutput{
+		MorningCall: morningCall,
+	}, nil
+}
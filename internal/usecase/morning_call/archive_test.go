@@ -0,0 +1,84 @@
+package morning_call
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestArchiveUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 1, 10, 7, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		status    valueobject.MorningCallStatus
+		archived  bool
+		requester string
+		input     bool
+		wantErr   string
+	}{
+		{name: "確認済みのコールをアーカイブ", status: valueobject.MorningCallStatusConfirmed, requester: "receiver", input: true},
+		{name: "アーカイブを解除", status: valueobject.MorningCallStatusExpired, archived: true, requester: "receiver", input: false},
+		{name: "送信者はアーカイブできない", status: valueobject.MorningCallStatusConfirmed, requester: "sender", input: true, wantErr: "受信者のみがモーニングコールをアーカイブできます"},
+		{name: "配信待ちのコールはアーカイブできない", status: valueobject.MorningCallStatusScheduled, requester: "receiver", input: true, wantErr: "終了したモーニングコールのみアーカイブできます"},
+		{name: "アーカイブしていないコールは解除できない", status: valueobject.MorningCallStatusConfirmed, requester: "receiver", input: false, wantErr: "アーカイブされていないモーニングコールです"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			morningCallRepo := memory.NewMorningCallRepository()
+			mc := &entity.MorningCall{
+				ID:            "mc1",
+				SenderID:      "sender",
+				ReceiverID:    "receiver",
+				ScheduledTime: now.Add(-time.Hour),
+				Status:        tt.status,
+				Archived:      tt.archived,
+				CreatedAt:     now.Add(-24 * time.Hour),
+				UpdatedAt:     now.Add(-time.Hour),
+			}
+			if err := morningCallRepo.Create(ctx, mc); err != nil {
+				t.Fatalf("failed to create morning call: %v", err)
+			}
+
+			uc := NewArchiveUseCase(morningCallRepo)
+			uc.now = func() time.Time { return now }
+
+			output, err := uc.Execute(ctx, ArchiveInput{MorningCallID: "mc1", ReceiverID: tt.requester, Archived: tt.input})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output.MorningCall.Archived != tt.input {
+				t.Errorf("Archived = %v, want %v", output.MorningCall.Archived, tt.input)
+			}
+
+			stored, err := morningCallRepo.FindByID(ctx, "mc1")
+			if err != nil {
+				t.Fatalf("failed to find morning call: %v", err)
+			}
+			if stored.Archived != tt.input {
+				t.Errorf("stored Archived = %v, want %v", stored.Archived, tt.input)
+			}
+		})
+	}
+
+	t.Run("存在しないモーニングコール", func(t *testing.T) {
+		uc := NewArchiveUseCase(memory.NewMorningCallRepository())
+		_, err := uc.Execute(ctx, ArchiveInput{MorningCallID: "unknown", ReceiverID: "receiver", Archived: true})
+		if err == nil || !strings.Contains(err.Error(), "モーニングコールが見つかりません") {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,101 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// DefaultCancelGracePeriod は配信後も送信者がキャンセルできる猶予期間のデフォルト値
+const DefaultCancelGracePeriod = 60 * time.Second
+
+// CancelUseCase は送信者がモーニングコールをキャンセルするユースケース
+// 配信前のコールに加え、配信直後の「やっぱりやめる」のため
+// 配信から猶予期間内で受信者がまだ確認していないコールもキャンセルできる
+type CancelUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	gracePeriod     time.Duration
+	now             func() time.Time
+}
+
+// NewCancelUseCase は新しいキャンセルユースケースを作成する
+// gracePeriodが0以下の場合、配信済みのコールはキャンセルできない
+func NewCancelUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	gracePeriod time.Duration,
+) *CancelUseCase {
+	return &CancelUseCase{
+		morningCallRepo: morningCallRepo,
+		gracePeriod:     gracePeriod,
+		now:             time.Now,
+	}
+}
+
+// CancelInput はキャンセルの入力データ
+type CancelInput struct {
+	MorningCallID string
+	SenderID      string // キャンセルする送信者のID
+}
+
+// CancelOutput はキャンセルの出力データ
+type CancelOutput struct {
+	MorningCall   *entity.MorningCall
+	AfterDelivery bool // 配信後の猶予期間内に取り消したか
+}
+
+// Execute は送信者のモーニングコールをキャンセルする
+func (uc *CancelUseCase) Execute(ctx context.Context, input CancelInput) (*CancelOutput, error) {
+	// 入力値の基本検証
+	if input.MorningCallID == "" {
+		return nil, fmt.Errorf("モーニングコールIDは必須です")
+	}
+	if input.SenderID == "" {
+		return nil, fmt.Errorf("送信者IDは必須です")
+	}
+
+	// モーニングコールの取得
+	morningCall, err := uc.morningCallRepo.FindByID(ctx, input.MorningCallID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("モーニングコールが見つかりません")
+		}
+		return nil, fmt.Errorf("モーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+
+	// 送信者本人のみキャンセルできる
+	if morningCall.SenderID != input.SenderID {
+		return nil, fmt.Errorf("送信者のみがモーニングコールをキャンセルできます")
+	}
+
+	// ステータスの確認（承認待ち・予定中、猶予期間内の配信済みのみキャンセル可能）
+	switch morningCall.Status {
+	case valueobject.MorningCallStatusPendingApproval, valueobject.MorningCallStatusScheduled, valueobject.MorningCallStatusDelivered:
+		// キャンセル可能（配信済みの猶予期間はエンティティで判定する）
+	case valueobject.MorningCallStatusCancelled:
+		return nil, fmt.Errorf("すでにキャンセル済みです")
+	case valueobject.MorningCallStatusConfirmed:
+		return nil, fmt.Errorf("受信者が起床確認済みのモーニングコールはキャンセルできません")
+	default:
+		return nil, fmt.Errorf("このステータスのモーニングコールはキャンセルできません")
+	}
+
+	afterDelivery := morningCall.Status == valueobject.MorningCallStatusDelivered
+	if reason := morningCall.CancelBySender(uc.now(), uc.gracePeriod); reason.IsNG() {
+		return nil, fmt.Errorf("キャンセルに失敗しました: %w", reason)
+	}
+
+	// 配信ワーカーや受信者の確認と同時に更新された場合は楽観ロックで検出する
+	if err := uc.morningCallRepo.Update(ctx, morningCall); err != nil {
+		return nil, fmt.Errorf("キャンセルの保存に失敗しました: %w", err)
+	}
+
+	return &CancelOutput{
+		MorningCall:   morningCall,
+		AfterDelivery: afterDelivery,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package morning_call
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// CancelSeriesUseCase はシリーズに属するモーニングコールをまとめてキャンセルするユースケース
+type CancelSeriesUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+}
+
+// NewCancelSeriesUseCase は新しいシリーズキャンセルユースケースを作成する
+func NewCancelSeriesUseCase(
+	morningCallRepo repository.MorningCallRepository,
+) *CancelSeriesUseCase {
+	return &CancelSeriesUseCase{
+		morningCallRepo: morningCallRepo,
+	}
+}
+
+// CancelSeriesInput はシリーズキャンセルの入力データ
+type CancelSeriesInput struct {
+	SeriesID string
+	SenderID string // キャンセル権限確認用
+}
+
+// CancelSeriesOutput はシリーズキャンセルの出力データ
+type CancelSeriesOutput struct {
+	MorningCalls []*entity.MorningCall // キャンセル後のシリーズのコール（アラーム時刻の昇順）
+	Cancelled    int                   // キャンセルした件数
+	Skipped      []SeriesSkip          // 対象外になったコールと理由
+}
+
+// Execute はシリーズのうち配信前（スケジュール済み・承認待ち）のモーニングコールをキャンセルする
+// 配信済み・確認済みなど既に終わったコールは履歴として残すため変更せず、理由とともにSkippedで返す
+func (uc *CancelSeriesUseCase) Execute(ctx context.Context, input CancelSeriesInput) (*CancelSeriesOutput, error) {
+	morningCalls, err := findSeries(ctx, uc.morningCallRepo, input.SeriesID, input.SenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 送信者の確認（送信者のみがキャンセル可能）
+	if morningCalls[0].SenderID != input.SenderID {
+		return nil, fmt.Errorf("送信者のみがシリーズをキャンセルできます")
+	}
+
+	output := &CancelSeriesOutput{
+		MorningCalls: morningCalls,
+		Skipped:      []SeriesSkip{},
+	}
+	for _, mc := range morningCalls {
+		if !mc.IsAwaitingDelivery() {
+			output.Skipped = append(output.Skipped, SeriesSkip{MorningCallID: mc.ID, Reason: seriesSkipReason(mc)})
+			continue
+		}
+		if reason := mc.Cancel(); reason.IsNG() {
+			output.Skipped = append(output.Skipped, SeriesSkip{MorningCallID: mc.ID, Reason: reason.Error()})
+			continue
+		}
+		if err := uc.morningCallRepo.Update(ctx, mc); err != nil {
+			output.Skipped = append(output.Skipped, SeriesSkip{MorningCallID: mc.ID, Reason: seriesSaveFailureReason(err)})
+			continue
+		}
+		output.Cancelled++
+	}
+
+	return output, nil
+}
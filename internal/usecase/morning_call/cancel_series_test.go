@@ -0,0 +1,57 @@
+package morning_call
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+func TestCancelSeriesUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("配信前のコールのみキャンセルする", func(t *testing.T) {
+		morningCallRepo := setupSeriesTest(t)
+		uc := NewCancelSeriesUseCase(morningCallRepo)
+
+		output, err := uc.Execute(ctx, CancelSeriesInput{SeriesID: "series1", SenderID: "user1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.Cancelled != 2 {
+			t.Errorf("Cancelled = %d, want 2", output.Cancelled)
+		}
+		if len(output.Skipped) != 1 || output.Skipped[0].MorningCallID != "mc1" || output.Skipped[0].Reason != "起床確認済みのため対象外です" {
+			t.Errorf("Skipped = %+v, want mc1 skipped as confirmed", output.Skipped)
+		}
+
+		want := map[string]valueobject.MorningCallStatus{
+			"mc1":   valueobject.MorningCallStatusConfirmed,
+			"mc2":   valueobject.MorningCallStatusCancelled,
+			"mc3":   valueobject.MorningCallStatusCancelled,
+			"other": valueobject.MorningCallStatusScheduled,
+		}
+		for id, status := range want {
+			mc, _ := morningCallRepo.FindByID(ctx, id)
+			if mc.Status != status {
+				t.Errorf("%s status = %s, want %s", id, mc.Status, status)
+			}
+		}
+	})
+
+	t.Run("受信者はシリーズをキャンセルできない", func(t *testing.T) {
+		uc := NewCancelSeriesUseCase(setupSeriesTest(t))
+		_, err := uc.Execute(ctx, CancelSeriesInput{SeriesID: "series1", SenderID: "user2"})
+		if err == nil || err.Error() != "送信者のみがシリーズをキャンセルできます" {
+			t.Errorf("error = %v, want forbidden", err)
+		}
+	})
+
+	t.Run("関係のないユーザーには存在を明かさない", func(t *testing.T) {
+		uc := NewCancelSeriesUseCase(setupSeriesTest(t))
+		_, err := uc.Execute(ctx, CancelSeriesInput{SeriesID: "series1", SenderID: "user3"})
+		if err == nil || err.Error() != "シリーズが見つかりません" {
+			t.Errorf("error = %v, want not found", err)
+		}
+	})
+}
@@ -0,0 +1,176 @@
+package morning_call
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestCancelUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	deliveredAt := time.Date(2026, 3, 15, 7, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name              string
+		status            valueobject.MorningCallStatus
+		requester         string
+		gracePeriod       time.Duration
+		elapsed           time.Duration // 配信からキャンセルまでの経過時間
+		wantErr           bool
+		errMsg            string
+		wantAfterDelivery bool
+	}{
+		{
+			name:        "予定中のコールをキャンセルする",
+			status:      valueobject.MorningCallStatusScheduled,
+			requester:   "sender",
+			gracePeriod: DefaultCancelGracePeriod,
+		},
+		{
+			name:        "承認待ちのコールをキャンセルする",
+			status:      valueobject.MorningCallStatusPendingApproval,
+			requester:   "sender",
+			gracePeriod: DefaultCancelGracePeriod,
+		},
+		{
+			name:              "配信直後のコールをキャンセルする",
+			status:            valueobject.MorningCallStatusDelivered,
+			requester:         "sender",
+			gracePeriod:       DefaultCancelGracePeriod,
+			elapsed:           10 * time.Second,
+			wantAfterDelivery: true,
+		},
+		{
+			name:              "猶予期間ちょうどはキャンセルできる",
+			status:            valueobject.MorningCallStatusDelivered,
+			requester:         "sender",
+			gracePeriod:       DefaultCancelGracePeriod,
+			elapsed:           DefaultCancelGracePeriod,
+			wantAfterDelivery: true,
+		},
+		{
+			name:        "猶予期間を1ナノ秒でも過ぎたらキャンセルできない",
+			status:      valueobject.MorningCallStatusDelivered,
+			requester:   "sender",
+			gracePeriod: DefaultCancelGracePeriod,
+			elapsed:     DefaultCancelGracePeriod + time.Nanosecond,
+			wantErr:     true,
+			errMsg:      "配信から60秒を過ぎたモーニングコールはキャンセルできません",
+		},
+		{
+			name:        "猶予期間が無効なら配信済みはキャンセルできない",
+			status:      valueobject.MorningCallStatusDelivered,
+			requester:   "sender",
+			gracePeriod: 0,
+			wantErr:     true,
+			errMsg:      "配信済みのモーニングコールはキャンセルできません",
+		},
+		{
+			name:        "猶予期間内でも起床確認済みはキャンセルできない",
+			status:      valueobject.MorningCallStatusConfirmed,
+			requester:   "sender",
+			gracePeriod: DefaultCancelGracePeriod,
+			elapsed:     time.Second,
+			wantErr:     true,
+			errMsg:      "受信者が起床確認済みのモーニングコールはキャンセルできません",
+		},
+		{
+			name:        "受信者はキャンセルできない",
+			status:      valueobject.MorningCallStatusScheduled,
+			requester:   "receiver",
+			gracePeriod: DefaultCancelGracePeriod,
+			wantErr:     true,
+			errMsg:      "送信者のみがモーニングコールをキャンセルできます",
+		},
+		{
+			name:        "キャンセル済みは再度キャンセルできない",
+			status:      valueobject.MorningCallStatusCancelled,
+			requester:   "sender",
+			gracePeriod: DefaultCancelGracePeriod,
+			wantErr:     true,
+			errMsg:      "すでにキャンセル済みです",
+		},
+		{
+			name:        "期限切れはキャンセルできない",
+			status:      valueobject.MorningCallStatusExpired,
+			requester:   "sender",
+			gracePeriod: DefaultCancelGracePeriod,
+			wantErr:     true,
+			errMsg:      "このステータスのモーニングコールはキャンセルできません",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			morningCallRepo := memory.NewMorningCallRepository()
+
+			morningCall := &entity.MorningCall{
+				ID:            "mc1",
+				SenderID:      "sender",
+				ReceiverID:    "receiver",
+				ScheduledTime: deliveredAt,
+				Status:        tt.status,
+				CreatedAt:     deliveredAt.Add(-time.Hour),
+				UpdatedAt:     deliveredAt,
+			}
+			if tt.status == valueobject.MorningCallStatusDelivered || tt.status == valueobject.MorningCallStatusConfirmed {
+				at := deliveredAt
+				morningCall.DeliveredAt = &at
+			}
+			if err := morningCallRepo.Create(ctx, morningCall); err != nil {
+				t.Fatalf("failed to create morning call: %v", err)
+			}
+
+			uc := NewCancelUseCase(morningCallRepo, tt.gracePeriod)
+			uc.now = func() time.Time { return deliveredAt.Add(tt.elapsed) }
+
+			output, err := uc.Execute(ctx, CancelInput{
+				MorningCallID: "mc1",
+				SenderID:      tt.requester,
+			})
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("error = %v, want containing %q", err, tt.errMsg)
+				}
+				saved, _ := morningCallRepo.FindByID(ctx, "mc1")
+				if saved.Status != tt.status {
+					t.Errorf("status changed to %s on error", saved.Status)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output.MorningCall.Status != valueobject.MorningCallStatusCancelled {
+				t.Errorf("status = %s, want cancelled", output.MorningCall.Status)
+			}
+			if output.AfterDelivery != tt.wantAfterDelivery {
+				t.Errorf("AfterDelivery = %v, want %v", output.AfterDelivery, tt.wantAfterDelivery)
+			}
+
+			saved, _ := morningCallRepo.FindByID(ctx, "mc1")
+			if saved.Status != valueobject.MorningCallStatusCancelled {
+				t.Errorf("saved status = %s, want cancelled", saved.Status)
+			}
+		})
+	}
+}
+
+func TestCancelUseCase_Execute_NotFound(t *testing.T) {
+	uc := NewCancelUseCase(memory.NewMorningCallRepository(), DefaultCancelGracePeriod)
+
+	_, err := uc.Execute(context.Background(), CancelInput{MorningCallID: "missing", SenderID: "sender"})
+	if err == nil || !strings.Contains(err.Error(), "モーニングコールが見つかりません") {
+		t.Errorf("error = %v, want not found", err)
+	}
+}
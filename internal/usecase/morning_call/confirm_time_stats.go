@@ -0,0 +1,104 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// ConfirmTimeStatsUseCase は送信者が自分のコールが起床確認されるまでの所要時間の統計を取得するユースケース
+type ConfirmTimeStatsUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	userRepo        repository.UserRepository
+}
+
+// NewConfirmTimeStatsUseCase は新しい確認所要時間の統計取得ユースケースを作成する
+func NewConfirmTimeStatsUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	userRepo repository.UserRepository,
+) *ConfirmTimeStatsUseCase {
+	return &ConfirmTimeStatsUseCase{
+		morningCallRepo: morningCallRepo,
+		userRepo:        userRepo,
+	}
+}
+
+// ConfirmTimeStatsInput は確認所要時間の統計取得の入力データ
+type ConfirmTimeStatsInput struct {
+	SenderID string // 必須：送信者のID
+}
+
+// ConfirmTimeStatsOutput は確認所要時間の統計取得の出力データ
+type ConfirmTimeStatsOutput struct {
+	ConfirmedCount int           // 所要時間を記録して起床確認されたコール数
+	Average        time.Duration // 所要時間の平均（確認されたコールがない場合は0）
+	Median         time.Duration // 所要時間の中央値（件数が偶数の場合は中央の2件の平均、確認されたコールがない場合は0）
+}
+
+// Execute は送信者が送ったコールのうち起床確認されたものの所要時間を集計する
+// 自動確認されたコール・セルフモーニングコール・管理者に削除されたコールは含めない
+func (uc *ConfirmTimeStatsUseCase) Execute(ctx context.Context, input ConfirmTimeStatsInput) (*ConfirmTimeStatsOutput, error) {
+	// 入力値の基本検証
+	if input.SenderID == "" {
+		return nil, fmt.Errorf("送信者IDは必須です")
+	}
+
+	// 送信者の存在確認
+	if _, err := uc.userRepo.FindByID(ctx, input.SenderID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("送信者が見つかりません")
+		}
+		return nil, fmt.Errorf("送信者の確認中にエラーが発生しました: %w", err)
+	}
+
+	// 送信者のコールをすべて読み、起床確認までの所要時間を集める
+	var durations []time.Duration
+	err := forEachSentCall(ctx, uc.morningCallRepo, input.SenderID, func(call entity.ReadOnlyMorningCall) {
+		elapsed, ok := call.TimeToConfirm()
+		if !ok || call.SelfCall() || call.IsDeleted() {
+			return
+		}
+		durations = append(durations, elapsed)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("送信モーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+
+	return &ConfirmTimeStatsOutput{
+		ConfirmedCount: len(durations),
+		Average:        averageDuration(durations),
+		Median:         medianDuration(durations),
+	}, nil
+}
+
+// averageDuration は所要時間の平均を返す（空の場合は0）
+func averageDuration(durations []time.Duration) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+	var total time.Duration
+	for _, d := range durations {
+		total += d
+	}
+	return total / time.Duration(len(durations))
+}
+
+// medianDuration は所要時間の中央値を返す（空の場合は0、件数が偶数の場合は中央の2件の平均）
+func medianDuration(durations []time.Duration) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+	sorted := append([]time.Duration(nil), durations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	return sorted[mid-1] + (sorted[mid]-sorted[mid-1])/2
+}
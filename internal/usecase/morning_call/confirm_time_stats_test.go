@@ -0,0 +1,149 @@
+package morning_call
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestConfirmTimeStatsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	morningCallRepo := memory.NewMorningCallRepository()
+	userRepo := memory.NewUserRepository()
+	for _, u := range []*entity.User{
+		{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+		{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+	uc := NewConfirmTimeStatsUseCase(morningCallRepo, userRepo)
+
+	createCall := func(id string, elapsed *time.Duration, modify func(mc *entity.MorningCall)) {
+		t.Helper()
+		mc := &entity.MorningCall{
+			ID:            id,
+			SenderID:      "sender",
+			ReceiverID:    "receiver",
+			ScheduledTime: time.Now().Add(-time.Hour),
+			Status:        valueobject.MorningCallStatusConfirmed,
+			TimeToConfirm: elapsed,
+		}
+		if modify != nil {
+			modify(mc)
+		}
+		if err := morningCallRepo.Create(ctx, mc); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+	durationPtr := func(d time.Duration) *time.Duration { return &d }
+
+	t.Run("確認されたコールがない場合は0", func(t *testing.T) {
+		output, err := uc.Execute(ctx, ConfirmTimeStatsInput{SenderID: "sender"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.ConfirmedCount != 0 || output.Average != 0 || output.Median != 0 {
+			t.Errorf("output = %+v, want zero", output)
+		}
+	})
+
+	// 集計対象3件（1分, 3分, 11分）
+	createCall("mc1", durationPtr(time.Minute), nil)
+	createCall("mc2", durationPtr(3*time.Minute), nil)
+	createCall("mc3", durationPtr(11*time.Minute), nil)
+	// 集計対象外：自動確認（所要時間なし）、セルフモーニングコール、削除済み、他の送信者
+	createCall("auto", nil, func(mc *entity.MorningCall) { mc.AutoConfirmed = true })
+	createCall("self", durationPtr(time.Hour), func(mc *entity.MorningCall) {
+		mc.ReceiverID = "sender"
+		mc.SelfCall = true
+	})
+	createCall("deleted", durationPtr(time.Hour), func(mc *entity.MorningCall) {
+		mc.DeleteByAdmin("admin", "規約違反", time.Now())
+	})
+	createCall("other", durationPtr(time.Hour), func(mc *entity.MorningCall) {
+		mc.SenderID = "receiver"
+		mc.ReceiverID = "sender"
+	})
+
+	t.Run("件数が奇数の場合は中央の値", func(t *testing.T) {
+		output, err := uc.Execute(ctx, ConfirmTimeStatsInput{SenderID: "sender"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.ConfirmedCount != 3 {
+			t.Errorf("ConfirmedCount = %d, want 3", output.ConfirmedCount)
+		}
+		if output.Average != 5*time.Minute {
+			t.Errorf("Average = %v, want 5m", output.Average)
+		}
+		if output.Median != 3*time.Minute {
+			t.Errorf("Median = %v, want 3m", output.Median)
+		}
+	})
+
+	t.Run("件数が偶数の場合は中央の2件の平均", func(t *testing.T) {
+		createCall("mc4", durationPtr(6*time.Minute), nil)
+		output, err := uc.Execute(ctx, ConfirmTimeStatsInput{SenderID: "sender"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.ConfirmedCount != 4 {
+			t.Errorf("ConfirmedCount = %d, want 4", output.ConfirmedCount)
+		}
+		if output.Average != 5*time.Minute+15*time.Second {
+			t.Errorf("Average = %v, want 5m15s", output.Average)
+		}
+		if output.Median != 4*time.Minute+30*time.Second {
+			t.Errorf("Median = %v, want 4m30s", output.Median)
+		}
+	})
+
+	t.Run("存在しない送信者", func(t *testing.T) {
+		_, err := uc.Execute(ctx, ConfirmTimeStatsInput{SenderID: "missing"})
+		if err == nil || !strings.Contains(err.Error(), "送信者が見つかりません") {
+			t.Errorf("error = %v, want not found", err)
+		}
+	})
+}
+
+func TestConfirmTimeStatsUseCase_Execute_ManyCalls(t *testing.T) {
+	ctx := context.Background()
+	morningCallRepo := memory.NewMorningCallRepository()
+	userRepo := memory.NewUserRepository()
+	if err := userRepo.Create(ctx, &entity.User{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"}); err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+
+	// 以前の取得上限（10000件）を超えるコールが確認され、すべてが集計されることを確認する
+	confirmed := 10001
+	base := time.Now().Add(-time.Hour)
+	for i := 0; i < confirmed; i++ {
+		elapsed := 2 * time.Minute
+		if err := morningCallRepo.Create(ctx, &entity.MorningCall{
+			ID:            fmt.Sprintf("mc%05d", i),
+			SenderID:      "sender",
+			ReceiverID:    "receiver",
+			ScheduledTime: base.Add(-time.Duration(i) * time.Minute),
+			Status:        valueobject.MorningCallStatusConfirmed,
+			TimeToConfirm: &elapsed,
+		}); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+
+	output, err := NewConfirmTimeStatsUseCase(morningCallRepo, userRepo).Execute(ctx, ConfirmTimeStatsInput{SenderID: "sender"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.ConfirmedCount != confirmed || output.Average != 2*time.Minute || output.Median != 2*time.Minute {
+		t.Errorf("output = %+v, want %d calls confirmed in 2m", output, confirmed)
+	}
+}
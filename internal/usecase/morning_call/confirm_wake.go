@@ -4,40 +4,69 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/ochamu/morning-call-api/internal/domain/entity"
 	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/service"
 	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/pkg/utils"
 )
 
 // ConfirmWakeUseCase は起床確認のユースケース
+// 確認した場合は、送信者に感謝ポイントを付与し、通知を希望する送信者へメールで通知する
 type ConfirmWakeUseCase struct {
-	morningCallRepo repository.MorningCallRepository
-	userRepo        repository.UserRepository
+	morningCallRepo  repository.MorningCallRepository
+	userRepo         repository.UserRepository
+	emailSender      service.EmailSender
+	auditLogger      service.AuditLogger
+	pointsPerConfirm int
+	now              func() time.Time
 }
 
 // NewConfirmWakeUseCase は新しい起床確認ユースケースを作成する
+// emailSenderがnilの場合は送信者への通知を行わない
+// auditLoggerがnilの場合は監査ログを記録せず、pointsPerConfirmが0以下の場合はポイントを付与しない
 func NewConfirmWakeUseCase(
 	morningCallRepo repository.MorningCallRepository,
 	userRepo repository.UserRepository,
+	emailSender service.EmailSender,
+	auditLogger service.AuditLogger,
+	pointsPerConfirm int,
 ) *ConfirmWakeUseCase {
 	return &ConfirmWakeUseCase{
-		morningCallRepo: morningCallRepo,
-		userRepo:        userRepo,
+		morningCallRepo:  morningCallRepo,
+		userRepo:         userRepo,
+		emailSender:      emailSender,
+		auditLogger:      auditLogger,
+		pointsPerConfirm: pointsPerConfirm,
+		now:              time.Now,
 	}
 }
 
 // ConfirmWakeInput は起床確認の入力データ
 type ConfirmWakeInput struct {
 	MorningCallID string
-	ReceiverID    string // 起床確認をする受信者のID
+	ConfirmerID   string                // 起床確認をするユーザーのID（受信者本人または代理人）
+	Location      *valueobject.GeoPoint // オプション：起床確認時の位置情報（受信者本人のみ）
+	ShareLocation bool                  // オプション：位置情報を送信者に公開するか
+	Stamp         valueobject.Stamp     // オプション：送信者へのお礼スタンプ（受信者本人のみ）
+	// ChallengeAnswer は起床クイズの回答（クイズ付きのコールでは必須、代理確認でも必要）
+	ChallengeAnswer string
+	// ConfirmMethod はクライアントで起床確認した操作（未指定はボタン扱い、代理確認でも記録する）
+	ConfirmMethod valueobject.ConfirmMethod
+	// RequestNextCall は確認と同時に翌日同時刻の次回のコールを送信者へリクエストするか（受信者本人のみ）
+	// リクエストは送信者の承認待ちになり、承認されると次回のコールが作成される
+	RequestNextCall bool
 }
 
 // ConfirmWakeOutput は起床確認の出力データ
 type ConfirmWakeOutput struct {
-	MorningCall *entity.MorningCall
-	ConfirmedAt time.Time
+	MorningCall    *entity.MorningCall
+	ConfirmedAt    time.Time
+	SenderNotified bool // 送信者への通知に成功したか（送信者が通知を希望しない場合はfalse）
+	PointsAwarded  int  // 送信者に付与した感謝ポイント（付与しなかった場合は0）
 }
 
 // Execute は起床確認を実行する
@@ -46,17 +75,28 @@ func (uc *ConfirmWakeUseCase) Execute(ctx context.Context, input ConfirmWakeInpu
 	if input.MorningCallID == "" {
 		return nil, fmt.Errorf("モーニングコールIDは必須です")
 	}
-	if input.ReceiverID == "" {
-		return nil, fmt.Errorf("受信者IDは必須です")
+	if input.ConfirmerID == "" {
+		return nil, fmt.Errorf("確認するユーザーのIDは必須です")
+	}
+	if input.Location != nil {
+		if reason := input.Location.Validate(); reason.IsNG() {
+			return nil, fmt.Errorf("%w", reason)
+		}
+	}
+	if input.Stamp != "" && !input.Stamp.IsValid() {
+		return nil, fmt.Errorf("無効なスタンプです")
+	}
+	if !input.ConfirmMethod.OrDefault().IsValid() {
+		return nil, fmt.Errorf("%w", valueobject.NGWithCode(valueobject.ReasonCodeInvalidFormat, "confirm_method", "無効な確認方法です"))
 	}
 
-	// 受信者の存在確認
-	receiver, err := uc.userRepo.FindByID(ctx, input.ReceiverID)
+	// 確認するユーザーの存在確認
+	confirmer, err := uc.userRepo.FindByID(ctx, input.ConfirmerID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
-			return nil, fmt.Errorf("受信者が見つかりません")
+			return nil, fmt.Errorf("ユーザーが見つかりません")
 		}
-		return nil, fmt.Errorf("受信者の確認中にエラーが発生しました: %w", err)
+		return nil, fmt.Errorf("ユーザーの確認中にエラーが発生しました: %w", err)
 	}
 
 	// モーニングコールの取得
@@ -68,41 +108,240 @@ func (uc *ConfirmWakeUseCase) Execute(ctx context.Context, input ConfirmWakeInpu
 		return nil, fmt.Errorf("モーニングコールの取得中にエラーが発生しました: %w", err)
 	}
 
-	// 受信者の確認（受信者本人のみ起床確認可能）
-	if morningCall.ReceiverID != receiver.ID {
-		return nil, fmt.Errorf("受信者のみが起床確認できます")
-	}
-
-	// ステータスの確認（配信済みまたはスケジュール済みのもののみ起床確認可能）
-	// 注: 本番環境では配信済み(Delivered)のみ許可すべきだが、開発・テスト環境では
-	// スケジュール済み(Scheduled)でも起床確認できるようにする
-	if morningCall.Status != valueobject.MorningCallStatusDelivered && 
-		morningCall.Status != valueobject.MorningCallStatusScheduled {
-		switch morningCall.Status {
-		case valueobject.MorningCallStatusConfirmed:
-			return nil, fmt.Errorf("すでに起床確認済みです")
-		case valueobject.MorningCallStatusCancelled:
-			return nil, fmt.Errorf("キャンセル済みのモーニングコールは起床確認できません")
-		case valueobject.MorningCallStatusExpired:
-			return nil, fmt.Errorf("期限切れのモーニングコールは起床確認できません")
-		default:
-			return nil, fmt.Errorf("このステータスのモーニングコールは起床確認できません")
+	// 確認者の権限確認（受信者本人または受信者が許可した代理人のみ起床確認可能）
+	isProxy := morningCall.ReceiverID != confirmer.ID
+	if isProxy {
+		if err := uc.checkProxyConfirmer(ctx, morningCall.ReceiverID, confirmer.ID); err != nil {
+			return nil, err
+		}
+		// 位置情報とお礼スタンプは受信者本人のものなので代理では送れない
+		if input.Location != nil {
+			return nil, fmt.Errorf("代理確認では位置情報を送信できません")
+		}
+		if input.Stamp != "" {
+			return nil, fmt.Errorf("代理確認ではスタンプを送信できません")
+		}
+		if input.RequestNextCall {
+			return nil, fmt.Errorf("代理確認では次回のコールをリクエストできません")
+		}
+	}
+
+	// ステータスの確認
+	if err := checkConfirmableStatus(morningCall.Status); err != nil {
+		return nil, err
+	}
+
+	// 起床確認期限の確認（期限切れの場合は期限切れ状態に遷移させて拒否する）
+	if morningCall.IsConfirmDeadlinePassed(uc.now()) {
+		return nil, uc.expire(ctx, morningCall)
+	}
+
+	// 起床クイズ付きのコールは正解しないと確認できない（不正解でも状態は変えない）
+	if reason := morningCall.CheckWakeChallenge(input.ChallengeAnswer); reason.IsNG() {
+		return nil, fmt.Errorf("%w", reason)
+	}
+
+	// 起床確認を記録（誰が確認したかも記録する）
+	var reason valueobject.NGReason
+	if isProxy {
+		reason = morningCall.ConfirmWakeUpByProxy(confirmer.ID)
+	} else {
+		reason = morningCall.ConfirmWakeUpWithLocation(input.Location, input.ShareLocation)
+	}
+	if reason.IsNG() {
+		return nil, fmt.Errorf("起床確認の記録に失敗しました: %w", reason)
+	}
+	if reason := morningCall.SetConfirmMethod(input.ConfirmMethod); reason.IsNG() {
+		return nil, fmt.Errorf("確認方法の記録に失敗しました: %w", reason)
+	}
+	confirmedAt := morningCall.UpdatedAt
+	if reason := morningCall.RecordTimeToConfirm(confirmedAt); reason.IsNG() {
+		return nil, fmt.Errorf("確認までの所要時間の記録に失敗しました: %w", reason)
+	}
+
+	// お礼スタンプを記録（任意）
+	if input.Stamp != "" {
+		if reason := morningCall.SetStamp(input.Stamp); reason.IsNG() {
+			return nil, fmt.Errorf("スタンプの記録に失敗しました: %w", reason)
 		}
 	}
 
-	// 起床確認を記録
-	if reason := morningCall.ConfirmWakeUp(); reason.IsNG() {
-		return nil, fmt.Errorf("起床確認の記録に失敗しました: %s", string(reason))
+	// 翌日同時刻の次回のコールをリクエスト（任意）
+	// 友達関係や受信時間帯などは送信者が承認してコールを作成する時点で改めて検証する
+	if input.RequestNextCall {
+		if reason := morningCall.RequestNextCall(uc.now()); reason.IsNG() {
+			return nil, fmt.Errorf("次回のコールのリクエストに失敗しました: %w", reason)
+		}
 	}
 
 	// リポジトリに保存
+	// 複数タブなどから同時に確認された場合は楽観ロックにより1件のみが成功する
 	if err := uc.morningCallRepo.Update(ctx, morningCall); err != nil {
+		if errors.Is(err, repository.ErrUpdateConflict) {
+			return nil, uc.conflictError(ctx, input.MorningCallID)
+		}
 		return nil, fmt.Errorf("起床確認の保存に失敗しました: %w", err)
 	}
 
 	// 結果を返す
+	// ポイントは確認の保存に成功した1回だけ付与するため、二重確認で二重に加算されることはない
 	return &ConfirmWakeOutput{
-		MorningCall: morningCall,
-		ConfirmedAt: morningCall.UpdatedAt,
+		MorningCall:    morningCall,
+		ConfirmedAt:    confirmedAt,
+		SenderNotified: uc.notifySender(ctx, morningCall, confirmer),
+		PointsAwarded:  uc.awardPoints(ctx, morningCall, confirmer),
 	}, nil
 }
+
+// awardPoints は起こしてくれた送信者に感謝ポイントを付与し、付与したポイントを返す
+// 送信者自身が代理で確認した場合は付与しない。付与に失敗しても起床確認自体は取り消さない
+func (uc *ConfirmWakeUseCase) awardPoints(ctx context.Context, morningCall *entity.MorningCall, confirmer *entity.User) int {
+	if uc.pointsPerConfirm <= 0 || confirmer.ID == morningCall.SenderID {
+		return 0
+	}
+
+	total, err := uc.userRepo.AddPoints(ctx, morningCall.SenderID, uc.pointsPerConfirm)
+	if err != nil {
+		utils.Logf(ctx, "感謝ポイントの付与に失敗しました: %v", err)
+		return 0
+	}
+
+	if uc.auditLogger != nil {
+		entry := service.AuditEntry{
+			Action:     "user.points_awarded",
+			ActorID:    confirmer.ID,
+			TargetType: "user",
+			TargetID:   morningCall.SenderID,
+			Details: map[string]string{
+				"morning_call_id": morningCall.ID,
+				"points":          strconv.Itoa(uc.pointsPerConfirm),
+				"total":           strconv.Itoa(total),
+			},
+			OccurredAt: uc.now(),
+		}
+		if err := uc.auditLogger.Record(ctx, entry); err != nil {
+			// 監査ログの失敗でポイントの付与は巻き戻さない
+			utils.Logf(ctx, "監査ログの記録に失敗しました: %v", err)
+		}
+	}
+	return uc.pointsPerConfirm
+}
+
+// notifySender は送信者へ起床確認されたことをメールで通知する
+// 送信者が通知を希望しない場合は送らない。通知に失敗しても起床確認自体は取り消さない
+func (uc *ConfirmWakeUseCase) notifySender(ctx context.Context, morningCall *entity.MorningCall, confirmer *entity.User) bool {
+	// セルフモーニングコールは自分で確認するため通知しない
+	if uc.emailSender == nil || morningCall.SelfCall {
+		return false
+	}
+
+	sender, err := uc.userRepo.FindByID(ctx, morningCall.SenderID)
+	if err != nil {
+		utils.Logf(ctx, "起床確認通知の送信者の取得に失敗しました: %v", err)
+		return false
+	}
+	if !sender.NotifyOnConfirmation {
+		return false
+	}
+
+	receiverName := morningCall.ReceiverID
+	if confirmer.ID == morningCall.ReceiverID {
+		receiverName = confirmer.Username
+	} else if receiver, err := uc.userRepo.FindByID(ctx, morningCall.ReceiverID); err == nil {
+		receiverName = receiver.Username
+	}
+
+	body := fmt.Sprintf(
+		"%s さん\n\n%s さんに設定した %s のモーニングコールが起床確認されました。",
+		sender.Username,
+		receiverName,
+		morningCall.ScheduledTime.Format("2006-01-02 15:04"),
+	)
+	if confirmer.ID != morningCall.ReceiverID {
+		body += fmt.Sprintf("\n（%s さんが代理で確認しました）", confirmer.Username)
+	}
+	if morningCall.HasPendingNextCallRequest() {
+		body += fmt.Sprintf(
+			"\n\n%s さんから翌日同時刻（%s）のモーニングコールがリクエストされています。承認すると新しいコールが作成されます。",
+			receiverName,
+			morningCall.NextCallRequest.RequestedTime.Format("2006-01-02 15:04"),
+		)
+	}
+
+	message := service.EmailMessage{
+		To:      sender.Email,
+		Subject: "モーニングコールが起床確認されました",
+		Body:    body,
+	}
+	if err := uc.emailSender.Send(ctx, message); err != nil {
+		utils.Logf(ctx, "起床確認通知の送信に失敗しました: %v", err)
+		return false
+	}
+	return true
+}
+
+// checkProxyConfirmer は受信者が確認者を代理人として許可しているかを確認する
+func (uc *ConfirmWakeUseCase) checkProxyConfirmer(ctx context.Context, receiverID, confirmerID string) error {
+	receiver, err := uc.userRepo.FindByID(ctx, receiverID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return fmt.Errorf("受信者が見つかりません")
+		}
+		return fmt.Errorf("受信者の確認中にエラーが発生しました: %w", err)
+	}
+	if !receiver.IsProxyConfirmer(confirmerID) {
+		return fmt.Errorf("受信者または受信者が許可した代理人のみが起床確認できます")
+	}
+	return nil
+}
+
+// expire は確認期限を過ぎたモーニングコールを期限切れにして保存し、確認不可のエラーを返す
+func (uc *ConfirmWakeUseCase) expire(ctx context.Context, morningCall *entity.MorningCall) error {
+	deadline := *morningCall.ConfirmDeadline
+	if reason := morningCall.MarkAsExpired(); reason.IsNG() {
+		return fmt.Errorf("期限切れへの更新に失敗しました: %w", reason)
+	}
+	if err := uc.morningCallRepo.Update(ctx, morningCall); err != nil {
+		if errors.Is(err, repository.ErrUpdateConflict) {
+			return uc.conflictError(ctx, morningCall.ID)
+		}
+		return fmt.Errorf("期限切れの保存に失敗しました: %w", err)
+	}
+	return fmt.Errorf("起床確認の期限（%s）を過ぎているため確認できません", deadline.Format(time.RFC3339))
+}
+
+// conflictError は保存時に競合した場合、最新の状態に応じたエラーを返す
+func (uc *ConfirmWakeUseCase) conflictError(ctx context.Context, morningCallID string) error {
+	latest, err := uc.morningCallRepo.FindByID(ctx, morningCallID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return fmt.Errorf("モーニングコールが見つかりません")
+		}
+		return fmt.Errorf("モーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+	if err := checkConfirmableStatus(latest.Status); err != nil {
+		return err
+	}
+	// 確認以外の更新と競合した場合は再試行を促す
+	return fmt.Errorf("モーニングコールが他の操作で更新されました。もう一度お試しください")
+}
+
+// checkConfirmableStatus は起床確認が可能なステータスかを確認する
+// 注: 本番環境では配信済み(Delivered)のみ許可すべきだが、開発・テスト環境では
+// スケジュール済み(Scheduled)でも起床確認できるようにする
+func checkConfirmableStatus(status valueobject.MorningCallStatus) error {
+	switch status {
+	case valueobject.MorningCallStatusDelivered, valueobject.MorningCallStatusScheduled:
+		return nil
+	case valueobject.MorningCallStatusConfirmed:
+		return fmt.Errorf("すでに起床確認済みです")
+	case valueobject.MorningCallStatusCancelled:
+		return fmt.Errorf("キャンセル済みのモーニングコールは起床確認できません")
+	case valueobject.MorningCallStatusExpired:
+		return fmt.Errorf("期限切れのモーニングコールは起床確認できません")
+	case valueobject.MorningCallStatusSkipped:
+		return fmt.Errorf("スキップ済みのモーニングコールは起床確認できません")
+	default:
+		return fmt.Errorf("このステータスのモーニングコールは起床確認できません")
+	}
+}
@@ -2,13 +2,18 @@ package morning_call
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
 	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/audit"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/mail"
 	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
 )
 
@@ -16,7 +21,7 @@ func TestNewConfirmWakeUseCase(t *testing.T) {
 	morningCallRepo := memory.NewMorningCallRepository()
 	userRepo := memory.NewUserRepository()
 
-	uc := NewConfirmWakeUseCase(morningCallRepo, userRepo)
+	uc := NewConfirmWakeUseCase(morningCallRepo, userRepo, nil, nil, 0)
 
 	if uc == nil {
 		t.Fatal("NewConfirmWakeUseCase returned nil")
@@ -59,7 +64,7 @@ func TestConfirmWakeUseCase_Execute_InputValidation(t *testing.T) {
 			name: "モーニングコールIDが空",
 			input: ConfirmWakeInput{
 				MorningCallID: "",
-				ReceiverID:    user1.ID,
+				ConfirmerID:   user1.ID,
 			},
 			wantErr: true,
 			errMsg:  "モーニングコールIDは必須です",
@@ -68,25 +73,25 @@ func TestConfirmWakeUseCase_Execute_InputValidation(t *testing.T) {
 			name: "受信者IDが空",
 			input: ConfirmWakeInput{
 				MorningCallID: "mc1",
-				ReceiverID:    "",
+				ConfirmerID:   "",
 			},
 			wantErr: true,
-			errMsg:  "受信者IDは必須です",
+			errMsg:  "確認するユーザーのIDは必須です",
 		},
 		{
-			name: "存在しない受信者",
+			name: "存在しない確認者",
 			input: ConfirmWakeInput{
 				MorningCallID: "mc1",
-				ReceiverID:    "nonexistent",
+				ConfirmerID:   "nonexistent",
 			},
 			wantErr: true,
-			errMsg:  "受信者が見つかりません",
+			errMsg:  "ユーザーが見つかりません",
 		},
 		{
 			name: "存在しないモーニングコール",
 			input: ConfirmWakeInput{
 				MorningCallID: "nonexistent",
-				ReceiverID:    user1.ID,
+				ConfirmerID:   user1.ID,
 			},
 			wantErr: true,
 			errMsg:  "モーニングコールが見つかりません",
@@ -95,7 +100,7 @@ func TestConfirmWakeUseCase_Execute_InputValidation(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			uc := NewConfirmWakeUseCase(morningCallRepo, userRepo)
+			uc := NewConfirmWakeUseCase(morningCallRepo, userRepo, nil, nil, 0)
 			output, err := uc.Execute(ctx, tt.input)
 
 			if tt.wantErr {
@@ -178,16 +183,16 @@ func TestConfirmWakeUseCase_Execute_Authorization(t *testing.T) {
 		t.Fatalf("failed to create morning call: %v", err)
 	}
 
-	uc := NewConfirmWakeUseCase(morningCallRepo, userRepo)
+	uc := NewConfirmWakeUseCase(morningCallRepo, userRepo, nil, nil, 0)
 
 	// 送信者による起床確認（失敗すべき）
 	output, err := uc.Execute(ctx, ConfirmWakeInput{
 		MorningCallID: morningCall.ID,
-		ReceiverID:    sender.ID,
+		ConfirmerID:   sender.ID,
 	})
 	if err == nil {
 		t.Error("sender should not be able to confirm wake")
-	} else if !strings.Contains(err.Error(), "受信者のみが起床確認できます") {
+	} else if !strings.Contains(err.Error(), "のみが起床確認できます") {
 		t.Errorf("unexpected error message: %v", err.Error())
 	}
 	if output != nil {
@@ -197,11 +202,11 @@ func TestConfirmWakeUseCase_Execute_Authorization(t *testing.T) {
 	// 無関係なユーザーによる起床確認（失敗すべき）
 	output2, err := uc.Execute(ctx, ConfirmWakeInput{
 		MorningCallID: morningCall.ID,
-		ReceiverID:    other.ID,
+		ConfirmerID:   other.ID,
 	})
 	if err == nil {
 		t.Error("unrelated user should not be able to confirm wake")
-	} else if !strings.Contains(err.Error(), "受信者のみが起床確認できます") {
+	} else if !strings.Contains(err.Error(), "のみが起床確認できます") {
 		t.Errorf("unexpected error message: %v", err.Error())
 	}
 	if output2 != nil {
@@ -211,7 +216,7 @@ func TestConfirmWakeUseCase_Execute_Authorization(t *testing.T) {
 	// 受信者による起床確認（成功すべき）
 	output3, err := uc.Execute(ctx, ConfirmWakeInput{
 		MorningCallID: morningCall.ID,
-		ReceiverID:    receiver.ID,
+		ConfirmerID:   receiver.ID,
 	})
 	if err != nil {
 		t.Errorf("receiver should be able to confirm wake: %v", err)
@@ -301,7 +306,7 @@ func TestConfirmWakeUseCase_Execute_StatusTransitions(t *testing.T) {
 		},
 	}
 
-	uc := NewConfirmWakeUseCase(morningCallRepo, userRepo)
+	uc := NewConfirmWakeUseCase(morningCallRepo, userRepo, nil, nil, 0)
 
 	for i, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -323,7 +328,7 @@ func TestConfirmWakeUseCase_Execute_StatusTransitions(t *testing.T) {
 			// 起床確認を試みる
 			output, err := uc.Execute(ctx, ConfirmWakeInput{
 				MorningCallID: morningCall.ID,
-				ReceiverID:    receiver.ID,
+				ConfirmerID:   receiver.ID,
 			})
 
 			if tc.canConfirm {
@@ -422,13 +427,13 @@ func TestConfirmWakeUseCase_Execute_SuccessFlow(t *testing.T) {
 		t.Fatalf("failed to create morning call: %v", err)
 	}
 
-	uc := NewConfirmWakeUseCase(morningCallRepo, userRepo)
+	uc := NewConfirmWakeUseCase(morningCallRepo, userRepo, nil, nil, 0)
 
 	// 起床確認を実行
 	beforeConfirm := time.Now()
 	output, err := uc.Execute(ctx, ConfirmWakeInput{
 		MorningCallID: morningCall.ID,
-		ReceiverID:    receiver.ID,
+		ConfirmerID:   receiver.ID,
 	})
 	afterConfirm := time.Now()
 
@@ -481,6 +486,368 @@ func TestConfirmWakeUseCase_Execute_SuccessFlow(t *testing.T) {
 	}
 }
 
+func TestConfirmWakeUseCase_Execute_WithLocation(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		location     *valueobject.GeoPoint
+		share        bool
+		wantErr      bool
+		errMsg       string
+		wantLocation bool
+	}{
+		{
+			name:         "位置情報なしでも確認できる",
+			location:     nil,
+			wantLocation: false,
+		},
+		{
+			name:         "位置情報を送信者に公開",
+			location:     &valueobject.GeoPoint{Latitude: 35.681236, Longitude: 139.767125},
+			share:        true,
+			wantLocation: true,
+		},
+		{
+			name:     "経度が範囲外",
+			location: &valueobject.GeoPoint{Latitude: 35.0, Longitude: 200},
+			wantErr:  true,
+			errMsg:   "経度は-180から180の範囲で指定してください",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			morningCallRepo := memory.NewMorningCallRepository()
+			userRepo := memory.NewUserRepository()
+
+			for _, u := range []*entity.User{
+				{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+				{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+			} {
+				if err := userRepo.Create(ctx, u); err != nil {
+					t.Fatalf("failed to create user: %v", err)
+				}
+			}
+
+			morningCall := &entity.MorningCall{
+				ID:            "mc1",
+				SenderID:      "sender",
+				ReceiverID:    "receiver",
+				ScheduledTime: time.Now().Add(-time.Hour),
+				Status:        valueobject.MorningCallStatusDelivered,
+				CreatedAt:     time.Now().Add(-2 * time.Hour),
+				UpdatedAt:     time.Now().Add(-time.Hour),
+			}
+			if err := morningCallRepo.Create(ctx, morningCall); err != nil {
+				t.Fatalf("failed to create morning call: %v", err)
+			}
+
+			uc := NewConfirmWakeUseCase(morningCallRepo, userRepo, nil, nil, 0)
+			_, err := uc.Execute(ctx, ConfirmWakeInput{
+				MorningCallID: morningCall.ID,
+				ConfirmerID:   "receiver",
+				Location:      tt.location,
+				ShareLocation: tt.share,
+			})
+
+			persisted, findErr := morningCallRepo.FindByID(ctx, morningCall.ID)
+			if findErr != nil {
+				t.Fatalf("failed to get persisted morning call: %v", findErr)
+			}
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error but got nil")
+				}
+				if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("error = %v, want containing %v", err, tt.errMsg)
+				}
+				if persisted.Status != valueobject.MorningCallStatusDelivered {
+					t.Errorf("検証失敗時はステータスを変更すべきでない: %v", persisted.Status)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if persisted.Status != valueobject.MorningCallStatusConfirmed {
+				t.Errorf("persisted Status = %v, want %v", persisted.Status, valueobject.MorningCallStatusConfirmed)
+			}
+			if (persisted.ConfirmLocation != nil) != tt.wantLocation {
+				t.Fatalf("persisted ConfirmLocation = %v, wantLocation %v", persisted.ConfirmLocation, tt.wantLocation)
+			}
+			if tt.wantLocation {
+				if *persisted.ConfirmLocation != *tt.location {
+					t.Errorf("ConfirmLocation = %+v, want %+v", *persisted.ConfirmLocation, *tt.location)
+				}
+				if persisted.ConfirmLocationShared != tt.share {
+					t.Errorf("ConfirmLocationShared = %v, want %v", persisted.ConfirmLocationShared, tt.share)
+				}
+			}
+		})
+	}
+}
+
+func TestConfirmWakeUseCase_Execute_WithStamp(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		stamp   valueobject.Stamp
+		wantErr bool
+	}{
+		{name: "スタンプなしでも確認できる", stamp: ""},
+		{name: "スタンプ付きで確認", stamp: valueobject.StampThankYou},
+		{name: "無効なスタンプ", stamp: valueobject.Stamp("unknown"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			morningCallRepo := memory.NewMorningCallRepository()
+			userRepo := memory.NewUserRepository()
+
+			for _, u := range []*entity.User{
+				{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+				{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+			} {
+				if err := userRepo.Create(ctx, u); err != nil {
+					t.Fatalf("failed to create user: %v", err)
+				}
+			}
+
+			morningCall := &entity.MorningCall{
+				ID:            "mc1",
+				SenderID:      "sender",
+				ReceiverID:    "receiver",
+				ScheduledTime: time.Now().Add(-time.Hour),
+				Status:        valueobject.MorningCallStatusDelivered,
+				CreatedAt:     time.Now().Add(-2 * time.Hour),
+				UpdatedAt:     time.Now().Add(-time.Hour),
+			}
+			if err := morningCallRepo.Create(ctx, morningCall); err != nil {
+				t.Fatalf("failed to create morning call: %v", err)
+			}
+
+			uc := NewConfirmWakeUseCase(morningCallRepo, userRepo, nil, nil, 0)
+			_, err := uc.Execute(ctx, ConfirmWakeInput{
+				MorningCallID: morningCall.ID,
+				ConfirmerID:   "receiver",
+				Stamp:         tt.stamp,
+			})
+
+			persisted, findErr := morningCallRepo.FindByID(ctx, morningCall.ID)
+			if findErr != nil {
+				t.Fatalf("failed to get persisted morning call: %v", findErr)
+			}
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error but got nil")
+				}
+				if persisted.Status != valueobject.MorningCallStatusDelivered {
+					t.Errorf("検証失敗時はステータスを変更すべきでない: %v", persisted.Status)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if persisted.Status != valueobject.MorningCallStatusConfirmed {
+				t.Errorf("persisted Status = %v, want %v", persisted.Status, valueobject.MorningCallStatusConfirmed)
+			}
+			if persisted.Stamp != tt.stamp {
+				t.Errorf("persisted Stamp = %v, want %v", persisted.Stamp, tt.stamp)
+			}
+			if (persisted.StampAt != nil) != (tt.stamp != "") {
+				t.Errorf("persisted StampAt = %v", persisted.StampAt)
+			}
+		})
+	}
+}
+
+func TestConfirmWakeUseCase_Execute_WithConfirmMethod(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		method  valueobject.ConfirmMethod
+		want    valueobject.ConfirmMethod
+		wantErr bool
+	}{
+		{name: "未指定はボタン扱い", method: "", want: valueobject.ConfirmMethodButton},
+		{name: "スワイプで確認", method: valueobject.ConfirmMethodSwipe, want: valueobject.ConfirmMethodSwipe},
+		{name: "シェイクで確認", method: valueobject.ConfirmMethodShake, want: valueobject.ConfirmMethodShake},
+		{name: "無効な確認方法", method: valueobject.ConfirmMethod("voice"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			morningCallRepo := memory.NewMorningCallRepository()
+			userRepo := memory.NewUserRepository()
+
+			for _, u := range []*entity.User{
+				{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+				{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+			} {
+				if err := userRepo.Create(ctx, u); err != nil {
+					t.Fatalf("failed to create user: %v", err)
+				}
+			}
+
+			morningCall := &entity.MorningCall{
+				ID:            "mc1",
+				SenderID:      "sender",
+				ReceiverID:    "receiver",
+				ScheduledTime: time.Now().Add(-time.Hour),
+				Status:        valueobject.MorningCallStatusDelivered,
+				CreatedAt:     time.Now().Add(-2 * time.Hour),
+				UpdatedAt:     time.Now().Add(-time.Hour),
+			}
+			if err := morningCallRepo.Create(ctx, morningCall); err != nil {
+				t.Fatalf("failed to create morning call: %v", err)
+			}
+
+			uc := NewConfirmWakeUseCase(morningCallRepo, userRepo, nil, nil, 0)
+			_, err := uc.Execute(ctx, ConfirmWakeInput{
+				MorningCallID: morningCall.ID,
+				ConfirmerID:   "receiver",
+				ConfirmMethod: tt.method,
+			})
+
+			persisted, findErr := morningCallRepo.FindByID(ctx, morningCall.ID)
+			if findErr != nil {
+				t.Fatalf("failed to get persisted morning call: %v", findErr)
+			}
+
+			if tt.wantErr {
+				var reason valueobject.NGReason
+				if !errors.As(err, &reason) || reason.Field() != "confirm_method" {
+					t.Fatalf("error = %v, want NGReason for confirm_method", err)
+				}
+				if persisted.Status != valueobject.MorningCallStatusDelivered {
+					t.Errorf("検証失敗時はステータスを変更すべきでない: %v", persisted.Status)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if persisted.ConfirmMethod != tt.want {
+				t.Errorf("persisted ConfirmMethod = %q, want %q", persisted.ConfirmMethod, tt.want)
+			}
+			// 配信日時がない場合はアラーム時刻（1時間前）からの所要時間を記録する
+			if persisted.TimeToConfirm == nil || *persisted.TimeToConfirm < time.Hour || *persisted.TimeToConfirm > time.Hour+time.Minute {
+				t.Errorf("persisted TimeToConfirm = %v, want about 1h", persisted.TimeToConfirm)
+			}
+		})
+	}
+}
+
+func TestConfirmWakeUseCase_Execute_RequestNextCall(t *testing.T) {
+	ctx := context.Background()
+	scheduledTime := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name            string
+		confirmerID     string
+		requestNextCall bool
+		selfCall        bool
+		wantErr         string
+		wantRequest     bool
+	}{
+		{name: "リクエストなしでは次回のコールを依頼しない", confirmerID: "receiver"},
+		{name: "確認と同時に翌日同時刻のコールを依頼する", confirmerID: "receiver", requestNextCall: true, wantRequest: true},
+		{name: "代理確認では依頼できない", confirmerID: "proxy", requestNextCall: true, wantErr: "代理確認では次回のコールをリクエストできません"},
+		{name: "セルフモーニングコールでは依頼できない", confirmerID: "sender", requestNextCall: true, selfCall: true, wantErr: "次回のコールのリクエストに失敗しました"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			morningCallRepo := memory.NewMorningCallRepository()
+			userRepo := memory.NewUserRepository()
+			emailSender := mail.NewMemoryEmailSender()
+
+			for _, u := range []*entity.User{
+				{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password", NotifyOnConfirmation: true},
+				{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password", ProxyConfirmerIDs: []string{"proxy"}},
+				{ID: "proxy", Username: "carol", Email: "carol@example.com", PasswordHash: "hashed_password"},
+			} {
+				if err := userRepo.Create(ctx, u); err != nil {
+					t.Fatalf("failed to create user: %v", err)
+				}
+			}
+
+			morningCall := &entity.MorningCall{
+				ID:            "mc1",
+				SenderID:      "sender",
+				ReceiverID:    "receiver",
+				ScheduledTime: scheduledTime,
+				Status:        valueobject.MorningCallStatusDelivered,
+				CreatedAt:     time.Now().Add(-2 * time.Hour),
+				UpdatedAt:     time.Now().Add(-time.Hour),
+			}
+			if tt.selfCall {
+				morningCall.ReceiverID, morningCall.SelfCall = "sender", true
+			}
+			if err := morningCallRepo.Create(ctx, morningCall); err != nil {
+				t.Fatalf("failed to create morning call: %v", err)
+			}
+
+			uc := NewConfirmWakeUseCase(morningCallRepo, userRepo, emailSender, nil, 0)
+			_, err := uc.Execute(ctx, ConfirmWakeInput{
+				MorningCallID:   morningCall.ID,
+				ConfirmerID:     tt.confirmerID,
+				RequestNextCall: tt.requestNextCall,
+			})
+
+			persisted, findErr := morningCallRepo.FindByID(ctx, morningCall.ID)
+			if findErr != nil {
+				t.Fatalf("failed to get persisted morning call: %v", findErr)
+			}
+
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want %s", err, tt.wantErr)
+				}
+				// 依頼できない場合は起床確認も保存しない
+				if persisted.Status != valueobject.MorningCallStatusDelivered || persisted.NextCallRequest != nil {
+					t.Errorf("persisted = %s, %+v, want unchanged", persisted.Status, persisted.NextCallRequest)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if persisted.Status != valueobject.MorningCallStatusConfirmed {
+				t.Errorf("persisted Status = %v, want %v", persisted.Status, valueobject.MorningCallStatusConfirmed)
+			}
+
+			// 依頼の有無で送信者への通知の内容も変わる
+			sent := emailSender.Messages()
+			if len(sent) != 1 {
+				t.Fatalf("sent %d emails, want 1", len(sent))
+			}
+			mentionsRequest := strings.Contains(sent[0].Body, "翌日同時刻")
+			if !tt.wantRequest {
+				if persisted.NextCallRequest != nil || mentionsRequest {
+					t.Errorf("NextCallRequest = %+v, body = %s, want no request", persisted.NextCallRequest, sent[0].Body)
+				}
+				return
+			}
+			if !persisted.HasPendingNextCallRequest() || !persisted.NextCallRequest.RequestedTime.Equal(scheduledTime.AddDate(0, 0, 1)) {
+				t.Errorf("NextCallRequest = %+v, want pending request for the next day", persisted.NextCallRequest)
+			}
+			if !mentionsRequest {
+				t.Errorf("body = %s, want mention of the next call request", sent[0].Body)
+			}
+		})
+	}
+}
+
 func TestConfirmWakeUseCase_Execute_MultipleConfirmAttempts(t *testing.T) {
 	ctx := context.Background()
 
@@ -529,12 +896,12 @@ func TestConfirmWakeUseCase_Execute_MultipleConfirmAttempts(t *testing.T) {
 		t.Fatalf("failed to create morning call: %v", err)
 	}
 
-	uc := NewConfirmWakeUseCase(morningCallRepo, userRepo)
+	uc := NewConfirmWakeUseCase(morningCallRepo, userRepo, nil, nil, 0)
 
 	// 1回目の起床確認（成功すべき）
 	output1, err := uc.Execute(ctx, ConfirmWakeInput{
 		MorningCallID: morningCall.ID,
-		ReceiverID:    receiver.ID,
+		ConfirmerID:   receiver.ID,
 	})
 	if err != nil {
 		t.Errorf("first confirm should succeed: %v", err)
@@ -548,7 +915,7 @@ func TestConfirmWakeUseCase_Execute_MultipleConfirmAttempts(t *testing.T) {
 	// 2回目の起床確認（失敗すべき - すでに確認済み）
 	output2, err := uc.Execute(ctx, ConfirmWakeInput{
 		MorningCallID: morningCall.ID,
-		ReceiverID:    receiver.ID,
+		ConfirmerID:   receiver.ID,
 	})
 	if err == nil {
 		t.Error("second confirm should fail")
@@ -633,13 +1000,13 @@ func TestConfirmWakeUseCase_Execute_MultipleMorningCalls(t *testing.T) {
 		}
 	}
 
-	uc := NewConfirmWakeUseCase(morningCallRepo, userRepo)
+	uc := NewConfirmWakeUseCase(morningCallRepo, userRepo, nil, nil, 0)
 
 	// 各モーニングコールを個別に確認
 	for _, mc := range morningCalls {
 		output, err := uc.Execute(ctx, ConfirmWakeInput{
 			MorningCallID: mc.ID,
-			ReceiverID:    receiver.ID,
+			ConfirmerID:   receiver.ID,
 		})
 
 		if err != nil {
@@ -688,3 +1055,596 @@ func TestConfirmWakeUseCase_Execute_MultipleMorningCalls(t *testing.T) {
 		}
 	}
 }
+
+// barrierMorningCallRepository は最初のFindByIDを指定数そろうまで待機させるリポジトリ
+// 全員が同じ状態を読み取ってから更新する状況を確定的に再現するために使用する
+type barrierMorningCallRepository struct {
+	repository.MorningCallRepository
+	mu      sync.Mutex
+	waiting int
+	ready   chan struct{}
+}
+
+func newBarrierMorningCallRepository(repo repository.MorningCallRepository, n int) *barrierMorningCallRepository {
+	return &barrierMorningCallRepository{
+		MorningCallRepository: repo,
+		waiting:               n,
+		ready:                 make(chan struct{}),
+	}
+}
+
+func (r *barrierMorningCallRepository) FindByID(ctx context.Context, id string) (*entity.MorningCall, error) {
+	mc, err := r.MorningCallRepository.FindByID(ctx, id)
+
+	r.mu.Lock()
+	if r.waiting > 0 {
+		r.waiting--
+		if r.waiting == 0 {
+			close(r.ready)
+		}
+		r.mu.Unlock()
+		<-r.ready
+		return mc, err
+	}
+	r.mu.Unlock()
+	return mc, err
+}
+
+func TestConfirmWakeUseCase_Execute_ConcurrentConfirm(t *testing.T) {
+	ctx := context.Background()
+	const concurrency = 10
+
+	morningCallRepo := memory.NewMorningCallRepository()
+	userRepo := memory.NewUserRepository()
+
+	receiver := &entity.User{
+		ID:           "receiver",
+		Username:     "bob",
+		Email:        "bob@example.com",
+		PasswordHash: "hashed_password",
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+	if err := userRepo.Create(ctx, receiver); err != nil {
+		t.Fatalf("failed to create receiver: %v", err)
+	}
+
+	morningCall := &entity.MorningCall{
+		ID:            "mc1",
+		SenderID:      "sender",
+		ReceiverID:    receiver.ID,
+		ScheduledTime: time.Now().Add(-1 * time.Hour),
+		Message:       "おはよう！",
+		Status:        valueobject.MorningCallStatusDelivered,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	if err := morningCallRepo.Create(ctx, morningCall); err != nil {
+		t.Fatalf("failed to create morning call: %v", err)
+	}
+
+	// 全員が配信済みの状態を読み取ってから保存に進む
+	uc := NewConfirmWakeUseCase(newBarrierMorningCallRepository(morningCallRepo, concurrency), userRepo, nil, nil, 0)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, concurrency)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := uc.Execute(ctx, ConfirmWakeInput{
+				MorningCallID: morningCall.ID,
+				ConfirmerID:   receiver.ID,
+			})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	succeeded := 0
+	for err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		if !strings.Contains(err.Error(), "すでに起床確認済みです") {
+			t.Errorf("unexpected error message: %v", err)
+		}
+	}
+	if succeeded != 1 {
+		t.Errorf("起床確認の成功数 = %d, want 1", succeeded)
+	}
+
+	stored, err := morningCallRepo.FindByID(ctx, morningCall.ID)
+	if err != nil {
+		t.Fatalf("failed to find morning call: %v", err)
+	}
+	if stored.Status != valueobject.MorningCallStatusConfirmed {
+		t.Errorf("expected status to be Confirmed, got %v", stored.Status)
+	}
+	if stored.Version != 1 {
+		t.Errorf("Version = %d, want 1（更新は1回のみのはず）", stored.Version)
+	}
+}
+
+func TestConfirmWakeUseCase_Execute_ConfirmDeadline(t *testing.T) {
+	ctx := context.Background()
+	deadline := time.Date(2025, 1, 1, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		deadline   *time.Time
+		now        time.Time
+		wantErr    string
+		wantStatus valueobject.MorningCallStatus
+	}{
+		{
+			name:       "期限内は確認できる",
+			deadline:   &deadline,
+			now:        deadline.Add(-time.Minute),
+			wantStatus: valueobject.MorningCallStatusConfirmed,
+		},
+		{
+			name:       "期限ちょうどは確認できる",
+			deadline:   &deadline,
+			now:        deadline,
+			wantStatus: valueobject.MorningCallStatusConfirmed,
+		},
+		{
+			name:       "期限後は確認できず期限切れになる",
+			deadline:   &deadline,
+			now:        deadline.Add(time.Nanosecond),
+			wantErr:    "起床確認の期限（2025-01-01T08:00:00Z）を過ぎているため確認できません",
+			wantStatus: valueobject.MorningCallStatusExpired,
+		},
+		{
+			name:       "期限未指定は無期限",
+			deadline:   nil,
+			now:        deadline.Add(365 * 24 * time.Hour),
+			wantStatus: valueobject.MorningCallStatusConfirmed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			morningCallRepo := memory.NewMorningCallRepository()
+			userRepo := memory.NewUserRepository()
+
+			receiver := &entity.User{
+				ID:           "receiver",
+				Username:     "bob",
+				Email:        "bob@example.com",
+				PasswordHash: "hashed_password",
+			}
+			if err := userRepo.Create(ctx, receiver); err != nil {
+				t.Fatalf("failed to create receiver: %v", err)
+			}
+
+			morningCall := &entity.MorningCall{
+				ID:              "mc1",
+				SenderID:        "sender",
+				ReceiverID:      receiver.ID,
+				ScheduledTime:   deadline.Add(-time.Hour),
+				Status:          valueobject.MorningCallStatusDelivered,
+				ConfirmDeadline: tt.deadline,
+				CreatedAt:       deadline.Add(-2 * time.Hour),
+				UpdatedAt:       deadline.Add(-time.Hour),
+			}
+			if err := morningCallRepo.Create(ctx, morningCall); err != nil {
+				t.Fatalf("failed to create morning call: %v", err)
+			}
+
+			uc := NewConfirmWakeUseCase(morningCallRepo, userRepo, nil, nil, 0)
+			uc.now = func() time.Time { return tt.now }
+
+			_, err := uc.Execute(ctx, ConfirmWakeInput{
+				MorningCallID: morningCall.ID,
+				ConfirmerID:   receiver.ID,
+			})
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("error = %v, want %s", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			persisted, err := morningCallRepo.FindByID(ctx, morningCall.ID)
+			if err != nil {
+				t.Fatalf("failed to get persisted morning call: %v", err)
+			}
+			if persisted.Status != tt.wantStatus {
+				t.Errorf("persisted Status = %v, want %v", persisted.Status, tt.wantStatus)
+			}
+		})
+	}
+
+	t.Run("期限切れ後の再確認は期限切れエラー", func(t *testing.T) {
+		morningCallRepo := memory.NewMorningCallRepository()
+		userRepo := memory.NewUserRepository()
+		if err := userRepo.Create(ctx, &entity.User{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"}); err != nil {
+			t.Fatalf("failed to create receiver: %v", err)
+		}
+		if err := morningCallRepo.Create(ctx, &entity.MorningCall{
+			ID:              "mc1",
+			SenderID:        "sender",
+			ReceiverID:      "receiver",
+			ScheduledTime:   deadline.Add(-time.Hour),
+			Status:          valueobject.MorningCallStatusDelivered,
+			ConfirmDeadline: &deadline,
+		}); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+
+		uc := NewConfirmWakeUseCase(morningCallRepo, userRepo, nil, nil, 0)
+		uc.now = func() time.Time { return deadline.Add(time.Hour) }
+
+		input := ConfirmWakeInput{MorningCallID: "mc1", ConfirmerID: "receiver"}
+		if _, err := uc.Execute(ctx, input); err == nil {
+			t.Fatal("expected error but got nil")
+		}
+		_, err := uc.Execute(ctx, input)
+		if err == nil || err.Error() != "期限切れのモーニングコールは起床確認できません" {
+			t.Errorf("error = %v, want 期限切れのモーニングコールは起床確認できません", err)
+		}
+	})
+}
+
+func TestConfirmWakeUseCase_Execute_ProxyConfirmer(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T) (*ConfirmWakeUseCase, *memory.MorningCallRepository) {
+		t.Helper()
+		morningCallRepo := memory.NewMorningCallRepository()
+		userRepo := memory.NewUserRepository()
+
+		receiver := &entity.User{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"}
+		if reason := receiver.AddProxyConfirmer("proxy"); reason.IsNG() {
+			t.Fatalf("failed to add proxy confirmer: %s", reason)
+		}
+		users := []*entity.User{
+			{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+			receiver,
+			{ID: "proxy", Username: "carol", Email: "carol@example.com", PasswordHash: "hashed_password"},
+			{ID: "other", Username: "dave", Email: "dave@example.com", PasswordHash: "hashed_password"},
+		}
+		for _, u := range users {
+			if err := userRepo.Create(ctx, u); err != nil {
+				t.Fatalf("failed to create user: %v", err)
+			}
+		}
+		if err := morningCallRepo.Create(ctx, &entity.MorningCall{
+			ID:            "mc1",
+			SenderID:      "sender",
+			ReceiverID:    "receiver",
+			ScheduledTime: time.Now().Add(-time.Hour),
+			Status:        valueobject.MorningCallStatusDelivered,
+		}); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+		return NewConfirmWakeUseCase(morningCallRepo, userRepo, nil, nil, 0), morningCallRepo
+	}
+
+	t.Run("許可された代理人は起床確認できる", func(t *testing.T) {
+		uc, morningCallRepo := setup(t)
+
+		output, err := uc.Execute(ctx, ConfirmWakeInput{MorningCallID: "mc1", ConfirmerID: "proxy"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.MorningCall.ConfirmedBy != "proxy" || !output.MorningCall.IsProxyConfirmed() {
+			t.Errorf("ConfirmedBy = %q, want proxy", output.MorningCall.ConfirmedBy)
+		}
+
+		saved, _ := morningCallRepo.FindByID(ctx, "mc1")
+		if saved.Status != valueobject.MorningCallStatusConfirmed || saved.ConfirmedBy != "proxy" {
+			t.Errorf("saved = (%s, %q), want (confirmed, proxy)", saved.Status, saved.ConfirmedBy)
+		}
+	})
+
+	t.Run("受信者本人の確認は代理確認として扱わない", func(t *testing.T) {
+		uc, _ := setup(t)
+
+		output, err := uc.Execute(ctx, ConfirmWakeInput{MorningCallID: "mc1", ConfirmerID: "receiver"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.MorningCall.ConfirmedBy != "receiver" || output.MorningCall.IsProxyConfirmed() {
+			t.Errorf("ConfirmedBy = %q, want receiver", output.MorningCall.ConfirmedBy)
+		}
+	})
+
+	t.Run("許可されていないユーザーは確認できない", func(t *testing.T) {
+		uc, morningCallRepo := setup(t)
+
+		for _, confirmerID := range []string{"other", "sender"} {
+			_, err := uc.Execute(ctx, ConfirmWakeInput{MorningCallID: "mc1", ConfirmerID: confirmerID})
+			if err == nil || !strings.Contains(err.Error(), "のみが起床確認できます") {
+				t.Errorf("%s: error = %v, want permission error", confirmerID, err)
+			}
+		}
+
+		saved, _ := morningCallRepo.FindByID(ctx, "mc1")
+		if saved.Status != valueobject.MorningCallStatusDelivered {
+			t.Errorf("Status = %s, want delivered", saved.Status)
+		}
+	})
+
+	t.Run("代理確認では位置情報とスタンプを送れない", func(t *testing.T) {
+		uc, _ := setup(t)
+
+		_, err := uc.Execute(ctx, ConfirmWakeInput{
+			MorningCallID: "mc1",
+			ConfirmerID:   "proxy",
+			Location:      &valueobject.GeoPoint{Latitude: 35.68, Longitude: 139.76},
+		})
+		if err == nil || err.Error() != "代理確認では位置情報を送信できません" {
+			t.Errorf("error = %v, want location error", err)
+		}
+
+		_, err = uc.Execute(ctx, ConfirmWakeInput{
+			MorningCallID: "mc1",
+			ConfirmerID:   "proxy",
+			Stamp:         valueobject.StampHeart,
+		})
+		if err == nil || err.Error() != "代理確認ではスタンプを送信できません" {
+			t.Errorf("error = %v, want stamp error", err)
+		}
+	})
+}
+
+func TestConfirmWakeUseCase_Execute_NotifyOnConfirmation(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		notify       bool
+		wantNotified bool
+	}{
+		{name: "通知を希望する送信者には通知する", notify: true, wantNotified: true},
+		{name: "通知を希望しない送信者には通知しない", notify: false, wantNotified: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			morningCallRepo := memory.NewMorningCallRepository()
+			userRepo := memory.NewUserRepository()
+			emailSender := mail.NewMemoryEmailSender()
+
+			users := []*entity.User{
+				{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password", NotifyOnConfirmation: tt.notify},
+				{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+			}
+			for _, u := range users {
+				if err := userRepo.Create(ctx, u); err != nil {
+					t.Fatalf("failed to create user: %v", err)
+				}
+			}
+			if err := morningCallRepo.Create(ctx, &entity.MorningCall{
+				ID:            "mc1",
+				SenderID:      "sender",
+				ReceiverID:    "receiver",
+				ScheduledTime: time.Now().Add(-time.Hour),
+				Status:        valueobject.MorningCallStatusDelivered,
+			}); err != nil {
+				t.Fatalf("failed to create morning call: %v", err)
+			}
+
+			uc := NewConfirmWakeUseCase(morningCallRepo, userRepo, emailSender, nil, 0)
+			output, err := uc.Execute(ctx, ConfirmWakeInput{MorningCallID: "mc1", ConfirmerID: "receiver"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			// 起床確認は通知の設定に関わらず記録される
+			saved, _ := morningCallRepo.FindByID(ctx, "mc1")
+			if saved.Status != valueobject.MorningCallStatusConfirmed {
+				t.Errorf("Status = %s, want confirmed", saved.Status)
+			}
+
+			if output.SenderNotified != tt.wantNotified {
+				t.Errorf("SenderNotified = %v, want %v", output.SenderNotified, tt.wantNotified)
+			}
+			messages := emailSender.Messages()
+			if !tt.wantNotified {
+				if len(messages) != 0 {
+					t.Errorf("sent %d messages, want 0", len(messages))
+				}
+				return
+			}
+			if len(messages) != 1 {
+				t.Fatalf("sent %d messages, want 1", len(messages))
+			}
+			if messages[0].To != "alice@example.com" || !strings.Contains(messages[0].Body, "bob") {
+				t.Errorf("message = %+v, want notification to alice about bob", messages[0])
+			}
+		})
+	}
+}
+
+func TestConfirmWakeUseCase_Execute_AwardPoints(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T, proxyIDs ...string) (*memory.UserRepository, *audit.MemoryAuditLogger, *ConfirmWakeUseCase) {
+		t.Helper()
+		userRepo := memory.NewUserRepository()
+		morningCallRepo := memory.NewMorningCallRepository()
+		for _, u := range []*entity.User{
+			{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed", Points: 5},
+			{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed", ProxyConfirmerIDs: proxyIDs},
+		} {
+			if err := userRepo.Create(ctx, u); err != nil {
+				t.Fatalf("failed to create user: %v", err)
+			}
+		}
+		if err := morningCallRepo.Create(ctx, &entity.MorningCall{
+			ID:            "mc1",
+			SenderID:      "sender",
+			ReceiverID:    "receiver",
+			ScheduledTime: time.Now().Add(-time.Hour),
+			Status:        valueobject.MorningCallStatusDelivered,
+		}); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+		auditLogger := audit.NewMemoryAuditLogger()
+		return userRepo, auditLogger, NewConfirmWakeUseCase(morningCallRepo, userRepo, nil, auditLogger, 10)
+	}
+
+	t.Run("確認した送信者にポイントを付与し、監査ログに記録する", func(t *testing.T) {
+		userRepo, auditLogger, uc := setup(t)
+
+		output, err := uc.Execute(ctx, ConfirmWakeInput{MorningCallID: "mc1", ConfirmerID: "receiver"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.PointsAwarded != 10 {
+			t.Errorf("PointsAwarded = %d, want 10", output.PointsAwarded)
+		}
+		sender, _ := userRepo.FindByID(ctx, "sender")
+		if sender.Points != 15 {
+			t.Errorf("sender points = %d, want 15", sender.Points)
+		}
+
+		entries := auditLogger.Entries()
+		if len(entries) != 1 {
+			t.Fatalf("audit entries = %d, want 1", len(entries))
+		}
+		if entries[0].Action != "user.points_awarded" || entries[0].TargetID != "sender" || entries[0].Details["total"] != "15" {
+			t.Errorf("unexpected audit entry: %+v", entries[0])
+		}
+	})
+
+	t.Run("二重確認ではポイントを加算しない", func(t *testing.T) {
+		userRepo, auditLogger, uc := setup(t)
+
+		if _, err := uc.Execute(ctx, ConfirmWakeInput{MorningCallID: "mc1", ConfirmerID: "receiver"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := uc.Execute(ctx, ConfirmWakeInput{MorningCallID: "mc1", ConfirmerID: "receiver"}); err == nil {
+			t.Fatal("expected error for second confirmation")
+		}
+		sender, _ := userRepo.FindByID(ctx, "sender")
+		if sender.Points != 15 {
+			t.Errorf("sender points = %d, want 15", sender.Points)
+		}
+		if len(auditLogger.Entries()) != 1 {
+			t.Errorf("audit entries = %d, want 1", len(auditLogger.Entries()))
+		}
+	})
+
+	t.Run("送信者自身が代理で確認した場合は付与しない", func(t *testing.T) {
+		userRepo, _, uc := setup(t, "sender")
+
+		output, err := uc.Execute(ctx, ConfirmWakeInput{MorningCallID: "mc1", ConfirmerID: "sender"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.PointsAwarded != 0 {
+			t.Errorf("PointsAwarded = %d, want 0", output.PointsAwarded)
+		}
+		sender, _ := userRepo.FindByID(ctx, "sender")
+		if sender.Points != 5 {
+			t.Errorf("sender points = %d, want 5", sender.Points)
+		}
+	})
+}
+
+func TestConfirmWakeUseCase_Execute_WakeChallenge(t *testing.T) {
+	ctx := context.Background()
+	challenge := &valueobject.Challenge{Difficulty: valueobject.ChallengeDifficultyEasy, Question: "3 + 4", Answer: 7}
+
+	tests := []struct {
+		name       string
+		challenge  *valueobject.Challenge
+		confirmer  string
+		answer     string
+		wantErr    string
+		wantStatus valueobject.MorningCallStatus
+	}{
+		{
+			name:       "正解なら確認できる",
+			challenge:  challenge,
+			confirmer:  "receiver",
+			answer:     "7",
+			wantStatus: valueobject.MorningCallStatusConfirmed,
+		},
+		{
+			name:       "不正解は確認できない",
+			challenge:  challenge,
+			confirmer:  "receiver",
+			answer:     "8",
+			wantErr:    "起床クイズの回答が正しくありません",
+			wantStatus: valueobject.MorningCallStatusDelivered,
+		},
+		{
+			name:       "未回答は確認できない",
+			challenge:  challenge,
+			confirmer:  "receiver",
+			wantErr:    "起床クイズの回答",
+			wantStatus: valueobject.MorningCallStatusDelivered,
+		},
+		{
+			name:       "代理人も回答が必要",
+			challenge:  challenge,
+			confirmer:  "proxy",
+			wantErr:    "起床クイズの回答",
+			wantStatus: valueobject.MorningCallStatusDelivered,
+		},
+		{
+			name:       "クイズなしは回答なしで確認できる",
+			challenge:  nil,
+			confirmer:  "receiver",
+			wantStatus: valueobject.MorningCallStatusConfirmed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			morningCallRepo := memory.NewMorningCallRepository()
+			userRepo := memory.NewUserRepository()
+
+			receiver := &entity.User{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"}
+			if reason := receiver.AddProxyConfirmer("proxy"); reason.IsNG() {
+				t.Fatalf("failed to add proxy confirmer: %s", reason)
+			}
+			for _, u := range []*entity.User{
+				receiver,
+				{ID: "proxy", Username: "carol", Email: "carol@example.com", PasswordHash: "hashed_password"},
+			} {
+				if err := userRepo.Create(ctx, u); err != nil {
+					t.Fatalf("failed to create user: %v", err)
+				}
+			}
+			if err := morningCallRepo.Create(ctx, &entity.MorningCall{
+				ID:            "mc1",
+				SenderID:      "sender",
+				ReceiverID:    "receiver",
+				ScheduledTime: time.Now().Add(-time.Hour),
+				Status:        valueobject.MorningCallStatusDelivered,
+				WakeChallenge: tt.challenge,
+			}); err != nil {
+				t.Fatalf("failed to create morning call: %v", err)
+			}
+
+			uc := NewConfirmWakeUseCase(morningCallRepo, userRepo, nil, nil, 0)
+			_, err := uc.Execute(ctx, ConfirmWakeInput{
+				MorningCallID:   "mc1",
+				ConfirmerID:     tt.confirmer,
+				ChallengeAnswer: tt.answer,
+			})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("error = %v, want containing %q", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			saved, _ := morningCallRepo.FindByID(ctx, "mc1")
+			if saved.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", saved.Status, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand/v2"
 	"time"
 
 	"github.com/ochamu/morning-call-api/internal/domain/entity"
@@ -17,18 +18,34 @@ type CreateUseCase struct {
 	morningCallRepo  repository.MorningCallRepository
 	userRepo         repository.UserRepository
 	relationshipRepo repository.RelationshipRepository
+	quotas           valueobject.PlanQuotas
+	limits           valueobject.InputLimits
+	systemMessages   valueobject.SystemMessageCatalog
+	scheduleAdjuster ScheduleAdjuster
+	now              func() time.Time
+	intn             func(n int) int // 起床クイズの問題を作る乱数（0以上n未満）
 }
 
 // NewCreateUseCase は新しいモーニングコール作成ユースケースを作成する
+// quotasがnilの場合はプラン別の上限を適用せず、scheduleAdjusterがnilの場合はアラーム時刻を調整しない
 func NewCreateUseCase(
 	morningCallRepo repository.MorningCallRepository,
 	userRepo repository.UserRepository,
 	relationshipRepo repository.RelationshipRepository,
+	quotas valueobject.PlanQuotas,
+	limits valueobject.InputLimits,
+	scheduleAdjuster ScheduleAdjuster,
 ) *CreateUseCase {
 	return &CreateUseCase{
 		morningCallRepo:  morningCallRepo,
 		userRepo:         userRepo,
 		relationshipRepo: relationshipRepo,
+		quotas:           quotas,
+		limits:           limits,
+		systemMessages:   valueobject.DefaultSystemMessageCatalog(),
+		scheduleAdjuster: scheduleAdjuster,
+		now:              time.Now,
+		intn:             rand.IntN,
 	}
 }
 
@@ -37,12 +54,36 @@ type CreateInput struct {
 	SenderID      string
 	ReceiverID    string
 	ScheduledTime time.Time
-	Message       string
+	// オプション：相対指定のアラーム時刻（ScheduledTimeとは排他、受信者のタイムゾーンで絶対時刻に変換する）
+	RelativeSchedule *valueobject.RelativeSchedule
+	Message          string
+	// オプション：システムが用意した定型文のID（Messageとは排他、指定した場合は定型文の本文をメッセージにする）
+	SystemMessageID string
+	// オプション：定型文の言語コード（未指定・未対応の場合は日本語）
+	SystemMessageLanguage string
+	// オプション：起床確認の期限（nilは無期限）
+	ConfirmDeadline *time.Time
+	// オプション：シリーズとしてまとめて作成する場合のシリーズID
+	SeriesID string
+	// オプション：送信者自身に設定するセルフモーニングコールか（受信者を省略した場合は送信者自身になる）
+	SelfCall bool
+	// オプション：起床クイズの難易度（指定した場合は受信者が計算問題に正解しないと起床確認できない）
+	ChallengeDifficulty valueobject.ChallengeDifficulty
+	// オプション：A/Bテスト用のメッセージバリアント（Message・SystemMessageIDとは排他、選ばれた1つをメッセージとして配信する）
+	MessageVariants []string
+	// オプション：メッセージバリアントの選び方（未指定はランダム）
+	MessageVariantStrategy valueobject.MessageVariantStrategy
 }
 
 // CreateOutput はモーニングコール作成の出力データ
 type CreateOutput struct {
 	MorningCall *entity.MorningCall
+	// AwaitingApproval は受信者の承認待ちになったか（falseの場合は即時スケジュール済み）
+	AwaitingApproval bool
+	// ApprovalDeadline は承認待ちの場合に、配信されるために承認が必要な期限（アラーム時刻）
+	ApprovalDeadline *time.Time
+	// ScheduleAdjustment は天気などに応じて指定された時刻からずらした幅（負の値は早めた、調整なしは0）
+	ScheduleAdjustment time.Duration
 }
 
 // Execute はモーニングコールを作成する
@@ -51,15 +92,45 @@ func (uc *CreateUseCase) Execute(ctx context.Context, input CreateInput) (*Creat
 	if input.SenderID == "" {
 		return nil, fmt.Errorf("送信者IDは必須です")
 	}
+	if input.SelfCall && input.ReceiverID == "" {
+		input.ReceiverID = input.SenderID
+	}
 	if input.ReceiverID == "" {
 		return nil, fmt.Errorf("受信者IDは必須です")
 	}
-	if input.SenderID == input.ReceiverID {
+	if input.SelfCall && input.SenderID != input.ReceiverID {
+		return nil, fmt.Errorf("セルフモーニングコールの受信者は送信者自身である必要があります")
+	}
+	// 自分自身へのコールはセルフモーニングコールとして明示した場合のみ許可する
+	if !input.SelfCall && input.SenderID == input.ReceiverID {
 		return nil, fmt.Errorf("自分自身にモーニングコールを設定することはできません")
 	}
-	if input.ScheduledTime.IsZero() {
+	if input.RelativeSchedule != nil && !input.ScheduledTime.IsZero() {
+		return nil, fmt.Errorf("スケジュール時刻と相対指定は同時に指定できません")
+	}
+	if input.ScheduledTime.IsZero() && input.RelativeSchedule == nil {
 		return nil, fmt.Errorf("スケジュール時刻は必須です")
 	}
+	if input.SystemMessageID != "" {
+		if input.Message != "" {
+			return nil, fmt.Errorf("メッセージと定型文IDは同時に指定できません")
+		}
+		message, ok := uc.systemMessages.Find(input.SystemMessageID)
+		if !ok {
+			return nil, fmt.Errorf("定型文の指定が不正です: %w",
+				valueobject.NGWithCode(valueobject.ReasonCodeInvalid, "system_message_id", "指定された定型文は存在しません"))
+		}
+		input.Message, _ = message.Text(input.SystemMessageLanguage)
+	}
+	if len(input.MessageVariants) > 0 {
+		if input.Message != "" || input.SystemMessageID != "" {
+			return nil, fmt.Errorf("メッセージとメッセージバリアントは同時に指定できません")
+		}
+		if !input.MessageVariantStrategy.OrDefault().IsValid() {
+			return nil, fmt.Errorf("メッセージバリアントの選び方が不正です: %w",
+				valueobject.NGWithCode(valueobject.ReasonCodeInvalid, "message_variant_strategy", "メッセージバリアントの選び方はrandomまたはround_robinを指定してください"))
+		}
+	}
 
 	// 送信者の存在確認
 	sender, err := uc.userRepo.FindByID(ctx, input.SenderID)
@@ -78,25 +149,45 @@ func (uc *CreateUseCase) Execute(ctx context.Context, input CreateInput) (*Creat
 		}
 		return nil, fmt.Errorf("受信者の確認中にエラーが発生しました: %w", err)
 	}
-
-	// 友達関係の確認
-	areFriends, err := uc.relationshipRepo.AreFriends(ctx, input.SenderID, input.ReceiverID)
-	if err != nil {
-		return nil, fmt.Errorf("友達関係の確認中にエラーが発生しました: %w", err)
-	}
-	if !areFriends {
-		return nil, fmt.Errorf("友達関係にないユーザーにはモーニングコールを設定できません")
+	// 削除予定のアカウントは猶予期間の経過後に関連データごと削除されるため、新しいコールを設定させない
+	if receiver.IsPendingDeletion() {
+		return nil, fmt.Errorf("受信者はアカウントの削除を予定しているため、モーニングコールを設定できません")
 	}
 
-	// ブロック状態の確認
-	isBlocked, err := uc.relationshipRepo.IsBlocked(ctx, input.SenderID, input.ReceiverID)
-	if err != nil {
-		return nil, fmt.Errorf("ブロック状態の確認中にエラーが発生しました: %w", err)
+	// 相対指定のアラーム時刻は受信者のタイムゾーンで絶対時刻に変換し、以降は絶対時刻と同じ検証を適用する
+	if input.RelativeSchedule != nil {
+		input.ScheduledTime = input.RelativeSchedule.Resolve(uc.now(), receiver.Location())
 	}
-	if isBlocked {
-		return nil, fmt.Errorf("ブロックされているユーザーにはモーニングコールを設定できません")
+
+	// 友達関係・ブロック状態・受信時間帯・不在時の転送は他のユーザーからのコールに関するものなので、
+	// セルフモーニングコールでは確認しない
+	forwardedFrom := ""
+	if !input.SelfCall {
+		if err := uc.checkRelationship(ctx, input.SenderID, input.ReceiverID); err != nil {
+			return nil, err
+		}
+
+		// 受信者が不在時の転送を設定している場合は転送先に届け、以降の確認は転送先に対して行う
+		forwardTo, err := uc.resolveForwarding(ctx, input.SenderID, receiver, input.ScheduledTime)
+		if err != nil {
+			return nil, err
+		}
+		if forwardTo != nil {
+			forwardedFrom = receiver.ID
+			receiver = forwardTo
+			input.ReceiverID = forwardTo.ID
+		}
+
+		// 受信者が受け付ける曜日・時間帯の確認
+		if err := uc.checkCallWindow(ctx, receiver, input.SenderID, input.ScheduledTime); err != nil {
+			return nil, err
+		}
 	}
 
+	// 天気などの外部データに応じてアラーム時刻を調整する
+	requestedTime := input.ScheduledTime
+	input.ScheduledTime = uc.adjustSchedule(ctx, receiver, input.SenderID, input.SelfCall, input.ScheduledTime)
+
 	// 同じユーザーペアで既にアクティブなモーニングコールがないか確認
 	activeCalls, err := uc.morningCallRepo.FindActiveByUserPair(ctx, input.SenderID, input.ReceiverID)
 	if err != nil {
@@ -115,6 +206,11 @@ func (uc *CreateUseCase) Execute(ctx context.Context, input CreateInput) (*Creat
 		}
 	}
 
+	// プラン別クォータの確認
+	if err := uc.checkQuota(ctx, sender, time.Now()); err != nil {
+		return nil, err
+	}
+
 	// UUIDを生成
 	id, err := utils.GenerateUUID()
 	if err != nil {
@@ -132,11 +228,39 @@ func (uc *CreateUseCase) Execute(ctx context.Context, input CreateInput) (*Creat
 		Status:        valueobject.MorningCallStatusScheduled,
 		CreatedAt:     now,
 		UpdatedAt:     now,
+		SeriesID:      input.SeriesID,
+		SelfCall:      input.SelfCall,
+		ForwardedFrom: forwardedFrom,
+	}
+	// 受信者が事前承認制を有効にしている場合は承認されるまで配信しない（セルフモーニングコールは承認不要）
+	if receiver.RequireCallApproval && !morningCall.SelfCall {
+		morningCall.Status = valueobject.MorningCallStatusPendingApproval
+	}
+	if input.ConfirmDeadline != nil {
+		deadline := *input.ConfirmDeadline
+		morningCall.ConfirmDeadline = &deadline
+	}
+	if input.ChallengeDifficulty != "" {
+		challenge, reason := valueobject.NewChallenge(input.ChallengeDifficulty, uc.intn)
+		if reason.IsNG() {
+			return nil, fmt.Errorf("起床クイズの作成に失敗しました: %w", reason)
+		}
+		morningCall.WakeChallenge = challenge
+	}
+	// A/Bテスト用のメッセージバリアントから配信する1つを選ぶ（バリアントを設定できるのはコールを作成する送信者のみ）
+	if len(input.MessageVariants) > 0 {
+		index, err := uc.selectMessageVariant(ctx, sender.ID, input.MessageVariants, input.MessageVariantStrategy.OrDefault())
+		if err != nil {
+			return nil, err
+		}
+		if reason := morningCall.SetMessageVariants(input.MessageVariants, index, uc.limits); reason.IsNG() {
+			return nil, fmt.Errorf("メッセージバリアントが不正です: %w", reason)
+		}
 	}
 
 	// ドメイン検証
-	if reason := morningCall.Validate(); reason != "" {
-		return nil, fmt.Errorf("モーニングコールの検証に失敗しました: %s", reason)
+	if reason := morningCall.Validate(uc.limits); reason.IsNG() {
+		return nil, fmt.Errorf("モーニングコールの検証に失敗しました: %w", reason)
 	}
 
 	// リポジトリに保存
@@ -144,7 +268,171 @@ func (uc *CreateUseCase) Execute(ctx context.Context, input CreateInput) (*Creat
 		return nil, fmt.Errorf("モーニングコールの作成に失敗しました: %w", err)
 	}
 
-	return &CreateOutput{
-		MorningCall: morningCall,
-	}, nil
+	output := &CreateOutput{
+		MorningCall:        morningCall,
+		ScheduleAdjustment: morningCall.ScheduledTime.Sub(requestedTime),
+	}
+	// 承認待ちのコールはアラーム時刻までに承認されないと配信されない
+	if morningCall.Status == valueobject.MorningCallStatusPendingApproval {
+		deadline := morningCall.ScheduledTime
+		output.AwaitingApproval = true
+		output.ApprovalDeadline = &deadline
+	}
+	return output, nil
+}
+
+// checkRelationship は送信者と受信者が友達関係にあり、ブロックされていないことを確認する
+func (uc *CreateUseCase) checkRelationship(ctx context.Context, senderID, receiverID string) error {
+	// 友達関係の確認
+	areFriends, err := uc.relationshipRepo.AreFriends(ctx, senderID, receiverID)
+	if err != nil {
+		return fmt.Errorf("友達関係の確認中にエラーが発生しました: %w", err)
+	}
+	if !areFriends {
+		return fmt.Errorf("友達関係にないユーザーにはモーニングコールを設定できません")
+	}
+
+	// ブロック状態の確認
+	isBlocked, err := uc.relationshipRepo.IsBlocked(ctx, senderID, receiverID)
+	if err != nil {
+		return fmt.Errorf("ブロック状態の確認中にエラーが発生しました: %w", err)
+	}
+	if isBlocked {
+		return fmt.Errorf("ブロックされているユーザーにはモーニングコールを設定できません")
+	}
+	return nil
+}
+
+// resolveForwarding は予定時刻に受信者の不在時の転送が有効な場合に転送先のユーザーを返す（転送しない場合はnil）
+// 転送先が送信者自身・送信者の友達でない・ブロック関係にある・削除予定の場合は転送せず、元の受信者に届ける
+// 転送先がさらに転送を設定していても辿らない（1回だけ転送するため転送がループしない）
+func (uc *CreateUseCase) resolveForwarding(ctx context.Context, senderID string, receiver *entity.User, scheduledTime time.Time) (*entity.User, error) {
+	targetID, ok := receiver.CallForwardingTarget(scheduledTime)
+	if !ok || targetID == senderID || targetID == receiver.ID {
+		return nil, nil
+	}
+
+	areFriends, err := uc.relationshipRepo.AreFriends(ctx, senderID, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("転送先との友達関係の確認中にエラーが発生しました: %w", err)
+	}
+	if !areFriends {
+		return nil, nil
+	}
+	isBlocked, err := uc.relationshipRepo.IsBlocked(ctx, senderID, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("転送先のブロック状態の確認中にエラーが発生しました: %w", err)
+	}
+	if isBlocked {
+		return nil, nil
+	}
+
+	target, err := uc.userRepo.FindByID(ctx, targetID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("転送先の確認中にエラーが発生しました: %w", err)
+	}
+	if target.IsPendingDeletion() {
+		return nil, nil
+	}
+	return target, nil
+}
+
+// checkCallWindow は予定時刻が受信者の受け付ける曜日・時間帯に含まれるかを確認する
+// 送信者との友達関係に受信者の設定がある場合はそれを優先し、ない場合は受信者全体の設定に従う
+// どちらも設定されていない場合は制限しない
+func (uc *CreateUseCase) checkCallWindow(ctx context.Context, receiver *entity.User, senderID string, scheduledTime time.Time) error {
+	window := receiver.CallWindow
+	relationship, err := uc.relationshipRepo.FindByUserPair(ctx, receiver.ID, senderID)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return fmt.Errorf("受信時間帯の確認中にエラーが発生しました: %w", err)
+	}
+	if relationship != nil {
+		if friendWindow := relationship.CallWindowFor(receiver.ID); friendWindow != nil {
+			window = friendWindow
+		}
+	}
+
+	if window != nil && !window.Allows(scheduledTime, receiver.Location()) {
+		return fmt.Errorf("受信者がこの曜日・時間帯のモーニングコールを受け付けていません")
+	}
+	return nil
+}
+
+// adjustSchedule はフックでアラーム時刻を調整する
+// 調整後の時刻が過去になる場合や、受信者の受け付ける曜日・時間帯から外れる場合は調整しない
+func (uc *CreateUseCase) adjustSchedule(ctx context.Context, receiver *entity.User, senderID string, selfCall bool, scheduledTime time.Time) time.Time {
+	if uc.scheduleAdjuster == nil {
+		return scheduledTime
+	}
+
+	adjusted := uc.scheduleAdjuster.Adjust(ctx, receiver, scheduledTime)
+	if adjusted.Equal(scheduledTime) || !adjusted.After(uc.now()) {
+		return scheduledTime
+	}
+	if !selfCall {
+		if err := uc.checkCallWindow(ctx, receiver, senderID, adjusted); err != nil {
+			return scheduledTime
+		}
+	}
+	return adjusted
+}
+
+// selectMessageVariant は配信するメッセージバリアントの番号を選ぶ
+// ランダムの場合は乱数で選び、順番の場合は同じバリアントで送ったコールの数に応じて先頭から順に選ぶ
+func (uc *CreateUseCase) selectMessageVariant(ctx context.Context, senderID string, variants []string, strategy valueobject.MessageVariantStrategy) (int, error) {
+	if strategy != valueobject.MessageVariantStrategyRoundRobin {
+		return uc.intn(len(variants)), nil
+	}
+
+	count := 0
+	err := forEachSentCall(ctx, uc.morningCallRepo, senderID, func(call entity.ReadOnlyMorningCall) {
+		if call.HasMessageVariants(variants) {
+			count++
+		}
+	})
+	if err != nil {
+		return 0, fmt.Errorf("送信済みモーニングコールの確認中にエラーが発生しました: %w", err)
+	}
+	return count % len(variants), nil
+}
+
+// checkQuota は送信者のプランに応じた作成上限を確認する
+func (uc *CreateUseCase) checkQuota(ctx context.Context, sender *entity.User, now time.Time) error {
+	if uc.quotas == nil {
+		return nil
+	}
+
+	plan := sender.EffectivePlan()
+	quota := uc.quotas.For(plan)
+	if quota.MaxActiveCalls <= 0 && quota.MaxDailyCalls <= 0 {
+		return nil
+	}
+
+	// 当日（ローカル時刻の0時以降）に作成した件数とアクティブな件数を数える
+	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	activeCount := 0
+	dailyCount := 0
+	err := forEachSentCall(ctx, uc.morningCallRepo, sender.ID, func(call entity.ReadOnlyMorningCall) {
+		if call.IsActive() {
+			activeCount++
+		}
+		if !call.CreatedAt().Before(startOfDay) {
+			dailyCount++
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("送信済みモーニングコールの確認中にエラーが発生しました: %w", err)
+	}
+
+	if valueobject.IsLimitReached(quota.MaxActiveCalls, activeCount) {
+		return fmt.Errorf("%s", valueobject.QuotaExceededMessage(plan, "アクティブなモーニングコール数", quota.MaxActiveCalls))
+	}
+	if valueobject.IsLimitReached(quota.MaxDailyCalls, dailyCount) {
+		return fmt.Errorf("%s", valueobject.QuotaExceededMessage(plan, "1日あたりのモーニングコール作成数", quota.MaxDailyCalls))
+	}
+
+	return nil
 }
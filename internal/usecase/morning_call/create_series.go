@@ -0,0 +1,141 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/pkg/utils"
+)
+
+// CreateSeriesUseCase は期間と曜日の指定から複数のモーニングコールをまとめて作成するユースケース
+type CreateSeriesUseCase struct {
+	createUseCase *CreateUseCase
+	userRepo      repository.UserRepository
+}
+
+// NewCreateSeriesUseCase は新しいシリーズ作成ユースケースを作成する
+// 各コールの作成には単独の作成と同じ検証（30日以内・友達関係・受信時間帯・プラン別の上限）を適用する
+func NewCreateSeriesUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	userRepo repository.UserRepository,
+	relationshipRepo repository.RelationshipRepository,
+	quotas valueobject.PlanQuotas,
+	limits valueobject.InputLimits,
+) *CreateSeriesUseCase {
+	return &CreateSeriesUseCase{
+		// シリーズの各コールは天気予報の対象期間外になることが多いため、天気による調整は行わない
+		createUseCase: NewCreateUseCase(morningCallRepo, userRepo, relationshipRepo, quotas, limits, nil),
+		userRepo:      userRepo,
+	}
+}
+
+// CreateSeriesInput はシリーズ作成の入力データ
+type CreateSeriesInput struct {
+	SenderID   string
+	ReceiverID string
+	Schedule   *valueobject.SeriesSchedule // 必須：期間・曜日・時刻（受信者のタイムゾーンで解釈する）
+	Message    string
+}
+
+// SeriesFailure はシリーズのうち作成できなかったコールの情報
+type SeriesFailure struct {
+	ScheduledTime time.Time
+	Reason        string
+}
+
+// CreateSeriesOutput はシリーズ作成の出力データ
+type CreateSeriesOutput struct {
+	SeriesID     string
+	MorningCalls []*entity.MorningCall // 作成できたコール（アラーム時刻の昇順）
+	Failures     []SeriesFailure       // 作成できなかったコール
+}
+
+// Execute は期間内の指定曜日ごとにモーニングコールを作成し、共通のシリーズIDで関連付ける
+//
+// 部分的に失敗した場合は作成できたコールをシリーズとして残し、作成できなかった日時と理由をFailuresで返す
+// 1件も作成できなかった場合は最初のエラーをそのまま返す
+func (uc *CreateSeriesUseCase) Execute(ctx context.Context, input CreateSeriesInput) (*CreateSeriesOutput, error) {
+	// 入力値の基本検証
+	if input.SenderID == "" {
+		return nil, fmt.Errorf("送信者IDは必須です")
+	}
+	if input.ReceiverID == "" {
+		return nil, fmt.Errorf("受信者IDは必須です")
+	}
+	if input.Schedule == nil {
+		return nil, fmt.Errorf("シリーズのスケジュールは必須です")
+	}
+	if reason := input.Schedule.Validate(); reason.IsNG() {
+		return nil, fmt.Errorf("シリーズのスケジュールが不正です: %w", reason)
+	}
+
+	// 日付と時刻は受信者のタイムゾーンで解釈する
+	receiver, err := uc.userRepo.FindByID(ctx, input.ReceiverID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("受信者が見つかりません")
+		}
+		return nil, fmt.Errorf("受信者の確認中にエラーが発生しました: %w", err)
+	}
+
+	occurrences := input.Schedule.Occurrences(receiver.Location())
+	if len(occurrences) == 0 {
+		return nil, fmt.Errorf("指定した期間に該当する曜日がありません")
+	}
+
+	seriesID, err := utils.GenerateUUID()
+	if err != nil {
+		return nil, fmt.Errorf("ID生成に失敗しました: %w", err)
+	}
+
+	output := &CreateSeriesOutput{
+		SeriesID:     seriesID,
+		MorningCalls: []*entity.MorningCall{},
+		Failures:     []SeriesFailure{},
+	}
+	var firstErr error
+	for _, scheduledTime := range occurrences {
+		created, err := uc.createUseCase.Execute(ctx, CreateInput{
+			SenderID:      input.SenderID,
+			ReceiverID:    input.ReceiverID,
+			ScheduledTime: scheduledTime,
+			Message:       input.Message,
+			SeriesID:      seriesID,
+		})
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			output.Failures = append(output.Failures, SeriesFailure{
+				ScheduledTime: scheduledTime,
+				Reason:        seriesFailureReason(ctx, err),
+			})
+			continue
+		}
+		output.MorningCalls = append(output.MorningCalls, created.MorningCall)
+	}
+
+	if len(output.MorningCalls) == 0 {
+		return nil, firstErr
+	}
+	return output, nil
+}
+
+// seriesFailureReason は作成できなかった理由として利用者に返すメッセージを決める
+// 技術的なエラーは内部の状態を見せないよう定型文にする
+func seriesFailureReason(ctx context.Context, err error) string {
+	var reason valueobject.NGReason
+	if errors.As(err, &reason) && reason.IsNG() {
+		return err.Error()
+	}
+	if errors.Unwrap(err) != nil {
+		utils.Logf(ctx, "シリーズのモーニングコールの作成に失敗しました: %v", err)
+		return "作成に失敗しました"
+	}
+	return err.Error()
+}
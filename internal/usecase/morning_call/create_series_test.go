@@ -0,0 +1,172 @@
+package morning_call
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestCreateSeriesUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	today := time.Now().UTC()
+
+	// date は今日からdays日後の日付を "YYYY-MM-DD" 形式で返す
+	date := func(days int) string {
+		return today.AddDate(0, 0, days).Format("2006-01-02")
+	}
+
+	setup := func(t *testing.T, quotas valueobject.PlanQuotas, friends bool) (*CreateSeriesUseCase, *memory.MorningCallRepository) {
+		t.Helper()
+		morningCallRepo := memory.NewMorningCallRepository()
+		userRepo := memory.NewUserRepository()
+		relationshipRepo := memory.NewRelationshipRepository()
+
+		for _, u := range []*entity.User{
+			{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+			{ID: "user2", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password", TimeZone: "UTC"},
+		} {
+			if err := userRepo.Create(ctx, u); err != nil {
+				t.Fatalf("failed to create user: %v", err)
+			}
+		}
+		if friends {
+			if err := relationshipRepo.Create(ctx, &entity.Relationship{
+				ID:          "rel1",
+				RequesterID: "user1",
+				ReceiverID:  "user2",
+				Status:      valueobject.RelationshipStatusAccepted,
+			}); err != nil {
+				t.Fatalf("failed to create friendship: %v", err)
+			}
+		}
+
+		return NewCreateSeriesUseCase(morningCallRepo, userRepo, relationshipRepo, quotas, valueobject.DefaultInputLimits()), morningCallRepo
+	}
+
+	parse := func(t *testing.T, start, end string, weekdays []string, at string) *valueobject.SeriesSchedule {
+		t.Helper()
+		schedule, reason := valueobject.ParseSeriesSchedule(start, end, weekdays, at)
+		if reason.IsNG() {
+			t.Fatalf("ParseSeriesSchedule() = %v", reason)
+		}
+		return schedule
+	}
+
+	t.Run("指定曜日ごとにコールを作成し共通のシリーズIDで関連付ける", func(t *testing.T) {
+		uc, morningCallRepo := setup(t, nil, true)
+		schedule := parse(t, date(1), date(14), []string{"mon", "tue", "wed", "thu", "fri"}, "07:00")
+
+		output, err := uc.Execute(ctx, CreateSeriesInput{SenderID: "user1", ReceiverID: "user2", Schedule: schedule, Message: "おはよう"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(output.MorningCalls) != 10 || len(output.Failures) != 0 {
+			t.Fatalf("created = %d, failures = %d, want 10 and 0", len(output.MorningCalls), len(output.Failures))
+		}
+
+		ids := make(map[string]bool)
+		for _, mc := range output.MorningCalls {
+			if mc.SeriesID != output.SeriesID {
+				t.Errorf("SeriesID = %s, want %s", mc.SeriesID, output.SeriesID)
+			}
+			if day := mc.ScheduledTime.Weekday(); day == time.Saturday || day == time.Sunday {
+				t.Errorf("weekend call created: %v", mc.ScheduledTime)
+			}
+			if h, m := mc.ScheduledTime.Hour(), mc.ScheduledTime.Minute(); h != 7 || m != 0 {
+				t.Errorf("ScheduledTime = %v, want 07:00", mc.ScheduledTime)
+			}
+			ids[mc.ID] = true
+		}
+		if len(ids) != 10 {
+			t.Errorf("each call should have its own ID: %d unique", len(ids))
+		}
+
+		saved, err := morningCallRepo.FindBySeriesID(ctx, output.SeriesID)
+		if err != nil || len(saved) != 10 {
+			t.Errorf("FindBySeriesID() = %d calls, %v", len(saved), err)
+		}
+	})
+
+	t.Run("30日を超える日は作成せず失敗として返す", func(t *testing.T) {
+		uc, _ := setup(t, nil, true)
+		// 28〜30日後は作成でき、31〜33日後は30日制約で作成できない
+		schedule := parse(t, date(28), date(33), nil, "00:00")
+
+		output, err := uc.Execute(ctx, CreateSeriesInput{SenderID: "user1", ReceiverID: "user2", Schedule: schedule})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(output.MorningCalls) != 3 || len(output.Failures) != 3 {
+			t.Fatalf("created = %d, failures = %d, want 3 and 3", len(output.MorningCalls), len(output.Failures))
+		}
+		for _, f := range output.Failures {
+			if !strings.Contains(f.Reason, "30日以内") {
+				t.Errorf("failure reason = %s, want 30-day limit", f.Reason)
+			}
+		}
+	})
+
+	t.Run("上限クォータに達した後のコールは失敗として返す", func(t *testing.T) {
+		quotas := valueobject.PlanQuotas{
+			valueobject.PlanFree: {MaxActiveCalls: 3, MaxDailyCalls: 10},
+		}
+		uc, _ := setup(t, quotas, true)
+		schedule := parse(t, date(1), date(5), nil, "07:00")
+
+		output, err := uc.Execute(ctx, CreateSeriesInput{SenderID: "user1", ReceiverID: "user2", Schedule: schedule})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(output.MorningCalls) != 3 || len(output.Failures) != 2 {
+			t.Fatalf("created = %d, failures = %d, want 3 and 2", len(output.MorningCalls), len(output.Failures))
+		}
+		// 作成できたのは先頭の日から
+		if !output.MorningCalls[2].ScheduledTime.Before(output.Failures[0].ScheduledTime) {
+			t.Errorf("calls should be created in chronological order")
+		}
+		if !strings.Contains(output.Failures[0].Reason, "アクティブなモーニングコール数の上限") {
+			t.Errorf("failure reason = %s, want quota", output.Failures[0].Reason)
+		}
+	})
+
+	t.Run("1件も作成できない場合はエラーを返す", func(t *testing.T) {
+		uc, morningCallRepo := setup(t, nil, false)
+		schedule := parse(t, date(1), date(3), nil, "07:00")
+
+		_, err := uc.Execute(ctx, CreateSeriesInput{SenderID: "user1", ReceiverID: "user2", Schedule: schedule})
+		if err == nil || !strings.Contains(err.Error(), "友達関係にないユーザー") {
+			t.Errorf("error = %v, want not friends", err)
+		}
+		if count, _ := morningCallRepo.Count(ctx); count != 0 {
+			t.Errorf("Count() = %d, want 0", count)
+		}
+	})
+
+	t.Run("該当する曜日がない期間", func(t *testing.T) {
+		uc, _ := setup(t, nil, true)
+		// 1日だけの期間で、その日と異なる曜日を指定する
+		day := today.AddDate(0, 0, 1)
+		other := strings.ToLower(day.AddDate(0, 0, 1).Weekday().String()[:3])
+		schedule := parse(t, date(1), date(1), []string{other}, "07:00")
+
+		_, err := uc.Execute(ctx, CreateSeriesInput{SenderID: "user1", ReceiverID: "user2", Schedule: schedule})
+		if err == nil || err.Error() != "指定した期間に該当する曜日がありません" {
+			t.Errorf("error = %v, want no occurrences", err)
+		}
+	})
+
+	t.Run("受信者が見つからない", func(t *testing.T) {
+		uc, _ := setup(t, nil, true)
+		schedule := parse(t, date(1), date(3), nil, "07:00")
+
+		_, err := uc.Execute(ctx, CreateSeriesInput{SenderID: "user1", ReceiverID: "unknown", Schedule: schedule})
+		if err == nil || err.Error() != "受信者が見つかりません" {
+			t.Errorf("error = %v, want receiver not found", err)
+		}
+	})
+}
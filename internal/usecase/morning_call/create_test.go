@@ -2,12 +2,15 @@ package morning_call
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/ochamu/morning-call-api/internal/domain/entity"
 	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/external"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/mail"
 	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
 )
 
@@ -16,7 +19,7 @@ func TestNewCreateUseCase(t *testing.T) {
 	userRepo := memory.NewUserRepository()
 	relationshipRepo := memory.NewRelationshipRepository()
 
-	uc := NewCreateUseCase(morningCallRepo, userRepo, relationshipRepo)
+	uc := NewCreateUseCase(morningCallRepo, userRepo, relationshipRepo, nil, valueobject.DefaultInputLimits(), nil)
 
 	if uc == nil {
 		t.Fatal("NewCreateUseCase returned nil")
@@ -105,6 +108,8 @@ func TestCreateUseCase_Execute(t *testing.T) {
 
 	// 将来の時刻を設定
 	futureTime := time.Now().Add(24 * time.Hour)
+	validDeadline := futureTime.Add(6 * time.Hour)
+	invalidDeadline := futureTime.Add(7*time.Hour - time.Minute)
 
 	tests := []struct {
 		name    string
@@ -231,6 +236,29 @@ func TestCreateUseCase_Execute(t *testing.T) {
 			wantErr: true,
 			errMsg:  "アラーム時刻は30日以内で設定してください",
 		},
+		{
+			name: "起床確認期限付きの作成",
+			input: CreateInput{
+				SenderID:        user1.ID,
+				ReceiverID:      user2.ID,
+				ScheduledTime:   futureTime.Add(5 * time.Hour), // 他のテストケースと時刻をずらす
+				Message:         "期限内に確認してね",
+				ConfirmDeadline: &validDeadline,
+			},
+			wantErr: false,
+		},
+		{
+			name: "起床確認期限がアラーム時刻より前",
+			input: CreateInput{
+				SenderID:        user1.ID,
+				ReceiverID:      user2.ID,
+				ScheduledTime:   futureTime.Add(7 * time.Hour),
+				Message:         "テストメッセージ",
+				ConfirmDeadline: &invalidDeadline,
+			},
+			wantErr: true,
+			errMsg:  "起床確認の期限はアラーム時刻より後である必要があります",
+		},
 		{
 			name: "メッセージが長すぎる",
 			input: CreateInput{
@@ -247,7 +275,7 @@ func TestCreateUseCase_Execute(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// 各テストケースで新しいUseCaseインスタンスを作成
-			uc := NewCreateUseCase(morningCallRepo, userRepo, relationshipRepo)
+			uc := NewCreateUseCase(morningCallRepo, userRepo, relationshipRepo, nil, valueobject.DefaultInputLimits(), nil)
 			output, err := uc.Execute(ctx, tt.input)
 
 			if tt.wantErr {
@@ -286,6 +314,10 @@ func TestCreateUseCase_Execute(t *testing.T) {
 						if mc.Status != valueobject.MorningCallStatusScheduled {
 							t.Errorf("MorningCall.Status = %v, want %v", mc.Status, valueobject.MorningCallStatusScheduled)
 						}
+						if (mc.ConfirmDeadline == nil) != (tt.input.ConfirmDeadline == nil) ||
+							(mc.ConfirmDeadline != nil && !mc.ConfirmDeadline.Equal(*tt.input.ConfirmDeadline)) {
+							t.Errorf("MorningCall.ConfirmDeadline = %v, want %v", mc.ConfirmDeadline, tt.input.ConfirmDeadline)
+						}
 					}
 				}
 			}
@@ -355,7 +387,7 @@ func TestCreateUseCase_Execute_DuplicateMorningCall(t *testing.T) {
 		t.Fatalf("failed to create existing morning call: %v", err)
 	}
 
-	uc := NewCreateUseCase(morningCallRepo, userRepo, relationshipRepo)
+	uc := NewCreateUseCase(morningCallRepo, userRepo, relationshipRepo, nil, valueobject.DefaultInputLimits(), nil)
 
 	// 同じ時刻付近（30秒後）に新しいモーニングコールを作成しようとする
 	input := CreateInput{
@@ -436,7 +468,7 @@ func TestCreateUseCase_Execute_BidirectionalFriendship(t *testing.T) {
 		t.Fatalf("failed to create friendship: %v", err)
 	}
 
-	uc := NewCreateUseCase(morningCallRepo, userRepo, relationshipRepo)
+	uc := NewCreateUseCase(morningCallRepo, userRepo, relationshipRepo, nil, valueobject.DefaultInputLimits(), nil)
 
 	// user1からuser2へのモーニングコール（友達関係は逆方向だが、双方向として扱われるべき）
 	input := CreateInput{
@@ -454,3 +486,1017 @@ func TestCreateUseCase_Execute_BidirectionalFriendship(t *testing.T) {
 		t.Error("expected successful creation with bidirectional friendship")
 	}
 }
+
+func TestCreateUseCase_Execute_RequireCallApproval(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name            string
+		requireApproval bool
+		wantStatus      valueobject.MorningCallStatus
+	}{
+		{
+			name:            "承認制の受信者へのコールは承認待ち",
+			requireApproval: true,
+			wantStatus:      valueobject.MorningCallStatusPendingApproval,
+		},
+		{
+			name:            "承認制でない受信者へのコールはスケジュール済み",
+			requireApproval: false,
+			wantStatus:      valueobject.MorningCallStatusScheduled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			morningCallRepo := memory.NewMorningCallRepository()
+			userRepo := memory.NewUserRepository()
+			relationshipRepo := memory.NewRelationshipRepository()
+
+			for _, u := range []*entity.User{
+				{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+				{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password", RequireCallApproval: tt.requireApproval},
+			} {
+				if err := userRepo.Create(ctx, u); err != nil {
+					t.Fatalf("failed to create user: %v", err)
+				}
+			}
+			if err := relationshipRepo.Create(ctx, &entity.Relationship{
+				ID:          "rel1",
+				RequesterID: "sender",
+				ReceiverID:  "receiver",
+				Status:      valueobject.RelationshipStatusAccepted,
+			}); err != nil {
+				t.Fatalf("failed to create friendship: %v", err)
+			}
+
+			uc := NewCreateUseCase(morningCallRepo, userRepo, relationshipRepo, nil, valueobject.DefaultInputLimits(), nil)
+			output, err := uc.Execute(ctx, CreateInput{
+				SenderID:      "sender",
+				ReceiverID:    "receiver",
+				ScheduledTime: time.Now().Add(time.Hour),
+				Message:       "おはよう！",
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output.MorningCall.Status != tt.wantStatus {
+				t.Errorf("Status = %v, want %v", output.MorningCall.Status, tt.wantStatus)
+			}
+
+			// 送信者には承認待ちになったかと、承認が必要な期限を返す
+			if output.AwaitingApproval != tt.requireApproval {
+				t.Errorf("AwaitingApproval = %v, want %v", output.AwaitingApproval, tt.requireApproval)
+			}
+			if tt.requireApproval {
+				if output.ApprovalDeadline == nil || !output.ApprovalDeadline.Equal(output.MorningCall.ScheduledTime) {
+					t.Errorf("ApprovalDeadline = %v, want %v", output.ApprovalDeadline, output.MorningCall.ScheduledTime)
+				}
+			} else if output.ApprovalDeadline != nil {
+				t.Errorf("ApprovalDeadline = %v, want nil", output.ApprovalDeadline)
+			}
+		})
+	}
+}
+
+func TestCreateUseCase_Execute_ReceiverPendingDeletion(t *testing.T) {
+	ctx := context.Background()
+	morningCallRepo := memory.NewMorningCallRepository()
+	userRepo := memory.NewUserRepository()
+	relationshipRepo := memory.NewRelationshipRepository()
+
+	scheduledDeletion := time.Now().Add(24 * time.Hour)
+	for _, u := range []*entity.User{
+		{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+		{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password", DeletionScheduledAt: &scheduledDeletion},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+	if err := relationshipRepo.Create(ctx, &entity.Relationship{
+		ID:          "rel1",
+		RequesterID: "sender",
+		ReceiverID:  "receiver",
+		Status:      valueobject.RelationshipStatusAccepted,
+	}); err != nil {
+		t.Fatalf("failed to create friendship: %v", err)
+	}
+
+	uc := NewCreateUseCase(morningCallRepo, userRepo, relationshipRepo, nil, valueobject.DefaultInputLimits(), nil)
+	_, err := uc.Execute(ctx, CreateInput{
+		SenderID:      "sender",
+		ReceiverID:    "receiver",
+		ScheduledTime: time.Now().Add(time.Hour),
+		Message:       "おはよう！",
+	})
+	if err == nil || !strings.Contains(err.Error(), "アカウントの削除を予定しているため") {
+		t.Errorf("error = %v, want pending deletion error", err)
+	}
+	if count, _ := morningCallRepo.Count(ctx); count != 0 {
+		t.Errorf("Count() = %d, want 0", count)
+	}
+}
+
+func TestCreateUseCase_Execute_CallForwarding(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	forwardUntil := now.Add(2 * time.Hour)
+
+	tests := []struct {
+		name              string
+		forwardTo         string // 受信者bobの転送先
+		targetForwardsTo  string // 転送先carolの転送先（空は転送しない）
+		senderKnowsTarget bool   // 送信者aliceと転送先carolが友達か
+		scheduledTime     time.Time
+		wantReceiver      string
+		wantForwardedFrom string
+	}{
+		{
+			name:              "転送期間中のコールは転送先に届く",
+			forwardTo:         "carol",
+			senderKnowsTarget: true,
+			scheduledTime:     now.Add(time.Hour),
+			wantReceiver:      "carol",
+			wantForwardedFrom: "bob",
+		},
+		{
+			name:              "転送期間外のコールは元の受信者に届く",
+			forwardTo:         "carol",
+			senderKnowsTarget: true,
+			scheduledTime:     now.Add(3 * time.Hour),
+			wantReceiver:      "bob",
+		},
+		{
+			name:          "転送先が送信者の友達でない場合は元の受信者に届く",
+			forwardTo:     "carol",
+			scheduledTime: now.Add(time.Hour),
+			wantReceiver:  "bob",
+		},
+		{
+			name:          "転送先が送信者自身の場合は元の受信者に届く",
+			forwardTo:     "alice",
+			scheduledTime: now.Add(time.Hour),
+			wantReceiver:  "bob",
+		},
+		{
+			// 転送は1回だけ行うため、転送先が元の受信者への転送を設定していてもループしない
+			name:              "転送先の転送設定は辿らない",
+			forwardTo:         "carol",
+			targetForwardsTo:  "bob",
+			senderKnowsTarget: true,
+			scheduledTime:     now.Add(time.Hour),
+			wantReceiver:      "carol",
+			wantForwardedFrom: "bob",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			morningCallRepo := memory.NewMorningCallRepository()
+			userRepo := memory.NewUserRepository()
+			relationshipRepo := memory.NewRelationshipRepository()
+
+			bob := &entity.User{ID: "bob", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"}
+			if reason := bob.SetCallForwarding(tt.forwardTo, now, forwardUntil, now); reason.IsNG() {
+				t.Fatalf("SetCallForwarding() = %v", reason)
+			}
+			carol := &entity.User{ID: "carol", Username: "carol", Email: "carol@example.com", PasswordHash: "hashed_password"}
+			if tt.targetForwardsTo != "" {
+				if reason := carol.SetCallForwarding(tt.targetForwardsTo, now, forwardUntil, now); reason.IsNG() {
+					t.Fatalf("SetCallForwarding() = %v", reason)
+				}
+			}
+			for _, u := range []*entity.User{
+				{ID: "alice", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+				bob,
+				carol,
+			} {
+				if err := userRepo.Create(ctx, u); err != nil {
+					t.Fatalf("failed to create user: %v", err)
+				}
+			}
+
+			friendships := [][2]string{{"alice", "bob"}, {"bob", "carol"}}
+			if tt.senderKnowsTarget {
+				friendships = append(friendships, [2]string{"alice", "carol"})
+			}
+			for _, pair := range friendships {
+				if err := relationshipRepo.Create(ctx, &entity.Relationship{
+					ID:          pair[0] + "-" + pair[1],
+					RequesterID: pair[0],
+					ReceiverID:  pair[1],
+					Status:      valueobject.RelationshipStatusAccepted,
+				}); err != nil {
+					t.Fatalf("failed to create friendship: %v", err)
+				}
+			}
+
+			uc := NewCreateUseCase(morningCallRepo, userRepo, relationshipRepo, nil, valueobject.DefaultInputLimits(), nil)
+			output, err := uc.Execute(ctx, CreateInput{
+				SenderID:      "alice",
+				ReceiverID:    "bob",
+				ScheduledTime: tt.scheduledTime,
+				Message:       "おはよう！",
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			mc := output.MorningCall
+			if mc.ReceiverID != tt.wantReceiver || mc.ForwardedFrom != tt.wantForwardedFrom {
+				t.Errorf("ReceiverID = %s, ForwardedFrom = %q, want %s, %q", mc.ReceiverID, mc.ForwardedFrom, tt.wantReceiver, tt.wantForwardedFrom)
+			}
+
+			// 転送先の受信一覧に転送元が分かる形で保存される
+			received, err := morningCallRepo.FindByReceiverID(ctx, tt.wantReceiver, 0, 10)
+			if err != nil {
+				t.Fatalf("FindByReceiverID() error = %v", err)
+			}
+			if len(received) != 1 || received[0].ForwardedFrom != tt.wantForwardedFrom {
+				t.Errorf("received = %v, want 1 call forwarded from %q", received, tt.wantForwardedFrom)
+			}
+		})
+	}
+}
+
+func TestCreateUseCase_Execute_SelfCall(t *testing.T) {
+	ctx := context.Background()
+	morningCallRepo := memory.NewMorningCallRepository()
+	userRepo := memory.NewUserRepository()
+	relationshipRepo := memory.NewRelationshipRepository()
+
+	// 友達関係がなく、承認制・受信時間帯の制限があってもセルフモーニングコールは設定できる
+	for _, u := range []*entity.User{
+		{
+			ID: "alice", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password",
+			RequireCallApproval: true, NotifyOnConfirmation: true,
+			CallWindow: &valueobject.CallWindow{Weekdays: []time.Weekday{time.Sunday}, StartMinute: 0, EndMinute: 1},
+		},
+		{ID: "bob", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+
+	uc := NewCreateUseCase(morningCallRepo, userRepo, relationshipRepo, nil, valueobject.DefaultInputLimits(), nil)
+	scheduledTime := time.Now().Add(time.Hour)
+
+	t.Run("受信者を省略したセルフコールは送信者自身に設定される", func(t *testing.T) {
+		output, err := uc.Execute(ctx, CreateInput{
+			SenderID:      "alice",
+			ScheduledTime: scheduledTime,
+			Message:       "自分で起きる",
+			SelfCall:      true,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		mc := output.MorningCall
+		if !mc.SelfCall || mc.ReceiverID != "alice" {
+			t.Errorf("SelfCall = %v, ReceiverID = %s, want self call to alice", mc.SelfCall, mc.ReceiverID)
+		}
+		if mc.Status != valueobject.MorningCallStatusScheduled || output.AwaitingApproval {
+			t.Errorf("Status = %s, AwaitingApproval = %v, want scheduled without approval", mc.Status, output.AwaitingApproval)
+		}
+
+		// 自分自身で起床確認でき、自分への通知や感謝ポイントは発生しない
+		if reason := mc.MarkAsDelivered(); reason.IsNG() {
+			t.Fatalf("MarkAsDelivered() = %v", reason)
+		}
+		if err := morningCallRepo.Update(ctx, mc); err != nil {
+			t.Fatalf("failed to update morning call: %v", err)
+		}
+		emailSender := mail.NewMemoryEmailSender()
+		confirmed, err := NewConfirmWakeUseCase(morningCallRepo, userRepo, emailSender, nil, 10).Execute(ctx, ConfirmWakeInput{
+			MorningCallID: mc.ID,
+			ConfirmerID:   "alice",
+		})
+		if err != nil {
+			t.Fatalf("confirm error: %v", err)
+		}
+		if confirmed.MorningCall.Status != valueobject.MorningCallStatusConfirmed {
+			t.Errorf("Status = %s, want confirmed", confirmed.MorningCall.Status)
+		}
+		if confirmed.SenderNotified || len(emailSender.Messages()) != 0 || confirmed.PointsAwarded != 0 {
+			t.Errorf("SenderNotified = %v, emails = %d, PointsAwarded = %d, want none", confirmed.SenderNotified, len(emailSender.Messages()), confirmed.PointsAwarded)
+		}
+	})
+
+	t.Run("セルフコールの受信者に他のユーザーは指定できない", func(t *testing.T) {
+		_, err := uc.Execute(ctx, CreateInput{
+			SenderID:      "alice",
+			ReceiverID:    "bob",
+			ScheduledTime: scheduledTime,
+			SelfCall:      true,
+		})
+		if err == nil || !strings.Contains(err.Error(), "セルフモーニングコールの受信者は送信者自身である必要があります") {
+			t.Errorf("error = %v, want self call receiver error", err)
+		}
+	})
+
+	t.Run("フラグなしの自分自身への送信は引き続き禁止", func(t *testing.T) {
+		_, err := uc.Execute(ctx, CreateInput{
+			SenderID:      "alice",
+			ReceiverID:    "alice",
+			ScheduledTime: scheduledTime.Add(time.Hour),
+		})
+		if err == nil || !strings.Contains(err.Error(), "自分自身にモーニングコールを設定することはできません") {
+			t.Errorf("error = %v, want self reference error", err)
+		}
+	})
+}
+
+func TestCreateUseCase_Execute_CallWindow(t *testing.T) {
+	ctx := context.Background()
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	// 受信者のタイムゾーンで直近の月曜日 07:30
+	scheduled := time.Now().In(tokyo).AddDate(0, 0, 1)
+	for scheduled.Weekday() != time.Monday {
+		scheduled = scheduled.AddDate(0, 0, 1)
+	}
+	scheduled = time.Date(scheduled.Year(), scheduled.Month(), scheduled.Day(), 7, 30, 0, 0, tokyo)
+
+	morning := &valueobject.CallWindow{StartMinute: 7 * 60, EndMinute: 9 * 60}
+	evening := &valueobject.CallWindow{StartMinute: 18 * 60, EndMinute: 20 * 60}
+	weekend := &valueobject.CallWindow{Weekdays: []time.Weekday{time.Saturday, time.Sunday}, StartMinute: 7 * 60, EndMinute: 9 * 60}
+
+	tests := []struct {
+		name         string
+		userWindow   *valueobject.CallWindow
+		friendWindow *valueobject.CallWindow
+		wantErr      bool
+	}{
+		{name: "設定なしは制限しない"},
+		{name: "ユーザー全体の時間帯内", userWindow: morning},
+		{name: "ユーザー全体の時間帯外", userWindow: evening, wantErr: true},
+		{name: "友達ごとの時間帯内", friendWindow: morning},
+		{name: "友達ごとの時間帯外", friendWindow: evening, wantErr: true},
+		{name: "友達ごとの設定はユーザー全体の拒否より優先する", userWindow: evening, friendWindow: morning},
+		{name: "友達ごとの設定はユーザー全体の許可より優先する", userWindow: morning, friendWindow: evening, wantErr: true},
+		{name: "友達ごとの曜日外", userWindow: morning, friendWindow: weekend, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			morningCallRepo := memory.NewMorningCallRepository()
+			userRepo := memory.NewUserRepository()
+			relationshipRepo := memory.NewRelationshipRepository()
+
+			for _, u := range []*entity.User{
+				{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+				{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password", TimeZone: "Asia/Tokyo", CallWindow: tt.userWindow},
+			} {
+				if err := userRepo.Create(ctx, u); err != nil {
+					t.Fatalf("failed to create user: %v", err)
+				}
+			}
+			// 受信者が友達リクエストの送信者側の場合も受信者の設定が使われることを確認するため、受信者から申請した関係にする
+			if err := relationshipRepo.Create(ctx, &entity.Relationship{
+				ID:                  "rel1",
+				RequesterID:         "receiver",
+				ReceiverID:          "sender",
+				Status:              valueobject.RelationshipStatusAccepted,
+				RequesterCallWindow: tt.friendWindow,
+				ReceiverCallWindow:  evening,
+			}); err != nil {
+				t.Fatalf("failed to create friendship: %v", err)
+			}
+
+			uc := NewCreateUseCase(morningCallRepo, userRepo, relationshipRepo, nil, valueobject.DefaultInputLimits(), nil)
+			_, err := uc.Execute(ctx, CreateInput{
+				SenderID:      "sender",
+				ReceiverID:    "receiver",
+				ScheduledTime: scheduled,
+				Message:       "おはよう！",
+			})
+			if tt.wantErr {
+				if err == nil || err.Error() != "受信者がこの曜日・時間帯のモーニングコールを受け付けていません" {
+					t.Errorf("error = %v, want call window rejection", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestCreateUseCase_Execute_RelativeSchedule(t *testing.T) {
+	ctx := context.Background()
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skipf("time zone data is not available: %v", err)
+	}
+
+	setup := func(t *testing.T) *CreateUseCase {
+		t.Helper()
+		morningCallRepo := memory.NewMorningCallRepository()
+		userRepo := memory.NewUserRepository()
+		relationshipRepo := memory.NewRelationshipRepository()
+
+		for _, u := range []*entity.User{
+			{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password", TimeZone: "America/New_York"},
+			{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password", TimeZone: "Asia/Tokyo"},
+		} {
+			if err := userRepo.Create(ctx, u); err != nil {
+				t.Fatalf("failed to create user: %v", err)
+			}
+		}
+		if err := relationshipRepo.Create(ctx, &entity.Relationship{
+			ID:          "rel1",
+			RequesterID: "sender",
+			ReceiverID:  "receiver",
+			Status:      valueobject.RelationshipStatusAccepted,
+		}); err != nil {
+			t.Fatalf("failed to create friendship: %v", err)
+		}
+		return NewCreateUseCase(morningCallRepo, userRepo, relationshipRepo, nil, valueobject.DefaultInputLimits(), nil)
+	}
+
+	t.Run("翌日の時刻は受信者のタイムゾーンで解釈する", func(t *testing.T) {
+		uc := setup(t)
+		now := time.Now()
+		uc.now = func() time.Time { return now }
+
+		schedule, reason := valueobject.ParseRelativeTomorrowAt("07:00")
+		if reason.IsNG() {
+			t.Fatalf("failed to parse: %s", reason)
+		}
+		output, err := uc.Execute(ctx, CreateInput{
+			SenderID:         "sender",
+			ReceiverID:       "receiver",
+			RelativeSchedule: schedule,
+			Message:          "おはよう！",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		local := now.In(tokyo)
+		want := time.Date(local.Year(), local.Month(), local.Day()+1, 7, 0, 0, 0, tokyo)
+		if !output.MorningCall.ScheduledTime.Equal(want) {
+			t.Errorf("ScheduledTime = %v, want %v", output.MorningCall.ScheduledTime, want)
+		}
+	})
+
+	t.Run("変換後の時刻にも既存の検証を適用する", func(t *testing.T) {
+		uc := setup(t)
+		// 基準時刻が過去の場合、変換後の時刻も過去になり拒否される
+		uc.now = func() time.Time { return time.Now().Add(-time.Hour) }
+
+		schedule, _ := valueobject.ParseRelativeAfter("30m")
+		_, err := uc.Execute(ctx, CreateInput{
+			SenderID:         "sender",
+			ReceiverID:       "receiver",
+			RelativeSchedule: schedule,
+			Message:          "おはよう！",
+		})
+		if err == nil || !strings.Contains(err.Error(), "モーニングコールの検証に失敗しました") {
+			t.Errorf("error = %v, want validation error", err)
+		}
+	})
+
+	t.Run("絶対時刻との同時指定はエラー", func(t *testing.T) {
+		uc := setup(t)
+
+		schedule, _ := valueobject.ParseRelativeAfter("30m")
+		_, err := uc.Execute(ctx, CreateInput{
+			SenderID:         "sender",
+			ReceiverID:       "receiver",
+			ScheduledTime:    time.Now().Add(time.Hour),
+			RelativeSchedule: schedule,
+			Message:          "おはよう！",
+		})
+		if err == nil || err.Error() != "スケジュール時刻と相対指定は同時に指定できません" {
+			t.Errorf("error = %v, want conflict error", err)
+		}
+	})
+}
+
+func TestCreateUseCase_Execute_InputLimits(t *testing.T) {
+	ctx := context.Background()
+	morningCallRepo := memory.NewMorningCallRepository()
+	userRepo := memory.NewUserRepository()
+	relationshipRepo := memory.NewRelationshipRepository()
+
+	for _, u := range []*entity.User{
+		{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+		{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+	if err := relationshipRepo.Create(ctx, &entity.Relationship{
+		ID:          "rel1",
+		RequesterID: "sender",
+		ReceiverID:  "receiver",
+		Status:      valueobject.RelationshipStatusAccepted,
+	}); err != nil {
+		t.Fatalf("failed to create friendship: %v", err)
+	}
+
+	limits := valueobject.DefaultInputLimits()
+	limits.MessageMaxLength = 5
+	uc := NewCreateUseCase(morningCallRepo, userRepo, relationshipRepo, nil, limits, nil)
+
+	_, err := uc.Execute(ctx, CreateInput{
+		SenderID:      "sender",
+		ReceiverID:    "receiver",
+		ScheduledTime: time.Now().Add(time.Hour),
+		Message:       "おはようございます",
+	})
+	if err == nil || !strings.Contains(err.Error(), "メッセージは5文字以内で入力してください") {
+		t.Errorf("error = %v, want message length error", err)
+	}
+}
+
+func TestCreateUseCase_Execute_SystemMessage(t *testing.T) {
+	ctx := context.Background()
+	morningCallRepo := memory.NewMorningCallRepository()
+	userRepo := memory.NewUserRepository()
+	relationshipRepo := memory.NewRelationshipRepository()
+
+	for _, u := range []*entity.User{
+		{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+		{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+	if err := relationshipRepo.Create(ctx, &entity.Relationship{
+		ID:          "rel1",
+		RequesterID: "sender",
+		ReceiverID:  "receiver",
+		Status:      valueobject.RelationshipStatusAccepted,
+	}); err != nil {
+		t.Fatalf("failed to create friendship: %v", err)
+	}
+
+	uc := NewCreateUseCase(morningCallRepo, userRepo, relationshipRepo, nil, valueobject.DefaultInputLimits(), nil)
+	catalogMessage, _ := valueobject.DefaultSystemMessageCatalog().Find("gentle-wake-up")
+
+	tests := []struct {
+		name        string
+		message     string
+		id          string
+		language    string
+		wantMessage string
+		wantErr     string
+	}{
+		{name: "定型文の本文を使う", id: "gentle-wake-up", wantMessage: catalogMessage.Texts["ja"]},
+		{name: "言語を指定する", id: "gentle-wake-up", language: "en", wantMessage: catalogMessage.Texts["en"]},
+		{name: "未対応の言語は日本語", id: "gentle-wake-up", language: "fr", wantMessage: catalogMessage.Texts["ja"]},
+		{name: "存在しない定型文", id: "unknown", wantErr: "指定された定型文は存在しません"},
+		{name: "メッセージとの同時指定", id: "gentle-wake-up", message: "おはよう", wantErr: "メッセージと定型文IDは同時に指定できません"},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := uc.Execute(ctx, CreateInput{
+				SenderID:              "sender",
+				ReceiverID:            "receiver",
+				ScheduledTime:         time.Now().Add(time.Duration(i+1) * time.Hour),
+				Message:               tt.message,
+				SystemMessageID:       tt.id,
+				SystemMessageLanguage: tt.language,
+			})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output.MorningCall.Message != tt.wantMessage {
+				t.Errorf("Message = %q, want %q", output.MorningCall.Message, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestCreateUseCase_Execute_PlanQuota(t *testing.T) {
+	ctx := context.Background()
+
+	quotas := valueobject.PlanQuotas{
+		valueobject.PlanFree:    {MaxActiveCalls: 2, MaxDailyCalls: 3},
+		valueobject.PlanPremium: {MaxActiveCalls: 10, MaxDailyCalls: 10},
+	}
+
+	setup := func(t *testing.T, plan valueobject.Plan) (*CreateUseCase, *memory.MorningCallRepository) {
+		t.Helper()
+		morningCallRepo := memory.NewMorningCallRepository()
+		userRepo := memory.NewUserRepository()
+		relationshipRepo := memory.NewRelationshipRepository()
+
+		users := []*entity.User{
+			{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password", Plan: plan},
+			{ID: "user2", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+		}
+		for _, u := range users {
+			if err := userRepo.Create(ctx, u); err != nil {
+				t.Fatalf("failed to create user: %v", err)
+			}
+		}
+		friendship := &entity.Relationship{
+			ID:          "rel1",
+			RequesterID: "user1",
+			ReceiverID:  "user2",
+			Status:      valueobject.RelationshipStatusAccepted,
+			CreatedAt:   time.Now(),
+			UpdatedAt:   time.Now(),
+		}
+		if err := relationshipRepo.Create(ctx, friendship); err != nil {
+			t.Fatalf("failed to create friendship: %v", err)
+		}
+
+		return NewCreateUseCase(morningCallRepo, userRepo, relationshipRepo, quotas, valueobject.DefaultInputLimits(), nil), morningCallRepo
+	}
+
+	createCall := func(uc *CreateUseCase, offset time.Duration) error {
+		_, err := uc.Execute(ctx, CreateInput{
+			SenderID:      "user1",
+			ReceiverID:    "user2",
+			ScheduledTime: time.Now().Add(24*time.Hour + offset),
+			Message:       "おはよう",
+		})
+		return err
+	}
+
+	t.Run("フリープランはアクティブ数の上限で作成できない", func(t *testing.T) {
+		uc, _ := setup(t, valueobject.PlanFree)
+		for i := 0; i < 2; i++ {
+			if err := createCall(uc, time.Duration(i)*time.Hour); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+
+		err := createCall(uc, 5*time.Hour)
+		if err == nil {
+			t.Fatal("expected quota error but got nil")
+		}
+		if !strings.Contains(err.Error(), "アクティブなモーニングコール数の上限（2件）") || !strings.Contains(err.Error(), "アップグレード") {
+			t.Errorf("unexpected error message: %v", err)
+		}
+	})
+
+	t.Run("確認済みのコールはアクティブ数に含めないが1日の作成数には含める", func(t *testing.T) {
+		uc, morningCallRepo := setup(t, valueobject.PlanFree)
+		for i := 0; i < 3; i++ {
+			if i == 2 {
+				// 既存のコールを確認済みにしてアクティブ枠を空ける
+				calls, err := morningCallRepo.FindBySenderID(ctx, "user1", 0, 10)
+				if err != nil {
+					t.Fatalf("failed to find calls: %v", err)
+				}
+				for _, call := range calls {
+					call.Status = valueobject.MorningCallStatusConfirmed
+					if err := morningCallRepo.Update(ctx, call); err != nil {
+						t.Fatalf("failed to update call: %v", err)
+					}
+				}
+			}
+			if err := createCall(uc, time.Duration(i)*time.Hour); err != nil {
+				t.Fatalf("unexpected error on call %d: %v", i+1, err)
+			}
+		}
+
+		err := createCall(uc, 5*time.Hour)
+		if err == nil {
+			t.Fatal("expected daily quota error but got nil")
+		}
+		if !strings.Contains(err.Error(), "1日あたりのモーニングコール作成数の上限（3件）") {
+			t.Errorf("unexpected error message: %v", err)
+		}
+	})
+
+	t.Run("前日以前に作成したコールは1日の作成数に含めない", func(t *testing.T) {
+		uc, morningCallRepo := setup(t, valueobject.PlanFree)
+		yesterday := time.Now().AddDate(0, 0, -1)
+		for i := 0; i < 3; i++ {
+			call := &entity.MorningCall{
+				ID:            "old" + string(rune('a'+i)),
+				SenderID:      "user1",
+				ReceiverID:    "user2",
+				ScheduledTime: yesterday,
+				Status:        valueobject.MorningCallStatusConfirmed,
+				CreatedAt:     yesterday,
+				UpdatedAt:     yesterday,
+			}
+			if err := morningCallRepo.Create(ctx, call); err != nil {
+				t.Fatalf("failed to create call: %v", err)
+			}
+		}
+
+		if err := createCall(uc, 0); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("一度に取得する件数を超えて送ったコールもアクティブ数に含める", func(t *testing.T) {
+		uc, morningCallRepo := setup(t, valueobject.PlanFree)
+		yesterday := time.Now().AddDate(0, 0, -1)
+		for i := 0; i < sentCallBatchSize; i++ {
+			call := &entity.MorningCall{
+				ID:            fmt.Sprintf("done%04d", i),
+				SenderID:      "user1",
+				ReceiverID:    "user2",
+				ScheduledTime: yesterday,
+				Status:        valueobject.MorningCallStatusConfirmed,
+				CreatedAt:     yesterday,
+				UpdatedAt:     yesterday,
+			}
+			if err := morningCallRepo.Create(ctx, call); err != nil {
+				t.Fatalf("failed to create call: %v", err)
+			}
+		}
+		// アラーム時刻の降順で取得されるため、より古い時刻のアクティブなコールは最後のページに入る
+		for i := 0; i < 2; i++ {
+			call := &entity.MorningCall{
+				ID:            fmt.Sprintf("active%d", i),
+				SenderID:      "user1",
+				ReceiverID:    "user2",
+				ScheduledTime: yesterday.Add(-time.Duration(i+1) * time.Hour),
+				Status:        valueobject.MorningCallStatusDelivered,
+				CreatedAt:     yesterday,
+				UpdatedAt:     yesterday,
+			}
+			if err := morningCallRepo.Create(ctx, call); err != nil {
+				t.Fatalf("failed to create call: %v", err)
+			}
+		}
+
+		err := createCall(uc, 0)
+		if err == nil || !strings.Contains(err.Error(), "アクティブなモーニングコール数の上限（2件）") {
+			t.Errorf("error = %v, want active quota error", err)
+		}
+	})
+
+	t.Run("プレミアムプランは上限が引き上げられる", func(t *testing.T) {
+		uc, _ := setup(t, valueobject.PlanPremium)
+		for i := 0; i < 3; i++ {
+			if err := createCall(uc, time.Duration(i)*time.Hour); err != nil {
+				t.Fatalf("unexpected error on call %d: %v", i+1, err)
+			}
+		}
+	})
+}
+
+func TestCreateUseCase_Execute_WakeChallenge(t *testing.T) {
+	ctx := context.Background()
+	userRepo := memory.NewUserRepository()
+	if err := userRepo.Create(ctx, &entity.User{ID: "alice", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"}); err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+
+	uc := NewCreateUseCase(memory.NewMorningCallRepository(), userRepo, memory.NewRelationshipRepository(), nil, valueobject.DefaultInputLimits(), nil)
+	uc.intn = func(n int) int { return 0 }
+
+	t.Run("難易度を指定すると起床クイズが設定される", func(t *testing.T) {
+		output, err := uc.Execute(ctx, CreateInput{
+			SenderID:            "alice",
+			ScheduledTime:       time.Now().Add(time.Hour),
+			SelfCall:            true,
+			ChallengeDifficulty: valueobject.ChallengeDifficultyEasy,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := output.MorningCall.WakeChallenge
+		if got == nil || got.Question != "1 + 1" || got.Answer != 2 {
+			t.Errorf("WakeChallenge = %+v, want 1 + 1 = 2", got)
+		}
+	})
+
+	t.Run("難易度を省略すると起床クイズなし", func(t *testing.T) {
+		output, err := uc.Execute(ctx, CreateInput{
+			SenderID:      "alice",
+			ScheduledTime: time.Now().Add(2 * time.Hour),
+			SelfCall:      true,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.MorningCall.WakeChallenge != nil {
+			t.Errorf("WakeChallenge = %+v, want nil", output.MorningCall.WakeChallenge)
+		}
+	})
+
+	t.Run("不正な難易度はエラー", func(t *testing.T) {
+		_, err := uc.Execute(ctx, CreateInput{
+			SenderID:            "alice",
+			ScheduledTime:       time.Now().Add(3 * time.Hour),
+			SelfCall:            true,
+			ChallengeDifficulty: "extreme",
+		})
+		if err == nil || !strings.Contains(err.Error(), "起床クイズ") {
+			t.Errorf("error = %v, want wake challenge error", err)
+		}
+	})
+}
+
+func TestCreateUseCase_Execute_ScheduleAdjuster(t *testing.T) {
+	ctx := context.Background()
+	rainy := NewWeatherScheduleAdjuster(external.NewStubWeatherProvider(valueobject.WeatherRain), valueobject.DefaultWeatherAdjustmentRules())
+
+	setup := func(t *testing.T, adjuster ScheduleAdjuster, window *valueobject.CallWindow) *CreateUseCase {
+		t.Helper()
+		userRepo := memory.NewUserRepository()
+		relationshipRepo := memory.NewRelationshipRepository()
+		for _, u := range []*entity.User{
+			{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+			{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password", TimeZone: "UTC", CallWindow: window},
+		} {
+			if err := userRepo.Create(ctx, u); err != nil {
+				t.Fatalf("failed to create user: %v", err)
+			}
+		}
+		if err := relationshipRepo.Create(ctx, &entity.Relationship{
+			ID:          "rel1",
+			RequesterID: "sender",
+			ReceiverID:  "receiver",
+			Status:      valueobject.RelationshipStatusAccepted,
+		}); err != nil {
+			t.Fatalf("failed to create friendship: %v", err)
+		}
+		return NewCreateUseCase(memory.NewMorningCallRepository(), userRepo, relationshipRepo, nil, valueobject.DefaultInputLimits(), adjuster)
+	}
+
+	// 受信者の時間帯（UTC）で翌日の7:00
+	now := time.Now().UTC()
+	scheduled := time.Date(now.Year(), now.Month(), now.Day()+1, 7, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		adjuster       ScheduleAdjuster
+		window         *valueobject.CallWindow
+		scheduled      time.Time
+		wantAdjustment time.Duration
+	}{
+		{name: "フックがない場合は調整しない", scheduled: scheduled},
+		{name: "雨の日は早める", adjuster: rainy, scheduled: scheduled, wantAdjustment: -15 * time.Minute},
+		{
+			name:      "予報を取得できない場合は調整せずに作成する",
+			adjuster:  NewWeatherScheduleAdjuster(external.NewStubWeatherProvider(""), valueobject.DefaultWeatherAdjustmentRules()),
+			scheduled: scheduled,
+		},
+		{
+			name:      "調整後の時刻が受信時間帯から外れる場合は調整しない",
+			adjuster:  rainy,
+			window:    &valueobject.CallWindow{StartMinute: 7 * 60, EndMinute: 9 * 60},
+			scheduled: scheduled,
+		},
+		{name: "調整後の時刻が過去になる場合は調整しない", adjuster: rainy, scheduled: time.Now().Add(10 * time.Minute)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := setup(t, tt.adjuster, tt.window)
+			output, err := uc.Execute(ctx, CreateInput{
+				SenderID:      "sender",
+				ReceiverID:    "receiver",
+				ScheduledTime: tt.scheduled,
+				Message:       "おはよう！",
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if want := tt.scheduled.Add(tt.wantAdjustment); !output.MorningCall.ScheduledTime.Equal(want) {
+				t.Errorf("ScheduledTime = %v, want %v", output.MorningCall.ScheduledTime, want)
+			}
+			if output.ScheduleAdjustment != tt.wantAdjustment {
+				t.Errorf("ScheduleAdjustment = %v, want %v", output.ScheduleAdjustment, tt.wantAdjustment)
+			}
+		})
+	}
+}
+
+func TestCreateUseCase_Execute_MessageVariants(t *testing.T) {
+	ctx := context.Background()
+	userRepo := memory.NewUserRepository()
+	if err := userRepo.Create(ctx, &entity.User{ID: "alice", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"}); err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+
+	variants := []string{"おはよう！", "起きて！", "朝だよ！"}
+	newUseCase := func() *CreateUseCase {
+		return NewCreateUseCase(memory.NewMorningCallRepository(), userRepo, memory.NewRelationshipRepository(), nil, valueobject.DefaultInputLimits(), nil)
+	}
+	create := func(uc *CreateUseCase, hour int, input CreateInput) (*CreateOutput, error) {
+		input.SenderID = "alice"
+		input.SelfCall = true
+		input.ScheduledTime = time.Now().Add(time.Duration(hour) * time.Hour)
+		return uc.Execute(ctx, input)
+	}
+
+	t.Run("ランダムに選んだバリアントをメッセージにする", func(t *testing.T) {
+		uc := newUseCase()
+		uc.intn = func(n int) int { return n - 1 }
+
+		output, err := create(uc, 1, CreateInput{MessageVariants: variants})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		mc := output.MorningCall
+		if mc.Message != "朝だよ！" || mc.MessageVariantIndex == nil || *mc.MessageVariantIndex != 2 {
+			t.Errorf("Message = %q, MessageVariantIndex = %v, want 朝だよ！ (2)", mc.Message, mc.MessageVariantIndex)
+		}
+		if len(mc.MessageVariants) != 3 {
+			t.Errorf("MessageVariants = %v, want 3 variants", mc.MessageVariants)
+		}
+	})
+
+	t.Run("順番の場合は同じバリアントで送った数に応じて選ぶ", func(t *testing.T) {
+		uc := newUseCase()
+		uc.intn = func(n int) int { t.Fatal("round_robin should not use random"); return 0 }
+
+		// 異なるバリアントのコールは数えない
+		if _, err := create(uc, 1, CreateInput{
+			MessageVariants:        []string{"A", "B"},
+			MessageVariantStrategy: valueobject.MessageVariantStrategyRoundRobin,
+		}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		var got []string
+		for i := 0; i < 4; i++ {
+			output, err := create(uc, i+2, CreateInput{
+				MessageVariants:        variants,
+				MessageVariantStrategy: valueobject.MessageVariantStrategyRoundRobin,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got = append(got, output.MorningCall.Message)
+		}
+		if want := "おはよう！,起きて！,朝だよ！,おはよう！"; strings.Join(got, ",") != want {
+			t.Errorf("messages = %s, want %s", strings.Join(got, ","), want)
+		}
+	})
+
+	t.Run("順番の場合は一度に取得する件数を超えて送ったコールも数える", func(t *testing.T) {
+		morningCallRepo := memory.NewMorningCallRepository()
+		uc := NewCreateUseCase(morningCallRepo, userRepo, memory.NewRelationshipRepository(), nil, valueobject.DefaultInputLimits(), nil)
+
+		// ページの境界をまたいでも漏れや重複がないよう、同じアラーム時刻のコールを用意する
+		scheduled := time.Now().Add(-time.Hour)
+		sent := sentCallBatchSize + 2
+		for i := 0; i < sent; i++ {
+			mc := &entity.MorningCall{
+				ID:            fmt.Sprintf("sent%04d", i),
+				SenderID:      "alice",
+				ReceiverID:    "alice",
+				ScheduledTime: scheduled,
+				Status:        valueobject.MorningCallStatusConfirmed,
+				SelfCall:      true,
+			}
+			if reason := mc.SetMessageVariants(variants, i%len(variants), valueobject.DefaultInputLimits()); reason.IsNG() {
+				t.Fatalf("failed to set variants: %v", reason)
+			}
+			if err := morningCallRepo.Create(ctx, mc); err != nil {
+				t.Fatalf("failed to create morning call: %v", err)
+			}
+		}
+
+		output, err := create(uc, 1, CreateInput{
+			MessageVariants:        variants,
+			MessageVariantStrategy: valueobject.MessageVariantStrategyRoundRobin,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := variants[sent%len(variants)]; output.MorningCall.Message != want {
+			t.Errorf("Message = %q, want %q", output.MorningCall.Message, want)
+		}
+	})
+
+	tests := []struct {
+		name    string
+		input   CreateInput
+		wantErr string
+	}{
+		{name: "メッセージとの同時指定", input: CreateInput{Message: "おはよう", MessageVariants: variants}, wantErr: "メッセージとメッセージバリアントは同時に指定できません"},
+		{name: "定型文との同時指定", input: CreateInput{SystemMessageID: "gentle-wake-up", MessageVariants: variants}, wantErr: "メッセージとメッセージバリアントは同時に指定できません"},
+		{name: "不正な選び方", input: CreateInput{MessageVariants: variants, MessageVariantStrategy: "weighted"}, wantErr: "メッセージバリアントの選び方はrandomまたはround_robinを指定してください"},
+		{name: "バリアントが1件", input: CreateInput{MessageVariants: []string{"おはよう"}}, wantErr: "メッセージバリアントは2件から5件の範囲で指定してください"},
+		{name: "バリアントごとの文字数制限", input: CreateInput{MessageVariants: []string{"おはよう", strings.Repeat("あ", 501)}}, wantErr: "メッセージバリアントの2件目は500文字以内で入力してください"},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := create(newUseCase(), i+1, tt.input)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
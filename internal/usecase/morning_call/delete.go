@@ -8,19 +8,23 @@ import (
 	"github.com/ochamu/morning-call-api/internal/domain/entity"
 	"github.com/ochamu/morning-call-api/internal/domain/repository"
 	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/pkg/utils"
 )
 
 // DeleteUseCase はモーニングコール削除のユースケース
 type DeleteUseCase struct {
 	morningCallRepo repository.MorningCallRepository
+	commentRepo     repository.CommentRepository
 }
 
 // NewDeleteUseCase は新しいモーニングコール削除ユースケースを作成する
 func NewDeleteUseCase(
 	morningCallRepo repository.MorningCallRepository,
+	commentRepo repository.CommentRepository,
 ) *DeleteUseCase {
 	return &DeleteUseCase{
 		morningCallRepo: morningCallRepo,
+		commentRepo:     commentRepo,
 	}
 }
 
@@ -59,11 +63,17 @@ func (uc *DeleteUseCase) Execute(ctx context.Context, input DeleteInput) (*Delet
 		return nil, fmt.Errorf("送信者のみがモーニングコールを削除できます")
 	}
 
-	// ステータスの確認（スケジュール済みまたはキャンセル済みのみ削除可能）
+	// 管理者が削除済みにしたコールは履歴として残す
+	if morningCall.IsDeleted() {
+		return nil, fmt.Errorf("管理者により削除済みのモーニングコールです")
+	}
+
+	// ステータスの確認（承認待ち・スケジュール済み・キャンセル済みのみ削除可能）
 	// 配信済みや確認済みのものは履歴として残す必要があるため削除不可
-	if morningCall.Status != valueobject.MorningCallStatusScheduled &&
+	if morningCall.Status != valueobject.MorningCallStatusPendingApproval &&
+		morningCall.Status != valueobject.MorningCallStatusScheduled &&
 		morningCall.Status != valueobject.MorningCallStatusCancelled {
-		return nil, fmt.Errorf("削除できるのはスケジュール済みまたはキャンセル済みのモーニングコールのみです")
+		return nil, fmt.Errorf("削除できるのは承認待ち・スケジュール済み・キャンセル済みのモーニングコールのみです")
 	}
 
 	// リポジトリから削除
@@ -71,6 +81,11 @@ func (uc *DeleteUseCase) Execute(ctx context.Context, input DeleteInput) (*Delet
 		return nil, fmt.Errorf("モーニングコールの削除に失敗しました: %w", err)
 	}
 
+	// コールに付いたコメントも削除する（失敗してもコール自体の削除は巻き戻さない）
+	if _, err := uc.commentRepo.DeleteByCallID(ctx, input.ID); err != nil {
+		utils.Logf(ctx, "モーニングコールのコメントの削除に失敗しました: %v", err)
+	}
+
 	// 削除されたモーニングコールの情報を返す
 	return &DeleteOutput{
 		DeletedMorningCall: morningCall,
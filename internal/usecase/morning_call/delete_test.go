@@ -15,7 +15,7 @@ import (
 func TestNewDeleteUseCase(t *testing.T) {
 	morningCallRepo := memory.NewMorningCallRepository()
 
-	uc := NewDeleteUseCase(morningCallRepo)
+	uc := NewDeleteUseCase(morningCallRepo, memory.NewCommentRepository())
 
 	if uc == nil {
 		t.Fatal("NewDeleteUseCase returned nil")
@@ -199,7 +199,7 @@ func TestDeleteUseCase_Execute(t *testing.T) {
 				SenderID: user1.ID,
 			},
 			wantErr: true,
-			errMsg:  "削除できるのはスケジュール済みまたはキャンセル済みのモーニングコールのみです",
+			errMsg:  "削除できるのは承認待ち・スケジュール済み・キャンセル済みのモーニングコールのみです",
 		},
 		{
 			name: "確認済みモーニングコールの削除",
@@ -208,7 +208,7 @@ func TestDeleteUseCase_Execute(t *testing.T) {
 				SenderID: user1.ID,
 			},
 			wantErr: true,
-			errMsg:  "削除できるのはスケジュール済みまたはキャンセル済みのモーニングコールのみです",
+			errMsg:  "削除できるのは承認待ち・スケジュール済み・キャンセル済みのモーニングコールのみです",
 		},
 		{
 			name: "期限切れモーニングコールの削除",
@@ -217,7 +217,7 @@ func TestDeleteUseCase_Execute(t *testing.T) {
 				SenderID: user1.ID,
 			},
 			wantErr: true,
-			errMsg:  "削除できるのはスケジュール済みまたはキャンセル済みのモーニングコールのみです",
+			errMsg:  "削除できるのは承認待ち・スケジュール済み・キャンセル済みのモーニングコールのみです",
 		},
 		{
 			name: "スケジュール済みの削除成功",
@@ -241,7 +241,7 @@ func TestDeleteUseCase_Execute(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			uc := NewDeleteUseCase(morningCallRepo)
+			uc := NewDeleteUseCase(morningCallRepo, memory.NewCommentRepository())
 			output, err := uc.Execute(ctx, tt.input)
 
 			if tt.wantErr {
@@ -336,7 +336,7 @@ func TestDeleteUseCase_Execute_Authorization(t *testing.T) {
 		t.Fatalf("failed to create morning call: %v", err)
 	}
 
-	uc := NewDeleteUseCase(morningCallRepo)
+	uc := NewDeleteUseCase(morningCallRepo, memory.NewCommentRepository())
 
 	// 送信者（user1）による削除は成功すべき
 	output, err := uc.Execute(ctx, DeleteInput{
@@ -446,7 +446,7 @@ func TestDeleteUseCase_Execute_StatusTransitions(t *testing.T) {
 		{valueobject.MorningCallStatusExpired, false, "期限切れ"},
 	}
 
-	uc := NewDeleteUseCase(morningCallRepo)
+	uc := NewDeleteUseCase(morningCallRepo, memory.NewCommentRepository())
 
 	for i, s := range statuses {
 		t.Run(s.description, func(t *testing.T) {
@@ -488,7 +488,7 @@ func TestDeleteUseCase_Execute_StatusTransitions(t *testing.T) {
 			} else {
 				if err == nil {
 					t.Errorf("should not be able to delete %s morning call", s.description)
-				} else if !strings.Contains(err.Error(), "削除できるのはスケジュール済みまたはキャンセル済みのモーニングコールのみです") {
+				} else if !strings.Contains(err.Error(), "削除できるのは承認待ち・スケジュール済み・キャンセル済みのモーニングコールのみです") {
 					t.Errorf("unexpected error message for %s: %v", s.description, err.Error())
 				}
 				if output != nil {
@@ -554,7 +554,7 @@ func TestDeleteUseCase_Execute_MultipleDeletes(t *testing.T) {
 		}
 	}
 
-	uc := NewDeleteUseCase(morningCallRepo)
+	uc := NewDeleteUseCase(morningCallRepo, memory.NewCommentRepository())
 
 	// すべてのモーニングコールを順番に削除
 	for i := 0; i < 5; i++ {
@@ -592,3 +592,41 @@ func TestDeleteUseCase_Execute_MultipleDeletes(t *testing.T) {
 		t.Error("expected output to be nil on error")
 	}
 }
+
+func TestDeleteUseCase_Execute_DeletesComments(t *testing.T) {
+	ctx := context.Background()
+	base := time.Date(2026, 3, 10, 6, 0, 0, 0, time.UTC)
+
+	morningCallRepo := memory.NewMorningCallRepository()
+	commentRepo := memory.NewCommentRepository()
+	for _, mc := range []*entity.MorningCall{
+		{ID: "mc1", SenderID: "user1", ReceiverID: "user2", ScheduledTime: base, Message: "おはよう", Status: valueobject.MorningCallStatusScheduled},
+		{ID: "mc2", SenderID: "user1", ReceiverID: "user2", ScheduledTime: base, Message: "おはよう", Status: valueobject.MorningCallStatusScheduled},
+	} {
+		if err := morningCallRepo.Create(ctx, mc); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+	for _, c := range []*entity.CallComment{
+		{ID: "c1", CallID: "mc1", AuthorID: "user1", Body: "起きてね", CreatedAt: base},
+		{ID: "c2", CallID: "mc1", AuthorID: "user2", Body: "了解", CreatedAt: base.Add(time.Minute)},
+		{ID: "c3", CallID: "mc2", AuthorID: "user1", Body: "別のコール", CreatedAt: base},
+	} {
+		if err := commentRepo.Create(ctx, c); err != nil {
+			t.Fatalf("failed to create comment: %v", err)
+		}
+	}
+
+	uc := NewDeleteUseCase(morningCallRepo, commentRepo)
+	if _, err := uc.Execute(ctx, DeleteInput{ID: "mc1", SenderID: "user1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 削除したコールのコメントだけが削除される
+	if n, _ := commentRepo.CountByCallID(ctx, "mc1"); n != 0 {
+		t.Errorf("comments of mc1 = %d, want 0", n)
+	}
+	if n, _ := commentRepo.CountByCallID(ctx, "mc2"); n != 1 {
+		t.Errorf("comments of mc2 = %d, want 1", n)
+	}
+}
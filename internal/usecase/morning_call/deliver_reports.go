@@ -0,0 +1,134 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// deliverReportsBatchSize はユーザーを一度に取得する件数
+const deliverReportsBatchSize = 100
+
+// DeliverReportsUseCase は直前に終わった週・月のレポートを全ユーザー分生成して届けるユースケース
+// スケジューラから定期的に呼び出す。期間はユーザーのタイムゾーンで区切り、同じ期間のレポートは1回だけ届ける
+type DeliverReportsUseCase struct {
+	userRepo  repository.UserRepository
+	generator *GenerateReportUseCase
+	notifier  service.ReportNotifier
+	period    valueobject.ReportPeriod
+	format    valueobject.ReportFormat
+	now       func() time.Time
+
+	mu        sync.Mutex
+	delivered map[string]time.Time // ユーザーID -> 最後に届けたレポートの集計開始時刻
+}
+
+// NewDeliverReportsUseCase は新しい定期レポート配信ユースケースを作成する
+// notifierがnilの場合はレポートを届けない。periodとformatが不正な場合は週次・CSVを使用する
+func NewDeliverReportsUseCase(
+	userRepo repository.UserRepository,
+	generator *GenerateReportUseCase,
+	notifier service.ReportNotifier,
+	period valueobject.ReportPeriod,
+	format valueobject.ReportFormat,
+) *DeliverReportsUseCase {
+	if !period.IsValid() {
+		period = valueobject.ReportPeriodWeekly
+	}
+	if !format.IsValid() {
+		format = valueobject.ReportFormatCSV
+	}
+	return &DeliverReportsUseCase{
+		userRepo:  userRepo,
+		generator: generator,
+		notifier:  notifier,
+		period:    period,
+		format:    format,
+		now:       time.Now,
+		delivered: make(map[string]time.Time),
+	}
+}
+
+// Execute はまだ届けていない直前の期間のレポートを生成して届け、届けた件数を返す
+// 期間内にコールが1件もないユーザーと削除予定のユーザーには届けない
+// 1人分の失敗で他のユーザーへの配信は止めず、発生したエラーをまとめて返す
+func (uc *DeliverReportsUseCase) Execute(ctx context.Context) (int, error) {
+	if uc.notifier == nil {
+		return 0, nil
+	}
+
+	now := uc.now()
+	delivered := 0
+	var errs []error
+	for offset := 0; ; offset += deliverReportsBatchSize {
+		users, err := uc.userRepo.FindAll(ctx, offset, deliverReportsBatchSize)
+		if err != nil {
+			return delivered, fmt.Errorf("failed to find users: %w", err)
+		}
+
+		for _, user := range users {
+			if user.IsPendingDeletion() {
+				continue
+			}
+			start, end := uc.period.PreviousRange(now, user.Location())
+			if uc.alreadyDelivered(user.ID, start) {
+				continue
+			}
+
+			output, err := uc.generator.generate(ctx, user, uc.period, start, end, uc.format)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to generate report for user %s: %w", user.ID, err))
+				continue
+			}
+			if output.Report.IsEmpty() {
+				uc.markDelivered(user.ID, start)
+				continue
+			}
+
+			if err := uc.notifier.NotifyReport(ctx, service.ReportDelivery{
+				UserID:   user.ID,
+				Email:    user.Email,
+				Period:   uc.period,
+				From:     start,
+				To:       end,
+				Summary:  summarizeReport(output.Report),
+				Format:   uc.format,
+				Filename: output.Filename,
+				Content:  output.Content,
+			}); err != nil {
+				// 次回の実行で再送する
+				errs = append(errs, fmt.Errorf("failed to notify report to user %s: %w", user.ID, err))
+				continue
+			}
+			uc.markDelivered(user.ID, start)
+			delivered++
+		}
+
+		if len(users) < deliverReportsBatchSize {
+			break
+		}
+	}
+
+	return delivered, errors.Join(errs...)
+}
+
+// alreadyDelivered はユーザーにstartから始まる期間のレポートを届け済みかを判定する
+func (uc *DeliverReportsUseCase) alreadyDelivered(userID string, start time.Time) bool {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	last, ok := uc.delivered[userID]
+	return ok && !last.Before(start)
+}
+
+// markDelivered はユーザーにstartから始まる期間のレポートを届けたことを記録する
+func (uc *DeliverReportsUseCase) markDelivered(userID string, start time.Time) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	uc.delivered[userID] = start
+}
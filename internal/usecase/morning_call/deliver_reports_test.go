@@ -0,0 +1,94 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// recordingReportNotifier は届けたレポートを記録するReportNotifier
+type recordingReportNotifier struct {
+	deliveries []service.ReportDelivery
+	err        error
+}
+
+func (n *recordingReportNotifier) NotifyReport(ctx context.Context, delivery service.ReportDelivery) error {
+	if n.err != nil {
+		return n.err
+	}
+	n.deliveries = append(n.deliveries, delivery)
+	return nil
+}
+
+func TestDeliverReportsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	day := func(d, hour int) time.Time { return time.Date(2026, 3, d, hour, 0, 0, 0, tokyo) }
+
+	// carolは先週のコールがないため届けない
+	morningCallRepo, userRepo := newReportTestRepos(t, []reportTestCall{
+		{"bob", "alice", day(3, 7), valueobject.MorningCallStatusConfirmed},
+	})
+	pendingDeletion := day(20, 0)
+	bob, _ := userRepo.FindByID(ctx, "bob")
+	bob.DeletionScheduledAt = &pendingDeletion
+	if err := userRepo.Update(ctx, bob); err != nil {
+		t.Fatalf("failed to update user: %v", err)
+	}
+
+	generator := NewGenerateReportUseCase(morningCallRepo, userRepo)
+	notifier := &recordingReportNotifier{}
+	uc := NewDeliverReportsUseCase(userRepo, generator, notifier, valueobject.ReportPeriodWeekly, valueobject.ReportFormatCSV)
+	now := day(9, 1)
+	uc.now = func() time.Time { return now }
+
+	count, err := uc.Execute(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 削除予定のbobとコールのないcarolには届けない
+	if count != 1 || len(notifier.deliveries) != 1 {
+		t.Fatalf("count = %d, deliveries = %d, want 1", count, len(notifier.deliveries))
+	}
+	d := notifier.deliveries[0]
+	if d.UserID != "alice" || d.Email != "alice@example.com" || d.Format != valueobject.ReportFormatCSV || !d.From.Equal(day(2, 0)) {
+		t.Errorf("delivery = %+v", d)
+	}
+	if !strings.Contains(d.Summary, "受け取ったコール: 1件") || !strings.HasPrefix(string(d.Content), "metric,value,username") {
+		t.Errorf("Summary = %q, Content = %q", d.Summary, d.Content)
+	}
+
+	// 同じ期間のレポートは重ねて届けない
+	now = day(10, 1)
+	if count, err := uc.Execute(ctx); err != nil || count != 0 {
+		t.Errorf("second Execute() = %d, %v, want 0, nil", count, err)
+	}
+}
+
+func TestDeliverReportsUseCase_Execute_NotifyFailure(t *testing.T) {
+	ctx := context.Background()
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	morningCallRepo, userRepo := newReportTestRepos(t, []reportTestCall{
+		{"bob", "alice", time.Date(2026, 3, 3, 7, 0, 0, 0, tokyo), valueobject.MorningCallStatusConfirmed},
+	})
+
+	errUnavailable := errors.New("unavailable")
+	notifier := &recordingReportNotifier{err: errUnavailable}
+	uc := NewDeliverReportsUseCase(userRepo, NewGenerateReportUseCase(morningCallRepo, userRepo), notifier, "", "")
+	uc.now = func() time.Time { return time.Date(2026, 3, 9, 1, 0, 0, 0, tokyo) }
+
+	if _, err := uc.Execute(ctx); !errors.Is(err, errUnavailable) {
+		t.Fatalf("Execute() = %v, want errUnavailable", err)
+	}
+
+	// 届けられなかったレポートは次回の実行で再送する（送信者のbobにも届ける）
+	notifier.err = nil
+	if count, err := uc.Execute(ctx); err != nil || count != 2 {
+		t.Errorf("retry Execute() = %d, %v, want 2, nil", count, err)
+	}
+}
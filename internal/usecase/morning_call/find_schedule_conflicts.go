@@ -0,0 +1,139 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+const (
+	// DefaultConflictWindow は重複とみなす時刻範囲のデフォルト値
+	DefaultConflictWindow = time.Minute
+	// MaxConflictWindow は重複とみなす時刻範囲の最大値
+	MaxConflictWindow = 24 * time.Hour
+)
+
+// FindScheduleConflictsUseCase は送信者の予定コールのスケジュール重複を検出するユースケース
+type FindScheduleConflictsUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	userRepo        repository.UserRepository
+}
+
+// NewFindScheduleConflictsUseCase は新しいスケジュール重複検出ユースケースを作成する
+func NewFindScheduleConflictsUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	userRepo repository.UserRepository,
+) *FindScheduleConflictsUseCase {
+	return &FindScheduleConflictsUseCase{
+		morningCallRepo: morningCallRepo,
+		userRepo:        userRepo,
+	}
+}
+
+// FindScheduleConflictsInput はスケジュール重複検出の入力データ
+type FindScheduleConflictsInput struct {
+	SenderID string        // 必須：送信者のID
+	Window   time.Duration // オプション：重複とみなす時刻範囲（デフォルト1分）
+}
+
+// ConflictGroup は時刻が重複しているモーニングコールのグループ
+type ConflictGroup struct {
+	MorningCalls []*entity.MorningCall // スケジュール時刻の昇順
+	StartTime    time.Time             // グループ内で最も早いスケジュール時刻
+	EndTime      time.Time             // グループ内で最も遅いスケジュール時刻
+}
+
+// FindScheduleConflictsOutput はスケジュール重複検出の出力データ
+type FindScheduleConflictsOutput struct {
+	Groups []ConflictGroup
+	Window time.Duration
+}
+
+// Execute は送信者の予定コールのうち、時刻が近接しているものをグループ化して返す
+func (uc *FindScheduleConflictsUseCase) Execute(ctx context.Context, input FindScheduleConflictsInput) (*FindScheduleConflictsOutput, error) {
+	// 入力値の基本検証
+	if input.SenderID == "" {
+		return nil, fmt.Errorf("送信者IDは必須です")
+	}
+	if input.Window < 0 {
+		return nil, fmt.Errorf("重複判定の範囲は0以上である必要があります")
+	}
+	if input.Window > MaxConflictWindow {
+		return nil, fmt.Errorf("重複判定の範囲は24時間以内で指定してください")
+	}
+	if input.Window == 0 {
+		input.Window = DefaultConflictWindow
+	}
+
+	// 送信者の存在確認
+	if _, err := uc.userRepo.FindByID(ctx, input.SenderID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("送信者が見つかりません")
+		}
+		return nil, fmt.Errorf("送信者の確認中にエラーが発生しました: %w", err)
+	}
+
+	// 送信者のコールをすべて読み、予定中のものだけをコピーして対象にする
+	var scheduledCalls []*entity.MorningCall
+	err := forEachSentCall(ctx, uc.morningCallRepo, input.SenderID, func(call entity.ReadOnlyMorningCall) {
+		if call.Status() == valueobject.MorningCallStatusScheduled {
+			scheduledCalls = append(scheduledCalls, call.Clone())
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("送信モーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+
+	return &FindScheduleConflictsOutput{
+		Groups: groupConflicts(scheduledCalls, input.Window),
+		Window: input.Window,
+	}, nil
+}
+
+// groupConflicts はスケジュール時刻が範囲内で連続するコールをグループ化する
+// 隣接するコールの時刻差が範囲未満であれば同じグループとみなし、2件以上のグループのみ返す
+func groupConflicts(calls []*entity.MorningCall, window time.Duration) []ConflictGroup {
+	groups := []ConflictGroup{}
+	if len(calls) < 2 {
+		return groups
+	}
+
+	sorted := make([]*entity.MorningCall, len(calls))
+	copy(sorted, calls)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].ScheduledTime.Equal(sorted[j].ScheduledTime) {
+			return sorted[i].ID < sorted[j].ID
+		}
+		return sorted[i].ScheduledTime.Before(sorted[j].ScheduledTime)
+	})
+
+	current := []*entity.MorningCall{sorted[0]}
+	flush := func() {
+		if len(current) >= 2 {
+			groups = append(groups, ConflictGroup{
+				MorningCalls: current,
+				StartTime:    current[0].ScheduledTime,
+				EndTime:      current[len(current)-1].ScheduledTime,
+			})
+		}
+	}
+
+	for _, call := range sorted[1:] {
+		prev := current[len(current)-1]
+		if call.ScheduledTime.Sub(prev.ScheduledTime) < window {
+			current = append(current, call)
+			continue
+		}
+		flush()
+		current = []*entity.MorningCall{call}
+	}
+	flush()
+
+	return groups
+}
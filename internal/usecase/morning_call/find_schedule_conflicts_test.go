@@ -0,0 +1,250 @@
+package morning_call
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestNewFindScheduleConflictsUseCase(t *testing.T) {
+	morningCallRepo := memory.NewMorningCallRepository()
+	userRepo := memory.NewUserRepository()
+
+	uc := NewFindScheduleConflictsUseCase(morningCallRepo, userRepo)
+
+	if uc == nil {
+		t.Fatal("NewFindScheduleConflictsUseCase returned nil")
+	}
+	if uc.morningCallRepo == nil {
+		t.Error("morningCallRepo is nil")
+	}
+	if uc.userRepo == nil {
+		t.Error("userRepo is nil")
+	}
+}
+
+func TestFindScheduleConflictsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	base := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+
+	type callDef struct {
+		id     string
+		offset time.Duration
+		status valueobject.MorningCallStatus
+	}
+
+	tests := []struct {
+		name       string
+		calls      []callDef
+		window     time.Duration
+		wantGroups [][]string
+	}{
+		{
+			name:       "コールなし",
+			calls:      nil,
+			wantGroups: [][]string{},
+		},
+		{
+			name: "重複なし",
+			calls: []callDef{
+				{"mc1", 0, valueobject.MorningCallStatusScheduled},
+				{"mc2", 5 * time.Minute, valueobject.MorningCallStatusScheduled},
+			},
+			wantGroups: [][]string{},
+		},
+		{
+			name: "同一分内の重複（デフォルト範囲）",
+			calls: []callDef{
+				{"mc1", 0, valueobject.MorningCallStatusScheduled},
+				{"mc2", 30 * time.Second, valueobject.MorningCallStatusScheduled},
+				{"mc3", 10 * time.Minute, valueobject.MorningCallStatusScheduled},
+			},
+			wantGroups: [][]string{{"mc1", "mc2"}},
+		},
+		{
+			name: "範囲ちょうどの差は重複としない",
+			calls: []callDef{
+				{"mc1", 0, valueobject.MorningCallStatusScheduled},
+				{"mc2", time.Minute, valueobject.MorningCallStatusScheduled},
+			},
+			wantGroups: [][]string{},
+		},
+		{
+			name: "連鎖する重複は1つのグループにまとめる",
+			calls: []callDef{
+				{"mc1", 0, valueobject.MorningCallStatusScheduled},
+				{"mc2", 4 * time.Minute, valueobject.MorningCallStatusScheduled},
+				{"mc3", 8 * time.Minute, valueobject.MorningCallStatusScheduled},
+				{"mc4", 30 * time.Minute, valueobject.MorningCallStatusScheduled},
+				{"mc5", 32 * time.Minute, valueobject.MorningCallStatusScheduled},
+			},
+			window:     5 * time.Minute,
+			wantGroups: [][]string{{"mc1", "mc2", "mc3"}, {"mc4", "mc5"}},
+		},
+		{
+			name: "予定中以外のコールは対象外",
+			calls: []callDef{
+				{"mc1", 0, valueobject.MorningCallStatusScheduled},
+				{"mc2", 10 * time.Second, valueobject.MorningCallStatusCancelled},
+				{"mc3", 20 * time.Second, valueobject.MorningCallStatusConfirmed},
+			},
+			wantGroups: [][]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			morningCallRepo := memory.NewMorningCallRepository()
+			userRepo := memory.NewUserRepository()
+
+			sender := &entity.User{
+				ID:           "sender",
+				Username:     "sender",
+				Email:        "sender@example.com",
+				PasswordHash: "hashed_password",
+				CreatedAt:    time.Now(),
+				UpdatedAt:    time.Now(),
+			}
+			if err := userRepo.Create(ctx, sender); err != nil {
+				t.Fatalf("failed to create sender: %v", err)
+			}
+
+			for i, c := range tt.calls {
+				mc := &entity.MorningCall{
+					ID:            c.id,
+					SenderID:      sender.ID,
+					ReceiverID:    fmt.Sprintf("receiver%d", i),
+					ScheduledTime: base.Add(c.offset),
+					Message:       "起きて",
+					Status:        c.status,
+					CreatedAt:     time.Now(),
+					UpdatedAt:     time.Now(),
+				}
+				if err := morningCallRepo.Create(ctx, mc); err != nil {
+					t.Fatalf("failed to create morning call: %v", err)
+				}
+			}
+
+			uc := NewFindScheduleConflictsUseCase(morningCallRepo, userRepo)
+			output, err := uc.Execute(ctx, FindScheduleConflictsInput{
+				SenderID: sender.ID,
+				Window:   tt.window,
+			})
+			if err != nil {
+				t.Fatalf("予期しないエラー: %v", err)
+			}
+
+			if len(output.Groups) != len(tt.wantGroups) {
+				t.Fatalf("グループ数 = %d, want %d", len(output.Groups), len(tt.wantGroups))
+			}
+			for i, want := range tt.wantGroups {
+				group := output.Groups[i]
+				if len(group.MorningCalls) != len(want) {
+					t.Fatalf("グループ%dの件数 = %d, want %d", i, len(group.MorningCalls), len(want))
+				}
+				for j, id := range want {
+					if group.MorningCalls[j].ID != id {
+						t.Errorf("グループ%d[%d] = %s, want %s", i, j, group.MorningCalls[j].ID, id)
+					}
+				}
+				if !group.StartTime.Equal(group.MorningCalls[0].ScheduledTime) {
+					t.Errorf("StartTimeが先頭のコールと一致しない")
+				}
+				if !group.EndTime.Equal(group.MorningCalls[len(want)-1].ScheduledTime) {
+					t.Errorf("EndTimeが末尾のコールと一致しない")
+				}
+			}
+		})
+	}
+}
+
+func TestFindScheduleConflictsUseCase_Execute_ManyCalls(t *testing.T) {
+	ctx := context.Background()
+	base := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	morningCallRepo := memory.NewMorningCallRepository()
+	userRepo := memory.NewUserRepository()
+	if err := userRepo.Create(ctx, &entity.User{ID: "sender", Username: "sender", Email: "sender@example.com", PasswordHash: "hashed_password"}); err != nil {
+		t.Fatalf("failed to create sender: %v", err)
+	}
+
+	// 一度に取得する件数を超えるコールを用意し、重複する2件は最後に取得される最も早い時刻にする
+	create := func(id string, scheduledTime time.Time) {
+		t.Helper()
+		if err := morningCallRepo.Create(ctx, &entity.MorningCall{
+			ID:            id,
+			SenderID:      "sender",
+			ReceiverID:    "receiver",
+			ScheduledTime: scheduledTime,
+			Message:       "起きて",
+			Status:        valueobject.MorningCallStatusScheduled,
+		}); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+	for i := 0; i < sentCallBatchSize; i++ {
+		create(fmt.Sprintf("later%04d", i), base.Add(time.Duration(i+1)*time.Hour))
+	}
+	create("early1", base)
+	create("early2", base.Add(30*time.Second))
+
+	output, err := NewFindScheduleConflictsUseCase(morningCallRepo, userRepo).Execute(ctx, FindScheduleConflictsInput{SenderID: "sender"})
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+	if len(output.Groups) != 1 || len(output.Groups[0].MorningCalls) != 2 ||
+		output.Groups[0].MorningCalls[0].ID != "early1" || output.Groups[0].MorningCalls[1].ID != "early2" {
+		t.Errorf("Groups = %+v, want one group of early1 and early2", output.Groups)
+	}
+}
+
+func TestFindScheduleConflictsUseCase_Execute_InputValidation(t *testing.T) {
+	ctx := context.Background()
+	morningCallRepo := memory.NewMorningCallRepository()
+	userRepo := memory.NewUserRepository()
+	uc := NewFindScheduleConflictsUseCase(morningCallRepo, userRepo)
+
+	tests := []struct {
+		name   string
+		input  FindScheduleConflictsInput
+		errMsg string
+	}{
+		{
+			name:   "送信者IDが空",
+			input:  FindScheduleConflictsInput{},
+			errMsg: "送信者IDは必須です",
+		},
+		{
+			name:   "負の範囲",
+			input:  FindScheduleConflictsInput{SenderID: "sender", Window: -time.Minute},
+			errMsg: "重複判定の範囲は0以上である必要があります",
+		},
+		{
+			name:   "範囲が上限超過",
+			input:  FindScheduleConflictsInput{SenderID: "sender", Window: 25 * time.Hour},
+			errMsg: "重複判定の範囲は24時間以内で指定してください",
+		},
+		{
+			name:   "存在しない送信者",
+			input:  FindScheduleConflictsInput{SenderID: "unknown"},
+			errMsg: "送信者が見つかりません",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.Execute(ctx, tt.input)
+			if err == nil {
+				t.Fatal("エラーが期待されたが成功した")
+			}
+			if !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("期待するエラーメッセージ = %q, 実際 = %q", tt.errMsg, err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+const (
+	// DefaultFrequentReceiversLimit はよく送る相手の取得件数のデフォルト値
+	DefaultFrequentReceiversLimit = 5
+	// MaxFrequentReceiversLimit はよく送る相手の取得件数の最大値
+	MaxFrequentReceiversLimit = 50
+)
+
+// FrequentReceiversUseCase は送信頻度の高い受信者を取得するユースケース
+type FrequentReceiversUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	userRepo        repository.UserRepository
+}
+
+// NewFrequentReceiversUseCase は新しいよく送る相手取得ユースケースを作成する
+func NewFrequentReceiversUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	userRepo repository.UserRepository,
+) *FrequentReceiversUseCase {
+	return &FrequentReceiversUseCase{
+		morningCallRepo: morningCallRepo,
+		userRepo:        userRepo,
+	}
+}
+
+// FrequentReceiversInput はよく送る相手取得の入力データ
+type FrequentReceiversInput struct {
+	SenderID string // 必須：送信者のID
+	Limit    int    // オプション：取得件数（デフォルト5件、最大50件）
+}
+
+// FrequentReceiver は送信頻度の集計結果
+type FrequentReceiver struct {
+	User       *entity.User
+	SendCount  int       // 累計送信数
+	LastSentAt time.Time // 直近の送信（作成）時刻
+}
+
+// FrequentReceiversOutput はよく送る相手取得の出力データ
+type FrequentReceiversOutput struct {
+	Receivers []FrequentReceiver // 送信数の降順
+}
+
+// Execute は送信者の過去のコール履歴を受信者別に集計し、送信数の多い順に返す
+func (uc *FrequentReceiversUseCase) Execute(ctx context.Context, input FrequentReceiversInput) (*FrequentReceiversOutput, error) {
+	// 入力値の基本検証
+	if input.SenderID == "" {
+		return nil, fmt.Errorf("送信者IDは必須です")
+	}
+	if input.Limit < 0 {
+		return nil, fmt.Errorf("取得件数は0以上である必要があります")
+	}
+	if input.Limit == 0 {
+		input.Limit = DefaultFrequentReceiversLimit
+	}
+	if input.Limit > MaxFrequentReceiversLimit {
+		input.Limit = MaxFrequentReceiversLimit // 最大値制限
+	}
+
+	// 送信者の存在確認
+	if _, err := uc.userRepo.FindByID(ctx, input.SenderID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("送信者が見つかりません")
+		}
+		return nil, fmt.Errorf("送信者の確認中にエラーが発生しました: %w", err)
+	}
+
+	// 送信者のコールをすべて読み、受信者別に送信数と直近送信時刻を集計
+	stats := make(map[string]*FrequentReceiver)
+	err := forEachSentCall(ctx, uc.morningCallRepo, input.SenderID, func(call entity.ReadOnlyMorningCall) {
+		s, ok := stats[call.ReceiverID()]
+		if !ok {
+			s = &FrequentReceiver{}
+			stats[call.ReceiverID()] = s
+		}
+		s.SendCount++
+		if call.CreatedAt().After(s.LastSentAt) {
+			s.LastSentAt = call.CreatedAt()
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("送信モーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+
+	receiverIDs := make([]string, 0, len(stats))
+	for id := range stats {
+		receiverIDs = append(receiverIDs, id)
+	}
+	// 送信数の降順、同数の場合は直近に送った順、さらにIDで順序を確定させる
+	sort.Slice(receiverIDs, func(i, j int) bool {
+		a, b := stats[receiverIDs[i]], stats[receiverIDs[j]]
+		if a.SendCount != b.SendCount {
+			return a.SendCount > b.SendCount
+		}
+		if !a.LastSentAt.Equal(b.LastSentAt) {
+			return a.LastSentAt.After(b.LastSentAt)
+		}
+		return receiverIDs[i] < receiverIDs[j]
+	})
+
+	receivers := make([]FrequentReceiver, 0, input.Limit)
+	for _, id := range receiverIDs {
+		if len(receivers) >= input.Limit {
+			break
+		}
+
+		receiver, err := uc.userRepo.FindByID(ctx, id)
+		if err != nil {
+			// 退会済みのユーザーは候補から除外する
+			if errors.Is(err, repository.ErrNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("受信者の取得中にエラーが発生しました: %w", err)
+		}
+
+		s := stats[id]
+		s.User = receiver
+		receivers = append(receivers, *s)
+	}
+
+	return &FrequentReceiversOutput{
+		Receivers: receivers,
+	}, nil
+}
@@ -0,0 +1,196 @@
+package morning_call
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestFrequentReceiversUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	base := time.Now().Add(-72 * time.Hour)
+
+	morningCallRepo := memory.NewMorningCallRepository()
+	userRepo := memory.NewUserRepository()
+
+	for _, u := range []*entity.User{
+		{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+		{ID: "bob", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+		{ID: "charlie", Username: "charlie", Email: "charlie@example.com", PasswordHash: "hashed_password"},
+		{ID: "dave", Username: "dave", Email: "dave@example.com", PasswordHash: "hashed_password"},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+
+	// bob: 3件、charlie: 2件（最新）、dave: 2件、退会済みユーザー: 5件
+	calls := []struct {
+		receiverID string
+		offset     time.Duration
+	}{
+		{"bob", 0}, {"bob", time.Hour}, {"bob", 2 * time.Hour},
+		{"charlie", 3 * time.Hour}, {"charlie", 10 * time.Hour},
+		{"dave", 4 * time.Hour}, {"dave", 5 * time.Hour},
+		{"deleted", 0}, {"deleted", time.Hour}, {"deleted", 2 * time.Hour}, {"deleted", 3 * time.Hour}, {"deleted", 4 * time.Hour},
+	}
+	for i, c := range calls {
+		mc := &entity.MorningCall{
+			ID:            fmt.Sprintf("mc%d", i),
+			SenderID:      "sender",
+			ReceiverID:    c.receiverID,
+			ScheduledTime: base.Add(c.offset + 24*time.Hour),
+			Status:        valueobject.MorningCallStatusConfirmed,
+			CreatedAt:     base.Add(c.offset),
+			UpdatedAt:     base.Add(c.offset),
+		}
+		if err := morningCallRepo.Create(ctx, mc); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+	// 他の送信者のコールは集計対象外
+	other := &entity.MorningCall{
+		ID:            "other",
+		SenderID:      "bob",
+		ReceiverID:    "dave",
+		ScheduledTime: base.Add(24 * time.Hour),
+		Status:        valueobject.MorningCallStatusScheduled,
+		CreatedAt:     base,
+		UpdatedAt:     base,
+	}
+	if err := morningCallRepo.Create(ctx, other); err != nil {
+		t.Fatalf("failed to create morning call: %v", err)
+	}
+
+	uc := NewFrequentReceiversUseCase(morningCallRepo, userRepo)
+
+	tests := []struct {
+		name      string
+		input     FrequentReceiversInput
+		wantIDs   []string
+		wantCount []int
+		wantErr   string
+	}{
+		{
+			name:      "送信数の降順、同数は直近に送った順",
+			input:     FrequentReceiversInput{SenderID: "sender"},
+			wantIDs:   []string{"bob", "charlie", "dave"},
+			wantCount: []int{3, 2, 2},
+		},
+		{
+			name:      "件数を制限できる",
+			input:     FrequentReceiversInput{SenderID: "sender", Limit: 1},
+			wantIDs:   []string{"bob"},
+			wantCount: []int{3},
+		},
+		{
+			name:      "送信履歴がない場合は空",
+			input:     FrequentReceiversInput{SenderID: "charlie"},
+			wantIDs:   []string{},
+			wantCount: []int{},
+		},
+		{
+			name:    "送信者ID未指定",
+			input:   FrequentReceiversInput{},
+			wantErr: "送信者IDは必須です",
+		},
+		{
+			name:    "存在しない送信者",
+			input:   FrequentReceiversInput{SenderID: "unknown"},
+			wantErr: "送信者が見つかりません",
+		},
+		{
+			name:    "負の件数",
+			input:   FrequentReceiversInput{SenderID: "sender", Limit: -1},
+			wantErr: "取得件数は0以上である必要があります",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := uc.Execute(ctx, tt.input)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(output.Receivers) != len(tt.wantIDs) {
+				t.Fatalf("got %d receivers, want %d", len(output.Receivers), len(tt.wantIDs))
+			}
+			for i, r := range output.Receivers {
+				if r.User.ID != tt.wantIDs[i] {
+					t.Errorf("receivers[%d] = %s, want %s", i, r.User.ID, tt.wantIDs[i])
+				}
+				if r.SendCount != tt.wantCount[i] {
+					t.Errorf("receivers[%d].SendCount = %d, want %d", i, r.SendCount, tt.wantCount[i])
+				}
+			}
+		})
+	}
+
+	t.Run("直近送信時刻は最新のコールの作成時刻", func(t *testing.T) {
+		output, err := uc.Execute(ctx, FrequentReceiversInput{SenderID: "sender"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := base.Add(10 * time.Hour); !output.Receivers[1].LastSentAt.Equal(want) {
+			t.Errorf("LastSentAt = %v, want %v", output.Receivers[1].LastSentAt, want)
+		}
+	})
+}
+
+func TestFrequentReceiversUseCase_Execute_ManyCalls(t *testing.T) {
+	ctx := context.Background()
+	base := time.Now().Add(-72 * time.Hour)
+
+	morningCallRepo := memory.NewMorningCallRepository()
+	userRepo := memory.NewUserRepository()
+	for _, u := range []*entity.User{
+		{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+		{ID: "bob", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+		{ID: "charlie", Username: "charlie", Email: "charlie@example.com", PasswordHash: "hashed_password"},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+
+	// 以前の取得上限（10000件）を超える送信履歴のうち、charlieへのコールは最も古い予定時刻にして最後に取得されるようにする
+	create := func(id, receiverID string, offset time.Duration) {
+		t.Helper()
+		if err := morningCallRepo.Create(ctx, &entity.MorningCall{
+			ID:            id,
+			SenderID:      "sender",
+			ReceiverID:    receiverID,
+			ScheduledTime: base.Add(offset + 24*time.Hour),
+			Status:        valueobject.MorningCallStatusConfirmed,
+			CreatedAt:     base.Add(offset),
+			UpdatedAt:     base.Add(offset),
+		}); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+	for i := 0; i < 10000; i++ {
+		create(fmt.Sprintf("bob%05d", i), "bob", time.Duration(i+1)*time.Second)
+	}
+	create("charlie1", "charlie", 0)
+
+	output, err := NewFrequentReceiversUseCase(morningCallRepo, userRepo).Execute(ctx, FrequentReceiversInput{SenderID: "sender"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(output.Receivers) != 2 || output.Receivers[0].SendCount != 10000 || output.Receivers[1].User.ID != "charlie" || output.Receivers[1].SendCount != 1 {
+		t.Errorf("Receivers = %+v, want bob with 10000 calls and charlie with 1", output.Receivers)
+	}
+}
@@ -0,0 +1,327 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+const (
+	// MaxReportDays は任意の集計期間として指定できる最大日数
+	MaxReportDays = 366
+	// ReportDateLayout は集計期間の開始日・終了日の形式
+	ReportDateLayout = "2006-01-02"
+	// reportTopWakersLimit はよく起こしてくれた人として返す人数
+	reportTopWakersLimit = 3
+	// reportBatchSize はコールを一度に取得する件数
+	reportBatchSize = 500
+)
+
+// GenerateReportUseCase は指定期間のモーニングコールの送受信をまとめたレポートを生成するユースケース
+// レポートはJSONまたはCSVで書き出し、APIからのダウンロードと定期配信（DeliverReportsUseCase）の両方で同じ内容を使う
+type GenerateReportUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	userRepo        repository.UserRepository
+	now             func() time.Time
+}
+
+// NewGenerateReportUseCase は新しいレポート生成ユースケースを作成する
+func NewGenerateReportUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	userRepo repository.UserRepository,
+) *GenerateReportUseCase {
+	return &GenerateReportUseCase{
+		morningCallRepo: morningCallRepo,
+		userRepo:        userRepo,
+		now:             time.Now,
+	}
+}
+
+// GenerateReportInput はレポート生成の入力データ
+// 期間はPeriodまたはFrom・Toのいずれかで指定し、どちらも未指定の場合は先週（月曜日から日曜日）とする
+type GenerateReportInput struct {
+	UserID string                   // 必須：レポートの対象ユーザーのID
+	Period valueobject.ReportPeriod // オプション：直前に終わった週・月を集計する
+	From   string                   // オプション：集計開始日（YYYY-MM-DD、ユーザーのタイムゾーン。Toと同時に指定する）
+	To     string                   // オプション：集計終了日（YYYY-MM-DD、この日を含む）
+	Format valueobject.ReportFormat // オプション：出力形式（デフォルトはJSON）
+}
+
+// GenerateReportOutput はレポート生成の出力データ
+type GenerateReportOutput struct {
+	Report   *MorningCallReport
+	Format   valueobject.ReportFormat
+	Filename string // ダウンロード・添付するときのファイル名
+	Content  []byte // 出力形式で書き出したレポート
+}
+
+// ReportCallSummary は送信または受信したコールの集計
+// 取り消し・スキップ・拒否されたコールと管理者により削除されたコールは数えない
+type ReportCallSummary struct {
+	Total     int // 期間内にアラーム時刻があるコールの件数
+	Confirmed int // 起床確認されたコールの件数
+	Missed    int // 起床確認されないまま期限切れになったコールの件数
+	Pending   int // まだ結果の出ていないコールの件数
+}
+
+// ConfirmationRate は結果の出たコールのうち起床確認された割合を返す（結果の出たコールがない場合は0）
+func (s ReportCallSummary) ConfirmationRate() float64 {
+	finished := s.Confirmed + s.Missed
+	if finished == 0 {
+		return 0
+	}
+	return float64(s.Confirmed) / float64(finished)
+}
+
+// ReportUserCount はユーザーごとの件数
+type ReportUserCount struct {
+	UserID   string
+	Username string
+	Count    int
+}
+
+// MorningCallReport は期間内のモーニングコールの送受信をまとめたレポート
+type MorningCallReport struct {
+	UserID   string
+	Username string
+	Period   valueobject.ReportPeriod // 任意の期間を指定した場合は空
+	From     time.Time                // 集計開始日の0時（ユーザーのタイムゾーン）
+	To       time.Time                // 集計終了日の翌日0時（この時刻を含まない）
+	Location *time.Location           // 日付の基準にしたタイムゾーン
+
+	Sent     ReportCallSummary // 自分が他のユーザーへ送ったコール（セルフコールを除く）
+	Received ReportCallSummary // 自分が受け取ったコール（セルフコールを含む）
+
+	LongestStreak int // 期間内で起床確認した日が連続した最長日数
+	CurrentStreak int // 期間の最終日まで起床確認した日が連続している日数
+
+	TopWakers []ReportUserCount // 起床確認したコールを多く送ってくれた人（件数の降順）
+}
+
+// IsEmpty は期間内に集計対象のコールが1件もないかを判定する
+func (r *MorningCallReport) IsEmpty() bool {
+	return r.Sent.Total == 0 && r.Received.Total == 0
+}
+
+// Execute はユーザーの指定期間のレポートを生成し、指定の形式で書き出す
+func (uc *GenerateReportUseCase) Execute(ctx context.Context, input GenerateReportInput) (*GenerateReportOutput, error) {
+	// 入力値の基本検証
+	if input.UserID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+	if input.Format == "" {
+		input.Format = valueobject.ReportFormatJSON
+	}
+	if !input.Format.IsValid() {
+		return nil, fmt.Errorf("%w", valueobject.NGWithCode(valueobject.ReasonCodeInvalid, "format", "出力形式は json または csv を指定してください"))
+	}
+
+	// ユーザーの存在確認（日付はユーザーのタイムゾーンで解釈する）
+	user, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("ユーザーの確認中にエラーが発生しました: %w", err)
+	}
+
+	period, start, end, reason := uc.resolvePeriod(input, user.Location())
+	if reason.IsNG() {
+		return nil, fmt.Errorf("集計期間が不正です: %w", reason)
+	}
+
+	return uc.generate(ctx, user, period, start, end, input.Format)
+}
+
+// generate はユーザーの期間 [start, end) のレポートを集計し、指定の形式で書き出す
+func (uc *GenerateReportUseCase) generate(ctx context.Context, user *entity.User, period valueobject.ReportPeriod, start, end time.Time, format valueobject.ReportFormat) (*GenerateReportOutput, error) {
+	report := &MorningCallReport{
+		UserID:   user.ID,
+		Username: user.Username,
+		Period:   period,
+		From:     start,
+		To:       end,
+		Location: user.Location(),
+	}
+
+	// 送信したコール（セルフコールは受信側で数える）
+	err := uc.eachCallInRange(ctx, uc.morningCallRepo.FindReadOnlyBySenderID, user.ID, start, end, func(mc entity.ReadOnlyMorningCall) {
+		if mc.ReceiverID() != user.ID {
+			countReportCall(&report.Sent, mc)
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("送信したモーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+
+	// 受信したコールと、起床確認した日・起こしてくれた人
+	wakeDays := make(map[string]bool)
+	wakers := make(map[string]int)
+	err = uc.eachCallInRange(ctx, uc.morningCallRepo.FindReadOnlyByReceiverID, user.ID, start, end, func(mc entity.ReadOnlyMorningCall) {
+		countReportCall(&report.Received, mc)
+		if mc.Status() != valueobject.MorningCallStatusConfirmed || mc.AutoConfirmed() {
+			return
+		}
+		wakeDays[mc.EffectiveScheduledTime().In(report.Location).Format(ReportDateLayout)] = true
+		if mc.SenderID() != user.ID {
+			wakers[mc.SenderID()]++
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("受信したモーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+
+	report.LongestStreak, report.CurrentStreak = reportStreaks(wakeDays, start, end)
+	if report.TopWakers, err = uc.topWakers(ctx, wakers); err != nil {
+		return nil, err
+	}
+
+	content, err := encodeReport(report, format)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode report: %w", err)
+	}
+
+	return &GenerateReportOutput{
+		Report:   report,
+		Format:   format,
+		Filename: fmt.Sprintf("morning-call-report_%s_%s.%s", start.Format(ReportDateLayout), end.AddDate(0, 0, -1).Format(ReportDateLayout), format),
+		Content:  content,
+	}, nil
+}
+
+// eachCallInRange はfindで取得したコールのうち、配信時刻が期間 [start, end) 内のものについてfnを呼び出す
+// 集計のみなのでコピーを作らない読み取り専用ビューで取得する
+func (uc *GenerateReportUseCase) eachCallInRange(
+	ctx context.Context,
+	find func(ctx context.Context, userID string, offset, limit int) ([]entity.ReadOnlyMorningCall, error),
+	userID string,
+	start, end time.Time,
+	fn func(mc entity.ReadOnlyMorningCall),
+) error {
+	for offset := 0; ; offset += reportBatchSize {
+		calls, err := find(ctx, userID, offset, reportBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, mc := range calls {
+			if mc.IsDeleted() {
+				continue
+			}
+			at := mc.EffectiveScheduledTime()
+			if at.Before(start) || !at.Before(end) {
+				continue
+			}
+			fn(mc)
+		}
+		if len(calls) < reportBatchSize {
+			return nil
+		}
+	}
+}
+
+// topWakers は起床確認したコールの件数が多い送信者を返す（退会済みのユーザーは除く）
+func (uc *GenerateReportUseCase) topWakers(ctx context.Context, counts map[string]int) ([]ReportUserCount, error) {
+	senderIDs := make([]string, 0, len(counts))
+	for id := range counts {
+		senderIDs = append(senderIDs, id)
+	}
+	// 件数の降順、同数の場合はIDで順序を確定させる
+	sort.Slice(senderIDs, func(i, j int) bool {
+		if counts[senderIDs[i]] != counts[senderIDs[j]] {
+			return counts[senderIDs[i]] > counts[senderIDs[j]]
+		}
+		return senderIDs[i] < senderIDs[j]
+	})
+
+	wakers := make([]ReportUserCount, 0, reportTopWakersLimit)
+	for _, id := range senderIDs {
+		if len(wakers) >= reportTopWakersLimit {
+			break
+		}
+		sender, err := uc.userRepo.FindByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("送信者の取得中にエラーが発生しました: %w", err)
+		}
+		wakers = append(wakers, ReportUserCount{UserID: sender.ID, Username: sender.Username, Count: counts[id]})
+	}
+	return wakers, nil
+}
+
+// resolvePeriod は集計単位または開始日・終了日から集計期間 [start, end) を求める
+func (uc *GenerateReportUseCase) resolvePeriod(input GenerateReportInput, loc *time.Location) (valueobject.ReportPeriod, time.Time, time.Time, valueobject.NGReason) {
+	if input.From == "" && input.To == "" {
+		period := input.Period
+		if period == "" {
+			period = valueobject.ReportPeriodWeekly
+		}
+		if !period.IsValid() {
+			return "", time.Time{}, time.Time{}, valueobject.NGWithCode(valueobject.ReasonCodeInvalid, "period", "集計単位は weekly または monthly を指定してください")
+		}
+		start, end := period.PreviousRange(uc.now(), loc)
+		return period, start, end, valueobject.OK()
+	}
+
+	if input.Period != "" {
+		return "", time.Time{}, time.Time{}, valueobject.NGWithCode(valueobject.ReasonCodeInvalid, "period", "periodとfrom・toは同時に指定できません")
+	}
+	if input.From == "" || input.To == "" {
+		return "", time.Time{}, time.Time{}, valueobject.NGWithCode(valueobject.ReasonCodeRequired, "from", "開始日と終了日は両方指定してください")
+	}
+	startDate, err := time.ParseInLocation(ReportDateLayout, input.From, loc)
+	if err != nil {
+		return "", time.Time{}, time.Time{}, valueobject.NGWithCode(valueobject.ReasonCodeInvalidFormat, "from", "開始日は YYYY-MM-DD の形式で指定してください")
+	}
+	endDate, err := time.ParseInLocation(ReportDateLayout, input.To, loc)
+	if err != nil {
+		return "", time.Time{}, time.Time{}, valueobject.NGWithCode(valueobject.ReasonCodeInvalidFormat, "to", "終了日は YYYY-MM-DD の形式で指定してください")
+	}
+	if endDate.Before(startDate) {
+		return "", time.Time{}, time.Time{}, valueobject.NGWithCode(valueobject.ReasonCodeOutOfRange, "to", "終了日は開始日以降の日付を指定してください")
+	}
+	if startDate.AddDate(0, 0, MaxReportDays).Before(endDate.AddDate(0, 0, 1)) {
+		return "", time.Time{}, time.Time{}, valueobject.NGWithCode(valueobject.ReasonCodeOutOfRange, "from", fmt.Sprintf("集計期間は%d日以内で指定してください", MaxReportDays))
+	}
+	return "", startDate, endDate.AddDate(0, 0, 1), valueobject.OK()
+}
+
+// countReportCall はコールの状態に応じて集計に加える
+func countReportCall(summary *ReportCallSummary, mc entity.ReadOnlyMorningCall) {
+	switch mc.Status() {
+	case valueobject.MorningCallStatusConfirmed:
+		summary.Confirmed++
+	case valueobject.MorningCallStatusExpired:
+		summary.Missed++
+	case valueobject.MorningCallStatusPendingApproval,
+		valueobject.MorningCallStatusScheduled,
+		valueobject.MorningCallStatusDelivered:
+		summary.Pending++
+	default:
+		// 取り消し・スキップ・拒否されたコールは起こす予定がなくなったため数えない
+		return
+	}
+	summary.Total++
+}
+
+// reportStreaks は起床確認した日付の集合から、期間 [start, end) 内の最長連続日数と最終日までの連続日数を求める
+func reportStreaks(wakeDays map[string]bool, start, end time.Time) (longest, current int) {
+	for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		if wakeDays[d.Format(ReportDateLayout)] {
+			current++
+			if current > longest {
+				longest = current
+			}
+		} else {
+			current = 0
+		}
+	}
+	return longest, current
+}
@@ -0,0 +1,211 @@
+package morning_call
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+// reportTestCall はレポートのテスト用に作成するコール
+type reportTestCall struct {
+	senderID   string
+	receiverID string
+	scheduled  time.Time
+	status     valueobject.MorningCallStatus
+}
+
+// newReportTestRepos はalice・bob・carolとコールを登録したリポジトリを作成する
+func newReportTestRepos(t *testing.T, calls []reportTestCall) (*memory.MorningCallRepository, *memory.UserRepository) {
+	t.Helper()
+	ctx := context.Background()
+
+	morningCallRepo := memory.NewMorningCallRepository()
+	userRepo := memory.NewUserRepository()
+	for _, name := range []string{"alice", "bob", "carol"} {
+		if err := userRepo.Create(ctx, &entity.User{ID: name, Username: name, Email: name + "@example.com", PasswordHash: "hashed_password", TimeZone: "Asia/Tokyo"}); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+	for i, c := range calls {
+		if err := morningCallRepo.Create(ctx, &entity.MorningCall{
+			ID:            fmt.Sprintf("mc%d", i),
+			SenderID:      c.senderID,
+			ReceiverID:    c.receiverID,
+			ScheduledTime: c.scheduled,
+			Status:        c.status,
+			CreatedAt:     c.scheduled.Add(-24 * time.Hour),
+			UpdatedAt:     c.scheduled,
+		}); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+	return morningCallRepo, userRepo
+}
+
+func TestGenerateReportUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+	day := func(d, hour int) time.Time { return time.Date(2026, 3, d, hour, 0, 0, 0, tokyo) }
+
+	// 先週は3月2日（月）から3月8日（日）
+	morningCallRepo, userRepo := newReportTestRepos(t, []reportTestCall{
+		{"bob", "alice", day(2, 7), valueobject.MorningCallStatusConfirmed},
+		{"bob", "alice", day(3, 7), valueobject.MorningCallStatusConfirmed},
+		{"carol", "alice", day(4, 7), valueobject.MorningCallStatusExpired},
+		{"carol", "alice", day(6, 7), valueobject.MorningCallStatusConfirmed},
+		{"alice", "alice", day(7, 7), valueobject.MorningCallStatusConfirmed},
+		{"bob", "alice", day(8, 7), valueobject.MorningCallStatusConfirmed},
+		{"bob", "alice", day(5, 7), valueobject.MorningCallStatusCancelled},
+		{"alice", "bob", day(3, 7), valueobject.MorningCallStatusConfirmed},
+		{"alice", "carol", day(8, 8), valueobject.MorningCallStatusScheduled},
+		// 先週より前と今週のコールは数えない
+		{"bob", "alice", day(1, 7), valueobject.MorningCallStatusConfirmed},
+		{"bob", "alice", day(9, 7), valueobject.MorningCallStatusConfirmed},
+	})
+
+	uc := NewGenerateReportUseCase(morningCallRepo, userRepo)
+	uc.now = func() time.Time { return day(11, 12) }
+
+	output, err := uc.Execute(ctx, GenerateReportInput{UserID: "alice"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	report := output.Report
+
+	if report.Period != valueobject.ReportPeriodWeekly || !report.From.Equal(day(2, 0)) || !report.To.Equal(day(9, 0)) {
+		t.Errorf("period = %s [%v, %v), want weekly from 3/2 to 3/9", report.Period, report.From, report.To)
+	}
+	wantReceived := ReportCallSummary{Total: 6, Confirmed: 5, Missed: 1}
+	if report.Received != wantReceived {
+		t.Errorf("Received = %+v, want %+v", report.Received, wantReceived)
+	}
+	wantSent := ReportCallSummary{Total: 2, Confirmed: 1, Pending: 1}
+	if report.Sent != wantSent {
+		t.Errorf("Sent = %+v, want %+v", report.Sent, wantSent)
+	}
+	if got := report.Received.ConfirmationRate(); got < 0.83 || got > 0.84 {
+		t.Errorf("Received.ConfirmationRate() = %v, want 5/6", got)
+	}
+	// 3月2日・3日と、3月6日から8日までが連続している
+	if report.LongestStreak != 3 || report.CurrentStreak != 3 {
+		t.Errorf("streak = longest %d, current %d, want 3, 3", report.LongestStreak, report.CurrentStreak)
+	}
+	// セルフコールは起こしてくれた人に含めない
+	if len(report.TopWakers) != 2 || report.TopWakers[0].Username != "bob" || report.TopWakers[0].Count != 3 || report.TopWakers[1].Username != "carol" {
+		t.Errorf("TopWakers = %+v, want bob(3), carol(1)", report.TopWakers)
+	}
+
+	if output.Format != valueobject.ReportFormatJSON || output.Filename != "morning-call-report_2026-03-02_2026-03-08.json" {
+		t.Errorf("Format = %s, Filename = %s", output.Format, output.Filename)
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(output.Content, &doc); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if doc["from"] != "2026-03-02" || doc["to"] != "2026-03-08" || doc["longest_streak"] != float64(3) {
+		t.Errorf("JSON = %s", output.Content)
+	}
+	if received, _ := doc["received"].(map[string]interface{}); received["confirmation_rate"] != 0.833 {
+		t.Errorf("received = %v, want confirmation_rate 0.833", doc["received"])
+	}
+}
+
+func TestGenerateReportUseCase_Execute_CSV(t *testing.T) {
+	ctx := context.Background()
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	morningCallRepo, userRepo := newReportTestRepos(t, []reportTestCall{
+		{"bob", "alice", time.Date(2026, 2, 10, 7, 0, 0, 0, tokyo), valueobject.MorningCallStatusConfirmed},
+	})
+
+	uc := NewGenerateReportUseCase(morningCallRepo, userRepo)
+	uc.now = func() time.Time { return time.Date(2026, 3, 11, 12, 0, 0, 0, tokyo) }
+
+	output, err := uc.Execute(ctx, GenerateReportInput{UserID: "alice", Period: valueobject.ReportPeriodMonthly, Format: valueobject.ReportFormatCSV})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.Filename != "morning-call-report_2026-02-01_2026-02-28.csv" {
+		t.Errorf("Filename = %s", output.Filename)
+	}
+
+	rows, err := csv.NewReader(strings.NewReader(string(output.Content))).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV: %v", err)
+	}
+	values := make(map[string][]string)
+	for _, row := range rows[1:] {
+		values[row[0]] = row[1:]
+	}
+	if strings.Join(rows[0], ",") != "metric,value,username" {
+		t.Errorf("header = %v", rows[0])
+	}
+	if values["received_total"][0] != "1" || values["received_confirmation_rate"][0] != "1.000" || values["sent_confirmation_rate"][0] != "0.000" {
+		t.Errorf("rows = %v", rows)
+	}
+	if got := values["top_waker_1"]; len(got) != 2 || got[0] != "1" || got[1] != "bob" {
+		t.Errorf("top_waker_1 = %v, want [1 bob]", got)
+	}
+}
+
+func TestGenerateReportUseCase_Execute_Validation(t *testing.T) {
+	ctx := context.Background()
+	morningCallRepo, userRepo := newReportTestRepos(t, nil)
+	uc := NewGenerateReportUseCase(morningCallRepo, userRepo)
+
+	tests := []struct {
+		name      string
+		input     GenerateReportInput
+		wantField string
+		wantMsg   string
+	}{
+		{name: "不明な出力形式", input: GenerateReportInput{UserID: "alice", Format: "xml"}, wantField: "format"},
+		{name: "不明な集計単位", input: GenerateReportInput{UserID: "alice", Period: "daily"}, wantField: "period"},
+		{name: "集計単位と日付の同時指定", input: GenerateReportInput{UserID: "alice", Period: valueobject.ReportPeriodWeekly, From: "2026-01-01", To: "2026-01-31"}, wantField: "period"},
+		{name: "開始日のみ", input: GenerateReportInput{UserID: "alice", From: "2026-01-01"}, wantField: "from"},
+		{name: "日付の形式が不正", input: GenerateReportInput{UserID: "alice", From: "2026/01/01", To: "2026-01-31"}, wantField: "from"},
+		{name: "終了日が開始日より前", input: GenerateReportInput{UserID: "alice", From: "2026-01-31", To: "2026-01-01"}, wantField: "to"},
+		{name: "期間が長すぎる", input: GenerateReportInput{UserID: "alice", From: "2025-01-01", To: "2026-01-02"}, wantField: "from"},
+		{name: "ユーザーIDなし", input: GenerateReportInput{}, wantMsg: "ユーザーIDは必須です"},
+		{name: "存在しないユーザー", input: GenerateReportInput{UserID: "unknown"}, wantMsg: "ユーザーが見つかりません"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.Execute(ctx, tt.input)
+			if err == nil {
+				t.Fatal("expected error but got nil")
+			}
+			if tt.wantMsg != "" {
+				if err.Error() != tt.wantMsg {
+					t.Errorf("error = %v, want %s", err, tt.wantMsg)
+				}
+				return
+			}
+			var reason valueobject.NGReason
+			if !errors.As(err, &reason) || reason.Field() != tt.wantField {
+				t.Errorf("error = %v, want NGReason on %s", err, tt.wantField)
+			}
+		})
+	}
+
+	// 任意の期間は終了日を含めて集計する
+	output, err := uc.Execute(ctx, GenerateReportInput{UserID: "alice", From: "2026-01-01", To: "2026-01-31"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.Report.Period != "" || output.Report.To.Sub(output.Report.From) != 31*24*time.Hour {
+		t.Errorf("period = %s [%v, %v)", output.Report.Period, output.Report.From, output.Report.To)
+	}
+}
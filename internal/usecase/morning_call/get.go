@@ -0,0 +1,140 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/pkg/utils"
+)
+
+// languageCodePattern は翻訳先として指定できる言語コードの形式（例: ja, en, pt-BR）
+var languageCodePattern = regexp.MustCompile(`^[A-Za-z]{2,3}(-[A-Za-z0-9]{2,8})?$`)
+
+// GetUseCase はモーニングコール詳細取得のユースケース
+type GetUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	accessLogRepo   repository.MorningCallAccessLogRepository
+	translator      service.Translator
+	now             func() time.Time
+}
+
+// NewGetUseCase は新しいモーニングコール詳細取得ユースケースを作成する
+// accessLogRepoがnilの場合は閲覧記録を残さず、translatorがnilの場合は翻訳を常に失敗として扱う
+func NewGetUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	accessLogRepo repository.MorningCallAccessLogRepository,
+	translator service.Translator,
+) *GetUseCase {
+	return &GetUseCase{
+		morningCallRepo: morningCallRepo,
+		accessLogRepo:   accessLogRepo,
+		translator:      translator,
+		now:             time.Now,
+	}
+}
+
+// GetInput はモーニングコール詳細取得の入力データ
+type GetInput struct {
+	MorningCallID string // 必須：取得するモーニングコールのID
+	ViewerID      string // 必須：閲覧するユーザーのID
+	TranslateTo   string // オプション：メッセージの翻訳先の言語コード（空の場合は翻訳しない）
+}
+
+// MessageTranslation はメッセージの翻訳結果
+type MessageTranslation struct {
+	Language string // 翻訳先の言語コード
+	Message  string // 翻訳したメッセージ（翻訳に失敗した場合は元のメッセージ）
+	Failed   bool   // 翻訳に失敗したか
+}
+
+// GetOutput はモーニングコール詳細取得の出力データ
+type GetOutput struct {
+	MorningCall *entity.MorningCall
+	Translation *MessageTranslation // 翻訳を指定しなかった場合、または翻訳するメッセージがない場合はnil
+}
+
+// Execute は送信者または受信者としてモーニングコールの詳細を取得する
+// 閲覧は権限のない第三者による試行も含めて閲覧記録に残す
+func (uc *GetUseCase) Execute(ctx context.Context, input GetInput) (*GetOutput, error) {
+	// 入力値の基本検証
+	if input.MorningCallID == "" {
+		return nil, fmt.Errorf("モーニングコールIDは必須です")
+	}
+	if input.ViewerID == "" {
+		return nil, fmt.Errorf("閲覧ユーザーIDは必須です")
+	}
+	if input.TranslateTo != "" && !languageCodePattern.MatchString(input.TranslateTo) {
+		reason := valueobject.NGWithCode(valueobject.ReasonCodeInvalidFormat, "translate", "翻訳先の言語コードは ja や en などの形式で指定してください")
+		return nil, fmt.Errorf("翻訳先の言語が不正です: %w", reason)
+	}
+
+	morningCall, err := uc.morningCallRepo.FindByID(ctx, input.MorningCallID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("モーニングコールが見つかりません")
+		}
+		return nil, fmt.Errorf("モーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+
+	// アクセス権限チェック（送信者または受信者）
+	allowed := morningCall.SenderID == input.ViewerID || morningCall.ReceiverID == input.ViewerID
+	uc.recordAccess(ctx, morningCall.ID, input.ViewerID, allowed)
+	if !allowed {
+		return nil, fmt.Errorf("送信者または受信者のみがモーニングコールを閲覧できます")
+	}
+
+	return &GetOutput{
+		MorningCall: morningCall,
+		Translation: uc.translate(ctx, morningCall, input.TranslateTo),
+	}, nil
+}
+
+// translate はメッセージを翻訳する
+// 翻訳に失敗しても取得自体は失敗させず、元のメッセージを返す
+func (uc *GetUseCase) translate(ctx context.Context, morningCall *entity.MorningCall, targetLang string) *MessageTranslation {
+	// 削除されたコールのメッセージは閲覧者に返さないため翻訳もしない
+	if targetLang == "" || morningCall.Message == "" || morningCall.IsDeleted() {
+		return nil
+	}
+
+	result := &MessageTranslation{Language: strings.ToLower(targetLang), Message: morningCall.Message}
+	if uc.translator == nil {
+		result.Failed = true
+		return result
+	}
+
+	translated, err := uc.translator.Translate(ctx, morningCall.Message, result.Language)
+	if err != nil {
+		utils.Logf(ctx, "メッセージの翻訳に失敗しました: %v", err)
+		result.Failed = true
+		return result
+	}
+	result.Message = translated
+	return result
+}
+
+// recordAccess は閲覧記録を1件残す
+func (uc *GetUseCase) recordAccess(ctx context.Context, morningCallID, viewerID string, allowed bool) {
+	if uc.accessLogRepo == nil {
+		return
+	}
+
+	access := &entity.MorningCallAccess{
+		MorningCallID: morningCallID,
+		ViewerID:      viewerID,
+		Allowed:       allowed,
+		AccessedAt:    uc.now(),
+	}
+	if err := uc.accessLogRepo.Record(ctx, access); err != nil {
+		// 閲覧記録の失敗で取得自体は失敗させない
+		utils.Logf(ctx, "閲覧記録の保存に失敗しました: %v", err)
+	}
+}
@@ -0,0 +1,235 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestGetUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+
+	morningCallRepo := memory.NewMorningCallRepository()
+	accessLogRepo := memory.NewMorningCallAccessLogRepository(0)
+	mc := &entity.MorningCall{
+		ID:            "mc1",
+		SenderID:      "sender",
+		ReceiverID:    "receiver",
+		ScheduledTime: now.Add(24 * time.Hour),
+		Message:       "おはよう",
+		Status:        valueobject.MorningCallStatusScheduled,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if err := morningCallRepo.Create(ctx, mc); err != nil {
+		t.Fatalf("failed to create morning call: %v", err)
+	}
+
+	uc := NewGetUseCase(morningCallRepo, accessLogRepo, nil)
+	uc.now = func() time.Time { return now }
+
+	tests := []struct {
+		name        string
+		input       GetInput
+		wantErr     string
+		wantRecord  bool
+		wantAllowed bool
+	}{
+		{
+			name:        "送信者は取得できる",
+			input:       GetInput{MorningCallID: "mc1", ViewerID: "sender"},
+			wantRecord:  true,
+			wantAllowed: true,
+		},
+		{
+			name:        "受信者は取得できる",
+			input:       GetInput{MorningCallID: "mc1", ViewerID: "receiver"},
+			wantRecord:  true,
+			wantAllowed: true,
+		},
+		{
+			name:        "第三者の閲覧試行は拒否して記録する",
+			input:       GetInput{MorningCallID: "mc1", ViewerID: "stranger"},
+			wantErr:     "送信者または受信者のみがモーニングコールを閲覧できます",
+			wantRecord:  true,
+			wantAllowed: false,
+		},
+		{
+			name:    "存在しないコールは記録しない",
+			input:   GetInput{MorningCallID: "unknown", ViewerID: "sender"},
+			wantErr: "モーニングコールが見つかりません",
+		},
+		{
+			name:    "モーニングコールID未指定",
+			input:   GetInput{ViewerID: "sender"},
+			wantErr: "モーニングコールIDは必須です",
+		},
+		{
+			name:    "閲覧ユーザーID未指定",
+			input:   GetInput{MorningCallID: "mc1"},
+			wantErr: "閲覧ユーザーIDは必須です",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before, _ := accessLogRepo.CountByMorningCallID(ctx, "mc1")
+
+			output, err := uc.Execute(ctx, tt.input)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if output.MorningCall.ID != "mc1" {
+					t.Errorf("MorningCall.ID = %s, want mc1", output.MorningCall.ID)
+				}
+			}
+
+			after, _ := accessLogRepo.CountByMorningCallID(ctx, "mc1")
+			if !tt.wantRecord {
+				if after != before {
+					t.Errorf("access log count changed: %d -> %d", before, after)
+				}
+				return
+			}
+			if after != before+1 {
+				t.Fatalf("access log count = %d, want %d", after, before+1)
+			}
+			logs, _ := accessLogRepo.FindByMorningCallID(ctx, "mc1", 0, 1)
+			latest := logs[0]
+			if latest.ViewerID != tt.input.ViewerID || latest.Allowed != tt.wantAllowed || !latest.AccessedAt.Equal(now) {
+				t.Errorf("latest access = %+v, want viewer=%s allowed=%v at=%v", latest, tt.input.ViewerID, tt.wantAllowed, now)
+			}
+		})
+	}
+
+	t.Run("アクセスログなしでも取得できる", func(t *testing.T) {
+		output, err := NewGetUseCase(morningCallRepo, nil, nil).Execute(ctx, GetInput{MorningCallID: "mc1", ViewerID: "sender"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.MorningCall.ID != "mc1" {
+			t.Errorf("MorningCall.ID = %s, want mc1", output.MorningCall.ID)
+		}
+	})
+}
+
+// fakeTranslator は呼び出しを記録するテスト用のTranslator
+type fakeTranslator struct {
+	translated string
+	err        error
+	calls      []string // 翻訳先の言語コード
+}
+
+func (f *fakeTranslator) Translate(_ context.Context, _ string, targetLang string) (string, error) {
+	f.calls = append(f.calls, targetLang)
+	return f.translated, f.err
+}
+
+func TestGetUseCase_Execute_Translate(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+
+	morningCallRepo := memory.NewMorningCallRepository()
+	for _, mc := range []*entity.MorningCall{
+		{ID: "mc1", SenderID: "sender", ReceiverID: "receiver", ScheduledTime: now.Add(time.Hour), Message: "Good morning", Status: valueobject.MorningCallStatusScheduled},
+		{ID: "mc2", SenderID: "sender", ReceiverID: "receiver", ScheduledTime: now.Add(time.Hour), Status: valueobject.MorningCallStatusScheduled},
+	} {
+		if err := morningCallRepo.Create(ctx, mc); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name       string
+		translator *fakeTranslator
+		input      GetInput
+		want       *MessageTranslation
+		wantCalls  []string
+		wantErr    string
+	}{
+		{
+			name:       "指定した言語に翻訳する",
+			translator: &fakeTranslator{translated: "おはよう"},
+			input:      GetInput{MorningCallID: "mc1", ViewerID: "receiver", TranslateTo: "JA"},
+			want:       &MessageTranslation{Language: "ja", Message: "おはよう"},
+			wantCalls:  []string{"ja"},
+		},
+		{
+			name:       "翻訳に失敗した場合は元のメッセージを返す",
+			translator: &fakeTranslator{err: errors.New("translation service unavailable")},
+			input:      GetInput{MorningCallID: "mc1", ViewerID: "receiver", TranslateTo: "fr"},
+			want:       &MessageTranslation{Language: "fr", Message: "Good morning", Failed: true},
+			wantCalls:  []string{"fr"},
+		},
+		{
+			name:       "翻訳を指定しない場合は翻訳しない",
+			translator: &fakeTranslator{translated: "おはよう"},
+			input:      GetInput{MorningCallID: "mc1", ViewerID: "receiver"},
+		},
+		{
+			name:       "メッセージがない場合は翻訳しない",
+			translator: &fakeTranslator{translated: "おはよう"},
+			input:      GetInput{MorningCallID: "mc2", ViewerID: "receiver", TranslateTo: "ja"},
+		},
+		{
+			name:       "不正な言語コード",
+			translator: &fakeTranslator{translated: "おはよう"},
+			input:      GetInput{MorningCallID: "mc1", ViewerID: "receiver", TranslateTo: "japanese!"},
+			wantErr:    "翻訳先の言語コードは ja や en などの形式で指定してください",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewGetUseCase(morningCallRepo, nil, tt.translator)
+
+			output, err := uc.Execute(ctx, tt.input)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.want == nil {
+				if output.Translation != nil {
+					t.Errorf("Translation = %+v, want nil", output.Translation)
+				}
+			} else if output.Translation == nil || *output.Translation != *tt.want {
+				t.Errorf("Translation = %+v, want %+v", output.Translation, tt.want)
+			}
+			if strings.Join(tt.translator.calls, ",") != strings.Join(tt.wantCalls, ",") {
+				t.Errorf("translator calls = %v, want %v", tt.translator.calls, tt.wantCalls)
+			}
+			// 元のメッセージは変更しない
+			if output.MorningCall.ID == "mc1" && output.MorningCall.Message != "Good morning" {
+				t.Errorf("Message = %q, want unchanged", output.MorningCall.Message)
+			}
+		})
+	}
+
+	t.Run("翻訳サービスがない場合は翻訳失敗として扱う", func(t *testing.T) {
+		output, err := NewGetUseCase(morningCallRepo, nil, nil).Execute(ctx, GetInput{MorningCallID: "mc1", ViewerID: "receiver", TranslateTo: "ja"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.Translation == nil || !output.Translation.Failed || output.Translation.Message != "Good morning" {
+			t.Errorf("Translation = %+v, want failed with original message", output.Translation)
+		}
+	})
+}
@@ -0,0 +1,291 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+const (
+	// DefaultLeaderboardLimit はランキングの取得件数のデフォルト値
+	DefaultLeaderboardLimit = 10
+	// MaxLeaderboardLimit はランキングの取得件数の最大値
+	MaxLeaderboardLimit = 100
+	// DefaultLeaderboardDays は期間が未指定の場合の集計日数（今日を含む）
+	DefaultLeaderboardDays = 30
+	// MaxLeaderboardDays は集計期間として指定できる最大日数（開始日と終了日を含む）
+	MaxLeaderboardDays = 366
+
+	// leaderboardDateLayout は集計期間の開始日・終了日の形式
+	leaderboardDateLayout = "2006-01-02"
+	// leaderboardUserBatchSize はユーザー情報を一度に取得する件数
+	leaderboardUserBatchSize = 100
+	// leaderboardFriendsLimit は友達ランキングで対象にする友達の最大数
+	leaderboardFriendsLimit = 1000
+)
+
+// LeaderboardKind はランキングの種類
+type LeaderboardKind string
+
+const (
+	// LeaderboardKindWoken は起床確認した回数のランキング
+	LeaderboardKindWoken LeaderboardKind = "woken"
+	// LeaderboardKindWakers は起こした（送ったコールが起床確認された）回数のランキング
+	LeaderboardKindWakers LeaderboardKind = "wakers"
+)
+
+// IsValid はランキングの種類が有効かどうかを判定する
+func (k LeaderboardKind) IsValid() bool {
+	return k == LeaderboardKindWoken || k == LeaderboardKindWakers
+}
+
+// LeaderboardScope はランキングの対象範囲
+type LeaderboardScope string
+
+const (
+	// LeaderboardScopeGlobal は全ユーザーを対象にする
+	LeaderboardScopeGlobal LeaderboardScope = "global"
+	// LeaderboardScopeFriends は自分と友達のみを対象にする
+	LeaderboardScopeFriends LeaderboardScope = "friends"
+)
+
+// IsValid はランキングの対象範囲が有効かどうかを判定する
+func (s LeaderboardScope) IsValid() bool {
+	return s == LeaderboardScopeGlobal || s == LeaderboardScopeFriends
+}
+
+// LeaderboardUseCase は期間内の起床確認・起こした回数のランキングを取得するユースケース
+type LeaderboardUseCase struct {
+	morningCallRepo  repository.MorningCallRepository
+	userRepo         repository.UserRepository
+	relationshipRepo repository.RelationshipRepository
+	now              func() time.Time
+}
+
+// NewLeaderboardUseCase は新しいランキング取得ユースケースを作成する
+func NewLeaderboardUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	userRepo repository.UserRepository,
+	relationshipRepo repository.RelationshipRepository,
+) *LeaderboardUseCase {
+	return &LeaderboardUseCase{
+		morningCallRepo:  morningCallRepo,
+		userRepo:         userRepo,
+		relationshipRepo: relationshipRepo,
+		now:              time.Now,
+	}
+}
+
+// LeaderboardInput はランキング取得の入力データ
+type LeaderboardInput struct {
+	UserID string           // 必須：リクエストしたユーザーのID
+	Kind   LeaderboardKind  // 必須：ランキングの種類
+	Scope  LeaderboardScope // オプション：対象範囲（デフォルトは全ユーザー）
+	From   string           // オプション：集計開始日（YYYY-MM-DD、ユーザーのタイムゾーン）
+	To     string           // オプション：集計終了日（YYYY-MM-DD、この日を含む。デフォルトは今日）
+	Limit  int              // オプション：取得件数（デフォルト10件、最大100件）
+}
+
+// LeaderboardEntry はランキングの1行
+type LeaderboardEntry struct {
+	Rank  int // 順位（同点は同じ順位とし、次の順位は人数分飛ばす）
+	User  *entity.User
+	Count int // 期間内の回数
+}
+
+// LeaderboardOutput はランキング取得の出力データ
+type LeaderboardOutput struct {
+	Kind    LeaderboardKind
+	Scope   LeaderboardScope
+	From    time.Time          // 集計開始日の0時（ユーザーのタイムゾーン）
+	To      time.Time          // 集計終了日の翌日0時（この時刻を含まない）
+	Entries []LeaderboardEntry // 回数の降順（同数はユーザーIDの昇順）
+	Me      *LeaderboardEntry  // リクエストしたユーザーの順位（期間内の回数が0の場合はnil）
+}
+
+// leaderboardCount はユーザーごとの集計値
+type leaderboardCount struct {
+	userID string
+	count  int
+}
+
+// Execute は期間内の回数が多い順にユーザーを返す
+// 全コールを走査せず、リポジトリが時間単位で保持する集計値を用いる
+// 凍結中・削除済みのユーザーは順位に含めない
+func (uc *LeaderboardUseCase) Execute(ctx context.Context, input LeaderboardInput) (*LeaderboardOutput, error) {
+	// 入力値の基本検証
+	if input.UserID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+	if !input.Kind.IsValid() {
+		return nil, fmt.Errorf("ランキングの種類は woken または wakers を指定してください")
+	}
+	if input.Scope == "" {
+		input.Scope = LeaderboardScopeGlobal
+	}
+	if !input.Scope.IsValid() {
+		return nil, fmt.Errorf("ランキングの対象範囲は global または friends を指定してください")
+	}
+	if input.Limit < 0 {
+		return nil, fmt.Errorf("取得件数は0以上である必要があります")
+	}
+	if input.Limit == 0 {
+		input.Limit = DefaultLeaderboardLimit
+	}
+	if input.Limit > MaxLeaderboardLimit {
+		input.Limit = MaxLeaderboardLimit // 最大値制限
+	}
+
+	// ユーザーの存在確認（期間の日付はユーザーのタイムゾーンで解釈する）
+	user, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("ユーザーの確認中にエラーが発生しました: %w", err)
+	}
+
+	start, end, reason := uc.resolvePeriod(input.From, input.To, user.Location())
+	if reason.IsNG() {
+		return nil, fmt.Errorf("集計期間が不正です: %w", reason)
+	}
+
+	counts, err := uc.morningCallRepo.CountConfirmationsByUser(ctx, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("集計値の取得中にエラーが発生しました: %w", err)
+	}
+	byUser := counts.Woken
+	if input.Kind == LeaderboardKindWakers {
+		byUser = counts.Wakers
+	}
+
+	var members map[string]bool
+	if input.Scope == LeaderboardScopeFriends {
+		members, err = uc.friendIDs(ctx, input.UserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ranked := make([]leaderboardCount, 0, len(byUser))
+	for userID, count := range byUser {
+		if count <= 0 || (members != nil && !members[userID]) {
+			continue
+		}
+		ranked = append(ranked, leaderboardCount{userID: userID, count: count})
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].count != ranked[j].count {
+			return ranked[i].count > ranked[j].count
+		}
+		return ranked[i].userID < ranked[j].userID
+	})
+
+	entries, me, err := uc.buildEntries(ctx, ranked, input.UserID, input.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LeaderboardOutput{
+		Kind:    input.Kind,
+		Scope:   input.Scope,
+		From:    start,
+		To:      end,
+		Entries: entries,
+		Me:      me,
+	}, nil
+}
+
+// resolvePeriod は開始日・終了日から集計期間 [start, end) を求める
+func (uc *LeaderboardUseCase) resolvePeriod(from, to string, loc *time.Location) (time.Time, time.Time, valueobject.NGReason) {
+	now := uc.now().In(loc)
+	endDate := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	if to != "" {
+		parsed, err := time.ParseInLocation(leaderboardDateLayout, to, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, valueobject.NGWithCode(valueobject.ReasonCodeInvalidFormat, "to", "終了日は YYYY-MM-DD の形式で指定してください")
+		}
+		endDate = parsed
+	}
+	startDate := endDate.AddDate(0, 0, -(DefaultLeaderboardDays - 1))
+	if from != "" {
+		parsed, err := time.ParseInLocation(leaderboardDateLayout, from, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, valueobject.NGWithCode(valueobject.ReasonCodeInvalidFormat, "from", "開始日は YYYY-MM-DD の形式で指定してください")
+		}
+		startDate = parsed
+	}
+
+	if endDate.Before(startDate) {
+		return time.Time{}, time.Time{}, valueobject.NGWithCode(valueobject.ReasonCodeOutOfRange, "to", "終了日は開始日以降の日付を指定してください")
+	}
+	if startDate.AddDate(0, 0, MaxLeaderboardDays).Before(endDate.AddDate(0, 0, 1)) {
+		return time.Time{}, time.Time{}, valueobject.NGWithCode(valueobject.ReasonCodeOutOfRange, "from", fmt.Sprintf("集計期間は%d日以内で指定してください", MaxLeaderboardDays))
+	}
+	return startDate, endDate.AddDate(0, 0, 1), valueobject.OK()
+}
+
+// friendIDs はユーザー本人と友達のIDの集合を返す
+func (uc *LeaderboardUseCase) friendIDs(ctx context.Context, userID string) (map[string]bool, error) {
+	friends, err := uc.relationshipRepo.FindFriendsByUserID(ctx, userID, 0, leaderboardFriendsLimit)
+	if err != nil {
+		return nil, fmt.Errorf("友達の取得中にエラーが発生しました: %w", err)
+	}
+	members := make(map[string]bool, len(friends)+1)
+	members[userID] = true
+	for _, rel := range friends {
+		members[rel.GetOtherUserID(userID)] = true
+	}
+	return members, nil
+}
+
+// buildEntries は並べ替え済みの集計値にユーザー情報を付けて順位を決める
+// 上位limit件と本人の順位が確定した時点でユーザー情報の取得を打ち切る
+func (uc *LeaderboardUseCase) buildEntries(ctx context.Context, ranked []leaderboardCount, userID string, limit int) ([]LeaderboardEntry, *LeaderboardEntry, error) {
+	entries := make([]LeaderboardEntry, 0, limit)
+	var me *LeaderboardEntry
+	rank, kept, prevCount := 0, 0, -1
+
+	for offset := 0; offset < len(ranked) && (len(entries) < limit || me == nil); offset += leaderboardUserBatchSize {
+		batch := ranked[offset:min(offset+leaderboardUserBatchSize, len(ranked))]
+		ids := make([]string, len(batch))
+		for i, c := range batch {
+			ids[i] = c.userID
+		}
+		users, err := uc.userRepo.FindByIDs(ctx, ids)
+		if err != nil {
+			return nil, nil, fmt.Errorf("ユーザー情報の取得中にエラーが発生しました: %w", err)
+		}
+		usersByID := make(map[string]*entity.User, len(users))
+		for _, u := range users {
+			usersByID[u.ID] = u
+		}
+
+		for _, c := range batch {
+			u, ok := usersByID[c.userID]
+			if !ok || u.IsFrozen {
+				continue
+			}
+			kept++
+			if c.count != prevCount {
+				rank = kept
+				prevCount = c.count
+			}
+			entry := LeaderboardEntry{Rank: rank, User: u, Count: c.count}
+			if len(entries) < limit {
+				entries = append(entries, entry)
+			}
+			if u.ID == userID {
+				me = &entry
+			}
+		}
+	}
+
+	return entries, me, nil
+}
@@ -0,0 +1,241 @@
+package morning_call
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestLeaderboardUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, tokyo)
+
+	morningCallRepo := memory.NewMorningCallRepository()
+	userRepo := memory.NewUserRepository()
+	relationshipRepo := memory.NewRelationshipRepository()
+
+	for _, u := range []*entity.User{
+		{ID: "alice", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password", TimeZone: "Asia/Tokyo"},
+		{ID: "bob", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password", TimeZone: "Asia/Tokyo"},
+		{ID: "carol", Username: "carol", Email: "carol@example.com", PasswordHash: "hashed_password", TimeZone: "Asia/Tokyo"},
+		{ID: "dave", Username: "dave", Email: "dave@example.com", PasswordHash: "hashed_password", TimeZone: "Asia/Tokyo"},
+		{ID: "eve", Username: "eve", Email: "eve@example.com", PasswordHash: "hashed_password", TimeZone: "Asia/Tokyo", IsFrozen: true},
+		{ID: "frank", Username: "frank", Email: "frank@example.com", PasswordHash: "hashed_password", TimeZone: "Asia/Tokyo"},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+
+	// aliceとbobのみ友達
+	friendship := &entity.Relationship{
+		ID:          "rel1",
+		RequesterID: "alice",
+		ReceiverID:  "bob",
+		Status:      valueobject.RelationshipStatusAccepted,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := relationshipRepo.Create(ctx, friendship); err != nil {
+		t.Fatalf("failed to create friendship: %v", err)
+	}
+
+	// 起床確認した回数: alice 3, bob 2, carol 2, dave 1, eve（凍結中）5
+	// 起こした回数: alice 8, bob 2, carol 2, dave 1
+	recent := time.Date(2026, 3, 10, 7, 0, 0, 0, tokyo)
+	calls := []struct {
+		senderID   string
+		receiverID string
+		scheduled  time.Time
+	}{
+		{"bob", "alice", recent}, {"carol", "alice", recent}, {"dave", "alice", recent},
+		{"alice", "bob", recent}, {"carol", "bob", recent},
+		{"alice", "carol", recent}, {"bob", "carol", recent},
+		{"alice", "dave", recent},
+		{"alice", "eve", recent}, {"alice", "eve", recent}, {"alice", "eve", recent}, {"alice", "eve", recent}, {"alice", "eve", recent},
+		// デフォルトの集計期間（直近30日）より前
+		{"bob", "dave", time.Date(2025, 12, 1, 7, 0, 0, 0, tokyo)},
+	}
+	for i, c := range calls {
+		mc := &entity.MorningCall{
+			ID:            fmt.Sprintf("mc%d", i),
+			SenderID:      c.senderID,
+			ReceiverID:    c.receiverID,
+			ScheduledTime: c.scheduled,
+			Status:        valueobject.MorningCallStatusConfirmed,
+			CreatedAt:     c.scheduled.Add(-24 * time.Hour),
+			UpdatedAt:     c.scheduled,
+		}
+		if err := morningCallRepo.Create(ctx, mc); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+
+	uc := NewLeaderboardUseCase(morningCallRepo, userRepo, relationshipRepo)
+	uc.now = func() time.Time { return now }
+
+	tests := []struct {
+		name      string
+		input     LeaderboardInput
+		wantIDs   []string
+		wantRanks []int
+		wantCount []int
+		wantMe    string // 空の場合はランク外
+		wantMeRnk int
+		wantErr   string
+	}{
+		{
+			name:      "起床確認した回数（同数は同順位、凍結中のユーザーは除外）",
+			input:     LeaderboardInput{UserID: "alice", Kind: LeaderboardKindWoken},
+			wantIDs:   []string{"alice", "bob", "carol", "dave"},
+			wantRanks: []int{1, 2, 2, 4},
+			wantCount: []int{3, 2, 2, 1},
+			wantMe:    "alice",
+			wantMeRnk: 1,
+		},
+		{
+			name:      "起こした回数",
+			input:     LeaderboardInput{UserID: "alice", Kind: LeaderboardKindWakers},
+			wantIDs:   []string{"alice", "bob", "carol", "dave"},
+			wantRanks: []int{1, 2, 2, 4},
+			wantCount: []int{8, 2, 2, 1},
+			wantMe:    "alice",
+			wantMeRnk: 1,
+		},
+		{
+			name:      "上位に入らなくても自分の順位を返す",
+			input:     LeaderboardInput{UserID: "dave", Kind: LeaderboardKindWoken, Limit: 1},
+			wantIDs:   []string{"alice"},
+			wantRanks: []int{1},
+			wantCount: []int{3},
+			wantMe:    "dave",
+			wantMeRnk: 4,
+		},
+		{
+			name:      "友達のみ",
+			input:     LeaderboardInput{UserID: "alice", Kind: LeaderboardKindWoken, Scope: LeaderboardScopeFriends},
+			wantIDs:   []string{"alice", "bob"},
+			wantRanks: []int{1, 2},
+			wantCount: []int{3, 2},
+			wantMe:    "alice",
+			wantMeRnk: 1,
+		},
+		{
+			name:      "期間を指定できる",
+			input:     LeaderboardInput{UserID: "dave", Kind: LeaderboardKindWoken, From: "2025-12-01", To: "2025-12-31"},
+			wantIDs:   []string{"dave"},
+			wantRanks: []int{1},
+			wantCount: []int{1},
+			wantMe:    "dave",
+			wantMeRnk: 1,
+		},
+		{
+			name:      "期間内の回数が0の場合はランク外",
+			input:     LeaderboardInput{UserID: "frank", Kind: LeaderboardKindWoken, Limit: 2},
+			wantIDs:   []string{"alice", "bob"},
+			wantRanks: []int{1, 2},
+			wantCount: []int{3, 2},
+		},
+		{
+			name:    "ユーザーID未指定",
+			input:   LeaderboardInput{Kind: LeaderboardKindWoken},
+			wantErr: "ユーザーIDは必須です",
+		},
+		{
+			name:    "不正な種類",
+			input:   LeaderboardInput{UserID: "alice", Kind: "sleepers"},
+			wantErr: "ランキングの種類は woken または wakers を指定してください",
+		},
+		{
+			name:    "不正な対象範囲",
+			input:   LeaderboardInput{UserID: "alice", Kind: LeaderboardKindWoken, Scope: "family"},
+			wantErr: "ランキングの対象範囲は global または friends を指定してください",
+		},
+		{
+			name:    "負の件数",
+			input:   LeaderboardInput{UserID: "alice", Kind: LeaderboardKindWoken, Limit: -1},
+			wantErr: "取得件数は0以上である必要があります",
+		},
+		{
+			name:    "存在しないユーザー",
+			input:   LeaderboardInput{UserID: "unknown", Kind: LeaderboardKindWoken},
+			wantErr: "ユーザーが見つかりません",
+		},
+		{
+			name:    "日付の形式が不正",
+			input:   LeaderboardInput{UserID: "alice", Kind: LeaderboardKindWoken, From: "2026/03/01"},
+			wantErr: "開始日は YYYY-MM-DD の形式で指定してください",
+		},
+		{
+			name:    "終了日が開始日より前",
+			input:   LeaderboardInput{UserID: "alice", Kind: LeaderboardKindWoken, From: "2026-03-10", To: "2026-03-09"},
+			wantErr: "終了日は開始日以降の日付を指定してください",
+		},
+		{
+			name:    "期間が長すぎる",
+			input:   LeaderboardInput{UserID: "alice", Kind: LeaderboardKindWoken, From: "2025-01-01", To: "2026-01-02"},
+			wantErr: "集計期間は366日以内で指定してください",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := uc.Execute(ctx, tt.input)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(output.Entries) != len(tt.wantIDs) {
+				t.Fatalf("got %d entries, want %d", len(output.Entries), len(tt.wantIDs))
+			}
+			for i, e := range output.Entries {
+				if e.User.ID != tt.wantIDs[i] || e.Rank != tt.wantRanks[i] || e.Count != tt.wantCount[i] {
+					t.Errorf("entries[%d] = {%s rank=%d count=%d}, want {%s rank=%d count=%d}",
+						i, e.User.ID, e.Rank, e.Count, tt.wantIDs[i], tt.wantRanks[i], tt.wantCount[i])
+				}
+			}
+
+			if tt.wantMe == "" {
+				if output.Me != nil {
+					t.Errorf("Me = %+v, want nil", output.Me)
+				}
+				return
+			}
+			if output.Me == nil || output.Me.User.ID != tt.wantMe || output.Me.Rank != tt.wantMeRnk {
+				t.Errorf("Me = %+v, want %s rank=%d", output.Me, tt.wantMe, tt.wantMeRnk)
+			}
+		})
+	}
+
+	t.Run("期間未指定の場合は今日までの30日間", func(t *testing.T) {
+		output, err := uc.Execute(ctx, LeaderboardInput{UserID: "alice", Kind: LeaderboardKindWoken})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := time.Date(2026, 2, 14, 0, 0, 0, 0, tokyo); !output.From.Equal(want) {
+			t.Errorf("From = %v, want %v", output.From, want)
+		}
+		if want := time.Date(2026, 3, 16, 0, 0, 0, 0, tokyo); !output.To.Equal(want) {
+			t.Errorf("To = %v, want %v", output.To, want)
+		}
+		if output.Scope != LeaderboardScopeGlobal {
+			t.Errorf("Scope = %s, want %s", output.Scope, LeaderboardScopeGlobal)
+		}
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/ochamu/morning-call-api/internal/domain/entity"
@@ -13,30 +14,73 @@ import (
 
 // ListUseCase はモーニングコール一覧取得のユースケース
 type ListUseCase struct {
-	morningCallRepo repository.MorningCallRepository
-	userRepo        repository.UserRepository
+	morningCallRepo  repository.MorningCallRepository
+	userRepo         repository.UserRepository
+	relationshipRepo repository.RelationshipRepository
+	now              func() time.Time
 }
 
+// SyncCursorOverlap は差分取得のカーソル（LastSyncTime）を取得開始時刻から遡らせる幅
+// 取得中に並行して更新されたコールを次回の差分取得で取りこぼさないため、境界付近のコールは重複して返すことがある
+const SyncCursorOverlap = 5 * time.Second
+
 // NewListUseCase は新しいモーニングコール一覧取得ユースケースを作成する
+// relationshipRepoがnilの場合はお気に入り送信元を考慮しない
 func NewListUseCase(
 	morningCallRepo repository.MorningCallRepository,
 	userRepo repository.UserRepository,
+	relationshipRepo repository.RelationshipRepository,
 ) *ListUseCase {
 	return &ListUseCase{
-		morningCallRepo: morningCallRepo,
-		userRepo:        userRepo,
+		morningCallRepo:  morningCallRepo,
+		userRepo:         userRepo,
+		relationshipRepo: relationshipRepo,
+		now:              time.Now,
 	}
 }
 
 // ListInput はモーニングコール一覧取得の入力データ
 type ListInput struct {
-	UserID    string                         // 必須：リクエストユーザーのID
-	ListType  ListType                       // 必須：一覧の種類（送信/受信）
-	Status    *valueobject.MorningCallStatus // オプション：ステータスでフィルタ
-	StartTime *time.Time                     // オプション：開始時刻でフィルタ
-	EndTime   *time.Time                     // オプション：終了時刻でフィルタ
-	Offset    int                            // ページネーション：開始位置
-	Limit     int                            // ページネーション：取得件数
+	UserID         string                         // 必須：リクエストユーザーのID
+	ListType       ListType                       // 必須：一覧の種類（送信/受信/アーカイブ）
+	Status         *valueobject.MorningCallStatus // オプション：ステータスでフィルタ
+	CounterpartID  string                         // オプション：相手のユーザーIDでフィルタ（送信一覧では受信者、受信一覧では送信者）
+	StartTime      *time.Time                     // オプション：アラーム時刻がこの時刻以降のものに絞る
+	EndTime        *time.Time                     // オプション：アラーム時刻がこの時刻以前のものに絞る
+	Sort           ListSortOrder                  // オプション：並び順（デフォルトはリポジトリから返される順序）
+	FavoritesFirst bool                           // オプション：お気に入り送信元からのコールを先頭に並べる（受信・アーカイブ一覧のみ）
+	UpdatedSince   *time.Time                     // オプション：更新日時がこの時刻以降のものに絞る（差分取得、並び順の指定がなければ更新日時の昇順）
+	Offset         int                            // ページネーション：開始位置
+	Limit          int                            // ページネーション：取得件数
+}
+
+// ListSortOrder は一覧の並び順を表す（先頭に"-"を付けると降順）
+type ListSortOrder string
+
+const (
+	ListSortOrderDefault           ListSortOrder = ""                // リポジトリから返される順序（送信一覧は新しい順、受信一覧は直近のものが先）
+	ListSortOrderScheduledTime     ListSortOrder = "scheduled_time"  // アラーム時刻の昇順
+	ListSortOrderScheduledTimeDesc ListSortOrder = "-scheduled_time" // アラーム時刻の降順
+	ListSortOrderCreatedAt         ListSortOrder = "created_at"      // 作成日時の昇順
+	ListSortOrderCreatedAtDesc     ListSortOrder = "-created_at"     // 作成日時の降順
+
+	// listSortOrderUpdatedAt は差分取得で並び順の指定がない場合に使う更新日時の昇順
+	// ページをまたいで取得しても取りこぼしが起きないよう、更新された順に返す
+	listSortOrderUpdatedAt ListSortOrder = "updated_at"
+)
+
+// IsValid は並び順が有効かどうかを判定する
+func (o ListSortOrder) IsValid() bool {
+	switch o {
+	case ListSortOrderDefault, ListSortOrderScheduledTime, ListSortOrderScheduledTimeDesc, ListSortOrderCreatedAt, ListSortOrderCreatedAtDesc:
+		return true
+	}
+	return false
+}
+
+// needsFullScan はリポジトリのページネーションをそのまま使えず、全件を取得して絞り込む必要があるかを判定する
+func (input ListInput) needsFullScan() bool {
+	return input.Status != nil || input.CounterpartID != "" || input.StartTime != nil || input.EndTime != nil || input.Sort != ListSortOrderDefault || input.FavoritesFirst || input.UpdatedSince != nil
 }
 
 // ListType は一覧の種類を表す
@@ -44,24 +88,46 @@ type ListType string
 
 const (
 	ListTypeSent     ListType = "sent"     // 送信したモーニングコール
-	ListTypeReceived ListType = "received" // 受信したモーニングコール
+	ListTypeReceived ListType = "received" // 受信したモーニングコール（アーカイブしたものを除く）
+	ListTypeArchived ListType = "archived" // 受信したモーニングコールのうちアーカイブしたもの
 )
 
+// isReceived は受信者として取得する一覧かを判定する
+func (t ListType) isReceived() bool {
+	return t == ListTypeReceived || t == ListTypeArchived
+}
+
 // ListOutput はモーニングコール一覧取得の出力データ
 type ListOutput struct {
 	MorningCalls []*entity.MorningCall
 	TotalCount   int  // フィルタ適用後の総件数
 	HasNext      bool // 次のページがあるか
+	// FavoriteSenders は受信者がお気に入り送信元に登録した友達の設定（送信者ID別、受信・アーカイブ一覧のみ）
+	FavoriteSenders map[string]valueobject.FavoriteSender
+	// LastSyncTime は次回の差分取得でUpdatedSinceに指定するサーバー時刻
+	// クライアントの時計とのずれの影響を受けないよう、取得を開始したサーバー時刻からSyncCursorOverlapだけ遡らせる
+	LastSyncTime time.Time
 }
 
 // Execute はモーニングコール一覧を取得する
+// UpdatedSinceを指定した場合は前回の取得以降に変更されたコールのみを返す
+// 管理者により削除されたコールも削除日時が更新日時となるため差分に含まれる（DeletedAtで判別できる）
 func (uc *ListUseCase) Execute(ctx context.Context, input ListInput) (*ListOutput, error) {
 	// 入力値の基本検証
 	if input.UserID == "" {
 		return nil, fmt.Errorf("ユーザーIDは必須です")
 	}
-	if input.ListType != ListTypeSent && input.ListType != ListTypeReceived {
-		return nil, fmt.Errorf("一覧タイプは'sent'または'received'を指定してください")
+	if input.ListType != ListTypeSent && !input.ListType.isReceived() {
+		return nil, fmt.Errorf("一覧タイプは'sent'、'received'または'archived'を指定してください")
+	}
+	if !input.Sort.IsValid() {
+		return nil, fmt.Errorf("並び順は scheduled_time、-scheduled_time、created_at、-created_at のいずれかを指定してください")
+	}
+	if input.FavoritesFirst && !input.ListType.isReceived() {
+		return nil, fmt.Errorf("お気に入り送信元の優先表示は受信一覧でのみ指定できます")
+	}
+	if input.StartTime != nil && input.EndTime != nil && input.StartTime.After(*input.EndTime) {
+		return nil, fmt.Errorf("開始時刻は終了時刻より前である必要があります")
 	}
 	if input.Limit <= 0 {
 		input.Limit = 20 // デフォルト値
@@ -72,6 +138,12 @@ func (uc *ListUseCase) Execute(ctx context.Context, input ListInput) (*ListOutpu
 	if input.Offset < 0 {
 		input.Offset = 0
 	}
+	if input.UpdatedSince != nil && input.Sort == ListSortOrderDefault {
+		input.Sort = listSortOrderUpdatedAt
+	}
+
+	// 取得を開始する前のサーバー時刻を差分取得のカーソルにする
+	lastSyncTime := uc.now().Add(-SyncCursorOverlap)
 
 	// ユーザーの存在確認
 	_, err := uc.userRepo.FindByID(ctx, input.UserID)
@@ -82,8 +154,17 @@ func (uc *ListUseCase) Execute(ctx context.Context, input ListInput) (*ListOutpu
 		return nil, fmt.Errorf("ユーザーの確認中にエラーが発生しました: %w", err)
 	}
 
+	// 受信一覧ではお気に入り送信元を取得しておく
+	var favorites map[string]valueobject.FavoriteSender
+	if input.ListType.isReceived() {
+		favorites, err = uc.findFavoriteSenders(ctx, input.UserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// 共通ロジックでリスト取得
-	morningCalls, totalCount, err := uc.listCallsWithFilters(ctx, input)
+	morningCalls, totalCount, err := uc.listCallsWithFilters(ctx, input, favorites)
 	if err != nil {
 		return nil, err
 	}
@@ -92,30 +173,46 @@ func (uc *ListUseCase) Execute(ctx context.Context, input ListInput) (*ListOutpu
 	hasNext := (input.Offset + len(morningCalls)) < totalCount
 
 	return &ListOutput{
-		MorningCalls: morningCalls,
-		TotalCount:   totalCount,
-		HasNext:      hasNext,
+		MorningCalls:    morningCalls,
+		TotalCount:      totalCount,
+		HasNext:         hasNext,
+		FavoriteSenders: favorites,
+		LastSyncTime:    lastSyncTime,
 	}, nil
 }
 
+// findFavoriteSenders は受信者がお気に入り送信元に登録した友達の設定を送信者ID別に返す
+func (uc *ListUseCase) findFavoriteSenders(ctx context.Context, receiverID string) (map[string]valueobject.FavoriteSender, error) {
+	favorites := make(map[string]valueobject.FavoriteSender)
+	if uc.relationshipRepo == nil {
+		return favorites, nil
+	}
+
+	friends, err := uc.relationshipRepo.FindFriendsByUserID(ctx, receiverID, 0, 10000)
+	if err != nil {
+		return nil, fmt.Errorf("お気に入り送信元の取得中にエラーが発生しました: %w", err)
+	}
+	for _, relationship := range friends {
+		if favorite := relationship.FavoriteSenderFor(receiverID); favorite != nil {
+			favorites[relationship.GetOtherUserID(receiverID)] = *favorite
+		}
+	}
+	return favorites, nil
+}
+
 // listCallsWithFilters は共通のフィルタリングロジックでモーニングコール一覧を取得する
-func (uc *ListUseCase) listCallsWithFilters(ctx context.Context, input ListInput) ([]*entity.MorningCall, int, error) {
+func (uc *ListUseCase) listCallsWithFilters(ctx context.Context, input ListInput, favorites map[string]valueobject.FavoriteSender) ([]*entity.MorningCall, int, error) {
 	// 期間フィルタがある場合
 	if input.StartTime != nil && input.EndTime != nil {
-		return uc.listCallsWithTimeRange(ctx, input)
+		return uc.listCallsWithTimeRange(ctx, input, favorites)
 	}
 
 	// 期間フィルタがない場合
-	return uc.listCallsWithoutTimeRange(ctx, input)
+	return uc.listCallsWithoutTimeRange(ctx, input, favorites)
 }
 
 // listCallsWithTimeRange は期間フィルタを適用してモーニングコール一覧を取得する
-func (uc *ListUseCase) listCallsWithTimeRange(ctx context.Context, input ListInput) ([]*entity.MorningCall, int, error) {
-	// 期間の妥当性チェック
-	if input.StartTime.After(*input.EndTime) {
-		return nil, 0, fmt.Errorf("開始時刻は終了時刻より前である必要があります")
-	}
-
+func (uc *ListUseCase) listCallsWithTimeRange(ctx context.Context, input ListInput, favorites map[string]valueobject.FavoriteSender) ([]*entity.MorningCall, int, error) {
 	// TODO: 将来的にはリポジトリレベルでユーザーIDフィルタを適用して
 	// パフォーマンスを改善する必要がある。現在は暫定的に10,000件の制限を設ける。
 	// 期間内のモーニングコールを取得
@@ -126,64 +223,42 @@ func (uc *ListUseCase) listCallsWithTimeRange(ctx context.Context, input ListInp
 
 	// ユーザーIDとステータスでフィルタリング
 	filteredCalls := uc.filterCalls(allCalls, input)
-
-	// ページネーション適用
-	totalCount := len(filteredCalls)
-	start := input.Offset
-	end := input.Offset + input.Limit
-	if start > totalCount {
-		return []*entity.MorningCall{}, totalCount, nil
-	}
-	if end > totalCount {
-		end = totalCount
+	sortCalls(filteredCalls, input.Sort)
+	if input.FavoritesFirst {
+		sortFavoritesFirst(filteredCalls, favorites)
 	}
 
-	return filteredCalls[start:end], totalCount, nil
+	return paginateCalls(filteredCalls, input.Offset, input.Limit), len(filteredCalls), nil
 }
 
 // listCallsWithoutTimeRange は期間フィルタなしでモーニングコール一覧を取得する
-func (uc *ListUseCase) listCallsWithoutTimeRange(ctx context.Context, input ListInput) ([]*entity.MorningCall, int, error) {
+func (uc *ListUseCase) listCallsWithoutTimeRange(ctx context.Context, input ListInput, favorites map[string]valueobject.FavoriteSender) ([]*entity.MorningCall, int, error) {
 	var morningCalls []*entity.MorningCall
 	var allCalls []*entity.MorningCall
 	var err error
 
-	// ステータスフィルタがある場合は、正確な総件数のため全件取得が必要
-	if input.Status != nil {
+	// フィルタや並び順の指定がある場合は、正確な総件数のため全件取得が必要
+	if input.needsFullScan() {
 		// 全件取得してフィルタリング（ページネーションは後で適用）
 		if input.ListType == ListTypeSent {
 			allCalls, err = uc.morningCallRepo.FindBySenderID(ctx, input.UserID, 0, 10000)
 		} else {
-			allCalls, err = uc.morningCallRepo.FindByReceiverID(ctx, input.UserID, 0, 10000)
+			allCalls, err = uc.morningCallRepo.FindByReceiverIDAndArchived(ctx, input.UserID, input.ListType == ListTypeArchived, 0, 10000)
 		}
 		if err != nil {
 			return nil, 0, fmt.Errorf("モーニングコールの取得中にエラーが発生しました: %w", err)
 		}
 
-		// ステータスでフィルタリング
-		var filteredCalls []*entity.MorningCall
-		for _, call := range allCalls {
-			if call.Status == *input.Status {
-				filteredCalls = append(filteredCalls, call)
-			}
+		filteredCalls := uc.filterCalls(allCalls, input)
+		sortCalls(filteredCalls, input.Sort)
+		if input.FavoritesFirst {
+			sortFavoritesFirst(filteredCalls, favorites)
 		}
 
-		// フィルタ適用後の総件数
-		totalCount := len(filteredCalls)
-
-		// ページネーション適用
-		start := input.Offset
-		end := input.Offset + input.Limit
-		if start > totalCount {
-			return []*entity.MorningCall{}, totalCount, nil
-		}
-		if end > totalCount {
-			end = totalCount
-		}
-
-		return filteredCalls[start:end], totalCount, nil
+		return paginateCalls(filteredCalls, input.Offset, input.Limit), len(filteredCalls), nil
 	}
 
-	// ステータスフィルタがない場合は通常のページネーション
+	// フィルタがない場合は通常のページネーション
 	if input.ListType == ListTypeSent {
 		morningCalls, err = uc.morningCallRepo.FindBySenderID(ctx, input.UserID, input.Offset, input.Limit)
 		if err != nil {
@@ -196,12 +271,13 @@ func (uc *ListUseCase) listCallsWithoutTimeRange(ctx context.Context, input List
 		return morningCalls, totalCount, nil
 	}
 
-	// 受信リストの場合
-	morningCalls, err = uc.morningCallRepo.FindByReceiverID(ctx, input.UserID, input.Offset, input.Limit)
+	// 受信リストの場合（アーカイブ一覧ではアーカイブしたもののみ、それ以外はアーカイブしたものを除く）
+	archived := input.ListType == ListTypeArchived
+	morningCalls, err = uc.morningCallRepo.FindByReceiverIDAndArchived(ctx, input.UserID, archived, input.Offset, input.Limit)
 	if err != nil {
 		return nil, 0, fmt.Errorf("受信モーニングコールの取得中にエラーが発生しました: %w", err)
 	}
-	totalCount, err := uc.morningCallRepo.CountByReceiverID(ctx, input.UserID)
+	totalCount, err := uc.morningCallRepo.CountByReceiverIDAndArchived(ctx, input.UserID, archived)
 	if err != nil {
 		return nil, 0, fmt.Errorf("受信モーニングコール数の取得中にエラーが発生しました: %w", err)
 	}
@@ -217,17 +293,78 @@ func (uc *ListUseCase) filterCalls(calls []*entity.MorningCall, input ListInput)
 		if input.ListType == ListTypeSent && call.SenderID != input.UserID {
 			continue
 		}
-		if input.ListType == ListTypeReceived && call.ReceiverID != input.UserID {
+		if input.ListType.isReceived() && (call.ReceiverID != input.UserID || call.Archived != (input.ListType == ListTypeArchived)) {
 			continue
 		}
 
+		// 相手のユーザーでフィルタリング
+		if input.CounterpartID != "" {
+			counterpartID := call.SenderID
+			if input.ListType == ListTypeSent {
+				counterpartID = call.ReceiverID
+			}
+			if counterpartID != input.CounterpartID {
+				continue
+			}
+		}
+
 		// ステータスでフィルタリング
 		if input.Status != nil && call.Status != *input.Status {
 			continue
 		}
 
+		// アラーム時刻でフィルタリング（境界を含む）
+		if input.StartTime != nil && call.ScheduledTime.Before(*input.StartTime) {
+			continue
+		}
+		if input.EndTime != nil && call.ScheduledTime.After(*input.EndTime) {
+			continue
+		}
+
+		// 更新日時でフィルタリング（境界を含む）
+		if input.UpdatedSince != nil && call.UpdatedAt.Before(*input.UpdatedSince) {
+			continue
+		}
+
 		filteredCalls = append(filteredCalls, call)
 	}
 
 	return filteredCalls
 }
+
+// sortCalls は指定された並び順でモーニングコールを並べ替える（同じ時刻の場合は元の順序を保つ）
+func sortCalls(calls []*entity.MorningCall, order ListSortOrder) {
+	var less func(a, b *entity.MorningCall) bool
+	switch order {
+	case ListSortOrderScheduledTime:
+		less = func(a, b *entity.MorningCall) bool { return a.ScheduledTime.Before(b.ScheduledTime) }
+	case ListSortOrderScheduledTimeDesc:
+		less = func(a, b *entity.MorningCall) bool { return a.ScheduledTime.After(b.ScheduledTime) }
+	case ListSortOrderCreatedAt:
+		less = func(a, b *entity.MorningCall) bool { return a.CreatedAt.Before(b.CreatedAt) }
+	case ListSortOrderCreatedAtDesc:
+		less = func(a, b *entity.MorningCall) bool { return a.CreatedAt.After(b.CreatedAt) }
+	case listSortOrderUpdatedAt:
+		less = func(a, b *entity.MorningCall) bool { return a.UpdatedAt.Before(b.UpdatedAt) }
+	default:
+		return
+	}
+	sort.SliceStable(calls, func(i, j int) bool { return less(calls[i], calls[j]) })
+}
+
+// sortFavoritesFirst はお気に入り送信元からのコールを先頭に移動する（それぞれの中では元の順序を保つ）
+func sortFavoritesFirst(calls []*entity.MorningCall, favorites map[string]valueobject.FavoriteSender) {
+	sort.SliceStable(calls, func(i, j int) bool {
+		_, iFavorite := favorites[calls[i].SenderID]
+		_, jFavorite := favorites[calls[j].SenderID]
+		return iFavorite && !jFavorite
+	})
+}
+
+// paginateCalls はフィルタ適用後のモーニングコールにページネーションを適用する
+func paginateCalls(calls []*entity.MorningCall, offset, limit int) []*entity.MorningCall {
+	if offset >= len(calls) {
+		return []*entity.MorningCall{}
+	}
+	return calls[offset:min(offset+limit, len(calls))]
+}
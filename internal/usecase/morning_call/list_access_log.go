@@ -0,0 +1,158 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// AccessViewerRole は閲覧したユーザーとコールの関係
+type AccessViewerRole string
+
+const (
+	AccessViewerRoleSender   AccessViewerRole = "sender"   // コールの送信者
+	AccessViewerRoleReceiver AccessViewerRole = "receiver" // コールの受信者
+	AccessViewerRoleOther    AccessViewerRole = "other"    // 送信者でも受信者でもない第三者
+)
+
+// ListAccessLogUseCase はモーニングコールの閲覧記録（アクセスログ）を取得するユースケース
+type ListAccessLogUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	accessLogRepo   repository.MorningCallAccessLogRepository
+	userRepo        repository.UserRepository
+}
+
+// NewListAccessLogUseCase は新しいアクセスログ取得ユースケースを作成する
+func NewListAccessLogUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	accessLogRepo repository.MorningCallAccessLogRepository,
+	userRepo repository.UserRepository,
+) *ListAccessLogUseCase {
+	return &ListAccessLogUseCase{
+		morningCallRepo: morningCallRepo,
+		accessLogRepo:   accessLogRepo,
+		userRepo:        userRepo,
+	}
+}
+
+// ListAccessLogInput はアクセスログ取得の入力データ
+type ListAccessLogInput struct {
+	MorningCallID string // 必須：対象のモーニングコールのID
+	UserID        string // 必須：リクエストしたユーザーのID
+	Offset        int    // ページネーション：開始位置
+	Limit         int    // ページネーション：取得件数（デフォルト20件、最大100件）
+}
+
+// AccessLogEntry はアクセスログの1件分
+type AccessLogEntry struct {
+	Access     *entity.MorningCallAccess
+	Viewer     *entity.User     // 閲覧したユーザー（退会済みの場合はnil）
+	ViewerRole AccessViewerRole // 閲覧したユーザーとコールの関係
+	IsSelf     bool             // リクエストしたユーザー自身による閲覧か
+}
+
+// ListAccessLogOutput はアクセスログ取得の出力データ
+type ListAccessLogOutput struct {
+	Entries    []AccessLogEntry // 閲覧日時の新しい順
+	TotalCount int              // 保持している閲覧記録の総件数
+	HasNext    bool             // 次のページがあるか
+}
+
+// Execute はモーニングコールの閲覧記録を新しい順に返す
+// 閲覧記録を確認できるのはコールの送信者と受信者のみ
+func (uc *ListAccessLogUseCase) Execute(ctx context.Context, input ListAccessLogInput) (*ListAccessLogOutput, error) {
+	// 入力値の基本検証
+	if input.MorningCallID == "" {
+		return nil, fmt.Errorf("モーニングコールIDは必須です")
+	}
+	if input.UserID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+	if input.Limit <= 0 {
+		input.Limit = 20 // デフォルト値
+	}
+	if input.Limit > 100 {
+		input.Limit = 100 // 最大値制限
+	}
+	if input.Offset < 0 {
+		input.Offset = 0
+	}
+
+	morningCall, err := uc.morningCallRepo.FindByID(ctx, input.MorningCallID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("モーニングコールが見つかりません")
+		}
+		return nil, fmt.Errorf("モーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+	if morningCall.SenderID != input.UserID && morningCall.ReceiverID != input.UserID {
+		return nil, fmt.Errorf("送信者または受信者のみがアクセスログを確認できます")
+	}
+
+	accesses, err := uc.accessLogRepo.FindByMorningCallID(ctx, morningCall.ID, input.Offset, input.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("アクセスログの取得中にエラーが発生しました: %w", err)
+	}
+	totalCount, err := uc.accessLogRepo.CountByMorningCallID(ctx, morningCall.ID)
+	if err != nil {
+		return nil, fmt.Errorf("アクセスログ数の取得中にエラーが発生しました: %w", err)
+	}
+
+	viewers, err := uc.findViewers(ctx, accesses)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]AccessLogEntry, len(accesses))
+	for i, access := range accesses {
+		entries[i] = AccessLogEntry{
+			Access:     access,
+			Viewer:     viewers[access.ViewerID],
+			ViewerRole: viewerRole(morningCall, access.ViewerID),
+			IsSelf:     access.ViewerID == input.UserID,
+		}
+	}
+
+	return &ListAccessLogOutput{
+		Entries:    entries,
+		TotalCount: totalCount,
+		HasNext:    input.Offset+len(accesses) < totalCount,
+	}, nil
+}
+
+// findViewers は閲覧したユーザーをまとめて取得する
+func (uc *ListAccessLogUseCase) findViewers(ctx context.Context, accesses []*entity.MorningCallAccess) (map[string]*entity.User, error) {
+	seen := make(map[string]bool, len(accesses))
+	ids := make([]string, 0, len(accesses))
+	for _, access := range accesses {
+		if !seen[access.ViewerID] {
+			seen[access.ViewerID] = true
+			ids = append(ids, access.ViewerID)
+		}
+	}
+
+	users, err := uc.userRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("閲覧ユーザーの取得中にエラーが発生しました: %w", err)
+	}
+	viewers := make(map[string]*entity.User, len(users))
+	for _, u := range users {
+		viewers[u.ID] = u
+	}
+	return viewers, nil
+}
+
+// viewerRole は閲覧したユーザーとコールの関係を返す
+func viewerRole(mc *entity.MorningCall, viewerID string) AccessViewerRole {
+	switch viewerID {
+	case mc.SenderID:
+		return AccessViewerRoleSender
+	case mc.ReceiverID:
+		return AccessViewerRoleReceiver
+	default:
+		return AccessViewerRoleOther
+	}
+}
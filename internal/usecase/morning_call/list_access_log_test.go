@@ -0,0 +1,148 @@
+package morning_call
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestListAccessLogUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	base := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+
+	morningCallRepo := memory.NewMorningCallRepository()
+	accessLogRepo := memory.NewMorningCallAccessLogRepository(0)
+	userRepo := memory.NewUserRepository()
+
+	for _, u := range []*entity.User{
+		{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+		{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+		{ID: "stranger", Username: "mallory", Email: "mallory@example.com", PasswordHash: "hashed_password"},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+	mc := &entity.MorningCall{
+		ID:            "mc1",
+		SenderID:      "sender",
+		ReceiverID:    "receiver",
+		ScheduledTime: base.Add(24 * time.Hour),
+		Message:       "おはよう",
+		Status:        valueobject.MorningCallStatusScheduled,
+		CreatedAt:     base,
+		UpdatedAt:     base,
+	}
+	if err := morningCallRepo.Create(ctx, mc); err != nil {
+		t.Fatalf("failed to create morning call: %v", err)
+	}
+
+	// 受信者の閲覧 → 第三者の閲覧試行 → 退会済みユーザーの閲覧試行 → 送信者の閲覧
+	for i, access := range []struct {
+		viewerID string
+		allowed  bool
+	}{
+		{"receiver", true}, {"stranger", false}, {"deleted", false}, {"sender", true},
+	} {
+		if err := accessLogRepo.Record(ctx, &entity.MorningCallAccess{
+			MorningCallID: "mc1",
+			ViewerID:      access.viewerID,
+			Allowed:       access.allowed,
+			AccessedAt:    base.Add(time.Duration(i) * time.Minute),
+		}); err != nil {
+			t.Fatalf("failed to record access: %v", err)
+		}
+	}
+
+	uc := NewListAccessLogUseCase(morningCallRepo, accessLogRepo, userRepo)
+
+	t.Run("新しい順に閲覧者と関係を返す", func(t *testing.T) {
+		output, err := uc.Execute(ctx, ListAccessLogInput{MorningCallID: "mc1", UserID: "sender"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.TotalCount != 4 || output.HasNext {
+			t.Errorf("TotalCount = %d, HasNext = %v, want 4, false", output.TotalCount, output.HasNext)
+		}
+
+		want := []struct {
+			viewerID string
+			role     AccessViewerRole
+			isSelf   bool
+			allowed  bool
+			username string
+		}{
+			{"sender", AccessViewerRoleSender, true, true, "alice"},
+			{"deleted", AccessViewerRoleOther, false, false, ""},
+			{"stranger", AccessViewerRoleOther, false, false, "mallory"},
+			{"receiver", AccessViewerRoleReceiver, false, true, "bob"},
+		}
+		if len(output.Entries) != len(want) {
+			t.Fatalf("got %d entries, want %d", len(output.Entries), len(want))
+		}
+		for i, e := range output.Entries {
+			w := want[i]
+			if e.Access.ViewerID != w.viewerID || e.ViewerRole != w.role || e.IsSelf != w.isSelf || e.Access.Allowed != w.allowed {
+				t.Errorf("entries[%d] = {%s %s self=%v allowed=%v}, want {%s %s self=%v allowed=%v}",
+					i, e.Access.ViewerID, e.ViewerRole, e.IsSelf, e.Access.Allowed, w.viewerID, w.role, w.isSelf, w.allowed)
+			}
+			username := ""
+			if e.Viewer != nil {
+				username = e.Viewer.Username
+			}
+			if username != w.username {
+				t.Errorf("entries[%d].Viewer.Username = %q, want %q", i, username, w.username)
+			}
+		}
+	})
+
+	t.Run("受信者も確認でき、ページネーションできる", func(t *testing.T) {
+		output, err := uc.Execute(ctx, ListAccessLogInput{MorningCallID: "mc1", UserID: "receiver", Offset: 2, Limit: 1})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(output.Entries) != 1 || output.Entries[0].Access.ViewerID != "stranger" || !output.HasNext {
+			t.Errorf("entries = %+v, HasNext = %v, want [stranger], true", output.Entries, output.HasNext)
+		}
+	})
+
+	errTests := []struct {
+		name    string
+		input   ListAccessLogInput
+		wantErr string
+	}{
+		{
+			name:    "第三者は確認できない",
+			input:   ListAccessLogInput{MorningCallID: "mc1", UserID: "stranger"},
+			wantErr: "送信者または受信者のみがアクセスログを確認できます",
+		},
+		{
+			name:    "存在しないコール",
+			input:   ListAccessLogInput{MorningCallID: "unknown", UserID: "sender"},
+			wantErr: "モーニングコールが見つかりません",
+		},
+		{
+			name:    "モーニングコールID未指定",
+			input:   ListAccessLogInput{UserID: "sender"},
+			wantErr: "モーニングコールIDは必須です",
+		},
+		{
+			name:    "ユーザーID未指定",
+			input:   ListAccessLogInput{MorningCallID: "mc1"},
+			wantErr: "ユーザーIDは必須です",
+		},
+	}
+	for _, tt := range errTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.Execute(ctx, tt.input)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package morning_call
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// ListCommentsUseCase はモーニングコールのコメントを時系列で取得するユースケース
+type ListCommentsUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	commentRepo     repository.CommentRepository
+}
+
+// NewListCommentsUseCase は新しいコメント一覧取得ユースケースを作成する
+func NewListCommentsUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	commentRepo repository.CommentRepository,
+) *ListCommentsUseCase {
+	return &ListCommentsUseCase{
+		morningCallRepo: morningCallRepo,
+		commentRepo:     commentRepo,
+	}
+}
+
+// ListCommentsInput はコメント一覧取得の入力データ
+type ListCommentsInput struct {
+	MorningCallID string // 必須：対象のモーニングコールのID
+	UserID        string // 必須：リクエストしたユーザーのID
+	Offset        int    // ページネーション：開始位置
+	Limit         int    // ページネーション：取得件数（デフォルト50件、最大100件）
+}
+
+// ListCommentsOutput はコメント一覧取得の出力データ
+type ListCommentsOutput struct {
+	Comments   []*entity.CallComment // 投稿日時の古い順
+	TotalCount int                   // コメントの総件数
+	HasNext    bool                  // 次のページがあるか
+}
+
+// Execute はモーニングコールのコメントを投稿日時の古い順に返す
+// コメントを確認できるのはコールの送信者と受信者のみ
+func (uc *ListCommentsUseCase) Execute(ctx context.Context, input ListCommentsInput) (*ListCommentsOutput, error) {
+	// 入力値の基本検証
+	if input.MorningCallID == "" {
+		return nil, fmt.Errorf("モーニングコールIDは必須です")
+	}
+	if input.UserID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+	if input.Limit <= 0 {
+		input.Limit = 50 // デフォルト値
+	}
+	if input.Limit > 100 {
+		input.Limit = 100 // 最大値制限
+	}
+	if input.Offset < 0 {
+		input.Offset = 0
+	}
+
+	morningCall, err := findCommentTarget(ctx, uc.morningCallRepo, input.MorningCallID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := uc.commentRepo.FindByCallID(ctx, morningCall.ID, input.Offset, input.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("コメントの取得中にエラーが発生しました: %w", err)
+	}
+	totalCount, err := uc.commentRepo.CountByCallID(ctx, morningCall.ID)
+	if err != nil {
+		return nil, fmt.Errorf("コメント数の取得中にエラーが発生しました: %w", err)
+	}
+
+	return &ListCommentsOutput{
+		Comments:   comments,
+		TotalCount: totalCount,
+		HasNext:    input.Offset+len(comments) < totalCount,
+	}, nil
+}
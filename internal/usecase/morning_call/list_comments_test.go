@@ -0,0 +1,61 @@
+package morning_call
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestListCommentsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	morningCallRepo, commentRepo := newCommentTestRepos(t)
+
+	// 起床確認の前後で送信者と受信者が交互にコメントする
+	addUC := NewAddCommentUseCase(morningCallRepo, commentRepo)
+	base := time.Date(2026, 3, 10, 5, 59, 0, 0, time.UTC)
+	for i, c := range []struct{ authorID, body string }{
+		{"sender", "起きた？"},
+		{"receiver", "起きたよ"},
+		{"sender", "えらい"},
+	} {
+		addUC.now = func() time.Time { return base.Add(time.Duration(i) * time.Minute) }
+		if _, err := addUC.Execute(ctx, AddCommentInput{MorningCallID: "mc1", AuthorID: c.authorID, Body: c.body}); err != nil {
+			t.Fatalf("failed to add comment: %v", err)
+		}
+	}
+
+	uc := NewListCommentsUseCase(morningCallRepo, commentRepo)
+
+	t.Run("投稿日時の古い順に返す", func(t *testing.T) {
+		for _, userID := range []string{"sender", "receiver"} {
+			output, err := uc.Execute(ctx, ListCommentsInput{MorningCallID: "mc1", UserID: userID})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output.TotalCount != 3 || output.HasNext || len(output.Comments) != 3 {
+				t.Fatalf("TotalCount = %d, HasNext = %v, len = %d, want 3, false, 3", output.TotalCount, output.HasNext, len(output.Comments))
+			}
+			for i, want := range []string{"起きた？", "起きたよ", "えらい"} {
+				if output.Comments[i].Body != want {
+					t.Errorf("Comments[%d].Body = %s, want %s", i, output.Comments[i].Body, want)
+				}
+			}
+		}
+	})
+
+	t.Run("ページネーション", func(t *testing.T) {
+		output, err := uc.Execute(ctx, ListCommentsInput{MorningCallID: "mc1", UserID: "receiver", Offset: 1, Limit: 1})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(output.Comments) != 1 || output.Comments[0].Body != "起きたよ" || !output.HasNext {
+			t.Errorf("Comments = %+v, HasNext = %v, want [起きたよ], true", output.Comments, output.HasNext)
+		}
+	})
+
+	t.Run("第三者は取得できない", func(t *testing.T) {
+		if _, err := uc.Execute(ctx, ListCommentsInput{MorningCallID: "mc1", UserID: "stranger"}); err == nil || err.Error() != "送信者または受信者のみがコメントを利用できます" {
+			t.Errorf("error = %v, want forbidden", err)
+		}
+	})
+}
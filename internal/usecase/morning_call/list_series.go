@@ -0,0 +1,107 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// ListSeriesUseCase はシリーズに属するモーニングコールの一覧取得ユースケース
+type ListSeriesUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+}
+
+// NewListSeriesUseCase は新しいシリーズ一覧取得ユースケースを作成する
+func NewListSeriesUseCase(
+	morningCallRepo repository.MorningCallRepository,
+) *ListSeriesUseCase {
+	return &ListSeriesUseCase{
+		morningCallRepo: morningCallRepo,
+	}
+}
+
+// ListSeriesInput はシリーズ一覧取得の入力データ
+type ListSeriesInput struct {
+	SeriesID string
+	UserID   string // 閲覧するユーザー（送信者または受信者）のID
+}
+
+// ListSeriesOutput はシリーズ一覧取得の出力データ
+type ListSeriesOutput struct {
+	MorningCalls []*entity.MorningCall // アラーム時刻の昇順
+}
+
+// Execute はシリーズに属するモーニングコールをアラーム時刻の昇順で取得する
+// 送信者・受信者以外にはシリーズの存在を明かさない
+func (uc *ListSeriesUseCase) Execute(ctx context.Context, input ListSeriesInput) (*ListSeriesOutput, error) {
+	morningCalls, err := findSeries(ctx, uc.morningCallRepo, input.SeriesID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListSeriesOutput{
+		MorningCalls: morningCalls,
+	}, nil
+}
+
+// seriesSkipLabels はシリーズの一括操作の対象外になったコールのステータスごとの説明
+var seriesSkipLabels = map[valueobject.MorningCallStatus]string{
+	valueobject.MorningCallStatusPendingApproval: "受信者の承認待ち",
+	valueobject.MorningCallStatusDelivered:       "配信済み",
+	valueobject.MorningCallStatusConfirmed:       "起床確認済み",
+	valueobject.MorningCallStatusCancelled:       "キャンセル済み",
+	valueobject.MorningCallStatusExpired:         "期限切れ",
+	valueobject.MorningCallStatusSkipped:         "スキップ済み",
+	valueobject.MorningCallStatusRejected:        "受信者が拒否済み",
+}
+
+// SeriesSkip はシリーズの一括操作の対象外になったコールの情報
+type SeriesSkip struct {
+	MorningCallID string
+	Reason        string
+}
+
+// seriesSkipReason は一括操作の対象外になったコールの理由を返す
+func seriesSkipReason(mc *entity.MorningCall) string {
+	if mc.IsDeleted() {
+		return "管理者により削除されているため対象外です"
+	}
+	if label, ok := seriesSkipLabels[mc.Status]; ok {
+		return label + "のため対象外です"
+	}
+	return "現在のステータスでは操作できないため対象外です"
+}
+
+// seriesSaveFailureReason はコールの保存に失敗した場合の理由を返す
+func seriesSaveFailureReason(err error) string {
+	if errors.Is(err, repository.ErrUpdateConflict) {
+		return "他の操作で更新されたためスキップしました"
+	}
+	return "保存に失敗しました"
+}
+
+// findSeries はシリーズのコールを取得し、ユーザーがシリーズの送信者または受信者であることを確認する
+func findSeries(ctx context.Context, morningCallRepo repository.MorningCallRepository, seriesID, userID string) ([]*entity.MorningCall, error) {
+	// 入力値の基本検証
+	if seriesID == "" {
+		return nil, fmt.Errorf("シリーズIDは必須です")
+	}
+	if userID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+
+	morningCalls, err := morningCallRepo.FindBySeriesID(ctx, seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("シリーズの取得中にエラーが発生しました: %w", err)
+	}
+
+	// シリーズのコールは送信者・受信者が共通のため先頭のコールで判定する
+	if len(morningCalls) == 0 || (morningCalls[0].SenderID != userID && morningCalls[0].ReceiverID != userID) {
+		return nil, fmt.Errorf("シリーズが見つかりません")
+	}
+	return morningCalls, nil
+}
@@ -0,0 +1,86 @@
+package morning_call
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func setupSeriesTest(t *testing.T) *memory.MorningCallRepository {
+	t.Helper()
+	ctx := context.Background()
+	morningCallRepo := memory.NewMorningCallRepository()
+	base := time.Now().Add(24 * time.Hour)
+
+	calls := []struct {
+		id     string
+		series string
+		offset time.Duration
+		status valueobject.MorningCallStatus
+	}{
+		{"mc1", "series1", 0, valueobject.MorningCallStatusConfirmed},
+		{"mc2", "series1", 24 * time.Hour, valueobject.MorningCallStatusScheduled},
+		{"mc3", "series1", 48 * time.Hour, valueobject.MorningCallStatusPendingApproval},
+		{"other", "", 72 * time.Hour, valueobject.MorningCallStatusScheduled},
+	}
+	for _, c := range calls {
+		if err := morningCallRepo.Create(ctx, &entity.MorningCall{
+			ID:            c.id,
+			SenderID:      "user1",
+			ReceiverID:    "user2",
+			ScheduledTime: base.Add(c.offset),
+			Status:        c.status,
+			SeriesID:      c.series,
+		}); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+	return morningCallRepo
+}
+
+func TestListSeriesUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		seriesID string
+		userID   string
+		wantIDs  []string
+		errorMsg string
+	}{
+		{name: "送信者はシリーズの一覧を取得できる", seriesID: "series1", userID: "user1", wantIDs: []string{"mc1", "mc2", "mc3"}},
+		{name: "受信者はシリーズの一覧を取得できる", seriesID: "series1", userID: "user2", wantIDs: []string{"mc1", "mc2", "mc3"}},
+		{name: "関係のないユーザーには存在を明かさない", seriesID: "series1", userID: "user3", errorMsg: "シリーズが見つかりません"},
+		{name: "存在しないシリーズ", seriesID: "unknown", userID: "user1", errorMsg: "シリーズが見つかりません"},
+		{name: "シリーズIDは必須", seriesID: "", userID: "user1", errorMsg: "シリーズIDは必須です"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewListSeriesUseCase(setupSeriesTest(t))
+			output, err := uc.Execute(ctx, ListSeriesInput{SeriesID: tt.seriesID, UserID: tt.userID})
+
+			if tt.errorMsg != "" {
+				if err == nil || err.Error() != tt.errorMsg {
+					t.Errorf("error = %v, want %s", err, tt.errorMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(output.MorningCalls) != len(tt.wantIDs) {
+				t.Fatalf("got %d calls, want %d", len(output.MorningCalls), len(tt.wantIDs))
+			}
+			for i, id := range tt.wantIDs {
+				if output.MorningCalls[i].ID != id {
+					t.Errorf("MorningCalls[%d] = %s, want %s", i, output.MorningCalls[i].ID, id)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package morning_call
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// ListSystemMessagesUseCase はシステムが用意した定型メッセージの一覧を取得するユースケース
+// ユーザーはモーニングコールの作成時に定型文のIDを指定して本文に使える
+type ListSystemMessagesUseCase struct {
+	catalog valueobject.SystemMessageCatalog
+}
+
+// NewListSystemMessagesUseCase は新しい定型メッセージ一覧取得ユースケースを作成する
+// catalogがnilの場合は組み込みの定型メッセージを使用する
+func NewListSystemMessagesUseCase(catalog valueobject.SystemMessageCatalog) *ListSystemMessagesUseCase {
+	if catalog == nil {
+		catalog = valueobject.DefaultSystemMessageCatalog()
+	}
+	return &ListSystemMessagesUseCase{
+		catalog: catalog,
+	}
+}
+
+// ListSystemMessagesInput は定型メッセージ一覧取得の入力データ
+type ListSystemMessagesInput struct {
+	Category valueobject.SystemMessageCategory // オプション：カテゴリで絞り込む（空の場合は全カテゴリ）
+	Language string                            // オプション：本文の言語コード（未指定・未対応の場合は日本語）
+}
+
+// SystemMessageItem は指定した言語で取り出した定型メッセージ
+type SystemMessageItem struct {
+	ID       string
+	Category valueobject.SystemMessageCategory
+	Text     string
+}
+
+// ListSystemMessagesOutput は定型メッセージ一覧取得の出力データ
+type ListSystemMessagesOutput struct {
+	Language string // 本文に使った言語コード
+	Messages []SystemMessageItem
+}
+
+// Execute は定型メッセージを指定した言語の本文で返す
+func (uc *ListSystemMessagesUseCase) Execute(ctx context.Context, input ListSystemMessagesInput) (*ListSystemMessagesOutput, error) {
+	_ = ctx // 将来的な外部データ実装のために保持
+
+	// 入力値の基本検証
+	if input.Category != "" && !input.Category.IsValid() {
+		return nil, fmt.Errorf("カテゴリは cheerful, gentle, strict のいずれかを指定してください")
+	}
+	if input.Language != "" && !languageCodePattern.MatchString(input.Language) {
+		return nil, fmt.Errorf("言語コードの形式が不正です")
+	}
+
+	language := valueobject.DefaultSystemMessageLanguage
+	if input.Language != "" && uc.catalog.SupportsLanguage(input.Language) {
+		language = strings.ToLower(input.Language)
+	}
+
+	messages := make([]SystemMessageItem, 0, len(uc.catalog))
+	for _, m := range uc.catalog {
+		if input.Category != "" && m.Category != input.Category {
+			continue
+		}
+		text, _ := m.Text(language)
+		messages = append(messages, SystemMessageItem{
+			ID:       m.ID,
+			Category: m.Category,
+			Text:     text,
+		})
+	}
+
+	return &ListSystemMessagesOutput{
+		Language: language,
+		Messages: messages,
+	}, nil
+}
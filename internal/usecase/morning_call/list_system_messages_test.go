@@ -0,0 +1,103 @@
+package morning_call
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+func TestListSystemMessagesUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	catalog := valueobject.SystemMessageCatalog{
+		{ID: "cheer", Category: valueobject.SystemMessageCategoryCheerful, Texts: map[string]string{"ja": "がんばろう", "en": "You can do it"}},
+		{ID: "gentle", Category: valueobject.SystemMessageCategoryGentle, Texts: map[string]string{"ja": "ゆっくり起きてね"}},
+		{ID: "strict", Category: valueobject.SystemMessageCategoryStrict, Texts: map[string]string{"ja": "起きなさい", "en": "Get up"}},
+	}
+	uc := NewListSystemMessagesUseCase(catalog)
+
+	tests := []struct {
+		name      string
+		input     ListSystemMessagesInput
+		wantLang  string
+		wantIDs   []string
+		wantTexts []string
+		wantErr   string
+	}{
+		{
+			name:      "全カテゴリを日本語で返す",
+			input:     ListSystemMessagesInput{},
+			wantLang:  "ja",
+			wantIDs:   []string{"cheer", "gentle", "strict"},
+			wantTexts: []string{"がんばろう", "ゆっくり起きてね", "起きなさい"},
+		},
+		{
+			name:      "カテゴリで絞り込む",
+			input:     ListSystemMessagesInput{Category: valueobject.SystemMessageCategoryStrict},
+			wantLang:  "ja",
+			wantIDs:   []string{"strict"},
+			wantTexts: []string{"起きなさい"},
+		},
+		{
+			name:      "英語の本文がない定型文は日本語で返す",
+			input:     ListSystemMessagesInput{Language: "en"},
+			wantLang:  "en",
+			wantIDs:   []string{"cheer", "gentle", "strict"},
+			wantTexts: []string{"You can do it", "ゆっくり起きてね", "Get up"},
+		},
+		{
+			name:      "未対応の言語は日本語で返す",
+			input:     ListSystemMessagesInput{Language: "fr"},
+			wantLang:  "ja",
+			wantIDs:   []string{"cheer", "gentle", "strict"},
+			wantTexts: []string{"がんばろう", "ゆっくり起きてね", "起きなさい"},
+		},
+		{
+			name:    "不正なカテゴリ",
+			input:   ListSystemMessagesInput{Category: "sleepy"},
+			wantErr: "カテゴリは cheerful, gentle, strict のいずれかを指定してください",
+		},
+		{
+			name:    "不正な言語コード",
+			input:   ListSystemMessagesInput{Language: "japanese!"},
+			wantErr: "言語コードの形式が不正です",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := uc.Execute(ctx, tt.input)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output.Language != tt.wantLang {
+				t.Errorf("Language = %s, want %s", output.Language, tt.wantLang)
+			}
+			if len(output.Messages) != len(tt.wantIDs) {
+				t.Fatalf("got %d messages, want %d", len(output.Messages), len(tt.wantIDs))
+			}
+			for i, m := range output.Messages {
+				if m.ID != tt.wantIDs[i] || m.Text != tt.wantTexts[i] {
+					t.Errorf("Messages[%d] = %s %q, want %s %q", i, m.ID, m.Text, tt.wantIDs[i], tt.wantTexts[i])
+				}
+			}
+		})
+	}
+}
+
+func TestNewListSystemMessagesUseCase_DefaultCatalog(t *testing.T) {
+	output, err := NewListSystemMessagesUseCase(nil).Execute(context.Background(), ListSystemMessagesInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(output.Messages) != len(valueobject.DefaultSystemMessageCatalog()) {
+		t.Errorf("got %d messages, want the built-in catalog", len(output.Messages))
+	}
+}
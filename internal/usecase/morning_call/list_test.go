@@ -16,7 +16,7 @@ func TestNewListUseCase(t *testing.T) {
 	morningCallRepo := memory.NewMorningCallRepository()
 	userRepo := memory.NewUserRepository()
 
-	uc := NewListUseCase(morningCallRepo, userRepo)
+	uc := NewListUseCase(morningCallRepo, userRepo, nil)
 
 	if uc == nil {
 		t.Fatal("NewListUseCase returned nil")
@@ -73,7 +73,18 @@ func TestListUseCase_Execute_InputValidation(t *testing.T) {
 				Limit:    20,
 			},
 			wantErr: true,
-			errMsg:  "一覧タイプは'sent'または'received'を指定してください",
+			errMsg:  "一覧タイプは'sent'、'received'または'archived'を指定してください",
+		},
+		{
+			name: "無効な並び順",
+			input: ListInput{
+				UserID:   user1.ID,
+				ListType: ListTypeSent,
+				Sort:     "priority",
+				Limit:    20,
+			},
+			wantErr: true,
+			errMsg:  "並び順は scheduled_time、-scheduled_time、created_at、-created_at のいずれかを指定してください",
 		},
 		{
 			name: "存在しないユーザー",
@@ -107,7 +118,7 @@ func TestListUseCase_Execute_InputValidation(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			uc := NewListUseCase(morningCallRepo, userRepo)
+			uc := NewListUseCase(morningCallRepo, userRepo, nil)
 			output, err := uc.Execute(ctx, tt.input)
 
 			if tt.wantErr {
@@ -207,7 +218,7 @@ func TestListUseCase_Execute_SentList(t *testing.T) {
 		}
 	}
 
-	uc := NewListUseCase(morningCallRepo, userRepo)
+	uc := NewListUseCase(morningCallRepo, userRepo, nil)
 
 	// user1の送信リストを取得
 	output, err := uc.Execute(ctx, ListInput{
@@ -310,7 +321,7 @@ func TestListUseCase_Execute_ReceivedList(t *testing.T) {
 		}
 	}
 
-	uc := NewListUseCase(morningCallRepo, userRepo)
+	uc := NewListUseCase(morningCallRepo, userRepo, nil)
 
 	// user1の受信リストを取得
 	output, err := uc.Execute(ctx, ListInput{
@@ -345,6 +356,85 @@ func TestListUseCase_Execute_ReceivedList(t *testing.T) {
 	}
 }
 
+func TestListUseCase_Execute_ArchivedList(t *testing.T) {
+	ctx := context.Background()
+
+	morningCallRepo := memory.NewMorningCallRepository()
+	userRepo := memory.NewUserRepository()
+
+	for _, u := range []*entity.User{
+		{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+		{ID: "user2", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+
+	// user1が受信した確認済みのコール5件のうち2件をアーカイブ済みにする
+	now := time.Now()
+	for i := 0; i < 5; i++ {
+		mc := &entity.MorningCall{
+			ID:            fmt.Sprintf("mc_%d", i),
+			SenderID:      "user2",
+			ReceiverID:    "user1",
+			ScheduledTime: now.Add(-time.Duration(i+1) * time.Hour),
+			Status:        valueobject.MorningCallStatusConfirmed,
+			Archived:      i < 2,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		}
+		if err := morningCallRepo.Create(ctx, mc); err != nil {
+			t.Fatalf("failed to create morning call %d: %v", i, err)
+		}
+	}
+
+	uc := NewListUseCase(morningCallRepo, userRepo, nil)
+	confirmed := valueobject.MorningCallStatusConfirmed
+	start, end := now.Add(-24*time.Hour), now
+
+	tests := []struct {
+		name     string
+		input    ListInput
+		archived bool
+		want     int
+	}{
+		{name: "受信一覧はアーカイブしたものを除く", input: ListInput{ListType: ListTypeReceived}, want: 3},
+		{name: "アーカイブ一覧", input: ListInput{ListType: ListTypeArchived}, archived: true, want: 2},
+		{name: "ステータスで絞り込んだ受信一覧", input: ListInput{ListType: ListTypeReceived, Status: &confirmed}, want: 3},
+		{name: "ステータスで絞り込んだアーカイブ一覧", input: ListInput{ListType: ListTypeArchived, Status: &confirmed}, archived: true, want: 2},
+		{name: "期間で絞り込んだ受信一覧", input: ListInput{ListType: ListTypeReceived, StartTime: &start, EndTime: &end}, want: 3},
+		{name: "期間で絞り込んだアーカイブ一覧", input: ListInput{ListType: ListTypeArchived, StartTime: &start, EndTime: &end}, archived: true, want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.input.UserID = "user1"
+			output, err := uc.Execute(ctx, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(output.MorningCalls) != tt.want || output.TotalCount != tt.want {
+				t.Errorf("got %d calls (total %d), want %d", len(output.MorningCalls), output.TotalCount, tt.want)
+			}
+			for _, mc := range output.MorningCalls {
+				if mc.Archived != tt.archived {
+					t.Errorf("morning call %s Archived = %v, want %v", mc.ID, mc.Archived, tt.archived)
+				}
+			}
+		})
+	}
+
+	// 送信一覧は受信者のアーカイブに影響されない
+	output, err := uc.Execute(ctx, ListInput{UserID: "user2", ListType: ListTypeSent})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.TotalCount != 5 {
+		t.Errorf("sent list total = %d, want 5", output.TotalCount)
+	}
+}
+
 func TestListUseCase_Execute_Pagination(t *testing.T) {
 	ctx := context.Background()
 
@@ -396,7 +486,7 @@ func TestListUseCase_Execute_Pagination(t *testing.T) {
 		}
 	}
 
-	uc := NewListUseCase(morningCallRepo, userRepo)
+	uc := NewListUseCase(morningCallRepo, userRepo, nil)
 
 	// 1ページ目を取得（10件）
 	output1, err := uc.Execute(ctx, ListInput{
@@ -536,7 +626,7 @@ func TestListUseCase_Execute_StatusFilter(t *testing.T) {
 		}
 	}
 
-	uc := NewListUseCase(morningCallRepo, userRepo)
+	uc := NewListUseCase(morningCallRepo, userRepo, nil)
 
 	// スケジュール済みのみでフィルタ
 	statusScheduled := valueobject.MorningCallStatusScheduled
@@ -641,7 +731,7 @@ func TestListUseCase_Execute_TimeRangeFilter(t *testing.T) {
 		}
 	}
 
-	uc := NewListUseCase(morningCallRepo, userRepo)
+	uc := NewListUseCase(morningCallRepo, userRepo, nil)
 
 	// 5時間後から20時間後までの範囲でフィルタ
 	startTime := baseTime.Add(5 * time.Hour)
@@ -689,6 +779,215 @@ func TestListUseCase_Execute_TimeRangeFilter(t *testing.T) {
 	}
 }
 
+func TestListUseCase_Execute_CounterpartAndSort(t *testing.T) {
+	ctx := context.Background()
+
+	morningCallRepo := memory.NewMorningCallRepository()
+	userRepo := memory.NewUserRepository()
+
+	for _, u := range []*entity.User{
+		{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+		{ID: "user2", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+		{ID: "user3", Username: "carol", Email: "carol@example.com", PasswordHash: "hashed_password"},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+
+	// user1が受信するコール（作成順とアラーム時刻の順を変えておく）
+	baseTime := time.Date(2026, 3, 15, 7, 0, 0, 0, time.UTC)
+	calls := []struct {
+		id       string
+		senderID string
+		offset   time.Duration // アラーム時刻のbaseTimeからのずれ
+		created  time.Duration // 作成日時のbaseTimeからのずれ
+	}{
+		{"mc_a", "user2", 3 * time.Hour, -3 * time.Hour},
+		{"mc_b", "user3", 1 * time.Hour, -1 * time.Hour},
+		{"mc_c", "user2", 2 * time.Hour, -5 * time.Hour},
+	}
+	for _, c := range calls {
+		mc := &entity.MorningCall{
+			ID:            c.id,
+			SenderID:      c.senderID,
+			ReceiverID:    "user1",
+			ScheduledTime: baseTime.Add(c.offset),
+			Status:        valueobject.MorningCallStatusScheduled,
+			CreatedAt:     baseTime.Add(c.created),
+			UpdatedAt:     baseTime.Add(c.created),
+		}
+		if err := morningCallRepo.Create(ctx, mc); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+
+	uc := NewListUseCase(morningCallRepo, userRepo, nil)
+	after := baseTime.Add(2 * time.Hour)
+
+	tests := []struct {
+		name    string
+		input   ListInput
+		wantIDs []string
+		wantAll int
+	}{
+		{
+			name:    "デフォルトは直近のものが先",
+			input:   ListInput{UserID: "user1", ListType: ListTypeReceived},
+			wantIDs: []string{"mc_b", "mc_c", "mc_a"},
+			wantAll: 3,
+		},
+		{
+			name:    "送信者で絞り込む",
+			input:   ListInput{UserID: "user1", ListType: ListTypeReceived, CounterpartID: "user2"},
+			wantIDs: []string{"mc_c", "mc_a"},
+			wantAll: 2,
+		},
+		{
+			name:    "開始時刻のみ指定",
+			input:   ListInput{UserID: "user1", ListType: ListTypeReceived, StartTime: &after},
+			wantIDs: []string{"mc_c", "mc_a"},
+			wantAll: 2,
+		},
+		{
+			name:    "終了時刻のみ指定",
+			input:   ListInput{UserID: "user1", ListType: ListTypeReceived, EndTime: &after},
+			wantIDs: []string{"mc_b", "mc_c"},
+			wantAll: 2,
+		},
+		{
+			name:    "アラーム時刻の降順",
+			input:   ListInput{UserID: "user1", ListType: ListTypeReceived, Sort: ListSortOrderScheduledTimeDesc},
+			wantIDs: []string{"mc_a", "mc_c", "mc_b"},
+			wantAll: 3,
+		},
+		{
+			name:    "作成日時の昇順でページネーション",
+			input:   ListInput{UserID: "user1", ListType: ListTypeReceived, Sort: ListSortOrderCreatedAt, Offset: 1, Limit: 1},
+			wantIDs: []string{"mc_a"},
+			wantAll: 3,
+		},
+		{
+			name:    "送信一覧では受信者で絞り込む",
+			input:   ListInput{UserID: "user2", ListType: ListTypeSent, CounterpartID: "user1", Sort: ListSortOrderCreatedAtDesc},
+			wantIDs: []string{"mc_a", "mc_c"},
+			wantAll: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := uc.Execute(ctx, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			ids := make([]string, len(output.MorningCalls))
+			for i, mc := range output.MorningCalls {
+				ids[i] = mc.ID
+			}
+			if strings.Join(ids, ",") != strings.Join(tt.wantIDs, ",") {
+				t.Errorf("ids = %v, want %v", ids, tt.wantIDs)
+			}
+			if output.TotalCount != tt.wantAll {
+				t.Errorf("TotalCount = %d, want %d", output.TotalCount, tt.wantAll)
+			}
+		})
+	}
+}
+
+func TestListUseCase_Execute_UpdatedSince(t *testing.T) {
+	ctx := context.Background()
+
+	morningCallRepo := memory.NewMorningCallRepository()
+	userRepo := memory.NewUserRepository()
+
+	for _, u := range []*entity.User{
+		{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+		{ID: "user2", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+
+	// 更新日時の順とアラーム時刻の順を変えておく
+	baseTime := time.Date(2026, 3, 15, 7, 0, 0, 0, time.UTC)
+	deletedAt := baseTime.Add(-1 * time.Hour)
+	calls := []struct {
+		id        string
+		updated   time.Duration // 更新日時のbaseTimeからのずれ
+		deletedAt *time.Time
+	}{
+		{"mc_old", -5 * time.Hour, nil},
+		{"mc_new", -2 * time.Hour, nil},
+		{"mc_deleted", -1 * time.Hour, &deletedAt},
+		{"mc_boundary", -3 * time.Hour, nil},
+	}
+	for i, c := range calls {
+		mc := &entity.MorningCall{
+			ID:            c.id,
+			SenderID:      "user1",
+			ReceiverID:    "user2",
+			ScheduledTime: baseTime.Add(time.Duration(i) * time.Hour),
+			Status:        valueobject.MorningCallStatusScheduled,
+			CreatedAt:     baseTime.Add(-6 * time.Hour),
+			UpdatedAt:     baseTime.Add(c.updated),
+			DeletedAt:     c.deletedAt,
+		}
+		if err := morningCallRepo.Create(ctx, mc); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+
+	uc := NewListUseCase(morningCallRepo, userRepo, nil)
+	uc.now = func() time.Time { return baseTime }
+	since := baseTime.Add(-3 * time.Hour)
+
+	tests := []struct {
+		name    string
+		input   ListInput
+		wantIDs []string
+	}{
+		{
+			name:    "更新日時の昇順で差分のみ返し、削除されたコールも含む",
+			input:   ListInput{UserID: "user1", ListType: ListTypeSent, UpdatedSince: &since},
+			wantIDs: []string{"mc_boundary", "mc_new", "mc_deleted"},
+		},
+		{
+			name:    "並び順を指定した場合はその順",
+			input:   ListInput{UserID: "user1", ListType: ListTypeSent, UpdatedSince: &since, Sort: ListSortOrderScheduledTimeDesc},
+			wantIDs: []string{"mc_boundary", "mc_deleted", "mc_new"},
+		},
+		{
+			name:    "受信一覧でも絞り込める",
+			input:   ListInput{UserID: "user2", ListType: ListTypeReceived, UpdatedSince: &since, Offset: 1, Limit: 1},
+			wantIDs: []string{"mc_new"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := uc.Execute(ctx, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			ids := make([]string, len(output.MorningCalls))
+			for i, mc := range output.MorningCalls {
+				ids[i] = mc.ID
+			}
+			if strings.Join(ids, ",") != strings.Join(tt.wantIDs, ",") {
+				t.Errorf("ids = %v, want %v", ids, tt.wantIDs)
+			}
+			if output.TotalCount != 3 {
+				t.Errorf("TotalCount = %d, want 3", output.TotalCount)
+			}
+			if want := baseTime.Add(-SyncCursorOverlap); !output.LastSyncTime.Equal(want) {
+				t.Errorf("LastSyncTime = %v, want %v", output.LastSyncTime, want)
+			}
+		})
+	}
+}
+
 func TestListUseCase_Execute_DefaultValues(t *testing.T) {
 	ctx := context.Background()
 
@@ -709,7 +1008,7 @@ func TestListUseCase_Execute_DefaultValues(t *testing.T) {
 		t.Fatalf("failed to create user1: %v", err)
 	}
 
-	uc := NewListUseCase(morningCallRepo, userRepo)
+	uc := NewListUseCase(morningCallRepo, userRepo, nil)
 
 	// Limitが0の場合、デフォルト値（20）が適用される
 	output, err := uc.Execute(ctx, ListInput{
@@ -751,3 +1050,81 @@ func TestListUseCase_Execute_DefaultValues(t *testing.T) {
 		t.Fatal("output is nil")
 	}
 }
+
+func TestListUseCase_Execute_FavoritesFirst(t *testing.T) {
+	ctx := context.Background()
+	morningCallRepo := memory.NewMorningCallRepository()
+	userRepo := memory.NewUserRepository()
+	relationshipRepo := memory.NewRelationshipRepository()
+
+	for _, u := range []*entity.User{
+		{ID: "receiver", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+		{ID: "favorite", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+		{ID: "other", Username: "carol", Email: "carol@example.com", PasswordHash: "hashed_password"},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+	for i, senderID := range []string{"favorite", "other"} {
+		rel := &entity.Relationship{
+			ID:          fmt.Sprintf("rel%d", i),
+			RequesterID: senderID,
+			ReceiverID:  "receiver",
+			Status:      valueobject.RelationshipStatusAccepted,
+		}
+		if senderID == "favorite" {
+			rel.ReceiverFavoriteSender = &valueobject.FavoriteSender{AlwaysRing: true}
+		}
+		if err := relationshipRepo.Create(ctx, rel); err != nil {
+			t.Fatalf("failed to create relationship: %v", err)
+		}
+	}
+
+	// お気に入りでない送信者のコールの方がアラーム時刻が早い
+	base := time.Now().Add(time.Hour)
+	for i, senderID := range []string{"other", "favorite", "other", "favorite"} {
+		if err := morningCallRepo.Create(ctx, &entity.MorningCall{
+			ID:            fmt.Sprintf("mc%d", i),
+			SenderID:      senderID,
+			ReceiverID:    "receiver",
+			ScheduledTime: base.Add(time.Duration(i) * time.Hour),
+			Status:        valueobject.MorningCallStatusScheduled,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		}); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+
+	uc := NewListUseCase(morningCallRepo, userRepo, relationshipRepo)
+
+	output, err := uc.Execute(ctx, ListInput{
+		UserID:         "receiver",
+		ListType:       ListTypeReceived,
+		Sort:           ListSortOrderScheduledTime,
+		FavoritesFirst: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// お気に入り送信元のコールが先頭に並び、それぞれの中ではアラーム時刻順を保つ
+	want := []string{"mc1", "mc3", "mc0", "mc2"}
+	if len(output.MorningCalls) != len(want) {
+		t.Fatalf("got %d calls, want %d", len(output.MorningCalls), len(want))
+	}
+	for i, mc := range output.MorningCalls {
+		if mc.ID != want[i] {
+			t.Errorf("MorningCalls[%d] = %s, want %s", i, mc.ID, want[i])
+		}
+	}
+	if favorite, ok := output.FavoriteSenders["favorite"]; !ok || !favorite.AlwaysRing || len(output.FavoriteSenders) != 1 {
+		t.Errorf("FavoriteSenders = %+v, want only favorite with AlwaysRing", output.FavoriteSenders)
+	}
+
+	// 送信一覧では指定できない
+	if _, err := uc.Execute(ctx, ListInput{UserID: "receiver", ListType: ListTypeSent, FavoritesFirst: true}); err == nil {
+		t.Error("expected error for favorites_first on sent list")
+	}
+}
@@ -0,0 +1,136 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// MessageVariantStatsUseCase は送信者がA/Bテストで送ったメッセージバリアント別の起床確認率を取得するユースケース
+type MessageVariantStatsUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	userRepo        repository.UserRepository
+}
+
+// NewMessageVariantStatsUseCase は新しいメッセージバリアント別の統計取得ユースケースを作成する
+func NewMessageVariantStatsUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	userRepo repository.UserRepository,
+) *MessageVariantStatsUseCase {
+	return &MessageVariantStatsUseCase{
+		morningCallRepo: morningCallRepo,
+		userRepo:        userRepo,
+	}
+}
+
+// MessageVariantStatsInput はメッセージバリアント別の統計取得の入力データ
+type MessageVariantStatsInput struct {
+	SenderID string // 必須：送信者のID
+	SeriesID string // オプション：指定したシリーズのコールのみを集計する
+}
+
+// MessageVariantStat はメッセージバリアントごとの集計
+// 同じ本文でも候補の組み合わせが異なる場合は別のバリアントとして集計する
+type MessageVariantStat struct {
+	MessageVariants     []string // バリアントの候補（順序を含む）
+	MessageVariantIndex int      // 候補のうち配信に選ばれたバリアントの番号（0始まり）
+	Message             string   // バリアントの本文
+	Delivered           int      // このバリアントが配信されたコール数
+	Confirmed           int      // 起床確認されたコール数
+	Missed              int      // 起床確認されないまま期限切れになったコール数
+
+	// ConfirmationRate は結果の出たコールのうち起床確認された割合（0〜1、結果の出たコールがない場合は0）
+	ConfirmationRate float64
+}
+
+// messageVariantKey はバリアントの集計単位（候補の組み合わせと番号）
+type messageVariantKey struct {
+	variants string // 候補をクォートして連結したもの（候補の区切りと本文が混ざらないようにする）
+	index    int
+}
+
+// MessageVariantStatsOutput はメッセージバリアント別の統計取得の出力データ
+type MessageVariantStatsOutput struct {
+	Variants []MessageVariantStat // 起床確認率の高い順（同率の場合は配信数の多い順、本文順、候補の順、番号順）
+}
+
+// Execute は送信者のコールのうちメッセージバリアントから選んで配信したものを、バリアントの候補と番号ごとに集計する
+// 未配信・キャンセルなど配信されなかったコールと、管理者に削除されたコールは含めない
+func (uc *MessageVariantStatsUseCase) Execute(ctx context.Context, input MessageVariantStatsInput) (*MessageVariantStatsOutput, error) {
+	// 入力値の基本検証
+	if input.SenderID == "" {
+		return nil, fmt.Errorf("送信者IDは必須です")
+	}
+
+	// 送信者の存在確認
+	if _, err := uc.userRepo.FindByID(ctx, input.SenderID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("送信者が見つかりません")
+		}
+		return nil, fmt.Errorf("送信者の確認中にエラーが発生しました: %w", err)
+	}
+
+	stats := make(map[messageVariantKey]*MessageVariantStat)
+	err := forEachSentCall(ctx, uc.morningCallRepo, input.SenderID, func(call entity.ReadOnlyMorningCall) {
+		index, ok := call.MessageVariantIndex()
+		if !ok || call.IsDeleted() || (input.SeriesID != "" && call.SeriesID() != input.SeriesID) {
+			return
+		}
+
+		variants := call.MessageVariants()
+		key := messageVariantKey{variants: fmt.Sprintf("%q", variants), index: index}
+		stat, exists := stats[key]
+		if !exists {
+			stat = &MessageVariantStat{MessageVariants: variants, MessageVariantIndex: index, Message: variants[index]}
+			stats[key] = stat
+		}
+		switch call.Status() {
+		case valueobject.MorningCallStatusDelivered:
+			stat.Delivered++
+		case valueobject.MorningCallStatusConfirmed:
+			stat.Delivered++
+			stat.Confirmed++
+		case valueobject.MorningCallStatusExpired:
+			stat.Delivered++
+			stat.Missed++
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("送信モーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+
+	output := &MessageVariantStatsOutput{Variants: make([]MessageVariantStat, 0, len(stats))}
+	for _, stat := range stats {
+		if stat.Delivered == 0 {
+			continue
+		}
+		if finished := stat.Confirmed + stat.Missed; finished > 0 {
+			stat.ConfirmationRate = float64(stat.Confirmed) / float64(finished)
+		}
+		output.Variants = append(output.Variants, *stat)
+	}
+	sort.Slice(output.Variants, func(i, j int) bool {
+		a, b := output.Variants[i], output.Variants[j]
+		if a.ConfirmationRate != b.ConfirmationRate {
+			return a.ConfirmationRate > b.ConfirmationRate
+		}
+		if a.Delivered != b.Delivered {
+			return a.Delivered > b.Delivered
+		}
+		if a.Message != b.Message {
+			return a.Message < b.Message
+		}
+		if c := slices.Compare(a.MessageVariants, b.MessageVariants); c != 0 {
+			return c < 0
+		}
+		return a.MessageVariantIndex < b.MessageVariantIndex
+	})
+
+	return output, nil
+}
@@ -0,0 +1,157 @@
+package morning_call
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestMessageVariantStatsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	morningCallRepo := memory.NewMorningCallRepository()
+	userRepo := memory.NewUserRepository()
+	for _, u := range []*entity.User{
+		{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+		{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+	uc := NewMessageVariantStatsUseCase(morningCallRepo, userRepo)
+
+	variants := []string{"A", "B"}
+	calls := []struct {
+		variant  int // -1はバリアントなし
+		status   valueobject.MorningCallStatus
+		seriesID string
+		deleted  bool
+	}{
+		// A: 確認2件・期限切れ2件・配信中1件
+		{variant: 0, status: valueobject.MorningCallStatusConfirmed},
+		{variant: 0, status: valueobject.MorningCallStatusConfirmed, seriesID: "s1"},
+		{variant: 0, status: valueobject.MorningCallStatusExpired},
+		{variant: 0, status: valueobject.MorningCallStatusExpired},
+		{variant: 0, status: valueobject.MorningCallStatusDelivered},
+		// B: 確認2件・期限切れ1件
+		{variant: 1, status: valueobject.MorningCallStatusConfirmed, seriesID: "s1"},
+		{variant: 1, status: valueobject.MorningCallStatusConfirmed},
+		{variant: 1, status: valueobject.MorningCallStatusExpired, seriesID: "s1"},
+		// 集計対象外：未配信・キャンセル・削除済み・バリアントなし
+		{variant: 1, status: valueobject.MorningCallStatusScheduled},
+		{variant: 1, status: valueobject.MorningCallStatusCancelled},
+		{variant: 1, status: valueobject.MorningCallStatusExpired, deleted: true},
+		{variant: -1, status: valueobject.MorningCallStatusConfirmed},
+	}
+	for i, c := range calls {
+		mc := &entity.MorningCall{
+			ID:            "mc" + string(rune('a'+i)),
+			SenderID:      "sender",
+			ReceiverID:    "receiver",
+			ScheduledTime: time.Now().Add(-time.Hour),
+			Status:        c.status,
+			SeriesID:      c.seriesID,
+		}
+		if c.variant >= 0 {
+			if reason := mc.SetMessageVariants(variants, c.variant, valueobject.DefaultInputLimits()); reason.IsNG() {
+				t.Fatalf("failed to set variants: %v", reason)
+			}
+		}
+		if c.deleted {
+			mc.DeleteByAdmin("admin", "規約違反", time.Now())
+		}
+		if err := morningCallRepo.Create(ctx, mc); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+
+	t.Run("バリアント別に起床確認率を比較する", func(t *testing.T) {
+		output, err := uc.Execute(ctx, MessageVariantStatsInput{SenderID: "sender"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := []MessageVariantStat{
+			{MessageVariants: variants, MessageVariantIndex: 1, Message: "B", Delivered: 3, Confirmed: 2, Missed: 1, ConfirmationRate: 2.0 / 3.0},
+			{MessageVariants: variants, MessageVariantIndex: 0, Message: "A", Delivered: 5, Confirmed: 2, Missed: 2, ConfirmationRate: 0.5},
+		}
+		if len(output.Variants) != len(want) {
+			t.Fatalf("Variants = %+v, want %+v", output.Variants, want)
+		}
+		for i := range want {
+			if !reflect.DeepEqual(output.Variants[i], want[i]) {
+				t.Errorf("Variants[%d] = %+v, want %+v", i, output.Variants[i], want[i])
+			}
+		}
+	})
+
+	t.Run("シリーズで絞り込む", func(t *testing.T) {
+		output, err := uc.Execute(ctx, MessageVariantStatsInput{SenderID: "sender", SeriesID: "s1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := []MessageVariantStat{
+			{MessageVariants: variants, MessageVariantIndex: 0, Message: "A", Delivered: 1, Confirmed: 1, ConfirmationRate: 1},
+			{MessageVariants: variants, MessageVariantIndex: 1, Message: "B", Delivered: 2, Confirmed: 1, Missed: 1, ConfirmationRate: 0.5},
+		}
+		if len(output.Variants) != len(want) {
+			t.Fatalf("Variants = %+v, want %+v", output.Variants, want)
+		}
+		for i := range want {
+			if !reflect.DeepEqual(output.Variants[i], want[i]) {
+				t.Errorf("Variants[%d] = %+v, want %+v", i, output.Variants[i], want[i])
+			}
+		}
+	})
+
+	t.Run("同じ本文でも候補の組み合わせが異なれば別に集計する", func(t *testing.T) {
+		repo := memory.NewMorningCallRepository()
+		otherVariants := []string{"C", "A"}
+		for i, c := range []struct {
+			variants []string
+			index    int
+			status   valueobject.MorningCallStatus
+		}{
+			{variants: variants, index: 0, status: valueobject.MorningCallStatusConfirmed},
+			{variants: otherVariants, index: 1, status: valueobject.MorningCallStatusExpired},
+		} {
+			mc := &entity.MorningCall{
+				ID:            "other" + string(rune('a'+i)),
+				SenderID:      "sender",
+				ReceiverID:    "receiver",
+				ScheduledTime: time.Now().Add(-time.Hour),
+				Status:        c.status,
+			}
+			if reason := mc.SetMessageVariants(c.variants, c.index, valueobject.DefaultInputLimits()); reason.IsNG() {
+				t.Fatalf("failed to set variants: %v", reason)
+			}
+			if err := repo.Create(ctx, mc); err != nil {
+				t.Fatalf("failed to create morning call: %v", err)
+			}
+		}
+
+		output, err := NewMessageVariantStatsUseCase(repo, userRepo).Execute(ctx, MessageVariantStatsInput{SenderID: "sender"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := []MessageVariantStat{
+			{MessageVariants: variants, MessageVariantIndex: 0, Message: "A", Delivered: 1, Confirmed: 1, ConfirmationRate: 1},
+			{MessageVariants: otherVariants, MessageVariantIndex: 1, Message: "A", Delivered: 1, Missed: 1},
+		}
+		if !reflect.DeepEqual(output.Variants, want) {
+			t.Errorf("Variants = %+v, want %+v", output.Variants, want)
+		}
+	})
+
+	t.Run("存在しない送信者", func(t *testing.T) {
+		_, err := uc.Execute(ctx, MessageVariantStatsInput{SenderID: "missing"})
+		if err == nil || !strings.Contains(err.Error(), "送信者が見つかりません") {
+			t.Errorf("error = %v, want not found", err)
+		}
+	})
+}
@@ -0,0 +1,90 @@
+package morning_call
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// NotifyExpirationUseCase はモーニングコールが起床確認・承認されないまま期限切れになったことを送信者へ通知するユースケース
+// 期限切れへの遷移（期限切れワーカーや期限後の起床確認）を購読して呼び出す
+type NotifyExpirationUseCase struct {
+	userRepo    repository.UserRepository
+	emailSender service.EmailSender
+}
+
+// NewNotifyExpirationUseCase は新しい期限切れ通知ユースケースを作成する
+// emailSenderがnilの場合は通知を行わない
+func NewNotifyExpirationUseCase(
+	userRepo repository.UserRepository,
+	emailSender service.EmailSender,
+) *NotifyExpirationUseCase {
+	return &NotifyExpirationUseCase{
+		userRepo:    userRepo,
+		emailSender: emailSender,
+	}
+}
+
+// NotifyExpirationInput は期限切れ通知の入力データ
+type NotifyExpirationInput struct {
+	MorningCall    *entity.MorningCall           // 期限切れになったモーニングコール
+	PreviousStatus valueobject.MorningCallStatus // 期限切れになる前のステータス（承認待ちの場合は承認されなかった旨を通知する）
+}
+
+// NotifyExpirationOutput は期限切れ通知の出力データ
+type NotifyExpirationOutput struct {
+	Notified bool // 送信者へ通知したか（通知の対象外の場合はfalse）
+}
+
+// Execute は期限切れになったモーニングコールの送信者へメールで通知する
+// 期限切れ以外のコール、セルフモーニングコール、通知を希望しない送信者には通知しない
+func (uc *NotifyExpirationUseCase) Execute(ctx context.Context, input NotifyExpirationInput) (*NotifyExpirationOutput, error) {
+	morningCall := input.MorningCall
+	if morningCall == nil {
+		return nil, fmt.Errorf("モーニングコールは必須です")
+	}
+	// セルフモーニングコールは送信者自身が受信者のため通知しない
+	if uc.emailSender == nil || morningCall.Status != valueobject.MorningCallStatusExpired || morningCall.SelfCall {
+		return &NotifyExpirationOutput{}, nil
+	}
+
+	sender, err := uc.userRepo.FindByID(ctx, morningCall.SenderID)
+	if err != nil {
+		return nil, fmt.Errorf("期限切れ通知の送信者の取得に失敗しました: %w", err)
+	}
+	if !sender.NotifyOnExpiration {
+		return &NotifyExpirationOutput{}, nil
+	}
+
+	// 受信者が退会済みなどで取得できない場合はIDで代用する
+	receiverName := morningCall.ReceiverID
+	if receiver, err := uc.userRepo.FindByID(ctx, morningCall.ReceiverID); err == nil {
+		receiverName = receiver.Username
+	}
+
+	// 承認待ちのまま期限切れになったコールは配信されていないため、起床確認ではなく承認されなかった旨を伝える
+	outcome := "起床確認されないまま"
+	if input.PreviousStatus == valueobject.MorningCallStatusPendingApproval {
+		outcome = "承認されないまま"
+	}
+	message := service.EmailMessage{
+		To:      sender.Email,
+		Subject: "モーニングコールが" + outcome + "期限切れになりました",
+		Body: fmt.Sprintf(
+			"%s さん\n\n%s さんに設定した %s のモーニングコールは、%s期限切れになりました。",
+			sender.Username,
+			receiverName,
+			morningCall.ScheduledTime.Format("2006-01-02 15:04"),
+			outcome,
+		),
+	}
+	if err := uc.emailSender.Send(ctx, message); err != nil {
+		return nil, fmt.Errorf("期限切れ通知の送信に失敗しました: %w", err)
+	}
+
+	return &NotifyExpirationOutput{Notified: true}, nil
+}
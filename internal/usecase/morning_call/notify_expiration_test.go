@@ -0,0 +1,101 @@
+package morning_call
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/mail"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestNotifyExpirationUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		status       valueobject.MorningCallStatus
+		previous     valueobject.MorningCallStatus
+		selfCall     bool
+		notify       bool
+		wantNotified bool
+	}{
+		{name: "期限切れになったコールの送信者に通知する", status: valueobject.MorningCallStatusExpired, notify: true, wantNotified: true},
+		{name: "承認待ちのまま期限切れになったコールは承認されなかった旨を通知する", status: valueobject.MorningCallStatusExpired, previous: valueobject.MorningCallStatusPendingApproval, notify: true, wantNotified: true},
+		{name: "通知を希望しない送信者には通知しない", status: valueobject.MorningCallStatusExpired, notify: false},
+		{name: "期限切れ以外のコールは通知しない", status: valueobject.MorningCallStatusConfirmed, notify: true},
+		{name: "セルフモーニングコールは通知しない", status: valueobject.MorningCallStatusExpired, selfCall: true, notify: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := memory.NewUserRepository()
+			emailSender := mail.NewMemoryEmailSender()
+
+			users := []*entity.User{
+				{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password", NotifyOnExpiration: tt.notify},
+				{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+			}
+			for _, u := range users {
+				if err := userRepo.Create(ctx, u); err != nil {
+					t.Fatalf("failed to create user: %v", err)
+				}
+			}
+			morningCall := &entity.MorningCall{
+				ID:            "mc1",
+				SenderID:      "sender",
+				ReceiverID:    "receiver",
+				ScheduledTime: time.Date(2026, 3, 15, 7, 0, 0, 0, time.UTC),
+				Status:        tt.status,
+				SelfCall:      tt.selfCall,
+			}
+			if tt.selfCall {
+				morningCall.ReceiverID = "sender"
+			}
+
+			uc := NewNotifyExpirationUseCase(userRepo, emailSender)
+			output, err := uc.Execute(ctx, NotifyExpirationInput{MorningCall: morningCall, PreviousStatus: tt.previous})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output.Notified != tt.wantNotified {
+				t.Errorf("Notified = %v, want %v", output.Notified, tt.wantNotified)
+			}
+
+			messages := emailSender.Messages()
+			if !tt.wantNotified {
+				if len(messages) != 0 {
+					t.Errorf("sent %d messages, want 0", len(messages))
+				}
+				return
+			}
+			if len(messages) != 1 {
+				t.Fatalf("sent %d messages, want 1", len(messages))
+			}
+			wantPhrase := "起床確認されないまま期限切れ"
+			if tt.previous == valueobject.MorningCallStatusPendingApproval {
+				wantPhrase = "承認されないまま期限切れ"
+			}
+			if messages[0].To != "alice@example.com" || !strings.Contains(messages[0].Body, "bob") || !strings.Contains(messages[0].Body, wantPhrase) {
+				t.Errorf("message = %+v, want expiration notice to alice about bob", messages[0])
+			}
+		})
+	}
+}
+
+func TestNotifyExpirationUseCase_Execute_SenderNotFound(t *testing.T) {
+	uc := NewNotifyExpirationUseCase(memory.NewUserRepository(), mail.NewMemoryEmailSender())
+
+	_, err := uc.Execute(context.Background(), NotifyExpirationInput{MorningCall: &entity.MorningCall{
+		ID:         "mc1",
+		SenderID:   "missing",
+		ReceiverID: "receiver",
+		Status:     valueobject.MorningCallStatusExpired,
+	}})
+	if err == nil {
+		t.Error("expected error, got nil")
+	}
+}
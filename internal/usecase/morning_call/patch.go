@@ -0,0 +1,137 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// PatchUseCase はモーニングコールの指定フィールドのみを更新するユースケース
+// 編集中のメッセージの自動保存など、一部の項目だけを頻繁に保存する用途を想定する
+type PatchUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	limits          valueobject.InputLimits
+	now             func() time.Time
+}
+
+// NewPatchUseCase は新しいモーニングコール部分更新ユースケースを作成する
+func NewPatchUseCase(morningCallRepo repository.MorningCallRepository, limits valueobject.InputLimits) *PatchUseCase {
+	return &PatchUseCase{
+		morningCallRepo: morningCallRepo,
+		limits:          limits,
+		now:             time.Now,
+	}
+}
+
+// PatchInput はモーニングコール部分更新の入力データ
+// nilのフィールドは変更しない
+type PatchInput struct {
+	ID                   string
+	SenderID             string     // 更新権限確認用
+	ScheduledTime        *time.Time // オプション：新しいアラーム時刻
+	Message              *string    // オプション：新しいメッセージ
+	ConfirmDeadline      *time.Time // オプション：新しい起床確認の期限
+	ClearConfirmDeadline bool       // 起床確認の期限を解除する（ConfirmDeadlineとは同時に指定できない）
+}
+
+// PatchOutput はモーニングコール部分更新の出力データ
+type PatchOutput struct {
+	MorningCall *entity.MorningCall
+}
+
+// Execute は指定されたフィールドのみを検証して更新する
+func (uc *PatchUseCase) Execute(ctx context.Context, input PatchInput) (*PatchOutput, error) {
+	// 入力値の基本検証
+	if input.ID == "" {
+		return nil, fmt.Errorf("モーニングコールIDは必須です")
+	}
+	if input.SenderID == "" {
+		return nil, fmt.Errorf("送信者IDは必須です")
+	}
+	if input.ConfirmDeadline != nil && input.ClearConfirmDeadline {
+		return nil, fmt.Errorf("起床確認の期限の設定と解除は同時に指定できません")
+	}
+	deadlineChanged := input.ConfirmDeadline != nil || input.ClearConfirmDeadline
+	if input.ScheduledTime == nil && input.Message == nil && !deadlineChanged {
+		return nil, fmt.Errorf("更新する項目を指定してください")
+	}
+
+	// モーニングコールの存在確認
+	morningCall, err := uc.morningCallRepo.FindByID(ctx, input.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("モーニングコールが見つかりません")
+		}
+		return nil, fmt.Errorf("モーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+
+	// 送信者の確認（送信者のみが更新可能）
+	if morningCall.SenderID != input.SenderID {
+		return nil, fmt.Errorf("送信者のみがモーニングコールを更新できます")
+	}
+
+	// ステータスの確認（スケジュール済みのもののみ更新可能）
+	if morningCall.Status != valueobject.MorningCallStatusScheduled {
+		return nil, fmt.Errorf("スケジュール済みのモーニングコールのみ更新できます")
+	}
+
+	// 取得したエンティティはリポジトリのコピーのため、検証に失敗しても保存済みの値には影響しない
+	// 時刻の更新
+	if input.ScheduledTime != nil {
+		morningCall.ScheduledTime = *input.ScheduledTime
+		if reason := morningCall.ValidateScheduledTime(); reason.IsNG() {
+			return nil, fmt.Errorf("アラーム時刻が不正です: %w", reason)
+		}
+
+		conflicted, err := hasNearbyActiveCall(ctx, uc.morningCallRepo, morningCall, *input.ScheduledTime)
+		if err != nil {
+			return nil, err
+		}
+		if conflicted {
+			return nil, fmt.Errorf("同じ時刻付近に既にモーニングコールが設定されています")
+		}
+	}
+
+	// メッセージの更新
+	if input.Message != nil {
+		morningCall.Message = *input.Message
+		if reason := morningCall.ValidateMessage(uc.limits); reason.IsNG() {
+			return nil, fmt.Errorf("メッセージが不正です: %w", reason)
+		}
+		// 送信者がメッセージを書き換えた場合はA/Bテストの対象から外す
+		morningCall.ClearMessageVariants()
+	}
+
+	// 起床確認の期限の更新
+	if input.ClearConfirmDeadline {
+		morningCall.ConfirmDeadline = nil
+	} else if input.ConfirmDeadline != nil {
+		deadline := *input.ConfirmDeadline
+		morningCall.ConfirmDeadline = &deadline
+	}
+	// 期限はアラーム時刻との前後関係を持つため、どちらかが変わった場合に検証する
+	if input.ScheduledTime != nil || deadlineChanged {
+		if reason := morningCall.ValidateConfirmDeadline(); reason.IsNG() {
+			return nil, fmt.Errorf("起床確認の期限が不正です: %w", reason)
+		}
+	}
+
+	morningCall.UpdatedAt = uc.now()
+
+	// リポジトリで更新
+	if err := uc.morningCallRepo.Update(ctx, morningCall); err != nil {
+		if errors.Is(err, repository.ErrUpdateConflict) {
+			return nil, fmt.Errorf("他の操作でモーニングコールが更新されました。再度お試しください")
+		}
+		return nil, fmt.Errorf("モーニングコールの更新に失敗しました: %w", err)
+	}
+
+	return &PatchOutput{
+		MorningCall: morningCall,
+	}, nil
+}
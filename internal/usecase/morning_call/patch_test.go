@@ -0,0 +1,235 @@
+package morning_call
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestPatchUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	baseTime := time.Now().Add(2 * time.Hour).Truncate(time.Second)
+	baseDeadline := baseTime.Add(30 * time.Minute)
+	newTime := baseTime.Add(time.Hour)
+	newDeadline := newTime.Add(time.Hour)
+	newMessage := "おはよう！"
+	emptyMessage := ""
+	longMessage := strings.Repeat("あ", valueobject.DefaultInputLimits().MessageMaxLength+1)
+	pastTime := time.Now().Add(-time.Hour)
+	beforeScheduled := baseTime.Add(-time.Minute)
+
+	tests := []struct {
+		name         string
+		status       valueobject.MorningCallStatus
+		input        PatchInput
+		wantErr      bool
+		errMsg       string
+		wantTime     time.Time
+		wantMessage  string
+		wantDeadline *time.Time
+	}{
+		{
+			name:         "メッセージのみ更新",
+			input:        PatchInput{SenderID: "sender", Message: &newMessage},
+			wantTime:     baseTime,
+			wantMessage:  newMessage,
+			wantDeadline: &baseDeadline,
+		},
+		{
+			name:         "メッセージを空にする",
+			input:        PatchInput{SenderID: "sender", Message: &emptyMessage},
+			wantTime:     baseTime,
+			wantMessage:  "",
+			wantDeadline: &baseDeadline,
+		},
+		{
+			name:         "時刻と期限を同時に更新",
+			input:        PatchInput{SenderID: "sender", ScheduledTime: &newTime, ConfirmDeadline: &newDeadline},
+			wantTime:     newTime,
+			wantMessage:  "起きて",
+			wantDeadline: &newDeadline,
+		},
+		{
+			name:         "期限のみ更新",
+			input:        PatchInput{SenderID: "sender", ConfirmDeadline: &newDeadline},
+			wantTime:     baseTime,
+			wantMessage:  "起きて",
+			wantDeadline: &newDeadline,
+		},
+		{
+			name:         "期限を解除",
+			input:        PatchInput{SenderID: "sender", ClearConfirmDeadline: true},
+			wantTime:     baseTime,
+			wantMessage:  "起きて",
+			wantDeadline: nil,
+		},
+		{
+			name:    "時刻の変更で既存の期限より後になる",
+			input:   PatchInput{SenderID: "sender", ScheduledTime: &newTime},
+			wantErr: true,
+			errMsg:  "起床確認の期限が不正です",
+		},
+		{
+			name:    "過去の時刻",
+			input:   PatchInput{SenderID: "sender", ScheduledTime: &pastTime},
+			wantErr: true,
+			errMsg:  "アラーム時刻が不正です",
+		},
+		{
+			name:    "長すぎるメッセージ",
+			input:   PatchInput{SenderID: "sender", Message: &longMessage},
+			wantErr: true,
+			errMsg:  "メッセージが不正です",
+		},
+		{
+			name:    "アラーム時刻より前の期限",
+			input:   PatchInput{SenderID: "sender", ConfirmDeadline: &beforeScheduled},
+			wantErr: true,
+			errMsg:  "起床確認の期限が不正です",
+		},
+		{
+			name:    "期限の設定と解除を同時に指定",
+			input:   PatchInput{SenderID: "sender", ConfirmDeadline: &newDeadline, ClearConfirmDeadline: true},
+			wantErr: true,
+			errMsg:  "同時に指定できません",
+		},
+		{
+			name:    "更新項目なし",
+			input:   PatchInput{SenderID: "sender"},
+			wantErr: true,
+			errMsg:  "更新する項目を指定してください",
+		},
+		{
+			name:    "送信者以外は更新できない",
+			input:   PatchInput{SenderID: "receiver", Message: &newMessage},
+			wantErr: true,
+			errMsg:  "送信者のみがモーニングコールを更新できます",
+		},
+		{
+			name:    "配信済みは更新できない",
+			status:  valueobject.MorningCallStatusDelivered,
+			input:   PatchInput{SenderID: "sender", Message: &newMessage},
+			wantErr: true,
+			errMsg:  "スケジュール済みのモーニングコールのみ",
+		},
+		{
+			name:    "存在しないモーニングコール",
+			input:   PatchInput{ID: "unknown", SenderID: "sender", Message: &newMessage},
+			wantErr: true,
+			errMsg:  "モーニングコールが見つかりません",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			morningCallRepo := memory.NewMorningCallRepository()
+
+			status := tt.status
+			if status == "" {
+				status = valueobject.MorningCallStatusScheduled
+			}
+			deadline := baseDeadline
+			createdAt := time.Now().Add(-time.Hour)
+			morningCall := &entity.MorningCall{
+				ID:              "mc1",
+				SenderID:        "sender",
+				ReceiverID:      "receiver",
+				ScheduledTime:   baseTime,
+				Message:         "起きて",
+				ConfirmDeadline: &deadline,
+				Status:          status,
+				CreatedAt:       createdAt,
+				UpdatedAt:       createdAt,
+			}
+			if err := morningCallRepo.Create(ctx, morningCall); err != nil {
+				t.Fatalf("failed to create morning call: %v", err)
+			}
+
+			input := tt.input
+			if input.ID == "" {
+				input.ID = morningCall.ID
+			}
+
+			uc := NewPatchUseCase(morningCallRepo, valueobject.DefaultInputLimits())
+			output, err := uc.Execute(ctx, input)
+
+			persisted, findErr := morningCallRepo.FindByID(ctx, morningCall.ID)
+			if findErr != nil {
+				t.Fatalf("failed to get persisted morning call: %v", findErr)
+			}
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error but got nil")
+				}
+				if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("error = %v, want containing %v", err, tt.errMsg)
+				}
+				// 失敗時は保存済みの値が変わらない
+				if !persisted.ScheduledTime.Equal(baseTime) || persisted.Message != "起きて" ||
+					persisted.ConfirmDeadline == nil || !persisted.ConfirmDeadline.Equal(baseDeadline) {
+					t.Errorf("morning call was modified on error: %+v", persisted)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output.MorningCall == nil {
+				t.Fatal("output.MorningCall is nil")
+			}
+			if !persisted.ScheduledTime.Equal(tt.wantTime) {
+				t.Errorf("ScheduledTime = %v, want %v", persisted.ScheduledTime, tt.wantTime)
+			}
+			if persisted.Message != tt.wantMessage {
+				t.Errorf("Message = %q, want %q", persisted.Message, tt.wantMessage)
+			}
+			switch {
+			case tt.wantDeadline == nil && persisted.ConfirmDeadline != nil:
+				t.Errorf("ConfirmDeadline = %v, want nil", *persisted.ConfirmDeadline)
+			case tt.wantDeadline != nil && (persisted.ConfirmDeadline == nil || !persisted.ConfirmDeadline.Equal(*tt.wantDeadline)):
+				t.Errorf("ConfirmDeadline = %v, want %v", persisted.ConfirmDeadline, *tt.wantDeadline)
+			}
+			if !persisted.UpdatedAt.After(createdAt) {
+				t.Errorf("UpdatedAt was not updated: %v", persisted.UpdatedAt)
+			}
+		})
+	}
+}
+
+func TestPatchUseCase_Execute_NearbyConflict(t *testing.T) {
+	ctx := context.Background()
+	morningCallRepo := memory.NewMorningCallRepository()
+
+	baseTime := time.Now().Add(2 * time.Hour)
+	for _, mc := range []*entity.MorningCall{
+		{ID: "mc1", SenderID: "sender", ReceiverID: "receiver", ScheduledTime: baseTime, Status: valueobject.MorningCallStatusScheduled},
+		{ID: "mc2", SenderID: "sender", ReceiverID: "receiver", ScheduledTime: baseTime.Add(2 * time.Hour), Status: valueobject.MorningCallStatusScheduled},
+	} {
+		if err := morningCallRepo.Create(ctx, mc); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+
+	uc := NewPatchUseCase(morningCallRepo, valueobject.DefaultInputLimits())
+
+	// 自分自身の時刻付近への変更は重複とみなさない
+	sameTime := baseTime.Add(time.Minute)
+	if _, err := uc.Execute(ctx, PatchInput{ID: "mc1", SenderID: "sender", ScheduledTime: &sameTime}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 別のコールの時刻付近への変更は拒否される
+	nearby := baseTime.Add(2 * time.Hour).Add(30 * time.Second)
+	_, err := uc.Execute(ctx, PatchInput{ID: "mc1", SenderID: "sender", ScheduledTime: &nearby})
+	if err == nil || !strings.Contains(err.Error(), "同じ時刻付近") {
+		t.Errorf("error = %v, want nearby conflict error", err)
+	}
+}
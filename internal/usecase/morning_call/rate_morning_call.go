@@ -0,0 +1,93 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// RateMorningCallUseCase は起床確認後に受信者がメッセージの役立ち度を評価するユースケース
+// 評価は送信者がどのメッセージが効果的だったかを知るために集計される
+type RateMorningCallUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	userRepo        repository.UserRepository
+}
+
+// NewRateMorningCallUseCase は新しい役立ち度評価ユースケースを作成する
+func NewRateMorningCallUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	userRepo repository.UserRepository,
+) *RateMorningCallUseCase {
+	return &RateMorningCallUseCase{
+		morningCallRepo: morningCallRepo,
+		userRepo:        userRepo,
+	}
+}
+
+// RateMorningCallInput は役立ち度評価の入力データ
+type RateMorningCallInput struct {
+	MorningCallID string
+	ReceiverID    string // 評価する受信者のID
+	Rating        int    // 役立ち度の評価（1〜5）
+}
+
+// RateMorningCallOutput は役立ち度評価の出力データ
+type RateMorningCallOutput struct {
+	MorningCall *entity.MorningCall
+}
+
+// Execute は起床確認済みのモーニングコールに役立ち度の評価を記録する
+// すでに評価済みの場合は評価を付け直す
+func (uc *RateMorningCallUseCase) Execute(ctx context.Context, input RateMorningCallInput) (*RateMorningCallOutput, error) {
+	// 入力値の基本検証
+	if input.MorningCallID == "" {
+		return nil, fmt.Errorf("モーニングコールIDは必須です")
+	}
+	if input.ReceiverID == "" {
+		return nil, fmt.Errorf("受信者IDは必須です")
+	}
+
+	// 受信者の存在確認
+	receiver, err := uc.userRepo.FindByID(ctx, input.ReceiverID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("受信者が見つかりません")
+		}
+		return nil, fmt.Errorf("受信者の確認中にエラーが発生しました: %w", err)
+	}
+
+	// モーニングコールの取得
+	morningCall, err := uc.morningCallRepo.FindByID(ctx, input.MorningCallID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("モーニングコールが見つかりません")
+		}
+		return nil, fmt.Errorf("モーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+
+	// 受信者本人のみ評価できる（セルフモーニングコールは評価の対象外）
+	if morningCall.ReceiverID != receiver.ID {
+		return nil, fmt.Errorf("受信者のみがモーニングコールを評価できます")
+	}
+	if morningCall.SelfCall {
+		return nil, fmt.Errorf("セルフモーニングコールは評価できません")
+	}
+
+	if reason := morningCall.SetHelpfulnessRating(input.Rating); reason.IsNG() {
+		return nil, fmt.Errorf("%w", reason)
+	}
+
+	if err := uc.morningCallRepo.Update(ctx, morningCall); err != nil {
+		if errors.Is(err, repository.ErrUpdateConflict) {
+			return nil, fmt.Errorf("他の操作でモーニングコールが更新されました。再度お試しください")
+		}
+		return nil, fmt.Errorf("評価の保存に失敗しました: %w", err)
+	}
+
+	return &RateMorningCallOutput{
+		MorningCall: morningCall,
+	}, nil
+}
@@ -0,0 +1,143 @@
+package morning_call
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestRateMorningCallUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	ratedBefore := 2
+
+	tests := []struct {
+		name      string
+		status    valueobject.MorningCallStatus
+		rated     *int // 評価前から付いている評価（未評価はnil）
+		selfCall  bool
+		requester string
+		rating    int
+		wantErr   string
+	}{
+		{
+			name:      "未評価のコールを評価する",
+			status:    valueobject.MorningCallStatusConfirmed,
+			requester: "receiver",
+			rating:    4,
+		},
+		{
+			name:      "評価済みのコールは評価を付け直せる",
+			status:    valueobject.MorningCallStatusConfirmed,
+			rated:     &ratedBefore,
+			requester: "receiver",
+			rating:    5,
+		},
+		{
+			name:      "範囲外の評価",
+			status:    valueobject.MorningCallStatusConfirmed,
+			requester: "receiver",
+			rating:    0,
+			wantErr:   "評価は1から5の範囲で指定してください",
+		},
+		{
+			name:      "送信者は評価できない",
+			status:    valueobject.MorningCallStatusConfirmed,
+			requester: "sender",
+			rating:    5,
+			wantErr:   "受信者のみがモーニングコールを評価できます",
+		},
+		{
+			name:      "未確認のコールは評価できない",
+			status:    valueobject.MorningCallStatusDelivered,
+			requester: "receiver",
+			rating:    3,
+			wantErr:   "起床確認済みのモーニングコールにのみ評価を付けられます",
+		},
+		{
+			name:      "セルフモーニングコールは評価できない",
+			status:    valueobject.MorningCallStatusConfirmed,
+			selfCall:  true,
+			requester: "sender",
+			rating:    3,
+			wantErr:   "セルフモーニングコールは評価できません",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			morningCallRepo := memory.NewMorningCallRepository()
+			userRepo := memory.NewUserRepository()
+			for _, u := range []*entity.User{
+				{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+				{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+			} {
+				if err := userRepo.Create(ctx, u); err != nil {
+					t.Fatalf("failed to create user: %v", err)
+				}
+			}
+
+			receiverID := "receiver"
+			if tt.selfCall {
+				receiverID = "sender"
+			}
+			if err := morningCallRepo.Create(ctx, &entity.MorningCall{
+				ID:                "mc1",
+				SenderID:          "sender",
+				ReceiverID:        receiverID,
+				ScheduledTime:     time.Now().Add(-time.Hour),
+				Status:            tt.status,
+				SelfCall:          tt.selfCall,
+				HelpfulnessRating: tt.rated,
+			}); err != nil {
+				t.Fatalf("failed to create morning call: %v", err)
+			}
+
+			uc := NewRateMorningCallUseCase(morningCallRepo, userRepo)
+			output, err := uc.Execute(ctx, RateMorningCallInput{
+				MorningCallID: "mc1",
+				ReceiverID:    tt.requester,
+				Rating:        tt.rating,
+			})
+
+			saved, _ := morningCallRepo.FindByID(ctx, "mc1")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("error = %v, want containing %q", err, tt.wantErr)
+				}
+				if (saved.HelpfulnessRating == nil) != (tt.rated == nil) {
+					t.Errorf("HelpfulnessRating changed to %v on error", saved.HelpfulnessRating)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := output.MorningCall.HelpfulnessRating; got == nil || *got != tt.rating {
+				t.Errorf("HelpfulnessRating = %v, want %d", got, tt.rating)
+			}
+			if saved.HelpfulnessRating == nil || *saved.HelpfulnessRating != tt.rating || saved.RatedAt == nil {
+				t.Errorf("saved HelpfulnessRating = %v, RatedAt = %v, want %d", saved.HelpfulnessRating, saved.RatedAt, tt.rating)
+			}
+		})
+	}
+}
+
+func TestRateMorningCallUseCase_Execute_NotFound(t *testing.T) {
+	ctx := context.Background()
+	userRepo := memory.NewUserRepository()
+	if err := userRepo.Create(ctx, &entity.User{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"}); err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+
+	uc := NewRateMorningCallUseCase(memory.NewMorningCallRepository(), userRepo)
+	_, err := uc.Execute(ctx, RateMorningCallInput{MorningCallID: "missing", ReceiverID: "receiver", Rating: 3})
+	if err == nil || !strings.Contains(err.Error(), "モーニングコールが見つかりません") {
+		t.Errorf("error = %v, want not found", err)
+	}
+}
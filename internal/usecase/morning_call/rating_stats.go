@@ -0,0 +1,77 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// RatingStatsUseCase は送信者が自分のコールに付いた役立ち度の評価の統計を取得するユースケース
+type RatingStatsUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	userRepo        repository.UserRepository
+}
+
+// NewRatingStatsUseCase は新しい役立ち度評価の統計取得ユースケースを作成する
+func NewRatingStatsUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	userRepo repository.UserRepository,
+) *RatingStatsUseCase {
+	return &RatingStatsUseCase{
+		morningCallRepo: morningCallRepo,
+		userRepo:        userRepo,
+	}
+}
+
+// RatingStatsInput は役立ち度評価の統計取得の入力データ
+type RatingStatsInput struct {
+	SenderID string // 必須：送信者のID
+}
+
+// RatingStatsOutput は役立ち度評価の統計取得の出力データ
+type RatingStatsOutput struct {
+	RatedCount    int     // 評価されたコール数
+	AverageRating float64 // 評価の平均（評価がない場合は0）
+	// Distribution は評価ごとのコール数（インデックス0が評価1、インデックス4が評価5）
+	Distribution [entity.MaxHelpfulnessRating]int
+}
+
+// Execute は送信者が送ったコールのうち評価されたものを集計する
+func (uc *RatingStatsUseCase) Execute(ctx context.Context, input RatingStatsInput) (*RatingStatsOutput, error) {
+	// 入力値の基本検証
+	if input.SenderID == "" {
+		return nil, fmt.Errorf("送信者IDは必須です")
+	}
+
+	// 送信者の存在確認
+	if _, err := uc.userRepo.FindByID(ctx, input.SenderID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("送信者が見つかりません")
+		}
+		return nil, fmt.Errorf("送信者の確認中にエラーが発生しました: %w", err)
+	}
+
+	// 送信者のコールをすべて読み、評価されたものを集計する
+	output := &RatingStatsOutput{}
+	total := 0
+	err := forEachSentCall(ctx, uc.morningCallRepo, input.SenderID, func(call entity.ReadOnlyMorningCall) {
+		rating, ok := call.HelpfulnessRating()
+		if !ok || call.IsDeleted() {
+			return
+		}
+		output.RatedCount++
+		output.Distribution[rating-entity.MinHelpfulnessRating]++
+		total += rating
+	})
+	if err != nil {
+		return nil, fmt.Errorf("送信モーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+	if output.RatedCount > 0 {
+		output.AverageRating = float64(total) / float64(output.RatedCount)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,123 @@
+package morning_call
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestRatingStatsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	morningCallRepo := memory.NewMorningCallRepository()
+	userRepo := memory.NewUserRepository()
+	for _, u := range []*entity.User{
+		{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+		{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+	uc := NewRatingStatsUseCase(morningCallRepo, userRepo)
+
+	t.Run("評価がない場合は平均0", func(t *testing.T) {
+		output, err := uc.Execute(ctx, RatingStatsInput{SenderID: "sender"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.RatedCount != 0 || output.AverageRating != 0 {
+			t.Errorf("RatedCount = %d, AverageRating = %v, want 0", output.RatedCount, output.AverageRating)
+		}
+	})
+
+	// 評価済み3件（5, 4, 4）、未評価1件、他の送信者のコール1件
+	ratings := []*int{intPtr(5), intPtr(4), intPtr(4), nil}
+	for i, rating := range ratings {
+		if err := morningCallRepo.Create(ctx, &entity.MorningCall{
+			ID:                "mc" + string(rune('a'+i)),
+			SenderID:          "sender",
+			ReceiverID:        "receiver",
+			ScheduledTime:     time.Now().Add(-time.Hour),
+			Status:            valueobject.MorningCallStatusConfirmed,
+			HelpfulnessRating: rating,
+		}); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+	if err := morningCallRepo.Create(ctx, &entity.MorningCall{
+		ID:                "other",
+		SenderID:          "receiver",
+		ReceiverID:        "sender",
+		ScheduledTime:     time.Now().Add(-time.Hour),
+		Status:            valueobject.MorningCallStatusConfirmed,
+		HelpfulnessRating: intPtr(1),
+	}); err != nil {
+		t.Fatalf("failed to create morning call: %v", err)
+	}
+
+	t.Run("送信者のコールの評価のみを集計する", func(t *testing.T) {
+		output, err := uc.Execute(ctx, RatingStatsInput{SenderID: "sender"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.RatedCount != 3 {
+			t.Errorf("RatedCount = %d, want 3", output.RatedCount)
+		}
+		if want := 13.0 / 3.0; output.AverageRating != want {
+			t.Errorf("AverageRating = %v, want %v", output.AverageRating, want)
+		}
+		if output.Distribution != [entity.MaxHelpfulnessRating]int{0, 0, 0, 2, 1} {
+			t.Errorf("Distribution = %v, want [0 0 0 2 1]", output.Distribution)
+		}
+	})
+
+	t.Run("存在しない送信者", func(t *testing.T) {
+		_, err := uc.Execute(ctx, RatingStatsInput{SenderID: "missing"})
+		if err == nil || !strings.Contains(err.Error(), "送信者が見つかりません") {
+			t.Errorf("error = %v, want not found", err)
+		}
+	})
+}
+
+func TestRatingStatsUseCase_Execute_ManyCalls(t *testing.T) {
+	ctx := context.Background()
+	morningCallRepo := memory.NewMorningCallRepository()
+	userRepo := memory.NewUserRepository()
+	if err := userRepo.Create(ctx, &entity.User{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"}); err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+
+	// 以前の取得上限（10000件）を超えるコールを評価し、すべてが集計されることを確認する
+	rated := 10001
+	base := time.Now().Add(-time.Hour)
+	for i := 0; i < rated; i++ {
+		if err := morningCallRepo.Create(ctx, &entity.MorningCall{
+			ID:                fmt.Sprintf("mc%04d", i),
+			SenderID:          "sender",
+			ReceiverID:        "receiver",
+			ScheduledTime:     base.Add(-time.Duration(i) * time.Minute),
+			Status:            valueobject.MorningCallStatusConfirmed,
+			HelpfulnessRating: intPtr(4),
+		}); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+
+	output, err := NewRatingStatsUseCase(morningCallRepo, userRepo).Execute(ctx, RatingStatsInput{SenderID: "sender"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.RatedCount != rated || output.Distribution[3] != rated || output.AverageRating != 4 {
+		t.Errorf("RatedCount = %d, Distribution = %v, AverageRating = %v, want %d ratings of 4", output.RatedCount, output.Distribution, output.AverageRating, rated)
+	}
+}
+
+func intPtr(v int) *int {
+	return &v
+}
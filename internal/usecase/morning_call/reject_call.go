@@ -0,0 +1,104 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/pkg/utils"
+)
+
+// RejectCallUseCase は受信者が承認待ちのモーニングコールを拒否するユースケース
+// 拒否した場合は送信者へメールで通知する
+type RejectCallUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	userRepo        repository.UserRepository
+	emailSender     service.EmailSender
+}
+
+// NewRejectCallUseCase は新しいモーニングコール拒否ユースケースを作成する
+// emailSenderがnilの場合は送信者への通知を行わない
+func NewRejectCallUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	userRepo repository.UserRepository,
+	emailSender service.EmailSender,
+) *RejectCallUseCase {
+	return &RejectCallUseCase{
+		morningCallRepo: morningCallRepo,
+		userRepo:        userRepo,
+		emailSender:     emailSender,
+	}
+}
+
+// RejectCallInput はモーニングコール拒否の入力データ
+type RejectCallInput struct {
+	MorningCallID string
+	ReceiverID    string // 拒否する受信者のID
+}
+
+// RejectCallOutput はモーニングコール拒否の出力データ
+type RejectCallOutput struct {
+	MorningCall    *entity.MorningCall
+	SenderNotified bool // 送信者への通知に成功したか
+}
+
+// Execute は受信者宛の承認待ちのモーニングコールを拒否し、送信者へ通知する
+func (uc *RejectCallUseCase) Execute(ctx context.Context, input RejectCallInput) (*RejectCallOutput, error) {
+	morningCall, err := findPendingApprovalCall(ctx, uc.morningCallRepo, uc.userRepo, input.MorningCallID, input.ReceiverID, "拒否")
+	if err != nil {
+		return nil, err
+	}
+
+	if reason := morningCall.Reject(); reason.IsNG() {
+		return nil, fmt.Errorf("拒否に失敗しました: %w", reason)
+	}
+
+	if err := uc.morningCallRepo.Update(ctx, morningCall); err != nil {
+		if errors.Is(err, repository.ErrUpdateConflict) {
+			return nil, fmt.Errorf("他の操作でモーニングコールが更新されました。再度お試しください")
+		}
+		return nil, fmt.Errorf("拒否の保存に失敗しました: %w", err)
+	}
+
+	return &RejectCallOutput{
+		MorningCall:    morningCall,
+		SenderNotified: uc.notifySender(ctx, morningCall),
+	}, nil
+}
+
+// notifySender は送信者へ拒否されたことをメールで通知する
+// 通知に失敗しても拒否自体は取り消さない
+func (uc *RejectCallUseCase) notifySender(ctx context.Context, morningCall *entity.MorningCall) bool {
+	if uc.emailSender == nil {
+		return false
+	}
+
+	sender, err := uc.userRepo.FindByID(ctx, morningCall.SenderID)
+	if err != nil {
+		utils.Logf(ctx, "拒否通知の送信者の取得に失敗しました: %v", err)
+		return false
+	}
+	receiverName := morningCall.ReceiverID
+	if receiver, err := uc.userRepo.FindByID(ctx, morningCall.ReceiverID); err == nil {
+		receiverName = receiver.Username
+	}
+
+	message := service.EmailMessage{
+		To:      sender.Email,
+		Subject: "モーニングコールが拒否されました",
+		Body: fmt.Sprintf(
+			"%s さん\n\n%s さんに設定した %s のモーニングコールは拒否されました。\nこのモーニングコールは配信されません。",
+			sender.Username,
+			receiverName,
+			morningCall.ScheduledTime.Format("2006-01-02 15:04"),
+		),
+	}
+	if err := uc.emailSender.Send(ctx, message); err != nil {
+		utils.Logf(ctx, "拒否通知の送信に失敗しました: %v", err)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,115 @@
+package morning_call
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/mail"
+)
+
+func TestRejectCallUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		status    valueobject.MorningCallStatus
+		requester string
+		wantErr   bool
+		errMsg    string
+	}{
+		{
+			name:      "受信者が拒否",
+			status:    valueobject.MorningCallStatusPendingApproval,
+			requester: "receiver",
+		},
+		{
+			name:      "送信者は拒否できない",
+			status:    valueobject.MorningCallStatusPendingApproval,
+			requester: "sender",
+			wantErr:   true,
+			errMsg:    "受信者のみがモーニングコールを拒否できます",
+		},
+		{
+			name:      "スケジュール済みは拒否できない",
+			status:    valueobject.MorningCallStatusScheduled,
+			requester: "receiver",
+			wantErr:   true,
+			errMsg:    "承認待ちのモーニングコールのみ拒否できます",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			morningCallRepo, userRepo := setupApprovalTest(t, tt.status, time.Now().Add(time.Hour))
+			emailSender := mail.NewMemoryEmailSender()
+
+			uc := NewRejectCallUseCase(morningCallRepo, userRepo, emailSender)
+			output, err := uc.Execute(ctx, RejectCallInput{
+				MorningCallID: "mc1",
+				ReceiverID:    tt.requester,
+			})
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error but got nil")
+				}
+				if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("error = %v, want containing %v", err, tt.errMsg)
+				}
+				if len(emailSender.Messages()) != 0 {
+					t.Errorf("notification sent on error: %d messages", len(emailSender.Messages()))
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output.MorningCall.Status != valueobject.MorningCallStatusRejected {
+				t.Errorf("Status = %v, want %v", output.MorningCall.Status, valueobject.MorningCallStatusRejected)
+			}
+			persisted, err := morningCallRepo.FindByID(ctx, "mc1")
+			if err != nil {
+				t.Fatalf("failed to get persisted morning call: %v", err)
+			}
+			if persisted.Status != valueobject.MorningCallStatusRejected {
+				t.Errorf("persisted Status = %v, want %v", persisted.Status, valueobject.MorningCallStatusRejected)
+			}
+
+			// 送信者へ通知される
+			if !output.SenderNotified {
+				t.Error("SenderNotified = false, want true")
+			}
+			messages := emailSender.Messages()
+			if len(messages) != 1 {
+				t.Fatalf("messages = %d, want 1", len(messages))
+			}
+			if messages[0].To != "alice@example.com" {
+				t.Errorf("To = %v, want alice@example.com", messages[0].To)
+			}
+			if !strings.Contains(messages[0].Body, "bob") {
+				t.Errorf("Body does not contain receiver name: %v", messages[0].Body)
+			}
+		})
+	}
+}
+
+func TestRejectCallUseCase_Execute_WithoutEmailSender(t *testing.T) {
+	ctx := context.Background()
+	morningCallRepo, userRepo := setupApprovalTest(t, valueobject.MorningCallStatusPendingApproval, time.Now().Add(time.Hour))
+
+	uc := NewRejectCallUseCase(morningCallRepo, userRepo, nil)
+	output, err := uc.Execute(ctx, RejectCallInput{MorningCallID: "mc1", ReceiverID: "receiver"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.SenderNotified {
+		t.Error("SenderNotified = true, want false")
+	}
+	if output.MorningCall.Status != valueobject.MorningCallStatusRejected {
+		t.Errorf("Status = %v, want %v", output.MorningCall.Status, valueobject.MorningCallStatusRejected)
+	}
+}
@@ -0,0 +1,159 @@
+package morning_call
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// reportJSON はレポートのJSON形式での表現
+// APIからのダウンロードと定期配信で同じファイルになるよう、ハンドラーのDTOではなくここで形式を定める
+type reportJSON struct {
+	UserID        string            `json:"user_id"`
+	Username      string            `json:"username"`
+	Period        string            `json:"period,omitempty"`
+	From          string            `json:"from"`
+	To            string            `json:"to"` // 集計終了日（この日を含む）
+	TimeZone      string            `json:"time_zone"`
+	Sent          reportSummaryJSON `json:"sent"`
+	Received      reportSummaryJSON `json:"received"`
+	LongestStreak int               `json:"longest_streak"`
+	CurrentStreak int               `json:"current_streak"`
+	TopWakers     []reportWakerJSON `json:"top_wakers"`
+}
+
+// reportSummaryJSON は送信・受信の集計のJSON形式での表現
+type reportSummaryJSON struct {
+	Total            int     `json:"total"`
+	Confirmed        int     `json:"confirmed"`
+	Missed           int     `json:"missed"`
+	Pending          int     `json:"pending"`
+	ConfirmationRate float64 `json:"confirmation_rate"` // 0〜1（小数点以下3桁に丸める）
+}
+
+// reportWakerJSON はよく起こしてくれた人のJSON形式での表現
+type reportWakerJSON struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	Count    int    `json:"count"`
+}
+
+// encodeReport はレポートを指定の形式で書き出す
+func encodeReport(report *MorningCallReport, format valueobject.ReportFormat) ([]byte, error) {
+	switch format {
+	case valueobject.ReportFormatCSV:
+		return encodeReportCSV(report)
+	case valueobject.ReportFormatJSON:
+		return encodeReportJSON(report)
+	default:
+		return nil, fmt.Errorf("unsupported report format: %s", format)
+	}
+}
+
+// encodeReportJSON はレポートをJSON形式で書き出す
+func encodeReportJSON(report *MorningCallReport) ([]byte, error) {
+	from, to := reportDates(report)
+	doc := reportJSON{
+		UserID:        report.UserID,
+		Username:      report.Username,
+		Period:        report.Period.String(),
+		From:          from,
+		To:            to,
+		TimeZone:      report.Location.String(),
+		Sent:          newReportSummaryJSON(report.Sent),
+		Received:      newReportSummaryJSON(report.Received),
+		LongestStreak: report.LongestStreak,
+		CurrentStreak: report.CurrentStreak,
+		TopWakers:     make([]reportWakerJSON, 0, len(report.TopWakers)),
+	}
+	for _, w := range report.TopWakers {
+		doc.TopWakers = append(doc.TopWakers, reportWakerJSON{UserID: w.UserID, Username: w.Username, Count: w.Count})
+	}
+	return json.Marshal(doc)
+}
+
+// newReportSummaryJSON は集計をJSON形式での表現に変換する
+func newReportSummaryJSON(s ReportCallSummary) reportSummaryJSON {
+	return reportSummaryJSON{
+		Total:            s.Total,
+		Confirmed:        s.Confirmed,
+		Missed:           s.Missed,
+		Pending:          s.Pending,
+		ConfirmationRate: math.Round(s.ConfirmationRate()*1000) / 1000,
+	}
+}
+
+// encodeReportCSV はレポートを「項目,値,ユーザー名」の1行1項目のCSV形式で書き出す
+// ユーザー名の列はよく起こしてくれた人の行でのみ使う
+func encodeReportCSV(report *MorningCallReport) ([]byte, error) {
+	from, to := reportDates(report)
+	rows := [][]string{
+		{"metric", "value", "username"},
+		{"from", from, ""},
+		{"to", to, ""},
+		{"time_zone", report.Location.String(), ""},
+	}
+	for _, section := range []struct {
+		prefix  string
+		summary ReportCallSummary
+	}{
+		{"sent", report.Sent},
+		{"received", report.Received},
+	} {
+		rows = append(rows,
+			[]string{section.prefix + "_total", strconv.Itoa(section.summary.Total), ""},
+			[]string{section.prefix + "_confirmed", strconv.Itoa(section.summary.Confirmed), ""},
+			[]string{section.prefix + "_missed", strconv.Itoa(section.summary.Missed), ""},
+			[]string{section.prefix + "_pending", strconv.Itoa(section.summary.Pending), ""},
+			[]string{section.prefix + "_confirmation_rate", strconv.FormatFloat(section.summary.ConfirmationRate(), 'f', 3, 64), ""},
+		)
+	}
+	rows = append(rows,
+		[]string{"longest_streak", strconv.Itoa(report.LongestStreak), ""},
+		[]string{"current_streak", strconv.Itoa(report.CurrentStreak), ""},
+	)
+	for i, w := range report.TopWakers {
+		rows = append(rows, []string{fmt.Sprintf("top_waker_%d", i+1), strconv.Itoa(w.Count), w.Username})
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// reportDates は集計期間の開始日と終了日（この日を含む）を返す
+func reportDates(report *MorningCallReport) (string, string) {
+	return report.From.Format(ReportDateLayout), report.To.AddDate(0, 0, -1).Format(ReportDateLayout)
+}
+
+// summarizeReport は通知の本文に使うレポートの要約を作成する
+func summarizeReport(report *MorningCallReport) string {
+	from, to := reportDates(report)
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s〜%s のモーニングコールレポート\n", from, to)
+	fmt.Fprintf(&b, "受け取ったコール: %d件（起床確認 %d件・確認率 %s）\n", report.Received.Total, report.Received.Confirmed, formatReportRate(report.Received))
+	fmt.Fprintf(&b, "送ったコール: %d件（起床確認 %d件・確認率 %s）\n", report.Sent.Total, report.Sent.Confirmed, formatReportRate(report.Sent))
+	fmt.Fprintf(&b, "最長ストリーク: %d日（現在 %d日）", report.LongestStreak, report.CurrentStreak)
+	if len(report.TopWakers) > 0 {
+		top := report.TopWakers[0]
+		fmt.Fprintf(&b, "\nいちばん起こしてくれた人: %s（%d回）", top.Username, top.Count)
+	}
+	return b.String()
+}
+
+// formatReportRate は確認率を百分率で表す（結果の出たコールがない場合は「-」）
+func formatReportRate(s ReportCallSummary) string {
+	if s.Confirmed+s.Missed == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d%%", int(math.Round(s.ConfirmationRate()*100)))
+}
@@ -0,0 +1,95 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// RequestRescheduleUseCase は受信者が送信者にアラーム時刻の変更をリクエストするユースケース
+// 送信者が承認するまでアラーム時刻は変わらず、リクエストの状態のみをモーニングコールに保持する
+type RequestRescheduleUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	userRepo        repository.UserRepository
+	now             func() time.Time
+}
+
+// NewRequestRescheduleUseCase は新しい時刻変更リクエストユースケースを作成する
+func NewRequestRescheduleUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	userRepo repository.UserRepository,
+) *RequestRescheduleUseCase {
+	return &RequestRescheduleUseCase{
+		morningCallRepo: morningCallRepo,
+		userRepo:        userRepo,
+		now:             time.Now,
+	}
+}
+
+// RequestRescheduleInput は時刻変更リクエストの入力データ
+type RequestRescheduleInput struct {
+	MorningCallID string
+	ReceiverID    string    // リクエストする受信者のID
+	RequestedTime time.Time // 希望するアラーム時刻
+}
+
+// RequestRescheduleOutput は時刻変更リクエストの出力データ
+type RequestRescheduleOutput struct {
+	MorningCall *entity.MorningCall
+}
+
+// Execute は受信者宛のスケジュール済みモーニングコールに時刻変更リクエストを記録する
+func (uc *RequestRescheduleUseCase) Execute(ctx context.Context, input RequestRescheduleInput) (*RequestRescheduleOutput, error) {
+	// 入力値の基本検証
+	if input.MorningCallID == "" {
+		return nil, fmt.Errorf("モーニングコールIDは必須です")
+	}
+	if input.ReceiverID == "" {
+		return nil, fmt.Errorf("受信者IDは必須です")
+	}
+	if input.RequestedTime.IsZero() {
+		return nil, fmt.Errorf("希望時刻は必須です")
+	}
+
+	// 受信者の存在確認
+	receiver, err := uc.userRepo.FindByID(ctx, input.ReceiverID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("受信者が見つかりません")
+		}
+		return nil, fmt.Errorf("受信者の確認中にエラーが発生しました: %w", err)
+	}
+
+	// モーニングコールの取得
+	morningCall, err := uc.morningCallRepo.FindByID(ctx, input.MorningCallID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("モーニングコールが見つかりません")
+		}
+		return nil, fmt.Errorf("モーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+
+	// 受信者本人のみリクエストできる
+	if morningCall.ReceiverID != receiver.ID {
+		return nil, fmt.Errorf("受信者のみがアラーム時刻の変更をリクエストできます")
+	}
+
+	if reason := morningCall.RequestReschedule(input.RequestedTime, uc.now()); reason.IsNG() {
+		return nil, fmt.Errorf("時刻の変更をリクエストできませんでした: %w", reason)
+	}
+
+	if err := uc.morningCallRepo.Update(ctx, morningCall); err != nil {
+		if errors.Is(err, repository.ErrUpdateConflict) {
+			return nil, fmt.Errorf("他の操作でモーニングコールが更新されました。再度お試しください")
+		}
+		return nil, fmt.Errorf("時刻変更リクエストの保存に失敗しました: %w", err)
+	}
+
+	return &RequestRescheduleOutput{
+		MorningCall: morningCall,
+	}, nil
+}
@@ -0,0 +1,162 @@
+package morning_call
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+// setupRescheduleTest は時刻変更リクエストのテスト用にユーザーとスケジュール済みのモーニングコールを作成する
+func setupRescheduleTest(t *testing.T, status valueobject.MorningCallStatus, scheduledTime time.Time) (repository.MorningCallRepository, repository.UserRepository) {
+	t.Helper()
+	ctx := context.Background()
+	morningCallRepo := memory.NewMorningCallRepository()
+	userRepo := memory.NewUserRepository()
+
+	for _, u := range []*entity.User{
+		{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+		{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+
+	morningCall := &entity.MorningCall{
+		ID:            "mc1",
+		SenderID:      "sender",
+		ReceiverID:    "receiver",
+		ScheduledTime: scheduledTime,
+		Status:        status,
+		CreatedAt:     time.Now().Add(-time.Hour),
+		UpdatedAt:     time.Now().Add(-time.Hour),
+	}
+	if err := morningCallRepo.Create(ctx, morningCall); err != nil {
+		t.Fatalf("failed to create morning call: %v", err)
+	}
+	return morningCallRepo, userRepo
+}
+
+func TestRequestRescheduleUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	scheduledTime := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name          string
+		status        valueobject.MorningCallStatus
+		requester     string
+		requestedTime time.Time
+		wantErr       bool
+		errMsg        string
+	}{
+		{
+			name:          "受信者が時刻の変更をリクエストする",
+			status:        valueobject.MorningCallStatusScheduled,
+			requester:     "receiver",
+			requestedTime: scheduledTime.Add(30 * time.Minute),
+		},
+		{
+			name:          "送信者はリクエストできない",
+			status:        valueobject.MorningCallStatusScheduled,
+			requester:     "sender",
+			requestedTime: scheduledTime.Add(30 * time.Minute),
+			wantErr:       true,
+			errMsg:        "受信者のみがアラーム時刻の変更をリクエストできます",
+		},
+		{
+			name:          "過去の希望時刻",
+			status:        valueobject.MorningCallStatusScheduled,
+			requester:     "receiver",
+			requestedTime: time.Now().Add(-time.Minute),
+			wantErr:       true,
+			errMsg:        "希望時刻は現在時刻より後である必要があります",
+		},
+		{
+			name:      "希望時刻が未指定",
+			status:    valueobject.MorningCallStatusScheduled,
+			requester: "receiver",
+			wantErr:   true,
+			errMsg:    "希望時刻は必須です",
+		},
+		{
+			name:          "配信済みはリクエストできない",
+			status:        valueobject.MorningCallStatusDelivered,
+			requester:     "receiver",
+			requestedTime: scheduledTime.Add(30 * time.Minute),
+			wantErr:       true,
+			errMsg:        "スケジュール済みのモーニングコールのみ",
+		},
+		{
+			name:          "存在しない受信者",
+			status:        valueobject.MorningCallStatusScheduled,
+			requester:     "unknown",
+			requestedTime: scheduledTime.Add(30 * time.Minute),
+			wantErr:       true,
+			errMsg:        "受信者が見つかりません",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			morningCallRepo, userRepo := setupRescheduleTest(t, tt.status, scheduledTime)
+
+			uc := NewRequestRescheduleUseCase(morningCallRepo, userRepo)
+			output, err := uc.Execute(ctx, RequestRescheduleInput{
+				MorningCallID: "mc1",
+				ReceiverID:    tt.requester,
+				RequestedTime: tt.requestedTime,
+			})
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error but got nil")
+				}
+				if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("error = %v, want containing %v", err, tt.errMsg)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !output.MorningCall.HasPendingRescheduleRequest() {
+				t.Errorf("RescheduleRequest = %+v, want pending", output.MorningCall.RescheduleRequest)
+			}
+
+			persisted, err := morningCallRepo.FindByID(ctx, "mc1")
+			if err != nil {
+				t.Fatalf("failed to get persisted morning call: %v", err)
+			}
+			if !persisted.HasPendingRescheduleRequest() || !persisted.RescheduleRequest.RequestedTime.Equal(tt.requestedTime) {
+				t.Errorf("persisted RescheduleRequest = %+v, want pending for %v", persisted.RescheduleRequest, tt.requestedTime)
+			}
+			// 送信者が承認するまでアラーム時刻は変わらない
+			if !persisted.ScheduledTime.Equal(scheduledTime) {
+				t.Errorf("ScheduledTime changed: got %v, want %v", persisted.ScheduledTime, scheduledTime)
+			}
+		})
+	}
+}
+
+func TestRequestRescheduleUseCase_Execute_Duplicate(t *testing.T) {
+	ctx := context.Background()
+	scheduledTime := time.Now().Add(time.Hour)
+	morningCallRepo, userRepo := setupRescheduleTest(t, valueobject.MorningCallStatusScheduled, scheduledTime)
+	uc := NewRequestRescheduleUseCase(morningCallRepo, userRepo)
+
+	input := RequestRescheduleInput{MorningCallID: "mc1", ReceiverID: "receiver", RequestedTime: scheduledTime.Add(time.Hour)}
+	if _, err := uc.Execute(ctx, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, err := uc.Execute(ctx, input)
+	if err == nil || !strings.Contains(err.Error(), "既に時刻の変更をリクエスト中です") {
+		t.Errorf("error = %v, want duplicate request", err)
+	}
+}
@@ -0,0 +1,168 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/pkg/utils"
+)
+
+// RespondNextCallUseCase は送信者が受信者からの次回のコールのリクエストを承認・拒否するユースケース
+// 承認した場合は翌日同時刻のコールを新しく作成し、拒否した場合は作成しない
+type RespondNextCallUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	createUseCase   *CreateUseCase
+	now             func() time.Time
+}
+
+// NewRespondNextCallUseCase は新しい次回のコールのリクエスト応答ユースケースを作成する
+// 次回のコールの作成には通常の作成と同じ検証（友達関係・ブロック・受信時間帯・時刻・プラン別の上限）を適用する
+func NewRespondNextCallUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	createUseCase *CreateUseCase,
+) *RespondNextCallUseCase {
+	return &RespondNextCallUseCase{
+		morningCallRepo: morningCallRepo,
+		createUseCase:   createUseCase,
+		now:             time.Now,
+	}
+}
+
+// RespondNextCallInput は次回のコールのリクエスト応答の入力データ
+type RespondNextCallInput struct {
+	MorningCallID string // リクエストを受けた起床確認済みのコールのID
+	SenderID      string // 応答する送信者のID
+	Approve       bool   // trueで承認、falseで拒否
+}
+
+// RespondNextCallOutput は次回のコールのリクエスト応答の出力データ
+type RespondNextCallOutput struct {
+	MorningCall *entity.MorningCall // リクエストを受けたコール
+	NextCall    *entity.MorningCall // 承認により作成した次回のコール（拒否した場合はnil）
+}
+
+// Execute は送信者のモーニングコールに届いている次回のコールのリクエストに応答する
+func (uc *RespondNextCallUseCase) Execute(ctx context.Context, input RespondNextCallInput) (*RespondNextCallOutput, error) {
+	action := "拒否"
+	if input.Approve {
+		action = "承認"
+	}
+
+	// 入力値の基本検証
+	if input.MorningCallID == "" {
+		return nil, fmt.Errorf("モーニングコールIDは必須です")
+	}
+	if input.SenderID == "" {
+		return nil, fmt.Errorf("送信者IDは必須です")
+	}
+
+	// モーニングコールの取得
+	morningCall, err := uc.morningCallRepo.FindByID(ctx, input.MorningCallID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("モーニングコールが見つかりません")
+		}
+		return nil, fmt.Errorf("モーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+
+	// 送信者本人のみ応答できる
+	if morningCall.SenderID != input.SenderID {
+		return nil, fmt.Errorf("送信者のみが次回のコールのリクエストを%sできます", action)
+	}
+
+	now := uc.now()
+	if !input.Approve {
+		if reason := morningCall.RejectNextCall(now); reason.IsNG() {
+			return nil, fmt.Errorf("次回のコールのリクエストを拒否できませんでした: %w", reason)
+		}
+		if err := uc.save(ctx, morningCall); err != nil {
+			return nil, err
+		}
+		return &RespondNextCallOutput{MorningCall: morningCall}, nil
+	}
+
+	if !morningCall.HasPendingNextCallRequest() {
+		return nil, fmt.Errorf("次回のコールのリクエストを承認できませんでした: %w",
+			valueobject.NGWithCode(valueobject.ReasonCodeInvalidState, "", "応答待ちの次回のコールのリクエストがありません"))
+	}
+
+	// 次回のコールを作成する（リクエストから時間が経っているため、友達関係や時刻の制約は作成時に改めて検証する）
+	nextCall, err := uc.createNextCall(ctx, morningCall)
+	if err != nil {
+		return nil, err
+	}
+
+	if reason := morningCall.ApproveNextCall(nextCall.ID, now); reason.IsNG() {
+		uc.discard(ctx, nextCall)
+		return nil, fmt.Errorf("次回のコールのリクエストを承認できませんでした: %w", reason)
+	}
+	// 同時に承認された場合などで保存に失敗したら、作成した次回のコールを削除して重複させない
+	if err := uc.save(ctx, morningCall); err != nil {
+		uc.discard(ctx, nextCall)
+		return nil, err
+	}
+
+	return &RespondNextCallOutput{
+		MorningCall: morningCall,
+		NextCall:    nextCall,
+	}, nil
+}
+
+// createNextCall はリクエストを受けたコールのメッセージと設定を引き継いで次回のコールを作成する
+// 受信者自身がリクエストしたコールのため、受信者が事前承認制にしていても承認済みとして作成する
+func (uc *RespondNextCallUseCase) createNextCall(ctx context.Context, morningCall *entity.MorningCall) (*entity.MorningCall, error) {
+	input := CreateInput{
+		SenderID:      morningCall.SenderID,
+		ReceiverID:    morningCall.ReceiverID,
+		ScheduledTime: morningCall.NextCallRequest.RequestedTime,
+		Message:       morningCall.Message,
+	}
+	if morningCall.ConfirmDeadline != nil {
+		deadline := morningCall.ConfirmDeadline.AddDate(0, 0, 1)
+		input.ConfirmDeadline = &deadline
+	}
+	if morningCall.WakeChallenge != nil {
+		input.ChallengeDifficulty = morningCall.WakeChallenge.Difficulty
+	}
+
+	output, err := uc.createUseCase.Execute(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	nextCall := output.MorningCall
+	if output.AwaitingApproval {
+		if reason := nextCall.Approve(); reason.IsNG() {
+			uc.discard(ctx, nextCall)
+			return nil, fmt.Errorf("次回のコールの承認に失敗しました: %w", reason)
+		}
+		if err := uc.morningCallRepo.Update(ctx, nextCall); err != nil {
+			uc.discard(ctx, nextCall)
+			return nil, fmt.Errorf("次回のコールの保存に失敗しました: %w", err)
+		}
+	}
+	return nextCall, nil
+}
+
+// save はリクエストへの応答を保存する
+func (uc *RespondNextCallUseCase) save(ctx context.Context, morningCall *entity.MorningCall) error {
+	if err := uc.morningCallRepo.Update(ctx, morningCall); err != nil {
+		if errors.Is(err, repository.ErrUpdateConflict) {
+			return fmt.Errorf("他の操作でモーニングコールが更新されました。再度お試しください")
+		}
+		return fmt.Errorf("次回のコールのリクエストへの応答の保存に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// discard は承認を完了できなかった場合に作成済みの次回のコールを削除する
+func (uc *RespondNextCallUseCase) discard(ctx context.Context, nextCall *entity.MorningCall) {
+	if err := uc.morningCallRepo.Delete(ctx, nextCall.ID); err != nil {
+		utils.Logf(ctx, "次回のコールの削除に失敗しました: %v", err)
+	}
+}
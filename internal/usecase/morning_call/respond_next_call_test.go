@@ -0,0 +1,183 @@
+package morning_call
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestRespondNextCallUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	scheduledTime := time.Now().Add(-time.Hour)
+	deadline := scheduledTime.Add(30 * time.Minute)
+
+	tests := []struct {
+		name                string
+		requester           string
+		approve             bool
+		withRequest         bool
+		unfriend            bool // リクエスト後に友達関係を解消する
+		requireCallApproval bool // 受信者が事前承認制を有効にしている
+		wantErr             string
+		wantStatus          entity.NextCallRequestStatus
+	}{
+		{
+			name:        "送信者が承認すると翌日同時刻のコールが作成される",
+			requester:   "sender",
+			approve:     true,
+			withRequest: true,
+			wantStatus:  entity.NextCallRequestStatusApproved,
+		},
+		{
+			name:                "受信者自身の依頼のため事前承認制でも承認済みで作成される",
+			requester:           "sender",
+			approve:             true,
+			withRequest:         true,
+			requireCallApproval: true,
+			wantStatus:          entity.NextCallRequestStatusApproved,
+		},
+		{
+			name:        "送信者が拒否するとコールは作成されない",
+			requester:   "sender",
+			withRequest: true,
+			wantStatus:  entity.NextCallRequestStatusRejected,
+		},
+		{
+			name:        "承認時に友達関係を再検証する",
+			requester:   "sender",
+			approve:     true,
+			withRequest: true,
+			unfriend:    true,
+			wantErr:     "友達関係にないユーザーにはモーニングコールを設定できません",
+			wantStatus:  entity.NextCallRequestStatusPending,
+		},
+		{
+			name:        "受信者は承認できない",
+			requester:   "receiver",
+			approve:     true,
+			withRequest: true,
+			wantErr:     "送信者のみが次回のコールのリクエストを承認できます",
+			wantStatus:  entity.NextCallRequestStatusPending,
+		},
+		{
+			name:      "リクエストがなければ承認できない",
+			requester: "sender",
+			approve:   true,
+			wantErr:   "応答待ちの次回のコールのリクエストがありません",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			morningCallRepo := memory.NewMorningCallRepository()
+			userRepo := memory.NewUserRepository()
+			relationshipRepo := memory.NewRelationshipRepository()
+
+			for _, u := range []*entity.User{
+				{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+				{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password", RequireCallApproval: tt.requireCallApproval},
+			} {
+				if err := userRepo.Create(ctx, u); err != nil {
+					t.Fatalf("failed to create user: %v", err)
+				}
+			}
+			if !tt.unfriend {
+				if err := relationshipRepo.Create(ctx, &entity.Relationship{
+					ID:          "rel1",
+					RequesterID: "sender",
+					ReceiverID:  "receiver",
+					Status:      valueobject.RelationshipStatusAccepted,
+					CreatedAt:   time.Now(),
+					UpdatedAt:   time.Now(),
+				}); err != nil {
+					t.Fatalf("failed to create relationship: %v", err)
+				}
+			}
+
+			morningCall := &entity.MorningCall{
+				ID:              "mc1",
+				SenderID:        "sender",
+				ReceiverID:      "receiver",
+				ScheduledTime:   scheduledTime,
+				Message:         "おはよう",
+				Status:          valueobject.MorningCallStatusConfirmed,
+				ConfirmDeadline: &deadline,
+				CreatedAt:       time.Now().Add(-2 * time.Hour),
+				UpdatedAt:       time.Now(),
+			}
+			if tt.withRequest {
+				if reason := morningCall.RequestNextCall(time.Now()); reason.IsNG() {
+					t.Fatalf("failed to request next call: %s", reason.Error())
+				}
+			}
+			if err := morningCallRepo.Create(ctx, morningCall); err != nil {
+				t.Fatalf("failed to create morning call: %v", err)
+			}
+
+			createUC := NewCreateUseCase(morningCallRepo, userRepo, relationshipRepo, nil, valueobject.DefaultInputLimits(), nil)
+			uc := NewRespondNextCallUseCase(morningCallRepo, createUC)
+			output, err := uc.Execute(ctx, RespondNextCallInput{
+				MorningCallID: morningCall.ID,
+				SenderID:      tt.requester,
+				Approve:       tt.approve,
+			})
+
+			persisted, findErr := morningCallRepo.FindByID(ctx, morningCall.ID)
+			if findErr != nil {
+				t.Fatalf("failed to get persisted morning call: %v", findErr)
+			}
+			if tt.withRequest && persisted.NextCallRequest.Status != tt.wantStatus {
+				t.Errorf("NextCallRequest.Status = %s, want %s", persisted.NextCallRequest.Status, tt.wantStatus)
+			}
+			count, _ := morningCallRepo.Count(ctx)
+
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want %s", err, tt.wantErr)
+				}
+				if count != 1 {
+					t.Errorf("Count() = %d, want 1 (no next call)", count)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !tt.approve {
+				if output.NextCall != nil || count != 1 {
+					t.Errorf("NextCall = %+v, Count() = %d, want no next call", output.NextCall, count)
+				}
+				return
+			}
+
+			next := output.NextCall
+			if next == nil || count != 2 {
+				t.Fatalf("NextCall = %+v, Count() = %d, want a created call", next, count)
+			}
+			if persisted.NextCallRequest.CreatedCallID != next.ID {
+				t.Errorf("CreatedCallID = %s, want %s", persisted.NextCallRequest.CreatedCallID, next.ID)
+			}
+			if !next.ScheduledTime.Equal(scheduledTime.AddDate(0, 0, 1)) || next.Message != morningCall.Message {
+				t.Errorf("next call = %v %q, want the next day with the same message", next.ScheduledTime, next.Message)
+			}
+			if next.ConfirmDeadline == nil || !next.ConfirmDeadline.Equal(deadline.AddDate(0, 0, 1)) {
+				t.Errorf("next ConfirmDeadline = %v, want the next day", next.ConfirmDeadline)
+			}
+			stored, _ := morningCallRepo.FindByID(ctx, next.ID)
+			if stored.Status != valueobject.MorningCallStatusScheduled {
+				t.Errorf("next Status = %s, want %s", stored.Status, valueobject.MorningCallStatusScheduled)
+			}
+
+			// 応答済みのリクエストは再度承認できない
+			if _, err := uc.Execute(ctx, RespondNextCallInput{MorningCallID: morningCall.ID, SenderID: "sender", Approve: true}); err == nil {
+				t.Error("expected error for already approved request")
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// RespondRescheduleUseCase は送信者が受信者からの時刻変更リクエストを承認・拒否するユースケース
+// 承認した場合はアラーム時刻を希望時刻に更新し、拒否した場合はアラーム時刻を変更しない
+type RespondRescheduleUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	now             func() time.Time
+}
+
+// NewRespondRescheduleUseCase は新しい時刻変更リクエスト応答ユースケースを作成する
+func NewRespondRescheduleUseCase(
+	morningCallRepo repository.MorningCallRepository,
+) *RespondRescheduleUseCase {
+	return &RespondRescheduleUseCase{
+		morningCallRepo: morningCallRepo,
+		now:             time.Now,
+	}
+}
+
+// RespondRescheduleInput は時刻変更リクエスト応答の入力データ
+type RespondRescheduleInput struct {
+	MorningCallID string
+	SenderID      string // 応答する送信者のID
+	Approve       bool   // trueで承認、falseで拒否
+}
+
+// RespondRescheduleOutput は時刻変更リクエスト応答の出力データ
+type RespondRescheduleOutput struct {
+	MorningCall *entity.MorningCall
+}
+
+// Execute は送信者のモーニングコールに届いている時刻変更リクエストに応答する
+func (uc *RespondRescheduleUseCase) Execute(ctx context.Context, input RespondRescheduleInput) (*RespondRescheduleOutput, error) {
+	action := "拒否"
+	if input.Approve {
+		action = "承認"
+	}
+
+	// 入力値の基本検証
+	if input.MorningCallID == "" {
+		return nil, fmt.Errorf("モーニングコールIDは必須です")
+	}
+	if input.SenderID == "" {
+		return nil, fmt.Errorf("送信者IDは必須です")
+	}
+
+	// モーニングコールの取得
+	morningCall, err := uc.morningCallRepo.FindByID(ctx, input.MorningCallID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("モーニングコールが見つかりません")
+		}
+		return nil, fmt.Errorf("モーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+
+	// 送信者本人のみ応答できる
+	if morningCall.SenderID != input.SenderID {
+		return nil, fmt.Errorf("送信者のみが時刻の変更リクエストを%sできます", action)
+	}
+
+	now := uc.now()
+	if input.Approve {
+		// 応答までに希望時刻を過ぎた場合は配信できないため承認しない
+		if morningCall.HasPendingRescheduleRequest() && !morningCall.RescheduleRequest.RequestedTime.After(now) {
+			return nil, fmt.Errorf("希望時刻を過ぎた時刻の変更リクエストは承認できません")
+		}
+		if reason := morningCall.ApproveReschedule(now); reason.IsNG() {
+			return nil, fmt.Errorf("時刻の変更リクエストを承認できませんでした: %w", reason)
+		}
+	} else {
+		if reason := morningCall.RejectReschedule(now); reason.IsNG() {
+			return nil, fmt.Errorf("時刻の変更リクエストを拒否できませんでした: %w", reason)
+		}
+	}
+
+	if err := uc.morningCallRepo.Update(ctx, morningCall); err != nil {
+		if errors.Is(err, repository.ErrUpdateConflict) {
+			return nil, fmt.Errorf("他の操作でモーニングコールが更新されました。再度お試しください")
+		}
+		return nil, fmt.Errorf("時刻変更リクエストへの応答の保存に失敗しました: %w", err)
+	}
+
+	return &RespondRescheduleOutput{
+		MorningCall: morningCall,
+	}, nil
+}
@@ -0,0 +1,146 @@
+package morning_call
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+func TestRespondRescheduleUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	scheduledTime := time.Now().Add(time.Hour)
+	requestedTime := scheduledTime.Add(30 * time.Minute)
+
+	tests := []struct {
+		name              string
+		requester         string
+		approve           bool
+		withRequest       bool
+		now               time.Time // ゼロ値の場合は現在時刻
+		wantErr           bool
+		errMsg            string
+		wantStatus        entity.RescheduleRequestStatus
+		wantScheduledTime time.Time
+	}{
+		{
+			name:              "送信者が承認すると希望時刻に変更される",
+			requester:         "sender",
+			approve:           true,
+			withRequest:       true,
+			wantStatus:        entity.RescheduleRequestStatusApproved,
+			wantScheduledTime: requestedTime,
+		},
+		{
+			name:              "送信者が拒否すると時刻は変わらない",
+			requester:         "sender",
+			approve:           false,
+			withRequest:       true,
+			wantStatus:        entity.RescheduleRequestStatusRejected,
+			wantScheduledTime: scheduledTime,
+		},
+		{
+			name:        "受信者は承認できない",
+			requester:   "receiver",
+			approve:     true,
+			withRequest: true,
+			wantErr:     true,
+			errMsg:      "送信者のみが時刻の変更リクエストを承認できます",
+		},
+		{
+			name:        "受信者は拒否できない",
+			requester:   "receiver",
+			approve:     false,
+			withRequest: true,
+			wantErr:     true,
+			errMsg:      "送信者のみが時刻の変更リクエストを拒否できます",
+		},
+		{
+			name:      "リクエストがなければ承認できない",
+			requester: "sender",
+			approve:   true,
+			wantErr:   true,
+			errMsg:    "応答待ちの時刻の変更リクエストがありません",
+		},
+		{
+			name:      "リクエストがなければ拒否できない",
+			requester: "sender",
+			approve:   false,
+			wantErr:   true,
+			errMsg:    "応答待ちの時刻の変更リクエストがありません",
+		},
+		{
+			name:        "希望時刻を過ぎたら承認できない",
+			requester:   "sender",
+			approve:     true,
+			withRequest: true,
+			now:         requestedTime,
+			wantErr:     true,
+			errMsg:      "希望時刻を過ぎた時刻の変更リクエストは承認できません",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			morningCallRepo, userRepo := setupRescheduleTest(t, valueobject.MorningCallStatusScheduled, scheduledTime)
+			if tt.withRequest {
+				requestUC := NewRequestRescheduleUseCase(morningCallRepo, userRepo)
+				if _, err := requestUC.Execute(ctx, RequestRescheduleInput{
+					MorningCallID: "mc1",
+					ReceiverID:    "receiver",
+					RequestedTime: requestedTime,
+				}); err != nil {
+					t.Fatalf("failed to request reschedule: %v", err)
+				}
+			}
+
+			uc := NewRespondRescheduleUseCase(morningCallRepo)
+			if !tt.now.IsZero() {
+				uc.now = func() time.Time { return tt.now }
+			}
+			output, err := uc.Execute(ctx, RespondRescheduleInput{
+				MorningCallID: "mc1",
+				SenderID:      tt.requester,
+				Approve:       tt.approve,
+			})
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error but got nil")
+				}
+				if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("error = %v, want containing %v", err, tt.errMsg)
+				}
+				persisted, _ := morningCallRepo.FindByID(ctx, "mc1")
+				if !persisted.ScheduledTime.Equal(scheduledTime) {
+					t.Errorf("ScheduledTime changed on error: %v", persisted.ScheduledTime)
+				}
+				if tt.withRequest && !persisted.HasPendingRescheduleRequest() {
+					t.Errorf("RescheduleRequest should stay pending on error: %+v", persisted.RescheduleRequest)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output.MorningCall.RescheduleRequest.Status != tt.wantStatus {
+				t.Errorf("RescheduleRequest.Status = %s, want %s", output.MorningCall.RescheduleRequest.Status, tt.wantStatus)
+			}
+
+			persisted, err := morningCallRepo.FindByID(ctx, "mc1")
+			if err != nil {
+				t.Fatalf("failed to get persisted morning call: %v", err)
+			}
+			if persisted.RescheduleRequest.Status != tt.wantStatus || persisted.RescheduleRequest.RespondedAt == nil {
+				t.Errorf("persisted RescheduleRequest = %+v, want %s", persisted.RescheduleRequest, tt.wantStatus)
+			}
+			if !persisted.ScheduledTime.Equal(tt.wantScheduledTime) {
+				t.Errorf("ScheduledTime = %v, want %v", persisted.ScheduledTime, tt.wantScheduledTime)
+			}
+		})
+	}
+}
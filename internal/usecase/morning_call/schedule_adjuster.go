@@ -0,0 +1,44 @@
+package morning_call
+
+import (
+	"context"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// ScheduleAdjuster はモーニングコールの作成時に外部データに応じてアラーム時刻を調整するフック
+type ScheduleAdjuster interface {
+	// Adjust は調整後のアラーム時刻を返す（調整しない場合はscheduledTimeをそのまま返す）
+	// 外部データを取得できない場合もコールの作成を妨げないよう、エラーにせず調整なしとすること
+	Adjust(ctx context.Context, receiver *entity.User, scheduledTime time.Time) time.Time
+}
+
+// WeatherScheduleAdjuster は受信者の地域のアラーム時刻の天気に応じて時刻をずらすScheduleAdjuster
+type WeatherScheduleAdjuster struct {
+	provider service.WeatherProvider
+	rules    valueobject.WeatherAdjustmentRules
+}
+
+// NewWeatherScheduleAdjuster は新しい天気連動の時刻調整フックを作成する
+func NewWeatherScheduleAdjuster(provider service.WeatherProvider, rules valueobject.WeatherAdjustmentRules) *WeatherScheduleAdjuster {
+	return &WeatherScheduleAdjuster{
+		provider: provider,
+		rules:    rules,
+	}
+}
+
+// Adjust はアラーム時刻の天気予報を取得し、ルールに従って時刻をずらす
+// 予報を取得できない場合や、ルールのない天気の場合は調整しない
+func (a *WeatherScheduleAdjuster) Adjust(ctx context.Context, receiver *entity.User, scheduledTime time.Time) time.Time {
+	condition, err := a.provider.Forecast(ctx, receiver.TimeZone, scheduledTime)
+	if err != nil {
+		return scheduledTime
+	}
+	return scheduledTime.Add(a.rules.OffsetFor(condition))
+}
+
+// インターフェースの実装を保証
+var _ ScheduleAdjuster = (*WeatherScheduleAdjuster)(nil)
@@ -0,0 +1,48 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/external"
+)
+
+// failingWeatherProvider は常に予報の取得に失敗するWeatherProvider
+type failingWeatherProvider struct{}
+
+func (failingWeatherProvider) Forecast(ctx context.Context, timeZone string, at time.Time) (valueobject.WeatherCondition, error) {
+	return "", errors.New("unavailable")
+}
+
+func TestWeatherScheduleAdjuster_Adjust(t *testing.T) {
+	ctx := context.Background()
+	receiver := &entity.User{ID: "receiver", TimeZone: "Asia/Tokyo"}
+	scheduled := time.Date(2026, 1, 1, 7, 0, 0, 0, time.UTC)
+	rules := valueobject.WeatherAdjustmentRules{
+		valueobject.WeatherRain: -15 * time.Minute,
+		valueobject.WeatherSnow: -3 * time.Hour, // 上限を超える設定は上限に丸める
+	}
+
+	tests := []struct {
+		name     string
+		adjuster *WeatherScheduleAdjuster
+		want     time.Time
+	}{
+		{name: "雨の日は早める", adjuster: NewWeatherScheduleAdjuster(external.NewStubWeatherProvider(valueobject.WeatherRain), rules), want: scheduled.Add(-15 * time.Minute)},
+		{name: "ずらし幅は上限に丸める", adjuster: NewWeatherScheduleAdjuster(external.NewStubWeatherProvider(valueobject.WeatherSnow), rules), want: scheduled.Add(-valueobject.MaxWeatherAdjustment)},
+		{name: "ルールのない天気は調整しない", adjuster: NewWeatherScheduleAdjuster(external.NewStubWeatherProvider(valueobject.WeatherClear), rules), want: scheduled},
+		{name: "予報を取得できない場合は調整しない", adjuster: NewWeatherScheduleAdjuster(failingWeatherProvider{}, rules), want: scheduled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.adjuster.Adjust(ctx, receiver, scheduled); !got.Equal(tt.want) {
+				t.Errorf("Adjust() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// SendStampUseCase は起床確認後に受信者から送信者へお礼スタンプを送るユースケース
+type SendStampUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	userRepo        repository.UserRepository
+}
+
+// NewSendStampUseCase は新しいお礼スタンプ送信ユースケースを作成する
+func NewSendStampUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	userRepo repository.UserRepository,
+) *SendStampUseCase {
+	return &SendStampUseCase{
+		morningCallRepo: morningCallRepo,
+		userRepo:        userRepo,
+	}
+}
+
+// SendStampInput はお礼スタンプ送信の入力データ
+type SendStampInput struct {
+	MorningCallID string
+	ReceiverID    string            // スタンプを送る受信者のID
+	Stamp         valueobject.Stamp // 送信するスタンプ
+}
+
+// SendStampOutput はお礼スタンプ送信の出力データ
+type SendStampOutput struct {
+	MorningCall *entity.MorningCall
+}
+
+// Execute は起床確認済みのモーニングコールにお礼スタンプを記録する
+func (uc *SendStampUseCase) Execute(ctx context.Context, input SendStampInput) (*SendStampOutput, error) {
+	// 入力値の基本検証
+	if input.MorningCallID == "" {
+		return nil, fmt.Errorf("モーニングコールIDは必須です")
+	}
+	if input.ReceiverID == "" {
+		return nil, fmt.Errorf("受信者IDは必須です")
+	}
+	if input.Stamp == "" {
+		return nil, fmt.Errorf("スタンプは必須です")
+	}
+	if !input.Stamp.IsValid() {
+		return nil, fmt.Errorf("無効なスタンプです")
+	}
+
+	// 受信者の存在確認
+	receiver, err := uc.userRepo.FindByID(ctx, input.ReceiverID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("受信者が見つかりません")
+		}
+		return nil, fmt.Errorf("受信者の確認中にエラーが発生しました: %w", err)
+	}
+
+	// モーニングコールの取得
+	morningCall, err := uc.morningCallRepo.FindByID(ctx, input.MorningCallID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("モーニングコールが見つかりません")
+		}
+		return nil, fmt.Errorf("モーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+
+	// 受信者本人のみスタンプを送れる
+	if morningCall.ReceiverID != receiver.ID {
+		return nil, fmt.Errorf("受信者のみがスタンプを送れます")
+	}
+
+	if reason := morningCall.SetStamp(input.Stamp); reason.IsNG() {
+		return nil, fmt.Errorf("%w", reason)
+	}
+
+	if err := uc.morningCallRepo.Update(ctx, morningCall); err != nil {
+		return nil, fmt.Errorf("スタンプの保存に失敗しました: %w", err)
+	}
+
+	return &SendStampOutput{
+		MorningCall: morningCall,
+	}, nil
+}
@@ -0,0 +1,145 @@
+package morning_call
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestNewSendStampUseCase(t *testing.T) {
+	morningCallRepo := memory.NewMorningCallRepository()
+	userRepo := memory.NewUserRepository()
+
+	uc := NewSendStampUseCase(morningCallRepo, userRepo)
+
+	if uc == nil {
+		t.Fatal("NewSendStampUseCase returned nil")
+	}
+	if uc.morningCallRepo == nil {
+		t.Error("morningCallRepo is nil")
+	}
+	if uc.userRepo == nil {
+		t.Error("userRepo is nil")
+	}
+}
+
+func TestSendStampUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		status    valueobject.MorningCallStatus
+		requester string
+		stamp     valueobject.Stamp
+		wantErr   bool
+		errMsg    string
+	}{
+		{
+			name:      "確認済みのコールにスタンプを送る",
+			status:    valueobject.MorningCallStatusConfirmed,
+			requester: "receiver",
+			stamp:     valueobject.StampThankYou,
+		},
+		{
+			name:      "スタンプが空",
+			status:    valueobject.MorningCallStatusConfirmed,
+			requester: "receiver",
+			stamp:     "",
+			wantErr:   true,
+			errMsg:    "スタンプは必須です",
+		},
+		{
+			name:      "無効なスタンプ",
+			status:    valueobject.MorningCallStatusConfirmed,
+			requester: "receiver",
+			stamp:     valueobject.Stamp("angry"),
+			wantErr:   true,
+			errMsg:    "無効なスタンプです",
+		},
+		{
+			name:      "送信者はスタンプを送れない",
+			status:    valueobject.MorningCallStatusConfirmed,
+			requester: "sender",
+			stamp:     valueobject.StampHeart,
+			wantErr:   true,
+			errMsg:    "受信者のみがスタンプを送れます",
+		},
+		{
+			name:      "未確認のコールにはスタンプを送れない",
+			status:    valueobject.MorningCallStatusDelivered,
+			requester: "receiver",
+			stamp:     valueobject.StampHeart,
+			wantErr:   true,
+			errMsg:    "起床確認済みのモーニングコールにのみスタンプを送れます",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			morningCallRepo := memory.NewMorningCallRepository()
+			userRepo := memory.NewUserRepository()
+
+			for _, u := range []*entity.User{
+				{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+				{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+			} {
+				if err := userRepo.Create(ctx, u); err != nil {
+					t.Fatalf("failed to create user: %v", err)
+				}
+			}
+
+			morningCall := &entity.MorningCall{
+				ID:            "mc1",
+				SenderID:      "sender",
+				ReceiverID:    "receiver",
+				ScheduledTime: time.Now().Add(-time.Hour),
+				Status:        tt.status,
+				CreatedAt:     time.Now().Add(-2 * time.Hour),
+				UpdatedAt:     time.Now().Add(-time.Hour),
+			}
+			if err := morningCallRepo.Create(ctx, morningCall); err != nil {
+				t.Fatalf("failed to create morning call: %v", err)
+			}
+
+			uc := NewSendStampUseCase(morningCallRepo, userRepo)
+			output, err := uc.Execute(ctx, SendStampInput{
+				MorningCallID: morningCall.ID,
+				ReceiverID:    tt.requester,
+				Stamp:         tt.stamp,
+			})
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error but got nil")
+				}
+				if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("error = %v, want containing %v", err, tt.errMsg)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output.MorningCall.Stamp != tt.stamp {
+				t.Errorf("Stamp = %v, want %v", output.MorningCall.Stamp, tt.stamp)
+			}
+
+			persisted, err := morningCallRepo.FindByID(ctx, morningCall.ID)
+			if err != nil {
+				t.Fatalf("failed to get persisted morning call: %v", err)
+			}
+			if persisted.Stamp != tt.stamp {
+				t.Errorf("persisted Stamp = %v, want %v", persisted.Stamp, tt.stamp)
+			}
+			if persisted.StampAt == nil {
+				t.Error("persisted StampAt should be set")
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package morning_call
+
+import (
+	"context"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// sentCallBatchSize は送信者のコールをリポジトリから一度に取得する件数
+const sentCallBatchSize = 500
+
+// forEachSentCall は送信者のコールを件数の上限なしにページ単位で読み取り、1件ずつfnに渡す
+// 件数を数えるだけの用途向けに、コピーを作らない読み取り専用ビューで取得する（リポジトリのエラーはそのまま返す）
+func forEachSentCall(ctx context.Context, morningCallRepo repository.MorningCallRepository, senderID string, fn func(call entity.ReadOnlyMorningCall)) error {
+	for offset := 0; ; offset += sentCallBatchSize {
+		batch, err := morningCallRepo.FindReadOnlyBySenderID(ctx, senderID, offset, sentCallBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, call := range batch {
+			fn(call)
+		}
+		if len(batch) < sentCallBatchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// SetDeliveryHintsUseCase は受信者が自分宛てのモーニングコールごとに配信時の音量とバイブレーションを設定するユースケース
+// コールごとの設定は受信者のデフォルト設定より優先される
+type SetDeliveryHintsUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	userRepo        repository.UserRepository
+}
+
+// NewSetDeliveryHintsUseCase は新しい音量・バイブレーション設定ユースケースを作成する
+func NewSetDeliveryHintsUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	userRepo repository.UserRepository,
+) *SetDeliveryHintsUseCase {
+	return &SetDeliveryHintsUseCase{
+		morningCallRepo: morningCallRepo,
+		userRepo:        userRepo,
+	}
+}
+
+// SetDeliveryHintsInput は音量・バイブレーション設定の入力データ
+type SetDeliveryHintsInput struct {
+	MorningCallID string
+	ReceiverID    string // 設定する受信者のID
+	Volume        *int   // 配信時の音量（0〜100、nilでコールごとの設定を解除し、受信者のデフォルトに従う）
+	Vibrate       *bool  // 配信時にバイブレーションするか（nilでコールごとの設定を解除し、受信者のデフォルトに従う）
+}
+
+// SetDeliveryHintsOutput は音量・バイブレーション設定の出力データ
+type SetDeliveryHintsOutput struct {
+	MorningCall *entity.MorningCall
+}
+
+// Execute は受信者宛の配信前のモーニングコールに配信時の音量とバイブレーションの有無を設定する
+func (uc *SetDeliveryHintsUseCase) Execute(ctx context.Context, input SetDeliveryHintsInput) (*SetDeliveryHintsOutput, error) {
+	// 入力値の基本検証
+	if input.MorningCallID == "" {
+		return nil, fmt.Errorf("モーニングコールIDは必須です")
+	}
+	if input.ReceiverID == "" {
+		return nil, fmt.Errorf("受信者IDは必須です")
+	}
+
+	// 受信者の存在確認
+	receiver, err := uc.userRepo.FindByID(ctx, input.ReceiverID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("受信者が見つかりません")
+		}
+		return nil, fmt.Errorf("受信者の確認中にエラーが発生しました: %w", err)
+	}
+
+	// モーニングコールの取得
+	morningCall, err := uc.morningCallRepo.FindByID(ctx, input.MorningCallID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("モーニングコールが見つかりません")
+		}
+		return nil, fmt.Errorf("モーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+
+	// 受信者本人のみ設定できる
+	if morningCall.ReceiverID != receiver.ID {
+		return nil, fmt.Errorf("受信者のみが音量とバイブレーションを設定できます")
+	}
+
+	if reason := morningCall.SetDeliveryHints(input.Volume, input.Vibrate); reason.IsNG() {
+		return nil, fmt.Errorf("音量とバイブレーションを設定できませんでした: %w", reason)
+	}
+
+	if err := uc.morningCallRepo.Update(ctx, morningCall); err != nil {
+		if errors.Is(err, repository.ErrUpdateConflict) {
+			return nil, fmt.Errorf("他の操作でモーニングコールが更新されました。再度お試しください")
+		}
+		return nil, fmt.Errorf("音量とバイブレーションの設定の保存に失敗しました: %w", err)
+	}
+
+	return &SetDeliveryHintsOutput{
+		MorningCall: morningCall,
+	}, nil
+}
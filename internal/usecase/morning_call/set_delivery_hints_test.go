@@ -0,0 +1,139 @@
+package morning_call
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestSetDeliveryHintsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	loud := 100
+	tooLoud := 101
+	still := false
+
+	tests := []struct {
+		name      string
+		status    valueobject.MorningCallStatus
+		requester string
+		initial   *int
+		volume    *int
+		vibrate   *bool
+		wantHints valueobject.DeliveryHints // 受信者のデフォルト（音量40・バイブあり）を踏まえた配信時のヒント
+		wantErr   bool
+		errMsg    string
+	}{
+		{
+			name:      "コールごとの設定がデフォルトより優先される",
+			status:    valueobject.MorningCallStatusScheduled,
+			requester: "receiver",
+			volume:    &loud,
+			vibrate:   &still,
+			wantHints: valueobject.DeliveryHints{Volume: 100, Vibrate: false},
+		},
+		{
+			name:      "設定を解除するとデフォルトに従う",
+			status:    valueobject.MorningCallStatusPendingApproval,
+			requester: "receiver",
+			initial:   &loud,
+			wantHints: valueobject.DeliveryHints{Volume: 40, Vibrate: true},
+		},
+		{
+			name:      "範囲外の音量",
+			status:    valueobject.MorningCallStatusScheduled,
+			requester: "receiver",
+			volume:    &tooLoud,
+			wantErr:   true,
+			errMsg:    "音量は0から100の範囲で指定してください",
+		},
+		{
+			name:      "送信者は設定できない",
+			status:    valueobject.MorningCallStatusScheduled,
+			requester: "sender",
+			volume:    &loud,
+			wantErr:   true,
+			errMsg:    "受信者のみが音量とバイブレーションを設定できます",
+		},
+		{
+			name:      "配信済みは設定できない",
+			status:    valueobject.MorningCallStatusDelivered,
+			requester: "receiver",
+			volume:    &loud,
+			wantErr:   true,
+			errMsg:    "配信前のモーニングコールのみ",
+		},
+		{
+			name:      "存在しない受信者",
+			status:    valueobject.MorningCallStatusScheduled,
+			requester: "unknown",
+			volume:    &loud,
+			wantErr:   true,
+			errMsg:    "受信者が見つかりません",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			morningCallRepo := memory.NewMorningCallRepository()
+			userRepo := memory.NewUserRepository()
+
+			receiver := &entity.User{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password", Volume: 40, Vibrate: true}
+			for _, u := range []*entity.User{
+				{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+				receiver,
+			} {
+				if err := userRepo.Create(ctx, u); err != nil {
+					t.Fatalf("failed to create user: %v", err)
+				}
+			}
+
+			morningCall := &entity.MorningCall{
+				ID:            "mc1",
+				SenderID:      "sender",
+				ReceiverID:    "receiver",
+				ScheduledTime: time.Now().Add(time.Hour),
+				Status:        tt.status,
+				Volume:        tt.initial,
+				CreatedAt:     time.Now().Add(-time.Hour),
+				UpdatedAt:     time.Now().Add(-time.Hour),
+			}
+			if err := morningCallRepo.Create(ctx, morningCall); err != nil {
+				t.Fatalf("failed to create morning call: %v", err)
+			}
+
+			uc := NewSetDeliveryHintsUseCase(morningCallRepo, userRepo)
+			_, err := uc.Execute(ctx, SetDeliveryHintsInput{
+				MorningCallID: morningCall.ID,
+				ReceiverID:    tt.requester,
+				Volume:        tt.volume,
+				Vibrate:       tt.vibrate,
+			})
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error but got nil")
+				}
+				if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("error = %v, want containing %v", err, tt.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			persisted, err := morningCallRepo.FindByID(ctx, morningCall.ID)
+			if err != nil {
+				t.Fatalf("failed to get persisted morning call: %v", err)
+			}
+			if got := persisted.ResolveDeliveryHints(receiver); got != tt.wantHints {
+				t.Errorf("ResolveDeliveryHints() = %+v, want %+v", got, tt.wantHints)
+			}
+		})
+	}
+}
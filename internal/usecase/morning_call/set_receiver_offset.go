@@ -0,0 +1,89 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// SetReceiverOffsetUseCase は受信者が自分宛てのモーニングコールのアラーム時刻をずらすユースケース
+// 送信者が設定した予定時刻は変更せず、ずらし幅のみを保持する
+type SetReceiverOffsetUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	userRepo        repository.UserRepository
+}
+
+// NewSetReceiverOffsetUseCase は新しいアラーム時刻ずらし設定ユースケースを作成する
+func NewSetReceiverOffsetUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	userRepo repository.UserRepository,
+) *SetReceiverOffsetUseCase {
+	return &SetReceiverOffsetUseCase{
+		morningCallRepo: morningCallRepo,
+		userRepo:        userRepo,
+	}
+}
+
+// SetReceiverOffsetInput はアラーム時刻ずらし設定の入力データ
+type SetReceiverOffsetInput struct {
+	MorningCallID string
+	ReceiverID    string // 設定する受信者のID
+	OffsetMinutes int    // ずらし幅（分、負の値は早める、0で元に戻す）
+}
+
+// SetReceiverOffsetOutput はアラーム時刻ずらし設定の出力データ
+type SetReceiverOffsetOutput struct {
+	MorningCall *entity.MorningCall
+}
+
+// Execute は受信者宛のスケジュール済みモーニングコールにずらし幅を設定する
+func (uc *SetReceiverOffsetUseCase) Execute(ctx context.Context, input SetReceiverOffsetInput) (*SetReceiverOffsetOutput, error) {
+	// 入力値の基本検証
+	if input.MorningCallID == "" {
+		return nil, fmt.Errorf("モーニングコールIDは必須です")
+	}
+	if input.ReceiverID == "" {
+		return nil, fmt.Errorf("受信者IDは必須です")
+	}
+
+	// 受信者の存在確認
+	receiver, err := uc.userRepo.FindByID(ctx, input.ReceiverID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("受信者が見つかりません")
+		}
+		return nil, fmt.Errorf("受信者の確認中にエラーが発生しました: %w", err)
+	}
+
+	// モーニングコールの取得
+	morningCall, err := uc.morningCallRepo.FindByID(ctx, input.MorningCallID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("モーニングコールが見つかりません")
+		}
+		return nil, fmt.Errorf("モーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+
+	// 受信者本人のみ設定できる
+	if morningCall.ReceiverID != receiver.ID {
+		return nil, fmt.Errorf("受信者のみがアラーム時刻をずらせます")
+	}
+
+	if reason := morningCall.SetReceiverOffset(input.OffsetMinutes); reason.IsNG() {
+		return nil, fmt.Errorf("アラーム時刻をずらせませんでした: %w", reason)
+	}
+
+	if err := uc.morningCallRepo.Update(ctx, morningCall); err != nil {
+		if errors.Is(err, repository.ErrUpdateConflict) {
+			return nil, fmt.Errorf("他の操作でモーニングコールが更新されました。再度お試しください")
+		}
+		return nil, fmt.Errorf("アラーム時刻のずらし幅の保存に失敗しました: %w", err)
+	}
+
+	return &SetReceiverOffsetOutput{
+		MorningCall: morningCall,
+	}, nil
+}
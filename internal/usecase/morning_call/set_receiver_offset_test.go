@@ -0,0 +1,140 @@
+package morning_call
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestSetReceiverOffsetUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		status    valueobject.MorningCallStatus
+		requester string
+		offset    int
+		wantErr   bool
+		errMsg    string
+	}{
+		{
+			name:      "受信者がアラームを遅らせる",
+			status:    valueobject.MorningCallStatusScheduled,
+			requester: "receiver",
+			offset:    10,
+		},
+		{
+			name:      "受信者がアラームを早める",
+			status:    valueobject.MorningCallStatusScheduled,
+			requester: "receiver",
+			offset:    -entity.MaxReceiverOffsetMinutes,
+		},
+		{
+			name:      "送信者は設定できない",
+			status:    valueobject.MorningCallStatusScheduled,
+			requester: "sender",
+			offset:    10,
+			wantErr:   true,
+			errMsg:    "受信者のみがアラーム時刻をずらせます",
+		},
+		{
+			name:      "範囲外のずらし幅",
+			status:    valueobject.MorningCallStatusScheduled,
+			requester: "receiver",
+			offset:    entity.MaxReceiverOffsetMinutes + 1,
+			wantErr:   true,
+			errMsg:    "前後30分以内",
+		},
+		{
+			name:      "配信済みは設定できない",
+			status:    valueobject.MorningCallStatusDelivered,
+			requester: "receiver",
+			offset:    10,
+			wantErr:   true,
+			errMsg:    "スケジュール済みのモーニングコールのみ",
+		},
+		{
+			name:      "存在しない受信者",
+			status:    valueobject.MorningCallStatusScheduled,
+			requester: "unknown",
+			offset:    10,
+			wantErr:   true,
+			errMsg:    "受信者が見つかりません",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			morningCallRepo := memory.NewMorningCallRepository()
+			userRepo := memory.NewUserRepository()
+
+			for _, u := range []*entity.User{
+				{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+				{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+			} {
+				if err := userRepo.Create(ctx, u); err != nil {
+					t.Fatalf("failed to create user: %v", err)
+				}
+			}
+
+			scheduledTime := time.Now().Add(time.Hour)
+			morningCall := &entity.MorningCall{
+				ID:            "mc1",
+				SenderID:      "sender",
+				ReceiverID:    "receiver",
+				ScheduledTime: scheduledTime,
+				Status:        tt.status,
+				CreatedAt:     time.Now().Add(-time.Hour),
+				UpdatedAt:     time.Now().Add(-time.Hour),
+			}
+			if err := morningCallRepo.Create(ctx, morningCall); err != nil {
+				t.Fatalf("failed to create morning call: %v", err)
+			}
+
+			uc := NewSetReceiverOffsetUseCase(morningCallRepo, userRepo)
+			output, err := uc.Execute(ctx, SetReceiverOffsetInput{
+				MorningCallID: morningCall.ID,
+				ReceiverID:    tt.requester,
+				OffsetMinutes: tt.offset,
+			})
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error but got nil")
+				}
+				if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("error = %v, want containing %v", err, tt.errMsg)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output.MorningCall.ReceiverOffsetMinutes != tt.offset {
+				t.Errorf("ReceiverOffsetMinutes = %d, want %d", output.MorningCall.ReceiverOffsetMinutes, tt.offset)
+			}
+
+			persisted, err := morningCallRepo.FindByID(ctx, morningCall.ID)
+			if err != nil {
+				t.Fatalf("failed to get persisted morning call: %v", err)
+			}
+			if persisted.ReceiverOffsetMinutes != tt.offset {
+				t.Errorf("persisted ReceiverOffsetMinutes = %d, want %d", persisted.ReceiverOffsetMinutes, tt.offset)
+			}
+			// 送信者が設定した予定時刻は変わらない
+			if !persisted.ScheduledTime.Equal(scheduledTime) {
+				t.Errorf("ScheduledTime changed: got %v, want %v", persisted.ScheduledTime, scheduledTime)
+			}
+			want := scheduledTime.Add(time.Duration(tt.offset) * time.Minute)
+			if !persisted.EffectiveScheduledTime().Equal(want) {
+				t.Errorf("EffectiveScheduledTime = %v, want %v", persisted.EffectiveScheduledTime(), want)
+			}
+		})
+	}
+}
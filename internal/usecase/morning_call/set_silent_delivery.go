@@ -0,0 +1,89 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// SetSilentDeliveryUseCase は受信者が自分宛てのモーニングコールごとに無音配信を設定するユースケース
+// コールごとの設定は受信者のデフォルト設定より優先される
+type SetSilentDeliveryUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	userRepo        repository.UserRepository
+}
+
+// NewSetSilentDeliveryUseCase は新しい無音配信設定ユースケースを作成する
+func NewSetSilentDeliveryUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	userRepo repository.UserRepository,
+) *SetSilentDeliveryUseCase {
+	return &SetSilentDeliveryUseCase{
+		morningCallRepo: morningCallRepo,
+		userRepo:        userRepo,
+	}
+}
+
+// SetSilentDeliveryInput は無音配信設定の入力データ
+type SetSilentDeliveryInput struct {
+	MorningCallID string
+	ReceiverID    string // 設定する受信者のID
+	Silent        *bool  // 無音で配信するか（nilでコールごとの設定を解除し、受信者のデフォルトに従う）
+}
+
+// SetSilentDeliveryOutput は無音配信設定の出力データ
+type SetSilentDeliveryOutput struct {
+	MorningCall *entity.MorningCall
+}
+
+// Execute は受信者宛の配信前のモーニングコールに無音配信の有無を設定する
+func (uc *SetSilentDeliveryUseCase) Execute(ctx context.Context, input SetSilentDeliveryInput) (*SetSilentDeliveryOutput, error) {
+	// 入力値の基本検証
+	if input.MorningCallID == "" {
+		return nil, fmt.Errorf("モーニングコールIDは必須です")
+	}
+	if input.ReceiverID == "" {
+		return nil, fmt.Errorf("受信者IDは必須です")
+	}
+
+	// 受信者の存在確認
+	receiver, err := uc.userRepo.FindByID(ctx, input.ReceiverID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("受信者が見つかりません")
+		}
+		return nil, fmt.Errorf("受信者の確認中にエラーが発生しました: %w", err)
+	}
+
+	// モーニングコールの取得
+	morningCall, err := uc.morningCallRepo.FindByID(ctx, input.MorningCallID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("モーニングコールが見つかりません")
+		}
+		return nil, fmt.Errorf("モーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+
+	// 受信者本人のみ設定できる
+	if morningCall.ReceiverID != receiver.ID {
+		return nil, fmt.Errorf("受信者のみが無音配信を設定できます")
+	}
+
+	if reason := morningCall.SetSilentDelivery(input.Silent); reason.IsNG() {
+		return nil, fmt.Errorf("無音配信を設定できませんでした: %w", reason)
+	}
+
+	if err := uc.morningCallRepo.Update(ctx, morningCall); err != nil {
+		if errors.Is(err, repository.ErrUpdateConflict) {
+			return nil, fmt.Errorf("他の操作でモーニングコールが更新されました。再度お試しください")
+		}
+		return nil, fmt.Errorf("無音配信の設定の保存に失敗しました: %w", err)
+	}
+
+	return &SetSilentDeliveryOutput{
+		MorningCall: morningCall,
+	}, nil
+}
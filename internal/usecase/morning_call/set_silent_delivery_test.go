@@ -0,0 +1,138 @@
+package morning_call
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestSetSilentDeliveryUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	silent := true
+	audible := false
+
+	tests := []struct {
+		name        string
+		status      valueobject.MorningCallStatus
+		requester   string
+		initial     *bool
+		silent      *bool
+		wantSilence bool // 受信者のデフォルト（無音）を踏まえた配信時の判定
+		wantErr     bool
+		errMsg      string
+	}{
+		{
+			name:        "コールごとに音を鳴らす設定がデフォルトより優先される",
+			status:      valueobject.MorningCallStatusScheduled,
+			requester:   "receiver",
+			silent:      &audible,
+			wantSilence: false,
+		},
+		{
+			name:        "コールごとに無音を設定する",
+			status:      valueobject.MorningCallStatusPendingApproval,
+			requester:   "receiver",
+			silent:      &silent,
+			wantSilence: true,
+		},
+		{
+			name:        "設定を解除するとデフォルトに従う",
+			status:      valueobject.MorningCallStatusScheduled,
+			requester:   "receiver",
+			initial:     &audible,
+			silent:      nil,
+			wantSilence: true,
+		},
+		{
+			name:      "送信者は設定できない",
+			status:    valueobject.MorningCallStatusScheduled,
+			requester: "sender",
+			silent:    &silent,
+			wantErr:   true,
+			errMsg:    "受信者のみが無音配信を設定できます",
+		},
+		{
+			name:      "配信済みは設定できない",
+			status:    valueobject.MorningCallStatusDelivered,
+			requester: "receiver",
+			silent:    &silent,
+			wantErr:   true,
+			errMsg:    "配信前のモーニングコールのみ",
+		},
+		{
+			name:      "存在しない受信者",
+			status:    valueobject.MorningCallStatusScheduled,
+			requester: "unknown",
+			silent:    &silent,
+			wantErr:   true,
+			errMsg:    "受信者が見つかりません",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			morningCallRepo := memory.NewMorningCallRepository()
+			userRepo := memory.NewUserRepository()
+
+			receiver := &entity.User{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password", SilentDelivery: true}
+			for _, u := range []*entity.User{
+				{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+				receiver,
+			} {
+				if err := userRepo.Create(ctx, u); err != nil {
+					t.Fatalf("failed to create user: %v", err)
+				}
+			}
+
+			morningCall := &entity.MorningCall{
+				ID:             "mc1",
+				SenderID:       "sender",
+				ReceiverID:     "receiver",
+				ScheduledTime:  time.Now().Add(time.Hour),
+				Status:         tt.status,
+				SilentDelivery: tt.initial,
+				CreatedAt:      time.Now().Add(-time.Hour),
+				UpdatedAt:      time.Now().Add(-time.Hour),
+			}
+			if err := morningCallRepo.Create(ctx, morningCall); err != nil {
+				t.Fatalf("failed to create morning call: %v", err)
+			}
+
+			uc := NewSetSilentDeliveryUseCase(morningCallRepo, userRepo)
+			_, err := uc.Execute(ctx, SetSilentDeliveryInput{
+				MorningCallID: morningCall.ID,
+				ReceiverID:    tt.requester,
+				Silent:        tt.silent,
+			})
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error but got nil")
+				}
+				if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("error = %v, want containing %v", err, tt.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			persisted, err := morningCallRepo.FindByID(ctx, morningCall.ID)
+			if err != nil {
+				t.Fatalf("failed to get persisted morning call: %v", err)
+			}
+			if (persisted.SilentDelivery == nil) != (tt.silent == nil) {
+				t.Errorf("persisted SilentDelivery = %v, want %v", persisted.SilentDelivery, tt.silent)
+			}
+			if got := persisted.ResolveSilentDelivery(receiver); got != tt.wantSilence {
+				t.Errorf("ResolveSilentDelivery() = %v, want %v", got, tt.wantSilence)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// SkipUseCase は受信者がモーニングコールをスキップするユースケース
+// 送信者によるキャンセルとは区別してスキップ済みステータスに遷移させる
+type SkipUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	userRepo        repository.UserRepository
+}
+
+// NewSkipUseCase は新しいスキップユースケースを作成する
+func NewSkipUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	userRepo repository.UserRepository,
+) *SkipUseCase {
+	return &SkipUseCase{
+		morningCallRepo: morningCallRepo,
+		userRepo:        userRepo,
+	}
+}
+
+// SkipInput はスキップの入力データ
+type SkipInput struct {
+	MorningCallID string
+	ReceiverID    string // スキップする受信者のID
+}
+
+// SkipOutput はスキップの出力データ
+type SkipOutput struct {
+	MorningCall *entity.MorningCall
+}
+
+// Execute は受信者宛の予定中・配信済みのモーニングコールをスキップする
+func (uc *SkipUseCase) Execute(ctx context.Context, input SkipInput) (*SkipOutput, error) {
+	// 入力値の基本検証
+	if input.MorningCallID == "" {
+		return nil, fmt.Errorf("モーニングコールIDは必須です")
+	}
+	if input.ReceiverID == "" {
+		return nil, fmt.Errorf("受信者IDは必須です")
+	}
+
+	// 受信者の存在確認
+	receiver, err := uc.userRepo.FindByID(ctx, input.ReceiverID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("受信者が見つかりません")
+		}
+		return nil, fmt.Errorf("受信者の確認中にエラーが発生しました: %w", err)
+	}
+
+	// モーニングコールの取得
+	morningCall, err := uc.morningCallRepo.FindByID(ctx, input.MorningCallID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("モーニングコールが見つかりません")
+		}
+		return nil, fmt.Errorf("モーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+
+	// 受信者本人のみスキップできる
+	if morningCall.ReceiverID != receiver.ID {
+		return nil, fmt.Errorf("受信者のみがモーニングコールをスキップできます")
+	}
+
+	// ステータスの確認（予定中・配信済みのみスキップ可能）
+	switch morningCall.Status {
+	case valueobject.MorningCallStatusScheduled, valueobject.MorningCallStatusDelivered:
+		// スキップ可能
+	case valueobject.MorningCallStatusSkipped:
+		return nil, fmt.Errorf("すでにスキップ済みです")
+	case valueobject.MorningCallStatusConfirmed:
+		return nil, fmt.Errorf("起床確認済みのモーニングコールはスキップできません")
+	case valueobject.MorningCallStatusCancelled:
+		return nil, fmt.Errorf("キャンセル済みのモーニングコールはスキップできません")
+	case valueobject.MorningCallStatusExpired:
+		return nil, fmt.Errorf("期限切れのモーニングコールはスキップできません")
+	default:
+		return nil, fmt.Errorf("このステータスのモーニングコールはスキップできません")
+	}
+
+	if reason := morningCall.Skip(); reason.IsNG() {
+		return nil, fmt.Errorf("スキップに失敗しました: %w", reason)
+	}
+
+	if err := uc.morningCallRepo.Update(ctx, morningCall); err != nil {
+		return nil, fmt.Errorf("スキップの保存に失敗しました: %w", err)
+	}
+
+	return &SkipOutput{
+		MorningCall: morningCall,
+	}, nil
+}
@@ -0,0 +1,123 @@
+package morning_call
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestSkipUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		status    valueobject.MorningCallStatus
+		requester string
+		wantErr   bool
+		errMsg    string
+	}{
+		{
+			name:      "予定中のコールをスキップする",
+			status:    valueobject.MorningCallStatusScheduled,
+			requester: "receiver",
+		},
+		{
+			name:      "配信済みのコールをスキップする",
+			status:    valueobject.MorningCallStatusDelivered,
+			requester: "receiver",
+		},
+		{
+			name:      "送信者はスキップできない",
+			status:    valueobject.MorningCallStatusScheduled,
+			requester: "sender",
+			wantErr:   true,
+			errMsg:    "受信者のみがモーニングコールをスキップできます",
+		},
+		{
+			name:      "起床確認済みはスキップできない",
+			status:    valueobject.MorningCallStatusConfirmed,
+			requester: "receiver",
+			wantErr:   true,
+			errMsg:    "起床確認済みのモーニングコールはスキップできません",
+		},
+		{
+			name:      "期限切れはスキップできない",
+			status:    valueobject.MorningCallStatusExpired,
+			requester: "receiver",
+			wantErr:   true,
+			errMsg:    "期限切れのモーニングコールはスキップできません",
+		},
+		{
+			name:      "スキップ済みは再度スキップできない",
+			status:    valueobject.MorningCallStatusSkipped,
+			requester: "receiver",
+			wantErr:   true,
+			errMsg:    "すでにスキップ済みです",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			morningCallRepo := memory.NewMorningCallRepository()
+			userRepo := memory.NewUserRepository()
+
+			for _, u := range []*entity.User{
+				{ID: "sender", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+				{ID: "receiver", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+			} {
+				if err := userRepo.Create(ctx, u); err != nil {
+					t.Fatalf("failed to create user: %v", err)
+				}
+			}
+
+			morningCall := &entity.MorningCall{
+				ID:            "mc1",
+				SenderID:      "sender",
+				ReceiverID:    "receiver",
+				ScheduledTime: time.Now().Add(time.Hour),
+				Status:        tt.status,
+				CreatedAt:     time.Now().Add(-time.Hour),
+				UpdatedAt:     time.Now().Add(-time.Hour),
+			}
+			if err := morningCallRepo.Create(ctx, morningCall); err != nil {
+				t.Fatalf("failed to create morning call: %v", err)
+			}
+
+			uc := NewSkipUseCase(morningCallRepo, userRepo)
+			output, err := uc.Execute(ctx, SkipInput{
+				MorningCallID: morningCall.ID,
+				ReceiverID:    tt.requester,
+			})
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error but got nil")
+				}
+				if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("error = %v, want containing %v", err, tt.errMsg)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output.MorningCall.Status != valueobject.MorningCallStatusSkipped {
+				t.Errorf("Status = %v, want skipped", output.MorningCall.Status)
+			}
+
+			persisted, err := morningCallRepo.FindByID(ctx, morningCall.ID)
+			if err != nil {
+				t.Fatalf("failed to get persisted morning call: %v", err)
+			}
+			if persisted.Status != valueobject.MorningCallStatusSkipped {
+				t.Errorf("persisted Status = %v, want skipped", persisted.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package morning_call
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// UnconfirmedCountUseCase は未確認のモーニングコール件数を取得するユースケース
+type UnconfirmedCountUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+}
+
+// NewUnconfirmedCountUseCase は新しい未確認件数取得ユースケースを作成する
+func NewUnconfirmedCountUseCase(morningCallRepo repository.MorningCallRepository) *UnconfirmedCountUseCase {
+	return &UnconfirmedCountUseCase{
+		morningCallRepo: morningCallRepo,
+	}
+}
+
+// UnconfirmedCountInput は未確認件数取得の入力データ
+type UnconfirmedCountInput struct {
+	ReceiverID string // 必須：受信者のID
+}
+
+// UnconfirmedCountOutput は未確認件数取得の出力データ
+type UnconfirmedCountOutput struct {
+	Count int // 配信済みで起床確認されていないモーニングコール数
+}
+
+// Execute は受信者宛てに配信済みで、まだ起床確認していないモーニングコールの件数を返す
+func (uc *UnconfirmedCountUseCase) Execute(ctx context.Context, input UnconfirmedCountInput) (*UnconfirmedCountOutput, error) {
+	// 入力値の基本検証
+	if input.ReceiverID == "" {
+		return nil, fmt.Errorf("受信者IDは必須です")
+	}
+
+	count, err := uc.morningCallRepo.CountByReceiverIDAndStatus(ctx, input.ReceiverID, valueobject.MorningCallStatusDelivered)
+	if err != nil {
+		return nil, fmt.Errorf("未確認件数の取得中にエラーが発生しました: %w", err)
+	}
+
+	return &UnconfirmedCountOutput{
+		Count: count,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package morning_call
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestUnconfirmedCountUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	createCall := func(t *testing.T, repo *memory.MorningCallRepository, id, receiverID string, status valueobject.MorningCallStatus) {
+		t.Helper()
+		mc := &entity.MorningCall{
+			ID:            id,
+			SenderID:      "sender",
+			ReceiverID:    receiverID,
+			ScheduledTime: now.Add(-time.Hour),
+			Status:        status,
+			CreatedAt:     now.Add(-2 * time.Hour),
+			UpdatedAt:     now.Add(-2 * time.Hour),
+		}
+		if err := repo.Create(ctx, mc); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+
+	t.Run("コールがない場合は0件", func(t *testing.T) {
+		uc := NewUnconfirmedCountUseCase(memory.NewMorningCallRepository())
+
+		output, err := uc.Execute(ctx, UnconfirmedCountInput{ReceiverID: "receiver"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.Count != 0 {
+			t.Errorf("Count = %d, want 0", output.Count)
+		}
+	})
+
+	t.Run("配信済み以外のみの場合は0件", func(t *testing.T) {
+		repo := memory.NewMorningCallRepository()
+		createCall(t, repo, "scheduled", "receiver", valueobject.MorningCallStatusScheduled)
+		createCall(t, repo, "confirmed", "receiver", valueobject.MorningCallStatusConfirmed)
+		createCall(t, repo, "other", "other", valueobject.MorningCallStatusDelivered)
+		uc := NewUnconfirmedCountUseCase(repo)
+
+		output, err := uc.Execute(ctx, UnconfirmedCountInput{ReceiverID: "receiver"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.Count != 0 {
+			t.Errorf("Count = %d, want 0", output.Count)
+		}
+	})
+
+	t.Run("大量の未確認コールを数える", func(t *testing.T) {
+		repo := memory.NewMorningCallRepository()
+		const delivered = 1000
+		for i := 0; i < delivered; i++ {
+			createCall(t, repo, fmt.Sprintf("delivered%d", i), "receiver", valueobject.MorningCallStatusDelivered)
+		}
+		// 確認済み・他の受信者のコールは対象外
+		for i := 0; i < 100; i++ {
+			createCall(t, repo, fmt.Sprintf("confirmed%d", i), "receiver", valueobject.MorningCallStatusConfirmed)
+			createCall(t, repo, fmt.Sprintf("other%d", i), "other", valueobject.MorningCallStatusDelivered)
+		}
+		uc := NewUnconfirmedCountUseCase(repo)
+
+		output, err := uc.Execute(ctx, UnconfirmedCountInput{ReceiverID: "receiver"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.Count != delivered {
+			t.Errorf("Count = %d, want %d", output.Count, delivered)
+		}
+	})
+
+	t.Run("受信者IDが空の場合はエラー", func(t *testing.T) {
+		uc := NewUnconfirmedCountUseCase(memory.NewMorningCallRepository())
+
+		_, err := uc.Execute(ctx, UnconfirmedCountInput{})
+		if err == nil || err.Error() != "受信者IDは必須です" {
+			t.Errorf("error = %v, want 受信者IDは必須です", err)
+		}
+	})
+}
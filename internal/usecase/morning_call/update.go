@@ -15,16 +15,19 @@ import (
 type UpdateUseCase struct {
 	morningCallRepo repository.MorningCallRepository
 	userRepo        repository.UserRepository
+	limits          valueobject.InputLimits
 }
 
 // NewUpdateUseCase は新しいモーニングコール更新ユースケースを作成する
 func NewUpdateUseCase(
 	morningCallRepo repository.MorningCallRepository,
 	userRepo repository.UserRepository,
+	limits valueobject.InputLimits,
 ) *UpdateUseCase {
 	return &UpdateUseCase{
 		morningCallRepo: morningCallRepo,
 		userRepo:        userRepo,
+		limits:          limits,
 	}
 }
 
@@ -78,43 +81,31 @@ func (uc *UpdateUseCase) Execute(ctx context.Context, input UpdateInput) (*Updat
 		// まず時刻の妥当性を検証（ドメインロジックでの検証）
 		oldTime := morningCall.ScheduledTime
 		morningCall.ScheduledTime = *input.ScheduledTime
-		if reason := morningCall.ValidateScheduledTime(); reason != "" {
+		if reason := morningCall.ValidateScheduledTime(); reason.IsNG() {
 			morningCall.ScheduledTime = oldTime // ロールバック
-			return nil, fmt.Errorf("%s", reason)
+			return nil, fmt.Errorf("%w", reason)
 		}
 		morningCall.ScheduledTime = oldTime // 一旦元に戻す
 
 		// 時刻の重複チェック（自身を除く）
-		activeCalls, err := uc.morningCallRepo.FindActiveByUserPair(ctx, morningCall.SenderID, morningCall.ReceiverID)
+		conflicted, err := hasNearbyActiveCall(ctx, uc.morningCallRepo, morningCall, *input.ScheduledTime)
 		if err != nil {
-			return nil, fmt.Errorf("既存のモーニングコール確認中にエラーが発生しました: %w", err)
+			return nil, err
 		}
-
-		for _, call := range activeCalls {
-			// 自身は除外
-			if call.ID == morningCall.ID {
-				continue
-			}
-			// 時刻が1分以内の場合は重複とみなす
-			timeDiff := call.ScheduledTime.Sub(*input.ScheduledTime)
-			if timeDiff < 0 {
-				timeDiff = -timeDiff
-			}
-			if timeDiff < time.Minute {
-				return nil, fmt.Errorf("同じ時刻付近に既にモーニングコールが設定されています")
-			}
+		if conflicted {
+			return nil, fmt.Errorf("同じ時刻付近に既にモーニングコールが設定されています")
 		}
 
 		// 時刻を更新
-		if reason := morningCall.UpdateScheduledTime(*input.ScheduledTime); reason != "" {
-			return nil, fmt.Errorf("時刻の更新に失敗しました: %s", reason)
+		if reason := morningCall.UpdateScheduledTime(*input.ScheduledTime); reason.IsNG() {
+			return nil, fmt.Errorf("時刻の更新に失敗しました: %w", reason)
 		}
 	}
 
 	// メッセージの更新
 	if input.Message != nil {
-		if reason := morningCall.UpdateMessage(*input.Message); reason != "" {
-			return nil, fmt.Errorf("メッセージの更新に失敗しました: %s", reason)
+		if reason := morningCall.UpdateMessage(*input.Message, uc.limits); reason.IsNG() {
+			return nil, fmt.Errorf("メッセージの更新に失敗しました: %w", reason)
 		}
 	}
 
@@ -127,3 +118,27 @@ func (uc *UpdateUseCase) Execute(ctx context.Context, input UpdateInput) (*Updat
 		MorningCall: morningCall,
 	}, nil
 }
+
+// hasNearbyActiveCall は同じ送信者・受信者間に指定時刻の前後1分以内のアクティブなコールがあるかを判定する（自身を除く）
+func hasNearbyActiveCall(ctx context.Context, morningCallRepo repository.MorningCallRepository, morningCall *entity.MorningCall, scheduledTime time.Time) (bool, error) {
+	activeCalls, err := morningCallRepo.FindActiveByUserPair(ctx, morningCall.SenderID, morningCall.ReceiverID)
+	if err != nil {
+		return false, fmt.Errorf("既存のモーニングコール確認中にエラーが発生しました: %w", err)
+	}
+
+	for _, call := range activeCalls {
+		// 自身は除外
+		if call.ID == morningCall.ID {
+			continue
+		}
+		// 時刻が1分以内の場合は重複とみなす
+		timeDiff := call.ScheduledTime.Sub(scheduledTime)
+		if timeDiff < 0 {
+			timeDiff = -timeDiff
+		}
+		if timeDiff < time.Minute {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,82 @@
+package morning_call
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// UpdateSeriesUseCase はシリーズに属するモーニングコールのメッセージをまとめて更新するユースケース
+type UpdateSeriesUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	limits          valueobject.InputLimits
+}
+
+// NewUpdateSeriesUseCase は新しいシリーズ更新ユースケースを作成する
+func NewUpdateSeriesUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	limits valueobject.InputLimits,
+) *UpdateSeriesUseCase {
+	return &UpdateSeriesUseCase{
+		morningCallRepo: morningCallRepo,
+		limits:          limits,
+	}
+}
+
+// UpdateSeriesInput はシリーズ更新の入力データ
+type UpdateSeriesInput struct {
+	SeriesID string
+	SenderID string // 更新権限確認用
+	Message  string // 新しいメッセージ（空の場合はメッセージなしにする）
+}
+
+// UpdateSeriesOutput はシリーズ更新の出力データ
+type UpdateSeriesOutput struct {
+	MorningCalls []*entity.MorningCall // 更新後のシリーズのコール（アラーム時刻の昇順）
+	Updated      int                   // 更新した件数
+	Skipped      []SeriesSkip          // 対象外になったコールと理由
+}
+
+// Execute はシリーズのうちスケジュール済みのモーニングコールのメッセージを更新する
+// 配信済み・確認済みなどのコールは変更せず、理由とともにSkippedで返す
+func (uc *UpdateSeriesUseCase) Execute(ctx context.Context, input UpdateSeriesInput) (*UpdateSeriesOutput, error) {
+	// メッセージはシリーズ全体に共通のため、個別のコールを変更する前に検証する
+	if reason := (&entity.MorningCall{Message: input.Message}).ValidateMessage(uc.limits); reason.IsNG() {
+		return nil, fmt.Errorf("メッセージの検証に失敗しました: %w", reason)
+	}
+
+	morningCalls, err := findSeries(ctx, uc.morningCallRepo, input.SeriesID, input.SenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 送信者の確認（送信者のみが更新可能）
+	if morningCalls[0].SenderID != input.SenderID {
+		return nil, fmt.Errorf("送信者のみがシリーズを更新できます")
+	}
+
+	output := &UpdateSeriesOutput{
+		MorningCalls: morningCalls,
+		Skipped:      []SeriesSkip{},
+	}
+	for _, mc := range morningCalls {
+		if mc.IsDeleted() || mc.Status != valueobject.MorningCallStatusScheduled {
+			output.Skipped = append(output.Skipped, SeriesSkip{MorningCallID: mc.ID, Reason: seriesSkipReason(mc)})
+			continue
+		}
+		if reason := mc.UpdateMessage(input.Message, uc.limits); reason.IsNG() {
+			output.Skipped = append(output.Skipped, SeriesSkip{MorningCallID: mc.ID, Reason: reason.Error()})
+			continue
+		}
+		if err := uc.morningCallRepo.Update(ctx, mc); err != nil {
+			output.Skipped = append(output.Skipped, SeriesSkip{MorningCallID: mc.ID, Reason: seriesSaveFailureReason(err)})
+			continue
+		}
+		output.Updated++
+	}
+
+	return output, nil
+}
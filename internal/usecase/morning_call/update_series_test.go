@@ -0,0 +1,82 @@
+package morning_call
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+func TestUpdateSeriesUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	limits := valueobject.DefaultInputLimits()
+
+	t.Run("スケジュール済みのコールのみメッセージを更新する", func(t *testing.T) {
+		morningCallRepo := setupSeriesTest(t)
+		uc := NewUpdateSeriesUseCase(morningCallRepo, limits)
+
+		output, err := uc.Execute(ctx, UpdateSeriesInput{SeriesID: "series1", SenderID: "user1", Message: "おはよう"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.Updated != 1 {
+			t.Errorf("Updated = %d, want 1", output.Updated)
+		}
+
+		wantSkipped := map[string]string{
+			"mc1": "起床確認済みのため対象外です",
+			"mc3": "受信者の承認待ちのため対象外です",
+		}
+		if len(output.Skipped) != len(wantSkipped) {
+			t.Fatalf("Skipped = %+v, want %d entries", output.Skipped, len(wantSkipped))
+		}
+		for _, skip := range output.Skipped {
+			if wantSkipped[skip.MorningCallID] != skip.Reason {
+				t.Errorf("skip reason for %s = %q, want %q", skip.MorningCallID, skip.Reason, wantSkipped[skip.MorningCallID])
+			}
+		}
+
+		want := map[string]string{"mc1": "", "mc2": "おはよう", "mc3": "", "other": ""}
+		for id, message := range want {
+			mc, _ := morningCallRepo.FindByID(ctx, id)
+			if mc.Message != message {
+				t.Errorf("%s message = %q, want %q", id, mc.Message, message)
+			}
+		}
+	})
+
+	t.Run("長すぎるメッセージはどのコールも変更しない", func(t *testing.T) {
+		morningCallRepo := setupSeriesTest(t)
+		uc := NewUpdateSeriesUseCase(morningCallRepo, limits)
+
+		_, err := uc.Execute(ctx, UpdateSeriesInput{
+			SeriesID: "series1",
+			SenderID: "user1",
+			Message:  strings.Repeat("あ", limits.MessageMaxLength+1),
+		})
+		if err == nil {
+			t.Fatal("expected error for too long message")
+		}
+		mc, _ := morningCallRepo.FindByID(ctx, "mc2")
+		if mc.Message != "" {
+			t.Errorf("mc2 message = %q, want unchanged", mc.Message)
+		}
+	})
+
+	t.Run("受信者はシリーズを更新できない", func(t *testing.T) {
+		uc := NewUpdateSeriesUseCase(setupSeriesTest(t), limits)
+		_, err := uc.Execute(ctx, UpdateSeriesInput{SeriesID: "series1", SenderID: "user2", Message: "おはよう"})
+		if err == nil || err.Error() != "送信者のみがシリーズを更新できます" {
+			t.Errorf("error = %v, want forbidden", err)
+		}
+	})
+
+	t.Run("関係のないユーザーには存在を明かさない", func(t *testing.T) {
+		uc := NewUpdateSeriesUseCase(setupSeriesTest(t), limits)
+		_, err := uc.Execute(ctx, UpdateSeriesInput{SeriesID: "series1", SenderID: "user3", Message: "おはよう"})
+		if err == nil || err.Error() != "シリーズが見つかりません" {
+			t.Errorf("error = %v, want not found", err)
+		}
+	})
+}
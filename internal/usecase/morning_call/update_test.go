@@ -15,7 +15,7 @@ func TestNewUpdateUseCase(t *testing.T) {
 	morningCallRepo := memory.NewMorningCallRepository()
 	userRepo := memory.NewUserRepository()
 
-	uc := NewUpdateUseCase(morningCallRepo, userRepo)
+	uc := NewUpdateUseCase(morningCallRepo, userRepo, valueobject.DefaultInputLimits())
 
 	if uc == nil {
 		t.Fatal("NewUpdateUseCase returned nil")
@@ -234,7 +234,7 @@ func TestUpdateUseCase_Execute(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			uc := NewUpdateUseCase(morningCallRepo, userRepo)
+			uc := NewUpdateUseCase(morningCallRepo, userRepo, valueobject.DefaultInputLimits())
 			output, err := uc.Execute(ctx, tt.input)
 
 			if tt.wantErr {
@@ -335,7 +335,7 @@ func TestUpdateUseCase_Execute_DuplicateTime(t *testing.T) {
 		t.Fatalf("failed to create morning call 2: %v", err)
 	}
 
-	uc := NewUpdateUseCase(morningCallRepo, userRepo)
+	uc := NewUpdateUseCase(morningCallRepo, userRepo, valueobject.DefaultInputLimits())
 
 	// モーニングコール2を1と同じ時刻付近に更新しようとする（30秒差）
 	duplicateTime := scheduledTime1.Add(30 * time.Second)
@@ -418,7 +418,7 @@ func TestUpdateUseCase_Execute_SelfUpdate(t *testing.T) {
 		t.Fatalf("failed to create morning call: %v", err)
 	}
 
-	uc := NewUpdateUseCase(morningCallRepo, userRepo)
+	uc := NewUpdateUseCase(morningCallRepo, userRepo, valueobject.DefaultInputLimits())
 
 	// 同じ時刻への更新（自身なので重複チェックに引っかからない）
 	sameTime := scheduledTime
@@ -0,0 +1,82 @@
+package morning_call
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// ValidateMessageUseCase はモーニングコールのメッセージを送信前に検証するユースケース
+type ValidateMessageUseCase struct {
+	bannedWords []string // 小文字に正規化した禁止語
+	limits      valueobject.InputLimits
+}
+
+// NewValidateMessageUseCase は新しいメッセージ事前検証ユースケースを作成する
+func NewValidateMessageUseCase(bannedWords []string, limits valueobject.InputLimits) *ValidateMessageUseCase {
+	normalized := make([]string, 0, len(bannedWords))
+	for _, w := range bannedWords {
+		w = strings.TrimSpace(w)
+		if w == "" {
+			continue
+		}
+		normalized = append(normalized, strings.ToLower(w))
+	}
+	return &ValidateMessageUseCase{
+		bannedWords: normalized,
+		limits:      limits,
+	}
+}
+
+// ValidateMessageInput はメッセージ事前検証の入力データ
+type ValidateMessageInput struct {
+	Message string
+}
+
+// ValidateMessageOutput はメッセージ事前検証の出力データ
+type ValidateMessageOutput struct {
+	Valid       bool     // すべての検証を通過したか
+	CharCount   int      // 文字数（絵文字・サロゲートペアも1文字として数える）
+	MaxLength   int      // 最大文字数
+	WithinLimit bool     // 文字数制限内か
+	BannedWords []string // メッセージに含まれていた禁止語
+	Reasons     []string // 検証に失敗した理由
+}
+
+// Execute はメッセージの文字数と禁止語を検証する
+// 検証結果は出力として返し、エラーは入力自体が不正な場合のみ返す
+func (uc *ValidateMessageUseCase) Execute(ctx context.Context, input ValidateMessageInput) (*ValidateMessageOutput, error) {
+	_ = ctx // 将来的な外部サービス連携のために保持
+
+	output := &ValidateMessageOutput{
+		CharCount:   entity.CountMessageLength(input.Message),
+		MaxLength:   uc.limits.MessageMaxLength,
+		BannedWords: []string{},
+		Reasons:     []string{},
+	}
+
+	// 文字数の検証はエンティティのルールを使用
+	mc := &entity.MorningCall{Message: input.Message}
+	if reason := mc.ValidateMessage(uc.limits); reason.IsNG() {
+		output.Reasons = append(output.Reasons, reason.Error())
+	} else {
+		output.WithinLimit = true
+	}
+
+	// 禁止語の検証（大文字小文字を区別しない）
+	lowerMessage := strings.ToLower(input.Message)
+	for _, word := range uc.bannedWords {
+		if strings.Contains(lowerMessage, word) {
+			output.BannedWords = append(output.BannedWords, word)
+		}
+	}
+	if len(output.BannedWords) > 0 {
+		output.Reasons = append(output.Reasons, fmt.Sprintf("メッセージに使用できない語句が含まれています: %s", strings.Join(output.BannedWords, ", ")))
+	}
+
+	output.Valid = len(output.Reasons) == 0
+	return output, nil
+}
@@ -0,0 +1,119 @@
+package morning_call
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+func TestNewValidateMessageUseCase(t *testing.T) {
+	uc := NewValidateMessageUseCase([]string{" Spam ", "", "バカ"}, valueobject.DefaultInputLimits())
+
+	if uc == nil {
+		t.Fatal("NewValidateMessageUseCase returned nil")
+	}
+	if len(uc.bannedWords) != 2 {
+		t.Fatalf("bannedWords = %v, want 2 entries", uc.bannedWords)
+	}
+	if uc.bannedWords[0] != "spam" {
+		t.Errorf("禁止語が正規化されていない: %s", uc.bannedWords[0])
+	}
+}
+
+func TestValidateMessageUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	uc := NewValidateMessageUseCase([]string{"spam", "バカ"}, valueobject.DefaultInputLimits())
+
+	tests := []struct {
+		name            string
+		message         string
+		wantValid       bool
+		wantCharCount   int
+		wantWithinLimit bool
+		wantBanned      []string
+	}{
+		{
+			name:            "通常のメッセージ",
+			message:         "おはよう！",
+			wantValid:       true,
+			wantCharCount:   5,
+			wantWithinLimit: true,
+			wantBanned:      []string{},
+		},
+		{
+			name:            "空のメッセージ",
+			message:         "",
+			wantValid:       true,
+			wantCharCount:   0,
+			wantWithinLimit: true,
+			wantBanned:      []string{},
+		},
+		{
+			name:            "絵文字500文字ちょうど",
+			message:         strings.Repeat("😀", 500),
+			wantValid:       true,
+			wantCharCount:   500,
+			wantWithinLimit: true,
+			wantBanned:      []string{},
+		},
+		{
+			name:            "サロゲートペア文字で501文字",
+			message:         strings.Repeat("𠮷", 501),
+			wantValid:       false,
+			wantCharCount:   501,
+			wantWithinLimit: false,
+			wantBanned:      []string{},
+		},
+		{
+			name:            "禁止語を含む（大文字小文字を区別しない）",
+			message:         "This is SPAM",
+			wantValid:       false,
+			wantCharCount:   12,
+			wantWithinLimit: true,
+			wantBanned:      []string{"spam"},
+		},
+		{
+			name:            "文字数超過かつ禁止語を含む",
+			message:         "バカ" + strings.Repeat("あ", 499),
+			wantValid:       false,
+			wantCharCount:   501,
+			wantWithinLimit: false,
+			wantBanned:      []string{"バカ"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := uc.Execute(ctx, ValidateMessageInput{Message: tt.message})
+			if err != nil {
+				t.Fatalf("予期しないエラー: %v", err)
+			}
+
+			if output.Valid != tt.wantValid {
+				t.Errorf("Valid = %v, want %v (reasons: %v)", output.Valid, tt.wantValid, output.Reasons)
+			}
+			if output.CharCount != tt.wantCharCount {
+				t.Errorf("CharCount = %d, want %d", output.CharCount, tt.wantCharCount)
+			}
+			if output.MaxLength != 500 {
+				t.Errorf("MaxLength = %d, want 500", output.MaxLength)
+			}
+			if output.WithinLimit != tt.wantWithinLimit {
+				t.Errorf("WithinLimit = %v, want %v", output.WithinLimit, tt.wantWithinLimit)
+			}
+			if len(output.BannedWords) != len(tt.wantBanned) {
+				t.Fatalf("BannedWords = %v, want %v", output.BannedWords, tt.wantBanned)
+			}
+			for i, w := range tt.wantBanned {
+				if output.BannedWords[i] != w {
+					t.Errorf("BannedWords[%d] = %s, want %s", i, output.BannedWords[i], w)
+				}
+			}
+			if output.Valid && len(output.Reasons) != 0 {
+				t.Errorf("有効なのに理由が設定されている: %v", output.Reasons)
+			}
+		})
+	}
+}
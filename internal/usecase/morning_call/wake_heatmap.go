@@ -0,0 +1,171 @@
+package morning_call
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+const (
+	// DefaultWakeHeatmapDays は期間が未指定の場合の集計日数（今日を含む）
+	DefaultWakeHeatmapDays = 365
+	// MaxWakeHeatmapDays は集計期間として指定できる最大日数（うるう年の1年分を含められるよう366日）
+	MaxWakeHeatmapDays = 366
+
+	// WakeHeatmapDateLayout はヒートマップの日付キーと期間の開始日・終了日の形式
+	WakeHeatmapDateLayout = "2006-01-02"
+	// wakeHeatmapBatchSize は受信したコールを一度に取得する件数
+	wakeHeatmapBatchSize = 500
+)
+
+// WakeHeatmapUseCase は受信者が起床確認したモーニングコールを日別に数えるユースケース
+// GitHubのcontributionグラフのような起床ヒートマップの表示に用いる
+type WakeHeatmapUseCase struct {
+	morningCallRepo repository.MorningCallRepository
+	userRepo        repository.UserRepository
+	now             func() time.Time
+}
+
+// NewWakeHeatmapUseCase は新しい起床ヒートマップ取得ユースケースを作成する
+func NewWakeHeatmapUseCase(
+	morningCallRepo repository.MorningCallRepository,
+	userRepo repository.UserRepository,
+) *WakeHeatmapUseCase {
+	return &WakeHeatmapUseCase{
+		morningCallRepo: morningCallRepo,
+		userRepo:        userRepo,
+		now:             time.Now,
+	}
+}
+
+// WakeHeatmapInput は起床ヒートマップ取得の入力データ
+// 期間はYearまたはFrom・Toのいずれかで指定し、どちらも未指定の場合は今日までの365日とする
+type WakeHeatmapInput struct {
+	UserID       string // 必須：受信者のID
+	Year         int    // オプション：集計する年（ユーザーのタイムゾーンでの1月1日から12月31日）
+	From         string // オプション：集計開始日（YYYY-MM-DD、ユーザーのタイムゾーン）
+	To           string // オプション：集計終了日（YYYY-MM-DD、この日を含む。デフォルトは今日）
+	IncludeEmpty bool   // trueの場合、確認件数が0の日も0として含める
+}
+
+// WakeHeatmapOutput は起床ヒートマップ取得の出力データ
+type WakeHeatmapOutput struct {
+	From     time.Time      // 集計開始日の0時（ユーザーのタイムゾーン）
+	To       time.Time      // 集計終了日の翌日0時（この時刻を含まない）
+	Location *time.Location // 日付キーの基準にしたタイムゾーン
+	Days     map[string]int // 日付（YYYY-MM-DD）-> 起床確認した件数
+	Total    int            // 期間内の起床確認の合計件数
+}
+
+// Execute は期間内に起床確認したモーニングコールをアラーム時刻の日付ごとに数える
+// 日付はユーザーのタイムゾーンで、受信者のずらし幅を反映したアラーム時刻から決める
+// 自動確認されたコールと管理者により削除されたコールは数えない
+func (uc *WakeHeatmapUseCase) Execute(ctx context.Context, input WakeHeatmapInput) (*WakeHeatmapOutput, error) {
+	// 入力値の基本検証
+	if input.UserID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+
+	// ユーザーの存在確認（日付はユーザーのタイムゾーンで解釈する）
+	user, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("ユーザーの確認中にエラーが発生しました: %w", err)
+	}
+	loc := user.Location()
+
+	start, end, reason := uc.resolvePeriod(input, loc)
+	if reason.IsNG() {
+		return nil, fmt.Errorf("集計期間が不正です: %w", reason)
+	}
+
+	days := make(map[string]int)
+	if input.IncludeEmpty {
+		for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+			days[d.Format(WakeHeatmapDateLayout)] = 0
+		}
+	}
+
+	total := 0
+	for offset := 0; ; offset += wakeHeatmapBatchSize {
+		calls, err := uc.morningCallRepo.FindByReceiverID(ctx, input.UserID, offset, wakeHeatmapBatchSize)
+		if err != nil {
+			return nil, fmt.Errorf("モーニングコールの取得中にエラーが発生しました: %w", err)
+		}
+		for _, mc := range calls {
+			if !countsAsWake(mc) {
+				continue
+			}
+			at := mc.EffectiveScheduledTime()
+			if at.Before(start) || !at.Before(end) {
+				continue
+			}
+			days[at.In(loc).Format(WakeHeatmapDateLayout)]++
+			total++
+		}
+		if len(calls) < wakeHeatmapBatchSize {
+			break
+		}
+	}
+
+	return &WakeHeatmapOutput{
+		From:     start,
+		To:       end,
+		Location: loc,
+		Days:     days,
+		Total:    total,
+	}, nil
+}
+
+// countsAsWake はコールを起床ヒートマップに数えるかを判定する
+func countsAsWake(mc *entity.MorningCall) bool {
+	return mc.Status == valueobject.MorningCallStatusConfirmed && !mc.AutoConfirmed && !mc.IsDeleted()
+}
+
+// resolvePeriod は年または開始日・終了日から集計期間 [start, end) を求める
+func (uc *WakeHeatmapUseCase) resolvePeriod(input WakeHeatmapInput, loc *time.Location) (time.Time, time.Time, valueobject.NGReason) {
+	now := uc.now().In(loc)
+
+	if input.Year != 0 {
+		if input.From != "" || input.To != "" {
+			return time.Time{}, time.Time{}, valueobject.NGWithCode(valueobject.ReasonCodeInvalid, "year", "yearとfrom・toは同時に指定できません")
+		}
+		if input.Year < 1 || input.Year > now.Year() {
+			return time.Time{}, time.Time{}, valueobject.NGWithCode(valueobject.ReasonCodeOutOfRange, "year", fmt.Sprintf("年は%d年以前を指定してください", now.Year()))
+		}
+		start := time.Date(input.Year, time.January, 1, 0, 0, 0, 0, loc)
+		return start, start.AddDate(1, 0, 0), valueobject.OK()
+	}
+
+	endDate := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	if input.To != "" {
+		parsed, err := time.ParseInLocation(WakeHeatmapDateLayout, input.To, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, valueobject.NGWithCode(valueobject.ReasonCodeInvalidFormat, "to", "終了日は YYYY-MM-DD の形式で指定してください")
+		}
+		endDate = parsed
+	}
+	startDate := endDate.AddDate(0, 0, -(DefaultWakeHeatmapDays - 1))
+	if input.From != "" {
+		parsed, err := time.ParseInLocation(WakeHeatmapDateLayout, input.From, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, valueobject.NGWithCode(valueobject.ReasonCodeInvalidFormat, "from", "開始日は YYYY-MM-DD の形式で指定してください")
+		}
+		startDate = parsed
+	}
+
+	if endDate.Before(startDate) {
+		return time.Time{}, time.Time{}, valueobject.NGWithCode(valueobject.ReasonCodeOutOfRange, "to", "終了日は開始日以降の日付を指定してください")
+	}
+	if startDate.AddDate(0, 0, MaxWakeHeatmapDays).Before(endDate.AddDate(0, 0, 1)) {
+		return time.Time{}, time.Time{}, valueobject.NGWithCode(valueobject.ReasonCodeOutOfRange, "from", fmt.Sprintf("集計期間は%d日以内で指定してください", MaxWakeHeatmapDays))
+	}
+	return startDate, endDate.AddDate(0, 0, 1), valueobject.OK()
+}
@@ -0,0 +1,185 @@
+package morning_call
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestWakeHeatmapUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, tokyo)
+
+	morningCallRepo := memory.NewMorningCallRepository()
+	userRepo := memory.NewUserRepository()
+	for _, u := range []*entity.User{
+		{ID: "alice", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password", TimeZone: "Asia/Tokyo"},
+		{ID: "bob", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password", TimeZone: "Asia/Tokyo"},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+
+	deletedAt := now
+	calls := []struct {
+		receiverID    string
+		scheduled     time.Time
+		status        valueobject.MorningCallStatus
+		autoConfirmed bool
+		deletedAt     *time.Time
+	}{
+		{receiverID: "alice", scheduled: time.Date(2026, 3, 10, 7, 0, 0, 0, tokyo), status: valueobject.MorningCallStatusConfirmed},
+		{receiverID: "alice", scheduled: time.Date(2026, 3, 10, 8, 0, 0, 0, tokyo), status: valueobject.MorningCallStatusConfirmed},
+		// UTCでは3月14日だが、東京では3月15日
+		{receiverID: "alice", scheduled: time.Date(2026, 3, 14, 22, 30, 0, 0, time.UTC), status: valueobject.MorningCallStatusConfirmed},
+		// 確認済み以外・自動確認・削除済みは数えない
+		{receiverID: "alice", scheduled: time.Date(2026, 3, 11, 7, 0, 0, 0, tokyo), status: valueobject.MorningCallStatusDelivered},
+		{receiverID: "alice", scheduled: time.Date(2026, 3, 11, 7, 0, 0, 0, tokyo), status: valueobject.MorningCallStatusConfirmed, autoConfirmed: true},
+		{receiverID: "alice", scheduled: time.Date(2026, 3, 11, 7, 0, 0, 0, tokyo), status: valueobject.MorningCallStatusConfirmed, deletedAt: &deletedAt},
+		// 他のユーザー宛は数えない
+		{receiverID: "bob", scheduled: time.Date(2026, 3, 10, 7, 0, 0, 0, tokyo), status: valueobject.MorningCallStatusConfirmed},
+		// デフォルトの集計期間（今日までの365日）より前
+		{receiverID: "alice", scheduled: time.Date(2025, 1, 1, 7, 0, 0, 0, tokyo), status: valueobject.MorningCallStatusConfirmed},
+	}
+	for i, c := range calls {
+		mc := &entity.MorningCall{
+			ID:            fmt.Sprintf("mc%d", i),
+			SenderID:      "sender",
+			ReceiverID:    c.receiverID,
+			ScheduledTime: c.scheduled,
+			Status:        c.status,
+			AutoConfirmed: c.autoConfirmed,
+			DeletedAt:     c.deletedAt,
+			CreatedAt:     c.scheduled.Add(-24 * time.Hour),
+			UpdatedAt:     c.scheduled,
+		}
+		if err := morningCallRepo.Create(ctx, mc); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+
+	uc := NewWakeHeatmapUseCase(morningCallRepo, userRepo)
+	uc.now = func() time.Time { return now }
+
+	tests := []struct {
+		name      string
+		input     WakeHeatmapInput
+		wantDays  map[string]int // IncludeEmptyの場合は0でない日のみ
+		wantLen   int
+		wantTotal int
+		wantFrom  time.Time
+	}{
+		{
+			name:      "デフォルトは今日までの365日",
+			input:     WakeHeatmapInput{UserID: "alice"},
+			wantDays:  map[string]int{"2026-03-10": 2, "2026-03-15": 1},
+			wantLen:   2,
+			wantTotal: 3,
+			wantFrom:  time.Date(2025, 3, 16, 0, 0, 0, 0, tokyo),
+		},
+		{
+			name:      "データのない日を0で含める",
+			input:     WakeHeatmapInput{UserID: "alice", IncludeEmpty: true},
+			wantDays:  map[string]int{"2026-03-10": 2, "2026-03-15": 1},
+			wantLen:   DefaultWakeHeatmapDays,
+			wantTotal: 3,
+			wantFrom:  time.Date(2025, 3, 16, 0, 0, 0, 0, tokyo),
+		},
+		{
+			name:      "年を指定する",
+			input:     WakeHeatmapInput{UserID: "alice", Year: 2025},
+			wantDays:  map[string]int{"2025-01-01": 1},
+			wantLen:   1,
+			wantTotal: 1,
+			wantFrom:  time.Date(2025, 1, 1, 0, 0, 0, 0, tokyo),
+		},
+		{
+			name:      "うるう年を0で埋める",
+			input:     WakeHeatmapInput{UserID: "alice", Year: 2024, IncludeEmpty: true},
+			wantDays:  map[string]int{},
+			wantLen:   366,
+			wantTotal: 0,
+			wantFrom:  time.Date(2024, 1, 1, 0, 0, 0, 0, tokyo),
+		},
+		{
+			name:      "開始日と終了日を指定する",
+			input:     WakeHeatmapInput{UserID: "alice", From: "2026-03-11", To: "2026-03-15"},
+			wantDays:  map[string]int{"2026-03-15": 1},
+			wantLen:   1,
+			wantTotal: 1,
+			wantFrom:  time.Date(2026, 3, 11, 0, 0, 0, 0, tokyo),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := uc.Execute(ctx, tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !output.From.Equal(tt.wantFrom) {
+				t.Errorf("From = %v, want %v", output.From, tt.wantFrom)
+			}
+			if len(output.Days) != tt.wantLen {
+				t.Errorf("len(Days) = %d, want %d", len(output.Days), tt.wantLen)
+			}
+			if output.Total != tt.wantTotal {
+				t.Errorf("Total = %d, want %d", output.Total, tt.wantTotal)
+			}
+			for date, count := range output.Days {
+				if count != tt.wantDays[date] {
+					t.Errorf("Days[%s] = %d, want %d", date, count, tt.wantDays[date])
+				}
+			}
+			for date, count := range tt.wantDays {
+				if output.Days[date] != count {
+					t.Errorf("Days[%s] = %d, want %d", date, output.Days[date], count)
+				}
+			}
+		})
+	}
+}
+
+func TestWakeHeatmapUseCase_Execute_InvalidPeriod(t *testing.T) {
+	ctx := context.Background()
+	userRepo := memory.NewUserRepository()
+	if err := userRepo.Create(ctx, &entity.User{ID: "alice", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"}); err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	uc := NewWakeHeatmapUseCase(memory.NewMorningCallRepository(), userRepo)
+	uc.now = func() time.Time { return time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name   string
+		input  WakeHeatmapInput
+		errMsg string
+	}{
+		{"ユーザーID未指定", WakeHeatmapInput{}, "ユーザーIDは必須です"},
+		{"存在しないユーザー", WakeHeatmapInput{UserID: "unknown"}, "ユーザーが見つかりません"},
+		{"年と期間の同時指定", WakeHeatmapInput{UserID: "alice", Year: 2025, From: "2025-01-01"}, "yearとfrom・toは同時に指定できません"},
+		{"未来の年", WakeHeatmapInput{UserID: "alice", Year: 2027}, "2026年以前を指定してください"},
+		{"不正な日付形式", WakeHeatmapInput{UserID: "alice", From: "2026/03/01"}, "開始日は YYYY-MM-DD の形式で指定してください"},
+		{"終了日が開始日より前", WakeHeatmapInput{UserID: "alice", From: "2026-03-10", To: "2026-03-09"}, "終了日は開始日以降の日付を指定してください"},
+		{"期間の上限を超える", WakeHeatmapInput{UserID: "alice", From: "2025-01-01", To: "2026-01-02"}, "集計期間は366日以内で指定してください"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.Execute(ctx, tt.input)
+			if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("error = %v, want containing %q", err, tt.errMsg)
+			}
+		})
+	}
+}
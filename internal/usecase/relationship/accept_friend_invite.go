@@ -0,0 +1,131 @@
+package relationship
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/pkg/utils"
+)
+
+// friendInviteUpdateAttempts は招待トークンの更新が競合した場合に試行する回数
+const friendInviteUpdateAttempts = 3
+
+// AcceptFriendInviteUseCase は招待トークンを読み取ったユーザーが発行者へ友達リクエストを送信するユースケース
+type AcceptFriendInviteUseCase struct {
+	inviteRepo          repository.FriendInviteRepository
+	sendFriendRequestUC *SendFriendRequestUseCase
+	now                 func() time.Time
+}
+
+// NewAcceptFriendInviteUseCase は新しい招待トークン使用ユースケースを作成する
+// 友達リクエストの送信はSendFriendRequestUseCaseに委ね、通常の送信と同じ検証・上限を適用する
+func NewAcceptFriendInviteUseCase(
+	inviteRepo repository.FriendInviteRepository,
+	sendFriendRequestUC *SendFriendRequestUseCase,
+) *AcceptFriendInviteUseCase {
+	return &AcceptFriendInviteUseCase{
+		inviteRepo:          inviteRepo,
+		sendFriendRequestUC: sendFriendRequestUC,
+		now:                 time.Now,
+	}
+}
+
+// AcceptFriendInviteInput は招待トークン使用の入力データ
+type AcceptFriendInviteInput struct {
+	Token  string // 必須：読み取った招待トークン
+	UserID string // 必須：トークンを使用するユーザーのID（友達リクエストの送信者になる）
+}
+
+// AcceptFriendInviteOutput は招待トークン使用の出力データ
+type AcceptFriendInviteOutput struct {
+	Relationship *entity.Relationship // 送信した友達リクエスト
+}
+
+// Execute は招待トークンを1回分使用し、発行者へ友達リクエストを送信する
+// 友達リクエストを送信できなかった場合は使用を取り消し、トークンの使用回数を消費しない
+func (uc *AcceptFriendInviteUseCase) Execute(ctx context.Context, input AcceptFriendInviteInput) (*AcceptFriendInviteOutput, error) {
+	// 入力値の基本検証
+	if input.Token == "" {
+		return nil, fmt.Errorf("招待トークンは必須です")
+	}
+	if input.UserID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+
+	// 先に使用回数を確保し、同じトークンの同時使用で上限を超えないようにする
+	invite, err := uc.consume(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	sendOutput, err := uc.sendFriendRequestUC.Execute(ctx, SendFriendRequestInput{
+		RequesterID: input.UserID,
+		ReceiverID:  invite.InviterID,
+	})
+	if err != nil {
+		uc.release(ctx, input.Token)
+		return nil, err
+	}
+
+	return &AcceptFriendInviteOutput{
+		Relationship: sendOutput.Relationship,
+	}, nil
+}
+
+// consume は招待トークンを検証して使用回数を1回分加算する
+// 他の使用と更新が競合した場合は最新の状態を取得し直して再試行する
+func (uc *AcceptFriendInviteUseCase) consume(ctx context.Context, input AcceptFriendInviteInput) (*entity.FriendInvite, error) {
+	var lastErr error
+	for attempt := 0; attempt < friendInviteUpdateAttempts; attempt++ {
+		invite, err := uc.inviteRepo.FindByToken(ctx, input.Token)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return nil, fmt.Errorf("招待トークンが見つかりません")
+			}
+			return nil, fmt.Errorf("招待トークンの取得中にエラーが発生しました: %w", err)
+		}
+
+		if invite.InviterID == input.UserID {
+			return nil, fmt.Errorf("自分が発行した招待トークンは使用できません")
+		}
+		if reason := invite.Use(uc.now()); reason.IsNG() {
+			return nil, fmt.Errorf("招待トークンを使用できません: %w", reason)
+		}
+
+		err = uc.inviteRepo.Update(ctx, invite)
+		if err == nil {
+			return invite, nil
+		}
+		if !errors.Is(err, repository.ErrUpdateConflict) {
+			return nil, fmt.Errorf("招待トークンの更新に失敗しました: %w", err)
+		}
+		lastErr = err
+	}
+	return nil, fmt.Errorf("招待トークンの更新に失敗しました: %w", lastErr)
+}
+
+// release は確保した使用回数を1回分戻す
+// 失敗してもトークンの使用回数が1回分多く残るだけのため、ログに記録して処理を続ける
+func (uc *AcceptFriendInviteUseCase) release(ctx context.Context, token string) {
+	for attempt := 0; attempt < friendInviteUpdateAttempts; attempt++ {
+		invite, err := uc.inviteRepo.FindByToken(ctx, token)
+		if err != nil {
+			utils.Logf(ctx, "招待トークンの使用の取り消しに失敗しました: %v", err)
+			return
+		}
+		invite.ReleaseUse()
+		err = uc.inviteRepo.Update(ctx, invite)
+		if err == nil {
+			return
+		}
+		if !errors.Is(err, repository.ErrUpdateConflict) {
+			utils.Logf(ctx, "招待トークンの使用の取り消しに失敗しました: %v", err)
+			return
+		}
+	}
+	utils.Logf(ctx, "招待トークンの使用の取り消しが競合により完了しませんでした")
+}
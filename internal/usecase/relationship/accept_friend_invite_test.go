@@ -0,0 +1,168 @@
+package relationship
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+// setupFriendInvite は招待トークン使用テスト用のユーザーと招待を作成する
+func setupFriendInvite(t *testing.T, userCount, maxUses int, expiresAt time.Time) (*AcceptFriendInviteUseCase, *memory.FriendInviteRepository, *memory.RelationshipRepository) {
+	t.Helper()
+	ctx := context.Background()
+
+	userRepo := memory.NewUserRepository()
+	for i := 1; i <= userCount; i++ {
+		u := &entity.User{
+			ID:           fmt.Sprintf("user%d", i),
+			Username:     fmt.Sprintf("user%d", i),
+			Email:        fmt.Sprintf("user%d@example.com", i),
+			PasswordHash: "hashed_password",
+		}
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+
+	inviteRepo := memory.NewFriendInviteRepository()
+	if err := inviteRepo.Create(ctx, &entity.FriendInvite{Token: "token1", InviterID: "user1", MaxUses: maxUses, ExpiresAt: expiresAt}); err != nil {
+		t.Fatalf("failed to create invite: %v", err)
+	}
+
+	relationshipRepo := memory.NewRelationshipRepository()
+	uc := NewAcceptFriendInviteUseCase(inviteRepo, NewSendFriendRequestUseCase(relationshipRepo, userRepo, nil, valueobject.DefaultFriendRequestResendPolicy()))
+	return uc, inviteRepo, relationshipRepo
+}
+
+func TestAcceptFriendInviteUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	t.Run("発行者へ友達リクエストを送信する", func(t *testing.T) {
+		uc, inviteRepo, relationshipRepo := setupFriendInvite(t, 2, 1, now.Add(time.Hour))
+		uc.now = func() time.Time { return now }
+
+		output, err := uc.Execute(ctx, AcceptFriendInviteInput{Token: "token1", UserID: "user2"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.Relationship.RequesterID != "user2" || output.Relationship.ReceiverID != "user1" || !output.Relationship.IsPending() {
+			t.Errorf("relationship = %+v, want pending request from user2 to user1", output.Relationship)
+		}
+		if _, err := relationshipRepo.FindByUserPair(ctx, "user1", "user2"); err != nil {
+			t.Errorf("relationship was not saved: %v", err)
+		}
+		invite, _ := inviteRepo.FindByToken(ctx, "token1")
+		if invite.UseCount != 1 {
+			t.Errorf("UseCount = %d, want 1", invite.UseCount)
+		}
+	})
+
+	t.Run("使用回数の上限に達したトークンは再使用できない", func(t *testing.T) {
+		uc, _, _ := setupFriendInvite(t, 3, 1, now.Add(time.Hour))
+		uc.now = func() time.Time { return now }
+
+		if _, err := uc.Execute(ctx, AcceptFriendInviteInput{Token: "token1", UserID: "user2"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		_, err := uc.Execute(ctx, AcceptFriendInviteInput{Token: "token1", UserID: "user3"})
+		if err == nil || !strings.Contains(err.Error(), "使用回数の上限に達しています") {
+			t.Errorf("expected limit error, got %v", err)
+		}
+	})
+
+	t.Run("有効期限切れのトークンは使用できない", func(t *testing.T) {
+		uc, inviteRepo, _ := setupFriendInvite(t, 2, 1, now.Add(time.Hour))
+		uc.now = func() time.Time { return now.Add(time.Hour) }
+
+		_, err := uc.Execute(ctx, AcceptFriendInviteInput{Token: "token1", UserID: "user2"})
+		if err == nil || !strings.Contains(err.Error(), "有効期限が切れています") {
+			t.Errorf("expected expired error, got %v", err)
+		}
+		invite, _ := inviteRepo.FindByToken(ctx, "token1")
+		if invite.UseCount != 0 {
+			t.Errorf("UseCount = %d, want 0", invite.UseCount)
+		}
+	})
+
+	t.Run("友達リクエストを送信できない場合は使用回数を消費しない", func(t *testing.T) {
+		uc, inviteRepo, _ := setupFriendInvite(t, 3, 2, now.Add(time.Hour))
+		uc.now = func() time.Time { return now }
+
+		if _, err := uc.Execute(ctx, AcceptFriendInviteInput{Token: "token1", UserID: "user2"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// 同じユーザーが重複して使用しても2通目のリクエストは送信されない
+		_, err := uc.Execute(ctx, AcceptFriendInviteInput{Token: "token1", UserID: "user2"})
+		if err == nil || !strings.Contains(err.Error(), "既に友達リクエストを送信済みです") {
+			t.Errorf("expected duplicate request error, got %v", err)
+		}
+		invite, _ := inviteRepo.FindByToken(ctx, "token1")
+		if invite.UseCount != 1 {
+			t.Errorf("UseCount = %d, want 1", invite.UseCount)
+		}
+
+		// 残りの1回は別のユーザーが使用できる
+		if _, err := uc.Execute(ctx, AcceptFriendInviteInput{Token: "token1", UserID: "user3"}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("同時に使用しても上限を超えない", func(t *testing.T) {
+		const users = 10
+		uc, inviteRepo, _ := setupFriendInvite(t, users+1, 1, now.Add(time.Hour))
+		uc.now = func() time.Time { return now }
+
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		succeeded := 0
+		for i := 2; i <= users+1; i++ {
+			wg.Add(1)
+			go func(userID string) {
+				defer wg.Done()
+				if _, err := uc.Execute(ctx, AcceptFriendInviteInput{Token: "token1", UserID: userID}); err == nil {
+					mu.Lock()
+					succeeded++
+					mu.Unlock()
+				}
+			}(fmt.Sprintf("user%d", i))
+		}
+		wg.Wait()
+
+		if succeeded != 1 {
+			t.Errorf("succeeded = %d, want 1", succeeded)
+		}
+		invite, _ := inviteRepo.FindByToken(ctx, "token1")
+		if invite.UseCount != 1 {
+			t.Errorf("UseCount = %d, want 1", invite.UseCount)
+		}
+	})
+
+	errorTests := []struct {
+		name    string
+		input   AcceptFriendInviteInput
+		wantErr string
+	}{
+		{name: "トークン未指定", input: AcceptFriendInviteInput{UserID: "user2"}, wantErr: "招待トークンは必須です"},
+		{name: "ユーザーID未指定", input: AcceptFriendInviteInput{Token: "token1"}, wantErr: "ユーザーIDは必須です"},
+		{name: "存在しないトークン", input: AcceptFriendInviteInput{Token: "unknown", UserID: "user2"}, wantErr: "招待トークンが見つかりません"},
+		{name: "自分が発行したトークン", input: AcceptFriendInviteInput{Token: "token1", UserID: "user1"}, wantErr: "自分が発行した招待トークンは使用できません"},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, _, _ := setupFriendInvite(t, 2, 1, now.Add(time.Hour))
+			uc.now = func() time.Time { return now }
+
+			if _, err := uc.Execute(ctx, tt.input); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -15,16 +15,24 @@ import (
 type AcceptFriendRequestUseCase struct {
 	relationshipRepo repository.RelationshipRepository
 	userRepo         repository.UserRepository
+	quotas           valueobject.PlanQuotas
+	txManager        repository.TransactionManager
 }
 
 // NewAcceptFriendRequestUseCase は新しい友達リクエスト承認ユースケースを作成する
+// quotasがnilの場合はプラン別の上限を適用しない
+// txManagerがnilの場合はトランザクションを使用せずリポジトリを直接更新する
 func NewAcceptFriendRequestUseCase(
 	relationshipRepo repository.RelationshipRepository,
 	userRepo repository.UserRepository,
+	quotas valueobject.PlanQuotas,
+	txManager repository.TransactionManager,
 ) *AcceptFriendRequestUseCase {
 	return &AcceptFriendRequestUseCase{
 		relationshipRepo: relationshipRepo,
 		userRepo:         userRepo,
+		quotas:           quotas,
+		txManager:        txManager,
 	}
 }
 
@@ -58,8 +66,18 @@ func (uc *AcceptFriendRequestUseCase) Execute(ctx context.Context, input AcceptF
 		return nil, fmt.Errorf("承認者の確認中にエラーが発生しました: %w", err)
 	}
 
+	// トランザクションを開始（取得から更新までを原子的に行う）
+	repos, tx, err := beginTx(ctx, uc.txManager, repository.TxRepositories{
+		User:         uc.userRepo,
+		Relationship: uc.relationshipRepo,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("トランザクションの開始に失敗しました: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // コミット済みの場合は何もしない
+
 	// 関係の取得
-	relationship, err := uc.relationshipRepo.FindByID(ctx, input.RelationshipID)
+	relationship, err := repos.Relationship.FindByID(ctx, input.RelationshipID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, fmt.Errorf("友達リクエストが見つかりません")
@@ -87,7 +105,7 @@ func (uc *AcceptFriendRequestUseCase) Execute(ctx context.Context, input AcceptF
 	}
 
 	// リクエスト送信者の存在確認（データ整合性のため）
-	requester, err := uc.userRepo.FindByID(ctx, relationship.RequesterID)
+	requester, err := repos.User.FindByID(ctx, relationship.RequesterID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, fmt.Errorf("リクエスト送信者が見つかりません")
@@ -95,16 +113,25 @@ func (uc *AcceptFriendRequestUseCase) Execute(ctx context.Context, input AcceptF
 		return nil, fmt.Errorf("リクエスト送信者の確認中にエラーが発生しました: %w", err)
 	}
 
+	// プラン別クォータの確認（承認すると承認者の友達が1人増えるため、承認者の上限を確認する）
+	if err := checkFriendQuota(ctx, repos.Relationship, uc.quotas, receiver); err != nil {
+		return nil, err
+	}
+
 	// 承認処理を実行
 	if reason := relationship.Accept(); reason.IsNG() {
-		return nil, fmt.Errorf("友達リクエストの承認に失敗しました: %s", reason)
+		return nil, fmt.Errorf("友達リクエストの承認に失敗しました: %w", reason)
 	}
 
 	// 更新日時を設定
 	relationship.UpdatedAt = time.Now()
 
 	// リポジトリで更新
-	if err := uc.relationshipRepo.Update(ctx, relationship); err != nil {
+	if err := repos.Relationship.Update(ctx, relationship); err != nil {
+		return nil, fmt.Errorf("友達リクエストの承認に失敗しました: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
 		return nil, fmt.Errorf("友達リクエストの承認に失敗しました: %w", err)
 	}
 
@@ -15,7 +15,7 @@ func TestNewAcceptFriendRequestUseCase(t *testing.T) {
 	relationshipRepo := memory.NewRelationshipRepository()
 	userRepo := memory.NewUserRepository()
 
-	uc := NewAcceptFriendRequestUseCase(relationshipRepo, userRepo)
+	uc := NewAcceptFriendRequestUseCase(relationshipRepo, userRepo, nil, nil)
 
 	if uc == nil {
 		t.Fatal("NewAcceptFriendRequestUseCase returned nil")
@@ -75,7 +75,7 @@ func TestAcceptFriendRequestUseCase_Execute_Success(t *testing.T) {
 	}
 
 	// UseCaseを作成して実行
-	uc := NewAcceptFriendRequestUseCase(relationshipRepo, userRepo)
+	uc := NewAcceptFriendRequestUseCase(relationshipRepo, userRepo, nil, nil)
 	input := AcceptFriendRequestInput{
 		RelationshipID: pendingRequest.ID,
 		ReceiverID:     user2.ID,
@@ -132,7 +132,7 @@ func TestAcceptFriendRequestUseCase_Execute_EmptyRelationshipID(t *testing.T) {
 		t.Fatalf("failed to create user: %v", err)
 	}
 
-	uc := NewAcceptFriendRequestUseCase(relationshipRepo, userRepo)
+	uc := NewAcceptFriendRequestUseCase(relationshipRepo, userRepo, nil, nil)
 	input := AcceptFriendRequestInput{
 		RelationshipID: "",
 		ReceiverID:     user.ID,
@@ -154,7 +154,7 @@ func TestAcceptFriendRequestUseCase_Execute_EmptyReceiverID(t *testing.T) {
 	relationshipRepo := memory.NewRelationshipRepository()
 	userRepo := memory.NewUserRepository()
 
-	uc := NewAcceptFriendRequestUseCase(relationshipRepo, userRepo)
+	uc := NewAcceptFriendRequestUseCase(relationshipRepo, userRepo, nil, nil)
 	input := AcceptFriendRequestInput{
 		RelationshipID: "rel1",
 		ReceiverID:     "",
@@ -176,7 +176,7 @@ func TestAcceptFriendRequestUseCase_Execute_NonExistentReceiver(t *testing.T) {
 	relationshipRepo := memory.NewRelationshipRepository()
 	userRepo := memory.NewUserRepository()
 
-	uc := NewAcceptFriendRequestUseCase(relationshipRepo, userRepo)
+	uc := NewAcceptFriendRequestUseCase(relationshipRepo, userRepo, nil, nil)
 	input := AcceptFriendRequestInput{
 		RelationshipID: "rel1",
 		ReceiverID:     "nonexistent",
@@ -211,7 +211,7 @@ func TestAcceptFriendRequestUseCase_Execute_NonExistentRelationship(t *testing.T
 		t.Fatalf("failed to create user: %v", err)
 	}
 
-	uc := NewAcceptFriendRequestUseCase(relationshipRepo, userRepo)
+	uc := NewAcceptFriendRequestUseCase(relationshipRepo, userRepo, nil, nil)
 	input := AcceptFriendRequestInput{
 		RelationshipID: "nonexistent",
 		ReceiverID:     user.ID,
@@ -284,7 +284,7 @@ func TestAcceptFriendRequestUseCase_Execute_UnauthorizedUser(t *testing.T) {
 	}
 
 	// user3（関係のない第三者）が承認を試みる
-	uc := NewAcceptFriendRequestUseCase(relationshipRepo, userRepo)
+	uc := NewAcceptFriendRequestUseCase(relationshipRepo, userRepo, nil, nil)
 	input := AcceptFriendRequestInput{
 		RelationshipID: pendingRequest.ID,
 		ReceiverID:     user3.ID,
@@ -345,7 +345,7 @@ func TestAcceptFriendRequestUseCase_Execute_AlreadyAccepted(t *testing.T) {
 		t.Fatalf("failed to create accepted request: %v", err)
 	}
 
-	uc := NewAcceptFriendRequestUseCase(relationshipRepo, userRepo)
+	uc := NewAcceptFriendRequestUseCase(relationshipRepo, userRepo, nil, nil)
 	input := AcceptFriendRequestInput{
 		RelationshipID: acceptedRequest.ID,
 		ReceiverID:     user2.ID,
@@ -406,7 +406,7 @@ func TestAcceptFriendRequestUseCase_Execute_RejectedRequest(t *testing.T) {
 		t.Fatalf("failed to create rejected request: %v", err)
 	}
 
-	uc := NewAcceptFriendRequestUseCase(relationshipRepo, userRepo)
+	uc := NewAcceptFriendRequestUseCase(relationshipRepo, userRepo, nil, nil)
 	input := AcceptFriendRequestInput{
 		RelationshipID: rejectedRequest.ID,
 		ReceiverID:     user2.ID,
@@ -467,7 +467,7 @@ func TestAcceptFriendRequestUseCase_Execute_BlockedRelationship(t *testing.T) {
 		t.Fatalf("failed to create blocked relationship: %v", err)
 	}
 
-	uc := NewAcceptFriendRequestUseCase(relationshipRepo, userRepo)
+	uc := NewAcceptFriendRequestUseCase(relationshipRepo, userRepo, nil, nil)
 	input := AcceptFriendRequestInput{
 		RelationshipID: blockedRelationship.ID,
 		ReceiverID:     user2.ID,
@@ -515,7 +515,7 @@ func TestAcceptFriendRequestUseCase_Execute_RequesterNotFound(t *testing.T) {
 		t.Fatalf("failed to create orphan request: %v", err)
 	}
 
-	uc := NewAcceptFriendRequestUseCase(relationshipRepo, userRepo)
+	uc := NewAcceptFriendRequestUseCase(relationshipRepo, userRepo, nil, nil)
 	input := AcceptFriendRequestInput{
 		RelationshipID: orphanRequest.ID,
 		ReceiverID:     receiver.ID,
@@ -530,3 +530,60 @@ func TestAcceptFriendRequestUseCase_Execute_RequesterNotFound(t *testing.T) {
 		t.Errorf("unexpected error message: %v", err)
 	}
 }
+
+func TestAcceptFriendRequestUseCase_Execute_PlanQuota(t *testing.T) {
+	ctx := context.Background()
+
+	quotas := valueobject.PlanQuotas{
+		valueobject.PlanFree:    {MaxFriends: 1},
+		valueobject.PlanPremium: {MaxFriends: 10},
+	}
+
+	setup := func(t *testing.T, plan valueobject.Plan) *AcceptFriendRequestUseCase {
+		t.Helper()
+		relationshipRepo := memory.NewRelationshipRepository()
+		userRepo := memory.NewUserRepository()
+
+		users := []*entity.User{
+			{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password", Plan: plan},
+			{ID: "user2", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+			{ID: "user3", Username: "charlie", Email: "charlie@example.com", PasswordHash: "hashed_password"},
+		}
+		for _, u := range users {
+			if err := userRepo.Create(ctx, u); err != nil {
+				t.Fatalf("failed to create user: %v", err)
+			}
+		}
+
+		// user1とuser2は友達、user3からuser1へは承認待ち
+		relationships := []*entity.Relationship{
+			{ID: "rel1", RequesterID: "user2", ReceiverID: "user1", Status: valueobject.RelationshipStatusAccepted, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+			{ID: "rel2", RequesterID: "user3", ReceiverID: "user1", Status: valueobject.RelationshipStatusPending, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		}
+		for _, rel := range relationships {
+			if err := relationshipRepo.Create(ctx, rel); err != nil {
+				t.Fatalf("failed to create relationship: %v", err)
+			}
+		}
+
+		return NewAcceptFriendRequestUseCase(relationshipRepo, userRepo, quotas, nil)
+	}
+
+	t.Run("フリープランは友達数が上限に達していると承認できない", func(t *testing.T) {
+		uc := setup(t, valueobject.PlanFree)
+		_, err := uc.Execute(ctx, AcceptFriendRequestInput{RelationshipID: "rel2", ReceiverID: "user1"})
+		if err == nil {
+			t.Fatal("expected quota error but got nil")
+		}
+		if !strings.Contains(err.Error(), "フリープランの友達数の上限（1件）") {
+			t.Errorf("unexpected error message: %v", err)
+		}
+	})
+
+	t.Run("プレミアムプランは承認できる", func(t *testing.T) {
+		uc := setup(t, valueobject.PlanPremium)
+		if _, err := uc.Execute(ctx, AcceptFriendRequestInput{RelationshipID: "rel2", ReceiverID: "user1"}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
@@ -8,23 +8,29 @@ import (
 
 	"github.com/ochamu/morning-call-api/internal/domain/entity"
 	"github.com/ochamu/morning-call-api/internal/domain/repository"
-	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
 )
 
 // BlockRelationshipUseCase は関係IDを使用したブロックのユースケース
 type BlockRelationshipUseCase struct {
 	relationshipRepo repository.RelationshipRepository
 	userRepo         repository.UserRepository
+	morningCallRepo  repository.MorningCallRepository
+	txManager        repository.TransactionManager
 }
 
 // NewBlockRelationshipUseCase は新しい関係ブロックユースケースを作成する
+// txManagerがnilの場合はトランザクションを使用せずリポジトリを直接更新する
 func NewBlockRelationshipUseCase(
 	relationshipRepo repository.RelationshipRepository,
 	userRepo repository.UserRepository,
+	morningCallRepo repository.MorningCallRepository,
+	txManager repository.TransactionManager,
 ) *BlockRelationshipUseCase {
 	return &BlockRelationshipUseCase{
 		relationshipRepo: relationshipRepo,
 		userRepo:         userRepo,
+		morningCallRepo:  morningCallRepo,
+		txManager:        txManager,
 	}
 }
 
@@ -36,10 +42,12 @@ type BlockRelationshipInput struct {
 
 // BlockRelationshipOutput は関係ブロックの出力データ
 type BlockRelationshipOutput struct {
-	Relationship *entity.Relationship
+	Relationship   *entity.Relationship
+	CancelledCalls int // 友達関係のブロックに伴いキャンセルしたモーニングコール数
 }
 
 // Execute は関係をブロックする
+// 友達の場合は2人の間のアクティブなモーニングコールもキャンセルする（BlockUserUseCaseと同じ扱い）
 func (uc *BlockRelationshipUseCase) Execute(ctx context.Context, input BlockRelationshipInput) (*BlockRelationshipOutput, error) {
 	// 入力値の基本検証
 	if input.RelationshipID == "" {
@@ -49,8 +57,19 @@ func (uc *BlockRelationshipUseCase) Execute(ctx context.Context, input BlockRela
 		return nil, fmt.Errorf("ブロック実行者IDは必須です")
 	}
 
+	// トランザクションを開始（関係のブロックとコールのキャンセルを原子的に行う）
+	repos, tx, err := beginTx(ctx, uc.txManager, repository.TxRepositories{
+		User:         uc.userRepo,
+		MorningCall:  uc.morningCallRepo,
+		Relationship: uc.relationshipRepo,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("トランザクションの開始に失敗しました: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // コミット済みの場合は何もしない
+
 	// 関係を取得
-	relationship, err := uc.relationshipRepo.FindByID(ctx, input.RelationshipID)
+	relationship, err := repos.Relationship.FindByID(ctx, input.RelationshipID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, fmt.Errorf("関係が見つかりません")
@@ -63,25 +82,42 @@ func (uc *BlockRelationshipUseCase) Execute(ctx context.Context, input BlockRela
 		return nil, fmt.Errorf("この関係をブロックする権限がありません")
 	}
 
-	// 既にブロック済みかどうか確認
-	if relationship.Status == valueobject.RelationshipStatusBlocked {
+	// 自分が既にブロック済みかどうか確認（相手のみがブロックしている場合は相互ブロックにする）
+	if relationship.IsBlockedBy(input.BlockerID) {
 		return nil, fmt.Errorf("既にブロックされています")
 	}
 
+	// 友達だったかどうかはブロック前に判定する
+	wasFriend := relationship.IsFriend()
+
 	// ブロック処理を実行
-	if reason := relationship.Block(); reason.IsNG() {
-		return nil, fmt.Errorf("関係のブロックに失敗しました: %s", reason)
+	if reason := relationship.BlockBy(input.BlockerID); reason.IsNG() {
+		return nil, fmt.Errorf("関係のブロックに失敗しました: %w", reason)
 	}
 
 	// 更新日時を設定
 	relationship.UpdatedAt = time.Now()
 
 	// リポジトリで更新
-	if err := uc.relationshipRepo.Update(ctx, relationship); err != nil {
+	if err := repos.Relationship.Update(ctx, relationship); err != nil {
+		return nil, fmt.Errorf("関係のブロックに失敗しました: %w", err)
+	}
+
+	// 友達をブロックした場合は、二人の間のアクティブなモーニングコールをキャンセルする
+	cancelledCalls := 0
+	if wasFriend && repos.MorningCall != nil {
+		cancelledCalls, err = cancelActiveCallsBetween(ctx, repos.MorningCall, relationship.RequesterID, relationship.ReceiverID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
 		return nil, fmt.Errorf("関係のブロックに失敗しました: %w", err)
 	}
 
 	return &BlockRelationshipOutput{
-		Relationship: relationship,
+		Relationship:   relationship,
+		CancelledCalls: cancelledCalls,
 	}, nil
 }
\ No newline at end of file
@@ -16,16 +16,23 @@ import (
 type BlockUserUseCase struct {
 	relationshipRepo repository.RelationshipRepository
 	userRepo         repository.UserRepository
+	morningCallRepo  repository.MorningCallRepository
+	txManager        repository.TransactionManager
 }
 
 // NewBlockUserUseCase は新しいユーザーブロックユースケースを作成する
+// txManagerがnilの場合はトランザクションを使用せずリポジトリを直接更新する
 func NewBlockUserUseCase(
 	relationshipRepo repository.RelationshipRepository,
 	userRepo repository.UserRepository,
+	morningCallRepo repository.MorningCallRepository,
+	txManager repository.TransactionManager,
 ) *BlockUserUseCase {
 	return &BlockUserUseCase{
 		relationshipRepo: relationshipRepo,
 		userRepo:         userRepo,
+		morningCallRepo:  morningCallRepo,
+		txManager:        txManager,
 	}
 }
 
@@ -37,10 +44,13 @@ type BlockUserInput struct {
 
 // BlockUserOutput はユーザーブロックの出力データ
 type BlockUserOutput struct {
-	Relationship *entity.Relationship
+	Relationship   *entity.Relationship
+	CancelledCalls int // 友達関係のブロックに伴いキャンセルしたモーニングコール数
 }
 
 // Execute はユーザーをブロックする
+// 友達の場合は関係をブロック状態に上書きし、2人の間のアクティブなモーニングコールをキャンセルする
+// ブロックを解除しても友達には戻らず、改めて友達リクエストが必要になる（UnblockUserUseCaseを参照）
 func (uc *BlockUserUseCase) Execute(ctx context.Context, input BlockUserInput) (*BlockUserOutput, error) {
 	// 入力値の基本検証
 	if input.BlockerID == "" {
@@ -71,50 +81,64 @@ func (uc *BlockUserUseCase) Execute(ctx context.Context, input BlockUserInput) (
 		return nil, fmt.Errorf("ブロック対象者の確認中にエラーが発生しました: %w", err)
 	}
 
+	// トランザクションを開始（関係のブロックとコールのキャンセルを原子的に行う）
+	repos, tx, err := beginTx(ctx, uc.txManager, repository.TxRepositories{
+		User:         uc.userRepo,
+		MorningCall:  uc.morningCallRepo,
+		Relationship: uc.relationshipRepo,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("トランザクションの開始に失敗しました: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // コミット済みの場合は何もしない
+
 	// 既存の関係を確認
-	existingRelationship, err := uc.relationshipRepo.FindByUserPair(ctx, input.BlockerID, input.BlockedID)
+	existingRelationship, err := repos.Relationship.FindByUserPair(ctx, input.BlockerID, input.BlockedID)
 	if err != nil && !errors.Is(err, repository.ErrNotFound) {
 		return nil, fmt.Errorf("既存の関係確認中にエラーが発生しました: %w", err)
 	}
 
 	var relationship *entity.Relationship
+	cancelledCalls := 0
 
 	// 既存の関係がある場合
 	if existingRelationship != nil {
-		// 既にブロック済みかどうか確認
-		if existingRelationship.Status == valueobject.RelationshipStatusBlocked {
-			// ブロック実行者が同じ場合（自分がRequesterとしてブロック済み）
-			if existingRelationship.RequesterID == input.BlockerID {
-				return nil, fmt.Errorf("既にこのユーザーをブロックしています")
-			}
-			// 相手からブロックされている場合
-			// メモリリポジトリの制約上、同じユーザーペアで複数の関係を持てないため、
-			// 相手からブロックされている場合は新規作成できない
-			return nil, fmt.Errorf("相手にブロックされています")
-		} else {
-			// ブロック以外の状態の場合、既存の関係を更新する
-			// ブロック実行者が関係の所有者（RequesterまたはReceiver）である必要がある
-			if existingRelationship.RequesterID == input.BlockerID || existingRelationship.ReceiverID == input.BlockerID {
-				// ブロック処理を実行
-				if reason := existingRelationship.Block(); reason.IsNG() {
-					return nil, fmt.Errorf("ユーザーのブロックに失敗しました: %s", reason)
-				}
-
-				// 更新日時を設定
-				existingRelationship.UpdatedAt = time.Now()
-
-				// リポジトリで更新
-				if err := uc.relationshipRepo.Update(ctx, existingRelationship); err != nil {
-					return nil, fmt.Errorf("ユーザーのブロックに失敗しました: %w", err)
-				}
-
-				relationship = existingRelationship
-			} else {
-				// 既存の関係があるが、ブロック実行者が関係に関与していない場合
-				// この場合はエラーとして処理
-				return nil, fmt.Errorf("この関係をブロックする権限がありません")
+		// ブロック実行者が関係の所有者（RequesterまたはReceiver）である必要がある
+		if !existingRelationship.InvolvesUser(input.BlockerID) {
+			return nil, fmt.Errorf("この関係をブロックする権限がありません")
+		}
+
+		// 自分が既にブロックしている場合（相互ブロックを含む）
+		if existingRelationship.IsBlockedBy(input.BlockerID) {
+			return nil, fmt.Errorf("既にこのユーザーをブロックしています")
+		}
+
+		// 友達だったかどうかはブロック前に判定する
+		wasFriend := existingRelationship.IsFriend()
+
+		// ブロック処理を実行
+		// 相手からのみブロックされている場合は相互ブロック状態になる
+		if reason := existingRelationship.BlockBy(input.BlockerID); reason.IsNG() {
+			return nil, fmt.Errorf("ユーザーのブロックに失敗しました: %w", reason)
+		}
+
+		// 更新日時を設定
+		existingRelationship.UpdatedAt = time.Now()
+
+		// リポジトリで更新
+		if err := repos.Relationship.Update(ctx, existingRelationship); err != nil {
+			return nil, fmt.Errorf("ユーザーのブロックに失敗しました: %w", err)
+		}
+
+		// 友達をブロックした場合は、二人の間のアクティブなモーニングコールをキャンセルする
+		if wasFriend && repos.MorningCall != nil {
+			cancelledCalls, err = cancelActiveCallsBetween(ctx, repos.MorningCall, input.BlockerID, input.BlockedID)
+			if err != nil {
+				return nil, err
 			}
 		}
+
+		relationship = existingRelationship
 	}
 
 	// 新規にブロック関係を作成する必要がある場合
@@ -129,7 +153,7 @@ func (uc *BlockUserUseCase) Execute(ctx context.Context, input BlockUserInput) (
 		var reason valueobject.NGReason
 		relationship, reason = entity.NewRelationship(id, blocker.ID, blocked.ID)
 		if reason.IsNG() {
-			return nil, fmt.Errorf("ブロック関係の作成に失敗しました: %s", reason)
+			return nil, fmt.Errorf("ブロック関係の作成に失敗しました: %w", reason)
 		}
 
 		// nilチェック（念のため）
@@ -138,12 +162,12 @@ func (uc *BlockUserUseCase) Execute(ctx context.Context, input BlockUserInput) (
 		}
 
 		// 即座にブロック状態に設定
-		if reason := relationship.Block(); reason.IsNG() {
-			return nil, fmt.Errorf("ブロック関係の設定に失敗しました: %s", reason)
+		if reason := relationship.BlockBy(blocker.ID); reason.IsNG() {
+			return nil, fmt.Errorf("ブロック関係の設定に失敗しました: %w", reason)
 		}
 
 		// リポジトリに保存
-		if err := uc.relationshipRepo.Create(ctx, relationship); err != nil {
+		if err := repos.Relationship.Create(ctx, relationship); err != nil {
 			// 重複エラーの場合
 			if errors.Is(err, repository.ErrAlreadyExists) {
 				return nil, fmt.Errorf("既にブロック関係が存在します")
@@ -152,14 +176,18 @@ func (uc *BlockUserUseCase) Execute(ctx context.Context, input BlockUserInput) (
 		}
 	}
 
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("ユーザーのブロックに失敗しました: %w", err)
+	}
+
 	// ログ出力（システムイベント）
-	// 実際の実装では、ここで関連するモーニングコールの削除や
-	// 通知サービスの呼び出しを行うことも考えられる
+	// 実際の実装では、ここで通知サービスの呼び出しを行うことも考えられる
 	// ただし、ブロックの場合は通知しないという選択肢もある
 	_ = blocker // ブロック実行者のログ用（将来の拡張用）
 	_ = blocked // ブロック対象者のログ用（将来の拡張用）
 
 	return &BlockUserOutput{
-		Relationship: relationship,
+		Relationship:   relationship,
+		CancelledCalls: cancelledCalls,
 	}, nil
 }
@@ -15,7 +15,7 @@ func TestNewBlockUserUseCase(t *testing.T) {
 	relationshipRepo := memory.NewRelationshipRepository()
 	userRepo := memory.NewUserRepository()
 
-	uc := NewBlockUserUseCase(relationshipRepo, userRepo)
+	uc := NewBlockUserUseCase(relationshipRepo, userRepo, nil, nil)
 
 	if uc == nil {
 		t.Fatal("NewBlockUserUseCase returned nil")
@@ -89,6 +89,16 @@ func TestBlockUserUseCase_Execute(t *testing.T) {
 				if output.Relationship.Status != valueobject.RelationshipStatusBlocked {
 					t.Errorf("Status = %v, want %v", output.Relationship.Status, valueobject.RelationshipStatusBlocked)
 				}
+				// 一方向のブロックとして記録される
+				if output.Relationship.BlockerID != blocker.ID {
+					t.Errorf("BlockerID = %v, want %v", output.Relationship.BlockerID, blocker.ID)
+				}
+				if output.Relationship.IsMutualBlock() {
+					t.Error("一方向のブロックは相互ブロックであるべきではない")
+				}
+				if output.Relationship.IsBlockedBy(blocked.ID) {
+					t.Error("ブロックされた側はブロックしていない状態であるべき")
+				}
 			},
 		},
 		{
@@ -206,7 +216,7 @@ func TestBlockUserUseCase_Execute(t *testing.T) {
 			},
 		},
 		{
-			name: "エラー - 相手からブロックされている場合の処理",
+			name: "成功ケース - 相手からブロックされている場合は相互ブロックになる",
 			input: BlockUserInput{
 				BlockerID: blocked.ID,
 				BlockedID: blocker.ID,
@@ -225,6 +235,7 @@ func TestBlockUserUseCase_Execute(t *testing.T) {
 					RequesterID: blocker.ID,
 					ReceiverID:  blocked.ID,
 					Status:      valueobject.RelationshipStatusBlocked,
+					BlockerID:   blocker.ID,
 					CreatedAt:   time.Now(),
 					UpdatedAt:   time.Now(),
 				}
@@ -232,8 +243,56 @@ func TestBlockUserUseCase_Execute(t *testing.T) {
 					t.Fatalf("failed to create existing block: %v", err)
 				}
 			},
+			wantErr: false,
+			checkFunc: func(t *testing.T, output *BlockUserOutput, rr *memory.RelationshipRepository) {
+				if output.Relationship.ID != "rel-4" {
+					t.Errorf("ID = %v, want rel-4（既存の関係を更新すべき）", output.Relationship.ID)
+				}
+				saved, err := rr.FindByID(ctx, "rel-4")
+				if err != nil {
+					t.Fatalf("failed to find relationship: %v", err)
+				}
+				if !saved.IsMutualBlock() {
+					t.Error("相互ブロック状態になるべき")
+				}
+				if saved.BlockerID != blocker.ID {
+					t.Errorf("BlockerID = %v, want %v（最初のブロック実行者を維持すべき）", saved.BlockerID, blocker.ID)
+				}
+				if !saved.IsBlockedBy(blocker.ID) || !saved.IsBlockedBy(blocked.ID) {
+					t.Error("双方がブロックしている状態になるべき")
+				}
+			},
+		},
+		{
+			name: "エラー - 相互ブロック済みの状態で再ブロック",
+			input: BlockUserInput{
+				BlockerID: blocked.ID,
+				BlockedID: blocker.ID,
+			},
+			setup: func(t *testing.T, rr *memory.RelationshipRepository, ur *memory.UserRepository) {
+				if err := ur.Create(ctx, blocker); err != nil {
+					t.Fatalf("failed to create blocker: %v", err)
+				}
+				if err := ur.Create(ctx, blocked); err != nil {
+					t.Fatalf("failed to create blocked: %v", err)
+				}
+
+				mutual := &entity.Relationship{
+					ID:          "rel-4",
+					RequesterID: blocker.ID,
+					ReceiverID:  blocked.ID,
+					Status:      valueobject.RelationshipStatusBlocked,
+					BlockerID:   blocker.ID,
+					MutualBlock: true,
+					CreatedAt:   time.Now(),
+					UpdatedAt:   time.Now(),
+				}
+				if err := rr.Create(ctx, mutual); err != nil {
+					t.Fatalf("failed to create mutual block: %v", err)
+				}
+			},
 			wantErr: true,
-			errMsg:  "相手にブロックされています",
+			errMsg:  "既にこのユーザーをブロックしています",
 		},
 		{
 			name: "エラー - ブロック実行者IDが空",
@@ -388,7 +447,7 @@ func TestBlockUserUseCase_Execute(t *testing.T) {
 			}
 
 			// UseCaseを作成して実行
-			uc := NewBlockUserUseCase(relationshipRepo, userRepo)
+			uc := NewBlockUserUseCase(relationshipRepo, userRepo, nil, nil)
 			output, err := uc.Execute(ctx, tt.input)
 
 			// エラーチェック
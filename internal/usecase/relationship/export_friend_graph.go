@@ -0,0 +1,195 @@
+package relationship
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+const (
+	// MaxFriendGraphDepth は友達関係グラフで辿れる最大の深さ（友達の友達まで）
+	MaxFriendGraphDepth = 2
+
+	// MaxFriendGraphNodes は友達関係グラフに含めるノード数の上限（自分を含む）
+	MaxFriendGraphNodes = 200
+
+	// friendGraphFetchLimit は1人のユーザーについて取得する友達関係の上限件数
+	friendGraphFetchLimit = 1000
+)
+
+// ExportFriendGraphUseCase は自分を中心とした友達関係のグラフを可視化用に取得するユースケース
+type ExportFriendGraphUseCase struct {
+	relationshipRepo repository.RelationshipRepository
+	userRepo         repository.UserRepository
+	maxNodes         int
+}
+
+// NewExportFriendGraphUseCase は新しい友達関係グラフ取得ユースケースを作成する
+func NewExportFriendGraphUseCase(
+	relationshipRepo repository.RelationshipRepository,
+	userRepo repository.UserRepository,
+) *ExportFriendGraphUseCase {
+	return &ExportFriendGraphUseCase{
+		relationshipRepo: relationshipRepo,
+		userRepo:         userRepo,
+		maxNodes:         MaxFriendGraphNodes,
+	}
+}
+
+// ExportFriendGraphInput は友達関係グラフ取得の入力データ
+type ExportFriendGraphInput struct {
+	UserID string // 中心にするユーザーID
+	Depth  int    // オプション：辿る深さ（1は友達まで、2は友達の友達まで。0の場合は1）
+}
+
+// FriendGraphNode はグラフのノード（ユーザー）
+type FriendGraphNode struct {
+	User  *entity.User
+	Depth int // 中心のユーザーからのホップ数（自分は0）
+}
+
+// FriendGraphEdge はグラフのエッジ（承認済みの友達関係）
+type FriendGraphEdge struct {
+	RelationshipID string
+	SourceID       string // 友達リクエストを送ったユーザーのID
+	TargetID       string // 友達リクエストを受けたユーザーのID
+}
+
+// ExportFriendGraphOutput は友達関係グラフ取得の出力データ
+type ExportFriendGraphOutput struct {
+	Nodes     []FriendGraphNode // 中心からの深さ順（同じ深さではユーザーID順）
+	Edges     []FriendGraphEdge // 両端がノードに含まれる友達関係
+	Truncated bool              // ノード数の上限に達したため一部のユーザーを省略したか
+}
+
+// Execute は友達関係グラフを取得する
+// 承認済みの友達関係のみを辿り、自分がブロックした・自分をブロックしたユーザーはノードに含めない
+// 辿った先のユーザーの友達関係のうち、両端がグラフに含まれるものをエッジとして返す
+func (uc *ExportFriendGraphUseCase) Execute(ctx context.Context, input ExportFriendGraphInput) (*ExportFriendGraphOutput, error) {
+	// 入力値の基本検証
+	if input.UserID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+	depth := input.Depth
+	if depth == 0 {
+		depth = 1
+	}
+	if depth < 1 || depth > MaxFriendGraphDepth {
+		return nil, fmt.Errorf("深さは1から%dの範囲で指定してください", MaxFriendGraphDepth)
+	}
+
+	// ユーザーの存在確認
+	center, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("ユーザーの確認中にエラーが発生しました: %w", err)
+	}
+
+	// ブロック関係にあるユーザーは友達の友達としても表示しない
+	blocked, err := uc.findBlockedUserIDs(ctx, center.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ExportFriendGraphOutput{
+		Nodes: []FriendGraphNode{{User: center, Depth: 0}},
+	}
+	depths := map[string]int{center.ID: 0}
+	edges := make(map[string]*entity.Relationship)
+
+	// 幅優先で辿り、近いユーザーから順に上限までノードに加える
+	frontier := []string{center.ID}
+	for level := 1; level <= depth && len(frontier) > 0; level++ {
+		var next []string
+		for _, userID := range frontier {
+			friendRels, err := uc.relationshipRepo.FindFriendsByUserID(ctx, userID, 0, friendGraphFetchLimit)
+			if err != nil {
+				return nil, fmt.Errorf("友達関係の取得中にエラーが発生しました: %w", err)
+			}
+			// 上限に達したときに省略されるユーザーが実行ごとに変わらないよう、相手のID順に辿る
+			sort.Slice(friendRels, func(i, j int) bool {
+				return friendRels[i].GetOtherUserID(userID) < friendRels[j].GetOtherUserID(userID)
+			})
+			for _, rel := range friendRels {
+				otherID := rel.GetOtherUserID(userID)
+				if blocked[otherID] {
+					continue
+				}
+				edges[rel.ID] = rel
+				if _, seen := depths[otherID]; seen {
+					continue
+				}
+				if len(depths) >= uc.maxNodes {
+					output.Truncated = true
+					continue
+				}
+				depths[otherID] = level
+				next = append(next, otherID)
+			}
+		}
+
+		users, err := uc.findUsers(ctx, next)
+		if err != nil {
+			return nil, err
+		}
+		frontier = frontier[:0]
+		for _, u := range users {
+			output.Nodes = append(output.Nodes, FriendGraphNode{User: u, Depth: level})
+			frontier = append(frontier, u.ID)
+		}
+	}
+
+	// 削除されたユーザーなどノードにならなかったユーザーとのエッジは含めない
+	included := make(map[string]bool, len(output.Nodes))
+	for _, node := range output.Nodes {
+		included[node.User.ID] = true
+	}
+	for _, rel := range edges {
+		if included[rel.RequesterID] && included[rel.ReceiverID] {
+			output.Edges = append(output.Edges, FriendGraphEdge{
+				RelationshipID: rel.ID,
+				SourceID:       rel.RequesterID,
+				TargetID:       rel.ReceiverID,
+			})
+		}
+	}
+	sort.Slice(output.Edges, func(i, j int) bool {
+		return output.Edges[i].RelationshipID < output.Edges[j].RelationshipID
+	})
+
+	return output, nil
+}
+
+// findBlockedUserIDs はユーザーとブロック関係にある相手のIDを返す（ブロックした側・された側を問わない）
+func (uc *ExportFriendGraphUseCase) findBlockedUserIDs(ctx context.Context, userID string) (map[string]bool, error) {
+	blockedRels, err := uc.relationshipRepo.FindBlockedRelationshipsByUserID(ctx, userID, 0, friendGraphFetchLimit)
+	if err != nil {
+		return nil, fmt.Errorf("ブロック関係の取得中にエラーが発生しました: %w", err)
+	}
+	blocked := make(map[string]bool, len(blockedRels))
+	for _, rel := range blockedRels {
+		blocked[rel.GetOtherUserID(userID)] = true
+	}
+	return blocked, nil
+}
+
+// findUsers はユーザーをまとめて取得し、ID順に並べて返す（存在しないユーザーは含めない）
+func (uc *ExportFriendGraphUseCase) findUsers(ctx context.Context, ids []string) ([]*entity.User, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	users, err := uc.userRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("ユーザー情報の取得中にエラーが発生しました: %w", err)
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].ID < users[j].ID
+	})
+	return users, nil
+}
@@ -0,0 +1,202 @@
+package relationship
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+// newFriendGraphFixture は次の関係を持つリポジトリを作成する
+//
+//	me - alice (友達), me - bob (友達), alice - bob (友達)
+//	alice - carol (友達), bob - dave (友達), carol - erin (友達)
+//	me - mallory (ブロック), alice - mallory (友達)
+//	me - frank (承認待ち)
+func newFriendGraphFixture(t *testing.T) (*memory.RelationshipRepository, *memory.UserRepository) {
+	t.Helper()
+	ctx := context.Background()
+	relationshipRepo := memory.NewRelationshipRepository()
+	userRepo := memory.NewUserRepository()
+
+	for _, name := range []string{"me", "alice", "bob", "carol", "dave", "erin", "mallory", "frank"} {
+		if err := userRepo.Create(ctx, &entity.User{
+			ID:           name,
+			Username:     name,
+			Email:        name + "@example.com",
+			PasswordHash: "hashed",
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		}); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+
+	rels := []struct {
+		requester, receiver string
+		status              valueobject.RelationshipStatus
+	}{
+		{"me", "alice", valueobject.RelationshipStatusAccepted},
+		{"bob", "me", valueobject.RelationshipStatusAccepted},
+		{"alice", "bob", valueobject.RelationshipStatusAccepted},
+		{"alice", "carol", valueobject.RelationshipStatusAccepted},
+		{"bob", "dave", valueobject.RelationshipStatusAccepted},
+		{"carol", "erin", valueobject.RelationshipStatusAccepted},
+		{"me", "mallory", valueobject.RelationshipStatusBlocked},
+		{"alice", "mallory", valueobject.RelationshipStatusAccepted},
+		{"me", "frank", valueobject.RelationshipStatusPending},
+	}
+	for _, r := range rels {
+		if err := relationshipRepo.Create(ctx, &entity.Relationship{
+			ID:          r.requester + "-" + r.receiver,
+			RequesterID: r.requester,
+			ReceiverID:  r.receiver,
+			Status:      r.status,
+			CreatedAt:   time.Now(),
+			UpdatedAt:   time.Now(),
+		}); err != nil {
+			t.Fatalf("failed to create relationship: %v", err)
+		}
+	}
+	return relationshipRepo, userRepo
+}
+
+// graphSummary はノードを「ID:深さ」、エッジを関係IDで表した文字列を返す
+func graphSummary(output *ExportFriendGraphOutput) (string, string) {
+	nodes := make([]string, 0, len(output.Nodes))
+	for _, node := range output.Nodes {
+		nodes = append(nodes, fmt.Sprintf("%s:%d", node.User.ID, node.Depth))
+	}
+	edges := make([]string, 0, len(output.Edges))
+	for _, edge := range output.Edges {
+		edges = append(edges, edge.RelationshipID)
+	}
+	return strings.Join(nodes, ","), strings.Join(edges, ",")
+}
+
+func TestExportFriendGraphUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		input         ExportFriendGraphInput
+		maxNodes      int
+		wantNodes     string
+		wantEdges     string
+		wantTruncated bool
+		errMsg        string
+	}{
+		{
+			name:      "深さ1は友達まで",
+			input:     ExportFriendGraphInput{UserID: "me", Depth: 1},
+			wantNodes: "me:0,alice:1,bob:1",
+			wantEdges: "bob-me,me-alice",
+		},
+		{
+			name:      "深さ未指定は1として扱う",
+			input:     ExportFriendGraphInput{UserID: "me"},
+			wantNodes: "me:0,alice:1,bob:1",
+			wantEdges: "bob-me,me-alice",
+		},
+		{
+			// ブロックしたmallory、承認待ちのfrank、3ホップ先のerinは含めない
+			name:      "深さ2は友達の友達まで",
+			input:     ExportFriendGraphInput{UserID: "me", Depth: 2},
+			wantNodes: "me:0,alice:1,bob:1,carol:2,dave:2",
+			wantEdges: "alice-bob,alice-carol,bob-dave,bob-me,me-alice",
+		},
+		{
+			name:          "ノード数の上限で打ち切る",
+			input:         ExportFriendGraphInput{UserID: "me", Depth: 2},
+			maxNodes:      4,
+			wantNodes:     "me:0,alice:1,bob:1,carol:2",
+			wantEdges:     "alice-bob,alice-carol,bob-me,me-alice",
+			wantTruncated: true,
+		},
+		{
+			name:   "深さ3は指定できない",
+			input:  ExportFriendGraphInput{UserID: "me", Depth: 3},
+			errMsg: "深さは1から2の範囲で指定してください",
+		},
+		{
+			name:   "負の深さは指定できない",
+			input:  ExportFriendGraphInput{UserID: "me", Depth: -1},
+			errMsg: "深さは1から2の範囲で指定してください",
+		},
+		{
+			name:   "ユーザーIDは必須",
+			input:  ExportFriendGraphInput{Depth: 1},
+			errMsg: "ユーザーIDは必須です",
+		},
+		{
+			name:   "存在しないユーザー",
+			input:  ExportFriendGraphInput{UserID: "unknown", Depth: 1},
+			errMsg: "ユーザーが見つかりません",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			relationshipRepo, userRepo := newFriendGraphFixture(t)
+			uc := NewExportFriendGraphUseCase(relationshipRepo, userRepo)
+			if tt.maxNodes > 0 {
+				uc.maxNodes = tt.maxNodes
+			}
+
+			output, err := uc.Execute(ctx, tt.input)
+			if tt.errMsg != "" {
+				if err == nil || err.Error() != tt.errMsg {
+					t.Fatalf("error = %v, want %q", err, tt.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			nodes, edges := graphSummary(output)
+			if nodes != tt.wantNodes {
+				t.Errorf("nodes = %s, want %s", nodes, tt.wantNodes)
+			}
+			if edges != tt.wantEdges {
+				t.Errorf("edges = %s, want %s", edges, tt.wantEdges)
+			}
+			if output.Truncated != tt.wantTruncated {
+				t.Errorf("Truncated = %v, want %v", output.Truncated, tt.wantTruncated)
+			}
+		})
+	}
+}
+
+func TestExportFriendGraphUseCase_Execute_BlockedByFriendOfFriend(t *testing.T) {
+	ctx := context.Background()
+	relationshipRepo, userRepo := newFriendGraphFixture(t)
+
+	// 自分をブロックしたユーザーも友達の友達として表示しない
+	if err := relationshipRepo.Create(ctx, &entity.Relationship{
+		ID:          "dave-me",
+		RequesterID: "dave",
+		ReceiverID:  "me",
+		Status:      valueobject.RelationshipStatusBlocked,
+	}); err != nil {
+		t.Fatalf("failed to create relationship: %v", err)
+	}
+
+	uc := NewExportFriendGraphUseCase(relationshipRepo, userRepo)
+	output, err := uc.Execute(ctx, ExportFriendGraphInput{UserID: "me", Depth: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	nodes, edges := graphSummary(output)
+	if nodes != "me:0,alice:1,bob:1,carol:2" {
+		t.Errorf("nodes = %s", nodes)
+	}
+	if strings.Contains(edges, "dave") {
+		t.Errorf("edges = %s, should not contain dave", edges)
+	}
+}
@@ -0,0 +1,56 @@
+package relationship
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// pendingRequestBatchSize は承認待ちの送信済みリクエストをリポジトリから一度に取得する件数
+const pendingRequestBatchSize = 500
+
+// checkFriendQuota はユーザーのプランに応じた友達数の上限を確認する
+// 承認待ちの送信済みリクエストも友達枠として数える（quotasがnilの場合は確認しない）
+func checkFriendQuota(ctx context.Context, relationshipRepo repository.RelationshipRepository, quotas valueobject.PlanQuotas, user *entity.User) error {
+	if quotas == nil {
+		return nil
+	}
+
+	plan := user.EffectivePlan()
+	quota := quotas.For(plan)
+	if quota.MaxFriends <= 0 {
+		return nil
+	}
+
+	friendCount, err := relationshipRepo.CountFriendsByUserID(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("友達数の確認中にエラーが発生しました: %w", err)
+	}
+	pendingCount, err := countPendingSentRequests(ctx, relationshipRepo, user.ID)
+	if err != nil {
+		return fmt.Errorf("送信済みリクエストの確認中にエラーが発生しました: %w", err)
+	}
+
+	if valueobject.IsLimitReached(quota.MaxFriends, friendCount+pendingCount) {
+		return fmt.Errorf("%s", valueobject.QuotaExceededMessage(plan, "友達数", quota.MaxFriends))
+	}
+	return nil
+}
+
+// countPendingSentRequests はユーザーが送った承認待ちのリクエストを件数の上限なしにページ単位で数える
+func countPendingSentRequests(ctx context.Context, relationshipRepo repository.RelationshipRepository, userID string) (int, error) {
+	count := 0
+	for offset := 0; ; offset += pendingRequestBatchSize {
+		batch, err := relationshipRepo.FindPendingRequestsByRequesterID(ctx, userID, offset, pendingRequestBatchSize)
+		if err != nil {
+			return 0, err
+		}
+		count += len(batch)
+		if len(batch) < pendingRequestBatchSize {
+			return count, nil
+		}
+	}
+}
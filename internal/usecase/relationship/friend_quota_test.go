@@ -0,0 +1,40 @@
+package relationship
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestCountPendingSentRequests_CountsBeyondBatchSize(t *testing.T) {
+	ctx := context.Background()
+	relationshipRepo := memory.NewRelationshipRepository()
+
+	want := pendingRequestBatchSize*2 + 3
+	for i := 0; i < want; i++ {
+		rel := &entity.Relationship{
+			ID:          fmt.Sprintf("rel%d", i),
+			RequesterID: "user1",
+			ReceiverID:  fmt.Sprintf("receiver%d", i),
+			Status:      valueobject.RelationshipStatusPending,
+			CreatedAt:   time.Now(),
+			UpdatedAt:   time.Now(),
+		}
+		if err := relationshipRepo.Create(ctx, rel); err != nil {
+			t.Fatalf("failed to create relationship: %v", err)
+		}
+	}
+
+	got, err := countPendingSentRequests(ctx, relationshipRepo, "user1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != want {
+		t.Errorf("countPendingSentRequests() = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,131 @@
+package relationship
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+const (
+	// DefaultFriendInviteTTL は招待トークンの有効期間のデフォルト値
+	DefaultFriendInviteTTL = 10 * time.Minute
+	// MaxFriendInviteTTL は招待トークンの有効期間として指定できる最大値
+	MaxFriendInviteTTL = 24 * time.Hour
+	// DefaultFriendInviteMaxUses は招待トークンの使用回数上限のデフォルト値
+	DefaultFriendInviteMaxUses = 1
+	// MaxFriendInviteMaxUses は招待トークンの使用回数上限として指定できる最大値
+	MaxFriendInviteMaxUses = 20
+
+	// FriendInviteURIBase はQRコードに埋め込む招待URIのベース（トークンをクエリパラメータで付与する）
+	FriendInviteURIBase = "morningcall://friends/invite"
+
+	// friendInviteTokenBytes は招待トークンのランダム部分のバイト数
+	friendInviteTokenBytes = 24
+)
+
+// GenerateFriendInviteTokenUseCase は自分への友達リクエスト用の招待トークンを発行するユースケース
+type GenerateFriendInviteTokenUseCase struct {
+	inviteRepo repository.FriendInviteRepository
+	userRepo   repository.UserRepository
+	now        func() time.Time
+}
+
+// NewGenerateFriendInviteTokenUseCase は新しい招待トークン発行ユースケースを作成する
+func NewGenerateFriendInviteTokenUseCase(
+	inviteRepo repository.FriendInviteRepository,
+	userRepo repository.UserRepository,
+) *GenerateFriendInviteTokenUseCase {
+	return &GenerateFriendInviteTokenUseCase{
+		inviteRepo: inviteRepo,
+		userRepo:   userRepo,
+		now:        time.Now,
+	}
+}
+
+// GenerateFriendInviteTokenInput は招待トークン発行の入力データ
+type GenerateFriendInviteTokenInput struct {
+	InviterID string        // 必須：トークンを発行するユーザーのID
+	MaxUses   int           // オプション：使用回数の上限（デフォルト1回、最大20回）
+	ExpiresIn time.Duration // オプション：有効期間（デフォルト10分、最大24時間）
+}
+
+// GenerateFriendInviteTokenOutput は招待トークン発行の出力データ
+type GenerateFriendInviteTokenOutput struct {
+	Invite *entity.FriendInvite
+	URI    string // QRコードに埋め込む招待URI
+}
+
+// Execute は招待トークンを発行する
+func (uc *GenerateFriendInviteTokenUseCase) Execute(ctx context.Context, input GenerateFriendInviteTokenInput) (*GenerateFriendInviteTokenOutput, error) {
+	// 入力値の基本検証
+	if input.InviterID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+	if input.MaxUses == 0 {
+		input.MaxUses = DefaultFriendInviteMaxUses
+	}
+	if input.MaxUses < 0 || input.MaxUses > MaxFriendInviteMaxUses {
+		reason := valueobject.NGWithCode(valueobject.ReasonCodeOutOfRange, "max_uses", fmt.Sprintf("使用回数の上限は1〜%d回で指定してください", MaxFriendInviteMaxUses))
+		return nil, fmt.Errorf("招待トークンの設定が不正です: %w", reason)
+	}
+	if input.ExpiresIn == 0 {
+		input.ExpiresIn = DefaultFriendInviteTTL
+	}
+	if input.ExpiresIn < 0 || input.ExpiresIn > MaxFriendInviteTTL {
+		reason := valueobject.NGWithCode(valueobject.ReasonCodeOutOfRange, "expires_in_minutes", fmt.Sprintf("有効期間は%d分以内で指定してください", int(MaxFriendInviteTTL.Minutes())))
+		return nil, fmt.Errorf("招待トークンの設定が不正です: %w", reason)
+	}
+
+	// 発行者の存在確認
+	inviter, err := uc.userRepo.FindByID(ctx, input.InviterID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("ユーザーの確認中にエラーが発生しました: %w", err)
+	}
+
+	token, err := generateFriendInviteToken()
+	if err != nil {
+		return nil, fmt.Errorf("招待トークンの生成に失敗しました: %w", err)
+	}
+
+	now := uc.now()
+	invite := &entity.FriendInvite{
+		Token:     token,
+		InviterID: inviter.ID,
+		MaxUses:   input.MaxUses,
+		ExpiresAt: now.Add(input.ExpiresIn),
+		CreatedAt: now,
+	}
+	if err := uc.inviteRepo.Create(ctx, invite); err != nil {
+		return nil, fmt.Errorf("招待トークンの保存に失敗しました: %w", err)
+	}
+
+	return &GenerateFriendInviteTokenOutput{
+		Invite: invite,
+		URI:    FriendInviteURI(token),
+	}, nil
+}
+
+// FriendInviteURI は招待トークンからQRコードに埋め込む招待URIを生成する
+func FriendInviteURI(token string) string {
+	return FriendInviteURIBase + "?" + url.Values{"token": {token}}.Encode()
+}
+
+// generateFriendInviteToken は推測困難な招待トークンを生成する（URLにそのまま埋め込める形式）
+func generateFriendInviteToken() (string, error) {
+	b := make([]byte, friendInviteTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("ランダムバイト生成に失敗しました: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
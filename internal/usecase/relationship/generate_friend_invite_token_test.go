@@ -0,0 +1,111 @@
+package relationship
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestGenerateFriendInviteTokenUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	userRepo := memory.NewUserRepository()
+	if err := userRepo.Create(ctx, &entity.User{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"}); err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	inviteRepo := memory.NewFriendInviteRepository()
+
+	uc := NewGenerateFriendInviteTokenUseCase(inviteRepo, userRepo)
+	uc.now = func() time.Time { return now }
+
+	tests := []struct {
+		name        string
+		input       GenerateFriendInviteTokenInput
+		wantMaxUses int
+		wantExpires time.Time
+		wantErr     string
+	}{
+		{
+			name:        "デフォルトは1回限り・10分間有効",
+			input:       GenerateFriendInviteTokenInput{InviterID: "user1"},
+			wantMaxUses: DefaultFriendInviteMaxUses,
+			wantExpires: now.Add(DefaultFriendInviteTTL),
+		},
+		{
+			name:        "使用回数と有効期間を指定できる",
+			input:       GenerateFriendInviteTokenInput{InviterID: "user1", MaxUses: 5, ExpiresIn: time.Hour},
+			wantMaxUses: 5,
+			wantExpires: now.Add(time.Hour),
+		},
+		{
+			name:    "ユーザーID未指定",
+			input:   GenerateFriendInviteTokenInput{},
+			wantErr: "ユーザーIDは必須です",
+		},
+		{
+			name:    "使用回数が上限を超える",
+			input:   GenerateFriendInviteTokenInput{InviterID: "user1", MaxUses: MaxFriendInviteMaxUses + 1},
+			wantErr: "使用回数の上限は1〜20回で指定してください",
+		},
+		{
+			name:    "有効期間が長すぎる",
+			input:   GenerateFriendInviteTokenInput{InviterID: "user1", ExpiresIn: MaxFriendInviteTTL + time.Minute},
+			wantErr: "有効期間は1440分以内で指定してください",
+		},
+		{
+			name:    "存在しないユーザー",
+			input:   GenerateFriendInviteTokenInput{InviterID: "unknown"},
+			wantErr: "ユーザーが見つかりません",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := uc.Execute(ctx, tt.input)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if output.Invite.MaxUses != tt.wantMaxUses || !output.Invite.ExpiresAt.Equal(tt.wantExpires) {
+				t.Errorf("invite = %+v, want MaxUses=%d ExpiresAt=%v", output.Invite, tt.wantMaxUses, tt.wantExpires)
+			}
+			if _, err := inviteRepo.FindByToken(ctx, output.Invite.Token); err != nil {
+				t.Errorf("invite was not saved: %v", err)
+			}
+
+			uri, err := url.Parse(output.URI)
+			if err != nil {
+				t.Fatalf("failed to parse URI %q: %v", output.URI, err)
+			}
+			if !strings.HasPrefix(output.URI, FriendInviteURIBase+"?") || uri.Query().Get("token") != output.Invite.Token {
+				t.Errorf("URI = %q, want %s with token %s", output.URI, FriendInviteURIBase, output.Invite.Token)
+			}
+		})
+	}
+
+	t.Run("発行するたびに異なるトークンになる", func(t *testing.T) {
+		first, err := uc.Execute(ctx, GenerateFriendInviteTokenInput{InviterID: "user1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		second, err := uc.Execute(ctx, GenerateFriendInviteTokenInput{InviterID: "user1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if first.Invite.Token == second.Invite.Token {
+			t.Errorf("tokens should differ: %s", first.Invite.Token)
+		}
+	})
+}
@@ -4,32 +4,48 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/ochamu/morning-call-api/internal/domain/entity"
 	"github.com/ochamu/morning-call-api/internal/domain/repository"
 	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
 )
 
+// friendActivityHistoryLimit は直近のやり取りを集計する際に取得する送受信それぞれの履歴件数
+const friendActivityHistoryLimit = 10000
+
+// FriendSortOrder は友達リストの並び順を表す
+type FriendSortOrder string
+
+const (
+	FriendSortOrderDefault  FriendSortOrder = ""         // リポジトリから返される順序
+	FriendSortOrderActivity FriendSortOrder = "activity" // 直近のやり取りの新しい順（やり取りのない友達は末尾）
+)
+
 // ListFriendsUseCase は友達リスト取得のユースケース
 type ListFriendsUseCase struct {
 	relationshipRepo repository.RelationshipRepository
 	userRepo         repository.UserRepository
+	morningCallRepo  repository.MorningCallRepository
 }
 
 // NewListFriendsUseCase は新しい友達リスト取得ユースケースを作成する
 func NewListFriendsUseCase(
 	relationshipRepo repository.RelationshipRepository,
 	userRepo repository.UserRepository,
+	morningCallRepo repository.MorningCallRepository,
 ) *ListFriendsUseCase {
 	return &ListFriendsUseCase{
 		relationshipRepo: relationshipRepo,
 		userRepo:         userRepo,
+		morningCallRepo:  morningCallRepo,
 	}
 }
 
 // ListFriendsInput は友達リスト取得の入力データ
 type ListFriendsInput struct {
-	UserID string // 友達リストを取得するユーザーID
+	UserID string          // 友達リストを取得するユーザーID
+	Sort   FriendSortOrder // オプション：並び順（デフォルトはリポジトリから返される順序）
 }
 
 // FriendInfo は友達情報
@@ -38,6 +54,7 @@ type FriendInfo struct {
 	Relationship *entity.Relationship // 関係情報
 	IsRequester  bool                 // 自分がリクエスト送信者かどうか
 	FriendSince  string               // 友達になった日時（文字列表現）
+	LatestCall   *entity.MorningCall  // 直近のモーニングコール（送受信を問わない。やり取りがない場合はnil）
 }
 
 // ListFriendsOutput は友達リスト取得の出力データ
@@ -52,6 +69,9 @@ func (uc *ListFriendsUseCase) Execute(ctx context.Context, input ListFriendsInpu
 	if input.UserID == "" {
 		return nil, fmt.Errorf("ユーザーIDは必須です")
 	}
+	if input.Sort != FriendSortOrderDefault && input.Sort != FriendSortOrderActivity {
+		return nil, fmt.Errorf("並び順が不正です")
+	}
 
 	// ユーザーの存在確認
 	user, err := uc.userRepo.FindByID(ctx, input.UserID)
@@ -78,54 +98,109 @@ func (uc *ListFriendsUseCase) Execute(ctx context.Context, input ListFriendsInpu
 	}
 
 	// 友達（Acceptedステータス）のみをフィルタリング
-	var friends []FriendInfo
+	var friendRels []*entity.Relationship
+	var friendIDs []string
 	for _, rel := range relationships {
 		// Acceptedステータスのみを対象とする
 		if rel.Status != valueobject.RelationshipStatusAccepted {
 			continue
 		}
+		friendRels = append(friendRels, rel)
+		friendIDs = append(friendIDs, rel.GetOtherUserID(user.ID))
+	}
 
+	// 友達のユーザー情報をまとめて取得（友達ごとの問い合わせを避ける）
+	friendUsers, err := uc.userRepo.FindByIDs(ctx, friendIDs)
+	if err != nil {
+		return nil, fmt.Errorf("友達情報の取得中にエラーが発生しました: %w", err)
+	}
+	usersByID := make(map[string]*entity.User, len(friendUsers))
+	for _, u := range friendUsers {
+		usersByID[u.ID] = u
+	}
+
+	// 直近のやり取りを相手ごとにまとめて解決
+	latestCalls, err := uc.findLatestCalls(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var friends []FriendInfo
+	for _, rel := range friendRels {
 		// 友達のユーザーIDを特定
-		var friendID string
-		var isRequester bool
-		if rel.RequesterID == user.ID {
-			friendID = rel.ReceiverID
-			isRequester = true
-		} else {
-			friendID = rel.RequesterID
-			isRequester = false
-		}
+		friendID := rel.GetOtherUserID(user.ID)
 
-		// 友達のユーザー情報を取得
-		friendUser, err := uc.userRepo.FindByID(ctx, friendID)
-		if err != nil {
-			// ユーザーが削除されている場合はスキップ
-			if errors.Is(err, repository.ErrNotFound) {
-				// 削除されたユーザーとの友達関係は表示しない
-				// ただし、データクリーンアップの観点から、
-				// 将来的には削除されたユーザーとの関係も削除する処理を検討
-				continue
-			}
-			return nil, fmt.Errorf("友達情報の取得中にエラーが発生しました: %w", err)
+		// 削除されたユーザーとの友達関係は表示しない
+		// ただし、データクリーンアップの観点から、
+		// 将来的には削除されたユーザーとの関係も削除する処理を検討
+		friendUser, exists := usersByID[friendID]
+		if !exists {
+			continue
 		}
 
 		// 友達情報を構築
 		friendInfo := FriendInfo{
 			User:         friendUser,
 			Relationship: rel,
-			IsRequester:  isRequester,
+			IsRequester:  rel.RequesterID == user.ID,
 			FriendSince:  rel.UpdatedAt.Format("2006-01-02 15:04:05"), // 承認日時（UpdatedAt）を友達になった日時とする
+			LatestCall:   latestCalls[friendID],
 		}
 
 		friends = append(friends, friendInfo)
 	}
 
-	// 友達リストを友達になった日時の新しい順にソート
-	// 実装の簡略化のため、現在はリポジトリから返される順序のまま
-	// 将来的には、UpdatedAtでソートする処理を追加することも検討
+	if input.Sort == FriendSortOrderActivity {
+		sortFriendsByActivity(friends)
+	}
 
 	return &ListFriendsOutput{
 		Friends:    friends,
 		TotalCount: len(friends),
 	}, nil
 }
+
+// findLatestCalls はユーザーの送受信履歴から、相手ごとの直近のモーニングコールを求める
+// 直近はアラーム時刻が最も新しいものとする
+func (uc *ListFriendsUseCase) findLatestCalls(ctx context.Context, userID string) (map[string]*entity.MorningCall, error) {
+	// 相手ごとの直近のコールだけを残すため、読み取り専用ビューで比較してから残ったものだけをコピーする
+	sentCalls, err := uc.morningCallRepo.FindReadOnlyBySenderID(ctx, userID, 0, friendActivityHistoryLimit)
+	if err != nil {
+		return nil, fmt.Errorf("送信したモーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+	receivedCalls, err := uc.morningCallRepo.FindReadOnlyByReceiverID(ctx, userID, 0, friendActivityHistoryLimit)
+	if err != nil {
+		return nil, fmt.Errorf("受信したモーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+
+	latestViews := make(map[string]entity.ReadOnlyMorningCall)
+	record := func(otherID string, mc entity.ReadOnlyMorningCall) {
+		if current, exists := latestViews[otherID]; !exists || mc.ScheduledTime().After(current.ScheduledTime()) {
+			latestViews[otherID] = mc
+		}
+	}
+	for _, mc := range sentCalls {
+		record(mc.ReceiverID(), mc)
+	}
+	for _, mc := range receivedCalls {
+		record(mc.SenderID(), mc)
+	}
+
+	latest := make(map[string]*entity.MorningCall, len(latestViews))
+	for otherID, mc := range latestViews {
+		latest[otherID] = mc.Clone()
+	}
+	return latest, nil
+}
+
+// sortFriendsByActivity は友達を直近のやり取りの新しい順に並べる
+// やり取りのない友達は末尾に置き、元の順序を保つ
+func sortFriendsByActivity(friends []FriendInfo) {
+	sort.SliceStable(friends, func(i, j int) bool {
+		a, b := friends[i].LatestCall, friends[j].LatestCall
+		if a == nil || b == nil {
+			return a != nil && b == nil
+		}
+		return a.ScheduledTime.After(b.ScheduledTime)
+	})
+}
@@ -15,7 +15,7 @@ func TestNewListFriendsUseCase(t *testing.T) {
 	relationshipRepo := memory.NewRelationshipRepository()
 	userRepo := memory.NewUserRepository()
 
-	uc := NewListFriendsUseCase(relationshipRepo, userRepo)
+	uc := NewListFriendsUseCase(relationshipRepo, userRepo, memory.NewMorningCallRepository())
 
 	if uc == nil {
 		t.Fatal("NewListFriendsUseCase returned nil")
@@ -26,6 +26,9 @@ func TestNewListFriendsUseCase(t *testing.T) {
 	if uc.userRepo == nil {
 		t.Error("userRepo is nil")
 	}
+	if uc.morningCallRepo == nil {
+		t.Error("morningCallRepo is nil")
+	}
 }
 
 func TestListFriendsUseCase_Execute(t *testing.T) {
@@ -464,7 +467,7 @@ func TestListFriendsUseCase_Execute(t *testing.T) {
 			}
 
 			// UseCaseを作成して実行
-			uc := NewListFriendsUseCase(relationshipRepo, userRepo)
+			uc := NewListFriendsUseCase(relationshipRepo, userRepo, memory.NewMorningCallRepository())
 			output, err := uc.Execute(ctx, tt.input)
 
 			// エラーチェック
@@ -486,3 +489,87 @@ func TestListFriendsUseCase_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestListFriendsUseCase_Execute_LatestCall(t *testing.T) {
+	ctx := context.Background()
+	base := time.Now().Add(24 * time.Hour)
+
+	relationshipRepo := memory.NewRelationshipRepository()
+	userRepo := memory.NewUserRepository()
+	morningCallRepo := memory.NewMorningCallRepository()
+
+	for _, id := range []string{"me", "sent-friend", "received-friend", "quiet-friend"} {
+		if err := userRepo.Create(ctx, &entity.User{ID: id, Username: id, Email: id + "@example.com", PasswordHash: "hashed"}); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+	for i, friendID := range []string{"quiet-friend", "sent-friend", "received-friend"} {
+		if err := relationshipRepo.Create(ctx, &entity.Relationship{
+			ID:          "rel-" + friendID,
+			RequesterID: "me",
+			ReceiverID:  friendID,
+			Status:      valueobject.RelationshipStatusAccepted,
+			CreatedAt:   base.Add(time.Duration(i) * time.Minute),
+			UpdatedAt:   base.Add(time.Duration(i) * time.Minute),
+		}); err != nil {
+			t.Fatalf("failed to create relationship: %v", err)
+		}
+	}
+	calls := []*entity.MorningCall{
+		{ID: "mc-sent-old", SenderID: "me", ReceiverID: "sent-friend", ScheduledTime: base, Status: valueobject.MorningCallStatusConfirmed},
+		{ID: "mc-sent-new", SenderID: "me", ReceiverID: "sent-friend", ScheduledTime: base.Add(2 * time.Hour), Status: valueobject.MorningCallStatusScheduled},
+		{ID: "mc-received", SenderID: "received-friend", ReceiverID: "me", ScheduledTime: base.Add(5 * time.Hour), Status: valueobject.MorningCallStatusScheduled},
+	}
+	for _, mc := range calls {
+		if err := morningCallRepo.Create(ctx, mc); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+
+	uc := NewListFriendsUseCase(relationshipRepo, userRepo, morningCallRepo)
+
+	t.Run("送受信を問わず直近のコールを付与する", func(t *testing.T) {
+		output, err := uc.Execute(ctx, ListFriendsInput{UserID: "me"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		latest := make(map[string]*entity.MorningCall)
+		for _, f := range output.Friends {
+			latest[f.User.ID] = f.LatestCall
+		}
+		if mc := latest["sent-friend"]; mc == nil || mc.ID != "mc-sent-new" {
+			t.Errorf("sent-friend LatestCall = %v, want mc-sent-new", mc)
+		}
+		if mc := latest["received-friend"]; mc == nil || mc.ID != "mc-received" {
+			t.Errorf("received-friend LatestCall = %v, want mc-received", mc)
+		}
+		if mc := latest["quiet-friend"]; mc != nil {
+			t.Errorf("quiet-friend LatestCall = %v, want nil", mc)
+		}
+	})
+
+	t.Run("活動日時の新しい順に並べ替える", func(t *testing.T) {
+		output, err := uc.Execute(ctx, ListFriendsInput{UserID: "me", Sort: FriendSortOrderActivity})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		want := []string{"received-friend", "sent-friend", "quiet-friend"}
+		if len(output.Friends) != len(want) {
+			t.Fatalf("Friends count = %d, want %d", len(output.Friends), len(want))
+		}
+		for i, id := range want {
+			if output.Friends[i].User.ID != id {
+				t.Errorf("Friends[%d] = %s, want %s", i, output.Friends[i].User.ID, id)
+			}
+		}
+	})
+
+	t.Run("不正な並び順はエラー", func(t *testing.T) {
+		_, err := uc.Execute(ctx, ListFriendsInput{UserID: "me", Sort: "name"})
+		if err == nil || err.Error() != "並び順が不正です" {
+			t.Errorf("error = %v, want 並び順が不正です", err)
+		}
+	})
+}
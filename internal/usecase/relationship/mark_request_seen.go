@@ -0,0 +1,93 @@
+package relationship
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// MarkRequestSeenUseCase は受信した友達リクエストを既読にするユースケース
+type MarkRequestSeenUseCase struct {
+	relationshipRepo repository.RelationshipRepository
+	userRepo         repository.UserRepository
+}
+
+// NewMarkRequestSeenUseCase は新しい友達リクエスト既読化ユースケースを作成する
+func NewMarkRequestSeenUseCase(
+	relationshipRepo repository.RelationshipRepository,
+	userRepo repository.UserRepository,
+) *MarkRequestSeenUseCase {
+	return &MarkRequestSeenUseCase{
+		relationshipRepo: relationshipRepo,
+		userRepo:         userRepo,
+	}
+}
+
+// MarkRequestSeenInput は友達リクエスト既読化の入力データ
+type MarkRequestSeenInput struct {
+	RelationshipID string // 既読にする関係ID
+	ReceiverID     string // リクエスト受信者のユーザーID
+}
+
+// MarkRequestSeenOutput は友達リクエスト既読化の出力データ
+type MarkRequestSeenOutput struct {
+	Relationship *entity.Relationship
+}
+
+// Execute は受信者宛の承認待ちリクエストを既読にする
+// 既読済みのリクエストに対しては何もせず成功を返す
+func (uc *MarkRequestSeenUseCase) Execute(ctx context.Context, input MarkRequestSeenInput) (*MarkRequestSeenOutput, error) {
+	// 入力値の基本検証
+	if input.RelationshipID == "" {
+		return nil, fmt.Errorf("関係IDは必須です")
+	}
+	if input.ReceiverID == "" {
+		return nil, fmt.Errorf("受信者IDは必須です")
+	}
+
+	// 受信者の存在確認
+	receiver, err := uc.userRepo.FindByID(ctx, input.ReceiverID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("受信者が見つかりません")
+		}
+		return nil, fmt.Errorf("受信者の確認中にエラーが発生しました: %w", err)
+	}
+
+	// 関係の取得
+	relationship, err := uc.relationshipRepo.FindByID(ctx, input.RelationshipID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("友達リクエストが見つかりません")
+		}
+		return nil, fmt.Errorf("友達リクエストの取得中にエラーが発生しました: %w", err)
+	}
+
+	// 既読化権限の確認（リクエスト受信者のみが既読化可能）
+	if !relationship.IsReceiver(receiver.ID) {
+		return nil, fmt.Errorf("このリクエストを既読にする権限がありません")
+	}
+
+	// 既読済みの場合は更新しない
+	if relationship.SeenByReceiver {
+		return &MarkRequestSeenOutput{
+			Relationship: relationship,
+		}, nil
+	}
+
+	if reason := relationship.MarkSeenBy(receiver.ID); reason.IsNG() {
+		return nil, fmt.Errorf("友達リクエストを既読にできませんでした: %w", reason)
+	}
+
+	// リポジトリで更新
+	if err := uc.relationshipRepo.Update(ctx, relationship); err != nil {
+		return nil, fmt.Errorf("友達リクエストの既読化に失敗しました: %w", err)
+	}
+
+	return &MarkRequestSeenOutput{
+		Relationship: relationship,
+	}, nil
+}
@@ -0,0 +1,210 @@
+package relationship
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+// setupSeenTestRepos は既読化テスト用のユーザーと関係を作成する
+func setupSeenTestRepos(t *testing.T, status valueobject.RelationshipStatus, seen bool) (*memory.RelationshipRepository, *memory.UserRepository) {
+	t.Helper()
+	ctx := context.Background()
+
+	relationshipRepo := memory.NewRelationshipRepository()
+	userRepo := memory.NewUserRepository()
+
+	for _, u := range []*entity.User{
+		{ID: "requester1", Username: "alice", Email: "alice@example.com", PasswordHash: "hash"},
+		{ID: "receiver1", Username: "bob", Email: "bob@example.com", PasswordHash: "hash"},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+
+	relationship := &entity.Relationship{
+		ID:             "rel1",
+		RequesterID:    "requester1",
+		ReceiverID:     "receiver1",
+		Status:         status,
+		SeenByReceiver: seen,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+	if err := relationshipRepo.Create(ctx, relationship); err != nil {
+		t.Fatalf("failed to create relationship: %v", err)
+	}
+
+	return relationshipRepo, userRepo
+}
+
+func TestMarkRequestSeenUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		status    valueobject.RelationshipStatus
+		seen      bool
+		requester string
+		wantErr   bool
+		errMsg    string
+	}{
+		{
+			name:      "受信者が未読のリクエストを既読にする",
+			status:    valueobject.RelationshipStatusPending,
+			requester: "receiver1",
+		},
+		{
+			name:      "既読済みのリクエストは何もせず成功する",
+			status:    valueobject.RelationshipStatusPending,
+			seen:      true,
+			requester: "receiver1",
+		},
+		{
+			name:      "送信者は既読にできない",
+			status:    valueobject.RelationshipStatusPending,
+			requester: "requester1",
+			wantErr:   true,
+			errMsg:    "このリクエストを既読にする権限がありません",
+		},
+		{
+			name:      "承認済みのリクエストは既読にできない",
+			status:    valueobject.RelationshipStatusAccepted,
+			requester: "receiver1",
+			wantErr:   true,
+			errMsg:    "承認待ち状態のリクエストのみ既読にできます",
+		},
+		{
+			name:      "存在しないユーザー",
+			status:    valueobject.RelationshipStatusPending,
+			requester: "unknown",
+			wantErr:   true,
+			errMsg:    "受信者が見つかりません",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			relationshipRepo, userRepo := setupSeenTestRepos(t, tt.status, tt.seen)
+
+			uc := NewMarkRequestSeenUseCase(relationshipRepo, userRepo)
+			output, err := uc.Execute(ctx, MarkRequestSeenInput{
+				RelationshipID: "rel1",
+				ReceiverID:     tt.requester,
+			})
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error but got nil")
+				}
+				if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("error = %v, want containing %v", err, tt.errMsg)
+				}
+				persisted, _ := relationshipRepo.FindByID(ctx, "rel1")
+				if persisted.SeenByReceiver != tt.seen {
+					t.Errorf("SeenByReceiver changed on error: got %v", persisted.SeenByReceiver)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !output.Relationship.SeenByReceiver {
+				t.Error("SeenByReceiver = false, want true")
+			}
+
+			persisted, err := relationshipRepo.FindByID(ctx, "rel1")
+			if err != nil {
+				t.Fatalf("failed to get persisted relationship: %v", err)
+			}
+			if !persisted.SeenByReceiver {
+				t.Error("persisted SeenByReceiver = false, want true")
+			}
+		})
+	}
+
+	t.Run("存在しない関係", func(t *testing.T) {
+		relationshipRepo, userRepo := setupSeenTestRepos(t, valueobject.RelationshipStatusPending, false)
+
+		uc := NewMarkRequestSeenUseCase(relationshipRepo, userRepo)
+		_, err := uc.Execute(ctx, MarkRequestSeenInput{
+			RelationshipID: "missing",
+			ReceiverID:     "receiver1",
+		})
+		if err == nil || !strings.Contains(err.Error(), "友達リクエストが見つかりません") {
+			t.Errorf("error = %v, want not found", err)
+		}
+	})
+}
+
+func TestMarkRequestSeen_AcceptAndReject(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		execute func(*memory.RelationshipRepository, *memory.UserRepository) error
+	}{
+		{
+			name: "承認すると未読件数から外れる",
+			execute: func(rr *memory.RelationshipRepository, ur *memory.UserRepository) error {
+				_, err := NewAcceptFriendRequestUseCase(rr, ur, nil, nil).Execute(ctx, AcceptFriendRequestInput{
+					RelationshipID: "rel1",
+					ReceiverID:     "receiver1",
+				})
+				return err
+			},
+		},
+		{
+			name: "拒否すると未読件数から外れる",
+			execute: func(rr *memory.RelationshipRepository, ur *memory.UserRepository) error {
+				_, err := NewRejectFriendRequestUseCase(rr, ur).Execute(ctx, RejectFriendRequestInput{
+					RelationshipID: "rel1",
+					ReceiverID:     "receiver1",
+				})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			relationshipRepo, userRepo := setupSeenTestRepos(t, valueobject.RelationshipStatusPending, false)
+			countUC := NewUnseenRequestCountUseCase(relationshipRepo)
+
+			before, err := countUC.Execute(ctx, UnseenRequestCountInput{ReceiverID: "receiver1"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if before.Count != 1 {
+				t.Fatalf("Count before = %d, want 1", before.Count)
+			}
+
+			if err := tt.execute(relationshipRepo, userRepo); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			after, err := countUC.Execute(ctx, UnseenRequestCountInput{ReceiverID: "receiver1"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if after.Count != 0 {
+				t.Errorf("Count after = %d, want 0", after.Count)
+			}
+
+			persisted, err := relationshipRepo.FindByID(ctx, "rel1")
+			if err != nil {
+				t.Fatalf("failed to get persisted relationship: %v", err)
+			}
+			if !persisted.SeenByReceiver {
+				t.Error("SeenByReceiver = false, want true")
+			}
+		})
+	}
+}
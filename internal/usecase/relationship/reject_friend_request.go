@@ -97,7 +97,7 @@ func (uc *RejectFriendRequestUseCase) Execute(ctx context.Context, input RejectF
 
 	// 拒否処理を実行
 	if reason := relationship.Reject(); reason.IsNG() {
-		return nil, fmt.Errorf("友達リクエストの拒否に失敗しました: %s", reason)
+		return nil, fmt.Errorf("友達リクエストの拒否に失敗しました: %w", reason)
 	}
 
 	// 更新日時を設定
@@ -13,16 +13,23 @@ import (
 type RemoveRelationshipUseCase struct {
 	relationshipRepo repository.RelationshipRepository
 	userRepo         repository.UserRepository
+	morningCallRepo  repository.MorningCallRepository
+	txManager        repository.TransactionManager
 }
 
 // NewRemoveRelationshipUseCase は新しい関係削除ユースケースを作成する
+// txManagerがnilの場合はトランザクションを使用せずリポジトリを直接更新する
 func NewRemoveRelationshipUseCase(
 	relationshipRepo repository.RelationshipRepository,
 	userRepo repository.UserRepository,
+	morningCallRepo repository.MorningCallRepository,
+	txManager repository.TransactionManager,
 ) *RemoveRelationshipUseCase {
 	return &RemoveRelationshipUseCase{
 		relationshipRepo: relationshipRepo,
 		userRepo:         userRepo,
+		morningCallRepo:  morningCallRepo,
+		txManager:        txManager,
 	}
 }
 
@@ -34,8 +41,9 @@ type RemoveRelationshipInput struct {
 
 // RemoveRelationshipOutput は関係削除の出力データ
 type RemoveRelationshipOutput struct {
-	Success bool
-	Message string
+	Success        bool
+	Message        string
+	CancelledCalls int // 友達関係の解除に伴いキャンセルしたモーニングコール数
 }
 
 // Execute は関係を削除する
@@ -57,8 +65,19 @@ func (uc *RemoveRelationshipUseCase) Execute(ctx context.Context, input RemoveRe
 		return nil, fmt.Errorf("ユーザーの確認中にエラーが発生しました: %w", err)
 	}
 
+	// トランザクションを開始（関係の削除とコールのキャンセルを原子的に行う）
+	repos, tx, err := beginTx(ctx, uc.txManager, repository.TxRepositories{
+		User:         uc.userRepo,
+		MorningCall:  uc.morningCallRepo,
+		Relationship: uc.relationshipRepo,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("トランザクションの開始に失敗しました: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }() // コミット済みの場合は何もしない
+
 	// 関係の取得
-	relationship, err := uc.relationshipRepo.FindByID(ctx, input.RelationshipID)
+	relationship, err := repos.Relationship.FindByID(ctx, input.RelationshipID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, fmt.Errorf("関係が見つかりません")
@@ -120,13 +139,26 @@ func (uc *RemoveRelationshipUseCase) Execute(ctx context.Context, input RemoveRe
 	}
 
 	// リポジトリから削除
-	if err := uc.relationshipRepo.Delete(ctx, relationship.ID); err != nil {
+	if err := repos.Relationship.Delete(ctx, relationship.ID); err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, fmt.Errorf("削除対象の関係が見つかりません")
 		}
 		return nil, fmt.Errorf("関係の削除に失敗しました: %w", err)
 	}
 
+	// 友達関係の解除時は、二人の間のアクティブなモーニングコールをキャンセルする
+	cancelledCalls := 0
+	if relationship.Status == valueobject.RelationshipStatusAccepted && repos.MorningCall != nil {
+		cancelledCalls, err = cancelActiveCallsBetween(ctx, repos.MorningCall, relationship.RequesterID, relationship.ReceiverID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("関係の削除に失敗しました: %w", err)
+	}
+
 	// 削除成功メッセージの生成
 	var message string
 	switch relationship.Status {
@@ -145,13 +177,33 @@ func (uc *RemoveRelationshipUseCase) Execute(ctx context.Context, input RemoveRe
 	}
 
 	// ログ出力（システムイベント）
-	// 実際の実装では、ここで関連するモーニングコールの削除や
-	// 通知サービスの呼び出しを行うことも考えられる
+	// 実際の実装では、ここで通知サービスの呼び出しを行うことも考えられる
 	_ = user      // 削除実行者のログ用（将来の拡張用）
 	_ = otherUser // 相手ユーザーのログ用（将来の拡張用）
 
 	return &RemoveRelationshipOutput{
-		Success: true,
-		Message: message,
+		Success:        true,
+		Message:        message,
+		CancelledCalls: cancelledCalls,
 	}, nil
 }
+
+// cancelActiveCallsBetween は2人のユーザー間（双方向）のアクティブなモーニングコールをキャンセルする
+func cancelActiveCallsBetween(ctx context.Context, morningCallRepo repository.MorningCallRepository, userID1, userID2 string) (int, error) {
+	calls, err := morningCallRepo.FindActiveBetweenUsers(ctx, userID1, userID2)
+	if err != nil {
+		return 0, fmt.Errorf("モーニングコールの取得中にエラーが発生しました: %w", err)
+	}
+
+	cancelled := 0
+	for _, call := range calls {
+		if reason := call.Cancel(); reason.IsNG() {
+			return cancelled, fmt.Errorf("モーニングコールのキャンセルに失敗しました: %w", reason)
+		}
+		if err := morningCallRepo.Update(ctx, call); err != nil {
+			return cancelled, fmt.Errorf("モーニングコールのキャンセルに失敗しました: %w", err)
+		}
+		cancelled++
+	}
+	return cancelled, nil
+}
@@ -15,7 +15,7 @@ func TestNewRemoveRelationshipUseCase(t *testing.T) {
 	relationshipRepo := memory.NewRelationshipRepository()
 	userRepo := memory.NewUserRepository()
 
-	uc := NewRemoveRelationshipUseCase(relationshipRepo, userRepo)
+	uc := NewRemoveRelationshipUseCase(relationshipRepo, userRepo, nil, nil)
 
 	if uc == nil {
 		t.Fatal("NewRemoveRelationshipUseCase returned nil")
@@ -474,7 +474,7 @@ func TestRemoveRelationshipUseCase_Execute(t *testing.T) {
 			}
 
 			// UseCaseを作成して実行
-			uc := NewRemoveRelationshipUseCase(relationshipRepo, userRepo)
+			uc := NewRemoveRelationshipUseCase(relationshipRepo, userRepo, nil, nil)
 			output, err := uc.Execute(ctx, tt.input)
 
 			// エラーチェック
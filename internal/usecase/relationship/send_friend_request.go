@@ -16,16 +16,26 @@ import (
 type SendFriendRequestUseCase struct {
 	relationshipRepo repository.RelationshipRepository
 	userRepo         repository.UserRepository
+	quotas           valueobject.PlanQuotas
+	resendPolicy     valueobject.FriendRequestResendPolicy
+	now              func() time.Time
 }
 
 // NewSendFriendRequestUseCase は新しい友達リクエスト送信ユースケースを作成する
+// quotasがnilの場合はプラン別の上限を適用しない
+// resendPolicyは拒否された相手へ同じリクエストを再送信する際の待機期間と回数の制限
 func NewSendFriendRequestUseCase(
 	relationshipRepo repository.RelationshipRepository,
 	userRepo repository.UserRepository,
+	quotas valueobject.PlanQuotas,
+	resendPolicy valueobject.FriendRequestResendPolicy,
 ) *SendFriendRequestUseCase {
 	return &SendFriendRequestUseCase{
 		relationshipRepo: relationshipRepo,
 		userRepo:         userRepo,
+		quotas:           quotas,
+		resendPolicy:     resendPolicy,
+		now:              time.Now,
 	}
 }
 
@@ -70,6 +80,10 @@ func (uc *SendFriendRequestUseCase) Execute(ctx context.Context, input SendFrien
 		}
 		return nil, fmt.Errorf("リクエスト受信者の確認中にエラーが発生しました: %w", err)
 	}
+	// 削除予定のアカウントは猶予期間の経過後に関係ごと削除されるため、新しいリクエストを受け付けない
+	if receiver.IsPendingDeletion() {
+		return nil, fmt.Errorf("リクエスト受信者はアカウントの削除を予定しているため、友達リクエストを送信できません")
+	}
 
 	// 既存の関係を確認
 	existingRelationship, err := uc.relationshipRepo.FindByUserPair(ctx, input.RequesterID, input.ReceiverID)
@@ -98,15 +112,14 @@ func (uc *SendFriendRequestUseCase) Execute(ctx context.Context, input SendFrien
 		case valueobject.RelationshipStatusRejected:
 			// 以前に拒否されたリクエストの場合
 			if existingRelationship.RequesterID == input.RequesterID {
-				// 同じ方向のリクエストで拒否済みの場合、再送信を試みる
-				now := time.Now()
-				// 拒否から24時間経過していない場合はエラー
-				if existingRelationship.UpdatedAt.Add(24 * time.Hour).After(now) {
-					return nil, fmt.Errorf("友達リクエストが拒否されました。24時間後に再送信できます")
+				// 同じ方向のリクエストで拒否済みの場合、再送信の制限の範囲内であれば再送信する
+				// （自動失効したリクエストは拒否後の待機期間なしで再送信できる）
+				if reason := existingRelationship.ResendWithPolicy(uc.resendPolicy, uc.now()); reason.IsNG() {
+					return nil, fmt.Errorf("%w", reason)
 				}
-				// 24時間経過している場合は再送信
-				if reason := existingRelationship.Resend(); reason.IsNG() {
-					return nil, fmt.Errorf("友達リクエストの再送信に失敗しました: %s", reason)
+				// プラン別クォータの確認
+				if err := checkFriendQuota(ctx, uc.relationshipRepo, uc.quotas, requester); err != nil {
+					return nil, err
 				}
 				// リポジトリで更新
 				if err := uc.relationshipRepo.Update(ctx, existingRelationship); err != nil {
@@ -120,6 +133,11 @@ func (uc *SendFriendRequestUseCase) Execute(ctx context.Context, input SendFrien
 		}
 	}
 
+	// プラン別クォータの確認
+	if err := checkFriendQuota(ctx, uc.relationshipRepo, uc.quotas, requester); err != nil {
+		return nil, err
+	}
+
 	// UUIDを生成
 	id, err := utils.GenerateUUID()
 	if err != nil {
@@ -129,7 +147,7 @@ func (uc *SendFriendRequestUseCase) Execute(ctx context.Context, input SendFrien
 	// 友達関係エンティティを作成
 	relationship, reason := entity.NewRelationship(id, requester.ID, receiver.ID)
 	if reason.IsNG() {
-		return nil, fmt.Errorf("友達リクエストの作成に失敗しました: %s", reason)
+		return nil, fmt.Errorf("友達リクエストの作成に失敗しました: %w", reason)
 	}
 
 	// リポジトリに保存
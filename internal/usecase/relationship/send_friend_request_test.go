@@ -15,7 +15,7 @@ func TestNewSendFriendRequestUseCase(t *testing.T) {
 	relationshipRepo := memory.NewRelationshipRepository()
 	userRepo := memory.NewUserRepository()
 
-	uc := NewSendFriendRequestUseCase(relationshipRepo, userRepo)
+	uc := NewSendFriendRequestUseCase(relationshipRepo, userRepo, nil, valueobject.DefaultFriendRequestResendPolicy())
 
 	if uc == nil {
 		t.Fatal("NewSendFriendRequestUseCase returned nil")
@@ -110,7 +110,7 @@ func TestSendFriendRequestUseCase_Execute(t *testing.T) {
 	}
 
 	// UseCaseを作成
-	uc := NewSendFriendRequestUseCase(relationshipRepo, userRepo)
+	uc := NewSendFriendRequestUseCase(relationshipRepo, userRepo, nil, valueobject.DefaultFriendRequestResendPolicy())
 
 	tests := []struct {
 		name        string
@@ -265,7 +265,7 @@ func TestSendFriendRequestUseCase_Execute_DuplicateRequest(t *testing.T) {
 	}
 
 	// UseCaseを作成
-	uc := NewSendFriendRequestUseCase(relationshipRepo, userRepo)
+	uc := NewSendFriendRequestUseCase(relationshipRepo, userRepo, nil, valueobject.DefaultFriendRequestResendPolicy())
 
 	// 1回目のリクエスト送信
 	input := SendFriendRequestInput{
@@ -341,7 +341,7 @@ func TestSendFriendRequestUseCase_Execute_ReverseRequest(t *testing.T) {
 	}
 
 	// UseCaseを作成
-	uc := NewSendFriendRequestUseCase(relationshipRepo, userRepo)
+	uc := NewSendFriendRequestUseCase(relationshipRepo, userRepo, nil, valueobject.DefaultFriendRequestResendPolicy())
 
 	// user1からuser2へのリクエスト（逆方向）
 	input := SendFriendRequestInput{
@@ -408,7 +408,7 @@ func TestSendFriendRequestUseCase_Execute_ResendAfterRejection(t *testing.T) {
 	}
 
 	// UseCaseを作成
-	uc := NewSendFriendRequestUseCase(relationshipRepo, userRepo)
+	uc := NewSendFriendRequestUseCase(relationshipRepo, userRepo, nil, valueobject.DefaultFriendRequestResendPolicy())
 
 	// 24時間後の再送信
 	input := SendFriendRequestInput{
@@ -474,7 +474,7 @@ func TestSendFriendRequestUseCase_Execute_ResendTooSoon(t *testing.T) {
 	}
 
 	// UseCaseを作成
-	uc := NewSendFriendRequestUseCase(relationshipRepo, userRepo)
+	uc := NewSendFriendRequestUseCase(relationshipRepo, userRepo, nil, valueobject.DefaultFriendRequestResendPolicy())
 
 	// 24時間以内の再送信（エラーになるはず）
 	input := SendFriendRequestInput{
@@ -494,6 +494,74 @@ func TestSendFriendRequestUseCase_Execute_ResendTooSoon(t *testing.T) {
 	}
 }
 
+func TestSendFriendRequestUseCase_Execute_ResendAfterExpiry(t *testing.T) {
+	ctx := context.Background()
+
+	// テスト用のリポジトリを作成
+	relationshipRepo := memory.NewRelationshipRepository()
+	userRepo := memory.NewUserRepository()
+
+	// テスト用ユーザーを作成
+	user1 := &entity.User{
+		ID:           "user1",
+		Username:     "alice",
+		Email:        "alice@example.com",
+		PasswordHash: "hashed_password",
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+	user2 := &entity.User{
+		ID:           "user2",
+		Username:     "bob",
+		Email:        "bob@example.com",
+		PasswordHash: "hashed_password",
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	// ユーザーをリポジトリに追加
+	if err := userRepo.Create(ctx, user1); err != nil {
+		t.Fatalf("failed to create user1: %v", err)
+	}
+	if err := userRepo.Create(ctx, user2); err != nil {
+		t.Fatalf("failed to create user2: %v", err)
+	}
+
+	// 自動失効したリクエスト（直前に失効）
+	expiredRequest := &entity.Relationship{
+		ID:          "rel1",
+		RequesterID: user1.ID,
+		ReceiverID:  user2.ID,
+		Status:      valueobject.RelationshipStatusPending,
+		CreatedAt:   time.Now().Add(-31 * 24 * time.Hour),
+		UpdatedAt:   time.Now().Add(-31 * 24 * time.Hour),
+	}
+	if reason := expiredRequest.Expire(); reason.IsNG() {
+		t.Fatalf("failed to expire request: %s", reason)
+	}
+	if err := relationshipRepo.Create(ctx, expiredRequest); err != nil {
+		t.Fatalf("failed to create expired request: %v", err)
+	}
+
+	// UseCaseを作成
+	uc := NewSendFriendRequestUseCase(relationshipRepo, userRepo, nil, valueobject.DefaultFriendRequestResendPolicy())
+
+	// 失効したリクエストは24時間待たずに再送信できる
+	output, err := uc.Execute(ctx, SendFriendRequestInput{
+		RequesterID: user1.ID,
+		ReceiverID:  user2.ID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.Relationship.Status != valueobject.RelationshipStatusPending {
+		t.Errorf("expected status pending, got %s", output.Relationship.Status)
+	}
+	if output.Relationship.IsExpired() {
+		t.Error("expected expired flag to be cleared after resend")
+	}
+}
+
 func TestSendFriendRequestUseCase_Execute_BlockedByReceiver(t *testing.T) {
 	ctx := context.Background()
 
@@ -541,7 +609,7 @@ func TestSendFriendRequestUseCase_Execute_BlockedByReceiver(t *testing.T) {
 	}
 
 	// UseCaseを作成
-	uc := NewSendFriendRequestUseCase(relationshipRepo, userRepo)
+	uc := NewSendFriendRequestUseCase(relationshipRepo, userRepo, nil, valueobject.DefaultFriendRequestResendPolicy())
 
 	// user1からuser2へのリクエスト（ブロックされている）
 	input := SendFriendRequestInput{
@@ -560,3 +628,174 @@ func TestSendFriendRequestUseCase_Execute_BlockedByReceiver(t *testing.T) {
 		t.Errorf("unexpected error message: %v", err)
 	}
 }
+
+func TestSendFriendRequestUseCase_Execute_ReceiverPendingDeletion(t *testing.T) {
+	ctx := context.Background()
+	relationshipRepo := memory.NewRelationshipRepository()
+	userRepo := memory.NewUserRepository()
+
+	scheduledDeletion := time.Now().Add(24 * time.Hour)
+	for _, u := range []*entity.User{
+		{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+		{ID: "user2", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password", DeletionScheduledAt: &scheduledDeletion},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+
+	uc := NewSendFriendRequestUseCase(relationshipRepo, userRepo, nil, valueobject.DefaultFriendRequestResendPolicy())
+	_, err := uc.Execute(ctx, SendFriendRequestInput{RequesterID: "user1", ReceiverID: "user2"})
+	if err == nil || !strings.Contains(err.Error(), "アカウントの削除を予定しているため") {
+		t.Errorf("error = %v, want pending deletion error", err)
+	}
+	if _, err := relationshipRepo.FindByUserPair(ctx, "user1", "user2"); err == nil {
+		t.Error("relationship should not be created")
+	}
+}
+
+func TestSendFriendRequestUseCase_PlanQuota(t *testing.T) {
+	ctx := context.Background()
+
+	quotas := valueobject.PlanQuotas{
+		valueobject.PlanFree:    {MaxFriends: 2},
+		valueobject.PlanPremium: {MaxFriends: 10},
+	}
+
+	setup := func(t *testing.T, plan valueobject.Plan) *SendFriendRequestUseCase {
+		t.Helper()
+		relationshipRepo := memory.NewRelationshipRepository()
+		userRepo := memory.NewUserRepository()
+
+		users := []*entity.User{
+			{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password", Plan: plan},
+			{ID: "user2", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+			{ID: "user3", Username: "charlie", Email: "charlie@example.com", PasswordHash: "hashed_password"},
+			{ID: "user4", Username: "dave", Email: "dave@example.com", PasswordHash: "hashed_password"},
+		}
+		for _, u := range users {
+			if err := userRepo.Create(ctx, u); err != nil {
+				t.Fatalf("failed to create user: %v", err)
+			}
+		}
+
+		// user1とuser2は友達、user1からuser3へは承認待ち
+		relationships := []*entity.Relationship{
+			{ID: "rel1", RequesterID: "user2", ReceiverID: "user1", Status: valueobject.RelationshipStatusAccepted, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+			{ID: "rel2", RequesterID: "user1", ReceiverID: "user3", Status: valueobject.RelationshipStatusPending, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		}
+		for _, rel := range relationships {
+			if err := relationshipRepo.Create(ctx, rel); err != nil {
+				t.Fatalf("failed to create relationship: %v", err)
+			}
+		}
+
+		return NewSendFriendRequestUseCase(relationshipRepo, userRepo, quotas, valueobject.DefaultFriendRequestResendPolicy())
+	}
+
+	t.Run("フリープランは友達数と承認待ちの合計が上限に達すると送信できない", func(t *testing.T) {
+		uc := setup(t, valueobject.PlanFree)
+		_, err := uc.Execute(ctx, SendFriendRequestInput{RequesterID: "user1", ReceiverID: "user4"})
+		if err == nil {
+			t.Fatal("expected quota error but got nil")
+		}
+		if !strings.Contains(err.Error(), "フリープランの友達数の上限（2件）") || !strings.Contains(err.Error(), "アップグレード") {
+			t.Errorf("unexpected error message: %v", err)
+		}
+	})
+
+	t.Run("プレミアムプランは送信できる", func(t *testing.T) {
+		uc := setup(t, valueobject.PlanPremium)
+		if _, err := uc.Execute(ctx, SendFriendRequestInput{RequesterID: "user1", ReceiverID: "user4"}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestSendFriendRequestUseCase_Execute_ResendLimit(t *testing.T) {
+	ctx := context.Background()
+
+	relationshipRepo := memory.NewRelationshipRepository()
+	userRepo := memory.NewUserRepository()
+	for _, u := range []*entity.User{
+		{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+		{ID: "user2", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+
+	baseTime := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	if err := relationshipRepo.Create(ctx, &entity.Relationship{
+		ID:          "rel1",
+		RequesterID: "user1",
+		ReceiverID:  "user2",
+		Status:      valueobject.RelationshipStatusRejected,
+		CreatedAt:   baseTime,
+		UpdatedAt:   baseTime,
+	}); err != nil {
+		t.Fatalf("failed to create rejected request: %v", err)
+	}
+
+	uc := NewSendFriendRequestUseCase(relationshipRepo, userRepo, nil, valueobject.FriendRequestResendPolicy{
+		Cooldown:   time.Hour,
+		MaxResends: 2,
+		Lockout:    24 * time.Hour,
+	})
+	now := baseTime
+	uc.now = func() time.Time { return now }
+	input := SendFriendRequestInput{RequesterID: "user1", ReceiverID: "user2"}
+
+	// reject は受信者が再送信されたリクエストを拒否したことにする
+	reject := func() {
+		t.Helper()
+		rel, err := relationshipRepo.FindByID(ctx, "rel1")
+		if err != nil {
+			t.Fatalf("failed to find relationship: %v", err)
+		}
+		rel.Status = valueobject.RelationshipStatusRejected
+		rel.UpdatedAt = now
+		if err := relationshipRepo.Update(ctx, rel); err != nil {
+			t.Fatalf("failed to reject relationship: %v", err)
+		}
+	}
+
+	// 上限の回数までは待機期間ごとに再送信できる
+	for i := 1; i <= 2; i++ {
+		now = now.Add(time.Hour)
+		output, err := uc.Execute(ctx, input)
+		if err != nil {
+			t.Fatalf("resend %d: unexpected error: %v", i, err)
+		}
+		if output.Relationship.ResendCount != i {
+			t.Errorf("resend %d: ResendCount = %d", i, output.Relationship.ResendCount)
+		}
+		reject()
+	}
+	lastSentAt := now
+
+	// 上限に達すると待機期間が過ぎても制限期間の間は再送信できない
+	now = lastSentAt.Add(24*time.Hour - time.Second)
+	_, err := uc.Execute(ctx, input)
+	if err == nil || !strings.Contains(err.Error(), "再送信は2回までです") {
+		t.Fatalf("expected resend limit error, got %v", err)
+	}
+
+	// 制限期間ちょうどで再び送信できる
+	now = lastSentAt.Add(24 * time.Hour)
+	output, err := uc.Execute(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error after lockout: %v", err)
+	}
+	if output.Relationship.ResendCount != 1 {
+		t.Errorf("ResendCount = %d, want 1 after lockout", output.Relationship.ResendCount)
+	}
+	stored, err := relationshipRepo.FindByID(ctx, "rel1")
+	if err != nil {
+		t.Fatalf("failed to find relationship: %v", err)
+	}
+	if stored.ResendCount != 1 || !stored.LastSentAt.Equal(now) {
+		t.Errorf("stored ResendCount = %d, LastSentAt = %v", stored.ResendCount, stored.LastSentAt)
+	}
+}
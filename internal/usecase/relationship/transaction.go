@@ -0,0 +1,25 @@
+package relationship
+
+import (
+	"context"
+
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// beginTx はトランザクションを開始し、トランザクション内で使用するリポジトリを返す
+// txManagerが未設定の場合は指定したリポジトリをそのまま使用し、コミット・ロールバックは何もしない
+func beginTx(ctx context.Context, txManager repository.TransactionManager, repos repository.TxRepositories) (*repository.TxRepositories, repository.Transaction, error) {
+	if txManager == nil {
+		return &repos, noopTransaction{}, nil
+	}
+	return txManager.WithTx(ctx)
+}
+
+// noopTransaction は何もしないトランザクション
+type noopTransaction struct{}
+
+// Commit は何もしない
+func (noopTransaction) Commit() error { return nil }
+
+// Rollback は何もしない
+func (noopTransaction) Rollback() error { return nil }
@@ -0,0 +1,274 @@
+package relationship
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+// failingMorningCallRepository は指定回数目のUpdateで失敗するモーニングコールリポジトリ
+type failingMorningCallRepository struct {
+	repository.MorningCallRepository
+	failOn  int
+	updates int
+}
+
+func (r *failingMorningCallRepository) Update(ctx context.Context, mc *entity.MorningCall) error {
+	r.updates++
+	if r.updates == r.failOn {
+		return errors.New("injected failure")
+	}
+	return r.MorningCallRepository.Update(ctx, mc)
+}
+
+// failingRelationshipRepository はUpdateで必ず失敗する友達関係リポジトリ
+type failingRelationshipRepository struct {
+	repository.RelationshipRepository
+}
+
+func (r *failingRelationshipRepository) Update(ctx context.Context, rel *entity.Relationship) error {
+	return errors.New("injected failure")
+}
+
+// wrappingTxManager はトランザクション内のリポジトリを差し替えるテスト用マネージャー
+type wrappingTxManager struct {
+	*memory.TransactionManager
+	wrap func(repos *repository.TxRepositories)
+}
+
+func (m *wrappingTxManager) WithTx(ctx context.Context) (*repository.TxRepositories, repository.Transaction, error) {
+	repos, tx, err := m.TransactionManager.WithTx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	m.wrap(repos)
+	return repos, tx, nil
+}
+
+// setupTransactionalRepos はトランザクションテスト用のユーザー・友達関係・モーニングコールを作成する
+func setupTransactionalRepos(t *testing.T, status valueobject.RelationshipStatus) (*memory.UserRepository, *memory.MorningCallRepository, *memory.RelationshipRepository) {
+	t.Helper()
+	ctx := context.Background()
+
+	userRepo := memory.NewUserRepository()
+	morningCallRepo := memory.NewMorningCallRepository()
+	relationshipRepo := memory.NewRelationshipRepository()
+
+	for _, u := range []*entity.User{
+		{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+		{ID: "user2", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+
+	rel := &entity.Relationship{
+		ID:          "rel1",
+		RequesterID: "user1",
+		ReceiverID:  "user2",
+		Status:      status,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	if err := relationshipRepo.Create(ctx, rel); err != nil {
+		t.Fatalf("failed to create relationship: %v", err)
+	}
+
+	// 双方向のアクティブなコールと、対象外の確認済みコール
+	calls := []*entity.MorningCall{
+		{ID: "mc1", SenderID: "user1", ReceiverID: "user2", Status: valueobject.MorningCallStatusScheduled},
+		{ID: "mc2", SenderID: "user2", ReceiverID: "user1", Status: valueobject.MorningCallStatusScheduled},
+		{ID: "mc3", SenderID: "user1", ReceiverID: "user2", Status: valueobject.MorningCallStatusConfirmed},
+	}
+	for i, mc := range calls {
+		mc.ScheduledTime = time.Now().Add(time.Duration(i+1) * time.Hour)
+		mc.CreatedAt = time.Now()
+		mc.UpdatedAt = time.Now()
+		if err := morningCallRepo.Create(ctx, mc); err != nil {
+			t.Fatalf("failed to create morning call: %v", err)
+		}
+	}
+
+	return userRepo, morningCallRepo, relationshipRepo
+}
+
+func TestRemoveRelationshipUseCase_CascadeCancelInTransaction(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("友達関係の解除でアクティブなコールがキャンセルされる", func(t *testing.T) {
+		userRepo, morningCallRepo, relationshipRepo := setupTransactionalRepos(t, valueobject.RelationshipStatusAccepted)
+		txManager := memory.NewTransactionManager(userRepo, morningCallRepo, relationshipRepo)
+		uc := NewRemoveRelationshipUseCase(relationshipRepo, userRepo, morningCallRepo, txManager)
+
+		output, err := uc.Execute(ctx, RemoveRelationshipInput{RelationshipID: "rel1", UserID: "user1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.CancelledCalls != 2 {
+			t.Errorf("CancelledCalls = %d, want 2", output.CancelledCalls)
+		}
+
+		if exists, _ := relationshipRepo.ExistsByID(ctx, "rel1"); exists {
+			t.Error("関係が削除されていません")
+		}
+		for id, want := range map[string]valueobject.MorningCallStatus{
+			"mc1": valueobject.MorningCallStatusCancelled,
+			"mc2": valueobject.MorningCallStatusCancelled,
+			"mc3": valueobject.MorningCallStatusConfirmed,
+		} {
+			mc, _ := morningCallRepo.FindByID(ctx, id)
+			if mc.Status != want {
+				t.Errorf("%s status = %s, want %s", id, mc.Status, want)
+			}
+		}
+	})
+
+	t.Run("キャンセル途中で失敗した場合は部分更新が残らない", func(t *testing.T) {
+		userRepo, morningCallRepo, relationshipRepo := setupTransactionalRepos(t, valueobject.RelationshipStatusAccepted)
+		txManager := &wrappingTxManager{
+			TransactionManager: memory.NewTransactionManager(userRepo, morningCallRepo, relationshipRepo),
+			wrap: func(repos *repository.TxRepositories) {
+				repos.MorningCall = &failingMorningCallRepository{MorningCallRepository: repos.MorningCall, failOn: 2}
+			},
+		}
+		uc := NewRemoveRelationshipUseCase(relationshipRepo, userRepo, morningCallRepo, txManager)
+
+		_, err := uc.Execute(ctx, RemoveRelationshipInput{RelationshipID: "rel1", UserID: "user1"})
+		if err == nil || !strings.Contains(err.Error(), "モーニングコールのキャンセルに失敗しました") {
+			t.Fatalf("expected cancel failure, got %v", err)
+		}
+
+		if exists, _ := relationshipRepo.ExistsByID(ctx, "rel1"); !exists {
+			t.Error("失敗時に関係の削除が残っています")
+		}
+		for _, id := range []string{"mc1", "mc2"} {
+			mc, _ := morningCallRepo.FindByID(ctx, id)
+			if mc.Status != valueobject.MorningCallStatusScheduled {
+				t.Errorf("失敗時に%sのキャンセルが残っています: %s", id, mc.Status)
+			}
+		}
+	})
+
+	t.Run("承認待ちの取り下げではコールをキャンセルしない", func(t *testing.T) {
+		userRepo, morningCallRepo, relationshipRepo := setupTransactionalRepos(t, valueobject.RelationshipStatusPending)
+		txManager := memory.NewTransactionManager(userRepo, morningCallRepo, relationshipRepo)
+		uc := NewRemoveRelationshipUseCase(relationshipRepo, userRepo, morningCallRepo, txManager)
+
+		output, err := uc.Execute(ctx, RemoveRelationshipInput{RelationshipID: "rel1", UserID: "user1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.CancelledCalls != 0 {
+			t.Errorf("CancelledCalls = %d, want 0", output.CancelledCalls)
+		}
+	})
+}
+
+func TestBlockRelationshipUseCase_CascadeCancelInTransaction(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("友達関係のブロックでアクティブなコールがキャンセルされる", func(t *testing.T) {
+		userRepo, morningCallRepo, relationshipRepo := setupTransactionalRepos(t, valueobject.RelationshipStatusAccepted)
+		txManager := memory.NewTransactionManager(userRepo, morningCallRepo, relationshipRepo)
+		uc := NewBlockRelationshipUseCase(relationshipRepo, userRepo, morningCallRepo, txManager)
+
+		output, err := uc.Execute(ctx, BlockRelationshipInput{RelationshipID: "rel1", BlockerID: "user1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.CancelledCalls != 2 {
+			t.Errorf("CancelledCalls = %d, want 2", output.CancelledCalls)
+		}
+		for _, id := range []string{"mc1", "mc2"} {
+			mc, _ := morningCallRepo.FindByID(ctx, id)
+			if mc.Status != valueobject.MorningCallStatusCancelled {
+				t.Errorf("%s status = %s, want cancelled", id, mc.Status)
+			}
+		}
+	})
+
+	t.Run("キャンセル途中で失敗した場合は友達関係のまま", func(t *testing.T) {
+		userRepo, morningCallRepo, relationshipRepo := setupTransactionalRepos(t, valueobject.RelationshipStatusAccepted)
+		txManager := &wrappingTxManager{
+			TransactionManager: memory.NewTransactionManager(userRepo, morningCallRepo, relationshipRepo),
+			wrap: func(repos *repository.TxRepositories) {
+				repos.MorningCall = &failingMorningCallRepository{MorningCallRepository: repos.MorningCall, failOn: 2}
+			},
+		}
+		uc := NewBlockRelationshipUseCase(relationshipRepo, userRepo, morningCallRepo, txManager)
+
+		if _, err := uc.Execute(ctx, BlockRelationshipInput{RelationshipID: "rel1", BlockerID: "user1"}); err == nil {
+			t.Fatal("expected error but got nil")
+		}
+
+		rel, _ := relationshipRepo.FindByID(ctx, "rel1")
+		if rel.Status != valueobject.RelationshipStatusAccepted {
+			t.Errorf("status = %s, want accepted", rel.Status)
+		}
+		mc, _ := morningCallRepo.FindByID(ctx, "mc1")
+		if mc.Status != valueobject.MorningCallStatusScheduled {
+			t.Errorf("失敗時にmc1のキャンセルが残っています: %s", mc.Status)
+		}
+	})
+
+	t.Run("承認待ちのブロックではコールをキャンセルしない", func(t *testing.T) {
+		userRepo, morningCallRepo, relationshipRepo := setupTransactionalRepos(t, valueobject.RelationshipStatusPending)
+		txManager := memory.NewTransactionManager(userRepo, morningCallRepo, relationshipRepo)
+		uc := NewBlockRelationshipUseCase(relationshipRepo, userRepo, morningCallRepo, txManager)
+
+		output, err := uc.Execute(ctx, BlockRelationshipInput{RelationshipID: "rel1", BlockerID: "user2"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.CancelledCalls != 0 {
+			t.Errorf("CancelledCalls = %d, want 0", output.CancelledCalls)
+		}
+	})
+}
+
+func TestAcceptFriendRequestUseCase_Transaction(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("トランザクション内で承認される", func(t *testing.T) {
+		userRepo, morningCallRepo, relationshipRepo := setupTransactionalRepos(t, valueobject.RelationshipStatusPending)
+		txManager := memory.NewTransactionManager(userRepo, morningCallRepo, relationshipRepo)
+		uc := NewAcceptFriendRequestUseCase(relationshipRepo, userRepo, nil, txManager)
+
+		if _, err := uc.Execute(ctx, AcceptFriendRequestInput{RelationshipID: "rel1", ReceiverID: "user2"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		rel, _ := relationshipRepo.FindByID(ctx, "rel1")
+		if rel.Status != valueobject.RelationshipStatusAccepted {
+			t.Errorf("status = %s, want accepted", rel.Status)
+		}
+	})
+
+	t.Run("更新に失敗した場合は承認待ちのまま", func(t *testing.T) {
+		userRepo, morningCallRepo, relationshipRepo := setupTransactionalRepos(t, valueobject.RelationshipStatusPending)
+		txManager := &wrappingTxManager{
+			TransactionManager: memory.NewTransactionManager(userRepo, morningCallRepo, relationshipRepo),
+			wrap: func(repos *repository.TxRepositories) {
+				repos.Relationship = &failingRelationshipRepository{RelationshipRepository: repos.Relationship}
+			},
+		}
+		uc := NewAcceptFriendRequestUseCase(relationshipRepo, userRepo, nil, txManager)
+
+		if _, err := uc.Execute(ctx, AcceptFriendRequestInput{RelationshipID: "rel1", ReceiverID: "user2"}); err == nil {
+			t.Fatal("expected error but got nil")
+		}
+
+		rel, _ := relationshipRepo.FindByID(ctx, "rel1")
+		if rel.Status != valueobject.RelationshipStatusPending {
+			t.Errorf("status = %s, want pending", rel.Status)
+		}
+	})
+}
@@ -0,0 +1,103 @@
+package relationship
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// UnblockUserUseCase はユーザーブロック解除のユースケース
+type UnblockUserUseCase struct {
+	relationshipRepo repository.RelationshipRepository
+	userRepo         repository.UserRepository
+}
+
+// NewUnblockUserUseCase は新しいユーザーブロック解除ユースケースを作成する
+func NewUnblockUserUseCase(
+	relationshipRepo repository.RelationshipRepository,
+	userRepo repository.UserRepository,
+) *UnblockUserUseCase {
+	return &UnblockUserUseCase{
+		relationshipRepo: relationshipRepo,
+		userRepo:         userRepo,
+	}
+}
+
+// UnblockUserInput はユーザーブロック解除の入力データ
+type UnblockUserInput struct {
+	BlockerID string // ブロックを解除する側のユーザーID
+	BlockedID string // ブロックを解除される側のユーザーID
+}
+
+// UnblockUserOutput はユーザーブロック解除の出力データ
+type UnblockUserOutput struct {
+	// Relationship は相手からのブロックが残っている場合の関係（ブロックが完全に解消された場合はnil）
+	Relationship *entity.Relationship
+	// Released はブロック状態が完全に解消されたか
+	Released bool
+}
+
+// Execute はユーザーのブロックを解除する
+// 相互ブロックの場合は自分のブロックのみを解除し、相手からのブロックは維持する
+// 一方向のブロックの場合は関係を削除し、改めて友達リクエストを送信できる状態に戻す
+func (uc *UnblockUserUseCase) Execute(ctx context.Context, input UnblockUserInput) (*UnblockUserOutput, error) {
+	// 入力値の基本検証
+	if input.BlockerID == "" {
+		return nil, fmt.Errorf("ブロック解除実行者IDは必須です")
+	}
+	if input.BlockedID == "" {
+		return nil, fmt.Errorf("ブロック解除対象者IDは必須です")
+	}
+	if input.BlockerID == input.BlockedID {
+		return nil, fmt.Errorf("自分自身のブロックを解除することはできません")
+	}
+
+	// ブロック解除実行者の存在確認
+	if _, err := uc.userRepo.FindByID(ctx, input.BlockerID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ブロック解除実行者が見つかりません")
+		}
+		return nil, fmt.Errorf("ブロック解除実行者の確認中にエラーが発生しました: %w", err)
+	}
+
+	// 関係を取得
+	relationship, err := uc.relationshipRepo.FindByUserPair(ctx, input.BlockerID, input.BlockedID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ブロック関係が見つかりません")
+		}
+		return nil, fmt.Errorf("既存の関係確認中にエラーが発生しました: %w", err)
+	}
+
+	if !relationship.IsBlockedBy(input.BlockerID) {
+		return nil, fmt.Errorf("このユーザーをブロックしていません")
+	}
+
+	released, reason := relationship.UnblockBy(input.BlockerID)
+	if reason.IsNG() {
+		return nil, fmt.Errorf("ブロックの解除に失敗しました: %w", reason)
+	}
+
+	// ブロックが完全に解消された場合は関係を削除する
+	if released {
+		if err := uc.relationshipRepo.Delete(ctx, relationship.ID); err != nil {
+			return nil, fmt.Errorf("ブロックの解除に失敗しました: %w", err)
+		}
+		return &UnblockUserOutput{
+			Released: true,
+		}, nil
+	}
+
+	// 相互ブロックの場合は相手からのブロックを残して更新する
+	if err := uc.relationshipRepo.Update(ctx, relationship); err != nil {
+		return nil, fmt.Errorf("ブロックの解除に失敗しました: %w", err)
+	}
+
+	return &UnblockUserOutput{
+		Relationship: relationship,
+		Released:     false,
+	}, nil
+}
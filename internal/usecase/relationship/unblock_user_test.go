@@ -0,0 +1,274 @@
+package relationship
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func setupUnblockUsers(t *testing.T, ctx context.Context, ur *memory.UserRepository) (*entity.User, *entity.User) {
+	t.Helper()
+
+	alice := &entity.User{
+		ID:           "alice-id",
+		Username:     "alice",
+		Email:        "alice@example.com",
+		PasswordHash: "hashed",
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+	bob := &entity.User{
+		ID:           "bob-id",
+		Username:     "bob",
+		Email:        "bob@example.com",
+		PasswordHash: "hashed",
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+	if err := ur.Create(ctx, alice); err != nil {
+		t.Fatalf("failed to create alice: %v", err)
+	}
+	if err := ur.Create(ctx, bob); err != nil {
+		t.Fatalf("failed to create bob: %v", err)
+	}
+	return alice, bob
+}
+
+func TestUnblockUserUseCase_OneWayBlock(t *testing.T) {
+	ctx := context.Background()
+	relationshipRepo := memory.NewRelationshipRepository()
+	userRepo := memory.NewUserRepository()
+	alice, bob := setupUnblockUsers(t, ctx, userRepo)
+
+	blockUC := NewBlockUserUseCase(relationshipRepo, userRepo, nil, nil)
+	unblockUC := NewUnblockUserUseCase(relationshipRepo, userRepo)
+
+	if _, err := blockUC.Execute(ctx, BlockUserInput{BlockerID: alice.ID, BlockedID: bob.ID}); err != nil {
+		t.Fatalf("failed to block: %v", err)
+	}
+
+	// ブロックされた側は解除できない
+	_, err := unblockUC.Execute(ctx, UnblockUserInput{BlockerID: bob.ID, BlockedID: alice.ID})
+	if err == nil || !strings.Contains(err.Error(), "このユーザーをブロックしていません") {
+		t.Errorf("error = %v, want contains このユーザーをブロックしていません", err)
+	}
+
+	// ブロックした側が解除すると関係が削除される
+	output, err := unblockUC.Execute(ctx, UnblockUserInput{BlockerID: alice.ID, BlockedID: bob.ID})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !output.Released {
+		t.Error("Released should be true")
+	}
+	if output.Relationship != nil {
+		t.Error("Relationship should be nil when released")
+	}
+	if _, err := relationshipRepo.FindByUserPair(ctx, alice.ID, bob.ID); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("relationship should be deleted, got err = %v", err)
+	}
+}
+
+func TestUnblockUserUseCase_MutualBlock(t *testing.T) {
+	ctx := context.Background()
+	relationshipRepo := memory.NewRelationshipRepository()
+	userRepo := memory.NewUserRepository()
+	alice, bob := setupUnblockUsers(t, ctx, userRepo)
+
+	blockUC := NewBlockUserUseCase(relationshipRepo, userRepo, nil, nil)
+	unblockUC := NewUnblockUserUseCase(relationshipRepo, userRepo)
+
+	// 双方がブロックする
+	if _, err := blockUC.Execute(ctx, BlockUserInput{BlockerID: alice.ID, BlockedID: bob.ID}); err != nil {
+		t.Fatalf("failed to block by alice: %v", err)
+	}
+	if _, err := blockUC.Execute(ctx, BlockUserInput{BlockerID: bob.ID, BlockedID: alice.ID}); err != nil {
+		t.Fatalf("failed to block by bob: %v", err)
+	}
+
+	// 先にブロックした側が解除しても、相手からのブロックは残る
+	output, err := unblockUC.Execute(ctx, UnblockUserInput{BlockerID: alice.ID, BlockedID: bob.ID})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.Released {
+		t.Error("Released should be false while bob still blocks alice")
+	}
+	saved, err := relationshipRepo.FindByUserPair(ctx, alice.ID, bob.ID)
+	if err != nil {
+		t.Fatalf("failed to find relationship: %v", err)
+	}
+	if !saved.IsBlocked() || saved.IsMutualBlock() {
+		t.Errorf("relationship should remain one-way blocked, got status=%v mutual=%v", saved.Status, saved.MutualBlock)
+	}
+	if saved.IsBlockedBy(alice.ID) || !saved.IsBlockedBy(bob.ID) {
+		t.Errorf("only bob should block alice, got BlockerID = %v", saved.BlockerID)
+	}
+	blocked, err := relationshipRepo.IsBlocked(ctx, alice.ID, bob.ID)
+	if err != nil {
+		t.Fatalf("failed to check block: %v", err)
+	}
+	if !blocked {
+		t.Error("users should still be blocked")
+	}
+
+	// 解除した側は再度ブロックでき、相互ブロックに戻る
+	if _, err := blockUC.Execute(ctx, BlockUserInput{BlockerID: alice.ID, BlockedID: bob.ID}); err != nil {
+		t.Fatalf("failed to re-block by alice: %v", err)
+	}
+	saved, err = relationshipRepo.FindByUserPair(ctx, alice.ID, bob.ID)
+	if err != nil {
+		t.Fatalf("failed to find relationship: %v", err)
+	}
+	if !saved.IsMutualBlock() {
+		t.Error("relationship should be mutual block again")
+	}
+
+	// 双方が解除すると関係が削除される
+	if _, err := unblockUC.Execute(ctx, UnblockUserInput{BlockerID: bob.ID, BlockedID: alice.ID}); err != nil {
+		t.Fatalf("failed to unblock by bob: %v", err)
+	}
+	output, err = unblockUC.Execute(ctx, UnblockUserInput{BlockerID: alice.ID, BlockedID: bob.ID})
+	if err != nil {
+		t.Fatalf("failed to unblock by alice: %v", err)
+	}
+	if !output.Released {
+		t.Error("Released should be true after both users unblock")
+	}
+	if _, err := relationshipRepo.FindByUserPair(ctx, alice.ID, bob.ID); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("relationship should be deleted, got err = %v", err)
+	}
+}
+
+func TestUnblockUserUseCase_FriendLifecycle(t *testing.T) {
+	ctx := context.Background()
+	userRepo, morningCallRepo, relationshipRepo := setupTransactionalRepos(t, valueobject.RelationshipStatusAccepted)
+	txManager := memory.NewTransactionManager(userRepo, morningCallRepo, relationshipRepo)
+
+	blockUC := NewBlockUserUseCase(relationshipRepo, userRepo, morningCallRepo, txManager)
+	unblockUC := NewUnblockUserUseCase(relationshipRepo, userRepo)
+	sendUC := NewSendFriendRequestUseCase(relationshipRepo, userRepo, nil, valueobject.DefaultFriendRequestResendPolicy())
+
+	// 友達をブロックすると関係がブロックに上書きされ、アクティブなコールがキャンセルされる
+	blockOutput, err := blockUC.Execute(ctx, BlockUserInput{BlockerID: "user2", BlockedID: "user1"})
+	if err != nil {
+		t.Fatalf("failed to block: %v", err)
+	}
+	if blockOutput.Relationship.ID != "rel1" || !blockOutput.Relationship.IsBlockedBy("user2") {
+		t.Errorf("relationship should be rel1 blocked by user2, got %+v", blockOutput.Relationship)
+	}
+	if blockOutput.CancelledCalls != 2 {
+		t.Errorf("CancelledCalls = %d, want 2", blockOutput.CancelledCalls)
+	}
+	for id, want := range map[string]valueobject.MorningCallStatus{
+		"mc1": valueobject.MorningCallStatusCancelled,
+		"mc2": valueobject.MorningCallStatusCancelled,
+		"mc3": valueobject.MorningCallStatusConfirmed,
+	} {
+		mc, _ := morningCallRepo.FindByID(ctx, id)
+		if mc.Status != want {
+			t.Errorf("%s status = %s, want %s", id, mc.Status, want)
+		}
+	}
+
+	// 友達一覧・友達数から即座に除外される
+	for _, userID := range []string{"user1", "user2"} {
+		friends, err := relationshipRepo.FindFriendsByUserID(ctx, userID, 0, 10)
+		if err != nil {
+			t.Fatalf("failed to find friends: %v", err)
+		}
+		count, err := relationshipRepo.CountFriendsByUserID(ctx, userID)
+		if err != nil {
+			t.Fatalf("failed to count friends: %v", err)
+		}
+		if len(friends) != 0 || count != 0 {
+			t.Errorf("%s friends = %d, count = %d, want 0", userID, len(friends), count)
+		}
+	}
+
+	// ブロック中は友達リクエストを送信できない
+	if _, err := sendUC.Execute(ctx, SendFriendRequestInput{RequesterID: "user1", ReceiverID: "user2"}); err == nil {
+		t.Error("expected error when sending friend request while blocked")
+	}
+
+	// ブロックを解除しても友達には戻らず、関係が削除される
+	unblockOutput, err := unblockUC.Execute(ctx, UnblockUserInput{BlockerID: "user2", BlockedID: "user1"})
+	if err != nil {
+		t.Fatalf("failed to unblock: %v", err)
+	}
+	if !unblockOutput.Released {
+		t.Error("Released should be true")
+	}
+	if friends, _ := relationshipRepo.AreFriends(ctx, "user1", "user2"); friends {
+		t.Error("users should not be friends after unblock")
+	}
+
+	// 友達に戻るには改めて友達リクエストが必要
+	sendOutput, err := sendUC.Execute(ctx, SendFriendRequestInput{RequesterID: "user1", ReceiverID: "user2"})
+	if err != nil {
+		t.Fatalf("failed to send friend request after unblock: %v", err)
+	}
+	if !sendOutput.Relationship.IsPending() {
+		t.Errorf("status = %s, want pending", sendOutput.Relationship.Status)
+	}
+}
+
+func TestUnblockUserUseCase_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		input  UnblockUserInput
+		errMsg string
+	}{
+		{
+			name:   "ブロック解除実行者IDが空",
+			input:  UnblockUserInput{BlockerID: "", BlockedID: "bob-id"},
+			errMsg: "ブロック解除実行者IDは必須です",
+		},
+		{
+			name:   "ブロック解除対象者IDが空",
+			input:  UnblockUserInput{BlockerID: "alice-id", BlockedID: ""},
+			errMsg: "ブロック解除対象者IDは必須です",
+		},
+		{
+			name:   "自分自身",
+			input:  UnblockUserInput{BlockerID: "alice-id", BlockedID: "alice-id"},
+			errMsg: "自分自身のブロックを解除することはできません",
+		},
+		{
+			name:   "ブロック解除実行者が存在しない",
+			input:  UnblockUserInput{BlockerID: "nonexistent", BlockedID: "bob-id"},
+			errMsg: "ブロック解除実行者が見つかりません",
+		},
+		{
+			name:   "ブロック関係が存在しない",
+			input:  UnblockUserInput{BlockerID: "alice-id", BlockedID: "bob-id"},
+			errMsg: "ブロック関係が見つかりません",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			relationshipRepo := memory.NewRelationshipRepository()
+			userRepo := memory.NewUserRepository()
+			setupUnblockUsers(t, ctx, userRepo)
+
+			uc := NewUnblockUserUseCase(relationshipRepo, userRepo)
+			_, err := uc.Execute(ctx, tt.input)
+			if err == nil {
+				t.Fatal("expected error but got nil")
+			}
+			if !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("error message = %v, want contains %v", err.Error(), tt.errMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package relationship
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// UnseenRequestCountUseCase は未読の友達リクエスト件数を取得するユースケース
+// 通知バッジ用にリクエスト本体や相手ユーザーの情報は取得しない
+type UnseenRequestCountUseCase struct {
+	relationshipRepo repository.RelationshipRepository
+}
+
+// NewUnseenRequestCountUseCase は新しい未読友達リクエスト件数取得ユースケースを作成する
+func NewUnseenRequestCountUseCase(relationshipRepo repository.RelationshipRepository) *UnseenRequestCountUseCase {
+	return &UnseenRequestCountUseCase{
+		relationshipRepo: relationshipRepo,
+	}
+}
+
+// UnseenRequestCountInput は未読友達リクエスト件数取得の入力データ
+type UnseenRequestCountInput struct {
+	ReceiverID string
+}
+
+// UnseenRequestCountOutput は未読友達リクエスト件数取得の出力データ
+type UnseenRequestCountOutput struct {
+	Count int
+}
+
+// Execute は受信者宛の未読の承認待ちリクエスト件数を返す
+func (uc *UnseenRequestCountUseCase) Execute(ctx context.Context, input UnseenRequestCountInput) (*UnseenRequestCountOutput, error) {
+	if input.ReceiverID == "" {
+		return nil, fmt.Errorf("受信者IDは必須です")
+	}
+
+	count, err := uc.relationshipRepo.CountUnseenRequestsByReceiverID(ctx, input.ReceiverID)
+	if err != nil {
+		return nil, fmt.Errorf("未読の友達リクエスト件数の取得中にエラーが発生しました: %w", err)
+	}
+
+	return &UnseenRequestCountOutput{
+		Count: count,
+	}, nil
+}
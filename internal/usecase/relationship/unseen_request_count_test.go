@@ -0,0 +1,64 @@
+package relationship
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestUnseenRequestCountUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("受信者IDが空の場合はエラー", func(t *testing.T) {
+		uc := NewUnseenRequestCountUseCase(memory.NewRelationshipRepository())
+		if _, err := uc.Execute(ctx, UnseenRequestCountInput{}); err == nil {
+			t.Error("expected error but got nil")
+		}
+	})
+
+	t.Run("未読の承認待ちリクエストのみを数える", func(t *testing.T) {
+		relationshipRepo := memory.NewRelationshipRepository()
+
+		relationships := []struct {
+			requesterID string
+			receiverID  string
+			status      valueobject.RelationshipStatus
+			seen        bool
+		}{
+			{"user1", "me", valueobject.RelationshipStatusPending, false},  // 対象
+			{"user2", "me", valueobject.RelationshipStatusPending, false},  // 対象
+			{"user3", "me", valueobject.RelationshipStatusPending, true},   // 既読
+			{"user4", "me", valueobject.RelationshipStatusAccepted, false}, // 承認済み
+			{"user5", "me", valueobject.RelationshipStatusRejected, false}, // 拒否済み
+			{"me", "user6", valueobject.RelationshipStatusPending, false},  // 自分が送信者
+		}
+		for i, r := range relationships {
+			rel := &entity.Relationship{
+				ID:             fmt.Sprintf("rel%d", i),
+				RequesterID:    r.requesterID,
+				ReceiverID:     r.receiverID,
+				Status:         r.status,
+				SeenByReceiver: r.seen,
+				CreatedAt:      time.Now(),
+				UpdatedAt:      time.Now(),
+			}
+			if err := relationshipRepo.Create(ctx, rel); err != nil {
+				t.Fatalf("failed to create relationship: %v", err)
+			}
+		}
+
+		uc := NewUnseenRequestCountUseCase(relationshipRepo)
+		output, err := uc.Execute(ctx, UnseenRequestCountInput{ReceiverID: "me"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.Count != 2 {
+			t.Errorf("Count = %d, want 2", output.Count)
+		}
+	})
+}
@@ -0,0 +1,86 @@
+package relationship
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// UpdateAutoConfirmUseCase は友達からのモーニングコールを自動で確認済みにするかを設定するユースケース
+type UpdateAutoConfirmUseCase struct {
+	relationshipRepo repository.RelationshipRepository
+	userRepo         repository.UserRepository
+}
+
+// NewUpdateAutoConfirmUseCase は新しい自動確認設定ユースケースを作成する
+func NewUpdateAutoConfirmUseCase(
+	relationshipRepo repository.RelationshipRepository,
+	userRepo repository.UserRepository,
+) *UpdateAutoConfirmUseCase {
+	return &UpdateAutoConfirmUseCase{
+		relationshipRepo: relationshipRepo,
+		userRepo:         userRepo,
+	}
+}
+
+// UpdateAutoConfirmInput は自動確認設定の入力データ
+type UpdateAutoConfirmInput struct {
+	RelationshipID string // 設定する友達関係のID
+	UserID         string // 設定するユーザー（相手からのコールを受け取る側）のID
+	AutoConfirm    bool   // 相手からのコールを配信時に自動で確認済みにするか
+}
+
+// UpdateAutoConfirmOutput は自動確認設定の出力データ
+type UpdateAutoConfirmOutput struct {
+	Relationship *entity.Relationship
+}
+
+// Execute は友達関係にある相手からのモーニングコールを自動で確認済みにするかを設定する
+func (uc *UpdateAutoConfirmUseCase) Execute(ctx context.Context, input UpdateAutoConfirmInput) (*UpdateAutoConfirmOutput, error) {
+	// 入力値の基本検証
+	if input.RelationshipID == "" {
+		return nil, fmt.Errorf("関係IDは必須です")
+	}
+	if input.UserID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+
+	// ユーザーの存在確認
+	user, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("ユーザーの確認中にエラーが発生しました: %w", err)
+	}
+
+	// 関係の取得
+	relationship, err := uc.relationshipRepo.FindByID(ctx, input.RelationshipID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("友達関係が見つかりません")
+		}
+		return nil, fmt.Errorf("友達関係の取得中にエラーが発生しました: %w", err)
+	}
+
+	// 関係の当事者以外には存在を明かさない
+	if !relationship.InvolvesUser(user.ID) {
+		return nil, fmt.Errorf("友達関係が見つかりません")
+	}
+
+	if reason := relationship.SetAutoConfirm(user.ID, input.AutoConfirm); reason.IsNG() {
+		return nil, fmt.Errorf("自動確認を設定できませんでした: %w", reason)
+	}
+
+	// リポジトリで更新
+	if err := uc.relationshipRepo.Update(ctx, relationship); err != nil {
+		return nil, fmt.Errorf("自動確認の設定に失敗しました: %w", err)
+	}
+
+	return &UpdateAutoConfirmOutput{
+		Relationship: relationship,
+	}, nil
+}
@@ -0,0 +1,86 @@
+package relationship
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestUpdateAutoConfirmUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T, status valueobject.RelationshipStatus) (*memory.RelationshipRepository, *UpdateAutoConfirmUseCase) {
+		t.Helper()
+		relationshipRepo := memory.NewRelationshipRepository()
+		userRepo := memory.NewUserRepository()
+		for _, u := range []*entity.User{
+			{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hash"},
+			{ID: "user2", Username: "bob", Email: "bob@example.com", PasswordHash: "hash"},
+			{ID: "user3", Username: "carol", Email: "carol@example.com", PasswordHash: "hash"},
+		} {
+			if err := userRepo.Create(ctx, u); err != nil {
+				t.Fatalf("failed to create user: %v", err)
+			}
+		}
+		if err := relationshipRepo.Create(ctx, &entity.Relationship{
+			ID:          "rel1",
+			RequesterID: "user1",
+			ReceiverID:  "user2",
+			Status:      status,
+		}); err != nil {
+			t.Fatalf("failed to create relationship: %v", err)
+		}
+		return relationshipRepo, NewUpdateAutoConfirmUseCase(relationshipRepo, userRepo)
+	}
+
+	t.Run("自動確認を有効化・無効化できる", func(t *testing.T) {
+		relationshipRepo, uc := setup(t, valueobject.RelationshipStatusAccepted)
+
+		if _, err := uc.Execute(ctx, UpdateAutoConfirmInput{RelationshipID: "rel1", UserID: "user2", AutoConfirm: true}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		saved, _ := relationshipRepo.FindByID(ctx, "rel1")
+		if !saved.AutoConfirmFor("user2") {
+			t.Error("AutoConfirmFor(user2) = false, want true")
+		}
+		if saved.AutoConfirmFor("user1") {
+			t.Error("AutoConfirmFor(user1) should remain false")
+		}
+
+		if _, err := uc.Execute(ctx, UpdateAutoConfirmInput{RelationshipID: "rel1", UserID: "user2", AutoConfirm: false}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		saved, _ = relationshipRepo.FindByID(ctx, "rel1")
+		if saved.AutoConfirmFor("user2") {
+			t.Error("AutoConfirmFor(user2) should be disabled")
+		}
+	})
+
+	t.Run("関係外のユーザーには存在を明かさない", func(t *testing.T) {
+		_, uc := setup(t, valueobject.RelationshipStatusAccepted)
+		_, err := uc.Execute(ctx, UpdateAutoConfirmInput{RelationshipID: "rel1", UserID: "user3", AutoConfirm: true})
+		if err == nil || err.Error() != "友達関係が見つかりません" {
+			t.Errorf("error = %v, want not found", err)
+		}
+	})
+
+	t.Run("友達でない関係には設定できない", func(t *testing.T) {
+		_, uc := setup(t, valueobject.RelationshipStatusPending)
+		_, err := uc.Execute(ctx, UpdateAutoConfirmInput{RelationshipID: "rel1", UserID: "user2", AutoConfirm: true})
+		if err == nil || !strings.Contains(err.Error(), "友達関係にある相手のみ自動確認を設定できます") {
+			t.Errorf("error = %v, want invalid state", err)
+		}
+	})
+
+	t.Run("存在しない関係", func(t *testing.T) {
+		_, uc := setup(t, valueobject.RelationshipStatusAccepted)
+		_, err := uc.Execute(ctx, UpdateAutoConfirmInput{RelationshipID: "unknown", UserID: "user2", AutoConfirm: true})
+		if err == nil || err.Error() != "友達関係が見つかりません" {
+			t.Errorf("error = %v, want not found", err)
+		}
+	})
+}
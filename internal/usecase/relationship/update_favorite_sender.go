@@ -0,0 +1,93 @@
+package relationship
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// UpdateFavoriteSenderUseCase は友達を「お気に入り送信元」に登録・解除するユースケース
+// お気に入り送信元からのコールは受信一覧で優先して表示でき、通知も個別に強化できる
+type UpdateFavoriteSenderUseCase struct {
+	relationshipRepo repository.RelationshipRepository
+	userRepo         repository.UserRepository
+}
+
+// NewUpdateFavoriteSenderUseCase は新しいお気に入り送信元設定ユースケースを作成する
+func NewUpdateFavoriteSenderUseCase(
+	relationshipRepo repository.RelationshipRepository,
+	userRepo repository.UserRepository,
+) *UpdateFavoriteSenderUseCase {
+	return &UpdateFavoriteSenderUseCase{
+		relationshipRepo: relationshipRepo,
+		userRepo:         userRepo,
+	}
+}
+
+// UpdateFavoriteSenderInput はお気に入り送信元設定の入力データ
+type UpdateFavoriteSenderInput struct {
+	RelationshipID string // 設定する友達関係のID
+	UserID         string // 設定するユーザー（相手からのコールを受け取る側）のID
+	Favorite       bool   // 相手をお気に入り送信元に登録するか（falseの場合は解除する）
+	AlwaysRing     bool   // 無音配信のデフォルト設定にかかわらず音を鳴らして通知するか（登録する場合のみ）
+}
+
+// UpdateFavoriteSenderOutput はお気に入り送信元設定の出力データ
+type UpdateFavoriteSenderOutput struct {
+	Relationship *entity.Relationship
+}
+
+// Execute は友達関係にある相手をお気に入り送信元に登録・解除する
+func (uc *UpdateFavoriteSenderUseCase) Execute(ctx context.Context, input UpdateFavoriteSenderInput) (*UpdateFavoriteSenderOutput, error) {
+	// 入力値の基本検証
+	if input.RelationshipID == "" {
+		return nil, fmt.Errorf("関係IDは必須です")
+	}
+	if input.UserID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+
+	// ユーザーの存在確認
+	user, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("ユーザーの確認中にエラーが発生しました: %w", err)
+	}
+
+	// 関係の取得
+	relationship, err := uc.relationshipRepo.FindByID(ctx, input.RelationshipID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("友達関係が見つかりません")
+		}
+		return nil, fmt.Errorf("友達関係の取得中にエラーが発生しました: %w", err)
+	}
+
+	// 関係の当事者以外には存在を明かさない
+	if !relationship.InvolvesUser(user.ID) {
+		return nil, fmt.Errorf("友達関係が見つかりません")
+	}
+
+	var favorite *valueobject.FavoriteSender
+	if input.Favorite {
+		favorite = &valueobject.FavoriteSender{AlwaysRing: input.AlwaysRing}
+	}
+	if reason := relationship.SetFavoriteSender(user.ID, favorite); reason.IsNG() {
+		return nil, fmt.Errorf("お気に入り送信元を設定できませんでした: %w", reason)
+	}
+
+	// リポジトリで更新
+	if err := uc.relationshipRepo.Update(ctx, relationship); err != nil {
+		return nil, fmt.Errorf("お気に入り送信元の設定に失敗しました: %w", err)
+	}
+
+	return &UpdateFavoriteSenderOutput{
+		Relationship: relationship,
+	}, nil
+}
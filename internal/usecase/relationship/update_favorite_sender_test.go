@@ -0,0 +1,78 @@
+package relationship
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestUpdateFavoriteSenderUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T, status valueobject.RelationshipStatus) (*memory.RelationshipRepository, *UpdateFavoriteSenderUseCase) {
+		t.Helper()
+		relationshipRepo := memory.NewRelationshipRepository()
+		userRepo := memory.NewUserRepository()
+		for _, u := range []*entity.User{
+			{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hash"},
+			{ID: "user2", Username: "bob", Email: "bob@example.com", PasswordHash: "hash"},
+			{ID: "user3", Username: "carol", Email: "carol@example.com", PasswordHash: "hash"},
+		} {
+			if err := userRepo.Create(ctx, u); err != nil {
+				t.Fatalf("failed to create user: %v", err)
+			}
+		}
+		if err := relationshipRepo.Create(ctx, &entity.Relationship{
+			ID:          "rel1",
+			RequesterID: "user1",
+			ReceiverID:  "user2",
+			Status:      status,
+		}); err != nil {
+			t.Fatalf("failed to create relationship: %v", err)
+		}
+		return relationshipRepo, NewUpdateFavoriteSenderUseCase(relationshipRepo, userRepo)
+	}
+
+	t.Run("お気に入り送信元に登録・解除できる", func(t *testing.T) {
+		relationshipRepo, uc := setup(t, valueobject.RelationshipStatusAccepted)
+
+		if _, err := uc.Execute(ctx, UpdateFavoriteSenderInput{RelationshipID: "rel1", UserID: "user2", Favorite: true, AlwaysRing: true}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		saved, _ := relationshipRepo.FindByID(ctx, "rel1")
+		if favorite := saved.FavoriteSenderFor("user2"); favorite == nil || !favorite.AlwaysRing {
+			t.Errorf("FavoriteSenderFor(user2) = %+v, want AlwaysRing", favorite)
+		}
+		if saved.FavoriteSenderFor("user1") != nil {
+			t.Error("FavoriteSenderFor(user1) should remain nil")
+		}
+
+		if _, err := uc.Execute(ctx, UpdateFavoriteSenderInput{RelationshipID: "rel1", UserID: "user2", Favorite: false, AlwaysRing: true}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		saved, _ = relationshipRepo.FindByID(ctx, "rel1")
+		if saved.FavoriteSenderFor("user2") != nil {
+			t.Error("FavoriteSenderFor(user2) should be removed")
+		}
+	})
+
+	t.Run("関係外のユーザーには存在を明かさない", func(t *testing.T) {
+		_, uc := setup(t, valueobject.RelationshipStatusAccepted)
+		_, err := uc.Execute(ctx, UpdateFavoriteSenderInput{RelationshipID: "rel1", UserID: "user3", Favorite: true})
+		if err == nil || err.Error() != "友達関係が見つかりません" {
+			t.Errorf("error = %v, want not found", err)
+		}
+	})
+
+	t.Run("友達でない関係には設定できない", func(t *testing.T) {
+		_, uc := setup(t, valueobject.RelationshipStatusPending)
+		_, err := uc.Execute(ctx, UpdateFavoriteSenderInput{RelationshipID: "rel1", UserID: "user2", Favorite: true})
+		if err == nil || !strings.Contains(err.Error(), "友達関係にある相手のみお気に入り送信元に登録できます") {
+			t.Errorf("error = %v, want invalid state", err)
+		}
+	})
+}
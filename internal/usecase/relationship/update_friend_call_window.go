@@ -0,0 +1,87 @@
+package relationship
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// UpdateFriendCallWindowUseCase は友達ごとにモーニングコールを受け付ける曜日と時間帯を設定するユースケース
+type UpdateFriendCallWindowUseCase struct {
+	relationshipRepo repository.RelationshipRepository
+	userRepo         repository.UserRepository
+}
+
+// NewUpdateFriendCallWindowUseCase は新しい友達ごとの受信時間帯設定ユースケースを作成する
+func NewUpdateFriendCallWindowUseCase(
+	relationshipRepo repository.RelationshipRepository,
+	userRepo repository.UserRepository,
+) *UpdateFriendCallWindowUseCase {
+	return &UpdateFriendCallWindowUseCase{
+		relationshipRepo: relationshipRepo,
+		userRepo:         userRepo,
+	}
+}
+
+// UpdateFriendCallWindowInput は友達ごとの受信時間帯設定の入力データ
+type UpdateFriendCallWindowInput struct {
+	RelationshipID string                  // 設定する友達関係のID
+	UserID         string                  // 設定するユーザー（相手からのコールを受け取る側）のID
+	CallWindow     *valueobject.CallWindow // 受け付ける曜日と時間帯（nilでユーザー全体の設定に従う）
+}
+
+// UpdateFriendCallWindowOutput は友達ごとの受信時間帯設定の出力データ
+type UpdateFriendCallWindowOutput struct {
+	Relationship *entity.Relationship
+}
+
+// Execute は友達関係にある相手からのモーニングコールを受け付ける曜日と時間帯を設定する
+func (uc *UpdateFriendCallWindowUseCase) Execute(ctx context.Context, input UpdateFriendCallWindowInput) (*UpdateFriendCallWindowOutput, error) {
+	// 入力値の基本検証
+	if input.RelationshipID == "" {
+		return nil, fmt.Errorf("関係IDは必須です")
+	}
+	if input.UserID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+
+	// ユーザーの存在確認
+	user, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("ユーザーの確認中にエラーが発生しました: %w", err)
+	}
+
+	// 関係の取得
+	relationship, err := uc.relationshipRepo.FindByID(ctx, input.RelationshipID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("友達関係が見つかりません")
+		}
+		return nil, fmt.Errorf("友達関係の取得中にエラーが発生しました: %w", err)
+	}
+
+	// 関係の当事者以外には存在を明かさない
+	if !relationship.InvolvesUser(user.ID) {
+		return nil, fmt.Errorf("友達関係が見つかりません")
+	}
+
+	if reason := relationship.SetCallWindow(user.ID, input.CallWindow); reason.IsNG() {
+		return nil, fmt.Errorf("受信時間帯を設定できませんでした: %w", reason)
+	}
+
+	// リポジトリで更新
+	if err := uc.relationshipRepo.Update(ctx, relationship); err != nil {
+		return nil, fmt.Errorf("受信時間帯の設定に失敗しました: %w", err)
+	}
+
+	return &UpdateFriendCallWindowOutput{
+		Relationship: relationship,
+	}, nil
+}
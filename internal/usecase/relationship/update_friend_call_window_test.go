@@ -0,0 +1,88 @@
+package relationship
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestUpdateFriendCallWindowUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	window := &valueobject.CallWindow{Weekdays: []time.Weekday{time.Monday}, StartMinute: 7 * 60, EndMinute: 9 * 60}
+
+	setup := func(t *testing.T, status valueobject.RelationshipStatus) (*memory.RelationshipRepository, *UpdateFriendCallWindowUseCase) {
+		t.Helper()
+		relationshipRepo := memory.NewRelationshipRepository()
+		userRepo := memory.NewUserRepository()
+		for _, u := range []*entity.User{
+			{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hash"},
+			{ID: "user2", Username: "bob", Email: "bob@example.com", PasswordHash: "hash"},
+			{ID: "user3", Username: "carol", Email: "carol@example.com", PasswordHash: "hash"},
+		} {
+			if err := userRepo.Create(ctx, u); err != nil {
+				t.Fatalf("failed to create user: %v", err)
+			}
+		}
+		if err := relationshipRepo.Create(ctx, &entity.Relationship{
+			ID:          "rel1",
+			RequesterID: "user1",
+			ReceiverID:  "user2",
+			Status:      status,
+		}); err != nil {
+			t.Fatalf("failed to create relationship: %v", err)
+		}
+		return relationshipRepo, NewUpdateFriendCallWindowUseCase(relationshipRepo, userRepo)
+	}
+
+	t.Run("友達ごとの受信時間帯を設定・解除できる", func(t *testing.T) {
+		relationshipRepo, uc := setup(t, valueobject.RelationshipStatusAccepted)
+
+		if _, err := uc.Execute(ctx, UpdateFriendCallWindowInput{RelationshipID: "rel1", UserID: "user2", CallWindow: window}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		saved, _ := relationshipRepo.FindByID(ctx, "rel1")
+		if got := saved.CallWindowFor("user2"); got == nil || got.StartMinute != window.StartMinute || len(got.Weekdays) != 1 {
+			t.Errorf("CallWindowFor(user2) = %+v, want %+v", got, window)
+		}
+		if saved.CallWindowFor("user1") != nil {
+			t.Errorf("CallWindowFor(user1) should remain nil")
+		}
+
+		if _, err := uc.Execute(ctx, UpdateFriendCallWindowInput{RelationshipID: "rel1", UserID: "user2"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		saved, _ = relationshipRepo.FindByID(ctx, "rel1")
+		if saved.CallWindowFor("user2") != nil {
+			t.Errorf("CallWindowFor(user2) should be cleared")
+		}
+	})
+
+	t.Run("関係外のユーザーには存在を明かさない", func(t *testing.T) {
+		_, uc := setup(t, valueobject.RelationshipStatusAccepted)
+		_, err := uc.Execute(ctx, UpdateFriendCallWindowInput{RelationshipID: "rel1", UserID: "user3", CallWindow: window})
+		if err == nil || err.Error() != "友達関係が見つかりません" {
+			t.Errorf("error = %v, want not found", err)
+		}
+	})
+
+	t.Run("友達でない関係には設定できない", func(t *testing.T) {
+		_, uc := setup(t, valueobject.RelationshipStatusPending)
+		_, err := uc.Execute(ctx, UpdateFriendCallWindowInput{RelationshipID: "rel1", UserID: "user2", CallWindow: window})
+		if err == nil || !strings.Contains(err.Error(), "友達関係にある相手のみ受信時間帯を設定できます") {
+			t.Errorf("error = %v, want invalid state", err)
+		}
+	})
+
+	t.Run("存在しない関係", func(t *testing.T) {
+		_, uc := setup(t, valueobject.RelationshipStatusAccepted)
+		_, err := uc.Execute(ctx, UpdateFriendCallWindowInput{RelationshipID: "unknown", UserID: "user2", CallWindow: window})
+		if err == nil || err.Error() != "友達関係が見つかりません" {
+			t.Errorf("error = %v, want not found", err)
+		}
+	})
+}
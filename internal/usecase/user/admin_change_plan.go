@@ -0,0 +1,82 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// AdminChangePlanUseCase は管理者によるユーザーのプラン変更ユースケース
+type AdminChangePlanUseCase struct {
+	userRepo repository.UserRepository
+}
+
+// NewAdminChangePlanUseCase は新しいプラン変更ユースケースを作成する
+func NewAdminChangePlanUseCase(userRepo repository.UserRepository) *AdminChangePlanUseCase {
+	return &AdminChangePlanUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// AdminChangePlanInput はプラン変更の入力データ
+type AdminChangePlanInput struct {
+	RequesterID string           // 必須：リクエストした管理者のID
+	UserID      string           // 必須：プランを変更するユーザーのID
+	Plan        valueobject.Plan // 必須：変更後のプラン
+}
+
+// AdminChangePlanOutput はプラン変更の出力データ
+type AdminChangePlanOutput struct {
+	User *entity.User
+}
+
+// Execute はユーザーのプランを変更する
+func (uc *AdminChangePlanUseCase) Execute(ctx context.Context, input AdminChangePlanInput) (*AdminChangePlanOutput, error) {
+	// 入力値の基本検証
+	if input.RequesterID == "" {
+		return nil, fmt.Errorf("リクエストユーザーIDは必須です")
+	}
+	if input.UserID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+	if !input.Plan.IsValid() {
+		return nil, fmt.Errorf("プランは'free'または'premium'を指定してください")
+	}
+
+	// 管理者権限の確認
+	requester, err := uc.userRepo.FindByID(ctx, input.RequesterID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("ユーザーの確認中にエラーが発生しました: %w", err)
+	}
+	if !requester.IsAdmin {
+		return nil, fmt.Errorf("管理者のみがプランを変更できます")
+	}
+
+	// 対象ユーザーの取得
+	target, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("対象ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("対象ユーザーの取得中にエラーが発生しました: %w", err)
+	}
+
+	if reason := target.ChangePlan(input.Plan); reason.IsNG() {
+		return nil, fmt.Errorf("プランの変更に失敗しました: %w", reason)
+	}
+
+	if err := uc.userRepo.Update(ctx, target); err != nil {
+		return nil, fmt.Errorf("プランの保存に失敗しました: %w", err)
+	}
+
+	return &AdminChangePlanOutput{
+		User: target,
+	}, nil
+}
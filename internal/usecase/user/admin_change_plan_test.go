@@ -0,0 +1,80 @@
+package user
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestAdminChangePlanUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	userRepo := memory.NewUserRepository()
+	users := []*entity.User{
+		{ID: "admin", Username: "admin", Email: "admin@example.com", PasswordHash: "hashed_password", IsAdmin: true},
+		{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password", Plan: valueobject.PlanFree},
+	}
+	for _, u := range users {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+
+	uc := NewAdminChangePlanUseCase(userRepo)
+
+	tests := []struct {
+		name    string
+		input   AdminChangePlanInput
+		wantErr string
+	}{
+		{
+			name:    "管理者以外は変更できない",
+			input:   AdminChangePlanInput{RequesterID: "user1", UserID: "user1", Plan: valueobject.PlanPremium},
+			wantErr: "管理者のみがプランを変更できます",
+		},
+		{
+			name:    "無効なプラン",
+			input:   AdminChangePlanInput{RequesterID: "admin", UserID: "user1", Plan: valueobject.Plan("gold")},
+			wantErr: "プランは'free'または'premium'を指定してください",
+		},
+		{
+			name:    "対象ユーザーが存在しない",
+			input:   AdminChangePlanInput{RequesterID: "admin", UserID: "unknown", Plan: valueobject.PlanPremium},
+			wantErr: "対象ユーザーが見つかりません",
+		},
+		{
+			name:  "管理者はプランを変更できる",
+			input: AdminChangePlanInput{RequesterID: "admin", UserID: "user1", Plan: valueobject.PlanPremium},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := uc.Execute(ctx, tt.input)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output.User.Plan != tt.input.Plan {
+				t.Errorf("Plan = %s, want %s", output.User.Plan, tt.input.Plan)
+			}
+
+			saved, err := userRepo.FindByID(ctx, tt.input.UserID)
+			if err != nil {
+				t.Fatalf("failed to find user: %v", err)
+			}
+			if saved.Plan != tt.input.Plan {
+				t.Errorf("saved Plan = %s, want %s", saved.Plan, tt.input.Plan)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// AdminListUsersUseCase は管理者向けのユーザー一覧取得ユースケース
+type AdminListUsersUseCase struct {
+	userRepo         repository.UserRepository
+	relationshipRepo repository.RelationshipRepository
+	morningCallRepo  repository.MorningCallRepository
+}
+
+// NewAdminListUsersUseCase は新しい管理者向けユーザー一覧取得ユースケースを作成する
+func NewAdminListUsersUseCase(
+	userRepo repository.UserRepository,
+	relationshipRepo repository.RelationshipRepository,
+	morningCallRepo repository.MorningCallRepository,
+) *AdminListUsersUseCase {
+	return &AdminListUsersUseCase{
+		userRepo:         userRepo,
+		relationshipRepo: relationshipRepo,
+		morningCallRepo:  morningCallRepo,
+	}
+}
+
+// SortOrder は登録日によるソート順を表す
+type SortOrder string
+
+const (
+	SortOrderCreatedAtAsc  SortOrder = "created_at_asc"  // 登録日の古い順
+	SortOrderCreatedAtDesc SortOrder = "created_at_desc" // 登録日の新しい順
+)
+
+// AdminListUsersInput は管理者向けユーザー一覧取得の入力データ
+type AdminListUsersInput struct {
+	RequesterID   string    // 必須：リクエストした管理者のID
+	Query         string    // オプション：ユーザー名またはメールアドレスの部分一致検索
+	Frozen        *bool     // オプション：凍結状態でフィルタ
+	Sort          SortOrder // オプション：登録日ソート（デフォルトは新しい順）
+	IncludeCounts bool      // オプション：友達数・コール数を含めるか
+	Offset        int       // ページネーション：開始位置
+	Limit         int       // ページネーション：取得件数
+}
+
+// AdminUserSummary は管理者向けのユーザー情報
+type AdminUserSummary struct {
+	User        *entity.User
+	FriendCount *int // IncludeCountsが有効な場合のみ設定される
+	CallCount   *int // 送信・受信を合わせたコール数（IncludeCountsが有効な場合のみ）
+}
+
+// AdminListUsersOutput は管理者向けユーザー一覧取得の出力データ
+type AdminListUsersOutput struct {
+	Users      []AdminUserSummary
+	TotalCount int  // フィルタ適用後の総件数
+	HasNext    bool // 次のページがあるか
+}
+
+// Execute は管理者向けにユーザー一覧を取得する
+func (uc *AdminListUsersUseCase) Execute(ctx context.Context, input AdminListUsersInput) (*AdminListUsersOutput, error) {
+	// 入力値の基本検証
+	if input.RequesterID == "" {
+		return nil, fmt.Errorf("リクエストユーザーIDは必須です")
+	}
+	if input.Sort == "" {
+		input.Sort = SortOrderCreatedAtDesc
+	}
+	if input.Sort != SortOrderCreatedAtAsc && input.Sort != SortOrderCreatedAtDesc {
+		return nil, fmt.Errorf("ソート順は'created_at_asc'または'created_at_desc'を指定してください")
+	}
+	if input.Limit <= 0 {
+		input.Limit = 20 // デフォルト値
+	}
+	if input.Limit > 100 {
+		input.Limit = 100 // 最大値制限
+	}
+	if input.Offset < 0 {
+		input.Offset = 0
+	}
+
+	// 管理者権限の確認
+	requester, err := uc.userRepo.FindByID(ctx, input.RequesterID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("ユーザーの確認中にエラーが発生しました: %w", err)
+	}
+	if !requester.IsAdmin {
+		return nil, fmt.Errorf("管理者のみがユーザー一覧を閲覧できます")
+	}
+
+	// 全ユーザーを取得
+	total, err := uc.userRepo.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ユーザー数の取得中にエラーが発生しました: %w", err)
+	}
+	allUsers, err := uc.userRepo.FindAll(ctx, 0, total)
+	if err != nil {
+		return nil, fmt.Errorf("ユーザー一覧の取得中にエラーが発生しました: %w", err)
+	}
+
+	// 検索条件と凍結状態でフィルタリング
+	filtered := make([]*entity.User, 0, len(allUsers))
+	for _, u := range allUsers {
+		if input.Frozen != nil && u.IsFrozen != *input.Frozen {
+			continue
+		}
+		if input.Query != "" && !containsIgnoreCase(u.Username, input.Query) && !containsIgnoreCase(u.Email, input.Query) {
+			continue
+		}
+		filtered = append(filtered, u)
+	}
+
+	// 登録日でソート（同一時刻の場合はIDで順序を保証）
+	sort.SliceStable(filtered, func(i, j int) bool {
+		if filtered[i].CreatedAt.Equal(filtered[j].CreatedAt) {
+			return filtered[i].ID < filtered[j].ID
+		}
+		if input.Sort == SortOrderCreatedAtAsc {
+			return filtered[i].CreatedAt.Before(filtered[j].CreatedAt)
+		}
+		return filtered[i].CreatedAt.After(filtered[j].CreatedAt)
+	})
+
+	// ページネーション適用
+	totalCount := len(filtered)
+	start := input.Offset
+	if start > totalCount {
+		start = totalCount
+	}
+	end := start + input.Limit
+	if end > totalCount {
+		end = totalCount
+	}
+	page := filtered[start:end]
+
+	summaries := make([]AdminUserSummary, 0, len(page))
+	for _, u := range page {
+		summary := AdminUserSummary{User: u}
+		if input.IncludeCounts {
+			if err := uc.fillCounts(ctx, &summary); err != nil {
+				return nil, err
+			}
+		}
+		summaries = append(summaries, summary)
+	}
+
+	return &AdminListUsersOutput{
+		Users:      summaries,
+		TotalCount: totalCount,
+		HasNext:    end < totalCount,
+	}, nil
+}
+
+// fillCounts はユーザーの友達数とコール数を設定する
+func (uc *AdminListUsersUseCase) fillCounts(ctx context.Context, summary *AdminUserSummary) error {
+	friendCount, err := uc.relationshipRepo.CountFriendsByUserID(ctx, summary.User.ID)
+	if err != nil {
+		return fmt.Errorf("友達数の取得中にエラーが発生しました: %w", err)
+	}
+
+	sentCount, err := uc.morningCallRepo.CountBySenderID(ctx, summary.User.ID)
+	if err != nil {
+		return fmt.Errorf("送信コール数の取得中にエラーが発生しました: %w", err)
+	}
+	receivedCount, err := uc.morningCallRepo.CountByReceiverID(ctx, summary.User.ID)
+	if err != nil {
+		return fmt.Errorf("受信コール数の取得中にエラーが発生しました: %w", err)
+	}
+
+	callCount := sentCount + receivedCount
+	summary.FriendCount = &friendCount
+	summary.CallCount = &callCount
+	return nil
+}
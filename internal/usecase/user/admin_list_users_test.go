@@ -0,0 +1,236 @@
+package user
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestNewAdminListUsersUseCase(t *testing.T) {
+	userRepo := memory.NewUserRepository()
+	relationshipRepo := memory.NewRelationshipRepository()
+	morningCallRepo := memory.NewMorningCallRepository()
+
+	uc := NewAdminListUsersUseCase(userRepo, relationshipRepo, morningCallRepo)
+
+	if uc == nil {
+		t.Fatal("NewAdminListUsersUseCase returned nil")
+	}
+	if uc.userRepo == nil {
+		t.Error("userRepo is nil")
+	}
+	if uc.relationshipRepo == nil {
+		t.Error("relationshipRepo is nil")
+	}
+	if uc.morningCallRepo == nil {
+		t.Error("morningCallRepo is nil")
+	}
+}
+
+func TestAdminListUsersUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	userRepo := memory.NewUserRepository()
+	relationshipRepo := memory.NewRelationshipRepository()
+	morningCallRepo := memory.NewMorningCallRepository()
+
+	base := time.Now().Add(-24 * time.Hour)
+	users := []*entity.User{
+		{ID: "admin-id", Username: "admin", Email: "admin@example.com", PasswordHash: "hashed", IsAdmin: true, CreatedAt: base, UpdatedAt: base},
+		{ID: "alice-id", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed", CreatedAt: base.Add(1 * time.Hour), UpdatedAt: base},
+		{ID: "bob-id", Username: "bob", Email: "bob@sample.org", PasswordHash: "hashed", IsFrozen: true, CreatedAt: base.Add(2 * time.Hour), UpdatedAt: base},
+		{ID: "carol-id", Username: "carol", Email: "carol@example.com", PasswordHash: "hashed", CreatedAt: base.Add(3 * time.Hour), UpdatedAt: base},
+	}
+	for _, u := range users {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user %s: %v", u.ID, err)
+		}
+	}
+
+	// aliceとcarolを友達にする
+	rel := &entity.Relationship{
+		ID:          "rel-1",
+		RequesterID: "alice-id",
+		ReceiverID:  "carol-id",
+		Status:      valueobject.RelationshipStatusAccepted,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	if err := relationshipRepo.Create(ctx, rel); err != nil {
+		t.Fatalf("failed to create relationship: %v", err)
+	}
+
+	// aliceからcarolへコールを作成
+	mc := &entity.MorningCall{
+		ID:            "mc-1",
+		SenderID:      "alice-id",
+		ReceiverID:    "carol-id",
+		ScheduledTime: time.Now().Add(1 * time.Hour),
+		Message:       "おはよう",
+		Status:        valueobject.MorningCallStatusScheduled,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	if err := morningCallRepo.Create(ctx, mc); err != nil {
+		t.Fatalf("failed to create morning call: %v", err)
+	}
+
+	uc := NewAdminListUsersUseCase(userRepo, relationshipRepo, morningCallRepo)
+
+	frozen := true
+	notFrozen := false
+
+	tests := []struct {
+		name      string
+		input     AdminListUsersInput
+		wantErr   bool
+		errMsg    string
+		wantIDs   []string
+		wantTotal int
+		wantNext  bool
+	}{
+		{
+			name:      "デフォルトは登録日の新しい順",
+			input:     AdminListUsersInput{RequesterID: "admin-id"},
+			wantIDs:   []string{"carol-id", "bob-id", "alice-id", "admin-id"},
+			wantTotal: 4,
+		},
+		{
+			name:      "登録日の古い順",
+			input:     AdminListUsersInput{RequesterID: "admin-id", Sort: SortOrderCreatedAtAsc},
+			wantIDs:   []string{"admin-id", "alice-id", "bob-id", "carol-id"},
+			wantTotal: 4,
+		},
+		{
+			name:      "ユーザー名で検索",
+			input:     AdminListUsersInput{RequesterID: "admin-id", Query: "ALI"},
+			wantIDs:   []string{"alice-id"},
+			wantTotal: 1,
+		},
+		{
+			name:      "メールアドレスで検索",
+			input:     AdminListUsersInput{RequesterID: "admin-id", Query: "sample.org"},
+			wantIDs:   []string{"bob-id"},
+			wantTotal: 1,
+		},
+		{
+			name:      "凍結ユーザーのみ",
+			input:     AdminListUsersInput{RequesterID: "admin-id", Frozen: &frozen},
+			wantIDs:   []string{"bob-id"},
+			wantTotal: 1,
+		},
+		{
+			name:      "凍結されていないユーザーのみ",
+			input:     AdminListUsersInput{RequesterID: "admin-id", Frozen: &notFrozen, Sort: SortOrderCreatedAtAsc},
+			wantIDs:   []string{"admin-id", "alice-id", "carol-id"},
+			wantTotal: 3,
+		},
+		{
+			name:      "ページネーション",
+			input:     AdminListUsersInput{RequesterID: "admin-id", Sort: SortOrderCreatedAtAsc, Offset: 1, Limit: 2},
+			wantIDs:   []string{"alice-id", "bob-id"},
+			wantTotal: 4,
+			wantNext:  true,
+		},
+		{
+			name:      "範囲外のオフセット",
+			input:     AdminListUsersInput{RequesterID: "admin-id", Offset: 10},
+			wantIDs:   []string{},
+			wantTotal: 4,
+		},
+		{
+			name:    "リクエストユーザーIDが空",
+			input:   AdminListUsersInput{},
+			wantErr: true,
+			errMsg:  "リクエストユーザーIDは必須です",
+		},
+		{
+			name:    "管理者以外",
+			input:   AdminListUsersInput{RequesterID: "alice-id"},
+			wantErr: true,
+			errMsg:  "管理者のみがユーザー一覧を閲覧できます",
+		},
+		{
+			name:    "存在しないユーザー",
+			input:   AdminListUsersInput{RequesterID: "unknown-id"},
+			wantErr: true,
+			errMsg:  "ユーザーが見つかりません",
+		},
+		{
+			name:    "不正なソート順",
+			input:   AdminListUsersInput{RequesterID: "admin-id", Sort: "username"},
+			wantErr: true,
+			errMsg:  "ソート順は",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := uc.Execute(ctx, tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("エラーが期待されたが成功した")
+				}
+				if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("期待するエラーメッセージ = %q, 実際 = %q", tt.errMsg, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("予期しないエラー: %v", err)
+			}
+
+			if output.TotalCount != tt.wantTotal {
+				t.Errorf("TotalCount = %d, want %d", output.TotalCount, tt.wantTotal)
+			}
+			if output.HasNext != tt.wantNext {
+				t.Errorf("HasNext = %v, want %v", output.HasNext, tt.wantNext)
+			}
+			if len(output.Users) != len(tt.wantIDs) {
+				t.Fatalf("件数 = %d, want %d", len(output.Users), len(tt.wantIDs))
+			}
+			for i, id := range tt.wantIDs {
+				if output.Users[i].User.ID != id {
+					t.Errorf("Users[%d].ID = %s, want %s", i, output.Users[i].User.ID, id)
+				}
+				if output.Users[i].FriendCount != nil || output.Users[i].CallCount != nil {
+					t.Errorf("IncludeCounts未指定なのに件数が設定されている")
+				}
+			}
+		})
+	}
+
+	t.Run("友達数とコール数を含める", func(t *testing.T) {
+		output, err := uc.Execute(ctx, AdminListUsersInput{
+			RequesterID:   "admin-id",
+			Sort:          SortOrderCreatedAtAsc,
+			IncludeCounts: true,
+		})
+		if err != nil {
+			t.Fatalf("予期しないエラー: %v", err)
+		}
+
+		want := map[string][2]int{
+			"admin-id": {0, 0},
+			"alice-id": {1, 1},
+			"bob-id":   {0, 0},
+			"carol-id": {1, 1},
+		}
+		for _, s := range output.Users {
+			if s.FriendCount == nil || s.CallCount == nil {
+				t.Fatalf("ユーザー %s の件数が設定されていない", s.User.ID)
+			}
+			if *s.FriendCount != want[s.User.ID][0] {
+				t.Errorf("ユーザー %s の友達数 = %d, want %d", s.User.ID, *s.FriendCount, want[s.User.ID][0])
+			}
+			if *s.CallCount != want[s.User.ID][1] {
+				t.Errorf("ユーザー %s のコール数 = %d, want %d", s.User.ID, *s.CallCount, want[s.User.ID][1])
+			}
+		}
+	})
+}
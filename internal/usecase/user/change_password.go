@@ -0,0 +1,123 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+)
+
+// ChangePasswordUseCase はパスワード変更のユースケース
+// 現在のパスワードと直近の履歴に含まれるパスワードへの変更は拒否する
+type ChangePasswordUseCase struct {
+	userRepo        repository.UserRepository
+	passwordService service.PasswordService
+	historySize     int
+}
+
+// NewChangePasswordUseCase は新しいパスワード変更ユースケースを作成する
+// historySizeは再利用を禁止する過去のパスワードの件数（現在のパスワードは含まない）
+func NewChangePasswordUseCase(
+	userRepo repository.UserRepository,
+	passwordService service.PasswordService,
+	historySize int,
+) *ChangePasswordUseCase {
+	return &ChangePasswordUseCase{
+		userRepo:        userRepo,
+		passwordService: passwordService,
+		historySize:     historySize,
+	}
+}
+
+// ChangePasswordInput はパスワード変更の入力データ
+type ChangePasswordInput struct {
+	UserID          string // 必須：パスワードを変更するユーザーのID
+	CurrentPassword string // 必須：本人確認のための現在のパスワード
+	NewPassword     string // 必須：変更後のパスワード
+}
+
+// ChangePasswordOutput はパスワード変更の出力データ
+type ChangePasswordOutput struct {
+	User *entity.User
+}
+
+// Execute はパスワードを変更する
+func (uc *ChangePasswordUseCase) Execute(ctx context.Context, input ChangePasswordInput) (*ChangePasswordOutput, error) {
+	// 入力値の基本検証
+	if input.UserID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+	if input.CurrentPassword == "" {
+		return nil, fmt.Errorf("現在のパスワードは必須です")
+	}
+	if reason := entity.ValidatePassword(input.NewPassword); reason.IsNG() {
+		return nil, fmt.Errorf("%w", reason)
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	// 現在のパスワードの確認
+	valid, err := uc.passwordService.VerifyPassword(input.CurrentPassword, user.PasswordHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify password: %w", err)
+	}
+	if !valid {
+		return nil, fmt.Errorf("現在のパスワードが正しくありません")
+	}
+
+	// 現在および過去のパスワードの再利用を拒否
+	reused, err := uc.isReused(input.NewPassword, user)
+	if err != nil {
+		return nil, err
+	}
+	if reused {
+		return nil, fmt.Errorf("過去に使用したパスワードは再利用できません")
+	}
+
+	newHash, err := uc.passwordService.HashPassword(input.NewPassword)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+	if reason := user.ChangePassword(newHash, uc.historySize); reason.IsNG() {
+		return nil, fmt.Errorf("%w", reason)
+	}
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return &ChangePasswordOutput{
+		User: user,
+	}, nil
+}
+
+// isReused は新しいパスワードが現在のパスワードまたは履歴内のパスワードと一致するかを判定する
+// 履歴は設定された件数分のみ照合する（設定を減らした場合に古い履歴で拒否しないため）
+func (uc *ChangePasswordUseCase) isReused(password string, user *entity.User) (bool, error) {
+	hashes := []string{user.PasswordHash}
+	history := user.PasswordHistory
+	if len(history) > uc.historySize {
+		history = history[:max(uc.historySize, 0)]
+	}
+	hashes = append(hashes, history...)
+
+	for _, hash := range hashes {
+		matched, err := uc.passwordService.VerifyPassword(password, hash)
+		if err != nil {
+			return false, fmt.Errorf("failed to verify password history: %w", err)
+		}
+		if matched {
+			return true, nil
+		}
+	}
+	return false, nil
+}
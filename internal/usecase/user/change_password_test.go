@@ -0,0 +1,106 @@
+package user
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestChangePasswordUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	newRepo := func(t *testing.T, history []string) *memory.UserRepository {
+		t.Helper()
+		userRepo := memory.NewUserRepository()
+		if err := userRepo.Create(ctx, &entity.User{
+			ID:              "user1",
+			Username:        "alice",
+			Email:           "alice@example.com",
+			PasswordHash:    "hashed_Current123!",
+			PasswordHistory: history,
+		}); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+		return userRepo
+	}
+
+	t.Run("変更すると変更前のパスワードが履歴の先頭に追加される", func(t *testing.T) {
+		userRepo := newRepo(t, []string{"hashed_Old1234!"})
+		uc := NewChangePasswordUseCase(userRepo, &mockPasswordService{}, 5)
+
+		if _, err := uc.Execute(ctx, ChangePasswordInput{UserID: "user1", CurrentPassword: "Current123!", NewPassword: "Brand123!"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		persisted, _ := userRepo.FindByID(ctx, "user1")
+		if persisted.PasswordHash != "hashed_Brand123!" {
+			t.Errorf("PasswordHash = %q, want hashed_Brand123!", persisted.PasswordHash)
+		}
+		want := []string{"hashed_Current123!", "hashed_Old1234!"}
+		if !reflect.DeepEqual(persisted.PasswordHistory, want) {
+			t.Errorf("PasswordHistory = %v, want %v", persisted.PasswordHistory, want)
+		}
+	})
+
+	t.Run("履歴の件数を超えた古いパスワードは押し出される", func(t *testing.T) {
+		userRepo := newRepo(t, []string{"hashed_Old1111!", "hashed_Old2222!"})
+		uc := NewChangePasswordUseCase(userRepo, &mockPasswordService{}, 2)
+
+		if _, err := uc.Execute(ctx, ChangePasswordInput{UserID: "user1", CurrentPassword: "Current123!", NewPassword: "Brand123!"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		persisted, _ := userRepo.FindByID(ctx, "user1")
+		want := []string{"hashed_Current123!", "hashed_Old1111!"}
+		if !reflect.DeepEqual(persisted.PasswordHistory, want) {
+			t.Errorf("PasswordHistory = %v, want %v", persisted.PasswordHistory, want)
+		}
+
+		// 押し出されたパスワードは再び使える
+		if _, err := uc.Execute(ctx, ChangePasswordInput{UserID: "user1", CurrentPassword: "Brand123!", NewPassword: "Old2222!"}); err != nil {
+			t.Errorf("unexpected error for pushed-out password: %v", err)
+		}
+	})
+
+	tests := []struct {
+		name        string
+		current     string
+		newPassword string
+		historySize int
+		wantErr     string
+	}{
+		{name: "現在のパスワードは再利用できない", current: "Current123!", newPassword: "Current123!", historySize: 5, wantErr: "過去に使用したパスワードは再利用できません"},
+		{name: "履歴内のパスワードは再利用できない", current: "Current123!", newPassword: "Old2222!", historySize: 5, wantErr: "過去に使用したパスワードは再利用できません"},
+		{name: "履歴の件数外のパスワードは照合しない", current: "Current123!", newPassword: "Old2222!", historySize: 1},
+		{name: "現在のパスワードが正しくない", current: "Wrong123!", newPassword: "Brand123!", historySize: 5, wantErr: "現在のパスワードが正しくありません"},
+		{name: "新しいパスワードが弱い", current: "Current123!", newPassword: "short", historySize: 5, wantErr: "パスワードは8文字以上である必要があります"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := newRepo(t, []string{"hashed_Old1111!", "hashed_Old2222!"})
+			uc := NewChangePasswordUseCase(userRepo, &mockPasswordService{}, tt.historySize)
+
+			_, err := uc.Execute(ctx, ChangePasswordInput{UserID: "user1", CurrentPassword: tt.current, NewPassword: tt.newPassword})
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("error = %v, want containing %q", err, tt.wantErr)
+			}
+
+			// 拒否した場合はパスワードも履歴も変わらない
+			persisted, _ := userRepo.FindByID(ctx, "user1")
+			if persisted.PasswordHash != "hashed_Current123!" || len(persisted.PasswordHistory) != 2 {
+				t.Errorf("user changed on error: hash=%q history=%v", persisted.PasswordHash, persisted.PasswordHistory)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// ChangeUsernameUseCase はユーザー名変更のユースケース
+// 乱用を防ぐため、一度変更するとクールダウン期間が経過するまで再変更できない
+type ChangeUsernameUseCase struct {
+	userRepo repository.UserRepository
+	limits   valueobject.InputLimits
+	cooldown time.Duration
+	now      func() time.Time
+}
+
+// NewChangeUsernameUseCase は新しいユーザー名変更ユースケースを作成する
+// cooldownが0以下の場合はentity.DefaultUsernameChangeCooldownを使用する
+func NewChangeUsernameUseCase(userRepo repository.UserRepository, limits valueobject.InputLimits, cooldown time.Duration) *ChangeUsernameUseCase {
+	if cooldown <= 0 {
+		cooldown = entity.DefaultUsernameChangeCooldown
+	}
+	return &ChangeUsernameUseCase{
+		userRepo: userRepo,
+		limits:   limits,
+		cooldown: cooldown,
+		now:      time.Now,
+	}
+}
+
+// ChangeUsernameInput はユーザー名変更の入力データ
+type ChangeUsernameInput struct {
+	UserID   string // 必須：ユーザー名を変更するユーザーのID
+	Username string // 必須：新しいユーザー名
+}
+
+// ChangeUsernameOutput はユーザー名変更の出力データ
+type ChangeUsernameOutput struct {
+	User         *entity.User
+	NextChangeAt time.Time // 次にユーザー名を変更できるようになる日時
+}
+
+// Execute はユーザー名を変更する
+func (uc *ChangeUsernameUseCase) Execute(ctx context.Context, input ChangeUsernameInput) (*ChangeUsernameOutput, error) {
+	// 入力値の基本検証
+	if input.UserID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	// クールダウンと形式の検証を先に行い、変更できない間は他のユーザー名の使用状況を返さない
+	if reason := user.ChangeUsername(input.Username, uc.now(), uc.cooldown, uc.limits); reason.IsNG() {
+		return nil, fmt.Errorf("%w", reason)
+	}
+
+	exists, err := uc.userRepo.ExistsByUsername(ctx, input.Username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check username existence: %w", err)
+	}
+	if exists {
+		return nil, fmt.Errorf("%w: ユーザー名 '%s' は既に使用されています", repository.ErrAlreadyExists, input.Username)
+	}
+
+	// 確認と保存の間に重複が発生した場合もリポジトリが ErrAlreadyExists を返す
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return nil, fmt.Errorf("%w: ユーザー名 '%s' は既に使用されています", repository.ErrAlreadyExists, input.Username)
+		}
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return &ChangeUsernameOutput{
+		User:         user,
+		NextChangeAt: *user.NextUsernameChangeAt(uc.cooldown),
+	}, nil
+}
@@ -0,0 +1,135 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestChangeUsernameUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	cooldown := 30 * 24 * time.Hour
+
+	newRepo := func(t *testing.T, changedAt *time.Time) *memory.UserRepository {
+		t.Helper()
+		userRepo := memory.NewUserRepository()
+		for _, u := range []*entity.User{
+			{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password", UsernameChangedAt: changedAt},
+			{ID: "user2", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+		} {
+			if err := userRepo.Create(ctx, u); err != nil {
+				t.Fatalf("failed to create user: %v", err)
+			}
+		}
+		return userRepo
+	}
+	newUseCase := func(userRepo *memory.UserRepository) *ChangeUsernameUseCase {
+		uc := NewChangeUsernameUseCase(userRepo, valueobject.DefaultInputLimits(), cooldown)
+		uc.now = func() time.Time { return now }
+		return uc
+	}
+
+	t.Run("初回はユーザー名を変更でき、クールダウンが始まる", func(t *testing.T) {
+		userRepo := newRepo(t, nil)
+		output, err := newUseCase(userRepo).Execute(ctx, ChangeUsernameInput{UserID: "user1", Username: "alice_new"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !output.NextChangeAt.Equal(now.Add(cooldown)) {
+			t.Errorf("NextChangeAt = %v, want %v", output.NextChangeAt, now.Add(cooldown))
+		}
+
+		persisted, _ := userRepo.FindByID(ctx, "user1")
+		if persisted.Username != "alice_new" || persisted.UsernameChangedAt == nil || !persisted.UsernameChangedAt.Equal(now) {
+			t.Errorf("(Username, UsernameChangedAt) = (%s, %v), want (alice_new, %v)", persisted.Username, persisted.UsernameChangedAt, now)
+		}
+		if _, err := userRepo.FindByUsername(ctx, "alice"); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("old username still found: err = %v", err)
+		}
+	})
+
+	t.Run("クールダウンの境界", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			elapsed time.Duration // 前回の変更からの経過時間
+			wantErr bool
+		}{
+			{name: "クールダウン中は変更できない", elapsed: 24 * time.Hour, wantErr: true},
+			{name: "期間の1ナノ秒前は変更できない", elapsed: cooldown - time.Nanosecond, wantErr: true},
+			{name: "期間ちょうどで変更できる", elapsed: cooldown},
+			{name: "期間を過ぎれば変更できる", elapsed: cooldown + time.Hour},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				changedAt := now.Add(-tt.elapsed)
+				userRepo := newRepo(t, &changedAt)
+
+				_, err := newUseCase(userRepo).Execute(ctx, ChangeUsernameInput{UserID: "user1", Username: "alice_new"})
+				persisted, _ := userRepo.FindByID(ctx, "user1")
+				if tt.wantErr {
+					var reason valueobject.NGReason
+					if !errors.As(err, &reason) || reason.Code() != valueobject.ReasonCodeInvalidState {
+						t.Errorf("error = %v, want cooldown error", err)
+					}
+					if persisted.Username != "alice" {
+						t.Errorf("Username = %s, want unchanged", persisted.Username)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if persisted.Username != "alice_new" {
+					t.Errorf("Username = %s, want alice_new", persisted.Username)
+				}
+			})
+		}
+	})
+
+	t.Run("他のユーザーが使用中のユーザー名には変更できない", func(t *testing.T) {
+		userRepo := newRepo(t, nil)
+		_, err := newUseCase(userRepo).Execute(ctx, ChangeUsernameInput{UserID: "user1", Username: "BOB"})
+		if !errors.Is(err, repository.ErrAlreadyExists) {
+			t.Errorf("error = %v, want ErrAlreadyExists", err)
+		}
+		// 失敗した変更ではクールダウンは始まらない
+		persisted, _ := userRepo.FindByID(ctx, "user1")
+		if persisted.Username != "alice" || persisted.UsernameChangedAt != nil {
+			t.Errorf("(Username, UsernameChangedAt) = (%s, %v), want unchanged", persisted.Username, persisted.UsernameChangedAt)
+		}
+	})
+
+	t.Run("不正な形式・現在と同じユーザー名", func(t *testing.T) {
+		tests := []struct {
+			username string
+			wantCode valueobject.ReasonCode
+		}{
+			{username: "", wantCode: valueobject.ReasonCodeRequired},
+			{username: "a", wantCode: valueobject.ReasonCodeTooShort},
+			{username: "alice!", wantCode: valueobject.ReasonCodeInvalidFormat},
+			{username: "Alice", wantCode: valueobject.ReasonCodeDuplicate},
+		}
+		for _, tt := range tests {
+			_, err := newUseCase(newRepo(t, nil)).Execute(ctx, ChangeUsernameInput{UserID: "user1", Username: tt.username})
+			var reason valueobject.NGReason
+			if !errors.As(err, &reason) || reason.Code() != tt.wantCode {
+				t.Errorf("Execute(%q) error = %v, want %s", tt.username, err, tt.wantCode)
+			}
+		}
+	})
+
+	t.Run("存在しないユーザー", func(t *testing.T) {
+		_, err := newUseCase(newRepo(t, nil)).Execute(ctx, ChangeUsernameInput{UserID: "unknown", Username: "alice_new"})
+		if err == nil || !strings.Contains(err.Error(), "ユーザーが見つかりません") {
+			t.Errorf("error = %v, want not found", err)
+		}
+	})
+}
@@ -0,0 +1,132 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// ErrAvailabilityCheckLimited は利用可能チェックの回数が上限に達した場合のエラー
+var ErrAvailabilityCheckLimited = errors.New("利用可能チェックの回数が上限に達しました。しばらくしてからお試しください")
+
+// CheckAvailabilityUseCase はユーザー名・メールアドレスが利用可能かを確認するユースケース
+// アカウントの列挙に使われないよう、リクエストしたユーザーごとに期間内の確認回数を制限する
+type CheckAvailabilityUseCase struct {
+	userRepo  repository.UserRepository
+	limits    valueobject.InputLimits
+	maxChecks int           // 期間内の上限回数（0以下で無制限）
+	window    time.Duration // 上限回数を数える期間
+	checks    map[string][]time.Time
+	mu        sync.Mutex
+	now       func() time.Time
+}
+
+// NewCheckAvailabilityUseCase は新しい利用可能チェックユースケースを作成する
+func NewCheckAvailabilityUseCase(
+	userRepo repository.UserRepository,
+	limits valueobject.InputLimits,
+	maxChecks int,
+	window time.Duration,
+) *CheckAvailabilityUseCase {
+	return &CheckAvailabilityUseCase{
+		userRepo:  userRepo,
+		limits:    limits,
+		maxChecks: maxChecks,
+		window:    window,
+		checks:    make(map[string][]time.Time),
+		now:       time.Now,
+	}
+}
+
+// CheckAvailabilityInput は利用可能チェックの入力データ
+type CheckAvailabilityInput struct {
+	RequesterID string // 必須：確認するユーザーのID
+	Username    string // 確認するユーザー名（空の場合は確認しない）
+	Email       string // 確認するメールアドレス（空の場合は確認しない）
+}
+
+// CheckAvailabilityOutput は利用可能チェックの出力データ
+// 確認しなかった項目はnil
+type CheckAvailabilityOutput struct {
+	UsernameAvailable *bool
+	EmailAvailable    *bool
+}
+
+// Execute はユーザー名・メールアドレスが未使用で利用可能かを確認する
+// 形式が不正な値は問い合わせずにエラーとし、回数の上限には形式が不正な確認も含める
+func (uc *CheckAvailabilityUseCase) Execute(ctx context.Context, input CheckAvailabilityInput) (*CheckAvailabilityOutput, error) {
+	if input.RequesterID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+	if input.Username == "" && input.Email == "" {
+		return nil, fmt.Errorf("ユーザー名またはメールアドレスを指定してください")
+	}
+
+	if !uc.allow(input.RequesterID) {
+		return nil, ErrAvailabilityCheckLimited
+	}
+
+	output := &CheckAvailabilityOutput{}
+
+	if input.Username != "" {
+		candidate := &entity.User{Username: input.Username}
+		if reason := candidate.ValidateUsername(uc.limits); reason.IsNG() {
+			return nil, fmt.Errorf("%w", reason)
+		}
+		exists, err := uc.userRepo.ExistsByUsername(ctx, candidate.Username)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check username: %w", err)
+		}
+		available := !exists
+		output.UsernameAvailable = &available
+	}
+
+	if input.Email != "" {
+		candidate := &entity.User{Email: input.Email}
+		if reason := candidate.ValidateEmail(uc.limits); reason.IsNG() {
+			return nil, fmt.Errorf("%w", reason)
+		}
+		exists, err := uc.userRepo.ExistsByEmail(ctx, candidate.Email)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check email: %w", err)
+		}
+		available := !exists
+		output.EmailAvailable = &available
+	}
+
+	return output, nil
+}
+
+// allow は期間内の確認回数が上限未満であれば今回の確認を記録してtrueを返す
+func (uc *CheckAvailabilityUseCase) allow(requesterID string) bool {
+	if uc.maxChecks <= 0 {
+		return true
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	now := uc.now()
+	cutoff := now.Add(-uc.window)
+
+	// 期間外になった記録を取り除く
+	recent := uc.checks[requesterID][:0]
+	for _, at := range uc.checks[requesterID] {
+		if at.After(cutoff) {
+			recent = append(recent, at)
+		}
+	}
+
+	if len(recent) >= uc.maxChecks {
+		uc.checks[requesterID] = recent
+		return false
+	}
+	uc.checks[requesterID] = append(recent, now)
+	return true
+}
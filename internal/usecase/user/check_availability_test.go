@@ -0,0 +1,130 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestCheckAvailabilityUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	newUseCase := func(t *testing.T, maxChecks int) *CheckAvailabilityUseCase {
+		t.Helper()
+		userRepo := memory.NewUserRepository()
+		if err := userRepo.Create(ctx, &entity.User{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"}); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+		return NewCheckAvailabilityUseCase(userRepo, valueobject.DefaultInputLimits(), maxChecks, time.Minute)
+	}
+
+	tests := []struct {
+		name         string
+		username     string
+		email        string
+		wantUsername *bool
+		wantEmail    *bool
+	}{
+		{name: "使用済みのユーザー名", username: "alice", wantUsername: boolPtr(false)},
+		{name: "大小文字を区別せずに判定する", username: "ALICE", wantUsername: boolPtr(false)},
+		{name: "未使用のユーザー名", username: "bob", wantUsername: boolPtr(true)},
+		{name: "使用済みのメールアドレス", email: "Alice@Example.com", wantEmail: boolPtr(false)},
+		{name: "未使用のメールアドレス", email: "bob@example.com", wantEmail: boolPtr(true)},
+		{name: "両方を同時に確認する", username: "bob", email: "alice@example.com", wantUsername: boolPtr(true), wantEmail: boolPtr(false)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := newUseCase(t, 0)
+			output, err := uc.Execute(ctx, CheckAvailabilityInput{RequesterID: "user2", Username: tt.username, Email: tt.email})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !equalBoolPtr(output.UsernameAvailable, tt.wantUsername) {
+				t.Errorf("UsernameAvailable = %v, want %v", formatBoolPtr(output.UsernameAvailable), formatBoolPtr(tt.wantUsername))
+			}
+			if !equalBoolPtr(output.EmailAvailable, tt.wantEmail) {
+				t.Errorf("EmailAvailable = %v, want %v", formatBoolPtr(output.EmailAvailable), formatBoolPtr(tt.wantEmail))
+			}
+		})
+	}
+
+	t.Run("形式が不正な値は問い合わせない", func(t *testing.T) {
+		uc := newUseCase(t, 0)
+		_, err := uc.Execute(ctx, CheckAvailabilityInput{RequesterID: "user2", Username: "a b"})
+		if err == nil || !strings.Contains(err.Error(), "ユーザー名には英数字") {
+			t.Errorf("error = %v, want invalid username", err)
+		}
+		_, err = uc.Execute(ctx, CheckAvailabilityInput{RequesterID: "user2", Email: "invalid"})
+		if err == nil {
+			t.Error("invalid email should be rejected")
+		}
+	})
+
+	t.Run("確認する値の指定が必須", func(t *testing.T) {
+		uc := newUseCase(t, 0)
+		if _, err := uc.Execute(ctx, CheckAvailabilityInput{RequesterID: "user2"}); err == nil {
+			t.Error("expected error when nothing to check")
+		}
+		if _, err := uc.Execute(ctx, CheckAvailabilityInput{Username: "bob"}); err == nil {
+			t.Error("expected error without requester")
+		}
+	})
+
+	t.Run("期間内の回数を制限する", func(t *testing.T) {
+		uc := newUseCase(t, 3)
+		now := time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)
+		uc.now = func() time.Time { return now }
+
+		// 形式が不正な確認も回数に含める
+		if _, err := uc.Execute(ctx, CheckAvailabilityInput{RequesterID: "user2", Username: "a b"}); err == nil || errors.Is(err, ErrAvailabilityCheckLimited) {
+			t.Fatalf("error = %v, want validation error", err)
+		}
+		for i := 0; i < 2; i++ {
+			if _, err := uc.Execute(ctx, CheckAvailabilityInput{RequesterID: "user2", Username: "bob"}); err != nil {
+				t.Fatalf("check %d: unexpected error: %v", i+1, err)
+			}
+		}
+		if _, err := uc.Execute(ctx, CheckAvailabilityInput{RequesterID: "user2", Username: "bob"}); !errors.Is(err, ErrAvailabilityCheckLimited) {
+			t.Errorf("error = %v, want ErrAvailabilityCheckLimited", err)
+		}
+
+		// 他のユーザーの回数には影響しない
+		if _, err := uc.Execute(ctx, CheckAvailabilityInput{RequesterID: "user3", Username: "bob"}); err != nil {
+			t.Errorf("other requester: unexpected error: %v", err)
+		}
+
+		// 期間が過ぎれば再び確認できる
+		now = now.Add(time.Minute)
+		if _, err := uc.Execute(ctx, CheckAvailabilityInput{RequesterID: "user2", Username: "bob"}); err != nil {
+			t.Errorf("after window: unexpected error: %v", err)
+		}
+	})
+}
+
+func boolPtr(v bool) *bool {
+	return &v
+}
+
+func equalBoolPtr(a, b *bool) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func formatBoolPtr(v *bool) string {
+	if v == nil {
+		return "nil"
+	}
+	if *v {
+		return "true"
+	}
+	return "false"
+}
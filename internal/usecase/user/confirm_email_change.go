@@ -0,0 +1,85 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// ConfirmEmailChangeUseCase はメールアドレス変更確認のユースケース
+type ConfirmEmailChangeUseCase struct {
+	userRepo repository.UserRepository
+	limits   valueobject.InputLimits
+}
+
+// NewConfirmEmailChangeUseCase は新しいメールアドレス変更確認ユースケースを作成する
+func NewConfirmEmailChangeUseCase(userRepo repository.UserRepository, limits valueobject.InputLimits) *ConfirmEmailChangeUseCase {
+	return &ConfirmEmailChangeUseCase{
+		userRepo: userRepo,
+		limits:   limits,
+	}
+}
+
+// ConfirmEmailChangeInput はメールアドレス変更確認の入力データ
+type ConfirmEmailChangeInput struct {
+	UserID string // 必須：変更を確認するユーザーのID
+	Token  string // 必須：新しいメールアドレスに送信された確認トークン
+}
+
+// ConfirmEmailChangeOutput はメールアドレス変更確認の出力データ
+type ConfirmEmailChangeOutput struct {
+	User *entity.User
+}
+
+// Execute は確認トークンを検証し、申請中のメールアドレスへ変更する
+func (uc *ConfirmEmailChangeUseCase) Execute(ctx context.Context, input ConfirmEmailChangeInput) (*ConfirmEmailChangeOutput, error) {
+	// 入力値の基本検証
+	if input.UserID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+	if input.Token == "" {
+		return nil, fmt.Errorf("確認トークンは必須です")
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	if !user.HasPendingEmailChange() {
+		return nil, fmt.Errorf("メールアドレスの変更申請がありません")
+	}
+
+	// 申請後に他のユーザーが同じメールアドレスを登録した場合は変更できない
+	exists, err := uc.userRepo.ExistsByEmail(ctx, user.PendingEmail)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check email existence: %w", err)
+	}
+	if exists {
+		return nil, fmt.Errorf("%w: メールアドレス '%s' は既に登録されています", repository.ErrAlreadyExists, user.PendingEmail)
+	}
+
+	if reason := user.ConfirmEmailChange(hashEmailChangeToken(input.Token), time.Now(), uc.limits); reason.IsNG() {
+		return nil, fmt.Errorf("%w", reason)
+	}
+
+	// 確認と保存の間に重複が発生した場合もリポジトリが ErrAlreadyExists を返す
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return nil, fmt.Errorf("%w: メールアドレス '%s' は既に登録されています", repository.ErrAlreadyExists, user.Email)
+		}
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return &ConfirmEmailChangeOutput{
+		User: user,
+	}, nil
+}
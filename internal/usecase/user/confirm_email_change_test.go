@@ -0,0 +1,126 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/mail"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+// requestEmailChangeForTest はメールアドレス変更を申請し、送信されたトークンを返す
+func requestEmailChangeForTest(t *testing.T, userRepo *memory.UserRepository, newEmail string) string {
+	t.Helper()
+	sender := mail.NewMemoryEmailSender()
+	uc := NewRequestEmailChangeUseCase(userRepo, &mockPasswordService{}, sender, valueobject.DefaultInputLimits())
+	if _, err := uc.Execute(context.Background(), RequestEmailChangeInput{UserID: "user1", NewEmail: newEmail, Password: "Password123!"}); err != nil {
+		t.Fatalf("failed to request email change: %v", err)
+	}
+	return extractEmailChangeToken(t, sender.Messages()[0].Body)
+}
+
+func TestConfirmEmailChangeUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正しいトークンでメールアドレスが変更される", func(t *testing.T) {
+		userRepo := memory.NewUserRepository()
+		setupEmailChangeUsers(t, userRepo)
+		token := requestEmailChangeForTest(t, userRepo, "alice.new@example.com")
+
+		uc := NewConfirmEmailChangeUseCase(userRepo, valueobject.DefaultInputLimits())
+		output, err := uc.Execute(ctx, ConfirmEmailChangeInput{UserID: "user1", Token: token})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.User.Email != "alice.new@example.com" {
+			t.Errorf("Email = %s", output.User.Email)
+		}
+
+		// 新しいメールで検索でき、旧メールは解放される
+		if found, err := userRepo.FindByEmail(ctx, "alice.new@example.com"); err != nil || found.ID != "user1" {
+			t.Errorf("新しいメールで検索できません: %v", err)
+		}
+		if _, err := userRepo.FindByEmail(ctx, "alice@example.com"); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("旧メールは解放されるべき: %v", err)
+		}
+
+		// 同じトークンは再利用できない
+		if _, err := uc.Execute(ctx, ConfirmEmailChangeInput{UserID: "user1", Token: token}); err == nil || !strings.Contains(err.Error(), "メールアドレスの変更申請がありません") {
+			t.Errorf("トークンの再利用が拒否されていません: %v", err)
+		}
+	})
+
+	t.Run("申請後に他のユーザーが同じメールアドレスを登録した場合は拒否する", func(t *testing.T) {
+		userRepo := memory.NewUserRepository()
+		setupEmailChangeUsers(t, userRepo)
+		token := requestEmailChangeForTest(t, userRepo, "taken@example.com")
+
+		other := &entity.User{ID: "user3", Username: "carol", Email: "taken@example.com", PasswordHash: "x", CreatedAt: time.Now(), UpdatedAt: time.Now()}
+		if err := userRepo.Create(ctx, other); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+
+		uc := NewConfirmEmailChangeUseCase(userRepo, valueobject.DefaultInputLimits())
+		_, err := uc.Execute(ctx, ConfirmEmailChangeInput{UserID: "user1", Token: token})
+		if !errors.Is(err, repository.ErrAlreadyExists) {
+			t.Errorf("error = %v, want %v", err, repository.ErrAlreadyExists)
+		}
+		stored, _ := userRepo.FindByID(ctx, "user1")
+		if stored.Email != "alice@example.com" {
+			t.Errorf("メールアドレスが変更されています: %s", stored.Email)
+		}
+	})
+
+	t.Run("有効期限切れのトークンは拒否する", func(t *testing.T) {
+		userRepo := memory.NewUserRepository()
+		setupEmailChangeUsers(t, userRepo)
+		token := requestEmailChangeForTest(t, userRepo, "alice.new@example.com")
+
+		stored, _ := userRepo.FindByID(ctx, "user1")
+		expired := time.Now().Add(-time.Minute)
+		stored.EmailChangeExpiresAt = &expired
+		if err := userRepo.Update(ctx, stored); err != nil {
+			t.Fatalf("failed to update user: %v", err)
+		}
+
+		uc := NewConfirmEmailChangeUseCase(userRepo, valueobject.DefaultInputLimits())
+		_, err := uc.Execute(ctx, ConfirmEmailChangeInput{UserID: "user1", Token: token})
+		if err == nil || !strings.Contains(err.Error(), "確認トークンの有効期限が切れています") {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	tests := []struct {
+		name   string
+		input  ConfirmEmailChangeInput
+		errMsg string
+	}{
+		{name: "ユーザーIDが空", input: ConfirmEmailChangeInput{Token: "token"}, errMsg: "ユーザーIDは必須です"},
+		{name: "トークンが空", input: ConfirmEmailChangeInput{UserID: "user1"}, errMsg: "確認トークンは必須です"},
+		{name: "ユーザーが存在しない", input: ConfirmEmailChangeInput{UserID: "nobody", Token: "token"}, errMsg: "ユーザーが見つかりません"},
+		{name: "トークンが一致しない", input: ConfirmEmailChangeInput{UserID: "user1", Token: "wrong-token"}, errMsg: "確認トークンが正しくありません"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := memory.NewUserRepository()
+			setupEmailChangeUsers(t, userRepo)
+			requestEmailChangeForTest(t, userRepo, "alice.new@example.com")
+
+			uc := NewConfirmEmailChangeUseCase(userRepo, valueobject.DefaultInputLimits())
+			_, err := uc.Execute(ctx, tt.input)
+			if err == nil {
+				t.Fatal("expected error but got nil")
+			}
+			if !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("error message = %v, want contains %v", err.Error(), tt.errMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// DeleteDeviceTokenUseCase はユーザーが登録した端末を削除するユースケース
+// ログアウトや端末の機種変更時に、不要になった端末へ通知が届かないようにする
+type DeleteDeviceTokenUseCase struct {
+	deviceRepo repository.DeviceTokenRepository
+}
+
+// NewDeleteDeviceTokenUseCase は新しい端末削除ユースケースを作成する
+func NewDeleteDeviceTokenUseCase(deviceRepo repository.DeviceTokenRepository) *DeleteDeviceTokenUseCase {
+	return &DeleteDeviceTokenUseCase{
+		deviceRepo: deviceRepo,
+	}
+}
+
+// DeleteDeviceTokenInput は端末削除の入力データ
+type DeleteDeviceTokenInput struct {
+	UserID   string // 必須：端末を削除するユーザーのID
+	DeviceID string // 必須：削除する端末のID
+}
+
+// Execute は本人の端末を削除する
+// 他のユーザーの端末は存在を明かさないよう、存在しない場合と同じエラーを返す
+func (uc *DeleteDeviceTokenUseCase) Execute(ctx context.Context, input DeleteDeviceTokenInput) error {
+	if input.UserID == "" {
+		return fmt.Errorf("ユーザーIDは必須です")
+	}
+	if input.DeviceID == "" {
+		return fmt.Errorf("端末IDは必須です")
+	}
+
+	device, err := uc.deviceRepo.FindByID(ctx, input.DeviceID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return fmt.Errorf("端末が見つかりません")
+		}
+		return fmt.Errorf("failed to find device token: %w", err)
+	}
+	if device.UserID != input.UserID {
+		return fmt.Errorf("端末が見つかりません")
+	}
+
+	if err := uc.deviceRepo.Delete(ctx, device.ID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return fmt.Errorf("端末が見つかりません")
+		}
+		return fmt.Errorf("failed to delete device token: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+func TestDeleteDeviceTokenUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	_, deviceRepo := newDeviceTokenFixture(t)
+	if err := deviceRepo.Create(ctx, &entity.DeviceToken{
+		ID: "d1", UserID: "user1", Token: "token", Platform: valueobject.DevicePlatformAndroid, CreatedAt: time.Now(),
+	}); err != nil {
+		t.Fatalf("failed to create device: %v", err)
+	}
+	uc := NewDeleteDeviceTokenUseCase(deviceRepo)
+
+	// 他のユーザーの端末は存在しない場合と同じく扱う
+	if err := uc.Execute(ctx, DeleteDeviceTokenInput{UserID: "user2", DeviceID: "d1"}); err == nil || !strings.Contains(err.Error(), "端末が見つかりません") {
+		t.Errorf("error = %v, want not found", err)
+	}
+	if _, err := deviceRepo.FindByID(ctx, "d1"); err != nil {
+		t.Fatalf("device deleted by another user: %v", err)
+	}
+
+	if err := uc.Execute(ctx, DeleteDeviceTokenInput{UserID: "user1", DeviceID: "d1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := deviceRepo.FindByID(ctx, "d1"); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("FindByID() error = %v, want ErrNotFound", err)
+	}
+	if err := uc.Execute(ctx, DeleteDeviceTokenInput{UserID: "user1", DeviceID: "d1"}); err == nil || !strings.Contains(err.Error(), "端末が見つかりません") {
+		t.Errorf("error = %v, want not found", err)
+	}
+}
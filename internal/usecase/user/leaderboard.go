@@ -0,0 +1,75 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+const (
+	// DefaultLeaderboardLimit はランキングの取得件数のデフォルト値
+	DefaultLeaderboardLimit = 10
+	// MaxLeaderboardLimit はランキングの取得件数の最大値
+	MaxLeaderboardLimit = 100
+)
+
+// LeaderboardUseCase は感謝ポイントの上位ユーザーを取得するユースケース
+type LeaderboardUseCase struct {
+	userRepo repository.UserRepository
+}
+
+// NewLeaderboardUseCase は新しいランキング取得ユースケースを作成する
+func NewLeaderboardUseCase(userRepo repository.UserRepository) *LeaderboardUseCase {
+	return &LeaderboardUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// LeaderboardInput はランキング取得の入力データ
+type LeaderboardInput struct {
+	Limit int // オプション：取得件数（デフォルト10件、最大100件）
+}
+
+// LeaderboardEntry はランキングの1行
+type LeaderboardEntry struct {
+	Rank int // 順位（同点は同じ順位とし、次の順位は人数分飛ばす）
+	User *entity.User
+}
+
+// LeaderboardOutput はランキング取得の出力データ
+type LeaderboardOutput struct {
+	Entries []LeaderboardEntry // ポイントの降順
+}
+
+// Execute は感謝ポイントの多い順にユーザーを返す
+// ポイントを持たないユーザーと凍結中のユーザーは含めない
+func (uc *LeaderboardUseCase) Execute(ctx context.Context, input LeaderboardInput) (*LeaderboardOutput, error) {
+	// 入力値の基本検証
+	if input.Limit < 0 {
+		return nil, fmt.Errorf("取得件数は0以上である必要があります")
+	}
+	if input.Limit == 0 {
+		input.Limit = DefaultLeaderboardLimit
+	}
+	if input.Limit > MaxLeaderboardLimit {
+		input.Limit = MaxLeaderboardLimit // 最大値制限
+	}
+
+	users, err := uc.userRepo.FindTopByPoints(ctx, input.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("ランキングの取得中にエラーが発生しました: %w", err)
+	}
+
+	entries := make([]LeaderboardEntry, 0, len(users))
+	for i, u := range users {
+		rank := i + 1
+		if i > 0 && u.Points == users[i-1].Points {
+			rank = entries[i-1].Rank
+		}
+		entries = append(entries, LeaderboardEntry{Rank: rank, User: u})
+	}
+
+	return &LeaderboardOutput{Entries: entries}, nil
+}
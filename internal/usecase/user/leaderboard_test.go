@@ -0,0 +1,92 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestLeaderboardUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	userRepo := memory.NewUserRepository()
+
+	now := time.Now()
+	for _, u := range []struct {
+		id     string
+		points int
+		frozen bool
+	}{
+		{"alice", 30, false},
+		{"bob", 50, false},
+		{"carol", 30, false},
+		{"dave", 10, false},
+		{"eve", 0, false},
+		{"frozen", 100, true},
+	} {
+		if err := userRepo.Create(ctx, &entity.User{
+			ID:           u.id,
+			Username:     u.id + "_user",
+			Email:        u.id + "@example.com",
+			PasswordHash: "hashed",
+			IsFrozen:     u.frozen,
+			Points:       u.points,
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		}); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+	uc := NewLeaderboardUseCase(userRepo)
+
+	tests := []struct {
+		name      string
+		limit     int
+		want      []string
+		wantRanks []int
+		errorMsg  string
+	}{
+		{
+			name:      "ポイントの多い順に並び、同点は同じ順位になる",
+			want:      []string{"bob", "alice", "carol", "dave"},
+			wantRanks: []int{1, 2, 2, 4},
+		},
+		{
+			name:      "件数を指定できる",
+			limit:     2,
+			want:      []string{"bob", "alice"},
+			wantRanks: []int{1, 2},
+		},
+		{
+			name:     "負の件数はエラー",
+			limit:    -1,
+			errorMsg: "取得件数は0以上である必要があります",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := uc.Execute(ctx, LeaderboardInput{Limit: tt.limit})
+			if tt.errorMsg != "" {
+				if err == nil || err.Error() != tt.errorMsg {
+					t.Errorf("error = %v, want %s", err, tt.errorMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(output.Entries) != len(tt.want) {
+				t.Fatalf("got %d entries, want %d", len(output.Entries), len(tt.want))
+			}
+			for i, entry := range output.Entries {
+				if got := fmt.Sprintf("%s#%d", entry.User.ID, entry.Rank); got != fmt.Sprintf("%s#%d", tt.want[i], tt.wantRanks[i]) {
+					t.Errorf("entries[%d] = %s, want %s#%d", i, got, tt.want[i], tt.wantRanks[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// ListDeviceTokensUseCase はユーザーが登録した端末の一覧を取得するユースケース
+type ListDeviceTokensUseCase struct {
+	deviceRepo repository.DeviceTokenRepository
+}
+
+// NewListDeviceTokensUseCase は新しい端末一覧取得ユースケースを作成する
+func NewListDeviceTokensUseCase(deviceRepo repository.DeviceTokenRepository) *ListDeviceTokensUseCase {
+	return &ListDeviceTokensUseCase{
+		deviceRepo: deviceRepo,
+	}
+}
+
+// ListDeviceTokensInput は端末一覧取得の入力データ
+type ListDeviceTokensInput struct {
+	UserID   string                     // 必須：ユーザーのID
+	Platform valueobject.DevicePlatform // オプション：指定したプラットフォームの端末のみ返す
+}
+
+// ListDeviceTokensOutput は端末一覧取得の出力データ
+type ListDeviceTokensOutput struct {
+	Devices []*entity.DeviceToken // 登録日時の昇順
+}
+
+// Execute はユーザーの有効な端末を返す
+// 無効化された端末は通知が届かずクリーンアップで削除されるため含めない
+func (uc *ListDeviceTokensUseCase) Execute(ctx context.Context, input ListDeviceTokensInput) (*ListDeviceTokensOutput, error) {
+	if input.UserID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+	if input.Platform != "" && !input.Platform.IsValid() {
+		return nil, fmt.Errorf("%w", valueobject.NGWithCode(valueobject.ReasonCodeInvalid, "platform", "プラットフォームはios、android、webのいずれかを指定してください"))
+	}
+
+	devices, err := uc.deviceRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find devices: %w", err)
+	}
+
+	result := make([]*entity.DeviceToken, 0, len(devices))
+	for _, device := range devices {
+		if device.IsInvalidated() {
+			continue
+		}
+		if input.Platform != "" && device.Platform != input.Platform {
+			continue
+		}
+		result = append(result, device)
+	}
+
+	return &ListDeviceTokensOutput{
+		Devices: result,
+	}, nil
+}
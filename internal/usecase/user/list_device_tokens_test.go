@@ -0,0 +1,62 @@
+package user
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+func TestListDeviceTokensUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	_, deviceRepo := newDeviceTokenFixture(t)
+	now := time.Date(2026, 3, 15, 7, 0, 0, 0, time.UTC)
+
+	for _, d := range []*entity.DeviceToken{
+		{ID: "ios", UserID: "user1", Token: "ios-token", Platform: valueobject.DevicePlatformIOS, CreatedAt: now},
+		{ID: "web", UserID: "user1", Token: "web-token", Platform: valueobject.DevicePlatformWeb, CreatedAt: now.Add(time.Minute)},
+		{ID: "invalid", UserID: "user1", Token: "invalid-token", Platform: valueobject.DevicePlatformIOS, CreatedAt: now, InvalidatedAt: &now},
+		{ID: "other", UserID: "user2", Token: "other-token", Platform: valueobject.DevicePlatformIOS, CreatedAt: now},
+	} {
+		if err := deviceRepo.Create(ctx, d); err != nil {
+			t.Fatalf("failed to create device: %v", err)
+		}
+	}
+	uc := NewListDeviceTokensUseCase(deviceRepo)
+
+	tests := []struct {
+		name     string
+		platform valueobject.DevicePlatform
+		wantIDs  []string
+	}{
+		{name: "無効化された端末を除いて返す", wantIDs: []string{"ios", "web"}},
+		{name: "プラットフォームで絞り込む", platform: valueobject.DevicePlatformWeb, wantIDs: []string{"web"}},
+		{name: "該当なしは空", platform: valueobject.DevicePlatformAndroid, wantIDs: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := uc.Execute(ctx, ListDeviceTokensInput{UserID: "user1", Platform: tt.platform})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(output.Devices) != len(tt.wantIDs) {
+				t.Fatalf("got %d devices, want %v", len(output.Devices), tt.wantIDs)
+			}
+			for i, id := range tt.wantIDs {
+				if output.Devices[i].ID != id {
+					t.Errorf("Devices[%d] = %s, want %s", i, output.Devices[i].ID, id)
+				}
+			}
+		})
+	}
+
+	t.Run("不明なプラットフォームはエラー", func(t *testing.T) {
+		_, err := uc.Execute(ctx, ListDeviceTokensInput{UserID: "user1", Platform: "windows"})
+		if err == nil || !strings.Contains(err.Error(), "プラットフォーム") {
+			t.Errorf("error = %v, want invalid platform", err)
+		}
+	})
+}
@@ -0,0 +1,113 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/pkg/utils"
+)
+
+// RegisterDeviceTokenUseCase はプッシュ通知の配信先としてユーザーの端末を登録するユースケース
+type RegisterDeviceTokenUseCase struct {
+	userRepo   repository.UserRepository
+	deviceRepo repository.DeviceTokenRepository
+	now        func() time.Time
+}
+
+// NewRegisterDeviceTokenUseCase は新しい端末登録ユースケースを作成する
+func NewRegisterDeviceTokenUseCase(
+	userRepo repository.UserRepository,
+	deviceRepo repository.DeviceTokenRepository,
+) *RegisterDeviceTokenUseCase {
+	return &RegisterDeviceTokenUseCase{
+		userRepo:   userRepo,
+		deviceRepo: deviceRepo,
+		now:        time.Now,
+	}
+}
+
+// RegisterDeviceTokenInput は端末登録の入力データ
+type RegisterDeviceTokenInput struct {
+	UserID   string                     // 必須：端末を登録するユーザーのID
+	Token    string                     // 必須：配信サービスが発行したトークン
+	Platform valueobject.DevicePlatform // 必須：端末のプラットフォーム
+}
+
+// RegisterDeviceTokenOutput は端末登録の出力データ
+type RegisterDeviceTokenOutput struct {
+	Device  *entity.DeviceToken
+	Created bool // 新しく登録したか（falseの場合は登録済みの端末をそのまま返した）
+}
+
+// Execute はユーザーの端末を登録する
+// 同じトークンがすでに本人の端末として有効に登録されている場合は何もせずに返す
+// 他のユーザーの端末として登録されている場合や無効化されている場合は、登録し直して現在のユーザーの端末にする
+func (uc *RegisterDeviceTokenUseCase) Execute(ctx context.Context, input RegisterDeviceTokenInput) (*RegisterDeviceTokenOutput, error) {
+	if input.UserID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	id, err := utils.GenerateUUID()
+	if err != nil {
+		return nil, fmt.Errorf("ID生成に失敗しました: %w", err)
+	}
+	device, reason := entity.NewDeviceToken(id, user.ID, input.Token, input.Platform, uc.now())
+	if reason.IsNG() {
+		return nil, fmt.Errorf("%w", reason)
+	}
+
+	existing, err := uc.deviceRepo.FindByToken(ctx, input.Token)
+	switch {
+	case err == nil:
+		if existing.UserID == user.ID && existing.Platform == input.Platform && !existing.IsInvalidated() {
+			return &RegisterDeviceTokenOutput{Device: existing}, nil
+		}
+		// 端末の持ち主が変わった・無効化後に再発行されたなどの場合は古い登録を破棄する
+		if err := uc.deviceRepo.Delete(ctx, existing.ID); err != nil && !errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("failed to delete previous device token: %w", err)
+		}
+	case !errors.Is(err, repository.ErrNotFound):
+		return nil, fmt.Errorf("failed to find device token: %w", err)
+	}
+
+	// 有効な端末の登録数の上限を確認する（無効化された端末はクリーンアップ待ちのため数えない）
+	devices, err := uc.deviceRepo.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find devices: %w", err)
+	}
+	active := 0
+	for _, d := range devices {
+		if !d.IsInvalidated() {
+			active++
+		}
+	}
+	if active >= entity.MaxDeviceTokensPerUser {
+		return nil, fmt.Errorf("%w", valueobject.NGWithCode(valueobject.ReasonCodeLimitExceeded, "token",
+			fmt.Sprintf("登録できる端末は%d台までです。使わなくなった端末を削除してください", entity.MaxDeviceTokensPerUser)))
+	}
+
+	if err := uc.deviceRepo.Create(ctx, device); err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return nil, fmt.Errorf("同じ端末の登録が同時に行われました。再度お試しください")
+		}
+		return nil, fmt.Errorf("failed to create device token: %w", err)
+	}
+
+	return &RegisterDeviceTokenOutput{
+		Device:  device,
+		Created: true,
+	}, nil
+}
@@ -0,0 +1,152 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+// newDeviceTokenFixture はユーザー2人を登録したリポジトリを返す
+func newDeviceTokenFixture(t *testing.T) (*memory.UserRepository, *memory.DeviceTokenRepository) {
+	t.Helper()
+	userRepo := memory.NewUserRepository()
+	for _, u := range []*entity.User{
+		{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+		{ID: "user2", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+	} {
+		if err := userRepo.Create(context.Background(), u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+	return userRepo, memory.NewDeviceTokenRepository()
+}
+
+func TestRegisterDeviceTokenUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("同じユーザーの複数の端末を登録できる", func(t *testing.T) {
+		userRepo, deviceRepo := newDeviceTokenFixture(t)
+		uc := NewRegisterDeviceTokenUseCase(userRepo, deviceRepo)
+
+		for _, in := range []RegisterDeviceTokenInput{
+			{UserID: "user1", Token: "ios-token", Platform: valueobject.DevicePlatformIOS},
+			{UserID: "user1", Token: "web-token", Platform: valueobject.DevicePlatformWeb},
+		} {
+			output, err := uc.Execute(ctx, in)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !output.Created || output.Device.ID == "" || output.Device.Platform != in.Platform {
+				t.Errorf("output = %+v, want created %s device", output.Device, in.Platform)
+			}
+		}
+
+		devices, _ := deviceRepo.FindByUserID(ctx, "user1")
+		if len(devices) != 2 {
+			t.Errorf("registered %d devices, want 2", len(devices))
+		}
+	})
+
+	t.Run("登録済みのトークンは同じ端末を返す", func(t *testing.T) {
+		userRepo, deviceRepo := newDeviceTokenFixture(t)
+		uc := NewRegisterDeviceTokenUseCase(userRepo, deviceRepo)
+
+		input := RegisterDeviceTokenInput{UserID: "user1", Token: "ios-token", Platform: valueobject.DevicePlatformIOS}
+		first, err := uc.Execute(ctx, input)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		second, err := uc.Execute(ctx, input)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if second.Created || second.Device.ID != first.Device.ID {
+			t.Errorf("second = %+v (created=%v), want existing %s", second.Device, second.Created, first.Device.ID)
+		}
+	})
+
+	t.Run("他のユーザーのトークンや無効化されたトークンは登録し直す", func(t *testing.T) {
+		userRepo, deviceRepo := newDeviceTokenFixture(t)
+		uc := NewRegisterDeviceTokenUseCase(userRepo, deviceRepo)
+
+		first, err := uc.Execute(ctx, RegisterDeviceTokenInput{UserID: "user1", Token: "shared-token", Platform: valueobject.DevicePlatformAndroid})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		moved, err := uc.Execute(ctx, RegisterDeviceTokenInput{UserID: "user2", Token: "shared-token", Platform: valueobject.DevicePlatformAndroid})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !moved.Created || moved.Device.UserID != "user2" || moved.Device.ID == first.Device.ID {
+			t.Errorf("moved = %+v, want new device of user2", moved.Device)
+		}
+		if devices, _ := deviceRepo.FindByUserID(ctx, "user1"); len(devices) != 0 {
+			t.Errorf("user1 still has %d devices", len(devices))
+		}
+
+		device, _ := deviceRepo.FindByID(ctx, moved.Device.ID)
+		device.Invalidate(time.Now())
+		if err := deviceRepo.Update(ctx, device); err != nil {
+			t.Fatalf("failed to invalidate device: %v", err)
+		}
+		renewed, err := uc.Execute(ctx, RegisterDeviceTokenInput{UserID: "user2", Token: "shared-token", Platform: valueobject.DevicePlatformAndroid})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !renewed.Created || renewed.Device.IsInvalidated() {
+			t.Errorf("renewed = %+v, want new valid device", renewed.Device)
+		}
+	})
+
+	t.Run("登録できる端末数に上限がある", func(t *testing.T) {
+		userRepo, deviceRepo := newDeviceTokenFixture(t)
+		uc := NewRegisterDeviceTokenUseCase(userRepo, deviceRepo)
+
+		for i := range entity.MaxDeviceTokensPerUser {
+			if _, err := uc.Execute(ctx, RegisterDeviceTokenInput{UserID: "user1", Token: fmt.Sprintf("token%d", i), Platform: valueobject.DevicePlatformIOS}); err != nil {
+				t.Fatalf("unexpected error on device %d: %v", i+1, err)
+			}
+		}
+		_, err := uc.Execute(ctx, RegisterDeviceTokenInput{UserID: "user1", Token: "one-more", Platform: valueobject.DevicePlatformIOS})
+		if err == nil || !strings.Contains(err.Error(), "登録できる端末は10台までです") {
+			t.Errorf("error = %v, want limit exceeded", err)
+		}
+	})
+
+	tests := []struct {
+		name   string
+		input  RegisterDeviceTokenInput
+		errMsg string
+	}{
+		{
+			name:   "トークンが空",
+			input:  RegisterDeviceTokenInput{UserID: "user1", Platform: valueobject.DevicePlatformIOS},
+			errMsg: "デバイストークンは必須です",
+		},
+		{
+			name:   "不明なプラットフォーム",
+			input:  RegisterDeviceTokenInput{UserID: "user1", Token: "token", Platform: "windows"},
+			errMsg: "プラットフォームはios、android、webのいずれかを指定してください",
+		},
+		{
+			name:   "存在しないユーザー",
+			input:  RegisterDeviceTokenInput{UserID: "missing", Token: "token", Platform: valueobject.DevicePlatformIOS},
+			errMsg: "ユーザーが見つかりません",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo, deviceRepo := newDeviceTokenFixture(t)
+			_, err := NewRegisterDeviceTokenUseCase(userRepo, deviceRepo).Execute(ctx, tt.input)
+			if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("error = %v, want containing %q", err, tt.errMsg)
+			}
+		})
+	}
+}
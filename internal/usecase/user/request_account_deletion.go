@@ -0,0 +1,93 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+)
+
+// RequestAccountDeletionUseCase はアカウントの削除を申請するユースケース
+// アカウントはすぐには削除せず削除予定とし、猶予期間の経過後にスケジューラが関連データとともに完全に削除する
+// 猶予期間中にログインすると削除予定は取り消される
+type RequestAccountDeletionUseCase struct {
+	userRepo        repository.UserRepository
+	passwordService service.PasswordService
+	gracePeriod     time.Duration
+	now             func() time.Time
+}
+
+// NewRequestAccountDeletionUseCase は新しいアカウント削除申請ユースケースを作成する
+// gracePeriodが0以下の場合はentity.DefaultAccountDeletionGracePeriodを使用する
+func NewRequestAccountDeletionUseCase(
+	userRepo repository.UserRepository,
+	passwordService service.PasswordService,
+	gracePeriod time.Duration,
+) *RequestAccountDeletionUseCase {
+	if gracePeriod <= 0 {
+		gracePeriod = entity.DefaultAccountDeletionGracePeriod
+	}
+	return &RequestAccountDeletionUseCase{
+		userRepo:        userRepo,
+		passwordService: passwordService,
+		gracePeriod:     gracePeriod,
+		now:             time.Now,
+	}
+}
+
+// RequestAccountDeletionInput はアカウント削除申請の入力データ
+type RequestAccountDeletionInput struct {
+	UserID   string // 必須：削除を申請するユーザーのID
+	Password string // 必須：本人確認のための現在のパスワード
+}
+
+// RequestAccountDeletionOutput はアカウント削除申請の出力データ
+type RequestAccountDeletionOutput struct {
+	User                *entity.User
+	DeletionScheduledAt time.Time // 完全に削除される日時（この日時までにログインすると取り消される）
+}
+
+// Execute はアカウントを削除予定にする
+func (uc *RequestAccountDeletionUseCase) Execute(ctx context.Context, input RequestAccountDeletionInput) (*RequestAccountDeletionOutput, error) {
+	// 入力値の基本検証
+	if input.UserID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+	if input.Password == "" {
+		return nil, fmt.Errorf("パスワードは必須です")
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	// 取り消しの難しい操作のため、現在のパスワードで本人確認する
+	valid, err := uc.passwordService.VerifyPassword(input.Password, user.PasswordHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify password: %w", err)
+	}
+	if !valid {
+		return nil, fmt.Errorf("パスワードが正しくありません")
+	}
+
+	if reason := user.ScheduleDeletion(uc.now(), uc.gracePeriod); reason.IsNG() {
+		return nil, fmt.Errorf("%w", reason)
+	}
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return &RequestAccountDeletionOutput{
+		User:                user,
+		DeletionScheduledAt: *user.DeletionScheduledAt,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestRequestAccountDeletionUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	newUseCase := func(t *testing.T) (*RequestAccountDeletionUseCase, *memory.UserRepository) {
+		t.Helper()
+		userRepo := memory.NewUserRepository()
+		if err := userRepo.Create(ctx, &entity.User{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_Current123!"}); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+		uc := NewRequestAccountDeletionUseCase(userRepo, &mockPasswordService{}, 7*24*time.Hour)
+		uc.now = func() time.Time { return now }
+		return uc, userRepo
+	}
+
+	t.Run("猶予期間の後に削除されるよう削除予定にする", func(t *testing.T) {
+		uc, userRepo := newUseCase(t)
+
+		output, err := uc.Execute(ctx, RequestAccountDeletionInput{UserID: "user1", Password: "Current123!"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := now.Add(7 * 24 * time.Hour)
+		if !output.DeletionScheduledAt.Equal(want) {
+			t.Errorf("DeletionScheduledAt = %v, want %v", output.DeletionScheduledAt, want)
+		}
+
+		persisted, _ := userRepo.FindByID(ctx, "user1")
+		if !persisted.IsPendingDeletion() || persisted.IsDeletionDue(want.Add(-time.Second)) || !persisted.IsDeletionDue(want) {
+			t.Errorf("DeletionScheduledAt = %v, want pending until %v", persisted.DeletionScheduledAt, want)
+		}
+	})
+
+	t.Run("パスワードが正しくない場合は削除予定にしない", func(t *testing.T) {
+		uc, userRepo := newUseCase(t)
+
+		_, err := uc.Execute(ctx, RequestAccountDeletionInput{UserID: "user1", Password: "Wrong123!"})
+		if err == nil || err.Error() != "パスワードが正しくありません" {
+			t.Errorf("error = %v, want wrong password", err)
+		}
+		persisted, _ := userRepo.FindByID(ctx, "user1")
+		if persisted.IsPendingDeletion() {
+			t.Error("user should not be pending deletion")
+		}
+	})
+
+	t.Run("既に削除予定の場合は申請できない", func(t *testing.T) {
+		uc, _ := newUseCase(t)
+		input := RequestAccountDeletionInput{UserID: "user1", Password: "Current123!"}
+		if _, err := uc.Execute(ctx, input); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		_, err := uc.Execute(ctx, input)
+		var reason valueobject.NGReason
+		if !errors.As(err, &reason) || reason.Code() != valueobject.ReasonCodeInvalidState {
+			t.Errorf("error = %v, want invalid state", err)
+		}
+	})
+
+	t.Run("存在しないユーザー", func(t *testing.T) {
+		uc, _ := newUseCase(t)
+		_, err := uc.Execute(ctx, RequestAccountDeletionInput{UserID: "unknown", Password: "Current123!"})
+		if err == nil || !strings.Contains(err.Error(), "ユーザーが見つかりません") {
+			t.Errorf("error = %v, want not found", err)
+		}
+	})
+}
@@ -0,0 +1,132 @@
+package user
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/pkg/utils"
+)
+
+// RequestEmailChangeUseCase はメールアドレス変更申請のユースケース
+type RequestEmailChangeUseCase struct {
+	userRepo        repository.UserRepository
+	passwordService service.PasswordService
+	emailSender     service.EmailSender
+	limits          valueobject.InputLimits
+}
+
+// NewRequestEmailChangeUseCase は新しいメールアドレス変更申請ユースケースを作成する
+func NewRequestEmailChangeUseCase(
+	userRepo repository.UserRepository,
+	passwordService service.PasswordService,
+	emailSender service.EmailSender,
+	limits valueobject.InputLimits,
+) *RequestEmailChangeUseCase {
+	return &RequestEmailChangeUseCase{
+		userRepo:        userRepo,
+		passwordService: passwordService,
+		emailSender:     emailSender,
+		limits:          limits,
+	}
+}
+
+// RequestEmailChangeInput はメールアドレス変更申請の入力データ
+type RequestEmailChangeInput struct {
+	UserID   string // 必須：変更を申請するユーザーのID
+	NewEmail string // 必須：変更後のメールアドレス
+	Password string // 必須：本人確認のための現在のパスワード
+}
+
+// RequestEmailChangeOutput はメールアドレス変更申請の出力データ
+type RequestEmailChangeOutput struct {
+	PendingEmail string    // 確認待ちのメールアドレス
+	ExpiresAt    time.Time // 確認トークンの有効期限
+}
+
+// Execute は新しいメールアドレス宛てに確認トークンを送信する
+// 確認が完了するまでは現在のメールアドレスのまま利用できる
+func (uc *RequestEmailChangeUseCase) Execute(ctx context.Context, input RequestEmailChangeInput) (*RequestEmailChangeOutput, error) {
+	// 入力値の基本検証
+	if input.UserID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+	if input.NewEmail == "" {
+		return nil, fmt.Errorf("新しいメールアドレスは必須です")
+	}
+	if input.Password == "" {
+		return nil, fmt.Errorf("パスワードは必須です")
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	// パスワードの再確認
+	valid, err := uc.passwordService.VerifyPassword(input.Password, user.PasswordHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify password: %w", err)
+	}
+	if !valid {
+		return nil, fmt.Errorf("パスワードが正しくありません")
+	}
+
+	// 他のユーザーが使用しているメールアドレスには変更できない
+	exists, err := uc.userRepo.ExistsByEmail(ctx, input.NewEmail)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check email existence: %w", err)
+	}
+	if exists && !strings.EqualFold(user.Email, input.NewEmail) {
+		return nil, fmt.Errorf("%w: メールアドレス '%s' は既に登録されています", repository.ErrAlreadyExists, input.NewEmail)
+	}
+
+	// 確認トークンの生成（保存するのはハッシュ値のみ）
+	token, err := utils.GenerateUUID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate email change token: %w", err)
+	}
+	if reason := user.RequestEmailChange(input.NewEmail, hashEmailChangeToken(token), time.Now(), uc.limits); reason.IsNG() {
+		return nil, fmt.Errorf("%w", reason)
+	}
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	// 新しいメールアドレス宛てに確認トークンを送信
+	message := service.EmailMessage{
+		To:      user.PendingEmail,
+		Subject: "メールアドレス変更の確認",
+		Body: fmt.Sprintf(
+			"%s さん\n\nメールアドレスの変更を受け付けました。\n以下の確認コードを %s までに入力して変更を完了してください。\n\n確認コード: %s\n\nお心当たりがない場合はこのメールを破棄してください。",
+			user.Username,
+			user.EmailChangeExpiresAt.Format("2006-01-02 15:04"),
+			token,
+		),
+	}
+	if err := uc.emailSender.Send(ctx, message); err != nil {
+		return nil, fmt.Errorf("確認メールの送信に失敗しました: %w", err)
+	}
+
+	return &RequestEmailChangeOutput{
+		PendingEmail: user.PendingEmail,
+		ExpiresAt:    *user.EmailChangeExpiresAt,
+	}, nil
+}
+
+// hashEmailChangeToken は確認トークンを保存用のハッシュ値に変換する
+func hashEmailChangeToken(token string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(token)))
+	return hex.EncodeToString(sum[:])
+}
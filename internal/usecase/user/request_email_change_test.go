@@ -0,0 +1,127 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/mail"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+// failingEmailSender は常に送信に失敗するテスト用のメール送信サービス
+type failingEmailSender struct{}
+
+func (failingEmailSender) Send(ctx context.Context, message service.EmailMessage) error {
+	return errors.New("smtp unavailable")
+}
+
+// setupEmailChangeUsers はメールアドレス変更テスト用のユーザーを作成する
+func setupEmailChangeUsers(t *testing.T, userRepo *memory.UserRepository) {
+	t.Helper()
+	ctx := context.Background()
+	for _, u := range []*entity.User{
+		{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_Password123!", CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		{ID: "user2", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_Password123!", CreatedAt: time.Now(), UpdatedAt: time.Now()},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+}
+
+// extractEmailChangeToken は確認メールの本文からトークンを取り出す
+func extractEmailChangeToken(t *testing.T, body string) string {
+	t.Helper()
+	for _, line := range strings.Split(body, "\n") {
+		if strings.HasPrefix(line, "確認コード: ") {
+			return strings.TrimPrefix(line, "確認コード: ")
+		}
+	}
+	t.Fatalf("確認コードが本文に含まれていません: %s", body)
+	return ""
+}
+
+func TestRequestEmailChangeUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("確認メールを新しいアドレスに送信する", func(t *testing.T) {
+		userRepo := memory.NewUserRepository()
+		setupEmailChangeUsers(t, userRepo)
+		sender := mail.NewMemoryEmailSender()
+		uc := NewRequestEmailChangeUseCase(userRepo, &mockPasswordService{}, sender, valueobject.DefaultInputLimits())
+
+		output, err := uc.Execute(ctx, RequestEmailChangeInput{UserID: "user1", NewEmail: "alice.new@example.com", Password: "Password123!"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if output.PendingEmail != "alice.new@example.com" {
+			t.Errorf("PendingEmail = %s", output.PendingEmail)
+		}
+
+		messages := sender.Messages()
+		if len(messages) != 1 || messages[0].To != "alice.new@example.com" {
+			t.Fatalf("確認メールが新しいアドレスに送信されていません: %+v", messages)
+		}
+		token := extractEmailChangeToken(t, messages[0].Body)
+
+		// 保存されるのはトークンのハッシュ値のみで、確認前は旧メールのまま
+		stored, _ := userRepo.FindByID(ctx, "user1")
+		if stored.Email != "alice@example.com" {
+			t.Errorf("確認前にメールアドレスが変更されています: %s", stored.Email)
+		}
+		if stored.EmailChangeTokenHash == "" || stored.EmailChangeTokenHash == token {
+			t.Error("トークンはハッシュ化して保存されるべき")
+		}
+		if _, err := userRepo.FindByEmail(ctx, "alice@example.com"); err != nil {
+			t.Errorf("変更中も旧メールで検索できるべき: %v", err)
+		}
+	})
+
+	tests := []struct {
+		name    string
+		input   RequestEmailChangeInput
+		sender  service.EmailSender
+		errMsg  string
+		wantErr error
+	}{
+		{name: "ユーザーIDが空", input: RequestEmailChangeInput{NewEmail: "new@example.com", Password: "Password123!"}, errMsg: "ユーザーIDは必須です"},
+		{name: "新しいメールアドレスが空", input: RequestEmailChangeInput{UserID: "user1", Password: "Password123!"}, errMsg: "新しいメールアドレスは必須です"},
+		{name: "パスワードが空", input: RequestEmailChangeInput{UserID: "user1", NewEmail: "new@example.com"}, errMsg: "パスワードは必須です"},
+		{name: "ユーザーが存在しない", input: RequestEmailChangeInput{UserID: "nobody", NewEmail: "new@example.com", Password: "Password123!"}, errMsg: "ユーザーが見つかりません"},
+		{name: "パスワードが正しくない", input: RequestEmailChangeInput{UserID: "user1", NewEmail: "new@example.com", Password: "Wrong123!"}, errMsg: "パスワードが正しくありません"},
+		{name: "他のユーザーのメールアドレス", input: RequestEmailChangeInput{UserID: "user1", NewEmail: "BOB@example.com", Password: "Password123!"}, wantErr: repository.ErrAlreadyExists},
+		{name: "現在と同じメールアドレス", input: RequestEmailChangeInput{UserID: "user1", NewEmail: "alice@example.com", Password: "Password123!"}, errMsg: "現在と同じメールアドレスには変更できません"},
+		{name: "形式が不正", input: RequestEmailChangeInput{UserID: "user1", NewEmail: "invalid", Password: "Password123!"}, errMsg: "メールアドレスの形式が正しくありません"},
+		{name: "メール送信に失敗", input: RequestEmailChangeInput{UserID: "user1", NewEmail: "new@example.com", Password: "Password123!"}, sender: failingEmailSender{}, errMsg: "確認メールの送信に失敗しました"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := memory.NewUserRepository()
+			setupEmailChangeUsers(t, userRepo)
+			sender := tt.sender
+			if sender == nil {
+				sender = mail.NewMemoryEmailSender()
+			}
+			uc := NewRequestEmailChangeUseCase(userRepo, &mockPasswordService{}, sender, valueobject.DefaultInputLimits())
+
+			_, err := uc.Execute(ctx, tt.input)
+			if err == nil {
+				t.Fatal("expected error but got nil")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.errMsg != "" && !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("error message = %v, want contains %v", err.Error(), tt.errMsg)
+			}
+		})
+	}
+}
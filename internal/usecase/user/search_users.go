@@ -0,0 +1,168 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// RelationshipState は検索者から見た相手との関係ステータス
+type RelationshipState string
+
+const (
+	// RelationshipStateNone は関係がない状態（拒否済みを含む）
+	RelationshipStateNone RelationshipState = "none"
+	// RelationshipStatePending は友達リクエストが承認待ちの状態
+	RelationshipStatePending RelationshipState = "pending"
+	// RelationshipStateAccepted は友達の状態
+	RelationshipStateAccepted RelationshipState = "accepted"
+	// RelationshipStateBlocked はブロック関係にある状態
+	RelationshipStateBlocked RelationshipState = "blocked"
+)
+
+// SearchUsersUseCase はユーザー検索のユースケース
+type SearchUsersUseCase struct {
+	userRepo         repository.UserRepository
+	relationshipRepo repository.RelationshipRepository
+}
+
+// NewSearchUsersUseCase は新しいユーザー検索ユースケースを作成する
+func NewSearchUsersUseCase(
+	userRepo repository.UserRepository,
+	relationshipRepo repository.RelationshipRepository,
+) *SearchUsersUseCase {
+	return &SearchUsersUseCase{
+		userRepo:         userRepo,
+		relationshipRepo: relationshipRepo,
+	}
+}
+
+// SearchUsersInput はユーザー検索の入力パラメータ
+type SearchUsersInput struct {
+	SearcherID string // 必須：検索者のID（結果から除外される）
+	Query      string // 検索クエリ（ユーザー名の部分一致）
+	Limit      int    // 取得件数の上限
+}
+
+// SearchUserResult は検索結果の1件
+type SearchUserResult struct {
+	User               *entity.User
+	RelationshipState  RelationshipState // 検索者との関係ステータス
+	CanSendMorningCall bool              // 検索者がこのユーザーにモーニングコールを送れるか
+}
+
+// SearchUsersOutput はユーザー検索の出力結果
+type SearchUsersOutput struct {
+	Results []SearchUserResult // 検索結果のリスト
+	Total   int                // 検索結果の総数
+}
+
+// Execute はユーザーを検索し、検索者との関係ステータスを付与して返す
+func (uc *SearchUsersUseCase) Execute(ctx context.Context, input SearchUsersInput) (*SearchUsersOutput, error) {
+	// 入力検証
+	if input.SearcherID == "" {
+		return nil, fmt.Errorf("検索者IDは必須です")
+	}
+	if input.Query == "" {
+		return nil, fmt.Errorf("検索クエリは必須です")
+	}
+
+	// Limitのデフォルト値
+	if input.Limit <= 0 {
+		input.Limit = 100
+	}
+
+	// 全ユーザーを取得（簡易的な実装）
+	// 本来はリポジトリにSearchメソッドを実装すべき
+	allUsers, err := uc.userRepo.FindAll(ctx, 0, 1000)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return &SearchUsersOutput{
+				Results: []SearchUserResult{},
+				Total:   0,
+			}, nil
+		}
+		return nil, fmt.Errorf("ユーザー検索に失敗しました: %w", err)
+	}
+
+	// フィルタリング（部分一致検索）
+	var matchedUsers []*entity.User
+	for _, u := range allUsers {
+		// 自分自身を除外
+		if u.ID == input.SearcherID {
+			continue
+		}
+
+		// ユーザー名の部分一致検索（大文字小文字を区別しない）
+		if containsIgnoreCase(u.Username, input.Query) {
+			matchedUsers = append(matchedUsers, u)
+
+			// 制限に達したら終了
+			if len(matchedUsers) >= input.Limit {
+				break
+			}
+		}
+	}
+
+	// 検索者の関係を一括で取得し、相手ユーザーIDで引けるようにする（N+1を避ける）
+	states, err := uc.resolveRelationshipStates(ctx, input.SearcherID)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]SearchUserResult, 0, len(matchedUsers))
+	for _, u := range matchedUsers {
+		state, ok := states[u.ID]
+		if !ok {
+			state = RelationshipStateNone
+		}
+		results = append(results, SearchUserResult{
+			User:               u,
+			RelationshipState:  state,
+			CanSendMorningCall: state == RelationshipStateAccepted,
+		})
+	}
+
+	return &SearchUsersOutput{
+		Results: results,
+		Total:   len(results),
+	}, nil
+}
+
+// resolveRelationshipStates は検索者のすべての関係を取得し、相手ユーザーIDごとの関係ステータスを返す
+func (uc *SearchUsersUseCase) resolveRelationshipStates(ctx context.Context, searcherID string) (map[string]RelationshipState, error) {
+	relationships, err := uc.relationshipRepo.FindByUserID(ctx, searcherID, 0, 10000)
+	if err != nil {
+		return nil, fmt.Errorf("関係の取得中にエラーが発生しました: %w", err)
+	}
+
+	states := make(map[string]RelationshipState, len(relationships))
+	for _, rel := range relationships {
+		otherID := rel.ReceiverID
+		if rel.ReceiverID == searcherID {
+			otherID = rel.RequesterID
+		}
+		states[otherID] = toRelationshipState(rel.Status)
+	}
+
+	return states, nil
+}
+
+// toRelationshipState は友達関係のステータスを検索結果用の関係ステータスに変換する
+func toRelationshipState(status valueobject.RelationshipStatus) RelationshipState {
+	switch status {
+	case valueobject.RelationshipStatusPending:
+		return RelationshipStatePending
+	case valueobject.RelationshipStatusAccepted:
+		return RelationshipStateAccepted
+	case valueobject.RelationshipStatusBlocked:
+		return RelationshipStateBlocked
+	default:
+		// 拒否済みは再リクエスト可能なため関係なしとして扱う
+		return RelationshipStateNone
+	}
+}
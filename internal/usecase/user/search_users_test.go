@@ -0,0 +1,155 @@
+package user
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestNewSearchUsersUseCase(t *testing.T) {
+	userRepo := memory.NewUserRepository()
+	relationshipRepo := memory.NewRelationshipRepository()
+
+	uc := NewSearchUsersUseCase(userRepo, relationshipRepo)
+
+	if uc == nil {
+		t.Fatal("NewSearchUsersUseCase returned nil")
+	}
+	if uc.userRepo == nil {
+		t.Error("userRepo is nil")
+	}
+	if uc.relationshipRepo == nil {
+		t.Error("relationshipRepo is nil")
+	}
+}
+
+func TestSearchUsersUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	userRepo := memory.NewUserRepository()
+	relationshipRepo := memory.NewRelationshipRepository()
+
+	now := time.Now()
+	for _, u := range []*entity.User{
+		{ID: "me", Username: "member_me", Email: "me@example.com", PasswordHash: "hashed", CreatedAt: now, UpdatedAt: now},
+		{ID: "friend", Username: "member_friend", Email: "friend@example.com", PasswordHash: "hashed", CreatedAt: now, UpdatedAt: now},
+		{ID: "pending", Username: "member_pending", Email: "pending@example.com", PasswordHash: "hashed", CreatedAt: now, UpdatedAt: now},
+		{ID: "blocked", Username: "member_blocked", Email: "blocked@example.com", PasswordHash: "hashed", CreatedAt: now, UpdatedAt: now},
+		{ID: "rejected", Username: "member_rejected", Email: "rejected@example.com", PasswordHash: "hashed", CreatedAt: now, UpdatedAt: now},
+		{ID: "stranger", Username: "member_stranger", Email: "stranger@example.com", PasswordHash: "hashed", CreatedAt: now, UpdatedAt: now},
+		{ID: "other", Username: "someone", Email: "other@example.com", PasswordHash: "hashed", CreatedAt: now, UpdatedAt: now},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user %s: %v", u.ID, err)
+		}
+	}
+
+	// 検索者が送信者・受信者のどちらの関係も解決できることを確認するため、向きを混在させる
+	for _, rel := range []*entity.Relationship{
+		{ID: "rel-friend", RequesterID: "me", ReceiverID: "friend", Status: valueobject.RelationshipStatusAccepted},
+		{ID: "rel-pending", RequesterID: "pending", ReceiverID: "me", Status: valueobject.RelationshipStatusPending},
+		{ID: "rel-blocked", RequesterID: "blocked", ReceiverID: "me", Status: valueobject.RelationshipStatusBlocked},
+		{ID: "rel-rejected", RequesterID: "me", ReceiverID: "rejected", Status: valueobject.RelationshipStatusRejected},
+		{ID: "rel-unrelated", RequesterID: "friend", ReceiverID: "stranger", Status: valueobject.RelationshipStatusAccepted},
+	} {
+		rel.CreatedAt = now
+		rel.UpdatedAt = now
+		if err := relationshipRepo.Create(ctx, rel); err != nil {
+			t.Fatalf("failed to create relationship %s: %v", rel.ID, err)
+		}
+	}
+
+	uc := NewSearchUsersUseCase(userRepo, relationshipRepo)
+	output, err := uc.Execute(ctx, SearchUsersInput{
+		SearcherID: "me",
+		Query:      "MEMBER",
+	})
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+
+	want := map[string]struct {
+		state   RelationshipState
+		canSend bool
+	}{
+		"friend":   {RelationshipStateAccepted, true},
+		"pending":  {RelationshipStatePending, false},
+		"blocked":  {RelationshipStateBlocked, false},
+		"rejected": {RelationshipStateNone, false},
+		"stranger": {RelationshipStateNone, false},
+	}
+
+	if output.Total != len(want) || len(output.Results) != len(want) {
+		t.Fatalf("検索結果数 = %d (Total %d), want %d", len(output.Results), output.Total, len(want))
+	}
+	for _, result := range output.Results {
+		if result.User.ID == "me" {
+			t.Error("自分自身が検索結果に含まれている")
+			continue
+		}
+		w, ok := want[result.User.ID]
+		if !ok {
+			t.Errorf("想定外のユーザーが含まれている: %s", result.User.ID)
+			continue
+		}
+		if result.RelationshipState != w.state {
+			t.Errorf("%s: RelationshipState = %s, want %s", result.User.ID, result.RelationshipState, w.state)
+		}
+		if result.CanSendMorningCall != w.canSend {
+			t.Errorf("%s: CanSendMorningCall = %v, want %v", result.User.ID, result.CanSendMorningCall, w.canSend)
+		}
+	}
+}
+
+func TestSearchUsersUseCase_Execute_Limit(t *testing.T) {
+	ctx := context.Background()
+	userRepo := memory.NewUserRepository()
+	relationshipRepo := memory.NewRelationshipRepository()
+
+	for _, id := range []string{"u1", "u2", "u3"} {
+		u := &entity.User{ID: id, Username: "user_" + id, Email: id + "@example.com", PasswordHash: "hashed"}
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user %s: %v", id, err)
+		}
+	}
+
+	uc := NewSearchUsersUseCase(userRepo, relationshipRepo)
+	output, err := uc.Execute(ctx, SearchUsersInput{SearcherID: "u1", Query: "user", Limit: 1})
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+	if len(output.Results) != 1 {
+		t.Errorf("検索結果数 = %d, want 1", len(output.Results))
+	}
+}
+
+func TestSearchUsersUseCase_Execute_InputValidation(t *testing.T) {
+	ctx := context.Background()
+	uc := NewSearchUsersUseCase(memory.NewUserRepository(), memory.NewRelationshipRepository())
+
+	tests := []struct {
+		name   string
+		input  SearchUsersInput
+		errMsg string
+	}{
+		{name: "検索者IDが空", input: SearchUsersInput{Query: "user"}, errMsg: "検索者IDは必須です"},
+		{name: "クエリが空", input: SearchUsersInput{SearcherID: "me"}, errMsg: "検索クエリは必須です"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.Execute(ctx, tt.input)
+			if err == nil {
+				t.Fatal("エラーが期待されたが成功した")
+			}
+			if !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("期待するエラーメッセージ = %q, 実際 = %q", tt.errMsg, err.Error())
+			}
+		})
+	}
+}
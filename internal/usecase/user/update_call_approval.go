@@ -0,0 +1,58 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// UpdateCallApprovalUseCase はモーニングコールの事前承認制の設定を変更するユースケース
+type UpdateCallApprovalUseCase struct {
+	userRepo repository.UserRepository
+}
+
+// NewUpdateCallApprovalUseCase は新しい事前承認制設定ユースケースを作成する
+func NewUpdateCallApprovalUseCase(userRepo repository.UserRepository) *UpdateCallApprovalUseCase {
+	return &UpdateCallApprovalUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// UpdateCallApprovalInput は事前承認制設定の入力データ
+type UpdateCallApprovalInput struct {
+	UserID              string // 必須：設定を変更するユーザーのID
+	RequireCallApproval bool   // 受け取るモーニングコールに事前の承認を必要とするか
+}
+
+// UpdateCallApprovalOutput は事前承認制設定の出力データ
+type UpdateCallApprovalOutput struct {
+	User *entity.User
+}
+
+// Execute は事前承認制の有効・無効を設定する
+func (uc *UpdateCallApprovalUseCase) Execute(ctx context.Context, input UpdateCallApprovalInput) (*UpdateCallApprovalOutput, error) {
+	if input.UserID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	user.SetRequireCallApproval(input.RequireCallApproval)
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return &UpdateCallApprovalOutput{
+		User: user,
+	}, nil
+}
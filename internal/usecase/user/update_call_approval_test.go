@@ -0,0 +1,60 @@
+package user
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestUpdateCallApprovalUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("事前承認制を有効化・無効化できる", func(t *testing.T) {
+		userRepo := memory.NewUserRepository()
+		createdAt := time.Now().Add(-time.Hour)
+		if err := userRepo.Create(ctx, &entity.User{
+			ID:           "user1",
+			Username:     "alice",
+			Email:        "alice@example.com",
+			PasswordHash: "hashed_password",
+			CreatedAt:    createdAt,
+			UpdatedAt:    createdAt,
+		}); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+
+		uc := NewUpdateCallApprovalUseCase(userRepo)
+		for _, enabled := range []bool{true, false} {
+			output, err := uc.Execute(ctx, UpdateCallApprovalInput{UserID: "user1", RequireCallApproval: enabled})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output.User.RequireCallApproval != enabled {
+				t.Errorf("RequireCallApproval = %v, want %v", output.User.RequireCallApproval, enabled)
+			}
+
+			persisted, err := userRepo.FindByID(ctx, "user1")
+			if err != nil {
+				t.Fatalf("failed to find user: %v", err)
+			}
+			if persisted.RequireCallApproval != enabled {
+				t.Errorf("persisted RequireCallApproval = %v, want %v", persisted.RequireCallApproval, enabled)
+			}
+			if !persisted.UpdatedAt.After(createdAt) {
+				t.Errorf("UpdatedAt was not updated: %v", persisted.UpdatedAt)
+			}
+		}
+	})
+
+	t.Run("存在しないユーザー", func(t *testing.T) {
+		uc := NewUpdateCallApprovalUseCase(memory.NewUserRepository())
+		_, err := uc.Execute(ctx, UpdateCallApprovalInput{UserID: "unknown", RequireCallApproval: true})
+		if err == nil || !strings.Contains(err.Error(), "ユーザーが見つかりません") {
+			t.Errorf("error = %v, want not found", err)
+		}
+	})
+}
@@ -0,0 +1,104 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// UpdateCallForwardingUseCase は不在時のモーニングコールの転送を設定・解除するユースケース
+type UpdateCallForwardingUseCase struct {
+	userRepo         repository.UserRepository
+	relationshipRepo repository.RelationshipRepository
+	now              func() time.Time
+}
+
+// NewUpdateCallForwardingUseCase は新しい転送設定ユースケースを作成する
+func NewUpdateCallForwardingUseCase(
+	userRepo repository.UserRepository,
+	relationshipRepo repository.RelationshipRepository,
+) *UpdateCallForwardingUseCase {
+	return &UpdateCallForwardingUseCase{
+		userRepo:         userRepo,
+		relationshipRepo: relationshipRepo,
+		now:              time.Now,
+	}
+}
+
+// UpdateCallForwardingInput は転送設定の入力データ
+type UpdateCallForwardingInput struct {
+	UserID   string    // 必須：転送を設定する受信者のID
+	TargetID string    // 設定時に必須：転送先の友達のユーザーID
+	From     time.Time // オプション：転送の開始日時（ゼロ値は現在から）
+	Until    time.Time // 設定時に必須：転送の終了日時
+	Enabled  bool      // trueで転送を設定、falseで解除
+}
+
+// UpdateCallForwardingOutput は転送設定の出力データ
+type UpdateCallForwardingOutput struct {
+	User *entity.User
+}
+
+// Execute は不在時の転送を設定または解除する
+// 転送先に設定できるのは友達のみで、転送先が自分への転送を設定している場合は転送がループするため設定できない
+func (uc *UpdateCallForwardingUseCase) Execute(ctx context.Context, input UpdateCallForwardingInput) (*UpdateCallForwardingOutput, error) {
+	if input.UserID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	now := uc.now()
+	if input.Enabled {
+		if reason := user.SetCallForwarding(input.TargetID, input.From, input.Until, now); reason.IsNG() {
+			return nil, fmt.Errorf("%w", reason)
+		}
+		if err := uc.checkForwardingTarget(ctx, user.ID, input.TargetID, now); err != nil {
+			return nil, err
+		}
+	} else if !user.ClearCallForwarding(now) {
+		return nil, fmt.Errorf("転送は設定されていません")
+	}
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return &UpdateCallForwardingOutput{
+		User: user,
+	}, nil
+}
+
+// checkForwardingTarget は転送先のユーザーが存在する友達で、自分への転送を設定していないかを確認する
+func (uc *UpdateCallForwardingUseCase) checkForwardingTarget(ctx context.Context, userID, targetID string, now time.Time) error {
+	target, err := uc.userRepo.FindByID(ctx, targetID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return fmt.Errorf("転送先のユーザーが見つかりません")
+		}
+		return fmt.Errorf("failed to find forwarding target: %w", err)
+	}
+
+	areFriends, err := uc.relationshipRepo.AreFriends(ctx, userID, targetID)
+	if err != nil {
+		return fmt.Errorf("failed to check friendship: %w", err)
+	}
+	if !areFriends {
+		return fmt.Errorf("転送先には友達のみ設定できます")
+	}
+
+	if target.IsForwardingCallsTo(userID, now) {
+		return fmt.Errorf("転送先のユーザーがあなたへの転送を設定しているため、転送を設定できません")
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package user
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func newCallForwardingFixture(t *testing.T, now time.Time) (*UpdateCallForwardingUseCase, *memory.UserRepository) {
+	t.Helper()
+	userRepo, relationshipRepo := newFriendTestRepos(t)
+	uc := NewUpdateCallForwardingUseCase(userRepo, relationshipRepo)
+	uc.now = func() time.Time { return now }
+	return uc, userRepo
+}
+
+func TestUpdateCallForwardingUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	until := now.Add(7 * 24 * time.Hour)
+
+	t.Run("友達への転送を設定・解除できる", func(t *testing.T) {
+		uc, userRepo := newCallForwardingFixture(t, now)
+
+		output, err := uc.Execute(ctx, UpdateCallForwardingInput{UserID: "receiver", TargetID: "friend", Until: until, Enabled: true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if target, ok := output.User.CallForwardingTarget(now); !ok || target != "friend" {
+			t.Errorf("CallForwardingTarget() = %q, %v, want friend", target, ok)
+		}
+		persisted, _ := userRepo.FindByID(ctx, "receiver")
+		if !persisted.IsForwardingCallsTo("friend", now) {
+			t.Error("persisted user should forward calls to friend")
+		}
+
+		if _, err := uc.Execute(ctx, UpdateCallForwardingInput{UserID: "receiver", Enabled: false}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		persisted, _ = userRepo.FindByID(ctx, "receiver")
+		if persisted.ForwardCallsTo != nil {
+			t.Error("forwarding should be cleared")
+		}
+	})
+
+	t.Run("互いに転送し合う設定はできない", func(t *testing.T) {
+		uc, _ := newCallForwardingFixture(t, now)
+
+		if _, err := uc.Execute(ctx, UpdateCallForwardingInput{UserID: "friend", TargetID: "receiver", Until: until, Enabled: true}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		_, err := uc.Execute(ctx, UpdateCallForwardingInput{UserID: "receiver", TargetID: "friend", Until: until, Enabled: true})
+		if err == nil || !strings.Contains(err.Error(), "転送を設定できません") {
+			t.Errorf("error = %v, want loop error", err)
+		}
+	})
+
+	t.Run("終了した転送はループとみなさない", func(t *testing.T) {
+		uc, _ := newCallForwardingFixture(t, now)
+
+		if _, err := uc.Execute(ctx, UpdateCallForwardingInput{UserID: "friend", TargetID: "receiver", Until: now.Add(time.Hour), Enabled: true}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		uc.now = func() time.Time { return now.Add(2 * time.Hour) }
+		if _, err := uc.Execute(ctx, UpdateCallForwardingInput{UserID: "receiver", TargetID: "friend", Until: until, Enabled: true}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	tests := []struct {
+		name   string
+		input  UpdateCallForwardingInput
+		errMsg string
+	}{
+		{
+			name:   "友達でないユーザーは設定できない",
+			input:  UpdateCallForwardingInput{UserID: "receiver", TargetID: "stranger", Until: until, Enabled: true},
+			errMsg: "転送先には友達のみ設定できます",
+		},
+		{
+			name:   "存在しないユーザーは設定できない",
+			input:  UpdateCallForwardingInput{UserID: "receiver", TargetID: "unknown", Until: until, Enabled: true},
+			errMsg: "転送先のユーザーが見つかりません",
+		},
+		{
+			name:   "自分自身は設定できない",
+			input:  UpdateCallForwardingInput{UserID: "receiver", TargetID: "receiver", Until: until, Enabled: true},
+			errMsg: "自分自身を転送先に設定することはできません",
+		},
+		{
+			name:   "終了日時が過去",
+			input:  UpdateCallForwardingInput{UserID: "receiver", TargetID: "friend", Until: now.Add(-time.Hour), Enabled: true},
+			errMsg: "転送の終了日時は現在より後である必要があります",
+		},
+		{
+			name:   "期間が長すぎる",
+			input:  UpdateCallForwardingInput{UserID: "receiver", TargetID: "friend", Until: now.Add(31 * 24 * time.Hour), Enabled: true},
+			errMsg: "転送の期間は30日以内である必要があります",
+		},
+		{
+			name:   "設定されていない転送は解除できない",
+			input:  UpdateCallForwardingInput{UserID: "receiver", Enabled: false},
+			errMsg: "転送は設定されていません",
+		},
+		{
+			name:   "存在しない受信者",
+			input:  UpdateCallForwardingInput{UserID: "unknown", TargetID: "friend", Until: until, Enabled: true},
+			errMsg: "ユーザーが見つかりません",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, _ := newCallForwardingFixture(t, now)
+			_, err := uc.Execute(ctx, tt.input)
+			if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("error = %v, want %q", err, tt.errMsg)
+			}
+		})
+	}
+}
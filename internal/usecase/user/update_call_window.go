@@ -0,0 +1,62 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+)
+
+// UpdateCallWindowUseCase はモーニングコールを受け付ける曜日と時間帯を設定するユースケース
+type UpdateCallWindowUseCase struct {
+	userRepo repository.UserRepository
+}
+
+// NewUpdateCallWindowUseCase は新しい受信時間帯設定ユースケースを作成する
+func NewUpdateCallWindowUseCase(userRepo repository.UserRepository) *UpdateCallWindowUseCase {
+	return &UpdateCallWindowUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// UpdateCallWindowInput は受信時間帯設定の入力データ
+type UpdateCallWindowInput struct {
+	UserID     string                  // 必須：設定を変更するユーザーのID
+	CallWindow *valueobject.CallWindow // 受け付ける曜日と時間帯（nilで制限を解除する）
+}
+
+// UpdateCallWindowOutput は受信時間帯設定の出力データ
+type UpdateCallWindowOutput struct {
+	User *entity.User
+}
+
+// Execute はすべての友達に共通する受信時間帯を設定する
+// 友達ごとの設定がある相手からのモーニングコールには友達ごとの設定が優先される
+func (uc *UpdateCallWindowUseCase) Execute(ctx context.Context, input UpdateCallWindowInput) (*UpdateCallWindowOutput, error) {
+	if input.UserID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	if reason := user.SetCallWindow(input.CallWindow); reason.IsNG() {
+		return nil, fmt.Errorf("%w", reason)
+	}
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return &UpdateCallWindowOutput{
+		User: user,
+	}, nil
+}
@@ -0,0 +1,62 @@
+package user
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestUpdateCallWindowUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	newRepo := func(t *testing.T) *memory.UserRepository {
+		t.Helper()
+		userRepo := memory.NewUserRepository()
+		if err := userRepo.Create(ctx, &entity.User{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"}); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+		return userRepo
+	}
+
+	t.Run("受信時間帯を設定・解除できる", func(t *testing.T) {
+		userRepo := newRepo(t)
+		uc := NewUpdateCallWindowUseCase(userRepo)
+
+		window := &valueobject.CallWindow{StartMinute: 6 * 60, EndMinute: 8 * 60}
+		if _, err := uc.Execute(ctx, UpdateCallWindowInput{UserID: "user1", CallWindow: window}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		persisted, _ := userRepo.FindByID(ctx, "user1")
+		if persisted.CallWindow == nil || persisted.CallWindow.Start() != "06:00" || persisted.CallWindow.End() != "08:00" {
+			t.Errorf("CallWindow = %+v, want %+v", persisted.CallWindow, window)
+		}
+
+		if _, err := uc.Execute(ctx, UpdateCallWindowInput{UserID: "user1"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		persisted, _ = userRepo.FindByID(ctx, "user1")
+		if persisted.CallWindow != nil {
+			t.Errorf("CallWindow = %+v, want nil", persisted.CallWindow)
+		}
+	})
+
+	t.Run("不正な時間帯", func(t *testing.T) {
+		uc := NewUpdateCallWindowUseCase(newRepo(t))
+		_, err := uc.Execute(ctx, UpdateCallWindowInput{UserID: "user1", CallWindow: &valueobject.CallWindow{EndMinute: 24 * 60}})
+		if err == nil || err.Error() != "終了時刻は00:00から23:59の範囲で指定してください" {
+			t.Errorf("error = %v, want out of range", err)
+		}
+	})
+
+	t.Run("存在しないユーザー", func(t *testing.T) {
+		uc := NewUpdateCallWindowUseCase(newRepo(t))
+		_, err := uc.Execute(ctx, UpdateCallWindowInput{UserID: "unknown"})
+		if err == nil || !strings.Contains(err.Error(), "ユーザーが見つかりません") {
+			t.Errorf("error = %v, want not found", err)
+		}
+	})
+}
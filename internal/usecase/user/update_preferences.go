@@ -0,0 +1,87 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// UpdatePreferencesUseCase はモーニングコールの受け取り方・通知に関する設定を変更するユースケース
+type UpdatePreferencesUseCase struct {
+	userRepo repository.UserRepository
+}
+
+// NewUpdatePreferencesUseCase は新しい受信設定変更ユースケースを作成する
+func NewUpdatePreferencesUseCase(userRepo repository.UserRepository) *UpdatePreferencesUseCase {
+	return &UpdatePreferencesUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// UpdatePreferencesInput は受信設定変更の入力データ
+// nilの項目は変更しない
+type UpdatePreferencesInput struct {
+	UserID               string // 必須：設定を変更するユーザーのID
+	SilentDelivery       *bool  // 受け取るモーニングコールを無音で配信するか
+	Volume               *int   // 受け取るモーニングコールの配信時の音量（0〜100）
+	Vibrate              *bool  // 受け取るモーニングコールの配信時にバイブレーションするか
+	NotifyOnConfirmation *bool  // 送ったモーニングコールの起床確認の通知を受け取るか
+	NotifyOnExpiration   *bool  // 送ったモーニングコールが期限切れになったときの通知を受け取るか
+	NotifyOnNewLogin     *bool  // 初めてのデバイス・IPアドレスからのログインの通知を受け取るか
+}
+
+// UpdatePreferencesOutput は受信設定変更の出力データ
+type UpdatePreferencesOutput struct {
+	User *entity.User
+}
+
+// Execute は指定された受信設定を変更する
+func (uc *UpdatePreferencesUseCase) Execute(ctx context.Context, input UpdatePreferencesInput) (*UpdatePreferencesOutput, error) {
+	if input.UserID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+	if input.SilentDelivery == nil && input.Volume == nil && input.Vibrate == nil &&
+		input.NotifyOnConfirmation == nil && input.NotifyOnExpiration == nil && input.NotifyOnNewLogin == nil {
+		return nil, fmt.Errorf("変更する設定を指定してください")
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	if input.SilentDelivery != nil {
+		user.SetSilentDelivery(*input.SilentDelivery)
+	}
+	if input.Volume != nil {
+		if reason := user.SetVolume(*input.Volume); reason.IsNG() {
+			return nil, fmt.Errorf("%w", reason)
+		}
+	}
+	if input.Vibrate != nil {
+		user.SetVibrate(*input.Vibrate)
+	}
+	if input.NotifyOnConfirmation != nil {
+		user.SetNotifyOnConfirmation(*input.NotifyOnConfirmation)
+	}
+	if input.NotifyOnExpiration != nil {
+		user.SetNotifyOnExpiration(*input.NotifyOnExpiration)
+	}
+	if input.NotifyOnNewLogin != nil {
+		user.SetNotifyOnNewLogin(*input.NotifyOnNewLogin)
+	}
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return &UpdatePreferencesOutput{
+		User: user,
+	}, nil
+}
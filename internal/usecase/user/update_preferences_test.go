@@ -0,0 +1,138 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestUpdatePreferencesUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	newRepo := func(t *testing.T) *memory.UserRepository {
+		t.Helper()
+		userRepo := memory.NewUserRepository()
+		if err := userRepo.Create(ctx, &entity.User{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"}); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+		return userRepo
+	}
+
+	t.Run("無音受信を有効・無効にできる", func(t *testing.T) {
+		userRepo := newRepo(t)
+		uc := NewUpdatePreferencesUseCase(userRepo)
+
+		for _, silent := range []bool{true, false} {
+			if _, err := uc.Execute(ctx, UpdatePreferencesInput{UserID: "user1", SilentDelivery: &silent}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			persisted, _ := userRepo.FindByID(ctx, "user1")
+			if persisted.SilentDelivery != silent {
+				t.Errorf("SilentDelivery = %v, want %v", persisted.SilentDelivery, silent)
+			}
+		}
+	})
+
+	t.Run("起床確認の通知を変更しても他の設定は変わらない", func(t *testing.T) {
+		userRepo := newRepo(t)
+		uc := NewUpdatePreferencesUseCase(userRepo)
+
+		silent := true
+		if _, err := uc.Execute(ctx, UpdatePreferencesInput{UserID: "user1", SilentDelivery: &silent}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		notify := true
+		if _, err := uc.Execute(ctx, UpdatePreferencesInput{UserID: "user1", NotifyOnConfirmation: &notify}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		persisted, _ := userRepo.FindByID(ctx, "user1")
+		if !persisted.NotifyOnConfirmation || !persisted.SilentDelivery {
+			t.Errorf("(NotifyOnConfirmation, SilentDelivery) = (%v, %v), want (true, true)", persisted.NotifyOnConfirmation, persisted.SilentDelivery)
+		}
+	})
+
+	t.Run("期限切れの通知を有効・無効にできる", func(t *testing.T) {
+		userRepo := newRepo(t)
+		uc := NewUpdatePreferencesUseCase(userRepo)
+
+		for _, notify := range []bool{true, false} {
+			if _, err := uc.Execute(ctx, UpdatePreferencesInput{UserID: "user1", NotifyOnExpiration: &notify}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			persisted, _ := userRepo.FindByID(ctx, "user1")
+			if persisted.NotifyOnExpiration != notify {
+				t.Errorf("NotifyOnExpiration = %v, want %v", persisted.NotifyOnExpiration, notify)
+			}
+		}
+	})
+
+	t.Run("新しいログインの通知を有効・無効にできる", func(t *testing.T) {
+		userRepo := newRepo(t)
+		uc := NewUpdatePreferencesUseCase(userRepo)
+
+		for _, notify := range []bool{false, true} {
+			if _, err := uc.Execute(ctx, UpdatePreferencesInput{UserID: "user1", NotifyOnNewLogin: &notify}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			persisted, _ := userRepo.FindByID(ctx, "user1")
+			if persisted.NotifyOnNewLogin != notify {
+				t.Errorf("NotifyOnNewLogin = %v, want %v", persisted.NotifyOnNewLogin, notify)
+			}
+		}
+	})
+
+	t.Run("配信時の音量とバイブレーションを変更できる", func(t *testing.T) {
+		userRepo := newRepo(t)
+		uc := NewUpdatePreferencesUseCase(userRepo)
+
+		volume := 35
+		vibrate := false
+		if _, err := uc.Execute(ctx, UpdatePreferencesInput{UserID: "user1", Volume: &volume, Vibrate: &vibrate}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		persisted, _ := userRepo.FindByID(ctx, "user1")
+		if persisted.Volume != 35 || persisted.Vibrate {
+			t.Errorf("(Volume, Vibrate) = (%d, %v), want (35, false)", persisted.Volume, persisted.Vibrate)
+		}
+	})
+
+	t.Run("範囲外の音量は変更しない", func(t *testing.T) {
+		userRepo := newRepo(t)
+		uc := NewUpdatePreferencesUseCase(userRepo)
+
+		for _, volume := range []int{-1, 101} {
+			_, err := uc.Execute(ctx, UpdatePreferencesInput{UserID: "user1", Volume: &volume})
+			var reason valueobject.NGReason
+			if !errors.As(err, &reason) || reason.Code() != valueobject.ReasonCodeOutOfRange {
+				t.Errorf("volume %d: error = %v, want out of range", volume, err)
+			}
+		}
+		persisted, _ := userRepo.FindByID(ctx, "user1")
+		if persisted.Volume != 0 {
+			t.Errorf("Volume = %d, want unchanged", persisted.Volume)
+		}
+	})
+
+	t.Run("変更する設定がない", func(t *testing.T) {
+		uc := NewUpdatePreferencesUseCase(newRepo(t))
+		_, err := uc.Execute(ctx, UpdatePreferencesInput{UserID: "user1"})
+		if err == nil || err.Error() != "変更する設定を指定してください" {
+			t.Errorf("error = %v, want missing preference error", err)
+		}
+	})
+
+	t.Run("存在しないユーザー", func(t *testing.T) {
+		silent := true
+		uc := NewUpdatePreferencesUseCase(newRepo(t))
+		_, err := uc.Execute(ctx, UpdatePreferencesInput{UserID: "unknown", SilentDelivery: &silent})
+		if err == nil || !strings.Contains(err.Error(), "ユーザーが見つかりません") {
+			t.Errorf("error = %v, want not found", err)
+		}
+	})
+}
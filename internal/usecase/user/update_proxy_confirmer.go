@@ -0,0 +1,99 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// UpdateProxyConfirmerUseCase は起床確認の代理人を設定・解除するユースケース
+type UpdateProxyConfirmerUseCase struct {
+	userRepo         repository.UserRepository
+	relationshipRepo repository.RelationshipRepository
+}
+
+// NewUpdateProxyConfirmerUseCase は新しい代理人設定ユースケースを作成する
+func NewUpdateProxyConfirmerUseCase(
+	userRepo repository.UserRepository,
+	relationshipRepo repository.RelationshipRepository,
+) *UpdateProxyConfirmerUseCase {
+	return &UpdateProxyConfirmerUseCase{
+		userRepo:         userRepo,
+		relationshipRepo: relationshipRepo,
+	}
+}
+
+// UpdateProxyConfirmerInput は代理人設定の入力データ
+type UpdateProxyConfirmerInput struct {
+	UserID  string // 必須：代理人を設定する受信者のID
+	ProxyID string // 必須：代理人にするユーザーのID
+	Enabled bool   // trueで代理人に設定、falseで解除
+}
+
+// UpdateProxyConfirmerOutput は代理人設定の出力データ
+type UpdateProxyConfirmerOutput struct {
+	User *entity.User
+}
+
+// Execute は起床確認の代理人を設定または解除する
+// 代理人に設定できるのは友達のみ（解除は友達関係がなくなった後でも行える）
+func (uc *UpdateProxyConfirmerUseCase) Execute(ctx context.Context, input UpdateProxyConfirmerInput) (*UpdateProxyConfirmerOutput, error) {
+	if input.UserID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+	if input.ProxyID == "" {
+		return nil, fmt.Errorf("代理人のユーザーIDは必須です")
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	if input.Enabled {
+		if reason := user.AddProxyConfirmer(input.ProxyID); reason.IsNG() {
+			return nil, fmt.Errorf("%w", reason)
+		}
+		if err := uc.checkProxyCandidate(ctx, user.ID, input.ProxyID); err != nil {
+			return nil, err
+		}
+	} else {
+		if reason := user.RemoveProxyConfirmer(input.ProxyID); reason.IsNG() {
+			return nil, fmt.Errorf("%w", reason)
+		}
+	}
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return &UpdateProxyConfirmerOutput{
+		User: user,
+	}, nil
+}
+
+// checkProxyCandidate は代理人に設定しようとしているユーザーが存在し、友達であるかを確認する
+func (uc *UpdateProxyConfirmerUseCase) checkProxyCandidate(ctx context.Context, userID, proxyID string) error {
+	exists, err := uc.userRepo.ExistsByID(ctx, proxyID)
+	if err != nil {
+		return fmt.Errorf("failed to find proxy user: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("代理人に設定するユーザーが見つかりません")
+	}
+
+	areFriends, err := uc.relationshipRepo.AreFriends(ctx, userID, proxyID)
+	if err != nil {
+		return fmt.Errorf("failed to check friendship: %w", err)
+	}
+	if !areFriends {
+		return fmt.Errorf("代理人には友達のみ設定できます")
+	}
+	return nil
+}
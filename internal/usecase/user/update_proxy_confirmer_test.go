@@ -0,0 +1,121 @@
+package user
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+// newFriendTestRepos は受信者receiver・その友達friend・友達ではないstrangerを登録したリポジトリを作成する
+func newFriendTestRepos(t *testing.T) (*memory.UserRepository, *memory.RelationshipRepository) {
+	t.Helper()
+	ctx := context.Background()
+	userRepo := memory.NewUserRepository()
+	relationshipRepo := memory.NewRelationshipRepository()
+
+	for _, u := range []*entity.User{
+		{ID: "receiver", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"},
+		{ID: "friend", Username: "bob", Email: "bob@example.com", PasswordHash: "hashed_password"},
+		{ID: "stranger", Username: "carol", Email: "carol@example.com", PasswordHash: "hashed_password"},
+	} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+
+	rel, reason := entity.NewRelationship("rel1", "receiver", "friend")
+	if reason.IsNG() {
+		t.Fatalf("failed to build relationship: %s", reason)
+	}
+	if reason := rel.Accept(); reason.IsNG() {
+		t.Fatalf("failed to accept relationship: %s", reason)
+	}
+	if err := relationshipRepo.Create(ctx, rel); err != nil {
+		t.Fatalf("failed to create relationship: %v", err)
+	}
+
+	return userRepo, relationshipRepo
+}
+
+func newProxyConfirmerFixture(t *testing.T) (*UpdateProxyConfirmerUseCase, *memory.UserRepository) {
+	t.Helper()
+	userRepo, relationshipRepo := newFriendTestRepos(t)
+	return NewUpdateProxyConfirmerUseCase(userRepo, relationshipRepo), userRepo
+}
+
+func TestUpdateProxyConfirmerUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("友達を代理人に設定・解除できる", func(t *testing.T) {
+		uc, userRepo := newProxyConfirmerFixture(t)
+
+		output, err := uc.Execute(ctx, UpdateProxyConfirmerInput{UserID: "receiver", ProxyID: "friend", Enabled: true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !output.User.IsProxyConfirmer("friend") {
+			t.Error("friend should be a proxy confirmer")
+		}
+		persisted, _ := userRepo.FindByID(ctx, "receiver")
+		if !persisted.IsProxyConfirmer("friend") {
+			t.Error("persisted user should have friend as proxy confirmer")
+		}
+
+		if _, err := uc.Execute(ctx, UpdateProxyConfirmerInput{UserID: "receiver", ProxyID: "friend", Enabled: false}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		persisted, _ = userRepo.FindByID(ctx, "receiver")
+		if persisted.IsProxyConfirmer("friend") {
+			t.Error("friend should no longer be a proxy confirmer")
+		}
+	})
+
+	tests := []struct {
+		name   string
+		input  UpdateProxyConfirmerInput
+		errMsg string
+	}{
+		{
+			name:   "友達でないユーザーは設定できない",
+			input:  UpdateProxyConfirmerInput{UserID: "receiver", ProxyID: "stranger", Enabled: true},
+			errMsg: "代理人には友達のみ設定できます",
+		},
+		{
+			name:   "存在しないユーザーは設定できない",
+			input:  UpdateProxyConfirmerInput{UserID: "receiver", ProxyID: "unknown", Enabled: true},
+			errMsg: "代理人に設定するユーザーが見つかりません",
+		},
+		{
+			name:   "自分自身は設定できない",
+			input:  UpdateProxyConfirmerInput{UserID: "receiver", ProxyID: "receiver", Enabled: true},
+			errMsg: "自分自身を代理人に設定することはできません",
+		},
+		{
+			name:   "設定されていない代理人は解除できない",
+			input:  UpdateProxyConfirmerInput{UserID: "receiver", ProxyID: "friend", Enabled: false},
+			errMsg: "代理人に設定されていません",
+		},
+		{
+			name:   "存在しない受信者",
+			input:  UpdateProxyConfirmerInput{UserID: "unknown", ProxyID: "friend", Enabled: true},
+			errMsg: "ユーザーが見つかりません",
+		},
+		{
+			name:   "代理人IDが空",
+			input:  UpdateProxyConfirmerInput{UserID: "receiver", Enabled: true},
+			errMsg: "代理人のユーザーIDは必須です",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, _ := newProxyConfirmerFixture(t)
+			_, err := uc.Execute(ctx, tt.input)
+			if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("error = %v, want %q", err, tt.errMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/domain/repository"
+)
+
+// UpdateTimeZoneUseCase はユーザーのタイムゾーンを変更するユースケース
+type UpdateTimeZoneUseCase struct {
+	userRepo repository.UserRepository
+}
+
+// NewUpdateTimeZoneUseCase は新しいタイムゾーン設定ユースケースを作成する
+func NewUpdateTimeZoneUseCase(userRepo repository.UserRepository) *UpdateTimeZoneUseCase {
+	return &UpdateTimeZoneUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// UpdateTimeZoneInput はタイムゾーン設定の入力データ
+type UpdateTimeZoneInput struct {
+	UserID   string // 必須：設定を変更するユーザーのID
+	TimeZone string // タイムゾーンのIANA名（空文字でサーバーのタイムゾーンに戻す）
+}
+
+// UpdateTimeZoneOutput はタイムゾーン設定の出力データ
+type UpdateTimeZoneOutput struct {
+	User *entity.User
+}
+
+// Execute はタイムゾーンを設定する
+func (uc *UpdateTimeZoneUseCase) Execute(ctx context.Context, input UpdateTimeZoneInput) (*UpdateTimeZoneOutput, error) {
+	if input.UserID == "" {
+		return nil, fmt.Errorf("ユーザーIDは必須です")
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("ユーザーが見つかりません")
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	if reason := user.SetTimeZone(input.TimeZone); reason.IsNG() {
+		return nil, fmt.Errorf("%w", reason)
+	}
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return &UpdateTimeZoneOutput{
+		User: user,
+	}, nil
+}
@@ -0,0 +1,54 @@
+package user
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ochamu/morning-call-api/internal/domain/entity"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+)
+
+func TestUpdateTimeZoneUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	newRepo := func(t *testing.T) *memory.UserRepository {
+		t.Helper()
+		userRepo := memory.NewUserRepository()
+		if err := userRepo.Create(ctx, &entity.User{ID: "user1", Username: "alice", Email: "alice@example.com", PasswordHash: "hashed_password"}); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+		return userRepo
+	}
+
+	t.Run("タイムゾーンを設定・解除できる", func(t *testing.T) {
+		userRepo := newRepo(t)
+		uc := NewUpdateTimeZoneUseCase(userRepo)
+
+		for _, tz := range []string{"Asia/Tokyo", ""} {
+			if _, err := uc.Execute(ctx, UpdateTimeZoneInput{UserID: "user1", TimeZone: tz}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			persisted, _ := userRepo.FindByID(ctx, "user1")
+			if persisted.TimeZone != tz {
+				t.Errorf("TimeZone = %q, want %q", persisted.TimeZone, tz)
+			}
+		}
+	})
+
+	t.Run("不正なタイムゾーン", func(t *testing.T) {
+		uc := NewUpdateTimeZoneUseCase(newRepo(t))
+		_, err := uc.Execute(ctx, UpdateTimeZoneInput{UserID: "user1", TimeZone: "Mars/Olympus"})
+		if err == nil || err.Error() != "タイムゾーンが不正です" {
+			t.Errorf("error = %v, want invalid time zone", err)
+		}
+	})
+
+	t.Run("存在しないユーザー", func(t *testing.T) {
+		uc := NewUpdateTimeZoneUseCase(newRepo(t))
+		_, err := uc.Execute(ctx, UpdateTimeZoneInput{UserID: "unknown", TimeZone: "UTC"})
+		if err == nil || !strings.Contains(err.Error(), "ユーザーが見つかりません") {
+			t.Errorf("error = %v, want not found", err)
+		}
+	})
+}
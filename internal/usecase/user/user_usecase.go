@@ -8,6 +8,7 @@ import (
 	"github.com/ochamu/morning-call-api/internal/domain/entity"
 	"github.com/ochamu/morning-call-api/internal/domain/repository"
 	"github.com/ochamu/morning-call-api/internal/domain/service"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
 	"github.com/ochamu/morning-call-api/pkg/utils"
 )
 
@@ -15,13 +16,15 @@ import (
 type UserUseCase struct {
 	userRepo        repository.UserRepository
 	passwordService service.PasswordService
+	limits          valueobject.InputLimits
 }
 
 // NewUserUseCase は新しいUserUseCaseを作成する
-func NewUserUseCase(userRepo repository.UserRepository, passwordService service.PasswordService) *UserUseCase {
+func NewUserUseCase(userRepo repository.UserRepository, passwordService service.PasswordService, limits valueobject.InputLimits) *UserUseCase {
 	return &UserUseCase{
 		userRepo:        userRepo,
 		passwordService: passwordService,
+		limits:          limits,
 	}
 }
 
@@ -46,7 +49,7 @@ func (uc *UserUseCase) Register(ctx context.Context, input RegisterInput) (*Regi
 
 	// パスワードの妥当性検証
 	if reason := entity.ValidatePassword(input.Password); reason.IsNG() {
-		return nil, fmt.Errorf("%s", reason)
+		return nil, fmt.Errorf("%w", reason)
 	}
 
 	// ユーザー名の重複チェック
@@ -80,9 +83,9 @@ func (uc *UserUseCase) Register(ctx context.Context, input RegisterInput) (*Regi
 	}
 
 	// ユーザーエンティティの作成
-	user, reason := entity.NewUser(userID, input.Username, input.Email, passwordHash)
+	user, reason := entity.NewUser(userID, input.Username, input.Email, passwordHash, uc.limits)
 	if reason.IsNG() {
-		return nil, fmt.Errorf("%s", reason)
+		return nil, fmt.Errorf("%w", reason)
 	}
 
 	// リポジトリに保存
@@ -150,69 +153,6 @@ func (uc *UserUseCase) VerifyPassword(ctx context.Context, username, password st
 	return user, nil
 }
 
-// SearchUsersInput はユーザー検索の入力パラメータ
-type SearchUsersInput struct {
-	Query     string // 検索クエリ（ユーザー名の部分一致）
-	ExcludeID string // 除外するユーザーID（通常は自分自身）
-	Limit     int    // 取得件数の上限
-}
-
-// SearchUsersOutput はユーザー検索の出力結果
-type SearchUsersOutput struct {
-	Users []*entity.User // 検索結果のユーザーリスト
-	Total int            // 検索結果の総数
-}
-
-// SearchUsers はユーザーを検索する
-func (uc *UserUseCase) SearchUsers(ctx context.Context, input SearchUsersInput) (*SearchUsersOutput, error) {
-	// 入力検証
-	if input.Query == "" {
-		return nil, fmt.Errorf("検索クエリは必須です")
-	}
-	
-	// Limitのデフォルト値
-	if input.Limit <= 0 {
-		input.Limit = 100
-	}
-	
-	// 全ユーザーを取得（簡易的な実装）
-	// 本来はリポジトリにSearchメソッドを実装すべき
-	allUsers, err := uc.userRepo.FindAll(ctx, 0, 1000)
-	if err != nil {
-		if errors.Is(err, repository.ErrNotFound) {
-			return &SearchUsersOutput{
-				Users: []*entity.User{},
-				Total: 0,
-			}, nil
-		}
-		return nil, fmt.Errorf("ユーザー検索に失敗しました: %w", err)
-	}
-	
-	// フィルタリング（部分一致検索）
-	var filteredUsers []*entity.User
-	for _, user := range allUsers {
-		// 自分自身を除外
-		if user.ID == input.ExcludeID {
-			continue
-		}
-		
-		// ユーザー名の部分一致検索（大文字小文字を区別しない）
-		if containsIgnoreCase(user.Username, input.Query) {
-			filteredUsers = append(filteredUsers, user)
-			
-			// 制限に達したら終了
-			if len(filteredUsers) >= input.Limit {
-				break
-			}
-		}
-	}
-	
-	return &SearchUsersOutput{
-		Users: filteredUsers,
-		Total: len(filteredUsers),
-	}, nil
-}
-
 // containsIgnoreCase は大文字小文字を区別せずに部分一致検索を行う
 func containsIgnoreCase(str, substr string) bool {
 	// 簡易的な実装
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ochamu/morning-call-api/internal/domain/entity"
 	"github.com/ochamu/morning-call-api/internal/domain/repository"
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
 )
 
 // mockPasswordService はテスト用のモックパスワードサービス
@@ -74,6 +76,19 @@ func (r *mockUserRepository) Create(ctx context.Context, user *entity.User) erro
 	return nil
 }
 
+func (r *mockUserRepository) BulkCreate(ctx context.Context, users []*entity.User, mode repository.BulkCreateMode) (repository.BulkCreateResult, error) {
+	_ = mode // テスト用モックのため常に部分成功として扱う
+	var result repository.BulkCreateResult
+	for i, user := range users {
+		if err := r.Create(ctx, user); err != nil {
+			result.Failed = append(result.Failed, &repository.BulkCreateError{Index: i, Err: err})
+			continue
+		}
+		result.Created++
+	}
+	return result, nil
+}
+
 func (r *mockUserRepository) FindByID(ctx context.Context, id string) (*entity.User, error) {
 	_ = ctx // テスト用モックのため未使用
 	if r.shouldFailFind {
@@ -87,6 +102,21 @@ func (r *mockUserRepository) FindByID(ctx context.Context, id string) (*entity.U
 	return user, nil
 }
 
+func (r *mockUserRepository) FindByIDs(ctx context.Context, ids []string) ([]*entity.User, error) {
+	_ = ctx // テスト用モックのため未使用
+	if r.shouldFailFind {
+		return nil, repository.ErrConnectionFailed
+	}
+
+	users := make([]*entity.User, 0, len(ids))
+	for _, id := range ids {
+		if user, exists := r.users[id]; exists {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
 func (r *mockUserRepository) FindByUsername(ctx context.Context, username string) (*entity.User, error) {
 	_ = ctx // テスト用モックのため未使用
 	if r.shouldFailFind {
@@ -187,6 +217,35 @@ func (r *mockUserRepository) Count(ctx context.Context) (int, error) {
 	return len(r.users), nil
 }
 
+func (r *mockUserRepository) Stats(ctx context.Context) (repository.RepositoryStats, error) {
+	_ = ctx // テスト用モックのため未使用
+	breakdown := make(map[string]int)
+	for _, user := range r.users {
+		breakdown[user.EffectivePlan().String()]++
+	}
+	return repository.RepositoryStats{Total: len(r.users), Breakdown: breakdown}, nil
+}
+
+func (r *mockUserRepository) AddPoints(ctx context.Context, id string, points int) (int, error) {
+	_ = ctx // テスト用モックのため未使用
+	user, exists := r.users[id]
+	if !exists {
+		return 0, repository.ErrNotFound
+	}
+	user.Points += points
+	return user.Points, nil
+}
+
+func (r *mockUserRepository) FindTopByPoints(ctx context.Context, limit int) ([]*entity.User, error) {
+	_ = ctx // テスト用モックのため未使用
+	return []*entity.User{}, nil
+}
+
+func (r *mockUserRepository) FindDeletionDue(ctx context.Context, now time.Time) ([]*entity.User, error) {
+	_ = ctx // テスト用モックのため未使用
+	return []*entity.User{}, nil
+}
+
 // TestRegister_Success はユーザー登録の成功ケースをテストする
 func TestRegister_Success(t *testing.T) {
 	tests := []struct {
@@ -228,7 +287,7 @@ func TestRegister_Success(t *testing.T) {
 			// Arrange
 			repo := newMockUserRepository()
 			passwordService := &mockPasswordService{}
-			uc := NewUserUseCase(repo, passwordService)
+			uc := NewUserUseCase(repo, passwordService, valueobject.DefaultInputLimits())
 			ctx := context.Background()
 
 			// Act
@@ -376,7 +435,7 @@ func TestRegister_ValidationErrors(t *testing.T) {
 			// Arrange
 			repo := newMockUserRepository()
 			passwordService := &mockPasswordService{}
-			uc := NewUserUseCase(repo, passwordService)
+			uc := NewUserUseCase(repo, passwordService, valueobject.DefaultInputLimits())
 			ctx := context.Background()
 
 			// Act
@@ -395,6 +454,45 @@ func TestRegister_ValidationErrors(t *testing.T) {
 	}
 }
 
+// TestRegister_InputLimits は注入した文字数制限が登録時の検証に反映されることを確認する
+func TestRegister_InputLimits(t *testing.T) {
+	limits := valueobject.DefaultInputLimits()
+	limits.UsernameMinLength = 5
+	limits.UsernameMaxLength = 8
+
+	tests := []struct {
+		name       string
+		username   string
+		wantErrMsg string
+	}{
+		{name: "最小文字数未満", username: "abcd", wantErrMsg: "ユーザー名は5文字以上である必要があります"},
+		{name: "最大文字数超過", username: "abcdefghi", wantErrMsg: "ユーザー名は8文字以内である必要があります"},
+		{name: "制限内", username: "abcdefgh"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewUserUseCase(newMockUserRepository(), &mockPasswordService{}, limits)
+
+			_, err := uc.Register(context.Background(), RegisterInput{
+				Username: tt.username,
+				Email:    tt.username + "@example.com",
+				Password: "Password123!",
+			})
+
+			if tt.wantErrMsg == "" {
+				if err != nil {
+					t.Errorf("Register() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErrMsg {
+				t.Errorf("Register() error = %v, want %s", err, tt.wantErrMsg)
+			}
+		})
+	}
+}
+
 // TestRegister_DuplicateErrors は重複エラーのテストケース
 func TestRegister_DuplicateErrors(t *testing.T) {
 	tests := []struct {
@@ -445,7 +543,7 @@ func TestRegister_DuplicateErrors(t *testing.T) {
 				repo.usersByEmail[strings.ToLower(tt.existingUser.Email)] = tt.existingUser
 			}
 			passwordService := &mockPasswordService{}
-			uc := NewUserUseCase(repo, passwordService)
+			uc := NewUserUseCase(repo, passwordService, valueobject.DefaultInputLimits())
 			ctx := context.Background()
 
 			// Act
@@ -469,7 +567,7 @@ func TestGetByID(t *testing.T) {
 	// Arrange
 	repo := newMockUserRepository()
 	passwordService := &mockPasswordService{}
-	uc := NewUserUseCase(repo, passwordService)
+	uc := NewUserUseCase(repo, passwordService, valueobject.DefaultInputLimits())
 	ctx := context.Background()
 
 	// 既存ユーザーを作成
@@ -535,7 +633,7 @@ func TestVerifyPassword(t *testing.T) {
 	// Arrange
 	repo := newMockUserRepository()
 	passwordService := &mockPasswordService{}
-	uc := NewUserUseCase(repo, passwordService)
+	uc := NewUserUseCase(repo, passwordService, valueobject.DefaultInputLimits())
 	ctx := context.Background()
 
 	// テストユーザーを登録
@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RequestIDHeader はリクエストIDを受け渡すHTTPヘッダー名
+const RequestIDHeader = "X-Request-ID"
+
+// MaxRequestIDLength は受け付けるリクエストIDの最大長（超過分は切り詰める）
+const MaxRequestIDLength = 128
+
+// requestIDContextKey はコンテキストにリクエストIDを保持するためのキーの型
+type requestIDContextKey struct{}
+
+// WithRequestID はリクエストIDを保持したコンテキストを返す
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey{}, requestID)
+}
+
+// RequestIDFromContext はコンテキストからリクエストIDを取得する（未設定の場合は空文字）
+func RequestIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	requestID, _ := ctx.Value(requestIDContextKey{}).(string)
+	return requestID
+}
+
+// SanitizeRequestID は外部から受け取ったリクエストIDを安全な形に整える
+// ログインジェクションを防ぐため英数字と一部の記号(-_.:/+=)以外は除去し、
+// MaxRequestIDLength を超える部分は切り詰める
+func SanitizeRequestID(raw string) string {
+	var b strings.Builder
+	for _, c := range strings.TrimSpace(raw) {
+		if b.Len() >= MaxRequestIDLength {
+			break
+		}
+		if isRequestIDChar(c) {
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
+
+// isRequestIDChar はリクエストIDに使用できる文字かを判定する
+func isRequestIDChar(c rune) bool {
+	switch {
+	case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		return true
+	case strings.ContainsRune("-_.:/+=", c):
+		return true
+	}
+	return false
+}
+
+// ResolveRequestID は受信したリクエストIDをサニタイズして返す
+// 空または使用できない値の場合は新しいIDを生成する
+func ResolveRequestID(raw string) string {
+	if requestID := SanitizeRequestID(raw); requestID != "" {
+		return requestID
+	}
+	requestID, err := GenerateUUID()
+	if err != nil {
+		// 乱数生成に失敗した場合も追跡できるよう時刻ベースのIDを使用する
+		return "req-" + strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+	return requestID
+}
+
+// RequestIDLogPrefix はログ行の先頭に付与するリクエストIDの表記を返す（IDが空の場合は空文字）
+func RequestIDLogPrefix(requestID string) string {
+	if requestID == "" {
+		return ""
+	}
+	return "[request_id=" + requestID + "] "
+}
+
+// Logf はコンテキストのリクエストIDを付与して標準ロガーへ出力する
+func Logf(ctx context.Context, format string, args ...interface{}) {
+	log.Print(RequestIDLogPrefix(RequestIDFromContext(ctx)) + fmt.Sprintf(format, args...))
+}
@@ -1,6 +1,7 @@
 package integration
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -185,4 +186,66 @@ func TestPasswordValidation(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func TestIssueScopedToken(t *testing.T) {
+	ts := NewTestServer(t)
+	defer ts.Close()
+
+	ts.RegisterUser(t, "tokenuser", "tokenuser@example.com", "Password123!")
+	sessionID := ts.LoginUser(t, "tokenuser", "Password123!")
+
+	t.Run("スコープ付きトークンを発行", func(t *testing.T) {
+		resp, err := ts.DoRequest("POST", "/api/v1/auth/tokens", map[string]interface{}{
+			"scopes": []string{"morning-calls:read"},
+		}, sessionID)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusCreated, resp.StatusCode)
+
+		var body struct {
+			Token  string   `json:"token"`
+			Scopes []string `json:"scopes"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			t.Fatalf("レスポンスのデコードエラー: %v", err)
+		}
+		if body.Token == "" || body.Token == sessionID {
+			t.Errorf("token = %q, want new token", body.Token)
+		}
+		if len(body.Scopes) != 1 || body.Scopes[0] != "morning-calls:read" {
+			t.Errorf("scopes = %v, want [morning-calls:read]", body.Scopes)
+		}
+	})
+
+	for _, tc := range []struct {
+		name   string
+		scopes []string
+	}{
+		{"スコープなし", nil},
+		{"未知のスコープ", []string{"morning-calls:write"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := ts.DoRequest("POST", "/api/v1/auth/tokens", map[string]interface{}{
+				"scopes": tc.scopes,
+			}, sessionID)
+			if err != nil {
+				t.Fatalf("リクエストエラー: %v", err)
+			}
+			defer resp.Body.Close()
+			AssertStatusCode(t, http.StatusBadRequest, resp.StatusCode)
+		})
+	}
+
+	t.Run("未認証では発行できない", func(t *testing.T) {
+		resp, err := ts.DoRequest("POST", "/api/v1/auth/tokens", map[string]interface{}{
+			"scopes": []string{"read-only"},
+		}, "")
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+}
@@ -0,0 +1,133 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestJSONDecodeErrors(t *testing.T) {
+	ts := NewTestServer(t)
+	defer ts.Close()
+
+	ts.RegisterUser(t, "jsonuser1", "json1@example.com", "Password123!")
+	session := ts.LoginUser(t, "jsonuser1", "Password123!")
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		body        string
+		sessionID   string
+		wantCode    string
+		wantField   string
+		wantMessage []string
+	}{
+		{
+			name:        "余分なフィールド",
+			method:      "POST",
+			path:        "/api/v1/users/register",
+			body:        `{"username":"newuser","email":"new@example.com","password":"Password123!","nickname":"x"}`,
+			wantCode:    "INVALID_REQUEST",
+			wantField:   "nickname",
+			wantMessage: []string{"不明なフィールド", "nickname"},
+		},
+		{
+			name:        "文字列フィールドに数値",
+			method:      "POST",
+			path:        "/api/v1/auth/login",
+			body:        `{"username":123,"password":"Password123!"}`,
+			wantCode:    "INVALID_REQUEST",
+			wantField:   "username",
+			wantMessage: []string{"usernameの型が不正です", "文字列", "数値", "位置"},
+		},
+		{
+			name:        "構文エラー",
+			method:      "POST",
+			path:        "/api/v1/morning-calls",
+			body:        `{"receiver_id": "abc",}`,
+			sessionID:   session,
+			wantCode:    "PARSE_ERROR",
+			wantMessage: []string{"JSONの構文が不正です", "位置: 23"},
+		},
+		{
+			name:        "日時の形式不正",
+			method:      "POST",
+			path:        "/api/v1/morning-calls",
+			body:        `{"receiver_id":"abc","scheduled_time":"tomorrow"}`,
+			sessionID:   session,
+			wantCode:    "PARSE_ERROR",
+			wantMessage: []string{"日時の形式が不正です"},
+		},
+		{
+			name:        "オブジェクトに配列",
+			method:      "POST",
+			path:        "/api/v1/relationships/request",
+			body:        `[]`,
+			sessionID:   session,
+			wantCode:    "PARSE_ERROR",
+			wantMessage: []string{"オブジェクト"},
+		},
+		{
+			name:        "空のボディ",
+			method:      "POST",
+			path:        "/api/v1/morning-calls/validate-message",
+			body:        ``,
+			sessionID:   session,
+			wantCode:    "PARSE_ERROR",
+			wantMessage: []string{"リクエストボディが空です"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.method, ts.Server.URL+tt.path, strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatalf("リクエスト作成エラー: %v", err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			if tt.sessionID != "" {
+				req.AddCookie(&http.Cookie{Name: "session_id", Value: tt.sessionID})
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("リクエストエラー: %v", err)
+			}
+			defer resp.Body.Close()
+
+			AssertStatusCode(t, http.StatusBadRequest, resp.StatusCode)
+
+			var result struct {
+				Error struct {
+					Code    string `json:"code"`
+					Message string `json:"message"`
+					Details []struct {
+						Field   string `json:"field"`
+						Message string `json:"message"`
+					} `json:"details"`
+				} `json:"error"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+				t.Fatalf("レスポンスデコードエラー: %v", err)
+			}
+
+			if result.Error.Code != tt.wantCode {
+				t.Errorf("code = %s, want %s", result.Error.Code, tt.wantCode)
+			}
+			for _, want := range tt.wantMessage {
+				if !strings.Contains(result.Error.Message, want) {
+					t.Errorf("message = %q, want containing %q", result.Error.Message, want)
+				}
+			}
+			if tt.wantField != "" {
+				if len(result.Error.Details) != 1 || result.Error.Details[0].Field != tt.wantField {
+					t.Errorf("details = %+v, want field %s", result.Error.Details, tt.wantField)
+				}
+			} else if len(result.Error.Details) != 0 {
+				t.Errorf("details = %+v, want empty", result.Error.Details)
+			}
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
 	"testing"
 	"time"
 )
@@ -131,6 +133,119 @@ func TestMorningCallCRUD(t *testing.T) {
 		}
 	})
 
+	t.Run("アクセスログに閲覧と権限のない閲覧試行が記録される", func(t *testing.T) {
+		if morningCallID == "" {
+			t.Skip("モーニングコールIDが設定されていません")
+		}
+
+		ts.RegisterUser(t, "mcuser3", "mc3@example.com", "Password123!")
+		session3 := ts.LoginUser(t, "mcuser3", "Password123!")
+
+		// 第三者の閲覧は403
+		forbiddenResp, err := ts.DoRequest("GET", fmt.Sprintf("/api/v1/morning-calls/%s", morningCallID), nil, session3)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer forbiddenResp.Body.Close()
+		AssertStatusCode(t, http.StatusForbidden, forbiddenResp.StatusCode)
+
+		// 第三者はアクセスログも確認できない
+		logForbiddenResp, err := ts.DoRequest("GET", fmt.Sprintf("/api/v1/morning-calls/%s/access-log", morningCallID), nil, session3)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer logForbiddenResp.Body.Close()
+		AssertStatusCode(t, http.StatusForbidden, logForbiddenResp.StatusCode)
+
+		// 受信者がアクセスログを確認する
+		resp, err := ts.DoRequest("GET", fmt.Sprintf("/api/v1/morning-calls/%s/access-log", morningCallID), nil, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+
+		var accessLog struct {
+			Entries []struct {
+				ViewerID   string `json:"viewer_id"`
+				ViewerRole string `json:"viewer_role"`
+				IsSelf     bool   `json:"is_self"`
+				Allowed    bool   `json:"allowed"`
+			} `json:"entries"`
+			Total int `json:"total"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&accessLog); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		// 送信者の閲覧（モーニングコール取得）と第三者の閲覧試行
+		if accessLog.Total != 2 || len(accessLog.Entries) != 2 {
+			t.Fatalf("アクセスログの件数が不正: %+v", accessLog)
+		}
+		if e := accessLog.Entries[0]; e.ViewerRole != "other" || e.Allowed || e.IsSelf {
+			t.Errorf("第三者の閲覧試行の記録が不正: %+v", e)
+		}
+		if e := accessLog.Entries[1]; e.ViewerID != user1ID || e.ViewerRole != "sender" || !e.Allowed || e.IsSelf {
+			t.Errorf("送信者の閲覧の記録が不正: %+v", e)
+		}
+	})
+
+	t.Run("受信者がメッセージを翻訳して取得する", func(t *testing.T) {
+		if morningCallID == "" {
+			t.Skip("モーニングコールIDが設定されていません")
+		}
+
+		type translationResult struct {
+			Message     string `json:"message"`
+			Translation *struct {
+				Language string `json:"language"`
+				Message  string `json:"message"`
+				Failed   bool   `json:"failed"`
+			} `json:"translation"`
+		}
+		get := func(query string) translationResult {
+			t.Helper()
+			resp, err := ts.DoRequest("GET", fmt.Sprintf("/api/v1/morning-calls/%s%s", morningCallID, query), nil, session2)
+			if err != nil {
+				t.Fatalf("リクエストエラー: %v", err)
+			}
+			defer resp.Body.Close()
+			AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+
+			var result translationResult
+			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+				t.Fatalf("レスポンスのパースに失敗: %v", err)
+			}
+			return result
+		}
+
+		// 元のメッセージと翻訳を両方返す
+		result := get("?translate=en")
+		if result.Message != "おはよう！今日も一日頑張ろう！" {
+			t.Errorf("元のメッセージが不正: %s", result.Message)
+		}
+		if result.Translation == nil || result.Translation.Failed || result.Translation.Message != "Good morning！今日も一日頑張ろう！" {
+			t.Errorf("翻訳結果が不正: %+v", result.Translation)
+		}
+
+		// 翻訳できない場合は元のメッセージのまま返す
+		result = get("?translate=fr")
+		if result.Translation == nil || !result.Translation.Failed || result.Translation.Message != result.Message {
+			t.Errorf("翻訳失敗時の結果が不正: %+v", result.Translation)
+		}
+
+		// 翻訳を指定しない場合は翻訳を返さない
+		if result := get(""); result.Translation != nil {
+			t.Errorf("翻訳を指定していないのに翻訳が返されました: %+v", result.Translation)
+		}
+
+		invalidResp, err := ts.DoRequest("GET", fmt.Sprintf("/api/v1/morning-calls/%s?translate=%s", morningCallID, "ja_JP!"), nil, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer invalidResp.Body.Close()
+		AssertStatusCode(t, http.StatusBadRequest, invalidResp.StatusCode)
+	})
+
 	t.Run("モーニングコール更新", func(t *testing.T) {
 		// 時間とメッセージを更新
 		tomorrow := time.Now().AddDate(0, 0, 1)
@@ -159,6 +274,45 @@ func TestMorningCallCRUD(t *testing.T) {
 		}
 	})
 
+	t.Run("モーニングコール部分更新", func(t *testing.T) {
+		// メッセージのみを更新し、時刻は変わらないことを確認
+		patchReq := map[string]interface{}{
+			"message": "下書きを自動保存しました",
+		}
+
+		resp, err := ts.DoRequest("PATCH", fmt.Sprintf("/api/v1/morning-calls/%s", morningCallID), patchReq, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+
+		var morningCall map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&morningCall); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+
+		if morningCall["message"] != "下書きを自動保存しました" {
+			t.Errorf("メッセージが更新されていません: actual=%v", morningCall["message"])
+		}
+		tomorrow := time.Now().AddDate(0, 0, 1)
+		wantTime := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 8, 0, 0, 0, time.Local)
+		scheduledTime, err := time.Parse(time.RFC3339, fmt.Sprint(morningCall["scheduled_time"]))
+		if err != nil || !scheduledTime.Equal(wantTime) {
+			t.Errorf("アラーム時刻が変更されています: actual=%v", morningCall["scheduled_time"])
+		}
+
+		// nullでのメッセージ指定はエラー
+		nullResp, err := ts.DoRequest("PATCH", fmt.Sprintf("/api/v1/morning-calls/%s", morningCallID), map[string]interface{}{"message": nil}, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer nullResp.Body.Close()
+
+		AssertStatusCode(t, http.StatusBadRequest, nullResp.StatusCode)
+	})
+
 	t.Run("送信済みモーニングコール一覧", func(t *testing.T) {
 		resp, err := ts.DoRequest("GET", "/api/v1/morning-calls/sent", nil, session1)
 		if err != nil {
@@ -195,6 +349,153 @@ func TestMorningCallCRUD(t *testing.T) {
 		}
 	})
 
+	t.Run("クエリパラメータで絞り込んだ受信一覧", func(t *testing.T) {
+		countCalls := func(path string) (int, float64) {
+			resp, err := ts.DoRequest("GET", path, nil, session2)
+			if err != nil {
+				t.Fatalf("リクエストエラー: %v", err)
+			}
+			defer resp.Body.Close()
+
+			AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+
+			var result map[string]interface{}
+			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+				t.Fatalf("レスポンスのパースに失敗: %v", err)
+			}
+			return len(result["morning_calls"].([]interface{})), result["limit"].(float64)
+		}
+
+		from := url.QueryEscape(time.Now().Format(time.RFC3339))
+		if n, limit := countCalls("/api/v1/morning-calls/received?status=scheduled&sender_id=" + user1ID + "&from=" + from + "&sort=-scheduled_time&limit=5"); n != 1 || limit != 5 {
+			t.Errorf("絞り込み結果が不正: expected=1件(limit=5), actual=%d件(limit=%v)", n, limit)
+		}
+		if n, _ := countCalls("/api/v1/morning-calls/received?status=confirmed"); n != 0 {
+			t.Errorf("ステータスの絞り込みが不正: expected=0, actual=%d", n)
+		}
+		if n, _ := countCalls("/api/v1/morning-calls/received?sender_id=" + user2ID); n != 0 {
+			t.Errorf("送信者の絞り込みが不正: expected=0, actual=%d", n)
+		}
+		if n, _ := countCalls("/api/v1/morning-calls/received?offset=1"); n != 0 {
+			t.Errorf("オフセットの適用が不正: expected=0, actual=%d", n)
+		}
+
+		// 不正なパラメータは項目ごとのエラーとしてまとめて返す
+		resp, err := ts.DoRequest("GET", "/api/v1/morning-calls/received?status=unknown&receiver_id=x&from=2026-03-01&offset=-1&limit=0&sort=priority", nil, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		AssertStatusCode(t, http.StatusBadRequest, resp.StatusCode)
+
+		var result struct {
+			Error struct {
+				Details []struct {
+					Field string `json:"field"`
+				} `json:"details"`
+			} `json:"error"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		var fields []string
+		for _, d := range result.Error.Details {
+			fields = append(fields, d.Field)
+		}
+		if got, want := strings.Join(fields, ","), "status,receiver_id,from,sort,offset,limit"; got != want {
+			t.Errorf("エラー項目が不正: expected=%s, actual=%s", want, got)
+		}
+	})
+
+	t.Run("前回以降の差分のみの取得", func(t *testing.T) {
+		listSince := func(since string) ([]interface{}, string) {
+			path := "/api/v1/morning-calls?type=received"
+			if since != "" {
+				path += "&updated_since=" + url.QueryEscape(since)
+			}
+			resp, err := ts.DoRequest("GET", path, nil, session2)
+			if err != nil {
+				t.Fatalf("リクエストエラー: %v", err)
+			}
+			defer resp.Body.Close()
+
+			AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+
+			var result map[string]interface{}
+			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+				t.Fatalf("レスポンスのパースに失敗: %v", err)
+			}
+			lastSyncTime, _ := result["last_sync_time"].(string)
+			return result["morning_calls"].([]interface{}), lastSyncTime
+		}
+
+		calls, lastSyncTime := listSince("")
+		if len(calls) != 1 || lastSyncTime == "" {
+			t.Fatalf("初回の取得が不正: calls=%d, last_sync_time=%q", len(calls), lastSyncTime)
+		}
+
+		// 更新日時ちょうどを指定した場合も差分に含まれる
+		updatedAt, _ := calls[0].(map[string]interface{})["updated_at"].(string)
+		if calls, _ := listSince(updatedAt); len(calls) != 1 {
+			t.Errorf("差分の件数が不正: expected=1, actual=%d", len(calls))
+		}
+		future := time.Now().Add(time.Hour).Format(time.RFC3339)
+		if calls, _ := listSince(future); len(calls) != 0 {
+			t.Errorf("変更がない場合の差分が不正: expected=0, actual=%d", len(calls))
+		}
+
+		resp, err := ts.DoRequest("GET", "/api/v1/morning-calls?type=received&updated_since=yesterday", nil, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("フィールドを指定した一覧取得", func(t *testing.T) {
+		resp, err := ts.DoRequest("GET", "/api/v1/morning-calls/sent?fields=id,message,status", nil, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+
+		var result map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		if result["total"] != float64(1) {
+			t.Errorf("件数が不正: expected=1, actual=%v", result["total"])
+		}
+		morningCalls := result["morning_calls"].([]interface{})
+		if len(morningCalls) != 1 {
+			t.Fatalf("送信済み数が不正: expected=1, actual=%d", len(morningCalls))
+		}
+
+		morningCall := morningCalls[0].(map[string]interface{})
+		if len(morningCall) != 3 {
+			t.Errorf("フィールド数が不正: expected=3, actual=%v", morningCall)
+		}
+		if morningCall["id"] != morningCallID {
+			t.Errorf("IDが不正: expected=%s, actual=%v", morningCallID, morningCall["id"])
+		}
+		if _, ok := morningCall["receiver_id"]; ok {
+			t.Error("指定していないフィールドが含まれています: receiver_id")
+		}
+	})
+
+	t.Run("存在しないフィールドを指定した一覧取得はエラー", func(t *testing.T) {
+		resp, err := ts.DoRequest("GET", "/api/v1/morning-calls/sent?fields=id,password", nil, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		AssertStatusCode(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
 	t.Run("起床確認", func(t *testing.T) {
 		// user2が起床確認
 		confirmURL := fmt.Sprintf("/api/v1/morning-calls/%s/confirm", morningCallID)
@@ -229,284 +530,443 @@ func TestMorningCallCRUD(t *testing.T) {
 		if morningCall["confirmed_at"] == nil {
 			t.Error("確認時刻が設定されていません")
 		}
-	})
-
-	t.Run("モーニングコール削除", func(t *testing.T) {
-		// 新しいモーニングコールを作成
-		tomorrow := time.Now().AddDate(0, 0, 1)
-		wakeTime := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 6, 0, 0, 0, time.Local)
-
-		createReq := map[string]interface{}{
-			"receiver_id": user2ID,
-			"scheduled_time":   wakeTime.Format(time.RFC3339),
-			"message":     "削除テスト用",
+		// 確認方法を指定しない場合はボタンでの確認として記録される
+		if morningCall["confirm_method"] != "button" {
+			t.Errorf("確認方法が不正: expected=button, actual=%v", morningCall["confirm_method"])
 		}
+	})
 
-		createResp, err := ts.DoRequest("POST", "/api/v1/morning-calls", createReq, session1)
+	t.Run("起床確認で送信者に感謝ポイントが付与される", func(t *testing.T) {
+		resp, err := ts.DoRequest("GET", "/api/v1/users/me", nil, session1)
 		if err != nil {
-			t.Fatalf("モーニングコール作成エラー: %v", err)
-		}
-		defer createResp.Body.Close()
-
-		if createResp.StatusCode != http.StatusCreated {
-			body, _ := io.ReadAll(createResp.Body)
-			t.Fatalf("モーニングコール作成失敗: status=%d, body=%s", createResp.StatusCode, body)
+			t.Fatalf("リクエストエラー: %v", err)
 		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
 
-		var morningCall map[string]interface{}
-		if err := json.NewDecoder(createResp.Body).Decode(&morningCall); err != nil {
+		var profile map[string]map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&profile); err != nil {
 			t.Fatalf("レスポンスのパースに失敗: %v", err)
 		}
-		
-		if morningCall["id"] == nil {
-			t.Fatalf("モーニングコールIDが取得できません: %v", morningCall)
+		if profile["user"]["points"] != float64(10) {
+			t.Errorf("ポイントが不正: expected=10, actual=%v", profile["user"]["points"])
 		}
-		deleteID := morningCall["id"].(string)
 
-		// 削除
-		deleteResp, err := ts.DoRequest("DELETE", fmt.Sprintf("/api/v1/morning-calls/%s", deleteID), nil, session1)
+		boardResp, err := ts.DoRequest("GET", "/api/v1/leaderboard", nil, session2)
 		if err != nil {
 			t.Fatalf("リクエストエラー: %v", err)
 		}
-		defer deleteResp.Body.Close()
-
-		AssertStatusCode(t, http.StatusOK, deleteResp.StatusCode)
-
-		// 削除確認
-		getResp, _ := ts.DoRequest("GET", fmt.Sprintf("/api/v1/morning-calls/%s", deleteID), nil, session1)
-		defer getResp.Body.Close()
+		defer boardResp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, boardResp.StatusCode)
 
-		AssertStatusCode(t, http.StatusNotFound, getResp.StatusCode)
+		var board struct {
+			Entries []struct {
+				Rank   int    `json:"rank"`
+				UserID string `json:"user_id"`
+				Points int    `json:"points"`
+			} `json:"entries"`
+		}
+		if err := json.NewDecoder(boardResp.Body).Decode(&board); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		if len(board.Entries) != 1 || board.Entries[0].UserID != user1ID || board.Entries[0].Rank != 1 || board.Entries[0].Points != 10 {
+			t.Errorf("ランキングが不正: %+v", board.Entries)
+		}
 	})
-}
 
-func TestMorningCallValidation(t *testing.T) {
-	ts := NewTestServer(t)
-	defer ts.Close()
-
-	// テストユーザーの作成
-	user1ID := ts.RegisterUser(t, "valuser1", "val1@example.com", "Password123!")
-	user2ID := ts.RegisterUser(t, "valuser2", "val2@example.com", "Password123!")
-	user3ID := ts.RegisterUser(t, "valuser3", "val3@example.com", "Password123!")
-	
-	session1 := ts.LoginUser(t, "valuser1", "Password123!")
+	t.Run("受信者による役立ち度の評価", func(t *testing.T) {
+		ratingURL := fmt.Sprintf("/api/v1/morning-calls/%s/rating", morningCallID)
 
-	// user1とuser2を友達にする
-	reqBody := map[string]string{
-		"receiver_id": user2ID,
-	}
-	relResp, _ := ts.DoRequest("POST", "/api/v1/relationships/request", reqBody, session1)
-	defer relResp.Body.Close()
-	
-	var relResult map[string]interface{}
-	if err := json.NewDecoder(relResp.Body).Decode(&relResult); err != nil {
-		t.Fatalf("友達リクエストレスポンスのデコードエラー: %v", err)
-	}
-	
-	// relationshipのレスポンス構造を確認
-	var relationshipID string
-	if relationship, ok := relResult["relationship"].(map[string]interface{}); ok {
-		if id, ok := relationship["id"].(string); ok {
-			relationshipID = id
-		} else {
-			t.Fatalf("relationshipのIDが取得できません: %v", relationship)
+		// 送信者は評価できない
+		forbiddenResp, err := ts.DoRequest("POST", ratingURL, map[string]interface{}{"rating": 5}, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
 		}
-	} else if id, ok := relResult["id"].(string); ok {
-		relationshipID = id
-	} else {
-		t.Fatalf("relationshipIDが取得できません: %v", relResult)
-	}
-	
-	session2 := ts.LoginUser(t, "valuser2", "Password123!")
-	ts.DoRequest("PUT", fmt.Sprintf("/api/v1/relationships/%s/accept", relationshipID), nil, session2)
-
-	t.Run("過去の時刻でのモーニングコール作成エラー", func(t *testing.T) {
-		yesterday := time.Now().AddDate(0, 0, -1)
+		defer forbiddenResp.Body.Close()
+		AssertStatusCode(t, http.StatusForbidden, forbiddenResp.StatusCode)
 
-		createReq := map[string]interface{}{
-			"receiver_id": user2ID,
-			"scheduled_time":   yesterday.Format(time.RFC3339),
-			"message":     "過去の時刻",
+		// 範囲外の評価は400
+		invalidResp, err := ts.DoRequest("POST", ratingURL, map[string]interface{}{"rating": 6}, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
 		}
+		defer invalidResp.Body.Close()
+		AssertStatusCode(t, http.StatusBadRequest, invalidResp.StatusCode)
 
-		resp, err := ts.DoRequest("POST", "/api/v1/morning-calls", createReq, session1)
+		resp, err := ts.DoRequest("POST", ratingURL, map[string]interface{}{"rating": 4}, session2)
 		if err != nil {
 			t.Fatalf("リクエストエラー: %v", err)
 		}
 		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
 
-		AssertStatusCode(t, http.StatusBadRequest, resp.StatusCode)
-	})
-
-	t.Run("30日以上先のモーニングコール作成エラー", func(t *testing.T) {
-		farFuture := time.Now().AddDate(0, 0, 31)
-
-		createReq := map[string]interface{}{
-			"receiver_id": user2ID,
-			"scheduled_time":   farFuture.Format(time.RFC3339),
-			"message":     "遠すぎる未来",
+		var rated map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&rated); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		if rated["helpfulness_rating"] != float64(4) || rated["rated_at"] == nil {
+			t.Errorf("評価が不正: rating=%v, rated_at=%v", rated["helpfulness_rating"], rated["rated_at"])
 		}
 
-		resp, err := ts.DoRequest("POST", "/api/v1/morning-calls", createReq, session1)
+		// 送信者は自分のコールの平均評価を確認できる
+		statsResp, err := ts.DoRequest("GET", "/api/v1/morning-calls/rating-stats", nil, session1)
 		if err != nil {
 			t.Fatalf("リクエストエラー: %v", err)
 		}
-		defer resp.Body.Close()
+		defer statsResp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, statsResp.StatusCode)
 
-		AssertStatusCode(t, http.StatusBadRequest, resp.StatusCode)
+		var stats struct {
+			RatedCount    int     `json:"rated_count"`
+			AverageRating float64 `json:"average_rating"`
+			Distribution  []struct {
+				Rating int `json:"rating"`
+				Count  int `json:"count"`
+			} `json:"distribution"`
+		}
+		if err := json.NewDecoder(statsResp.Body).Decode(&stats); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		if stats.RatedCount != 1 || stats.AverageRating != 4 || len(stats.Distribution) != 5 || stats.Distribution[3].Count != 1 {
+			t.Errorf("評価の統計が不正: %+v", stats)
+		}
 	})
 
-	t.Run("友達でないユーザーへのモーニングコール作成エラー", func(t *testing.T) {
-		tomorrow := time.Now().AddDate(0, 0, 1)
+	t.Run("起床確認・起こした回数のランキング", func(t *testing.T) {
+		today := time.Now()
+		query := fmt.Sprintf("?type=wakers&scope=friends&from=%s&to=%s",
+			today.AddDate(0, 0, -7).Format("2006-01-02"), today.AddDate(0, 0, 30).Format("2006-01-02"))
+		resp, err := ts.DoRequest("GET", "/api/v1/morning-calls/leaderboard"+query, nil, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
 
-		createReq := map[string]interface{}{
-			"receiver_id": user3ID,
-			"scheduled_time":   tomorrow.Format(time.RFC3339),
-			"message":     "友達でない",
+		type entry struct {
+			Rank   int    `json:"rank"`
+			UserID string `json:"user_id"`
+			Count  int    `json:"count"`
+		}
+		var board struct {
+			Entries []entry `json:"entries"`
+			Me      *entry  `json:"me"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&board); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		if len(board.Entries) != 1 || board.Entries[0].UserID != user1ID || board.Entries[0].Count != 1 {
+			t.Errorf("ランキングが不正: %+v", board.Entries)
+		}
+		if board.Me != nil {
+			t.Errorf("起こした回数が0のユーザーはランク外: %+v", board.Me)
 		}
 
-		resp, err := ts.DoRequest("POST", "/api/v1/morning-calls", createReq, session1)
+		invalidResp, err := ts.DoRequest("GET", "/api/v1/morning-calls/leaderboard?type=sleepers", nil, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer invalidResp.Body.Close()
+		AssertStatusCode(t, http.StatusBadRequest, invalidResp.StatusCode)
+	})
+
+	t.Run("起床ヒートマップ", func(t *testing.T) {
+		today := time.Now()
+		query := fmt.Sprintf("?from=%s&to=%s",
+			today.AddDate(0, 0, -7).Format("2006-01-02"), today.AddDate(0, 0, 30).Format("2006-01-02"))
+		resp, err := ts.DoRequest("GET", "/api/v1/morning-calls/heatmap"+query, nil, session2)
 		if err != nil {
 			t.Fatalf("リクエストエラー: %v", err)
 		}
 		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
 
-		// 友達でないのでエラーになるはず
-		if resp.StatusCode == http.StatusCreated {
-			t.Error("友達でないユーザーへのモーニングコールが作成されました")
+		var heatmap struct {
+			Days  map[string]int `json:"days"`
+			Total int            `json:"total"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&heatmap); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		if heatmap.Total != 1 || len(heatmap.Days) != 1 {
+			t.Errorf("ヒートマップが不正: %+v", heatmap)
 		}
-	})
 
-	t.Run("自分自身へのモーニングコール作成エラー", func(t *testing.T) {
-		tomorrow := time.Now().AddDate(0, 0, 1)
+		// 年を指定し、データのない日を0で埋める
+		yearResp, err := ts.DoRequest("GET", "/api/v1/morning-calls/heatmap?year=2024&include_empty=true", nil, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer yearResp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, yearResp.StatusCode)
 
-		createReq := map[string]interface{}{
-			"receiver_id": user1ID,
-			"scheduled_time":   tomorrow.Format(time.RFC3339),
-			"message":     "自分宛て",
+		heatmap.Days = nil
+		if err := json.NewDecoder(yearResp.Body).Decode(&heatmap); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		if heatmap.Total != 0 || len(heatmap.Days) != 366 {
+			t.Errorf("2024年のヒートマップが不正: total=%d days=%d", heatmap.Total, len(heatmap.Days))
 		}
 
-		resp, err := ts.DoRequest("POST", "/api/v1/morning-calls", createReq, session1)
+		invalidResp, err := ts.DoRequest("GET", "/api/v1/morning-calls/heatmap?year=2024&from=2024-01-01", nil, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer invalidResp.Body.Close()
+		AssertStatusCode(t, http.StatusBadRequest, invalidResp.StatusCode)
+	})
+
+	t.Run("モーニングコールのレポート", func(t *testing.T) {
+		today := time.Now()
+		query := fmt.Sprintf("?from=%s&to=%s",
+			today.AddDate(0, 0, -7).Format("2006-01-02"), today.AddDate(0, 0, 30).Format("2006-01-02"))
+		resp, err := ts.DoRequest("GET", "/api/v1/morning-calls/report"+query, nil, session2)
 		if err != nil {
 			t.Fatalf("リクエストエラー: %v", err)
 		}
 		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
 
-		AssertStatusCode(t, http.StatusBadRequest, resp.StatusCode)
-	})
+		if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "application/json") {
+			t.Errorf("Content-Typeが不正: %s", ct)
+		}
+		var report struct {
+			UserID   string `json:"user_id"`
+			Received struct {
+				Total     int `json:"total"`
+				Confirmed int `json:"confirmed"`
+			} `json:"received"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		if report.UserID != user2ID || report.Received.Confirmed != 1 {
+			t.Errorf("レポートが不正: %+v", report)
+		}
 
-	t.Run("空のメッセージでのモーニングコール作成", func(t *testing.T) {
-		tomorrow := time.Now().AddDate(0, 0, 1)
+		// CSVはファイルとしてダウンロードできる
+		csvResp, err := ts.DoRequest("GET", "/api/v1/morning-calls/report?period=monthly&format=csv", nil, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer csvResp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, csvResp.StatusCode)
 
-		createReq := map[string]interface{}{
-			"receiver_id": user2ID,
-			"scheduled_time":   tomorrow.Format(time.RFC3339),
-			"message":     "",
+		if cd := csvResp.Header.Get("Content-Disposition"); !strings.HasPrefix(cd, "attachment;") || !strings.Contains(cd, ".csv") {
+			t.Errorf("Content-Dispositionが不正: %s", cd)
+		}
+		body, _ := io.ReadAll(csvResp.Body)
+		if !strings.HasPrefix(string(body), "metric,value,username\n") {
+			t.Errorf("CSVが不正: %s", body)
 		}
 
-		resp, err := ts.DoRequest("POST", "/api/v1/morning-calls", createReq, session1)
+		for _, invalid := range []string{"?format=xml", "?period=daily", "?period=weekly&from=2024-01-01&to=2024-01-31"} {
+			invalidResp, err := ts.DoRequest("GET", "/api/v1/morning-calls/report"+invalid, nil, session2)
+			if err != nil {
+				t.Fatalf("リクエストエラー: %v", err)
+			}
+			invalidResp.Body.Close()
+			AssertStatusCode(t, http.StatusBadRequest, invalidResp.StatusCode)
+		}
+	})
+
+	t.Run("システム提供の定型文", func(t *testing.T) {
+		resp, err := ts.DoRequest("GET", "/api/v1/morning-calls/system-messages?category=gentle&lang=en", nil, session1)
 		if err != nil {
 			t.Fatalf("リクエストエラー: %v", err)
 		}
 		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
 
-		// 空のメッセージは許可される（デフォルトメッセージが使われる）
-		AssertStatusCode(t, http.StatusCreated, resp.StatusCode)
+		var list struct {
+			Language string `json:"language"`
+			Messages []struct {
+				ID       string `json:"id"`
+				Category string `json:"category"`
+				Text     string `json:"text"`
+			} `json:"messages"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		if list.Language != "en" || len(list.Messages) == 0 {
+			t.Fatalf("定型文一覧が不正: %+v", list)
+		}
+		for _, m := range list.Messages {
+			if m.Category != "gentle" || m.Text == "" {
+				t.Errorf("定型文が不正: %+v", m)
+			}
+		}
+
+		invalidResp, err := ts.DoRequest("GET", "/api/v1/morning-calls/system-messages?category=sleepy", nil, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer invalidResp.Body.Close()
+		AssertStatusCode(t, http.StatusBadRequest, invalidResp.StatusCode)
+
+		// 存在しない定型文IDを指定した作成は400
+		createResp, err := ts.DoRequest("POST", "/api/v1/morning-calls", map[string]interface{}{
+			"receiver_id":       user2ID,
+			"scheduled_time":    time.Now().Add(48 * time.Hour).Format(time.RFC3339),
+			"system_message_id": "unknown",
+		}, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer createResp.Body.Close()
+		AssertStatusCode(t, http.StatusBadRequest, createResp.StatusCode)
 	})
-}
 
-func TestMorningCallAuthorization(t *testing.T) {
-	ts := NewTestServer(t)
-	defer ts.Close()
+	t.Run("受信者によるお気に入り送信元の登録", func(t *testing.T) {
+		setFavorite := func(favorite bool) {
+			t.Helper()
+			resp, err := ts.DoRequest("PUT", fmt.Sprintf("/api/v1/relationships/%s/favorite-sender", relationshipID), map[string]interface{}{
+				"favorite":    favorite,
+				"always_ring": true,
+			}, session2)
+			if err != nil {
+				t.Fatalf("リクエストエラー: %v", err)
+			}
+			defer resp.Body.Close()
+			AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+		}
+		setFavorite(true)
+		// 他のテストに影響しないよう解除しておく
+		defer setFavorite(false)
 
-	// テストユーザーの作成
-	_ = ts.RegisterUser(t, "authmc1", "authmc1@example.com", "Password123!")
-	user2ID := ts.RegisterUser(t, "authmc2", "authmc2@example.com", "Password123!")
-	_ = ts.RegisterUser(t, "authmc3", "authmc3@example.com", "Password123!")
-	
-	session1 := ts.LoginUser(t, "authmc1", "Password123!")
-	session2 := ts.LoginUser(t, "authmc2", "Password123!")
-	session3 := ts.LoginUser(t, "authmc3", "Password123!")
+		// 友達一覧でお気に入り送信元かどうかを返す
+		friendsResp, err := ts.DoRequest("GET", "/api/v1/relationships/friends", nil, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer friendsResp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, friendsResp.StatusCode)
+		var friends struct {
+			Friends []struct {
+				ID             string `json:"id"`
+				FavoriteSender bool   `json:"favorite_sender"`
+			} `json:"friends"`
+		}
+		if err := json.NewDecoder(friendsResp.Body).Decode(&friends); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		if len(friends.Friends) != 1 || friends.Friends[0].ID != user1ID || !friends.Friends[0].FavoriteSender {
+			t.Errorf("友達一覧のお気に入り送信元が不正: %+v", friends.Friends)
+		}
 
-	// user1とuser2を友達にする
-	reqBody := map[string]string{
-		"receiver_id": user2ID,
-	}
-	relResp, _ := ts.DoRequest("POST", "/api/v1/relationships/request", reqBody, session1)
-	defer relResp.Body.Close()
-	
-	var relResult map[string]interface{}
-	if err := json.NewDecoder(relResp.Body).Decode(&relResult); err != nil {
-		t.Fatalf("友達リクエストレスポンスのデコードエラー: %v", err)
-	}
-	
-	// relationshipのレスポンス構造を確認
-	var relationshipID string
-	if relationship, ok := relResult["relationship"].(map[string]interface{}); ok {
-		if id, ok := relationship["id"].(string); ok {
-			relationshipID = id
-		} else {
-			t.Fatalf("relationshipのIDが取得できません: %v", relationship)
+		// 受信一覧ではお気に入り送信元からのコールに印が付く
+		listResp, err := ts.DoRequest("GET", "/api/v1/morning-calls/received?favorites_first=true", nil, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer listResp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, listResp.StatusCode)
+		var list struct {
+			MorningCalls []struct {
+				SenderID           string `json:"sender_id"`
+				FromFavoriteSender bool   `json:"from_favorite_sender"`
+			} `json:"morning_calls"`
+		}
+		if err := json.NewDecoder(listResp.Body).Decode(&list); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		if len(list.MorningCalls) == 0 {
+			t.Fatal("受信一覧が空です")
+		}
+		for _, mc := range list.MorningCalls {
+			if mc.SenderID == user1ID && !mc.FromFavoriteSender {
+				t.Errorf("お気に入り送信元からのコールに印が付いていません: %+v", mc)
+			}
 		}
-	} else if id, ok := relResult["id"].(string); ok {
-		relationshipID = id
-	} else {
-		t.Fatalf("relationshipIDが取得できません: %v", relResult)
-	}
-	
-	ts.DoRequest("PUT", fmt.Sprintf("/api/v1/relationships/%s/accept", relationshipID), nil, session2)
 
-	// user1からuser2へモーニングコールを作成（時刻をずらして作成）
-	tomorrow := time.Now().AddDate(0, 0, 1)
-	wakeTime := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 9, 30, 0, 0, time.Local)
-	createReq := map[string]interface{}{
-		"receiver_id": user2ID,
-		"scheduled_time":   wakeTime.Format(time.RFC3339),
-		"message":     "権限テスト",
-	}
+		// 送信一覧では指定できない
+		sentResp, err := ts.DoRequest("GET", "/api/v1/morning-calls/sent?favorites_first=true", nil, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer sentResp.Body.Close()
+		AssertStatusCode(t, http.StatusBadRequest, sentResp.StatusCode)
+	})
 
-	createResp, _ := ts.DoRequest("POST", "/api/v1/morning-calls", createReq, session1)
-	defer createResp.Body.Close()
+	t.Run("受信者によるアーカイブとアーカイブ解除", func(t *testing.T) {
+		if morningCallID == "" {
+			t.Skip("モーニングコールIDが設定されていません")
+		}
 
-	var createResult map[string]interface{}
-	json.NewDecoder(createResp.Body).Decode(&createResult)
-	morningCallID := createResult["id"].(string)
+		countCalls := func(path string) int {
+			t.Helper()
+			resp, err := ts.DoRequest("GET", path, nil, session2)
+			if err != nil {
+				t.Fatalf("リクエストエラー: %v", err)
+			}
+			defer resp.Body.Close()
+			AssertStatusCode(t, http.StatusOK, resp.StatusCode)
 
-	t.Run("第三者による更新は失敗", func(t *testing.T) {
-		updateReq := map[string]interface{}{
-			"message": "不正な更新",
+			var result struct {
+				MorningCalls []map[string]interface{} `json:"morning_calls"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+				t.Fatalf("レスポンスのパースに失敗: %v", err)
+			}
+			return len(result.MorningCalls)
 		}
 
-		resp, err := ts.DoRequest("PUT", fmt.Sprintf("/api/v1/morning-calls/%s", morningCallID), updateReq, session3)
+		// 送信者はアーカイブできない
+		forbiddenResp, err := ts.DoRequest("PUT", fmt.Sprintf("/api/v1/morning-calls/%s/archive", morningCallID), nil, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer forbiddenResp.Body.Close()
+		AssertStatusCode(t, http.StatusForbidden, forbiddenResp.StatusCode)
+
+		resp, err := ts.DoRequest("PUT", fmt.Sprintf("/api/v1/morning-calls/%s/archive", morningCallID), nil, session2)
 		if err != nil {
 			t.Fatalf("リクエストエラー: %v", err)
 		}
 		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
 
-		AssertStatusCode(t, http.StatusForbidden, resp.StatusCode)
-	})
+		var morningCall map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&morningCall); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		if morningCall["archived"] != true {
+			t.Errorf("アーカイブされていません: %v", morningCall)
+		}
 
-	t.Run("受信者による更新は失敗", func(t *testing.T) {
-		updateReq := map[string]interface{}{
-			"message": "受信者からの更新",
+		// アーカイブしたコールは受信一覧に含まれず、アーカイブ一覧で取得できる
+		if n := countCalls("/api/v1/morning-calls/received"); n != 0 {
+			t.Errorf("受信一覧にアーカイブしたコールが含まれています: %d件", n)
+		}
+		if n := countCalls("/api/v1/morning-calls/archived"); n != 1 {
+			t.Errorf("アーカイブ一覧の件数が不正: expected=1, actual=%d", n)
 		}
 
-		resp, err := ts.DoRequest("PUT", fmt.Sprintf("/api/v1/morning-calls/%s", morningCallID), updateReq, session2)
+		unarchiveResp, err := ts.DoRequest("DELETE", fmt.Sprintf("/api/v1/morning-calls/%s/archive", morningCallID), nil, session2)
 		if err != nil {
 			t.Fatalf("リクエストエラー: %v", err)
 		}
-		defer resp.Body.Close()
+		defer unarchiveResp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, unarchiveResp.StatusCode)
 
-		AssertStatusCode(t, http.StatusForbidden, resp.StatusCode)
+		if n := countCalls("/api/v1/morning-calls/received"); n != 1 {
+			t.Errorf("アーカイブ解除後の受信数が不正: expected=1, actual=%d", n)
+		}
+		if n := countCalls("/api/v1/morning-calls/archived"); n != 0 {
+			t.Errorf("アーカイブ解除後のアーカイブ一覧の件数が不正: expected=0, actual=%d", n)
+		}
 	})
 
-	t.Run("送信者による削除は成功", func(t *testing.T) {
-		// 新しいモーニングコールを作成（時刻をずらして作成）
-		wakeTime2 := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 10, 0, 0, 0, time.Local)
+	t.Run("モーニングコール削除", func(t *testing.T) {
+		// 新しいモーニングコールを作成
+		tomorrow := time.Now().AddDate(0, 0, 1)
+		wakeTime := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 6, 0, 0, 0, time.Local)
+
 		createReq := map[string]interface{}{
 			"receiver_id": user2ID,
-			"scheduled_time":   wakeTime2.Format(time.RFC3339),
-			"message":     "削除テスト",
+			"scheduled_time":   wakeTime.Format(time.RFC3339),
+			"message":     "削除テスト用",
 		}
 
 		createResp, err := ts.DoRequest("POST", "/api/v1/morning-calls", createReq, session1)
@@ -530,7 +990,7 @@ func TestMorningCallAuthorization(t *testing.T) {
 		}
 		deleteID := morningCall["id"].(string)
 
-		// 送信者が削除
+		// 削除
 		deleteResp, err := ts.DoRequest("DELETE", fmt.Sprintf("/api/v1/morning-calls/%s", deleteID), nil, session1)
 		if err != nil {
 			t.Fatalf("リクエストエラー: %v", err)
@@ -538,85 +998,1355 @@ func TestMorningCallAuthorization(t *testing.T) {
 		defer deleteResp.Body.Close()
 
 		AssertStatusCode(t, http.StatusOK, deleteResp.StatusCode)
+
+		// 削除確認
+		getResp, _ := ts.DoRequest("GET", fmt.Sprintf("/api/v1/morning-calls/%s", deleteID), nil, session1)
+		defer getResp.Body.Close()
+
+		AssertStatusCode(t, http.StatusNotFound, getResp.StatusCode)
 	})
 
-	t.Run("送信者による起床確認は失敗", func(t *testing.T) {
-		// 新しいモーニングコールを作成（時刻をずらして作成）
-		wakeTime3 := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 10, 30, 0, 0, time.Local)
-		createReq := map[string]interface{}{
-			"receiver_id": user2ID,
-			"scheduled_time":   wakeTime3.Format(time.RFC3339),
-			"message":     "起床確認テスト用",
-		}
+	t.Run("送信者によるキャンセル", func(t *testing.T) {
+		tomorrow := time.Now().AddDate(0, 0, 1)
+		wakeTime := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 6, 30, 0, 0, time.Local)
 
-		createResp, err := ts.DoRequest("POST", "/api/v1/morning-calls", createReq, session1)
+		createResp, err := ts.DoRequest("POST", "/api/v1/morning-calls", map[string]interface{}{
+			"receiver_id":    user2ID,
+			"scheduled_time": wakeTime.Format(time.RFC3339),
+			"message":        "キャンセルテスト用",
+		}, session1)
 		if err != nil {
 			t.Fatalf("モーニングコール作成エラー: %v", err)
 		}
 		defer createResp.Body.Close()
 
-		if createResp.StatusCode != http.StatusCreated {
-			body, _ := io.ReadAll(createResp.Body)
-			t.Fatalf("モーニングコール作成失敗: status=%d, body=%s", createResp.StatusCode, body)
-		}
+		AssertStatusCode(t, http.StatusCreated, createResp.StatusCode)
 
 		var morningCall map[string]interface{}
 		if err := json.NewDecoder(createResp.Body).Decode(&morningCall); err != nil {
 			t.Fatalf("レスポンスのパースに失敗: %v", err)
 		}
-		
-		if morningCall["id"] == nil {
-			t.Fatalf("モーニングコールIDが取得できません: %v", morningCall)
+		cancelURL := fmt.Sprintf("/api/v1/morning-calls/%s/cancel", morningCall["id"])
+
+		// 受信者はキャンセルできない
+		forbiddenResp, err := ts.DoRequest("PUT", cancelURL, nil, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
 		}
-		confirmID := morningCall["id"].(string)
-		
-		// 送信者による起床確認（失敗するはず）
-		resp, err := ts.DoRequest("PUT", fmt.Sprintf("/api/v1/morning-calls/%s/confirm", confirmID), nil, session1)
+		defer forbiddenResp.Body.Close()
+
+		AssertStatusCode(t, http.StatusForbidden, forbiddenResp.StatusCode)
+
+		resp, err := ts.DoRequest("PUT", cancelURL, nil, session1)
 		if err != nil {
 			t.Fatalf("リクエストエラー: %v", err)
 		}
 		defer resp.Body.Close()
 
-		AssertStatusCode(t, http.StatusForbidden, resp.StatusCode)
-	})
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+		AssertJSONResponse(t, resp, "status", "cancelled")
 
-	t.Run("受信者による起床確認は成功", func(t *testing.T) {
-		// 新しいモーニングコールを作成（時刻をずらして作成）
-		wakeTime4 := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 11, 0, 0, 0, time.Local)
-		createReq := map[string]interface{}{
-			"receiver_id": user2ID,
-			"scheduled_time":   wakeTime4.Format(time.RFC3339),
-			"message":     "起床確認成功テスト用",
+		// 起床確認済みのコールはキャンセルできない
+		confirmedResp, err := ts.DoRequest("PUT", fmt.Sprintf("/api/v1/morning-calls/%s/cancel", morningCallID), nil, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
 		}
+		defer confirmedResp.Body.Close()
 
-		createResp, err := ts.DoRequest("POST", "/api/v1/morning-calls", createReq, session1)
+		AssertStatusCode(t, http.StatusBadRequest, confirmedResp.StatusCode)
+	})
+
+	t.Run("受信者による時刻の変更リクエスト", func(t *testing.T) {
+		tomorrow := time.Now().AddDate(0, 0, 1)
+		wakeTime := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 5, 30, 0, 0, time.Local)
+		requestedTime := wakeTime.Add(30 * time.Minute)
+
+		createResp, err := ts.DoRequest("POST", "/api/v1/morning-calls", map[string]interface{}{
+			"receiver_id":    user2ID,
+			"scheduled_time": wakeTime.Format(time.RFC3339),
+			"message":        "時刻変更テスト用",
+		}, session1)
 		if err != nil {
 			t.Fatalf("モーニングコール作成エラー: %v", err)
 		}
 		defer createResp.Body.Close()
 
-		if createResp.StatusCode != http.StatusCreated {
-			body, _ := io.ReadAll(createResp.Body)
-			t.Fatalf("モーニングコール作成失敗: status=%d, body=%s", createResp.StatusCode, body)
+		AssertStatusCode(t, http.StatusCreated, createResp.StatusCode)
+
+		var morningCall map[string]interface{}
+		if err := json.NewDecoder(createResp.Body).Decode(&morningCall); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		requestURL := fmt.Sprintf("/api/v1/morning-calls/%s/reschedule-request", morningCall["id"])
+
+		// 送信者はリクエストできない
+		forbiddenResp, err := ts.DoRequest("POST", requestURL, map[string]interface{}{
+			"requested_time": requestedTime.Format(time.RFC3339),
+		}, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer forbiddenResp.Body.Close()
+
+		AssertStatusCode(t, http.StatusForbidden, forbiddenResp.StatusCode)
+
+		resp, err := ts.DoRequest("POST", requestURL, map[string]interface{}{
+			"requested_time": requestedTime.Format(time.RFC3339),
+		}, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		AssertStatusCode(t, http.StatusCreated, resp.StatusCode)
+
+		// 受信者は承認できない
+		receiverApproveResp, err := ts.DoRequest("POST", requestURL+"/approve", nil, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer receiverApproveResp.Body.Close()
+
+		AssertStatusCode(t, http.StatusForbidden, receiverApproveResp.StatusCode)
+
+		// 送信者が承認するとアラーム時刻が希望時刻に変わる
+		approveResp, err := ts.DoRequest("POST", requestURL+"/approve", nil, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer approveResp.Body.Close()
+
+		AssertStatusCode(t, http.StatusOK, approveResp.StatusCode)
+
+		var approved struct {
+			ScheduledTime     time.Time `json:"scheduled_time"`
+			RescheduleRequest struct {
+				Status string `json:"status"`
+			} `json:"reschedule_request"`
+		}
+		if err := json.NewDecoder(approveResp.Body).Decode(&approved); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		if !approved.ScheduledTime.Equal(requestedTime) {
+			t.Errorf("scheduled_time = %v, want %v", approved.ScheduledTime, requestedTime)
+		}
+		if approved.RescheduleRequest.Status != "approved" {
+			t.Errorf("reschedule_request.status = %s, want approved", approved.RescheduleRequest.Status)
+		}
+
+		// 応答済みのリクエストは拒否できない
+		rejectResp, err := ts.DoRequest("POST", requestURL+"/reject", nil, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer rejectResp.Body.Close()
+
+		AssertStatusCode(t, http.StatusBadRequest, rejectResp.StatusCode)
+	})
+
+	t.Run("受信者の事前承認", func(t *testing.T) {
+		// user2が事前承認制を有効化
+		settingResp, err := ts.DoRequest("PUT", "/api/v1/users/me/call-approval", map[string]interface{}{"require_call_approval": true}, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer settingResp.Body.Close()
+
+		AssertStatusCode(t, http.StatusOK, settingResp.StatusCode)
+
+		// 承認制の受信者へのコールは承認待ちになる
+		createCall := func(hour int) string {
+			tomorrow := time.Now().AddDate(0, 0, 1)
+			wakeTime := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), hour, 0, 0, 0, time.Local)
+			createResp, err := ts.DoRequest("POST", "/api/v1/morning-calls", map[string]interface{}{
+				"receiver_id":    user2ID,
+				"scheduled_time": wakeTime.Format(time.RFC3339),
+				"message":        "承認テスト用",
+			}, session1)
+			if err != nil {
+				t.Fatalf("モーニングコール作成エラー: %v", err)
+			}
+			defer createResp.Body.Close()
+
+			AssertStatusCode(t, http.StatusCreated, createResp.StatusCode)
+
+			var morningCall map[string]interface{}
+			if err := json.NewDecoder(createResp.Body).Decode(&morningCall); err != nil {
+				t.Fatalf("レスポンスのパースに失敗: %v", err)
+			}
+			if morningCall["status"] != "pending_approval" {
+				t.Errorf("ステータスが不正: expected=pending_approval, actual=%v", morningCall["status"])
+			}
+			return morningCall["id"].(string)
+		}
+
+		// 送信者は承認できない
+		approveID := createCall(5)
+		forbiddenResp, err := ts.DoRequest("PUT", fmt.Sprintf("/api/v1/morning-calls/%s/approve", approveID), nil, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer forbiddenResp.Body.Close()
+
+		AssertStatusCode(t, http.StatusForbidden, forbiddenResp.StatusCode)
+
+		// 受信者が承認するとスケジュール済みになる
+		approveResp, err := ts.DoRequest("PUT", fmt.Sprintf("/api/v1/morning-calls/%s/approve", approveID), nil, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer approveResp.Body.Close()
+
+		AssertStatusCode(t, http.StatusOK, approveResp.StatusCode)
+
+		var approved map[string]interface{}
+		if err := json.NewDecoder(approveResp.Body).Decode(&approved); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		if approved["status"] != "scheduled" {
+			t.Errorf("ステータスが不正: expected=scheduled, actual=%v", approved["status"])
+		}
+
+		// 受信者が拒否すると拒否済みになり、送信者へ通知される
+		rejectID := createCall(9)
+		before := len(ts.EmailSender.Messages())
+		rejectResp, err := ts.DoRequest("PUT", fmt.Sprintf("/api/v1/morning-calls/%s/reject", rejectID), nil, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer rejectResp.Body.Close()
+
+		AssertStatusCode(t, http.StatusOK, rejectResp.StatusCode)
+
+		var rejected map[string]interface{}
+		if err := json.NewDecoder(rejectResp.Body).Decode(&rejected); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
 		}
+		if rejected["status"] != "rejected" {
+			t.Errorf("ステータスが不正: expected=rejected, actual=%v", rejected["status"])
+		}
+		messages := ts.EmailSender.Messages()
+		if len(messages) != before+1 || messages[len(messages)-1].To != "mc1@example.com" {
+			t.Errorf("送信者への拒否通知が送信されていません: %+v", messages)
+		}
+	})
+
+	t.Run("代理人による起床確認", func(t *testing.T) {
+		// 前のテストで有効化した事前承認制を無効に戻す
+		settingResp, err := ts.DoRequest("PUT", "/api/v1/users/me/call-approval", map[string]interface{}{"require_call_approval": false}, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer settingResp.Body.Close()
+
+		AssertStatusCode(t, http.StatusOK, settingResp.StatusCode)
+
+		tomorrow := time.Now().AddDate(0, 0, 1)
+		wakeTime := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 11, 0, 0, 0, time.Local)
+		createResp, err := ts.DoRequest("POST", "/api/v1/morning-calls", map[string]interface{}{
+			"receiver_id":    user2ID,
+			"scheduled_time": wakeTime.Format(time.RFC3339),
+			"message":        "代理確認テスト用",
+		}, session1)
+		if err != nil {
+			t.Fatalf("モーニングコール作成エラー: %v", err)
+		}
+		defer createResp.Body.Close()
+
+		AssertStatusCode(t, http.StatusCreated, createResp.StatusCode)
 
 		var morningCall map[string]interface{}
 		if err := json.NewDecoder(createResp.Body).Decode(&morningCall); err != nil {
 			t.Fatalf("レスポンスのパースに失敗: %v", err)
 		}
-		
-		if morningCall["id"] == nil {
-			t.Fatalf("モーニングコールIDが取得できません: %v", morningCall)
+		callID := morningCall["id"].(string)
+
+		// 代理人に設定されていないユーザーは確認できない
+		forbiddenResp, err := ts.DoRequest("PUT", fmt.Sprintf("/api/v1/morning-calls/%s/confirm", callID), nil, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
 		}
-		confirmID := morningCall["id"].(string)
-		
-		// 受信者による起床確認（成功するはず）
-		resp, err := ts.DoRequest("PUT", fmt.Sprintf("/api/v1/morning-calls/%s/confirm", confirmID), nil, session2)
+		defer forbiddenResp.Body.Close()
+
+		AssertStatusCode(t, http.StatusForbidden, forbiddenResp.StatusCode)
+
+		// 受信者が友達を代理人に設定する
+		proxyResp, err := ts.DoRequest("PUT", fmt.Sprintf("/api/v1/users/me/proxy-confirmers/%s", user1ID), nil, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer proxyResp.Body.Close()
+
+		AssertStatusCode(t, http.StatusOK, proxyResp.StatusCode)
+
+		// 代理人が確認すると、誰が確認したかが記録される
+		confirmResp, err := ts.DoRequest("PUT", fmt.Sprintf("/api/v1/morning-calls/%s/confirm", callID), nil, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer confirmResp.Body.Close()
+
+		AssertStatusCode(t, http.StatusOK, confirmResp.StatusCode)
+
+		var confirmed map[string]interface{}
+		if err := json.NewDecoder(confirmResp.Body).Decode(&confirmed); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		if confirmed["status"] != "confirmed" || confirmed["confirmed_by"] != user1ID || confirmed["proxy_confirmed"] != true {
+			t.Errorf("代理確認の記録が不正: %+v", confirmed)
+		}
+
+		// 代理人を解除する
+		removeResp, err := ts.DoRequest("DELETE", fmt.Sprintf("/api/v1/users/me/proxy-confirmers/%s", user1ID), nil, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer removeResp.Body.Close()
+
+		AssertStatusCode(t, http.StatusOK, removeResp.StatusCode)
+	})
+}
+
+func TestMorningCallValidation(t *testing.T) {
+	ts := NewTestServer(t)
+	defer ts.Close()
+
+	// テストユーザーの作成
+	user1ID := ts.RegisterUser(t, "valuser1", "val1@example.com", "Password123!")
+	user2ID := ts.RegisterUser(t, "valuser2", "val2@example.com", "Password123!")
+	user3ID := ts.RegisterUser(t, "valuser3", "val3@example.com", "Password123!")
+	
+	session1 := ts.LoginUser(t, "valuser1", "Password123!")
+
+	// user1とuser2を友達にする
+	reqBody := map[string]string{
+		"receiver_id": user2ID,
+	}
+	relResp, _ := ts.DoRequest("POST", "/api/v1/relationships/request", reqBody, session1)
+	defer relResp.Body.Close()
+	
+	var relResult map[string]interface{}
+	if err := json.NewDecoder(relResp.Body).Decode(&relResult); err != nil {
+		t.Fatalf("友達リクエストレスポンスのデコードエラー: %v", err)
+	}
+	
+	// relationshipのレスポンス構造を確認
+	var relationshipID string
+	if relationship, ok := relResult["relationship"].(map[string]interface{}); ok {
+		if id, ok := relationship["id"].(string); ok {
+			relationshipID = id
+		} else {
+			t.Fatalf("relationshipのIDが取得できません: %v", relationship)
+		}
+	} else if id, ok := relResult["id"].(string); ok {
+		relationshipID = id
+	} else {
+		t.Fatalf("relationshipIDが取得できません: %v", relResult)
+	}
+	
+	session2 := ts.LoginUser(t, "valuser2", "Password123!")
+	ts.DoRequest("PUT", fmt.Sprintf("/api/v1/relationships/%s/accept", relationshipID), nil, session2)
+
+	t.Run("過去の時刻でのモーニングコール作成エラー", func(t *testing.T) {
+		yesterday := time.Now().AddDate(0, 0, -1)
+
+		createReq := map[string]interface{}{
+			"receiver_id": user2ID,
+			"scheduled_time":   yesterday.Format(time.RFC3339),
+			"message":     "過去の時刻",
+		}
+
+		resp, err := ts.DoRequest("POST", "/api/v1/morning-calls", createReq, session1)
 		if err != nil {
 			t.Fatalf("リクエストエラー: %v", err)
 		}
 		defer resp.Body.Close()
 
-		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+		AssertStatusCode(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("30日以上先のモーニングコール作成エラー", func(t *testing.T) {
+		farFuture := time.Now().AddDate(0, 0, 31)
+
+		createReq := map[string]interface{}{
+			"receiver_id": user2ID,
+			"scheduled_time":   farFuture.Format(time.RFC3339),
+			"message":     "遠すぎる未来",
+		}
+
+		resp, err := ts.DoRequest("POST", "/api/v1/morning-calls", createReq, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		AssertStatusCode(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("友達でないユーザーへのモーニングコール作成エラー", func(t *testing.T) {
+		tomorrow := time.Now().AddDate(0, 0, 1)
+
+		createReq := map[string]interface{}{
+			"receiver_id": user3ID,
+			"scheduled_time":   tomorrow.Format(time.RFC3339),
+			"message":     "友達でない",
+		}
+
+		resp, err := ts.DoRequest("POST", "/api/v1/morning-calls", createReq, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		// 友達でないのでエラーになるはず
+		if resp.StatusCode == http.StatusCreated {
+			t.Error("友達でないユーザーへのモーニングコールが作成されました")
+		}
+	})
+
+	t.Run("自分自身へのモーニングコール作成エラー", func(t *testing.T) {
+		tomorrow := time.Now().AddDate(0, 0, 1)
+
+		createReq := map[string]interface{}{
+			"receiver_id": user1ID,
+			"scheduled_time":   tomorrow.Format(time.RFC3339),
+			"message":     "自分宛て",
+		}
+
+		resp, err := ts.DoRequest("POST", "/api/v1/morning-calls", createReq, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		AssertStatusCode(t, http.StatusBadRequest, resp.StatusCode)
 	})
-}
\ No newline at end of file
+
+	t.Run("セルフモーニングコールの作成", func(t *testing.T) {
+		resp, err := ts.DoRequest("POST", "/api/v1/morning-calls", map[string]interface{}{
+			"self_call":      true,
+			"scheduled_time": time.Now().Add(3 * time.Hour).Format(time.RFC3339),
+			"message":        "自分で起きる",
+		}, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusCreated, resp.StatusCode)
+
+		var created struct {
+			ID         string `json:"id"`
+			SenderID   string `json:"sender_id"`
+			ReceiverID string `json:"receiver_id"`
+			SelfCall   bool   `json:"self_call"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		if !created.SelfCall || created.SenderID != user1ID || created.ReceiverID != user1ID {
+			t.Errorf("セルフモーニングコールが不正: %+v", created)
+		}
+
+		// 他のテストの件数に影響しないよう削除しておく
+		deleteResp, err := ts.DoRequest("DELETE", "/api/v1/morning-calls/"+created.ID, nil, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer deleteResp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, deleteResp.StatusCode)
+	})
+
+	t.Run("メッセージは元のテキストで保存し、表示用には無害化して返す", func(t *testing.T) {
+		message := "*起きて*\n<script>alert('xss')</script>"
+		resp, err := ts.DoRequest("POST", "/api/v1/morning-calls", map[string]interface{}{
+			"self_call":      true,
+			"scheduled_time": time.Now().Add(3 * time.Hour).Format(time.RFC3339),
+			"message":        message,
+		}, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusCreated, resp.StatusCode)
+
+		var created struct {
+			ID          string `json:"id"`
+			Message     string `json:"message"`
+			MessageHTML string `json:"message_html"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		if created.Message != message {
+			t.Errorf("message = %q, want %q", created.Message, message)
+		}
+		if created.MessageHTML != "<strong>起きて</strong><br>" {
+			t.Errorf("message_html = %q, want sanitized html", created.MessageHTML)
+		}
+
+		// 他のテストの件数に影響しないよう削除しておく
+		deleteResp, err := ts.DoRequest("DELETE", "/api/v1/morning-calls/"+created.ID, nil, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer deleteResp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, deleteResp.StatusCode)
+	})
+
+	t.Run("空のメッセージでのモーニングコール作成", func(t *testing.T) {
+		tomorrow := time.Now().AddDate(0, 0, 1)
+
+		createReq := map[string]interface{}{
+			"receiver_id": user2ID,
+			"scheduled_time":   tomorrow.Format(time.RFC3339),
+			"message":     "",
+		}
+
+		resp, err := ts.DoRequest("POST", "/api/v1/morning-calls", createReq, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		// 空のメッセージは許可される（デフォルトメッセージが使われる）
+		AssertStatusCode(t, http.StatusCreated, resp.StatusCode)
+	})
+}
+
+func TestMorningCallAuthorization(t *testing.T) {
+	ts := NewTestServer(t)
+	defer ts.Close()
+
+	// テストユーザーの作成
+	_ = ts.RegisterUser(t, "authmc1", "authmc1@example.com", "Password123!")
+	user2ID := ts.RegisterUser(t, "authmc2", "authmc2@example.com", "Password123!")
+	_ = ts.RegisterUser(t, "authmc3", "authmc3@example.com", "Password123!")
+	
+	session1 := ts.LoginUser(t, "authmc1", "Password123!")
+	session2 := ts.LoginUser(t, "authmc2", "Password123!")
+	session3 := ts.LoginUser(t, "authmc3", "Password123!")
+
+	// user1とuser2を友達にする
+	reqBody := map[string]string{
+		"receiver_id": user2ID,
+	}
+	relResp, _ := ts.DoRequest("POST", "/api/v1/relationships/request", reqBody, session1)
+	defer relResp.Body.Close()
+	
+	var relResult map[string]interface{}
+	if err := json.NewDecoder(relResp.Body).Decode(&relResult); err != nil {
+		t.Fatalf("友達リクエストレスポンスのデコードエラー: %v", err)
+	}
+	
+	// relationshipのレスポンス構造を確認
+	var relationshipID string
+	if relationship, ok := relResult["relationship"].(map[string]interface{}); ok {
+		if id, ok := relationship["id"].(string); ok {
+			relationshipID = id
+		} else {
+			t.Fatalf("relationshipのIDが取得できません: %v", relationship)
+		}
+	} else if id, ok := relResult["id"].(string); ok {
+		relationshipID = id
+	} else {
+		t.Fatalf("relationshipIDが取得できません: %v", relResult)
+	}
+	
+	ts.DoRequest("PUT", fmt.Sprintf("/api/v1/relationships/%s/accept", relationshipID), nil, session2)
+
+	// user1からuser2へモーニングコールを作成（時刻をずらして作成）
+	tomorrow := time.Now().AddDate(0, 0, 1)
+	wakeTime := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 9, 30, 0, 0, time.Local)
+	createReq := map[string]interface{}{
+		"receiver_id": user2ID,
+		"scheduled_time":   wakeTime.Format(time.RFC3339),
+		"message":     "権限テスト",
+	}
+
+	createResp, _ := ts.DoRequest("POST", "/api/v1/morning-calls", createReq, session1)
+	defer createResp.Body.Close()
+
+	var createResult map[string]interface{}
+	json.NewDecoder(createResp.Body).Decode(&createResult)
+	morningCallID := createResult["id"].(string)
+
+	t.Run("第三者による更新は失敗", func(t *testing.T) {
+		updateReq := map[string]interface{}{
+			"message": "不正な更新",
+		}
+
+		resp, err := ts.DoRequest("PUT", fmt.Sprintf("/api/v1/morning-calls/%s", morningCallID), updateReq, session3)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		AssertStatusCode(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("受信者による更新は失敗", func(t *testing.T) {
+		updateReq := map[string]interface{}{
+			"message": "受信者からの更新",
+		}
+
+		resp, err := ts.DoRequest("PUT", fmt.Sprintf("/api/v1/morning-calls/%s", morningCallID), updateReq, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		AssertStatusCode(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("送信者による削除は成功", func(t *testing.T) {
+		// 新しいモーニングコールを作成（時刻をずらして作成）
+		wakeTime2 := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 10, 0, 0, 0, time.Local)
+		createReq := map[string]interface{}{
+			"receiver_id": user2ID,
+			"scheduled_time":   wakeTime2.Format(time.RFC3339),
+			"message":     "削除テスト",
+		}
+
+		createResp, err := ts.DoRequest("POST", "/api/v1/morning-calls", createReq, session1)
+		if err != nil {
+			t.Fatalf("モーニングコール作成エラー: %v", err)
+		}
+		defer createResp.Body.Close()
+
+		if createResp.StatusCode != http.StatusCreated {
+			body, _ := io.ReadAll(createResp.Body)
+			t.Fatalf("モーニングコール作成失敗: status=%d, body=%s", createResp.StatusCode, body)
+		}
+
+		var morningCall map[string]interface{}
+		if err := json.NewDecoder(createResp.Body).Decode(&morningCall); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		
+		if morningCall["id"] == nil {
+			t.Fatalf("モーニングコールIDが取得できません: %v", morningCall)
+		}
+		deleteID := morningCall["id"].(string)
+
+		// 送信者が削除
+		deleteResp, err := ts.DoRequest("DELETE", fmt.Sprintf("/api/v1/morning-calls/%s", deleteID), nil, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer deleteResp.Body.Close()
+
+		AssertStatusCode(t, http.StatusOK, deleteResp.StatusCode)
+	})
+
+	t.Run("送信者による起床確認は失敗", func(t *testing.T) {
+		// 新しいモーニングコールを作成（時刻をずらして作成）
+		wakeTime3 := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 10, 30, 0, 0, time.Local)
+		createReq := map[string]interface{}{
+			"receiver_id": user2ID,
+			"scheduled_time":   wakeTime3.Format(time.RFC3339),
+			"message":     "起床確認テスト用",
+		}
+
+		createResp, err := ts.DoRequest("POST", "/api/v1/morning-calls", createReq, session1)
+		if err != nil {
+			t.Fatalf("モーニングコール作成エラー: %v", err)
+		}
+		defer createResp.Body.Close()
+
+		if createResp.StatusCode != http.StatusCreated {
+			body, _ := io.ReadAll(createResp.Body)
+			t.Fatalf("モーニングコール作成失敗: status=%d, body=%s", createResp.StatusCode, body)
+		}
+
+		var morningCall map[string]interface{}
+		if err := json.NewDecoder(createResp.Body).Decode(&morningCall); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		
+		if morningCall["id"] == nil {
+			t.Fatalf("モーニングコールIDが取得できません: %v", morningCall)
+		}
+		confirmID := morningCall["id"].(string)
+		
+		// 送信者による起床確認（失敗するはず）
+		resp, err := ts.DoRequest("PUT", fmt.Sprintf("/api/v1/morning-calls/%s/confirm", confirmID), nil, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		AssertStatusCode(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("受信者による起床確認は成功", func(t *testing.T) {
+		// 新しいモーニングコールを作成（時刻をずらして作成）
+		wakeTime4 := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 11, 0, 0, 0, time.Local)
+		createReq := map[string]interface{}{
+			"receiver_id": user2ID,
+			"scheduled_time":   wakeTime4.Format(time.RFC3339),
+			"message":     "起床確認成功テスト用",
+		}
+
+		createResp, err := ts.DoRequest("POST", "/api/v1/morning-calls", createReq, session1)
+		if err != nil {
+			t.Fatalf("モーニングコール作成エラー: %v", err)
+		}
+		defer createResp.Body.Close()
+
+		if createResp.StatusCode != http.StatusCreated {
+			body, _ := io.ReadAll(createResp.Body)
+			t.Fatalf("モーニングコール作成失敗: status=%d, body=%s", createResp.StatusCode, body)
+		}
+
+		var morningCall map[string]interface{}
+		if err := json.NewDecoder(createResp.Body).Decode(&morningCall); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		
+		if morningCall["id"] == nil {
+			t.Fatalf("モーニングコールIDが取得できません: %v", morningCall)
+		}
+		confirmID := morningCall["id"].(string)
+		
+		// 受信者による起床確認（成功するはず）
+		resp, err := ts.DoRequest("PUT", fmt.Sprintf("/api/v1/morning-calls/%s/confirm", confirmID), nil, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+	})
+}
+func TestMorningCallSeries(t *testing.T) {
+	ts := NewTestServer(t)
+	defer ts.Close()
+
+	_ = ts.RegisterUser(t, "seriesuser1", "series1@example.com", "Password123!")
+	user2ID := ts.RegisterUser(t, "seriesuser2", "series2@example.com", "Password123!")
+
+	session1 := ts.LoginUser(t, "seriesuser1", "Password123!")
+	session2 := ts.LoginUser(t, "seriesuser2", "Password123!")
+
+	// user1とuser2を友達にする
+	relResp, _ := ts.DoRequest("POST", "/api/v1/relationships/request", map[string]string{"receiver_id": user2ID}, session1)
+	defer relResp.Body.Close()
+	var relResult map[string]interface{}
+	if err := json.NewDecoder(relResp.Body).Decode(&relResult); err != nil {
+		t.Fatalf("友達リクエストレスポンスのデコードエラー: %v", err)
+	}
+	relationshipID, _ := relResult["id"].(string)
+	if relationship, ok := relResult["relationship"].(map[string]interface{}); ok {
+		relationshipID, _ = relationship["id"].(string)
+	}
+	acceptResp, _ := ts.DoRequest("PUT", fmt.Sprintf("/api/v1/relationships/%s/accept", relationshipID), nil, session2)
+	acceptResp.Body.Close()
+
+	// 明日から3日間、毎朝7時
+	start := time.Now().AddDate(0, 0, 1)
+	end := start.AddDate(0, 0, 2)
+	createReq := map[string]interface{}{
+		"receiver_id": user2ID,
+		"start_date":  start.Format("2006-01-02"),
+		"end_date":    end.Format("2006-01-02"),
+		"time":        "07:00",
+		"message":     "おはよう",
+	}
+
+	var seriesID string
+	t.Run("シリーズを作成する", func(t *testing.T) {
+		resp, err := ts.DoRequest("POST", "/api/v1/morning-calls/series", createReq, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusCreated {
+			body, _ := io.ReadAll(resp.Body)
+			t.Fatalf("シリーズ作成失敗: status=%d, body=%s", resp.StatusCode, body)
+		}
+
+		var result map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		seriesID, _ = result["series_id"].(string)
+		calls, _ := result["morning_calls"].([]interface{})
+		if seriesID == "" || len(calls) != 3 {
+			t.Fatalf("series_id=%q, morning_calls=%d, want 3", seriesID, len(calls))
+		}
+		for _, c := range calls {
+			if c.(map[string]interface{})["series_id"] != seriesID {
+				t.Errorf("シリーズIDが一致しない: %v", c)
+			}
+		}
+	})
+
+	t.Run("不正な日付は400", func(t *testing.T) {
+		invalid := map[string]interface{}{
+			"receiver_id": user2ID,
+			"start_date":  "tomorrow",
+			"end_date":    end.Format("2006-01-02"),
+			"time":        "07:00",
+		}
+		resp, err := ts.DoRequest("POST", "/api/v1/morning-calls/series", invalid, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("受信者はシリーズを取得できるがキャンセルできない", func(t *testing.T) {
+		if seriesID == "" {
+			t.Skip("シリーズIDが設定されていません")
+		}
+		resp, err := ts.DoRequest("GET", "/api/v1/morning-calls/series/"+seriesID, nil, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+
+		cancelResp, err := ts.DoRequest("PUT", "/api/v1/morning-calls/series/"+seriesID+"/cancel", nil, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer cancelResp.Body.Close()
+		AssertStatusCode(t, http.StatusForbidden, cancelResp.StatusCode)
+	})
+
+	t.Run("送信者はシリーズのメッセージをまとめて更新できる", func(t *testing.T) {
+		if seriesID == "" {
+			t.Skip("シリーズIDが設定されていません")
+		}
+		body := map[string]interface{}{"message": "今週もがんばろう"}
+		resp, err := ts.DoRequest("PUT", "/api/v1/morning-calls/series/"+seriesID, body, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+
+		var result map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		if result["updated"] != float64(3) {
+			t.Errorf("updated = %v, want 3", result["updated"])
+		}
+
+		forbiddenResp, err := ts.DoRequest("PUT", "/api/v1/morning-calls/series/"+seriesID, body, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer forbiddenResp.Body.Close()
+		AssertStatusCode(t, http.StatusForbidden, forbiddenResp.StatusCode)
+	})
+
+	t.Run("送信者はシリーズをまとめてキャンセルできる", func(t *testing.T) {
+		if seriesID == "" {
+			t.Skip("シリーズIDが設定されていません")
+		}
+		resp, err := ts.DoRequest("PUT", "/api/v1/morning-calls/series/"+seriesID+"/cancel", nil, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+
+		var result map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		if result["cancelled"] != float64(3) {
+			t.Errorf("cancelled = %v, want 3", result["cancelled"])
+		}
+	})
+}
+
+func TestMorningCallNextCallRequest(t *testing.T) {
+	ts := NewTestServer(t)
+	defer ts.Close()
+
+	_ = ts.RegisterUser(t, "nextuser1", "next1@example.com", "Password123!")
+	user2ID := ts.RegisterUser(t, "nextuser2", "next2@example.com", "Password123!")
+
+	session1 := ts.LoginUser(t, "nextuser1", "Password123!")
+	session2 := ts.LoginUser(t, "nextuser2", "Password123!")
+
+	// user1とuser2を友達にする
+	relResp, _ := ts.DoRequest("POST", "/api/v1/relationships/request", map[string]string{"receiver_id": user2ID}, session1)
+	defer relResp.Body.Close()
+	var relResult map[string]interface{}
+	if err := json.NewDecoder(relResp.Body).Decode(&relResult); err != nil {
+		t.Fatalf("友達リクエストレスポンスのデコードエラー: %v", err)
+	}
+	relationshipID, _ := relResult["id"].(string)
+	if relationship, ok := relResult["relationship"].(map[string]interface{}); ok {
+		relationshipID, _ = relationship["id"].(string)
+	}
+	acceptResp, _ := ts.DoRequest("PUT", fmt.Sprintf("/api/v1/relationships/%s/accept", relationshipID), nil, session2)
+	acceptResp.Body.Close()
+
+	createCall := func(t *testing.T, hour int) string {
+		tomorrow := time.Now().AddDate(0, 0, 1)
+		wakeTime := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), hour, 0, 0, 0, time.Local)
+		resp, err := ts.DoRequest("POST", "/api/v1/morning-calls", map[string]interface{}{
+			"receiver_id":    user2ID,
+			"scheduled_time": wakeTime.Format(time.RFC3339),
+			"message":        "明日もよろしく",
+		}, session1)
+		if err != nil {
+			t.Fatalf("モーニングコール作成エラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusCreated, resp.StatusCode)
+
+		var created map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		return created["id"].(string)
+	}
+
+	type nextCallRequest struct {
+		RequestedTime time.Time `json:"requested_time"`
+		Status        string    `json:"status"`
+		CreatedCallID string    `json:"created_call_id"`
+	}
+	type callResponse struct {
+		ID              string           `json:"id"`
+		ScheduledTime   time.Time        `json:"scheduled_time"`
+		Status          string           `json:"status"`
+		NextCallRequest *nextCallRequest `json:"next_call_request"`
+	}
+
+	// confirm は受信者として起床確認し、確認後のコールを返す
+	confirm := func(t *testing.T, callID string, requestNextCall bool) callResponse {
+		resp, err := ts.DoRequest("PUT", fmt.Sprintf("/api/v1/morning-calls/%s/confirm", callID), map[string]interface{}{
+			"request_next_call": requestNextCall,
+		}, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+
+		var confirmed callResponse
+		if err := json.NewDecoder(resp.Body).Decode(&confirmed); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		return confirmed
+	}
+
+	t.Run("確認と同時に依頼し、送信者の承認で次回のコールが作られる", func(t *testing.T) {
+		callID := createCall(t, 6)
+		confirmed := confirm(t, callID, true)
+		if confirmed.NextCallRequest == nil || confirmed.NextCallRequest.Status != "pending" ||
+			!confirmed.NextCallRequest.RequestedTime.Equal(confirmed.ScheduledTime.AddDate(0, 0, 1)) {
+			t.Fatalf("next_call_request = %+v, want pending for the next day", confirmed.NextCallRequest)
+		}
+		approveURL := fmt.Sprintf("/api/v1/morning-calls/%s/next-call-request/approve", callID)
+
+		// 受信者は承認できない
+		forbiddenResp, err := ts.DoRequest("POST", approveURL, nil, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer forbiddenResp.Body.Close()
+		AssertStatusCode(t, http.StatusForbidden, forbiddenResp.StatusCode)
+
+		resp, err := ts.DoRequest("POST", approveURL, nil, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusCreated, resp.StatusCode)
+
+		var approved struct {
+			MorningCall callResponse  `json:"morning_call"`
+			NextCall    *callResponse `json:"next_call"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&approved); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		if approved.NextCall == nil || approved.NextCall.Status != "scheduled" ||
+			!approved.NextCall.ScheduledTime.Equal(confirmed.ScheduledTime.AddDate(0, 0, 1)) {
+			t.Fatalf("next_call = %+v, want a scheduled call for the next day", approved.NextCall)
+		}
+		if approved.MorningCall.NextCallRequest.Status != "approved" || approved.MorningCall.NextCallRequest.CreatedCallID != approved.NextCall.ID {
+			t.Errorf("next_call_request = %+v, want approved with created_call_id", approved.MorningCall.NextCallRequest)
+		}
+
+		// 応答済みのリクエストは再度承認できない
+		againResp, err := ts.DoRequest("POST", approveURL, nil, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer againResp.Body.Close()
+		AssertStatusCode(t, http.StatusBadRequest, againResp.StatusCode)
+	})
+
+	t.Run("依頼しなければ応答できない", func(t *testing.T) {
+		callID := createCall(t, 7)
+		confirmed := confirm(t, callID, false)
+		if confirmed.NextCallRequest != nil {
+			t.Fatalf("next_call_request = %+v, want none", confirmed.NextCallRequest)
+		}
+
+		resp, err := ts.DoRequest("POST", fmt.Sprintf("/api/v1/morning-calls/%s/next-call-request/reject", callID), nil, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestMorningCallComments(t *testing.T) {
+	ts := NewTestServer(t)
+	defer ts.Close()
+
+	_ = ts.RegisterUser(t, "commentuser1", "comment1@example.com", "Password123!")
+	user2ID := ts.RegisterUser(t, "commentuser2", "comment2@example.com", "Password123!")
+	_ = ts.RegisterUser(t, "commentuser3", "comment3@example.com", "Password123!")
+
+	session1 := ts.LoginUser(t, "commentuser1", "Password123!")
+	session2 := ts.LoginUser(t, "commentuser2", "Password123!")
+	session3 := ts.LoginUser(t, "commentuser3", "Password123!")
+
+	// user1とuser2を友達にする
+	relResp, _ := ts.DoRequest("POST", "/api/v1/relationships/request", map[string]string{"receiver_id": user2ID}, session1)
+	defer relResp.Body.Close()
+	var relResult map[string]interface{}
+	if err := json.NewDecoder(relResp.Body).Decode(&relResult); err != nil {
+		t.Fatalf("友達リクエストレスポンスのデコードエラー: %v", err)
+	}
+	relationshipID, _ := relResult["id"].(string)
+	if relationship, ok := relResult["relationship"].(map[string]interface{}); ok {
+		relationshipID, _ = relationship["id"].(string)
+	}
+	acceptResp, _ := ts.DoRequest("PUT", fmt.Sprintf("/api/v1/relationships/%s/accept", relationshipID), nil, session2)
+	acceptResp.Body.Close()
+
+	tomorrow := time.Now().AddDate(0, 0, 1)
+	wakeTime := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 6, 0, 0, 0, time.Local)
+	createResp, err := ts.DoRequest("POST", "/api/v1/morning-calls", map[string]interface{}{
+		"receiver_id":    user2ID,
+		"scheduled_time": wakeTime.Format(time.RFC3339),
+		"message":        "おはよう",
+	}, session1)
+	if err != nil {
+		t.Fatalf("モーニングコール作成エラー: %v", err)
+	}
+	defer createResp.Body.Close()
+	AssertStatusCode(t, http.StatusCreated, createResp.StatusCode)
+	var created map[string]interface{}
+	if err := json.NewDecoder(createResp.Body).Decode(&created); err != nil {
+		t.Fatalf("レスポンスのパースに失敗: %v", err)
+	}
+	commentsURL := fmt.Sprintf("/api/v1/morning-calls/%s/comments", created["id"])
+
+	t.Run("送信者と受信者がコメントし、時系列で取得できる", func(t *testing.T) {
+		for _, c := range []struct {
+			session string
+			body    string
+		}{
+			{session1, "明日は<b>早い</b>よ"},
+			{session2, "了解、起きたら連絡する"},
+		} {
+			resp, err := ts.DoRequest("POST", commentsURL, map[string]string{"body": c.body}, c.session)
+			if err != nil {
+				t.Fatalf("リクエストエラー: %v", err)
+			}
+			resp.Body.Close()
+			AssertStatusCode(t, http.StatusCreated, resp.StatusCode)
+		}
+
+		resp, err := ts.DoRequest("GET", commentsURL, nil, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+
+		var result struct {
+			Comments []struct {
+				Body     string `json:"body"`
+				BodyHTML string `json:"body_html"`
+				IsMine   bool   `json:"is_mine"`
+			} `json:"comments"`
+			Total int `json:"total"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		if result.Total != 2 || len(result.Comments) != 2 {
+			t.Fatalf("comments = %+v, want 2 comments", result)
+		}
+		first, second := result.Comments[0], result.Comments[1]
+		if first.Body != "明日は<b>早い</b>よ" || first.BodyHTML != "明日は早いよ" || first.IsMine {
+			t.Errorf("first comment = %+v", first)
+		}
+		if second.Body != "了解、起きたら連絡する" || !second.IsMine {
+			t.Errorf("second comment = %+v", second)
+		}
+	})
+
+	t.Run("第三者はコメントも閲覧もできない", func(t *testing.T) {
+		postResp, err := ts.DoRequest("POST", commentsURL, map[string]string{"body": "おはよう"}, session3)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		postResp.Body.Close()
+		AssertStatusCode(t, http.StatusForbidden, postResp.StatusCode)
+
+		getResp, err := ts.DoRequest("GET", commentsURL, nil, session3)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		getResp.Body.Close()
+		AssertStatusCode(t, http.StatusForbidden, getResp.StatusCode)
+	})
+
+	t.Run("空や長すぎるコメントは追加できない", func(t *testing.T) {
+		for _, body := range []string{"  ", strings.Repeat("あ", 501)} {
+			resp, err := ts.DoRequest("POST", commentsURL, map[string]string{"body": body}, session1)
+			if err != nil {
+				t.Fatalf("リクエストエラー: %v", err)
+			}
+			resp.Body.Close()
+			AssertStatusCode(t, http.StatusBadRequest, resp.StatusCode)
+		}
+	})
+}
+
+func TestMorningCallConfirmMethod(t *testing.T) {
+	ts := NewTestServer(t)
+	defer ts.Close()
+
+	_ = ts.RegisterUser(t, "methoduser1", "method1@example.com", "Password123!")
+	user2ID := ts.RegisterUser(t, "methoduser2", "method2@example.com", "Password123!")
+
+	session1 := ts.LoginUser(t, "methoduser1", "Password123!")
+	session2 := ts.LoginUser(t, "methoduser2", "Password123!")
+
+	// user1とuser2を友達にする
+	relResp, _ := ts.DoRequest("POST", "/api/v1/relationships/request", map[string]string{"receiver_id": user2ID}, session1)
+	defer relResp.Body.Close()
+	var relResult map[string]interface{}
+	if err := json.NewDecoder(relResp.Body).Decode(&relResult); err != nil {
+		t.Fatalf("友達リクエストレスポンスのデコードエラー: %v", err)
+	}
+	relationshipID, _ := relResult["id"].(string)
+	if relationship, ok := relResult["relationship"].(map[string]interface{}); ok {
+		relationshipID, _ = relationship["id"].(string)
+	}
+	acceptResp, _ := ts.DoRequest("PUT", fmt.Sprintf("/api/v1/relationships/%s/accept", relationshipID), nil, session2)
+	acceptResp.Body.Close()
+
+	tomorrow := time.Now().AddDate(0, 0, 1)
+	wakeTime := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 7, 0, 0, 0, time.Local)
+	createResp, err := ts.DoRequest("POST", "/api/v1/morning-calls", map[string]interface{}{
+		"receiver_id":    user2ID,
+		"scheduled_time": wakeTime.Format(time.RFC3339),
+		"message":        "振って起きてね",
+	}, session1)
+	if err != nil {
+		t.Fatalf("モーニングコール作成エラー: %v", err)
+	}
+	defer createResp.Body.Close()
+	AssertStatusCode(t, http.StatusCreated, createResp.StatusCode)
+	var created map[string]interface{}
+	if err := json.NewDecoder(createResp.Body).Decode(&created); err != nil {
+		t.Fatalf("レスポンスのパースに失敗: %v", err)
+	}
+	confirmURL := fmt.Sprintf("/api/v1/morning-calls/%s/confirm", created["id"])
+
+	t.Run("不正な確認方法はバリデーションエラー", func(t *testing.T) {
+		resp, err := ts.DoRequest("PUT", confirmURL, map[string]interface{}{"confirm_method": "voice"}, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("指定した確認方法が記録される", func(t *testing.T) {
+		resp, err := ts.DoRequest("PUT", confirmURL, map[string]interface{}{"confirm_method": "shake"}, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+
+		var confirmed map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&confirmed); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		if confirmed["status"] != "confirmed" || confirmed["confirm_method"] != "shake" {
+			t.Errorf("確認結果が不正: status=%v, confirm_method=%v", confirmed["status"], confirmed["confirm_method"])
+		}
+	})
+}
+
+func TestMorningCallCallForwarding(t *testing.T) {
+	ts := NewTestServer(t)
+	defer ts.Close()
+
+	_ = ts.RegisterUser(t, "fwdsender", "fwdsender@example.com", "Password123!")
+	receiverID := ts.RegisterUser(t, "fwdreceiver", "fwdreceiver@example.com", "Password123!")
+	targetID := ts.RegisterUser(t, "fwdtarget", "fwdtarget@example.com", "Password123!")
+	strangerID := ts.RegisterUser(t, "fwdstranger", "fwdstranger@example.com", "Password123!")
+
+	senderSession := ts.LoginUser(t, "fwdsender", "Password123!")
+	receiverSession := ts.LoginUser(t, "fwdreceiver", "Password123!")
+	targetSession := ts.LoginUser(t, "fwdtarget", "Password123!")
+
+	// 送信者・受信者・転送先の3人を互いに友達にする
+	makeFriends := func(requesterSession, receiverSession, receiverUserID string) {
+		relResp, _ := ts.DoRequest("POST", "/api/v1/relationships/request", map[string]string{"receiver_id": receiverUserID}, requesterSession)
+		defer relResp.Body.Close()
+		var relResult map[string]interface{}
+		if err := json.NewDecoder(relResp.Body).Decode(&relResult); err != nil {
+			t.Fatalf("友達リクエストレスポンスのデコードエラー: %v", err)
+		}
+		relationshipID, _ := relResult["id"].(string)
+		if relationship, ok := relResult["relationship"].(map[string]interface{}); ok {
+			relationshipID, _ = relationship["id"].(string)
+		}
+		acceptResp, _ := ts.DoRequest("PUT", fmt.Sprintf("/api/v1/relationships/%s/accept", relationshipID), nil, receiverSession)
+		acceptResp.Body.Close()
+	}
+	makeFriends(senderSession, receiverSession, receiverID)
+	makeFriends(receiverSession, targetSession, targetID)
+	makeFriends(senderSession, targetSession, targetID)
+
+	until := time.Now().AddDate(0, 0, 3)
+
+	t.Run("友達でないユーザーには転送できない", func(t *testing.T) {
+		resp, err := ts.DoRequest("PUT", "/api/v1/users/me/call-forwarding", map[string]interface{}{
+			"forward_to": strangerID,
+			"until":      until.Format(time.RFC3339),
+		}, receiverSession)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("終了日時の指定は必須", func(t *testing.T) {
+		resp, err := ts.DoRequest("PUT", "/api/v1/users/me/call-forwarding", map[string]interface{}{
+			"forward_to": targetID,
+		}, receiverSession)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("転送を設定する", func(t *testing.T) {
+		resp, err := ts.DoRequest("PUT", "/api/v1/users/me/call-forwarding", map[string]interface{}{
+			"forward_to": targetID,
+			"until":      until.Format(time.RFC3339),
+		}, receiverSession)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+
+		var result map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		user, _ := result["user"].(map[string]interface{})
+		forwarding, _ := user["call_forwarding"].(map[string]interface{})
+		if forwarding["forward_to"] != targetID {
+			t.Errorf("call_forwarding = %v, want forward_to %s", user["call_forwarding"], targetID)
+		}
+	})
+
+	t.Run("転送先から転送元への転送はループするため設定できない", func(t *testing.T) {
+		resp, err := ts.DoRequest("PUT", "/api/v1/users/me/call-forwarding", map[string]interface{}{
+			"forward_to": receiverID,
+			"until":      until.Format(time.RFC3339),
+		}, targetSession)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("転送期間中のコールは転送先に届き、双方に転送元が分かる", func(t *testing.T) {
+		tomorrow := time.Now().AddDate(0, 0, 1)
+		wakeTime := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 7, 0, 0, 0, time.Local)
+		createResp, err := ts.DoRequest("POST", "/api/v1/morning-calls", map[string]interface{}{
+			"receiver_id":    receiverID,
+			"scheduled_time": wakeTime.Format(time.RFC3339),
+			"message":        "代わりに起きてね",
+		}, senderSession)
+		if err != nil {
+			t.Fatalf("モーニングコール作成エラー: %v", err)
+		}
+		defer createResp.Body.Close()
+		AssertStatusCode(t, http.StatusCreated, createResp.StatusCode)
+
+		var created map[string]interface{}
+		if err := json.NewDecoder(createResp.Body).Decode(&created); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		if created["receiver_id"] != targetID || created["forwarded_from"] != receiverID {
+			t.Errorf("作成結果が不正: receiver_id=%v, forwarded_from=%v", created["receiver_id"], created["forwarded_from"])
+		}
+
+		// 転送先も転送されたコールであることを確認できる
+		getResp, err := ts.DoRequest("GET", fmt.Sprintf("/api/v1/morning-calls/%s", created["id"]), nil, targetSession)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer getResp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, getResp.StatusCode)
+
+		var fetched map[string]interface{}
+		if err := json.NewDecoder(getResp.Body).Decode(&fetched); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		if fetched["forwarded_from"] != receiverID {
+			t.Errorf("forwarded_from = %v, want %s", fetched["forwarded_from"], receiverID)
+		}
+	})
+
+	t.Run("転送を解除すると元の受信者に届く", func(t *testing.T) {
+		deleteResp, err := ts.DoRequest("DELETE", "/api/v1/users/me/call-forwarding", nil, receiverSession)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer deleteResp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, deleteResp.StatusCode)
+
+		tomorrow := time.Now().AddDate(0, 0, 1)
+		wakeTime := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 8, 0, 0, 0, time.Local)
+		createResp, err := ts.DoRequest("POST", "/api/v1/morning-calls", map[string]interface{}{
+			"receiver_id":    receiverID,
+			"scheduled_time": wakeTime.Format(time.RFC3339),
+			"message":        "自分で起きてね",
+		}, senderSession)
+		if err != nil {
+			t.Fatalf("モーニングコール作成エラー: %v", err)
+		}
+		defer createResp.Body.Close()
+		AssertStatusCode(t, http.StatusCreated, createResp.StatusCode)
+
+		var created map[string]interface{}
+		if err := json.NewDecoder(createResp.Body).Decode(&created); err != nil {
+			t.Fatalf("レスポンスのパースに失敗: %v", err)
+		}
+		if created["receiver_id"] != receiverID || created["forwarded_from"] != nil {
+			t.Errorf("作成結果が不正: receiver_id=%v, forwarded_from=%v", created["receiver_id"], created["forwarded_from"])
+		}
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -123,6 +124,58 @@ func TestFriendRequestFlow(t *testing.T) {
 		}
 	})
 
+	t.Run("未読件数と既読化", func(t *testing.T) {
+		getUnseenCount := func(t *testing.T) float64 {
+			t.Helper()
+			resp, err := ts.DoRequest("GET", "/api/v1/relationships/requests/unseen-count", nil, session2)
+			if err != nil {
+				t.Fatalf("リクエストエラー: %v", err)
+			}
+			defer resp.Body.Close()
+
+			AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+
+			var result map[string]interface{}
+			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+				t.Fatalf("JSONデコードエラー: %v", err)
+			}
+			count, _ := result["count"].(float64)
+			return count
+		}
+
+		if count := getUnseenCount(t); count != 1 {
+			t.Errorf("未読件数が不正: expected=1, actual=%v", count)
+		}
+
+		// 送信者は既読にできない
+		resp, err := ts.DoRequest("PUT", fmt.Sprintf("/api/v1/relationships/%s/seen", relationshipID), nil, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		resp.Body.Close()
+		AssertStatusCode(t, http.StatusForbidden, resp.StatusCode)
+
+		// 受信者が既読にする
+		resp, err = ts.DoRequest("PUT", fmt.Sprintf("/api/v1/relationships/%s/seen", relationshipID), nil, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+
+		var result map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("JSONデコードエラー: %v", err)
+		}
+		if result["seen_by_receiver"] != true {
+			t.Errorf("既読フラグが不正: expected=true, actual=%v", result["seen_by_receiver"])
+		}
+
+		if count := getUnseenCount(t); count != 0 {
+			t.Errorf("未読件数が不正: expected=0, actual=%v", count)
+		}
+	})
+
 	t.Run("友達リクエスト承認", func(t *testing.T) {
 		// まず既存のリクエストを取得してIDを確認
 		resp, err := ts.DoRequest("GET", "/api/v1/relationships/requests", nil, session2)
@@ -396,4 +449,217 @@ func TestRelationshipAuthorization(t *testing.T) {
 
 		AssertStatusCode(t, http.StatusOK, acceptResp.StatusCode)
 	})
-}
\ No newline at end of file
+}
+func TestFriendInviteFlow(t *testing.T) {
+	ts := NewTestServer(t)
+	defer ts.Close()
+
+	// テストユーザーの作成
+	user1ID := ts.RegisterUser(t, "inviteuser1", "invite1@example.com", "Password123!")
+	ts.RegisterUser(t, "inviteuser2", "invite2@example.com", "Password123!")
+	ts.RegisterUser(t, "inviteuser3", "invite3@example.com", "Password123!")
+
+	session1 := ts.LoginUser(t, "inviteuser1", "Password123!")
+	session2 := ts.LoginUser(t, "inviteuser2", "Password123!")
+	session3 := ts.LoginUser(t, "inviteuser3", "Password123!")
+
+	var token string
+
+	t.Run("招待トークンとQR用URIを発行", func(t *testing.T) {
+		resp, err := ts.DoRequest("POST", "/api/v1/relationships/invites", nil, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		AssertStatusCode(t, http.StatusCreated, resp.StatusCode)
+
+		var result struct {
+			Token   string `json:"token"`
+			URI     string `json:"uri"`
+			MaxUses int    `json:"max_uses"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("JSONデコードエラー: %v", err)
+		}
+		if result.Token == "" || result.MaxUses != 1 {
+			t.Errorf("招待トークンが不正: %+v", result)
+		}
+		if !strings.HasPrefix(result.URI, "morningcall://friends/invite?token=") || !strings.Contains(result.URI, result.Token) {
+			t.Errorf("招待URIが不正: %s", result.URI)
+		}
+		token = result.Token
+	})
+
+	t.Run("トークンを読み取って友達リクエストを送信", func(t *testing.T) {
+		if token == "" {
+			t.Skip("招待トークンが設定されていません")
+		}
+
+		resp, err := ts.DoRequest("POST", "/api/v1/relationships/accept-invite", map[string]string{"token": token}, session2)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		AssertStatusCode(t, http.StatusCreated, resp.StatusCode)
+
+		var result map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("JSONデコードエラー: %v", err)
+		}
+		relationship, ok := result["relationship"].(map[string]interface{})
+		if !ok {
+			t.Fatal("relationshipフィールドが存在しません")
+		}
+		if relationship["receiver_id"] != user1ID || relationship["status"] != "pending" {
+			t.Errorf("友達リクエストが不正: %v", relationship)
+		}
+	})
+
+	t.Run("使用済みのトークンは再使用できない", func(t *testing.T) {
+		if token == "" {
+			t.Skip("招待トークンが設定されていません")
+		}
+
+		resp, err := ts.DoRequest("POST", "/api/v1/relationships/accept-invite", map[string]string{"token": token}, session3)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		AssertStatusCode(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("存在しないトークン", func(t *testing.T) {
+		resp, err := ts.DoRequest("POST", "/api/v1/relationships/accept-invite", map[string]string{"token": "unknown"}, session3)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		AssertStatusCode(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestFriendGraph(t *testing.T) {
+	ts := NewTestServer(t)
+	defer ts.Close()
+
+	_ = ts.RegisterUser(t, "graphuser1", "graph1@example.com", "Password123!")
+	user2ID := ts.RegisterUser(t, "graphuser2", "graph2@example.com", "Password123!")
+	user3ID := ts.RegisterUser(t, "graphuser3", "graph3@example.com", "Password123!")
+
+	session1 := ts.LoginUser(t, "graphuser1", "Password123!")
+	session2 := ts.LoginUser(t, "graphuser2", "Password123!")
+	session3 := ts.LoginUser(t, "graphuser3", "Password123!")
+
+	// befriend はリクエストを送って承認し、友達にする
+	befriend := func(t *testing.T, requesterSession, receiverID, receiverSession string) {
+		resp, err := ts.DoRequest("POST", "/api/v1/relationships/request", map[string]string{"receiver_id": receiverID}, requesterSession)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusCreated, resp.StatusCode)
+
+		var result map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("JSONデコードエラー: %v", err)
+		}
+		relationshipID := result["relationship"].(map[string]interface{})["id"].(string)
+
+		acceptResp, err := ts.DoRequest("PUT", fmt.Sprintf("/api/v1/relationships/%s/accept", relationshipID), nil, receiverSession)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer acceptResp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, acceptResp.StatusCode)
+	}
+
+	// user1 - user2 - user3 の順につながる
+	befriend(t, session1, user2ID, session2)
+	befriend(t, session2, user3ID, session3)
+
+	type graph struct {
+		Nodes []struct {
+			ID       string `json:"id"`
+			Username string `json:"username"`
+			Email    string `json:"email"`
+			Depth    int    `json:"depth"`
+		} `json:"nodes"`
+		Edges []struct {
+			Source string `json:"source"`
+			Target string `json:"target"`
+		} `json:"edges"`
+		Truncated bool `json:"truncated"`
+	}
+	getGraph := func(t *testing.T, query string) graph {
+		resp, err := ts.DoRequest("GET", "/api/v1/relationships/graph"+query, nil, session1)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+
+		var g graph
+		if err := json.NewDecoder(resp.Body).Decode(&g); err != nil {
+			t.Fatalf("JSONデコードエラー: %v", err)
+		}
+		return g
+	}
+
+	t.Run("深さ未指定は友達まで", func(t *testing.T) {
+		g := getGraph(t, "")
+		if len(g.Nodes) != 2 || len(g.Edges) != 1 {
+			t.Fatalf("グラフが不正: %+v", g)
+		}
+		if g.Nodes[1].ID != user2ID || g.Nodes[1].Depth != 1 {
+			t.Errorf("友達のノードが不正: %+v", g.Nodes[1])
+		}
+	})
+
+	t.Run("深さ2で友達の友達まで", func(t *testing.T) {
+		g := getGraph(t, "?depth=2")
+		if len(g.Nodes) != 3 || len(g.Edges) != 2 || g.Truncated {
+			t.Fatalf("グラフが不正: %+v", g)
+		}
+		last := g.Nodes[2]
+		if last.ID != user3ID || last.Username != "graphuser3" || last.Depth != 2 {
+			t.Errorf("友達の友達のノードが不正: %+v", last)
+		}
+		// 友達の友達の連絡先は公開しない
+		if last.Email != "" {
+			t.Errorf("メールアドレスが含まれています: %s", last.Email)
+		}
+		found := false
+		for _, edge := range g.Edges {
+			if edge.Source == user2ID && edge.Target == user3ID {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("友達同士のエッジが含まれていません: %+v", g.Edges)
+		}
+	})
+
+	t.Run("不正な深さはエラー", func(t *testing.T) {
+		for _, depth := range []string{"0", "3", "abc"} {
+			resp, err := ts.DoRequest("GET", "/api/v1/relationships/graph?depth="+depth, nil, session1)
+			if err != nil {
+				t.Fatalf("リクエストエラー: %v", err)
+			}
+			resp.Body.Close()
+			AssertStatusCode(t, http.StatusBadRequest, resp.StatusCode)
+		}
+	})
+
+	t.Run("認証なしはエラー", func(t *testing.T) {
+		resp, err := ts.DoRequest("GET", "/api/v1/relationships/graph", nil, "")
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+}
@@ -10,9 +10,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ochamu/morning-call-api/internal/domain/valueobject"
 	"github.com/ochamu/morning-call-api/internal/handler"
 	"github.com/ochamu/morning-call-api/internal/infrastructure/auth"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/mail"
 	"github.com/ochamu/morning-call-api/internal/infrastructure/memory"
+	"github.com/ochamu/morning-call-api/internal/infrastructure/translation"
 	authUC "github.com/ochamu/morning-call-api/internal/usecase/auth"
 	morningCallUC "github.com/ochamu/morning-call-api/internal/usecase/morning_call"
 	relationshipUC "github.com/ochamu/morning-call-api/internal/usecase/relationship"
@@ -27,6 +30,7 @@ type TestServer struct {
 	RelationRepo   *memory.RelationshipRepository
 	PasswordService *auth.PasswordService
 	SessionManager *auth.SessionManager
+	EmailSender    *mail.MemoryEmailSender
 }
 
 // NewTestServer はテスト用サーバーを初期化します
@@ -35,41 +39,140 @@ func NewTestServer(t *testing.T) *TestServer {
 	userRepo := memory.NewUserRepository()
 	morningCallRepo := memory.NewMorningCallRepository()
 	relationshipRepo := memory.NewRelationshipRepository()
+	accessLogRepo := memory.NewMorningCallAccessLogRepository(memory.DefaultMaxAccessLogsPerCall)
+	friendInviteRepo := memory.NewFriendInviteRepository()
+	deviceTokenRepo := memory.NewDeviceTokenRepository()
+	commentRepo := memory.NewCommentRepository()
+	transactionManager := memory.NewTransactionManager(userRepo, morningCallRepo, relationshipRepo)
 	
 	// サービスの初期化
 	passwordService := auth.NewPasswordService()
 	sessionManager := auth.NewSessionManager(24 * time.Hour)
+	emailSender := mail.NewMemoryEmailSender()
+	translator := translation.NewCachingTranslator(translation.NewDictionaryTranslator(nil), translation.DefaultCacheSize)
 
 	// ユースケースの初期化
 	authUseCase := authUC.NewAuthUseCase(userRepo, passwordService)
-	userUseCase := userUC.NewUserUseCase(userRepo, passwordService)
+	authUseCase.SetLoginNotifier(mail.NewLoginNotifier(emailSender))
+	userUseCase := userUC.NewUserUseCase(userRepo, passwordService, valueobject.DefaultInputLimits())
 	
 	// モーニングコールユースケースの初期化
-	createMorningCallUC := morningCallUC.NewCreateUseCase(morningCallRepo, userRepo, relationshipRepo)
-	updateMorningCallUC := morningCallUC.NewUpdateUseCase(morningCallRepo, userRepo)
-	deleteMorningCallUC := morningCallUC.NewDeleteUseCase(morningCallRepo)
-	listMorningCallUC := morningCallUC.NewListUseCase(morningCallRepo, userRepo)
-	confirmWakeUC := morningCallUC.NewConfirmWakeUseCase(morningCallRepo, userRepo)
+	createMorningCallUC := morningCallUC.NewCreateUseCase(morningCallRepo, userRepo, relationshipRepo, valueobject.DefaultPlanQuotas(), valueobject.DefaultInputLimits(), nil)
+	updateMorningCallUC := morningCallUC.NewUpdateUseCase(morningCallRepo, userRepo, valueobject.DefaultInputLimits())
+	deleteMorningCallUC := morningCallUC.NewDeleteUseCase(morningCallRepo, commentRepo)
+	listMorningCallUC := morningCallUC.NewListUseCase(morningCallRepo, userRepo, relationshipRepo)
+	confirmWakeUC := morningCallUC.NewConfirmWakeUseCase(morningCallRepo, userRepo, emailSender, nil, 10)
+	findConflictsUC := morningCallUC.NewFindScheduleConflictsUseCase(morningCallRepo, userRepo)
+	validateMessageUC := morningCallUC.NewValidateMessageUseCase(nil, valueobject.DefaultInputLimits())
+	sendStampUC := morningCallUC.NewSendStampUseCase(morningCallRepo, userRepo)
+	frequentReceiversUC := morningCallUC.NewFrequentReceiversUseCase(morningCallRepo, userRepo)
+	skipMorningCallUC := morningCallUC.NewSkipUseCase(morningCallRepo, userRepo)
+	unconfirmedCountUC := morningCallUC.NewUnconfirmedCountUseCase(morningCallRepo)
+	setReceiverOffsetUC := morningCallUC.NewSetReceiverOffsetUseCase(morningCallRepo, userRepo)
+	patchMorningCallUC := morningCallUC.NewPatchUseCase(morningCallRepo, valueobject.DefaultInputLimits())
+	approveCallUC := morningCallUC.NewApproveCallUseCase(morningCallRepo, userRepo)
+	rejectCallUC := morningCallUC.NewRejectCallUseCase(morningCallRepo, userRepo, emailSender)
+	setSilentDeliveryUC := morningCallUC.NewSetSilentDeliveryUseCase(morningCallRepo, userRepo)
+	createSeriesUC := morningCallUC.NewCreateSeriesUseCase(morningCallRepo, userRepo, relationshipRepo, valueobject.DefaultPlanQuotas(), valueobject.DefaultInputLimits())
+	listSeriesUC := morningCallUC.NewListSeriesUseCase(morningCallRepo)
+	cancelSeriesUC := morningCallUC.NewCancelSeriesUseCase(morningCallRepo)
+	updateSeriesUC := morningCallUC.NewUpdateSeriesUseCase(morningCallRepo, valueobject.DefaultInputLimits())
+	callLeaderboardUC := morningCallUC.NewLeaderboardUseCase(morningCallRepo, userRepo, relationshipRepo)
+	getMorningCallUC := morningCallUC.NewGetUseCase(morningCallRepo, accessLogRepo, translator)
+	listAccessLogUC := morningCallUC.NewListAccessLogUseCase(morningCallRepo, accessLogRepo, userRepo)
+	archiveMorningCallUC := morningCallUC.NewArchiveUseCase(morningCallRepo)
+	cancelMorningCallUC := morningCallUC.NewCancelUseCase(morningCallRepo, morningCallUC.DefaultCancelGracePeriod)
+	requestRescheduleUC := morningCallUC.NewRequestRescheduleUseCase(morningCallRepo, userRepo)
+	respondRescheduleUC := morningCallUC.NewRespondRescheduleUseCase(morningCallRepo)
+	respondNextCallUC := morningCallUC.NewRespondNextCallUseCase(morningCallRepo, createMorningCallUC)
+	wakeHeatmapUC := morningCallUC.NewWakeHeatmapUseCase(morningCallRepo, userRepo)
+	listSystemMessagesUC := morningCallUC.NewListSystemMessagesUseCase(valueobject.DefaultSystemMessageCatalog())
+	rateMorningCallUC := morningCallUC.NewRateMorningCallUseCase(morningCallRepo, userRepo)
+	ratingStatsUC := morningCallUC.NewRatingStatsUseCase(morningCallRepo, userRepo)
+	confirmTimeStatsUC := morningCallUC.NewConfirmTimeStatsUseCase(morningCallRepo, userRepo)
+	messageVariantStatsUC := morningCallUC.NewMessageVariantStatsUseCase(morningCallRepo, userRepo)
+	setDeliveryHintsUC := morningCallUC.NewSetDeliveryHintsUseCase(morningCallRepo, userRepo)
+	generateReportUC := morningCallUC.NewGenerateReportUseCase(morningCallRepo, userRepo)
+	addCommentUC := morningCallUC.NewAddCommentUseCase(morningCallRepo, commentRepo)
+	listCommentsUC := morningCallUC.NewListCommentsUseCase(morningCallRepo, commentRepo)
 	
 	// 関係性ユースケースの初期化
-	sendFriendRequestUC := relationshipUC.NewSendFriendRequestUseCase(relationshipRepo, userRepo)
-	acceptFriendRequestUC := relationshipUC.NewAcceptFriendRequestUseCase(relationshipRepo, userRepo)
+	sendFriendRequestUC := relationshipUC.NewSendFriendRequestUseCase(relationshipRepo, userRepo, valueobject.DefaultPlanQuotas(), valueobject.DefaultFriendRequestResendPolicy())
+	acceptFriendRequestUC := relationshipUC.NewAcceptFriendRequestUseCase(relationshipRepo, userRepo, valueobject.DefaultPlanQuotas(), transactionManager)
 	rejectFriendRequestUC := relationshipUC.NewRejectFriendRequestUseCase(relationshipRepo, userRepo)
-	blockUserUC := relationshipUC.NewBlockUserUseCase(relationshipRepo, userRepo)
-	blockRelationshipUC := relationshipUC.NewBlockRelationshipUseCase(relationshipRepo, userRepo)
-	removeRelationshipUC := relationshipUC.NewRemoveRelationshipUseCase(relationshipRepo, userRepo)
-	listFriendsUC := relationshipUC.NewListFriendsUseCase(relationshipRepo, userRepo)
+	blockUserUC := relationshipUC.NewBlockUserUseCase(relationshipRepo, userRepo, morningCallRepo, transactionManager)
+	blockRelationshipUC := relationshipUC.NewBlockRelationshipUseCase(relationshipRepo, userRepo, morningCallRepo, transactionManager)
+	removeRelationshipUC := relationshipUC.NewRemoveRelationshipUseCase(relationshipRepo, userRepo, morningCallRepo, transactionManager)
+	listFriendsUC := relationshipUC.NewListFriendsUseCase(relationshipRepo, userRepo, morningCallRepo)
 	listFriendRequestsUC := relationshipUC.NewListFriendRequestsUseCase(relationshipRepo, userRepo)
+	markRequestSeenUC := relationshipUC.NewMarkRequestSeenUseCase(relationshipRepo, userRepo)
+	unseenRequestCountUC := relationshipUC.NewUnseenRequestCountUseCase(relationshipRepo)
+	friendCallWindowUC := relationshipUC.NewUpdateFriendCallWindowUseCase(relationshipRepo, userRepo)
+	updateAutoConfirmUC := relationshipUC.NewUpdateAutoConfirmUseCase(relationshipRepo, userRepo)
+	favoriteSenderUC := relationshipUC.NewUpdateFavoriteSenderUseCase(relationshipRepo, userRepo)
+	generateFriendInviteUC := relationshipUC.NewGenerateFriendInviteTokenUseCase(friendInviteRepo, userRepo)
+	acceptFriendInviteUC := relationshipUC.NewAcceptFriendInviteUseCase(friendInviteRepo, sendFriendRequestUC)
+	friendGraphUC := relationshipUC.NewExportFriendGraphUseCase(relationshipRepo, userRepo)
 
 	// Handlerの初期化
 	authHandler := handler.NewAuthHandler(authUseCase, sessionManager)
-	userHandler := handler.NewUserHandler(userUseCase, sessionManager)
+	searchUsersUC := userUC.NewSearchUsersUseCase(userRepo, relationshipRepo)
+	requestEmailChangeUC := userUC.NewRequestEmailChangeUseCase(userRepo, passwordService, emailSender, valueobject.DefaultInputLimits())
+	updateCallApprovalUC := userUC.NewUpdateCallApprovalUseCase(userRepo)
+	updateProxyConfirmerUC := userUC.NewUpdateProxyConfirmerUseCase(userRepo, relationshipRepo)
+	updateCallForwardingUC := userUC.NewUpdateCallForwardingUseCase(userRepo, relationshipRepo)
+	updateTimeZoneUC := userUC.NewUpdateTimeZoneUseCase(userRepo)
+	updatePreferencesUC := userUC.NewUpdatePreferencesUseCase(userRepo)
+	updateCallWindowUC := userUC.NewUpdateCallWindowUseCase(userRepo)
+	checkAvailabilityUC := userUC.NewCheckAvailabilityUseCase(userRepo, valueobject.DefaultInputLimits(), 20, time.Minute)
+	leaderboardUC := userUC.NewLeaderboardUseCase(userRepo)
+	changeUsernameUC := userUC.NewChangeUsernameUseCase(userRepo, valueobject.DefaultInputLimits(), 0)
+	changePasswordUC := userUC.NewChangePasswordUseCase(userRepo, passwordService, 5)
+	confirmEmailChangeUC := userUC.NewConfirmEmailChangeUseCase(userRepo, valueobject.DefaultInputLimits())
+	registerDeviceUC := userUC.NewRegisterDeviceTokenUseCase(userRepo, deviceTokenRepo)
+	listDevicesUC := userUC.NewListDeviceTokensUseCase(deviceTokenRepo)
+	deleteDeviceUC := userUC.NewDeleteDeviceTokenUseCase(deviceTokenRepo)
+	requestAccountDeletionUC := userUC.NewRequestAccountDeletionUseCase(userRepo, passwordService, 0)
+	userHandler := handler.NewUserHandler(userUseCase, searchUsersUC, requestEmailChangeUC, confirmEmailChangeUC, updateCallApprovalUC, updateProxyConfirmerUC, updateCallForwardingUC, updateTimeZoneUC, updatePreferencesUC, changePasswordUC, updateCallWindowUC, checkAvailabilityUC, leaderboardUC, changeUsernameUC, registerDeviceUC, listDevicesUC, deleteDeviceUC, requestAccountDeletionUC, sessionManager)
 	morningCallHandler := handler.NewMorningCallHandler(
 		createMorningCallUC,
 		updateMorningCallUC,
 		deleteMorningCallUC,
 		listMorningCallUC,
 		confirmWakeUC,
+		findConflictsUC,
+		validateMessageUC,
+		sendStampUC,
+		frequentReceiversUC,
+		skipMorningCallUC,
+		unconfirmedCountUC,
+		setReceiverOffsetUC,
+		patchMorningCallUC,
+		approveCallUC,
+		rejectCallUC,
+		setSilentDeliveryUC,
+		createSeriesUC,
+		listSeriesUC,
+		cancelSeriesUC,
+		updateSeriesUC,
+		callLeaderboardUC,
+		getMorningCallUC,
+		listAccessLogUC,
+		archiveMorningCallUC,
+		cancelMorningCallUC,
+		requestRescheduleUC,
+		respondRescheduleUC,
+		respondNextCallUC,
+		wakeHeatmapUC,
+		listSystemMessagesUC,
+		rateMorningCallUC,
+		ratingStatsUC,
+		confirmTimeStatsUC,
+		messageVariantStatsUC,
+		setDeliveryHintsUC,
+		generateReportUC,
+		addCommentUC,
+		listCommentsUC,
 		sessionManager,
 	)
 	relationshipHandler := handler.NewRelationshipHandler(
@@ -81,6 +184,14 @@ func NewTestServer(t *testing.T) *TestServer {
 		removeRelationshipUC,
 		listFriendsUC,
 		listFriendRequestsUC,
+		markRequestSeenUC,
+		unseenRequestCountUC,
+		friendCallWindowUC,
+		updateAutoConfirmUC,
+		favoriteSenderUC,
+		generateFriendInviteUC,
+		acceptFriendInviteUC,
+		friendGraphUC,
 		userUseCase,
 		sessionManager,
 	)
@@ -105,6 +216,7 @@ func NewTestServer(t *testing.T) *TestServer {
 		RelationRepo:   relationshipRepo,
 		PasswordService: passwordService,
 		SessionManager: sessionManager,
+		EmailSender:    emailSender,
 	}
 }
 
@@ -40,12 +40,42 @@ func SetupTestRouter(
 
 	// 認証が必要なエンドポイント
 	router.HandleFunc("/api/v1/auth/logout", authMiddleware.Authenticate(authHandler.HandleLogout))
+	router.HandleFunc("/api/v1/auth/tokens", authMiddleware.Authenticate(authHandler.HandleIssueToken))
 	router.HandleFunc("/api/v1/users/me", authMiddleware.Authenticate(userHandler.HandleGetProfile))
 	router.HandleFunc("/api/v1/users/search", authMiddleware.Authenticate(userHandler.HandleSearchUsers))
+	router.HandleFunc("/api/v1/users/check", authMiddleware.Authenticate(userHandler.HandleCheckAvailability))
+	router.HandleFunc("/api/v1/users/me/email/request", authMiddleware.Authenticate(userHandler.HandleRequestEmailChange))
+	router.HandleFunc("/api/v1/users/me/email/confirm", authMiddleware.Authenticate(userHandler.HandleConfirmEmailChange))
+	router.HandleFunc("/api/v1/users/me/call-approval", authMiddleware.Authenticate(userHandler.HandleUpdateCallApproval))
+	router.HandleFunc("/api/v1/users/me/timezone", authMiddleware.Authenticate(userHandler.HandleUpdateTimeZone))
+	router.HandleFunc("/api/v1/users/me/username", authMiddleware.Authenticate(userHandler.HandleChangeUsername))
+	router.HandleFunc("/api/v1/users/me/preferences", authMiddleware.Authenticate(userHandler.HandleUpdatePreferences))
+	router.HandleFunc("/api/v1/users/me/password", authMiddleware.Authenticate(userHandler.HandleChangePassword))
+	router.HandleFunc("/api/v1/users/me/call-window", authMiddleware.Authenticate(userHandler.HandleUpdateCallWindow))
+	router.HandleFunc("/api/v1/users/me/proxy-confirmers/", authMiddleware.Authenticate(userHandler.HandleUpdateProxyConfirmer))
+	router.HandleFunc("/api/v1/users/me/call-forwarding", authMiddleware.Authenticate(userHandler.HandleUpdateCallForwarding))
+	router.HandleFunc("/api/v1/users/me/devices", authMiddleware.Authenticate(userHandler.HandleDevices))
+	router.HandleFunc("/api/v1/users/me/devices/", authMiddleware.Authenticate(userHandler.HandleDeleteDevice))
+	router.HandleFunc("/api/v1/users/me/deletion", authMiddleware.Authenticate(userHandler.HandleRequestAccountDeletion))
+	router.HandleFunc("/api/v1/leaderboard", authMiddleware.Authenticate(userHandler.HandleLeaderboard))
 
 	// Special morning call endpoints (これらを先に登録)
 	router.HandleFunc("/api/v1/morning-calls/sent", authMiddleware.Authenticate(morningCallHandler.HandleListSent))
 	router.HandleFunc("/api/v1/morning-calls/received", authMiddleware.Authenticate(morningCallHandler.HandleListReceived))
+	router.HandleFunc("/api/v1/morning-calls/archived", authMiddleware.Authenticate(morningCallHandler.HandleListArchived))
+	router.HandleFunc("/api/v1/morning-calls/conflicts", authMiddleware.Authenticate(morningCallHandler.HandleListConflicts))
+	router.HandleFunc("/api/v1/morning-calls/frequent-receivers", authMiddleware.Authenticate(morningCallHandler.HandleListFrequentReceivers))
+	router.HandleFunc("/api/v1/morning-calls/unconfirmed-count", authMiddleware.Authenticate(morningCallHandler.HandleUnconfirmedCount))
+	router.HandleFunc("/api/v1/morning-calls/heatmap", authMiddleware.Authenticate(morningCallHandler.HandleWakeHeatmap))
+	router.HandleFunc("/api/v1/morning-calls/report", authMiddleware.Authenticate(morningCallHandler.HandleReport))
+	router.HandleFunc("/api/v1/morning-calls/system-messages", authMiddleware.Authenticate(morningCallHandler.HandleListSystemMessages))
+	router.HandleFunc("/api/v1/morning-calls/rating-stats", authMiddleware.Authenticate(morningCallHandler.HandleRatingStats))
+	router.HandleFunc("/api/v1/morning-calls/confirm-time-stats", authMiddleware.Authenticate(morningCallHandler.HandleConfirmTimeStats))
+	router.HandleFunc("/api/v1/morning-calls/variant-stats", authMiddleware.Authenticate(morningCallHandler.HandleMessageVariantStats))
+	router.HandleFunc("/api/v1/morning-calls/leaderboard", authMiddleware.Authenticate(morningCallHandler.HandleLeaderboard))
+	router.HandleFunc("/api/v1/morning-calls/validate-message", authMiddleware.Authenticate(morningCallHandler.HandleValidateMessage))
+	router.HandleFunc("/api/v1/morning-calls/series", authMiddleware.Authenticate(morningCallHandler.HandleCreateSeries))
+	router.HandleFunc("/api/v1/morning-calls/series/", authMiddleware.Authenticate(morningCallHandler.HandleSeries))
 
 	// MorningCallエンドポイント
 	router.HandleFunc("/api/v1/morning-calls", authMiddleware.Authenticate(func(w http.ResponseWriter, r *http.Request) {
@@ -97,6 +127,142 @@ func SetupTestRouter(
 			morningCallHandler.HandleConfirmWake(w, r)
 			return
 		}
+		if strings.HasSuffix(idPart, "/rating") {
+			if r.Method != http.MethodPost {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			morningCallHandler.HandleRate(w, r)
+			return
+		}
+		if strings.HasSuffix(idPart, "/stamp") {
+			if r.Method != http.MethodPut {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			morningCallHandler.HandleSendStamp(w, r)
+			return
+		}
+		if strings.HasSuffix(idPart, "/skip") {
+			if r.Method != http.MethodPut {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			morningCallHandler.HandleSkip(w, r)
+			return
+		}
+		if strings.HasSuffix(idPart, "/cancel") {
+			if r.Method != http.MethodPut {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			morningCallHandler.HandleCancel(w, r)
+			return
+		}
+		if strings.HasSuffix(idPart, "/reschedule-request") {
+			if r.Method != http.MethodPost {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			morningCallHandler.HandleRequestReschedule(w, r)
+			return
+		}
+		if strings.HasSuffix(idPart, "/reschedule-request/approve") {
+			if r.Method != http.MethodPost {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			morningCallHandler.HandleApproveReschedule(w, r)
+			return
+		}
+		if strings.HasSuffix(idPart, "/reschedule-request/reject") {
+			if r.Method != http.MethodPost {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			morningCallHandler.HandleRejectReschedule(w, r)
+			return
+		}
+		if strings.HasSuffix(idPart, "/next-call-request/approve") {
+			if r.Method != http.MethodPost {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			morningCallHandler.HandleApproveNextCall(w, r)
+			return
+		}
+		if strings.HasSuffix(idPart, "/next-call-request/reject") {
+			if r.Method != http.MethodPost {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			morningCallHandler.HandleRejectNextCall(w, r)
+			return
+		}
+		if strings.HasSuffix(idPart, "/offset") {
+			if r.Method != http.MethodPut {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			morningCallHandler.HandleSetReceiverOffset(w, r)
+			return
+		}
+		if strings.HasSuffix(idPart, "/silent") {
+			if r.Method != http.MethodPut {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			morningCallHandler.HandleSetSilentDelivery(w, r)
+			return
+		}
+		if strings.HasSuffix(idPart, "/delivery-hints") {
+			if r.Method != http.MethodPut {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			morningCallHandler.HandleSetDeliveryHints(w, r)
+			return
+		}
+		if strings.HasSuffix(idPart, "/approve") {
+			if r.Method != http.MethodPut {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			morningCallHandler.HandleApprove(w, r)
+			return
+		}
+		if strings.HasSuffix(idPart, "/archive") {
+			if r.Method != http.MethodPut && r.Method != http.MethodDelete {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			morningCallHandler.HandleArchive(w, r)
+			return
+		}
+		if strings.HasSuffix(idPart, "/access-log") {
+			if r.Method != http.MethodGet {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			morningCallHandler.HandleAccessLog(w, r)
+			return
+		}
+		if strings.HasSuffix(idPart, "/comments") {
+			if r.Method != http.MethodGet && r.Method != http.MethodPost {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			morningCallHandler.HandleComments(w, r)
+			return
+		}
+		if strings.HasSuffix(idPart, "/reject") {
+			if r.Method != http.MethodPut {
+				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			morningCallHandler.HandleReject(w, r)
+			return
+		}
 		
 		// Regular CRUD operations
 		switch r.Method {
@@ -104,6 +270,8 @@ func SetupTestRouter(
 			morningCallHandler.HandleGet(w, r)
 		case http.MethodPut:
 			morningCallHandler.HandleUpdate(w, r)
+		case http.MethodPatch:
+			morningCallHandler.HandlePatch(w, r)
 		case http.MethodDelete:
 			morningCallHandler.HandleDelete(w, r)
 		default:
@@ -116,6 +284,10 @@ func SetupTestRouter(
 	router.HandleFunc("/api/v1/relationships/request", authMiddleware.Authenticate(relationshipHandler.HandleSendFriendRequest))
 	router.HandleFunc("/api/v1/relationships/friends", authMiddleware.Authenticate(relationshipHandler.HandleListFriends))
 	router.HandleFunc("/api/v1/relationships/requests", authMiddleware.Authenticate(relationshipHandler.HandleListFriendRequests))
+	router.HandleFunc("/api/v1/relationships/requests/unseen-count", authMiddleware.Authenticate(relationshipHandler.HandleUnseenRequestCount))
+	router.HandleFunc("/api/v1/relationships/invites", authMiddleware.Authenticate(relationshipHandler.HandleGenerateFriendInvite))
+	router.HandleFunc("/api/v1/relationships/accept-invite", authMiddleware.Authenticate(relationshipHandler.HandleAcceptFriendInvite))
+	router.HandleFunc("/api/v1/relationships/graph", authMiddleware.Authenticate(relationshipHandler.HandleFriendGraph))
 
 	// Relationship ID based endpoints
 	router.HandleFunc("/api/v1/relationships/", authMiddleware.Authenticate(func(w http.ResponseWriter, r *http.Request) {
@@ -134,6 +306,22 @@ func SetupTestRouter(
 				relationshipHandler.HandleBlockUser(w, r)
 				return
 			}
+			if strings.HasSuffix(idPart, "/seen") {
+				relationshipHandler.HandleMarkRequestSeen(w, r)
+				return
+			}
+			if strings.HasSuffix(idPart, "/call-window") {
+				relationshipHandler.HandleUpdateFriendCallWindow(w, r)
+				return
+			}
+			if strings.HasSuffix(idPart, "/auto-confirm") {
+				relationshipHandler.HandleUpdateAutoConfirm(w, r)
+				return
+			}
+			if strings.HasSuffix(idPart, "/favorite-sender") {
+				relationshipHandler.HandleUpdateFavoriteSender(w, r)
+				return
+			}
 			
 			// DELETE endpoint
 			if r.Method == http.MethodDelete {
@@ -204,7 +392,7 @@ func (m *testAuthMiddleware) Authenticate(next http.HandlerFunc) http.HandlerFun
 func applyCORS(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
 		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
 
 		if r.Method == "OPTIONS" {
@@ -1,8 +1,10 @@
 package integration
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -45,6 +47,53 @@ func TestUserProfile(t *testing.T) {
 		}
 	})
 
+	t.Run("ETagによるプロフィールのキャッシュ", func(t *testing.T) {
+		ts.RegisterUser(t, "etaguser", "etag@example.com", "Password123!")
+		sessionID := ts.LoginUser(t, "etaguser", "Password123!")
+
+		getProfile := func(ifNoneMatch string) *http.Response {
+			t.Helper()
+			req, err := http.NewRequest("GET", ts.Server.URL+"/api/v1/users/me", nil)
+			if err != nil {
+				t.Fatalf("リクエスト作成エラー: %v", err)
+			}
+			req.AddCookie(&http.Cookie{Name: "session_id", Value: sessionID})
+			if ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", ifNoneMatch)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("リクエストエラー: %v", err)
+			}
+			resp.Body.Close()
+			return resp
+		}
+
+		first := getProfile("")
+		AssertStatusCode(t, http.StatusOK, first.StatusCode)
+		etag := first.Header.Get("ETag")
+		if etag == "" {
+			t.Fatal("ETagが返されていません")
+		}
+
+		// 変更がなければ304を返す
+		AssertStatusCode(t, http.StatusNotModified, getProfile(etag).StatusCode)
+
+		// 設定を変更するとETagが変わり、古いETagでは200を返す
+		resp, err := ts.DoRequest("PUT", "/api/v1/users/me/preferences", map[string]interface{}{"volume": 50}, sessionID)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		resp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+
+		updated := getProfile(etag)
+		AssertStatusCode(t, http.StatusOK, updated.StatusCode)
+		if got := updated.Header.Get("ETag"); got == "" || got == etag {
+			t.Errorf("更新後のETagが変わっていません: before=%s, after=%s", etag, got)
+		}
+	})
+
 	t.Run("未認証でのプロフィール取得", func(t *testing.T) {
 		resp, err := ts.DoRequest("GET", "/api/v1/users/me", nil, "")
 		if err != nil {
@@ -96,6 +145,13 @@ func TestUserSearch(t *testing.T) {
 			user := u.(map[string]interface{})
 			if user["username"] == "searchuser2" {
 				found = true
+				// 友達でないユーザーは関係なし・送信不可
+				if user["relationship_status"] != "none" {
+					t.Errorf("relationship_statusが不正: expected=none, actual=%v", user["relationship_status"])
+				}
+				if user["can_send_morning_call"] != false {
+					t.Errorf("can_send_morning_callが不正: expected=false, actual=%v", user["can_send_morning_call"])
+				}
 				break
 			}
 		}
@@ -195,4 +251,359 @@ func TestUserRegistrationValidation(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func TestUserEmailChange(t *testing.T) {
+	ts := NewTestServer(t)
+	defer ts.Close()
+
+	ts.RegisterUser(t, "emailuser", "email.old@example.com", "Password123!")
+	ts.RegisterUser(t, "otheruser", "other@example.com", "Password123!")
+	sessionID := ts.LoginUser(t, "emailuser", "Password123!")
+
+	t.Run("パスワードが正しくない場合は申請できない", func(t *testing.T) {
+		resp, err := ts.DoRequest("POST", "/api/v1/users/me/email/request", map[string]string{
+			"new_email": "email.new@example.com",
+			"password":  "Wrong123!",
+		}, sessionID)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		AssertStatusCode(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("他のユーザーのメールアドレスには変更できない", func(t *testing.T) {
+		resp, err := ts.DoRequest("POST", "/api/v1/users/me/email/request", map[string]string{
+			"new_email": "other@example.com",
+			"password":  "Password123!",
+		}, sessionID)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		AssertStatusCode(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("申請と確認でメールアドレスが変更される", func(t *testing.T) {
+		resp, err := ts.DoRequest("POST", "/api/v1/users/me/email/request", map[string]string{
+			"new_email": "email.new@example.com",
+			"password":  "Password123!",
+		}, sessionID)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		resp.Body.Close()
+		AssertStatusCode(t, http.StatusAccepted, resp.StatusCode)
+
+		messages := ts.EmailSender.Messages()
+		if len(messages) != 1 || messages[0].To != "email.new@example.com" {
+			t.Fatalf("確認メールが送信されていません: %+v", messages)
+		}
+		var token string
+		for _, line := range strings.Split(messages[0].Body, "\n") {
+			if strings.HasPrefix(line, "確認コード: ") {
+				token = strings.TrimPrefix(line, "確認コード: ")
+			}
+		}
+
+		// 確認前は旧メールアドレスのまま
+		stored, err := ts.UserRepo.FindByEmail(context.Background(), "email.old@example.com")
+		if err != nil || stored.Username != "emailuser" {
+			t.Fatalf("確認前は旧メールアドレスのままのはずです: %v", err)
+		}
+
+		// 誤ったトークンでは変更されない
+		resp, err = ts.DoRequest("POST", "/api/v1/users/me/email/confirm", map[string]string{"token": "wrong"}, sessionID)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		resp.Body.Close()
+		AssertStatusCode(t, http.StatusBadRequest, resp.StatusCode)
+
+		resp, err = ts.DoRequest("POST", "/api/v1/users/me/email/confirm", map[string]string{"token": token}, sessionID)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+
+		var result map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("JSONデコードエラー: %v", err)
+		}
+		user, ok := result["user"].(map[string]interface{})
+		if !ok || user["email"] != "email.new@example.com" {
+			t.Errorf("メールアドレスが変更されていません: %v", result)
+		}
+	})
+
+	t.Run("未認証では申請できない", func(t *testing.T) {
+		resp, err := ts.DoRequest("POST", "/api/v1/users/me/email/request", map[string]string{
+			"new_email": "x@example.com",
+			"password":  "Password123!",
+		}, "")
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		AssertStatusCode(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+}
+
+func TestUserChangeUsername(t *testing.T) {
+	ts := NewTestServer(t)
+	defer ts.Close()
+
+	ts.RegisterUser(t, "renameuser", "rename@example.com", "Password123!")
+	ts.RegisterUser(t, "takenuser", "taken@example.com", "Password123!")
+	sessionID := ts.LoginUser(t, "renameuser", "Password123!")
+
+	t.Run("他のユーザーのユーザー名には変更できない", func(t *testing.T) {
+		resp, err := ts.DoRequest("PUT", "/api/v1/users/me/username", map[string]string{"username": "takenuser"}, sessionID)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		AssertStatusCode(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("一度変更するとクールダウン中は再変更できない", func(t *testing.T) {
+		resp, err := ts.DoRequest("PUT", "/api/v1/users/me/username", map[string]string{"username": "renamed_user"}, sessionID)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+
+		var result map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("JSONデコードエラー: %v", err)
+		}
+		user, ok := result["user"].(map[string]interface{})
+		if !ok || user["username"] != "renamed_user" {
+			t.Errorf("ユーザー名が変更されていません: %v", result)
+		}
+		if _, ok := result["next_username_change_at"].(string); !ok {
+			t.Errorf("次に変更できる日時が返されていません: %v", result)
+		}
+
+		// 変更後のユーザー名でログインできる
+		ts.LoginUser(t, "renamed_user", "Password123!")
+
+		resp, err = ts.DoRequest("PUT", "/api/v1/users/me/username", map[string]string{"username": "renamed_again"}, sessionID)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestUserAvailabilityCheck(t *testing.T) {
+	ts := NewTestServer(t)
+	defer ts.Close()
+
+	ts.RegisterUser(t, "checkuser", "check@example.com", "Password123!")
+	sessionID := ts.LoginUser(t, "checkuser", "Password123!")
+
+	t.Run("使用済み・未使用を判定する", func(t *testing.T) {
+		resp, err := ts.DoRequest("GET", "/api/v1/users/check?username=checkuser&email=free@example.com", nil, sessionID)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+
+		var result map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("JSONデコードエラー: %v", err)
+		}
+		if result["username_available"] != false {
+			t.Errorf("username_availableが不正: expected=false, actual=%v", result["username_available"])
+		}
+		if result["email_available"] != true {
+			t.Errorf("email_availableが不正: expected=true, actual=%v", result["email_available"])
+		}
+	})
+
+	t.Run("未認証では確認できない", func(t *testing.T) {
+		resp, err := ts.DoRequest("GET", "/api/v1/users/check?username=checkuser", nil, "")
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		AssertStatusCode(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("回数の上限を超えると制限される", func(t *testing.T) {
+		limitedSessionID := ts.LoginUser(t, "checkuser", "Password123!")
+
+		lastStatus := 0
+		for i := 0; i < 30 && lastStatus != http.StatusTooManyRequests; i++ {
+			resp, err := ts.DoRequest("GET", "/api/v1/users/check?username=someone", nil, limitedSessionID)
+			if err != nil {
+				t.Fatalf("リクエストエラー: %v", err)
+			}
+			resp.Body.Close()
+			lastStatus = resp.StatusCode
+		}
+
+		AssertStatusCode(t, http.StatusTooManyRequests, lastStatus)
+	})
+}
+
+func TestUserDevices(t *testing.T) {
+	ts := NewTestServer(t)
+	defer ts.Close()
+
+	ts.RegisterUser(t, "deviceuser", "device@example.com", "Password123!")
+	ts.RegisterUser(t, "otherdevice", "otherdevice@example.com", "Password123!")
+	sessionID := ts.LoginUser(t, "deviceuser", "Password123!")
+	otherSessionID := ts.LoginUser(t, "otherdevice", "Password123!")
+
+	var deviceID string
+
+	t.Run("端末を登録すると一覧に表示される", func(t *testing.T) {
+		body := map[string]string{"token": "apns-token-123456", "platform": "ios"}
+		resp, err := ts.DoRequest("POST", "/api/v1/users/me/devices", body, sessionID)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusCreated, resp.StatusCode)
+
+		var result map[string]map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("JSONデコードエラー: %v", err)
+		}
+		deviceID, _ = result["device"]["id"].(string)
+		if deviceID == "" || result["device"]["token_suffix"] != "123456" {
+			t.Errorf("登録した端末が不正です: %v", result)
+		}
+
+		// 同じトークンの再登録は同じ端末を返す
+		resp, err = ts.DoRequest("POST", "/api/v1/users/me/devices", body, sessionID)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+
+		resp, err = ts.DoRequest("GET", "/api/v1/users/me/devices?platform=ios", nil, sessionID)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+
+		var list struct {
+			Devices []map[string]interface{} `json:"devices"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+			t.Fatalf("JSONデコードエラー: %v", err)
+		}
+		if len(list.Devices) != 1 || list.Devices[0]["id"] != deviceID {
+			t.Errorf("端末一覧が不正です: %v", list.Devices)
+		}
+	})
+
+	t.Run("不明なプラットフォームは登録できない", func(t *testing.T) {
+		resp, err := ts.DoRequest("POST", "/api/v1/users/me/devices", map[string]string{"token": "token", "platform": "windows"}, sessionID)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		AssertStatusCode(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("他のユーザーの端末は削除できない", func(t *testing.T) {
+		resp, err := ts.DoRequest("DELETE", "/api/v1/users/me/devices/"+deviceID, nil, otherSessionID)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		AssertStatusCode(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("自分の端末を削除できる", func(t *testing.T) {
+		resp, err := ts.DoRequest("DELETE", "/api/v1/users/me/devices/"+deviceID, nil, sessionID)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		AssertStatusCode(t, http.StatusNoContent, resp.StatusCode)
+	})
+}
+
+func TestUserAccountDeletion(t *testing.T) {
+	ts := NewTestServer(t)
+	defer ts.Close()
+
+	ts.RegisterUser(t, "leavinguser", "leaving@example.com", "Password123!")
+	sessionID := ts.LoginUser(t, "leavinguser", "Password123!")
+
+	t.Run("パスワードが正しくない場合は削除を申請できない", func(t *testing.T) {
+		resp, err := ts.DoRequest("POST", "/api/v1/users/me/deletion", map[string]string{"password": "Wrong123!"}, sessionID)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+
+		AssertStatusCode(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("削除を申請するとログアウトされる", func(t *testing.T) {
+		resp, err := ts.DoRequest("POST", "/api/v1/users/me/deletion", map[string]string{"password": "Password123!"}, sessionID)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusAccepted, resp.StatusCode)
+
+		var result map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("JSONデコードエラー: %v", err)
+		}
+		if result["deletion_scheduled_at"] == nil {
+			t.Errorf("削除予定日時が返されていません: %v", result)
+		}
+
+		resp, err = ts.DoRequest("GET", "/api/v1/users/me", nil, sessionID)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("猶予期間中にログインすると削除が取り消される", func(t *testing.T) {
+		resp, err := ts.DoRequest("POST", "/api/v1/auth/login", map[string]string{
+			"username": "leavinguser",
+			"password": "Password123!",
+		}, "")
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusOK, resp.StatusCode)
+		AssertJSONResponse(t, resp, "deletion_canceled", true)
+
+		// 取り消し後は再び削除を申請できる
+		newSessionID := ts.LoginUser(t, "leavinguser", "Password123!")
+		resp, err = ts.DoRequest("POST", "/api/v1/users/me/deletion", map[string]string{"password": "Password123!"}, newSessionID)
+		if err != nil {
+			t.Fatalf("リクエストエラー: %v", err)
+		}
+		defer resp.Body.Close()
+		AssertStatusCode(t, http.StatusAccepted, resp.StatusCode)
+	})
+}